@@ -438,9 +438,10 @@ func (x *fastReflection_GenericAuthorization) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_Grant               protoreflect.MessageDescriptor
-	fd_Grant_authorization protoreflect.FieldDescriptor
-	fd_Grant_expiration    protoreflect.FieldDescriptor
+	md_Grant                 protoreflect.MessageDescriptor
+	fd_Grant_authorization   protoreflect.FieldDescriptor
+	fd_Grant_expiration      protoreflect.FieldDescriptor
+	fd_Grant_granter_pub_key protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -448,6 +449,7 @@ func init() {
 	md_Grant = File_cosmos_authz_v1beta1_authz_proto.Messages().ByName("Grant")
 	fd_Grant_authorization = md_Grant.Fields().ByName("authorization")
 	fd_Grant_expiration = md_Grant.Fields().ByName("expiration")
+	fd_Grant_granter_pub_key = md_Grant.Fields().ByName("granter_pub_key")
 }
 
 var _ protoreflect.Message = (*fastReflection_Grant)(nil)
@@ -527,6 +529,12 @@ func (x *fastReflection_Grant) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.GranterPubKey != nil {
+		value := protoreflect.ValueOfMessage(x.GranterPubKey.ProtoReflect())
+		if !f(fd_Grant_granter_pub_key, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -546,6 +554,8 @@ func (x *fastReflection_Grant) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Authorization != nil
 	case "cosmos.authz.v1beta1.Grant.expiration":
 		return x.Expiration != nil
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		return x.GranterPubKey != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -566,6 +576,8 @@ func (x *fastReflection_Grant) Clear(fd protoreflect.FieldDescriptor) {
 		x.Authorization = nil
 	case "cosmos.authz.v1beta1.Grant.expiration":
 		x.Expiration = nil
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		x.GranterPubKey = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -588,6 +600,9 @@ func (x *fastReflection_Grant) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "cosmos.authz.v1beta1.Grant.expiration":
 		value := x.Expiration
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		value := x.GranterPubKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -612,6 +627,8 @@ func (x *fastReflection_Grant) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Authorization = value.Message().Interface().(*anypb.Any)
 	case "cosmos.authz.v1beta1.Grant.expiration":
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		x.GranterPubKey = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -642,6 +659,11 @@ func (x *fastReflection_Grant) Mutable(fd protoreflect.FieldDescriptor) protoref
 			x.Expiration = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		if x.GranterPubKey == nil {
+			x.GranterPubKey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.GranterPubKey.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -661,6 +683,9 @@ func (x *fastReflection_Grant) NewField(fd protoreflect.FieldDescriptor) protore
 	case "cosmos.authz.v1beta1.Grant.expiration":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.authz.v1beta1.Grant.granter_pub_key":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.Grant"))
@@ -738,6 +763,10 @@ func (x *fastReflection_Grant) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Expiration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GranterPubKey != nil {
+			l = options.Size(x.GranterPubKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -767,6 +796,20 @@ func (x *fastReflection_Grant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GranterPubKey != nil {
+			encoded, err := options.Marshal(x.GranterPubKey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Expiration != nil {
 			encoded, err := options.Marshal(x.Expiration)
 			if err != nil {
@@ -916,6 +959,42 @@ func (x *fastReflection_Grant) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GranterPubKey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GranterPubKey == nil {
+					x.GranterPubKey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GranterPubKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -952,11 +1031,12 @@ func (x *fastReflection_Grant) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GrantAuthorization               protoreflect.MessageDescriptor
-	fd_GrantAuthorization_granter       protoreflect.FieldDescriptor
-	fd_GrantAuthorization_grantee       protoreflect.FieldDescriptor
-	fd_GrantAuthorization_authorization protoreflect.FieldDescriptor
-	fd_GrantAuthorization_expiration    protoreflect.FieldDescriptor
+	md_GrantAuthorization                 protoreflect.MessageDescriptor
+	fd_GrantAuthorization_granter         protoreflect.FieldDescriptor
+	fd_GrantAuthorization_grantee         protoreflect.FieldDescriptor
+	fd_GrantAuthorization_authorization   protoreflect.FieldDescriptor
+	fd_GrantAuthorization_expiration      protoreflect.FieldDescriptor
+	fd_GrantAuthorization_granter_pub_key protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -966,6 +1046,7 @@ func init() {
 	fd_GrantAuthorization_grantee = md_GrantAuthorization.Fields().ByName("grantee")
 	fd_GrantAuthorization_authorization = md_GrantAuthorization.Fields().ByName("authorization")
 	fd_GrantAuthorization_expiration = md_GrantAuthorization.Fields().ByName("expiration")
+	fd_GrantAuthorization_granter_pub_key = md_GrantAuthorization.Fields().ByName("granter_pub_key")
 }
 
 var _ protoreflect.Message = (*fastReflection_GrantAuthorization)(nil)
@@ -1057,6 +1138,12 @@ func (x *fastReflection_GrantAuthorization) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.GranterPubKey != nil {
+		value := protoreflect.ValueOfMessage(x.GranterPubKey.ProtoReflect())
+		if !f(fd_GrantAuthorization_granter_pub_key, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1080,6 +1167,8 @@ func (x *fastReflection_GrantAuthorization) Has(fd protoreflect.FieldDescriptor)
 		return x.Authorization != nil
 	case "cosmos.authz.v1beta1.GrantAuthorization.expiration":
 		return x.Expiration != nil
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		return x.GranterPubKey != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.GrantAuthorization"))
@@ -1104,6 +1193,8 @@ func (x *fastReflection_GrantAuthorization) Clear(fd protoreflect.FieldDescripto
 		x.Authorization = nil
 	case "cosmos.authz.v1beta1.GrantAuthorization.expiration":
 		x.Expiration = nil
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		x.GranterPubKey = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.GrantAuthorization"))
@@ -1132,6 +1223,9 @@ func (x *fastReflection_GrantAuthorization) Get(descriptor protoreflect.FieldDes
 	case "cosmos.authz.v1beta1.GrantAuthorization.expiration":
 		value := x.Expiration
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		value := x.GranterPubKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.GrantAuthorization"))
@@ -1160,6 +1254,8 @@ func (x *fastReflection_GrantAuthorization) Set(fd protoreflect.FieldDescriptor,
 		x.Authorization = value.Message().Interface().(*anypb.Any)
 	case "cosmos.authz.v1beta1.GrantAuthorization.expiration":
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		x.GranterPubKey = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.GrantAuthorization"))
@@ -1190,6 +1286,11 @@ func (x *fastReflection_GrantAuthorization) Mutable(fd protoreflect.FieldDescrip
 			x.Expiration = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		if x.GranterPubKey == nil {
+			x.GranterPubKey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.GranterPubKey.ProtoReflect())
 	case "cosmos.authz.v1beta1.GrantAuthorization.granter":
 		panic(fmt.Errorf("field granter of message cosmos.authz.v1beta1.GrantAuthorization is not mutable"))
 	case "cosmos.authz.v1beta1.GrantAuthorization.grantee":
@@ -1217,6 +1318,9 @@ func (x *fastReflection_GrantAuthorization) NewField(fd protoreflect.FieldDescri
 	case "cosmos.authz.v1beta1.GrantAuthorization.expiration":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.GrantAuthorization"))
@@ -1302,6 +1406,10 @@ func (x *fastReflection_GrantAuthorization) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Expiration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GranterPubKey != nil {
+			l = options.Size(x.GranterPubKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1331,6 +1439,20 @@ func (x *fastReflection_GrantAuthorization) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GranterPubKey != nil {
+			encoded, err := options.Marshal(x.GranterPubKey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.Expiration != nil {
 			encoded, err := options.Marshal(x.Expiration)
 			if err != nil {
@@ -1558,6 +1680,42 @@ func (x *fastReflection_GrantAuthorization) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GranterPubKey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GranterPubKey == nil {
+					x.GranterPubKey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GranterPubKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2138,6 +2296,10 @@ type Grant struct {
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// granter_pub_key is the public key of the granter the grant is bound to, if
+	// any. A grant bound to the public key of the granter is revoked as soon as
+	// the granter's public key changes.
+	GranterPubKey *anypb.Any `protobuf:"bytes,3,opt,name=granter_pub_key,json=granterPubKey,proto3" json:"granter_pub_key,omitempty"`
 }
 
 func (x *Grant) Reset() {
@@ -2174,6 +2336,13 @@ func (x *Grant) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Grant) GetGranterPubKey() *anypb.Any {
+	if x != nil {
+		return x.GranterPubKey
+	}
+	return nil
+}
+
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
@@ -2185,6 +2354,9 @@ type GrantAuthorization struct {
 	Grantee       string                 `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *anypb.Any             `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// granter_pub_key is the public key of the granter the grant is bound to, if
+	// any.
+	GranterPubKey *anypb.Any `protobuf:"bytes,5,opt,name=granter_pub_key,json=granterPubKey,proto3" json:"granter_pub_key,omitempty"`
 }
 
 func (x *GrantAuthorization) Reset() {
@@ -2235,6 +2407,13 @@ func (x *GrantAuthorization) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GrantAuthorization) GetGranterPubKey() *anypb.Any {
+	if x != nil {
+		return x.GranterPubKey
+	}
+	return nil
+}
+
 // GrantQueueItem contains the list of TypeURL of a sdk.Msg.
 type GrantQueueItem struct {
 	state         protoimpl.MessageState
@@ -2293,8 +2472,8 @@ var file_cosmos_authz_v1beta1_authz_proto_rawDesc = []byte{
 	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74,
 	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69,
-	0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1,
-	0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x62, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89,
+	0x02, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x62, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x26, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
@@ -2305,25 +2484,36 @@ var file_cosmos_authz_v1beta1_authz_proto_rawDesc = []byte{
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
 	0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0xa2, 0x02, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a,
-	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x65, 0x12, 0x62, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x26,
-	0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0xfa, 0x02, 0x0a, 0x12, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x62, 0x0a, 0x0d, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x26, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x56, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18,
+	0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x6e, 0x74,
 	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x73, 0x67,
 	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
 	0x52, 0x0b, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x42, 0xd0, 0x01,
@@ -2367,13 +2557,15 @@ var file_cosmos_authz_v1beta1_authz_proto_goTypes = []interface{}{
 var file_cosmos_authz_v1beta1_authz_proto_depIdxs = []int32{
 	4, // 0: cosmos.authz.v1beta1.Grant.authorization:type_name -> google.protobuf.Any
 	5, // 1: cosmos.authz.v1beta1.Grant.expiration:type_name -> google.protobuf.Timestamp
-	4, // 2: cosmos.authz.v1beta1.GrantAuthorization.authorization:type_name -> google.protobuf.Any
-	5, // 3: cosmos.authz.v1beta1.GrantAuthorization.expiration:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 2: cosmos.authz.v1beta1.Grant.granter_pub_key:type_name -> google.protobuf.Any
+	4, // 3: cosmos.authz.v1beta1.GrantAuthorization.authorization:type_name -> google.protobuf.Any
+	5, // 4: cosmos.authz.v1beta1.GrantAuthorization.expiration:type_name -> google.protobuf.Timestamp
+	4, // 5: cosmos.authz.v1beta1.GrantAuthorization.granter_pub_key:type_name -> google.protobuf.Any
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_authz_v1beta1_authz_proto_init() }
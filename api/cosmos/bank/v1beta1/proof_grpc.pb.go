@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/bank/v1beta1/proof.proto
+
+package bankv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ProofService_BalanceProof_FullMethodName = "/cosmos.bank.v1beta1.ProofService/BalanceProof"
+)
+
+// ProofServiceClient is the client API for ProofService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProofServiceClient interface {
+	// BalanceProof queries the balance of a single coin for a single account
+	// together with the merkle proof of the balance against the app hash.
+	BalanceProof(ctx context.Context, in *QueryBalanceProofRequest, opts ...grpc.CallOption) (*QueryBalanceProofResponse, error)
+}
+
+type proofServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProofServiceClient(cc grpc.ClientConnInterface) ProofServiceClient {
+	return &proofServiceClient{cc}
+}
+
+func (c *proofServiceClient) BalanceProof(ctx context.Context, in *QueryBalanceProofRequest, opts ...grpc.CallOption) (*QueryBalanceProofResponse, error) {
+	out := new(QueryBalanceProofResponse)
+	err := c.cc.Invoke(ctx, ProofService_BalanceProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofServiceServer is the server API for ProofService service.
+// All implementations must embed UnimplementedProofServiceServer
+// for forward compatibility
+type ProofServiceServer interface {
+	// BalanceProof queries the balance of a single coin for a single account
+	// together with the merkle proof of the balance against the app hash.
+	BalanceProof(context.Context, *QueryBalanceProofRequest) (*QueryBalanceProofResponse, error)
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+// UnimplementedProofServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProofServiceServer struct {
+}
+
+func (UnimplementedProofServiceServer) BalanceProof(context.Context, *QueryBalanceProofRequest) (*QueryBalanceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceProof not implemented")
+}
+func (UnimplementedProofServiceServer) mustEmbedUnimplementedProofServiceServer() {}
+
+// UnsafeProofServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProofServiceServer will
+// result in compilation errors.
+type UnsafeProofServiceServer interface {
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+func RegisterProofServiceServer(s grpc.ServiceRegistrar, srv ProofServiceServer) {
+	s.RegisterService(&ProofService_ServiceDesc, srv)
+}
+
+func _ProofService_BalanceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).BalanceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProofService_BalanceProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).BalanceProof(ctx, req.(*QueryBalanceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProofService_ServiceDesc is the grpc.ServiceDesc for ProofService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProofService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.ProofService",
+	HandlerType: (*ProofServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BalanceProof",
+			Handler:    _ProofService_BalanceProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/bank/v1beta1/proof.proto",
+}
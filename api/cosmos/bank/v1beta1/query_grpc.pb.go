@@ -30,6 +30,7 @@ const (
 	Query_DenomsMetadata_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
 	Query_DenomOwners_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_SendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_BlockedAddresses_FullMethodName        = "/cosmos.bank.v1beta1.Query/BlockedAddresses"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +93,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BlockedAddresses queries for the BlockedAddress entries set by governance.
+	//
+	// This query only returns the addresses which were blocked by governance. The
+	// addresses blocked by the app, which cannot be unblocked, are resolved when
+	// looking up specific addresses.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
 }
 
 type queryClient struct {
@@ -201,6 +208,15 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -261,6 +277,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BlockedAddresses queries for the BlockedAddress entries set by governance.
+	//
+	// This query only returns the addresses which were blocked by governance. The
+	// addresses blocked by the app, which cannot be unblocked, are resolved when
+	// looking up specific addresses.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -301,6 +323,9 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -512,6 +537,24 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +606,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                   = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName              = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName         = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_AddBlockedAddresses_FullMethodName    = "/cosmos.bank.v1beta1.Msg/AddBlockedAddresses"
+	Msg_RemoveBlockedAddresses_FullMethodName = "/cosmos.bank.v1beta1.Msg/RemoveBlockedAddresses"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +47,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// AddBlockedAddresses is a governance operation for restricting addresses
+	// from receiving funds, in addition to the addresses blocked by the app.
+	AddBlockedAddresses(ctx context.Context, in *MsgAddBlockedAddresses, opts ...grpc.CallOption) (*MsgAddBlockedAddressesResponse, error)
+	// RemoveBlockedAddresses is a governance operation for lifting the
+	// restriction of addresses from receiving funds, whether they were blocked
+	// by governance or by the app.
+	RemoveBlockedAddresses(ctx context.Context, in *MsgRemoveBlockedAddresses, opts ...grpc.CallOption) (*MsgRemoveBlockedAddressesResponse, error)
 }
 
 type msgClient struct {
@@ -91,6 +100,24 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) AddBlockedAddresses(ctx context.Context, in *MsgAddBlockedAddresses, opts ...grpc.CallOption) (*MsgAddBlockedAddressesResponse, error) {
+	out := new(MsgAddBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_AddBlockedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBlockedAddresses(ctx context.Context, in *MsgRemoveBlockedAddresses, opts ...grpc.CallOption) (*MsgRemoveBlockedAddressesResponse, error) {
+	out := new(MsgRemoveBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveBlockedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +138,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// AddBlockedAddresses is a governance operation for restricting addresses
+	// from receiving funds, in addition to the addresses blocked by the app.
+	AddBlockedAddresses(context.Context, *MsgAddBlockedAddresses) (*MsgAddBlockedAddressesResponse, error)
+	// RemoveBlockedAddresses is a governance operation for lifting the
+	// restriction of addresses from receiving funds, whether they were blocked
+	// by governance or by the app.
+	RemoveBlockedAddresses(context.Context, *MsgRemoveBlockedAddresses) (*MsgRemoveBlockedAddressesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -130,6 +164,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) AddBlockedAddresses(context.Context, *MsgAddBlockedAddresses) (*MsgAddBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedAddresses not implemented")
+}
+func (UnimplementedMsgServer) RemoveBlockedAddresses(context.Context, *MsgRemoveBlockedAddresses) (*MsgRemoveBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedAddresses not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +255,42 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddBlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlockedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddBlockedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlockedAddresses(ctx, req.(*MsgAddBlockedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlockedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveBlockedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlockedAddresses(ctx, req.(*MsgRemoveBlockedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +314,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "AddBlockedAddresses",
+			Handler:    _Msg_AddBlockedAddresses_Handler,
+		},
+		{
+			MethodName: "RemoveBlockedAddresses",
+			Handler:    _Msg_RemoveBlockedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
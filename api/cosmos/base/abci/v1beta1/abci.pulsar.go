@@ -2952,9 +2952,10 @@ func (x *fastReflection_Attribute) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GasInfo            protoreflect.MessageDescriptor
-	fd_GasInfo_gas_wanted protoreflect.FieldDescriptor
-	fd_GasInfo_gas_used   protoreflect.FieldDescriptor
+	md_GasInfo               protoreflect.MessageDescriptor
+	fd_GasInfo_gas_wanted    protoreflect.FieldDescriptor
+	fd_GasInfo_gas_used      protoreflect.FieldDescriptor
+	fd_GasInfo_gas_breakdown protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2962,6 +2963,7 @@ func init() {
 	md_GasInfo = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("GasInfo")
 	fd_GasInfo_gas_wanted = md_GasInfo.Fields().ByName("gas_wanted")
 	fd_GasInfo_gas_used = md_GasInfo.Fields().ByName("gas_used")
+	fd_GasInfo_gas_breakdown = md_GasInfo.Fields().ByName("gas_breakdown")
 }
 
 var _ protoreflect.Message = (*fastReflection_GasInfo)(nil)
@@ -3041,6 +3043,12 @@ func (x *fastReflection_GasInfo) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.GasBreakdown != nil {
+		value := protoreflect.ValueOfMessage(x.GasBreakdown.ProtoReflect())
+		if !f(fd_GasInfo_gas_breakdown, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3060,6 +3068,8 @@ func (x *fastReflection_GasInfo) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.GasWanted != uint64(0)
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
 		return x.GasUsed != uint64(0)
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		return x.GasBreakdown != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasInfo"))
@@ -3080,6 +3090,8 @@ func (x *fastReflection_GasInfo) Clear(fd protoreflect.FieldDescriptor) {
 		x.GasWanted = uint64(0)
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
 		x.GasUsed = uint64(0)
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		x.GasBreakdown = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasInfo"))
@@ -3102,6 +3114,9 @@ func (x *fastReflection_GasInfo) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
 		value := x.GasUsed
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		value := x.GasBreakdown
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasInfo"))
@@ -3126,6 +3141,8 @@ func (x *fastReflection_GasInfo) Set(fd protoreflect.FieldDescriptor, value prot
 		x.GasWanted = value.Uint()
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
 		x.GasUsed = value.Uint()
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		x.GasBreakdown = value.Message().Interface().(*GasBreakdown)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasInfo"))
@@ -3146,6 +3163,11 @@ func (x *fastReflection_GasInfo) Set(fd protoreflect.FieldDescriptor, value prot
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_GasInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		if x.GasBreakdown == nil {
+			x.GasBreakdown = new(GasBreakdown)
+		}
+		return protoreflect.ValueOfMessage(x.GasBreakdown.ProtoReflect())
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_wanted":
 		panic(fmt.Errorf("field gas_wanted of message cosmos.base.abci.v1beta1.GasInfo is not mutable"))
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
@@ -3167,6 +3189,9 @@ func (x *fastReflection_GasInfo) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.base.abci.v1beta1.GasInfo.gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.GasInfo.gas_breakdown":
+		m := new(GasBreakdown)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasInfo"))
@@ -3242,6 +3267,10 @@ func (x *fastReflection_GasInfo) ProtoMethods() *protoiface.Methods {
 		if x.GasUsed != 0 {
 			n += 1 + runtime.Sov(uint64(x.GasUsed))
 		}
+		if x.GasBreakdown != nil {
+			l = options.Size(x.GasBreakdown)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3271,6 +3300,20 @@ func (x *fastReflection_GasInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GasBreakdown != nil {
+			encoded, err := options.Marshal(x.GasBreakdown)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.GasUsed != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
@@ -3368,6 +3411,42 @@ func (x *fastReflection_GasInfo) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasBreakdown", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasBreakdown == nil {
+					x.GasBreakdown = &GasBreakdown{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasBreakdown); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3403,134 +3482,130 @@ func (x *fastReflection_GasInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Result_3_list)(nil)
+var _ protoreflect.List = (*_GasBreakdown_1_list)(nil)
 
-type _Result_3_list struct {
-	list *[]*abci.Event
+type _GasBreakdown_1_list struct {
+	list *[]*GasConsumption
 }
 
-func (x *_Result_3_list) Len() int {
+func (x *_GasBreakdown_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Result_3_list) Get(i int) protoreflect.Value {
+func (x *_GasBreakdown_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Result_3_list) Set(i int, value protoreflect.Value) {
+func (x *_GasBreakdown_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	concreteValue := valueUnwrapped.Interface().(*GasConsumption)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Result_3_list) Append(value protoreflect.Value) {
+func (x *_GasBreakdown_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	concreteValue := valueUnwrapped.Interface().(*GasConsumption)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Result_3_list) AppendMutable() protoreflect.Value {
-	v := new(abci.Event)
+func (x *_GasBreakdown_1_list) AppendMutable() protoreflect.Value {
+	v := new(GasConsumption)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Result_3_list) Truncate(n int) {
+func (x *_GasBreakdown_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Result_3_list) NewElement() protoreflect.Value {
-	v := new(abci.Event)
+func (x *_GasBreakdown_1_list) NewElement() protoreflect.Value {
+	v := new(GasConsumption)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Result_3_list) IsValid() bool {
+func (x *_GasBreakdown_1_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_Result_4_list)(nil)
+var _ protoreflect.List = (*_GasBreakdown_2_list)(nil)
 
-type _Result_4_list struct {
-	list *[]*anypb.Any
+type _GasBreakdown_2_list struct {
+	list *[]*GasConsumption
 }
 
-func (x *_Result_4_list) Len() int {
+func (x *_GasBreakdown_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Result_4_list) Get(i int) protoreflect.Value {
+func (x *_GasBreakdown_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Result_4_list) Set(i int, value protoreflect.Value) {
+func (x *_GasBreakdown_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*GasConsumption)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Result_4_list) Append(value protoreflect.Value) {
+func (x *_GasBreakdown_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*GasConsumption)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Result_4_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_GasBreakdown_2_list) AppendMutable() protoreflect.Value {
+	v := new(GasConsumption)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Result_4_list) Truncate(n int) {
+func (x *_GasBreakdown_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Result_4_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_GasBreakdown_2_list) NewElement() protoreflect.Value {
+	v := new(GasConsumption)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Result_4_list) IsValid() bool {
+func (x *_GasBreakdown_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Result               protoreflect.MessageDescriptor
-	fd_Result_data          protoreflect.FieldDescriptor
-	fd_Result_log           protoreflect.FieldDescriptor
-	fd_Result_events        protoreflect.FieldDescriptor
-	fd_Result_msg_responses protoreflect.FieldDescriptor
+	md_GasBreakdown                 protoreflect.MessageDescriptor
+	fd_GasBreakdown_ante_decorators protoreflect.FieldDescriptor
+	fd_GasBreakdown_msgs            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_Result = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("Result")
-	fd_Result_data = md_Result.Fields().ByName("data")
-	fd_Result_log = md_Result.Fields().ByName("log")
-	fd_Result_events = md_Result.Fields().ByName("events")
-	fd_Result_msg_responses = md_Result.Fields().ByName("msg_responses")
+	md_GasBreakdown = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("GasBreakdown")
+	fd_GasBreakdown_ante_decorators = md_GasBreakdown.Fields().ByName("ante_decorators")
+	fd_GasBreakdown_msgs = md_GasBreakdown.Fields().ByName("msgs")
 }
 
-var _ protoreflect.Message = (*fastReflection_Result)(nil)
+var _ protoreflect.Message = (*fastReflection_GasBreakdown)(nil)
 
-type fastReflection_Result Result
+type fastReflection_GasBreakdown GasBreakdown
 
-func (x *Result) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Result)(x)
+func (x *GasBreakdown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasBreakdown)(x)
 }
 
-func (x *Result) slowProtoReflect() protoreflect.Message {
+func (x *GasBreakdown) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3542,43 +3617,43 @@ func (x *Result) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Result_messageType fastReflection_Result_messageType
-var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+var _fastReflection_GasBreakdown_messageType fastReflection_GasBreakdown_messageType
+var _ protoreflect.MessageType = fastReflection_GasBreakdown_messageType{}
 
-type fastReflection_Result_messageType struct{}
+type fastReflection_GasBreakdown_messageType struct{}
 
-func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Result)(nil)
+func (x fastReflection_GasBreakdown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasBreakdown)(nil)
 }
-func (x fastReflection_Result_messageType) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x fastReflection_GasBreakdown_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasBreakdown)
 }
-func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x fastReflection_GasBreakdown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasBreakdown
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x *fastReflection_GasBreakdown) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasBreakdown
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Result) Type() protoreflect.MessageType {
-	return _fastReflection_Result_messageType
+func (x *fastReflection_GasBreakdown) Type() protoreflect.MessageType {
+	return _fastReflection_GasBreakdown_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Result) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x *fastReflection_GasBreakdown) New() protoreflect.Message {
+	return new(fastReflection_GasBreakdown)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
-	return (*Result)(x)
+func (x *fastReflection_GasBreakdown) Interface() protoreflect.ProtoMessage {
+	return (*GasBreakdown)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3586,28 +3661,16 @@ func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_Result_data, value) {
-			return
-		}
-	}
-	if x.Log != "" {
-		value := protoreflect.ValueOfString(x.Log)
-		if !f(fd_Result_log, value) {
-			return
-		}
-	}
-	if len(x.Events) != 0 {
-		value := protoreflect.ValueOfList(&_Result_3_list{list: &x.Events})
-		if !f(fd_Result_events, value) {
+func (x *fastReflection_GasBreakdown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AnteDecorators) != 0 {
+		value := protoreflect.ValueOfList(&_GasBreakdown_1_list{list: &x.AnteDecorators})
+		if !f(fd_GasBreakdown_ante_decorators, value) {
 			return
 		}
 	}
-	if len(x.MsgResponses) != 0 {
-		value := protoreflect.ValueOfList(&_Result_4_list{list: &x.MsgResponses})
-		if !f(fd_Result_msg_responses, value) {
+	if len(x.Msgs) != 0 {
+		value := protoreflect.ValueOfList(&_GasBreakdown_2_list{list: &x.Msgs})
+		if !f(fd_GasBreakdown_msgs, value) {
 			return
 		}
 	}
@@ -3624,21 +3687,17 @@ func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasBreakdown) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.data":
-		return len(x.Data) != 0
-	case "cosmos.base.abci.v1beta1.Result.log":
-		return x.Log != ""
-	case "cosmos.base.abci.v1beta1.Result.events":
-		return len(x.Events) != 0
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
-		return len(x.MsgResponses) != 0
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
+		return len(x.AnteDecorators) != 0
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
+		return len(x.Msgs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3648,21 +3707,17 @@ func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GasBreakdown) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.data":
-		x.Data = nil
-	case "cosmos.base.abci.v1beta1.Result.log":
-		x.Log = ""
-	case "cosmos.base.abci.v1beta1.Result.events":
-		x.Events = nil
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
-		x.MsgResponses = nil
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
+		x.AnteDecorators = nil
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
+		x.Msgs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3672,31 +3727,25 @@ func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasBreakdown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.base.abci.v1beta1.Result.log":
-		value := x.Log
-		return protoreflect.ValueOfString(value)
-	case "cosmos.base.abci.v1beta1.Result.events":
-		if len(x.Events) == 0 {
-			return protoreflect.ValueOfList(&_Result_3_list{})
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
+		if len(x.AnteDecorators) == 0 {
+			return protoreflect.ValueOfList(&_GasBreakdown_1_list{})
 		}
-		listValue := &_Result_3_list{list: &x.Events}
+		listValue := &_GasBreakdown_1_list{list: &x.AnteDecorators}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
-		if len(x.MsgResponses) == 0 {
-			return protoreflect.ValueOfList(&_Result_4_list{})
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
+		if len(x.Msgs) == 0 {
+			return protoreflect.ValueOfList(&_GasBreakdown_2_list{})
 		}
-		listValue := &_Result_4_list{list: &x.MsgResponses}
+		listValue := &_GasBreakdown_2_list{list: &x.Msgs}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3710,25 +3759,21 @@ func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasBreakdown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.data":
-		x.Data = value.Bytes()
-	case "cosmos.base.abci.v1beta1.Result.log":
-		x.Log = value.Interface().(string)
-	case "cosmos.base.abci.v1beta1.Result.events":
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
 		lv := value.List()
-		clv := lv.(*_Result_3_list)
-		x.Events = *clv.list
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		clv := lv.(*_GasBreakdown_1_list)
+		x.AnteDecorators = *clv.list
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
 		lv := value.List()
-		clv := lv.(*_Result_4_list)
-		x.MsgResponses = *clv.list
+		clv := lv.(*_GasBreakdown_2_list)
+		x.Msgs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3742,62 +3787,54 @@ func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasBreakdown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.events":
-		if x.Events == nil {
-			x.Events = []*abci.Event{}
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
+		if x.AnteDecorators == nil {
+			x.AnteDecorators = []*GasConsumption{}
 		}
-		value := &_Result_3_list{list: &x.Events}
+		value := &_GasBreakdown_1_list{list: &x.AnteDecorators}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
-		if x.MsgResponses == nil {
-			x.MsgResponses = []*anypb.Any{}
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
+		if x.Msgs == nil {
+			x.Msgs = []*GasConsumption{}
 		}
-		value := &_Result_4_list{list: &x.MsgResponses}
+		value := &_GasBreakdown_2_list{list: &x.Msgs}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.base.abci.v1beta1.Result.data":
-		panic(fmt.Errorf("field data of message cosmos.base.abci.v1beta1.Result is not mutable"))
-	case "cosmos.base.abci.v1beta1.Result.log":
-		panic(fmt.Errorf("field log of message cosmos.base.abci.v1beta1.Result is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasBreakdown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.Result.data":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.base.abci.v1beta1.Result.log":
-		return protoreflect.ValueOfString("")
-	case "cosmos.base.abci.v1beta1.Result.events":
-		list := []*abci.Event{}
-		return protoreflect.ValueOfList(&_Result_3_list{list: &list})
-	case "cosmos.base.abci.v1beta1.Result.msg_responses":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_Result_4_list{list: &list})
+	case "cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators":
+		list := []*GasConsumption{}
+		return protoreflect.ValueOfList(&_GasBreakdown_1_list{list: &list})
+	case "cosmos.base.abci.v1beta1.GasBreakdown.msgs":
+		list := []*GasConsumption{}
+		return protoreflect.ValueOfList(&_GasBreakdown_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasBreakdown"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasBreakdown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.Result", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.GasBreakdown", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3805,7 +3842,7 @@ func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasBreakdown) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3816,7 +3853,7 @@ func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasBreakdown) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3828,7 +3865,7 @@ func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Result) IsValid() bool {
+func (x *fastReflection_GasBreakdown) IsValid() bool {
 	return x != nil
 }
 
@@ -3838,9 +3875,9 @@ func (x *fastReflection_Result) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasBreakdown) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*GasBreakdown)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3852,22 +3889,14 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Log)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Events) > 0 {
-			for _, e := range x.Events {
+		if len(x.AnteDecorators) > 0 {
+			for _, e := range x.AnteDecorators {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.MsgResponses) > 0 {
-			for _, e := range x.MsgResponses {
+		if len(x.Msgs) > 0 {
+			for _, e := range x.Msgs {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3882,7 +3911,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*GasBreakdown)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3901,9 +3930,9 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MsgResponses) > 0 {
-			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
+		if len(x.Msgs) > 0 {
+			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Msgs[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3914,12 +3943,12 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Events) > 0 {
-			for iNdEx := len(x.Events) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Events[iNdEx])
+		if len(x.AnteDecorators) > 0 {
+			for iNdEx := len(x.AnteDecorators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AnteDecorators[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3930,27 +3959,13 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Log) > 0 {
-			i -= len(x.Log)
-			copy(dAtA[i:], x.Log)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Log)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3958,7 +3973,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*GasBreakdown)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3990,81 +4005,15 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasBreakdown: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Log = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnteDecorators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4091,14 +4040,14 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Events = append(x.Events, &abci.Event{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Events[len(x.Events)-1]); err != nil {
+				x.AnteDecorators = append(x.AnteDecorators, &GasConsumption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AnteDecorators[len(x.AnteDecorators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4125,8 +4074,8 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
+				x.Msgs = append(x.Msgs, &GasConsumption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msgs[len(x.Msgs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4166,27 +4115,27 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SimulationResponse          protoreflect.MessageDescriptor
-	fd_SimulationResponse_gas_info protoreflect.FieldDescriptor
-	fd_SimulationResponse_result   protoreflect.FieldDescriptor
+	md_GasConsumption          protoreflect.MessageDescriptor
+	fd_GasConsumption_name     protoreflect.FieldDescriptor
+	fd_GasConsumption_gas_used protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_SimulationResponse = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SimulationResponse")
-	fd_SimulationResponse_gas_info = md_SimulationResponse.Fields().ByName("gas_info")
-	fd_SimulationResponse_result = md_SimulationResponse.Fields().ByName("result")
+	md_GasConsumption = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("GasConsumption")
+	fd_GasConsumption_name = md_GasConsumption.Fields().ByName("name")
+	fd_GasConsumption_gas_used = md_GasConsumption.Fields().ByName("gas_used")
 }
 
-var _ protoreflect.Message = (*fastReflection_SimulationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GasConsumption)(nil)
 
-type fastReflection_SimulationResponse SimulationResponse
+type fastReflection_GasConsumption GasConsumption
 
-func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SimulationResponse)(x)
+func (x *GasConsumption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasConsumption)(x)
 }
 
-func (x *SimulationResponse) slowProtoReflect() protoreflect.Message {
+func (x *GasConsumption) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4198,43 +4147,43 @@ func (x *SimulationResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SimulationResponse_messageType fastReflection_SimulationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_SimulationResponse_messageType{}
+var _fastReflection_GasConsumption_messageType fastReflection_GasConsumption_messageType
+var _ protoreflect.MessageType = fastReflection_GasConsumption_messageType{}
 
-type fastReflection_SimulationResponse_messageType struct{}
+type fastReflection_GasConsumption_messageType struct{}
 
-func (x fastReflection_SimulationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SimulationResponse)(nil)
+func (x fastReflection_GasConsumption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasConsumption)(nil)
 }
-func (x fastReflection_SimulationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_SimulationResponse)
+func (x fastReflection_GasConsumption_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasConsumption)
 }
-func (x fastReflection_SimulationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulationResponse
+func (x fastReflection_GasConsumption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasConsumption
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SimulationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulationResponse
+func (x *fastReflection_GasConsumption) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasConsumption
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SimulationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_SimulationResponse_messageType
+func (x *fastReflection_GasConsumption) Type() protoreflect.MessageType {
+	return _fastReflection_GasConsumption_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SimulationResponse) New() protoreflect.Message {
-	return new(fastReflection_SimulationResponse)
+func (x *fastReflection_GasConsumption) New() protoreflect.Message {
+	return new(fastReflection_GasConsumption)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SimulationResponse) Interface() protoreflect.ProtoMessage {
-	return (*SimulationResponse)(x)
+func (x *fastReflection_GasConsumption) Interface() protoreflect.ProtoMessage {
+	return (*GasConsumption)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4242,16 +4191,16 @@ func (x *fastReflection_SimulationResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SimulationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GasInfo != nil {
-		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-		if !f(fd_SimulationResponse_gas_info, value) {
+func (x *fastReflection_GasConsumption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_GasConsumption_name, value) {
 			return
 		}
 	}
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_SimulationResponse_result, value) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_GasConsumption_gas_used, value) {
 			return
 		}
 	}
@@ -4268,17 +4217,17 @@ func (x *fastReflection_SimulationResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SimulationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasConsumption) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		return x.GasInfo != nil
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		return x.Result != nil
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		return x.Name != ""
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		return x.GasUsed != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4288,17 +4237,17 @@ func (x *fastReflection_SimulationResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GasConsumption) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		x.GasInfo = nil
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		x.Result = nil
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		x.Name = ""
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		x.GasUsed = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4308,19 +4257,19 @@ func (x *fastReflection_SimulationResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SimulationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasConsumption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		value := x.GasInfo
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		value := x.Result
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4334,17 +4283,17 @@ func (x *fastReflection_SimulationResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasConsumption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		x.GasInfo = value.Message().Interface().(*GasInfo)
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		x.Result = value.Message().Interface().(*Result)
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		x.GasUsed = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4358,52 +4307,44 @@ func (x *fastReflection_SimulationResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasConsumption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		if x.GasInfo == nil {
-			x.GasInfo = new(GasInfo)
-		}
-		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		if x.Result == nil {
-			x.Result = new(Result)
-		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		panic(fmt.Errorf("field name of message cosmos.base.abci.v1beta1.GasConsumption is not mutable"))
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		panic(fmt.Errorf("field gas_used of message cosmos.base.abci.v1beta1.GasConsumption is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SimulationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasConsumption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
-		m := new(GasInfo)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
-		m := new(Result)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.GasConsumption.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.abci.v1beta1.GasConsumption.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.GasConsumption"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.GasConsumption does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SimulationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasConsumption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SimulationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.GasConsumption", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4411,7 +4352,7 @@ func (x *fastReflection_SimulationResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SimulationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasConsumption) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4422,7 +4363,7 @@ func (x *fastReflection_SimulationResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasConsumption) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4434,7 +4375,7 @@ func (x *fastReflection_SimulationResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SimulationResponse) IsValid() bool {
+func (x *fastReflection_GasConsumption) IsValid() bool {
 	return x != nil
 }
 
@@ -4444,9 +4385,9 @@ func (x *fastReflection_SimulationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasConsumption) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SimulationResponse)
+		x := input.Message.Interface().(*GasConsumption)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4458,13 +4399,12 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.GasInfo != nil {
-			l = options.Size(x.GasInfo)
+		l = len(x.Name)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Result != nil {
-			l = options.Size(x.Result)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4476,7 +4416,7 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SimulationResponse)
+		x := input.Message.Interface().(*GasConsumption)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4495,31 +4435,15 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.GasInfo != nil {
-			encoded, err := options.Marshal(x.GasInfo)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4534,7 +4458,7 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SimulationResponse)
+		x := input.Message.Interface().(*GasConsumption)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4566,17 +4490,17 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasConsumption: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4586,33 +4510,29 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.GasInfo == nil {
-					x.GasInfo = &GasInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 				}
-				var msglen int
+				x.GasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4622,25 +4542,2402 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.GasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Result_3_list)(nil)
+
+type _Result_3_list struct {
+	list *[]*abci.Event
+}
+
+func (x *_Result_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Result_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Result_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Result_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Result_3_list) AppendMutable() protoreflect.Value {
+	v := new(abci.Event)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Result_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Result_3_list) NewElement() protoreflect.Value {
+	v := new(abci.Event)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Result_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Result_4_list)(nil)
+
+type _Result_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Result_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Result_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Result_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Result_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Result_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Result_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Result_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Result_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Result               protoreflect.MessageDescriptor
+	fd_Result_data          protoreflect.FieldDescriptor
+	fd_Result_log           protoreflect.FieldDescriptor
+	fd_Result_events        protoreflect.FieldDescriptor
+	fd_Result_msg_responses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_abci_v1beta1_abci_proto_init()
+	md_Result = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("Result")
+	fd_Result_data = md_Result.Fields().ByName("data")
+	fd_Result_log = md_Result.Fields().ByName("log")
+	fd_Result_events = md_Result.Fields().ByName("events")
+	fd_Result_msg_responses = md_Result.Fields().ByName("msg_responses")
+}
+
+var _ protoreflect.Message = (*fastReflection_Result)(nil)
+
+type fastReflection_Result Result
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Result)(x)
+}
+
+func (x *Result) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Result_messageType fastReflection_Result_messageType
+var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+
+type fastReflection_Result_messageType struct{}
+
+func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Result)(nil)
+}
+func (x fastReflection_Result_messageType) New() protoreflect.Message {
+	return new(fastReflection_Result)
+}
+func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Result) Type() protoreflect.MessageType {
+	return _fastReflection_Result_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Result) New() protoreflect.Message {
+	return new(fastReflection_Result)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
+	return (*Result)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_Result_data, value) {
+			return
+		}
+	}
+	if x.Log != "" {
+		value := protoreflect.ValueOfString(x.Log)
+		if !f(fd_Result_log, value) {
+			return
+		}
+	}
+	if len(x.Events) != 0 {
+		value := protoreflect.ValueOfList(&_Result_3_list{list: &x.Events})
+		if !f(fd_Result_events, value) {
+			return
+		}
+	}
+	if len(x.MsgResponses) != 0 {
+		value := protoreflect.ValueOfList(&_Result_4_list{list: &x.MsgResponses})
+		if !f(fd_Result_msg_responses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.data":
+		return len(x.Data) != 0
+	case "cosmos.base.abci.v1beta1.Result.log":
+		return x.Log != ""
+	case "cosmos.base.abci.v1beta1.Result.events":
+		return len(x.Events) != 0
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		return len(x.MsgResponses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.data":
+		x.Data = nil
+	case "cosmos.base.abci.v1beta1.Result.log":
+		x.Log = ""
+	case "cosmos.base.abci.v1beta1.Result.events":
+		x.Events = nil
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		x.MsgResponses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.abci.v1beta1.Result.log":
+		value := x.Log
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.abci.v1beta1.Result.events":
+		if len(x.Events) == 0 {
+			return protoreflect.ValueOfList(&_Result_3_list{})
+		}
+		listValue := &_Result_3_list{list: &x.Events}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		if len(x.MsgResponses) == 0 {
+			return protoreflect.ValueOfList(&_Result_4_list{})
+		}
+		listValue := &_Result_4_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.data":
+		x.Data = value.Bytes()
+	case "cosmos.base.abci.v1beta1.Result.log":
+		x.Log = value.Interface().(string)
+	case "cosmos.base.abci.v1beta1.Result.events":
+		lv := value.List()
+		clv := lv.(*_Result_3_list)
+		x.Events = *clv.list
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		lv := value.List()
+		clv := lv.(*_Result_4_list)
+		x.MsgResponses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.events":
+		if x.Events == nil {
+			x.Events = []*abci.Event{}
+		}
+		value := &_Result_3_list{list: &x.Events}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		if x.MsgResponses == nil {
+			x.MsgResponses = []*anypb.Any{}
+		}
+		value := &_Result_4_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.abci.v1beta1.Result.data":
+		panic(fmt.Errorf("field data of message cosmos.base.abci.v1beta1.Result is not mutable"))
+	case "cosmos.base.abci.v1beta1.Result.log":
+		panic(fmt.Errorf("field log of message cosmos.base.abci.v1beta1.Result is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.Result.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.abci.v1beta1.Result.log":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.abci.v1beta1.Result.events":
+		list := []*abci.Event{}
+		return protoreflect.ValueOfList(&_Result_3_list{list: &list})
+	case "cosmos.base.abci.v1beta1.Result.msg_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Result_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.Result"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.Result", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Result) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Log)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Events) > 0 {
+			for _, e := range x.Events {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgResponses) > 0 {
+			for _, e := range x.MsgResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgResponses) > 0 {
+			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Events) > 0 {
+			for iNdEx := len(x.Events) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Events[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Log) > 0 {
+			i -= len(x.Log)
+			copy(dAtA[i:], x.Log)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Log)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Log = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Events = append(x.Events, &abci.Event{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Events[len(x.Events)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SimulationResponse          protoreflect.MessageDescriptor
+	fd_SimulationResponse_gas_info protoreflect.FieldDescriptor
+	fd_SimulationResponse_result   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_abci_v1beta1_abci_proto_init()
+	md_SimulationResponse = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SimulationResponse")
+	fd_SimulationResponse_gas_info = md_SimulationResponse.Fields().ByName("gas_info")
+	fd_SimulationResponse_result = md_SimulationResponse.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_SimulationResponse)(nil)
+
+type fastReflection_SimulationResponse SimulationResponse
+
+func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulationResponse)(x)
+}
+
+func (x *SimulationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SimulationResponse_messageType fastReflection_SimulationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_SimulationResponse_messageType{}
+
+type fastReflection_SimulationResponse_messageType struct{}
+
+func (x fastReflection_SimulationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulationResponse)(nil)
+}
+func (x fastReflection_SimulationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulationResponse)
+}
+func (x fastReflection_SimulationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SimulationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SimulationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_SimulationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SimulationResponse) New() protoreflect.Message {
+	return new(fastReflection_SimulationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SimulationResponse) Interface() protoreflect.ProtoMessage {
+	return (*SimulationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SimulationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasInfo != nil {
+		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+		if !f(fd_SimulationResponse_gas_info, value) {
+			return
+		}
+	}
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_SimulationResponse_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SimulationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		return x.GasInfo != nil
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		return x.Result != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		x.GasInfo = nil
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SimulationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		value := x.GasInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		x.GasInfo = value.Message().Interface().(*GasInfo)
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		x.Result = value.Message().Interface().(*Result)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		if x.GasInfo == nil {
+			x.GasInfo = new(GasInfo)
+		}
+		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		if x.Result == nil {
+			x.Result = new(Result)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SimulationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.SimulationResponse.gas_info":
+		m := new(GasInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.base.abci.v1beta1.SimulationResponse.result":
+		m := new(Result)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SimulationResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SimulationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SimulationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SimulationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SimulationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SimulationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SimulationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GasInfo != nil {
+			l = options.Size(x.GasInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SimulationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.GasInfo != nil {
+			encoded, err := options.Marshal(x.GasInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SimulationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasInfo == nil {
+					x.GasInfo = &GasInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &Result{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgData          protoreflect.MessageDescriptor
+	fd_MsgData_msg_type protoreflect.FieldDescriptor
+	fd_MsgData_data     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_abci_v1beta1_abci_proto_init()
+	md_MsgData = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("MsgData")
+	fd_MsgData_msg_type = md_MsgData.Fields().ByName("msg_type")
+	fd_MsgData_data = md_MsgData.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgData)(nil)
+
+type fastReflection_MsgData MsgData
+
+func (x *MsgData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgData)(x)
+}
+
+func (x *MsgData) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgData_messageType fastReflection_MsgData_messageType
+var _ protoreflect.MessageType = fastReflection_MsgData_messageType{}
+
+type fastReflection_MsgData_messageType struct{}
+
+func (x fastReflection_MsgData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgData)(nil)
+}
+func (x fastReflection_MsgData_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgData)
+}
+func (x fastReflection_MsgData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgData) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgData) Type() protoreflect.MessageType {
+	return _fastReflection_MsgData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgData) New() protoreflect.Message {
+	return new(fastReflection_MsgData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgData) Interface() protoreflect.ProtoMessage {
+	return (*MsgData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgType != "" {
+		value := protoreflect.ValueOfString(x.MsgType)
+		if !f(fd_MsgData_msg_type, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_MsgData_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		return x.MsgType != ""
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		x.MsgType = ""
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		value := x.MsgType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		x.MsgType = value.Interface().(string)
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		panic(fmt.Errorf("field msg_type of message cosmos.base.abci.v1beta1.MsgData is not mutable"))
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		panic(fmt.Errorf("field data of message cosmos.base.abci.v1beta1.MsgData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.abci.v1beta1.MsgData.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.MsgData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgType) > 0 {
+			i -= len(x.MsgType)
+			copy(dAtA[i:], x.MsgType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgType)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TxMsgData_1_list)(nil)
+
+type _TxMsgData_1_list struct {
+	list *[]*MsgData
+}
+
+func (x *_TxMsgData_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TxMsgData_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TxMsgData_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgData)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TxMsgData_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgData)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TxMsgData_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgData)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TxMsgData_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TxMsgData_1_list) NewElement() protoreflect.Value {
+	v := new(MsgData)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TxMsgData_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TxMsgData_2_list)(nil)
+
+type _TxMsgData_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_TxMsgData_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TxMsgData_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TxMsgData_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TxMsgData_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TxMsgData_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TxMsgData_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TxMsgData_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TxMsgData_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TxMsgData               protoreflect.MessageDescriptor
+	fd_TxMsgData_data          protoreflect.FieldDescriptor
+	fd_TxMsgData_msg_responses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_abci_v1beta1_abci_proto_init()
+	md_TxMsgData = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("TxMsgData")
+	fd_TxMsgData_data = md_TxMsgData.Fields().ByName("data")
+	fd_TxMsgData_msg_responses = md_TxMsgData.Fields().ByName("msg_responses")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxMsgData)(nil)
+
+type fastReflection_TxMsgData TxMsgData
+
+func (x *TxMsgData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxMsgData)(x)
+}
+
+func (x *TxMsgData) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxMsgData_messageType fastReflection_TxMsgData_messageType
+var _ protoreflect.MessageType = fastReflection_TxMsgData_messageType{}
+
+type fastReflection_TxMsgData_messageType struct{}
+
+func (x fastReflection_TxMsgData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxMsgData)(nil)
+}
+func (x fastReflection_TxMsgData_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxMsgData)
+}
+func (x fastReflection_TxMsgData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxMsgData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxMsgData) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxMsgData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxMsgData) Type() protoreflect.MessageType {
+	return _fastReflection_TxMsgData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxMsgData) New() protoreflect.Message {
+	return new(fastReflection_TxMsgData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxMsgData) Interface() protoreflect.ProtoMessage {
+	return (*TxMsgData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxMsgData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfList(&_TxMsgData_1_list{list: &x.Data})
+		if !f(fd_TxMsgData_data, value) {
+			return
+		}
+	}
+	if len(x.MsgResponses) != 0 {
+		value := protoreflect.ValueOfList(&_TxMsgData_2_list{list: &x.MsgResponses})
+		if !f(fd_TxMsgData_msg_responses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxMsgData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		return len(x.Data) != 0
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		return len(x.MsgResponses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxMsgData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		x.Data = nil
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		x.MsgResponses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxMsgData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		if len(x.Data) == 0 {
+			return protoreflect.ValueOfList(&_TxMsgData_1_list{})
+		}
+		listValue := &_TxMsgData_1_list{list: &x.Data}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		if len(x.MsgResponses) == 0 {
+			return protoreflect.ValueOfList(&_TxMsgData_2_list{})
+		}
+		listValue := &_TxMsgData_2_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxMsgData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		lv := value.List()
+		clv := lv.(*_TxMsgData_1_list)
+		x.Data = *clv.list
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		lv := value.List()
+		clv := lv.(*_TxMsgData_2_list)
+		x.MsgResponses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxMsgData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		if x.Data == nil {
+			x.Data = []*MsgData{}
+		}
+		value := &_TxMsgData_1_list{list: &x.Data}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		if x.MsgResponses == nil {
+			x.MsgResponses = []*anypb.Any{}
+		}
+		value := &_TxMsgData_2_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxMsgData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.abci.v1beta1.TxMsgData.data":
+		list := []*MsgData{}
+		return protoreflect.ValueOfList(&_TxMsgData_1_list{list: &list})
+	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_TxMsgData_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+		}
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxMsgData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.TxMsgData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxMsgData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxMsgData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxMsgData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxMsgData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Data) > 0 {
+			for _, e := range x.Data {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgResponses) > 0 {
+			for _, e := range x.MsgResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxMsgData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgResponses) > 0 {
+			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Data) > 0 {
+			for iNdEx := len(x.Data) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Data[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxMsgData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxMsgData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxMsgData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data, &MsgData{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Data[len(x.Data)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Result == nil {
-					x.Result = &Result{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4679,29 +6976,88 @@ func (x *fastReflection_SimulationResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_SearchTxsResult_6_list)(nil)
+
+type _SearchTxsResult_6_list struct {
+	list *[]*TxResponse
+}
+
+func (x *_SearchTxsResult_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SearchTxsResult_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SearchTxsResult_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TxResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SearchTxsResult_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TxResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SearchTxsResult_6_list) AppendMutable() protoreflect.Value {
+	v := new(TxResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SearchTxsResult_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SearchTxsResult_6_list) NewElement() protoreflect.Value {
+	v := new(TxResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SearchTxsResult_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgData          protoreflect.MessageDescriptor
-	fd_MsgData_msg_type protoreflect.FieldDescriptor
-	fd_MsgData_data     protoreflect.FieldDescriptor
+	md_SearchTxsResult             protoreflect.MessageDescriptor
+	fd_SearchTxsResult_total_count protoreflect.FieldDescriptor
+	fd_SearchTxsResult_count       protoreflect.FieldDescriptor
+	fd_SearchTxsResult_page_number protoreflect.FieldDescriptor
+	fd_SearchTxsResult_page_total  protoreflect.FieldDescriptor
+	fd_SearchTxsResult_limit       protoreflect.FieldDescriptor
+	fd_SearchTxsResult_txs         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_MsgData = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("MsgData")
-	fd_MsgData_msg_type = md_MsgData.Fields().ByName("msg_type")
-	fd_MsgData_data = md_MsgData.Fields().ByName("data")
+	md_SearchTxsResult = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SearchTxsResult")
+	fd_SearchTxsResult_total_count = md_SearchTxsResult.Fields().ByName("total_count")
+	fd_SearchTxsResult_count = md_SearchTxsResult.Fields().ByName("count")
+	fd_SearchTxsResult_page_number = md_SearchTxsResult.Fields().ByName("page_number")
+	fd_SearchTxsResult_page_total = md_SearchTxsResult.Fields().ByName("page_total")
+	fd_SearchTxsResult_limit = md_SearchTxsResult.Fields().ByName("limit")
+	fd_SearchTxsResult_txs = md_SearchTxsResult.Fields().ByName("txs")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgData)(nil)
+var _ protoreflect.Message = (*fastReflection_SearchTxsResult)(nil)
 
-type fastReflection_MsgData MsgData
+type fastReflection_SearchTxsResult SearchTxsResult
 
-func (x *MsgData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgData)(x)
+func (x *SearchTxsResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SearchTxsResult)(x)
 }
 
-func (x *MsgData) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[7]
+func (x *SearchTxsResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4712,43 +7068,43 @@ func (x *MsgData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgData_messageType fastReflection_MsgData_messageType
-var _ protoreflect.MessageType = fastReflection_MsgData_messageType{}
+var _fastReflection_SearchTxsResult_messageType fastReflection_SearchTxsResult_messageType
+var _ protoreflect.MessageType = fastReflection_SearchTxsResult_messageType{}
 
-type fastReflection_MsgData_messageType struct{}
+type fastReflection_SearchTxsResult_messageType struct{}
 
-func (x fastReflection_MsgData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgData)(nil)
+func (x fastReflection_SearchTxsResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SearchTxsResult)(nil)
 }
-func (x fastReflection_MsgData_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgData)
+func (x fastReflection_SearchTxsResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SearchTxsResult)
 }
-func (x fastReflection_MsgData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgData
+func (x fastReflection_SearchTxsResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SearchTxsResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgData) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgData
+func (x *fastReflection_SearchTxsResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SearchTxsResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgData) Type() protoreflect.MessageType {
-	return _fastReflection_MsgData_messageType
+func (x *fastReflection_SearchTxsResult) Type() protoreflect.MessageType {
+	return _fastReflection_SearchTxsResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgData) New() protoreflect.Message {
-	return new(fastReflection_MsgData)
+func (x *fastReflection_SearchTxsResult) New() protoreflect.Message {
+	return new(fastReflection_SearchTxsResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgData) Interface() protoreflect.ProtoMessage {
-	return (*MsgData)(x)
+func (x *fastReflection_SearchTxsResult) Interface() protoreflect.ProtoMessage {
+	return (*SearchTxsResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4756,16 +7112,40 @@ func (x *fastReflection_MsgData) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MsgType != "" {
-		value := protoreflect.ValueOfString(x.MsgType)
-		if !f(fd_MsgData_msg_type, value) {
+func (x *fastReflection_SearchTxsResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalCount)
+		if !f(fd_SearchTxsResult_total_count, value) {
 			return
 		}
 	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_MsgData_data, value) {
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_SearchTxsResult_count, value) {
+			return
+		}
+	}
+	if x.PageNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PageNumber)
+		if !f(fd_SearchTxsResult_page_number, value) {
+			return
+		}
+	}
+	if x.PageTotal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PageTotal)
+		if !f(fd_SearchTxsResult_page_total, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_SearchTxsResult_limit, value) {
+			return
+		}
+	}
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_SearchTxsResult_6_list{list: &x.Txs})
+		if !f(fd_SearchTxsResult_txs, value) {
 			return
 		}
 	}
@@ -4782,17 +7162,25 @@ func (x *fastReflection_MsgData) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SearchTxsResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		return x.MsgType != ""
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		return len(x.Data) != 0
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		return x.TotalCount != uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		return x.Count != uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		return x.PageNumber != uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		return x.PageTotal != uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		return x.Limit != uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		return len(x.Txs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4802,17 +7190,25 @@ func (x *fastReflection_MsgData) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SearchTxsResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		x.MsgType = ""
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		x.Data = nil
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		x.TotalCount = uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		x.Count = uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		x.PageNumber = uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		x.PageTotal = uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		x.Limit = uint64(0)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		x.Txs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4822,19 +7218,34 @@ func (x *fastReflection_MsgData) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchTxsResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		value := x.MsgType
-		return protoreflect.ValueOfString(value)
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		value := x.PageNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		value := x.PageTotal
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_SearchTxsResult_6_list{})
+		}
+		listValue := &_SearchTxsResult_6_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4848,17 +7259,27 @@ func (x *fastReflection_MsgData) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SearchTxsResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		x.MsgType = value.Interface().(string)
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		x.Data = value.Bytes()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		x.TotalCount = value.Uint()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		x.Count = value.Uint()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		x.PageNumber = value.Uint()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		x.PageTotal = value.Uint()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		x.Limit = value.Uint()
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		lv := value.List()
+		clv := lv.(*_SearchTxsResult_6_list)
+		x.Txs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4872,44 +7293,65 @@ func (x *fastReflection_MsgData) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchTxsResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		panic(fmt.Errorf("field msg_type of message cosmos.base.abci.v1beta1.MsgData is not mutable"))
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		panic(fmt.Errorf("field data of message cosmos.base.abci.v1beta1.MsgData is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		if x.Txs == nil {
+			x.Txs = []*TxResponse{}
+		}
+		value := &_SearchTxsResult_6_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		panic(fmt.Errorf("field total_count of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		panic(fmt.Errorf("field count of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		panic(fmt.Errorf("field page_number of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		panic(fmt.Errorf("field page_total of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		panic(fmt.Errorf("field limit of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchTxsResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.MsgData.msg_type":
-		return protoreflect.ValueOfString("")
-	case "cosmos.base.abci.v1beta1.MsgData.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+		list := []*TxResponse{}
+		return protoreflect.ValueOfList(&_SearchTxsResult_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.MsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.MsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SearchTxsResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.MsgData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SearchTxsResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4917,7 +7359,7 @@ func (x *fastReflection_MsgData) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SearchTxsResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4928,7 +7370,7 @@ func (x *fastReflection_MsgData) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SearchTxsResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4940,7 +7382,7 @@ func (x *fastReflection_MsgData) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgData) IsValid() bool {
+func (x *fastReflection_SearchTxsResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4950,9 +7392,9 @@ func (x *fastReflection_MsgData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgData)
+		x := input.Message.Interface().(*SearchTxsResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4964,13 +7406,26 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.MsgType)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TotalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCount))
 		}
-		l = len(x.Data)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.PageNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.PageNumber))
+		}
+		if x.PageTotal != 0 {
+			n += 1 + runtime.Sov(uint64(x.PageTotal))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4982,7 +7437,7 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgData)
+		x := input.Message.Interface().(*SearchTxsResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5001,19 +7456,46 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x28
 		}
-		if len(x.MsgType) > 0 {
-			i -= len(x.MsgType)
-			copy(dAtA[i:], x.MsgType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgType)))
+		if x.PageTotal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageTotal))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x20
+		}
+		if x.PageNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TotalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5026,7 +7508,7 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgData)
+		x := input.Message.Interface().(*SearchTxsResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5058,17 +7540,17 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchTxsResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchTxsResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
 				}
-				var stringLen uint64
+				x.TotalCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5078,29 +7560,92 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.TotalCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageNumber", wireType)
 				}
-				x.MsgType = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+				x.PageNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PageNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageTotal", wireType)
+				}
+				x.PageTotal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PageTotal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5110,24 +7655,24 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Txs = append(x.Txs, &TxResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -5165,131 +7710,88 @@ func (x *fastReflection_MsgData) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TxMsgData_1_list)(nil)
-
-type _TxMsgData_1_list struct {
-	list *[]*MsgData
-}
-
-func (x *_TxMsgData_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TxMsgData_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TxMsgData_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgData)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TxMsgData_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgData)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TxMsgData_1_list) AppendMutable() protoreflect.Value {
-	v := new(MsgData)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TxMsgData_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TxMsgData_1_list) NewElement() protoreflect.Value {
-	v := new(MsgData)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TxMsgData_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TxMsgData_2_list)(nil)
+var _ protoreflect.List = (*_SearchBlocksResult_6_list)(nil)
 
-type _TxMsgData_2_list struct {
-	list *[]*anypb.Any
+type _SearchBlocksResult_6_list struct {
+	list *[]*types.Block
 }
 
-func (x *_TxMsgData_2_list) Len() int {
+func (x *_SearchBlocksResult_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TxMsgData_2_list) Get(i int) protoreflect.Value {
+func (x *_SearchBlocksResult_6_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_TxMsgData_2_list) Set(i int, value protoreflect.Value) {
+func (x *_SearchBlocksResult_6_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*types.Block)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TxMsgData_2_list) Append(value protoreflect.Value) {
+func (x *_SearchBlocksResult_6_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*types.Block)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TxMsgData_2_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_SearchBlocksResult_6_list) AppendMutable() protoreflect.Value {
+	v := new(types.Block)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TxMsgData_2_list) Truncate(n int) {
+func (x *_SearchBlocksResult_6_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TxMsgData_2_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_SearchBlocksResult_6_list) NewElement() protoreflect.Value {
+	v := new(types.Block)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TxMsgData_2_list) IsValid() bool {
+func (x *_SearchBlocksResult_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TxMsgData               protoreflect.MessageDescriptor
-	fd_TxMsgData_data          protoreflect.FieldDescriptor
-	fd_TxMsgData_msg_responses protoreflect.FieldDescriptor
+	md_SearchBlocksResult             protoreflect.MessageDescriptor
+	fd_SearchBlocksResult_total_count protoreflect.FieldDescriptor
+	fd_SearchBlocksResult_count       protoreflect.FieldDescriptor
+	fd_SearchBlocksResult_page_number protoreflect.FieldDescriptor
+	fd_SearchBlocksResult_page_total  protoreflect.FieldDescriptor
+	fd_SearchBlocksResult_limit       protoreflect.FieldDescriptor
+	fd_SearchBlocksResult_blocks      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_TxMsgData = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("TxMsgData")
-	fd_TxMsgData_data = md_TxMsgData.Fields().ByName("data")
-	fd_TxMsgData_msg_responses = md_TxMsgData.Fields().ByName("msg_responses")
+	md_SearchBlocksResult = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SearchBlocksResult")
+	fd_SearchBlocksResult_total_count = md_SearchBlocksResult.Fields().ByName("total_count")
+	fd_SearchBlocksResult_count = md_SearchBlocksResult.Fields().ByName("count")
+	fd_SearchBlocksResult_page_number = md_SearchBlocksResult.Fields().ByName("page_number")
+	fd_SearchBlocksResult_page_total = md_SearchBlocksResult.Fields().ByName("page_total")
+	fd_SearchBlocksResult_limit = md_SearchBlocksResult.Fields().ByName("limit")
+	fd_SearchBlocksResult_blocks = md_SearchBlocksResult.Fields().ByName("blocks")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxMsgData)(nil)
+var _ protoreflect.Message = (*fastReflection_SearchBlocksResult)(nil)
 
-type fastReflection_TxMsgData TxMsgData
+type fastReflection_SearchBlocksResult SearchBlocksResult
 
-func (x *TxMsgData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxMsgData)(x)
+func (x *SearchBlocksResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SearchBlocksResult)(x)
 }
 
-func (x *TxMsgData) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[8]
+func (x *SearchBlocksResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5300,43 +7802,43 @@ func (x *TxMsgData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxMsgData_messageType fastReflection_TxMsgData_messageType
-var _ protoreflect.MessageType = fastReflection_TxMsgData_messageType{}
+var _fastReflection_SearchBlocksResult_messageType fastReflection_SearchBlocksResult_messageType
+var _ protoreflect.MessageType = fastReflection_SearchBlocksResult_messageType{}
 
-type fastReflection_TxMsgData_messageType struct{}
+type fastReflection_SearchBlocksResult_messageType struct{}
 
-func (x fastReflection_TxMsgData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxMsgData)(nil)
+func (x fastReflection_SearchBlocksResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SearchBlocksResult)(nil)
 }
-func (x fastReflection_TxMsgData_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxMsgData)
+func (x fastReflection_SearchBlocksResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SearchBlocksResult)
 }
-func (x fastReflection_TxMsgData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxMsgData
+func (x fastReflection_SearchBlocksResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SearchBlocksResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxMsgData) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxMsgData
+func (x *fastReflection_SearchBlocksResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SearchBlocksResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxMsgData) Type() protoreflect.MessageType {
-	return _fastReflection_TxMsgData_messageType
+func (x *fastReflection_SearchBlocksResult) Type() protoreflect.MessageType {
+	return _fastReflection_SearchBlocksResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxMsgData) New() protoreflect.Message {
-	return new(fastReflection_TxMsgData)
+func (x *fastReflection_SearchBlocksResult) New() protoreflect.Message {
+	return new(fastReflection_SearchBlocksResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxMsgData) Interface() protoreflect.ProtoMessage {
-	return (*TxMsgData)(x)
+func (x *fastReflection_SearchBlocksResult) Interface() protoreflect.ProtoMessage {
+	return (*SearchBlocksResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5344,16 +7846,40 @@ func (x *fastReflection_TxMsgData) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxMsgData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfList(&_TxMsgData_1_list{list: &x.Data})
-		if !f(fd_TxMsgData_data, value) {
+func (x *fastReflection_SearchBlocksResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalCount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalCount)
+		if !f(fd_SearchBlocksResult_total_count, value) {
 			return
 		}
 	}
-	if len(x.MsgResponses) != 0 {
-		value := protoreflect.ValueOfList(&_TxMsgData_2_list{list: &x.MsgResponses})
-		if !f(fd_TxMsgData_msg_responses, value) {
+	if x.Count != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Count)
+		if !f(fd_SearchBlocksResult_count, value) {
+			return
+		}
+	}
+	if x.PageNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PageNumber)
+		if !f(fd_SearchBlocksResult_page_number, value) {
+			return
+		}
+	}
+	if x.PageTotal != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PageTotal)
+		if !f(fd_SearchBlocksResult_page_total, value) {
+			return
+		}
+	}
+	if x.Limit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Limit)
+		if !f(fd_SearchBlocksResult_limit, value) {
+			return
+		}
+	}
+	if len(x.Blocks) != 0 {
+		value := protoreflect.ValueOfList(&_SearchBlocksResult_6_list{list: &x.Blocks})
+		if !f(fd_SearchBlocksResult_blocks, value) {
 			return
 		}
 	}
@@ -5370,17 +7896,25 @@ func (x *fastReflection_TxMsgData) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxMsgData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SearchBlocksResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		return len(x.Data) != 0
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
-		return len(x.MsgResponses) != 0
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		return x.TotalCount != int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		return x.Count != int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		return x.PageNumber != int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		return x.PageTotal != int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		return x.Limit != int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
+		return len(x.Blocks) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5390,17 +7924,25 @@ func (x *fastReflection_TxMsgData) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxMsgData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SearchBlocksResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		x.Data = nil
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
-		x.MsgResponses = nil
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		x.TotalCount = int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		x.Count = int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		x.PageNumber = int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		x.PageTotal = int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		x.Limit = int64(0)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
+		x.Blocks = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5410,25 +7952,34 @@ func (x *fastReflection_TxMsgData) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxMsgData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchBlocksResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		if len(x.Data) == 0 {
-			return protoreflect.ValueOfList(&_TxMsgData_1_list{})
-		}
-		listValue := &_TxMsgData_1_list{list: &x.Data}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
-		if len(x.MsgResponses) == 0 {
-			return protoreflect.ValueOfList(&_TxMsgData_2_list{})
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		value := x.Count
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		value := x.PageNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		value := x.PageTotal
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		value := x.Limit
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
+		if len(x.Blocks) == 0 {
+			return protoreflect.ValueOfList(&_SearchBlocksResult_6_list{})
 		}
-		listValue := &_TxMsgData_2_list{list: &x.MsgResponses}
+		listValue := &_SearchBlocksResult_6_list{list: &x.Blocks}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5442,21 +7993,27 @@ func (x *fastReflection_TxMsgData) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxMsgData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SearchBlocksResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		lv := value.List()
-		clv := lv.(*_TxMsgData_1_list)
-		x.Data = *clv.list
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		x.TotalCount = value.Int()
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		x.Count = value.Int()
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		x.PageNumber = value.Int()
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		x.PageTotal = value.Int()
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		x.Limit = value.Int()
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
 		lv := value.List()
-		clv := lv.(*_TxMsgData_2_list)
-		x.MsgResponses = *clv.list
+		clv := lv.(*_SearchBlocksResult_6_list)
+		x.Blocks = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5470,54 +8027,65 @@ func (x *fastReflection_TxMsgData) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxMsgData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchBlocksResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		if x.Data == nil {
-			x.Data = []*MsgData{}
-		}
-		value := &_TxMsgData_1_list{list: &x.Data}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
-		if x.MsgResponses == nil {
-			x.MsgResponses = []*anypb.Any{}
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
+		if x.Blocks == nil {
+			x.Blocks = []*types.Block{}
 		}
-		value := &_TxMsgData_2_list{list: &x.MsgResponses}
+		value := &_SearchBlocksResult_6_list{list: &x.Blocks}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		panic(fmt.Errorf("field total_count of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		panic(fmt.Errorf("field count of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		panic(fmt.Errorf("field page_number of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		panic(fmt.Errorf("field page_total of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		panic(fmt.Errorf("field limit of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxMsgData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SearchBlocksResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.TxMsgData.data":
-		list := []*MsgData{}
-		return protoreflect.ValueOfList(&_TxMsgData_1_list{list: &list})
-	case "cosmos.base.abci.v1beta1.TxMsgData.msg_responses":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_TxMsgData_2_list{list: &list})
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
+		list := []*types.Block{}
+		return protoreflect.ValueOfList(&_SearchBlocksResult_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.TxMsgData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.TxMsgData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxMsgData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SearchBlocksResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.TxMsgData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SearchBlocksResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5525,7 +8093,7 @@ func (x *fastReflection_TxMsgData) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxMsgData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SearchBlocksResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5536,7 +8104,7 @@ func (x *fastReflection_TxMsgData) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxMsgData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SearchBlocksResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5548,7 +8116,7 @@ func (x *fastReflection_TxMsgData) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxMsgData) IsValid() bool {
+func (x *fastReflection_SearchBlocksResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5558,9 +8126,9 @@ func (x *fastReflection_TxMsgData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxMsgData)
+		x := input.Message.Interface().(*SearchBlocksResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5572,14 +8140,23 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Data) > 0 {
-			for _, e := range x.Data {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.TotalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCount))
 		}
-		if len(x.MsgResponses) > 0 {
-			for _, e := range x.MsgResponses {
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.PageNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.PageNumber))
+		}
+		if x.PageTotal != 0 {
+			n += 1 + runtime.Sov(uint64(x.PageTotal))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if len(x.Blocks) > 0 {
+			for _, e := range x.Blocks {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -5594,7 +8171,7 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxMsgData)
+		x := input.Message.Interface().(*SearchBlocksResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5613,9 +8190,9 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MsgResponses) > 0 {
-			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
+		if len(x.Blocks) > 0 {
+			for iNdEx := len(x.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Blocks[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5626,24 +8203,33 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x32
 			}
 		}
-		if len(x.Data) > 0 {
-			for iNdEx := len(x.Data) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Data[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PageTotal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageTotal))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PageNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TotalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5656,7 +8242,7 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxMsgData)
+		x := input.Message.Interface().(*SearchBlocksResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5688,17 +8274,74 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxMsgData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchBlocksResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxMsgData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchBlocksResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
 				}
-				var msglen int
+				x.TotalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalCount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageNumber", wireType)
+				}
+				x.PageNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PageNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageTotal", wireType)
+				}
+				x.PageTotal = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5708,29 +8351,33 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.PageTotal |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 				}
-				x.Data = append(x.Data, &MsgData{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Data[len(x.Data)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5757,8 +8404,8 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
+				x.Blocks = append(x.Blocks, &types.Block{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blocks[len(x.Blocks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5797,88 +8444,78 @@ func (x *fastReflection_TxMsgData) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_SearchTxsResult_6_list)(nil)
+var _ protoreflect.List = (*_ComposedVoteExtension_1_list)(nil)
 
-type _SearchTxsResult_6_list struct {
-	list *[]*TxResponse
+type _ComposedVoteExtension_1_list struct {
+	list *[]*ModuleVoteExtension
 }
 
-func (x *_SearchTxsResult_6_list) Len() int {
+func (x *_ComposedVoteExtension_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_SearchTxsResult_6_list) Get(i int) protoreflect.Value {
+func (x *_ComposedVoteExtension_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_SearchTxsResult_6_list) Set(i int, value protoreflect.Value) {
+func (x *_ComposedVoteExtension_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TxResponse)
+	concreteValue := valueUnwrapped.Interface().(*ModuleVoteExtension)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_SearchTxsResult_6_list) Append(value protoreflect.Value) {
+func (x *_ComposedVoteExtension_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TxResponse)
+	concreteValue := valueUnwrapped.Interface().(*ModuleVoteExtension)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_SearchTxsResult_6_list) AppendMutable() protoreflect.Value {
-	v := new(TxResponse)
+func (x *_ComposedVoteExtension_1_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleVoteExtension)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_SearchTxsResult_6_list) Truncate(n int) {
+func (x *_ComposedVoteExtension_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_SearchTxsResult_6_list) NewElement() protoreflect.Value {
-	v := new(TxResponse)
+func (x *_ComposedVoteExtension_1_list) NewElement() protoreflect.Value {
+	v := new(ModuleVoteExtension)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_SearchTxsResult_6_list) IsValid() bool {
+func (x *_ComposedVoteExtension_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_SearchTxsResult             protoreflect.MessageDescriptor
-	fd_SearchTxsResult_total_count protoreflect.FieldDescriptor
-	fd_SearchTxsResult_count       protoreflect.FieldDescriptor
-	fd_SearchTxsResult_page_number protoreflect.FieldDescriptor
-	fd_SearchTxsResult_page_total  protoreflect.FieldDescriptor
-	fd_SearchTxsResult_limit       protoreflect.FieldDescriptor
-	fd_SearchTxsResult_txs         protoreflect.FieldDescriptor
+	md_ComposedVoteExtension            protoreflect.MessageDescriptor
+	fd_ComposedVoteExtension_extensions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_SearchTxsResult = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SearchTxsResult")
-	fd_SearchTxsResult_total_count = md_SearchTxsResult.Fields().ByName("total_count")
-	fd_SearchTxsResult_count = md_SearchTxsResult.Fields().ByName("count")
-	fd_SearchTxsResult_page_number = md_SearchTxsResult.Fields().ByName("page_number")
-	fd_SearchTxsResult_page_total = md_SearchTxsResult.Fields().ByName("page_total")
-	fd_SearchTxsResult_limit = md_SearchTxsResult.Fields().ByName("limit")
-	fd_SearchTxsResult_txs = md_SearchTxsResult.Fields().ByName("txs")
+	md_ComposedVoteExtension = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("ComposedVoteExtension")
+	fd_ComposedVoteExtension_extensions = md_ComposedVoteExtension.Fields().ByName("extensions")
 }
 
-var _ protoreflect.Message = (*fastReflection_SearchTxsResult)(nil)
+var _ protoreflect.Message = (*fastReflection_ComposedVoteExtension)(nil)
 
-type fastReflection_SearchTxsResult SearchTxsResult
+type fastReflection_ComposedVoteExtension ComposedVoteExtension
 
-func (x *SearchTxsResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SearchTxsResult)(x)
+func (x *ComposedVoteExtension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ComposedVoteExtension)(x)
 }
 
-func (x *SearchTxsResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[9]
+func (x *ComposedVoteExtension) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5889,43 +8526,43 @@ func (x *SearchTxsResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SearchTxsResult_messageType fastReflection_SearchTxsResult_messageType
-var _ protoreflect.MessageType = fastReflection_SearchTxsResult_messageType{}
+var _fastReflection_ComposedVoteExtension_messageType fastReflection_ComposedVoteExtension_messageType
+var _ protoreflect.MessageType = fastReflection_ComposedVoteExtension_messageType{}
 
-type fastReflection_SearchTxsResult_messageType struct{}
+type fastReflection_ComposedVoteExtension_messageType struct{}
 
-func (x fastReflection_SearchTxsResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SearchTxsResult)(nil)
+func (x fastReflection_ComposedVoteExtension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ComposedVoteExtension)(nil)
 }
-func (x fastReflection_SearchTxsResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SearchTxsResult)
+func (x fastReflection_ComposedVoteExtension_messageType) New() protoreflect.Message {
+	return new(fastReflection_ComposedVoteExtension)
 }
-func (x fastReflection_SearchTxsResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SearchTxsResult
+func (x fastReflection_ComposedVoteExtension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ComposedVoteExtension
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SearchTxsResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SearchTxsResult
+func (x *fastReflection_ComposedVoteExtension) Descriptor() protoreflect.MessageDescriptor {
+	return md_ComposedVoteExtension
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SearchTxsResult) Type() protoreflect.MessageType {
-	return _fastReflection_SearchTxsResult_messageType
+func (x *fastReflection_ComposedVoteExtension) Type() protoreflect.MessageType {
+	return _fastReflection_ComposedVoteExtension_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SearchTxsResult) New() protoreflect.Message {
-	return new(fastReflection_SearchTxsResult)
+func (x *fastReflection_ComposedVoteExtension) New() protoreflect.Message {
+	return new(fastReflection_ComposedVoteExtension)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SearchTxsResult) Interface() protoreflect.ProtoMessage {
-	return (*SearchTxsResult)(x)
+func (x *fastReflection_ComposedVoteExtension) Interface() protoreflect.ProtoMessage {
+	return (*ComposedVoteExtension)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5933,40 +8570,10 @@ func (x *fastReflection_SearchTxsResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SearchTxsResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TotalCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TotalCount)
-		if !f(fd_SearchTxsResult_total_count, value) {
-			return
-		}
-	}
-	if x.Count != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Count)
-		if !f(fd_SearchTxsResult_count, value) {
-			return
-		}
-	}
-	if x.PageNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PageNumber)
-		if !f(fd_SearchTxsResult_page_number, value) {
-			return
-		}
-	}
-	if x.PageTotal != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PageTotal)
-		if !f(fd_SearchTxsResult_page_total, value) {
-			return
-		}
-	}
-	if x.Limit != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Limit)
-		if !f(fd_SearchTxsResult_limit, value) {
-			return
-		}
-	}
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_SearchTxsResult_6_list{list: &x.Txs})
-		if !f(fd_SearchTxsResult_txs, value) {
+func (x *fastReflection_ComposedVoteExtension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Extensions) != 0 {
+		value := protoreflect.ValueOfList(&_ComposedVoteExtension_1_list{list: &x.Extensions})
+		if !f(fd_ComposedVoteExtension_extensions, value) {
 			return
 		}
 	}
@@ -5983,25 +8590,15 @@ func (x *fastReflection_SearchTxsResult) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SearchTxsResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ComposedVoteExtension) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		return x.TotalCount != uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		return x.Count != uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		return x.PageNumber != uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		return x.PageTotal != uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		return x.Limit != uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
-		return len(x.Txs) != 0
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
+		return len(x.Extensions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6011,25 +8608,15 @@ func (x *fastReflection_SearchTxsResult) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchTxsResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ComposedVoteExtension) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		x.TotalCount = uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		x.Count = uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		x.PageNumber = uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		x.PageTotal = uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		x.Limit = uint64(0)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
-		x.Txs = nil
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
+		x.Extensions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6039,34 +8626,19 @@ func (x *fastReflection_SearchTxsResult) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SearchTxsResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ComposedVoteExtension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		value := x.TotalCount
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		value := x.Count
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		value := x.PageNumber
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		value := x.PageTotal
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		value := x.Limit
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_SearchTxsResult_6_list{})
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
+		if len(x.Extensions) == 0 {
+			return protoreflect.ValueOfList(&_ComposedVoteExtension_1_list{})
 		}
-		listValue := &_SearchTxsResult_6_list{list: &x.Txs}
+		listValue := &_ComposedVoteExtension_1_list{list: &x.Extensions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6080,27 +8652,17 @@ func (x *fastReflection_SearchTxsResult) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchTxsResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ComposedVoteExtension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		x.TotalCount = value.Uint()
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		x.Count = value.Uint()
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		x.PageNumber = value.Uint()
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		x.PageTotal = value.Uint()
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		x.Limit = value.Uint()
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
 		lv := value.List()
-		clv := lv.(*_SearchTxsResult_6_list)
-		x.Txs = *clv.list
+		clv := lv.(*_ComposedVoteExtension_1_list)
+		x.Extensions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6114,65 +8676,45 @@ func (x *fastReflection_SearchTxsResult) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchTxsResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ComposedVoteExtension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
-		if x.Txs == nil {
-			x.Txs = []*TxResponse{}
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
+		if x.Extensions == nil {
+			x.Extensions = []*ModuleVoteExtension{}
 		}
-		value := &_SearchTxsResult_6_list{list: &x.Txs}
+		value := &_ComposedVoteExtension_1_list{list: &x.Extensions}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		panic(fmt.Errorf("field total_count of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		panic(fmt.Errorf("field count of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		panic(fmt.Errorf("field page_number of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		panic(fmt.Errorf("field page_total of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		panic(fmt.Errorf("field limit of message cosmos.base.abci.v1beta1.SearchTxsResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SearchTxsResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ComposedVoteExtension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.total_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_number":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.page_total":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.limit":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.base.abci.v1beta1.SearchTxsResult.txs":
-		list := []*TxResponse{}
-		return protoreflect.ValueOfList(&_SearchTxsResult_6_list{list: &list})
+	case "cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions":
+		list := []*ModuleVoteExtension{}
+		return protoreflect.ValueOfList(&_ComposedVoteExtension_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchTxsResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ComposedVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchTxsResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ComposedVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SearchTxsResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ComposedVoteExtension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SearchTxsResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.ComposedVoteExtension", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6180,7 +8722,7 @@ func (x *fastReflection_SearchTxsResult) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SearchTxsResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ComposedVoteExtension) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6191,7 +8733,7 @@ func (x *fastReflection_SearchTxsResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchTxsResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ComposedVoteExtension) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6203,7 +8745,7 @@ func (x *fastReflection_SearchTxsResult) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SearchTxsResult) IsValid() bool {
+func (x *fastReflection_ComposedVoteExtension) IsValid() bool {
 	return x != nil
 }
 
@@ -6213,9 +8755,9 @@ func (x *fastReflection_SearchTxsResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ComposedVoteExtension) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SearchTxsResult)
+		x := input.Message.Interface().(*ComposedVoteExtension)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6223,27 +8765,12 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 			}
 		}
 		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.TotalCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.TotalCount))
-		}
-		if x.Count != 0 {
-			n += 1 + runtime.Sov(uint64(x.Count))
-		}
-		if x.PageNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.PageNumber))
-		}
-		if x.PageTotal != 0 {
-			n += 1 + runtime.Sov(uint64(x.PageTotal))
-		}
-		if x.Limit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Limit))
-		}
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Extensions) > 0 {
+			for _, e := range x.Extensions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -6258,7 +8785,7 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SearchTxsResult)
+		x := input.Message.Interface().(*ComposedVoteExtension)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6277,9 +8804,9 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
+		if len(x.Extensions) > 0 {
+			for iNdEx := len(x.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Extensions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6290,34 +8817,9 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x32
+				dAtA[i] = 0xa
 			}
 		}
-		if x.Limit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.PageTotal != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageTotal))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.PageNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageNumber))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Count != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.TotalCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6329,7 +8831,7 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SearchTxsResult)
+		x := input.Message.Interface().(*ComposedVoteExtension)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6361,110 +8863,15 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchTxsResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ComposedVoteExtension: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchTxsResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ComposedVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
-				}
-				x.TotalCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TotalCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-				}
-				x.Count = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Count |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageNumber", wireType)
-				}
-				x.PageNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PageNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageTotal", wireType)
-				}
-				x.PageTotal = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PageTotal |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-				}
-				x.Limit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Limit |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6491,8 +8898,8 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Txs = append(x.Txs, &TxResponse{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+				x.Extensions = append(x.Extensions, &ModuleVoteExtension{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Extensions[len(x.Extensions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6531,88 +8938,29 @@ func (x *fastReflection_SearchTxsResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_SearchBlocksResult_6_list)(nil)
-
-type _SearchBlocksResult_6_list struct {
-	list *[]*types.Block
-}
-
-func (x *_SearchBlocksResult_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_SearchBlocksResult_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_SearchBlocksResult_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*types.Block)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_SearchBlocksResult_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*types.Block)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_SearchBlocksResult_6_list) AppendMutable() protoreflect.Value {
-	v := new(types.Block)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_SearchBlocksResult_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_SearchBlocksResult_6_list) NewElement() protoreflect.Value {
-	v := new(types.Block)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_SearchBlocksResult_6_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_SearchBlocksResult             protoreflect.MessageDescriptor
-	fd_SearchBlocksResult_total_count protoreflect.FieldDescriptor
-	fd_SearchBlocksResult_count       protoreflect.FieldDescriptor
-	fd_SearchBlocksResult_page_number protoreflect.FieldDescriptor
-	fd_SearchBlocksResult_page_total  protoreflect.FieldDescriptor
-	fd_SearchBlocksResult_limit       protoreflect.FieldDescriptor
-	fd_SearchBlocksResult_blocks      protoreflect.FieldDescriptor
+	md_ModuleVoteExtension           protoreflect.MessageDescriptor
+	fd_ModuleVoteExtension_module    protoreflect.FieldDescriptor
+	fd_ModuleVoteExtension_extension protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_abci_v1beta1_abci_proto_init()
-	md_SearchBlocksResult = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("SearchBlocksResult")
-	fd_SearchBlocksResult_total_count = md_SearchBlocksResult.Fields().ByName("total_count")
-	fd_SearchBlocksResult_count = md_SearchBlocksResult.Fields().ByName("count")
-	fd_SearchBlocksResult_page_number = md_SearchBlocksResult.Fields().ByName("page_number")
-	fd_SearchBlocksResult_page_total = md_SearchBlocksResult.Fields().ByName("page_total")
-	fd_SearchBlocksResult_limit = md_SearchBlocksResult.Fields().ByName("limit")
-	fd_SearchBlocksResult_blocks = md_SearchBlocksResult.Fields().ByName("blocks")
+	md_ModuleVoteExtension = File_cosmos_base_abci_v1beta1_abci_proto.Messages().ByName("ModuleVoteExtension")
+	fd_ModuleVoteExtension_module = md_ModuleVoteExtension.Fields().ByName("module")
+	fd_ModuleVoteExtension_extension = md_ModuleVoteExtension.Fields().ByName("extension")
 }
 
-var _ protoreflect.Message = (*fastReflection_SearchBlocksResult)(nil)
+var _ protoreflect.Message = (*fastReflection_ModuleVoteExtension)(nil)
 
-type fastReflection_SearchBlocksResult SearchBlocksResult
+type fastReflection_ModuleVoteExtension ModuleVoteExtension
 
-func (x *SearchBlocksResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SearchBlocksResult)(x)
+func (x *ModuleVoteExtension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleVoteExtension)(x)
 }
 
-func (x *SearchBlocksResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[10]
+func (x *ModuleVoteExtension) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6623,43 +8971,43 @@ func (x *SearchBlocksResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SearchBlocksResult_messageType fastReflection_SearchBlocksResult_messageType
-var _ protoreflect.MessageType = fastReflection_SearchBlocksResult_messageType{}
+var _fastReflection_ModuleVoteExtension_messageType fastReflection_ModuleVoteExtension_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleVoteExtension_messageType{}
 
-type fastReflection_SearchBlocksResult_messageType struct{}
+type fastReflection_ModuleVoteExtension_messageType struct{}
 
-func (x fastReflection_SearchBlocksResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SearchBlocksResult)(nil)
+func (x fastReflection_ModuleVoteExtension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleVoteExtension)(nil)
 }
-func (x fastReflection_SearchBlocksResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SearchBlocksResult)
+func (x fastReflection_ModuleVoteExtension_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleVoteExtension)
 }
-func (x fastReflection_SearchBlocksResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SearchBlocksResult
+func (x fastReflection_ModuleVoteExtension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleVoteExtension
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SearchBlocksResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SearchBlocksResult
+func (x *fastReflection_ModuleVoteExtension) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleVoteExtension
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SearchBlocksResult) Type() protoreflect.MessageType {
-	return _fastReflection_SearchBlocksResult_messageType
+func (x *fastReflection_ModuleVoteExtension) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleVoteExtension_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SearchBlocksResult) New() protoreflect.Message {
-	return new(fastReflection_SearchBlocksResult)
+func (x *fastReflection_ModuleVoteExtension) New() protoreflect.Message {
+	return new(fastReflection_ModuleVoteExtension)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SearchBlocksResult) Interface() protoreflect.ProtoMessage {
-	return (*SearchBlocksResult)(x)
+func (x *fastReflection_ModuleVoteExtension) Interface() protoreflect.ProtoMessage {
+	return (*ModuleVoteExtension)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6667,40 +9015,16 @@ func (x *fastReflection_SearchBlocksResult) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SearchBlocksResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TotalCount != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalCount)
-		if !f(fd_SearchBlocksResult_total_count, value) {
-			return
-		}
-	}
-	if x.Count != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Count)
-		if !f(fd_SearchBlocksResult_count, value) {
-			return
-		}
-	}
-	if x.PageNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.PageNumber)
-		if !f(fd_SearchBlocksResult_page_number, value) {
+func (x *fastReflection_ModuleVoteExtension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ModuleVoteExtension_module, value) {
 			return
 		}
 	}
-	if x.PageTotal != int64(0) {
-		value := protoreflect.ValueOfInt64(x.PageTotal)
-		if !f(fd_SearchBlocksResult_page_total, value) {
-			return
-		}
-	}
-	if x.Limit != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Limit)
-		if !f(fd_SearchBlocksResult_limit, value) {
-			return
-		}
-	}
-	if len(x.Blocks) != 0 {
-		value := protoreflect.ValueOfList(&_SearchBlocksResult_6_list{list: &x.Blocks})
-		if !f(fd_SearchBlocksResult_blocks, value) {
+	if len(x.Extension) != 0 {
+		value := protoreflect.ValueOfBytes(x.Extension)
+		if !f(fd_ModuleVoteExtension_extension, value) {
 			return
 		}
 	}
@@ -6717,25 +9041,17 @@ func (x *fastReflection_SearchBlocksResult) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SearchBlocksResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ModuleVoteExtension) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		return x.TotalCount != int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		return x.Count != int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		return x.PageNumber != int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		return x.PageTotal != int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		return x.Limit != int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		return len(x.Blocks) != 0
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		return x.Module != ""
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		return len(x.Extension) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6745,25 +9061,17 @@ func (x *fastReflection_SearchBlocksResult) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchBlocksResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ModuleVoteExtension) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		x.TotalCount = int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		x.Count = int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		x.PageNumber = int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		x.PageTotal = int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		x.Limit = int64(0)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		x.Blocks = nil
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		x.Module = ""
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		x.Extension = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6773,34 +9081,19 @@ func (x *fastReflection_SearchBlocksResult) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SearchBlocksResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleVoteExtension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		value := x.TotalCount
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		value := x.Count
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		value := x.PageNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		value := x.PageTotal
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		value := x.Limit
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		if len(x.Blocks) == 0 {
-			return protoreflect.ValueOfList(&_SearchBlocksResult_6_list{})
-		}
-		listValue := &_SearchBlocksResult_6_list{list: &x.Blocks}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		value := x.Extension
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6814,27 +9107,17 @@ func (x *fastReflection_SearchBlocksResult) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchBlocksResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ModuleVoteExtension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		x.TotalCount = value.Int()
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		x.Count = value.Int()
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		x.PageNumber = value.Int()
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		x.PageTotal = value.Int()
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		x.Limit = value.Int()
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		lv := value.List()
-		clv := lv.(*_SearchBlocksResult_6_list)
-		x.Blocks = *clv.list
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		x.Module = value.Interface().(string)
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		x.Extension = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6848,65 +9131,44 @@ func (x *fastReflection_SearchBlocksResult) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchBlocksResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleVoteExtension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		if x.Blocks == nil {
-			x.Blocks = []*types.Block{}
-		}
-		value := &_SearchBlocksResult_6_list{list: &x.Blocks}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		panic(fmt.Errorf("field total_count of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		panic(fmt.Errorf("field count of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		panic(fmt.Errorf("field page_number of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		panic(fmt.Errorf("field page_total of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		panic(fmt.Errorf("field limit of message cosmos.base.abci.v1beta1.SearchBlocksResult is not mutable"))
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		panic(fmt.Errorf("field module of message cosmos.base.abci.v1beta1.ModuleVoteExtension is not mutable"))
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		panic(fmt.Errorf("field extension of message cosmos.base.abci.v1beta1.ModuleVoteExtension is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SearchBlocksResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleVoteExtension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.total_count":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.count":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.page_total":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.limit":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.abci.v1beta1.SearchBlocksResult.blocks":
-		list := []*types.Block{}
-		return protoreflect.ValueOfList(&_SearchBlocksResult_6_list{list: &list})
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.module":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.abci.v1beta1.ModuleVoteExtension.extension":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.SearchBlocksResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.abci.v1beta1.ModuleVoteExtension"))
 		}
-		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.SearchBlocksResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.abci.v1beta1.ModuleVoteExtension does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SearchBlocksResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ModuleVoteExtension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.SearchBlocksResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.abci.v1beta1.ModuleVoteExtension", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6914,7 +9176,7 @@ func (x *fastReflection_SearchBlocksResult) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SearchBlocksResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ModuleVoteExtension) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6925,7 +9187,7 @@ func (x *fastReflection_SearchBlocksResult) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SearchBlocksResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ModuleVoteExtension) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6937,7 +9199,7 @@ func (x *fastReflection_SearchBlocksResult) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SearchBlocksResult) IsValid() bool {
+func (x *fastReflection_ModuleVoteExtension) IsValid() bool {
 	return x != nil
 }
 
@@ -6947,9 +9209,9 @@ func (x *fastReflection_SearchBlocksResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ModuleVoteExtension) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SearchBlocksResult)
+		x := input.Message.Interface().(*ModuleVoteExtension)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6961,26 +9223,13 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TotalCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.TotalCount))
-		}
-		if x.Count != 0 {
-			n += 1 + runtime.Sov(uint64(x.Count))
-		}
-		if x.PageNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.PageNumber))
-		}
-		if x.PageTotal != 0 {
-			n += 1 + runtime.Sov(uint64(x.PageTotal))
-		}
-		if x.Limit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Limit))
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Blocks) > 0 {
-			for _, e := range x.Blocks {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Extension)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6992,7 +9241,7 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SearchBlocksResult)
+		x := input.Message.Interface().(*ModuleVoteExtension)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7011,46 +9260,19 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Blocks) > 0 {
-			for iNdEx := len(x.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Blocks[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if x.Limit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.PageTotal != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageTotal))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.PageNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PageNumber))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Count != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+		if len(x.Extension) > 0 {
+			i -= len(x.Extension)
+			copy(dAtA[i:], x.Extension)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Extension)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.TotalCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7063,7 +9285,7 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SearchBlocksResult)
+		x := input.Message.Interface().(*ModuleVoteExtension)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7082,106 +9304,30 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchBlocksResult: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SearchBlocksResult: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
-				}
-				x.TotalCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TotalCount |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-				}
-				x.Count = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Count |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageNumber", wireType)
-				}
-				x.PageNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PageNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PageTotal", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PageTotal = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PageTotal |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleVoteExtension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 				}
-				x.Limit = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7191,16 +9337,29 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Limit |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7210,24 +9369,24 @@ func (x *fastReflection_SearchBlocksResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Blocks = append(x.Blocks, &types.Block{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blocks[len(x.Blocks)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Extension = append(x.Extension[:0], dAtA[iNdEx:postIndex]...)
+				if x.Extension == nil {
+					x.Extension = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -7586,6 +9745,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// GasBreakdown is the gas consumed by each ante decorator and msg of the tx.
+	// It is only reported by the simulations.
+	GasBreakdown *GasBreakdown `protobuf:"bytes,3,opt,name=gas_breakdown,json=gasBreakdown,proto3" json:"gas_breakdown,omitempty"`
 }
 
 func (x *GasInfo) Reset() {
@@ -7622,6 +9784,108 @@ func (x *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (x *GasInfo) GetGasBreakdown() *GasBreakdown {
+	if x != nil {
+		return x.GasBreakdown
+	}
+	return nil
+}
+
+// GasBreakdown is the gas consumed by the ante decorators and msgs of a tx. The
+// gas consumed by an ante decorator excludes the gas consumed by the decorators
+// it wraps.
+type GasBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AnteDecorators is the gas consumed by the ante decorators, in the order
+	// they were run.
+	AnteDecorators []*GasConsumption `protobuf:"bytes,1,rep,name=ante_decorators,json=anteDecorators,proto3" json:"ante_decorators,omitempty"`
+	// Msgs is the gas consumed by the msgs, in the order they were run.
+	Msgs []*GasConsumption `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (x *GasBreakdown) Reset() {
+	*x = GasBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasBreakdown) ProtoMessage() {}
+
+// Deprecated: Use GasBreakdown.ProtoReflect.Descriptor instead.
+func (*GasBreakdown) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GasBreakdown) GetAnteDecorators() []*GasConsumption {
+	if x != nil {
+		return x.AnteDecorators
+	}
+	return nil
+}
+
+func (x *GasBreakdown) GetMsgs() []*GasConsumption {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// GasConsumption is the gas consumed by an ante decorator or a msg.
+type GasConsumption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the type name of the ante decorator, or the type URL of the msg.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// GasUsed is the amount of gas consumed.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (x *GasConsumption) Reset() {
+	*x = GasConsumption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasConsumption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasConsumption) ProtoMessage() {}
+
+// Deprecated: Use GasConsumption.ProtoReflect.Descriptor instead.
+func (*GasConsumption) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GasConsumption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GasConsumption) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	state         protoimpl.MessageState
@@ -7649,7 +9913,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[5]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7663,7 +9927,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{7}
 }
 
 // Deprecated: Do not use.
@@ -7709,7 +9973,7 @@ type SimulationResponse struct {
 func (x *SimulationResponse) Reset() {
 	*x = SimulationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[6]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7723,7 +9987,7 @@ func (*SimulationResponse) ProtoMessage() {}
 
 // Deprecated: Use SimulationResponse.ProtoReflect.Descriptor instead.
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SimulationResponse) GetGasInfo() *GasInfo {
@@ -7756,7 +10020,7 @@ type MsgData struct {
 func (x *MsgData) Reset() {
 	*x = MsgData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[7]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7770,7 +10034,7 @@ func (*MsgData) ProtoMessage() {}
 
 // Deprecated: Use MsgData.ProtoReflect.Descriptor instead.
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MsgData) GetMsgType() string {
@@ -7807,7 +10071,7 @@ type TxMsgData struct {
 func (x *TxMsgData) Reset() {
 	*x = TxMsgData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[8]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7821,7 +10085,7 @@ func (*TxMsgData) ProtoMessage() {}
 
 // Deprecated: Use TxMsgData.ProtoReflect.Descriptor instead.
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{10}
 }
 
 // Deprecated: Do not use.
@@ -7862,7 +10126,7 @@ type SearchTxsResult struct {
 func (x *SearchTxsResult) Reset() {
 	*x = SearchTxsResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[9]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7876,7 +10140,7 @@ func (*SearchTxsResult) ProtoMessage() {}
 
 // Deprecated: Use SearchTxsResult.ProtoReflect.Descriptor instead.
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SearchTxsResult) GetTotalCount() uint64 {
@@ -7944,7 +10208,7 @@ type SearchBlocksResult struct {
 func (x *SearchBlocksResult) Reset() {
 	*x = SearchBlocksResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[10]
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7958,7 +10222,7 @@ func (*SearchBlocksResult) ProtoMessage() {}
 
 // Deprecated: Use SearchBlocksResult.ProtoReflect.Descriptor instead.
 func (*SearchBlocksResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchBlocksResult) GetTotalCount() int64 {
@@ -8003,6 +10267,91 @@ func (x *SearchBlocksResult) GetBlocks() []*types.Block {
 	return nil
 }
 
+// ComposedVoteExtension defines the vote extension composed by baseapp from the
+// vote extensions of the modules registered in its vote extension registry.
+type ComposedVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// extensions are the vote extensions of the modules, ordered by module name.
+	// The modules without vote extension are omitted.
+	Extensions []*ModuleVoteExtension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *ComposedVoteExtension) Reset() {
+	*x = ComposedVoteExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComposedVoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComposedVoteExtension) ProtoMessage() {}
+
+// Deprecated: Use ComposedVoteExtension.ProtoReflect.Descriptor instead.
+func (*ComposedVoteExtension) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ComposedVoteExtension) GetExtensions() []*ModuleVoteExtension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ModuleVoteExtension defines the vote extension of a module.
+type ModuleVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module is the name the module registered its vote extension handler under.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// extension is the vote extension of the module.
+	Extension []byte `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (x *ModuleVoteExtension) Reset() {
+	*x = ModuleVoteExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleVoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleVoteExtension) ProtoMessage() {}
+
+// Deprecated: Use ModuleVoteExtension.ProtoReflect.Descriptor instead.
+func (*ModuleVoteExtension) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ModuleVoteExtension) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ModuleVoteExtension) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
 var File_cosmos_base_abci_v1beta1_abci_proto protoreflect.FileDescriptor
 
 var file_cosmos_base_abci_v1beta1_abci_proto_rawDesc = []byte{
@@ -8067,87 +10416,119 @@ var file_cosmos_base_abci_v1beta1_abci_proto_rawDesc = []byte{
 	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x43, 0x0a, 0x07, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61,
-	0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73,
-	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73,
-	0x55, 0x73, 0x65, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x16, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x39, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x6d, 0x73,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x22, 0x96, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x90, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61,
+	0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x67, 0x61, 0x73, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x0c, 0x47, 0x61, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x57, 0x0a, 0x0f, 0x61, 0x6e, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61, 0x6e,
+	0x74, 0x65, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x04,
+	0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73,
+	0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65,
+	0x64, 0x22, 0xa9, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0d,
+	0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x96, 0x01,
+	0x0a, 0x12, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0xd0,
+	0xde, 0x1f, 0x01, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x40, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x3a, 0x06, 0x18, 0x01, 0x80, 0xdc, 0x20, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x54, 0x78, 0x4d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c,
+	0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x3a, 0x04, 0x80, 0xdc,
+	0x20, 0x01, 0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x78, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x03, 0x74, 0x78, 0x73, 0x3a, 0x04, 0x80, 0xdc, 0x20,
+	0x01, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01, 0x22, 0x72, 0x0a, 0x15,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01,
+	0x22, 0x51, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0x80,
+	0xdc, 0x20, 0x01, 0x42, 0xe7, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08, 0xc8, 0xde,
-	0x1f, 0x00, 0xd0, 0xde, 0x1f, 0x01, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62,
-	0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x40, 0x0a, 0x07, 0x4d, 0x73, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x3a, 0x06, 0x18, 0x01, 0x80, 0xdc, 0x20, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09,
-	0x54, 0x78, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x3a,
-	0x04, 0x80, 0xdc, 0x20, 0x01, 0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
-	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x03, 0x74, 0x78, 0x73, 0x3a, 0x04,
-	0x80, 0xdc, 0x20, 0x01, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01, 0x42,
-	0xe7, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x42, 0x09, 0x41, 0x62, 0x63, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x61, 0x62, 0x63, 0x69,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x41, 0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42,
-	0x61, 0x73, 0x65, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x41,
-	0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xd8, 0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x62, 0x63, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x62, 0x63,
+	0x69, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x41, 0xaa, 0x02,
+	0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x62, 0x63,
+	0x69, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x73, 0x65, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xd8, 0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8162,42 +10543,50 @@ func file_cosmos_base_abci_v1beta1_abci_proto_rawDescGZIP() []byte {
 	return file_cosmos_base_abci_v1beta1_abci_proto_rawDescData
 }
 
-var file_cosmos_base_abci_v1beta1_abci_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cosmos_base_abci_v1beta1_abci_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_cosmos_base_abci_v1beta1_abci_proto_goTypes = []interface{}{
-	(*TxResponse)(nil),         // 0: cosmos.base.abci.v1beta1.TxResponse
-	(*ABCIMessageLog)(nil),     // 1: cosmos.base.abci.v1beta1.ABCIMessageLog
-	(*StringEvent)(nil),        // 2: cosmos.base.abci.v1beta1.StringEvent
-	(*Attribute)(nil),          // 3: cosmos.base.abci.v1beta1.Attribute
-	(*GasInfo)(nil),            // 4: cosmos.base.abci.v1beta1.GasInfo
-	(*Result)(nil),             // 5: cosmos.base.abci.v1beta1.Result
-	(*SimulationResponse)(nil), // 6: cosmos.base.abci.v1beta1.SimulationResponse
-	(*MsgData)(nil),            // 7: cosmos.base.abci.v1beta1.MsgData
-	(*TxMsgData)(nil),          // 8: cosmos.base.abci.v1beta1.TxMsgData
-	(*SearchTxsResult)(nil),    // 9: cosmos.base.abci.v1beta1.SearchTxsResult
-	(*SearchBlocksResult)(nil), // 10: cosmos.base.abci.v1beta1.SearchBlocksResult
-	(*anypb.Any)(nil),          // 11: google.protobuf.Any
-	(*abci.Event)(nil),         // 12: tendermint.abci.Event
-	(*types.Block)(nil),        // 13: tendermint.types.Block
+	(*TxResponse)(nil),            // 0: cosmos.base.abci.v1beta1.TxResponse
+	(*ABCIMessageLog)(nil),        // 1: cosmos.base.abci.v1beta1.ABCIMessageLog
+	(*StringEvent)(nil),           // 2: cosmos.base.abci.v1beta1.StringEvent
+	(*Attribute)(nil),             // 3: cosmos.base.abci.v1beta1.Attribute
+	(*GasInfo)(nil),               // 4: cosmos.base.abci.v1beta1.GasInfo
+	(*GasBreakdown)(nil),          // 5: cosmos.base.abci.v1beta1.GasBreakdown
+	(*GasConsumption)(nil),        // 6: cosmos.base.abci.v1beta1.GasConsumption
+	(*Result)(nil),                // 7: cosmos.base.abci.v1beta1.Result
+	(*SimulationResponse)(nil),    // 8: cosmos.base.abci.v1beta1.SimulationResponse
+	(*MsgData)(nil),               // 9: cosmos.base.abci.v1beta1.MsgData
+	(*TxMsgData)(nil),             // 10: cosmos.base.abci.v1beta1.TxMsgData
+	(*SearchTxsResult)(nil),       // 11: cosmos.base.abci.v1beta1.SearchTxsResult
+	(*SearchBlocksResult)(nil),    // 12: cosmos.base.abci.v1beta1.SearchBlocksResult
+	(*ComposedVoteExtension)(nil), // 13: cosmos.base.abci.v1beta1.ComposedVoteExtension
+	(*ModuleVoteExtension)(nil),   // 14: cosmos.base.abci.v1beta1.ModuleVoteExtension
+	(*anypb.Any)(nil),             // 15: google.protobuf.Any
+	(*abci.Event)(nil),            // 16: tendermint.abci.Event
+	(*types.Block)(nil),           // 17: tendermint.types.Block
 }
 var file_cosmos_base_abci_v1beta1_abci_proto_depIdxs = []int32{
 	1,  // 0: cosmos.base.abci.v1beta1.TxResponse.logs:type_name -> cosmos.base.abci.v1beta1.ABCIMessageLog
-	11, // 1: cosmos.base.abci.v1beta1.TxResponse.tx:type_name -> google.protobuf.Any
-	12, // 2: cosmos.base.abci.v1beta1.TxResponse.events:type_name -> tendermint.abci.Event
+	15, // 1: cosmos.base.abci.v1beta1.TxResponse.tx:type_name -> google.protobuf.Any
+	16, // 2: cosmos.base.abci.v1beta1.TxResponse.events:type_name -> tendermint.abci.Event
 	2,  // 3: cosmos.base.abci.v1beta1.ABCIMessageLog.events:type_name -> cosmos.base.abci.v1beta1.StringEvent
 	3,  // 4: cosmos.base.abci.v1beta1.StringEvent.attributes:type_name -> cosmos.base.abci.v1beta1.Attribute
-	12, // 5: cosmos.base.abci.v1beta1.Result.events:type_name -> tendermint.abci.Event
-	11, // 6: cosmos.base.abci.v1beta1.Result.msg_responses:type_name -> google.protobuf.Any
-	4,  // 7: cosmos.base.abci.v1beta1.SimulationResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
-	5,  // 8: cosmos.base.abci.v1beta1.SimulationResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
-	7,  // 9: cosmos.base.abci.v1beta1.TxMsgData.data:type_name -> cosmos.base.abci.v1beta1.MsgData
-	11, // 10: cosmos.base.abci.v1beta1.TxMsgData.msg_responses:type_name -> google.protobuf.Any
-	0,  // 11: cosmos.base.abci.v1beta1.SearchTxsResult.txs:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	13, // 12: cosmos.base.abci.v1beta1.SearchBlocksResult.blocks:type_name -> tendermint.types.Block
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 5: cosmos.base.abci.v1beta1.GasInfo.gas_breakdown:type_name -> cosmos.base.abci.v1beta1.GasBreakdown
+	6,  // 6: cosmos.base.abci.v1beta1.GasBreakdown.ante_decorators:type_name -> cosmos.base.abci.v1beta1.GasConsumption
+	6,  // 7: cosmos.base.abci.v1beta1.GasBreakdown.msgs:type_name -> cosmos.base.abci.v1beta1.GasConsumption
+	16, // 8: cosmos.base.abci.v1beta1.Result.events:type_name -> tendermint.abci.Event
+	15, // 9: cosmos.base.abci.v1beta1.Result.msg_responses:type_name -> google.protobuf.Any
+	4,  // 10: cosmos.base.abci.v1beta1.SimulationResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
+	7,  // 11: cosmos.base.abci.v1beta1.SimulationResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
+	9,  // 12: cosmos.base.abci.v1beta1.TxMsgData.data:type_name -> cosmos.base.abci.v1beta1.MsgData
+	15, // 13: cosmos.base.abci.v1beta1.TxMsgData.msg_responses:type_name -> google.protobuf.Any
+	0,  // 14: cosmos.base.abci.v1beta1.SearchTxsResult.txs:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	17, // 15: cosmos.base.abci.v1beta1.SearchBlocksResult.blocks:type_name -> tendermint.types.Block
+	14, // 16: cosmos.base.abci.v1beta1.ComposedVoteExtension.extensions:type_name -> cosmos.base.abci.v1beta1.ModuleVoteExtension
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_base_abci_v1beta1_abci_proto_init() }
@@ -8267,7 +10656,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*GasBreakdown); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8279,7 +10668,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationResponse); i {
+			switch v := v.(*GasConsumption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8291,7 +10680,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgData); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8303,7 +10692,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxMsgData); i {
+			switch v := v.(*SimulationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8315,7 +10704,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchTxsResult); i {
+			switch v := v.(*MsgData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8327,6 +10716,30 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			}
 		}
 		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxMsgData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTxsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchBlocksResult); i {
 			case 0:
 				return &v.state
@@ -8338,6 +10751,30 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposedVoteExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_abci_v1beta1_abci_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleVoteExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8345,7 +10782,7 @@ func file_cosmos_base_abci_v1beta1_abci_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_base_abci_v1beta1_abci_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/admin/v1beta1/admin.proto
+
+package adminv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_LogLevel_FullMethodName              = "/cosmos.base.admin.v1beta1.Service/LogLevel"
+	Service_SetLogLevel_FullMethodName           = "/cosmos.base.admin.v1beta1.Service/SetLogLevel"
+	Service_CreateSnapshot_FullMethodName        = "/cosmos.base.admin.v1beta1.Service/CreateSnapshot"
+	Service_Drain_FullMethodName                 = "/cosmos.base.admin.v1beta1.Service/Drain"
+	Service_Compact_FullMethodName               = "/cosmos.base.admin.v1beta1.Service/Compact"
+	Service_RollbackPreflight_FullMethodName     = "/cosmos.base.admin.v1beta1.Service/RollbackPreflight"
+	Service_IAVLCaches_FullMethodName            = "/cosmos.base.admin.v1beta1.Service/IAVLCaches"
+	Service_ResizeIAVLCache_FullMethodName       = "/cosmos.base.admin.v1beta1.Service/ResizeIAVLCache"
+	Service_QueryRouteOverrides_FullMethodName   = "/cosmos.base.admin.v1beta1.Service/QueryRouteOverrides"
+	Service_SetQueryRouteOverride_FullMethodName = "/cosmos.base.admin.v1beta1.Service/SetQueryRouteOverride"
+	Service_RequestLogSettings_FullMethodName    = "/cosmos.base.admin.v1beta1.Service/RequestLogSettings"
+	Service_SetRequestLogSettings_FullMethodName = "/cosmos.base.admin.v1beta1.Service/SetRequestLogSettings"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// LogLevel returns the current log level of the node.
+	LogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// SetLogLevel changes the log level of the node.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the node state.
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	// Drain enables or disables the draining of the node. A draining node rejects
+	// the new requests made to its gRPC and REST API servers, so that it can be
+	// taken out of a load balancer before being stopped.
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	// Compact compacts the application database.
+	Compact(ctx context.Context, in *CompactRequest, opts ...grpc.CallOption) (*CompactResponse, error)
+	// RollbackPreflight reports whether the application state of the node can be
+	// rolled back by one height, without rolling it back.
+	RollbackPreflight(ctx context.Context, in *RollbackPreflightRequest, opts ...grpc.CallOption) (*RollbackPreflightResponse, error)
+	// IAVLCaches returns the size and the hit rate of the IAVL node cache of
+	// each store.
+	IAVLCaches(ctx context.Context, in *IAVLCachesRequest, opts ...grpc.CallOption) (*IAVLCachesResponse, error)
+	// ResizeIAVLCache changes the size of the IAVL node cache of a store. The
+	// cache is resized when the next block is committed.
+	ResizeIAVLCache(ctx context.Context, in *ResizeIAVLCacheRequest, opts ...grpc.CallOption) (*ResizeIAVLCacheResponse, error)
+	// QueryRouteOverrides returns the local overrides of the gRPC query routes
+	// of the node.
+	QueryRouteOverrides(ctx context.Context, in *QueryRouteOverridesRequest, opts ...grpc.CallOption) (*QueryRouteOverridesResponse, error)
+	// SetQueryRouteOverride disables or enables a gRPC query route on the node,
+	// whatever the state of the query circuit breaker of the chain, or removes
+	// the override of the route. The overrides are kept until the node is
+	// restarted.
+	SetQueryRouteOverride(ctx context.Context, in *SetQueryRouteOverrideRequest, opts ...grpc.CallOption) (*SetQueryRouteOverrideResponse, error)
+	// RequestLogSettings returns the settings of the logging of the requests
+	// served by the gRPC server of the node.
+	RequestLogSettings(ctx context.Context, in *RequestLogSettingsRequest, opts ...grpc.CallOption) (*RequestLogSettingsResponse, error)
+	// SetRequestLogSettings changes the settings of the logging of the requests
+	// served by the gRPC server of the node. The settings are kept until the
+	// node is restarted.
+	SetRequestLogSettings(ctx context.Context, in *SetRequestLogSettingsRequest, opts ...grpc.CallOption) (*SetRequestLogSettingsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) LogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, Service_LogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, Service_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, Service_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, Service_Drain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Compact(ctx context.Context, in *CompactRequest, opts ...grpc.CallOption) (*CompactResponse, error) {
+	out := new(CompactResponse)
+	err := c.cc.Invoke(ctx, Service_Compact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RollbackPreflight(ctx context.Context, in *RollbackPreflightRequest, opts ...grpc.CallOption) (*RollbackPreflightResponse, error) {
+	out := new(RollbackPreflightResponse)
+	err := c.cc.Invoke(ctx, Service_RollbackPreflight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) IAVLCaches(ctx context.Context, in *IAVLCachesRequest, opts ...grpc.CallOption) (*IAVLCachesResponse, error) {
+	out := new(IAVLCachesResponse)
+	err := c.cc.Invoke(ctx, Service_IAVLCaches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResizeIAVLCache(ctx context.Context, in *ResizeIAVLCacheRequest, opts ...grpc.CallOption) (*ResizeIAVLCacheResponse, error) {
+	out := new(ResizeIAVLCacheResponse)
+	err := c.cc.Invoke(ctx, Service_ResizeIAVLCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) QueryRouteOverrides(ctx context.Context, in *QueryRouteOverridesRequest, opts ...grpc.CallOption) (*QueryRouteOverridesResponse, error) {
+	out := new(QueryRouteOverridesResponse)
+	err := c.cc.Invoke(ctx, Service_QueryRouteOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetQueryRouteOverride(ctx context.Context, in *SetQueryRouteOverrideRequest, opts ...grpc.CallOption) (*SetQueryRouteOverrideResponse, error) {
+	out := new(SetQueryRouteOverrideResponse)
+	err := c.cc.Invoke(ctx, Service_SetQueryRouteOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RequestLogSettings(ctx context.Context, in *RequestLogSettingsRequest, opts ...grpc.CallOption) (*RequestLogSettingsResponse, error) {
+	out := new(RequestLogSettingsResponse)
+	err := c.cc.Invoke(ctx, Service_RequestLogSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetRequestLogSettings(ctx context.Context, in *SetRequestLogSettingsRequest, opts ...grpc.CallOption) (*SetRequestLogSettingsResponse, error) {
+	out := new(SetRequestLogSettingsResponse)
+	err := c.cc.Invoke(ctx, Service_SetRequestLogSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// LogLevel returns the current log level of the node.
+	LogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
+	// SetLogLevel changes the log level of the node.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the node state.
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	// Drain enables or disables the draining of the node. A draining node rejects
+	// the new requests made to its gRPC and REST API servers, so that it can be
+	// taken out of a load balancer before being stopped.
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	// Compact compacts the application database.
+	Compact(context.Context, *CompactRequest) (*CompactResponse, error)
+	// RollbackPreflight reports whether the application state of the node can be
+	// rolled back by one height, without rolling it back.
+	RollbackPreflight(context.Context, *RollbackPreflightRequest) (*RollbackPreflightResponse, error)
+	// IAVLCaches returns the size and the hit rate of the IAVL node cache of
+	// each store.
+	IAVLCaches(context.Context, *IAVLCachesRequest) (*IAVLCachesResponse, error)
+	// ResizeIAVLCache changes the size of the IAVL node cache of a store. The
+	// cache is resized when the next block is committed.
+	ResizeIAVLCache(context.Context, *ResizeIAVLCacheRequest) (*ResizeIAVLCacheResponse, error)
+	// QueryRouteOverrides returns the local overrides of the gRPC query routes
+	// of the node.
+	QueryRouteOverrides(context.Context, *QueryRouteOverridesRequest) (*QueryRouteOverridesResponse, error)
+	// SetQueryRouteOverride disables or enables a gRPC query route on the node,
+	// whatever the state of the query circuit breaker of the chain, or removes
+	// the override of the route. The overrides are kept until the node is
+	// restarted.
+	SetQueryRouteOverride(context.Context, *SetQueryRouteOverrideRequest) (*SetQueryRouteOverrideResponse, error)
+	// RequestLogSettings returns the settings of the logging of the requests
+	// served by the gRPC server of the node.
+	RequestLogSettings(context.Context, *RequestLogSettingsRequest) (*RequestLogSettingsResponse, error)
+	// SetRequestLogSettings changes the settings of the logging of the requests
+	// served by the gRPC server of the node. The settings are kept until the
+	// node is restarted.
+	SetRequestLogSettings(context.Context, *SetRequestLogSettingsRequest) (*SetRequestLogSettingsResponse, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) LogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevel not implemented")
+}
+func (UnimplementedServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedServiceServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedServiceServer) Drain(context.Context, *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedServiceServer) Compact(context.Context, *CompactRequest) (*CompactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compact not implemented")
+}
+func (UnimplementedServiceServer) RollbackPreflight(context.Context, *RollbackPreflightRequest) (*RollbackPreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPreflight not implemented")
+}
+func (UnimplementedServiceServer) IAVLCaches(context.Context, *IAVLCachesRequest) (*IAVLCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IAVLCaches not implemented")
+}
+func (UnimplementedServiceServer) ResizeIAVLCache(context.Context, *ResizeIAVLCacheRequest) (*ResizeIAVLCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeIAVLCache not implemented")
+}
+func (UnimplementedServiceServer) QueryRouteOverrides(context.Context, *QueryRouteOverridesRequest) (*QueryRouteOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRouteOverrides not implemented")
+}
+func (UnimplementedServiceServer) SetQueryRouteOverride(context.Context, *SetQueryRouteOverrideRequest) (*SetQueryRouteOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueryRouteOverride not implemented")
+}
+func (UnimplementedServiceServer) RequestLogSettings(context.Context, *RequestLogSettingsRequest) (*RequestLogSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLogSettings not implemented")
+}
+func (UnimplementedServiceServer) SetRequestLogSettings(context.Context, *SetRequestLogSettingsRequest) (*SetRequestLogSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequestLogSettings not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_LogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_LogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Compact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Compact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Compact(ctx, req.(*CompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RollbackPreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RollbackPreflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RollbackPreflight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RollbackPreflight(ctx, req.(*RollbackPreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_IAVLCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IAVLCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).IAVLCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_IAVLCaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).IAVLCaches(ctx, req.(*IAVLCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResizeIAVLCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeIAVLCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResizeIAVLCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResizeIAVLCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResizeIAVLCache(ctx, req.(*ResizeIAVLCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_QueryRouteOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).QueryRouteOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_QueryRouteOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).QueryRouteOverrides(ctx, req.(*QueryRouteOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetQueryRouteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueryRouteOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetQueryRouteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetQueryRouteOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetQueryRouteOverride(ctx, req.(*SetQueryRouteOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RequestLogSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLogSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RequestLogSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RequestLogSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RequestLogSettings(ctx, req.(*RequestLogSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetRequestLogSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequestLogSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetRequestLogSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetRequestLogSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetRequestLogSettings(ctx, req.(*SetRequestLogSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.admin.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogLevel",
+			Handler:    _Service_LogLevel_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Service_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Service_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Service_Drain_Handler,
+		},
+		{
+			MethodName: "Compact",
+			Handler:    _Service_Compact_Handler,
+		},
+		{
+			MethodName: "RollbackPreflight",
+			Handler:    _Service_RollbackPreflight_Handler,
+		},
+		{
+			MethodName: "IAVLCaches",
+			Handler:    _Service_IAVLCaches_Handler,
+		},
+		{
+			MethodName: "ResizeIAVLCache",
+			Handler:    _Service_ResizeIAVLCache_Handler,
+		},
+		{
+			MethodName: "QueryRouteOverrides",
+			Handler:    _Service_QueryRouteOverrides_Handler,
+		},
+		{
+			MethodName: "SetQueryRouteOverride",
+			Handler:    _Service_SetQueryRouteOverride_Handler,
+		},
+		{
+			MethodName: "RequestLogSettings",
+			Handler:    _Service_RequestLogSettings_Handler,
+		},
+		{
+			MethodName: "SetRequestLogSettings",
+			Handler:    _Service_SetRequestLogSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/admin/v1beta1/admin.proto",
+}
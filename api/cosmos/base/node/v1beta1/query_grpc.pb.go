@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
-	Service_Status_FullMethodName = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Config_FullMethodName      = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_Status_FullMethodName      = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_TxStatus_FullMethodName    = "/cosmos.base.node.v1beta1.Service/TxStatus"
+	Service_RouteStatus_FullMethodName = "/cosmos.base.node.v1beta1.Service/RouteStatus"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +33,14 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// TxStatus queries for the status of a tx in the lifecycle of the mempool of
+	// the node. Unlike the tx index, it also reports the txs which are pending or
+	// were evicted from the mempool.
+	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// RouteStatus queries for the Msg routes currently disabled by the circuit
+	// breaker, and the query routes which exceeded the query gas limit of the
+	// node since it started.
+	RouteStatus(ctx context.Context, in *RouteStatusRequest, opts ...grpc.CallOption) (*RouteStatusResponse, error)
 }
 
 type serviceClient struct {
@@ -59,6 +69,24 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, Service_TxStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RouteStatus(ctx context.Context, in *RouteStatusRequest, opts ...grpc.CallOption) (*RouteStatusResponse, error) {
+	out := new(RouteStatusResponse)
+	err := c.cc.Invoke(ctx, Service_RouteStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -67,6 +95,14 @@ type ServiceServer interface {
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// TxStatus queries for the status of a tx in the lifecycle of the mempool of
+	// the node. Unlike the tx index, it also reports the txs which are pending or
+	// were evicted from the mempool.
+	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// RouteStatus queries for the Msg routes currently disabled by the circuit
+	// breaker, and the query routes which exceeded the query gas limit of the
+	// node since it started.
+	RouteStatus(context.Context, *RouteStatusRequest) (*RouteStatusResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +116,12 @@ func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*Conf
 func (UnimplementedServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedServiceServer) TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (UnimplementedServiceServer) RouteStatus(context.Context, *RouteStatusRequest) (*RouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteStatus not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +171,42 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TxStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxStatus(ctx, req.(*TxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RouteStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RouteStatus(ctx, req.(*RouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +222,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Service_TxStatus_Handler,
+		},
+		{
+			MethodName: "RouteStatus",
+			Handler:    _Service_RouteStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
-	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
-	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_Account_FullMethodName            = "/cosmos.circuit.v1.Query/Account"
+	Query_Accounts_FullMethodName           = "/cosmos.circuit.v1.Query/Accounts"
+	Query_DisabledList_FullMethodName       = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_ReadOnlyMode_FullMethodName       = "/cosmos.circuit.v1.Query/ReadOnlyMode"
+	Query_RateLimits_FullMethodName         = "/cosmos.circuit.v1.Query/RateLimits"
+	Query_DisabledNFTs_FullMethodName       = "/cosmos.circuit.v1.Query/DisabledNFTs"
+	Query_DisabledQueries_FullMethodName    = "/cosmos.circuit.v1.Query/DisabledQueries"
+	Query_TripCapabilities_FullMethodName   = "/cosmos.circuit.v1.Query/TripCapabilities"
+	Query_RecoveryAccount_FullMethodName    = "/cosmos.circuit.v1.Query/RecoveryAccount"
+	Query_CheckAuthorization_FullMethodName = "/cosmos.circuit.v1.Query/CheckAuthorization"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +41,24 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// ReadOnlyMode returns whether the chain is in read-only mode and which
+	// Msg type URLs are exempt from it.
+	ReadOnlyMode(ctx context.Context, in *QueryReadOnlyModeRequest, opts ...grpc.CallOption) (*ReadOnlyModeResponse, error)
+	// RateLimits returns the rate limited Msg type URLs.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	// DisabledNFTs returns the paused nft classes and nfts.
+	DisabledNFTs(ctx context.Context, in *QueryDisabledNFTsRequest, opts ...grpc.CallOption) (*DisabledNFTsResponse, error)
+	// DisabledQueries returns the disabled gRPC query routes and patterns.
+	DisabledQueries(ctx context.Context, in *QueryDisabledQueriesRequest, opts ...grpc.CallOption) (*DisabledQueriesResponse, error)
+	// TripCapabilities returns the capabilities of the modules to trip the
+	// circuit breaker.
+	TripCapabilities(ctx context.Context, in *QueryTripCapabilitiesRequest, opts ...grpc.CallOption) (*TripCapabilitiesResponse, error)
+	// RecoveryAccount returns the account which can only reset the circuit
+	// breaker, set at genesis.
+	RecoveryAccount(ctx context.Context, in *QueryRecoveryAccountRequest, opts ...grpc.CallOption) (*RecoveryAccountResponse, error)
+	// CheckAuthorization returns whether an account may trip or reset the
+	// circuit breaker for a Msg type URL, without submitting a tx.
+	CheckAuthorization(ctx context.Context, in *QueryCheckAuthorizationRequest, opts ...grpc.CallOption) (*CheckAuthorizationResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +96,69 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) ReadOnlyMode(ctx context.Context, in *QueryReadOnlyModeRequest, opts ...grpc.CallOption) (*ReadOnlyModeResponse, error) {
+	out := new(ReadOnlyModeResponse)
+	err := c.cc.Invoke(ctx, Query_ReadOnlyMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledNFTs(ctx context.Context, in *QueryDisabledNFTsRequest, opts ...grpc.CallOption) (*DisabledNFTsResponse, error) {
+	out := new(DisabledNFTsResponse)
+	err := c.cc.Invoke(ctx, Query_DisabledNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledQueries(ctx context.Context, in *QueryDisabledQueriesRequest, opts ...grpc.CallOption) (*DisabledQueriesResponse, error) {
+	out := new(DisabledQueriesResponse)
+	err := c.cc.Invoke(ctx, Query_DisabledQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripCapabilities(ctx context.Context, in *QueryTripCapabilitiesRequest, opts ...grpc.CallOption) (*TripCapabilitiesResponse, error) {
+	out := new(TripCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Query_TripCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryAccount(ctx context.Context, in *QueryRecoveryAccountRequest, opts ...grpc.CallOption) (*RecoveryAccountResponse, error) {
+	out := new(RecoveryAccountResponse)
+	err := c.cc.Invoke(ctx, Query_RecoveryAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckAuthorization(ctx context.Context, in *QueryCheckAuthorizationRequest, opts ...grpc.CallOption) (*CheckAuthorizationResponse, error) {
+	out := new(CheckAuthorizationResponse)
+	err := c.cc.Invoke(ctx, Query_CheckAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +169,24 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// ReadOnlyMode returns whether the chain is in read-only mode and which
+	// Msg type URLs are exempt from it.
+	ReadOnlyMode(context.Context, *QueryReadOnlyModeRequest) (*ReadOnlyModeResponse, error)
+	// RateLimits returns the rate limited Msg type URLs.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*RateLimitsResponse, error)
+	// DisabledNFTs returns the paused nft classes and nfts.
+	DisabledNFTs(context.Context, *QueryDisabledNFTsRequest) (*DisabledNFTsResponse, error)
+	// DisabledQueries returns the disabled gRPC query routes and patterns.
+	DisabledQueries(context.Context, *QueryDisabledQueriesRequest) (*DisabledQueriesResponse, error)
+	// TripCapabilities returns the capabilities of the modules to trip the
+	// circuit breaker.
+	TripCapabilities(context.Context, *QueryTripCapabilitiesRequest) (*TripCapabilitiesResponse, error)
+	// RecoveryAccount returns the account which can only reset the circuit
+	// breaker, set at genesis.
+	RecoveryAccount(context.Context, *QueryRecoveryAccountRequest) (*RecoveryAccountResponse, error)
+	// CheckAuthorization returns whether an account may trip or reset the
+	// circuit breaker for a Msg type URL, without submitting a tx.
+	CheckAuthorization(context.Context, *QueryCheckAuthorizationRequest) (*CheckAuthorizationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +203,27 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) ReadOnlyMode(context.Context, *QueryReadOnlyModeRequest) (*ReadOnlyModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadOnlyMode not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) DisabledNFTs(context.Context, *QueryDisabledNFTsRequest) (*DisabledNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledNFTs not implemented")
+}
+func (UnimplementedQueryServer) DisabledQueries(context.Context, *QueryDisabledQueriesRequest) (*DisabledQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledQueries not implemented")
+}
+func (UnimplementedQueryServer) TripCapabilities(context.Context, *QueryTripCapabilitiesRequest) (*TripCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCapabilities not implemented")
+}
+func (UnimplementedQueryServer) RecoveryAccount(context.Context, *QueryRecoveryAccountRequest) (*RecoveryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryAccount not implemented")
+}
+func (UnimplementedQueryServer) CheckAuthorization(context.Context, *QueryCheckAuthorizationRequest) (*CheckAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAuthorization not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +291,132 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReadOnlyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadOnlyModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReadOnlyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReadOnlyMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReadOnlyMode(ctx, req.(*QueryReadOnlyModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DisabledNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledNFTs(ctx, req.(*QueryDisabledNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DisabledQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledQueries(ctx, req.(*QueryDisabledQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripCapabilities(ctx, req.(*QueryTripCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecoveryAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryAccount(ctx, req.(*QueryRecoveryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckAuthorization(ctx, req.(*QueryCheckAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +436,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "ReadOnlyMode",
+			Handler:    _Query_ReadOnlyMode_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "DisabledNFTs",
+			Handler:    _Query_DisabledNFTs_Handler,
+		},
+		{
+			MethodName: "DisabledQueries",
+			Handler:    _Query_DisabledQueries_Handler,
+		},
+		{
+			MethodName: "TripCapabilities",
+			Handler:    _Query_TripCapabilities_Handler,
+		},
+		{
+			MethodName: "RecoveryAccount",
+			Handler:    _Query_RecoveryAccount_Handler,
+		},
+		{
+			MethodName: "CheckAuthorization",
+			Handler:    _Query_CheckAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -22,6 +22,15 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_TripAll_FullMethodName                 = "/cosmos.circuit.v1.Msg/TripAll"
+	Msg_ResetAll_FullMethodName                = "/cosmos.circuit.v1.Msg/ResetAll"
+	Msg_SetRateLimit_FullMethodName            = "/cosmos.circuit.v1.Msg/SetRateLimit"
+	Msg_RemoveRateLimit_FullMethodName         = "/cosmos.circuit.v1.Msg/RemoveRateLimit"
+	Msg_TripNFT_FullMethodName                 = "/cosmos.circuit.v1.Msg/TripNFT"
+	Msg_ResetNFT_FullMethodName                = "/cosmos.circuit.v1.Msg/ResetNFT"
+	Msg_SetTripCapability_FullMethodName       = "/cosmos.circuit.v1.Msg/SetTripCapability"
+	Msg_TripQuery_FullMethodName               = "/cosmos.circuit.v1.Msg/TripQuery"
+	Msg_ResetQuery_FullMethodName              = "/cosmos.circuit.v1.Msg/ResetQuery"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +45,33 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// TripAll puts the chain in read-only mode: processing of every Msg whose
+	// type URL is not exempted is paused, while queries remain functional.
+	TripAll(ctx context.Context, in *MsgTripAll, opts ...grpc.CallOption) (*MsgTripAllResponse, error)
+	// ResetAll lifts the read-only mode enabled using TripAll.
+	ResetAll(ctx context.Context, in *MsgResetAll, opts ...grpc.CallOption) (*MsgResetAllResponse, error)
+	// SetRateLimit throttles the processing of Msg's in the state machine: at
+	// most a given number of Msg's of each type URL are processed per window of
+	// blocks.
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit lifts the rate limits set using SetRateLimit.
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	// TripNFT pauses the processing of the nft Msg's acting on a nft class, or
+	// on some nfts of a class.
+	TripNFT(ctx context.Context, in *MsgTripNFT, opts ...grpc.CallOption) (*MsgTripNFTResponse, error)
+	// ResetNFT resumes the processing of the nft Msg's paused using TripNFT.
+	ResetNFT(ctx context.Context, in *MsgResetNFT, opts ...grpc.CallOption) (*MsgResetNFTResponse, error)
+	// SetTripCapability grants a module the capability to trip the circuit
+	// breaker for some Msg type URLs, or revokes it. It can only be executed by
+	// the module authority, usually governance.
+	SetTripCapability(ctx context.Context, in *MsgSetTripCapability, opts ...grpc.CallOption) (*MsgSetTripCapabilityResponse, error)
+	// TripQuery disables some gRPC query routes on every node, e.g. the
+	// expensive queries slowing down the nodes of the RPC providers. The
+	// queries made by the modules during the execution of the txs are never
+	// disabled.
+	TripQuery(ctx context.Context, in *MsgTripQuery, opts ...grpc.CallOption) (*MsgTripQueryResponse, error)
+	// ResetQuery enables the gRPC query routes disabled using TripQuery.
+	ResetQuery(ctx context.Context, in *MsgResetQuery, opts ...grpc.CallOption) (*MsgResetQueryResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +109,87 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) TripAll(ctx context.Context, in *MsgTripAll, opts ...grpc.CallOption) (*MsgTripAllResponse, error) {
+	out := new(MsgTripAllResponse)
+	err := c.cc.Invoke(ctx, Msg_TripAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetAll(ctx context.Context, in *MsgResetAll, opts ...grpc.CallOption) (*MsgResetAllResponse, error) {
+	out := new(MsgResetAllResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TripNFT(ctx context.Context, in *MsgTripNFT, opts ...grpc.CallOption) (*MsgTripNFTResponse, error) {
+	out := new(MsgTripNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_TripNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetNFT(ctx context.Context, in *MsgResetNFT, opts ...grpc.CallOption) (*MsgResetNFTResponse, error) {
+	out := new(MsgResetNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTripCapability(ctx context.Context, in *MsgSetTripCapability, opts ...grpc.CallOption) (*MsgSetTripCapabilityResponse, error) {
+	out := new(MsgSetTripCapabilityResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTripCapability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TripQuery(ctx context.Context, in *MsgTripQuery, opts ...grpc.CallOption) (*MsgTripQueryResponse, error) {
+	out := new(MsgTripQueryResponse)
+	err := c.cc.Invoke(ctx, Msg_TripQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetQuery(ctx context.Context, in *MsgResetQuery, opts ...grpc.CallOption) (*MsgResetQueryResponse, error) {
+	out := new(MsgResetQueryResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +202,33 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// TripAll puts the chain in read-only mode: processing of every Msg whose
+	// type URL is not exempted is paused, while queries remain functional.
+	TripAll(context.Context, *MsgTripAll) (*MsgTripAllResponse, error)
+	// ResetAll lifts the read-only mode enabled using TripAll.
+	ResetAll(context.Context, *MsgResetAll) (*MsgResetAllResponse, error)
+	// SetRateLimit throttles the processing of Msg's in the state machine: at
+	// most a given number of Msg's of each type URL are processed per window of
+	// blocks.
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit lifts the rate limits set using SetRateLimit.
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	// TripNFT pauses the processing of the nft Msg's acting on a nft class, or
+	// on some nfts of a class.
+	TripNFT(context.Context, *MsgTripNFT) (*MsgTripNFTResponse, error)
+	// ResetNFT resumes the processing of the nft Msg's paused using TripNFT.
+	ResetNFT(context.Context, *MsgResetNFT) (*MsgResetNFTResponse, error)
+	// SetTripCapability grants a module the capability to trip the circuit
+	// breaker for some Msg type URLs, or revokes it. It can only be executed by
+	// the module authority, usually governance.
+	SetTripCapability(context.Context, *MsgSetTripCapability) (*MsgSetTripCapabilityResponse, error)
+	// TripQuery disables some gRPC query routes on every node, e.g. the
+	// expensive queries slowing down the nodes of the RPC providers. The
+	// queries made by the modules during the execution of the txs are never
+	// disabled.
+	TripQuery(context.Context, *MsgTripQuery) (*MsgTripQueryResponse, error)
+	// ResetQuery enables the gRPC query routes disabled using TripQuery.
+	ResetQuery(context.Context, *MsgResetQuery) (*MsgResetQueryResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +245,33 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) TripAll(context.Context, *MsgTripAll) (*MsgTripAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripAll not implemented")
+}
+func (UnimplementedMsgServer) ResetAll(context.Context, *MsgResetAll) (*MsgResetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetAll not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (UnimplementedMsgServer) TripNFT(context.Context, *MsgTripNFT) (*MsgTripNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripNFT not implemented")
+}
+func (UnimplementedMsgServer) ResetNFT(context.Context, *MsgResetNFT) (*MsgResetNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetNFT not implemented")
+}
+func (UnimplementedMsgServer) SetTripCapability(context.Context, *MsgSetTripCapability) (*MsgSetTripCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTripCapability not implemented")
+}
+func (UnimplementedMsgServer) TripQuery(context.Context, *MsgTripQuery) (*MsgTripQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripQuery not implemented")
+}
+func (UnimplementedMsgServer) ResetQuery(context.Context, *MsgResetQuery) (*MsgResetQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetQuery not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +339,168 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TripAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TripAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripAll(ctx, req.(*MsgTripAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetAll(ctx, req.(*MsgResetAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TripNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TripNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripNFT(ctx, req.(*MsgTripNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetNFT(ctx, req.(*MsgResetNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTripCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTripCapability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTripCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTripCapability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTripCapability(ctx, req.(*MsgSetTripCapability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TripQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TripQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripQuery(ctx, req.(*MsgTripQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetQuery(ctx, req.(*MsgResetQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +520,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "TripAll",
+			Handler:    _Msg_TripAll_Handler,
+		},
+		{
+			MethodName: "ResetAll",
+			Handler:    _Msg_ResetAll_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "TripNFT",
+			Handler:    _Msg_TripNFT_Handler,
+		},
+		{
+			MethodName: "ResetNFT",
+			Handler:    _Msg_ResetNFT_Handler,
+		},
+		{
+			MethodName: "SetTripCapability",
+			Handler:    _Msg_SetTripCapability_Handler,
+		},
+		{
+			MethodName: "TripQuery",
+			Handler:    _Msg_TripQuery_Handler,
+		},
+		{
+			MethodName: "ResetQuery",
+			Handler:    _Msg_ResetQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
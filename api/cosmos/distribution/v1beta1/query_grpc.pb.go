@@ -23,6 +23,7 @@ const (
 	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
 	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
 	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorAPR_FullMethodName                = "/cosmos.distribution.v1beta1.Query/ValidatorAPR"
 	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
 	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
@@ -43,6 +44,9 @@ type QueryClient interface {
 	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// ValidatorAPR queries the current nominal APR of the delegations to a
+	// validator.
+	ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -102,6 +106,15 @@ func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidato
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error) {
+	out := new(QueryValidatorAPRResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAPR_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
 	out := new(QueryValidatorSlashesResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorSlashes_FullMethodName, in, out, opts...)
@@ -168,6 +181,9 @@ type QueryServer interface {
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorAPR queries the current nominal APR of the delegations to a
+	// validator.
+	ValidatorAPR(context.Context, *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -200,6 +216,9 @@ func (UnimplementedQueryServer) ValidatorOutstandingRewards(context.Context, *Qu
 func (UnimplementedQueryServer) ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
 }
+func (UnimplementedQueryServer) ValidatorAPR(context.Context, *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAPR not implemented")
+}
 func (UnimplementedQueryServer) ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
 }
@@ -303,6 +322,24 @@ func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAPR(ctx, req.(*QueryValidatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorSlashesRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +471,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorCommission",
 			Handler:    _Query_ValidatorCommission_Handler,
 		},
+		{
+			MethodName: "ValidatorAPR",
+			Handler:    _Query_ValidatorAPR_Handler,
+		},
 		{
 			MethodName: "ValidatorSlashes",
 			Handler:    _Query_ValidatorSlashes_Handler,
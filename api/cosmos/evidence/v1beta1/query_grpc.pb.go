@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName         = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName      = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_ValidateEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/ValidateEvidence"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,13 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// ValidateEvidence pre-validates evidence before it is submitted. It checks
+	// the evidence structure, that it is handled by the app and was not submitted
+	// yet, that its height is within the bounds accepted by the chain and, for
+	// evidence against a validator, that the validator can still be punished.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidateEvidence(ctx context.Context, in *QueryValidateEvidenceRequest, opts ...grpc.CallOption) (*QueryValidateEvidenceResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +67,15 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) ValidateEvidence(ctx context.Context, in *QueryValidateEvidenceRequest, opts ...grpc.CallOption) (*QueryValidateEvidenceResponse, error) {
+	out := new(QueryValidateEvidenceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidateEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +84,13 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// ValidateEvidence pre-validates evidence before it is submitted. It checks
+	// the evidence structure, that it is handled by the app and was not submitted
+	// yet, that its height is within the bounds accepted by the chain and, for
+	// evidence against a validator, that the validator can still be punished.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidateEvidence(context.Context, *QueryValidateEvidenceRequest) (*QueryValidateEvidenceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +104,9 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) ValidateEvidence(context.Context, *QueryValidateEvidenceRequest) (*QueryValidateEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateEvidence not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +156,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidateEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidateEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateEvidence(ctx, req.(*QueryValidateEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +189,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "ValidateEvidence",
+			Handler:    _Query_ValidateEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
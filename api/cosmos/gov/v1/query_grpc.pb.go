@@ -21,15 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName      = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName          = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName         = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName              = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName             = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName            = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName           = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName          = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName       = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalTemplates_FullMethodName = "/cosmos.gov.v1.Query/ProposalTemplates"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +55,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalTemplates queries the templates of the common proposal messages,
+	// which are validated against the template upon submission.
+	ProposalTemplates(ctx context.Context, in *QueryProposalTemplatesRequest, opts ...grpc.CallOption) (*QueryProposalTemplatesResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +149,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalTemplates(ctx context.Context, in *QueryProposalTemplatesRequest, opts ...grpc.CallOption) (*QueryProposalTemplatesResponse, error) {
+	out := new(QueryProposalTemplatesResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +180,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalTemplates queries the templates of the common proposal messages,
+	// which are validated against the template upon submission.
+	ProposalTemplates(context.Context, *QueryProposalTemplatesRequest) (*QueryProposalTemplatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +217,9 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalTemplates(context.Context, *QueryProposalTemplatesRequest) (*QueryProposalTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTemplates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +395,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTemplates(ctx, req.(*QueryProposalTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +456,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalTemplates",
+			Handler:    _Query_ProposalTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/nft/v1beta1/auction.proto
+
+package nftv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuctionMsg_CreateAuction_FullMethodName = "/cosmos.nft.v1beta1.AuctionMsg/CreateAuction"
+	AuctionMsg_Bid_FullMethodName           = "/cosmos.nft.v1beta1.AuctionMsg/Bid"
+	AuctionMsg_CancelAuction_FullMethodName = "/cosmos.nft.v1beta1.AuctionMsg/CancelAuction"
+)
+
+// AuctionMsgClient is the client API for AuctionMsg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuctionMsgClient interface {
+	// CreateAuction defines a method for the owner of a nft to put it up for auction. The nft is escrowed by the module
+	// until the auction is settled or canceled.
+	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
+	// Bid defines a method to bid on an auction. The bid is escrowed by the module until it is outbid or the auction is
+	// settled. A bid on a dutch auction buys the nft at the current price.
+	Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgBidResponse, error)
+	// CancelAuction defines a method for the seller of a nft to cancel its auction, as long as it has no bid.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+}
+
+type auctionMsgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuctionMsgClient(cc grpc.ClientConnInterface) AuctionMsgClient {
+	return &auctionMsgClient{cc}
+}
+
+func (c *auctionMsgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
+	out := new(MsgCreateAuctionResponse)
+	err := c.cc.Invoke(ctx, AuctionMsg_CreateAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionMsgClient) Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgBidResponse, error) {
+	out := new(MsgBidResponse)
+	err := c.cc.Invoke(ctx, AuctionMsg_Bid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionMsgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, AuctionMsg_CancelAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuctionMsgServer is the server API for AuctionMsg service.
+// All implementations must embed UnimplementedAuctionMsgServer
+// for forward compatibility
+type AuctionMsgServer interface {
+	// CreateAuction defines a method for the owner of a nft to put it up for auction. The nft is escrowed by the module
+	// until the auction is settled or canceled.
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	// Bid defines a method to bid on an auction. The bid is escrowed by the module until it is outbid or the auction is
+	// settled. A bid on a dutch auction buys the nft at the current price.
+	Bid(context.Context, *MsgBid) (*MsgBidResponse, error)
+	// CancelAuction defines a method for the seller of a nft to cancel its auction, as long as it has no bid.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	mustEmbedUnimplementedAuctionMsgServer()
+}
+
+// UnimplementedAuctionMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedAuctionMsgServer struct {
+}
+
+func (UnimplementedAuctionMsgServer) CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (UnimplementedAuctionMsgServer) Bid(context.Context, *MsgBid) (*MsgBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (UnimplementedAuctionMsgServer) CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (UnimplementedAuctionMsgServer) mustEmbedUnimplementedAuctionMsgServer() {}
+
+// UnsafeAuctionMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuctionMsgServer will
+// result in compilation errors.
+type UnsafeAuctionMsgServer interface {
+	mustEmbedUnimplementedAuctionMsgServer()
+}
+
+func RegisterAuctionMsgServer(s grpc.ServiceRegistrar, srv AuctionMsgServer) {
+	s.RegisterService(&AuctionMsg_ServiceDesc, srv)
+}
+
+func _AuctionMsg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionMsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionMsg_CreateAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionMsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionMsg_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionMsgServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionMsg_Bid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionMsgServer).Bid(ctx, req.(*MsgBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionMsg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionMsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionMsg_CancelAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionMsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuctionMsg_ServiceDesc is the grpc.ServiceDesc for AuctionMsg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuctionMsg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.nft.v1beta1.AuctionMsg",
+	HandlerType: (*AuctionMsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAuction",
+			Handler:    _AuctionMsg_CreateAuction_Handler,
+		},
+		{
+			MethodName: "Bid",
+			Handler:    _AuctionMsg_Bid_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _AuctionMsg_CancelAuction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/nft/v1beta1/auction.proto",
+}
+
+const (
+	AuctionQuery_Auction_FullMethodName  = "/cosmos.nft.v1beta1.AuctionQuery/Auction"
+	AuctionQuery_Auctions_FullMethodName = "/cosmos.nft.v1beta1.AuctionQuery/Auctions"
+)
+
+// AuctionQueryClient is the client API for AuctionQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuctionQueryClient interface {
+	// Auction queries an auction based on its id, with the minimum amount of the next bid
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// Auctions queries all the open auctions, or the auctions of a seller
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+}
+
+type auctionQueryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuctionQueryClient(cc grpc.ClientConnInterface) AuctionQueryClient {
+	return &auctionQueryClient{cc}
+}
+
+func (c *auctionQueryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, AuctionQuery_Auction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionQueryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	err := c.cc.Invoke(ctx, AuctionQuery_Auctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuctionQueryServer is the server API for AuctionQuery service.
+// All implementations must embed UnimplementedAuctionQueryServer
+// for forward compatibility
+type AuctionQueryServer interface {
+	// Auction queries an auction based on its id, with the minimum amount of the next bid
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// Auctions queries all the open auctions, or the auctions of a seller
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	mustEmbedUnimplementedAuctionQueryServer()
+}
+
+// UnimplementedAuctionQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedAuctionQueryServer struct {
+}
+
+func (UnimplementedAuctionQueryServer) Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (UnimplementedAuctionQueryServer) Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+}
+func (UnimplementedAuctionQueryServer) mustEmbedUnimplementedAuctionQueryServer() {}
+
+// UnsafeAuctionQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuctionQueryServer will
+// result in compilation errors.
+type UnsafeAuctionQueryServer interface {
+	mustEmbedUnimplementedAuctionQueryServer()
+}
+
+func RegisterAuctionQueryServer(s grpc.ServiceRegistrar, srv AuctionQueryServer) {
+	s.RegisterService(&AuctionQuery_ServiceDesc, srv)
+}
+
+func _AuctionQuery_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionQueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionQuery_Auction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionQueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionQuery_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionQueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionQuery_Auctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionQueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuctionQuery_ServiceDesc is the grpc.ServiceDesc for AuctionQuery service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuctionQuery_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.nft.v1beta1.AuctionQuery",
+	HandlerType: (*AuctionQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Auction",
+			Handler:    _AuctionQuery_Auction_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _AuctionQuery_Auctions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/nft/v1beta1/auction.proto",
+}
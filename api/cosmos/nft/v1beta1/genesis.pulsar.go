@@ -4,6 +4,7 @@ package nftv1beta1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -114,436 +115,1900 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState         protoreflect.MessageDescriptor
-	fd_GenesisState_classes protoreflect.FieldDescriptor
-	fd_GenesisState_entries protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
 
-func init() {
-	file_cosmos_nft_v1beta1_genesis_proto_init()
-	md_GenesisState = File_cosmos_nft_v1beta1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_classes = md_GenesisState.Fields().ByName("classes")
-	fd_GenesisState_entries = md_GenesisState.Fields().ByName("entries")
+type _GenesisState_3_list struct {
+	list *[]*ClassDataSchema
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
-
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_nft_v1beta1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassDataSchema)
+	(*x.list)[i] = concreteValue
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassDataSchema)
+	*x.list = append(*x.list, concreteValue)
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(ClassDataSchema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(ClassDataSchema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ClassStats
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassStats)
+	(*x.list)[i] = concreteValue
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Classes) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.Classes})
-		if !f(fd_GenesisState_classes, value) {
-			return
-		}
-	}
-	if len(x.Entries) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Entries})
-		if !f(fd_GenesisState_entries, value) {
-			return
-		}
-	}
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassStats)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		return len(x.Classes) != 0
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		return len(x.Entries) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ClassStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		x.Classes = nil
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		x.Entries = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		if len(x.Classes) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_1_list{})
-		}
-		listValue := &_GenesisState_1_list{list: &x.Classes}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		if len(x.Entries) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Entries}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ClassStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		lv := value.List()
-		clv := lv.(*_GenesisState_1_list)
-		x.Classes = *clv.list
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Entries = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		if x.Classes == nil {
-			x.Classes = []*Class{}
-		}
-		value := &_GenesisState_1_list{list: &x.Classes}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		if x.Entries == nil {
-			x.Entries = []*Entry{}
-		}
-		value := &_GenesisState_2_list{list: &x.Entries}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*UsageGrant
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.nft.v1beta1.GenesisState.classes":
-		list := []*Class{}
-		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
-	case "cosmos.nft.v1beta1.GenesisState.entries":
-		list := []*Entry{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
-		}
-		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.nft.v1beta1.GenesisState", d.FullName()))
-	}
-	panic("unreachable")
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsageGrant)
+	(*x.list)[i] = concreteValue
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsageGrant)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(UsageGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.Classes) > 0 {
-			for _, e := range x.Classes {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Entries) > 0 {
-			for _, e := range x.Entries {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Entries) > 0 {
-			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Entries[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(UsageGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*ClassJSONSchema
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassJSONSchema)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassJSONSchema)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(ClassJSONSchema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(ClassJSONSchema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ReservedClassPrefix
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReservedClassPrefix)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReservedClassPrefix)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ReservedClassPrefix)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ReservedClassPrefix)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*Auction
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(Auction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(Auction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ClassRoyalty
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassRoyalty)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassRoyalty)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ClassRoyalty)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ClassRoyalty)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*Cw721Collection
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Cw721Collection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Cw721Collection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(Cw721Collection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(Cw721Collection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ClassMetadataMapping
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassMetadataMapping)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassMetadataMapping)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ClassMetadataMapping)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ClassMetadataMapping)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*TimelockedTransfer
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(TimelockedTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(TimelockedTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*ClassTransferFee
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassTransferFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClassTransferFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(ClassTransferFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(ClassTransferFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                         protoreflect.MessageDescriptor
+	fd_GenesisState_classes                 protoreflect.FieldDescriptor
+	fd_GenesisState_entries                 protoreflect.FieldDescriptor
+	fd_GenesisState_data_schemas            protoreflect.FieldDescriptor
+	fd_GenesisState_class_stats             protoreflect.FieldDescriptor
+	fd_GenesisState_usage_grants            protoreflect.FieldDescriptor
+	fd_GenesisState_json_schemas            protoreflect.FieldDescriptor
+	fd_GenesisState_reserved_class_prefixes protoreflect.FieldDescriptor
+	fd_GenesisState_auctions                protoreflect.FieldDescriptor
+	fd_GenesisState_next_auction_id         protoreflect.FieldDescriptor
+	fd_GenesisState_royalties               protoreflect.FieldDescriptor
+	fd_GenesisState_cw721_imports           protoreflect.FieldDescriptor
+	fd_GenesisState_metadata_mappings       protoreflect.FieldDescriptor
+	fd_GenesisState_timelocked_transfers    protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_fees           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_nft_v1beta1_genesis_proto_init()
+	md_GenesisState = File_cosmos_nft_v1beta1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_classes = md_GenesisState.Fields().ByName("classes")
+	fd_GenesisState_entries = md_GenesisState.Fields().ByName("entries")
+	fd_GenesisState_data_schemas = md_GenesisState.Fields().ByName("data_schemas")
+	fd_GenesisState_class_stats = md_GenesisState.Fields().ByName("class_stats")
+	fd_GenesisState_usage_grants = md_GenesisState.Fields().ByName("usage_grants")
+	fd_GenesisState_json_schemas = md_GenesisState.Fields().ByName("json_schemas")
+	fd_GenesisState_reserved_class_prefixes = md_GenesisState.Fields().ByName("reserved_class_prefixes")
+	fd_GenesisState_auctions = md_GenesisState.Fields().ByName("auctions")
+	fd_GenesisState_next_auction_id = md_GenesisState.Fields().ByName("next_auction_id")
+	fd_GenesisState_royalties = md_GenesisState.Fields().ByName("royalties")
+	fd_GenesisState_cw721_imports = md_GenesisState.Fields().ByName("cw721_imports")
+	fd_GenesisState_metadata_mappings = md_GenesisState.Fields().ByName("metadata_mappings")
+	fd_GenesisState_timelocked_transfers = md_GenesisState.Fields().ByName("timelocked_transfers")
+	fd_GenesisState_transfer_fees = md_GenesisState.Fields().ByName("transfer_fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_nft_v1beta1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Classes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.Classes})
+		if !f(fd_GenesisState_classes, value) {
+			return
+		}
+	}
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Entries})
+		if !f(fd_GenesisState_entries, value) {
+			return
+		}
+	}
+	if len(x.DataSchemas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DataSchemas})
+		if !f(fd_GenesisState_data_schemas, value) {
+			return
+		}
+	}
+	if len(x.ClassStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ClassStats})
+		if !f(fd_GenesisState_class_stats, value) {
+			return
+		}
+	}
+	if len(x.UsageGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.UsageGrants})
+		if !f(fd_GenesisState_usage_grants, value) {
+			return
+		}
+	}
+	if len(x.JsonSchemas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.JsonSchemas})
+		if !f(fd_GenesisState_json_schemas, value) {
+			return
+		}
+	}
+	if len(x.ReservedClassPrefixes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ReservedClassPrefixes})
+		if !f(fd_GenesisState_reserved_class_prefixes, value) {
+			return
+		}
+	}
+	if len(x.Auctions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.Auctions})
+		if !f(fd_GenesisState_auctions, value) {
+			return
+		}
+	}
+	if x.NextAuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextAuctionId)
+		if !f(fd_GenesisState_next_auction_id, value) {
+			return
+		}
+	}
+	if len(x.Royalties) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.Royalties})
+		if !f(fd_GenesisState_royalties, value) {
+			return
+		}
+	}
+	if len(x.Cw721Imports) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.Cw721Imports})
+		if !f(fd_GenesisState_cw721_imports, value) {
+			return
+		}
+	}
+	if len(x.MetadataMappings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.MetadataMappings})
+		if !f(fd_GenesisState_metadata_mappings, value) {
+			return
+		}
+	}
+	if len(x.TimelockedTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.TimelockedTransfers})
+		if !f(fd_GenesisState_timelocked_transfers, value) {
+			return
+		}
+	}
+	if len(x.TransferFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.TransferFees})
+		if !f(fd_GenesisState_transfer_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		return len(x.Classes) != 0
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		return len(x.Entries) != 0
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		return len(x.DataSchemas) != 0
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		return len(x.ClassStats) != 0
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		return len(x.UsageGrants) != 0
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		return len(x.JsonSchemas) != 0
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		return len(x.ReservedClassPrefixes) != 0
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		return len(x.Auctions) != 0
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		return x.NextAuctionId != uint64(0)
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		return len(x.Royalties) != 0
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		return len(x.Cw721Imports) != 0
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		return len(x.MetadataMappings) != 0
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		return len(x.TimelockedTransfers) != 0
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		return len(x.TransferFees) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		x.Classes = nil
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		x.Entries = nil
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		x.DataSchemas = nil
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		x.ClassStats = nil
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		x.UsageGrants = nil
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		x.JsonSchemas = nil
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		x.ReservedClassPrefixes = nil
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		x.Auctions = nil
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		x.NextAuctionId = uint64(0)
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		x.Royalties = nil
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		x.Cw721Imports = nil
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		x.MetadataMappings = nil
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		x.TimelockedTransfers = nil
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		x.TransferFees = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		if len(x.Classes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+		}
+		listValue := &_GenesisState_1_list{list: &x.Classes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		if len(x.DataSchemas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.DataSchemas}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		if len(x.ClassStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ClassStats}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		if len(x.UsageGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.UsageGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		if len(x.JsonSchemas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.JsonSchemas}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		if len(x.ReservedClassPrefixes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ReservedClassPrefixes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		if len(x.Auctions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		value := x.NextAuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		if len(x.Royalties) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.Royalties}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		if len(x.Cw721Imports) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.Cw721Imports}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		if len(x.MetadataMappings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.MetadataMappings}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		if len(x.TimelockedTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.TimelockedTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		if len(x.TransferFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.TransferFees}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_1_list)
+		x.Classes = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Entries = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.DataSchemas = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ClassStats = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.UsageGrants = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.JsonSchemas = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ReservedClassPrefixes = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.Auctions = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		x.NextAuctionId = value.Uint()
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.Royalties = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.Cw721Imports = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.MetadataMappings = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.TimelockedTransfers = *clv.list
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.TransferFees = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		if x.Classes == nil {
+			x.Classes = []*Class{}
+		}
+		value := &_GenesisState_1_list{list: &x.Classes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		if x.Entries == nil {
+			x.Entries = []*Entry{}
+		}
+		value := &_GenesisState_2_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		if x.DataSchemas == nil {
+			x.DataSchemas = []*ClassDataSchema{}
+		}
+		value := &_GenesisState_3_list{list: &x.DataSchemas}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		if x.ClassStats == nil {
+			x.ClassStats = []*ClassStats{}
+		}
+		value := &_GenesisState_4_list{list: &x.ClassStats}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		if x.UsageGrants == nil {
+			x.UsageGrants = []*UsageGrant{}
+		}
+		value := &_GenesisState_5_list{list: &x.UsageGrants}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		if x.JsonSchemas == nil {
+			x.JsonSchemas = []*ClassJSONSchema{}
+		}
+		value := &_GenesisState_6_list{list: &x.JsonSchemas}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		if x.ReservedClassPrefixes == nil {
+			x.ReservedClassPrefixes = []*ReservedClassPrefix{}
+		}
+		value := &_GenesisState_7_list{list: &x.ReservedClassPrefixes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		if x.Auctions == nil {
+			x.Auctions = []*Auction{}
+		}
+		value := &_GenesisState_8_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		if x.Royalties == nil {
+			x.Royalties = []*ClassRoyalty{}
+		}
+		value := &_GenesisState_10_list{list: &x.Royalties}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		if x.Cw721Imports == nil {
+			x.Cw721Imports = []*Cw721Collection{}
+		}
+		value := &_GenesisState_11_list{list: &x.Cw721Imports}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		if x.MetadataMappings == nil {
+			x.MetadataMappings = []*ClassMetadataMapping{}
+		}
+		value := &_GenesisState_12_list{list: &x.MetadataMappings}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		if x.TimelockedTransfers == nil {
+			x.TimelockedTransfers = []*TimelockedTransfer{}
+		}
+		value := &_GenesisState_13_list{list: &x.TimelockedTransfers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		if x.TransferFees == nil {
+			x.TransferFees = []*ClassTransferFee{}
+		}
+		value := &_GenesisState_14_list{list: &x.TransferFees}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		panic(fmt.Errorf("field next_auction_id of message cosmos.nft.v1beta1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.GenesisState.classes":
+		list := []*Class{}
+		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.entries":
+		list := []*Entry{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.data_schemas":
+		list := []*ClassDataSchema{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.class_stats":
+		list := []*ClassStats{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.usage_grants":
+		list := []*UsageGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.json_schemas":
+		list := []*ClassJSONSchema{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes":
+		list := []*ReservedClassPrefix{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.auctions":
+		list := []*Auction{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.next_auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.nft.v1beta1.GenesisState.royalties":
+		list := []*ClassRoyalty{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.cw721_imports":
+		list := []*Cw721Collection{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.metadata_mappings":
+		list := []*ClassMetadataMapping{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.timelocked_transfers":
+		list := []*TimelockedTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "cosmos.nft.v1beta1.GenesisState.transfer_fees":
+		list := []*ClassTransferFee{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.nft.v1beta1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Classes) > 0 {
+			for _, e := range x.Classes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DataSchemas) > 0 {
+			for _, e := range x.DataSchemas {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ClassStats) > 0 {
+			for _, e := range x.ClassStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UsageGrants) > 0 {
+			for _, e := range x.UsageGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.JsonSchemas) > 0 {
+			for _, e := range x.JsonSchemas {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReservedClassPrefixes) > 0 {
+			for _, e := range x.ReservedClassPrefixes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Auctions) > 0 {
+			for _, e := range x.Auctions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextAuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextAuctionId))
+		}
+		if len(x.Royalties) > 0 {
+			for _, e := range x.Royalties {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Cw721Imports) > 0 {
+			for _, e := range x.Cw721Imports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MetadataMappings) > 0 {
+			for _, e := range x.MetadataMappings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TimelockedTransfers) > 0 {
+			for _, e := range x.TimelockedTransfers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TransferFees) > 0 {
+			for _, e := range x.TransferFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TransferFees) > 0 {
+			for iNdEx := len(x.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.TimelockedTransfers) > 0 {
+			for iNdEx := len(x.TimelockedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TimelockedTransfers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.MetadataMappings) > 0 {
+			for iNdEx := len(x.MetadataMappings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MetadataMappings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Cw721Imports) > 0 {
+			for iNdEx := len(x.Cw721Imports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Cw721Imports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Royalties) > 0 {
+			for iNdEx := len(x.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Royalties[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.NextAuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextAuctionId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Auctions) > 0 {
+			for iNdEx := len(x.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Auctions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ReservedClassPrefixes) > 0 {
+			for iNdEx := len(x.ReservedClassPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReservedClassPrefixes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.JsonSchemas) > 0 {
+			for iNdEx := len(x.JsonSchemas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.JsonSchemas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.UsageGrants) > 0 {
+			for iNdEx := len(x.UsageGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UsageGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ClassStats) > 0 {
+			for iNdEx := len(x.ClassStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClassStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.DataSchemas) > 0 {
+			for iNdEx := len(x.DataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DataSchemas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Classes) > 0 {
+			for iNdEx := len(x.Classes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Classes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Classes = append(x.Classes, &Class{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Classes[len(x.Classes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &Entry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSchemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSchemas = append(x.DataSchemas, &ClassDataSchema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataSchemas[len(x.DataSchemas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassStats = append(x.ClassStats, &ClassStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClassStats[len(x.ClassStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsageGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UsageGrants = append(x.UsageGrants, &UsageGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UsageGrants[len(x.UsageGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JsonSchemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JsonSchemas = append(x.JsonSchemas, &ClassJSONSchema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JsonSchemas[len(x.JsonSchemas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedClassPrefixes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReservedClassPrefixes = append(x.ReservedClassPrefixes, &ReservedClassPrefix{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservedClassPrefixes[len(x.ReservedClassPrefixes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Classes) > 0 {
-			for iNdEx := len(x.Classes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Classes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Auctions = append(x.Auctions, &Auction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auctions[len(x.Auctions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextAuctionId", wireType)
+				}
+				x.NextAuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextAuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -570,14 +2035,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Classes = append(x.Classes, &Class{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Classes[len(x.Classes)-1]); err != nil {
+				x.Royalties = append(x.Royalties, &ClassRoyalty{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Royalties[len(x.Royalties)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cw721Imports", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -604,8 +2069,110 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Entries = append(x.Entries, &Entry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+				x.Cw721Imports = append(x.Cw721Imports, &Cw721Collection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Cw721Imports[len(x.Cw721Imports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataMappings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataMappings = append(x.MetadataMappings, &ClassMetadataMapping{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MetadataMappings[len(x.MetadataMappings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockedTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TimelockedTransfers = append(x.TimelockedTransfers, &TimelockedTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimelockedTransfers[len(x.TimelockedTransfers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferFees = append(x.TransferFees, &ClassTransferFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferFees[len(x.TransferFees)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1225,6 +2792,31 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// data_schemas defines the registered NFT data schemas of the classes.
+	DataSchemas []*ClassDataSchema `protobuf:"bytes,3,rep,name=data_schemas,json=dataSchemas,proto3" json:"data_schemas,omitempty"`
+	// class_stats defines the supply and holder statistics of the classes.
+	ClassStats []*ClassStats `protobuf:"bytes,4,rep,name=class_stats,json=classStats,proto3" json:"class_stats,omitempty"`
+	// usage_grants defines the unexpired usage grants of the nfts.
+	UsageGrants []*UsageGrant `protobuf:"bytes,5,rep,name=usage_grants,json=usageGrants,proto3" json:"usage_grants,omitempty"`
+	// json_schemas defines the registered JSON schemas of the classes.
+	JsonSchemas []*ClassJSONSchema `protobuf:"bytes,6,rep,name=json_schemas,json=jsonSchemas,proto3" json:"json_schemas,omitempty"`
+	// reserved_class_prefixes defines the class id prefixes reserved by governance.
+	ReservedClassPrefixes []*ReservedClassPrefix `protobuf:"bytes,7,rep,name=reserved_class_prefixes,json=reservedClassPrefixes,proto3" json:"reserved_class_prefixes,omitempty"`
+	// auctions defines the open auctions, if the auctions are enabled.
+	Auctions []*Auction `protobuf:"bytes,8,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// next_auction_id defines the id of the next auction to be created.
+	NextAuctionId uint64 `protobuf:"varint,9,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
+	// royalties defines the default royalties of the classes.
+	Royalties []*ClassRoyalty `protobuf:"bytes,10,rep,name=royalties,proto3" json:"royalties,omitempty"`
+	// cw721_imports defines the snapshots of cw721 contracts imported as classes at genesis. They are not exported, as
+	// the imported classes and nfts are exported with the others.
+	Cw721Imports []*Cw721Collection `protobuf:"bytes,11,rep,name=cw721_imports,json=cw721Imports,proto3" json:"cw721_imports,omitempty"`
+	// metadata_mappings defines the mappings of the data of the nfts of the classes to ERC-721 metadata.
+	MetadataMappings []*ClassMetadataMapping `protobuf:"bytes,12,rep,name=metadata_mappings,json=metadataMappings,proto3" json:"metadata_mappings,omitempty"`
+	// timelocked_transfers defines the pending time-locked transfers, whose nfts are escrowed by the module account.
+	TimelockedTransfers []*TimelockedTransfer `protobuf:"bytes,13,rep,name=timelocked_transfers,json=timelockedTransfers,proto3" json:"timelocked_transfers,omitempty"`
+	// transfer_fees defines the transfer fees of the classes.
+	TransferFees []*ClassTransferFee `protobuf:"bytes,14,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1261,6 +2853,90 @@ func (x *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (x *GenesisState) GetDataSchemas() []*ClassDataSchema {
+	if x != nil {
+		return x.DataSchemas
+	}
+	return nil
+}
+
+func (x *GenesisState) GetClassStats() []*ClassStats {
+	if x != nil {
+		return x.ClassStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUsageGrants() []*UsageGrant {
+	if x != nil {
+		return x.UsageGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetJsonSchemas() []*ClassJSONSchema {
+	if x != nil {
+		return x.JsonSchemas
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReservedClassPrefixes() []*ReservedClassPrefix {
+	if x != nil {
+		return x.ReservedClassPrefixes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctions() []*Auction {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextAuctionId() uint64 {
+	if x != nil {
+		return x.NextAuctionId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetRoyalties() []*ClassRoyalty {
+	if x != nil {
+		return x.Royalties
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCw721Imports() []*Cw721Collection {
+	if x != nil {
+		return x.Cw721Imports
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMetadataMappings() []*ClassMetadataMapping {
+	if x != nil {
+		return x.MetadataMappings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelockedTransfers() []*TimelockedTransfer {
+	if x != nil {
+		return x.TimelockedTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferFees() []*ClassTransferFee {
+	if x != nil {
+		return x.TransferFees
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	state         protoimpl.MessageState
@@ -1313,34 +2989,95 @@ var file_cosmos_nft_v1beta1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e,
-	0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x66, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e,
-	0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4a,
-	0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a,
-	0x04, 0x6e, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4e, 0x46, 0x54, 0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x42, 0xc0, 0x01, 0x0a, 0x16, 0x63,
-	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e,
-	0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6e, 0x66, 0x74, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4e, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4e, 0x66, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xca, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e,
-	0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x4e, 0x66, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e,
+	0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x77, 0x37,
+	0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x66, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x07, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12,
+	0x3f, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x41, 0x0a, 0x0c, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x5f, 0x0a, 0x17, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x15, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x09, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x52, 0x09, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a,
+	0x0d, 0x63, 0x77, 0x37, 0x32, 0x31, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x77, 0x37, 0x32, 0x31, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x63, 0x77, 0x37, 0x32, 0x31, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5b, 0x0a,
+	0x11, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x5f, 0x0a, 0x14, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x05,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x6e,
+	0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e,
+	0x46, 0x54, 0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x42, 0xc0, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e, 0x66, 0x74,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6e, 0x66, 0x74, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4e, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x4e, 0x66, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4e,
+	0x66, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1357,20 +3094,42 @@ func file_cosmos_nft_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_nft_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cosmos_nft_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: cosmos.nft.v1beta1.GenesisState
-	(*Entry)(nil),        // 1: cosmos.nft.v1beta1.Entry
-	(*Class)(nil),        // 2: cosmos.nft.v1beta1.Class
-	(*NFT)(nil),          // 3: cosmos.nft.v1beta1.NFT
+	(*GenesisState)(nil),         // 0: cosmos.nft.v1beta1.GenesisState
+	(*Entry)(nil),                // 1: cosmos.nft.v1beta1.Entry
+	(*Class)(nil),                // 2: cosmos.nft.v1beta1.Class
+	(*ClassDataSchema)(nil),      // 3: cosmos.nft.v1beta1.ClassDataSchema
+	(*ClassStats)(nil),           // 4: cosmos.nft.v1beta1.ClassStats
+	(*UsageGrant)(nil),           // 5: cosmos.nft.v1beta1.UsageGrant
+	(*ClassJSONSchema)(nil),      // 6: cosmos.nft.v1beta1.ClassJSONSchema
+	(*ReservedClassPrefix)(nil),  // 7: cosmos.nft.v1beta1.ReservedClassPrefix
+	(*Auction)(nil),              // 8: cosmos.nft.v1beta1.Auction
+	(*ClassRoyalty)(nil),         // 9: cosmos.nft.v1beta1.ClassRoyalty
+	(*Cw721Collection)(nil),      // 10: cosmos.nft.v1beta1.Cw721Collection
+	(*ClassMetadataMapping)(nil), // 11: cosmos.nft.v1beta1.ClassMetadataMapping
+	(*TimelockedTransfer)(nil),   // 12: cosmos.nft.v1beta1.TimelockedTransfer
+	(*ClassTransferFee)(nil),     // 13: cosmos.nft.v1beta1.ClassTransferFee
+	(*NFT)(nil),                  // 14: cosmos.nft.v1beta1.NFT
 }
 var file_cosmos_nft_v1beta1_genesis_proto_depIdxs = []int32{
-	2, // 0: cosmos.nft.v1beta1.GenesisState.classes:type_name -> cosmos.nft.v1beta1.Class
-	1, // 1: cosmos.nft.v1beta1.GenesisState.entries:type_name -> cosmos.nft.v1beta1.Entry
-	3, // 2: cosmos.nft.v1beta1.Entry.nfts:type_name -> cosmos.nft.v1beta1.NFT
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: cosmos.nft.v1beta1.GenesisState.classes:type_name -> cosmos.nft.v1beta1.Class
+	1,  // 1: cosmos.nft.v1beta1.GenesisState.entries:type_name -> cosmos.nft.v1beta1.Entry
+	3,  // 2: cosmos.nft.v1beta1.GenesisState.data_schemas:type_name -> cosmos.nft.v1beta1.ClassDataSchema
+	4,  // 3: cosmos.nft.v1beta1.GenesisState.class_stats:type_name -> cosmos.nft.v1beta1.ClassStats
+	5,  // 4: cosmos.nft.v1beta1.GenesisState.usage_grants:type_name -> cosmos.nft.v1beta1.UsageGrant
+	6,  // 5: cosmos.nft.v1beta1.GenesisState.json_schemas:type_name -> cosmos.nft.v1beta1.ClassJSONSchema
+	7,  // 6: cosmos.nft.v1beta1.GenesisState.reserved_class_prefixes:type_name -> cosmos.nft.v1beta1.ReservedClassPrefix
+	8,  // 7: cosmos.nft.v1beta1.GenesisState.auctions:type_name -> cosmos.nft.v1beta1.Auction
+	9,  // 8: cosmos.nft.v1beta1.GenesisState.royalties:type_name -> cosmos.nft.v1beta1.ClassRoyalty
+	10, // 9: cosmos.nft.v1beta1.GenesisState.cw721_imports:type_name -> cosmos.nft.v1beta1.Cw721Collection
+	11, // 10: cosmos.nft.v1beta1.GenesisState.metadata_mappings:type_name -> cosmos.nft.v1beta1.ClassMetadataMapping
+	12, // 11: cosmos.nft.v1beta1.GenesisState.timelocked_transfers:type_name -> cosmos.nft.v1beta1.TimelockedTransfer
+	13, // 12: cosmos.nft.v1beta1.GenesisState.transfer_fees:type_name -> cosmos.nft.v1beta1.ClassTransferFee
+	14, // 13: cosmos.nft.v1beta1.Entry.nfts:type_name -> cosmos.nft.v1beta1.NFT
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_nft_v1beta1_genesis_proto_init() }
@@ -1378,6 +3137,8 @@ func file_cosmos_nft_v1beta1_genesis_proto_init() {
 	if File_cosmos_nft_v1beta1_genesis_proto != nil {
 		return
 	}
+	file_cosmos_nft_v1beta1_auction_proto_init()
+	file_cosmos_nft_v1beta1_cw721_proto_init()
 	file_cosmos_nft_v1beta1_nft_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cosmos_nft_v1beta1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/nft/v1beta1/proof.proto
+
+package nftv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ProofService_OwnerProof_FullMethodName = "/cosmos.nft.v1beta1.ProofService/OwnerProof"
+)
+
+// ProofServiceClient is the client API for ProofService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProofServiceClient interface {
+	// OwnerProof queries the owner of an NFT together with the merkle proof of
+	// the ownership record against the app hash.
+	OwnerProof(ctx context.Context, in *QueryOwnerProofRequest, opts ...grpc.CallOption) (*QueryOwnerProofResponse, error)
+}
+
+type proofServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProofServiceClient(cc grpc.ClientConnInterface) ProofServiceClient {
+	return &proofServiceClient{cc}
+}
+
+func (c *proofServiceClient) OwnerProof(ctx context.Context, in *QueryOwnerProofRequest, opts ...grpc.CallOption) (*QueryOwnerProofResponse, error) {
+	out := new(QueryOwnerProofResponse)
+	err := c.cc.Invoke(ctx, ProofService_OwnerProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofServiceServer is the server API for ProofService service.
+// All implementations must embed UnimplementedProofServiceServer
+// for forward compatibility
+type ProofServiceServer interface {
+	// OwnerProof queries the owner of an NFT together with the merkle proof of
+	// the ownership record against the app hash.
+	OwnerProof(context.Context, *QueryOwnerProofRequest) (*QueryOwnerProofResponse, error)
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+// UnimplementedProofServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProofServiceServer struct {
+}
+
+func (UnimplementedProofServiceServer) OwnerProof(context.Context, *QueryOwnerProofRequest) (*QueryOwnerProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerProof not implemented")
+}
+func (UnimplementedProofServiceServer) mustEmbedUnimplementedProofServiceServer() {}
+
+// UnsafeProofServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProofServiceServer will
+// result in compilation errors.
+type UnsafeProofServiceServer interface {
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+func RegisterProofServiceServer(s grpc.ServiceRegistrar, srv ProofServiceServer) {
+	s.RegisterService(&ProofService_ServiceDesc, srv)
+}
+
+func _ProofService_OwnerProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).OwnerProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProofService_OwnerProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).OwnerProof(ctx, req.(*QueryOwnerProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProofService_ServiceDesc is the grpc.ServiceDesc for ProofService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProofService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.nft.v1beta1.ProofService",
+	HandlerType: (*ProofServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OwnerProof",
+			Handler:    _ProofService_OwnerProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/nft/v1beta1/proof.proto",
+}
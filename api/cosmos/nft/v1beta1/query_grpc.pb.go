@@ -19,13 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balance_FullMethodName = "/cosmos.nft.v1beta1.Query/Balance"
-	Query_Owner_FullMethodName   = "/cosmos.nft.v1beta1.Query/Owner"
-	Query_Supply_FullMethodName  = "/cosmos.nft.v1beta1.Query/Supply"
-	Query_NFTs_FullMethodName    = "/cosmos.nft.v1beta1.Query/NFTs"
-	Query_NFT_FullMethodName     = "/cosmos.nft.v1beta1.Query/NFT"
-	Query_Class_FullMethodName   = "/cosmos.nft.v1beta1.Query/Class"
-	Query_Classes_FullMethodName = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_Balance_FullMethodName               = "/cosmos.nft.v1beta1.Query/Balance"
+	Query_Owner_FullMethodName                 = "/cosmos.nft.v1beta1.Query/Owner"
+	Query_Supply_FullMethodName                = "/cosmos.nft.v1beta1.Query/Supply"
+	Query_NFTs_FullMethodName                  = "/cosmos.nft.v1beta1.Query/NFTs"
+	Query_NFTsOfOwner_FullMethodName           = "/cosmos.nft.v1beta1.Query/NFTsOfOwner"
+	Query_NFT_FullMethodName                   = "/cosmos.nft.v1beta1.Query/NFT"
+	Query_Class_FullMethodName                 = "/cosmos.nft.v1beta1.Query/Class"
+	Query_Classes_FullMethodName               = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_ClassDataSchema_FullMethodName       = "/cosmos.nft.v1beta1.Query/ClassDataSchema"
+	Query_ClassStats_FullMethodName            = "/cosmos.nft.v1beta1.Query/ClassStats"
+	Query_URI_FullMethodName                   = "/cosmos.nft.v1beta1.Query/URI"
+	Query_UsageGrant_FullMethodName            = "/cosmos.nft.v1beta1.Query/UsageGrant"
+	Query_ReservedClassPrefixes_FullMethodName = "/cosmos.nft.v1beta1.Query/ReservedClassPrefixes"
+	Query_ClassRoyalty_FullMethodName          = "/cosmos.nft.v1beta1.Query/ClassRoyalty"
+	Query_ClassMetadataMapping_FullMethodName  = "/cosmos.nft.v1beta1.Query/ClassMetadataMapping"
+	Query_ERC721Metadata_FullMethodName        = "/cosmos.nft.v1beta1.Query/ERC721Metadata"
+	Query_TimelockedTransfer_FullMethodName    = "/cosmos.nft.v1beta1.Query/TimelockedTransfer"
+	Query_TimelockedTransfers_FullMethodName   = "/cosmos.nft.v1beta1.Query/TimelockedTransfers"
+	Query_ClassTransferFee_FullMethodName      = "/cosmos.nft.v1beta1.Query/ClassTransferFee"
+	Query_NFTsByID_FullMethodName              = "/cosmos.nft.v1beta1.Query/NFTsByID"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,12 +54,42 @@ type QueryClient interface {
 	// NFTs queries all NFTs of a given class or owner,choose at least one of the two, similar to tokenByIndex in
 	// ERC721Enumerable
 	NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...grpc.CallOption) (*QueryNFTsResponse, error)
+	// NFTsOfOwner queries the NFTs of an owner across all classes, ordered by class id and id. Unlike NFTs, it
+	// paginates with a cursor which stays valid while the NFTs of the owner are transferred between pages
+	NFTsOfOwner(ctx context.Context, in *QueryNFTsOfOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsOfOwnerResponse, error)
 	// NFT queries an NFT based on its class and id.
 	NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error)
 	// Class queries an NFT class based on its id
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassDataSchema queries the protobuf type the NFT data of a class must decode to, and the JSON schema the data of
+	// the class and of its NFTs must conform to
+	ClassDataSchema(ctx context.Context, in *QueryClassDataSchemaRequest, opts ...grpc.CallOption) (*QueryClassDataSchemaResponse, error)
+	// ClassStats queries the supply and holder statistics of a class
+	ClassStats(ctx context.Context, in *QueryClassStatsRequest, opts ...grpc.CallOption) (*QueryClassStatsResponse, error)
+	// URI queries the uri and uri hash of a class or of a nft, with the height they were last updated at
+	URI(ctx context.Context, in *QueryURIRequest, opts ...grpc.CallOption) (*QueryURIResponse, error)
+	// UsageGrant queries the unexpired usage grant of a nft
+	UsageGrant(ctx context.Context, in *QueryUsageGrantRequest, opts ...grpc.CallOption) (*QueryUsageGrantResponse, error)
+	// ReservedClassPrefixes queries the class id prefixes reserved by governance
+	ReservedClassPrefixes(ctx context.Context, in *QueryReservedClassPrefixesRequest, opts ...grpc.CallOption) (*QueryReservedClassPrefixesResponse, error)
+	// ClassRoyalty queries the default royalty of the nfts of a class
+	ClassRoyalty(ctx context.Context, in *QueryClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryClassRoyaltyResponse, error)
+	// ClassMetadataMapping queries the mapping of the data of the nfts of a class to ERC-721 metadata
+	ClassMetadataMapping(ctx context.Context, in *QueryClassMetadataMappingRequest, opts ...grpc.CallOption) (*QueryClassMetadataMappingResponse, error)
+	// ERC721Metadata renders the on-chain data of a nft as ERC-721 metadata JSON, through the metadata mapping of its
+	// class, so that marketplaces can read it as the token uri of a ERC-721 token
+	ERC721Metadata(ctx context.Context, in *QueryERC721MetadataRequest, opts ...grpc.CallOption) (*QueryERC721MetadataResponse, error)
+	// TimelockedTransfer queries the pending time-locked transfer of a nft
+	TimelockedTransfer(ctx context.Context, in *QueryTimelockedTransferRequest, opts ...grpc.CallOption) (*QueryTimelockedTransferResponse, error)
+	// TimelockedTransfers queries all the pending time-locked transfers, or the ones of a sender or of a receiver
+	TimelockedTransfers(ctx context.Context, in *QueryTimelockedTransfersRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersResponse, error)
+	// ClassTransferFee queries the fee charged on the transfers of the nfts of a class
+	ClassTransferFee(ctx context.Context, in *QueryClassTransferFeeRequest, opts ...grpc.CallOption) (*QueryClassTransferFeeResponse, error)
+	// NFTsByID queries the nfts with a given id, or with an id matching a prefix pattern, across all classes, e.g. to
+	// locate the wrapped classes of bridged collections holding the same token id
+	NFTsByID(ctx context.Context, in *QueryNFTsByIDRequest, opts ...grpc.CallOption) (*QueryNFTsByIDResponse, error)
 }
 
 type queryClient struct {
@@ -93,6 +136,15 @@ func (c *queryClient) NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) NFTsOfOwner(ctx context.Context, in *QueryNFTsOfOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsOfOwnerResponse, error) {
+	out := new(QueryNFTsOfOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_NFTsOfOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error) {
 	out := new(QueryNFTResponse)
 	err := c.cc.Invoke(ctx, Query_NFT_FullMethodName, in, out, opts...)
@@ -120,6 +172,114 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassDataSchema(ctx context.Context, in *QueryClassDataSchemaRequest, opts ...grpc.CallOption) (*QueryClassDataSchemaResponse, error) {
+	out := new(QueryClassDataSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_ClassDataSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassStats(ctx context.Context, in *QueryClassStatsRequest, opts ...grpc.CallOption) (*QueryClassStatsResponse, error) {
+	out := new(QueryClassStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ClassStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) URI(ctx context.Context, in *QueryURIRequest, opts ...grpc.CallOption) (*QueryURIResponse, error) {
+	out := new(QueryURIResponse)
+	err := c.cc.Invoke(ctx, Query_URI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UsageGrant(ctx context.Context, in *QueryUsageGrantRequest, opts ...grpc.CallOption) (*QueryUsageGrantResponse, error) {
+	out := new(QueryUsageGrantResponse)
+	err := c.cc.Invoke(ctx, Query_UsageGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReservedClassPrefixes(ctx context.Context, in *QueryReservedClassPrefixesRequest, opts ...grpc.CallOption) (*QueryReservedClassPrefixesResponse, error) {
+	out := new(QueryReservedClassPrefixesResponse)
+	err := c.cc.Invoke(ctx, Query_ReservedClassPrefixes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassRoyalty(ctx context.Context, in *QueryClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryClassRoyaltyResponse, error) {
+	out := new(QueryClassRoyaltyResponse)
+	err := c.cc.Invoke(ctx, Query_ClassRoyalty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassMetadataMapping(ctx context.Context, in *QueryClassMetadataMappingRequest, opts ...grpc.CallOption) (*QueryClassMetadataMappingResponse, error) {
+	out := new(QueryClassMetadataMappingResponse)
+	err := c.cc.Invoke(ctx, Query_ClassMetadataMapping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC721Metadata(ctx context.Context, in *QueryERC721MetadataRequest, opts ...grpc.CallOption) (*QueryERC721MetadataResponse, error) {
+	out := new(QueryERC721MetadataResponse)
+	err := c.cc.Invoke(ctx, Query_ERC721Metadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedTransfer(ctx context.Context, in *QueryTimelockedTransferRequest, opts ...grpc.CallOption) (*QueryTimelockedTransferResponse, error) {
+	out := new(QueryTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedTransfers(ctx context.Context, in *QueryTimelockedTransfersRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersResponse, error) {
+	out := new(QueryTimelockedTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassTransferFee(ctx context.Context, in *QueryClassTransferFeeRequest, opts ...grpc.CallOption) (*QueryClassTransferFeeResponse, error) {
+	out := new(QueryClassTransferFeeResponse)
+	err := c.cc.Invoke(ctx, Query_ClassTransferFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTsByID(ctx context.Context, in *QueryNFTsByIDRequest, opts ...grpc.CallOption) (*QueryNFTsByIDResponse, error) {
+	out := new(QueryNFTsByIDResponse)
+	err := c.cc.Invoke(ctx, Query_NFTsByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -133,12 +293,42 @@ type QueryServer interface {
 	// NFTs queries all NFTs of a given class or owner,choose at least one of the two, similar to tokenByIndex in
 	// ERC721Enumerable
 	NFTs(context.Context, *QueryNFTsRequest) (*QueryNFTsResponse, error)
+	// NFTsOfOwner queries the NFTs of an owner across all classes, ordered by class id and id. Unlike NFTs, it
+	// paginates with a cursor which stays valid while the NFTs of the owner are transferred between pages
+	NFTsOfOwner(context.Context, *QueryNFTsOfOwnerRequest) (*QueryNFTsOfOwnerResponse, error)
 	// NFT queries an NFT based on its class and id.
 	NFT(context.Context, *QueryNFTRequest) (*QueryNFTResponse, error)
 	// Class queries an NFT class based on its id
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassDataSchema queries the protobuf type the NFT data of a class must decode to, and the JSON schema the data of
+	// the class and of its NFTs must conform to
+	ClassDataSchema(context.Context, *QueryClassDataSchemaRequest) (*QueryClassDataSchemaResponse, error)
+	// ClassStats queries the supply and holder statistics of a class
+	ClassStats(context.Context, *QueryClassStatsRequest) (*QueryClassStatsResponse, error)
+	// URI queries the uri and uri hash of a class or of a nft, with the height they were last updated at
+	URI(context.Context, *QueryURIRequest) (*QueryURIResponse, error)
+	// UsageGrant queries the unexpired usage grant of a nft
+	UsageGrant(context.Context, *QueryUsageGrantRequest) (*QueryUsageGrantResponse, error)
+	// ReservedClassPrefixes queries the class id prefixes reserved by governance
+	ReservedClassPrefixes(context.Context, *QueryReservedClassPrefixesRequest) (*QueryReservedClassPrefixesResponse, error)
+	// ClassRoyalty queries the default royalty of the nfts of a class
+	ClassRoyalty(context.Context, *QueryClassRoyaltyRequest) (*QueryClassRoyaltyResponse, error)
+	// ClassMetadataMapping queries the mapping of the data of the nfts of a class to ERC-721 metadata
+	ClassMetadataMapping(context.Context, *QueryClassMetadataMappingRequest) (*QueryClassMetadataMappingResponse, error)
+	// ERC721Metadata renders the on-chain data of a nft as ERC-721 metadata JSON, through the metadata mapping of its
+	// class, so that marketplaces can read it as the token uri of a ERC-721 token
+	ERC721Metadata(context.Context, *QueryERC721MetadataRequest) (*QueryERC721MetadataResponse, error)
+	// TimelockedTransfer queries the pending time-locked transfer of a nft
+	TimelockedTransfer(context.Context, *QueryTimelockedTransferRequest) (*QueryTimelockedTransferResponse, error)
+	// TimelockedTransfers queries all the pending time-locked transfers, or the ones of a sender or of a receiver
+	TimelockedTransfers(context.Context, *QueryTimelockedTransfersRequest) (*QueryTimelockedTransfersResponse, error)
+	// ClassTransferFee queries the fee charged on the transfers of the nfts of a class
+	ClassTransferFee(context.Context, *QueryClassTransferFeeRequest) (*QueryClassTransferFeeResponse, error)
+	// NFTsByID queries the nfts with a given id, or with an id matching a prefix pattern, across all classes, e.g. to
+	// locate the wrapped classes of bridged collections holding the same token id
+	NFTsByID(context.Context, *QueryNFTsByIDRequest) (*QueryNFTsByIDResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -158,6 +348,9 @@ func (UnimplementedQueryServer) Supply(context.Context, *QuerySupplyRequest) (*Q
 func (UnimplementedQueryServer) NFTs(context.Context, *QueryNFTsRequest) (*QueryNFTsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NFTs not implemented")
 }
+func (UnimplementedQueryServer) NFTsOfOwner(context.Context, *QueryNFTsOfOwnerRequest) (*QueryNFTsOfOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsOfOwner not implemented")
+}
 func (UnimplementedQueryServer) NFT(context.Context, *QueryNFTRequest) (*QueryNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NFT not implemented")
 }
@@ -167,6 +360,42 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) ClassDataSchema(context.Context, *QueryClassDataSchemaRequest) (*QueryClassDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassDataSchema not implemented")
+}
+func (UnimplementedQueryServer) ClassStats(context.Context, *QueryClassStatsRequest) (*QueryClassStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassStats not implemented")
+}
+func (UnimplementedQueryServer) URI(context.Context, *QueryURIRequest) (*QueryURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method URI not implemented")
+}
+func (UnimplementedQueryServer) UsageGrant(context.Context, *QueryUsageGrantRequest) (*QueryUsageGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsageGrant not implemented")
+}
+func (UnimplementedQueryServer) ReservedClassPrefixes(context.Context, *QueryReservedClassPrefixesRequest) (*QueryReservedClassPrefixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservedClassPrefixes not implemented")
+}
+func (UnimplementedQueryServer) ClassRoyalty(context.Context, *QueryClassRoyaltyRequest) (*QueryClassRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassRoyalty not implemented")
+}
+func (UnimplementedQueryServer) ClassMetadataMapping(context.Context, *QueryClassMetadataMappingRequest) (*QueryClassMetadataMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassMetadataMapping not implemented")
+}
+func (UnimplementedQueryServer) ERC721Metadata(context.Context, *QueryERC721MetadataRequest) (*QueryERC721MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC721Metadata not implemented")
+}
+func (UnimplementedQueryServer) TimelockedTransfer(context.Context, *QueryTimelockedTransferRequest) (*QueryTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfer not implemented")
+}
+func (UnimplementedQueryServer) TimelockedTransfers(context.Context, *QueryTimelockedTransfersRequest) (*QueryTimelockedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfers not implemented")
+}
+func (UnimplementedQueryServer) ClassTransferFee(context.Context, *QueryClassTransferFeeRequest) (*QueryClassTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassTransferFee not implemented")
+}
+func (UnimplementedQueryServer) NFTsByID(context.Context, *QueryNFTsByIDRequest) (*QueryNFTsByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsByID not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -252,6 +481,24 @@ func _Query_NFTs_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NFTsOfOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsOfOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsOfOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NFTsOfOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsOfOwner(ctx, req.(*QueryNFTsOfOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNFTRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +553,222 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassDataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassDataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassDataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassDataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassDataSchema(ctx, req.(*QueryClassDataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassStats(ctx, req.(*QueryClassStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_URI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).URI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_URI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).URI(ctx, req.(*QueryURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UsageGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsageGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UsageGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UsageGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsageGrant(ctx, req.(*QueryUsageGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReservedClassPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservedClassPrefixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservedClassPrefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReservedClassPrefixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservedClassPrefixes(ctx, req.(*QueryReservedClassPrefixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassRoyalty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassRoyalty(ctx, req.(*QueryClassRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassMetadataMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassMetadataMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassMetadataMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassMetadataMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassMetadataMapping(ctx, req.(*QueryClassMetadataMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC721Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC721MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC721Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ERC721Metadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC721Metadata(ctx, req.(*QueryERC721MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfer(ctx, req.(*QueryTimelockedTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfers(ctx, req.(*QueryTimelockedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassTransferFee(ctx, req.(*QueryClassTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTsByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NFTsByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsByID(ctx, req.(*QueryNFTsByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -329,6 +792,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NFTs",
 			Handler:    _Query_NFTs_Handler,
 		},
+		{
+			MethodName: "NFTsOfOwner",
+			Handler:    _Query_NFTsOfOwner_Handler,
+		},
 		{
 			MethodName: "NFT",
 			Handler:    _Query_NFT_Handler,
@@ -341,6 +808,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassDataSchema",
+			Handler:    _Query_ClassDataSchema_Handler,
+		},
+		{
+			MethodName: "ClassStats",
+			Handler:    _Query_ClassStats_Handler,
+		},
+		{
+			MethodName: "URI",
+			Handler:    _Query_URI_Handler,
+		},
+		{
+			MethodName: "UsageGrant",
+			Handler:    _Query_UsageGrant_Handler,
+		},
+		{
+			MethodName: "ReservedClassPrefixes",
+			Handler:    _Query_ReservedClassPrefixes_Handler,
+		},
+		{
+			MethodName: "ClassRoyalty",
+			Handler:    _Query_ClassRoyalty_Handler,
+		},
+		{
+			MethodName: "ClassMetadataMapping",
+			Handler:    _Query_ClassMetadataMapping_Handler,
+		},
+		{
+			MethodName: "ERC721Metadata",
+			Handler:    _Query_ERC721Metadata_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfer",
+			Handler:    _Query_TimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfers",
+			Handler:    _Query_TimelockedTransfers_Handler,
+		},
+		{
+			MethodName: "ClassTransferFee",
+			Handler:    _Query_ClassTransferFee_Handler,
+		},
+		{
+			MethodName: "NFTsByID",
+			Handler:    _Query_NFTsByID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
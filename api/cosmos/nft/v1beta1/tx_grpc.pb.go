@@ -19,7 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_Send_FullMethodName                      = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_UpdateURI_FullMethodName                 = "/cosmos.nft.v1beta1.Msg/UpdateURI"
+	Msg_GrantUse_FullMethodName                  = "/cosmos.nft.v1beta1.Msg/GrantUse"
+	Msg_Revoke_FullMethodName                    = "/cosmos.nft.v1beta1.Msg/Revoke"
+	Msg_FreezeClass_FullMethodName               = "/cosmos.nft.v1beta1.Msg/FreezeClass"
+	Msg_UnfreezeClass_FullMethodName             = "/cosmos.nft.v1beta1.Msg/UnfreezeClass"
+	Msg_ReserveClassPrefix_FullMethodName        = "/cosmos.nft.v1beta1.Msg/ReserveClassPrefix"
+	Msg_ReleaseClassPrefix_FullMethodName        = "/cosmos.nft.v1beta1.Msg/ReleaseClassPrefix"
+	Msg_SetClassRoyalty_FullMethodName           = "/cosmos.nft.v1beta1.Msg/SetClassRoyalty"
+	Msg_ImportCw721_FullMethodName               = "/cosmos.nft.v1beta1.Msg/ImportCw721"
+	Msg_SetClassMetadataMapping_FullMethodName   = "/cosmos.nft.v1beta1.Msg/SetClassMetadataMapping"
+	Msg_TransferWithTimelock_FullMethodName      = "/cosmos.nft.v1beta1.Msg/TransferWithTimelock"
+	Msg_ReclaimTimelockedTransfer_FullMethodName = "/cosmos.nft.v1beta1.Msg/ReclaimTimelockedTransfer"
+	Msg_SetClassTransferFee_FullMethodName       = "/cosmos.nft.v1beta1.Msg/SetClassTransferFee"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +41,41 @@ const (
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// UpdateURI defines a method to update the uri of a class or of a nft, together with the hash of the content it
+	// points to.
+	UpdateURI(ctx context.Context, in *MsgUpdateURI, opts ...grpc.CallOption) (*MsgUpdateURIResponse, error)
+	// GrantUse defines a method for the owner of a nft to grant its usage to another account until the given expiry,
+	// without transferring its ownership.
+	GrantUse(ctx context.Context, in *MsgGrantUse, opts ...grpc.CallOption) (*MsgGrantUseResponse, error)
+	// Revoke defines a method for the issuer of a class to revoke a nft of the class by burning it.
+	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// FreezeClass defines a method for the admin of a class to halt the mint, transfer and burn of the nfts of the class.
+	FreezeClass(ctx context.Context, in *MsgFreezeClass, opts ...grpc.CallOption) (*MsgFreezeClassResponse, error)
+	// UnfreezeClass defines a method for the admin of a class to lift the freeze of the class.
+	UnfreezeClass(ctx context.Context, in *MsgUnfreezeClass, opts ...grpc.CallOption) (*MsgUnfreezeClassResponse, error)
+	// ReserveClassPrefix defines a governance operation to reserve the class ids starting with a prefix to an owner, or
+	// to change the owner of a reserved prefix.
+	ReserveClassPrefix(ctx context.Context, in *MsgReserveClassPrefix, opts ...grpc.CallOption) (*MsgReserveClassPrefixResponse, error)
+	// ReleaseClassPrefix defines a governance operation to release a reserved class id prefix.
+	ReleaseClassPrefix(ctx context.Context, in *MsgReleaseClassPrefix, opts ...grpc.CallOption) (*MsgReleaseClassPrefixResponse, error)
+	// SetClassRoyalty defines a method for the admin of a class to set, or remove, the default royalty of the nfts of
+	// the class.
+	SetClassRoyalty(ctx context.Context, in *MsgSetClassRoyalty, opts ...grpc.CallOption) (*MsgSetClassRoyaltyResponse, error)
+	// ImportCw721 defines a governance operation to import the snapshots of cw721 contracts as classes, for chains
+	// migrating their collections off CosmWasm.
+	ImportCw721(ctx context.Context, in *MsgImportCw721, opts ...grpc.CallOption) (*MsgImportCw721Response, error)
+	// SetClassMetadataMapping defines a method for the admin of a class to set, or remove, the mapping of the data of the
+	// nfts of the class to ERC-721 metadata.
+	SetClassMetadataMapping(ctx context.Context, in *MsgSetClassMetadataMapping, opts ...grpc.CallOption) (*MsgSetClassMetadataMappingResponse, error)
+	// TransferWithTimelock defines a method to transfer a nft to another account at a given height or time, e.g. to vest
+	// nft rewards. The nft is escrowed by the module until then, and can be reclaimed by its sender before.
+	TransferWithTimelock(ctx context.Context, in *MsgTransferWithTimelock, opts ...grpc.CallOption) (*MsgTransferWithTimelockResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of a time-locked transfer to take the nft back before it
+	// is released.
+	ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetClassTransferFee defines a method for the admin of a class to set, or remove, the fee charged on the transfers
+	// of the nfts of the class.
+	SetClassTransferFee(ctx context.Context, in *MsgSetClassTransferFee, opts ...grpc.CallOption) (*MsgSetClassTransferFeeResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +95,164 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateURI(ctx context.Context, in *MsgUpdateURI, opts ...grpc.CallOption) (*MsgUpdateURIResponse, error) {
+	out := new(MsgUpdateURIResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateURI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantUse(ctx context.Context, in *MsgGrantUse, opts ...grpc.CallOption) (*MsgGrantUseResponse, error) {
+	out := new(MsgGrantUseResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantUse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error) {
+	out := new(MsgRevokeResponse)
+	err := c.cc.Invoke(ctx, Msg_Revoke_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeClass(ctx context.Context, in *MsgFreezeClass, opts ...grpc.CallOption) (*MsgFreezeClassResponse, error) {
+	out := new(MsgFreezeClassResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeClass(ctx context.Context, in *MsgUnfreezeClass, opts ...grpc.CallOption) (*MsgUnfreezeClassResponse, error) {
+	out := new(MsgUnfreezeClassResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReserveClassPrefix(ctx context.Context, in *MsgReserveClassPrefix, opts ...grpc.CallOption) (*MsgReserveClassPrefixResponse, error) {
+	out := new(MsgReserveClassPrefixResponse)
+	err := c.cc.Invoke(ctx, Msg_ReserveClassPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseClassPrefix(ctx context.Context, in *MsgReleaseClassPrefix, opts ...grpc.CallOption) (*MsgReleaseClassPrefixResponse, error) {
+	out := new(MsgReleaseClassPrefixResponse)
+	err := c.cc.Invoke(ctx, Msg_ReleaseClassPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClassRoyalty(ctx context.Context, in *MsgSetClassRoyalty, opts ...grpc.CallOption) (*MsgSetClassRoyaltyResponse, error) {
+	out := new(MsgSetClassRoyaltyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetClassRoyalty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ImportCw721(ctx context.Context, in *MsgImportCw721, opts ...grpc.CallOption) (*MsgImportCw721Response, error) {
+	out := new(MsgImportCw721Response)
+	err := c.cc.Invoke(ctx, Msg_ImportCw721_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClassMetadataMapping(ctx context.Context, in *MsgSetClassMetadataMapping, opts ...grpc.CallOption) (*MsgSetClassMetadataMappingResponse, error) {
+	out := new(MsgSetClassMetadataMappingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetClassMetadataMapping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferWithTimelock(ctx context.Context, in *MsgTransferWithTimelock, opts ...grpc.CallOption) (*MsgTransferWithTimelockResponse, error) {
+	out := new(MsgTransferWithTimelockResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferWithTimelock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error) {
+	out := new(MsgReclaimTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ReclaimTimelockedTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClassTransferFee(ctx context.Context, in *MsgSetClassTransferFee, opts ...grpc.CallOption) (*MsgSetClassTransferFeeResponse, error) {
+	out := new(MsgSetClassTransferFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetClassTransferFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// UpdateURI defines a method to update the uri of a class or of a nft, together with the hash of the content it
+	// points to.
+	UpdateURI(context.Context, *MsgUpdateURI) (*MsgUpdateURIResponse, error)
+	// GrantUse defines a method for the owner of a nft to grant its usage to another account until the given expiry,
+	// without transferring its ownership.
+	GrantUse(context.Context, *MsgGrantUse) (*MsgGrantUseResponse, error)
+	// Revoke defines a method for the issuer of a class to revoke a nft of the class by burning it.
+	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// FreezeClass defines a method for the admin of a class to halt the mint, transfer and burn of the nfts of the class.
+	FreezeClass(context.Context, *MsgFreezeClass) (*MsgFreezeClassResponse, error)
+	// UnfreezeClass defines a method for the admin of a class to lift the freeze of the class.
+	UnfreezeClass(context.Context, *MsgUnfreezeClass) (*MsgUnfreezeClassResponse, error)
+	// ReserveClassPrefix defines a governance operation to reserve the class ids starting with a prefix to an owner, or
+	// to change the owner of a reserved prefix.
+	ReserveClassPrefix(context.Context, *MsgReserveClassPrefix) (*MsgReserveClassPrefixResponse, error)
+	// ReleaseClassPrefix defines a governance operation to release a reserved class id prefix.
+	ReleaseClassPrefix(context.Context, *MsgReleaseClassPrefix) (*MsgReleaseClassPrefixResponse, error)
+	// SetClassRoyalty defines a method for the admin of a class to set, or remove, the default royalty of the nfts of
+	// the class.
+	SetClassRoyalty(context.Context, *MsgSetClassRoyalty) (*MsgSetClassRoyaltyResponse, error)
+	// ImportCw721 defines a governance operation to import the snapshots of cw721 contracts as classes, for chains
+	// migrating their collections off CosmWasm.
+	ImportCw721(context.Context, *MsgImportCw721) (*MsgImportCw721Response, error)
+	// SetClassMetadataMapping defines a method for the admin of a class to set, or remove, the mapping of the data of the
+	// nfts of the class to ERC-721 metadata.
+	SetClassMetadataMapping(context.Context, *MsgSetClassMetadataMapping) (*MsgSetClassMetadataMappingResponse, error)
+	// TransferWithTimelock defines a method to transfer a nft to another account at a given height or time, e.g. to vest
+	// nft rewards. The nft is escrowed by the module until then, and can be reclaimed by its sender before.
+	TransferWithTimelock(context.Context, *MsgTransferWithTimelock) (*MsgTransferWithTimelockResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of a time-locked transfer to take the nft back before it
+	// is released.
+	ReclaimTimelockedTransfer(context.Context, *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetClassTransferFee defines a method for the admin of a class to set, or remove, the fee charged on the transfers
+	// of the nfts of the class.
+	SetClassTransferFee(context.Context, *MsgSetClassTransferFee) (*MsgSetClassTransferFeeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +263,45 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) UpdateURI(context.Context, *MsgUpdateURI) (*MsgUpdateURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateURI not implemented")
+}
+func (UnimplementedMsgServer) GrantUse(context.Context, *MsgGrantUse) (*MsgGrantUseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUse not implemented")
+}
+func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedMsgServer) FreezeClass(context.Context, *MsgFreezeClass) (*MsgFreezeClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeClass not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeClass(context.Context, *MsgUnfreezeClass) (*MsgUnfreezeClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeClass not implemented")
+}
+func (UnimplementedMsgServer) ReserveClassPrefix(context.Context, *MsgReserveClassPrefix) (*MsgReserveClassPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveClassPrefix not implemented")
+}
+func (UnimplementedMsgServer) ReleaseClassPrefix(context.Context, *MsgReleaseClassPrefix) (*MsgReleaseClassPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseClassPrefix not implemented")
+}
+func (UnimplementedMsgServer) SetClassRoyalty(context.Context, *MsgSetClassRoyalty) (*MsgSetClassRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClassRoyalty not implemented")
+}
+func (UnimplementedMsgServer) ImportCw721(context.Context, *MsgImportCw721) (*MsgImportCw721Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCw721 not implemented")
+}
+func (UnimplementedMsgServer) SetClassMetadataMapping(context.Context, *MsgSetClassMetadataMapping) (*MsgSetClassMetadataMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClassMetadataMapping not implemented")
+}
+func (UnimplementedMsgServer) TransferWithTimelock(context.Context, *MsgTransferWithTimelock) (*MsgTransferWithTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferWithTimelock not implemented")
+}
+func (UnimplementedMsgServer) ReclaimTimelockedTransfer(context.Context, *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTimelockedTransfer not implemented")
+}
+func (UnimplementedMsgServer) SetClassTransferFee(context.Context, *MsgSetClassTransferFee) (*MsgSetClassTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClassTransferFee not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +333,240 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateURI)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateURI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateURI(ctx, req.(*MsgUpdateURI))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantUse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantUse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantUse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantUse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantUse(ctx, req.(*MsgGrantUse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevoke)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Revoke(ctx, req.(*MsgRevoke))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeClass(ctx, req.(*MsgFreezeClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeClass(ctx, req.(*MsgUnfreezeClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReserveClassPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReserveClassPrefix)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReserveClassPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReserveClassPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReserveClassPrefix(ctx, req.(*MsgReserveClassPrefix))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseClassPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseClassPrefix)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseClassPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReleaseClassPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseClassPrefix(ctx, req.(*MsgReleaseClassPrefix))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClassRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassRoyalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetClassRoyalty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassRoyalty(ctx, req.(*MsgSetClassRoyalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ImportCw721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgImportCw721)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ImportCw721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ImportCw721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ImportCw721(ctx, req.(*MsgImportCw721))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClassMetadataMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassMetadataMapping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassMetadataMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetClassMetadataMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassMetadataMapping(ctx, req.(*MsgSetClassMetadataMapping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferWithTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferWithTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferWithTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferWithTimelock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferWithTimelock(ctx, req.(*MsgTransferWithTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReclaimTimelockedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, req.(*MsgReclaimTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClassTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassTransferFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetClassTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassTransferFee(ctx, req.(*MsgSetClassTransferFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +578,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "UpdateURI",
+			Handler:    _Msg_UpdateURI_Handler,
+		},
+		{
+			MethodName: "GrantUse",
+			Handler:    _Msg_GrantUse_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _Msg_Revoke_Handler,
+		},
+		{
+			MethodName: "FreezeClass",
+			Handler:    _Msg_FreezeClass_Handler,
+		},
+		{
+			MethodName: "UnfreezeClass",
+			Handler:    _Msg_UnfreezeClass_Handler,
+		},
+		{
+			MethodName: "ReserveClassPrefix",
+			Handler:    _Msg_ReserveClassPrefix_Handler,
+		},
+		{
+			MethodName: "ReleaseClassPrefix",
+			Handler:    _Msg_ReleaseClassPrefix_Handler,
+		},
+		{
+			MethodName: "SetClassRoyalty",
+			Handler:    _Msg_SetClassRoyalty_Handler,
+		},
+		{
+			MethodName: "ImportCw721",
+			Handler:    _Msg_ImportCw721_Handler,
+		},
+		{
+			MethodName: "SetClassMetadataMapping",
+			Handler:    _Msg_SetClassMetadataMapping_Handler,
+		},
+		{
+			MethodName: "TransferWithTimelock",
+			Handler:    _Msg_TransferWithTimelock_Handler,
+		},
+		{
+			MethodName: "ReclaimTimelockedTransfer",
+			Handler:    _Msg_ReclaimTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "SetClassTransferFee",
+			Handler:    _Msg_SetClassTransferFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
 	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
 	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_LossRecords_FullMethodName  = "/cosmos.slashing.v1beta1.Query/LossRecords"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,12 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// LossRecords queries the stake a delegator lost to the slashes of
+	// validators, oldest first. Loss records are only kept for the
+	// loss_record_retention blocks following the slash.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecords(ctx context.Context, in *QueryLossRecordsRequest, opts ...grpc.CallOption) (*QueryLossRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) LossRecords(ctx context.Context, in *QueryLossRecordsRequest, opts ...grpc.CallOption) (*QueryLossRecordsResponse, error) {
+	out := new(QueryLossRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_LossRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +97,12 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// LossRecords queries the stake a delegator lost to the slashes of
+	// validators, oldest first. Loss records are only kept for the
+	// loss_record_retention blocks following the slash.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecords(context.Context, *QueryLossRecordsRequest) (*QueryLossRecordsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +119,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) LossRecords(context.Context, *QueryLossRecordsRequest) (*QueryLossRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LossRecords not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +189,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LossRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLossRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LossRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LossRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LossRecords(ctx, req.(*QueryLossRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +226,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "LossRecords",
+			Handler:    _Query_LossRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -19,20 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_ValidatorsBelowMinCommission_FullMethodName     = "/cosmos.staking.v1beta1.Query/ValidatorsBelowMinCommission"
+	Query_ValidatorsBelowMinSelfDelegation_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorsBelowMinSelfDelegation"
+	Query_InactiveValidators_FullMethodName               = "/cosmos.staking.v1beta1.Query/InactiveValidators"
+	Query_TombstonedValidators_FullMethodName             = "/cosmos.staking.v1beta1.Query/TombstonedValidators"
+	Query_Validator_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorDelegationsStream_FullMethodName       = "/cosmos.staking.v1beta1.Query/ValidatorDelegationsStream"
+	Query_ValidatorUnbondingDelegations_FullMethodName    = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName              = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_AllDelegations_FullMethodName                   = "/cosmos.staking.v1beta1.Query/AllDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName    = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName               = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_AutoRedelegation_FullMethodName                 = "/cosmos.staking.v1beta1.Query/AutoRedelegation"
+	Query_ValidatorJailHistory_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorJailHistory"
+	Query_ValidatorAddresses_FullMethodName               = "/cosmos.staking.v1beta1.Query/ValidatorAddresses"
+	Query_HistoricalInfo_FullMethodName                   = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_HistoricalInfoSize_FullMethodName               = "/cosmos.staking.v1beta1.Query/HistoricalInfoSize"
+	Query_ValidatorSetCheckpoint_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorSetCheckpoint"
+	Query_ValidatorSetCheckpoints_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorSetCheckpoints"
+	Query_Pool_FullMethodName                             = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                           = "/cosmos.staking.v1beta1.Query/Params"
+	Query_YieldInputs_FullMethodName                      = "/cosmos.staking.v1beta1.Query/YieldInputs"
+	Query_PowerDistribution_FullMethodName                = "/cosmos.staking.v1beta1.Query/PowerDistribution"
+	Query_TopDelegators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/TopDelegators"
+	Query_ValidateCreateValidator_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidateCreateValidator"
 )
 
 // QueryClient is the client API for Query service.
@@ -44,6 +60,34 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+	// ValidatorsBelowMinCommission queries the validators whose commission rate
+	// is below the minimum commission rate of the params.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorsBelowMinCommission(ctx context.Context, in *QueryValidatorsBelowMinCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinCommissionResponse, error)
+	// ValidatorsBelowMinSelfDelegation queries the validators whose
+	// self-delegation fell below their min_self_delegation and which are not
+	// jailed yet, with the time at which they are jailed unless they top it up.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorsBelowMinSelfDelegation(ctx context.Context, in *QueryValidatorsBelowMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinSelfDelegationResponse, error)
+	// InactiveValidators queries the validators which have been unbonded with no
+	// delegator but their operator for longer than the inactive validator cleanup
+	// period, and are removed by the cleanup unless it runs in dry-run mode.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	InactiveValidators(ctx context.Context, in *QueryInactiveValidatorsRequest, opts ...grpc.CallOption) (*QueryInactiveValidatorsResponse, error)
+	// TombstonedValidators queries the validators which are tombstoned by the
+	// slashing module but are still stored by the staking module, and can
+	// therefore still be delegated to unless the reject_tombstoned_delegations
+	// param is enabled.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TombstonedValidators(ctx context.Context, in *QueryTombstonedValidatorsRequest, opts ...grpc.CallOption) (*QueryTombstonedValidatorsResponse, error)
 	// Validator queries validator info for given validator address.
 	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
 	// ValidatorDelegations queries delegate info for given validator.
@@ -51,6 +95,10 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	ValidatorDelegations(ctx context.Context, in *QueryValidatorDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationsResponse, error)
+	// ValidatorDelegationsStream streams all the delegations of a validator, in
+	// batches all read from the state at the queried height. It is only served by
+	// the gRPC server of a node, which rate limits the streams.
+	ValidatorDelegationsStream(ctx context.Context, in *QueryValidatorDelegationsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorDelegationsStreamClient, error)
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -66,6 +114,10 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error)
+	// AllDelegations queries the delegations of all the delegators, optionally
+	// filtered by balance and excluding module accounts, e.g. to export the
+	// distribution of the stake at a given height.
+	AllDelegations(ctx context.Context, in *QueryAllDelegationsRequest, opts ...grpc.CallOption) (*QueryAllDelegationsResponse, error)
 	// DelegatorUnbondingDelegations queries all unbonding delegations of a given
 	// delegator address.
 	//
@@ -86,12 +138,52 @@ type QueryClient interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
+	// AutoRedelegation queries the auto-redelegation preference of a delegator.
+	AutoRedelegation(ctx context.Context, in *QueryAutoRedelegationRequest, opts ...grpc.CallOption) (*QueryAutoRedelegationResponse, error)
+	// ValidatorJailHistory queries the jail history of a validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error)
+	// ValidatorAddresses resolves the operator, consensus and account addresses
+	// of a validator from any of them.
+	ValidatorAddresses(ctx context.Context, in *QueryValidatorAddressesRequest, opts ...grpc.CallOption) (*QueryValidatorAddressesResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
+	// HistoricalInfoSize queries the current size of the historical info store.
+	HistoricalInfoSize(ctx context.Context, in *QueryHistoricalInfoSizeRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoSizeResponse, error)
+	// ValidatorSetCheckpoint queries the validator set checkpoint taken at a
+	// given height.
+	ValidatorSetCheckpoint(ctx context.Context, in *QueryValidatorSetCheckpointRequest, opts ...grpc.CallOption) (*QueryValidatorSetCheckpointResponse, error)
+	// ValidatorSetCheckpoints queries all the validator set checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorSetCheckpoints(ctx context.Context, in *QueryValidatorSetCheckpointsRequest, opts ...grpc.CallOption) (*QueryValidatorSetCheckpointsResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// YieldInputs queries, at a single height, the inputs of the estimation of
+	// the staking yield: the bonded ratio, the inflation, the community tax and,
+	// optionally, the commission rate of a validator, so that wallets compute the
+	// APR consistently instead of mixing heights across modules.
+	YieldInputs(ctx context.Context, in *QueryYieldInputsRequest, opts ...grpc.CallOption) (*QueryYieldInputsResponse, error)
+	// PowerDistribution queries a summary of the distribution of the consensus
+	// power among the bonded validators: the cumulative share of the top
+	// validators, the Nakamoto coefficient and a histogram of the powers. It
+	// reads an index of the validator powers sorted by power, maintained as the
+	// validator set is updated.
+	PowerDistribution(ctx context.Context, in *QueryPowerDistributionRequest, opts ...grpc.CallOption) (*QueryPowerDistributionResponse, error)
+	// TopDelegators queries the delegations of a validator with the most shares,
+	// in decreasing order of shares. It reads an index of the delegations of each
+	// validator sorted by shares, so that it does not scan all the delegations of
+	// the validator.
+	TopDelegators(ctx context.Context, in *QueryTopDelegatorsRequest, opts ...grpc.CallOption) (*QueryTopDelegatorsResponse, error)
+	// ValidateCreateValidator checks a MsgCreateValidator against the current
+	// state and consensus params without executing it, and reports every failing
+	// check, so that validator onboarding tools can pre-flight the transaction.
+	ValidateCreateValidator(ctx context.Context, in *QueryValidateCreateValidatorRequest, opts ...grpc.CallOption) (*QueryValidateCreateValidatorResponse, error)
 }
 
 type queryClient struct {
@@ -111,6 +203,42 @@ func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest
 	return out, nil
 }
 
+func (c *queryClient) ValidatorsBelowMinCommission(ctx context.Context, in *QueryValidatorsBelowMinCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinCommissionResponse, error) {
+	out := new(QueryValidatorsBelowMinCommissionResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsBelowMinCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsBelowMinSelfDelegation(ctx context.Context, in *QueryValidatorsBelowMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	out := new(QueryValidatorsBelowMinSelfDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsBelowMinSelfDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InactiveValidators(ctx context.Context, in *QueryInactiveValidatorsRequest, opts ...grpc.CallOption) (*QueryInactiveValidatorsResponse, error) {
+	out := new(QueryInactiveValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_InactiveValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TombstonedValidators(ctx context.Context, in *QueryTombstonedValidatorsRequest, opts ...grpc.CallOption) (*QueryTombstonedValidatorsResponse, error) {
+	out := new(QueryTombstonedValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_TombstonedValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
 	out := new(QueryValidatorResponse)
 	err := c.cc.Invoke(ctx, Query_Validator_FullMethodName, in, out, opts...)
@@ -129,6 +257,38 @@ func (c *queryClient) ValidatorDelegations(ctx context.Context, in *QueryValidat
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDelegationsStream(ctx context.Context, in *QueryValidatorDelegationsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorDelegationsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_ValidatorDelegationsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryValidatorDelegationsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ValidatorDelegationsStreamClient interface {
+	Recv() (*QueryValidatorDelegationsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryValidatorDelegationsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryValidatorDelegationsStreamClient) Recv() (*QueryValidatorDelegationsStreamResponse, error) {
+	m := new(QueryValidatorDelegationsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error) {
 	out := new(QueryValidatorUnbondingDelegationsResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorUnbondingDelegations_FullMethodName, in, out, opts...)
@@ -165,6 +325,15 @@ func (c *queryClient) DelegatorDelegations(ctx context.Context, in *QueryDelegat
 	return out, nil
 }
 
+func (c *queryClient) AllDelegations(ctx context.Context, in *QueryAllDelegationsRequest, opts ...grpc.CallOption) (*QueryAllDelegationsResponse, error) {
+	out := new(QueryAllDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_AllDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingDelegationsResponse, error) {
 	out := new(QueryDelegatorUnbondingDelegationsResponse)
 	err := c.cc.Invoke(ctx, Query_DelegatorUnbondingDelegations_FullMethodName, in, out, opts...)
@@ -201,6 +370,33 @@ func (c *queryClient) DelegatorValidator(ctx context.Context, in *QueryDelegator
 	return out, nil
 }
 
+func (c *queryClient) AutoRedelegation(ctx context.Context, in *QueryAutoRedelegationRequest, opts ...grpc.CallOption) (*QueryAutoRedelegationResponse, error) {
+	out := new(QueryAutoRedelegationResponse)
+	err := c.cc.Invoke(ctx, Query_AutoRedelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error) {
+	out := new(QueryValidatorJailHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorJailHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAddresses(ctx context.Context, in *QueryValidatorAddressesRequest, opts ...grpc.CallOption) (*QueryValidatorAddressesResponse, error) {
+	out := new(QueryValidatorAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
 	out := new(QueryHistoricalInfoResponse)
 	err := c.cc.Invoke(ctx, Query_HistoricalInfo_FullMethodName, in, out, opts...)
@@ -210,6 +406,33 @@ func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInf
 	return out, nil
 }
 
+func (c *queryClient) HistoricalInfoSize(ctx context.Context, in *QueryHistoricalInfoSizeRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoSizeResponse, error) {
+	out := new(QueryHistoricalInfoSizeResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalInfoSize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetCheckpoint(ctx context.Context, in *QueryValidatorSetCheckpointRequest, opts ...grpc.CallOption) (*QueryValidatorSetCheckpointResponse, error) {
+	out := new(QueryValidatorSetCheckpointResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSetCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetCheckpoints(ctx context.Context, in *QueryValidatorSetCheckpointsRequest, opts ...grpc.CallOption) (*QueryValidatorSetCheckpointsResponse, error) {
+	out := new(QueryValidatorSetCheckpointsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSetCheckpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
 	out := new(QueryPoolResponse)
 	err := c.cc.Invoke(ctx, Query_Pool_FullMethodName, in, out, opts...)
@@ -228,6 +451,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) YieldInputs(ctx context.Context, in *QueryYieldInputsRequest, opts ...grpc.CallOption) (*QueryYieldInputsResponse, error) {
+	out := new(QueryYieldInputsResponse)
+	err := c.cc.Invoke(ctx, Query_YieldInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PowerDistribution(ctx context.Context, in *QueryPowerDistributionRequest, opts ...grpc.CallOption) (*QueryPowerDistributionResponse, error) {
+	out := new(QueryPowerDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_PowerDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopDelegators(ctx context.Context, in *QueryTopDelegatorsRequest, opts ...grpc.CallOption) (*QueryTopDelegatorsResponse, error) {
+	out := new(QueryTopDelegatorsResponse)
+	err := c.cc.Invoke(ctx, Query_TopDelegators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateCreateValidator(ctx context.Context, in *QueryValidateCreateValidatorRequest, opts ...grpc.CallOption) (*QueryValidateCreateValidatorResponse, error) {
+	out := new(QueryValidateCreateValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_ValidateCreateValidator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -237,6 +496,34 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+	// ValidatorsBelowMinCommission queries the validators whose commission rate
+	// is below the minimum commission rate of the params.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorsBelowMinCommission(context.Context, *QueryValidatorsBelowMinCommissionRequest) (*QueryValidatorsBelowMinCommissionResponse, error)
+	// ValidatorsBelowMinSelfDelegation queries the validators whose
+	// self-delegation fell below their min_self_delegation and which are not
+	// jailed yet, with the time at which they are jailed unless they top it up.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorsBelowMinSelfDelegation(context.Context, *QueryValidatorsBelowMinSelfDelegationRequest) (*QueryValidatorsBelowMinSelfDelegationResponse, error)
+	// InactiveValidators queries the validators which have been unbonded with no
+	// delegator but their operator for longer than the inactive validator cleanup
+	// period, and are removed by the cleanup unless it runs in dry-run mode.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	InactiveValidators(context.Context, *QueryInactiveValidatorsRequest) (*QueryInactiveValidatorsResponse, error)
+	// TombstonedValidators queries the validators which are tombstoned by the
+	// slashing module but are still stored by the staking module, and can
+	// therefore still be delegated to unless the reject_tombstoned_delegations
+	// param is enabled.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TombstonedValidators(context.Context, *QueryTombstonedValidatorsRequest) (*QueryTombstonedValidatorsResponse, error)
 	// Validator queries validator info for given validator address.
 	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
 	// ValidatorDelegations queries delegate info for given validator.
@@ -244,6 +531,10 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	ValidatorDelegations(context.Context, *QueryValidatorDelegationsRequest) (*QueryValidatorDelegationsResponse, error)
+	// ValidatorDelegationsStream streams all the delegations of a validator, in
+	// batches all read from the state at the queried height. It is only served by
+	// the gRPC server of a node, which rate limits the streams.
+	ValidatorDelegationsStream(*QueryValidatorDelegationsStreamRequest, Query_ValidatorDelegationsStreamServer) error
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -259,6 +550,10 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error)
+	// AllDelegations queries the delegations of all the delegators, optionally
+	// filtered by balance and excluding module accounts, e.g. to export the
+	// distribution of the stake at a given height.
+	AllDelegations(context.Context, *QueryAllDelegationsRequest) (*QueryAllDelegationsResponse, error)
 	// DelegatorUnbondingDelegations queries all unbonding delegations of a given
 	// delegator address.
 	//
@@ -279,12 +574,52 @@ type QueryServer interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
+	// AutoRedelegation queries the auto-redelegation preference of a delegator.
+	AutoRedelegation(context.Context, *QueryAutoRedelegationRequest) (*QueryAutoRedelegationResponse, error)
+	// ValidatorJailHistory queries the jail history of a validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error)
+	// ValidatorAddresses resolves the operator, consensus and account addresses
+	// of a validator from any of them.
+	ValidatorAddresses(context.Context, *QueryValidatorAddressesRequest) (*QueryValidatorAddressesResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
+	// HistoricalInfoSize queries the current size of the historical info store.
+	HistoricalInfoSize(context.Context, *QueryHistoricalInfoSizeRequest) (*QueryHistoricalInfoSizeResponse, error)
+	// ValidatorSetCheckpoint queries the validator set checkpoint taken at a
+	// given height.
+	ValidatorSetCheckpoint(context.Context, *QueryValidatorSetCheckpointRequest) (*QueryValidatorSetCheckpointResponse, error)
+	// ValidatorSetCheckpoints queries all the validator set checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	ValidatorSetCheckpoints(context.Context, *QueryValidatorSetCheckpointsRequest) (*QueryValidatorSetCheckpointsResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// YieldInputs queries, at a single height, the inputs of the estimation of
+	// the staking yield: the bonded ratio, the inflation, the community tax and,
+	// optionally, the commission rate of a validator, so that wallets compute the
+	// APR consistently instead of mixing heights across modules.
+	YieldInputs(context.Context, *QueryYieldInputsRequest) (*QueryYieldInputsResponse, error)
+	// PowerDistribution queries a summary of the distribution of the consensus
+	// power among the bonded validators: the cumulative share of the top
+	// validators, the Nakamoto coefficient and a histogram of the powers. It
+	// reads an index of the validator powers sorted by power, maintained as the
+	// validator set is updated.
+	PowerDistribution(context.Context, *QueryPowerDistributionRequest) (*QueryPowerDistributionResponse, error)
+	// TopDelegators queries the delegations of a validator with the most shares,
+	// in decreasing order of shares. It reads an index of the delegations of each
+	// validator sorted by shares, so that it does not scan all the delegations of
+	// the validator.
+	TopDelegators(context.Context, *QueryTopDelegatorsRequest) (*QueryTopDelegatorsResponse, error)
+	// ValidateCreateValidator checks a MsgCreateValidator against the current
+	// state and consensus params without executing it, and reports every failing
+	// check, so that validator onboarding tools can pre-flight the transaction.
+	ValidateCreateValidator(context.Context, *QueryValidateCreateValidatorRequest) (*QueryValidateCreateValidatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -295,12 +630,27 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
 }
+func (UnimplementedQueryServer) ValidatorsBelowMinCommission(context.Context, *QueryValidatorsBelowMinCommissionRequest) (*QueryValidatorsBelowMinCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsBelowMinCommission not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsBelowMinSelfDelegation(context.Context, *QueryValidatorsBelowMinSelfDelegationRequest) (*QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsBelowMinSelfDelegation not implemented")
+}
+func (UnimplementedQueryServer) InactiveValidators(context.Context, *QueryInactiveValidatorsRequest) (*QueryInactiveValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveValidators not implemented")
+}
+func (UnimplementedQueryServer) TombstonedValidators(context.Context, *QueryTombstonedValidatorsRequest) (*QueryTombstonedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TombstonedValidators not implemented")
+}
 func (UnimplementedQueryServer) Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
 }
 func (UnimplementedQueryServer) ValidatorDelegations(context.Context, *QueryValidatorDelegationsRequest) (*QueryValidatorDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegations not implemented")
 }
+func (UnimplementedQueryServer) ValidatorDelegationsStream(*QueryValidatorDelegationsStreamRequest, Query_ValidatorDelegationsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatorDelegationsStream not implemented")
+}
 func (UnimplementedQueryServer) ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
 }
@@ -313,6 +663,9 @@ func (UnimplementedQueryServer) UnbondingDelegation(context.Context, *QueryUnbon
 func (UnimplementedQueryServer) DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegations not implemented")
 }
+func (UnimplementedQueryServer) AllDelegations(context.Context, *QueryAllDelegationsRequest) (*QueryAllDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDelegations not implemented")
+}
 func (UnimplementedQueryServer) DelegatorUnbondingDelegations(context.Context, *QueryDelegatorUnbondingDelegationsRequest) (*QueryDelegatorUnbondingDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingDelegations not implemented")
 }
@@ -325,15 +678,45 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidator not implemented")
 }
+func (UnimplementedQueryServer) AutoRedelegation(context.Context, *QueryAutoRedelegationRequest) (*QueryAutoRedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoRedelegation not implemented")
+}
+func (UnimplementedQueryServer) ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorJailHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorAddresses(context.Context, *QueryValidatorAddressesRequest) (*QueryValidatorAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAddresses not implemented")
+}
 func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
+func (UnimplementedQueryServer) HistoricalInfoSize(context.Context, *QueryHistoricalInfoSizeRequest) (*QueryHistoricalInfoSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfoSize not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSetCheckpoint(context.Context, *QueryValidatorSetCheckpointRequest) (*QueryValidatorSetCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetCheckpoint not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSetCheckpoints(context.Context, *QueryValidatorSetCheckpointsRequest) (*QueryValidatorSetCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetCheckpoints not implemented")
+}
 func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) YieldInputs(context.Context, *QueryYieldInputsRequest) (*QueryYieldInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldInputs not implemented")
+}
+func (UnimplementedQueryServer) PowerDistribution(context.Context, *QueryPowerDistributionRequest) (*QueryPowerDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerDistribution not implemented")
+}
+func (UnimplementedQueryServer) TopDelegators(context.Context, *QueryTopDelegatorsRequest) (*QueryTopDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopDelegators not implemented")
+}
+func (UnimplementedQueryServer) ValidateCreateValidator(context.Context, *QueryValidateCreateValidatorRequest) (*QueryValidateCreateValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreateValidator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -365,6 +748,78 @@ func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorsBelowMinCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsBelowMinCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsBelowMinCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsBelowMinCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsBelowMinCommission(ctx, req.(*QueryValidatorsBelowMinCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsBelowMinSelfDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsBelowMinSelfDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsBelowMinSelfDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsBelowMinSelfDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsBelowMinSelfDelegation(ctx, req.(*QueryValidatorsBelowMinSelfDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InactiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InactiveValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveValidators(ctx, req.(*QueryInactiveValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TombstonedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTombstonedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TombstonedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TombstonedValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TombstonedValidators(ctx, req.(*QueryTombstonedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorRequest)
 	if err := dec(in); err != nil {
@@ -401,6 +856,27 @@ func _Query_ValidatorDelegations_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorDelegationsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryValidatorDelegationsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ValidatorDelegationsStream(m, &queryValidatorDelegationsStreamServer{stream})
+}
+
+type Query_ValidatorDelegationsStreamServer interface {
+	Send(*QueryValidatorDelegationsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryValidatorDelegationsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryValidatorDelegationsStreamServer) Send(m *QueryValidatorDelegationsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorUnbondingDelegationsRequest)
 	if err := dec(in); err != nil {
@@ -473,6 +949,24 @@ func _Query_DelegatorDelegations_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDelegations(ctx, req.(*QueryAllDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorUnbondingDelegationsRequest)
 	if err := dec(in); err != nil {
@@ -545,6 +1039,60 @@ func _Query_DelegatorValidator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoRedelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoRedelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoRedelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoRedelegation(ctx, req.(*QueryAutoRedelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorJailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorJailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, req.(*QueryValidatorJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAddresses(ctx, req.(*QueryValidatorAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoricalInfoRequest)
 	if err := dec(in); err != nil {
@@ -563,6 +1111,60 @@ func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalInfoSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalInfoSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfoSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalInfoSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfoSize(ctx, req.(*QueryHistoricalInfoSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSetCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetCheckpoint(ctx, req.(*QueryValidatorSetCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSetCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetCheckpoints(ctx, req.(*QueryValidatorSetCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolRequest)
 	if err := dec(in); err != nil {
@@ -599,6 +1201,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_YieldInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldInputs(ctx, req.(*QueryYieldInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PowerDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPowerDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PowerDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PowerDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PowerDistribution(ctx, req.(*QueryPowerDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TopDelegators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopDelegators(ctx, req.(*QueryTopDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateCreateValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateCreateValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateCreateValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidateCreateValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateCreateValidator(ctx, req.(*QueryValidateCreateValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -610,6 +1284,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Validators",
 			Handler:    _Query_Validators_Handler,
 		},
+		{
+			MethodName: "ValidatorsBelowMinCommission",
+			Handler:    _Query_ValidatorsBelowMinCommission_Handler,
+		},
+		{
+			MethodName: "ValidatorsBelowMinSelfDelegation",
+			Handler:    _Query_ValidatorsBelowMinSelfDelegation_Handler,
+		},
+		{
+			MethodName: "InactiveValidators",
+			Handler:    _Query_InactiveValidators_Handler,
+		},
+		{
+			MethodName: "TombstonedValidators",
+			Handler:    _Query_TombstonedValidators_Handler,
+		},
 		{
 			MethodName: "Validator",
 			Handler:    _Query_Validator_Handler,
@@ -634,6 +1324,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorDelegations",
 			Handler:    _Query_DelegatorDelegations_Handler,
 		},
+		{
+			MethodName: "AllDelegations",
+			Handler:    _Query_AllDelegations_Handler,
+		},
 		{
 			MethodName: "DelegatorUnbondingDelegations",
 			Handler:    _Query_DelegatorUnbondingDelegations_Handler,
@@ -650,10 +1344,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidator",
 			Handler:    _Query_DelegatorValidator_Handler,
 		},
+		{
+			MethodName: "AutoRedelegation",
+			Handler:    _Query_AutoRedelegation_Handler,
+		},
+		{
+			MethodName: "ValidatorJailHistory",
+			Handler:    _Query_ValidatorJailHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorAddresses",
+			Handler:    _Query_ValidatorAddresses_Handler,
+		},
 		{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
 		},
+		{
+			MethodName: "HistoricalInfoSize",
+			Handler:    _Query_HistoricalInfoSize_Handler,
+		},
+		{
+			MethodName: "ValidatorSetCheckpoint",
+			Handler:    _Query_ValidatorSetCheckpoint_Handler,
+		},
+		{
+			MethodName: "ValidatorSetCheckpoints",
+			Handler:    _Query_ValidatorSetCheckpoints_Handler,
+		},
 		{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
@@ -662,7 +1380,29 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "YieldInputs",
+			Handler:    _Query_YieldInputs_Handler,
+		},
+		{
+			MethodName: "PowerDistribution",
+			Handler:    _Query_PowerDistribution_Handler,
+		},
+		{
+			MethodName: "TopDelegators",
+			Handler:    _Query_TopDelegators_Handler,
+		},
+		{
+			MethodName: "ValidateCreateValidator",
+			Handler:    _Query_ValidateCreateValidator_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidatorDelegationsStream",
+			Handler:       _Query_ValidatorDelegationsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
 }
@@ -594,30 +594,85 @@ func (x *fastReflection_HistoricalInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ValidatorSetCheckpoint_5_list)(nil)
+
+type _ValidatorSetCheckpoint_5_list struct {
+	list *[]*CheckpointValidator
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CheckpointValidator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CheckpointValidator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) AppendMutable() protoreflect.Value {
+	v := new(CheckpointValidator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) NewElement() protoreflect.Value {
+	v := new(CheckpointValidator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorSetCheckpoint_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_CommissionRates                 protoreflect.MessageDescriptor
-	fd_CommissionRates_rate            protoreflect.FieldDescriptor
-	fd_CommissionRates_max_rate        protoreflect.FieldDescriptor
-	fd_CommissionRates_max_change_rate protoreflect.FieldDescriptor
+	md_ValidatorSetCheckpoint                 protoreflect.MessageDescriptor
+	fd_ValidatorSetCheckpoint_height          protoreflect.FieldDescriptor
+	fd_ValidatorSetCheckpoint_time            protoreflect.FieldDescriptor
+	fd_ValidatorSetCheckpoint_validators_hash protoreflect.FieldDescriptor
+	fd_ValidatorSetCheckpoint_total_power     protoreflect.FieldDescriptor
+	fd_ValidatorSetCheckpoint_validators      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_CommissionRates = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("CommissionRates")
-	fd_CommissionRates_rate = md_CommissionRates.Fields().ByName("rate")
-	fd_CommissionRates_max_rate = md_CommissionRates.Fields().ByName("max_rate")
-	fd_CommissionRates_max_change_rate = md_CommissionRates.Fields().ByName("max_change_rate")
+	md_ValidatorSetCheckpoint = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("ValidatorSetCheckpoint")
+	fd_ValidatorSetCheckpoint_height = md_ValidatorSetCheckpoint.Fields().ByName("height")
+	fd_ValidatorSetCheckpoint_time = md_ValidatorSetCheckpoint.Fields().ByName("time")
+	fd_ValidatorSetCheckpoint_validators_hash = md_ValidatorSetCheckpoint.Fields().ByName("validators_hash")
+	fd_ValidatorSetCheckpoint_total_power = md_ValidatorSetCheckpoint.Fields().ByName("total_power")
+	fd_ValidatorSetCheckpoint_validators = md_ValidatorSetCheckpoint.Fields().ByName("validators")
 }
 
-var _ protoreflect.Message = (*fastReflection_CommissionRates)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSetCheckpoint)(nil)
 
-type fastReflection_CommissionRates CommissionRates
+type fastReflection_ValidatorSetCheckpoint ValidatorSetCheckpoint
 
-func (x *CommissionRates) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CommissionRates)(x)
+func (x *ValidatorSetCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSetCheckpoint)(x)
 }
 
-func (x *CommissionRates) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSetCheckpoint) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,43 +684,43 @@ func (x *CommissionRates) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CommissionRates_messageType fastReflection_CommissionRates_messageType
-var _ protoreflect.MessageType = fastReflection_CommissionRates_messageType{}
+var _fastReflection_ValidatorSetCheckpoint_messageType fastReflection_ValidatorSetCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSetCheckpoint_messageType{}
 
-type fastReflection_CommissionRates_messageType struct{}
+type fastReflection_ValidatorSetCheckpoint_messageType struct{}
 
-func (x fastReflection_CommissionRates_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CommissionRates)(nil)
+func (x fastReflection_ValidatorSetCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSetCheckpoint)(nil)
 }
-func (x fastReflection_CommissionRates_messageType) New() protoreflect.Message {
-	return new(fastReflection_CommissionRates)
+func (x fastReflection_ValidatorSetCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetCheckpoint)
 }
-func (x fastReflection_CommissionRates_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommissionRates
+func (x fastReflection_ValidatorSetCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetCheckpoint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CommissionRates) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommissionRates
+func (x *fastReflection_ValidatorSetCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetCheckpoint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CommissionRates) Type() protoreflect.MessageType {
-	return _fastReflection_CommissionRates_messageType
+func (x *fastReflection_ValidatorSetCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSetCheckpoint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CommissionRates) New() protoreflect.Message {
-	return new(fastReflection_CommissionRates)
+func (x *fastReflection_ValidatorSetCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetCheckpoint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CommissionRates) Interface() protoreflect.ProtoMessage {
-	return (*CommissionRates)(x)
+func (x *fastReflection_ValidatorSetCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSetCheckpoint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -673,22 +728,34 @@ func (x *fastReflection_CommissionRates) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CommissionRates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Rate != "" {
-		value := protoreflect.ValueOfString(x.Rate)
-		if !f(fd_CommissionRates_rate, value) {
+func (x *fastReflection_ValidatorSetCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ValidatorSetCheckpoint_height, value) {
 			return
 		}
 	}
-	if x.MaxRate != "" {
-		value := protoreflect.ValueOfString(x.MaxRate)
-		if !f(fd_CommissionRates_max_rate, value) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_ValidatorSetCheckpoint_time, value) {
 			return
 		}
 	}
-	if x.MaxChangeRate != "" {
-		value := protoreflect.ValueOfString(x.MaxChangeRate)
-		if !f(fd_CommissionRates_max_change_rate, value) {
+	if len(x.ValidatorsHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorsHash)
+		if !f(fd_ValidatorSetCheckpoint_validators_hash, value) {
+			return
+		}
+	}
+	if x.TotalPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalPower)
+		if !f(fd_ValidatorSetCheckpoint_total_power, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorSetCheckpoint_5_list{list: &x.Validators})
+		if !f(fd_ValidatorSetCheckpoint_validators, value) {
 			return
 		}
 	}
@@ -705,19 +772,23 @@ func (x *fastReflection_CommissionRates) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CommissionRates) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSetCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		return x.Rate != ""
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		return x.MaxRate != ""
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		return x.MaxChangeRate != ""
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		return x.Height != int64(0)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		return x.Time != nil
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		return len(x.ValidatorsHash) != 0
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		return x.TotalPower != int64(0)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		return len(x.Validators) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -727,19 +798,23 @@ func (x *fastReflection_CommissionRates) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommissionRates) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSetCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		x.Rate = ""
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		x.MaxRate = ""
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		x.MaxChangeRate = ""
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		x.Height = int64(0)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		x.Time = nil
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		x.ValidatorsHash = nil
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		x.TotalPower = int64(0)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		x.Validators = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -749,22 +824,31 @@ func (x *fastReflection_CommissionRates) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CommissionRates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSetCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		value := x.Rate
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		value := x.MaxRate
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		value := x.MaxChangeRate
-		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		value := x.ValidatorsHash
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorSetCheckpoint_5_list{})
+		}
+		listValue := &_ValidatorSetCheckpoint_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -778,19 +862,25 @@ func (x *fastReflection_CommissionRates) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommissionRates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSetCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		x.Rate = value.Interface().(string)
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		x.MaxRate = value.Interface().(string)
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		x.MaxChangeRate = value.Interface().(string)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		x.Height = value.Int()
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		x.ValidatorsHash = value.Bytes()
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		x.TotalPower = value.Int()
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		lv := value.List()
+		clv := lv.(*_ValidatorSetCheckpoint_5_list)
+		x.Validators = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -804,48 +894,65 @@ func (x *fastReflection_CommissionRates) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommissionRates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSetCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		panic(fmt.Errorf("field rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		panic(fmt.Errorf("field max_rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		panic(fmt.Errorf("field max_change_rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		if x.Validators == nil {
+			x.Validators = []*CheckpointValidator{}
+		}
+		value := &_ValidatorSetCheckpoint_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		panic(fmt.Errorf("field height of message cosmos.staking.v1beta1.ValidatorSetCheckpoint is not mutable"))
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		panic(fmt.Errorf("field validators_hash of message cosmos.staking.v1beta1.ValidatorSetCheckpoint is not mutable"))
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		panic(fmt.Errorf("field total_power of message cosmos.staking.v1beta1.ValidatorSetCheckpoint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CommissionRates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSetCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.CommissionRates.rate":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
-		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.total_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators":
+		list := []*CheckpointValidator{}
+		return protoreflect.ValueOfList(&_ValidatorSetCheckpoint_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValidatorSetCheckpoint"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValidatorSetCheckpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CommissionRates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSetCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.CommissionRates", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.ValidatorSetCheckpoint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -853,7 +960,7 @@ func (x *fastReflection_CommissionRates) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CommissionRates) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSetCheckpoint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -864,7 +971,7 @@ func (x *fastReflection_CommissionRates) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommissionRates) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSetCheckpoint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -876,7 +983,7 @@ func (x *fastReflection_CommissionRates) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CommissionRates) IsValid() bool {
+func (x *fastReflection_ValidatorSetCheckpoint) IsValid() bool {
 	return x != nil
 }
 
@@ -886,9 +993,9 @@ func (x *fastReflection_CommissionRates) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSetCheckpoint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CommissionRates)
+		x := input.Message.Interface().(*ValidatorSetCheckpoint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -900,18 +1007,26 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Rate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
-		l = len(x.MaxRate)
-		if l > 0 {
+		if x.Time != nil {
+			l = options.Size(x.Time)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxChangeRate)
+		l = len(x.ValidatorsHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TotalPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPower))
+		}
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -922,7 +1037,7 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CommissionRates)
+		x := input.Message.Interface().(*ValidatorSetCheckpoint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -941,26 +1056,52 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxChangeRate) > 0 {
-			i -= len(x.MaxChangeRate)
-			copy(dAtA[i:], x.MaxChangeRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangeRate)))
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.TotalPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPower))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ValidatorsHash) > 0 {
+			i -= len(x.ValidatorsHash)
+			copy(dAtA[i:], x.ValidatorsHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsHash)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MaxRate) > 0 {
-			i -= len(x.MaxRate)
-			copy(dAtA[i:], x.MaxRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRate)))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Rate) > 0 {
-			i -= len(x.Rate)
-			copy(dAtA[i:], x.Rate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -973,7 +1114,7 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CommissionRates)
+		x := input.Message.Interface().(*ValidatorSetCheckpoint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1005,17 +1146,17 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetCheckpoint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var stringLen uint64
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1025,29 +1166,16 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Rate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1057,29 +1185,33 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxRate = string(dAtA[iNdEx:postIndex])
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1089,28 +1221,83 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxChangeRate = string(dAtA[iNdEx:postIndex])
+				x.ValidatorsHash = append(x.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorsHash == nil {
+					x.ValidatorsHash = []byte{}
+				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				x.TotalPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &CheckpointValidator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -1143,27 +1330,27 @@ func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Commission                  protoreflect.MessageDescriptor
-	fd_Commission_commission_rates protoreflect.FieldDescriptor
-	fd_Commission_update_time      protoreflect.FieldDescriptor
+	md_CheckpointValidator              protoreflect.MessageDescriptor
+	fd_CheckpointValidator_cons_address protoreflect.FieldDescriptor
+	fd_CheckpointValidator_power        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Commission = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Commission")
-	fd_Commission_commission_rates = md_Commission.Fields().ByName("commission_rates")
-	fd_Commission_update_time = md_Commission.Fields().ByName("update_time")
+	md_CheckpointValidator = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("CheckpointValidator")
+	fd_CheckpointValidator_cons_address = md_CheckpointValidator.Fields().ByName("cons_address")
+	fd_CheckpointValidator_power = md_CheckpointValidator.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_Commission)(nil)
+var _ protoreflect.Message = (*fastReflection_CheckpointValidator)(nil)
 
-type fastReflection_Commission Commission
+type fastReflection_CheckpointValidator CheckpointValidator
 
-func (x *Commission) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Commission)(x)
+func (x *CheckpointValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CheckpointValidator)(x)
 }
 
-func (x *Commission) slowProtoReflect() protoreflect.Message {
+func (x *CheckpointValidator) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,43 +1362,43 @@ func (x *Commission) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Commission_messageType fastReflection_Commission_messageType
-var _ protoreflect.MessageType = fastReflection_Commission_messageType{}
+var _fastReflection_CheckpointValidator_messageType fastReflection_CheckpointValidator_messageType
+var _ protoreflect.MessageType = fastReflection_CheckpointValidator_messageType{}
 
-type fastReflection_Commission_messageType struct{}
+type fastReflection_CheckpointValidator_messageType struct{}
 
-func (x fastReflection_Commission_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Commission)(nil)
+func (x fastReflection_CheckpointValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CheckpointValidator)(nil)
 }
-func (x fastReflection_Commission_messageType) New() protoreflect.Message {
-	return new(fastReflection_Commission)
+func (x fastReflection_CheckpointValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_CheckpointValidator)
 }
-func (x fastReflection_Commission_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Commission
+func (x fastReflection_CheckpointValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CheckpointValidator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Commission) Descriptor() protoreflect.MessageDescriptor {
-	return md_Commission
+func (x *fastReflection_CheckpointValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_CheckpointValidator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Commission) Type() protoreflect.MessageType {
-	return _fastReflection_Commission_messageType
+func (x *fastReflection_CheckpointValidator) Type() protoreflect.MessageType {
+	return _fastReflection_CheckpointValidator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Commission) New() protoreflect.Message {
-	return new(fastReflection_Commission)
+func (x *fastReflection_CheckpointValidator) New() protoreflect.Message {
+	return new(fastReflection_CheckpointValidator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Commission) Interface() protoreflect.ProtoMessage {
-	return (*Commission)(x)
+func (x *fastReflection_CheckpointValidator) Interface() protoreflect.ProtoMessage {
+	return (*CheckpointValidator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1219,16 +1406,16 @@ func (x *fastReflection_Commission) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Commission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CommissionRates != nil {
-		value := protoreflect.ValueOfMessage(x.CommissionRates.ProtoReflect())
-		if !f(fd_Commission_commission_rates, value) {
+func (x *fastReflection_CheckpointValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsAddress)
+		if !f(fd_CheckpointValidator_cons_address, value) {
 			return
 		}
 	}
-	if x.UpdateTime != nil {
-		value := protoreflect.ValueOfMessage(x.UpdateTime.ProtoReflect())
-		if !f(fd_Commission_update_time, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_CheckpointValidator_power, value) {
 			return
 		}
 	}
@@ -1245,17 +1432,17 @@ func (x *fastReflection_Commission) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Commission) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CheckpointValidator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		return x.CommissionRates != nil
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		return x.UpdateTime != nil
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		return x.ConsAddress != ""
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		return x.Power != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1265,17 +1452,17 @@ func (x *fastReflection_Commission) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Commission) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CheckpointValidator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		x.CommissionRates = nil
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		x.UpdateTime = nil
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		x.ConsAddress = ""
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		x.Power = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1285,19 +1472,19 @@ func (x *fastReflection_Commission) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Commission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckpointValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		value := x.CommissionRates
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		value := x.UpdateTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		value := x.ConsAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1311,17 +1498,17 @@ func (x *fastReflection_Commission) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Commission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CheckpointValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		x.CommissionRates = value.Message().Interface().(*CommissionRates)
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		x.UpdateTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		x.ConsAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		x.Power = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1335,52 +1522,44 @@ func (x *fastReflection_Commission) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Commission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckpointValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		if x.CommissionRates == nil {
-			x.CommissionRates = new(CommissionRates)
-		}
-		return protoreflect.ValueOfMessage(x.CommissionRates.ProtoReflect())
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		if x.UpdateTime == nil {
-			x.UpdateTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.UpdateTime.ProtoReflect())
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		panic(fmt.Errorf("field cons_address of message cosmos.staking.v1beta1.CheckpointValidator is not mutable"))
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		panic(fmt.Errorf("field power of message cosmos.staking.v1beta1.CheckpointValidator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Commission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckpointValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Commission.commission_rates":
-		m := new(CommissionRates)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Commission.update_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.CheckpointValidator.cons_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.CheckpointValidator.power":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CheckpointValidator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CheckpointValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Commission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CheckpointValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Commission", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.CheckpointValidator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1388,7 +1567,7 @@ func (x *fastReflection_Commission) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Commission) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CheckpointValidator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1399,7 +1578,7 @@ func (x *fastReflection_Commission) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Commission) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CheckpointValidator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1411,7 +1590,7 @@ func (x *fastReflection_Commission) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Commission) IsValid() bool {
+func (x *fastReflection_CheckpointValidator) IsValid() bool {
 	return x != nil
 }
 
@@ -1421,9 +1600,9 @@ func (x *fastReflection_Commission) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CheckpointValidator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Commission)
+		x := input.Message.Interface().(*CheckpointValidator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1435,13 +1614,12 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.CommissionRates != nil {
-			l = options.Size(x.CommissionRates)
+		l = len(x.ConsAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.UpdateTime != nil {
-			l = options.Size(x.UpdateTime)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1453,7 +1631,7 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Commission)
+		x := input.Message.Interface().(*CheckpointValidator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1472,31 +1650,15 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.UpdateTime != nil {
-			encoded, err := options.Marshal(x.UpdateTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.CommissionRates != nil {
-			encoded, err := options.Marshal(x.CommissionRates)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ConsAddress) > 0 {
+			i -= len(x.ConsAddress)
+			copy(dAtA[i:], x.ConsAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1511,7 +1673,7 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Commission)
+		x := input.Message.Interface().(*CheckpointValidator)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1543,17 +1705,17 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Commission: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CheckpointValidator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CheckpointValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1563,33 +1725,29 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CommissionRates == nil {
-					x.CommissionRates = &CommissionRates{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommissionRates); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ConsAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				var msglen int
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1599,28 +1757,11 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.UpdateTime == nil {
-					x.UpdateTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1657,33 +1798,29 @@ func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Description                  protoreflect.MessageDescriptor
-	fd_Description_moniker          protoreflect.FieldDescriptor
-	fd_Description_identity         protoreflect.FieldDescriptor
-	fd_Description_website          protoreflect.FieldDescriptor
-	fd_Description_security_contact protoreflect.FieldDescriptor
-	fd_Description_details          protoreflect.FieldDescriptor
+	md_CommissionRates                 protoreflect.MessageDescriptor
+	fd_CommissionRates_rate            protoreflect.FieldDescriptor
+	fd_CommissionRates_max_rate        protoreflect.FieldDescriptor
+	fd_CommissionRates_max_change_rate protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Description = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Description")
-	fd_Description_moniker = md_Description.Fields().ByName("moniker")
-	fd_Description_identity = md_Description.Fields().ByName("identity")
-	fd_Description_website = md_Description.Fields().ByName("website")
-	fd_Description_security_contact = md_Description.Fields().ByName("security_contact")
-	fd_Description_details = md_Description.Fields().ByName("details")
+	md_CommissionRates = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("CommissionRates")
+	fd_CommissionRates_rate = md_CommissionRates.Fields().ByName("rate")
+	fd_CommissionRates_max_rate = md_CommissionRates.Fields().ByName("max_rate")
+	fd_CommissionRates_max_change_rate = md_CommissionRates.Fields().ByName("max_change_rate")
 }
 
-var _ protoreflect.Message = (*fastReflection_Description)(nil)
+var _ protoreflect.Message = (*fastReflection_CommissionRates)(nil)
 
-type fastReflection_Description Description
+type fastReflection_CommissionRates CommissionRates
 
-func (x *Description) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Description)(x)
+func (x *CommissionRates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CommissionRates)(x)
 }
 
-func (x *Description) slowProtoReflect() protoreflect.Message {
+func (x *CommissionRates) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1695,43 +1832,43 @@ func (x *Description) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Description_messageType fastReflection_Description_messageType
-var _ protoreflect.MessageType = fastReflection_Description_messageType{}
+var _fastReflection_CommissionRates_messageType fastReflection_CommissionRates_messageType
+var _ protoreflect.MessageType = fastReflection_CommissionRates_messageType{}
 
-type fastReflection_Description_messageType struct{}
+type fastReflection_CommissionRates_messageType struct{}
 
-func (x fastReflection_Description_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Description)(nil)
+func (x fastReflection_CommissionRates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CommissionRates)(nil)
 }
-func (x fastReflection_Description_messageType) New() protoreflect.Message {
-	return new(fastReflection_Description)
+func (x fastReflection_CommissionRates_messageType) New() protoreflect.Message {
+	return new(fastReflection_CommissionRates)
 }
-func (x fastReflection_Description_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Description
+func (x fastReflection_CommissionRates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommissionRates
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Description) Descriptor() protoreflect.MessageDescriptor {
-	return md_Description
+func (x *fastReflection_CommissionRates) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommissionRates
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Description) Type() protoreflect.MessageType {
-	return _fastReflection_Description_messageType
+func (x *fastReflection_CommissionRates) Type() protoreflect.MessageType {
+	return _fastReflection_CommissionRates_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Description) New() protoreflect.Message {
-	return new(fastReflection_Description)
+func (x *fastReflection_CommissionRates) New() protoreflect.Message {
+	return new(fastReflection_CommissionRates)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Description) Interface() protoreflect.ProtoMessage {
-	return (*Description)(x)
+func (x *fastReflection_CommissionRates) Interface() protoreflect.ProtoMessage {
+	return (*CommissionRates)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1739,34 +1876,22 @@ func (x *fastReflection_Description) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Description) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Moniker != "" {
-		value := protoreflect.ValueOfString(x.Moniker)
-		if !f(fd_Description_moniker, value) {
-			return
-		}
-	}
-	if x.Identity != "" {
-		value := protoreflect.ValueOfString(x.Identity)
-		if !f(fd_Description_identity, value) {
-			return
-		}
-	}
-	if x.Website != "" {
-		value := protoreflect.ValueOfString(x.Website)
-		if !f(fd_Description_website, value) {
+func (x *fastReflection_CommissionRates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_CommissionRates_rate, value) {
 			return
 		}
 	}
-	if x.SecurityContact != "" {
-		value := protoreflect.ValueOfString(x.SecurityContact)
-		if !f(fd_Description_security_contact, value) {
+	if x.MaxRate != "" {
+		value := protoreflect.ValueOfString(x.MaxRate)
+		if !f(fd_CommissionRates_max_rate, value) {
 			return
 		}
 	}
-	if x.Details != "" {
-		value := protoreflect.ValueOfString(x.Details)
-		if !f(fd_Description_details, value) {
+	if x.MaxChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxChangeRate)
+		if !f(fd_CommissionRates_max_change_rate, value) {
 			return
 		}
 	}
@@ -1783,23 +1908,19 @@ func (x *fastReflection_Description) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Description) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CommissionRates) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		return x.Moniker != ""
-	case "cosmos.staking.v1beta1.Description.identity":
-		return x.Identity != ""
-	case "cosmos.staking.v1beta1.Description.website":
-		return x.Website != ""
-	case "cosmos.staking.v1beta1.Description.security_contact":
-		return x.SecurityContact != ""
-	case "cosmos.staking.v1beta1.Description.details":
-		return x.Details != ""
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
+		return x.Rate != ""
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
+		return x.MaxRate != ""
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
+		return x.MaxChangeRate != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1809,23 +1930,19 @@ func (x *fastReflection_Description) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Description) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CommissionRates) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		x.Moniker = ""
-	case "cosmos.staking.v1beta1.Description.identity":
-		x.Identity = ""
-	case "cosmos.staking.v1beta1.Description.website":
-		x.Website = ""
-	case "cosmos.staking.v1beta1.Description.security_contact":
-		x.SecurityContact = ""
-	case "cosmos.staking.v1beta1.Description.details":
-		x.Details = ""
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
+		x.Rate = ""
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
+		x.MaxRate = ""
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
+		x.MaxChangeRate = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1835,28 +1952,22 @@ func (x *fastReflection_Description) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Description) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommissionRates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		value := x.Moniker
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Description.identity":
-		value := x.Identity
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Description.website":
-		value := x.Website
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
+		value := x.Rate
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Description.security_contact":
-		value := x.SecurityContact
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
+		value := x.MaxRate
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Description.details":
-		value := x.Details
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
+		value := x.MaxChangeRate
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1870,23 +1981,19 @@ func (x *fastReflection_Description) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Description) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CommissionRates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		x.Moniker = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Description.identity":
-		x.Identity = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Description.website":
-		x.Website = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Description.security_contact":
-		x.SecurityContact = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Description.details":
-		x.Details = value.Interface().(string)
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
+		x.Rate = value.Interface().(string)
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
+		x.MaxRate = value.Interface().(string)
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
+		x.MaxChangeRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1900,56 +2007,48 @@ func (x *fastReflection_Description) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Description) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommissionRates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		panic(fmt.Errorf("field moniker of message cosmos.staking.v1beta1.Description is not mutable"))
-	case "cosmos.staking.v1beta1.Description.identity":
-		panic(fmt.Errorf("field identity of message cosmos.staking.v1beta1.Description is not mutable"))
-	case "cosmos.staking.v1beta1.Description.website":
-		panic(fmt.Errorf("field website of message cosmos.staking.v1beta1.Description is not mutable"))
-	case "cosmos.staking.v1beta1.Description.security_contact":
-		panic(fmt.Errorf("field security_contact of message cosmos.staking.v1beta1.Description is not mutable"))
-	case "cosmos.staking.v1beta1.Description.details":
-		panic(fmt.Errorf("field details of message cosmos.staking.v1beta1.Description is not mutable"))
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
+		panic(fmt.Errorf("field rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
+		panic(fmt.Errorf("field max_rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
+		panic(fmt.Errorf("field max_change_rate of message cosmos.staking.v1beta1.CommissionRates is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Description) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommissionRates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Description.moniker":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Description.identity":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Description.website":
+	case "cosmos.staking.v1beta1.CommissionRates.rate":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Description.security_contact":
+	case "cosmos.staking.v1beta1.CommissionRates.max_rate":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Description.details":
+	case "cosmos.staking.v1beta1.CommissionRates.max_change_rate":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.CommissionRates"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.CommissionRates does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Description) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CommissionRates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Description", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.CommissionRates", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1957,7 +2056,7 @@ func (x *fastReflection_Description) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Description) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CommissionRates) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1968,7 +2067,7 @@ func (x *fastReflection_Description) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Description) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CommissionRates) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1980,7 +2079,7 @@ func (x *fastReflection_Description) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Description) IsValid() bool {
+func (x *fastReflection_CommissionRates) IsValid() bool {
 	return x != nil
 }
 
@@ -1990,9 +2089,9 @@ func (x *fastReflection_Description) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CommissionRates) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Description)
+		x := input.Message.Interface().(*CommissionRates)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2004,23 +2103,15 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Moniker)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Identity)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Website)
+		l = len(x.Rate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SecurityContact)
+		l = len(x.MaxRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Details)
+		l = len(x.MaxChangeRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2034,7 +2125,7 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Description)
+		x := input.Message.Interface().(*CommissionRates)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2053,41 +2144,27 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Details) > 0 {
-			i -= len(x.Details)
-			copy(dAtA[i:], x.Details)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Details)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.SecurityContact) > 0 {
-			i -= len(x.SecurityContact)
-			copy(dAtA[i:], x.SecurityContact)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SecurityContact)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Website) > 0 {
-			i -= len(x.Website)
-			copy(dAtA[i:], x.Website)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Website)))
+		if len(x.MaxChangeRate) > 0 {
+			i -= len(x.MaxChangeRate)
+			copy(dAtA[i:], x.MaxChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangeRate)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Identity) > 0 {
-			i -= len(x.Identity)
-			copy(dAtA[i:], x.Identity)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+		if len(x.MaxRate) > 0 {
+			i -= len(x.MaxRate)
+			copy(dAtA[i:], x.MaxRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRate)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Moniker) > 0 {
-			i -= len(x.Moniker)
-			copy(dAtA[i:], x.Moniker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
-			i--
-			dAtA[i] = 0xa
-		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2099,7 +2176,7 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Description)
+		x := input.Message.Interface().(*CommissionRates)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2131,15 +2208,15 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Description: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2167,11 +2244,11 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Moniker = string(dAtA[iNdEx:postIndex])
+				x.Rate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2199,75 +2276,11 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Identity = string(dAtA[iNdEx:postIndex])
+				x.MaxRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Website = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SecurityContact = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2295,7 +2308,7 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Details = string(dAtA[iNdEx:postIndex])
+				x.MaxChangeRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2332,96 +2345,28 @@ func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Validator_13_list)(nil)
-
-type _Validator_13_list struct {
-	list *[]uint64
-}
-
-func (x *_Validator_13_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Validator_13_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
-}
-
-func (x *_Validator_13_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Validator_13_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Validator_13_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Validator at list field UnbondingIds as it is not of Message kind"))
-}
-
-func (x *_Validator_13_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Validator_13_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_Validator_13_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Validator                             protoreflect.MessageDescriptor
-	fd_Validator_operator_address            protoreflect.FieldDescriptor
-	fd_Validator_consensus_pubkey            protoreflect.FieldDescriptor
-	fd_Validator_jailed                      protoreflect.FieldDescriptor
-	fd_Validator_status                      protoreflect.FieldDescriptor
-	fd_Validator_tokens                      protoreflect.FieldDescriptor
-	fd_Validator_delegator_shares            protoreflect.FieldDescriptor
-	fd_Validator_description                 protoreflect.FieldDescriptor
-	fd_Validator_unbonding_height            protoreflect.FieldDescriptor
-	fd_Validator_unbonding_time              protoreflect.FieldDescriptor
-	fd_Validator_commission                  protoreflect.FieldDescriptor
-	fd_Validator_min_self_delegation         protoreflect.FieldDescriptor
-	fd_Validator_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
-	fd_Validator_unbonding_ids               protoreflect.FieldDescriptor
+	md_Commission                  protoreflect.MessageDescriptor
+	fd_Commission_commission_rates protoreflect.FieldDescriptor
+	fd_Commission_update_time      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Validator = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Validator")
-	fd_Validator_operator_address = md_Validator.Fields().ByName("operator_address")
-	fd_Validator_consensus_pubkey = md_Validator.Fields().ByName("consensus_pubkey")
-	fd_Validator_jailed = md_Validator.Fields().ByName("jailed")
-	fd_Validator_status = md_Validator.Fields().ByName("status")
-	fd_Validator_tokens = md_Validator.Fields().ByName("tokens")
-	fd_Validator_delegator_shares = md_Validator.Fields().ByName("delegator_shares")
-	fd_Validator_description = md_Validator.Fields().ByName("description")
-	fd_Validator_unbonding_height = md_Validator.Fields().ByName("unbonding_height")
-	fd_Validator_unbonding_time = md_Validator.Fields().ByName("unbonding_time")
-	fd_Validator_commission = md_Validator.Fields().ByName("commission")
-	fd_Validator_min_self_delegation = md_Validator.Fields().ByName("min_self_delegation")
-	fd_Validator_unbonding_on_hold_ref_count = md_Validator.Fields().ByName("unbonding_on_hold_ref_count")
-	fd_Validator_unbonding_ids = md_Validator.Fields().ByName("unbonding_ids")
+	md_Commission = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Commission")
+	fd_Commission_commission_rates = md_Commission.Fields().ByName("commission_rates")
+	fd_Commission_update_time = md_Commission.Fields().ByName("update_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_Validator)(nil)
+var _ protoreflect.Message = (*fastReflection_Commission)(nil)
 
-type fastReflection_Validator Validator
+type fastReflection_Commission Commission
 
-func (x *Validator) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Validator)(x)
+func (x *Commission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Commission)(x)
 }
 
-func (x *Validator) slowProtoReflect() protoreflect.Message {
+func (x *Commission) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2433,43 +2378,43 @@ func (x *Validator) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Validator_messageType fastReflection_Validator_messageType
-var _ protoreflect.MessageType = fastReflection_Validator_messageType{}
+var _fastReflection_Commission_messageType fastReflection_Commission_messageType
+var _ protoreflect.MessageType = fastReflection_Commission_messageType{}
 
-type fastReflection_Validator_messageType struct{}
+type fastReflection_Commission_messageType struct{}
 
-func (x fastReflection_Validator_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Validator)(nil)
+func (x fastReflection_Commission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Commission)(nil)
 }
-func (x fastReflection_Validator_messageType) New() protoreflect.Message {
-	return new(fastReflection_Validator)
+func (x fastReflection_Commission_messageType) New() protoreflect.Message {
+	return new(fastReflection_Commission)
 }
-func (x fastReflection_Validator_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Validator
+func (x fastReflection_Commission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Commission
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Validator) Descriptor() protoreflect.MessageDescriptor {
-	return md_Validator
+func (x *fastReflection_Commission) Descriptor() protoreflect.MessageDescriptor {
+	return md_Commission
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Validator) Type() protoreflect.MessageType {
-	return _fastReflection_Validator_messageType
+func (x *fastReflection_Commission) Type() protoreflect.MessageType {
+	return _fastReflection_Commission_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Validator) New() protoreflect.Message {
-	return new(fastReflection_Validator)
+func (x *fastReflection_Commission) New() protoreflect.Message {
+	return new(fastReflection_Commission)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
-	return (*Validator)(x)
+func (x *fastReflection_Commission) Interface() protoreflect.ProtoMessage {
+	return (*Commission)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2477,131 +2422,43 @@ func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OperatorAddress != "" {
-		value := protoreflect.ValueOfString(x.OperatorAddress)
-		if !f(fd_Validator_operator_address, value) {
+func (x *fastReflection_Commission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CommissionRates != nil {
+		value := protoreflect.ValueOfMessage(x.CommissionRates.ProtoReflect())
+		if !f(fd_Commission_commission_rates, value) {
 			return
 		}
 	}
-	if x.ConsensusPubkey != nil {
-		value := protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
-		if !f(fd_Validator_consensus_pubkey, value) {
+	if x.UpdateTime != nil {
+		value := protoreflect.ValueOfMessage(x.UpdateTime.ProtoReflect())
+		if !f(fd_Commission_update_time, value) {
 			return
 		}
 	}
-	if x.Jailed != false {
-		value := protoreflect.ValueOfBool(x.Jailed)
-		if !f(fd_Validator_jailed, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Commission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		return x.CommissionRates != nil
+	case "cosmos.staking.v1beta1.Commission.update_time":
+		return x.UpdateTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Validator_status, value) {
-			return
-		}
-	}
-	if x.Tokens != "" {
-		value := protoreflect.ValueOfString(x.Tokens)
-		if !f(fd_Validator_tokens, value) {
-			return
-		}
-	}
-	if x.DelegatorShares != "" {
-		value := protoreflect.ValueOfString(x.DelegatorShares)
-		if !f(fd_Validator_delegator_shares, value) {
-			return
-		}
-	}
-	if x.Description != nil {
-		value := protoreflect.ValueOfMessage(x.Description.ProtoReflect())
-		if !f(fd_Validator_description, value) {
-			return
-		}
-	}
-	if x.UnbondingHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.UnbondingHeight)
-		if !f(fd_Validator_unbonding_height, value) {
-			return
-		}
-	}
-	if x.UnbondingTime != nil {
-		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-		if !f(fd_Validator_unbonding_time, value) {
-			return
-		}
-	}
-	if x.Commission != nil {
-		value := protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
-		if !f(fd_Validator_commission, value) {
-			return
-		}
-	}
-	if x.MinSelfDelegation != "" {
-		value := protoreflect.ValueOfString(x.MinSelfDelegation)
-		if !f(fd_Validator_min_self_delegation, value) {
-			return
-		}
-	}
-	if x.UnbondingOnHoldRefCount != int64(0) {
-		value := protoreflect.ValueOfInt64(x.UnbondingOnHoldRefCount)
-		if !f(fd_Validator_unbonding_on_hold_ref_count, value) {
-			return
-		}
-	}
-	if len(x.UnbondingIds) != 0 {
-		value := protoreflect.ValueOfList(&_Validator_13_list{list: &x.UnbondingIds})
-		if !f(fd_Validator_unbonding_ids, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		return x.OperatorAddress != ""
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		return x.ConsensusPubkey != nil
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		return x.Jailed != false
-	case "cosmos.staking.v1beta1.Validator.status":
-		return x.Status != 0
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		return x.Tokens != ""
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		return x.DelegatorShares != ""
-	case "cosmos.staking.v1beta1.Validator.description":
-		return x.Description != nil
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		return x.UnbondingHeight != int64(0)
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
-		return x.UnbondingTime != nil
-	case "cosmos.staking.v1beta1.Validator.commission":
-		return x.Commission != nil
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		return x.MinSelfDelegation != ""
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		return x.UnbondingOnHoldRefCount != int64(0)
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		return len(x.UnbondingIds) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
-		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2611,39 +2468,17 @@ func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Commission) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		x.OperatorAddress = ""
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		x.ConsensusPubkey = nil
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		x.Jailed = false
-	case "cosmos.staking.v1beta1.Validator.status":
-		x.Status = 0
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		x.Tokens = ""
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		x.DelegatorShares = ""
-	case "cosmos.staking.v1beta1.Validator.description":
-		x.Description = nil
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		x.UnbondingHeight = int64(0)
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
-		x.UnbondingTime = nil
-	case "cosmos.staking.v1beta1.Validator.commission":
-		x.Commission = nil
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		x.MinSelfDelegation = ""
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		x.UnbondingOnHoldRefCount = int64(0)
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		x.UnbondingIds = nil
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		x.CommissionRates = nil
+	case "cosmos.staking.v1beta1.Commission.update_time":
+		x.UpdateTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2653,55 +2488,19 @@ func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Commission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		value := x.OperatorAddress
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		value := x.ConsensusPubkey
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		value := x.Jailed
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.staking.v1beta1.Validator.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		value := x.Tokens
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		value := x.DelegatorShares
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Validator.description":
-		value := x.Description
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		value := x.UnbondingHeight
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
-		value := x.UnbondingTime
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		value := x.CommissionRates
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.commission":
-		value := x.Commission
+	case "cosmos.staking.v1beta1.Commission.update_time":
+		value := x.UpdateTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		value := x.MinSelfDelegation
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		value := x.UnbondingOnHoldRefCount
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		if len(x.UnbondingIds) == 0 {
-			return protoreflect.ValueOfList(&_Validator_13_list{})
-		}
-		listValue := &_Validator_13_list{list: &x.UnbondingIds}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2715,41 +2514,17 @@ func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Commission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		x.OperatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		x.ConsensusPubkey = value.Message().Interface().(*anypb.Any)
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		x.Jailed = value.Bool()
-	case "cosmos.staking.v1beta1.Validator.status":
-		x.Status = (BondStatus)(value.Enum())
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		x.Tokens = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		x.DelegatorShares = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Validator.description":
-		x.Description = value.Message().Interface().(*Description)
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		x.UnbondingHeight = value.Int()
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
-		x.UnbondingTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.staking.v1beta1.Validator.commission":
-		x.Commission = value.Message().Interface().(*Commission)
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		x.MinSelfDelegation = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		x.UnbondingOnHoldRefCount = value.Int()
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		lv := value.List()
-		clv := lv.(*_Validator_13_list)
-		x.UnbondingIds = *clv.list
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		x.CommissionRates = value.Message().Interface().(*CommissionRates)
+	case "cosmos.staking.v1beta1.Commission.update_time":
+		x.UpdateTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2763,109 +2538,52 @@ func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Commission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		if x.ConsensusPubkey == nil {
-			x.ConsensusPubkey = new(anypb.Any)
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		if x.CommissionRates == nil {
+			x.CommissionRates = new(CommissionRates)
 		}
-		return protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.description":
-		if x.Description == nil {
-			x.Description = new(Description)
+		return protoreflect.ValueOfMessage(x.CommissionRates.ProtoReflect())
+	case "cosmos.staking.v1beta1.Commission.update_time":
+		if x.UpdateTime == nil {
+			x.UpdateTime = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Description.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
-		if x.UnbondingTime == nil {
-			x.UnbondingTime = new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(x.UpdateTime.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.commission":
-		if x.Commission == nil {
-			x.Commission = new(Commission)
-		}
-		return protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		if x.UnbondingIds == nil {
-			x.UnbondingIds = []uint64{}
-		}
-		value := &_Validator_13_list{list: &x.UnbondingIds}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		panic(fmt.Errorf("field operator_address of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		panic(fmt.Errorf("field jailed of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.status":
-		panic(fmt.Errorf("field status of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		panic(fmt.Errorf("field tokens of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		panic(fmt.Errorf("field delegator_shares of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		panic(fmt.Errorf("field unbonding_height of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		panic(fmt.Errorf("field min_self_delegation of message cosmos.staking.v1beta1.Validator is not mutable"))
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.Validator is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
-		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Validator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Commission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Validator.operator_address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.jailed":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.staking.v1beta1.Validator.status":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.staking.v1beta1.Validator.tokens":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Validator.delegator_shares":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Validator.description":
-		m := new(Description)
+	case "cosmos.staking.v1beta1.Commission.commission_rates":
+		m := new(CommissionRates)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.unbonding_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+	case "cosmos.staking.v1beta1.Commission.update_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.commission":
-		m := new(Commission)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_Validator_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Commission"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Commission does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Commission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Validator", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Commission", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2873,7 +2591,7 @@ func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Commission) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2884,7 +2602,7 @@ func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Commission) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2896,7 +2614,7 @@ func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Validator) IsValid() bool {
+func (x *fastReflection_Commission) IsValid() bool {
 	return x != nil
 }
 
@@ -2906,9 +2624,9 @@ func (x *fastReflection_Validator) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Commission) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*Commission)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2920,57 +2638,14 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OperatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ConsensusPubkey != nil {
-			l = options.Size(x.ConsensusPubkey)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Jailed {
-			n += 2
-		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		l = len(x.Tokens)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DelegatorShares)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Description != nil {
-			l = options.Size(x.Description)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.UnbondingHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.UnbondingHeight))
-		}
-		if x.UnbondingTime != nil {
-			l = options.Size(x.UnbondingTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Commission != nil {
-			l = options.Size(x.Commission)
+		if x.CommissionRates != nil {
+			l = options.Size(x.CommissionRates)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinSelfDelegation)
-		if l > 0 {
+		if x.UpdateTime != nil {
+			l = options.Size(x.UpdateTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.UnbondingOnHoldRefCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.UnbondingOnHoldRefCount))
-		}
-		if len(x.UnbondingIds) > 0 {
-			l = 0
-			for _, e := range x.UnbondingIds {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2981,7 +2656,7 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*Commission)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3000,73 +2675,8 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.UnbondingIds) > 0 {
-			var pksize2 int
-			for _, num := range x.UnbondingIds {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.UnbondingIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if x.UnbondingOnHoldRefCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingOnHoldRefCount))
-			i--
-			dAtA[i] = 0x60
-		}
-		if len(x.MinSelfDelegation) > 0 {
-			i -= len(x.MinSelfDelegation)
-			copy(dAtA[i:], x.MinSelfDelegation)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSelfDelegation)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.Commission != nil {
-			encoded, err := options.Marshal(x.Commission)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.UnbondingTime != nil {
-			encoded, err := options.Marshal(x.UnbondingTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if x.UnbondingHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingHeight))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.Description != nil {
-			encoded, err := options.Marshal(x.Description)
+		if x.UpdateTime != nil {
+			encoded, err := options.Marshal(x.UpdateTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3077,39 +2687,10 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.DelegatorShares) > 0 {
-			i -= len(x.DelegatorShares)
-			copy(dAtA[i:], x.DelegatorShares)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorShares)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Tokens) > 0 {
-			i -= len(x.Tokens)
-			copy(dAtA[i:], x.Tokens)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tokens)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Jailed {
-			i--
-			if x.Jailed {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x12
 		}
-		if x.ConsensusPubkey != nil {
-			encoded, err := options.Marshal(x.ConsensusPubkey)
+		if x.CommissionRates != nil {
+			encoded, err := options.Marshal(x.CommissionRates)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3120,13 +2701,6 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.OperatorAddress) > 0 {
-			i -= len(x.OperatorAddress)
-			copy(dAtA[i:], x.OperatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -3140,7 +2714,7 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*Commission)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3172,49 +2746,17 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Commission: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-				}
-				var msglen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3239,119 +2781,16 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ConsensusPubkey == nil {
-					x.ConsensusPubkey = &anypb.Any{}
+				if x.CommissionRates == nil {
+					x.CommissionRates = &CommissionRates{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsensusPubkey); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommissionRates); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Jailed = bool(v != 0)
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= BondStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Tokens = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorShares = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3378,245 +2817,27 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Description == nil {
-					x.Description = &Description{}
+				if x.UpdateTime == nil {
+					x.UpdateTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Description); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
-				}
-				x.UnbondingHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.UnbondingHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.UnbondingTime == nil {
-					x.UnbondingTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Commission == nil {
-					x.Commission = &Commission{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinSelfDelegation = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-				}
-				x.UnbondingOnHoldRefCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 13:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.UnbondingIds = append(x.UnbondingIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.UnbondingIds) == 0 {
-						x.UnbondingIds = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.UnbondingIds = append(x.UnbondingIds, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3638,72 +2859,34 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValAddresses_1_list)(nil)
-
-type _ValAddresses_1_list struct {
-	list *[]string
-}
-
-func (x *_ValAddresses_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValAddresses_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_ValAddresses_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValAddresses_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValAddresses_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ValAddresses at list field Addresses as it is not of Message kind"))
-}
-
-func (x *_ValAddresses_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValAddresses_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_ValAddresses_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValAddresses           protoreflect.MessageDescriptor
-	fd_ValAddresses_addresses protoreflect.FieldDescriptor
+	md_Description                  protoreflect.MessageDescriptor
+	fd_Description_moniker          protoreflect.FieldDescriptor
+	fd_Description_identity         protoreflect.FieldDescriptor
+	fd_Description_website          protoreflect.FieldDescriptor
+	fd_Description_security_contact protoreflect.FieldDescriptor
+	fd_Description_details          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_ValAddresses = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("ValAddresses")
-	fd_ValAddresses_addresses = md_ValAddresses.Fields().ByName("addresses")
+	md_Description = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Description")
+	fd_Description_moniker = md_Description.Fields().ByName("moniker")
+	fd_Description_identity = md_Description.Fields().ByName("identity")
+	fd_Description_website = md_Description.Fields().ByName("website")
+	fd_Description_security_contact = md_Description.Fields().ByName("security_contact")
+	fd_Description_details = md_Description.Fields().ByName("details")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValAddresses)(nil)
+var _ protoreflect.Message = (*fastReflection_Description)(nil)
 
-type fastReflection_ValAddresses ValAddresses
+type fastReflection_Description Description
 
-func (x *ValAddresses) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValAddresses)(x)
+func (x *Description) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Description)(x)
 }
 
-func (x *ValAddresses) slowProtoReflect() protoreflect.Message {
+func (x *Description) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3715,43 +2898,43 @@ func (x *ValAddresses) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValAddresses_messageType fastReflection_ValAddresses_messageType
-var _ protoreflect.MessageType = fastReflection_ValAddresses_messageType{}
+var _fastReflection_Description_messageType fastReflection_Description_messageType
+var _ protoreflect.MessageType = fastReflection_Description_messageType{}
 
-type fastReflection_ValAddresses_messageType struct{}
+type fastReflection_Description_messageType struct{}
 
-func (x fastReflection_ValAddresses_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValAddresses)(nil)
+func (x fastReflection_Description_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Description)(nil)
 }
-func (x fastReflection_ValAddresses_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValAddresses)
+func (x fastReflection_Description_messageType) New() protoreflect.Message {
+	return new(fastReflection_Description)
 }
-func (x fastReflection_ValAddresses_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValAddresses
+func (x fastReflection_Description_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Description
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValAddresses) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValAddresses
+func (x *fastReflection_Description) Descriptor() protoreflect.MessageDescriptor {
+	return md_Description
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValAddresses) Type() protoreflect.MessageType {
-	return _fastReflection_ValAddresses_messageType
+func (x *fastReflection_Description) Type() protoreflect.MessageType {
+	return _fastReflection_Description_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValAddresses) New() protoreflect.Message {
-	return new(fastReflection_ValAddresses)
+func (x *fastReflection_Description) New() protoreflect.Message {
+	return new(fastReflection_Description)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValAddresses) Interface() protoreflect.ProtoMessage {
-	return (*ValAddresses)(x)
+func (x *fastReflection_Description) Interface() protoreflect.ProtoMessage {
+	return (*Description)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3759,35 +2942,67 @@ func (x *fastReflection_ValAddresses) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValAddresses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Addresses) != 0 {
-		value := protoreflect.ValueOfList(&_ValAddresses_1_list{list: &x.Addresses})
-		if !f(fd_ValAddresses_addresses, value) {
+func (x *fastReflection_Description) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_Description_moniker, value) {
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValAddresses) Has(fd protoreflect.FieldDescriptor) bool {
+	if x.Identity != "" {
+		value := protoreflect.ValueOfString(x.Identity)
+		if !f(fd_Description_identity, value) {
+			return
+		}
+	}
+	if x.Website != "" {
+		value := protoreflect.ValueOfString(x.Website)
+		if !f(fd_Description_website, value) {
+			return
+		}
+	}
+	if x.SecurityContact != "" {
+		value := protoreflect.ValueOfString(x.SecurityContact)
+		if !f(fd_Description_security_contact, value) {
+			return
+		}
+	}
+	if x.Details != "" {
+		value := protoreflect.ValueOfString(x.Details)
+		if !f(fd_Description_details, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Description) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		return len(x.Addresses) != 0
+	case "cosmos.staking.v1beta1.Description.moniker":
+		return x.Moniker != ""
+	case "cosmos.staking.v1beta1.Description.identity":
+		return x.Identity != ""
+	case "cosmos.staking.v1beta1.Description.website":
+		return x.Website != ""
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		return x.SecurityContact != ""
+	case "cosmos.staking.v1beta1.Description.details":
+		return x.Details != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3797,15 +3012,23 @@ func (x *fastReflection_ValAddresses) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValAddresses) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Description) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		x.Addresses = nil
+	case "cosmos.staking.v1beta1.Description.moniker":
+		x.Moniker = ""
+	case "cosmos.staking.v1beta1.Description.identity":
+		x.Identity = ""
+	case "cosmos.staking.v1beta1.Description.website":
+		x.Website = ""
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		x.SecurityContact = ""
+	case "cosmos.staking.v1beta1.Description.details":
+		x.Details = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3815,19 +3038,28 @@ func (x *fastReflection_ValAddresses) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValAddresses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Description) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		if len(x.Addresses) == 0 {
-			return protoreflect.ValueOfList(&_ValAddresses_1_list{})
-		}
-		listValue := &_ValAddresses_1_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.Description.moniker":
+		value := x.Moniker
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Description.identity":
+		value := x.Identity
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Description.website":
+		value := x.Website
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		value := x.SecurityContact
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Description.details":
+		value := x.Details
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3841,17 +3073,23 @@ func (x *fastReflection_ValAddresses) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValAddresses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Description) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		lv := value.List()
-		clv := lv.(*_ValAddresses_1_list)
-		x.Addresses = *clv.list
+	case "cosmos.staking.v1beta1.Description.moniker":
+		x.Moniker = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Description.identity":
+		x.Identity = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Description.website":
+		x.Website = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		x.SecurityContact = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Description.details":
+		x.Details = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3865,45 +3103,56 @@ func (x *fastReflection_ValAddresses) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValAddresses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Description) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		if x.Addresses == nil {
-			x.Addresses = []string{}
-		}
-		value := &_ValAddresses_1_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.Description.moniker":
+		panic(fmt.Errorf("field moniker of message cosmos.staking.v1beta1.Description is not mutable"))
+	case "cosmos.staking.v1beta1.Description.identity":
+		panic(fmt.Errorf("field identity of message cosmos.staking.v1beta1.Description is not mutable"))
+	case "cosmos.staking.v1beta1.Description.website":
+		panic(fmt.Errorf("field website of message cosmos.staking.v1beta1.Description is not mutable"))
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		panic(fmt.Errorf("field security_contact of message cosmos.staking.v1beta1.Description is not mutable"))
+	case "cosmos.staking.v1beta1.Description.details":
+		panic(fmt.Errorf("field details of message cosmos.staking.v1beta1.Description is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValAddresses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Description) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.ValAddresses.addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ValAddresses_1_list{list: &list})
+	case "cosmos.staking.v1beta1.Description.moniker":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Description.identity":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Description.website":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Description.security_contact":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Description.details":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Description"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Description does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValAddresses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Description) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.ValAddresses", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Description", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3911,7 +3160,7 @@ func (x *fastReflection_ValAddresses) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValAddresses) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Description) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3922,7 +3171,7 @@ func (x *fastReflection_ValAddresses) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValAddresses) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Description) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3934,7 +3183,7 @@ func (x *fastReflection_ValAddresses) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValAddresses) IsValid() bool {
+func (x *fastReflection_Description) IsValid() bool {
 	return x != nil
 }
 
@@ -3944,9 +3193,9 @@ func (x *fastReflection_ValAddresses) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Description) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValAddresses)
+		x := input.Message.Interface().(*Description)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3958,11 +3207,25 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Addresses) > 0 {
-			for _, s := range x.Addresses {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Moniker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Identity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Website)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SecurityContact)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Details)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3974,7 +3237,7 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValAddresses)
+		x := input.Message.Interface().(*Description)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3993,14 +3256,40 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Addresses) > 0 {
-			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Addresses[iNdEx])
-				copy(dAtA[i:], x.Addresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Details) > 0 {
+			i -= len(x.Details)
+			copy(dAtA[i:], x.Details)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Details)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SecurityContact) > 0 {
+			i -= len(x.SecurityContact)
+			copy(dAtA[i:], x.SecurityContact)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SecurityContact)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Website) > 0 {
+			i -= len(x.Website)
+			copy(dAtA[i:], x.Website)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Website)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Identity) > 0 {
+			i -= len(x.Identity)
+			copy(dAtA[i:], x.Identity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4013,7 +3302,7 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValAddresses)
+		x := input.Message.Interface().(*Description)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4045,15 +3334,15 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Description: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4081,11 +3370,139 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				x.Moniker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Website = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SecurityContact = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Details = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
 				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
@@ -4118,28 +3535,96 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Validator_13_list)(nil)
+
+type _Validator_13_list struct {
+	list *[]uint64
+}
+
+func (x *_Validator_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Validator_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Validator_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Validator_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Validator_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Validator at list field UnbondingIds as it is not of Message kind"))
+}
+
+func (x *_Validator_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Validator_13_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Validator_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_DVPair                   protoreflect.MessageDescriptor
-	fd_DVPair_delegator_address protoreflect.FieldDescriptor
-	fd_DVPair_validator_address protoreflect.FieldDescriptor
+	md_Validator                             protoreflect.MessageDescriptor
+	fd_Validator_operator_address            protoreflect.FieldDescriptor
+	fd_Validator_consensus_pubkey            protoreflect.FieldDescriptor
+	fd_Validator_jailed                      protoreflect.FieldDescriptor
+	fd_Validator_status                      protoreflect.FieldDescriptor
+	fd_Validator_tokens                      protoreflect.FieldDescriptor
+	fd_Validator_delegator_shares            protoreflect.FieldDescriptor
+	fd_Validator_description                 protoreflect.FieldDescriptor
+	fd_Validator_unbonding_height            protoreflect.FieldDescriptor
+	fd_Validator_unbonding_time              protoreflect.FieldDescriptor
+	fd_Validator_commission                  protoreflect.FieldDescriptor
+	fd_Validator_min_self_delegation         protoreflect.FieldDescriptor
+	fd_Validator_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
+	fd_Validator_unbonding_ids               protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_DVPair = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVPair")
-	fd_DVPair_delegator_address = md_DVPair.Fields().ByName("delegator_address")
-	fd_DVPair_validator_address = md_DVPair.Fields().ByName("validator_address")
+	md_Validator = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Validator")
+	fd_Validator_operator_address = md_Validator.Fields().ByName("operator_address")
+	fd_Validator_consensus_pubkey = md_Validator.Fields().ByName("consensus_pubkey")
+	fd_Validator_jailed = md_Validator.Fields().ByName("jailed")
+	fd_Validator_status = md_Validator.Fields().ByName("status")
+	fd_Validator_tokens = md_Validator.Fields().ByName("tokens")
+	fd_Validator_delegator_shares = md_Validator.Fields().ByName("delegator_shares")
+	fd_Validator_description = md_Validator.Fields().ByName("description")
+	fd_Validator_unbonding_height = md_Validator.Fields().ByName("unbonding_height")
+	fd_Validator_unbonding_time = md_Validator.Fields().ByName("unbonding_time")
+	fd_Validator_commission = md_Validator.Fields().ByName("commission")
+	fd_Validator_min_self_delegation = md_Validator.Fields().ByName("min_self_delegation")
+	fd_Validator_unbonding_on_hold_ref_count = md_Validator.Fields().ByName("unbonding_on_hold_ref_count")
+	fd_Validator_unbonding_ids = md_Validator.Fields().ByName("unbonding_ids")
 }
 
-var _ protoreflect.Message = (*fastReflection_DVPair)(nil)
+var _ protoreflect.Message = (*fastReflection_Validator)(nil)
 
-type fastReflection_DVPair DVPair
+type fastReflection_Validator Validator
 
-func (x *DVPair) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DVPair)(x)
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Validator)(x)
 }
 
-func (x *DVPair) slowProtoReflect() protoreflect.Message {
+func (x *Validator) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4151,43 +3636,43 @@ func (x *DVPair) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DVPair_messageType fastReflection_DVPair_messageType
-var _ protoreflect.MessageType = fastReflection_DVPair_messageType{}
+var _fastReflection_Validator_messageType fastReflection_Validator_messageType
+var _ protoreflect.MessageType = fastReflection_Validator_messageType{}
 
-type fastReflection_DVPair_messageType struct{}
+type fastReflection_Validator_messageType struct{}
 
-func (x fastReflection_DVPair_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DVPair)(nil)
+func (x fastReflection_Validator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Validator)(nil)
 }
-func (x fastReflection_DVPair_messageType) New() protoreflect.Message {
-	return new(fastReflection_DVPair)
+func (x fastReflection_Validator_messageType) New() protoreflect.Message {
+	return new(fastReflection_Validator)
 }
-func (x fastReflection_DVPair_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVPair
+func (x fastReflection_Validator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Validator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DVPair) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVPair
+func (x *fastReflection_Validator) Descriptor() protoreflect.MessageDescriptor {
+	return md_Validator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DVPair) Type() protoreflect.MessageType {
-	return _fastReflection_DVPair_messageType
+func (x *fastReflection_Validator) Type() protoreflect.MessageType {
+	return _fastReflection_Validator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DVPair) New() protoreflect.Message {
-	return new(fastReflection_DVPair)
+func (x *fastReflection_Validator) New() protoreflect.Message {
+	return new(fastReflection_Validator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DVPair) Interface() protoreflect.ProtoMessage {
-	return (*DVPair)(x)
+func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
+	return (*Validator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4195,16 +3680,82 @@ func (x *fastReflection_DVPair) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DVPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_DVPair_delegator_address, value) {
+func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_Validator_operator_address, value) {
 			return
 		}
 	}
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_DVPair_validator_address, value) {
+	if x.ConsensusPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
+		if !f(fd_Validator_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.Jailed != false {
+		value := protoreflect.ValueOfBool(x.Jailed)
+		if !f(fd_Validator_jailed, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Validator_status, value) {
+			return
+		}
+	}
+	if x.Tokens != "" {
+		value := protoreflect.ValueOfString(x.Tokens)
+		if !f(fd_Validator_tokens, value) {
+			return
+		}
+	}
+	if x.DelegatorShares != "" {
+		value := protoreflect.ValueOfString(x.DelegatorShares)
+		if !f(fd_Validator_delegator_shares, value) {
+			return
+		}
+	}
+	if x.Description != nil {
+		value := protoreflect.ValueOfMessage(x.Description.ProtoReflect())
+		if !f(fd_Validator_description, value) {
+			return
+		}
+	}
+	if x.UnbondingHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingHeight)
+		if !f(fd_Validator_unbonding_height, value) {
+			return
+		}
+	}
+	if x.UnbondingTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+		if !f(fd_Validator_unbonding_time, value) {
+			return
+		}
+	}
+	if x.Commission != nil {
+		value := protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
+		if !f(fd_Validator_commission, value) {
+			return
+		}
+	}
+	if x.MinSelfDelegation != "" {
+		value := protoreflect.ValueOfString(x.MinSelfDelegation)
+		if !f(fd_Validator_min_self_delegation, value) {
+			return
+		}
+	}
+	if x.UnbondingOnHoldRefCount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingOnHoldRefCount)
+		if !f(fd_Validator_unbonding_on_hold_ref_count, value) {
+			return
+		}
+	}
+	if len(x.UnbondingIds) != 0 {
+		value := protoreflect.ValueOfList(&_Validator_13_list{list: &x.UnbondingIds})
+		if !f(fd_Validator_unbonding_ids, value) {
 			return
 		}
 	}
@@ -4221,17 +3772,39 @@ func (x *fastReflection_DVPair) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DVPair) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
-		return x.DelegatorAddress != ""
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
-		return x.ValidatorAddress != ""
+	case "cosmos.staking.v1beta1.Validator.operator_address":
+		return x.OperatorAddress != ""
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		return x.ConsensusPubkey != nil
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		return x.Jailed != false
+	case "cosmos.staking.v1beta1.Validator.status":
+		return x.Status != 0
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		return x.Tokens != ""
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		return x.DelegatorShares != ""
+	case "cosmos.staking.v1beta1.Validator.description":
+		return x.Description != nil
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		return x.UnbondingHeight != int64(0)
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		return x.UnbondingTime != nil
+	case "cosmos.staking.v1beta1.Validator.commission":
+		return x.Commission != nil
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
+		return x.MinSelfDelegation != ""
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		return x.UnbondingOnHoldRefCount != int64(0)
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		return len(x.UnbondingIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4241,17 +3814,39 @@ func (x *fastReflection_DVPair) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPair) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
-		x.DelegatorAddress = ""
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
-		x.ValidatorAddress = ""
+	case "cosmos.staking.v1beta1.Validator.operator_address":
+		x.OperatorAddress = ""
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		x.ConsensusPubkey = nil
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		x.Jailed = false
+	case "cosmos.staking.v1beta1.Validator.status":
+		x.Status = 0
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		x.Tokens = ""
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		x.DelegatorShares = ""
+	case "cosmos.staking.v1beta1.Validator.description":
+		x.Description = nil
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		x.UnbondingHeight = int64(0)
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		x.UnbondingTime = nil
+	case "cosmos.staking.v1beta1.Validator.commission":
+		x.Commission = nil
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
+		x.MinSelfDelegation = ""
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		x.UnbondingOnHoldRefCount = int64(0)
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		x.UnbondingIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4261,19 +3856,55 @@ func (x *fastReflection_DVPair) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DVPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
-		value := x.DelegatorAddress
+	case "cosmos.staking.v1beta1.Validator.operator_address":
+		value := x.OperatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
-		value := x.ValidatorAddress
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		value := x.Jailed
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.staking.v1beta1.Validator.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		value := x.Tokens
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		value := x.DelegatorShares
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Validator.description":
+		value := x.Description
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		value := x.UnbondingHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		value := x.UnbondingTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.commission":
+		value := x.Commission
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
+		value := x.MinSelfDelegation
 		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		value := x.UnbondingOnHoldRefCount
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		if len(x.UnbondingIds) == 0 {
+			return protoreflect.ValueOfList(&_Validator_13_list{})
+		}
+		listValue := &_Validator_13_list{list: &x.UnbondingIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4287,17 +3918,41 @@ func (x *fastReflection_DVPair) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Validator.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		x.ConsensusPubkey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		x.Jailed = value.Bool()
+	case "cosmos.staking.v1beta1.Validator.status":
+		x.Status = (BondStatus)(value.Enum())
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		x.Tokens = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		x.DelegatorShares = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Validator.description":
+		x.Description = value.Message().Interface().(*Description)
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		x.UnbondingHeight = value.Int()
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		x.UnbondingTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.staking.v1beta1.Validator.commission":
+		x.Commission = value.Message().Interface().(*Commission)
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
+		x.MinSelfDelegation = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		x.UnbondingOnHoldRefCount = value.Int()
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		lv := value.List()
+		clv := lv.(*_Validator_13_list)
+		x.UnbondingIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4311,44 +3966,109 @@ func (x *fastReflection_DVPair) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Validator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.DVPair is not mutable"))
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
-		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.DVPair is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		if x.ConsensusPubkey == nil {
+			x.ConsensusPubkey = new(anypb.Any)
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
+		return protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.description":
+		if x.Description == nil {
+			x.Description = new(Description)
+		}
+		return protoreflect.ValueOfMessage(x.Description.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		if x.UnbondingTime == nil {
+			x.UnbondingTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.commission":
+		if x.Commission == nil {
+			x.Commission = new(Commission)
+		}
+		return protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		if x.UnbondingIds == nil {
+			x.UnbondingIds = []uint64{}
+		}
+		value := &_Validator_13_list{list: &x.UnbondingIds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.Validator.operator_address":
+		panic(fmt.Errorf("field operator_address of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		panic(fmt.Errorf("field jailed of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.status":
+		panic(fmt.Errorf("field status of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		panic(fmt.Errorf("field tokens of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		panic(fmt.Errorf("field delegator_shares of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		panic(fmt.Errorf("field unbonding_height of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
+		panic(fmt.Errorf("field min_self_delegation of message cosmos.staking.v1beta1.Validator is not mutable"))
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.Validator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DVPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Validator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+	case "cosmos.staking.v1beta1.Validator.operator_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.DVPair.validator_address":
+	case "cosmos.staking.v1beta1.Validator.consensus_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.jailed":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.staking.v1beta1.Validator.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.staking.v1beta1.Validator.tokens":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Validator.delegator_shares":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Validator.description":
+		m := new(Description)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.unbonding_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.Validator.unbonding_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.commission":
+		m := new(Commission)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Validator.min_self_delegation":
 		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Validator.unbonding_on_hold_ref_count":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.Validator.unbonding_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Validator_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Validator"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Validator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DVPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVPair", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Validator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4356,7 +4076,7 @@ func (x *fastReflection_DVPair) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DVPair) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4367,7 +4087,7 @@ func (x *fastReflection_DVPair) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPair) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4379,7 +4099,7 @@ func (x *fastReflection_DVPair) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DVPair) IsValid() bool {
+func (x *fastReflection_Validator) IsValid() bool {
 	return x != nil
 }
 
@@ -4389,9 +4109,9 @@ func (x *fastReflection_DVPair) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DVPair)
+		x := input.Message.Interface().(*Validator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4403,14 +4123,57 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
+		l = len(x.OperatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ValidatorAddress)
+		if x.ConsensusPubkey != nil {
+			l = options.Size(x.ConsensusPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Jailed {
+			n += 2
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Tokens)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DelegatorShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Description != nil {
+			l = options.Size(x.Description)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnbondingHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingHeight))
+		}
+		if x.UnbondingTime != nil {
+			l = options.Size(x.UnbondingTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Commission != nil {
+			l = options.Size(x.Commission)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinSelfDelegation)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.UnbondingOnHoldRefCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingOnHoldRefCount))
+		}
+		if len(x.UnbondingIds) > 0 {
+			l = 0
+			for _, e := range x.UnbondingIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4421,7 +4184,7 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DVPair)
+		x := input.Message.Interface().(*Validator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4440,75 +4203,190 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if len(x.UnbondingIds) > 0 {
+			var pksize2 int
+			for _, num := range x.UnbondingIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.UnbondingIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x6a
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.UnbondingOnHoldRefCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingOnHoldRefCount))
 			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+			dAtA[i] = 0x60
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DVPair)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		if len(x.MinSelfDelegation) > 0 {
+			i -= len(x.MinSelfDelegation)
+			copy(dAtA[i:], x.MinSelfDelegation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSelfDelegation)))
+			i--
+			dAtA[i] = 0x5a
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		if x.Commission != nil {
+			encoded, err := options.Marshal(x.Commission)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.UnbondingTime != nil {
+			encoded, err := options.Marshal(x.UnbondingTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.UnbondingHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Description != nil {
+			encoded, err := options.Marshal(x.Description)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DelegatorShares) > 0 {
+			i -= len(x.DelegatorShares)
+			copy(dAtA[i:], x.DelegatorShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorShares)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Tokens) > 0 {
+			i -= len(x.Tokens)
+			copy(dAtA[i:], x.Tokens)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tokens)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Jailed {
+			i--
+			if x.Jailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ConsensusPubkey != nil {
+			encoded, err := options.Marshal(x.ConsensusPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Validator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
@@ -4533,11 +4411,86 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConsensusPubkey == nil {
+					x.ConsensusPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsensusPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Jailed = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= BondStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4565,29 +4518,3911 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				x.Tokens = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.DelegatorShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Description == nil {
+					x.Description = &Description{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Description); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				}
+				x.UnbondingHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnbondingTime == nil {
+					x.UnbondingTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Commission == nil {
+					x.Commission = &Commission{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinSelfDelegation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				}
+				x.UnbondingOnHoldRefCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.UnbondingIds = append(x.UnbondingIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.UnbondingIds) == 0 {
+						x.UnbondingIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.UnbondingIds = append(x.UnbondingIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValAddresses_1_list)(nil)
+
+type _ValAddresses_1_list struct {
+	list *[]string
+}
+
+func (x *_ValAddresses_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValAddresses_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValAddresses_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValAddresses_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValAddresses_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValAddresses at list field Addresses as it is not of Message kind"))
+}
+
+func (x *_ValAddresses_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValAddresses_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValAddresses_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValAddresses           protoreflect.MessageDescriptor
+	fd_ValAddresses_addresses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_ValAddresses = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("ValAddresses")
+	fd_ValAddresses_addresses = md_ValAddresses.Fields().ByName("addresses")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValAddresses)(nil)
+
+type fastReflection_ValAddresses ValAddresses
+
+func (x *ValAddresses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValAddresses)(x)
+}
+
+func (x *ValAddresses) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValAddresses_messageType fastReflection_ValAddresses_messageType
+var _ protoreflect.MessageType = fastReflection_ValAddresses_messageType{}
+
+type fastReflection_ValAddresses_messageType struct{}
+
+func (x fastReflection_ValAddresses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValAddresses)(nil)
+}
+func (x fastReflection_ValAddresses_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValAddresses)
+}
+func (x fastReflection_ValAddresses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValAddresses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValAddresses) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValAddresses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValAddresses) Type() protoreflect.MessageType {
+	return _fastReflection_ValAddresses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValAddresses) New() protoreflect.Message {
+	return new(fastReflection_ValAddresses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValAddresses) Interface() protoreflect.ProtoMessage {
+	return (*ValAddresses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValAddresses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Addresses) != 0 {
+		value := protoreflect.ValueOfList(&_ValAddresses_1_list{list: &x.Addresses})
+		if !f(fd_ValAddresses_addresses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValAddresses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		return len(x.Addresses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValAddresses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		x.Addresses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValAddresses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		if len(x.Addresses) == 0 {
+			return protoreflect.ValueOfList(&_ValAddresses_1_list{})
+		}
+		listValue := &_ValAddresses_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValAddresses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		lv := value.List()
+		clv := lv.(*_ValAddresses_1_list)
+		x.Addresses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValAddresses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		if x.Addresses == nil {
+			x.Addresses = []string{}
+		}
+		value := &_ValAddresses_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValAddresses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.ValAddresses.addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValAddresses_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.ValAddresses"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.ValAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValAddresses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.ValAddresses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValAddresses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValAddresses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValAddresses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValAddresses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Addresses) > 0 {
+			for _, s := range x.Addresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValAddresses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Addresses) > 0 {
+			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Addresses[iNdEx])
+				copy(dAtA[i:], x.Addresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValAddresses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DVPair                   protoreflect.MessageDescriptor
+	fd_DVPair_delegator_address protoreflect.FieldDescriptor
+	fd_DVPair_validator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_DVPair = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVPair")
+	fd_DVPair_delegator_address = md_DVPair.Fields().ByName("delegator_address")
+	fd_DVPair_validator_address = md_DVPair.Fields().ByName("validator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_DVPair)(nil)
+
+type fastReflection_DVPair DVPair
+
+func (x *DVPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DVPair)(x)
+}
+
+func (x *DVPair) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DVPair_messageType fastReflection_DVPair_messageType
+var _ protoreflect.MessageType = fastReflection_DVPair_messageType{}
+
+type fastReflection_DVPair_messageType struct{}
+
+func (x fastReflection_DVPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DVPair)(nil)
+}
+func (x fastReflection_DVPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_DVPair)
+}
+func (x fastReflection_DVPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVPair
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DVPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVPair
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DVPair) Type() protoreflect.MessageType {
+	return _fastReflection_DVPair_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DVPair) New() protoreflect.Message {
+	return new(fastReflection_DVPair)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DVPair) Interface() protoreflect.ProtoMessage {
+	return (*DVPair)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DVPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_DVPair_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DVPair_validator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DVPair) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		return x.ValidatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPair) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		x.ValidatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DVPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.DVPair is not mutable"))
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.DVPair is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DVPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPair.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.DVPair.validator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPair"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DVPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVPair", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DVPair) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPair) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DVPair) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DVPair)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DVPair)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DVPair)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DVPairs_1_list)(nil)
+
+type _DVPairs_1_list struct {
+	list *[]*DVPair
+}
+
+func (x *_DVPairs_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DVPairs_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DVPairs_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DVPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DVPairs_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DVPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DVPairs_1_list) AppendMutable() protoreflect.Value {
+	v := new(DVPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DVPairs_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DVPairs_1_list) NewElement() protoreflect.Value {
+	v := new(DVPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DVPairs_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DVPairs       protoreflect.MessageDescriptor
+	fd_DVPairs_pairs protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_DVPairs = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVPairs")
+	fd_DVPairs_pairs = md_DVPairs.Fields().ByName("pairs")
+}
+
+var _ protoreflect.Message = (*fastReflection_DVPairs)(nil)
+
+type fastReflection_DVPairs DVPairs
+
+func (x *DVPairs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DVPairs)(x)
+}
+
+func (x *DVPairs) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DVPairs_messageType fastReflection_DVPairs_messageType
+var _ protoreflect.MessageType = fastReflection_DVPairs_messageType{}
+
+type fastReflection_DVPairs_messageType struct{}
+
+func (x fastReflection_DVPairs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DVPairs)(nil)
+}
+func (x fastReflection_DVPairs_messageType) New() protoreflect.Message {
+	return new(fastReflection_DVPairs)
+}
+func (x fastReflection_DVPairs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVPairs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DVPairs) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVPairs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DVPairs) Type() protoreflect.MessageType {
+	return _fastReflection_DVPairs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DVPairs) New() protoreflect.Message {
+	return new(fastReflection_DVPairs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DVPairs) Interface() protoreflect.ProtoMessage {
+	return (*DVPairs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DVPairs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pairs) != 0 {
+		value := protoreflect.ValueOfList(&_DVPairs_1_list{list: &x.Pairs})
+		if !f(fd_DVPairs_pairs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DVPairs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		return len(x.Pairs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPairs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		x.Pairs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DVPairs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		if len(x.Pairs) == 0 {
+			return protoreflect.ValueOfList(&_DVPairs_1_list{})
+		}
+		listValue := &_DVPairs_1_list{list: &x.Pairs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPairs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		lv := value.List()
+		clv := lv.(*_DVPairs_1_list)
+		x.Pairs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPairs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		if x.Pairs == nil {
+			x.Pairs = []*DVPair{}
+		}
+		value := &_DVPairs_1_list{list: &x.Pairs}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DVPairs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVPairs.pairs":
+		list := []*DVPair{}
+		return protoreflect.ValueOfList(&_DVPairs_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DVPairs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVPairs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DVPairs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVPairs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DVPairs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DVPairs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Pairs) > 0 {
+			for _, e := range x.Pairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DVPairs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Pairs) > 0 {
+			for iNdEx := len(x.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DVPairs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pairs = append(x.Pairs, &DVPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pairs[len(x.Pairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DVVTriplet                       protoreflect.MessageDescriptor
+	fd_DVVTriplet_delegator_address     protoreflect.FieldDescriptor
+	fd_DVVTriplet_validator_src_address protoreflect.FieldDescriptor
+	fd_DVVTriplet_validator_dst_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_DVVTriplet = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVVTriplet")
+	fd_DVVTriplet_delegator_address = md_DVVTriplet.Fields().ByName("delegator_address")
+	fd_DVVTriplet_validator_src_address = md_DVVTriplet.Fields().ByName("validator_src_address")
+	fd_DVVTriplet_validator_dst_address = md_DVVTriplet.Fields().ByName("validator_dst_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_DVVTriplet)(nil)
+
+type fastReflection_DVVTriplet DVVTriplet
+
+func (x *DVVTriplet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DVVTriplet)(x)
+}
+
+func (x *DVVTriplet) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DVVTriplet_messageType fastReflection_DVVTriplet_messageType
+var _ protoreflect.MessageType = fastReflection_DVVTriplet_messageType{}
+
+type fastReflection_DVVTriplet_messageType struct{}
+
+func (x fastReflection_DVVTriplet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DVVTriplet)(nil)
+}
+func (x fastReflection_DVVTriplet_messageType) New() protoreflect.Message {
+	return new(fastReflection_DVVTriplet)
+}
+func (x fastReflection_DVVTriplet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVVTriplet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DVVTriplet) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVVTriplet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DVVTriplet) Type() protoreflect.MessageType {
+	return _fastReflection_DVVTriplet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DVVTriplet) New() protoreflect.Message {
+	return new(fastReflection_DVVTriplet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DVVTriplet) Interface() protoreflect.ProtoMessage {
+	return (*DVVTriplet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DVVTriplet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_DVVTriplet_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorSrcAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorSrcAddress)
+		if !f(fd_DVVTriplet_validator_src_address, value) {
+			return
+		}
+	}
+	if x.ValidatorDstAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorDstAddress)
+		if !f(fd_DVVTriplet_validator_dst_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DVVTriplet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		return x.ValidatorSrcAddress != ""
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		return x.ValidatorDstAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		x.ValidatorSrcAddress = ""
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		x.ValidatorDstAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DVVTriplet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		value := x.ValidatorSrcAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		value := x.ValidatorDstAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		x.ValidatorSrcAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		x.ValidatorDstAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		panic(fmt.Errorf("field validator_src_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		panic(fmt.Errorf("field validator_dst_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DVVTriplet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DVVTriplet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVVTriplet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DVVTriplet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DVVTriplet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DVVTriplet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorSrcAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorDstAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DVVTriplet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorDstAddress) > 0 {
+			i -= len(x.ValidatorDstAddress)
+			copy(dAtA[i:], x.ValidatorDstAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorDstAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorSrcAddress) > 0 {
+			i -= len(x.ValidatorSrcAddress)
+			copy(dAtA[i:], x.ValidatorSrcAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSrcAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DVVTriplet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DVVTriplets_1_list)(nil)
+
+type _DVVTriplets_1_list struct {
+	list *[]*DVVTriplet
+}
+
+func (x *_DVVTriplets_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DVVTriplets_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DVVTriplets_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DVVTriplet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DVVTriplets_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DVVTriplet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DVVTriplets_1_list) AppendMutable() protoreflect.Value {
+	v := new(DVVTriplet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DVVTriplets_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DVVTriplets_1_list) NewElement() protoreflect.Value {
+	v := new(DVVTriplet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DVVTriplets_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DVVTriplets          protoreflect.MessageDescriptor
+	fd_DVVTriplets_triplets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_DVVTriplets = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVVTriplets")
+	fd_DVVTriplets_triplets = md_DVVTriplets.Fields().ByName("triplets")
+}
+
+var _ protoreflect.Message = (*fastReflection_DVVTriplets)(nil)
+
+type fastReflection_DVVTriplets DVVTriplets
+
+func (x *DVVTriplets) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DVVTriplets)(x)
+}
+
+func (x *DVVTriplets) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DVVTriplets_messageType fastReflection_DVVTriplets_messageType
+var _ protoreflect.MessageType = fastReflection_DVVTriplets_messageType{}
+
+type fastReflection_DVVTriplets_messageType struct{}
+
+func (x fastReflection_DVVTriplets_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DVVTriplets)(nil)
+}
+func (x fastReflection_DVVTriplets_messageType) New() protoreflect.Message {
+	return new(fastReflection_DVVTriplets)
+}
+func (x fastReflection_DVVTriplets_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVVTriplets
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DVVTriplets) Descriptor() protoreflect.MessageDescriptor {
+	return md_DVVTriplets
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DVVTriplets) Type() protoreflect.MessageType {
+	return _fastReflection_DVVTriplets_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DVVTriplets) New() protoreflect.Message {
+	return new(fastReflection_DVVTriplets)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DVVTriplets) Interface() protoreflect.ProtoMessage {
+	return (*DVVTriplets)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DVVTriplets) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Triplets) != 0 {
+		value := protoreflect.ValueOfList(&_DVVTriplets_1_list{list: &x.Triplets})
+		if !f(fd_DVVTriplets_triplets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DVVTriplets) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		return len(x.Triplets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplets) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		x.Triplets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DVVTriplets) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		if len(x.Triplets) == 0 {
+			return protoreflect.ValueOfList(&_DVVTriplets_1_list{})
+		}
+		listValue := &_DVVTriplets_1_list{list: &x.Triplets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplets) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		lv := value.List()
+		clv := lv.(*_DVVTriplets_1_list)
+		x.Triplets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplets) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		if x.Triplets == nil {
+			x.Triplets = []*DVVTriplet{}
+		}
+		value := &_DVVTriplets_1_list{list: &x.Triplets}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DVVTriplets) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
+		list := []*DVVTriplet{}
+		return protoreflect.ValueOfList(&_DVVTriplets_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DVVTriplets) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVVTriplets", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DVVTriplets) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DVVTriplets) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DVVTriplets) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DVVTriplets)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Triplets) > 0 {
+			for _, e := range x.Triplets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DVVTriplets)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Triplets) > 0 {
+			for iNdEx := len(x.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Triplets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DVVTriplets)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Triplets = append(x.Triplets, &DVVTriplet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Triplets[len(x.Triplets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Delegation                   protoreflect.MessageDescriptor
+	fd_Delegation_delegator_address protoreflect.FieldDescriptor
+	fd_Delegation_validator_address protoreflect.FieldDescriptor
+	fd_Delegation_shares            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_Delegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Delegation")
+	fd_Delegation_delegator_address = md_Delegation.Fields().ByName("delegator_address")
+	fd_Delegation_validator_address = md_Delegation.Fields().ByName("validator_address")
+	fd_Delegation_shares = md_Delegation.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_Delegation)(nil)
+
+type fastReflection_Delegation Delegation
+
+func (x *Delegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Delegation)(x)
+}
+
+func (x *Delegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Delegation_messageType fastReflection_Delegation_messageType
+var _ protoreflect.MessageType = fastReflection_Delegation_messageType{}
+
+type fastReflection_Delegation_messageType struct{}
+
+func (x fastReflection_Delegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Delegation)(nil)
+}
+func (x fastReflection_Delegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Delegation)
+}
+func (x fastReflection_Delegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Delegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Delegation) Type() protoreflect.MessageType {
+	return _fastReflection_Delegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Delegation) New() protoreflect.Message {
+	return new(fastReflection_Delegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Delegation) Interface() protoreflect.ProtoMessage {
+	return (*Delegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Delegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_Delegation_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_Delegation_validator_address, value) {
+			return
+		}
+	}
+	if x.Shares != "" {
+		value := protoreflect.ValueOfString(x.Shares)
+		if !f(fd_Delegation_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Delegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		return x.Shares != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Delegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		x.Shares = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Delegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		value := x.Shares
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Delegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		x.Shares = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Delegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.Delegation is not mutable"))
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.Delegation is not mutable"))
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		panic(fmt.Errorf("field shares of message cosmos.staking.v1beta1.Delegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Delegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Delegation.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Delegation.shares":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Delegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Delegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Delegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Delegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Delegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Delegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Shares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Delegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			i -= len(x.Shares)
+			copy(dAtA[i:], x.Shares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Delegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DelegationLabel                   protoreflect.MessageDescriptor
+	fd_DelegationLabel_delegator_address protoreflect.FieldDescriptor
+	fd_DelegationLabel_validator_address protoreflect.FieldDescriptor
+	fd_DelegationLabel_label             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_staking_v1beta1_staking_proto_init()
+	md_DelegationLabel = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DelegationLabel")
+	fd_DelegationLabel_delegator_address = md_DelegationLabel.Fields().ByName("delegator_address")
+	fd_DelegationLabel_validator_address = md_DelegationLabel.Fields().ByName("validator_address")
+	fd_DelegationLabel_label = md_DelegationLabel.Fields().ByName("label")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegationLabel)(nil)
+
+type fastReflection_DelegationLabel DelegationLabel
+
+func (x *DelegationLabel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegationLabel)(x)
+}
+
+func (x *DelegationLabel) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegationLabel_messageType fastReflection_DelegationLabel_messageType
+var _ protoreflect.MessageType = fastReflection_DelegationLabel_messageType{}
+
+type fastReflection_DelegationLabel_messageType struct{}
+
+func (x fastReflection_DelegationLabel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegationLabel)(nil)
+}
+func (x fastReflection_DelegationLabel_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegationLabel)
+}
+func (x fastReflection_DelegationLabel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationLabel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegationLabel) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationLabel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegationLabel) Type() protoreflect.MessageType {
+	return _fastReflection_DelegationLabel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegationLabel) New() protoreflect.Message {
+	return new(fastReflection_DelegationLabel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegationLabel) Interface() protoreflect.ProtoMessage {
+	return (*DelegationLabel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegationLabel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_DelegationLabel_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DelegationLabel_validator_address, value) {
+			return
+		}
+	}
+	if x.Label != "" {
+		value := protoreflect.ValueOfString(x.Label)
+		if !f(fd_DelegationLabel_label, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegationLabel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		return x.Label != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLabel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		x.Label = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegationLabel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		value := x.Label
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLabel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		x.Label = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLabel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.DelegationLabel is not mutable"))
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.DelegationLabel is not mutable"))
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		panic(fmt.Errorf("field label of message cosmos.staking.v1beta1.DelegationLabel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegationLabel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.staking.v1beta1.DelegationLabel.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.DelegationLabel.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.DelegationLabel.label":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationLabel"))
+		}
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.DelegationLabel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegationLabel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DelegationLabel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegationLabel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLabel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegationLabel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegationLabel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegationLabel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Label)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationLabel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Label) > 0 {
+			i -= len(x.Label)
+			copy(dAtA[i:], x.Label)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Label)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationLabel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationLabel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Label = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
@@ -4602,78 +8437,31 @@ func (x *fastReflection_DVPair) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DVPairs_1_list)(nil)
-
-type _DVPairs_1_list struct {
-	list *[]*DVPair
-}
-
-func (x *_DVPairs_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DVPairs_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DVPairs_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DVPair)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DVPairs_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DVPair)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DVPairs_1_list) AppendMutable() protoreflect.Value {
-	v := new(DVPair)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DVPairs_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DVPairs_1_list) NewElement() protoreflect.Value {
-	v := new(DVPair)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DVPairs_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DVPairs       protoreflect.MessageDescriptor
-	fd_DVPairs_pairs protoreflect.FieldDescriptor
+	md_AutoRedelegation                            protoreflect.MessageDescriptor
+	fd_AutoRedelegation_delegator_address          protoreflect.FieldDescriptor
+	fd_AutoRedelegation_fallback_validator_address protoreflect.FieldDescriptor
+	fd_AutoRedelegation_jail_duration              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_DVPairs = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVPairs")
-	fd_DVPairs_pairs = md_DVPairs.Fields().ByName("pairs")
+	md_AutoRedelegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("AutoRedelegation")
+	fd_AutoRedelegation_delegator_address = md_AutoRedelegation.Fields().ByName("delegator_address")
+	fd_AutoRedelegation_fallback_validator_address = md_AutoRedelegation.Fields().ByName("fallback_validator_address")
+	fd_AutoRedelegation_jail_duration = md_AutoRedelegation.Fields().ByName("jail_duration")
 }
 
-var _ protoreflect.Message = (*fastReflection_DVPairs)(nil)
+var _ protoreflect.Message = (*fastReflection_AutoRedelegation)(nil)
 
-type fastReflection_DVPairs DVPairs
+type fastReflection_AutoRedelegation AutoRedelegation
 
-func (x *DVPairs) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DVPairs)(x)
+func (x *AutoRedelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoRedelegation)(x)
 }
 
-func (x *DVPairs) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[7]
+func (x *AutoRedelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4684,43 +8472,43 @@ func (x *DVPairs) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DVPairs_messageType fastReflection_DVPairs_messageType
-var _ protoreflect.MessageType = fastReflection_DVPairs_messageType{}
+var _fastReflection_AutoRedelegation_messageType fastReflection_AutoRedelegation_messageType
+var _ protoreflect.MessageType = fastReflection_AutoRedelegation_messageType{}
 
-type fastReflection_DVPairs_messageType struct{}
+type fastReflection_AutoRedelegation_messageType struct{}
 
-func (x fastReflection_DVPairs_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DVPairs)(nil)
+func (x fastReflection_AutoRedelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoRedelegation)(nil)
 }
-func (x fastReflection_DVPairs_messageType) New() protoreflect.Message {
-	return new(fastReflection_DVPairs)
+func (x fastReflection_AutoRedelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoRedelegation)
 }
-func (x fastReflection_DVPairs_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVPairs
+func (x fastReflection_AutoRedelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRedelegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DVPairs) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVPairs
+func (x *fastReflection_AutoRedelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRedelegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DVPairs) Type() protoreflect.MessageType {
-	return _fastReflection_DVPairs_messageType
+func (x *fastReflection_AutoRedelegation) Type() protoreflect.MessageType {
+	return _fastReflection_AutoRedelegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DVPairs) New() protoreflect.Message {
-	return new(fastReflection_DVPairs)
+func (x *fastReflection_AutoRedelegation) New() protoreflect.Message {
+	return new(fastReflection_AutoRedelegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DVPairs) Interface() protoreflect.ProtoMessage {
-	return (*DVPairs)(x)
+func (x *fastReflection_AutoRedelegation) Interface() protoreflect.ProtoMessage {
+	return (*AutoRedelegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4728,10 +8516,22 @@ func (x *fastReflection_DVPairs) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DVPairs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Pairs) != 0 {
-		value := protoreflect.ValueOfList(&_DVPairs_1_list{list: &x.Pairs})
-		if !f(fd_DVPairs_pairs, value) {
+func (x *fastReflection_AutoRedelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_AutoRedelegation_delegator_address, value) {
+			return
+		}
+	}
+	if x.FallbackValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.FallbackValidatorAddress)
+		if !f(fd_AutoRedelegation_fallback_validator_address, value) {
+			return
+		}
+	}
+	if x.JailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+		if !f(fd_AutoRedelegation_jail_duration, value) {
 			return
 		}
 	}
@@ -4748,15 +8548,19 @@ func (x *fastReflection_DVPairs) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DVPairs) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AutoRedelegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		return len(x.Pairs) != 0
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		return x.FallbackValidatorAddress != ""
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		return x.JailDuration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4766,15 +8570,19 @@ func (x *fastReflection_DVPairs) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPairs) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AutoRedelegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		x.Pairs = nil
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		x.FallbackValidatorAddress = ""
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		x.JailDuration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4784,19 +8592,22 @@ func (x *fastReflection_DVPairs) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DVPairs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AutoRedelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		if len(x.Pairs) == 0 {
-			return protoreflect.ValueOfList(&_DVPairs_1_list{})
-		}
-		listValue := &_DVPairs_1_list{list: &x.Pairs}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		value := x.FallbackValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4810,17 +8621,19 @@ func (x *fastReflection_DVPairs) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPairs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AutoRedelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		lv := value.List()
-		clv := lv.(*_DVPairs_1_list)
-		x.Pairs = *clv.list
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		x.FallbackValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		x.JailDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4834,45 +8647,52 @@ func (x *fastReflection_DVPairs) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPairs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AutoRedelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		if x.Pairs == nil {
-			x.Pairs = []*DVPair{}
-		}
-		value := &_DVPairs_1_list{list: &x.Pairs}
-		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		if x.JailDuration == nil {
+			x.JailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.AutoRedelegation is not mutable"))
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		panic(fmt.Errorf("field fallback_validator_address of message cosmos.staking.v1beta1.AutoRedelegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DVPairs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AutoRedelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVPairs.pairs":
-		list := []*DVPair{}
-		return protoreflect.ValueOfList(&_DVPairs_1_list{list: &list})
+	case "cosmos.staking.v1beta1.AutoRedelegation.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.AutoRedelegation.fallback_validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.AutoRedelegation.jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVPairs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.AutoRedelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVPairs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.AutoRedelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DVPairs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AutoRedelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVPairs", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.AutoRedelegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4880,7 +8700,7 @@ func (x *fastReflection_DVPairs) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DVPairs) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AutoRedelegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4891,7 +8711,7 @@ func (x *fastReflection_DVPairs) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVPairs) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AutoRedelegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4903,7 +8723,7 @@ func (x *fastReflection_DVPairs) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DVPairs) IsValid() bool {
+func (x *fastReflection_AutoRedelegation) IsValid() bool {
 	return x != nil
 }
 
@@ -4913,9 +8733,9 @@ func (x *fastReflection_DVPairs) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AutoRedelegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DVPairs)
+		x := input.Message.Interface().(*AutoRedelegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4927,11 +8747,17 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Pairs) > 0 {
-			for _, e := range x.Pairs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FallbackValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JailDuration != nil {
+			l = options.Size(x.JailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4943,7 +8769,7 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DVPairs)
+		x := input.Message.Interface().(*AutoRedelegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4962,21 +8788,33 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Pairs) > 0 {
-			for iNdEx := len(x.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Pairs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.JailDuration != nil {
+			encoded, err := options.Marshal(x.JailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FallbackValidatorAddress) > 0 {
+			i -= len(x.FallbackValidatorAddress)
+			copy(dAtA[i:], x.FallbackValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FallbackValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4989,7 +8827,7 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DVPairs)
+		x := input.Message.Interface().(*AutoRedelegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5008,28 +8846,92 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRedelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FallbackValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5056,8 +8958,10 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Pairs = append(x.Pairs, &DVPair{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pairs[len(x.Pairs)-1]); err != nil {
+				if x.JailDuration == nil {
+					x.JailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailDuration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5097,30 +9001,32 @@ func (x *fastReflection_DVPairs) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_DVVTriplet                       protoreflect.MessageDescriptor
-	fd_DVVTriplet_delegator_address     protoreflect.FieldDescriptor
-	fd_DVVTriplet_validator_src_address protoreflect.FieldDescriptor
-	fd_DVVTriplet_validator_dst_address protoreflect.FieldDescriptor
+	md_JailRecord                   protoreflect.MessageDescriptor
+	fd_JailRecord_validator_address protoreflect.FieldDescriptor
+	fd_JailRecord_reason            protoreflect.FieldDescriptor
+	fd_JailRecord_height            protoreflect.FieldDescriptor
+	fd_JailRecord_time              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_DVVTriplet = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVVTriplet")
-	fd_DVVTriplet_delegator_address = md_DVVTriplet.Fields().ByName("delegator_address")
-	fd_DVVTriplet_validator_src_address = md_DVVTriplet.Fields().ByName("validator_src_address")
-	fd_DVVTriplet_validator_dst_address = md_DVVTriplet.Fields().ByName("validator_dst_address")
+	md_JailRecord = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("JailRecord")
+	fd_JailRecord_validator_address = md_JailRecord.Fields().ByName("validator_address")
+	fd_JailRecord_reason = md_JailRecord.Fields().ByName("reason")
+	fd_JailRecord_height = md_JailRecord.Fields().ByName("height")
+	fd_JailRecord_time = md_JailRecord.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_DVVTriplet)(nil)
+var _ protoreflect.Message = (*fastReflection_JailRecord)(nil)
 
-type fastReflection_DVVTriplet DVVTriplet
+type fastReflection_JailRecord JailRecord
 
-func (x *DVVTriplet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DVVTriplet)(x)
+func (x *JailRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_JailRecord)(x)
 }
 
-func (x *DVVTriplet) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[8]
+func (x *JailRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5131,43 +9037,43 @@ func (x *DVVTriplet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DVVTriplet_messageType fastReflection_DVVTriplet_messageType
-var _ protoreflect.MessageType = fastReflection_DVVTriplet_messageType{}
+var _fastReflection_JailRecord_messageType fastReflection_JailRecord_messageType
+var _ protoreflect.MessageType = fastReflection_JailRecord_messageType{}
 
-type fastReflection_DVVTriplet_messageType struct{}
+type fastReflection_JailRecord_messageType struct{}
 
-func (x fastReflection_DVVTriplet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DVVTriplet)(nil)
+func (x fastReflection_JailRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_JailRecord)(nil)
 }
-func (x fastReflection_DVVTriplet_messageType) New() protoreflect.Message {
-	return new(fastReflection_DVVTriplet)
+func (x fastReflection_JailRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_JailRecord)
 }
-func (x fastReflection_DVVTriplet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVVTriplet
+func (x fastReflection_JailRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_JailRecord
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DVVTriplet) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVVTriplet
+func (x *fastReflection_JailRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_JailRecord
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DVVTriplet) Type() protoreflect.MessageType {
-	return _fastReflection_DVVTriplet_messageType
+func (x *fastReflection_JailRecord) Type() protoreflect.MessageType {
+	return _fastReflection_JailRecord_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DVVTriplet) New() protoreflect.Message {
-	return new(fastReflection_DVVTriplet)
+func (x *fastReflection_JailRecord) New() protoreflect.Message {
+	return new(fastReflection_JailRecord)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DVVTriplet) Interface() protoreflect.ProtoMessage {
-	return (*DVVTriplet)(x)
+func (x *fastReflection_JailRecord) Interface() protoreflect.ProtoMessage {
+	return (*JailRecord)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5175,22 +9081,28 @@ func (x *fastReflection_DVVTriplet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DVVTriplet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_DVVTriplet_delegator_address, value) {
+func (x *fastReflection_JailRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_JailRecord_validator_address, value) {
 			return
 		}
 	}
-	if x.ValidatorSrcAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorSrcAddress)
-		if !f(fd_DVVTriplet_validator_src_address, value) {
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_JailRecord_reason, value) {
 			return
 		}
 	}
-	if x.ValidatorDstAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorDstAddress)
-		if !f(fd_DVVTriplet_validator_dst_address, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_JailRecord_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_JailRecord_time, value) {
 			return
 		}
 	}
@@ -5207,19 +9119,21 @@ func (x *fastReflection_DVVTriplet) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DVVTriplet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_JailRecord) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		return x.DelegatorAddress != ""
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		return x.ValidatorSrcAddress != ""
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
-		return x.ValidatorDstAddress != ""
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		return x.Reason != 0
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		return x.Height != int64(0)
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		return x.Time != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5229,19 +9143,21 @@ func (x *fastReflection_DVVTriplet) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_JailRecord) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		x.DelegatorAddress = ""
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		x.ValidatorSrcAddress = ""
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
-		x.ValidatorDstAddress = ""
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		x.Reason = 0
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		x.Height = int64(0)
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		x.Time = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5251,22 +9167,25 @@ func (x *fastReflection_DVVTriplet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DVVTriplet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_JailRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		value := x.ValidatorSrcAddress
-		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
-		value := x.ValidatorDstAddress
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5280,19 +9199,21 @@ func (x *fastReflection_DVVTriplet) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_JailRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		x.ValidatorSrcAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
-		x.ValidatorDstAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		x.Reason = (JailReason)(value.Enum())
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		x.Height = value.Int()
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5306,48 +9227,56 @@ func (x *fastReflection_DVVTriplet) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_JailRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		panic(fmt.Errorf("field validator_src_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
-		panic(fmt.Errorf("field validator_dst_address of message cosmos.staking.v1beta1.DVVTriplet is not mutable"))
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.JailRecord is not mutable"))
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		panic(fmt.Errorf("field reason of message cosmos.staking.v1beta1.JailRecord is not mutable"))
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		panic(fmt.Errorf("field height of message cosmos.staking.v1beta1.JailRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DVVTriplet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_JailRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplet.delegator_address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_src_address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.DVVTriplet.validator_dst_address":
+	case "cosmos.staking.v1beta1.JailRecord.validator_address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.JailRecord.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.staking.v1beta1.JailRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.JailRecord.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.JailRecord"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.JailRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DVVTriplet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_JailRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVVTriplet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.JailRecord", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5355,7 +9284,7 @@ func (x *fastReflection_DVVTriplet) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DVVTriplet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_JailRecord) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5366,7 +9295,7 @@ func (x *fastReflection_DVVTriplet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_JailRecord) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5378,7 +9307,7 @@ func (x *fastReflection_DVVTriplet) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DVVTriplet) IsValid() bool {
+func (x *fastReflection_JailRecord) IsValid() bool {
 	return x != nil
 }
 
@@ -5388,9 +9317,9 @@ func (x *fastReflection_DVVTriplet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_JailRecord) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DVVTriplet)
+		x := input.Message.Interface().(*JailRecord)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5402,16 +9331,18 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
+		l = len(x.ValidatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ValidatorSrcAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
 		}
-		l = len(x.ValidatorDstAddress)
-		if l > 0 {
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5424,7 +9355,7 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DVVTriplet)
+		x := input.Message.Interface().(*JailRecord)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5443,24 +9374,34 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorDstAddress) > 0 {
-			i -= len(x.ValidatorDstAddress)
-			copy(dAtA[i:], x.ValidatorDstAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorDstAddress)))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
-		if len(x.ValidatorSrcAddress) > 0 {
-			i -= len(x.ValidatorSrcAddress)
-			copy(dAtA[i:], x.ValidatorSrcAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSrcAddress)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x18
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5475,7 +9416,7 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DVVTriplet)
+		x := input.Message.Interface().(*JailRecord)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5507,15 +9448,15 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5543,13 +9484,13 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				var stringLen uint64
+				x.Reason = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5559,29 +9500,35 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Reason |= JailReason(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5591,23 +9538,27 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5644,78 +9595,29 @@ func (x *fastReflection_DVVTriplet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DVVTriplets_1_list)(nil)
-
-type _DVVTriplets_1_list struct {
-	list *[]*DVVTriplet
-}
-
-func (x *_DVVTriplets_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DVVTriplets_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DVVTriplets_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DVVTriplet)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DVVTriplets_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DVVTriplet)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DVVTriplets_1_list) AppendMutable() protoreflect.Value {
-	v := new(DVVTriplet)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DVVTriplets_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DVVTriplets_1_list) NewElement() protoreflect.Value {
-	v := new(DVVTriplet)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DVVTriplets_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DVVTriplets          protoreflect.MessageDescriptor
-	fd_DVVTriplets_triplets protoreflect.FieldDescriptor
+	md_SelfDelegationDeficit                   protoreflect.MessageDescriptor
+	fd_SelfDelegationDeficit_validator_address protoreflect.FieldDescriptor
+	fd_SelfDelegationDeficit_jail_time         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_DVVTriplets = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DVVTriplets")
-	fd_DVVTriplets_triplets = md_DVVTriplets.Fields().ByName("triplets")
+	md_SelfDelegationDeficit = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("SelfDelegationDeficit")
+	fd_SelfDelegationDeficit_validator_address = md_SelfDelegationDeficit.Fields().ByName("validator_address")
+	fd_SelfDelegationDeficit_jail_time = md_SelfDelegationDeficit.Fields().ByName("jail_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_DVVTriplets)(nil)
+var _ protoreflect.Message = (*fastReflection_SelfDelegationDeficit)(nil)
 
-type fastReflection_DVVTriplets DVVTriplets
+type fastReflection_SelfDelegationDeficit SelfDelegationDeficit
 
-func (x *DVVTriplets) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DVVTriplets)(x)
+func (x *SelfDelegationDeficit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SelfDelegationDeficit)(x)
 }
 
-func (x *DVVTriplets) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[9]
+func (x *SelfDelegationDeficit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5726,43 +9628,43 @@ func (x *DVVTriplets) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DVVTriplets_messageType fastReflection_DVVTriplets_messageType
-var _ protoreflect.MessageType = fastReflection_DVVTriplets_messageType{}
+var _fastReflection_SelfDelegationDeficit_messageType fastReflection_SelfDelegationDeficit_messageType
+var _ protoreflect.MessageType = fastReflection_SelfDelegationDeficit_messageType{}
 
-type fastReflection_DVVTriplets_messageType struct{}
+type fastReflection_SelfDelegationDeficit_messageType struct{}
 
-func (x fastReflection_DVVTriplets_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DVVTriplets)(nil)
+func (x fastReflection_SelfDelegationDeficit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SelfDelegationDeficit)(nil)
 }
-func (x fastReflection_DVVTriplets_messageType) New() protoreflect.Message {
-	return new(fastReflection_DVVTriplets)
+func (x fastReflection_SelfDelegationDeficit_messageType) New() protoreflect.Message {
+	return new(fastReflection_SelfDelegationDeficit)
 }
-func (x fastReflection_DVVTriplets_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVVTriplets
+func (x fastReflection_SelfDelegationDeficit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SelfDelegationDeficit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DVVTriplets) Descriptor() protoreflect.MessageDescriptor {
-	return md_DVVTriplets
+func (x *fastReflection_SelfDelegationDeficit) Descriptor() protoreflect.MessageDescriptor {
+	return md_SelfDelegationDeficit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DVVTriplets) Type() protoreflect.MessageType {
-	return _fastReflection_DVVTriplets_messageType
+func (x *fastReflection_SelfDelegationDeficit) Type() protoreflect.MessageType {
+	return _fastReflection_SelfDelegationDeficit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DVVTriplets) New() protoreflect.Message {
-	return new(fastReflection_DVVTriplets)
+func (x *fastReflection_SelfDelegationDeficit) New() protoreflect.Message {
+	return new(fastReflection_SelfDelegationDeficit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DVVTriplets) Interface() protoreflect.ProtoMessage {
-	return (*DVVTriplets)(x)
+func (x *fastReflection_SelfDelegationDeficit) Interface() protoreflect.ProtoMessage {
+	return (*SelfDelegationDeficit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5770,10 +9672,16 @@ func (x *fastReflection_DVVTriplets) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DVVTriplets) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Triplets) != 0 {
-		value := protoreflect.ValueOfList(&_DVVTriplets_1_list{list: &x.Triplets})
-		if !f(fd_DVVTriplets_triplets, value) {
+func (x *fastReflection_SelfDelegationDeficit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_SelfDelegationDeficit_validator_address, value) {
+			return
+		}
+	}
+	if x.JailTime != nil {
+		value := protoreflect.ValueOfMessage(x.JailTime.ProtoReflect())
+		if !f(fd_SelfDelegationDeficit_jail_time, value) {
 			return
 		}
 	}
@@ -5790,15 +9698,17 @@ func (x *fastReflection_DVVTriplets) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DVVTriplets) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SelfDelegationDeficit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		return len(x.Triplets) != 0
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		return x.JailTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5808,15 +9718,17 @@ func (x *fastReflection_DVVTriplets) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplets) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SelfDelegationDeficit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		x.Triplets = nil
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		x.JailTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5826,19 +9738,19 @@ func (x *fastReflection_DVVTriplets) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DVVTriplets) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SelfDelegationDeficit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		if len(x.Triplets) == 0 {
-			return protoreflect.ValueOfList(&_DVVTriplets_1_list{})
-		}
-		listValue := &_DVVTriplets_1_list{list: &x.Triplets}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		value := x.JailTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5852,17 +9764,17 @@ func (x *fastReflection_DVVTriplets) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplets) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SelfDelegationDeficit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		lv := value.List()
-		clv := lv.(*_DVVTriplets_1_list)
-		x.Triplets = *clv.list
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		x.JailTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5876,45 +9788,48 @@ func (x *fastReflection_DVVTriplets) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplets) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SelfDelegationDeficit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		if x.Triplets == nil {
-			x.Triplets = []*DVVTriplet{}
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		if x.JailTime == nil {
+			x.JailTime = new(timestamppb.Timestamp)
 		}
-		value := &_DVVTriplets_1_list{list: &x.Triplets}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.JailTime.ProtoReflect())
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.SelfDelegationDeficit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DVVTriplets) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SelfDelegationDeficit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.DVVTriplets.triplets":
-		list := []*DVVTriplet{}
-		return protoreflect.ValueOfList(&_DVVTriplets_1_list{list: &list})
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DVVTriplets"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.SelfDelegationDeficit"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.DVVTriplets does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.SelfDelegationDeficit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DVVTriplets) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SelfDelegationDeficit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.DVVTriplets", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.SelfDelegationDeficit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5922,7 +9837,7 @@ func (x *fastReflection_DVVTriplets) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DVVTriplets) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SelfDelegationDeficit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5933,7 +9848,7 @@ func (x *fastReflection_DVVTriplets) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DVVTriplets) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SelfDelegationDeficit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5945,7 +9860,7 @@ func (x *fastReflection_DVVTriplets) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DVVTriplets) IsValid() bool {
+func (x *fastReflection_SelfDelegationDeficit) IsValid() bool {
 	return x != nil
 }
 
@@ -5955,9 +9870,9 @@ func (x *fastReflection_DVVTriplets) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SelfDelegationDeficit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DVVTriplets)
+		x := input.Message.Interface().(*SelfDelegationDeficit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5969,11 +9884,13 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Triplets) > 0 {
-			for _, e := range x.Triplets {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JailTime != nil {
+			l = options.Size(x.JailTime)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5985,7 +9902,7 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DVVTriplets)
+		x := input.Message.Interface().(*SelfDelegationDeficit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6004,21 +9921,26 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Triplets) > 0 {
-			for iNdEx := len(x.Triplets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Triplets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.JailTime != nil {
+			encoded, err := options.Marshal(x.JailTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6031,7 +9953,7 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DVVTriplets)
+		x := input.Message.Interface().(*SelfDelegationDeficit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6063,15 +9985,47 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SelfDelegationDeficit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SelfDelegationDeficit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6098,8 +10052,10 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Triplets = append(x.Triplets, &DVVTriplet{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Triplets[len(x.Triplets)-1]); err != nil {
+				if x.JailTime == nil {
+					x.JailTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6138,31 +10094,82 @@ func (x *fastReflection_DVVTriplets) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_UnbondingDelegation_3_list)(nil)
+
+type _UnbondingDelegation_3_list struct {
+	list *[]*UnbondingDelegationEntry
+}
+
+func (x *_UnbondingDelegation_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_UnbondingDelegation_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_UnbondingDelegation_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingDelegationEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UnbondingDelegation_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingDelegationEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UnbondingDelegation_3_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingDelegationEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingDelegation_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UnbondingDelegation_3_list) NewElement() protoreflect.Value {
+	v := new(UnbondingDelegationEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingDelegation_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Delegation                   protoreflect.MessageDescriptor
-	fd_Delegation_delegator_address protoreflect.FieldDescriptor
-	fd_Delegation_validator_address protoreflect.FieldDescriptor
-	fd_Delegation_shares            protoreflect.FieldDescriptor
+	md_UnbondingDelegation                   protoreflect.MessageDescriptor
+	fd_UnbondingDelegation_delegator_address protoreflect.FieldDescriptor
+	fd_UnbondingDelegation_validator_address protoreflect.FieldDescriptor
+	fd_UnbondingDelegation_entries           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Delegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Delegation")
-	fd_Delegation_delegator_address = md_Delegation.Fields().ByName("delegator_address")
-	fd_Delegation_validator_address = md_Delegation.Fields().ByName("validator_address")
-	fd_Delegation_shares = md_Delegation.Fields().ByName("shares")
+	md_UnbondingDelegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("UnbondingDelegation")
+	fd_UnbondingDelegation_delegator_address = md_UnbondingDelegation.Fields().ByName("delegator_address")
+	fd_UnbondingDelegation_validator_address = md_UnbondingDelegation.Fields().ByName("validator_address")
+	fd_UnbondingDelegation_entries = md_UnbondingDelegation.Fields().ByName("entries")
 }
 
-var _ protoreflect.Message = (*fastReflection_Delegation)(nil)
+var _ protoreflect.Message = (*fastReflection_UnbondingDelegation)(nil)
 
-type fastReflection_Delegation Delegation
+type fastReflection_UnbondingDelegation UnbondingDelegation
 
-func (x *Delegation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Delegation)(x)
+func (x *UnbondingDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingDelegation)(x)
 }
 
-func (x *Delegation) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[10]
+func (x *UnbondingDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6173,43 +10180,43 @@ func (x *Delegation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Delegation_messageType fastReflection_Delegation_messageType
-var _ protoreflect.MessageType = fastReflection_Delegation_messageType{}
+var _fastReflection_UnbondingDelegation_messageType fastReflection_UnbondingDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingDelegation_messageType{}
 
-type fastReflection_Delegation_messageType struct{}
+type fastReflection_UnbondingDelegation_messageType struct{}
 
-func (x fastReflection_Delegation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Delegation)(nil)
+func (x fastReflection_UnbondingDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingDelegation)(nil)
 }
-func (x fastReflection_Delegation_messageType) New() protoreflect.Message {
-	return new(fastReflection_Delegation)
+func (x fastReflection_UnbondingDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingDelegation)
 }
-func (x fastReflection_Delegation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Delegation
+func (x fastReflection_UnbondingDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingDelegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Delegation) Descriptor() protoreflect.MessageDescriptor {
-	return md_Delegation
+func (x *fastReflection_UnbondingDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingDelegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Delegation) Type() protoreflect.MessageType {
-	return _fastReflection_Delegation_messageType
+func (x *fastReflection_UnbondingDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingDelegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Delegation) New() protoreflect.Message {
-	return new(fastReflection_Delegation)
+func (x *fastReflection_UnbondingDelegation) New() protoreflect.Message {
+	return new(fastReflection_UnbondingDelegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Delegation) Interface() protoreflect.ProtoMessage {
-	return (*Delegation)(x)
+func (x *fastReflection_UnbondingDelegation) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingDelegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6217,22 +10224,22 @@ func (x *fastReflection_Delegation) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Delegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_UnbondingDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.DelegatorAddress != "" {
 		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_Delegation_delegator_address, value) {
+		if !f(fd_UnbondingDelegation_delegator_address, value) {
 			return
 		}
 	}
 	if x.ValidatorAddress != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_Delegation_validator_address, value) {
+		if !f(fd_UnbondingDelegation_validator_address, value) {
 			return
 		}
 	}
-	if x.Shares != "" {
-		value := protoreflect.ValueOfString(x.Shares)
-		if !f(fd_Delegation_shares, value) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_UnbondingDelegation_3_list{list: &x.Entries})
+		if !f(fd_UnbondingDelegation_entries, value) {
 			return
 		}
 	}
@@ -6249,19 +10256,19 @@ func (x *fastReflection_Delegation) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Delegation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UnbondingDelegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
 		return x.DelegatorAddress != ""
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
 		return x.ValidatorAddress != ""
-	case "cosmos.staking.v1beta1.Delegation.shares":
-		return x.Shares != ""
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		return len(x.Entries) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6271,19 +10278,19 @@ func (x *fastReflection_Delegation) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Delegation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UnbondingDelegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
 		x.DelegatorAddress = ""
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
 		x.ValidatorAddress = ""
-	case "cosmos.staking.v1beta1.Delegation.shares":
-		x.Shares = ""
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		x.Entries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6293,22 +10300,25 @@ func (x *fastReflection_Delegation) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Delegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
 		value := x.DelegatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
 		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Delegation.shares":
-		value := x.Shares
-		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_UnbondingDelegation_3_list{})
+		}
+		listValue := &_UnbondingDelegation_3_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6322,19 +10332,21 @@ func (x *fastReflection_Delegation) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Delegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UnbondingDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
 		x.DelegatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
 		x.ValidatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Delegation.shares":
-		x.Shares = value.Interface().(string)
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		lv := value.List()
+		clv := lv.(*_UnbondingDelegation_3_list)
+		x.Entries = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6348,48 +10360,53 @@ func (x *fastReflection_Delegation) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Delegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.Delegation is not mutable"))
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
-		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.Delegation is not mutable"))
-	case "cosmos.staking.v1beta1.Delegation.shares":
-		panic(fmt.Errorf("field shares of message cosmos.staking.v1beta1.Delegation is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		if x.Entries == nil {
+			x.Entries = []*UnbondingDelegationEntry{}
+		}
+		value := &_UnbondingDelegation_3_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.UnbondingDelegation is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.UnbondingDelegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Delegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Delegation.delegator_address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Delegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Delegation.shares":
+	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
+		list := []*UnbondingDelegationEntry{}
+		return protoreflect.ValueOfList(&_UnbondingDelegation_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Delegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Delegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Delegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UnbondingDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Delegation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.UnbondingDelegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6397,7 +10414,7 @@ func (x *fastReflection_Delegation) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Delegation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UnbondingDelegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6408,7 +10425,7 @@ func (x *fastReflection_Delegation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Delegation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UnbondingDelegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6420,7 +10437,7 @@ func (x *fastReflection_Delegation) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Delegation) IsValid() bool {
+func (x *fastReflection_UnbondingDelegation) IsValid() bool {
 	return x != nil
 }
 
@@ -6430,9 +10447,9 @@ func (x *fastReflection_Delegation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Delegation)
+		x := input.Message.Interface().(*UnbondingDelegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6452,9 +10469,11 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Shares)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6466,7 +10485,7 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Delegation)
+		x := input.Message.Interface().(*UnbondingDelegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6485,12 +10504,21 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Shares) > 0 {
-			i -= len(x.Shares)
-			copy(dAtA[i:], x.Shares)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
 		if len(x.ValidatorAddress) > 0 {
 			i -= len(x.ValidatorAddress)
@@ -6517,7 +10545,7 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Delegation)
+		x := input.Message.Interface().(*UnbondingDelegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6549,10 +10577,10 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6621,9 +10649,9 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6633,23 +10661,25 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Shares = string(dAtA[iNdEx:postIndex])
+				x.Entries = append(x.Entries, &UnbondingDelegationEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6686,82 +10716,37 @@ func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_UnbondingDelegation_3_list)(nil)
-
-type _UnbondingDelegation_3_list struct {
-	list *[]*UnbondingDelegationEntry
-}
-
-func (x *_UnbondingDelegation_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_UnbondingDelegation_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_UnbondingDelegation_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*UnbondingDelegationEntry)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_UnbondingDelegation_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*UnbondingDelegationEntry)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_UnbondingDelegation_3_list) AppendMutable() protoreflect.Value {
-	v := new(UnbondingDelegationEntry)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_UnbondingDelegation_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_UnbondingDelegation_3_list) NewElement() protoreflect.Value {
-	v := new(UnbondingDelegationEntry)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_UnbondingDelegation_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_UnbondingDelegation                   protoreflect.MessageDescriptor
-	fd_UnbondingDelegation_delegator_address protoreflect.FieldDescriptor
-	fd_UnbondingDelegation_validator_address protoreflect.FieldDescriptor
-	fd_UnbondingDelegation_entries           protoreflect.FieldDescriptor
+	md_UnbondingDelegationEntry                             protoreflect.MessageDescriptor
+	fd_UnbondingDelegationEntry_creation_height             protoreflect.FieldDescriptor
+	fd_UnbondingDelegationEntry_completion_time             protoreflect.FieldDescriptor
+	fd_UnbondingDelegationEntry_initial_balance             protoreflect.FieldDescriptor
+	fd_UnbondingDelegationEntry_balance                     protoreflect.FieldDescriptor
+	fd_UnbondingDelegationEntry_unbonding_id                protoreflect.FieldDescriptor
+	fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_UnbondingDelegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("UnbondingDelegation")
-	fd_UnbondingDelegation_delegator_address = md_UnbondingDelegation.Fields().ByName("delegator_address")
-	fd_UnbondingDelegation_validator_address = md_UnbondingDelegation.Fields().ByName("validator_address")
-	fd_UnbondingDelegation_entries = md_UnbondingDelegation.Fields().ByName("entries")
+	md_UnbondingDelegationEntry = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("UnbondingDelegationEntry")
+	fd_UnbondingDelegationEntry_creation_height = md_UnbondingDelegationEntry.Fields().ByName("creation_height")
+	fd_UnbondingDelegationEntry_completion_time = md_UnbondingDelegationEntry.Fields().ByName("completion_time")
+	fd_UnbondingDelegationEntry_initial_balance = md_UnbondingDelegationEntry.Fields().ByName("initial_balance")
+	fd_UnbondingDelegationEntry_balance = md_UnbondingDelegationEntry.Fields().ByName("balance")
+	fd_UnbondingDelegationEntry_unbonding_id = md_UnbondingDelegationEntry.Fields().ByName("unbonding_id")
+	fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count = md_UnbondingDelegationEntry.Fields().ByName("unbonding_on_hold_ref_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_UnbondingDelegation)(nil)
+var _ protoreflect.Message = (*fastReflection_UnbondingDelegationEntry)(nil)
 
-type fastReflection_UnbondingDelegation UnbondingDelegation
+type fastReflection_UnbondingDelegationEntry UnbondingDelegationEntry
 
-func (x *UnbondingDelegation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UnbondingDelegation)(x)
+func (x *UnbondingDelegationEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingDelegationEntry)(x)
 }
 
-func (x *UnbondingDelegation) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[11]
+func (x *UnbondingDelegationEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6772,43 +10757,43 @@ func (x *UnbondingDelegation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_UnbondingDelegation_messageType fastReflection_UnbondingDelegation_messageType
-var _ protoreflect.MessageType = fastReflection_UnbondingDelegation_messageType{}
+var _fastReflection_UnbondingDelegationEntry_messageType fastReflection_UnbondingDelegationEntry_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingDelegationEntry_messageType{}
 
-type fastReflection_UnbondingDelegation_messageType struct{}
+type fastReflection_UnbondingDelegationEntry_messageType struct{}
 
-func (x fastReflection_UnbondingDelegation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UnbondingDelegation)(nil)
+func (x fastReflection_UnbondingDelegationEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingDelegationEntry)(nil)
 }
-func (x fastReflection_UnbondingDelegation_messageType) New() protoreflect.Message {
-	return new(fastReflection_UnbondingDelegation)
+func (x fastReflection_UnbondingDelegationEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingDelegationEntry)
 }
-func (x fastReflection_UnbondingDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingDelegation
+func (x fastReflection_UnbondingDelegationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingDelegationEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_UnbondingDelegation) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingDelegation
+func (x *fastReflection_UnbondingDelegationEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingDelegationEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UnbondingDelegation) Type() protoreflect.MessageType {
-	return _fastReflection_UnbondingDelegation_messageType
+func (x *fastReflection_UnbondingDelegationEntry) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingDelegationEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UnbondingDelegation) New() protoreflect.Message {
-	return new(fastReflection_UnbondingDelegation)
+func (x *fastReflection_UnbondingDelegationEntry) New() protoreflect.Message {
+	return new(fastReflection_UnbondingDelegationEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UnbondingDelegation) Interface() protoreflect.ProtoMessage {
-	return (*UnbondingDelegation)(x)
+func (x *fastReflection_UnbondingDelegationEntry) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingDelegationEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6816,22 +10801,40 @@ func (x *fastReflection_UnbondingDelegation) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UnbondingDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_UnbondingDelegation_delegator_address, value) {
+func (x *fastReflection_UnbondingDelegationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CreationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreationHeight)
+		if !f(fd_UnbondingDelegationEntry_creation_height, value) {
 			return
 		}
 	}
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_UnbondingDelegation_validator_address, value) {
+	if x.CompletionTime != nil {
+		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+		if !f(fd_UnbondingDelegationEntry_completion_time, value) {
 			return
 		}
 	}
-	if len(x.Entries) != 0 {
-		value := protoreflect.ValueOfList(&_UnbondingDelegation_3_list{list: &x.Entries})
-		if !f(fd_UnbondingDelegation_entries, value) {
+	if x.InitialBalance != "" {
+		value := protoreflect.ValueOfString(x.InitialBalance)
+		if !f(fd_UnbondingDelegationEntry_initial_balance, value) {
+			return
+		}
+	}
+	if x.Balance != "" {
+		value := protoreflect.ValueOfString(x.Balance)
+		if !f(fd_UnbondingDelegationEntry_balance, value) {
+			return
+		}
+	}
+	if x.UnbondingId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnbondingId)
+		if !f(fd_UnbondingDelegationEntry_unbonding_id, value) {
+			return
+		}
+	}
+	if x.UnbondingOnHoldRefCount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingOnHoldRefCount)
+		if !f(fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count, value) {
 			return
 		}
 	}
@@ -6848,19 +10851,25 @@ func (x *fastReflection_UnbondingDelegation) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UnbondingDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UnbondingDelegationEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
-		return x.DelegatorAddress != ""
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
-		return x.ValidatorAddress != ""
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		return len(x.Entries) != 0
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		return x.CreationHeight != int64(0)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		return x.CompletionTime != nil
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+		return x.InitialBalance != ""
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+		return x.Balance != ""
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		return x.UnbondingId != uint64(0)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		return x.UnbondingOnHoldRefCount != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6870,19 +10879,25 @@ func (x *fastReflection_UnbondingDelegation) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UnbondingDelegationEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
-		x.DelegatorAddress = ""
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
-		x.ValidatorAddress = ""
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		x.Entries = nil
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		x.CreationHeight = int64(0)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		x.CompletionTime = nil
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+		x.InitialBalance = ""
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+		x.Balance = ""
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		x.UnbondingId = uint64(0)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		x.UnbondingOnHoldRefCount = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6892,25 +10907,31 @@ func (x *fastReflection_UnbondingDelegation) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UnbondingDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
-		value := x.DelegatorAddress
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		value := x.CreationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		value := x.CompletionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+		value := x.InitialBalance
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
-		value := x.ValidatorAddress
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+		value := x.Balance
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		if len(x.Entries) == 0 {
-			return protoreflect.ValueOfList(&_UnbondingDelegation_3_list{})
-		}
-		listValue := &_UnbondingDelegation_3_list{list: &x.Entries}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		value := x.UnbondingId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		value := x.UnbondingOnHoldRefCount
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6924,21 +10945,25 @@ func (x *fastReflection_UnbondingDelegation) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UnbondingDelegationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		lv := value.List()
-		clv := lv.(*_UnbondingDelegation_3_list)
-		x.Entries = *clv.list
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		x.CreationHeight = value.Int()
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+		x.InitialBalance = value.Interface().(string)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+		x.Balance = value.Interface().(string)
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		x.UnbondingId = value.Uint()
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		x.UnbondingOnHoldRefCount = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6952,53 +10977,64 @@ func (x *fastReflection_UnbondingDelegation) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		if x.Entries == nil {
-			x.Entries = []*UnbondingDelegationEntry{}
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		if x.CompletionTime == nil {
+			x.CompletionTime = new(timestamppb.Timestamp)
 		}
-		value := &_UnbondingDelegation_3_list{list: &x.Entries}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.UnbondingDelegation is not mutable"))
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
-		panic(fmt.Errorf("field validator_address of message cosmos.staking.v1beta1.UnbondingDelegation is not mutable"))
+		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		panic(fmt.Errorf("field creation_height of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+		panic(fmt.Errorf("field initial_balance of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+		panic(fmt.Errorf("field balance of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		panic(fmt.Errorf("field unbonding_id of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UnbondingDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingDelegationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegation.delegator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.UnbondingDelegation.validator_address":
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.UnbondingDelegation.entries":
-		list := []*UnbondingDelegationEntry{}
-		return protoreflect.ValueOfList(&_UnbondingDelegation_3_list{list: &list})
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UnbondingDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UnbondingDelegationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.UnbondingDelegation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.UnbondingDelegationEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7006,7 +11042,7 @@ func (x *fastReflection_UnbondingDelegation) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UnbondingDelegation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UnbondingDelegationEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7017,7 +11053,7 @@ func (x *fastReflection_UnbondingDelegation) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UnbondingDelegationEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7029,7 +11065,7 @@ func (x *fastReflection_UnbondingDelegation) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UnbondingDelegation) IsValid() bool {
+func (x *fastReflection_UnbondingDelegationEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -7039,9 +11075,9 @@ func (x *fastReflection_UnbondingDelegation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UnbondingDelegation)
+		x := input.Message.Interface().(*UnbondingDelegationEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7053,19 +11089,26 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
+		if x.CreationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreationHeight))
+		}
+		if x.CompletionTime != nil {
+			l = options.Size(x.CompletionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InitialBalance)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ValidatorAddress)
+		l = len(x.Balance)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Entries) > 0 {
-			for _, e := range x.Entries {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.UnbondingId != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingId))
+		}
+		if x.UnbondingOnHoldRefCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingOnHoldRefCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7077,7 +11120,7 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingDelegation)
+		x := input.Message.Interface().(*UnbondingDelegationEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7096,35 +11139,48 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Entries) > 0 {
-			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Entries[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
+		if x.UnbondingOnHoldRefCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingOnHoldRefCount))
+			i--
+			dAtA[i] = 0x30
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if x.UnbondingId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Balance) > 0 {
+			i -= len(x.Balance)
+			copy(dAtA[i:], x.Balance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.InitialBalance) > 0 {
+			i -= len(x.InitialBalance)
+			copy(dAtA[i:], x.InitialBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialBalance)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CompletionTime != nil {
+			encoded, err := options.Marshal(x.CompletionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.CreationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7137,7 +11193,7 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingDelegation)
+		x := input.Message.Interface().(*UnbondingDelegationEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7169,15 +11225,70 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				}
+				x.CreationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CompletionTime == nil {
+					x.CompletionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7205,11 +11316,11 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				x.InitialBalance = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7237,13 +11348,32 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				x.Balance = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+				}
+				x.UnbondingId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				var msglen int
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				}
+				x.UnbondingOnHoldRefCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7253,26 +11383,11 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Entries = append(x.Entries, &UnbondingDelegationEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7309,36 +11424,36 @@ func (x *fastReflection_UnbondingDelegation) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_UnbondingDelegationEntry                             protoreflect.MessageDescriptor
-	fd_UnbondingDelegationEntry_creation_height             protoreflect.FieldDescriptor
-	fd_UnbondingDelegationEntry_completion_time             protoreflect.FieldDescriptor
-	fd_UnbondingDelegationEntry_initial_balance             protoreflect.FieldDescriptor
-	fd_UnbondingDelegationEntry_balance                     protoreflect.FieldDescriptor
-	fd_UnbondingDelegationEntry_unbonding_id                protoreflect.FieldDescriptor
-	fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
+	md_RedelegationEntry                             protoreflect.MessageDescriptor
+	fd_RedelegationEntry_creation_height             protoreflect.FieldDescriptor
+	fd_RedelegationEntry_completion_time             protoreflect.FieldDescriptor
+	fd_RedelegationEntry_initial_balance             protoreflect.FieldDescriptor
+	fd_RedelegationEntry_shares_dst                  protoreflect.FieldDescriptor
+	fd_RedelegationEntry_unbonding_id                protoreflect.FieldDescriptor
+	fd_RedelegationEntry_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_UnbondingDelegationEntry = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("UnbondingDelegationEntry")
-	fd_UnbondingDelegationEntry_creation_height = md_UnbondingDelegationEntry.Fields().ByName("creation_height")
-	fd_UnbondingDelegationEntry_completion_time = md_UnbondingDelegationEntry.Fields().ByName("completion_time")
-	fd_UnbondingDelegationEntry_initial_balance = md_UnbondingDelegationEntry.Fields().ByName("initial_balance")
-	fd_UnbondingDelegationEntry_balance = md_UnbondingDelegationEntry.Fields().ByName("balance")
-	fd_UnbondingDelegationEntry_unbonding_id = md_UnbondingDelegationEntry.Fields().ByName("unbonding_id")
-	fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count = md_UnbondingDelegationEntry.Fields().ByName("unbonding_on_hold_ref_count")
+	md_RedelegationEntry = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("RedelegationEntry")
+	fd_RedelegationEntry_creation_height = md_RedelegationEntry.Fields().ByName("creation_height")
+	fd_RedelegationEntry_completion_time = md_RedelegationEntry.Fields().ByName("completion_time")
+	fd_RedelegationEntry_initial_balance = md_RedelegationEntry.Fields().ByName("initial_balance")
+	fd_RedelegationEntry_shares_dst = md_RedelegationEntry.Fields().ByName("shares_dst")
+	fd_RedelegationEntry_unbonding_id = md_RedelegationEntry.Fields().ByName("unbonding_id")
+	fd_RedelegationEntry_unbonding_on_hold_ref_count = md_RedelegationEntry.Fields().ByName("unbonding_on_hold_ref_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_UnbondingDelegationEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_RedelegationEntry)(nil)
 
-type fastReflection_UnbondingDelegationEntry UnbondingDelegationEntry
+type fastReflection_RedelegationEntry RedelegationEntry
 
-func (x *UnbondingDelegationEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UnbondingDelegationEntry)(x)
+func (x *RedelegationEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationEntry)(x)
 }
 
-func (x *UnbondingDelegationEntry) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[12]
+func (x *RedelegationEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7349,43 +11464,43 @@ func (x *UnbondingDelegationEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_UnbondingDelegationEntry_messageType fastReflection_UnbondingDelegationEntry_messageType
-var _ protoreflect.MessageType = fastReflection_UnbondingDelegationEntry_messageType{}
+var _fastReflection_RedelegationEntry_messageType fastReflection_RedelegationEntry_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationEntry_messageType{}
 
-type fastReflection_UnbondingDelegationEntry_messageType struct{}
+type fastReflection_RedelegationEntry_messageType struct{}
 
-func (x fastReflection_UnbondingDelegationEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UnbondingDelegationEntry)(nil)
+func (x fastReflection_RedelegationEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationEntry)(nil)
 }
-func (x fastReflection_UnbondingDelegationEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_UnbondingDelegationEntry)
+func (x fastReflection_RedelegationEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntry)
 }
-func (x fastReflection_UnbondingDelegationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingDelegationEntry
+func (x fastReflection_RedelegationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_UnbondingDelegationEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingDelegationEntry
+func (x *fastReflection_RedelegationEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UnbondingDelegationEntry) Type() protoreflect.MessageType {
-	return _fastReflection_UnbondingDelegationEntry_messageType
+func (x *fastReflection_RedelegationEntry) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UnbondingDelegationEntry) New() protoreflect.Message {
-	return new(fastReflection_UnbondingDelegationEntry)
+func (x *fastReflection_RedelegationEntry) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UnbondingDelegationEntry) Interface() protoreflect.ProtoMessage {
-	return (*UnbondingDelegationEntry)(x)
+func (x *fastReflection_RedelegationEntry) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7393,40 +11508,40 @@ func (x *fastReflection_UnbondingDelegationEntry) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UnbondingDelegationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_RedelegationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.CreationHeight != int64(0) {
 		value := protoreflect.ValueOfInt64(x.CreationHeight)
-		if !f(fd_UnbondingDelegationEntry_creation_height, value) {
+		if !f(fd_RedelegationEntry_creation_height, value) {
 			return
 		}
 	}
 	if x.CompletionTime != nil {
 		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
-		if !f(fd_UnbondingDelegationEntry_completion_time, value) {
+		if !f(fd_RedelegationEntry_completion_time, value) {
 			return
 		}
 	}
 	if x.InitialBalance != "" {
 		value := protoreflect.ValueOfString(x.InitialBalance)
-		if !f(fd_UnbondingDelegationEntry_initial_balance, value) {
+		if !f(fd_RedelegationEntry_initial_balance, value) {
 			return
 		}
 	}
-	if x.Balance != "" {
-		value := protoreflect.ValueOfString(x.Balance)
-		if !f(fd_UnbondingDelegationEntry_balance, value) {
+	if x.SharesDst != "" {
+		value := protoreflect.ValueOfString(x.SharesDst)
+		if !f(fd_RedelegationEntry_shares_dst, value) {
 			return
 		}
 	}
 	if x.UnbondingId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.UnbondingId)
-		if !f(fd_UnbondingDelegationEntry_unbonding_id, value) {
+		if !f(fd_RedelegationEntry_unbonding_id, value) {
 			return
 		}
 	}
 	if x.UnbondingOnHoldRefCount != int64(0) {
 		value := protoreflect.ValueOfInt64(x.UnbondingOnHoldRefCount)
-		if !f(fd_UnbondingDelegationEntry_unbonding_on_hold_ref_count, value) {
+		if !f(fd_RedelegationEntry_unbonding_on_hold_ref_count, value) {
 			return
 		}
 	}
@@ -7443,25 +11558,25 @@ func (x *fastReflection_UnbondingDelegationEntry) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UnbondingDelegationEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RedelegationEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
 		return x.CreationHeight != int64(0)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		return x.CompletionTime != nil
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
 		return x.InitialBalance != ""
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
-		return x.Balance != ""
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+		return x.SharesDst != ""
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
 		return x.UnbondingId != uint64(0)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
 		return x.UnbondingOnHoldRefCount != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7471,25 +11586,25 @@ func (x *fastReflection_UnbondingDelegationEntry) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegationEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RedelegationEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
 		x.CreationHeight = int64(0)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		x.CompletionTime = nil
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
 		x.InitialBalance = ""
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
-		x.Balance = ""
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+		x.SharesDst = ""
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
 		x.UnbondingId = uint64(0)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
 		x.UnbondingOnHoldRefCount = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7499,31 +11614,31 @@ func (x *fastReflection_UnbondingDelegationEntry) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UnbondingDelegationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
 		value := x.CreationHeight
 		return protoreflect.ValueOfInt64(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		value := x.CompletionTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
 		value := x.InitialBalance
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
-		value := x.Balance
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+		value := x.SharesDst
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
 		value := x.UnbondingId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
 		value := x.UnbondingOnHoldRefCount
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7537,25 +11652,25 @@ func (x *fastReflection_UnbondingDelegationEntry) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RedelegationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
 		x.CreationHeight = value.Int()
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
 		x.InitialBalance = value.Interface().(string)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
-		x.Balance = value.Interface().(string)
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+		x.SharesDst = value.Interface().(string)
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
 		x.UnbondingId = value.Uint()
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
 		x.UnbondingOnHoldRefCount = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7569,64 +11684,64 @@ func (x *fastReflection_UnbondingDelegationEntry) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		if x.CompletionTime == nil {
 			x.CompletionTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
-		panic(fmt.Errorf("field creation_height of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
-		panic(fmt.Errorf("field initial_balance of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
-		panic(fmt.Errorf("field balance of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
-		panic(fmt.Errorf("field unbonding_id of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
-		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.UnbondingDelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
+		panic(fmt.Errorf("field creation_height of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
+		panic(fmt.Errorf("field initial_balance of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+		panic(fmt.Errorf("field shares_dst of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
+		panic(fmt.Errorf("field unbonding_id of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
+		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UnbondingDelegationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.creation_height":
+	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time":
+	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.balance":
+	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_id":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.staking.v1beta1.UnbondingDelegationEntry.unbonding_on_hold_ref_count":
+	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.UnbondingDelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.UnbondingDelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UnbondingDelegationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RedelegationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.UnbondingDelegationEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.RedelegationEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7634,7 +11749,7 @@ func (x *fastReflection_UnbondingDelegationEntry) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UnbondingDelegationEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RedelegationEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7645,7 +11760,7 @@ func (x *fastReflection_UnbondingDelegationEntry) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingDelegationEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RedelegationEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7657,7 +11772,7 @@ func (x *fastReflection_UnbondingDelegationEntry) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UnbondingDelegationEntry) IsValid() bool {
+func (x *fastReflection_RedelegationEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -7667,9 +11782,9 @@ func (x *fastReflection_UnbondingDelegationEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UnbondingDelegationEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7692,7 +11807,7 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Balance)
+		l = len(x.SharesDst)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7712,7 +11827,7 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingDelegationEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7741,10 +11856,10 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 			i--
 			dAtA[i] = 0x28
 		}
-		if len(x.Balance) > 0 {
-			i -= len(x.Balance)
-			copy(dAtA[i:], x.Balance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+		if len(x.SharesDst) > 0 {
+			i -= len(x.SharesDst)
+			copy(dAtA[i:], x.SharesDst)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SharesDst)))
 			i--
 			dAtA[i] = 0x22
 		}
@@ -7785,7 +11900,7 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingDelegationEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7817,10 +11932,10 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7912,7 +12027,7 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7940,7 +12055,7 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Balance = string(dAtA[iNdEx:postIndex])
+				x.SharesDst = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 5:
 				if wireType != 0 {
@@ -8015,37 +12130,84 @@ func (x *fastReflection_UnbondingDelegationEntry) ProtoMethods() *protoiface.Met
 	}
 }
 
+var _ protoreflect.List = (*_Redelegation_4_list)(nil)
+
+type _Redelegation_4_list struct {
+	list *[]*RedelegationEntry
+}
+
+func (x *_Redelegation_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Redelegation_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Redelegation_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Redelegation_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Redelegation_4_list) AppendMutable() protoreflect.Value {
+	v := new(RedelegationEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Redelegation_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Redelegation_4_list) NewElement() protoreflect.Value {
+	v := new(RedelegationEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Redelegation_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_RedelegationEntry                             protoreflect.MessageDescriptor
-	fd_RedelegationEntry_creation_height             protoreflect.FieldDescriptor
-	fd_RedelegationEntry_completion_time             protoreflect.FieldDescriptor
-	fd_RedelegationEntry_initial_balance             protoreflect.FieldDescriptor
-	fd_RedelegationEntry_shares_dst                  protoreflect.FieldDescriptor
-	fd_RedelegationEntry_unbonding_id                protoreflect.FieldDescriptor
-	fd_RedelegationEntry_unbonding_on_hold_ref_count protoreflect.FieldDescriptor
+	md_Redelegation                       protoreflect.MessageDescriptor
+	fd_Redelegation_delegator_address     protoreflect.FieldDescriptor
+	fd_Redelegation_validator_src_address protoreflect.FieldDescriptor
+	fd_Redelegation_validator_dst_address protoreflect.FieldDescriptor
+	fd_Redelegation_entries               protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_RedelegationEntry = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("RedelegationEntry")
-	fd_RedelegationEntry_creation_height = md_RedelegationEntry.Fields().ByName("creation_height")
-	fd_RedelegationEntry_completion_time = md_RedelegationEntry.Fields().ByName("completion_time")
-	fd_RedelegationEntry_initial_balance = md_RedelegationEntry.Fields().ByName("initial_balance")
-	fd_RedelegationEntry_shares_dst = md_RedelegationEntry.Fields().ByName("shares_dst")
-	fd_RedelegationEntry_unbonding_id = md_RedelegationEntry.Fields().ByName("unbonding_id")
-	fd_RedelegationEntry_unbonding_on_hold_ref_count = md_RedelegationEntry.Fields().ByName("unbonding_on_hold_ref_count")
+	md_Redelegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Redelegation")
+	fd_Redelegation_delegator_address = md_Redelegation.Fields().ByName("delegator_address")
+	fd_Redelegation_validator_src_address = md_Redelegation.Fields().ByName("validator_src_address")
+	fd_Redelegation_validator_dst_address = md_Redelegation.Fields().ByName("validator_dst_address")
+	fd_Redelegation_entries = md_Redelegation.Fields().ByName("entries")
 }
 
-var _ protoreflect.Message = (*fastReflection_RedelegationEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_Redelegation)(nil)
 
-type fastReflection_RedelegationEntry RedelegationEntry
+type fastReflection_Redelegation Redelegation
 
-func (x *RedelegationEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RedelegationEntry)(x)
+func (x *Redelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Redelegation)(x)
 }
 
-func (x *RedelegationEntry) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[13]
+func (x *Redelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8056,43 +12218,43 @@ func (x *RedelegationEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RedelegationEntry_messageType fastReflection_RedelegationEntry_messageType
-var _ protoreflect.MessageType = fastReflection_RedelegationEntry_messageType{}
+var _fastReflection_Redelegation_messageType fastReflection_Redelegation_messageType
+var _ protoreflect.MessageType = fastReflection_Redelegation_messageType{}
 
-type fastReflection_RedelegationEntry_messageType struct{}
+type fastReflection_Redelegation_messageType struct{}
 
-func (x fastReflection_RedelegationEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RedelegationEntry)(nil)
+func (x fastReflection_Redelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Redelegation)(nil)
 }
-func (x fastReflection_RedelegationEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_RedelegationEntry)
+func (x fastReflection_Redelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Redelegation)
 }
-func (x fastReflection_RedelegationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationEntry
+func (x fastReflection_Redelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redelegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RedelegationEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationEntry
+func (x *fastReflection_Redelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redelegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RedelegationEntry) Type() protoreflect.MessageType {
-	return _fastReflection_RedelegationEntry_messageType
+func (x *fastReflection_Redelegation) Type() protoreflect.MessageType {
+	return _fastReflection_Redelegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RedelegationEntry) New() protoreflect.Message {
-	return new(fastReflection_RedelegationEntry)
+func (x *fastReflection_Redelegation) New() protoreflect.Message {
+	return new(fastReflection_Redelegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RedelegationEntry) Interface() protoreflect.ProtoMessage {
-	return (*RedelegationEntry)(x)
+func (x *fastReflection_Redelegation) Interface() protoreflect.ProtoMessage {
+	return (*Redelegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8100,40 +12262,28 @@ func (x *fastReflection_RedelegationEntry) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RedelegationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CreationHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreationHeight)
-		if !f(fd_RedelegationEntry_creation_height, value) {
-			return
-		}
-	}
-	if x.CompletionTime != nil {
-		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
-		if !f(fd_RedelegationEntry_completion_time, value) {
-			return
-		}
-	}
-	if x.InitialBalance != "" {
-		value := protoreflect.ValueOfString(x.InitialBalance)
-		if !f(fd_RedelegationEntry_initial_balance, value) {
+func (x *fastReflection_Redelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_Redelegation_delegator_address, value) {
 			return
 		}
 	}
-	if x.SharesDst != "" {
-		value := protoreflect.ValueOfString(x.SharesDst)
-		if !f(fd_RedelegationEntry_shares_dst, value) {
+	if x.ValidatorSrcAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorSrcAddress)
+		if !f(fd_Redelegation_validator_src_address, value) {
 			return
 		}
 	}
-	if x.UnbondingId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.UnbondingId)
-		if !f(fd_RedelegationEntry_unbonding_id, value) {
+	if x.ValidatorDstAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorDstAddress)
+		if !f(fd_Redelegation_validator_dst_address, value) {
 			return
 		}
 	}
-	if x.UnbondingOnHoldRefCount != int64(0) {
-		value := protoreflect.ValueOfInt64(x.UnbondingOnHoldRefCount)
-		if !f(fd_RedelegationEntry_unbonding_on_hold_ref_count, value) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_Redelegation_4_list{list: &x.Entries})
+		if !f(fd_Redelegation_entries, value) {
 			return
 		}
 	}
@@ -8150,25 +12300,21 @@ func (x *fastReflection_RedelegationEntry) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RedelegationEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Redelegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		return x.CreationHeight != int64(0)
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		return x.CompletionTime != nil
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
-		return x.InitialBalance != ""
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
-		return x.SharesDst != ""
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		return x.UnbondingId != uint64(0)
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		return x.UnbondingOnHoldRefCount != int64(0)
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+		return x.ValidatorSrcAddress != ""
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		return x.ValidatorDstAddress != ""
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		return len(x.Entries) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8178,25 +12324,21 @@ func (x *fastReflection_RedelegationEntry) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Redelegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		x.CreationHeight = int64(0)
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		x.CompletionTime = nil
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
-		x.InitialBalance = ""
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
-		x.SharesDst = ""
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		x.UnbondingId = uint64(0)
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		x.UnbondingOnHoldRefCount = int64(0)
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+		x.ValidatorSrcAddress = ""
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		x.ValidatorDstAddress = ""
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		x.Entries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8206,31 +12348,28 @@ func (x *fastReflection_RedelegationEntry) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RedelegationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		value := x.CreationHeight
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		value := x.CompletionTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
-		value := x.InitialBalance
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+		value := x.DelegatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
-		value := x.SharesDst
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+		value := x.ValidatorSrcAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		value := x.UnbondingId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		value := x.UnbondingOnHoldRefCount
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		value := x.ValidatorDstAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_Redelegation_4_list{})
+		}
+		listValue := &_Redelegation_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8244,25 +12383,23 @@ func (x *fastReflection_RedelegationEntry) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Redelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		x.CreationHeight = value.Int()
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
-		x.InitialBalance = value.Interface().(string)
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
-		x.SharesDst = value.Interface().(string)
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		x.UnbondingId = value.Uint()
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		x.UnbondingOnHoldRefCount = value.Int()
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+		x.ValidatorSrcAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		x.ValidatorDstAddress = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		lv := value.List()
+		clv := lv.(*_Redelegation_4_list)
+		x.Entries = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8276,64 +12413,57 @@ func (x *fastReflection_RedelegationEntry) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		if x.CompletionTime == nil {
-			x.CompletionTime = new(timestamppb.Timestamp)
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		if x.Entries == nil {
+			x.Entries = []*RedelegationEntry{}
 		}
-		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		panic(fmt.Errorf("field creation_height of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
-		panic(fmt.Errorf("field initial_balance of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
-		panic(fmt.Errorf("field shares_dst of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		panic(fmt.Errorf("field unbonding_id of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		panic(fmt.Errorf("field unbonding_on_hold_ref_count of message cosmos.staking.v1beta1.RedelegationEntry is not mutable"))
+		value := &_Redelegation_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+		panic(fmt.Errorf("field validator_src_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		panic(fmt.Errorf("field validator_dst_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RedelegationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.RedelegationEntry.creation_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.staking.v1beta1.RedelegationEntry.completion_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.RedelegationEntry.initial_balance":
+	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.RedelegationEntry.shares_dst":
+	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.staking.v1beta1.RedelegationEntry.unbonding_on_hold_ref_count":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Redelegation.entries":
+		list := []*RedelegationEntry{}
+		return protoreflect.ValueOfList(&_Redelegation_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.RedelegationEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.RedelegationEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RedelegationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Redelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.RedelegationEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Redelegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8341,7 +12471,7 @@ func (x *fastReflection_RedelegationEntry) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RedelegationEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Redelegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8352,7 +12482,7 @@ func (x *fastReflection_RedelegationEntry) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Redelegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8364,7 +12494,7 @@ func (x *fastReflection_RedelegationEntry) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RedelegationEntry) IsValid() bool {
+func (x *fastReflection_Redelegation) IsValid() bool {
 	return x != nil
 }
 
@@ -8374,9 +12504,9 @@ func (x *fastReflection_RedelegationEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RedelegationEntry)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8388,26 +12518,23 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.CreationHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreationHeight))
-		}
-		if x.CompletionTime != nil {
-			l = options.Size(x.CompletionTime)
+		l = len(x.DelegatorAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.InitialBalance)
+		l = len(x.ValidatorSrcAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SharesDst)
+		l = len(x.ValidatorDstAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.UnbondingId != 0 {
-			n += 1 + runtime.Sov(uint64(x.UnbondingId))
-		}
-		if x.UnbondingOnHoldRefCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.UnbondingOnHoldRefCount))
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8419,7 +12546,7 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationEntry)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8438,48 +12565,42 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.UnbondingOnHoldRefCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingOnHoldRefCount))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.UnbondingId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.SharesDst) > 0 {
-			i -= len(x.SharesDst)
-			copy(dAtA[i:], x.SharesDst)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SharesDst)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.InitialBalance) > 0 {
-			i -= len(x.InitialBalance)
-			copy(dAtA[i:], x.InitialBalance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialBalance)))
+		if len(x.ValidatorDstAddress) > 0 {
+			i -= len(x.ValidatorDstAddress)
+			copy(dAtA[i:], x.ValidatorDstAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorDstAddress)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.CompletionTime != nil {
-			encoded, err := options.Marshal(x.CompletionTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ValidatorSrcAddress) > 0 {
+			i -= len(x.ValidatorSrcAddress)
+			copy(dAtA[i:], x.ValidatorSrcAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSrcAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.CreationHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationHeight))
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8492,7 +12613,7 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationEntry)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8524,70 +12645,15 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-				}
-				x.CreationHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CreationHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CompletionTime == nil {
-					x.CompletionTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8615,11 +12681,11 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InitialBalance = string(dAtA[iNdEx:postIndex])
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8647,13 +12713,13 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SharesDst = string(dAtA[iNdEx:postIndex])
+				x.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 				}
-				x.UnbondingId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8663,16 +12729,29 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.UnbondingId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.UnbondingOnHoldRefCount = 0
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8682,11 +12761,26 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &RedelegationEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8722,84 +12816,106 @@ func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Redelegation_4_list)(nil)
+var _ protoreflect.List = (*_Params_10_list)(nil)
 
-type _Redelegation_4_list struct {
-	list *[]*RedelegationEntry
+type _Params_10_list struct {
+	list *[]*BondDenom
 }
 
-func (x *_Redelegation_4_list) Len() int {
+func (x *_Params_10_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Redelegation_4_list) Get(i int) protoreflect.Value {
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Redelegation_4_list) Set(i int, value protoreflect.Value) {
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
+	concreteValue := valueUnwrapped.Interface().(*BondDenom)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Redelegation_4_list) Append(value protoreflect.Value) {
+func (x *_Params_10_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
+	concreteValue := valueUnwrapped.Interface().(*BondDenom)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Redelegation_4_list) AppendMutable() protoreflect.Value {
-	v := new(RedelegationEntry)
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	v := new(BondDenom)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Redelegation_4_list) Truncate(n int) {
+func (x *_Params_10_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Redelegation_4_list) NewElement() protoreflect.Value {
-	v := new(RedelegationEntry)
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := new(BondDenom)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Redelegation_4_list) IsValid() bool {
+func (x *_Params_10_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Redelegation                       protoreflect.MessageDescriptor
-	fd_Redelegation_delegator_address     protoreflect.FieldDescriptor
-	fd_Redelegation_validator_src_address protoreflect.FieldDescriptor
-	fd_Redelegation_validator_dst_address protoreflect.FieldDescriptor
-	fd_Redelegation_entries               protoreflect.FieldDescriptor
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_unbonding_time                     protoreflect.FieldDescriptor
+	fd_Params_max_validators                     protoreflect.FieldDescriptor
+	fd_Params_max_entries                        protoreflect.FieldDescriptor
+	fd_Params_historical_entries                 protoreflect.FieldDescriptor
+	fd_Params_bond_denom                         protoreflect.FieldDescriptor
+	fd_Params_min_commission_rate                protoreflect.FieldDescriptor
+	fd_Params_undelegation_dust_threshold        protoreflect.FieldDescriptor
+	fd_Params_historical_info_max_bytes          protoreflect.FieldDescriptor
+	fd_Params_validator_set_checkpoint_interval  protoreflect.FieldDescriptor
+	fd_Params_additional_bond_denoms             protoreflect.FieldDescriptor
+	fd_Params_min_self_delegation_grace_period   protoreflect.FieldDescriptor
+	fd_Params_min_delegation_amount              protoreflect.FieldDescriptor
+	fd_Params_inactive_validator_cleanup_period  protoreflect.FieldDescriptor
+	fd_Params_inactive_validator_cleanup_dry_run protoreflect.FieldDescriptor
+	fd_Params_reject_tombstoned_delegations      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Redelegation = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Redelegation")
-	fd_Redelegation_delegator_address = md_Redelegation.Fields().ByName("delegator_address")
-	fd_Redelegation_validator_src_address = md_Redelegation.Fields().ByName("validator_src_address")
-	fd_Redelegation_validator_dst_address = md_Redelegation.Fields().ByName("validator_dst_address")
-	fd_Redelegation_entries = md_Redelegation.Fields().ByName("entries")
+	md_Params = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Params")
+	fd_Params_unbonding_time = md_Params.Fields().ByName("unbonding_time")
+	fd_Params_max_validators = md_Params.Fields().ByName("max_validators")
+	fd_Params_max_entries = md_Params.Fields().ByName("max_entries")
+	fd_Params_historical_entries = md_Params.Fields().ByName("historical_entries")
+	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
+	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	fd_Params_undelegation_dust_threshold = md_Params.Fields().ByName("undelegation_dust_threshold")
+	fd_Params_historical_info_max_bytes = md_Params.Fields().ByName("historical_info_max_bytes")
+	fd_Params_validator_set_checkpoint_interval = md_Params.Fields().ByName("validator_set_checkpoint_interval")
+	fd_Params_additional_bond_denoms = md_Params.Fields().ByName("additional_bond_denoms")
+	fd_Params_min_self_delegation_grace_period = md_Params.Fields().ByName("min_self_delegation_grace_period")
+	fd_Params_min_delegation_amount = md_Params.Fields().ByName("min_delegation_amount")
+	fd_Params_inactive_validator_cleanup_period = md_Params.Fields().ByName("inactive_validator_cleanup_period")
+	fd_Params_inactive_validator_cleanup_dry_run = md_Params.Fields().ByName("inactive_validator_cleanup_dry_run")
+	fd_Params_reject_tombstoned_delegations = md_Params.Fields().ByName("reject_tombstoned_delegations")
 }
 
-var _ protoreflect.Message = (*fastReflection_Redelegation)(nil)
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
 
-type fastReflection_Redelegation Redelegation
+type fastReflection_Params Params
 
-func (x *Redelegation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Redelegation)(x)
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
 }
 
-func (x *Redelegation) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[14]
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8810,43 +12926,43 @@ func (x *Redelegation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Redelegation_messageType fastReflection_Redelegation_messageType
-var _ protoreflect.MessageType = fastReflection_Redelegation_messageType{}
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
 
-type fastReflection_Redelegation_messageType struct{}
+type fastReflection_Params_messageType struct{}
 
-func (x fastReflection_Redelegation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Redelegation)(nil)
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
 }
-func (x fastReflection_Redelegation_messageType) New() protoreflect.Message {
-	return new(fastReflection_Redelegation)
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
-func (x fastReflection_Redelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Redelegation
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Redelegation) Descriptor() protoreflect.MessageDescriptor {
-	return md_Redelegation
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Redelegation) Type() protoreflect.MessageType {
-	return _fastReflection_Redelegation_messageType
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Redelegation) New() protoreflect.Message {
-	return new(fastReflection_Redelegation)
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Redelegation) Interface() protoreflect.ProtoMessage {
-	return (*Redelegation)(x)
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8854,28 +12970,94 @@ func (x *fastReflection_Redelegation) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Redelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_Redelegation_delegator_address, value) {
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UnbondingTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+		if !f(fd_Params_unbonding_time, value) {
 			return
 		}
 	}
-	if x.ValidatorSrcAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorSrcAddress)
-		if !f(fd_Redelegation_validator_src_address, value) {
+	if x.MaxValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxValidators)
+		if !f(fd_Params_max_validators, value) {
 			return
 		}
 	}
-	if x.ValidatorDstAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorDstAddress)
-		if !f(fd_Redelegation_validator_dst_address, value) {
+	if x.MaxEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxEntries)
+		if !f(fd_Params_max_entries, value) {
 			return
 		}
 	}
-	if len(x.Entries) != 0 {
-		value := protoreflect.ValueOfList(&_Redelegation_4_list{list: &x.Entries})
-		if !f(fd_Redelegation_entries, value) {
+	if x.HistoricalEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.HistoricalEntries)
+		if !f(fd_Params_historical_entries, value) {
+			return
+		}
+	}
+	if x.BondDenom != "" {
+		value := protoreflect.ValueOfString(x.BondDenom)
+		if !f(fd_Params_bond_denom, value) {
+			return
+		}
+	}
+	if x.MinCommissionRate != "" {
+		value := protoreflect.ValueOfString(x.MinCommissionRate)
+		if !f(fd_Params_min_commission_rate, value) {
+			return
+		}
+	}
+	if x.UndelegationDustThreshold != "" {
+		value := protoreflect.ValueOfString(x.UndelegationDustThreshold)
+		if !f(fd_Params_undelegation_dust_threshold, value) {
+			return
+		}
+	}
+	if x.HistoricalInfoMaxBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoricalInfoMaxBytes)
+		if !f(fd_Params_historical_info_max_bytes, value) {
+			return
+		}
+	}
+	if x.ValidatorSetCheckpointInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorSetCheckpointInterval)
+		if !f(fd_Params_validator_set_checkpoint_interval, value) {
+			return
+		}
+	}
+	if len(x.AdditionalBondDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.AdditionalBondDenoms})
+		if !f(fd_Params_additional_bond_denoms, value) {
+			return
+		}
+	}
+	if x.MinSelfDelegationGracePeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MinSelfDelegationGracePeriod.ProtoReflect())
+		if !f(fd_Params_min_self_delegation_grace_period, value) {
+			return
+		}
+	}
+	if x.MinDelegationAmount != "" {
+		value := protoreflect.ValueOfString(x.MinDelegationAmount)
+		if !f(fd_Params_min_delegation_amount, value) {
+			return
+		}
+	}
+	if x.InactiveValidatorCleanupPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.InactiveValidatorCleanupPeriod.ProtoReflect())
+		if !f(fd_Params_inactive_validator_cleanup_period, value) {
+			return
+		}
+	}
+	if x.InactiveValidatorCleanupDryRun != false {
+		value := protoreflect.ValueOfBool(x.InactiveValidatorCleanupDryRun)
+		if !f(fd_Params_inactive_validator_cleanup_dry_run, value) {
+			return
+		}
+	}
+	if x.RejectTombstonedDelegations != false {
+		value := protoreflect.ValueOfBool(x.RejectTombstonedDelegations)
+		if !f(fd_Params_reject_tombstoned_delegations, value) {
 			return
 		}
 	}
@@ -8892,21 +13074,43 @@ func (x *fastReflection_Redelegation) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Redelegation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
-		return x.DelegatorAddress != ""
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
-		return x.ValidatorSrcAddress != ""
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
-		return x.ValidatorDstAddress != ""
-	case "cosmos.staking.v1beta1.Redelegation.entries":
-		return len(x.Entries) != 0
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		return x.UnbondingTime != nil
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		return x.MaxValidators != uint32(0)
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		return x.MaxEntries != uint32(0)
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		return x.HistoricalEntries != uint32(0)
+	case "cosmos.staking.v1beta1.Params.bond_denom":
+		return x.BondDenom != ""
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+		return x.MinCommissionRate != ""
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
+		return x.UndelegationDustThreshold != ""
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		return x.HistoricalInfoMaxBytes != uint64(0)
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		return x.ValidatorSetCheckpointInterval != uint64(0)
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
+		return len(x.AdditionalBondDenoms) != 0
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		return x.MinSelfDelegationGracePeriod != nil
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		return x.MinDelegationAmount != ""
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		return x.InactiveValidatorCleanupPeriod != nil
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		return x.InactiveValidatorCleanupDryRun != false
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		return x.RejectTombstonedDelegations != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8916,21 +13120,43 @@ func (x *fastReflection_Redelegation) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Redelegation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
-		x.DelegatorAddress = ""
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
-		x.ValidatorSrcAddress = ""
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
-		x.ValidatorDstAddress = ""
-	case "cosmos.staking.v1beta1.Redelegation.entries":
-		x.Entries = nil
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		x.UnbondingTime = nil
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		x.MaxValidators = uint32(0)
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		x.MaxEntries = uint32(0)
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		x.HistoricalEntries = uint32(0)
+	case "cosmos.staking.v1beta1.Params.bond_denom":
+		x.BondDenom = ""
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+		x.MinCommissionRate = ""
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
+		x.UndelegationDustThreshold = ""
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		x.HistoricalInfoMaxBytes = uint64(0)
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		x.ValidatorSetCheckpointInterval = uint64(0)
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
+		x.AdditionalBondDenoms = nil
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		x.MinSelfDelegationGracePeriod = nil
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		x.MinDelegationAmount = ""
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		x.InactiveValidatorCleanupPeriod = nil
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		x.InactiveValidatorCleanupDryRun = false
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		x.RejectTombstonedDelegations = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8940,28 +13166,61 @@ func (x *fastReflection_Redelegation) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Redelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
-		value := x.DelegatorAddress
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		value := x.UnbondingTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		value := x.MaxValidators
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		value := x.MaxEntries
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		value := x.HistoricalEntries
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.staking.v1beta1.Params.bond_denom":
+		value := x.BondDenom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
-		value := x.ValidatorSrcAddress
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+		value := x.MinCommissionRate
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
-		value := x.ValidatorDstAddress
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
+		value := x.UndelegationDustThreshold
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Redelegation.entries":
-		if len(x.Entries) == 0 {
-			return protoreflect.ValueOfList(&_Redelegation_4_list{})
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		value := x.HistoricalInfoMaxBytes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		value := x.ValidatorSetCheckpointInterval
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
+		if len(x.AdditionalBondDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
 		}
-		listValue := &_Redelegation_4_list{list: &x.Entries}
+		listValue := &_Params_10_list{list: &x.AdditionalBondDenoms}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		value := x.MinSelfDelegationGracePeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		value := x.MinDelegationAmount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		value := x.InactiveValidatorCleanupPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		value := x.InactiveValidatorCleanupDryRun
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		value := x.RejectTombstonedDelegations
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8975,23 +13234,45 @@ func (x *fastReflection_Redelegation) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Redelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
-		x.ValidatorSrcAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
-		x.ValidatorDstAddress = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Redelegation.entries":
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		x.UnbondingTime = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		x.MaxValidators = uint32(value.Uint())
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		x.MaxEntries = uint32(value.Uint())
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		x.HistoricalEntries = uint32(value.Uint())
+	case "cosmos.staking.v1beta1.Params.bond_denom":
+		x.BondDenom = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+		x.MinCommissionRate = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
+		x.UndelegationDustThreshold = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		x.HistoricalInfoMaxBytes = value.Uint()
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		x.ValidatorSetCheckpointInterval = value.Uint()
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
 		lv := value.List()
-		clv := lv.(*_Redelegation_4_list)
-		x.Entries = *clv.list
+		clv := lv.(*_Params_10_list)
+		x.AdditionalBondDenoms = *clv.list
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		x.MinSelfDelegationGracePeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		x.MinDelegationAmount = value.Interface().(string)
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		x.InactiveValidatorCleanupPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		x.InactiveValidatorCleanupDryRun = value.Bool()
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		x.RejectTombstonedDelegations = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9005,57 +13286,113 @@ func (x *fastReflection_Redelegation) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Redelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.entries":
-		if x.Entries == nil {
-			x.Entries = []*RedelegationEntry{}
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		if x.UnbondingTime == nil {
+			x.UnbondingTime = new(durationpb.Duration)
 		}
-		value := &_Redelegation_4_list{list: &x.Entries}
+		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
+		if x.AdditionalBondDenoms == nil {
+			x.AdditionalBondDenoms = []*BondDenom{}
+		}
+		value := &_Params_10_list{list: &x.AdditionalBondDenoms}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
-		panic(fmt.Errorf("field validator_src_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
-		panic(fmt.Errorf("field validator_dst_address of message cosmos.staking.v1beta1.Redelegation is not mutable"))
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		if x.MinSelfDelegationGracePeriod == nil {
+			x.MinSelfDelegationGracePeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MinSelfDelegationGracePeriod.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		if x.InactiveValidatorCleanupPeriod == nil {
+			x.InactiveValidatorCleanupPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.InactiveValidatorCleanupPeriod.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		panic(fmt.Errorf("field max_validators of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		panic(fmt.Errorf("field max_entries of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		panic(fmt.Errorf("field historical_entries of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.bond_denom":
+		panic(fmt.Errorf("field bond_denom of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+		panic(fmt.Errorf("field min_commission_rate of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
+		panic(fmt.Errorf("field undelegation_dust_threshold of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		panic(fmt.Errorf("field historical_info_max_bytes of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		panic(fmt.Errorf("field validator_set_checkpoint_interval of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		panic(fmt.Errorf("field min_delegation_amount of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		panic(fmt.Errorf("field inactive_validator_cleanup_dry_run of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		panic(fmt.Errorf("field reject_tombstoned_delegations of message cosmos.staking.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Redelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Redelegation.delegator_address":
+	case "cosmos.staking.v1beta1.Params.unbonding_time":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.max_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.staking.v1beta1.Params.max_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.staking.v1beta1.Params.historical_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.staking.v1beta1.Params.bond_denom":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Redelegation.validator_src_address":
+	case "cosmos.staking.v1beta1.Params.min_commission_rate":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Redelegation.validator_dst_address":
+	case "cosmos.staking.v1beta1.Params.undelegation_dust_threshold":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Redelegation.entries":
-		list := []*RedelegationEntry{}
-		return protoreflect.ValueOfList(&_Redelegation_4_list{list: &list})
+	case "cosmos.staking.v1beta1.Params.historical_info_max_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.Params.validator_set_checkpoint_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.Params.additional_bond_denoms":
+		list := []*BondDenom{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "cosmos.staking.v1beta1.Params.min_self_delegation_grace_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.min_delegation_amount":
+		return protoreflect.ValueOfString("")
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.Params.inactive_validator_cleanup_dry_run":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.staking.v1beta1.Params.reject_tombstoned_delegations":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Redelegation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Redelegation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Redelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Redelegation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Params", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9063,7 +13400,7 @@ func (x *fastReflection_Redelegation) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Redelegation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9074,7 +13411,7 @@ func (x *fastReflection_Redelegation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Redelegation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9086,7 +13423,7 @@ func (x *fastReflection_Redelegation) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Redelegation) IsValid() bool {
+func (x *fastReflection_Params) IsValid() bool {
 	return x != nil
 }
 
@@ -9096,156 +13433,412 @@ func (x *fastReflection_Redelegation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Redelegation)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Size:              0,
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UnbondingTime != nil {
+			l = options.Size(x.UnbondingTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxValidators))
+		}
+		if x.MaxEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEntries))
+		}
+		if x.HistoricalEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoricalEntries))
+		}
+		l = len(x.BondDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinCommissionRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UndelegationDustThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HistoricalInfoMaxBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoricalInfoMaxBytes))
+		}
+		if x.ValidatorSetCheckpointInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorSetCheckpointInterval))
+		}
+		if len(x.AdditionalBondDenoms) > 0 {
+			for _, e := range x.AdditionalBondDenoms {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinSelfDelegationGracePeriod != nil {
+			l = options.Size(x.MinSelfDelegationGracePeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinDelegationAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InactiveValidatorCleanupPeriod != nil {
+			l = options.Size(x.InactiveValidatorCleanupPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InactiveValidatorCleanupDryRun {
+			n += 2
+		}
+		if x.RejectTombstonedDelegations {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RejectTombstonedDelegations {
+			i--
+			if x.RejectTombstonedDelegations {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.InactiveValidatorCleanupDryRun {
+			i--
+			if x.InactiveValidatorCleanupDryRun {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.InactiveValidatorCleanupPeriod != nil {
+			encoded, err := options.Marshal(x.InactiveValidatorCleanupPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MinDelegationAmount) > 0 {
+			i -= len(x.MinDelegationAmount)
+			copy(dAtA[i:], x.MinDelegationAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinDelegationAmount)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.MinSelfDelegationGracePeriod != nil {
+			encoded, err := options.Marshal(x.MinSelfDelegationGracePeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.AdditionalBondDenoms) > 0 {
+			for iNdEx := len(x.AdditionalBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AdditionalBondDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.ValidatorSetCheckpointInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorSetCheckpointInterval))
+			i--
+			dAtA[i] = 0x48
 		}
-		l = len(x.ValidatorSrcAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.HistoricalInfoMaxBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalInfoMaxBytes))
+			i--
+			dAtA[i] = 0x40
 		}
-		l = len(x.ValidatorDstAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.UndelegationDustThreshold) > 0 {
+			i -= len(x.UndelegationDustThreshold)
+			copy(dAtA[i:], x.UndelegationDustThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UndelegationDustThreshold)))
+			i--
+			dAtA[i] = 0x3a
 		}
-		if len(x.Entries) > 0 {
-			for _, e := range x.Entries {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.MinCommissionRate) > 0 {
+			i -= len(x.MinCommissionRate)
+			copy(dAtA[i:], x.MinCommissionRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCommissionRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BondDenom) > 0 {
+			i -= len(x.BondDenom)
+			copy(dAtA[i:], x.BondDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.HistoricalEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalEntries))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEntries))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxValidators))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UnbondingTime != nil {
+			encoded, err := options.Marshal(x.UnbondingTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		return protoiface.SizeOutput{
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+			Buf:               input.Buf,
+		}, nil
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Redelegation)
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
 		if x == nil {
-			return protoiface.MarshalOutput{
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
+		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Entries) > 0 {
-			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Entries[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnbondingTime == nil {
+					x.UnbondingTime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				}
+				x.MaxValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				}
+				x.MaxEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				}
+				x.HistoricalEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoricalEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.ValidatorDstAddress) > 0 {
-			i -= len(x.ValidatorDstAddress)
-			copy(dAtA[i:], x.ValidatorDstAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorDstAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.ValidatorSrcAddress) > 0 {
-			i -= len(x.ValidatorSrcAddress)
-			copy(dAtA[i:], x.ValidatorSrcAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSrcAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Redelegation)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9273,11 +13866,11 @@ func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UndelegationDustThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9305,11 +13898,119 @@ func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+				x.UndelegationDustThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalInfoMaxBytes", wireType)
+				}
+				x.HistoricalInfoMaxBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoricalInfoMaxBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCheckpointInterval", wireType)
+				}
+				x.ValidatorSetCheckpointInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorSetCheckpointInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalBondDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdditionalBondDenoms = append(x.AdditionalBondDenoms, &BondDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdditionalBondDenoms[len(x.AdditionalBondDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationGracePeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinSelfDelegationGracePeriod == nil {
+					x.MinSelfDelegationGracePeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinSelfDelegationGracePeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9326,24 +14027,80 @@ func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDelegationAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorCleanupPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InactiveValidatorCleanupPeriod == nil {
+					x.InactiveValidatorCleanupPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InactiveValidatorCleanupPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorCleanupDryRun", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InactiveValidatorCleanupDryRun = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTombstonedDelegations", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9353,26 +14110,12 @@ func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Entries = append(x.Entries, &RedelegationEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
+				x.RejectTombstonedDelegations = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9409,36 +14152,28 @@ func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_unbonding_time      protoreflect.FieldDescriptor
-	fd_Params_max_validators      protoreflect.FieldDescriptor
-	fd_Params_max_entries         protoreflect.FieldDescriptor
-	fd_Params_historical_entries  protoreflect.FieldDescriptor
-	fd_Params_bond_denom          protoreflect.FieldDescriptor
-	fd_Params_min_commission_rate protoreflect.FieldDescriptor
+	md_BondDenom                 protoreflect.MessageDescriptor
+	fd_BondDenom_denom           protoreflect.FieldDescriptor
+	fd_BondDenom_power_reduction protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_staking_v1beta1_staking_proto_init()
-	md_Params = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("Params")
-	fd_Params_unbonding_time = md_Params.Fields().ByName("unbonding_time")
-	fd_Params_max_validators = md_Params.Fields().ByName("max_validators")
-	fd_Params_max_entries = md_Params.Fields().ByName("max_entries")
-	fd_Params_historical_entries = md_Params.Fields().ByName("historical_entries")
-	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
-	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	md_BondDenom = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("BondDenom")
+	fd_BondDenom_denom = md_BondDenom.Fields().ByName("denom")
+	fd_BondDenom_power_reduction = md_BondDenom.Fields().ByName("power_reduction")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_BondDenom)(nil)
 
-type fastReflection_Params Params
+type fastReflection_BondDenom BondDenom
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *BondDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BondDenom)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[15]
+func (x *BondDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9449,43 +14184,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_BondDenom_messageType fastReflection_BondDenom_messageType
+var _ protoreflect.MessageType = fastReflection_BondDenom_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_BondDenom_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_BondDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BondDenom)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_BondDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_BondDenom)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_BondDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BondDenom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_BondDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_BondDenom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_BondDenom) Type() protoreflect.MessageType {
+	return _fastReflection_BondDenom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_BondDenom) New() protoreflect.Message {
+	return new(fastReflection_BondDenom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_BondDenom) Interface() protoreflect.ProtoMessage {
+	return (*BondDenom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9493,40 +14228,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.UnbondingTime != nil {
-		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-		if !f(fd_Params_unbonding_time, value) {
-			return
-		}
-	}
-	if x.MaxValidators != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxValidators)
-		if !f(fd_Params_max_validators, value) {
-			return
-		}
-	}
-	if x.MaxEntries != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxEntries)
-		if !f(fd_Params_max_entries, value) {
-			return
-		}
-	}
-	if x.HistoricalEntries != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.HistoricalEntries)
-		if !f(fd_Params_historical_entries, value) {
+func (x *fastReflection_BondDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_BondDenom_denom, value) {
 			return
 		}
 	}
-	if x.BondDenom != "" {
-		value := protoreflect.ValueOfString(x.BondDenom)
-		if !f(fd_Params_bond_denom, value) {
-			return
-		}
-	}
-	if x.MinCommissionRate != "" {
-		value := protoreflect.ValueOfString(x.MinCommissionRate)
-		if !f(fd_Params_min_commission_rate, value) {
+	if x.PowerReduction != "" {
+		value := protoreflect.ValueOfString(x.PowerReduction)
+		if !f(fd_BondDenom_power_reduction, value) {
 			return
 		}
 	}
@@ -9543,25 +14254,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BondDenom) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		return x.UnbondingTime != nil
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		return x.MaxValidators != uint32(0)
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		return x.MaxEntries != uint32(0)
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		return x.HistoricalEntries != uint32(0)
-	case "cosmos.staking.v1beta1.Params.bond_denom":
-		return x.BondDenom != ""
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
-		return x.MinCommissionRate != ""
+	case "cosmos.staking.v1beta1.BondDenom.denom":
+		return x.Denom != ""
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
+		return x.PowerReduction != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9571,25 +14274,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BondDenom) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		x.UnbondingTime = nil
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		x.MaxValidators = uint32(0)
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		x.MaxEntries = uint32(0)
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		x.HistoricalEntries = uint32(0)
-	case "cosmos.staking.v1beta1.Params.bond_denom":
-		x.BondDenom = ""
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
-		x.MinCommissionRate = ""
+	case "cosmos.staking.v1beta1.BondDenom.denom":
+		x.Denom = ""
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
+		x.PowerReduction = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9599,31 +14294,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BondDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		value := x.UnbondingTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		value := x.MaxValidators
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		value := x.MaxEntries
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		value := x.HistoricalEntries
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.staking.v1beta1.Params.bond_denom":
-		value := x.BondDenom
+	case "cosmos.staking.v1beta1.BondDenom.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
-		value := x.MinCommissionRate
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
+		value := x.PowerReduction
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9637,25 +14320,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BondDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		x.UnbondingTime = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		x.MaxValidators = uint32(value.Uint())
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		x.MaxEntries = uint32(value.Uint())
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		x.HistoricalEntries = uint32(value.Uint())
-	case "cosmos.staking.v1beta1.Params.bond_denom":
-		x.BondDenom = value.Interface().(string)
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
-		x.MinCommissionRate = value.Interface().(string)
+	case "cosmos.staking.v1beta1.BondDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
+		x.PowerReduction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9669,64 +14344,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BondDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		if x.UnbondingTime == nil {
-			x.UnbondingTime = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		panic(fmt.Errorf("field max_validators of message cosmos.staking.v1beta1.Params is not mutable"))
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		panic(fmt.Errorf("field max_entries of message cosmos.staking.v1beta1.Params is not mutable"))
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		panic(fmt.Errorf("field historical_entries of message cosmos.staking.v1beta1.Params is not mutable"))
-	case "cosmos.staking.v1beta1.Params.bond_denom":
-		panic(fmt.Errorf("field bond_denom of message cosmos.staking.v1beta1.Params is not mutable"))
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
-		panic(fmt.Errorf("field min_commission_rate of message cosmos.staking.v1beta1.Params is not mutable"))
+	case "cosmos.staking.v1beta1.BondDenom.denom":
+		panic(fmt.Errorf("field denom of message cosmos.staking.v1beta1.BondDenom is not mutable"))
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
+		panic(fmt.Errorf("field power_reduction of message cosmos.staking.v1beta1.BondDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BondDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.staking.v1beta1.Params.unbonding_time":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.staking.v1beta1.Params.max_validators":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.staking.v1beta1.Params.max_entries":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.staking.v1beta1.Params.historical_entries":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.staking.v1beta1.Params.bond_denom":
+	case "cosmos.staking.v1beta1.BondDenom.denom":
 		return protoreflect.ValueOfString("")
-	case "cosmos.staking.v1beta1.Params.min_commission_rate":
+	case "cosmos.staking.v1beta1.BondDenom.power_reduction":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.BondDenom"))
 		}
-		panic(fmt.Errorf("message cosmos.staking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.staking.v1beta1.BondDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BondDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.staking.v1beta1.BondDenom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9734,7 +14389,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BondDenom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9745,7 +14400,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BondDenom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9757,7 +14412,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_BondDenom) IsValid() bool {
 	return x != nil
 }
 
@@ -9767,9 +14422,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BondDenom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BondDenom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9781,24 +14436,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.UnbondingTime != nil {
-			l = options.Size(x.UnbondingTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MaxValidators != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxValidators))
-		}
-		if x.MaxEntries != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxEntries))
-		}
-		if x.HistoricalEntries != 0 {
-			n += 1 + runtime.Sov(uint64(x.HistoricalEntries))
-		}
-		l = len(x.BondDenom)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinCommissionRate)
+		l = len(x.PowerReduction)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -9812,7 +14454,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BondDenom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9831,46 +14473,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinCommissionRate) > 0 {
-			i -= len(x.MinCommissionRate)
-			copy(dAtA[i:], x.MinCommissionRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCommissionRate)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.BondDenom) > 0 {
-			i -= len(x.BondDenom)
-			copy(dAtA[i:], x.BondDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.HistoricalEntries != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalEntries))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.MaxEntries != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEntries))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.MaxValidators != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxValidators))
+		if len(x.PowerReduction) > 0 {
+			i -= len(x.PowerReduction)
+			copy(dAtA[i:], x.PowerReduction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PowerReduction)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.UnbondingTime != nil {
-			encoded, err := options.Marshal(x.UnbondingTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9885,7 +14498,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BondDenom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9917,108 +14530,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BondDenom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BondDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.UnbondingTime == nil {
-					x.UnbondingTime = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
-				}
-				x.MaxValidators = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxValidators |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-				}
-				x.MaxEntries = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxEntries |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-				}
-				x.HistoricalEntries = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.HistoricalEntries |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10046,11 +14566,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerReduction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10078,7 +14598,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
+				x.PowerReduction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -10119,6 +14639,7 @@ var (
 	md_DelegationResponse            protoreflect.MessageDescriptor
 	fd_DelegationResponse_delegation protoreflect.FieldDescriptor
 	fd_DelegationResponse_balance    protoreflect.FieldDescriptor
+	fd_DelegationResponse_label      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -10126,6 +14647,7 @@ func init() {
 	md_DelegationResponse = File_cosmos_staking_v1beta1_staking_proto.Messages().ByName("DelegationResponse")
 	fd_DelegationResponse_delegation = md_DelegationResponse.Fields().ByName("delegation")
 	fd_DelegationResponse_balance = md_DelegationResponse.Fields().ByName("balance")
+	fd_DelegationResponse_label = md_DelegationResponse.Fields().ByName("label")
 }
 
 var _ protoreflect.Message = (*fastReflection_DelegationResponse)(nil)
@@ -10137,7 +14659,7 @@ func (x *DelegationResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *DelegationResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[16]
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10205,6 +14727,12 @@ func (x *fastReflection_DelegationResponse) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.Label != "" {
+		value := protoreflect.ValueOfString(x.Label)
+		if !f(fd_DelegationResponse_label, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -10224,6 +14752,8 @@ func (x *fastReflection_DelegationResponse) Has(fd protoreflect.FieldDescriptor)
 		return x.Delegation != nil
 	case "cosmos.staking.v1beta1.DelegationResponse.balance":
 		return x.Balance != nil
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		return x.Label != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10244,6 +14774,8 @@ func (x *fastReflection_DelegationResponse) Clear(fd protoreflect.FieldDescripto
 		x.Delegation = nil
 	case "cosmos.staking.v1beta1.DelegationResponse.balance":
 		x.Balance = nil
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		x.Label = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10266,6 +14798,9 @@ func (x *fastReflection_DelegationResponse) Get(descriptor protoreflect.FieldDes
 	case "cosmos.staking.v1beta1.DelegationResponse.balance":
 		value := x.Balance
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		value := x.Label
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10290,6 +14825,8 @@ func (x *fastReflection_DelegationResponse) Set(fd protoreflect.FieldDescriptor,
 		x.Delegation = value.Message().Interface().(*Delegation)
 	case "cosmos.staking.v1beta1.DelegationResponse.balance":
 		x.Balance = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		x.Label = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10320,6 +14857,8 @@ func (x *fastReflection_DelegationResponse) Mutable(fd protoreflect.FieldDescrip
 			x.Balance = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		panic(fmt.Errorf("field label of message cosmos.staking.v1beta1.DelegationResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10339,6 +14878,8 @@ func (x *fastReflection_DelegationResponse) NewField(fd protoreflect.FieldDescri
 	case "cosmos.staking.v1beta1.DelegationResponse.balance":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.staking.v1beta1.DelegationResponse.label":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.DelegationResponse"))
@@ -10416,6 +14957,10 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Balance)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Label)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10445,6 +14990,13 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Label) > 0 {
+			i -= len(x.Label)
+			copy(dAtA[i:], x.Label)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Label)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Balance != nil {
 			encoded, err := options.Marshal(x.Balance)
 			if err != nil {
@@ -10594,6 +15146,38 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Label = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10651,7 +15235,7 @@ func (x *RedelegationEntryResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RedelegationEntryResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[17]
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11201,7 +15785,7 @@ func (x *RedelegationResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RedelegationResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[18]
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11723,7 +16307,7 @@ func (x *Pool) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Pool) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[19]
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12256,7 +16840,7 @@ func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[20]
+	mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12773,80 +17357,262 @@ var (
 		"INFRACTION_DOUBLE_SIGN": 1,
 		"INFRACTION_DOWNTIME":    2,
 	}
-)
+)
+
+func (x Infraction) Enum() *Infraction {
+	p := new(Infraction)
+	*p = x
+	return p
+}
+
+func (x Infraction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Infraction) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_staking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+}
+
+func (Infraction) Type() protoreflect.EnumType {
+	return &file_cosmos_staking_v1beta1_staking_proto_enumTypes[1]
+}
+
+func (x Infraction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Infraction.Descriptor instead.
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+// JailReason indicates why a validator was jailed.
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines an unknown jail reason.
+	JailReason_JAIL_REASON_UNSPECIFIED JailReason = 0
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	JailReason_JAIL_REASON_DOWNTIME JailReason = 1
+	// DOUBLE_SIGN defines a validator that double-signed a block.
+	JailReason_JAIL_REASON_DOUBLE_SIGN JailReason = 2
+	// MIN_SELF_DELEGATION defines a validator whose self-delegation fell below
+	// its declared minimum.
+	JailReason_JAIL_REASON_MIN_SELF_DELEGATION JailReason = 3
+)
+
+// Enum value maps for JailReason.
+var (
+	JailReason_name = map[int32]string{
+		0: "JAIL_REASON_UNSPECIFIED",
+		1: "JAIL_REASON_DOWNTIME",
+		2: "JAIL_REASON_DOUBLE_SIGN",
+		3: "JAIL_REASON_MIN_SELF_DELEGATION",
+	}
+	JailReason_value = map[string]int32{
+		"JAIL_REASON_UNSPECIFIED":         0,
+		"JAIL_REASON_DOWNTIME":            1,
+		"JAIL_REASON_DOUBLE_SIGN":         2,
+		"JAIL_REASON_MIN_SELF_DELEGATION": 3,
+	}
+)
+
+func (x JailReason) Enum() *JailReason {
+	p := new(JailReason)
+	*p = x
+	return p
+}
+
+func (x JailReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JailReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_staking_v1beta1_staking_proto_enumTypes[2].Descriptor()
+}
+
+func (JailReason) Type() protoreflect.EnumType {
+	return &file_cosmos_staking_v1beta1_staking_proto_enumTypes[2]
+}
+
+func (x JailReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JailReason.Descriptor instead.
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
+}
+
+// HistoricalInfo contains header and validator information for a given block.
+// It is stored as part of staking module's state, which persists the `n` most
+// recent HistoricalInfo
+// (`n` is set by the staking module's `historical_entries` parameter).
+type HistoricalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *types.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Valset []*Validator  `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset,omitempty"`
+}
+
+func (x *HistoricalInfo) Reset() {
+	*x = HistoricalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalInfo) ProtoMessage() {}
+
+// Deprecated: Use HistoricalInfo.ProtoReflect.Descriptor instead.
+func (*HistoricalInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HistoricalInfo) GetHeader() *types.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *HistoricalInfo) GetValset() []*Validator {
+	if x != nil {
+		return x.Valset
+	}
+	return nil
+}
+
+// ValidatorSetCheckpoint is a compact record of the bonded validator set at a
+// given height, stored every validator_set_checkpoint_interval blocks. Being
+// part of the application state, it is committed to by the app hash signed by
+// the validators, so light clients can use it as an additional trust anchor
+// against long-range attacks.
+type ValidatorSetCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height at which the checkpoint was taken.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the checkpoint was taken.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// validators_hash is the CometBFT hash of the validator set, it matches the
+	// next_validators_hash of the block header at height.
+	ValidatorsHash []byte `protobuf:"bytes,3,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	// total_power is the total consensus power of the validator set.
+	TotalPower int64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// validators are the validators of the set.
+	Validators []*CheckpointValidator `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *ValidatorSetCheckpoint) Reset() {
+	*x = ValidatorSetCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (x Infraction) Enum() *Infraction {
-	p := new(Infraction)
-	*p = x
-	return p
+func (x *ValidatorSetCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x Infraction) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*ValidatorSetCheckpoint) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSetCheckpoint.ProtoReflect.Descriptor instead.
+func (*ValidatorSetCheckpoint) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
 }
 
-func (Infraction) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_staking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+func (x *ValidatorSetCheckpoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (Infraction) Type() protoreflect.EnumType {
-	return &file_cosmos_staking_v1beta1_staking_proto_enumTypes[1]
+func (x *ValidatorSetCheckpoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
 }
 
-func (x Infraction) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ValidatorSetCheckpoint) GetValidatorsHash() []byte {
+	if x != nil {
+		return x.ValidatorsHash
+	}
+	return nil
 }
 
-// Deprecated: Use Infraction.Descriptor instead.
-func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+func (x *ValidatorSetCheckpoint) GetTotalPower() int64 {
+	if x != nil {
+		return x.TotalPower
+	}
+	return 0
 }
 
-// HistoricalInfo contains header and validator information for a given block.
-// It is stored as part of staking module's state, which persists the `n` most
-// recent HistoricalInfo
-// (`n` is set by the staking module's `historical_entries` parameter).
-type HistoricalInfo struct {
+func (x *ValidatorSetCheckpoint) GetValidators() []*CheckpointValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+// CheckpointValidator is a validator of a ValidatorSetCheckpoint.
+type CheckpointValidator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *types.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Valset []*Validator  `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset,omitempty"`
+	// cons_address is the bech32-encoded consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (x *HistoricalInfo) Reset() {
-	*x = HistoricalInfo{}
+func (x *CheckpointValidator) Reset() {
+	*x = CheckpointValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[0]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HistoricalInfo) String() string {
+func (x *CheckpointValidator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoricalInfo) ProtoMessage() {}
+func (*CheckpointValidator) ProtoMessage() {}
 
-// Deprecated: Use HistoricalInfo.ProtoReflect.Descriptor instead.
-func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CheckpointValidator.ProtoReflect.Descriptor instead.
+func (*CheckpointValidator) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *HistoricalInfo) GetHeader() *types.Header {
+func (x *CheckpointValidator) GetConsAddress() string {
 	if x != nil {
-		return x.Header
+		return x.ConsAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *HistoricalInfo) GetValset() []*Validator {
+func (x *CheckpointValidator) GetPower() int64 {
 	if x != nil {
-		return x.Valset
+		return x.Power
 	}
-	return nil
+	return 0
 }
 
 // CommissionRates defines the initial commission rates to be used for creating
@@ -12867,7 +17633,7 @@ type CommissionRates struct {
 func (x *CommissionRates) Reset() {
 	*x = CommissionRates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[1]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12881,7 +17647,7 @@ func (*CommissionRates) ProtoMessage() {}
 
 // Deprecated: Use CommissionRates.ProtoReflect.Descriptor instead.
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CommissionRates) GetRate() string {
@@ -12920,7 +17686,7 @@ type Commission struct {
 func (x *Commission) Reset() {
 	*x = Commission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[2]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12934,7 +17700,7 @@ func (*Commission) ProtoMessage() {}
 
 // Deprecated: Use Commission.ProtoReflect.Descriptor instead.
 func (*Commission) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Commission) GetCommissionRates() *CommissionRates {
@@ -12972,7 +17738,7 @@ type Description struct {
 func (x *Description) Reset() {
 	*x = Description{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[3]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12986,7 +17752,7 @@ func (*Description) ProtoMessage() {}
 
 // Deprecated: Use Description.ProtoReflect.Descriptor instead.
 func (*Description) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Description) GetMoniker() string {
@@ -13070,7 +17836,7 @@ type Validator struct {
 func (x *Validator) Reset() {
 	*x = Validator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[4]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13084,7 +17850,7 @@ func (*Validator) ProtoMessage() {}
 
 // Deprecated: Use Validator.ProtoReflect.Descriptor instead.
 func (*Validator) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Validator) GetOperatorAddress() string {
@@ -13190,7 +17956,7 @@ type ValAddresses struct {
 func (x *ValAddresses) Reset() {
 	*x = ValAddresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[5]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13204,7 +17970,7 @@ func (*ValAddresses) ProtoMessage() {}
 
 // Deprecated: Use ValAddresses.ProtoReflect.Descriptor instead.
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValAddresses) GetAddresses() []string {
@@ -13229,7 +17995,7 @@ type DVPair struct {
 func (x *DVPair) Reset() {
 	*x = DVPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[6]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13243,7 +18009,7 @@ func (*DVPair) ProtoMessage() {}
 
 // Deprecated: Use DVPair.ProtoReflect.Descriptor instead.
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DVPair) GetDelegatorAddress() string {
@@ -13272,7 +18038,7 @@ type DVPairs struct {
 func (x *DVPairs) Reset() {
 	*x = DVPairs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[7]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13286,7 +18052,7 @@ func (*DVPairs) ProtoMessage() {}
 
 // Deprecated: Use DVPairs.ProtoReflect.Descriptor instead.
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DVPairs) GetPairs() []*DVPair {
@@ -13313,7 +18079,7 @@ type DVVTriplet struct {
 func (x *DVVTriplet) Reset() {
 	*x = DVVTriplet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[8]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13327,7 +18093,7 @@ func (*DVVTriplet) ProtoMessage() {}
 
 // Deprecated: Use DVVTriplet.ProtoReflect.Descriptor instead.
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DVVTriplet) GetDelegatorAddress() string {
@@ -13363,7 +18129,7 @@ type DVVTriplets struct {
 func (x *DVVTriplets) Reset() {
 	*x = DVVTriplets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[9]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13377,7 +18143,7 @@ func (*DVVTriplets) ProtoMessage() {}
 
 // Deprecated: Use DVVTriplets.ProtoReflect.Descriptor instead.
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DVVTriplets) GetTriplets() []*DVVTriplet {
@@ -13406,7 +18172,7 @@ type Delegation struct {
 func (x *Delegation) Reset() {
 	*x = Delegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[10]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13420,7 +18186,7 @@ func (*Delegation) ProtoMessage() {}
 
 // Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Delegation) GetDelegatorAddress() string {
@@ -13430,18 +18196,247 @@ func (x *Delegation) GetDelegatorAddress() string {
 	return ""
 }
 
-func (x *Delegation) GetValidatorAddress() string {
+func (x *Delegation) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *Delegation) GetShares() string {
+	if x != nil {
+		return x.Shares
+	}
+	return ""
+}
+
+// DelegationLabel defines a short label attached by a delegator to one of its
+// delegations. Labels are stored apart from the delegations and play no role in
+// the staking logic.
+type DelegationLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// label is the label of the delegation.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *DelegationLabel) Reset() {
+	*x = DelegationLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationLabel) ProtoMessage() {}
+
+// Deprecated: Use DelegationLabel.ProtoReflect.Descriptor instead.
+func (*DelegationLabel) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DelegationLabel) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *DelegationLabel) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *DelegationLabel) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// AutoRedelegation defines the preference of a delegator to have its stake
+// redelegated automatically to a fallback validator when one of its validators
+// stays jailed for longer than a given duration.
+type AutoRedelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// fallback_validator_address is the bech32-encoded address of the validator
+	// receiving the redelegated stake.
+	FallbackValidatorAddress string `protobuf:"bytes,2,opt,name=fallback_validator_address,json=fallbackValidatorAddress,proto3" json:"fallback_validator_address,omitempty"`
+	// jail_duration is how long a validator must stay jailed before the stake
+	// delegated to it is redelegated.
+	JailDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+}
+
+func (x *AutoRedelegation) Reset() {
+	*x = AutoRedelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoRedelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRedelegation) ProtoMessage() {}
+
+// Deprecated: Use AutoRedelegation.ProtoReflect.Descriptor instead.
+func (*AutoRedelegation) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AutoRedelegation) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *AutoRedelegation) GetFallbackValidatorAddress() string {
+	if x != nil {
+		return x.FallbackValidatorAddress
+	}
+	return ""
+}
+
+func (x *AutoRedelegation) GetJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.JailDuration
+	}
+	return nil
+}
+
+// JailRecord records a single jailing of a validator together with the reason
+// it was jailed for.
+type JailRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reason defines why the validator was jailed.
+	Reason JailReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.JailReason" json:"reason,omitempty"`
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *JailRecord) Reset() {
+	*x = JailRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JailRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JailRecord) ProtoMessage() {}
+
+// Deprecated: Use JailRecord.ProtoReflect.Descriptor instead.
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *JailRecord) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *JailRecord) GetReason() JailReason {
+	if x != nil {
+		return x.Reason
+	}
+	return JailReason_JAIL_REASON_UNSPECIFIED
+}
+
+func (x *JailRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *JailRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// SelfDelegationDeficit records a validator whose self-delegation fell below
+// its min_self_delegation, and the time at which it is jailed unless its
+// self-delegation is topped up by then.
+type SelfDelegationDeficit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// jail_time is the time at which the validator is jailed.
+	JailTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=jail_time,json=jailTime,proto3" json:"jail_time,omitempty"`
+}
+
+func (x *SelfDelegationDeficit) Reset() {
+	*x = SelfDelegationDeficit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfDelegationDeficit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfDelegationDeficit) ProtoMessage() {}
+
+// Deprecated: Use SelfDelegationDeficit.ProtoReflect.Descriptor instead.
+func (*SelfDelegationDeficit) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SelfDelegationDeficit) GetValidatorAddress() string {
 	if x != nil {
 		return x.ValidatorAddress
 	}
 	return ""
 }
 
-func (x *Delegation) GetShares() string {
+func (x *SelfDelegationDeficit) GetJailTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Shares
+		return x.JailTime
 	}
-	return ""
+	return nil
 }
 
 // UnbondingDelegation stores all of a single delegator's unbonding bonds
@@ -13462,7 +18457,7 @@ type UnbondingDelegation struct {
 func (x *UnbondingDelegation) Reset() {
 	*x = UnbondingDelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[11]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13476,7 +18471,7 @@ func (*UnbondingDelegation) ProtoMessage() {}
 
 // Deprecated: Use UnbondingDelegation.ProtoReflect.Descriptor instead.
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UnbondingDelegation) GetDelegatorAddress() string {
@@ -13523,7 +18518,7 @@ type UnbondingDelegationEntry struct {
 func (x *UnbondingDelegationEntry) Reset() {
 	*x = UnbondingDelegationEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[12]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13537,7 +18532,7 @@ func (*UnbondingDelegationEntry) ProtoMessage() {}
 
 // Deprecated: Use UnbondingDelegationEntry.ProtoReflect.Descriptor instead.
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UnbondingDelegationEntry) GetCreationHeight() int64 {
@@ -13605,7 +18600,7 @@ type RedelegationEntry struct {
 func (x *RedelegationEntry) Reset() {
 	*x = RedelegationEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[13]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13619,7 +18614,7 @@ func (*RedelegationEntry) ProtoMessage() {}
 
 // Deprecated: Use RedelegationEntry.ProtoReflect.Descriptor instead.
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RedelegationEntry) GetCreationHeight() int64 {
@@ -13684,7 +18679,7 @@ type Redelegation struct {
 func (x *Redelegation) Reset() {
 	*x = Redelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[14]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13698,7 +18693,7 @@ func (*Redelegation) ProtoMessage() {}
 
 // Deprecated: Use Redelegation.ProtoReflect.Descriptor instead.
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Redelegation) GetDelegatorAddress() string {
@@ -13741,18 +18736,45 @@ type Params struct {
 	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
 	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
 	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
+	// historical_entries is the maximum number of historical entries to persist.
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate string `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// undelegation_dust_threshold is the token amount below which the remainder of a delegation is undelegated as
+	// well by Msg/UndelegateTokens, so that no dust delegation is left behind. Zero disables it.
+	UndelegationDustThreshold string `protobuf:"bytes,7,opt,name=undelegation_dust_threshold,json=undelegationDustThreshold,proto3" json:"undelegation_dust_threshold,omitempty"`
+	// historical_info_max_bytes is the maximum number of bytes, keys included, the persisted historical entries may
+	// take. The oldest entries are pruned first, the entry of the current block is always kept. Zero disables it.
+	HistoricalInfoMaxBytes uint64 `protobuf:"varint,8,opt,name=historical_info_max_bytes,json=historicalInfoMaxBytes,proto3" json:"historical_info_max_bytes,omitempty"`
+	// validator_set_checkpoint_interval is the number of blocks between two validator set checkpoints. Zero disables
+	// them.
+	ValidatorSetCheckpointInterval uint64 `protobuf:"varint,9,opt,name=validator_set_checkpoint_interval,json=validatorSetCheckpointInterval,proto3" json:"validator_set_checkpoint_interval,omitempty"`
+	// additional_bond_denoms are the bondable coin denominations other than bond_denom, each with its own power
+	// reduction. They can only be set if the app enabled multi-asset staking in the keeper.
+	AdditionalBondDenoms []*BondDenom `protobuf:"bytes,10,rep,name=additional_bond_denoms,json=additionalBondDenoms,proto3" json:"additional_bond_denoms,omitempty"`
+	// min_self_delegation_grace_period is the time a validator whose self-delegation fell below its
+	// min_self_delegation is given to top it up before being jailed. Zero jails the validator immediately.
+	MinSelfDelegationGracePeriod *durationpb.Duration `protobuf:"bytes,11,opt,name=min_self_delegation_grace_period,json=minSelfDelegationGracePeriod,proto3" json:"min_self_delegation_grace_period,omitempty"`
+	// min_delegation_amount is the minimum token amount a delegation must be worth after a delegation to it, and that
+	// must be left in it after an undelegation unless it is fully undelegated. Zero disables it.
+	MinDelegationAmount string `protobuf:"bytes,12,opt,name=min_delegation_amount,json=minDelegationAmount,proto3" json:"min_delegation_amount,omitempty"`
+	// inactive_validator_cleanup_period is the time a validator must have been unbonded with no delegator but its operator
+	// before its self-delegation is force-undelegated and it is removed, at the end of a block. Zero disables the cleanup.
+	InactiveValidatorCleanupPeriod *durationpb.Duration `protobuf:"bytes,13,opt,name=inactive_validator_cleanup_period,json=inactiveValidatorCleanupPeriod,proto3" json:"inactive_validator_cleanup_period,omitempty"`
+	// inactive_validator_cleanup_dry_run makes the cleanup only report the validators it would remove, with events,
+	// instead of removing them.
+	InactiveValidatorCleanupDryRun bool `protobuf:"varint,14,opt,name=inactive_validator_cleanup_dry_run,json=inactiveValidatorCleanupDryRun,proto3" json:"inactive_validator_cleanup_dry_run,omitempty"`
+	// reject_tombstoned_delegations rejects delegations and redelegations to tombstoned validators, which can never be
+	// unjailed and therefore never earn rewards. It requires the slashing keeper to be set in the staking keeper.
+	RejectTombstonedDelegations bool `protobuf:"varint,15,opt,name=reject_tombstoned_delegations,json=rejectTombstonedDelegations,proto3" json:"reject_tombstoned_delegations,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[15]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13766,7 +18788,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Params) GetUnbondingTime() *durationpb.Duration {
@@ -13811,6 +18833,116 @@ func (x *Params) GetMinCommissionRate() string {
 	return ""
 }
 
+func (x *Params) GetUndelegationDustThreshold() string {
+	if x != nil {
+		return x.UndelegationDustThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetHistoricalInfoMaxBytes() uint64 {
+	if x != nil {
+		return x.HistoricalInfoMaxBytes
+	}
+	return 0
+}
+
+func (x *Params) GetValidatorSetCheckpointInterval() uint64 {
+	if x != nil {
+		return x.ValidatorSetCheckpointInterval
+	}
+	return 0
+}
+
+func (x *Params) GetAdditionalBondDenoms() []*BondDenom {
+	if x != nil {
+		return x.AdditionalBondDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetMinSelfDelegationGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.MinSelfDelegationGracePeriod
+	}
+	return nil
+}
+
+func (x *Params) GetMinDelegationAmount() string {
+	if x != nil {
+		return x.MinDelegationAmount
+	}
+	return ""
+}
+
+func (x *Params) GetInactiveValidatorCleanupPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.InactiveValidatorCleanupPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetInactiveValidatorCleanupDryRun() bool {
+	if x != nil {
+		return x.InactiveValidatorCleanupDryRun
+	}
+	return false
+}
+
+func (x *Params) GetRejectTombstonedDelegations() bool {
+	if x != nil {
+		return x.RejectTombstonedDelegations
+	}
+	return false
+}
+
+// BondDenom defines a bondable coin denomination other than the bond_denom param, and the amount of it required for
+// 1 unit of consensus-engine power.
+type BondDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the coin denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// power_reduction is the amount of denom required for 1 unit of consensus-engine power.
+	PowerReduction string `protobuf:"bytes,2,opt,name=power_reduction,json=powerReduction,proto3" json:"power_reduction,omitempty"`
+}
+
+func (x *BondDenom) Reset() {
+	*x = BondDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondDenom) ProtoMessage() {}
+
+// Deprecated: Use BondDenom.ProtoReflect.Descriptor instead.
+func (*BondDenom) Descriptor() ([]byte, []int) {
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BondDenom) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *BondDenom) GetPowerReduction() string {
+	if x != nil {
+		return x.PowerReduction
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -13820,12 +18952,14 @@ type DelegationResponse struct {
 
 	Delegation *Delegation   `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
 	Balance    *v1beta1.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// label is the label attached to the delegation by the delegator, if any.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (x *DelegationResponse) Reset() {
 	*x = DelegationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[16]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13839,7 +18973,7 @@ func (*DelegationResponse) ProtoMessage() {}
 
 // Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DelegationResponse) GetDelegation() *Delegation {
@@ -13856,6 +18990,13 @@ func (x *DelegationResponse) GetBalance() *v1beta1.Coin {
 	return nil
 }
 
+func (x *DelegationResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 // RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
 // contains a balance in addition to shares which is more suitable for client
 // responses.
@@ -13871,7 +19012,7 @@ type RedelegationEntryResponse struct {
 func (x *RedelegationEntryResponse) Reset() {
 	*x = RedelegationEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[17]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13885,7 +19026,7 @@ func (*RedelegationEntryResponse) ProtoMessage() {}
 
 // Deprecated: Use RedelegationEntryResponse.ProtoReflect.Descriptor instead.
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RedelegationEntryResponse) GetRedelegationEntry() *RedelegationEntry {
@@ -13917,7 +19058,7 @@ type RedelegationResponse struct {
 func (x *RedelegationResponse) Reset() {
 	*x = RedelegationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[18]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13931,7 +19072,7 @@ func (*RedelegationResponse) ProtoMessage() {}
 
 // Deprecated: Use RedelegationResponse.ProtoReflect.Descriptor instead.
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RedelegationResponse) GetRedelegation() *Redelegation {
@@ -13962,7 +19103,7 @@ type Pool struct {
 func (x *Pool) Reset() {
 	*x = Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[19]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13976,7 +19117,7 @@ func (*Pool) ProtoMessage() {}
 
 // Deprecated: Use Pool.ProtoReflect.Descriptor instead.
 func (*Pool) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Pool) GetNotBondedTokens() string {
@@ -14006,7 +19147,7 @@ type ValidatorUpdates struct {
 func (x *ValidatorUpdates) Reset() {
 	*x = ValidatorUpdates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[20]
+		mi := &file_cosmos_staking_v1beta1_staking_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14020,7 +19161,7 @@ func (*ValidatorUpdates) ProtoMessage() {}
 
 // Deprecated: Use ValidatorUpdates.ProtoReflect.Descriptor instead.
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ValidatorUpdates) GetUpdates() []*abci.ValidatorUpdate {
@@ -14062,114 +19203,175 @@ var file_cosmos_staking_v1beta1_staking_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde,
 	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x22,
-	0xb7, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x91, 0x02, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xb7, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x5c, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x69, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0xd0, 0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xb7, 0x07, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a,
+	0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42,
+	0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x54, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x6d, 0x61,
-	0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
-	0x00, 0xd0, 0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde,
-	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa8, 0x01,
-	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a,
-	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb7, 0x07, 0x0a, 0x09, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3a,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x12, 0x67, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x50, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x50, 0x0a,
+	0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x4d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6c,
+	0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x53, 0x65,
+	0x6c, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1b,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x3a,
+	0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x46, 0x0a, 0x0c, 0x56, 0x61, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x44, 0x56, 0x50, 0x61, 0x69, 0x72, 0x12, 0x45, 0x0a, 0x11,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x4a, 0x0a, 0x07, 0x44, 0x56, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x3f, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x75,
-	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
-	0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
-	0x65, 0x6c, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1b, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x75, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
-	0x1f, 0x00, 0x22, 0x46, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x56, 0x50, 0x61, 0x69, 0x72, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x44, 0x56, 0x56, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x12,
+	0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x44,
-	0x56, 0x50, 0x61, 0x69, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x58, 0x0a, 0x0b,
+	0x44, 0x56, 0x56, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x74,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x56, 0x56, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65,
+	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x74, 0x72,
+	0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
 	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65,
@@ -14178,49 +19380,70 @@ var file_cosmos_staking_v1beta1_staking_proto_rawDesc = []byte{
 	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x4a, 0x0a,
-	0x07, 0x44, 0x56, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x44, 0x56, 0x50, 0x61, 0x69, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x44, 0x56,
-	0x56, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x4c, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x72, 0x63,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4e,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x93,
+	0x02, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a,
-	0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x44, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x58, 0x0a, 0x0b, 0x44, 0x56, 0x56, 0x54, 0x72, 0x69, 0x70,
-	0x6c, 0x65, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x44, 0x56, 0x56, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22,
-	0xfa, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45,
-	0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x06,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x84, 0x02, 0x0a,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x1a, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x18, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x6a,
+	0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8,
+	0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6a, 0x61,
+	0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x4a, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0xb9, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x6a, 0x61,
+	0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x6a, 0x61, 0x69, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x84, 0x02, 0x0a,
 	0x13, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
 	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
@@ -14314,7 +19537,7 @@ var file_cosmos_staking_v1beta1_staking_proto_rawDesc = []byte{
 	0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e,
 	0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
-	0xa7, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x6e,
+	0x83, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x6e,
 	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8,
@@ -14338,87 +19561,160 @@ var file_cosmos_staking_v1beta1_staking_proto_rawDesc = []byte{
 	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d,
 	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
 	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x74, 0x65, 0x3a, 0x24, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3e, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xde, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0x61, 0x74, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda,
 	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x53, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x75, 0x6e,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x73, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x21, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x62, 0x0a,
+	0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x12, 0x70, 0x0a, 0x20, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x75, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x73, 0x0a, 0x21, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x1e, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x4a, 0x0a, 0x22, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x42, 0x0a, 0x1d, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1b, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x24, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6e, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x6a, 0x0a, 0x0f, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3e, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xde, 0x01,
+	0x0a, 0x19, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x72,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72,
 	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x00, 0x22, 0x8e, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x82, 0x01, 0x0a, 0x11,
-	0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62,
-	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0f, 0x6e, 0x6f, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x12, 0x77, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x6f, 0x6e,
-	0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x3a, 0x08, 0xe8, 0xa0, 0x1f, 0x01, 0xf0,
-	0xa0, 0x1f, 0x01, 0x22, 0x59, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2a, 0xb6,
-	0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
-	0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b,
-	0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14, 0x42,
+	0x12, 0x56, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x8e, 0x02, 0x0a, 0x04, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea,
+	0xde, 0x1f, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x42, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x77, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x52, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea,
+	0xde, 0x1f, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x3a, 0x08, 0xe8, 0xa0, 0x1f, 0x01, 0xf0, 0xa0, 0x1f, 0x01, 0x22, 0x59, 0x0a, 0x10, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x45, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62,
+	0x63, 0x69, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2a, 0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a,
+	0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42,
 	0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e,
-	0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x0d,
-	0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
-	0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4e,
-	0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a,
-	0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e,
-	0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a,
+	0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
+	0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46,
+	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x2a, 0x85,
+	0x01, 0x0a, 0x0a, 0x4a, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x17, 0x4a, 0x41, 0x49, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x41,
+	0x49, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4a, 0x41, 0x49, 0x4c, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10,
+	0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4a, 0x41, 0x49, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72,
 	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
@@ -14447,67 +19743,84 @@ func file_cosmos_staking_v1beta1_staking_proto_rawDescGZIP() []byte {
 	return file_cosmos_staking_v1beta1_staking_proto_rawDescData
 }
 
-var file_cosmos_staking_v1beta1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_staking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_cosmos_staking_v1beta1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cosmos_staking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_cosmos_staking_v1beta1_staking_proto_goTypes = []interface{}{
 	(BondStatus)(0),                   // 0: cosmos.staking.v1beta1.BondStatus
 	(Infraction)(0),                   // 1: cosmos.staking.v1beta1.Infraction
-	(*HistoricalInfo)(nil),            // 2: cosmos.staking.v1beta1.HistoricalInfo
-	(*CommissionRates)(nil),           // 3: cosmos.staking.v1beta1.CommissionRates
-	(*Commission)(nil),                // 4: cosmos.staking.v1beta1.Commission
-	(*Description)(nil),               // 5: cosmos.staking.v1beta1.Description
-	(*Validator)(nil),                 // 6: cosmos.staking.v1beta1.Validator
-	(*ValAddresses)(nil),              // 7: cosmos.staking.v1beta1.ValAddresses
-	(*DVPair)(nil),                    // 8: cosmos.staking.v1beta1.DVPair
-	(*DVPairs)(nil),                   // 9: cosmos.staking.v1beta1.DVPairs
-	(*DVVTriplet)(nil),                // 10: cosmos.staking.v1beta1.DVVTriplet
-	(*DVVTriplets)(nil),               // 11: cosmos.staking.v1beta1.DVVTriplets
-	(*Delegation)(nil),                // 12: cosmos.staking.v1beta1.Delegation
-	(*UnbondingDelegation)(nil),       // 13: cosmos.staking.v1beta1.UnbondingDelegation
-	(*UnbondingDelegationEntry)(nil),  // 14: cosmos.staking.v1beta1.UnbondingDelegationEntry
-	(*RedelegationEntry)(nil),         // 15: cosmos.staking.v1beta1.RedelegationEntry
-	(*Redelegation)(nil),              // 16: cosmos.staking.v1beta1.Redelegation
-	(*Params)(nil),                    // 17: cosmos.staking.v1beta1.Params
-	(*DelegationResponse)(nil),        // 18: cosmos.staking.v1beta1.DelegationResponse
-	(*RedelegationEntryResponse)(nil), // 19: cosmos.staking.v1beta1.RedelegationEntryResponse
-	(*RedelegationResponse)(nil),      // 20: cosmos.staking.v1beta1.RedelegationResponse
-	(*Pool)(nil),                      // 21: cosmos.staking.v1beta1.Pool
-	(*ValidatorUpdates)(nil),          // 22: cosmos.staking.v1beta1.ValidatorUpdates
-	(*types.Header)(nil),              // 23: tendermint.types.Header
-	(*timestamppb.Timestamp)(nil),     // 24: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                 // 25: google.protobuf.Any
-	(*durationpb.Duration)(nil),       // 26: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),              // 27: cosmos.base.v1beta1.Coin
-	(*abci.ValidatorUpdate)(nil),      // 28: tendermint.abci.ValidatorUpdate
+	(JailReason)(0),                   // 2: cosmos.staking.v1beta1.JailReason
+	(*HistoricalInfo)(nil),            // 3: cosmos.staking.v1beta1.HistoricalInfo
+	(*ValidatorSetCheckpoint)(nil),    // 4: cosmos.staking.v1beta1.ValidatorSetCheckpoint
+	(*CheckpointValidator)(nil),       // 5: cosmos.staking.v1beta1.CheckpointValidator
+	(*CommissionRates)(nil),           // 6: cosmos.staking.v1beta1.CommissionRates
+	(*Commission)(nil),                // 7: cosmos.staking.v1beta1.Commission
+	(*Description)(nil),               // 8: cosmos.staking.v1beta1.Description
+	(*Validator)(nil),                 // 9: cosmos.staking.v1beta1.Validator
+	(*ValAddresses)(nil),              // 10: cosmos.staking.v1beta1.ValAddresses
+	(*DVPair)(nil),                    // 11: cosmos.staking.v1beta1.DVPair
+	(*DVPairs)(nil),                   // 12: cosmos.staking.v1beta1.DVPairs
+	(*DVVTriplet)(nil),                // 13: cosmos.staking.v1beta1.DVVTriplet
+	(*DVVTriplets)(nil),               // 14: cosmos.staking.v1beta1.DVVTriplets
+	(*Delegation)(nil),                // 15: cosmos.staking.v1beta1.Delegation
+	(*DelegationLabel)(nil),           // 16: cosmos.staking.v1beta1.DelegationLabel
+	(*AutoRedelegation)(nil),          // 17: cosmos.staking.v1beta1.AutoRedelegation
+	(*JailRecord)(nil),                // 18: cosmos.staking.v1beta1.JailRecord
+	(*SelfDelegationDeficit)(nil),     // 19: cosmos.staking.v1beta1.SelfDelegationDeficit
+	(*UnbondingDelegation)(nil),       // 20: cosmos.staking.v1beta1.UnbondingDelegation
+	(*UnbondingDelegationEntry)(nil),  // 21: cosmos.staking.v1beta1.UnbondingDelegationEntry
+	(*RedelegationEntry)(nil),         // 22: cosmos.staking.v1beta1.RedelegationEntry
+	(*Redelegation)(nil),              // 23: cosmos.staking.v1beta1.Redelegation
+	(*Params)(nil),                    // 24: cosmos.staking.v1beta1.Params
+	(*BondDenom)(nil),                 // 25: cosmos.staking.v1beta1.BondDenom
+	(*DelegationResponse)(nil),        // 26: cosmos.staking.v1beta1.DelegationResponse
+	(*RedelegationEntryResponse)(nil), // 27: cosmos.staking.v1beta1.RedelegationEntryResponse
+	(*RedelegationResponse)(nil),      // 28: cosmos.staking.v1beta1.RedelegationResponse
+	(*Pool)(nil),                      // 29: cosmos.staking.v1beta1.Pool
+	(*ValidatorUpdates)(nil),          // 30: cosmos.staking.v1beta1.ValidatorUpdates
+	(*types.Header)(nil),              // 31: tendermint.types.Header
+	(*timestamppb.Timestamp)(nil),     // 32: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                 // 33: google.protobuf.Any
+	(*durationpb.Duration)(nil),       // 34: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),              // 35: cosmos.base.v1beta1.Coin
+	(*abci.ValidatorUpdate)(nil),      // 36: tendermint.abci.ValidatorUpdate
 }
 var file_cosmos_staking_v1beta1_staking_proto_depIdxs = []int32{
-	23, // 0: cosmos.staking.v1beta1.HistoricalInfo.header:type_name -> tendermint.types.Header
-	6,  // 1: cosmos.staking.v1beta1.HistoricalInfo.valset:type_name -> cosmos.staking.v1beta1.Validator
-	3,  // 2: cosmos.staking.v1beta1.Commission.commission_rates:type_name -> cosmos.staking.v1beta1.CommissionRates
-	24, // 3: cosmos.staking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
-	25, // 4: cosmos.staking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
-	0,  // 5: cosmos.staking.v1beta1.Validator.status:type_name -> cosmos.staking.v1beta1.BondStatus
-	5,  // 6: cosmos.staking.v1beta1.Validator.description:type_name -> cosmos.staking.v1beta1.Description
-	24, // 7: cosmos.staking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
-	4,  // 8: cosmos.staking.v1beta1.Validator.commission:type_name -> cosmos.staking.v1beta1.Commission
-	8,  // 9: cosmos.staking.v1beta1.DVPairs.pairs:type_name -> cosmos.staking.v1beta1.DVPair
-	10, // 10: cosmos.staking.v1beta1.DVVTriplets.triplets:type_name -> cosmos.staking.v1beta1.DVVTriplet
-	14, // 11: cosmos.staking.v1beta1.UnbondingDelegation.entries:type_name -> cosmos.staking.v1beta1.UnbondingDelegationEntry
-	24, // 12: cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
-	24, // 13: cosmos.staking.v1beta1.RedelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
-	15, // 14: cosmos.staking.v1beta1.Redelegation.entries:type_name -> cosmos.staking.v1beta1.RedelegationEntry
-	26, // 15: cosmos.staking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
-	12, // 16: cosmos.staking.v1beta1.DelegationResponse.delegation:type_name -> cosmos.staking.v1beta1.Delegation
-	27, // 17: cosmos.staking.v1beta1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
-	15, // 18: cosmos.staking.v1beta1.RedelegationEntryResponse.redelegation_entry:type_name -> cosmos.staking.v1beta1.RedelegationEntry
-	16, // 19: cosmos.staking.v1beta1.RedelegationResponse.redelegation:type_name -> cosmos.staking.v1beta1.Redelegation
-	19, // 20: cosmos.staking.v1beta1.RedelegationResponse.entries:type_name -> cosmos.staking.v1beta1.RedelegationEntryResponse
-	28, // 21: cosmos.staking.v1beta1.ValidatorUpdates.updates:type_name -> tendermint.abci.ValidatorUpdate
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	31, // 0: cosmos.staking.v1beta1.HistoricalInfo.header:type_name -> tendermint.types.Header
+	9,  // 1: cosmos.staking.v1beta1.HistoricalInfo.valset:type_name -> cosmos.staking.v1beta1.Validator
+	32, // 2: cosmos.staking.v1beta1.ValidatorSetCheckpoint.time:type_name -> google.protobuf.Timestamp
+	5,  // 3: cosmos.staking.v1beta1.ValidatorSetCheckpoint.validators:type_name -> cosmos.staking.v1beta1.CheckpointValidator
+	6,  // 4: cosmos.staking.v1beta1.Commission.commission_rates:type_name -> cosmos.staking.v1beta1.CommissionRates
+	32, // 5: cosmos.staking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
+	33, // 6: cosmos.staking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
+	0,  // 7: cosmos.staking.v1beta1.Validator.status:type_name -> cosmos.staking.v1beta1.BondStatus
+	8,  // 8: cosmos.staking.v1beta1.Validator.description:type_name -> cosmos.staking.v1beta1.Description
+	32, // 9: cosmos.staking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
+	7,  // 10: cosmos.staking.v1beta1.Validator.commission:type_name -> cosmos.staking.v1beta1.Commission
+	11, // 11: cosmos.staking.v1beta1.DVPairs.pairs:type_name -> cosmos.staking.v1beta1.DVPair
+	13, // 12: cosmos.staking.v1beta1.DVVTriplets.triplets:type_name -> cosmos.staking.v1beta1.DVVTriplet
+	34, // 13: cosmos.staking.v1beta1.AutoRedelegation.jail_duration:type_name -> google.protobuf.Duration
+	2,  // 14: cosmos.staking.v1beta1.JailRecord.reason:type_name -> cosmos.staking.v1beta1.JailReason
+	32, // 15: cosmos.staking.v1beta1.JailRecord.time:type_name -> google.protobuf.Timestamp
+	32, // 16: cosmos.staking.v1beta1.SelfDelegationDeficit.jail_time:type_name -> google.protobuf.Timestamp
+	21, // 17: cosmos.staking.v1beta1.UnbondingDelegation.entries:type_name -> cosmos.staking.v1beta1.UnbondingDelegationEntry
+	32, // 18: cosmos.staking.v1beta1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	32, // 19: cosmos.staking.v1beta1.RedelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	22, // 20: cosmos.staking.v1beta1.Redelegation.entries:type_name -> cosmos.staking.v1beta1.RedelegationEntry
+	34, // 21: cosmos.staking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
+	25, // 22: cosmos.staking.v1beta1.Params.additional_bond_denoms:type_name -> cosmos.staking.v1beta1.BondDenom
+	34, // 23: cosmos.staking.v1beta1.Params.min_self_delegation_grace_period:type_name -> google.protobuf.Duration
+	34, // 24: cosmos.staking.v1beta1.Params.inactive_validator_cleanup_period:type_name -> google.protobuf.Duration
+	15, // 25: cosmos.staking.v1beta1.DelegationResponse.delegation:type_name -> cosmos.staking.v1beta1.Delegation
+	35, // 26: cosmos.staking.v1beta1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	22, // 27: cosmos.staking.v1beta1.RedelegationEntryResponse.redelegation_entry:type_name -> cosmos.staking.v1beta1.RedelegationEntry
+	23, // 28: cosmos.staking.v1beta1.RedelegationResponse.redelegation:type_name -> cosmos.staking.v1beta1.Redelegation
+	27, // 29: cosmos.staking.v1beta1.RedelegationResponse.entries:type_name -> cosmos.staking.v1beta1.RedelegationEntryResponse
+	36, // 30: cosmos.staking.v1beta1.ValidatorUpdates.updates:type_name -> tendermint.abci.ValidatorUpdate
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_staking_v1beta1_staking_proto_init() }
@@ -14529,7 +19842,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommissionRates); i {
+			switch v := v.(*ValidatorSetCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14541,7 +19854,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commission); i {
+			switch v := v.(*CheckpointValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14553,7 +19866,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Description); i {
+			switch v := v.(*CommissionRates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14565,7 +19878,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Validator); i {
+			switch v := v.(*Commission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14577,7 +19890,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValAddresses); i {
+			switch v := v.(*Description); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14589,7 +19902,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DVPair); i {
+			switch v := v.(*Validator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14601,7 +19914,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DVPairs); i {
+			switch v := v.(*ValAddresses); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14613,7 +19926,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DVVTriplet); i {
+			switch v := v.(*DVPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14625,7 +19938,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DVVTriplets); i {
+			switch v := v.(*DVPairs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14637,7 +19950,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Delegation); i {
+			switch v := v.(*DVVTriplet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14649,7 +19962,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnbondingDelegation); i {
+			switch v := v.(*DVVTriplets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14661,7 +19974,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnbondingDelegationEntry); i {
+			switch v := v.(*Delegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14673,7 +19986,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedelegationEntry); i {
+			switch v := v.(*DelegationLabel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14685,7 +19998,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Redelegation); i {
+			switch v := v.(*AutoRedelegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14697,7 +20010,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*JailRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14709,7 +20022,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegationResponse); i {
+			switch v := v.(*SelfDelegationDeficit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14721,7 +20034,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedelegationEntryResponse); i {
+			switch v := v.(*UnbondingDelegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14733,7 +20046,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedelegationResponse); i {
+			switch v := v.(*UnbondingDelegationEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14745,7 +20058,7 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pool); i {
+			switch v := v.(*RedelegationEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14757,6 +20070,90 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_staking_v1beta1_staking_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Redelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_staking_v1beta1_staking_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidatorUpdates); i {
 			case 0:
 				return &v.state
@@ -14774,8 +20171,8 @@ func file_cosmos_staking_v1beta1_staking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_staking_v1beta1_staking_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
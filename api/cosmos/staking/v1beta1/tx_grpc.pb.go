@@ -24,6 +24,10 @@ const (
 	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
 	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
 	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	Msg_UndelegateTokens_FullMethodName          = "/cosmos.staking.v1beta1.Msg/UndelegateTokens"
+	Msg_SetDelegationLabel_FullMethodName        = "/cosmos.staking.v1beta1.Msg/SetDelegationLabel"
+	Msg_SetAutoRedelegation_FullMethodName       = "/cosmos.staking.v1beta1.Msg/SetAutoRedelegation"
+	Msg_RemoveAutoRedelegation_FullMethodName    = "/cosmos.staking.v1beta1.Msg/RemoveAutoRedelegation"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
 	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
 )
@@ -45,6 +49,20 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// UndelegateTokens defines a method for performing an undelegation of an
+	// exact token amount from a delegate and a validator. The token amount is
+	// converted to shares rounding up, and the remainder of the delegation is
+	// undelegated as well if it is below the undelegation dust threshold.
+	UndelegateTokens(ctx context.Context, in *MsgUndelegateTokens, opts ...grpc.CallOption) (*MsgUndelegateTokensResponse, error)
+	// SetDelegationLabel defines a method for setting, or removing with an empty
+	// label, the label of a delegation.
+	SetDelegationLabel(ctx context.Context, in *MsgSetDelegationLabel, opts ...grpc.CallOption) (*MsgSetDelegationLabelResponse, error)
+	// SetAutoRedelegation defines a method for opting in to the automatic
+	// redelegation of the stake delegated to validators jailed for too long.
+	SetAutoRedelegation(ctx context.Context, in *MsgSetAutoRedelegation, opts ...grpc.CallOption) (*MsgSetAutoRedelegationResponse, error)
+	// RemoveAutoRedelegation defines a method for opting out of the automatic
+	// redelegation.
+	RemoveAutoRedelegation(ctx context.Context, in *MsgRemoveAutoRedelegation, opts ...grpc.CallOption) (*MsgRemoveAutoRedelegationResponse, error)
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	//
@@ -109,6 +127,42 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UndelegateTokens(ctx context.Context, in *MsgUndelegateTokens, opts ...grpc.CallOption) (*MsgUndelegateTokensResponse, error) {
+	out := new(MsgUndelegateTokensResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDelegationLabel(ctx context.Context, in *MsgSetDelegationLabel, opts ...grpc.CallOption) (*MsgSetDelegationLabelResponse, error) {
+	out := new(MsgSetDelegationLabelResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDelegationLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRedelegation(ctx context.Context, in *MsgSetAutoRedelegation, opts ...grpc.CallOption) (*MsgSetAutoRedelegationResponse, error) {
+	out := new(MsgSetAutoRedelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoRedelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAutoRedelegation(ctx context.Context, in *MsgRemoveAutoRedelegation, opts ...grpc.CallOption) (*MsgRemoveAutoRedelegationResponse, error) {
+	out := new(MsgRemoveAutoRedelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAutoRedelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
 	out := new(MsgCancelUnbondingDelegationResponse)
 	err := c.cc.Invoke(ctx, Msg_CancelUnbondingDelegation_FullMethodName, in, out, opts...)
@@ -144,6 +198,20 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// UndelegateTokens defines a method for performing an undelegation of an
+	// exact token amount from a delegate and a validator. The token amount is
+	// converted to shares rounding up, and the remainder of the delegation is
+	// undelegated as well if it is below the undelegation dust threshold.
+	UndelegateTokens(context.Context, *MsgUndelegateTokens) (*MsgUndelegateTokensResponse, error)
+	// SetDelegationLabel defines a method for setting, or removing with an empty
+	// label, the label of a delegation.
+	SetDelegationLabel(context.Context, *MsgSetDelegationLabel) (*MsgSetDelegationLabelResponse, error)
+	// SetAutoRedelegation defines a method for opting in to the automatic
+	// redelegation of the stake delegated to validators jailed for too long.
+	SetAutoRedelegation(context.Context, *MsgSetAutoRedelegation) (*MsgSetAutoRedelegationResponse, error)
+	// RemoveAutoRedelegation defines a method for opting out of the automatic
+	// redelegation.
+	RemoveAutoRedelegation(context.Context, *MsgRemoveAutoRedelegation) (*MsgRemoveAutoRedelegationResponse, error)
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	//
@@ -175,6 +243,18 @@ func (UnimplementedMsgServer) BeginRedelegate(context.Context, *MsgBeginRedelega
 func (UnimplementedMsgServer) Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
+func (UnimplementedMsgServer) UndelegateTokens(context.Context, *MsgUndelegateTokens) (*MsgUndelegateTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateTokens not implemented")
+}
+func (UnimplementedMsgServer) SetDelegationLabel(context.Context, *MsgSetDelegationLabel) (*MsgSetDelegationLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegationLabel not implemented")
+}
+func (UnimplementedMsgServer) SetAutoRedelegation(context.Context, *MsgSetAutoRedelegation) (*MsgSetAutoRedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRedelegation not implemented")
+}
+func (UnimplementedMsgServer) RemoveAutoRedelegation(context.Context, *MsgRemoveAutoRedelegation) (*MsgRemoveAutoRedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAutoRedelegation not implemented")
+}
 func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
@@ -284,6 +364,78 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UndelegateTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateTokens(ctx, req.(*MsgUndelegateTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegationLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegationLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegationLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDelegationLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegationLabel(ctx, req.(*MsgSetDelegationLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRedelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRedelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoRedelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRedelegation(ctx, req.(*MsgSetAutoRedelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAutoRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAutoRedelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAutoRedelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAutoRedelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAutoRedelegation(ctx, req.(*MsgRemoveAutoRedelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCancelUnbondingDelegation)
 	if err := dec(in); err != nil {
@@ -347,6 +499,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "UndelegateTokens",
+			Handler:    _Msg_UndelegateTokens_Handler,
+		},
+		{
+			MethodName: "SetDelegationLabel",
+			Handler:    _Msg_SetDelegationLabel_Handler,
+		},
+		{
+			MethodName: "SetAutoRedelegation",
+			Handler:    _Msg_SetAutoRedelegation_Handler,
+		},
+		{
+			MethodName: "RemoveAutoRedelegation",
+			Handler:    _Msg_RemoveAutoRedelegation_Handler,
+		},
 		{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
@@ -19,15 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
-	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
-	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
-	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
-	Service_GetBlockWithTxs_FullMethodName = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
-	Service_TxDecode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxDecode"
-	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
-	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
-	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_Simulate_FullMethodName         = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_GetTx_FullMethodName            = "/cosmos.tx.v1beta1.Service/GetTx"
+	Service_BroadcastTx_FullMethodName      = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	Service_GetTxsEvent_FullMethodName      = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
+	Service_GetBlockWithTxs_FullMethodName  = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
+	Service_TxDecode_FullMethodName         = "/cosmos.tx.v1beta1.Service/TxDecode"
+	Service_TxEncode_FullMethodName         = "/cosmos.tx.v1beta1.Service/TxEncode"
+	Service_TxEncodeAmino_FullMethodName    = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
+	Service_TxDecodeAmino_FullMethodName    = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_GetFeeSuggestion_FullMethodName = "/cosmos.tx.v1beta1.Service/GetFeeSuggestion"
 )
 
 // ServiceClient is the client API for Service service.
@@ -62,6 +63,10 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// GetFeeSuggestion returns percentile based gas price suggestions per fee
+	// denom, computed from the transactions included in the most recent blocks
+	// seen by the node.
+	GetFeeSuggestion(ctx context.Context, in *GetFeeSuggestionRequest, opts ...grpc.CallOption) (*GetFeeSuggestionResponse, error)
 }
 
 type serviceClient struct {
@@ -153,6 +158,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) GetFeeSuggestion(ctx context.Context, in *GetFeeSuggestionRequest, opts ...grpc.CallOption) (*GetFeeSuggestionResponse, error) {
+	out := new(GetFeeSuggestionResponse)
+	err := c.cc.Invoke(ctx, Service_GetFeeSuggestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -185,6 +199,10 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// GetFeeSuggestion returns percentile based gas price suggestions per fee
+	// denom, computed from the transactions included in the most recent blocks
+	// seen by the node.
+	GetFeeSuggestion(context.Context, *GetFeeSuggestionRequest) (*GetFeeSuggestionResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -219,6 +237,9 @@ func (UnimplementedServiceServer) TxEncodeAmino(context.Context, *TxEncodeAminoR
 func (UnimplementedServiceServer) TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (UnimplementedServiceServer) GetFeeSuggestion(context.Context, *GetFeeSuggestionRequest) (*GetFeeSuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSuggestion not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +415,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetFeeSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetFeeSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetFeeSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetFeeSuggestion(ctx, req.(*GetFeeSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,6 +476,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "GetFeeSuggestion",
+			Handler:    _Service_GetFeeSuggestion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -150,7 +151,7 @@ func (app *BaseApp) Info(req *abci.RequestInfo) (*abci.ResponseInfo, error) {
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
-func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
+func (app *BaseApp) Query(ctx context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
 	// add panic recovery for all queries
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
@@ -173,6 +174,11 @@ func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci
 		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.trace), nil
 	}
 
+	// forward the queries for pruned heights to the archive node, if any
+	if app.isArchiveHeight(req.Height) {
+		return app.queryArchive(ctx, req), nil
+	}
+
 	// handle gRPC routes first rather than calling splitPath because '/' characters
 	// are used as part of gRPC paths
 	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
@@ -351,7 +357,19 @@ func (app *BaseApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, er
 
 	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
 	if err != nil {
-		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
+		// a tx failing recheck is evicted from the CometBFT mempool
+		if mode == execModeReCheck {
+			app.trackTxStatus(req.Tx, mempool.TxStatusEvicted, app.LastBlockHeight())
+		}
+		return sdkerrors.ResponseCheckTxWithEvents(app.normalizeTxError(err), gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
+	}
+
+	if mode == execModeCheck {
+		status := mempool.TxStatusPending
+		if _, isNoOp := app.mempool.(mempool.NoOpMempool); isNoOp {
+			status = mempool.TxStatusAccepted
+		}
+		app.trackTxStatus(req.Tx, status, app.LastBlockHeight())
 	}
 
 	return &abci.ResponseCheckTx{
@@ -681,6 +699,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 	for _, rawTx := range req.Txs {
 		if _, err := app.txDecoder(rawTx); err == nil {
 			txResults = append(txResults, app.deliverTx(rawTx))
+			app.trackTxStatus(rawTx, mempool.TxStatusIncluded, req.Height)
 		}
 	}
 
@@ -969,9 +988,18 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	resp, err := handler(ctx, req)
+	resp, err := func() (resp *abci.ResponseQuery, err error) {
+		defer app.recoverQueryOutOfGas(req.Path, &err)
+		if err := app.checkQueryCircuit(ctx, req.Path); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}()
 	if err != nil {
-		resp = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
+		if !errors.Is(err, sdkerrors.ErrOutOfGas) {
+			err = gRPCErrorToSDKError(err)
+		}
+		resp = sdkerrors.QueryResult(err, app.trace)
 		resp.Height = req.Height
 		return resp
 	}
@@ -1064,6 +1092,10 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		WithMinGasPrices(app.minGasPrices).
 		WithBlockHeight(height)
 
+	if limit := app.queryGasLimit.Load(); limit > 0 {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(limit))
+	}
+
 	if height != lastBlockHeight {
 		rms, ok := app.cms.(*rootmulti.Store)
 		if ok {
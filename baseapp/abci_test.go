@@ -10,6 +10,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -548,6 +549,49 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+// anteGasDecorator consumes a fixed amount of gas.
+type anteGasDecorator struct{ gas uint64 }
+
+func (d anteGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(d.gas, "ante")
+	return next(ctx, tx, simulate)
+}
+
+func TestABCI_SimulateTx_GasBreakdown(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(anteGasDecorator{gas: 7}, anteGasDecorator{gas: 3}))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 2, 5))
+	require.NoError(t, err)
+
+	gInfo, _, err := suite.baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, uint64(17), gInfo.GasUsed)
+
+	decorator := "github.com/cosmos/cosmos-sdk/baseapp_test.anteGasDecorator"
+	msg := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	require.Equal(t, &sdk.GasBreakdown{
+		AnteDecorators: []sdk.GasConsumption{{Name: decorator, GasUsed: 7}, {Name: decorator, GasUsed: 3}},
+		Msgs:           []sdk.GasConsumption{{Name: msg, GasUsed: 2}, {Name: msg, GasUsed: 5}},
+	}, gInfo.GasBreakdown)
+
+	// the gas consumption is only broken down by the simulations
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	gInfo, _, err = suite.baseApp.SimDeliver(suite.txConfig.TxEncoder(), newTxCounter(t, suite.txConfig, 2, 2))
+	require.NoError(t, err)
+	require.Nil(t, gInfo.GasBreakdown)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1257,6 +1301,62 @@ func TestABCI_PrepareProposal_ReachedMaxBytes(t *testing.T) {
 	require.Equal(t, 8, len(resPrepareProposal.Txs))
 }
 
+func TestABCI_PrepareProposal_Lanes(t *testing.T) {
+	priorityLane := mempool.Lane{
+		Name:          "priority",
+		Match:         mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+		Mempool:       mempool.NewSenderNonceMempool(),
+		MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2),
+	}
+	defaultLane := mempool.Lane{
+		Name:          "default",
+		Mempool:       mempool.NewSenderNonceMempool(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+	pool, err := mempool.NewLaneMempool(priorityLane, defaultLane)
+	require.NoError(t, err)
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	for i := 0; i < 20; i++ {
+		require.NoError(t, pool.Insert(sdk.Context{}, newTxCounter(t, suite.txConfig, int64(i), int64(i))))
+
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter2{Counter: int64(i), Signer: addr.String()}))
+		setTxSignature(t, builder, uint64(i))
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+	}
+	require.Equal(t, 40, pool.CountTx())
+
+	reqPrepareProposal := abci.RequestPrepareProposal{
+		MaxTxBytes: 3000,
+		Height:     1,
+	}
+	resPrepareProposal, err := suite.baseApp.PrepareProposal(&reqPrepareProposal)
+	require.NoError(t, err)
+
+	// the txs of the priority lane come first, within their share of the block
+	var priorityTxs, priorityBytes, totalBytes int
+	for i, txBz := range resPrepareProposal.Txs {
+		tx, err := suite.txConfig.TxDecoder()(txBz)
+		require.NoError(t, err)
+		totalBytes += len(txBz)
+		if priorityLane.Match(tx) {
+			require.Equal(t, priorityTxs, i)
+			priorityTxs++
+			priorityBytes += len(txBz)
+		}
+	}
+	require.Positive(t, priorityTxs)
+	require.LessOrEqual(t, priorityBytes, 750)
+	require.Greater(t, len(resPrepareProposal.Txs), priorityTxs)
+	require.LessOrEqual(t, totalBytes, 3000)
+}
+
 func TestABCI_PrepareProposal_BadEncoding(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool()
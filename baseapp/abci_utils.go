@@ -165,7 +165,8 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// reached or the mempool is exhausted. If the mempool is a LaneMempool, the
+// transactions of a lane which used up its share of the block space are skipped.
 //
 // Note:
 //
@@ -189,8 +190,13 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
+			blockSpace   *mempool.LaneBlockSpace
 		)
 
+		if lanes, ok := h.mempool.(*mempool.LaneMempool); ok {
+			blockSpace = lanes.NewBlockSpace(req.MaxTxBytes)
+		}
+
 		iterator := h.mempool.Select(ctx, req.Txs)
 
 		for iterator != nil {
@@ -208,6 +214,13 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 				}
 			} else {
 				txSize := int64(len(bz))
+				if blockSpace != nil && !blockSpace.Reserve(memTx, txSize) {
+					// The lane of the transaction used up its share of the block
+					// space, but the transactions of the next lanes may still fit.
+					iterator = iterator.Next()
+					continue
+				}
+
 				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
 					selectedTxs = append(selectedTxs, bz)
 				} else {
@@ -0,0 +1,154 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ArchiveQuerier performs ABCI queries against an archive node. When set, the
+// queries for heights which are pruned from the local stores are forwarded to
+// it, so that pruned and archive nodes can serve the same queries.
+type ArchiveQuerier interface {
+	ABCIQuery(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error)
+}
+
+// isArchiveHeight returns true if the queries for the given height must be
+// forwarded to the archive node, i.e. if an archive node is configured and the
+// state at the given height is no longer available locally.
+func (app *BaseApp) isArchiveHeight(height int64) bool {
+	if app.archiveQuerier == nil || height <= 0 || height >= app.LastBlockHeight() {
+		return false
+	}
+
+	qms := app.qms
+	if qms == nil {
+		qms = app.cms.(storetypes.MultiStore)
+	}
+
+	_, err := qms.CacheMultiStoreWithVersion(height)
+	return err != nil
+}
+
+// queryArchive forwards the given query to the archive node. The proof of the
+// response, when requested, is verified against the app hash committed locally
+// at the queried height, if it is still known.
+func (app *BaseApp) queryArchive(ctx context.Context, req *abci.RequestQuery) *abci.ResponseQuery {
+	resp, err := app.archiveQuerier.ABCIQuery(ctx, req)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrIO, "failed to query archive node at height %d: %s", req.Height, err), app.trace)
+	}
+
+	if resp.IsOK() && req.Prove {
+		if err := app.verifyArchiveProof(req, resp); err != nil {
+			return sdkerrors.QueryResult(err, app.trace)
+		}
+	}
+
+	return resp
+}
+
+// verifyArchiveProof verifies the proof of a store query response forwarded by
+// the archive node against the app hash committed locally at the response
+// height. Responses are not verified when the commit info of the height is not
+// available locally.
+func (app *BaseApp) verifyArchiveProof(req *abci.RequestQuery, resp *abci.ResponseQuery) error {
+	path := SplitABCIQueryPath(req.Path)
+	if len(path) < 3 || path[0] != QueryPathStore || path[len(path)-1] != "key" {
+		return nil
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil
+	}
+
+	commitInfo, err := rms.GetCommitInfo(resp.Height)
+	if err != nil {
+		return nil
+	}
+
+	if resp.ProofOps == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "archive node response is missing its proof")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(path[1]), merkle.KeyEncodingURL).
+		AppendKey(resp.Key, merkle.KeyEncodingURL).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(resp.Value) == 0 {
+		err = prt.VerifyAbsence(resp.ProofOps, commitInfo.Hash(), keyPath)
+	} else {
+		err = prt.VerifyValue(resp.ProofOps, commitInfo.Hash(), keyPath, resp.Value)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid archive node response at height %d: %s", resp.Height, err)
+	}
+
+	return nil
+}
+
+// queryArchiveGRPC forwards the given gRPC query to the archive node.
+func (app *BaseApp) queryArchiveGRPC(ctx context.Context, fullMethod string, req interface{}, height int64) (gogoproto.Message, error) {
+	reqMsg, ok := req.(gogoproto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported request type %T", req)
+	}
+
+	bz, err := gogoproto.Marshal(reqMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	abciResp := app.queryArchive(ctx, &abci.RequestQuery{Path: fullMethod, Data: bz, Height: height})
+	if !abciResp.IsOK() {
+		return nil, errorsmod.ABCIError(abciResp.Codespace, abciResp.Code, abciResp.Log)
+	}
+
+	resp, err := newGRPCResponse(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gogoproto.Unmarshal(abciResp.Value, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// newGRPCResponse returns an empty response of the given gRPC query method,
+// e.g. "/cosmos.bank.v1beta1.Query/Balance".
+func newGRPCResponse(fullMethod string) (gogoproto.Message, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a gRPC method", fullMethod)
+	}
+
+	typ := gogoproto.MessageType(string(methodDesc.Output().FullName()))
+	if typ == nil {
+		return nil, fmt.Errorf("unknown response type %s of %s", methodDesc.Output().FullName(), fullMethod)
+	}
+
+	return reflect.New(typ.Elem()).Interface().(gogoproto.Message), nil
+}
@@ -0,0 +1,84 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type mockArchiveQuerier struct {
+	reqs []*abci.RequestQuery
+	resp *abci.ResponseQuery
+}
+
+func (q *mockArchiveQuerier) ABCIQuery(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	q.reqs = append(q.reqs, req)
+	return q.resp, nil
+}
+
+func TestABCI_Query_Archive(t *testing.T) {
+	archiveResp, err := (&testdata.SayHelloResponse{Greeting: "Hello from archive!"}).Marshal()
+	require.NoError(t, err)
+
+	archive := &mockArchiveQuerier{resp: &abci.ResponseQuery{Value: archiveResp}}
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+	}
+
+	suite := NewBaseAppSuite(
+		t,
+		grpcQueryOpt,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10)),
+		baseapp.SetArchiveQuerier(archive),
+	)
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+
+	// the latest heights are queried locally
+	for _, height := range []int64{0, suite.baseApp.LastBlockHeight()} {
+		resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: reqBz, Path: "/testpb.Query/SayHello", Height: height})
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+
+		var res testdata.SayHelloResponse
+		require.NoError(t, res.Unmarshal(resQuery.Value))
+		require.Equal(t, "Hello foo!", res.Greeting)
+	}
+	require.Empty(t, archive.reqs)
+
+	// the pruned heights are queried from the archive node
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: reqBz, Path: "/testpb.Query/SayHello", Height: 2})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Equal(t, archiveResp, resQuery.Value)
+	require.Equal(t, []*abci.RequestQuery{{Data: reqBz, Path: "/testpb.Query/SayHello", Height: 2}}, archive.reqs)
+
+	// the proofs of the archive node responses are verified
+	archive.resp = &abci.ResponseQuery{Key: []byte("key"), Value: []byte("value"), Height: 2}
+	resQuery, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: []byte("key"), Path: "/store/key1/key", Height: 2, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code, resQuery)
+	require.Contains(t, resQuery.Log, "archive node response is missing its proof")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync/atomic"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -62,6 +63,7 @@ type BaseApp struct {
 	db                dbm.DB                      // common DB backend
 	cms               storetypes.CommitMultiStore // Main (uncached) state
 	qms               storetypes.MultiStore       // Optional alternative multistore for querying only.
+	archiveQuerier    ArchiveQuerier              // Optional archive node querier, to which the queries for pruned heights are forwarded.
 	storeLoader       StoreLoader                 // function to handle store loading, may be overridden with SetStoreLoader()
 	grpcQueryRouter   *GRPCQueryRouter            // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter           // router for redirecting Msg service messages
@@ -69,9 +71,10 @@ type BaseApp struct {
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
-	mempool     mempool.Mempool // application side mempool
-	anteHandler sdk.AnteHandler // ante handler for fee and auth
-	postHandler sdk.PostHandler // post handler, optional, e.g. for tips
+	mempool     mempool.Mempool          // application side mempool
+	txStatuses  *mempool.TxStatusTracker // optional tracker of the status of the txs seen by the mempool
+	anteHandler sdk.AnteHandler          // ante handler for fee and auth
+	postHandler sdk.PostHandler          // post handler, optional, e.g. for tips
 
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
@@ -144,6 +147,15 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// queryGasLimit defines the gas limit of the queries served by the node. A
+	// value of 0 indicates that the queries are not limited. It may be updated
+	// while the queries are served, see UpdateQueryGasLimit.
+	queryGasLimit *atomic.Uint64
+
+	// queryGasExceeded records, by query route, the last committed height at
+	// which a query exceeded queryGasLimit.
+	queryGasExceeded *queryGasExceededTracker
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from CometBFT. It is used as part of the process of determining the
@@ -173,6 +185,11 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// normalizedErrorCodespaces defines the codespaces of the errors whose log
+	// is normalized in the results of the txs which fail in CheckTx,
+	// FinalizeBlock or Simulate.
+	normalizedErrorCodespaces map[string]struct{}
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
@@ -197,6 +214,8 @@ func NewBaseApp(
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
+		queryGasLimit:    &atomic.Uint64{},
+		queryGasExceeded: &queryGasExceededTracker{},
 	}
 
 	for _, option := range options {
@@ -355,6 +374,12 @@ func (app *BaseApp) CommitMultiStore() storetypes.CommitMultiStore {
 	return app.cms
 }
 
+// StreamingManager returns the streaming manager, e.g. to append an
+// ABCIListener to the ones already registered.
+func (app *BaseApp) StreamingManager() storetypes.StreamingManager {
+	return app.streamingManager
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -447,6 +472,14 @@ func (app *BaseApp) setIndexEvents(ie []string) {
 	}
 }
 
+func (app *BaseApp) setNormalizedErrorCodespaces(codespaces []string) {
+	app.normalizedErrorCodespaces = make(map[string]struct{})
+
+	for _, codespace := range codespaces {
+		app.normalizedErrorCodespaces[codespace] = struct{}{}
+	}
+}
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -506,6 +539,17 @@ func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
 	app.msgServiceRouter.SetCircuit(cb)
 }
 
+// SetQueryCircuitBreaker sets the circuit breaker of the gRPC query routes for
+// the BaseApp. It is checked on every query made to the node through ABCI,
+// gRPC or the REST API, but not on the queries made by the modules while
+// executing the transactions, which must stay deterministic.
+func (app *BaseApp) SetQueryCircuitBreaker(cb QueryCircuitBreaker) {
+	if app.grpcQueryRouter == nil {
+		panic("cannot set query circuit breaker with no grpc query router set")
+	}
+	app.grpcQueryRouter.SetCircuit(cb)
+}
+
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
 // ParamStore. If the BaseApp has no ParamStore defined, nil is returned.
 func (app *BaseApp) GetConsensusParams(ctx sdk.Context) cmtproto.ConsensusParams {
@@ -722,7 +766,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
-			err,
+			app.normalizeTxError(err),
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
@@ -784,6 +828,15 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// break down the gas consumed by the ante decorators and msgs when
+	// simulating, to profile them and estimate the fees precisely. The lists
+	// are reported empty rather than omitted.
+	var gasBreakdown *sdk.GasBreakdown
+	if mode == execModeSimulate {
+		gasBreakdown = &sdk.GasBreakdown{AnteDecorators: []sdk.GasConsumption{}, Msgs: []sdk.GasConsumption{}}
+		ctx = ctx.WithGasBreakdown(gasBreakdown)
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -795,7 +848,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
-		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed(), GasBreakdown: gasBreakdown}
 	}()
 
 	blockGasConsumed := false
@@ -940,6 +993,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 	events := sdk.EmptyEvents()
 	var msgResponses []*codectypes.Any
 
+	var gasBreakdown *sdk.GasBreakdown
+	if mode == execModeSimulate {
+		gasBreakdown = ctx.GasBreakdown()
+	}
+
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		if mode != execModeFinalize && mode != execModeSimulate {
@@ -952,7 +1010,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		gasBefore := ctx.GasMeter().GasConsumed()
 		msgResult, err := handler(ctx, msg)
+		if gasBreakdown != nil {
+			gasBreakdown.AddMsg(msg, ctx.GasMeter().GasConsumed()-gasBefore)
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -1043,6 +1105,8 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 
 	_, _, _, err = app.runTx(execModePrepareProposal, bz)
 	if err != nil {
+		// the invalid tx is removed from the mempool by the proposal handler
+		app.trackTxStatus(bz, mempool.TxStatusEvicted, app.LastBlockHeight())
 		return nil, err
 	}
 
@@ -1068,7 +1132,9 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 	return tx, nil
 }
 
-// Close is called in start cmd to gracefully cleanup resources.
+// Close is called in start cmd to gracefully cleanup resources. It waits for
+// the state sync snapshot in progress, if any, to be aborted once its current
+// chunk is saved.
 func (app *BaseApp) Close() error {
-	return nil
+	return app.snapshotManager.Close()
 }
@@ -8,3 +8,9 @@ import (
 type CircuitBreaker interface {
 	IsAllowed(ctx sdk.Context, typeURL string) bool
 }
+
+// QueryCircuitBreaker is an interface that defines the methods for a circuit
+// breaker of the gRPC query routes.
+type QueryCircuitBreaker interface {
+	IsQueryAllowed(ctx sdk.Context, route string) bool
+}
@@ -0,0 +1,38 @@
+package baseapp
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// internalErrorLog is the normalized log of the errors which are not registered,
+// and so have no description.
+const internalErrorLog = "internal error"
+
+// normalizeTxError returns the error a failed tx is reported with in CheckTx,
+// FinalizeBlock and Simulate. If the codespace of the error is normalized, the
+// error, which may contain addresses, heights or amounts wrapped into it by the
+// module, is replaced with the registered error it wraps, so that the log of the
+// result only depends on its codespace and code, which are kept. The full error
+// is logged instead.
+func (app *BaseApp) normalizeTxError(err error) error {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	if _, ok := app.normalizedErrorCodespaces[codespace]; !ok {
+		return err
+	}
+
+	app.logger.Debug(
+		"normalized the error of a tx",
+		"codespace", codespace,
+		"code", code,
+		"err", err,
+	)
+
+	var registered *errorsmod.Error
+	if !errors.As(err, &registered) {
+		return errors.New(internalErrorLog)
+	}
+
+	return registered
+}
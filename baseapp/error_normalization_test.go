@@ -0,0 +1,68 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestABCI_NormalizedErrors(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			return
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		codespaces []string
+		expLog     string
+	}{
+		{"not normalized", nil, "must contain at least one message: invalid request"},
+		{"other codespace normalized", []string{"bank"}, "must contain at least one message: invalid request"},
+		{"codespace normalized", []string{"bank", sdkerrors.RootCodespace}, "invalid request"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, anteOpt, baseapp.SetNormalizedErrorCodespaces(tc.codespaces...))
+
+			_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			// a transaction with no messages fails with a wrapped error
+			emptyTx := suite.txConfig.NewTxBuilder().GetTx()
+			bz, err := suite.txConfig.TxEncoder()(emptyTx)
+			require.NoError(t, err)
+
+			// the codespace and code are never changed
+			checkRes, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+			require.NoError(t, err)
+			require.Equal(t, sdkerrors.ErrInvalidRequest.Codespace(), checkRes.Codespace)
+			require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), checkRes.Code)
+			require.Equal(t, tc.expLog, checkRes.Log)
+
+			_, _, err = suite.baseApp.Simulate(bz)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			require.EqualError(t, err, tc.expLog)
+
+			res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: 1,
+				Txs:    [][]byte{bz},
+			})
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, 1)
+			require.Equal(t, sdkerrors.ErrInvalidRequest.Codespace(), res.TxResults[0].Codespace)
+			require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.TxResults[0].Code)
+			require.Equal(t, tc.expLog, res.TxResults[0].Log)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"fmt"
+	"sync"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -19,6 +20,12 @@ type GRPCQueryRouter struct {
 	routes      map[string]GRPCQueryHandler
 	cdc         encoding.Codec
 	serviceData []serviceData
+
+	// circuitBreaker and the local overrides of the operator decide which
+	// routes are disabled, see IsRouteAllowed.
+	circuitBreaker QueryCircuitBreaker
+	overridesMtx   sync.RWMutex
+	overrides      map[string]bool
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -32,7 +39,8 @@ var _ gogogrpc.Server = &GRPCQueryRouter{}
 // NewGRPCQueryRouter creates a new GRPCQueryRouter
 func NewGRPCQueryRouter() *GRPCQueryRouter {
 	return &GRPCQueryRouter{
-		routes: map[string]GRPCQueryHandler{},
+		routes:    map[string]GRPCQueryHandler{},
+		overrides: map[string]bool{},
 	}
 }
 
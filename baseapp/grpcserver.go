@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,25 +26,25 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// If there's some metadata in the context, retrieve it.
-		md, ok := metadata.FromIncomingContext(grpcCtx)
-		if !ok {
-			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		height, err := queryHeight(grpcCtx)
+		if err != nil {
+			return nil, err
 		}
 
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		// Forward the queries for pruned heights to the archive node, if any.
+		if app.isArchiveHeight(height) {
+			resp, err := app.queryArchiveGRPC(grpcCtx, info.FullMethod, req, height)
 			if err != nil {
-				return nil, errorsmod.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
-			}
-			if err := checkNegativeHeight(height); err != nil {
 				return nil, err
 			}
+
+			md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+			if err = grpc.SetHeader(grpcCtx, md); err != nil {
+				app.logger.Error("failed to set gRPC header", "err", err)
+			}
+
+			return resp, nil
 		}
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
@@ -61,14 +62,55 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
 		if err = grpc.SetHeader(grpcCtx, md); err != nil {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
+		defer app.recoverQueryOutOfGas(info.FullMethod, &err)
+		if err := app.checkQueryCircuit(sdkCtx, info.FullMethod); err != nil {
+			return nil, err
+		}
 		return handler(grpcCtx, req)
 	}
 
+	// Define an interceptor for all gRPC streaming queries: this interceptor
+	// creates a single sdk.Context for the whole stream, so that all the
+	// responses are read from the same version of the state.
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		height, err := queryHeight(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		if app.isArchiveHeight(height) {
+			return status.Errorf(codes.Unimplemented, "streaming queries cannot be forwarded to the archive node, height %d is pruned", height)
+		}
+
+		sdkCtx, err := app.CreateQueryContext(height, false)
+		if err != nil {
+			return err
+		}
+
+		if height == 0 {
+			height = sdkCtx.BlockHeight()
+		}
+
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		if err = stream.SetHeader(md); err != nil {
+			app.logger.Error("failed to set gRPC header", "err", err)
+		}
+
+		defer app.recoverQueryOutOfGas(info.FullMethod, &err)
+		if err := app.checkQueryCircuit(sdkCtx, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &queryServerStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(stream.Context(), sdk.SdkContextKey, sdkCtx),
+		})
+	}
+
 	// Loop through all services and methods, add the interceptor, and register
 	// the service.
 	for _, data := range app.GRPCQueryRouter().serviceData {
@@ -88,14 +130,69 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			stream, streamHandler := stream, stream.Handler
+			newStreams[i] = stream
+			newStreams[i].Handler = func(srv interface{}, serverStream grpc.ServerStream) error {
+				info := &grpc.StreamServerInfo{
+					FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName),
+					IsServerStream: stream.ServerStreams,
+					IsClientStream: stream.ClientStreams,
+				}
+				return grpcmiddleware.ChainStreamServer(
+					grpcrecovery.StreamServerInterceptor(),
+					streamInterceptor,
+				)(srv, serverStream, info, streamHandler)
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// queryHeight returns the height of the query given in the gRPC metadata of
+// ctx, or 0 if not set.
+func queryHeight(ctx context.Context) (int64, error) {
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Get height header from the request context, if present.
+	var height int64
+	if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+		var err error
+		height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		if err != nil {
+			return 0, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		}
+		if err := checkNegativeHeight(height); err != nil {
+			return 0, err
+		}
+	}
+
+	return height, nil
+}
+
+// queryServerStream is a grpc.ServerStream whose context holds the
+// sdk.Context of the query.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *queryServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -57,6 +57,22 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetArchiveQuerier returns a BaseApp option function that sets the querier of
+// the archive node to which the queries for pruned heights are forwarded.
+func SetArchiveQuerier(querier ArchiveQuerier) func(*BaseApp) {
+	return func(app *BaseApp) { app.archiveQuerier = querier }
+}
+
+// SetNormalizedErrorCodespaces returns a BaseApp option function that sets the
+// codespaces, usually module names, of the errors whose log is normalized in the
+// results of the txs which fail in CheckTx, FinalizeBlock or Simulate. The log of
+// such a result is replaced with the description of the registered error,
+// stripped of the details wrapped into it, while the full error is logged by the
+// node at the debug level.
+func SetNormalizedErrorCodespaces(codespaces ...string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setNormalizedErrorCodespaces(codespaces) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -67,6 +83,17 @@ func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
 }
 
+// SetIAVLStoreCacheSizes provides a BaseApp option function that sets the size
+// of the IAVL cache of the given stores, by store name, overriding the size set
+// with SetIAVLCacheSize.
+func SetIAVLStoreCacheSizes(sizes map[string]int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for name, size := range sizes {
+			bapp.cms.SetIAVLStoreCacheSize(name, size)
+		}
+	}
+}
+
 // SetIAVLDisableFastNode enables(false)/disables(true) fast node usage from the IAVL store.
 func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
@@ -93,6 +120,19 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetTxStatusCacheSize returns a BaseApp option function that sets the number
+// of txs whose status in the lifecycle of the mempool is tracked. A size of zero
+// disables the tracking.
+func SetTxStatusCacheSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxStatusCacheSize(size) }
+}
+
+// SetQueryGasLimit returns a BaseApp option function that sets the gas limit of
+// the queries served by the node. A limit of zero leaves the queries unlimited.
+func SetQueryGasLimit(limit uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryGasLimit(limit) }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
@@ -285,6 +325,36 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// SetTxStatusCacheSize sets the number of txs whose status in the lifecycle of
+// the mempool is tracked. A size of zero disables the tracking.
+func (app *BaseApp) SetTxStatusCacheSize(size int) {
+	if app.sealed {
+		panic("SetTxStatusCacheSize() on sealed BaseApp")
+	}
+
+	if size <= 0 {
+		app.txStatuses = nil
+		return
+	}
+	app.txStatuses = mempool.NewTxStatusTracker(size)
+}
+
+// SetQueryGasLimit sets the gas limit of the queries served by the node. A limit
+// of zero leaves the queries unlimited.
+func (app *BaseApp) SetQueryGasLimit(limit uint64) {
+	if app.sealed {
+		panic("SetQueryGasLimit() on sealed BaseApp")
+	}
+	app.queryGasLimit.Store(limit)
+}
+
+// UpdateQueryGasLimit updates the gas limit of the queries served by the node
+// at runtime. The queries being served keep the previous limit. A limit of
+// zero leaves the queries unlimited.
+func (app *BaseApp) UpdateQueryGasLimit(limit uint64) {
+	app.queryGasLimit.Store(limit)
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
@@ -318,6 +388,18 @@ func (app *BaseApp) SetVerifyVoteExtensionHandler(handler sdk.VerifyVoteExtensio
 	app.verifyVoteExt = handler
 }
 
+// SetVoteExtensionRegistry sets the ExtendVote and VerifyVoteExtension handlers
+// of the BaseApp to the ones composing the vote extension handlers of the
+// modules registered in the registry.
+func (app *BaseApp) SetVoteExtensionRegistry(registry *VoteExtensionRegistry) {
+	if app.sealed {
+		panic("SetVoteExtensionRegistry() on sealed BaseApp")
+	}
+
+	app.extendVote = registry.ExtendVoteHandler()
+	app.verifyVoteExt = registry.VerifyVoteExtensionHandler()
+}
+
 // SetStoreMetrics sets the prepare proposal function for the BaseApp.
 func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	if app.sealed {
@@ -0,0 +1,135 @@
+package baseapp
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCircuit sets the circuit breaker of the query routes.
+func (qrt *GRPCQueryRouter) SetCircuit(cb QueryCircuitBreaker) {
+	qrt.circuitBreaker = cb
+}
+
+// SetRouteOverride disables or enables a query route on the node, whatever the
+// state of the circuit breaker. The route is the full gRPC method name of the
+// route, e.g. "/cosmos.bank.v1beta1.Query/DenomOwners", or a glob pattern using
+// the path.Match syntax, e.g. "/cosmos.bank.v1beta1.Query/*".
+func (qrt *GRPCQueryRouter) SetRouteOverride(route string, enabled bool) error {
+	route = "/" + strings.TrimPrefix(route, "/")
+	if _, err := path.Match(route, ""); err != nil {
+		return fmt.Errorf("invalid query route pattern %s: %w", route, err)
+	}
+	if !strings.ContainsAny(route, "*?[") && qrt.Route(route) == nil {
+		return fmt.Errorf("unknown query route %s", route)
+	}
+
+	qrt.overridesMtx.Lock()
+	defer qrt.overridesMtx.Unlock()
+	qrt.overrides[route] = enabled
+	return nil
+}
+
+// RemoveRouteOverride removes the override of a query route set using
+// SetRouteOverride.
+func (qrt *GRPCQueryRouter) RemoveRouteOverride(route string) {
+	qrt.overridesMtx.Lock()
+	defer qrt.overridesMtx.Unlock()
+	delete(qrt.overrides, "/"+strings.TrimPrefix(route, "/"))
+}
+
+// RouteOverrides returns the overridden query routes, and whether they are
+// enabled.
+func (qrt *GRPCQueryRouter) RouteOverrides() map[string]bool {
+	qrt.overridesMtx.RLock()
+	defer qrt.overridesMtx.RUnlock()
+
+	overrides := make(map[string]bool, len(qrt.overrides))
+	for route, enabled := range qrt.overrides {
+		overrides[route] = enabled
+	}
+	return overrides
+}
+
+// routeOverride returns whether the query route is enabled by the overrides,
+// and false if it is not overridden. The override of the route itself takes
+// precedence over the patterns matching it, and the route is disabled if any
+// of the patterns disables it.
+func (qrt *GRPCQueryRouter) routeOverride(route string) (enabled, overridden bool) {
+	qrt.overridesMtx.RLock()
+	defer qrt.overridesMtx.RUnlock()
+
+	if enabled, found := qrt.overrides[route]; found {
+		return enabled, true
+	}
+
+	for pattern, patternEnabled := range qrt.overrides {
+		if matched, _ := path.Match(pattern, route); !matched {
+			continue
+		}
+		if !patternEnabled {
+			return false, true
+		}
+		enabled, overridden = true, true
+	}
+
+	return enabled, overridden
+}
+
+// IsRouteAllowed returns false if the query route is disabled by the local
+// overrides or, if it is not overridden, by the circuit breaker in the state
+// of ctx.
+func (qrt *GRPCQueryRouter) IsRouteAllowed(ctx sdk.Context, route string) bool {
+	if enabled, overridden := qrt.routeOverride(route); overridden {
+		return enabled
+	}
+
+	return qrt.circuitBreaker == nil || qrt.circuitBreaker.IsQueryAllowed(ctx, route)
+}
+
+// QueryRouteOverrides returns the local overrides of the query routes of the
+// node, see SetQueryRouteOverride.
+func (app *BaseApp) QueryRouteOverrides() map[string]bool {
+	return app.grpcQueryRouter.RouteOverrides()
+}
+
+// SetQueryRouteOverride disables or enables a query route on the node, whatever
+// the state of the query circuit breaker, e.g. for an operator to shed the load
+// of an expensive query before the chain disables it, or to serve a query on a
+// private node while it is disabled for the chain. The overrides are kept in
+// memory until the node is restarted.
+func (app *BaseApp) SetQueryRouteOverride(route string, enabled bool) error {
+	return app.grpcQueryRouter.SetRouteOverride(route, enabled)
+}
+
+// RemoveQueryRouteOverride removes the local override of a query route, which
+// is then disabled only if the query circuit breaker disables it.
+func (app *BaseApp) RemoveQueryRouteOverride(route string) {
+	app.grpcQueryRouter.RemoveRouteOverride(route)
+}
+
+// checkQueryCircuit returns an Unavailable error if the query route is
+// disabled. The circuit breaker is read from the latest state whatever the
+// height of the query ctx, so that a route cannot be queried at a height
+// before it was disabled.
+func (app *BaseApp) checkQueryCircuit(ctx sdk.Context, route string) error {
+	qrt := app.grpcQueryRouter
+	if _, overridden := qrt.routeOverride(route); !overridden && qrt.circuitBreaker != nil && ctx.BlockHeight() != app.LastBlockHeight() {
+		latestCtx, err := app.CreateQueryContext(0, false)
+		if err != nil {
+			return err
+		}
+		ctx = latestCtx
+	}
+
+	if !qrt.IsRouteAllowed(ctx, route) {
+		return status.Errorf(codes.Unavailable, "query route %s is disabled by the circuit breaker", route)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// disabledQueries is a query circuit breaker disabling the given routes.
+type disabledQueries map[string]bool
+
+func (d disabledQueries) IsQueryAllowed(_ sdk.Context, route string) bool {
+	return !d[route]
+}
+
+func TestABCI_QueryCircuitBreaker(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+	}
+	suite := NewBaseAppSuite(t, grpcQueryOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	echoReq, err := (&testdata.EchoRequest{Message: "foo"}).Marshal()
+	require.NoError(t, err)
+	helloReq, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+	query := func(path string, data []byte) *abci.ResponseQuery {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: data, Path: path})
+		require.NoError(t, err)
+		return res
+	}
+	requireDisabled := func(res *abci.ResponseQuery) {
+		require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code, res)
+		require.Contains(t, res.Log, "is disabled by the circuit breaker")
+	}
+
+	suite.baseApp.SetQueryCircuitBreaker(disabledQueries{"/testpb.Query/Echo": true})
+	requireDisabled(query("/testpb.Query/Echo", echoReq))
+	require.Equal(t, abci.CodeTypeOK, query("/testpb.Query/SayHello", helloReq).Code)
+
+	// the local overrides take precedence over the circuit breaker
+	require.NoError(t, suite.baseApp.SetQueryRouteOverride("/testpb.Query/Echo", true))
+	require.NoError(t, suite.baseApp.SetQueryRouteOverride("/testpb.Query/*", false))
+	require.Equal(t, map[string]bool{"/testpb.Query/Echo": true, "/testpb.Query/*": false}, suite.baseApp.QueryRouteOverrides())
+	require.Equal(t, abci.CodeTypeOK, query("/testpb.Query/Echo", echoReq).Code)
+	requireDisabled(query("/testpb.Query/SayHello", helloReq))
+
+	suite.baseApp.RemoveQueryRouteOverride("/testpb.Query/Echo")
+	suite.baseApp.RemoveQueryRouteOverride("/testpb.Query/*")
+	require.Empty(t, suite.baseApp.QueryRouteOverrides())
+	requireDisabled(query("/testpb.Query/Echo", echoReq))
+
+	// only the registered routes and valid patterns can be overridden
+	require.ErrorContains(t, suite.baseApp.SetQueryRouteOverride("/testpb.Query/Unknown", false), "unknown query route")
+	require.ErrorContains(t, suite.baseApp.SetQueryRouteOverride("/testpb.Query/[", false), "invalid query route pattern")
+
+	// the queries made by the modules are never disabled
+	ctx := suite.baseApp.NewContext(true, cmtproto.Header{})
+	_, err = suite.baseApp.GRPCQueryRouter().Route("/testpb.Query/Echo")(ctx, &abci.RequestQuery{Data: echoReq})
+	require.NoError(t, err)
+}
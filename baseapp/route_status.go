@@ -0,0 +1,102 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// queryGasExceededTracker records, by query route, the last committed height at
+// which a query exceeded the query gas limit. It is safe for concurrent use as
+// the queries are served concurrently.
+type queryGasExceededTracker struct {
+	mtx     sync.RWMutex
+	heights map[string]int64
+}
+
+func (t *queryGasExceededTracker) record(path string, height int64) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.heights == nil {
+		t.heights = make(map[string]int64)
+	}
+	t.heights[path] = height
+}
+
+func (t *queryGasExceededTracker) snapshot() map[string]int64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	heights := make(map[string]int64, len(t.heights))
+	for path, height := range t.heights {
+		heights[path] = height
+	}
+	return heights
+}
+
+// QueryGasLimit returns the gas limit of the queries served by the node, or 0
+// if the queries are not limited.
+func (app *BaseApp) QueryGasLimit() uint64 {
+	return app.queryGasLimit.Load()
+}
+
+// QueryGasExceededRoutes returns, by query route, the last committed height at
+// which a query exceeded the query gas limit since the node started.
+func (app *BaseApp) QueryGasExceededRoutes() map[string]int64 {
+	return app.queryGasExceeded.snapshot()
+}
+
+// DisabledMsgRoutes returns the sorted type URLs of the registered Msgs which
+// the circuit breaker currently disables, as of the state of the given context.
+func (app *BaseApp) DisabledMsgRoutes(ctx sdk.Context) []string {
+	return app.msgServiceRouter.DisabledRoutes(ctx)
+}
+
+// recoverQueryOutOfGas must be deferred by the query handlers. It turns the
+// out of gas panic of a query exceeding the query gas limit into an error set
+// in err, and records the query route as having exceeded the limit. Any other
+// panic is propagated.
+func (app *BaseApp) recoverQueryOutOfGas(path string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	oog, ok := r.(storetypes.ErrorOutOfGas)
+	if !ok {
+		panic(r)
+	}
+
+	app.queryGasExceeded.record(path, app.LastBlockHeight())
+	*err = errorsmod.Wrapf(
+		sdkerrors.ErrOutOfGas,
+		"query %s exceeded the query gas limit of %d; out of gas in location: %s", path, app.QueryGasLimit(), oog.Descriptor,
+	)
+}
+
+// DisabledRoutes returns the sorted type URLs of the registered Msgs which the
+// circuit breaker disables, as of the state of the given context. The reads of
+// the circuit breaker are not metered.
+func (msr *MsgServiceRouter) DisabledRoutes(ctx sdk.Context) []string {
+	if msr.circuitBreaker == nil {
+		return nil
+	}
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var disabled []string
+	for msgURL := range msr.routes {
+		if !msr.circuitBreaker.IsAllowed(ctx, msgURL) {
+			disabled = append(disabled, msgURL)
+		}
+	}
+	sort.Strings(disabled)
+
+	return disabled
+}
@@ -0,0 +1,80 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasConsumingQueryImpl consumes gas when saying hello.
+type gasConsumingQueryImpl struct {
+	testdata.QueryImpl
+}
+
+func (q gasConsumingQueryImpl) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(100, "say hello")
+	return q.QueryImpl.SayHello(ctx, req)
+}
+
+// disabledMsgs is a circuit breaker disabling the given Msgs.
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) IsAllowed(_ sdk.Context, typeURL string) bool {
+	return !d[typeURL]
+}
+
+func TestABCI_QueryGasLimit(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), gasConsumingQueryImpl{})
+	}
+	suite := NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryGasLimit(50))
+	require.Equal(t, uint64(50), suite.baseApp.QueryGasLimit())
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// a query within the limit is served
+	echoReq, err := (&testdata.EchoRequest{Message: "foo"}).Marshal()
+	require.NoError(t, err)
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: echoReq, Path: "/testpb.Query/Echo"})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Empty(t, suite.baseApp.QueryGasExceededRoutes())
+
+	// a query exceeding the limit fails and is recorded
+	helloReq, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+	resQuery, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Data: helloReq, Path: "/testpb.Query/SayHello"})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), resQuery.Code, resQuery)
+	require.Contains(t, resQuery.Log, "exceeded the query gas limit of 50")
+	require.Equal(t, map[string]int64{"/testpb.Query/SayHello": 1}, suite.baseApp.QueryGasExceededRoutes())
+}
+
+func TestDisabledMsgRoutes(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), Counter2ServerImpl{})
+
+	ctx := suite.baseApp.NewContext(true, cmtproto.Header{})
+	require.Empty(t, suite.baseApp.DisabledMsgRoutes(ctx))
+
+	msgURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})
+	suite.baseApp.SetCircuitBreaker(disabledMsgs{msgURL: true})
+	require.Equal(t, []string{msgURL}, suite.baseApp.DisabledMsgRoutes(ctx))
+}
@@ -26,7 +26,10 @@ func (app *BaseApp) SimCheck(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *
 // Simulate executes a tx in simulate mode to get result and gas info.
 func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	gasInfo, result, _, err := app.runTx(execModeSimulate, txBytes)
-	return gasInfo, result, err
+	if err != nil {
+		return gasInfo, result, app.normalizeTxError(err)
+	}
+	return gasInfo, result, nil
 }
 
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
@@ -0,0 +1,23 @@
+package baseapp
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// TxStatusTracker returns the tracker of the status of the txs seen by the
+// mempool of the node, or nil if the tracking is disabled.
+func (app *BaseApp) TxStatusTracker() *mempool.TxStatusTracker {
+	return app.txStatuses
+}
+
+// trackTxStatus sets the status of the tx, by tx hash, if the status of the txs
+// is tracked.
+func (app *BaseApp) trackTxStatus(txBytes []byte, status mempool.TxStatus, height int64) {
+	if app.txStatuses == nil {
+		return
+	}
+
+	app.txStatuses.Track(tmhash.Sum(txBytes), status, height)
+}
@@ -0,0 +1,71 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestABCI_TxStatus(t *testing.T) {
+	// the txs are no longer valid once rechecked
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			if ctx.IsReCheckTx() {
+				return ctx, sdkerrors.ErrInvalidSequence
+			}
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetTxStatusCacheSize(10))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txStatus := func(txBytes []byte) mempool.TxStatusRecord {
+		record, _ := suite.baseApp.TxStatusTracker().Get(tmhash.Sum(txBytes))
+		return record
+	}
+
+	tx0, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	tx1, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 0))
+	require.NoError(t, err)
+
+	require.Equal(t, mempool.TxStatusUnknown, txStatus(tx0).Status)
+
+	// the app-side mempool is a no-op mempool, so the txs are only accepted
+	res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: tx0})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+	require.Equal(t, mempool.TxStatusRecord{Status: mempool.TxStatusAccepted}, txStatus(tx0))
+
+	res, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: tx0, Type: abci.CheckTxType_Recheck})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+	require.Equal(t, mempool.TxStatusEvicted, txStatus(tx0).Status)
+
+	res, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: tx1})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+	require.Equal(t, mempool.TxStatusAccepted, txStatus(tx1).Status)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{tx1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, mempool.TxStatusRecord{Status: mempool.TxStatusIncluded, Height: 1}, txStatus(tx1))
+
+	// the tracking is disabled by default
+	require.Nil(t, NewBaseAppSuite(t, anteOpt).baseApp.TxStatusTracker())
+}
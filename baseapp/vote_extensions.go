@@ -0,0 +1,243 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VoteExtensionHandler defines the vote extension handlers of a module. They
+// are called by the VoteExtensionRegistry with the vote extension of the module
+// only, i.e. the VoteExtension of the responses of ExtendVote and of the requests
+// of VerifyVoteExtension is the vote extension of the module.
+type VoteExtensionHandler struct {
+	// ExtendVote returns the vote extension of the module, which is omitted
+	// from the composed vote extension if it is empty.
+	ExtendVote sdk.ExtendVoteHandler
+	// VerifyVoteExtension verifies the vote extension of the module, which is
+	// empty if it was omitted by the validator. The vote is rejected if any
+	// module rejects it.
+	VerifyVoteExtension sdk.VerifyVoteExtensionHandler
+
+	// GasLimit is the gas limit of each call to the handlers, unlimited if zero.
+	GasLimit uint64
+	// TimeBudget is the duration ExtendVote may take, unlimited if zero. The
+	// context of the handler is canceled once it is exceeded, and a vote
+	// extension returned after it is dropped. As the verification of the vote
+	// extensions must be deterministic, it only applies to ExtendVote.
+	TimeBudget time.Duration
+}
+
+// VoteExtensionRegistry composes the vote extension handlers registered by the
+// modules into the ExtendVote and VerifyVoteExtension handlers of the app, so
+// that several modules, e.g. an oracle module and a consumer chain module, can
+// extend the votes of the validators. The handlers are called in the order of
+// the names of the modules, and the vote extensions of the modules are composed
+// into a ComposedVoteExtension, which modules can decode with
+// DecodeVoteExtension in PrepareProposal and ProcessProposal.
+//
+// An error, a panic, including an out of gas panic, or an exceeded time budget
+// of the ExtendVote handler of a module only omits the vote extension of the
+// module, while the VerifyVoteExtension handler of every module must accept the
+// vote for it to be accepted.
+type VoteExtensionRegistry struct {
+	modules  []string
+	handlers map[string]VoteExtensionHandler
+}
+
+// NewVoteExtensionRegistry returns an empty VoteExtensionRegistry.
+func NewVoteExtensionRegistry() *VoteExtensionRegistry {
+	return &VoteExtensionRegistry{handlers: make(map[string]VoteExtensionHandler)}
+}
+
+// Register registers the vote extension handlers of a module. It panics if the
+// module name is empty, if a handler is missing, or if the module already
+// registered its handlers.
+func (r *VoteExtensionRegistry) Register(module string, handler VoteExtensionHandler) {
+	if module == "" {
+		panic("cannot register vote extension handlers without module name")
+	}
+	if handler.ExtendVote == nil || handler.VerifyVoteExtension == nil {
+		panic(fmt.Sprintf("missing vote extension handler of module %s", module))
+	}
+	if _, ok := r.handlers[module]; ok {
+		panic(fmt.Sprintf("vote extension handlers of module %s already registered", module))
+	}
+
+	r.handlers[module] = handler
+	r.modules = append(r.modules, module)
+	sort.Strings(r.modules)
+}
+
+// Modules returns the names of the modules which registered vote extension
+// handlers, in the order their handlers are called.
+func (r *VoteExtensionRegistry) Modules() []string {
+	return append([]string(nil), r.modules...)
+}
+
+// ExtendVoteHandler returns the ExtendVote handler composing the vote extensions
+// of the registered modules.
+func (r *VoteExtensionRegistry) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		var composed sdk.ComposedVoteExtension
+		for _, module := range r.modules {
+			ext, err := r.extendVote(ctx, module, req)
+			if err != nil {
+				ctx.Logger().Error("failed to extend vote", "module", module, "height", req.Height, "err", err)
+				continue
+			}
+			if len(ext) > 0 {
+				composed.Extensions = append(composed.Extensions, sdk.ModuleVoteExtension{Module: module, Extension: ext})
+			}
+		}
+
+		bz, err := composed.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the VerifyVoteExtension handler verifying
+// the vote extensions of the registered modules. A vote extension which is not
+// a canonically encoded ComposedVoteExtension, or which holds the vote
+// extension of a module without handlers, is rejected.
+func (r *VoteExtensionRegistry) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		extensions, err := DecodeVoteExtension(req.VoteExtension)
+		if err != nil {
+			return nil, err
+		}
+		for module := range extensions {
+			if _, ok := r.handlers[module]; !ok {
+				return nil, fmt.Errorf("vote extension of unknown module %s", module)
+			}
+		}
+
+		for _, module := range r.modules {
+			status, err := r.verifyVoteExtension(ctx, module, req, extensions[module])
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "module %s", module)
+			}
+			if status != abci.ResponseVerifyVoteExtension_ACCEPT {
+				return &abci.ResponseVerifyVoteExtension{Status: status}, nil
+			}
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// extendVote calls the ExtendVote handler of the module within its gas limit and
+// time budget, on a branch of the state discarded afterwards.
+func (r *VoteExtensionRegistry) extendVote(ctx sdk.Context, module string, req *abci.RequestExtendVote) (ext []byte, err error) {
+	handler := r.handlers[module]
+	ctx = withGasLimit(ctx, handler.GasLimit)
+	if handler.TimeBudget > 0 {
+		goCtx, cancel := context.WithTimeout(ctx.Context(), handler.TimeBudget)
+		defer cancel()
+		ctx = ctx.WithContext(goCtx)
+	}
+	defer recoverVoteExtensionPanic(&err)
+
+	start := time.Now()
+	resp, err := handler.ExtendVote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if elapsed := time.Since(start); handler.TimeBudget > 0 && elapsed > handler.TimeBudget {
+		return nil, fmt.Errorf("took %s, above the time budget of %s", elapsed, handler.TimeBudget)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.VoteExtension, nil
+}
+
+// verifyVoteExtension calls the VerifyVoteExtension handler of the module with
+// the vote extension of the module, within its gas limit, on a branch of the
+// state discarded afterwards.
+func (r *VoteExtensionRegistry) verifyVoteExtension(
+	ctx sdk.Context, module string, req *abci.RequestVerifyVoteExtension, ext []byte,
+) (status abci.ResponseVerifyVoteExtension_VerifyStatus, err error) {
+	handler := r.handlers[module]
+	ctx = withGasLimit(ctx, handler.GasLimit)
+	defer recoverVoteExtensionPanic(&err)
+
+	moduleReq := *req
+	moduleReq.VoteExtension = ext
+	resp, err := handler.VerifyVoteExtension(ctx, &moduleReq)
+	if err != nil {
+		return abci.ResponseVerifyVoteExtension_REJECT, err
+	}
+	if resp == nil {
+		return abci.ResponseVerifyVoteExtension_REJECT, nil
+	}
+	return resp.Status, nil
+}
+
+// withGasLimit returns a branch of the context metering its gas up to the limit,
+// or without limit if it is zero.
+func withGasLimit(ctx sdk.Context, limit uint64) sdk.Context {
+	ctx, _ = ctx.CacheContext()
+	if limit == 0 {
+		return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+	return ctx.WithGasMeter(storetypes.NewGasMeter(limit))
+}
+
+// recoverVoteExtensionPanic turns a panic of a vote extension handler, e.g. an
+// out of gas panic, into an error.
+func recoverVoteExtensionPanic(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+		*err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		return
+	}
+	*err = fmt.Errorf("recovered panic: %v", r)
+}
+
+// DecodeVoteExtension decodes a vote extension composed by the
+// VoteExtensionRegistry into the vote extensions of the modules by module name.
+// An empty vote extension decodes to no vote extension. It returns an error if
+// the vote extension is not canonically encoded, i.e. if the modules are not
+// ordered by name, are repeated, have empty vote extensions, or if the encoding
+// differs from the one of ExtendVote.
+func DecodeVoteExtension(bz []byte) (map[string][]byte, error) {
+	var composed sdk.ComposedVoteExtension
+	if err := composed.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid composed vote extension")
+	}
+
+	extensions := make(map[string][]byte, len(composed.Extensions))
+	for i, ext := range composed.Extensions {
+		if i > 0 && ext.Module <= composed.Extensions[i-1].Module {
+			return nil, fmt.Errorf("vote extension of module %s is not ordered by module name", ext.Module)
+		}
+		if len(ext.Extension) == 0 {
+			return nil, fmt.Errorf("empty vote extension of module %s", ext.Module)
+		}
+		extensions[ext.Module] = ext.Extension
+	}
+
+	canonical, err := composed.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(canonical, bz) {
+		return nil, fmt.Errorf("composed vote extension is not canonically encoded")
+	}
+	return extensions, nil
+}
@@ -0,0 +1,150 @@
+package baseapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// staticVoteExtensionHandler returns handlers extending the votes with ext and
+// accepting only the vote extension ext
+func staticVoteExtensionHandler(ext string) baseapp.VoteExtensionHandler {
+	return baseapp.VoteExtensionHandler{
+		ExtendVote: func(sdk.Context, *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+			return &abci.ResponseExtendVote{VoteExtension: []byte(ext)}, nil
+		},
+		VerifyVoteExtension: func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+			if string(req.VoteExtension) != ext {
+				return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+			}
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		},
+	}
+}
+
+func newVoteExtensionContext() sdk.Context {
+	return testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+}
+
+func TestVoteExtensionRegistry_Compose(t *testing.T) {
+	registry := baseapp.NewVoteExtensionRegistry()
+	registry.Register("oracle", staticVoteExtensionHandler("price"))
+	registry.Register("ccv", staticVoteExtensionHandler("slash"))
+	require.Equal(t, []string{"ccv", "oracle"}, registry.Modules())
+	require.Panics(t, func() { registry.Register("oracle", staticVoteExtensionHandler("price")) })
+	require.Panics(t, func() { registry.Register("other", baseapp.VoteExtensionHandler{}) })
+
+	ctx := newVoteExtensionContext()
+	resp, err := registry.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+
+	// the vote extensions are ordered by module name
+	var composed sdk.ComposedVoteExtension
+	require.NoError(t, composed.Unmarshal(resp.VoteExtension))
+	require.Equal(t, []sdk.ModuleVoteExtension{
+		{Module: "ccv", Extension: []byte("slash")},
+		{Module: "oracle", Extension: []byte("price")},
+	}, composed.Extensions)
+
+	extensions, err := baseapp.DecodeVoteExtension(resp.VoteExtension)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"ccv": []byte("slash"), "oracle": []byte("price")}, extensions)
+
+	verifyResp, err := registry.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: resp.VoteExtension})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyResp.Status)
+}
+
+func TestVoteExtensionRegistry_Budgets(t *testing.T) {
+	registry := baseapp.NewVoteExtensionRegistry()
+	registry.Register("oracle", staticVoteExtensionHandler("price"))
+
+	outOfGas := staticVoteExtensionHandler("gas")
+	outOfGas.GasLimit = 10
+	outOfGas.ExtendVote = func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		ctx.GasMeter().ConsumeGas(11, "test")
+		return &abci.ResponseExtendVote{VoteExtension: []byte("gas")}, nil
+	}
+	registry.Register("gas", outOfGas)
+
+	slow := staticVoteExtensionHandler("slow")
+	slow.TimeBudget = time.Millisecond
+	slow.ExtendVote = func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		<-ctx.Context().Done()
+		return &abci.ResponseExtendVote{VoteExtension: []byte("slow")}, nil
+	}
+	registry.Register("slow", slow)
+
+	failing := staticVoteExtensionHandler("failing")
+	failing.ExtendVote = func(sdk.Context, *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		return nil, errors.New("failed")
+	}
+	registry.Register("failing", failing)
+
+	panicking := staticVoteExtensionHandler("panicking")
+	panicking.ExtendVote = func(sdk.Context, *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		panic("panicked")
+	}
+	registry.Register("panicking", panicking)
+
+	// only the vote extensions of the handlers within their budgets are kept
+	ctx := newVoteExtensionContext()
+	resp, err := registry.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+	extensions, err := baseapp.DecodeVoteExtension(resp.VoteExtension)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"oracle": []byte("price")}, extensions)
+
+	// the omitted vote extensions are rejected by the verifiers expecting them
+	verifyResp, err := registry.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: resp.VoteExtension})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyResp.Status)
+}
+
+func TestVoteExtensionRegistry_Verify(t *testing.T) {
+	registry := baseapp.NewVoteExtensionRegistry()
+	registry.Register("ccv", staticVoteExtensionHandler("slash"))
+	registry.Register("oracle", staticVoteExtensionHandler("price"))
+	verify := registry.VerifyVoteExtensionHandler()
+	ctx := newVoteExtensionContext()
+
+	marshal := func(extensions ...sdk.ModuleVoteExtension) []byte {
+		bz, err := (&sdk.ComposedVoteExtension{Extensions: extensions}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name      string
+		ext       []byte
+		expStatus abci.ResponseVerifyVoteExtension_VerifyStatus
+		expErr    bool
+	}{
+		{"valid", marshal(sdk.ModuleVoteExtension{Module: "ccv", Extension: []byte("slash")}, sdk.ModuleVoteExtension{Module: "oracle", Extension: []byte("price")}), abci.ResponseVerifyVoteExtension_ACCEPT, false},
+		{"rejected by a module", marshal(sdk.ModuleVoteExtension{Module: "ccv", Extension: []byte("slash")}, sdk.ModuleVoteExtension{Module: "oracle", Extension: []byte("other")}), abci.ResponseVerifyVoteExtension_REJECT, false},
+		{"not ordered", marshal(sdk.ModuleVoteExtension{Module: "oracle", Extension: []byte("price")}, sdk.ModuleVoteExtension{Module: "ccv", Extension: []byte("slash")}), 0, true},
+		{"unknown module", marshal(sdk.ModuleVoteExtension{Module: "unknown", Extension: []byte("price")}), 0, true},
+		{"empty module vote extension", marshal(sdk.ModuleVoteExtension{Module: "ccv"}), 0, true},
+		{"malformed", []byte("malformed"), 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := verify(ctx, &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: tc.ext})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, resp.Status)
+		})
+	}
+}
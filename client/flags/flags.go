@@ -83,6 +83,7 @@ const (
 	FlagTip              = "tip"
 	FlagAux              = "aux"
 	FlagInitHeight       = "initial-height"
+	FlagWatch            = "watch"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -114,6 +115,12 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	_ = cmd.MarkFlagRequired(FlagChainID)
 }
 
+// AddWatchFlagToCmd adds the --watch flag to a query command whose result can be
+// watched with client.PrintOrWatchQuery.
+func AddWatchFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagWatch, false, "Keep running and print the fields of the result that changed at every new block")
+}
+
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f := cmd.Flags()
@@ -33,6 +33,51 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TxStatus defines the status of a tx in the lifecycle of the mempool of the
+// node.
+type TxStatus int32
+
+const (
+	// TX_STATUS_UNKNOWN defines a tx never received, or no longer tracked, by the
+	// node.
+	TxStatus_TX_STATUS_UNKNOWN TxStatus = 0
+	// TX_STATUS_ACCEPTED defines a tx which passed CheckTx, and is only kept by the
+	// CometBFT mempool as the app-side mempool is a no-op mempool.
+	TxStatus_TX_STATUS_ACCEPTED TxStatus = 1
+	// TX_STATUS_PENDING defines a tx which passed CheckTx and was inserted in the
+	// app-side mempool, waiting to be included in a block.
+	TxStatus_TX_STATUS_PENDING TxStatus = 2
+	// TX_STATUS_EVICTED defines a tx removed from the mempool without being
+	// included in a block, as it is no longer valid.
+	TxStatus_TX_STATUS_EVICTED TxStatus = 3
+	// TX_STATUS_INCLUDED defines a tx included in a block.
+	TxStatus_TX_STATUS_INCLUDED TxStatus = 4
+)
+
+var TxStatus_name = map[int32]string{
+	0: "TX_STATUS_UNKNOWN",
+	1: "TX_STATUS_ACCEPTED",
+	2: "TX_STATUS_PENDING",
+	3: "TX_STATUS_EVICTED",
+	4: "TX_STATUS_INCLUDED",
+}
+
+var TxStatus_value = map[string]int32{
+	"TX_STATUS_UNKNOWN":  0,
+	"TX_STATUS_ACCEPTED": 1,
+	"TX_STATUS_PENDING":  2,
+	"TX_STATUS_EVICTED":  3,
+	"TX_STATUS_INCLUDED": 4,
+}
+
+func (x TxStatus) String() string {
+	return proto.EnumName(TxStatus_name, int32(x))
+}
+
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{0}
+}
+
 // ConfigRequest defines the request structure for the Config gRPC query.
 type ConfigRequest struct {
 }
@@ -246,11 +291,281 @@ func (m *StatusResponse) GetValidatorHash() []byte {
 	return nil
 }
 
+// TxStatusRequest defines the request structure for the TxStatus gRPC query.
+type TxStatusRequest struct {
+	// hash is the hex encoded hash of the tx.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *TxStatusRequest) Reset()         { *m = TxStatusRequest{} }
+func (m *TxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*TxStatusRequest) ProtoMessage()    {}
+func (*TxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{4}
+}
+func (m *TxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusRequest.Merge(m, src)
+}
+func (m *TxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusRequest proto.InternalMessageInfo
+
+func (m *TxStatusRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// TxStatusResponse defines the response structure for the TxStatus gRPC query.
+type TxStatusResponse struct {
+	Status TxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.base.node.v1beta1.TxStatus" json:"status,omitempty"`
+	// height is the height of the block the tx was included in, or the last
+	// committed height when the tx reached any other status.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
+func (m *TxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResponse) ProtoMessage()    {}
+func (*TxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{5}
+}
+func (m *TxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResponse.Merge(m, src)
+}
+func (m *TxStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusResponse proto.InternalMessageInfo
+
+func (m *TxStatusResponse) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatus_TX_STATUS_UNKNOWN
+}
+
+func (m *TxStatusResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RouteStatusRequest defines the request structure for the RouteStatus gRPC
+// query.
+type RouteStatusRequest struct {
+}
+
+func (m *RouteStatusRequest) Reset()         { *m = RouteStatusRequest{} }
+func (m *RouteStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteStatusRequest) ProtoMessage()    {}
+func (*RouteStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{6}
+}
+func (m *RouteStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteStatusRequest.Merge(m, src)
+}
+func (m *RouteStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteStatusRequest proto.InternalMessageInfo
+
+// RouteStatusResponse defines the response structure for the RouteStatus gRPC
+// query.
+type RouteStatusResponse struct {
+	// disabled_msg_type_urls are the type URLs of the Msgs the circuit breaker
+	// currently disables.
+	DisabledMsgTypeUrls []string `protobuf:"bytes,1,rep,name=disabled_msg_type_urls,json=disabledMsgTypeUrls,proto3" json:"disabled_msg_type_urls,omitempty"`
+	// query_gas_limit is the gas limit of the queries served by the node, 0 if
+	// the queries are not limited.
+	QueryGasLimit uint64 `protobuf:"varint,2,opt,name=query_gas_limit,json=queryGasLimit,proto3" json:"query_gas_limit,omitempty"`
+	// gas_exceeded_queries are the query routes which exceeded the query gas
+	// limit.
+	GasExceededQueries []*QueryGasExceeded `protobuf:"bytes,3,rep,name=gas_exceeded_queries,json=gasExceededQueries,proto3" json:"gas_exceeded_queries,omitempty"`
+}
+
+func (m *RouteStatusResponse) Reset()         { *m = RouteStatusResponse{} }
+func (m *RouteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*RouteStatusResponse) ProtoMessage()    {}
+func (*RouteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{7}
+}
+func (m *RouteStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteStatusResponse.Merge(m, src)
+}
+func (m *RouteStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteStatusResponse proto.InternalMessageInfo
+
+func (m *RouteStatusResponse) GetDisabledMsgTypeUrls() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *RouteStatusResponse) GetQueryGasLimit() uint64 {
+	if m != nil {
+		return m.QueryGasLimit
+	}
+	return 0
+}
+
+func (m *RouteStatusResponse) GetGasExceededQueries() []*QueryGasExceeded {
+	if m != nil {
+		return m.GasExceededQueries
+	}
+	return nil
+}
+
+// QueryGasExceeded defines a query route which exceeded the query gas limit of
+// the node.
+type QueryGasExceeded struct {
+	// path is the full method name of the query route.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// height is the last committed height when the query route last exceeded the
+	// gas limit.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryGasExceeded) Reset()         { *m = QueryGasExceeded{} }
+func (m *QueryGasExceeded) String() string { return proto.CompactTextString(m) }
+func (*QueryGasExceeded) ProtoMessage()    {}
+func (*QueryGasExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{8}
+}
+func (m *QueryGasExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasExceeded.Merge(m, src)
+}
+func (m *QueryGasExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasExceeded proto.InternalMessageInfo
+
+func (m *QueryGasExceeded) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryGasExceeded) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.base.node.v1beta1.TxStatus", TxStatus_name, TxStatus_value)
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
 	proto.RegisterType((*StatusRequest)(nil), "cosmos.base.node.v1beta1.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "cosmos.base.node.v1beta1.StatusResponse")
+	proto.RegisterType((*TxStatusRequest)(nil), "cosmos.base.node.v1beta1.TxStatusRequest")
+	proto.RegisterType((*TxStatusResponse)(nil), "cosmos.base.node.v1beta1.TxStatusResponse")
+	proto.RegisterType((*RouteStatusRequest)(nil), "cosmos.base.node.v1beta1.RouteStatusRequest")
+	proto.RegisterType((*RouteStatusResponse)(nil), "cosmos.base.node.v1beta1.RouteStatusResponse")
+	proto.RegisterType((*QueryGasExceeded)(nil), "cosmos.base.node.v1beta1.QueryGasExceeded")
 }
 
 func init() {
@@ -258,39 +573,59 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x1c, 0xc5, 0x9b, 0xfe, 0xfa, 0xeb, 0x98, 0x61, 0x1d, 0xcb, 0x00, 0x95, 0x0a, 0x65, 0x55, 0x05,
-	0xa2, 0x20, 0xcd, 0xd6, 0xca, 0x9d, 0xc3, 0x38, 0x6c, 0x88, 0x0b, 0x4a, 0x39, 0x71, 0x89, 0xdc,
-	0xf4, 0xbb, 0xc4, 0x5a, 0x62, 0x7b, 0xb6, 0x53, 0x89, 0x2b, 0x12, 0xf7, 0x49, 0x1c, 0x10, 0xff,
-	0x11, 0xc7, 0x49, 0x5c, 0x38, 0x01, 0x6a, 0xf9, 0x43, 0x50, 0x6c, 0x67, 0xa8, 0x87, 0x0d, 0x4e,
-	0xb1, 0xdf, 0xfb, 0xd8, 0x79, 0x7a, 0x5f, 0xa3, 0x87, 0xa9, 0xd0, 0xa5, 0xd0, 0x64, 0x46, 0x35,
-	0x10, 0x2e, 0xe6, 0x40, 0x16, 0x07, 0x33, 0x30, 0xf4, 0x80, 0x9c, 0x55, 0xa0, 0xde, 0x61, 0xa9,
-	0x84, 0x11, 0x61, 0xdf, 0x51, 0xb8, 0xa6, 0x70, 0x4d, 0x61, 0x4f, 0x0d, 0x1e, 0x64, 0x42, 0x64,
-	0x05, 0x10, 0x2a, 0x19, 0xa1, 0x9c, 0x0b, 0x43, 0x0d, 0x13, 0x5c, 0xbb, 0x73, 0x83, 0x3d, 0xef,
-	0xda, 0xdd, 0xac, 0x3a, 0x21, 0x86, 0x95, 0xa0, 0x0d, 0x2d, 0xa5, 0x07, 0xee, 0x64, 0x22, 0x13,
-	0x76, 0x49, 0xea, 0x95, 0x53, 0x47, 0xdb, 0x68, 0xeb, 0x85, 0xe0, 0x27, 0x2c, 0x8b, 0xe1, 0xac,
-	0x02, 0x6d, 0x46, 0x9f, 0x02, 0xd4, 0x6b, 0x14, 0x2d, 0x05, 0xd7, 0x10, 0x3e, 0x45, 0x3b, 0x25,
-	0xe3, 0xac, 0xac, 0xca, 0x24, 0xa3, 0x3a, 0x91, 0x8a, 0xa5, 0xd0, 0x0f, 0x86, 0xc1, 0x78, 0x33,
-	0xde, 0xf6, 0xc6, 0x11, 0xd5, 0xaf, 0x6b, 0x39, 0xc4, 0x68, 0x57, 0xaa, 0x8a, 0x33, 0x9e, 0x25,
-	0xa7, 0x00, 0x32, 0x51, 0x90, 0x02, 0x37, 0xfd, 0xb6, 0xa5, 0x77, 0xbc, 0xf5, 0x0a, 0x40, 0xc6,
-	0xd6, 0x08, 0x9f, 0xa0, 0xdb, 0x0d, 0xcf, 0xb8, 0x01, 0xb5, 0xa0, 0x45, 0xff, 0x3f, 0x77, 0xb5,
-	0xd7, 0x5f, 0x7a, 0xb9, 0x8e, 0x3a, 0x35, 0xd4, 0x54, 0xba, 0x89, 0xfa, 0x3d, 0x40, 0xbd, 0x46,
-	0xf1, 0x51, 0x27, 0xe8, 0x2e, 0x50, 0x55, 0x30, 0xd0, 0x26, 0xd1, 0x46, 0x28, 0x48, 0x72, 0x60,
-	0x59, 0x6e, 0x6c, 0xdc, 0x4e, 0xbc, 0xdb, 0x98, 0xd3, 0xda, 0x3b, 0xb6, 0x56, 0x78, 0x0f, 0x75,
-	0x3d, 0xd4, 0xb6, 0x90, 0xdf, 0x85, 0xcf, 0xd1, 0xe6, 0x65, 0x87, 0x36, 0xd3, 0xcd, 0xc9, 0x00,
-	0xbb, 0x96, 0x71, 0xd3, 0x32, 0x7e, 0xd3, 0x10, 0x87, 0x9d, 0xf3, 0x1f, 0x7b, 0x41, 0xfc, 0xe7,
-	0x48, 0x78, 0x1f, 0xdd, 0xa0, 0x52, 0x26, 0x39, 0xd5, 0x79, 0xbf, 0x33, 0x0c, 0xc6, 0xb7, 0xe2,
-	0x0d, 0x2a, 0xe5, 0x31, 0xd5, 0x79, 0xf8, 0x08, 0xf5, 0x16, 0xb4, 0x60, 0x73, 0x6a, 0x84, 0x72,
-	0xc0, 0xff, 0x16, 0xd8, 0xba, 0x54, 0x6b, 0x6c, 0xf2, 0xb9, 0x8d, 0x36, 0xa6, 0xa0, 0x16, 0x75,
-	0xb1, 0x1f, 0x02, 0xd4, 0x75, 0x73, 0x09, 0x1f, 0xe3, 0xab, 0xde, 0x08, 0x5e, 0x9b, 0xe5, 0x60,
-	0xfc, 0x77, 0xd0, 0xf5, 0x36, 0x1a, 0xbf, 0xff, 0xfa, 0xeb, 0x63, 0x7b, 0x14, 0x0e, 0xc9, 0x95,
-	0x8f, 0x34, 0x75, 0x3f, 0xaf, 0x73, 0xb8, 0xd2, 0xaf, 0xcb, 0xb1, 0x36, 0xa8, 0xeb, 0x72, 0xac,
-	0xcf, 0xef, 0x5f, 0x72, 0x68, 0x7b, 0xe2, 0xf0, 0xe8, 0xcb, 0x32, 0x0a, 0x2e, 0x96, 0x51, 0xf0,
-	0x73, 0x19, 0x05, 0xe7, 0xab, 0xa8, 0x75, 0xb1, 0x8a, 0x5a, 0xdf, 0x56, 0x51, 0xeb, 0xed, 0x7e,
-	0xc6, 0x4c, 0x5e, 0xcd, 0x70, 0x2a, 0xca, 0xe6, 0x16, 0xf7, 0xd9, 0xd7, 0xf3, 0x53, 0x92, 0x16,
-	0x0c, 0xb8, 0x21, 0x99, 0x92, 0xa9, 0xbd, 0x77, 0xd6, 0xb5, 0xb3, 0x7c, 0xf6, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x89, 0x73, 0x23, 0x6e, 0x9f, 0x03, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6b, 0xdb, 0x46,
+	0x1c, 0xc7, 0xa3, 0xd8, 0x4b, 0x9b, 0xcb, 0x92, 0x38, 0x97, 0x34, 0x78, 0x66, 0xb8, 0x41, 0xac,
+	0x9d, 0x6b, 0x1a, 0x89, 0xba, 0x6f, 0x7b, 0x28, 0xb4, 0x8e, 0x49, 0x43, 0x3b, 0x2f, 0x95, 0x9d,
+	0x6d, 0x8c, 0x81, 0x38, 0xcb, 0xbf, 0xc8, 0x47, 0x25, 0xdd, 0x55, 0x77, 0x0a, 0x09, 0x63, 0x0c,
+	0x06, 0x7b, 0x2f, 0x8c, 0x31, 0xd8, 0x5f, 0xb4, 0xa7, 0x11, 0xd8, 0xcb, 0x9e, 0xb6, 0x91, 0xec,
+	0x0f, 0x19, 0x77, 0x3a, 0x25, 0x55, 0x86, 0x96, 0x3c, 0xf9, 0xf4, 0xfd, 0x7d, 0xee, 0xe7, 0xaf,
+	0xbe, 0x77, 0x3f, 0xa1, 0x8f, 0x02, 0x26, 0x62, 0x26, 0xdc, 0x09, 0x11, 0xe0, 0x26, 0x6c, 0x0a,
+	0xee, 0xd1, 0xa3, 0x09, 0x48, 0xf2, 0xc8, 0x7d, 0x93, 0x41, 0x7a, 0xe2, 0xf0, 0x94, 0x49, 0x86,
+	0x9b, 0x39, 0xe5, 0x28, 0xca, 0x51, 0x94, 0x63, 0xa8, 0xd6, 0x87, 0x21, 0x63, 0x61, 0x04, 0x2e,
+	0xe1, 0xd4, 0x25, 0x49, 0xc2, 0x24, 0x91, 0x94, 0x25, 0x22, 0xdf, 0xd7, 0xba, 0x6b, 0xaa, 0xfa,
+	0x69, 0x92, 0x1d, 0xba, 0x92, 0xc6, 0x20, 0x24, 0x89, 0xb9, 0x01, 0x36, 0x42, 0x16, 0x32, 0xbd,
+	0x74, 0xd5, 0x2a, 0x57, 0xed, 0x55, 0xb4, 0xdc, 0x67, 0xc9, 0x21, 0x0d, 0x3d, 0x78, 0x93, 0x81,
+	0x90, 0xf6, 0xcf, 0x16, 0x5a, 0x29, 0x14, 0xc1, 0x59, 0x22, 0x00, 0x77, 0xd1, 0x5a, 0x4c, 0x13,
+	0x1a, 0x67, 0xb1, 0x1f, 0x12, 0xe1, 0xf3, 0x94, 0x06, 0xd0, 0xb4, 0xb6, 0xac, 0xce, 0xa2, 0xb7,
+	0x6a, 0x0a, 0xbb, 0x44, 0xec, 0x2b, 0x19, 0x3b, 0x68, 0x9d, 0xa7, 0x59, 0x42, 0x93, 0xd0, 0x7f,
+	0x0d, 0xc0, 0xfd, 0x14, 0x02, 0x48, 0x64, 0x73, 0x5e, 0xd3, 0x6b, 0xa6, 0xf4, 0x02, 0x80, 0x7b,
+	0xba, 0x80, 0x1f, 0xa0, 0x46, 0xc1, 0xd3, 0x44, 0x42, 0x7a, 0x44, 0xa2, 0x66, 0x2d, 0x6f, 0x6d,
+	0xf4, 0x3d, 0x23, 0x2b, 0xab, 0x23, 0x49, 0x64, 0x26, 0x0a, 0xab, 0x7f, 0x5a, 0x68, 0xa5, 0x50,
+	0x8c, 0xd5, 0x1e, 0xba, 0x03, 0x24, 0x8d, 0x28, 0x08, 0xe9, 0x0b, 0xc9, 0x52, 0xf0, 0x67, 0x40,
+	0xc3, 0x99, 0xd4, 0x76, 0xeb, 0xde, 0x7a, 0x51, 0x1c, 0xa9, 0xda, 0x73, 0x5d, 0xc2, 0x9b, 0x68,
+	0xc1, 0x40, 0xf3, 0x1a, 0x32, 0x4f, 0xf8, 0x09, 0x5a, 0xbc, 0xc8, 0x50, 0x7b, 0x5a, 0xea, 0xb5,
+	0x9c, 0x3c, 0x65, 0xa7, 0x48, 0xd9, 0x19, 0x17, 0xc4, 0xb3, 0xfa, 0xdb, 0xbf, 0xee, 0x5a, 0xde,
+	0xe5, 0x16, 0xfc, 0x01, 0xba, 0x4d, 0x38, 0xf7, 0x67, 0x44, 0xcc, 0x9a, 0xf5, 0x2d, 0xab, 0xf3,
+	0xbe, 0x77, 0x8b, 0x70, 0xfe, 0x9c, 0x88, 0x19, 0xbe, 0x87, 0x56, 0x8e, 0x48, 0x44, 0xa7, 0x44,
+	0xb2, 0x34, 0x07, 0xde, 0xd3, 0xc0, 0xf2, 0x85, 0xaa, 0x30, 0xfb, 0x1e, 0x5a, 0x1d, 0x1f, 0x97,
+	0xde, 0x19, 0x63, 0x54, 0xd7, 0x7c, 0x1e, 0xbf, 0x5e, 0xdb, 0x87, 0xa8, 0x31, 0x3e, 0xbe, 0x12,
+	0xc4, 0x27, 0x68, 0x41, 0x68, 0x45, 0x93, 0x2b, 0x3d, 0xdb, 0xa9, 0xba, 0x57, 0xce, 0xc5, 0x5e,
+	0xb3, 0xe3, 0x4a, 0x20, 0xb5, 0x22, 0x10, 0x7b, 0x03, 0x61, 0x8f, 0x65, 0x12, 0xca, 0xa7, 0x70,
+	0x6a, 0xa1, 0xf5, 0x92, 0x6c, 0x1c, 0x3c, 0x46, 0x9b, 0x53, 0x2a, 0xc8, 0x24, 0x82, 0xa9, 0x1f,
+	0x8b, 0xd0, 0x97, 0x27, 0x1c, 0xfc, 0x2c, 0x8d, 0x94, 0xa3, 0x5a, 0x67, 0xd1, 0x5b, 0x2f, 0xaa,
+	0x9f, 0x8a, 0x70, 0x7c, 0xc2, 0xe1, 0x20, 0x8d, 0x04, 0xbe, 0x8f, 0x56, 0xf5, 0x30, 0xe8, 0x8b,
+	0x16, 0xd1, 0x98, 0x16, 0x87, 0xb2, 0xac, 0xe5, 0x5d, 0x22, 0x5e, 0x2a, 0x11, 0x7f, 0x8d, 0x36,
+	0x14, 0x01, 0xc7, 0x01, 0xc0, 0x14, 0xa6, 0xbe, 0xaa, 0x52, 0x10, 0xcd, 0xda, 0x56, 0xad, 0xb3,
+	0xd4, 0xeb, 0x56, 0xbf, 0xec, 0x2b, 0xd3, 0x66, 0x60, 0x76, 0x7a, 0x38, 0xbc, 0x7c, 0x78, 0x95,
+	0x77, 0xb1, 0x9f, 0xa0, 0xc6, 0x55, 0x4e, 0x05, 0xcf, 0x89, 0xbc, 0x08, 0x5e, 0xad, 0xab, 0x82,
+	0xea, 0x7e, 0x87, 0x6e, 0x17, 0xa1, 0xe2, 0x3b, 0x68, 0x6d, 0xfc, 0xa5, 0x3f, 0x1a, 0x3f, 0x1d,
+	0x1f, 0x8c, 0xfc, 0x83, 0xe1, 0x8b, 0xe1, 0x67, 0x5f, 0x0c, 0x1b, 0x73, 0x78, 0x13, 0xe1, 0x4b,
+	0xf9, 0x69, 0xbf, 0x3f, 0xd8, 0x1f, 0x0f, 0x76, 0x1a, 0x56, 0x19, 0xdf, 0x1f, 0x0c, 0x77, 0xf6,
+	0x86, 0xbb, 0x8d, 0xf9, 0xb2, 0x3c, 0xf8, 0x7c, 0xaf, 0xaf, 0xe8, 0x5a, 0xb9, 0xcb, 0xde, 0xb0,
+	0xff, 0xf2, 0x60, 0x67, 0xb0, 0xd3, 0xa8, 0xf7, 0x7e, 0xab, 0xa3, 0x5b, 0x23, 0x48, 0x8f, 0xd4,
+	0x44, 0xfe, 0x60, 0xa1, 0x85, 0x7c, 0xa0, 0xf1, 0xc7, 0xd5, 0xb9, 0x94, 0x3e, 0x02, 0xad, 0xce,
+	0xf5, 0x60, 0x7e, 0xca, 0x76, 0xe7, 0xfb, 0xdf, 0xff, 0xf9, 0x71, 0xde, 0xc6, 0x5b, 0x6e, 0xe5,
+	0xd7, 0x2d, 0xc8, 0xff, 0x5c, 0xf9, 0x30, 0x99, 0xfc, 0x8f, 0x8f, 0xd2, 0xdd, 0x6a, 0x75, 0xae,
+	0x07, 0x6f, 0xee, 0xc3, 0xdc, 0xee, 0x9f, 0xac, 0x77, 0x4e, 0xe7, 0xc1, 0x0d, 0xc6, 0xc2, 0x78,
+	0xe9, 0xde, 0x04, 0x35, 0x6e, 0x7a, 0xda, 0xcd, 0x43, 0xdc, 0xad, 0x76, 0x23, 0x8f, 0xfd, 0xdc,
+	0x90, 0xfb, 0x8d, 0x1a, 0xe2, 0x6f, 0xf1, 0x2f, 0x16, 0x5a, 0x7a, 0x67, 0x8e, 0xf0, 0xc3, 0xea,
+	0xff, 0xfb, 0xef, 0x14, 0xb6, 0xb6, 0x6f, 0x48, 0x1b, 0x83, 0x8e, 0x36, 0xd8, 0xc1, 0xf7, 0xab,
+	0x0d, 0xa6, 0x6a, 0x9b, 0xf1, 0xf8, 0x6c, 0xf7, 0xd7, 0xb3, 0xb6, 0x75, 0x7a, 0xd6, 0xb6, 0xfe,
+	0x3e, 0x6b, 0x5b, 0x6f, 0xcf, 0xdb, 0x73, 0xa7, 0xe7, 0xed, 0xb9, 0x3f, 0xce, 0xdb, 0x73, 0x5f,
+	0x6d, 0x87, 0x54, 0xce, 0xb2, 0x89, 0x13, 0xb0, 0xb8, 0xe8, 0x95, 0xff, 0x6c, 0x8b, 0xe9, 0x6b,
+	0x37, 0x88, 0x28, 0x24, 0xd2, 0x0d, 0x53, 0x1e, 0xe8, 0xee, 0x93, 0x05, 0xfd, 0xe5, 0x7c, 0xfc,
+	0xef, 0x00, 0xf6, 0x2f, 0x24, 0x9c, 0x0d, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -309,6 +644,14 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// TxStatus queries for the status of a tx in the lifecycle of the mempool of
+	// the node. Unlike the tx index, it also reports the txs which are pending or
+	// were evicted from the mempool.
+	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// RouteStatus queries for the Msg routes currently disabled by the circuit
+	// breaker, and the query routes which exceeded the query gas limit of the
+	// node since it started.
+	RouteStatus(ctx context.Context, in *RouteStatusRequest, opts ...grpc.CallOption) (*RouteStatusResponse, error)
 }
 
 type serviceClient struct {
@@ -337,12 +680,38 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/TxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RouteStatus(ctx context.Context, in *RouteStatusRequest, opts ...grpc.CallOption) (*RouteStatusResponse, error) {
+	out := new(RouteStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/RouteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// TxStatus queries for the status of a tx in the lifecycle of the mempool of
+	// the node. Unlike the tx index, it also reports the txs which are pending or
+	// were evicted from the mempool.
+	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// RouteStatus queries for the Msg routes currently disabled by the circuit
+	// breaker, and the query routes which exceeded the query gas limit of the
+	// node since it started.
+	RouteStatus(context.Context, *RouteStatusRequest) (*RouteStatusResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -355,6 +724,12 @@ func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigReques
 func (*UnimplementedServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedServiceServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (*UnimplementedServiceServer) RouteStatus(ctx context.Context, req *RouteStatusRequest) (*RouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteStatus not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -396,6 +771,42 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/TxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxStatus(ctx, req.(*TxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/RouteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RouteStatus(ctx, req.(*RouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -408,6 +819,14 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Service_TxStatus_Handler,
+		},
+		{
+			MethodName: "RouteStatus",
+			Handler:    _Service_RouteStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -560,50 +979,222 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *TxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MinimumGasPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PruningKeepRecent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PruningInterval)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasExceededQueries) > 0 {
+		for iNdEx := len(m.GasExceededQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasExceededQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.QueryGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinimumGasPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PruningKeepRecent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PruningInterval)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -637,6 +1228,83 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *TxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RouteStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RouteStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for _, s := range m.DisabledMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.QueryGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.QueryGasLimit))
+	}
+	if len(m.GasExceededQueries) > 0 {
+		for _, e := range m.GasExceededQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGasExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1081,6 +1749,462 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrls = append(m.DisabledMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryGasLimit", wireType)
+			}
+			m.QueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasExceededQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasExceededQueries = append(m.GasExceededQueries, &QueryGasExceeded{})
+			if err := m.GasExceededQueries[len(m.GasExceededQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,18 +2,34 @@ package node
 
 import (
 	context "context"
+	"encoding/hex"
+	"sort"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
+// RouteStatusProvider reports the status of the Msg and query routes of the
+// app, as implemented by BaseApp.
+type RouteStatusProvider interface {
+	DisabledMsgRoutes(ctx sdk.Context) []string
+	QueryGasLimit() uint64
+	QueryGasExceededRoutes() map[string]int64
+}
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+// The tracker of the status of the txs seen by the mempool may be nil if the
+// tracking is disabled, and the provider of the status of the routes may be nil
+// if the app does not report it.
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, txStatuses *mempool.TxStatusTracker, routes RouteStatusProvider) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, txStatuses, routes))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -25,13 +41,18 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 var _ ServiceServer = queryServer{}
 
 type queryServer struct {
-	clientCtx client.Context
-	cfg       config.Config
+	clientCtx  client.Context
+	cfg        config.Config
+	txStatuses *mempool.TxStatusTracker
+	routes     RouteStatusProvider
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
+func NewQueryServer(clientCtx client.Context, cfg config.Config, txStatuses *mempool.TxStatusTracker, routes RouteStatusProvider) ServiceServer {
 	return queryServer{
-		clientCtx: clientCtx,
+		clientCtx:  clientCtx,
+		cfg:        cfg,
+		txStatuses: txStatuses,
+		routes:     routes,
 	}
 }
 
@@ -61,3 +82,48 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) TxStatus(_ context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if s.txStatuses == nil {
+		return nil, status.Error(codes.Unimplemented, "tx status tracking is disabled")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", err)
+	}
+
+	record, _ := s.txStatuses.Get(hash)
+	return &TxStatusResponse{
+		Status: TxStatus(record.Status),
+		Height: record.Height,
+	}, nil
+}
+
+func (s queryServer) RouteStatus(ctx context.Context, _ *RouteStatusRequest) (*RouteStatusResponse, error) {
+	if s.routes == nil {
+		return nil, status.Error(codes.Unimplemented, "route status reporting is not supported by the app")
+	}
+
+	exceeded := s.routes.QueryGasExceededRoutes()
+	paths := make([]string, 0, len(exceeded))
+	for path := range exceeded {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	gasExceeded := make([]*QueryGasExceeded, len(paths))
+	for i, path := range paths {
+		gasExceeded[i] = &QueryGasExceeded{Path: path, Height: exceeded[path]}
+	}
+
+	return &RouteStatusResponse{
+		DisabledMsgTypeUrls: s.routes.DisabledMsgRoutes(sdk.UnwrapSDKContext(ctx)),
+		QueryGasLimit:       s.routes.QueryGasLimit(),
+		GasExceededQueries:  gasExceeded,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,10 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 func TestServiceServer_Config(t *testing.T) {
-	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), nil, nil)
 	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
 
 	resp, err := svr.Config(ctx, &ConfigRequest{})
@@ -19,3 +21,54 @@ func TestServiceServer_Config(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 }
+
+func TestServiceServer_TxStatus(t *testing.T) {
+	txStatuses := mempool.NewTxStatusTracker(10)
+	txStatuses.Track([]byte{0x01, 0x02}, mempool.TxStatusIncluded, 5)
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), txStatuses, nil)
+
+	resp, err := svr.TxStatus(context.Background(), &TxStatusRequest{Hash: "0102"})
+	require.NoError(t, err)
+	require.Equal(t, &TxStatusResponse{Status: TxStatus_TX_STATUS_INCLUDED, Height: 5}, resp)
+
+	// a tx never received by the node is unknown
+	resp, err = svr.TxStatus(context.Background(), &TxStatusRequest{Hash: "0103"})
+	require.NoError(t, err)
+	require.Equal(t, TxStatus_TX_STATUS_UNKNOWN, resp.Status)
+
+	_, err = svr.TxStatus(context.Background(), &TxStatusRequest{Hash: "xyz"})
+	require.Error(t, err)
+
+	_, err = NewQueryServer(client.Context{}, *config.DefaultConfig(), nil, nil).TxStatus(context.Background(), &TxStatusRequest{Hash: "0102"})
+	require.Error(t, err)
+}
+
+type mockRouteStatusProvider struct{}
+
+func (mockRouteStatusProvider) DisabledMsgRoutes(sdk.Context) []string {
+	return []string{"/cosmos.bank.v1beta1.MsgSend"}
+}
+
+func (mockRouteStatusProvider) QueryGasLimit() uint64 { return 1000 }
+
+func (mockRouteStatusProvider) QueryGasExceededRoutes() map[string]int64 {
+	return map[string]int64{"/cosmos.bank.v1beta1.Query/TotalSupply": 7, "/cosmos.bank.v1beta1.Query/AllBalances": 5}
+}
+
+func TestServiceServer_RouteStatus(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), nil, mockRouteStatusProvider{})
+
+	resp, err := svr.RouteStatus(sdk.Context{}, &RouteStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &RouteStatusResponse{
+		DisabledMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		QueryGasLimit:       1000,
+		GasExceededQueries: []*QueryGasExceeded{
+			{Path: "/cosmos.bank.v1beta1.Query/AllBalances", Height: 5},
+			{Path: "/cosmos.bank.v1beta1.Query/TotalSupply", Height: 7},
+		},
+	}, resp)
+
+	_, err = NewQueryServer(client.Context{}, *config.DefaultConfig(), nil, nil).RouteStatus(sdk.Context{}, &RouteStatusRequest{})
+	require.Error(t, err)
+}
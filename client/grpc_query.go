@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -122,9 +123,18 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	return nil
 }
 
-// NewStream implements the grpc ClientConn.NewStream method
-func (Context) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
-	return nil, fmt.Errorf("streaming rpc not supported")
+// NewStream implements the grpc ClientConn.NewStream method. Streaming RPCs
+// are only supported through the gRPC client of the context, as they cannot be
+// run as ABCI queries.
+func (ctx Context) NewStream(grpcCtx gocontext.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if ctx.GRPCClient == nil {
+		return nil, fmt.Errorf("streaming rpc not supported, a gRPC endpoint must be set with --%s", flags.FlagGRPC)
+	}
+
+	if ctx.Height > 0 {
+		grpcCtx = metadata.AppendToOutgoingContext(grpcCtx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(ctx.Height, 10))
+	}
+	return ctx.GRPCClient.NewStream(grpcCtx, desc, method, opts...)
 }
 
 // gRPCCodec checks if Context's Codec is codec.GRPCCodecProvider
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	// watchSubscriber is the subscriber name used for new block subscriptions.
+	watchSubscriber = "cosmos-sdk-client-watch"
+	// watchPollInterval is the interval at which the node is polled for new
+	// blocks when it does not support event subscriptions.
+	watchPollInterval = time.Second
+)
+
+// WatchChange describes the change of a single field of a watched query result
+// between two blocks. Old is nil for added fields and New is nil for removed
+// fields.
+type WatchChange struct {
+	Path string `json:"path"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+// WatchDiff is printed by watched queries every time the query result changes.
+type WatchDiff struct {
+	Height  int64         `json:"height"`
+	Changes []WatchChange `json:"changes"`
+}
+
+// PrintOrWatchQuery runs query and prints its result. If the --watch flag is
+// set on cmd, it then re-runs query on every new block and prints a WatchDiff
+// each time the result changes, until the command is interrupted.
+func PrintOrWatchQuery(cmd *cobra.Command, clientCtx Context, query func(ctx context.Context) (proto.Message, error)) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	res, err := query(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := clientCtx.PrintProto(res); err != nil {
+		return err
+	}
+
+	watch, _ := cmd.Flags().GetBool(flags.FlagWatch)
+	if !watch {
+		return nil
+	}
+
+	if clientCtx.Height != 0 {
+		return fmt.Errorf("--%s cannot be used with --%s", flags.FlagWatch, flags.FlagHeight)
+	}
+
+	prev, err := clientCtx.Codec.MarshalJSON(res)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	return clientCtx.watchBlocks(ctx, func(height int64) error {
+		res, err := query(ctx)
+		if err != nil {
+			return err
+		}
+
+		next, err := clientCtx.Codec.MarshalJSON(res)
+		if err != nil {
+			return err
+		}
+
+		changes, err := diffJSON(prev, next)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+		prev = next
+
+		out, err := json.Marshal(WatchDiff{Height: height, Changes: changes})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.printOutput(out)
+	})
+}
+
+// watchBlocks calls onBlock with the height of every new block until ctx is
+// done. New blocks are streamed when the node supports event subscriptions and
+// polled otherwise.
+func (ctx Context) watchBlocks(goCtx context.Context, onBlock func(height int64) error) error {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	if events, ok := subscribeNewBlocks(goCtx, node); ok {
+		for {
+			select {
+			case <-goCtx.Done():
+				return nil
+			case event, ok := <-events:
+				if !ok {
+					return errors.New("new block subscription closed by the node")
+				}
+
+				data, ok := event.Data.(cmttypes.EventDataNewBlock)
+				if !ok {
+					continue
+				}
+
+				if err := onBlock(data.Block.Height); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	var lastHeight int64
+	for {
+		select {
+		case <-goCtx.Done():
+			return nil
+		case <-ticker.C:
+			status, err := node.Status(goCtx)
+			if err != nil {
+				if goCtx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			height := status.SyncInfo.LatestBlockHeight
+			if lastHeight != 0 && height > lastHeight {
+				if err := onBlock(height); err != nil {
+					return err
+				}
+			}
+			lastHeight = height
+		}
+	}
+}
+
+// subscribeNewBlocks subscribes to new block events, starting the node
+// client's websocket connection if needed. It returns false if the node does
+// not support subscriptions, in which case the caller falls back to polling.
+func subscribeNewBlocks(goCtx context.Context, node CometRPC) (<-chan coretypes.ResultEvent, bool) {
+	events, ok := node.(rpcclient.EventsClient)
+	if !ok {
+		return nil, false
+	}
+
+	if svc, ok := node.(interface {
+		IsRunning() bool
+		Start() error
+	}); ok && !svc.IsRunning() {
+		if err := svc.Start(); err != nil {
+			return nil, false
+		}
+	}
+
+	out, err := events.Subscribe(goCtx, watchSubscriber, cmttypes.QueryForEvent(cmttypes.EventNewBlock).String())
+	if err != nil {
+		return nil, false
+	}
+
+	go func() {
+		<-goCtx.Done()
+		_ = events.UnsubscribeAll(context.Background(), watchSubscriber)
+	}()
+
+	return out, true
+}
+
+// diffJSON compares two JSON documents and returns the changed leaf fields,
+// sorted by path.
+func diffJSON(prev, next []byte) ([]WatchChange, error) {
+	var prevObj, nextObj any
+	if err := json.Unmarshal(prev, &prevObj); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(next, &nextObj); err != nil {
+		return nil, err
+	}
+
+	prevFields, nextFields := map[string]any{}, map[string]any{}
+	flattenJSON("", prevObj, prevFields)
+	flattenJSON("", nextObj, nextFields)
+
+	var changes []WatchChange
+	for path, oldValue := range prevFields {
+		newValue, ok := nextFields[path]
+		switch {
+		case !ok:
+			changes = append(changes, WatchChange{Path: path, Old: oldValue})
+		case !jsonEqual(oldValue, newValue):
+			changes = append(changes, WatchChange{Path: path, Old: oldValue, New: newValue})
+		}
+	}
+
+	for path, newValue := range nextFields {
+		if _, ok := prevFields[path]; !ok {
+			changes = append(changes, WatchChange{Path: path, New: newValue})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	return changes, nil
+}
+
+// flattenJSON collects the leaf values of a decoded JSON document into fields,
+// keyed by their dotted path, e.g. "validator.tokens" or "balances[0].amount".
+func flattenJSON(path string, value any, fields map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 && path != "" {
+			fields[path] = v
+		}
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			flattenJSON(childPath, child, fields)
+		}
+	case []any:
+		if len(v) == 0 && path != "" {
+			fields[path] = v
+		}
+		for i, child := range v {
+			flattenJSON(path+"["+strconv.Itoa(i)+"]", child, fields)
+		}
+	default:
+		fields[path] = v
+	}
+}
+
+func jsonEqual(a, b any) bool {
+	aBz, errA := json.Marshal(a)
+	bBz, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aBz) == string(bBz)
+}
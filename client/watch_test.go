@@ -0,0 +1,61 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prev     string
+		next     string
+		expected []WatchChange
+	}{
+		{
+			"no change",
+			`{"tokens":"100","status":"BOND_STATUS_BONDED"}`,
+			`{"status":"BOND_STATUS_BONDED","tokens":"100"}`,
+			nil,
+		},
+		{
+			"changed leaf",
+			`{"validator":{"tokens":"100","jailed":false}}`,
+			`{"validator":{"tokens":"150","jailed":true}}`,
+			[]WatchChange{
+				{Path: "validator.jailed", Old: false, New: true},
+				{Path: "validator.tokens", Old: "100", New: "150"},
+			},
+		},
+		{
+			"added and removed array entries",
+			`{"entries":[{"balance":"10"},{"balance":"20"}]}`,
+			`{"entries":[{"balance":"20"}]}`,
+			[]WatchChange{
+				{Path: "entries[0].balance", Old: "10", New: "20"},
+				{Path: "entries[1].balance", Old: "20"},
+			},
+		},
+		{
+			"added field",
+			`{"status":"PROPOSAL_STATUS_DEPOSIT_PERIOD"}`,
+			`{"status":"PROPOSAL_STATUS_VOTING_PERIOD","voting_end_time":"2023-01-01T00:00:00Z"}`,
+			[]WatchChange{
+				{Path: "status", Old: "PROPOSAL_STATUS_DEPOSIT_PERIOD", New: "PROPOSAL_STATUS_VOTING_PERIOD"},
+				{Path: "voting_end_time", New: "2023-01-01T00:00:00Z"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changes, err := diffJSON([]byte(tc.prev), []byte(tc.next))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, changes)
+		})
+	}
+
+	_, err := diffJSON([]byte("{"), []byte("{}"))
+	require.Error(t, err)
+}
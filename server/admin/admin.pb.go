@@ -0,0 +1,5535 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/admin/v1beta1/admin.proto
+
+package admin
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryRouteState defines the local override of a gRPC query route.
+type QueryRouteState int32
+
+const (
+	// QUERY_ROUTE_STATE_UNSPECIFIED defines a route which is not overridden, and
+	// is disabled only if the query circuit breaker of the chain disables it.
+	QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED QueryRouteState = 0
+	// QUERY_ROUTE_STATE_DISABLED defines a route disabled on the node.
+	QueryRouteState_QUERY_ROUTE_STATE_DISABLED QueryRouteState = 1
+	// QUERY_ROUTE_STATE_ENABLED defines a route enabled on the node, even if the
+	// query circuit breaker of the chain disables it.
+	QueryRouteState_QUERY_ROUTE_STATE_ENABLED QueryRouteState = 2
+)
+
+var QueryRouteState_name = map[int32]string{
+	0: "QUERY_ROUTE_STATE_UNSPECIFIED",
+	1: "QUERY_ROUTE_STATE_DISABLED",
+	2: "QUERY_ROUTE_STATE_ENABLED",
+}
+
+var QueryRouteState_value = map[string]int32{
+	"QUERY_ROUTE_STATE_UNSPECIFIED": 0,
+	"QUERY_ROUTE_STATE_DISABLED":    1,
+	"QUERY_ROUTE_STATE_ENABLED":     2,
+}
+
+func (x QueryRouteState) String() string {
+	return proto.EnumName(QueryRouteState_name, int32(x))
+}
+
+func (QueryRouteState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{0}
+}
+
+// LogLevelRequest is the request type for the Service/LogLevel RPC method.
+type LogLevelRequest struct {
+}
+
+func (m *LogLevelRequest) Reset()         { *m = LogLevelRequest{} }
+func (m *LogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*LogLevelRequest) ProtoMessage()    {}
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{0}
+}
+func (m *LogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelRequest.Merge(m, src)
+}
+func (m *LogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelRequest proto.InternalMessageInfo
+
+// LogLevelResponse is the response type for the Service/LogLevel RPC method.
+type LogLevelResponse struct {
+	// level is the current log level, e.g. "info" or "consensus:debug,*:error".
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *LogLevelResponse) Reset()         { *m = LogLevelResponse{} }
+func (m *LogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*LogLevelResponse) ProtoMessage()    {}
+func (*LogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{1}
+}
+func (m *LogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogLevelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelResponse.Merge(m, src)
+}
+func (m *LogLevelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelResponse proto.InternalMessageInfo
+
+func (m *LogLevelResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+// SetLogLevelRequest is the request type for the Service/SetLogLevel RPC method.
+type SetLogLevelRequest struct {
+	// level is the new log level, e.g. "info" or "consensus:debug,*:error".
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{2}
+}
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+// SetLogLevelResponse is the response type for the Service/SetLogLevel RPC method.
+type SetLogLevelResponse struct {
+	// previous_level is the log level which was replaced.
+	PreviousLevel string `protobuf:"bytes,1,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+}
+
+func (m *SetLogLevelResponse) Reset()         { *m = SetLogLevelResponse{} }
+func (m *SetLogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelResponse) ProtoMessage()    {}
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{3}
+}
+func (m *SetLogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelResponse.Merge(m, src)
+}
+func (m *SetLogLevelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelResponse proto.InternalMessageInfo
+
+func (m *SetLogLevelResponse) GetPreviousLevel() string {
+	if m != nil {
+		return m.PreviousLevel
+	}
+	return ""
+}
+
+// CreateSnapshotRequest is the request type for the Service/CreateSnapshot RPC method.
+type CreateSnapshotRequest struct {
+	// height is the height of the snapshot. 0 takes the snapshot of the latest
+	// committed height.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CreateSnapshotRequest) Reset()         { *m = CreateSnapshotRequest{} }
+func (m *CreateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotRequest) ProtoMessage()    {}
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{4}
+}
+func (m *CreateSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotRequest.Merge(m, src)
+}
+func (m *CreateSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSnapshotRequest proto.InternalMessageInfo
+
+func (m *CreateSnapshotRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// CreateSnapshotResponse is the response type for the Service/CreateSnapshot RPC method.
+type CreateSnapshotResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format uint32 `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunks uint32 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Hash   []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *CreateSnapshotResponse) Reset()         { *m = CreateSnapshotResponse{} }
+func (m *CreateSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotResponse) ProtoMessage()    {}
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{5}
+}
+func (m *CreateSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotResponse.Merge(m, src)
+}
+func (m *CreateSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSnapshotResponse proto.InternalMessageInfo
+
+func (m *CreateSnapshotResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CreateSnapshotResponse) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *CreateSnapshotResponse) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *CreateSnapshotResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// DrainRequest is the request type for the Service/Drain RPC method.
+type DrainRequest struct {
+	// enable defines if the node starts or stops draining.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{6}
+}
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
+}
+func (m *DrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
+
+func (m *DrainRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+// DrainResponse is the response type for the Service/Drain RPC method.
+type DrainResponse struct {
+	// draining defines if the node is draining.
+	Draining bool `protobuf:"varint,1,opt,name=draining,proto3" json:"draining,omitempty"`
+}
+
+func (m *DrainResponse) Reset()         { *m = DrainResponse{} }
+func (m *DrainResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()    {}
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{7}
+}
+func (m *DrainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainResponse.Merge(m, src)
+}
+func (m *DrainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainResponse proto.InternalMessageInfo
+
+func (m *DrainResponse) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+// CompactRequest is the request type for the Service/Compact RPC method.
+type CompactRequest struct {
+}
+
+func (m *CompactRequest) Reset()         { *m = CompactRequest{} }
+func (m *CompactRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactRequest) ProtoMessage()    {}
+func (*CompactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{8}
+}
+func (m *CompactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactRequest.Merge(m, src)
+}
+func (m *CompactRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactRequest proto.InternalMessageInfo
+
+// CompactResponse is the response type for the Service/Compact RPC method.
+type CompactResponse struct {
+}
+
+func (m *CompactResponse) Reset()         { *m = CompactResponse{} }
+func (m *CompactResponse) String() string { return proto.CompactTextString(m) }
+func (*CompactResponse) ProtoMessage()    {}
+func (*CompactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{9}
+}
+func (m *CompactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactResponse.Merge(m, src)
+}
+func (m *CompactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactResponse proto.InternalMessageInfo
+
+// RollbackPreflightRequest is the request type for the Service/RollbackPreflight RPC method.
+type RollbackPreflightRequest struct {
+}
+
+func (m *RollbackPreflightRequest) Reset()         { *m = RollbackPreflightRequest{} }
+func (m *RollbackPreflightRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackPreflightRequest) ProtoMessage()    {}
+func (*RollbackPreflightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{10}
+}
+func (m *RollbackPreflightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPreflightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPreflightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackPreflightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPreflightRequest.Merge(m, src)
+}
+func (m *RollbackPreflightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPreflightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPreflightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPreflightRequest proto.InternalMessageInfo
+
+// RollbackPreflightResponse is the response type for the Service/RollbackPreflight RPC method.
+type RollbackPreflightResponse struct {
+	// height is the latest committed height of the application.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// target_height is the height the application would be rolled back to.
+	TargetHeight int64 `protobuf:"varint,2,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	// ok defines if the state at target_height is available, so that the
+	// rollback command can be run once the node is stopped.
+	Ok bool `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+	// reason explains why the state cannot be rolled back when ok is false.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RollbackPreflightResponse) Reset()         { *m = RollbackPreflightResponse{} }
+func (m *RollbackPreflightResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackPreflightResponse) ProtoMessage()    {}
+func (*RollbackPreflightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{11}
+}
+func (m *RollbackPreflightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPreflightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPreflightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackPreflightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPreflightResponse.Merge(m, src)
+}
+func (m *RollbackPreflightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPreflightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPreflightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPreflightResponse proto.InternalMessageInfo
+
+func (m *RollbackPreflightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RollbackPreflightResponse) GetTargetHeight() int64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *RollbackPreflightResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *RollbackPreflightResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// IAVLCachesRequest is the request type for the Service/IAVLCaches RPC method.
+type IAVLCachesRequest struct {
+}
+
+func (m *IAVLCachesRequest) Reset()         { *m = IAVLCachesRequest{} }
+func (m *IAVLCachesRequest) String() string { return proto.CompactTextString(m) }
+func (*IAVLCachesRequest) ProtoMessage()    {}
+func (*IAVLCachesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{12}
+}
+func (m *IAVLCachesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IAVLCachesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IAVLCachesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IAVLCachesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IAVLCachesRequest.Merge(m, src)
+}
+func (m *IAVLCachesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IAVLCachesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IAVLCachesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IAVLCachesRequest proto.InternalMessageInfo
+
+// IAVLCachesResponse is the response type for the Service/IAVLCaches RPC method.
+type IAVLCachesResponse struct {
+	// caches are the IAVL node caches of the stores, sorted by store name.
+	Caches []*IAVLCache `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
+}
+
+func (m *IAVLCachesResponse) Reset()         { *m = IAVLCachesResponse{} }
+func (m *IAVLCachesResponse) String() string { return proto.CompactTextString(m) }
+func (*IAVLCachesResponse) ProtoMessage()    {}
+func (*IAVLCachesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{13}
+}
+func (m *IAVLCachesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IAVLCachesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IAVLCachesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IAVLCachesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IAVLCachesResponse.Merge(m, src)
+}
+func (m *IAVLCachesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IAVLCachesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IAVLCachesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IAVLCachesResponse proto.InternalMessageInfo
+
+func (m *IAVLCachesResponse) GetCaches() []*IAVLCache {
+	if m != nil {
+		return m.Caches
+	}
+	return nil
+}
+
+// IAVLCache describes the IAVL node cache of a store.
+type IAVLCache struct {
+	// store is the name of the store.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// size is the size of the cache, in number of nodes.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hits and misses are the number of cache lookups which were hits and
+	// misses since the store was loaded.
+	Hits   uint64 `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses uint64 `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	// hit_rate is the ratio of the cache lookups which were hits.
+	HitRate float64 `protobuf:"fixed64,5,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+}
+
+func (m *IAVLCache) Reset()         { *m = IAVLCache{} }
+func (m *IAVLCache) String() string { return proto.CompactTextString(m) }
+func (*IAVLCache) ProtoMessage()    {}
+func (*IAVLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{14}
+}
+func (m *IAVLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IAVLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IAVLCache.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IAVLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IAVLCache.Merge(m, src)
+}
+func (m *IAVLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *IAVLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_IAVLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IAVLCache proto.InternalMessageInfo
+
+func (m *IAVLCache) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *IAVLCache) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *IAVLCache) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *IAVLCache) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *IAVLCache) GetHitRate() float64 {
+	if m != nil {
+		return m.HitRate
+	}
+	return 0
+}
+
+// ResizeIAVLCacheRequest is the request type for the Service/ResizeIAVLCache RPC method.
+type ResizeIAVLCacheRequest struct {
+	// store is the name of the store.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// size is the new size of the cache, in number of nodes.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *ResizeIAVLCacheRequest) Reset()         { *m = ResizeIAVLCacheRequest{} }
+func (m *ResizeIAVLCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeIAVLCacheRequest) ProtoMessage()    {}
+func (*ResizeIAVLCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{15}
+}
+func (m *ResizeIAVLCacheRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResizeIAVLCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResizeIAVLCacheRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResizeIAVLCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeIAVLCacheRequest.Merge(m, src)
+}
+func (m *ResizeIAVLCacheRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResizeIAVLCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeIAVLCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeIAVLCacheRequest proto.InternalMessageInfo
+
+func (m *ResizeIAVLCacheRequest) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *ResizeIAVLCacheRequest) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+// ResizeIAVLCacheResponse is the response type for the Service/ResizeIAVLCache RPC method.
+type ResizeIAVLCacheResponse struct {
+	// previous_size is the size of the cache before it is resized.
+	PreviousSize uint64 `protobuf:"varint,1,opt,name=previous_size,json=previousSize,proto3" json:"previous_size,omitempty"`
+}
+
+func (m *ResizeIAVLCacheResponse) Reset()         { *m = ResizeIAVLCacheResponse{} }
+func (m *ResizeIAVLCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*ResizeIAVLCacheResponse) ProtoMessage()    {}
+func (*ResizeIAVLCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{16}
+}
+func (m *ResizeIAVLCacheResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResizeIAVLCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResizeIAVLCacheResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResizeIAVLCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeIAVLCacheResponse.Merge(m, src)
+}
+func (m *ResizeIAVLCacheResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResizeIAVLCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeIAVLCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeIAVLCacheResponse proto.InternalMessageInfo
+
+func (m *ResizeIAVLCacheResponse) GetPreviousSize() uint64 {
+	if m != nil {
+		return m.PreviousSize
+	}
+	return 0
+}
+
+// QueryRouteOverride is the local override of a gRPC query route.
+type QueryRouteOverride struct {
+	// route is the full gRPC method name of the query route, e.g.
+	// "/cosmos.bank.v1beta1.Query/DenomOwners", or a glob pattern, e.g.
+	// "/cosmos.bank.v1beta1.Query/*".
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// state is the override of the route.
+	State QueryRouteState `protobuf:"varint,2,opt,name=state,proto3,enum=cosmos.base.admin.v1beta1.QueryRouteState" json:"state,omitempty"`
+}
+
+func (m *QueryRouteOverride) Reset()         { *m = QueryRouteOverride{} }
+func (m *QueryRouteOverride) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteOverride) ProtoMessage()    {}
+func (*QueryRouteOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{17}
+}
+func (m *QueryRouteOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteOverride.Merge(m, src)
+}
+func (m *QueryRouteOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteOverride proto.InternalMessageInfo
+
+func (m *QueryRouteOverride) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *QueryRouteOverride) GetState() QueryRouteState {
+	if m != nil {
+		return m.State
+	}
+	return QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED
+}
+
+// QueryRouteOverridesRequest is the request type for the Service/QueryRouteOverrides RPC method.
+type QueryRouteOverridesRequest struct {
+}
+
+func (m *QueryRouteOverridesRequest) Reset()         { *m = QueryRouteOverridesRequest{} }
+func (m *QueryRouteOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteOverridesRequest) ProtoMessage()    {}
+func (*QueryRouteOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{18}
+}
+func (m *QueryRouteOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteOverridesRequest.Merge(m, src)
+}
+func (m *QueryRouteOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteOverridesRequest proto.InternalMessageInfo
+
+// QueryRouteOverridesResponse is the response type for the Service/QueryRouteOverrides RPC method.
+type QueryRouteOverridesResponse struct {
+	// overrides are the local overrides of the query routes, sorted by route.
+	Overrides []*QueryRouteOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (m *QueryRouteOverridesResponse) Reset()         { *m = QueryRouteOverridesResponse{} }
+func (m *QueryRouteOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteOverridesResponse) ProtoMessage()    {}
+func (*QueryRouteOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{19}
+}
+func (m *QueryRouteOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteOverridesResponse.Merge(m, src)
+}
+func (m *QueryRouteOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryRouteOverridesResponse) GetOverrides() []*QueryRouteOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// SetQueryRouteOverrideRequest is the request type for the Service/SetQueryRouteOverride RPC method.
+type SetQueryRouteOverrideRequest struct {
+	// override is the new override of the route. The override of the route is
+	// removed if its state is QUERY_ROUTE_STATE_UNSPECIFIED.
+	Override *QueryRouteOverride `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+}
+
+func (m *SetQueryRouteOverrideRequest) Reset()         { *m = SetQueryRouteOverrideRequest{} }
+func (m *SetQueryRouteOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*SetQueryRouteOverrideRequest) ProtoMessage()    {}
+func (*SetQueryRouteOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{20}
+}
+func (m *SetQueryRouteOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetQueryRouteOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetQueryRouteOverrideRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetQueryRouteOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQueryRouteOverrideRequest.Merge(m, src)
+}
+func (m *SetQueryRouteOverrideRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetQueryRouteOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQueryRouteOverrideRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQueryRouteOverrideRequest proto.InternalMessageInfo
+
+func (m *SetQueryRouteOverrideRequest) GetOverride() *QueryRouteOverride {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+// SetQueryRouteOverrideResponse is the response type for the Service/SetQueryRouteOverride RPC method.
+type SetQueryRouteOverrideResponse struct {
+	// previous_state is the override of the route which was replaced.
+	PreviousState QueryRouteState `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=cosmos.base.admin.v1beta1.QueryRouteState" json:"previous_state,omitempty"`
+}
+
+func (m *SetQueryRouteOverrideResponse) Reset()         { *m = SetQueryRouteOverrideResponse{} }
+func (m *SetQueryRouteOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*SetQueryRouteOverrideResponse) ProtoMessage()    {}
+func (*SetQueryRouteOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{21}
+}
+func (m *SetQueryRouteOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetQueryRouteOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetQueryRouteOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetQueryRouteOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQueryRouteOverrideResponse.Merge(m, src)
+}
+func (m *SetQueryRouteOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetQueryRouteOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQueryRouteOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQueryRouteOverrideResponse proto.InternalMessageInfo
+
+func (m *SetQueryRouteOverrideResponse) GetPreviousState() QueryRouteState {
+	if m != nil {
+		return m.PreviousState
+	}
+	return QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED
+}
+
+// RequestLogSettings defines the settings of the logging of the gRPC requests.
+type RequestLogSettings struct {
+	// enabled defines if the requests are logged at all.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// sample_rate is the fraction of the requests, between 0 and 1, which are
+	// logged.
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// slow_query_threshold is the latency above which a request is always
+	// logged. 0 disables the logging of the slow requests.
+	SlowQueryThreshold time.Duration `protobuf:"bytes,3,opt,name=slow_query_threshold,json=slowQueryThreshold,proto3,stdduration" json:"slow_query_threshold"`
+}
+
+func (m *RequestLogSettings) Reset()         { *m = RequestLogSettings{} }
+func (m *RequestLogSettings) String() string { return proto.CompactTextString(m) }
+func (*RequestLogSettings) ProtoMessage()    {}
+func (*RequestLogSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{22}
+}
+func (m *RequestLogSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLogSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLogSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLogSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLogSettings.Merge(m, src)
+}
+func (m *RequestLogSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLogSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLogSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLogSettings proto.InternalMessageInfo
+
+func (m *RequestLogSettings) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *RequestLogSettings) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *RequestLogSettings) GetSlowQueryThreshold() time.Duration {
+	if m != nil {
+		return m.SlowQueryThreshold
+	}
+	return 0
+}
+
+// RequestLogSettingsRequest is the request type for the Service/RequestLogSettings RPC method.
+type RequestLogSettingsRequest struct {
+}
+
+func (m *RequestLogSettingsRequest) Reset()         { *m = RequestLogSettingsRequest{} }
+func (m *RequestLogSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestLogSettingsRequest) ProtoMessage()    {}
+func (*RequestLogSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{23}
+}
+func (m *RequestLogSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLogSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLogSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLogSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLogSettingsRequest.Merge(m, src)
+}
+func (m *RequestLogSettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLogSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLogSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLogSettingsRequest proto.InternalMessageInfo
+
+// RequestLogSettingsResponse is the response type for the Service/RequestLogSettings RPC method.
+type RequestLogSettingsResponse struct {
+	// settings are the current settings of the request logging.
+	Settings RequestLogSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings"`
+}
+
+func (m *RequestLogSettingsResponse) Reset()         { *m = RequestLogSettingsResponse{} }
+func (m *RequestLogSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestLogSettingsResponse) ProtoMessage()    {}
+func (*RequestLogSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{24}
+}
+func (m *RequestLogSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLogSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLogSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLogSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLogSettingsResponse.Merge(m, src)
+}
+func (m *RequestLogSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLogSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLogSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLogSettingsResponse proto.InternalMessageInfo
+
+func (m *RequestLogSettingsResponse) GetSettings() RequestLogSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return RequestLogSettings{}
+}
+
+// SetRequestLogSettingsRequest is the request type for the Service/SetRequestLogSettings RPC method.
+type SetRequestLogSettingsRequest struct {
+	// settings are the new settings of the request logging.
+	Settings RequestLogSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings"`
+}
+
+func (m *SetRequestLogSettingsRequest) Reset()         { *m = SetRequestLogSettingsRequest{} }
+func (m *SetRequestLogSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRequestLogSettingsRequest) ProtoMessage()    {}
+func (*SetRequestLogSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{25}
+}
+func (m *SetRequestLogSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRequestLogSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRequestLogSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRequestLogSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRequestLogSettingsRequest.Merge(m, src)
+}
+func (m *SetRequestLogSettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRequestLogSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRequestLogSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRequestLogSettingsRequest proto.InternalMessageInfo
+
+func (m *SetRequestLogSettingsRequest) GetSettings() RequestLogSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return RequestLogSettings{}
+}
+
+// SetRequestLogSettingsResponse is the response type for the Service/SetRequestLogSettings RPC method.
+type SetRequestLogSettingsResponse struct {
+	// previous_settings are the settings which were replaced.
+	PreviousSettings RequestLogSettings `protobuf:"bytes,1,opt,name=previous_settings,json=previousSettings,proto3" json:"previous_settings"`
+}
+
+func (m *SetRequestLogSettingsResponse) Reset()         { *m = SetRequestLogSettingsResponse{} }
+func (m *SetRequestLogSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRequestLogSettingsResponse) ProtoMessage()    {}
+func (*SetRequestLogSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8ad4736aa42ef, []int{26}
+}
+func (m *SetRequestLogSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRequestLogSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRequestLogSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRequestLogSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRequestLogSettingsResponse.Merge(m, src)
+}
+func (m *SetRequestLogSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRequestLogSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRequestLogSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRequestLogSettingsResponse proto.InternalMessageInfo
+
+func (m *SetRequestLogSettingsResponse) GetPreviousSettings() RequestLogSettings {
+	if m != nil {
+		return m.PreviousSettings
+	}
+	return RequestLogSettings{}
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.base.admin.v1beta1.QueryRouteState", QueryRouteState_name, QueryRouteState_value)
+	proto.RegisterType((*LogLevelRequest)(nil), "cosmos.base.admin.v1beta1.LogLevelRequest")
+	proto.RegisterType((*LogLevelResponse)(nil), "cosmos.base.admin.v1beta1.LogLevelResponse")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "cosmos.base.admin.v1beta1.SetLogLevelRequest")
+	proto.RegisterType((*SetLogLevelResponse)(nil), "cosmos.base.admin.v1beta1.SetLogLevelResponse")
+	proto.RegisterType((*CreateSnapshotRequest)(nil), "cosmos.base.admin.v1beta1.CreateSnapshotRequest")
+	proto.RegisterType((*CreateSnapshotResponse)(nil), "cosmos.base.admin.v1beta1.CreateSnapshotResponse")
+	proto.RegisterType((*DrainRequest)(nil), "cosmos.base.admin.v1beta1.DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "cosmos.base.admin.v1beta1.DrainResponse")
+	proto.RegisterType((*CompactRequest)(nil), "cosmos.base.admin.v1beta1.CompactRequest")
+	proto.RegisterType((*CompactResponse)(nil), "cosmos.base.admin.v1beta1.CompactResponse")
+	proto.RegisterType((*RollbackPreflightRequest)(nil), "cosmos.base.admin.v1beta1.RollbackPreflightRequest")
+	proto.RegisterType((*RollbackPreflightResponse)(nil), "cosmos.base.admin.v1beta1.RollbackPreflightResponse")
+	proto.RegisterType((*IAVLCachesRequest)(nil), "cosmos.base.admin.v1beta1.IAVLCachesRequest")
+	proto.RegisterType((*IAVLCachesResponse)(nil), "cosmos.base.admin.v1beta1.IAVLCachesResponse")
+	proto.RegisterType((*IAVLCache)(nil), "cosmos.base.admin.v1beta1.IAVLCache")
+	proto.RegisterType((*ResizeIAVLCacheRequest)(nil), "cosmos.base.admin.v1beta1.ResizeIAVLCacheRequest")
+	proto.RegisterType((*ResizeIAVLCacheResponse)(nil), "cosmos.base.admin.v1beta1.ResizeIAVLCacheResponse")
+	proto.RegisterType((*QueryRouteOverride)(nil), "cosmos.base.admin.v1beta1.QueryRouteOverride")
+	proto.RegisterType((*QueryRouteOverridesRequest)(nil), "cosmos.base.admin.v1beta1.QueryRouteOverridesRequest")
+	proto.RegisterType((*QueryRouteOverridesResponse)(nil), "cosmos.base.admin.v1beta1.QueryRouteOverridesResponse")
+	proto.RegisterType((*SetQueryRouteOverrideRequest)(nil), "cosmos.base.admin.v1beta1.SetQueryRouteOverrideRequest")
+	proto.RegisterType((*SetQueryRouteOverrideResponse)(nil), "cosmos.base.admin.v1beta1.SetQueryRouteOverrideResponse")
+	proto.RegisterType((*RequestLogSettings)(nil), "cosmos.base.admin.v1beta1.RequestLogSettings")
+	proto.RegisterType((*RequestLogSettingsRequest)(nil), "cosmos.base.admin.v1beta1.RequestLogSettingsRequest")
+	proto.RegisterType((*RequestLogSettingsResponse)(nil), "cosmos.base.admin.v1beta1.RequestLogSettingsResponse")
+	proto.RegisterType((*SetRequestLogSettingsRequest)(nil), "cosmos.base.admin.v1beta1.SetRequestLogSettingsRequest")
+	proto.RegisterType((*SetRequestLogSettingsResponse)(nil), "cosmos.base.admin.v1beta1.SetRequestLogSettingsResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/admin/v1beta1/admin.proto", fileDescriptor_02f8ad4736aa42ef)
+}
+
+var fileDescriptor_02f8ad4736aa42ef = []byte{
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xcf, 0xa6, 0x6e, 0xe3, 0x4c, 0xbe, 0x5f, 0xd2, 0x60, 0x6f, 0x1b, 0x27, 0x6c, 0x29, 0xb8,
+	0x29, 0xb1, 0x49, 0xda, 0x42, 0x0f, 0x15, 0x22, 0x1f, 0x46, 0x44, 0x44, 0x4d, 0xf3, 0x9c, 0x20,
+	0xd1, 0x8b, 0x59, 0xdb, 0x2f, 0xbb, 0x4b, 0xd6, 0x7e, 0xee, 0xbe, 0x67, 0xf3, 0x21, 0x84, 0xa8,
+	0xc4, 0x89, 0x13, 0x47, 0x24, 0xee, 0xfc, 0x2d, 0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x8f, 0xa0,
+	0xf7, 0xb1, 0x6b, 0x27, 0x5e, 0xbb, 0x5e, 0xa9, 0x27, 0xef, 0xcc, 0x9b, 0xdf, 0xcc, 0xef, 0xcd,
+	0xcc, 0xee, 0x8c, 0xe1, 0x6e, 0x8d, 0xb2, 0x06, 0x65, 0xc5, 0xaa, 0xcd, 0x48, 0xd1, 0xae, 0x37,
+	0xbc, 0x66, 0xb1, 0xb3, 0x59, 0x25, 0xdc, 0xde, 0x54, 0x52, 0xa1, 0x15, 0x50, 0x4e, 0x51, 0x56,
+	0x99, 0x15, 0x84, 0x59, 0x41, 0x1d, 0x68, 0x33, 0x73, 0xc9, 0xa1, 0x0e, 0x95, 0x56, 0x45, 0xf1,
+	0xa4, 0x00, 0x66, 0xce, 0xa1, 0xd4, 0xf1, 0x49, 0x51, 0x4a, 0xd5, 0xf6, 0x69, 0xb1, 0xde, 0x0e,
+	0x6c, 0xee, 0x51, 0xed, 0xd0, 0x5a, 0x80, 0xb9, 0x03, 0xea, 0x1c, 0x90, 0x0e, 0xf1, 0x31, 0x79,
+	0xd1, 0x26, 0x8c, 0x5b, 0x79, 0x98, 0xef, 0xaa, 0x58, 0x8b, 0x36, 0x19, 0x41, 0x4b, 0x70, 0xdd,
+	0x17, 0x8a, 0x8c, 0xb1, 0x66, 0xe4, 0x27, 0xb1, 0x12, 0xac, 0x75, 0x40, 0x65, 0xc2, 0xaf, 0xe0,
+	0x07, 0xd8, 0x3e, 0x81, 0xc5, 0x4b, 0xb6, 0xda, 0xf1, 0x5d, 0x98, 0x6d, 0x05, 0xa4, 0xe3, 0xd1,
+	0x36, 0xab, 0xf4, 0xa2, 0x66, 0x42, 0xad, 0x34, 0xb7, 0x8a, 0x70, 0x73, 0x37, 0x20, 0x36, 0x27,
+	0xe5, 0xa6, 0xdd, 0x62, 0x2e, 0xe5, 0x61, 0xb0, 0x65, 0xb8, 0xe1, 0x12, 0xcf, 0x71, 0xb9, 0xc4,
+	0xa5, 0xb0, 0x96, 0x2c, 0x0e, 0xcb, 0x57, 0x01, 0x3a, 0xe2, 0x00, 0x84, 0xd0, 0x9f, 0xd2, 0xa0,
+	0x61, 0xf3, 0xcc, 0xf8, 0x9a, 0x91, 0x9f, 0xc1, 0x5a, 0x12, 0xfa, 0x9a, 0xdb, 0x6e, 0x9e, 0xb1,
+	0xcc, 0x35, 0xa5, 0x57, 0x12, 0x42, 0x90, 0x72, 0x6d, 0xe6, 0x66, 0x52, 0x6b, 0x46, 0x7e, 0x1a,
+	0xcb, 0x67, 0xeb, 0x7d, 0x98, 0xde, 0x0b, 0x6c, 0xaf, 0xd9, 0xc3, 0x8e, 0x34, 0xed, 0xaa, 0x4f,
+	0x64, 0xac, 0x34, 0xd6, 0x92, 0x75, 0x1f, 0x66, 0xb4, 0x9d, 0x26, 0x65, 0x42, 0xba, 0x2e, 0x14,
+	0x5e, 0xd3, 0xd1, 0xa6, 0x91, 0x6c, 0xcd, 0xc3, 0xec, 0x2e, 0x6d, 0xb4, 0xec, 0x5a, 0x78, 0x69,
+	0x51, 0xb4, 0x48, 0xa3, 0x1c, 0x58, 0x26, 0x64, 0x30, 0xf5, 0xfd, 0xaa, 0x5d, 0x3b, 0x7b, 0x16,
+	0x90, 0x53, 0x5f, 0x5c, 0x29, 0x34, 0xff, 0xc5, 0x80, 0x6c, 0xcc, 0x61, 0x6c, 0x3e, 0xae, 0x45,
+	0xf9, 0xb8, 0x03, 0x33, 0xdc, 0x0e, 0x1c, 0xc2, 0x2b, 0xfa, 0x78, 0x5c, 0x1e, 0x4f, 0x2b, 0xe5,
+	0x17, 0xca, 0x68, 0x16, 0xc6, 0xe9, 0x99, 0x4c, 0x4c, 0x1a, 0x8f, 0xd3, 0x33, 0xe1, 0x2c, 0x20,
+	0x36, 0xa3, 0x4d, 0x99, 0x96, 0x49, 0xac, 0x25, 0x6b, 0x11, 0x16, 0xf6, 0xb7, 0xbf, 0x3a, 0xd8,
+	0xb5, 0x6b, 0x2e, 0x61, 0x21, 0x2f, 0x0c, 0xa8, 0x57, 0xa9, 0xf9, 0x3c, 0x81, 0x1b, 0x35, 0xa9,
+	0xc9, 0x18, 0x6b, 0xd7, 0xf2, 0x53, 0x5b, 0xef, 0x15, 0x06, 0xf6, 0x7c, 0x21, 0x82, 0x63, 0x8d,
+	0xb1, 0x7e, 0x82, 0xc9, 0x48, 0x29, 0x3a, 0x91, 0x71, 0x1a, 0x90, 0xb0, 0x13, 0xa5, 0x20, 0x0a,
+	0xc7, 0xbc, 0x1f, 0x89, 0xbc, 0x4f, 0x0a, 0xcb, 0x67, 0x59, 0x4c, 0x8f, 0xab, 0x12, 0xa7, 0xb0,
+	0x7c, 0x16, 0x77, 0x69, 0x78, 0x8c, 0x11, 0x26, 0xef, 0x92, 0xc2, 0x5a, 0x42, 0x59, 0x48, 0xbb,
+	0x1e, 0xaf, 0x04, 0x36, 0x27, 0x99, 0xeb, 0x6b, 0x46, 0xde, 0xc0, 0x13, 0xae, 0xc7, 0xb1, 0xcd,
+	0x89, 0xb5, 0x03, 0xcb, 0x98, 0x08, 0x87, 0x5d, 0x62, 0xdd, 0x97, 0x62, 0x34, 0x2a, 0xd6, 0xa7,
+	0xf0, 0x4e, 0x9f, 0x0f, 0x9d, 0x9a, 0x3b, 0x10, 0xbd, 0x16, 0x15, 0x89, 0x53, 0x1d, 0x3c, 0x1d,
+	0x2a, 0xcb, 0x02, 0xef, 0x03, 0x3a, 0x6a, 0x93, 0xe0, 0x07, 0x4c, 0xdb, 0x9c, 0x1c, 0x76, 0x48,
+	0x10, 0x78, 0x75, 0x99, 0x8a, 0x40, 0x28, 0xc2, 0xf8, 0x52, 0x40, 0x9f, 0x09, 0x56, 0x36, 0x57,
+	0x04, 0x66, 0xb7, 0xd6, 0x87, 0xa4, 0xba, 0xeb, 0xb3, 0x2c, 0x10, 0x58, 0x01, 0xad, 0xdb, 0x60,
+	0xf6, 0x47, 0x8b, 0x2a, 0xfc, 0x2d, 0xdc, 0x8a, 0x3d, 0xd5, 0xf7, 0xf9, 0x12, 0x26, 0x69, 0xa8,
+	0xd4, 0xd5, 0xde, 0x18, 0x89, 0x42, 0xe8, 0x0a, 0x77, 0xf1, 0x96, 0x07, 0xb7, 0xcb, 0x84, 0xc7,
+	0xd8, 0xe8, 0x0a, 0xec, 0x43, 0x3a, 0x34, 0x96, 0x49, 0x48, 0x1c, 0x2b, 0x82, 0x5b, 0x01, 0xac,
+	0x0c, 0x08, 0xa5, 0x2f, 0x76, 0xd4, 0xf3, 0x55, 0x53, 0x09, 0x36, 0x12, 0x27, 0x38, 0x2a, 0xb5,
+	0x14, 0xad, 0xbf, 0x0c, 0x40, 0xfa, 0x2a, 0x07, 0xd4, 0x29, 0x13, 0xce, 0xbd, 0xa6, 0xc3, 0x50,
+	0x06, 0x26, 0xd4, 0x37, 0xa5, 0xae, 0xbf, 0x1b, 0xa1, 0x88, 0x56, 0x61, 0x8a, 0xd9, 0x8d, 0x96,
+	0x4f, 0x2a, 0x41, 0x58, 0x61, 0x03, 0x83, 0x52, 0x89, 0x66, 0x45, 0x27, 0xb0, 0xc4, 0x7c, 0xfa,
+	0x5d, 0xe5, 0x85, 0x08, 0x5c, 0xe1, 0x6e, 0x40, 0x98, 0x4b, 0xfd, 0xba, 0x7c, 0x07, 0xa6, 0xb6,
+	0xb2, 0x05, 0x35, 0x39, 0x0a, 0xe1, 0xe4, 0x28, 0xec, 0xe9, 0xc9, 0xb1, 0x93, 0x7e, 0xf5, 0xcf,
+	0xea, 0xd8, 0x1f, 0xff, 0xae, 0x1a, 0x18, 0x09, 0x07, 0x92, 0xf8, 0x71, 0x08, 0xb7, 0x6e, 0x41,
+	0xb6, 0x9f, 0x67, 0xd8, 0x10, 0x0d, 0x30, 0xe3, 0x0e, 0x75, 0xda, 0x0e, 0x21, 0xcd, 0xb4, 0x6e,
+	0x84, 0x12, 0xf5, 0x3b, 0xda, 0x49, 0x09, 0x66, 0x38, 0x72, 0x62, 0x51, 0xd9, 0x13, 0x03, 0xe9,
+	0xbc, 0xfd, 0x80, 0x2f, 0x0d, 0xd9, 0x1a, 0x43, 0xee, 0xf8, 0x0d, 0x2c, 0x74, 0x5b, 0xe3, 0x2d,
+	0xc4, 0x9e, 0x8f, 0xda, 0x44, 0xeb, 0xd7, 0x19, 0xcc, 0x5d, 0xe9, 0x25, 0xf4, 0x2e, 0xac, 0x1c,
+	0x9d, 0x94, 0xf0, 0xd7, 0x15, 0x7c, 0x78, 0x72, 0x5c, 0xaa, 0x94, 0x8f, 0xb7, 0x8f, 0x4b, 0x95,
+	0x93, 0xa7, 0xe5, 0x67, 0xa5, 0xdd, 0xfd, 0xcf, 0xf7, 0x4b, 0x7b, 0xf3, 0x63, 0x28, 0x07, 0x66,
+	0xbf, 0xc9, 0xde, 0x7e, 0x79, 0x7b, 0xe7, 0xa0, 0xb4, 0x37, 0x6f, 0xa0, 0x15, 0xc8, 0xf6, 0x9f,
+	0x97, 0x9e, 0xaa, 0xe3, 0xf1, 0xad, 0x3f, 0xa7, 0x60, 0xa2, 0x4c, 0x82, 0x8e, 0x57, 0x23, 0xa8,
+	0x06, 0xe9, 0x70, 0xce, 0xa3, 0x61, 0x1d, 0x7f, 0x65, 0x71, 0x30, 0xef, 0x8f, 0x64, 0xab, 0xf3,
+	0xe8, 0xc3, 0x54, 0xcf, 0x3e, 0x81, 0x86, 0xe5, 0xae, 0x7f, 0x47, 0x31, 0x0b, 0xa3, 0x9a, 0xeb,
+	0x68, 0x6d, 0x98, 0xbd, 0xbc, 0x4e, 0xa0, 0x8f, 0x86, 0x78, 0x88, 0x5d, 0x55, 0xcc, 0xcd, 0x04,
+	0x08, 0x1d, 0xf6, 0x39, 0x5c, 0x97, 0x7b, 0x02, 0xfa, 0x60, 0x08, 0xb6, 0x77, 0xe3, 0x30, 0xf3,
+	0x6f, 0x36, 0x8c, 0x1a, 0x71, 0x42, 0x2f, 0x11, 0xe8, 0xde, 0x30, 0x66, 0x97, 0x56, 0x0f, 0x73,
+	0x7d, 0x14, 0x53, 0x1d, 0xe1, 0x67, 0x58, 0xe8, 0x5b, 0x3b, 0xd0, 0x83, 0x61, 0x4d, 0x3e, 0x60,
+	0x83, 0x31, 0x1f, 0x26, 0x03, 0xe9, 0xf8, 0x1e, 0x40, 0x77, 0xbf, 0x40, 0x1f, 0x8e, 0xb2, 0x47,
+	0x84, 0x5f, 0x06, 0x73, 0x63, 0x44, 0x6b, 0x1d, 0xea, 0x7b, 0x98, 0xbb, 0x32, 0xb4, 0xd1, 0xe6,
+	0xd0, 0xb7, 0x39, 0x6e, 0x49, 0x30, 0xb7, 0x92, 0x40, 0x74, 0xe4, 0x5f, 0x0d, 0x58, 0x8c, 0x99,
+	0xb1, 0xe8, 0x51, 0xa2, 0xe1, 0x16, 0xdd, 0xfb, 0xe3, 0xa4, 0x30, 0x4d, 0xe3, 0x37, 0x03, 0x6e,
+	0xc6, 0xce, 0x44, 0xf4, 0xc9, 0xf0, 0x57, 0x6d, 0xe0, 0xc0, 0x36, 0x1f, 0x27, 0x07, 0x6a, 0x32,
+	0x2f, 0xe3, 0x67, 0xe5, 0xc3, 0x44, 0xdf, 0xd7, 0x90, 0xc6, 0xa3, 0x84, 0xa8, 0xcb, 0x09, 0x89,
+	0xa1, 0xf1, 0x86, 0x84, 0x0c, 0x66, 0xf2, 0x38, 0x39, 0x50, 0x91, 0xd9, 0xd9, 0x7d, 0x75, 0x9e,
+	0x33, 0x5e, 0x9f, 0xe7, 0x8c, 0xff, 0xce, 0x73, 0xc6, 0xef, 0x17, 0xb9, 0xb1, 0xd7, 0x17, 0xb9,
+	0xb1, 0xbf, 0x2f, 0x72, 0x63, 0xcf, 0xef, 0x39, 0x1e, 0x77, 0xdb, 0xd5, 0x42, 0x8d, 0x36, 0x8a,
+	0xfa, 0x2f, 0xa8, 0xfa, 0xd9, 0x60, 0xf5, 0xb3, 0x22, 0x23, 0x41, 0x87, 0x04, 0xea, 0x1f, 0x68,
+	0xf5, 0x86, 0xdc, 0x04, 0x1e, 0xfc, 0x3f, 0x00, 0x25, 0x93, 0xf9, 0x89, 0xab, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// LogLevel returns the current log level of the node.
+	LogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// SetLogLevel changes the log level of the node.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the node state.
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	// Drain enables or disables the draining of the node. A draining node rejects
+	// the new requests made to its gRPC and REST API servers, so that it can be
+	// taken out of a load balancer before being stopped.
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	// Compact compacts the application database.
+	Compact(ctx context.Context, in *CompactRequest, opts ...grpc.CallOption) (*CompactResponse, error)
+	// RollbackPreflight reports whether the application state of the node can be
+	// rolled back by one height, without rolling it back.
+	RollbackPreflight(ctx context.Context, in *RollbackPreflightRequest, opts ...grpc.CallOption) (*RollbackPreflightResponse, error)
+	// IAVLCaches returns the size and the hit rate of the IAVL node cache of
+	// each store.
+	IAVLCaches(ctx context.Context, in *IAVLCachesRequest, opts ...grpc.CallOption) (*IAVLCachesResponse, error)
+	// ResizeIAVLCache changes the size of the IAVL node cache of a store. The
+	// cache is resized when the next block is committed.
+	ResizeIAVLCache(ctx context.Context, in *ResizeIAVLCacheRequest, opts ...grpc.CallOption) (*ResizeIAVLCacheResponse, error)
+	// QueryRouteOverrides returns the local overrides of the gRPC query routes
+	// of the node.
+	QueryRouteOverrides(ctx context.Context, in *QueryRouteOverridesRequest, opts ...grpc.CallOption) (*QueryRouteOverridesResponse, error)
+	// SetQueryRouteOverride disables or enables a gRPC query route on the node,
+	// whatever the state of the query circuit breaker of the chain, or removes
+	// the override of the route. The overrides are kept until the node is
+	// restarted.
+	SetQueryRouteOverride(ctx context.Context, in *SetQueryRouteOverrideRequest, opts ...grpc.CallOption) (*SetQueryRouteOverrideResponse, error)
+	// RequestLogSettings returns the settings of the logging of the requests
+	// served by the gRPC server of the node.
+	RequestLogSettings(ctx context.Context, in *RequestLogSettingsRequest, opts ...grpc.CallOption) (*RequestLogSettingsResponse, error)
+	// SetRequestLogSettings changes the settings of the logging of the requests
+	// served by the gRPC server of the node. The settings are kept until the
+	// node is restarted.
+	SetRequestLogSettings(ctx context.Context, in *SetRequestLogSettingsRequest, opts ...grpc.CallOption) (*SetRequestLogSettingsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) LogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/LogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Compact(ctx context.Context, in *CompactRequest, opts ...grpc.CallOption) (*CompactResponse, error) {
+	out := new(CompactResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/Compact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RollbackPreflight(ctx context.Context, in *RollbackPreflightRequest, opts ...grpc.CallOption) (*RollbackPreflightResponse, error) {
+	out := new(RollbackPreflightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/RollbackPreflight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) IAVLCaches(ctx context.Context, in *IAVLCachesRequest, opts ...grpc.CallOption) (*IAVLCachesResponse, error) {
+	out := new(IAVLCachesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/IAVLCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResizeIAVLCache(ctx context.Context, in *ResizeIAVLCacheRequest, opts ...grpc.CallOption) (*ResizeIAVLCacheResponse, error) {
+	out := new(ResizeIAVLCacheResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/ResizeIAVLCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) QueryRouteOverrides(ctx context.Context, in *QueryRouteOverridesRequest, opts ...grpc.CallOption) (*QueryRouteOverridesResponse, error) {
+	out := new(QueryRouteOverridesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/QueryRouteOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetQueryRouteOverride(ctx context.Context, in *SetQueryRouteOverrideRequest, opts ...grpc.CallOption) (*SetQueryRouteOverrideResponse, error) {
+	out := new(SetQueryRouteOverrideResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetQueryRouteOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RequestLogSettings(ctx context.Context, in *RequestLogSettingsRequest, opts ...grpc.CallOption) (*RequestLogSettingsResponse, error) {
+	out := new(RequestLogSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/RequestLogSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetRequestLogSettings(ctx context.Context, in *SetRequestLogSettingsRequest, opts ...grpc.CallOption) (*SetRequestLogSettingsResponse, error) {
+	out := new(SetRequestLogSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetRequestLogSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// LogLevel returns the current log level of the node.
+	LogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
+	// SetLogLevel changes the log level of the node.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the node state.
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	// Drain enables or disables the draining of the node. A draining node rejects
+	// the new requests made to its gRPC and REST API servers, so that it can be
+	// taken out of a load balancer before being stopped.
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	// Compact compacts the application database.
+	Compact(context.Context, *CompactRequest) (*CompactResponse, error)
+	// RollbackPreflight reports whether the application state of the node can be
+	// rolled back by one height, without rolling it back.
+	RollbackPreflight(context.Context, *RollbackPreflightRequest) (*RollbackPreflightResponse, error)
+	// IAVLCaches returns the size and the hit rate of the IAVL node cache of
+	// each store.
+	IAVLCaches(context.Context, *IAVLCachesRequest) (*IAVLCachesResponse, error)
+	// ResizeIAVLCache changes the size of the IAVL node cache of a store. The
+	// cache is resized when the next block is committed.
+	ResizeIAVLCache(context.Context, *ResizeIAVLCacheRequest) (*ResizeIAVLCacheResponse, error)
+	// QueryRouteOverrides returns the local overrides of the gRPC query routes
+	// of the node.
+	QueryRouteOverrides(context.Context, *QueryRouteOverridesRequest) (*QueryRouteOverridesResponse, error)
+	// SetQueryRouteOverride disables or enables a gRPC query route on the node,
+	// whatever the state of the query circuit breaker of the chain, or removes
+	// the override of the route. The overrides are kept until the node is
+	// restarted.
+	SetQueryRouteOverride(context.Context, *SetQueryRouteOverrideRequest) (*SetQueryRouteOverrideResponse, error)
+	// RequestLogSettings returns the settings of the logging of the requests
+	// served by the gRPC server of the node.
+	RequestLogSettings(context.Context, *RequestLogSettingsRequest) (*RequestLogSettingsResponse, error)
+	// SetRequestLogSettings changes the settings of the logging of the requests
+	// served by the gRPC server of the node. The settings are kept until the
+	// node is restarted.
+	SetRequestLogSettings(context.Context, *SetRequestLogSettingsRequest) (*SetRequestLogSettingsResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) LogLevel(ctx context.Context, req *LogLevelRequest) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevel not implemented")
+}
+func (*UnimplementedServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedServiceServer) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedServiceServer) Drain(ctx context.Context, req *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (*UnimplementedServiceServer) Compact(ctx context.Context, req *CompactRequest) (*CompactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compact not implemented")
+}
+func (*UnimplementedServiceServer) RollbackPreflight(ctx context.Context, req *RollbackPreflightRequest) (*RollbackPreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPreflight not implemented")
+}
+func (*UnimplementedServiceServer) IAVLCaches(ctx context.Context, req *IAVLCachesRequest) (*IAVLCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IAVLCaches not implemented")
+}
+func (*UnimplementedServiceServer) ResizeIAVLCache(ctx context.Context, req *ResizeIAVLCacheRequest) (*ResizeIAVLCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeIAVLCache not implemented")
+}
+func (*UnimplementedServiceServer) QueryRouteOverrides(ctx context.Context, req *QueryRouteOverridesRequest) (*QueryRouteOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRouteOverrides not implemented")
+}
+func (*UnimplementedServiceServer) SetQueryRouteOverride(ctx context.Context, req *SetQueryRouteOverrideRequest) (*SetQueryRouteOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueryRouteOverride not implemented")
+}
+func (*UnimplementedServiceServer) RequestLogSettings(ctx context.Context, req *RequestLogSettingsRequest) (*RequestLogSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLogSettings not implemented")
+}
+func (*UnimplementedServiceServer) SetRequestLogSettings(ctx context.Context, req *SetRequestLogSettingsRequest) (*SetRequestLogSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequestLogSettings not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_LogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/LogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Compact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/Compact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Compact(ctx, req.(*CompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RollbackPreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RollbackPreflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/RollbackPreflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RollbackPreflight(ctx, req.(*RollbackPreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_IAVLCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IAVLCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).IAVLCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/IAVLCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).IAVLCaches(ctx, req.(*IAVLCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResizeIAVLCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeIAVLCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResizeIAVLCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/ResizeIAVLCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResizeIAVLCache(ctx, req.(*ResizeIAVLCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_QueryRouteOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).QueryRouteOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/QueryRouteOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).QueryRouteOverrides(ctx, req.(*QueryRouteOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetQueryRouteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueryRouteOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetQueryRouteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetQueryRouteOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetQueryRouteOverride(ctx, req.(*SetQueryRouteOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RequestLogSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLogSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RequestLogSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/RequestLogSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RequestLogSettings(ctx, req.(*RequestLogSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetRequestLogSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequestLogSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetRequestLogSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetRequestLogSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetRequestLogSettings(ctx, req.(*SetRequestLogSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.admin.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogLevel",
+			Handler:    _Service_LogLevel_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Service_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Service_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Service_Drain_Handler,
+		},
+		{
+			MethodName: "Compact",
+			Handler:    _Service_Compact_Handler,
+		},
+		{
+			MethodName: "RollbackPreflight",
+			Handler:    _Service_RollbackPreflight_Handler,
+		},
+		{
+			MethodName: "IAVLCaches",
+			Handler:    _Service_IAVLCaches_Handler,
+		},
+		{
+			MethodName: "ResizeIAVLCache",
+			Handler:    _Service_ResizeIAVLCache_Handler,
+		},
+		{
+			MethodName: "QueryRouteOverrides",
+			Handler:    _Service_QueryRouteOverrides_Handler,
+		},
+		{
+			MethodName: "SetQueryRouteOverride",
+			Handler:    _Service_SetQueryRouteOverride_Handler,
+		},
+		{
+			MethodName: "RequestLogSettings",
+			Handler:    _Service_RequestLogSettings_Handler,
+		},
+		{
+			MethodName: "SetRequestLogSettings",
+			Handler:    _Service_SetRequestLogSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/admin/v1beta1/admin.proto",
+}
+
+func (m *LogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousLevel) > 0 {
+		i -= len(m.PreviousLevel)
+		copy(dAtA[i:], m.PreviousLevel)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PreviousLevel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Format != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackPreflightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPreflightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPreflightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackPreflightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPreflightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPreflightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IAVLCachesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IAVLCachesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IAVLCachesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IAVLCachesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IAVLCachesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IAVLCachesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caches) > 0 {
+		for iNdEx := len(m.Caches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Caches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IAVLCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IAVLCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IAVLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HitRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HitRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Misses != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Hits != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResizeIAVLCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResizeIAVLCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResizeIAVLCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResizeIAVLCacheResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResizeIAVLCacheResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResizeIAVLCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PreviousSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetQueryRouteOverrideRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetQueryRouteOverrideRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetQueryRouteOverrideRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Override != nil {
+		{
+			size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetQueryRouteOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetQueryRouteOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetQueryRouteOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousState != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PreviousState))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestLogSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLogSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLogSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlowQueryThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlowQueryThreshold):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAdmin(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.SampleRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SampleRate))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestLogSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLogSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLogSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestLogSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLogSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLogSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRequestLogSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRequestLogSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRequestLogSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRequestLogSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRequestLogSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRequestLogSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PreviousSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetLogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousLevel)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAdmin(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CreateSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAdmin(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovAdmin(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovAdmin(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	return n
+}
+
+func (m *DrainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Draining {
+		n += 2
+	}
+	return n
+}
+
+func (m *CompactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CompactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RollbackPreflightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RollbackPreflightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAdmin(uint64(m.Height))
+	}
+	if m.TargetHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.TargetHeight))
+	}
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *IAVLCachesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IAVLCachesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Caches) > 0 {
+		for _, e := range m.Caches {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IAVLCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAdmin(uint64(m.Size_))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovAdmin(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovAdmin(uint64(m.Misses))
+	}
+	if m.HitRate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ResizeIAVLCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAdmin(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *ResizeIAVLCacheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PreviousSize))
+	}
+	return n
+}
+
+func (m *QueryRouteOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovAdmin(uint64(m.State))
+	}
+	return n
+}
+
+func (m *QueryRouteOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRouteOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetQueryRouteOverrideRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Override != nil {
+		l = m.Override.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetQueryRouteOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousState != 0 {
+		n += 1 + sovAdmin(uint64(m.PreviousState))
+	}
+	return n
+}
+
+func (m *RequestLogSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.SampleRate != 0 {
+		n += 9
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlowQueryThreshold)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *RequestLogSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestLogSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settings.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *SetRequestLogSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settings.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *SetRequestLogSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PreviousSettings.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPreflightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPreflightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPreflightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPreflightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPreflightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPreflightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IAVLCachesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IAVLCachesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IAVLCachesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IAVLCachesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IAVLCachesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IAVLCachesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caches = append(m.Caches, &IAVLCache{})
+			if err := m.Caches[len(m.Caches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IAVLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IAVLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IAVLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HitRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResizeIAVLCacheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResizeIAVLCacheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResizeIAVLCacheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResizeIAVLCacheResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResizeIAVLCacheResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResizeIAVLCacheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSize", wireType)
+			}
+			m.PreviousSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= QueryRouteState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteOverridesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteOverridesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, &QueryRouteOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetQueryRouteOverrideRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetQueryRouteOverrideRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetQueryRouteOverrideRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Override == nil {
+				m.Override = &QueryRouteOverride{}
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetQueryRouteOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetQueryRouteOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetQueryRouteOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousState", wireType)
+			}
+			m.PreviousState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousState |= QueryRouteState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestLogSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLogSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLogSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SampleRate = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowQueryThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlowQueryThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestLogSettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLogSettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLogSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestLogSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLogSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLogSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRequestLogSettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRequestLogSettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRequestLogSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRequestLogSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRequestLogSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRequestLogSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,443 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// AuthorizationHeader is the gRPC metadata key carrying the admin token.
+	AuthorizationHeader = "authorization"
+	// bearerPrefix prefixes the admin token in the authorization metadata.
+	bearerPrefix = "Bearer "
+)
+
+// compacter is implemented by the database backends which can be compacted,
+// e.g. goleveldb.
+type compacter interface {
+	ForceCompact(start, limit []byte) error
+}
+
+// iavlCacheTuner is implemented by the multi stores whose IAVL caches can be
+// inspected and resized at runtime, e.g. rootmulti.Store.
+type iavlCacheTuner interface {
+	IAVLCaches() []rootmulti.IAVLCacheInfo
+	ResizeIAVLCache(name string, cacheSize int) error
+}
+
+// queryRouteOverrider is implemented by the applications whose query routes can
+// be disabled or enabled locally, e.g. baseapp.BaseApp.
+type queryRouteOverrider interface {
+	QueryRouteOverrides() map[string]bool
+	SetQueryRouteOverride(route string, enabled bool) error
+	RemoveQueryRouteOverride(route string)
+}
+
+var _ ServiceServer = (*Server)(nil)
+
+// Server implements the admin gRPC Service of a node.
+type Server struct {
+	logger   log.Logger
+	app      types.Application
+	db       dbm.DB
+	logLevel *servercmtlog.LevelFilter
+	// requestLogger is nil if the gRPC server of the node is disabled.
+	requestLogger *servergrpc.RequestLogger
+
+	draining atomic.Bool
+}
+
+// NewServer returns the admin Server of the node running app on db. The log
+// level filter may be nil, in which case the log level cannot be changed.
+func NewServer(logger log.Logger, app types.Application, db dbm.DB, logLevel *servercmtlog.LevelFilter) *Server {
+	return &Server{
+		logger:   logger,
+		app:      app,
+		db:       db,
+		logLevel: logLevel,
+	}
+}
+
+// LogLevel implements the Service/LogLevel gRPC method.
+func (s *Server) LogLevel(_ context.Context, _ *LogLevelRequest) (*LogLevelResponse, error) {
+	if s.logLevel == nil {
+		return nil, status.Error(codes.Unimplemented, "the log level cannot be changed at runtime")
+	}
+
+	return &LogLevelResponse{Level: s.logLevel.Level()}, nil
+}
+
+// SetLogLevel implements the Service/SetLogLevel gRPC method.
+func (s *Server) SetLogLevel(_ context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if s.logLevel == nil {
+		return nil, status.Error(codes.Unimplemented, "the log level cannot be changed at runtime")
+	}
+
+	previous := s.logLevel.Level()
+	if err := s.logLevel.SetLevel(req.Level); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.logger.Info("changed log level", "level", req.Level, "previous", previous)
+	return &SetLogLevelResponse{PreviousLevel: previous}, nil
+}
+
+// CreateSnapshot implements the Service/CreateSnapshot gRPC method.
+func (s *Server) CreateSnapshot(_ context.Context, req *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	manager := s.app.SnapshotManager()
+	if manager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "state sync snapshots are not enabled")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = uint64(s.app.CommitMultiStore().LastCommitID().Version)
+	}
+
+	snapshot, err := manager.Create(height)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to create snapshot at height %d: %s", height, err)
+	}
+
+	s.logger.Info("created snapshot", "height", snapshot.Height, "format", snapshot.Format, "chunks", snapshot.Chunks)
+	return &CreateSnapshotResponse{
+		Height: snapshot.Height,
+		Format: snapshot.Format,
+		Chunks: snapshot.Chunks,
+		Hash:   snapshot.Hash,
+	}, nil
+}
+
+// Drain implements the Service/Drain gRPC method.
+func (s *Server) Drain(_ context.Context, req *DrainRequest) (*DrainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if s.draining.Swap(req.Enable) != req.Enable {
+		s.logger.Info("changed node draining", "draining", req.Enable)
+	}
+	return &DrainResponse{Draining: req.Enable}, nil
+}
+
+// Compact implements the Service/Compact gRPC method.
+func (s *Server) Compact(_ context.Context, _ *CompactRequest) (*CompactResponse, error) {
+	db, ok := s.db.(compacter)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the %T database backend does not support compaction", s.db)
+	}
+
+	s.logger.Info("compacting application database")
+	if err := db.ForceCompact(nil, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compact the application database: %s", err)
+	}
+
+	s.logger.Info("compacted application database")
+	return &CompactResponse{}, nil
+}
+
+// RollbackPreflight implements the Service/RollbackPreflight gRPC method.
+func (s *Server) RollbackPreflight(_ context.Context, _ *RollbackPreflightRequest) (*RollbackPreflightResponse, error) {
+	cms := s.app.CommitMultiStore()
+	height := cms.LastCommitID().Version
+
+	res := &RollbackPreflightResponse{Height: height, TargetHeight: height - 1}
+	if res.TargetHeight <= 0 {
+		res.Reason = "no committed state to roll back to"
+		return res, nil
+	}
+
+	if _, err := cms.CacheMultiStoreWithVersion(res.TargetHeight); err != nil {
+		res.Reason = fmt.Sprintf("state at height %d is not available: %s", res.TargetHeight, err)
+		return res, nil
+	}
+
+	res.Ok = true
+	return res, nil
+}
+
+// IAVLCaches implements the Service/IAVLCaches gRPC method.
+func (s *Server) IAVLCaches(_ context.Context, _ *IAVLCachesRequest) (*IAVLCachesResponse, error) {
+	cms, ok := s.app.CommitMultiStore().(iavlCacheTuner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the multi store of the application does not expose its iavl caches")
+	}
+
+	infos := cms.IAVLCaches()
+	res := &IAVLCachesResponse{Caches: make([]*IAVLCache, len(infos))}
+	for i, info := range infos {
+		res.Caches[i] = &IAVLCache{
+			Store:   info.Name,
+			Size_:   uint64(info.Size),
+			Hits:    info.Hits,
+			Misses:  info.Misses,
+			HitRate: info.HitRate(),
+		}
+	}
+
+	return res, nil
+}
+
+// ResizeIAVLCache implements the Service/ResizeIAVLCache gRPC method.
+func (s *Server) ResizeIAVLCache(_ context.Context, req *ResizeIAVLCacheRequest) (*ResizeIAVLCacheResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Size_ > math.MaxInt32 {
+		return nil, status.Errorf(codes.InvalidArgument, "iavl cache size %d is too large", req.Size_)
+	}
+
+	cms, ok := s.app.CommitMultiStore().(iavlCacheTuner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the iavl caches of the application cannot be resized")
+	}
+
+	var previous int
+	for _, info := range cms.IAVLCaches() {
+		if info.Name == req.Store {
+			previous = info.Size
+		}
+	}
+
+	if err := cms.ResizeIAVLCache(req.Store, int(req.Size_)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.logger.Info("scheduled iavl cache resize", "store_key", req.Store, "size", req.Size_, "previous", previous)
+	return &ResizeIAVLCacheResponse{PreviousSize: uint64(previous)}, nil
+}
+
+// QueryRouteOverrides implements the Service/QueryRouteOverrides gRPC method.
+func (s *Server) QueryRouteOverrides(_ context.Context, _ *QueryRouteOverridesRequest) (*QueryRouteOverridesResponse, error) {
+	app, ok := s.app.(queryRouteOverrider)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the query routes of the application cannot be overridden")
+	}
+
+	overrides := app.QueryRouteOverrides()
+	res := &QueryRouteOverridesResponse{Overrides: make([]*QueryRouteOverride, 0, len(overrides))}
+	for route, enabled := range overrides {
+		res.Overrides = append(res.Overrides, &QueryRouteOverride{Route: route, State: queryRouteState(enabled, true)})
+	}
+	sort.Slice(res.Overrides, func(i, j int) bool { return res.Overrides[i].Route < res.Overrides[j].Route })
+
+	return res, nil
+}
+
+// SetQueryRouteOverride implements the Service/SetQueryRouteOverride gRPC method.
+func (s *Server) SetQueryRouteOverride(_ context.Context, req *SetQueryRouteOverrideRequest) (*SetQueryRouteOverrideResponse, error) {
+	if req == nil || req.Override == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	app, ok := s.app.(queryRouteOverrider)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the query routes of the application cannot be overridden")
+	}
+
+	route, state := "/"+strings.TrimPrefix(req.Override.Route, "/"), req.Override.State
+	enabled, overridden := app.QueryRouteOverrides()[route]
+	previous := queryRouteState(enabled, overridden)
+
+	switch state {
+	case QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED:
+		app.RemoveQueryRouteOverride(route)
+	case QueryRouteState_QUERY_ROUTE_STATE_DISABLED, QueryRouteState_QUERY_ROUTE_STATE_ENABLED:
+		if err := app.SetQueryRouteOverride(route, state == QueryRouteState_QUERY_ROUTE_STATE_ENABLED); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown query route state %s", state)
+	}
+
+	s.logger.Info("changed query route override", "route", route, "state", state, "previous", previous)
+	return &SetQueryRouteOverrideResponse{PreviousState: previous}, nil
+}
+
+// queryRouteState returns the state of a query route override.
+func queryRouteState(enabled, overridden bool) QueryRouteState {
+	switch {
+	case !overridden:
+		return QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED
+	case enabled:
+		return QueryRouteState_QUERY_ROUTE_STATE_ENABLED
+	default:
+		return QueryRouteState_QUERY_ROUTE_STATE_DISABLED
+	}
+}
+
+// RequestLogSettings implements the Service/RequestLogSettings gRPC method.
+func (s *Server) RequestLogSettings(_ context.Context, _ *RequestLogSettingsRequest) (*RequestLogSettingsResponse, error) {
+	requestLogger := s.requestLogger
+	if requestLogger == nil {
+		return nil, status.Error(codes.Unimplemented, "the gRPC server of the node is not enabled")
+	}
+
+	return &RequestLogSettingsResponse{Settings: toRequestLogSettings(requestLogger.Settings())}, nil
+}
+
+// SetRequestLogSettings implements the Service/SetRequestLogSettings gRPC method.
+func (s *Server) SetRequestLogSettings(_ context.Context, req *SetRequestLogSettingsRequest) (*SetRequestLogSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	requestLogger := s.requestLogger
+	if requestLogger == nil {
+		return nil, status.Error(codes.Unimplemented, "the gRPC server of the node is not enabled")
+	}
+
+	previous := requestLogger.Settings()
+	settings := servergrpc.RequestLogSettings{
+		Enabled:            req.Settings.Enabled,
+		SampleRate:         req.Settings.SampleRate,
+		SlowQueryThreshold: req.Settings.SlowQueryThreshold,
+	}
+	if err := requestLogger.SetSettings(settings); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.logger.Info("changed gRPC request log settings", "enabled", settings.Enabled, "sample_rate", settings.SampleRate,
+		"slow_query_threshold", settings.SlowQueryThreshold.String())
+	return &SetRequestLogSettingsResponse{PreviousSettings: toRequestLogSettings(previous)}, nil
+}
+
+// toRequestLogSettings returns the settings of a request logger as a proto
+// message.
+func toRequestLogSettings(settings servergrpc.RequestLogSettings) RequestLogSettings {
+	return RequestLogSettings{
+		Enabled:            settings.Enabled,
+		SampleRate:         settings.SampleRate,
+		SlowQueryThreshold: settings.SlowQueryThreshold,
+	}
+}
+
+// SetRequestLogger sets the logger of the requests served by the gRPC server
+// of the node, whose settings are exposed by the service. It must be called
+// before the service is served.
+func (s *Server) SetRequestLogger(requestLogger *servergrpc.RequestLogger) {
+	s.requestLogger = requestLogger
+}
+
+// Draining returns true if the node is draining.
+func (s *Server) Draining() bool {
+	return s.draining.Load()
+}
+
+// UnaryServerInterceptor returns the interceptor rejecting the unary requests
+// made while the node is draining. It is meant to be installed on the public
+// gRPC server of the node.
+func (s *Server) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if s.Draining() {
+			return nil, status.Error(codes.Unavailable, "node is draining")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor rejecting the streams opened
+// while the node is draining.
+func (s *Server) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if s.Draining() {
+			return status.Error(codes.Unavailable, "node is draining")
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authenticate returns an error if the metadata of ctx does not carry token.
+func authenticate(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(AuthorizationHeader) {
+		got, ok := strings.CutPrefix(value, bearerPrefix)
+		if ok && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+// NewGRPCServer returns a gRPC server serving srv, which authenticates every
+// call with token.
+func NewGRPCServer(srv *Server, token string) *grpc.Server {
+	grpcSrv := grpc.NewServer(
+		grpc.ForceServerCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()),
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := authenticate(ctx, token); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authenticate(ss.Context(), token); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+
+	RegisterServiceServer(grpcSrv, srv)
+	gogoreflection.Register(grpcSrv)
+	return grpcSrv
+}
+
+// StartGRPCServer starts the admin gRPC server on the address specified in cfg.
+//
+// Note, this creates a blocking process until ctx is canceled or the server
+// fails.
+func StartGRPCServer(ctx context.Context, logger log.Logger, cfg config.AdminConfig, grpcSrv *grpc.Server) error {
+	listener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", cfg.Address, err)
+	}
+
+	errCh := make(chan error)
+	go func() {
+		logger.Info("starting admin gRPC server...", "address", cfg.Address)
+		errCh <- grpcSrv.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Info("stopping admin gRPC server...", "address", cfg.Address)
+		grpcSrv.GracefulStop()
+		return nil
+
+	case err := <-errCh:
+		logger.Error("failed to start admin gRPC server", "err", err)
+		return err
+	}
+}
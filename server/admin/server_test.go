@@ -0,0 +1,237 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// testApp is an application exposing a multi store.
+type testApp struct {
+	types.Application
+	cms storetypes.CommitMultiStore
+}
+
+func (app testApp) CommitMultiStore() storetypes.CommitMultiStore {
+	return app.cms
+}
+
+func TestAuthenticate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		md    metadata.MD
+		valid bool
+	}{
+		{"no metadata", nil, false},
+		{"valid token", metadata.Pairs(AuthorizationHeader, "Bearer secret"), true},
+		{"invalid token", metadata.Pairs(AuthorizationHeader, "Bearer wrong"), false},
+		{"missing bearer prefix", metadata.Pairs(AuthorizationHeader, "secret"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			err := authenticate(ctx, "secret")
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewServer(log.NewNopLogger(), nil, nil, nil).LogLevel(ctx, &LogLevelRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	levelFilter, err := servercmtlog.NewLevelFilter("info")
+	require.NoError(t, err)
+	srv := NewServer(log.NewNopLogger(), nil, nil, levelFilter)
+
+	res, err := srv.SetLogLevel(ctx, &SetLogLevelRequest{Level: "consensus:debug,*:error"})
+	require.NoError(t, err)
+	require.Equal(t, "info", res.PreviousLevel)
+	require.False(t, levelFilter.Filter("consensus", "debug"))
+	require.True(t, levelFilter.Filter("state", "info"))
+
+	_, err = srv.SetLogLevel(ctx, &SetLogLevelRequest{Level: "consensus:verbose"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	level, err := srv.LogLevel(ctx, &LogLevelRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "consensus:debug,*:error", level.Level)
+}
+
+func TestDrain(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(log.NewNopLogger(), nil, nil, nil)
+	interceptor := srv.UnaryServerInterceptor()
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	drain, err := srv.Drain(ctx, &DrainRequest{Enable: true})
+	require.NoError(t, err)
+	require.True(t, drain.Draining)
+
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = srv.Drain(ctx, &DrainRequest{Enable: false})
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+}
+
+func TestCompactUnsupportedBackend(t *testing.T) {
+	srv := NewServer(log.NewNopLogger(), nil, dbm.NewMemDB(), nil)
+
+	_, err := srv.Compact(context.Background(), &CompactRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestIAVLCaches(t *testing.T) {
+	ctx := context.Background()
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(storetypes.NewKVStoreKey("staking"), storetypes.StoreTypeIAVL, nil)
+	cms.SetIAVLCacheSize(100)
+	cms.SetIAVLStoreCacheSize("staking", 200)
+	require.NoError(t, cms.LoadLatestVersion())
+	srv := NewServer(log.NewNopLogger(), testApp{cms: cms}, db, nil)
+
+	res, err := srv.IAVLCaches(ctx, &IAVLCachesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Caches, 2)
+	require.Equal(t, "bank", res.Caches[0].Store)
+	require.Equal(t, uint64(100), res.Caches[0].Size_)
+	require.Equal(t, "staking", res.Caches[1].Store)
+	require.Equal(t, uint64(200), res.Caches[1].Size_)
+
+	_, err = srv.ResizeIAVLCache(ctx, &ResizeIAVLCacheRequest{Store: "gov", Size_: 300})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resize, err := srv.ResizeIAVLCache(ctx, &ResizeIAVLCacheRequest{Store: "bank", Size_: 300})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), resize.PreviousSize)
+
+	cms.Commit()
+	res, err = srv.IAVLCaches(ctx, &IAVLCachesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), res.Caches[0].Size_)
+}
+
+// overridingApp is an application whose query routes can be overridden.
+type overridingApp struct {
+	types.Application
+	overrides map[string]bool
+}
+
+func (app overridingApp) QueryRouteOverrides() map[string]bool {
+	return app.overrides
+}
+
+func (app overridingApp) SetQueryRouteOverride(route string, enabled bool) error {
+	if route == "/cosmos.bank.v1beta1.Query/Unknown" {
+		return errors.New("unknown query route")
+	}
+	app.overrides[route] = enabled
+	return nil
+}
+
+func (app overridingApp) RemoveQueryRouteOverride(route string) {
+	delete(app.overrides, route)
+}
+
+func TestQueryRouteOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewServer(log.NewNopLogger(), testApp{}, nil, nil).QueryRouteOverrides(ctx, &QueryRouteOverridesRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	srv := NewServer(log.NewNopLogger(), overridingApp{overrides: map[string]bool{}}, nil, nil)
+	set := func(route string, state QueryRouteState) (*SetQueryRouteOverrideResponse, error) {
+		return srv.SetQueryRouteOverride(ctx, &SetQueryRouteOverrideRequest{Override: &QueryRouteOverride{Route: route, State: state}})
+	}
+
+	res, err := set("cosmos.bank.v1beta1.Query/DenomOwners", QueryRouteState_QUERY_ROUTE_STATE_DISABLED)
+	require.NoError(t, err)
+	require.Equal(t, QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED, res.PreviousState)
+	res, err = set("/cosmos.bank.v1beta1.Query/DenomOwners", QueryRouteState_QUERY_ROUTE_STATE_ENABLED)
+	require.NoError(t, err)
+	require.Equal(t, QueryRouteState_QUERY_ROUTE_STATE_DISABLED, res.PreviousState)
+	_, err = set("/cosmos.bank.v1beta1.Query/*", QueryRouteState_QUERY_ROUTE_STATE_DISABLED)
+	require.NoError(t, err)
+
+	_, err = set("/cosmos.bank.v1beta1.Query/Unknown", QueryRouteState_QUERY_ROUTE_STATE_DISABLED)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	overrides, err := srv.QueryRouteOverrides(ctx, &QueryRouteOverridesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*QueryRouteOverride{
+		{Route: "/cosmos.bank.v1beta1.Query/*", State: QueryRouteState_QUERY_ROUTE_STATE_DISABLED},
+		{Route: "/cosmos.bank.v1beta1.Query/DenomOwners", State: QueryRouteState_QUERY_ROUTE_STATE_ENABLED},
+	}, overrides.Overrides)
+
+	res, err = set("/cosmos.bank.v1beta1.Query/*", QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.Equal(t, QueryRouteState_QUERY_ROUTE_STATE_DISABLED, res.PreviousState)
+	overrides, err = srv.QueryRouteOverrides(ctx, &QueryRouteOverridesRequest{})
+	require.NoError(t, err)
+	require.Len(t, overrides.Overrides, 1)
+}
+
+func TestRequestLogSettings(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(log.NewNopLogger(), nil, nil, nil)
+
+	_, err := srv.RequestLogSettings(ctx, &RequestLogSettingsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	requestLogger, err := servergrpc.NewRequestLogger(log.NewNopLogger(), config.GRPCConfig{SlowQueryThreshold: time.Second})
+	require.NoError(t, err)
+	srv.SetRequestLogger(requestLogger)
+
+	settings := RequestLogSettings{Enabled: true, SampleRate: 0.1, SlowQueryThreshold: 500 * time.Millisecond}
+	res, err := srv.SetRequestLogSettings(ctx, &SetRequestLogSettingsRequest{Settings: settings})
+	require.NoError(t, err)
+	require.Equal(t, RequestLogSettings{SlowQueryThreshold: time.Second}, res.PreviousSettings)
+	require.Equal(t, servergrpc.RequestLogSettings{Enabled: true, SampleRate: 0.1, SlowQueryThreshold: 500 * time.Millisecond}, requestLogger.Settings())
+
+	// invalid settings are rejected and leave the settings untouched
+	_, err = srv.SetRequestLogSettings(ctx, &SetRequestLogSettingsRequest{Settings: RequestLogSettings{SampleRate: 1.5}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	current, err := srv.RequestLogSettings(ctx, &RequestLogSettingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, settings, current.Settings)
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/admin"
+)
+
+const (
+	flagAdminToken         = "token"
+	flagEnabled            = "enabled"
+	flagSampleRate         = "sample-rate"
+	flagSlowQueryThreshold = "slow-query-threshold"
+)
+
+// NewAdminCmd creates a command calling the admin gRPC service of a running
+// node. The address and the token of the service are read from app.toml,
+// unless overridden by flags.
+func NewAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Perform operational actions on a running node through its admin gRPC service",
+		Long: `Perform operational actions on a running node through its admin gRPC service.
+The service must be enabled in the [admin] section of app.toml of the node.`,
+	}
+
+	cmd.PersistentFlags().String(FlagAdminAddress, "", "The admin gRPC server address of the node (defaults to the address in app.toml)")
+	cmd.PersistentFlags().String(flagAdminToken, "", "The admin token of the node (defaults to the token in app.toml)")
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "log-level [level]",
+			Short: "Query or change the log level of the node, e.g. \"info\" or \"consensus:debug,*:error\"",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error) {
+				if len(args) == 0 {
+					return client.LogLevel(ctx, &admin.LogLevelRequest{})
+				}
+				return client.SetLogLevel(ctx, &admin.SetLogLevelRequest{Level: args[0]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "snapshot [height]",
+			Short: "Take a state sync snapshot at the given height, or at the latest height if omitted",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error) {
+				var height uint64
+				if len(args) == 1 {
+					var err error
+					if height, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+						return nil, fmt.Errorf("invalid height: %w", err)
+					}
+				}
+				return client.CreateSnapshot(ctx, &admin.CreateSnapshotRequest{Height: height})
+			}),
+		},
+		&cobra.Command{
+			Use:   "drain [true|false]",
+			Short: "Start or stop rejecting the new gRPC and REST API requests made to the node",
+			Args:  cobra.ExactArgs(1),
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error) {
+				enable, err := strconv.ParseBool(args[0])
+				if err != nil {
+					return nil, fmt.Errorf("invalid drain value: %w", err)
+				}
+				return client.Drain(ctx, &admin.DrainRequest{Enable: enable})
+			}),
+		},
+		&cobra.Command{
+			Use:   "compact",
+			Short: "Compact the application database of the node",
+			Args:  cobra.NoArgs,
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, _ []string) (gogoproto.Message, error) {
+				return client.Compact(ctx, &admin.CompactRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "rollback-preflight",
+			Short: "Check whether the application state can be rolled back by one height",
+			Args:  cobra.NoArgs,
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, _ []string) (gogoproto.Message, error) {
+				return client.RollbackPreflight(ctx, &admin.RollbackPreflightRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "iavl-cache [store] [size]",
+			Short: "Query the size and hit rate of the IAVL cache of each store, or resize the cache of a store",
+			Long: `Query the size and hit rate of the IAVL cache of each store, or resize the cache
+of a store. The cache is resized when the next block is committed, and keeps its
+size until the node is restarted.`,
+			Args: func(_ *cobra.Command, args []string) error {
+				if len(args) != 0 && len(args) != 2 {
+					return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+				}
+				return nil
+			},
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error) {
+				if len(args) == 0 {
+					return client.IAVLCaches(ctx, &admin.IAVLCachesRequest{})
+				}
+
+				size, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid cache size: %w", err)
+				}
+				return client.ResizeIAVLCache(ctx, &admin.ResizeIAVLCacheRequest{Store: args[0], Size_: size})
+			}),
+		},
+		&cobra.Command{
+			Use:   "query-route [route] [disabled|enabled|none]",
+			Short: "Query the local overrides of the gRPC query routes, or disable or enable a query route on the node",
+			Long: `Query the local overrides of the gRPC query routes, or disable or enable a query
+route on the node, whatever the state of the query circuit breaker of the chain.
+The route is a full gRPC method name, e.g. "/cosmos.bank.v1beta1.Query/DenomOwners",
+or a glob pattern, e.g. "/cosmos.bank.v1beta1.Query/*". "none" removes the override
+of the route. The overrides are kept until the node is restarted.`,
+			Args: func(_ *cobra.Command, args []string) error {
+				if len(args) != 0 && len(args) != 2 {
+					return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+				}
+				return nil
+			},
+			RunE: runAdminCmd(func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error) {
+				if len(args) == 0 {
+					return client.QueryRouteOverrides(ctx, &admin.QueryRouteOverridesRequest{})
+				}
+
+				var state admin.QueryRouteState
+				switch args[1] {
+				case "disabled":
+					state = admin.QueryRouteState_QUERY_ROUTE_STATE_DISABLED
+				case "enabled":
+					state = admin.QueryRouteState_QUERY_ROUTE_STATE_ENABLED
+				case "none":
+					state = admin.QueryRouteState_QUERY_ROUTE_STATE_UNSPECIFIED
+				default:
+					return nil, fmt.Errorf("invalid query route state %s, expected disabled, enabled or none", args[1])
+				}
+				return client.SetQueryRouteOverride(ctx, &admin.SetQueryRouteOverrideRequest{
+					Override: &admin.QueryRouteOverride{Route: args[0], State: state},
+				})
+			}),
+		},
+		newRequestLogCmd(),
+	)
+
+	return cmd
+}
+
+// newRequestLogCmd creates the command querying or changing the settings of the
+// logging of the gRPC requests of the node. Only the settings whose flags are
+// set are changed.
+func newRequestLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-log",
+		Short: "Query or change the settings of the logging of the requests served by the gRPC server of the node",
+		Long: `Query or change the settings of the logging of the requests served by the gRPC
+server of the node. Only the settings whose flags are set are changed, and they
+are kept until the node is restarted.`,
+		Example: "request-log --enabled --sample-rate 0.1 --slow-query-threshold 500ms",
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.Flags().Bool(flagEnabled, false, "Log the gRPC requests")
+	cmd.Flags().Float64(flagSampleRate, 0, "The fraction of the requests, between 0 and 1, which are logged")
+	cmd.Flags().Duration(flagSlowQueryThreshold, 0, "The latency above which a request is always logged, 0 disabling it")
+
+	cmd.RunE = runAdminCmd(func(ctx context.Context, client admin.ServiceClient, _ []string) (gogoproto.Message, error) {
+		res, err := client.RequestLogSettings(ctx, &admin.RequestLogSettingsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		flags := cmd.Flags()
+		if !flags.Changed(flagEnabled) && !flags.Changed(flagSampleRate) && !flags.Changed(flagSlowQueryThreshold) {
+			return res, nil
+		}
+
+		settings := res.Settings
+		if flags.Changed(flagEnabled) {
+			settings.Enabled, _ = flags.GetBool(flagEnabled)
+		}
+		if flags.Changed(flagSampleRate) {
+			settings.SampleRate, _ = flags.GetFloat64(flagSampleRate)
+		}
+		if flags.Changed(flagSlowQueryThreshold) {
+			settings.SlowQueryThreshold, _ = flags.GetDuration(flagSlowQueryThreshold)
+		}
+		return client.SetRequestLogSettings(ctx, &admin.SetRequestLogSettingsRequest{Settings: settings})
+	})
+
+	return cmd
+}
+
+// runAdminCmd returns a cobra RunE function dialing the admin gRPC service and
+// printing the response of call as JSON.
+func runAdminCmd(call func(ctx context.Context, client admin.ServiceClient, args []string) (gogoproto.Message, error)) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		serverCtx := GetServerContextFromCmd(cmd)
+
+		address, _ := cmd.Flags().GetString(FlagAdminAddress)
+		if address == "" {
+			address = serverCtx.Viper.GetString(FlagAdminAddress)
+		}
+		token, _ := cmd.Flags().GetString(flagAdminToken)
+		if token == "" {
+			token = serverCtx.Viper.GetString("admin.token")
+		}
+
+		conn, err := grpc.Dial(
+			address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec())),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial the admin gRPC server at %s: %w", address, err)
+		}
+		defer conn.Close()
+
+		ctx := metadata.AppendToOutgoingContext(cmd.Context(), admin.AuthorizationHeader, "Bearer "+token)
+		res, err := call(ctx, admin.NewServiceClient(conn), args)
+		if err != nil {
+			return err
+		}
+
+		bz, err := codec.ProtoMarshalJSON(res, nil)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(bz))
+		return nil
+	}
+}
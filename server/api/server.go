@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/log"
@@ -41,8 +42,16 @@ type Server struct {
 	// this mutex to avoid data races.
 	mtx      sync.Mutex
 	listener net.Listener
+
+	// inFlight is the number of requests being served, drained by
+	// WaitInFlight when the node shuts down.
+	inFlight atomic.Int64
 }
 
+// inFlightPollInterval is the interval at which WaitInFlight checks whether
+// the requests being served are drained.
+const inFlightPollInterval = 10 * time.Millisecond
+
 // CustomGRPCHeaderMatcher for mapping request headers to
 // GRPC metadata.
 // HTTP headers that start with 'Grpc-Metadata-' are automatically mapped to
@@ -147,11 +156,12 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	go func(enableUnsafeCORS bool) {
 		s.logger.Info("starting API server...", "address", cfg.API.Address)
 
+		handler := s.trackInFlight(s.Router)
 		if enableUnsafeCORS {
 			allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(s.Router), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(handler), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		} else {
-			errCh <- tmrpcserver.Serve(s.listener, s.Router, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, handler, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		}
 	}(cfg.API.EnableUnsafeCORS)
 
@@ -177,6 +187,33 @@ func (s *Server) Close() error {
 	return s.listener.Close()
 }
 
+// WaitInFlight waits for the requests being served to complete, or for ctx to
+// be done. It is called once the server is closed, so that the node does not
+// shut down while serving requests.
+func (s *Server) WaitInFlight(ctx context.Context) error {
+	ticker := time.NewTicker(inFlightPollInterval)
+	defer ticker.Stop()
+
+	for s.inFlight.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d API requests still in flight: %w", s.inFlight.Load(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// trackInFlight counts the requests being served by h.
+func (s *Server) trackInFlight(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+		h.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) SetTelemetry(m *telemetry.Metrics) {
 	s.mtx.Lock()
 	s.metrics = m
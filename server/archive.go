@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// rpcArchiveQuerier forwards ABCI queries to the CometBFT RPC endpoint of an
+// archive node.
+type rpcArchiveQuerier struct {
+	client *rpchttp.HTTP
+}
+
+var _ baseapp.ArchiveQuerier = rpcArchiveQuerier{}
+
+// NewRPCArchiveQuerier returns an ArchiveQuerier forwarding the queries to the
+// CometBFT RPC endpoint of an archive node, e.g. "http://archive-node:26657".
+func NewRPCArchiveQuerier(address string, timeout time.Duration) (baseapp.ArchiveQuerier, error) {
+	client, err := rpchttp.NewWithTimeout(address, "/websocket", uint(timeout.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcArchiveQuerier{client: client}, nil
+}
+
+// ABCIQuery implements baseapp.ArchiveQuerier.
+func (q rpcArchiveQuerier) ABCIQuery(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	res, err := q.client.ABCIQueryWithOptions(ctx, req.Path, req.Data, rpcclient.ABCIQueryOptions{
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Response, nil
+}
@@ -3,7 +3,10 @@ package config
 import (
 	"fmt"
 	"math"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -30,6 +33,26 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultGRPCSlowQueryThreshold defines the default latency above which gRPC
+	// requests are logged as slow.
+	DefaultGRPCSlowQueryThreshold = time.Second
+
+	// DefaultArchiveTimeout defines the default timeout of the queries forwarded
+	// to the archive node.
+	DefaultArchiveTimeout = 10 * time.Second
+
+	// DefaultShutdownTimeout defines the default time given to the node to
+	// shut down gracefully.
+	DefaultShutdownTimeout = 30 * time.Second
+
+	// DefaultTxStatusCacheSize defines the default number of txs whose status in
+	// the lifecycle of the mempool is tracked.
+	DefaultTxStatusCacheSize = 10_000
+
+	// DefaultAdminAddress defines the default address to bind the admin gRPC
+	// server to.
+	DefaultAdminAddress = "localhost:9095"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -72,9 +95,22 @@ type BaseConfig struct {
 	// ResponseCommit.RetainHeight.
 	MinRetainBlocks uint64 `mapstructure:"min-retain-blocks"`
 
+	// QueryGasLimit defines the gas limit of the queries served by the node. The
+	// query routes exceeding it are reported by the node service. 0 disables the
+	// limit.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheMaxBytes bounds the size in bytes of the keys and values in
+	// the inter-block cache of each store. 0 disables the bound.
+	InterBlockCacheMaxBytes uint64 `mapstructure:"inter-block-cache-max-bytes"`
+
+	// InterBlockCacheStores defines the names of the stores cached in the
+	// inter-block cache. If empty, all stores are cached.
+	InterBlockCacheStores []string `mapstructure:"inter-block-cache-stores"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -82,6 +118,10 @@ type BaseConfig struct {
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
+	// IAVLStoreCacheSizes overrides the size of the iavl tree cache of some
+	// stores, in the form {storeName}={size}.
+	IAVLStoreCacheSizes []string `mapstructure:"iavl-store-cache-sizes"`
+
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
@@ -91,6 +131,18 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// ShutdownTimeout bounds the time given to the node to shut down gracefully,
+	// i.e. to drain the in-flight requests, finish the snapshot chunk being saved,
+	// flush the telemetry and close the stores. The remaining steps are skipped
+	// once it expires.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
+
+	// ConfigReloadInterval defines how often app.toml is checked for changes to
+	// the settings which can be applied without a restart: the query gas limit,
+	// the pruning interval, the iavl cache sizes and whether telemetry is
+	// enabled. 0 disables the reload.
+	ConfigReloadInterval time.Duration `mapstructure:"config-reload-interval"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -139,6 +191,17 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// LogRequests defines if the gRPC server should log the requests it handles.
+	LogRequests bool `mapstructure:"log-requests"`
+
+	// LogSampleRate defines the fraction of requests, between 0 and 1, that are
+	// logged when LogRequests is enabled.
+	LogSampleRate float64 `mapstructure:"log-sample-rate"`
+
+	// SlowQueryThreshold defines the latency above which a request is always
+	// logged when LogRequests is enabled. 0 disables slow query logging.
+	SlowQueryThreshold time.Duration `mapstructure:"slow-query-threshold"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -166,6 +229,35 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// TxStatusCacheSize defines the number of txs whose status in the lifecycle
+	// of the mempool (accepted, pending, evicted or included) is tracked by the
+	// node. Zero disables the tracking.
+	TxStatusCacheSize int `mapstructure:"tx-status-cache-size"`
+}
+
+// ArchiveConfig defines the configuration of the archive node to which the
+// queries for heights pruned from this node are forwarded.
+type ArchiveConfig struct {
+	// RPCAddress defines the CometBFT RPC address of the archive node. An empty
+	// address disables the forwarding.
+	RPCAddress string `mapstructure:"rpc-address"`
+
+	// Timeout defines the timeout of the queries forwarded to the archive node.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// AdminConfig defines the configuration of the admin gRPC service of the node.
+type AdminConfig struct {
+	// Enable defines if the admin gRPC service should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the admin gRPC server binds to. It must be a
+	// loopback address.
+	Address string `mapstructure:"address"`
+
+	// Token defines the token the admin gRPC calls must be authenticated with.
+	Token string `mapstructure:"token"`
 }
 
 // State Streaming configuration
@@ -194,6 +286,8 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
+	Admin     AdminConfig      `mapstructure:"admin"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -238,6 +332,7 @@ func DefaultConfig() *Config {
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
 			AppDBBackend:        "",
+			ShutdownTimeout:     DefaultShutdownTimeout,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -256,6 +351,10 @@ func DefaultConfig() *Config {
 			Address:        DefaultGRPCAddress,
 			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
 			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+
+			LogRequests:        false,
+			LogSampleRate:      0,
+			SlowQueryThreshold: DefaultGRPCSlowQueryThreshold,
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable: true,
@@ -271,7 +370,17 @@ func DefaultConfig() *Config {
 			},
 		},
 		Mempool: MempoolConfig{
-			MaxTxs: 5_000,
+			MaxTxs:            5_000,
+			TxStatusCacheSize: DefaultTxStatusCacheSize,
+		},
+		Archive: ArchiveConfig{
+			RPCAddress: "",
+			Timeout:    DefaultArchiveTimeout,
+		},
+		Admin: AdminConfig{
+			Enable:  false,
+			Address: DefaultAdminAddress,
+			Token:   "",
 		},
 	}
 }
@@ -295,6 +404,69 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.GRPC.LogSampleRate < 0 || c.GRPC.LogSampleRate > 1 {
+		return sdkerrors.ErrAppConfig.Wrapf("gRPC log-sample-rate must be between 0 and 1, got %v", c.GRPC.LogSampleRate)
+	}
+	if c.ShutdownTimeout <= 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("shutdown timeout must be positive, got %v", c.ShutdownTimeout)
+	}
+	if c.ConfigReloadInterval < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("config reload interval cannot be negative, got %v", c.ConfigReloadInterval)
+	}
+	if c.Archive.RPCAddress != "" && c.Archive.Timeout <= 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("archive timeout must be positive, got %v", c.Archive.Timeout)
+	}
+	if _, err := ParseIAVLStoreCacheSizes(c.IAVLStoreCacheSizes); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+	if c.Admin.Enable {
+		if c.Admin.Token == "" {
+			return sdkerrors.ErrAppConfig.Wrap("admin token must be set when the admin service is enabled")
+		}
+		if !isLoopbackAddress(c.Admin.Address) {
+			return sdkerrors.ErrAppConfig.Wrapf("admin address must be a loopback address, got %s", c.Admin.Address)
+		}
+	}
 
 	return nil
 }
+
+// ParseIAVLStoreCacheSizes parses the iavl cache sizes of the stores, given in
+// the form {storeName}={size}.
+func ParseIAVLStoreCacheSizes(entries []string) (map[string]int, error) {
+	sizes := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		name, value, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid iavl store cache size %q, expected {storeName}={size}", entry)
+		}
+
+		size, err := strconv.ParseUint(strings.TrimSpace(value), 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid iavl cache size of store %s: %w", name, err)
+		}
+		if _, ok := sizes[name]; ok {
+			return nil, fmt.Errorf("duplicate iavl cache size of store %s", name)
+		}
+
+		sizes[name] = int(size)
+	}
+
+	return sizes, nil
+}
+
+// isLoopbackAddress returns true if address is a host:port address whose host
+// is localhost or a loopback IP.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -208,3 +208,37 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestAdminConfigValidation(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Admin.Enable = true
+	require.ErrorContains(t, cfg.ValidateBasic(), "admin token must be set")
+
+	cfg.Admin.Token = "secret"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Admin.Address = "127.0.0.1:9095"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Admin.Address = "0.0.0.0:9095"
+	require.ErrorContains(t, cfg.ValidateBasic(), "admin address must be a loopback address")
+}
+
+func TestParseIAVLStoreCacheSizes(t *testing.T) {
+	sizes, err := ParseIAVLStoreCacheSizes([]string{"bank=1000", " staking = 2000 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"bank": 1000, "staking": 2000}, sizes)
+
+	for _, entries := range [][]string{{"bank"}, {"=1000"}, {"bank=-1"}, {"bank=1000", "bank=2000"}} {
+		_, err := ParseIAVLStoreCacheSizes(entries)
+		require.Error(t, err, entries)
+	}
+
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.IAVLStoreCacheSizes = []string{"bank:1000"}
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid iavl store cache size")
+}
@@ -60,9 +60,27 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # ResponseCommit.RetainHeight.
 min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 
+# QueryGasLimit defines the gas limit of the queries served by the node. The
+# query routes exceeding it are reported by the RouteStatus query of the node
+# service. 0 disables the limit.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheMaxBytes bounds the size in bytes of the keys and values in the
+# inter-block cache of each store, in addition to its number of entries. 0
+# disables the bound.
+inter-block-cache-max-bytes = {{ .BaseConfig.InterBlockCacheMaxBytes }}
+
+# InterBlockCacheStores defines the names of the stores cached in the inter-block
+# cache. If empty, all stores are cached. The cache hits and misses of each store
+# are reported to telemetry when it is enabled.
+#
+# Example:
+# ["staking", "params", "bank"]
+inter-block-cache-stores = [{{ range .BaseConfig.InterBlockCacheStores }}{{ printf "%q, " . }}{{end}}]
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs CometBFT what to index. If empty, all events will be indexed.
 #
@@ -73,6 +91,15 @@ index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 # IavlCacheSize set the size of the iavl tree cache (in number of nodes).
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
+# IAVLStoreCacheSizes overrides the size of the iavl tree cache of some stores,
+# in the form {storeName}={size}. The cache hits and misses of each store are
+# reported to telemetry when it is enabled, and the cache of a store can be
+# resized at runtime with the admin service.
+#
+# Example:
+# ["bank=1000000", "staking=2000000"]
+iavl-store-cache-sizes = [{{ range .BaseConfig.IAVLStoreCacheSizes }}{{ printf "%q, " . }}{{end}}]
+
 # IAVLDisableFastNode enables or disables the fast node feature of IAVL. 
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
@@ -87,6 +114,19 @@ iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# ShutdownTimeout bounds the time given to the node to shut down gracefully,
+# i.e. to drain the in-flight API and gRPC requests, finish the state sync
+# snapshot chunk being saved, flush the telemetry and close the stores.
+shutdown-timeout = "{{ .BaseConfig.ShutdownTimeout }}"
+
+# ConfigReloadInterval defines how often this file is checked for changes to the
+# settings which are applied without a restart: query-gas-limit,
+# pruning-interval (with the custom pruning strategy), iavl-cache-size,
+# iavl-store-cache-sizes and telemetry.enabled (when telemetry was enabled at
+# startup). Each applied change is logged; changes to the other settings are
+# only logged as requiring a restart. 0 disables the reload.
+config-reload-interval = "{{ .BaseConfig.ConfigReloadInterval }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -172,6 +212,17 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# LogRequests defines if the gRPC server should log the requests it handles.
+log-requests = {{ .GRPC.LogRequests }}
+
+# LogSampleRate defines the fraction of requests, between 0 and 1, that are
+# logged when log-requests is enabled.
+log-sample-rate = {{ .GRPC.LogSampleRate }}
+
+# SlowQueryThreshold defines the latency above which a request is always logged
+# when log-requests is enabled, e.g. "500ms". 0 disables slow query logging.
+slow-query-threshold = "{{ .GRPC.SlowQueryThreshold }}"
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -236,6 +287,43 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# tx-status-cache-size is the number of txs whose status in the lifecycle of the mempool
+# (accepted, pending, evicted or included) is tracked by the node, and queryable through
+# the node TxStatus query. Setting it to 0 disables the tracking.
+tx-status-cache-size = {{ .Mempool.TxStatusCacheSize }}
+
+###############################################################################
+###                         Archive Node                                    ###
+###############################################################################
+
+[archive]
+# rpc-address defines the CometBFT RPC address of an archive node, e.g. "http://archive-node:26657".
+# When set, the queries for heights pruned from this node are transparently forwarded to the archive
+# node, so that pruned and archive nodes can be served behind the same endpoint. The proofs of the
+# forwarded store queries are verified against the app hashes committed by this node.
+# An empty address disables the forwarding.
+rpc-address = "{{ .Archive.RPCAddress }}"
+
+# timeout defines the timeout of the queries forwarded to the archive node.
+timeout = "{{ .Archive.Timeout }}"
+
+###############################################################################
+###                         Admin Configuration                             ###
+###############################################################################
+
+[admin]
+# Enable defines if the admin gRPC service should be enabled. The service exposes the operational
+# actions which can be performed on a running node without restarting it: changing the log level,
+# taking a state sync snapshot, draining the node, compacting the database and checking a rollback.
+enable = {{ .Admin.Enable }}
+
+# Address defines the address the admin gRPC server binds to. It must be a loopback address.
+address = "{{ .Admin.Address }}"
+
+# Token defines the token the admin gRPC calls must be authenticated with, passed in the
+# "authorization" metadata as "Bearer <token>". It is required when the service is enabled.
+token = "{{ .Admin.Token }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,272 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// The app.toml keys of the settings a ConfigWatcher applies without a restart.
+const (
+	configKeyQueryGasLimit       = "query-gas-limit"
+	configKeyPruningInterval     = "pruning-interval"
+	configKeyIAVLCacheSize       = "iavl-cache-size"
+	configKeyIAVLStoreCacheSizes = "iavl-store-cache-sizes"
+	configKeyTelemetryEnabled    = "telemetry.enabled"
+)
+
+// queryGasLimitUpdater is implemented by the applications whose query gas limit
+// can be changed at runtime, e.g. baseapp.BaseApp.
+type queryGasLimitUpdater interface {
+	UpdateQueryGasLimit(limit uint64)
+}
+
+// pruningIntervalUpdater is implemented by the multi stores whose pruning
+// interval can be changed at runtime, e.g. rootmulti.Store.
+type pruningIntervalUpdater interface {
+	UpdatePruningInterval(interval uint64) error
+}
+
+// iavlCacheResizer is implemented by the multi stores whose IAVL caches can be
+// resized at runtime, e.g. rootmulti.Store.
+type iavlCacheResizer interface {
+	IAVLCaches() []rootmulti.IAVLCacheInfo
+	ResizeIAVLCache(name string, cacheSize int) error
+}
+
+// ConfigWatcher applies the changes made to app.toml while the node runs to
+// the settings which are safe to change without a restart: the query gas
+// limit, the pruning interval of the custom pruning strategy, the iavl cache
+// sizes and, if telemetry was enabled at startup, whether metrics are
+// collected. Each applied change is logged with its previous and new values.
+// The changes to the other settings are logged as requiring a restart.
+//
+// The changes are detected against the previous content of the file, so that
+// the settings overridden by flags keep their value until they are edited.
+type ConfigWatcher struct {
+	logger  log.Logger
+	path    string
+	app     types.Application
+	metrics *telemetry.Metrics
+
+	modTime  time.Time
+	settings map[string]interface{}
+	config   serverconfig.Config
+}
+
+// NewConfigWatcher returns a ConfigWatcher of the app.toml file at path, whose
+// current content is the baseline of the changes. metrics is nil if telemetry
+// is disabled.
+func NewConfigWatcher(logger log.Logger, path string, app types.Application, metrics *telemetry.Metrics) (*ConfigWatcher, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, config, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfigWatcher{
+		logger:   logger,
+		path:     path,
+		app:      app,
+		metrics:  metrics,
+		modTime:  info.ModTime(),
+		settings: settings,
+		config:   config,
+	}, nil
+}
+
+// Run checks the file for changes every interval until ctx is canceled.
+func (w *ConfigWatcher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Reload()
+		}
+	}
+}
+
+// Reload applies the changes made to the file since it was last read, if it
+// was modified since.
+func (w *ConfigWatcher) Reload() {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		w.logger.Error("failed to stat config file", "path", w.path, "err", err)
+		return
+	}
+	if info.ModTime().Equal(w.modTime) {
+		return
+	}
+	// an invalid file is only reported once, until it is modified again
+	w.modTime = info.ModTime()
+
+	settings, config, err := readConfigFile(w.path)
+	if err != nil {
+		w.logger.Error("failed to reload config file", "path", w.path, "err", err)
+		return
+	}
+
+	reloaded := w.apply(config)
+
+	var restart []string
+	for _, key := range changedConfigKeys(w.settings, settings) {
+		if !reloaded[key] {
+			restart = append(restart, key)
+		}
+	}
+	if len(restart) > 0 {
+		w.logger.Info("config changes require a restart to take effect", "keys", restart)
+	}
+
+	w.settings = settings
+	w.config = config
+}
+
+// apply applies the changes of the reloadable settings from the previous
+// config to next, and returns the keys of the settings it handled.
+func (w *ConfigWatcher) apply(next serverconfig.Config) map[string]bool {
+	prev := w.config
+	reloaded := make(map[string]bool)
+
+	if next.QueryGasLimit != prev.QueryGasLimit {
+		if app, ok := w.app.(queryGasLimitUpdater); ok {
+			app.UpdateQueryGasLimit(next.QueryGasLimit)
+			w.logApplied(configKeyQueryGasLimit, prev.QueryGasLimit, next.QueryGasLimit)
+			reloaded[configKeyQueryGasLimit] = true
+		}
+	}
+
+	if next.PruningInterval != prev.PruningInterval &&
+		prev.Pruning == pruningtypes.PruningOptionCustom && next.Pruning == pruningtypes.PruningOptionCustom {
+		if cms, ok := w.app.CommitMultiStore().(pruningIntervalUpdater); ok {
+			reloaded[configKeyPruningInterval] = true
+			interval, err := strconv.ParseUint(next.PruningInterval, 10, 64)
+			if err == nil {
+				err = cms.UpdatePruningInterval(interval)
+			}
+			if err != nil {
+				w.logger.Error("failed to apply config change", "key", configKeyPruningInterval, "err", err)
+			} else {
+				w.logApplied(configKeyPruningInterval, prev.PruningInterval, next.PruningInterval)
+			}
+		}
+	}
+
+	if next.IAVLCacheSize != prev.IAVLCacheSize || !reflect.DeepEqual(next.IAVLStoreCacheSizes, prev.IAVLStoreCacheSizes) {
+		if cms, ok := w.app.CommitMultiStore().(iavlCacheResizer); ok {
+			reloaded[configKeyIAVLCacheSize] = true
+			reloaded[configKeyIAVLStoreCacheSizes] = true
+			w.resizeIAVLCaches(cms, prev, next)
+		}
+	}
+
+	if next.Telemetry.Enabled != prev.Telemetry.Enabled && w.metrics != nil {
+		w.metrics.SetEnabled(next.Telemetry.Enabled)
+		w.logApplied(configKeyTelemetryEnabled, prev.Telemetry.Enabled, next.Telemetry.Enabled)
+		reloaded[configKeyTelemetryEnabled] = true
+	}
+
+	return reloaded
+}
+
+// resizeIAVLCaches resizes the caches of the iavl stores whose size differs
+// between the previous and next configs.
+func (w *ConfigWatcher) resizeIAVLCaches(cms iavlCacheResizer, prev, next serverconfig.Config) {
+	prevSizes, err := serverconfig.ParseIAVLStoreCacheSizes(prev.IAVLStoreCacheSizes)
+	if err != nil {
+		// the previous sizes were either validated at startup or rejected below
+		prevSizes = map[string]int{}
+	}
+	nextSizes, err := serverconfig.ParseIAVLStoreCacheSizes(next.IAVLStoreCacheSizes)
+	if err != nil {
+		w.logger.Error("failed to apply config change", "key", configKeyIAVLStoreCacheSizes, "err", err)
+		return
+	}
+
+	cacheSize := func(cfg serverconfig.Config, sizes map[string]int, name string) int {
+		if size, ok := sizes[name]; ok {
+			return size
+		}
+		return int(cfg.IAVLCacheSize)
+	}
+
+	for _, info := range cms.IAVLCaches() {
+		previous, size := cacheSize(prev, prevSizes, info.Name), cacheSize(next, nextSizes, info.Name)
+		if previous == size {
+			continue
+		}
+
+		if err := cms.ResizeIAVLCache(info.Name, size); err != nil {
+			w.logger.Error("failed to apply config change", "key", configKeyIAVLCacheSize, "store_key", info.Name, "err", err)
+			continue
+		}
+		w.logger.Info("applied config change", "key", configKeyIAVLCacheSize, "store_key", info.Name, "previous", previous, "new", size)
+	}
+}
+
+func (w *ConfigWatcher) logApplied(key string, previous, next interface{}) {
+	w.logger.Info("applied config change", "key", key, "previous", previous, "new", next)
+}
+
+// readConfigFile reads the config file at path, and returns its settings by key
+// along with the parsed config.
+func readConfigFile(path string) (map[string]interface{}, serverconfig.Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, serverconfig.Config{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	config, err := serverconfig.GetConfig(v)
+	if err != nil {
+		return nil, serverconfig.Config{}, err
+	}
+
+	settings := make(map[string]interface{})
+	for _, key := range v.AllKeys() {
+		settings[key] = v.Get(key)
+	}
+
+	return settings, config, nil
+}
+
+// changedConfigKeys returns the sorted keys whose value differs between the
+// settings.
+func changedConfigKeys(prev, next map[string]interface{}) []string {
+	var keys []string
+	for key, value := range next {
+		if !reflect.DeepEqual(prev[key], value) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range prev {
+		if _, ok := next[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+type reloadableApp struct {
+	types.Application
+
+	cms           *rootmulti.Store
+	queryGasLimit uint64
+}
+
+func (app *reloadableApp) CommitMultiStore() storetypes.CommitMultiStore { return app.cms }
+
+func (app *reloadableApp) UpdateQueryGasLimit(limit uint64) { app.queryGasLimit = limit }
+
+func TestConfigWatcher(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.SetPruning(pruningtypes.NewCustomPruningOptions(100, 10))
+	cms.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(storetypes.NewKVStoreKey("staking"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	app := &reloadableApp{cms: cms}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	cfg := serverconfig.DefaultConfig()
+	cfg.Pruning = pruningtypes.PruningOptionCustom
+	cfg.PruningKeepRecent = "100"
+	cfg.PruningInterval = "10"
+	cfg.IAVLCacheSize = 1000
+	serverconfig.WriteConfigFile(path, cfg)
+
+	var buf bytes.Buffer
+	watcher, err := server.NewConfigWatcher(log.NewLogger(&buf, log.ColorOption(false)), path, app, nil)
+	require.NoError(t, err)
+
+	// an unmodified file is not reloaded
+	watcher.Reload()
+	require.Empty(t, buf.String())
+
+	cfg.QueryGasLimit = 500_000
+	cfg.PruningInterval = "20"
+	cfg.IAVLStoreCacheSizes = []string{"bank=2000"}
+	cfg.Telemetry.Enabled = true
+	cfg.MinRetainBlocks = 10
+	writeConfigFile(t, path, cfg)
+	watcher.Reload()
+
+	require.Equal(t, uint64(500_000), app.queryGasLimit)
+	logs := buf.String()
+	require.Contains(t, logs, "applied config change key=query-gas-limit new=500000 previous=0")
+	require.Contains(t, logs, "applied config change key=pruning-interval new=20 previous=10")
+	require.Contains(t, logs, "applied config change key=iavl-cache-size new=2000 previous=1000 store_key=bank")
+	require.NotContains(t, logs, "store_key=staking")
+	// telemetry was disabled at startup, so it cannot be enabled without a restart
+	require.Contains(t, logs, `config changes require a restart to take effect keys=["min-retain-blocks","telemetry.enabled"]`)
+
+	// the changes are applied to the store on the next commit
+	cms.Commit()
+	for _, info := range cms.IAVLCaches() {
+		if info.Name == "bank" {
+			require.Equal(t, 2000, info.Size)
+		}
+	}
+	require.Equal(t, uint64(20), cms.GetPruning().Interval)
+
+	// an invalid file is reported, and the previous settings are kept
+	buf.Reset()
+	require.NoError(t, os.WriteFile(path, []byte("query-gas-limit = "), 0o600))
+	touch(t, path)
+	watcher.Reload()
+	require.Contains(t, buf.String(), "failed to reload config file")
+	require.Equal(t, uint64(500_000), app.queryGasLimit)
+}
+
+// writeConfigFile writes cfg to path, with a modification time distinct from
+// the previous one regardless of the resolution of the file system clock.
+func writeConfigFile(t *testing.T, path string, cfg *serverconfig.Config) {
+	t.Helper()
+	serverconfig.WriteConfigFile(path, cfg)
+	touch(t, path)
+}
+
+func touch(t *testing.T, path string) {
+	t.Helper()
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	modTime := info.ModTime().Add(time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
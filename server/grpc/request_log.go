@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+var versionRegex = regexp.MustCompile(`^v\d+`)
+
+// RequestLogSettings defines the runtime settings of a RequestLogger.
+type RequestLogSettings struct {
+	// Enabled defines if requests are logged at all.
+	Enabled bool
+	// SampleRate is the fraction of requests, between 0 and 1, that are logged.
+	SampleRate float64
+	// SlowQueryThreshold is the latency above which a request is always logged.
+	// 0 disables slow query logging.
+	SlowQueryThreshold time.Duration
+}
+
+// Validate returns an error if the settings are invalid.
+func (s RequestLogSettings) Validate() error {
+	if s.SampleRate < 0 || s.SampleRate > 1 {
+		return fmt.Errorf("sample rate must be between 0 and 1, got %v", s.SampleRate)
+	}
+	if s.SlowQueryThreshold < 0 {
+		return fmt.Errorf("slow query threshold cannot be negative, got %s", s.SlowQueryThreshold)
+	}
+	return nil
+}
+
+// RequestLogger is a gRPC server interceptor logging a sample of the requests
+// handled by the server, as well as every request slower than a threshold.
+// Its settings can be updated at runtime through the admin service of the
+// node, see SetSettings.
+type RequestLogger struct {
+	logger log.Logger
+
+	mu       sync.RWMutex
+	settings RequestLogSettings
+}
+
+// NewRequestLogger returns a RequestLogger configured from the gRPC server
+// configuration.
+func NewRequestLogger(logger log.Logger, cfg config.GRPCConfig) (*RequestLogger, error) {
+	settings := RequestLogSettings{
+		Enabled:            cfg.LogRequests,
+		SampleRate:         cfg.LogSampleRate,
+		SlowQueryThreshold: cfg.SlowQueryThreshold,
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid gRPC request log configuration: %w", err)
+	}
+
+	return &RequestLogger{logger: logger, settings: settings}, nil
+}
+
+// Settings returns the current settings of the logger.
+func (l *RequestLogger) Settings() RequestLogSettings {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.settings
+}
+
+// SetSettings updates the settings of the logger.
+func (l *RequestLogger) SetSettings(settings RequestLogSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.settings = settings
+	return nil
+}
+
+// UnaryServerInterceptor returns the interceptor logging unary requests.
+func (l *RequestLogger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		settings := l.Settings()
+		if !settings.Enabled {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		l.log(settings, info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor logging streaming requests.
+// The logged duration is the lifetime of the stream.
+func (l *RequestLogger) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		settings := l.Settings()
+		if !settings.Enabled {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		err := handler(srv, ss)
+		l.log(settings, info.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+func (l *RequestLogger) log(settings RequestLogSettings, fullMethod string, duration time.Duration, err error) {
+	slow := settings.SlowQueryThreshold > 0 && duration >= settings.SlowQueryThreshold
+	if !slow && (settings.SampleRate == 0 || rand.Float64() >= settings.SampleRate) { //nolint:gosec // sampling does not need a secure source
+		return
+	}
+
+	module, method := splitFullMethod(fullMethod)
+	keyVals := []any{
+		"module", module,
+		"method", method,
+		"duration", duration.String(),
+		"code", status.Code(err).String(),
+	}
+
+	if slow {
+		l.logger.Info("slow gRPC request", append(keyVals, "threshold", settings.SlowQueryThreshold.String())...)
+		return
+	}
+	l.logger.Info("gRPC request", keyVals...)
+}
+
+// splitFullMethod returns the module and method labels of a gRPC full method
+// name, e.g. "bank" and "Balance" for "/cosmos.bank.v1beta1.Query/Balance".
+// The module is the last segment of the service package that is not a version.
+func splitFullMethod(fullMethod string) (module, method string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", fullMethod
+	}
+
+	segments := strings.Split(service, ".")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if !versionRegex.MatchString(segments[i]) {
+			return segments[i], method
+		}
+	}
+
+	return service, method
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestSplitFullMethod(t *testing.T) {
+	testCases := []struct {
+		fullMethod string
+		module     string
+		method     string
+	}{
+		{"/cosmos.bank.v1beta1.Query/Balance", "bank", "Balance"},
+		{"/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock", "tendermint", "GetLatestBlock"},
+		{"/ibc.core.client.v1.Query/ClientState", "client", "ClientState"},
+		{"/testpb.Query/Echo", "testpb", "Echo"},
+		{"malformed", "unknown", "malformed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fullMethod, func(t *testing.T) {
+			module, method := splitFullMethod(tc.fullMethod)
+			require.Equal(t, tc.module, module)
+			require.Equal(t, tc.method, method)
+		})
+	}
+}
+
+func TestRequestLoggerUnaryServerInterceptor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings RequestLogSettings
+		latency  time.Duration
+		expected string
+	}{
+		{
+			"disabled",
+			RequestLogSettings{Enabled: false, SampleRate: 1},
+			0,
+			"",
+		},
+		{
+			"sampled",
+			RequestLogSettings{Enabled: true, SampleRate: 1},
+			0,
+			"gRPC request",
+		},
+		{
+			"not sampled",
+			RequestLogSettings{Enabled: true, SampleRate: 0, SlowQueryThreshold: time.Hour},
+			0,
+			"",
+		},
+		{
+			"slow query",
+			RequestLogSettings{Enabled: true, SampleRate: 0, SlowQueryThreshold: time.Millisecond},
+			5 * time.Millisecond,
+			"slow gRPC request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l, err := NewRequestLogger(log.NewLogger(&buf, log.ColorOption(false)), config.GRPCConfig{})
+			require.NoError(t, err)
+			require.NoError(t, l.SetSettings(tc.settings))
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Balance"}
+			handler := func(ctx context.Context, req any) (any, error) {
+				time.Sleep(tc.latency)
+				return "res", nil
+			}
+
+			res, err := l.UnaryServerInterceptor()(context.Background(), "req", info, handler)
+			require.NoError(t, err)
+			require.Equal(t, "res", res)
+
+			if tc.expected == "" {
+				require.Empty(t, buf.String())
+				return
+			}
+			require.Contains(t, buf.String(), tc.expected)
+			require.Contains(t, buf.String(), "module=bank")
+			require.Contains(t, buf.String(), "method=Balance")
+			require.Contains(t, buf.String(), "code=OK")
+		})
+	}
+}
+
+func TestRequestLoggerSetSettings(t *testing.T) {
+	l, err := NewRequestLogger(log.NewNopLogger(), config.GRPCConfig{SlowQueryThreshold: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, RequestLogSettings{SlowQueryThreshold: time.Second}, l.Settings())
+
+	_, err = NewRequestLogger(log.NewNopLogger(), config.GRPCConfig{LogSampleRate: 2})
+	require.Error(t, err)
+
+	settings := RequestLogSettings{Enabled: true, SlowQueryThreshold: 500 * time.Millisecond}
+	require.NoError(t, l.SetSettings(settings))
+	require.Equal(t, settings, l.Settings())
+
+	// invalid settings are rejected and leave the settings untouched
+	require.Error(t, l.SetSettings(RequestLogSettings{SampleRate: 1.5}))
+	require.Error(t, l.SetSettings(RequestLogSettings{SlowQueryThreshold: -time.Second}))
+	require.Equal(t, settings, l.Settings())
+}
@@ -20,8 +20,9 @@ import (
 )
 
 // NewGRPCServer returns a correctly configured and initialized gRPC server.
+// Additional server options, e.g. interceptors, may be provided.
 // Note, the caller is responsible for starting the server. See StartGRPCServer.
-func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig) (*grpc.Server, error) {
+func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	maxSendMsgSize := cfg.MaxSendMsgSize
 	if maxSendMsgSize == 0 {
 		maxSendMsgSize = config.DefaultGRPCMaxSendMsgSize
@@ -32,11 +33,11 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 		maxRecvMsgSize = config.DefaultGRPCMaxRecvMsgSize
 	}
 
-	grpcSrv := grpc.NewServer(
+	grpcSrv := grpc.NewServer(append([]grpc.ServerOption{
 		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(maxSendMsgSize),
 		grpc.MaxRecvMsgSize(maxRecvMsgSize),
-	)
+	}, opts...)...)
 
 	app.RegisterGRPCServer(grpcSrv)
 
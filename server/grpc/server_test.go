@@ -3,6 +3,7 @@ package grpc_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -105,6 +106,30 @@ func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_StakingValidatorDelegationsStream() {
+	val0 := s.network.Validators[0]
+
+	// gRPC streaming queries should run on the state at the requested height
+	stakingClient := stakingtypes.NewQueryClient(s.conn)
+	stream, err := stakingClient.ValidatorDelegationsStream(
+		metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1"),
+		&stakingtypes.QueryValidatorDelegationsStreamRequest{ValidatorAddr: val0.ValAddress.String()},
+	)
+	s.Require().NoError(err)
+
+	res, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Len(res.DelegationResponses, 1)
+	s.Require().Equal(val0.Address.String(), res.DelegationResponses[0].Delegation.DelegatorAddress)
+
+	header, err := stream.Header()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	_, err = stream.Recv()
+	s.Require().ErrorIs(err, io.EOF)
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	// Test server reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"sync"
+
+	"cosmossdk.io/log"
+)
+
+// LevelFilter is a log filter whose log level can be changed at runtime.
+// Levels use the format of the log_level flag, e.g. "info" or
+// "consensus:debug,*:error".
+type LevelFilter struct {
+	mu     sync.RWMutex
+	level  string
+	filter log.FilterFunc
+}
+
+// NewLevelFilter returns a LevelFilter filtering the logs below level.
+func NewLevelFilter(level string) (*LevelFilter, error) {
+	f := &LevelFilter{}
+	if err := f.SetLevel(level); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Level returns the current log level.
+func (f *LevelFilter) Level() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.level
+}
+
+// SetLevel changes the log level. The level is left unchanged if it is invalid.
+func (f *LevelFilter) SetLevel(level string) error {
+	filter, err := log.ParseLogLevel(level)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.level, f.filter = level, filter
+	return nil
+}
+
+// Filter implements log.FilterFunc.
+func (f *LevelFilter) Filter(key, level string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.filter(key, level)
+}
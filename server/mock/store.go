@@ -138,6 +138,10 @@ func (ms multiStore) SetIAVLCacheSize(size int) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetIAVLStoreCacheSize(name string, size int) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetIAVLDisableFastNode(disable bool) {
 	panic("not implemented")
 }
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/log"
+)
+
+// ShutdownCoordinator shuts down the services of a node in the order their
+// steps are registered, within a timeout shared by all the steps. Each step is
+// given a context canceled once the timeout expires, upon which it must return
+// promptly. A step still running when the timeout expires is abandoned, and
+// the remaining steps are skipped as they may depend on it.
+type ShutdownCoordinator struct {
+	logger  log.Logger
+	timeout time.Duration
+	steps   []shutdownStep
+}
+
+type shutdownStep struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewShutdownCoordinator returns a ShutdownCoordinator with no steps.
+func NewShutdownCoordinator(logger log.Logger, timeout time.Duration) *ShutdownCoordinator {
+	return &ShutdownCoordinator{logger: logger, timeout: timeout}
+}
+
+// Register appends a shutdown step.
+func (c *ShutdownCoordinator) Register(name string, fn func(ctx context.Context) error) {
+	c.steps = append(c.steps, shutdownStep{name: name, fn: fn})
+}
+
+// Go runs the given service in g and appends its shutdown step. The context
+// passed to run is canceled by the step, which then waits for run to return.
+// If the timeout expires first, forceStop, if not nil, is called.
+func (c *ShutdownCoordinator) Go(g *errgroup.Group, name string, run func(ctx context.Context) error, forceStop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	g.Go(func() error {
+		defer close(done)
+		return run(ctx)
+	})
+
+	c.Register(name, func(shutdownCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+
+		case <-shutdownCtx.Done():
+			if forceStop != nil {
+				c.logger.Info("forcing stop", "service", name)
+				forceStop()
+			}
+			return shutdownCtx.Err()
+		}
+	})
+}
+
+// Shutdown runs the registered steps in order and returns their errors.
+func (c *ShutdownCoordinator) Shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var errs []error
+	for _, step := range c.steps {
+		if ctx.Err() != nil {
+			c.logger.Error("skipping shutdown step as the shutdown timed out", "step", step.name)
+			continue
+		}
+
+		c.logger.Info("shutting down", "step", step.name)
+		if err := runShutdownStep(ctx, step); err != nil {
+			errs = append(errs, fmt.Errorf("failed to shut down %s: %w", step.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runShutdownStep runs step until it returns or ctx is done, whichever comes
+// first.
+func runShutdownStep(ctx context.Context, step shutdownStep) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- step.fn(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+
+	case <-ctx.Done():
+		// prefer the result of a step returning as the timeout expires
+		select {
+		case err := <-result:
+			return err
+		default:
+			return ctx.Err()
+		}
+	}
+}
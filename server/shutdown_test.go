@@ -0,0 +1,70 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestShutdownCoordinator(t *testing.T) {
+	shutdown := server.NewShutdownCoordinator(log.NewNopLogger(), time.Second)
+
+	var order []string
+	g := new(errgroup.Group)
+	shutdown.Go(g, "service", func(ctx context.Context) error {
+		<-ctx.Done()
+		order = append(order, "service")
+		return nil
+	}, nil)
+	shutdown.Register("failing", func(context.Context) error {
+		order = append(order, "failing")
+		return errors.New("boom")
+	})
+	shutdown.Register("stores", func(context.Context) error {
+		order = append(order, "stores")
+		return nil
+	})
+
+	// the steps run in order, even after one fails
+	err := shutdown.Shutdown()
+	require.ErrorContains(t, err, "failed to shut down failing: boom")
+	require.Equal(t, []string{"service", "failing", "stores"}, order)
+	require.NoError(t, g.Wait())
+}
+
+func TestShutdownCoordinatorTimeout(t *testing.T) {
+	shutdown := server.NewShutdownCoordinator(log.NewNopLogger(), 50*time.Millisecond)
+
+	stop := make(chan struct{})
+	forced := false
+	g := new(errgroup.Group)
+	shutdown.Go(g, "service", func(ctx context.Context) error {
+		// ignores ctx, e.g. while draining long requests
+		<-stop
+		return nil
+	}, func() {
+		forced = true
+		close(stop)
+	})
+
+	skipped := true
+	shutdown.Register("stores", func(context.Context) error {
+		skipped = false
+		return nil
+	})
+
+	// the service is forced to stop, and the remaining steps are skipped
+	err := shutdown.Shutdown()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, g.Wait())
+	require.True(t, forced)
+	require.True(t, skipped)
+}
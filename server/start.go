@@ -7,6 +7,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
@@ -30,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/admin"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
@@ -56,12 +58,18 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
+	// inter-block cache flags
+	FlagInterBlockCacheMaxBytes = "inter-block-cache-max-bytes"
+	FlagInterBlockCacheStores   = "inter-block-cache-stores"
+
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
+	FlagQueryGasLimit       = "query-gas-limit"
 	FlagIAVLCacheSize       = "iavl-cache-size"
+	FlagIAVLStoreCacheSizes = "iavl-store-cache-sizes"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
 
@@ -86,7 +94,16 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs            = "mempool.max-txs"
+	FlagMempoolTxStatusCacheSize = "mempool.tx-status-cache-size"
+
+	// archive node flags
+	FlagArchiveRPCAddress = "archive.rpc-address"
+	FlagArchiveTimeout    = "archive.timeout"
+
+	// admin service flags
+	FlagAdminEnable  = "admin.enable"
+	FlagAdminAddress = "admin.address"
 )
 
 // StartCmdOptions defines options that can be customized in `StartCmdWithOptions`,
@@ -184,6 +201,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagInterBlockCacheMaxBytes, 0, "Maximum size in bytes of the keys and values in the inter-block cache of each store (0 for no limit)")
+	cmd.Flags().StringSlice(FlagInterBlockCacheStores, []string{}, "Names of the stores to cache in the inter-block cache (all stores if empty)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -191,6 +210,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "The gas limit of the queries served by the node (0 for no limit)")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
 	cmd.Flags().String(FlagAPIAddress, serverconfig.DefaultAPIAddress, "the API server address to listen on")
@@ -206,7 +226,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().StringSlice(FlagIAVLStoreCacheSizes, []string{}, "Sizes of the IAVL tree cache of some stores, in the form {storeName}={size}")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolTxStatusCacheSize, serverconfig.DefaultTxStatusCacheSize, "The number of txs whose status in the lifecycle of the mempool is tracked (0 disables the tracking)")
+	cmd.Flags().String(FlagArchiveRPCAddress, "", "The CometBFT RPC address of an archive node to which the queries for pruned heights are forwarded")
+	cmd.Flags().Duration(FlagArchiveTimeout, serverconfig.DefaultArchiveTimeout, "The timeout of the queries forwarded to the archive node")
+	cmd.Flags().Bool(FlagAdminEnable, false, "Define if the admin gRPC service should be enabled (the admin token must be set in app.toml)")
+	cmd.Flags().String(FlagAdminAddress, serverconfig.DefaultAdminAddress, "The loopback address the admin gRPC server listens on")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -232,7 +258,7 @@ func start(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreato
 		return err
 	}
 
-	app, appCleanupFn, err := startApp(svrCtx, appCreator, opts)
+	app, db, appCleanupFn, err := startApp(svrCtx, appCreator, opts)
 	if err != nil {
 		return err
 	}
@@ -248,7 +274,7 @@ func start(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreato
 	if !withCmt {
 		return startStandAlone(svrCtx, app, opts)
 	}
-	return startInProcess(svrCtx, svrCfg, clientCtx, app, metrics, opts)
+	return startInProcess(svrCtx, svrCfg, clientCtx, app, db, metrics, opts)
 }
 
 func startStandAlone(svrCtx *Context, app types.Application, opts StartCmdOptions) error {
@@ -282,20 +308,33 @@ func startStandAlone(svrCtx *Context, app types.Application, opts StartCmdOption
 }
 
 func startInProcess(svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app types.Application,
-	metrics *telemetry.Metrics, opts StartCmdOptions,
+	db dbm.DB, metrics *telemetry.Metrics, opts StartCmdOptions,
 ) error {
 	cmtCfg := svrCtx.Config
 	home := cmtCfg.RootDir
 
 	gRPCOnly := svrCtx.Viper.GetBool(flagGRPCOnly)
 
+	g, ctx := getCtx(svrCtx, true)
+
+	// The services are shut down in the order they are registered with the
+	// coordinator: the API and gRPC servers drain their in-flight requests, the
+	// node stops committing blocks, then the telemetry is flushed and the stores
+	// are closed.
+	shutdown := NewShutdownCoordinator(svrCtx.Logger.With("module", "shutdown"), svrCfg.ShutdownTimeout)
+
+	var tmNode *node.Node
 	if gRPCOnly {
 		// TODO: Generalize logic so that gRPC only is really in startStandAlone
 		svrCtx.Logger.Info("starting node in gRPC only mode; CometBFT is disabled")
 		svrCfg.GRPC.Enable = true
 	} else {
 		svrCtx.Logger.Info("starting node with ABCI CometBFT in-process")
-		tmNode, cleanupFn, err := startCmtNode(cmtCfg, app, svrCtx)
+		var (
+			cleanupFn func()
+			err       error
+		)
+		tmNode, cleanupFn, err = startCmtNode(cmtCfg, app, svrCtx)
 		if err != nil {
 			return err
 		}
@@ -315,24 +354,70 @@ func startInProcess(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clien
 		}
 	}
 
-	g, ctx := getCtx(svrCtx, true)
+	var adminSrv *admin.Server
+	if svrCfg.Admin.Enable {
+		adminSrv = admin.NewServer(svrCtx.Logger.With("module", "admin-server"), app, db, svrCtx.LogLevel)
+	}
 
-	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, clientCtx, svrCtx, app)
+	grpcSrv, clientCtx, err := newGrpcServer(svrCfg.GRPC, clientCtx, svrCtx, app, adminSrv)
 	if err != nil {
 		return err
 	}
 
-	err = startAPIServer(ctx, g, cmtCfg, svrCfg, clientCtx, svrCtx, app, home, grpcSrv, metrics)
-	if err != nil {
+	// the API server is shut down before the gRPC server, as the gRPC gateway
+	// forwards its requests to the gRPC server
+	if err := startAPIServer(g, shutdown, cmtCfg, svrCfg, clientCtx, svrCtx, app, home, grpcSrv, metrics); err != nil {
 		return err
 	}
 
+	startGrpcServer(g, shutdown, svrCfg.GRPC, svrCtx, grpcSrv)
+
+	if adminSrv != nil {
+		startAdminServer(g, shutdown, svrCfg.Admin, svrCtx, adminSrv)
+	}
+
+	if svrCfg.ConfigReloadInterval > 0 {
+		configFile := filepath.Join(home, "config", "app.toml")
+		watcher, err := NewConfigWatcher(svrCtx.Logger.With("module", "config-watcher"), configFile, app, metrics)
+		if err != nil {
+			return err
+		}
+		shutdown.Go(g, "config watcher", func(ctx context.Context) error {
+			return watcher.Run(ctx, svrCfg.ConfigReloadInterval)
+		}, nil)
+	}
+
+	if tmNode != nil {
+		shutdown.Register("CometBFT node", func(context.Context) error {
+			return tmNode.Stop()
+		})
+	}
+
+	if metrics != nil {
+		shutdown.Register("telemetry", func(context.Context) error {
+			metrics.Shutdown()
+			return nil
+		})
+	}
+
+	shutdown.Register("application", func(context.Context) error {
+		if err := app.Close(); err != nil {
+			return err
+		}
+		return db.Close()
+	})
+
 	if opts.PostSetup != nil {
 		if err := opts.PostSetup(svrCtx, clientCtx, ctx, g); err != nil {
 			return err
 		}
 	}
 
+	g.Go(func() error {
+		<-ctx.Done()
+		return shutdown.Shutdown()
+	})
+
 	// wait for signal capture and gracefully return
 	// we are guaranteed to be waiting for the "ListenForQuitSignals" goroutine.
 	return g.Wait()
@@ -424,13 +509,14 @@ func setupTraceWriter(svrCtx *Context) (traceWriter io.WriteCloser, cleanup func
 	return traceWriter, cleanup, nil
 }
 
-func startGrpcServer(
-	ctx context.Context,
-	g *errgroup.Group,
+// newGrpcServer creates the gRPC server of the node and its request logger, or
+// returns nil if gRPC is disabled.
+func newGrpcServer(
 	config serverconfig.GRPCConfig,
 	clientCtx client.Context,
 	svrCtx *Context,
 	app types.Application,
+	adminSrv *admin.Server,
 ) (*grpc.Server, client.Context, error) {
 	if !config.Enable {
 		// return grpcServer as nil if gRPC is disabled
@@ -470,22 +556,69 @@ func startGrpcServer(
 	clientCtx = clientCtx.WithGRPCClient(grpcClient)
 	svrCtx.Logger.Debug("gRPC client assigned to client context", "target", grpcAddress)
 
-	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, app, config)
+	logger := svrCtx.Logger.With("module", "grpc-server")
+	requestLogger, err := servergrpc.NewRequestLogger(logger, config)
+	if err != nil {
+		return nil, clientCtx, err
+	}
+
+	var grpcOpts []grpc.ServerOption
+	if adminSrv != nil {
+		// expose the request log settings, and reject the requests made while
+		// the node is draining
+		adminSrv.SetRequestLogger(requestLogger)
+		grpcOpts = append(grpcOpts,
+			grpc.ChainUnaryInterceptor(adminSrv.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(adminSrv.StreamServerInterceptor()),
+		)
+	}
+	grpcOpts = append(grpcOpts,
+		grpc.ChainUnaryInterceptor(requestLogger.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(requestLogger.StreamServerInterceptor()),
+	)
+
+	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, app, config, grpcOpts...)
 	if err != nil {
 		return nil, clientCtx, err
 	}
 
-	// Start the gRPC server in a goroutine. Note, the provided ctx will ensure
-	// that the server is gracefully shut down.
-	g.Go(func() error {
-		return servergrpc.StartGRPCServer(ctx, svrCtx.Logger.With("module", "grpc-server"), config, grpcSrv)
-	})
 	return grpcSrv, clientCtx, nil
 }
 
+// startGrpcServer starts the gRPC server, if enabled, in a goroutine and
+// registers its shutdown. The gRPC server is gracefully stopped, draining its
+// in-flight requests, unless the shutdown times out.
+func startGrpcServer(
+	g *errgroup.Group,
+	shutdown *ShutdownCoordinator,
+	config serverconfig.GRPCConfig,
+	svrCtx *Context,
+	grpcSrv *grpc.Server,
+) {
+	if grpcSrv == nil {
+		return
+	}
+
+	logger := svrCtx.Logger.With("module", "grpc-server")
+	shutdown.Go(g, "gRPC server", func(ctx context.Context) error {
+		return servergrpc.StartGRPCServer(ctx, logger, config, grpcSrv)
+	}, grpcSrv.Stop)
+}
+
+// startAdminServer starts the admin gRPC server in a goroutine and registers
+// its shutdown.
+func startAdminServer(g *errgroup.Group, shutdown *ShutdownCoordinator, config serverconfig.AdminConfig, svrCtx *Context, adminSrv *admin.Server) {
+	grpcSrv := admin.NewGRPCServer(adminSrv, config.Token)
+	shutdown.Go(g, "admin server", func(ctx context.Context) error {
+		return admin.StartGRPCServer(ctx, svrCtx.Logger.With("module", "admin-server"), config, grpcSrv)
+	}, grpcSrv.Stop)
+}
+
+// startAPIServer starts the API server, if enabled, in a goroutine and
+// registers its shutdown, which drains its in-flight requests.
 func startAPIServer(
-	ctx context.Context,
 	g *errgroup.Group,
+	shutdown *ShutdownCoordinator,
 	cmtCfg *cmtcfg.Config,
 	svrCfg serverconfig.Config,
 	clientCtx client.Context,
@@ -508,9 +641,10 @@ func startAPIServer(
 		apiSrv.SetTelemetry(metrics)
 	}
 
-	g.Go(func() error {
+	shutdown.Go(g, "API server", func(ctx context.Context) error {
 		return apiSrv.Start(ctx, svrCfg)
-	})
+	}, nil)
+	shutdown.Register("API requests", apiSrv.WaitInFlight)
 	return nil
 }
 
@@ -585,16 +719,16 @@ func getCtx(svrCtx *Context, block bool) (*errgroup.Group, context.Context) {
 	return g, ctx
 }
 
-func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions) (app types.Application, cleanupFn func(), err error) {
+func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions) (app types.Application, db dbm.DB, cleanupFn func(), err error) {
 	traceWriter, traceCleanupFn, err := setupTraceWriter(svrCtx)
 	if err != nil {
-		return app, traceCleanupFn, err
+		return app, db, traceCleanupFn, err
 	}
 
 	home := svrCtx.Config.RootDir
-	db, err := opts.DBOpener(home, GetAppDBBackend(svrCtx.Viper))
+	db, err = opts.DBOpener(home, GetAppDBBackend(svrCtx.Viper))
 	if err != nil {
-		return app, traceCleanupFn, err
+		return app, db, traceCleanupFn, err
 	}
 
 	app = appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
@@ -604,5 +738,5 @@ func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions
 			svrCtx.Logger.Error(localErr.Error())
 		}
 	}
-	return app, cleanupFn, nil
+	return app, db, cleanupFn, nil
 }
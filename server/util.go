@@ -26,6 +26,8 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	storecache "cosmossdk.io/store/cache"
+	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -33,6 +35,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -49,6 +52,10 @@ type Context struct {
 	Viper  *viper.Viper
 	Config *cmtcfg.Config
 	Logger log.Logger
+
+	// LogLevel filters the logs of Logger at runtime. It is only set when the
+	// admin service is enabled, see CreateSDKLogger.
+	LogLevel *servercmtlog.LevelFilter
 }
 
 func NewDefaultContext() *Context {
@@ -60,7 +67,7 @@ func NewDefaultContext() *Context {
 }
 
 func NewContext(v *viper.Viper, config *cmtcfg.Config, logger log.Logger) *Context {
-	return &Context{v, config, logger}
+	return &Context{Viper: v, Config: config, Logger: logger}
 }
 
 func bindFlags(basename string, cmd *cobra.Command, v *viper.Viper) (err error) {
@@ -168,6 +175,8 @@ func InterceptConfigsAndCreateContext(cmd *cobra.Command, customAppConfigTemplat
 
 // CreateSDKLogger creates a the default SDK logger.
 // It reads the log level and format from the server context.
+// When the admin service is enabled, the log level is filtered by a
+// LevelFilter assigned to ctx.LogLevel, so that it can be changed at runtime.
 func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 	var opts []log.Option
 	if ctx.Viper.GetString(flags.FlagLogFormat) == flags.OutputFormatJSON {
@@ -176,6 +185,20 @@ func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 
 	// check and set filter level or keys for the logger if any
 	logLvlStr := ctx.Viper.GetString(flags.FlagLogLevel)
+	if ctx.Viper.GetBool(FlagAdminEnable) {
+		if logLvlStr == "" || ctx.Viper.GetBool("trace") { // cmtcli.TraceFlag
+			logLvlStr = zerolog.TraceLevel.String()
+		}
+
+		levelFilter, err := servercmtlog.NewLevelFilter(logLvlStr)
+		if err != nil {
+			return nil, err
+		}
+		ctx.LogLevel = levelFilter
+
+		return log.NewLogger(out, append(opts, log.FilterOption(levelFilter.Filter))...), nil
+	}
+
 	if logLvlStr == "" {
 		return log.NewLogger(out, opts...), nil
 	}
@@ -333,6 +356,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewAdminCmd(),
 	)
 }
 
@@ -454,12 +478,33 @@ func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	)
 }
 
+// getStoreMetrics returns the metrics gatherer of the stores, which reports to
+// telemetry with its global labels if it is enabled.
+func getStoreMetrics(appOpts types.AppOptions) storemetrics.StoreMetrics {
+	if !cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		return storemetrics.NewNoOpMetrics()
+	}
+
+	var globalLabels [][]string
+	for _, label := range cast.ToSlice(appOpts.Get("telemetry.global-labels")) {
+		if l := cast.ToStringSlice(label); len(l) == 2 {
+			globalLabels = append(globalLabels, l)
+		}
+	}
+
+	return storemetrics.NewMetrics(globalLabels)
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the Cosmos SDK
 func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache storetypes.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		cache = store.NewCommitKVStoreCacheManager(
+			storecache.WithMaxBytes(cast.ToUint64(appOpts.Get(FlagInterBlockCacheMaxBytes))),
+			storecache.WithStores(cast.ToStringSlice(appOpts.Get(FlagInterBlockCacheStores))...),
+			storecache.WithMetrics(getStoreMetrics(appOpts)),
+		)
 	}
 
 	pruningOpts, err := GetPruningOptionsFromFlags(appOpts)
@@ -498,21 +543,39 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		)
 	}
 
+	iavlStoreCacheSizes, err := config.ParseIAVLStoreCacheSizes(cast.ToStringSlice(appOpts.Get(FlagIAVLStoreCacheSizes)))
+	if err != nil {
+		panic(err)
+	}
+
+	var archiveQuerier baseapp.ArchiveQuerier
+	if archiveAddress := cast.ToString(appOpts.Get(FlagArchiveRPCAddress)); archiveAddress != "" {
+		archiveQuerier, err = NewRPCArchiveQuerier(archiveAddress, cast.ToDuration(appOpts.Get(FlagArchiveTimeout)))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(FlagMinRetainBlocks))),
+		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
+		baseapp.SetIAVLStoreCacheSizes(iavlStoreCacheSizes),
+		func(bapp *baseapp.BaseApp) { bapp.SetStoreMetrics(getStoreMetrics(appOpts)) },
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		defaultMempool,
+		baseapp.SetTxStatusCacheSize(cast.ToInt(appOpts.Get(FlagMempoolTxStatusCacheSize))),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		baseapp.SetChainID(chainID),
+		baseapp.SetArchiveQuerier(archiveQuerier),
 	}
 }
 
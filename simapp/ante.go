@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	circuitante "cosmossdk.io/x/circuit/ante"
+	evidenceante "cosmossdk.io/x/evidence/ante"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
@@ -11,7 +12,8 @@ import (
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	CircuitKeeper circuitante.CircuitBreaker
+	CircuitKeeper  circuitante.CircuitBreaker
+	EvidenceKeeper evidenceante.EvidenceValidator
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -30,6 +32,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	var feeDecorator sdk.AnteDecorator = ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+	if options.EvidenceKeeper != nil {
+		// valid equivocation evidence can be submitted without paying fees
+		feeDecorator = evidenceante.NewFeeExemptionDecorator(options.EvidenceKeeper, feeDecorator, evidenceante.DefaultMaxFeeExemptGas)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
@@ -38,7 +46,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		feeDecorator,
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
@@ -126,6 +126,7 @@ var (
 						stakingtypes.ModuleName,
 						genutiltypes.ModuleName,
 						feegrant.ModuleName,
+						nft.ModuleName,
 						group.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
@@ -233,8 +234,10 @@ var (
 				}),
 			},
 			{
-				Name:   nft.ModuleName,
-				Config: appconfig.WrapAny(&nftmodulev1.Module{}),
+				Name: nft.ModuleName,
+				Config: appconfig.WrapAny(&nftmodulev1.Module{
+					EnableAuctions: true,
+				}),
 			},
 			{
 				Name:   feegrant.ModuleName,
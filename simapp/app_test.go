@@ -48,6 +48,8 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
 	})
 
+	ctx := app.NewContext(true, cmtproto.Header{})
+
 	// BlockedAddresses returns a map of addresses in app v1 and a map of modules name in app v2.
 	for acc := range BlockedAddresses() {
 		var addr sdk.AccAddress
@@ -59,7 +61,7 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 
 		require.True(
 			t,
-			app.BankKeeper.BlockedAddr(addr),
+			app.BankKeeper.BlockedAddr(ctx, addr),
 			fmt.Sprintf("ensure that blocked addresses are properly set in bank keeper: %s should be blocked", acc),
 		)
 	}
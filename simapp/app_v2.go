@@ -172,6 +172,8 @@ func NewSimApp(
 		panic(err)
 	}
 
+	registerProposalTemplates(*app.GovKeeper, app.BankKeeper, app.AccountKeeper.AddressCodec())
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
@@ -0,0 +1,100 @@
+package simapp
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// registerProposalTemplates registers the templates of the common governance
+// actions, so that the proposals performing them are validated upon
+// submission.
+func registerProposalTemplates(govKeeper govkeeper.Keeper, bankKeeper bankkeeper.Keeper, addressCodec address.Codec) {
+	templates := []struct {
+		name, description string
+		msg               sdk.Msg
+		validate          govkeeper.ProposalTemplateValidator
+	}{
+		{
+			"bank-params", "Change the params of the bank module",
+			&banktypes.MsgUpdateParams{},
+			func(_ context.Context, msg sdk.Msg) error { return msg.(*banktypes.MsgUpdateParams).Params.Validate() },
+		},
+		{
+			"distribution-params", "Change the params of the distribution module",
+			&distrtypes.MsgUpdateParams{},
+			func(_ context.Context, msg sdk.Msg) error {
+				return msg.(*distrtypes.MsgUpdateParams).Params.ValidateBasic()
+			},
+		},
+		{
+			"mint-params", "Change the params of the mint module",
+			&minttypes.MsgUpdateParams{},
+			func(_ context.Context, msg sdk.Msg) error { return msg.(*minttypes.MsgUpdateParams).Params.Validate() },
+		},
+		{
+			"slashing-params", "Change the params of the slashing module",
+			&slashingtypes.MsgUpdateParams{},
+			func(_ context.Context, msg sdk.Msg) error { return msg.(*slashingtypes.MsgUpdateParams).Params.Validate() },
+		},
+		{
+			"staking-params", "Change the params of the staking module",
+			&stakingtypes.MsgUpdateParams{},
+			func(_ context.Context, msg sdk.Msg) error { return msg.(*stakingtypes.MsgUpdateParams).Params.Validate() },
+		},
+		{
+			"software-upgrade", "Schedule a software upgrade at a future height",
+			&upgradetypes.MsgSoftwareUpgrade{},
+			func(ctx context.Context, msg sdk.Msg) error {
+				plan := msg.(*upgradetypes.MsgSoftwareUpgrade).Plan
+				if err := plan.ValidateBasic(); err != nil {
+					return err
+				}
+
+				if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); plan.Height <= height {
+					return fmt.Errorf("upgrade cannot be scheduled in the past: height %d, current height %d", plan.Height, height)
+				}
+
+				return nil
+			},
+		},
+		{
+			"community-pool-spend", "Spend funds from the community pool",
+			&distrtypes.MsgCommunityPoolSpend{},
+			func(ctx context.Context, msg sdk.Msg) error {
+				spend := msg.(*distrtypes.MsgCommunityPoolSpend)
+				if !spend.Amount.IsValid() {
+					return fmt.Errorf("invalid amount %s", spend.Amount)
+				}
+
+				recipient, err := addressCodec.StringToBytes(spend.Recipient)
+				if err != nil {
+					return fmt.Errorf("invalid recipient: %w", err)
+				}
+
+				if bankKeeper.BlockedAddr(ctx, recipient) {
+					return fmt.Errorf("%s is not allowed to receive external funds", spend.Recipient)
+				}
+
+				return nil
+			},
+		},
+	}
+
+	for _, t := range templates {
+		if err := govKeeper.RegisterProposalTemplate(t.name, t.description, t.msg, t.validate); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -190,7 +190,9 @@ func TestAppImportExport(t *testing.T) {
 		stakingtypes.StoreKey: {
 			stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
 			stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
-			stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
+			stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey, stakingtypes.ValidatorTokensHistoryKey,
+			stakingtypes.InactiveValidatorQueueKey, stakingtypes.InactiveValidatorKey, stakingtypes.InactiveValidatorDryRunKey,
+			stakingtypes.AutoRedelegationByValIndexKey,
 		},
 		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
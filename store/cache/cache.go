@@ -3,10 +3,12 @@ package cache
 import (
 	"fmt"
 
+	gometrics "github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+
 	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/types"
-
-	lru "github.com/hashicorp/golang-lru"
 )
 
 var (
@@ -20,14 +22,18 @@ var (
 
 type (
 	// CommitKVStoreCache implements an inter-block (persistent) cache that wraps a
-	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache).
-	// During a cache miss, the read is delegated to the underlying CommitKVStore
-	// and cached. Deletes and writes always happen to both the cache and the
-	// CommitKVStore in a write-through manner. Caching performed in the
-	// CommitKVStore and below is completely irrelevant to this layer.
+	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache),
+	// or LRU cache if the cache has a byte budget. During a cache miss, the read is
+	// delegated to the underlying CommitKVStore and cached. Deletes and writes
+	// always happen to both the cache and the CommitKVStore in a write-through
+	// manner. Caching performed in the CommitKVStore and below is completely
+	// irrelevant to this layer.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+		cache valueCache
+
+		metrics metrics.StoreMetrics
+		labels  []gometrics.Label
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -36,10 +42,47 @@ type (
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
 		cacheSize uint
+		maxBytes  uint64
+		stores    map[string]bool
+		metrics   metrics.StoreMetrics
 		caches    map[string]types.CommitKVStore
 	}
+
+	// CommitKVStoreCacheManagerOption configures a CommitKVStoreCacheManager.
+	CommitKVStoreCacheManagerOption func(*CommitKVStoreCacheManager)
 )
 
+// WithMaxBytes bounds the total size of the keys and values cached per store to
+// maxBytes, in addition to the number of cached entries. 0 disables the bound.
+func WithMaxBytes(maxBytes uint64) CommitKVStoreCacheManagerOption {
+	return func(cmgr *CommitKVStoreCacheManager) {
+		cmgr.maxBytes = maxBytes
+	}
+}
+
+// WithStores restricts the caching to the stores with the given names. All the
+// stores are cached if no name is given.
+func WithStores(names ...string) CommitKVStoreCacheManagerOption {
+	return func(cmgr *CommitKVStoreCacheManager) {
+		if len(names) == 0 {
+			cmgr.stores = nil
+			return
+		}
+
+		cmgr.stores = make(map[string]bool, len(names))
+		for _, name := range names {
+			cmgr.stores[name] = true
+		}
+	}
+}
+
+// WithMetrics reports the cache hits and misses of every store to m.
+func WithMetrics(m metrics.StoreMetrics) CommitKVStoreCacheManagerOption {
+	return func(cmgr *CommitKVStoreCacheManager) {
+		cmgr.metrics = m
+	}
+}
+
 func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreCache {
 	cache, err := lru.NewARC(int(size))
 	if err != nil {
@@ -48,23 +91,53 @@ func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreC
 
 	return &CommitKVStoreCache{
 		CommitKVStore: store,
-		cache:         cache,
+		cache:         arcCache{cache},
+		metrics:       metrics.NewNoOpMetrics(),
 	}
 }
 
-func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
-	return &CommitKVStoreCacheManager{
+// newCommitKVStoreCache returns the CommitKVStoreCache of the store with the
+// given name, as configured by cmgr.
+func (cmgr *CommitKVStoreCacheManager) newCommitKVStoreCache(name string, store types.CommitKVStore) *CommitKVStoreCache {
+	if cmgr.maxBytes == 0 {
+		ckv := NewCommitKVStoreCache(store, cmgr.cacheSize)
+		ckv.metrics = cmgr.metrics
+		ckv.labels = []gometrics.Label{{Name: "store_key", Value: name}}
+		return ckv
+	}
+
+	return &CommitKVStoreCache{
+		CommitKVStore: store,
+		cache:         newSizedCache(cmgr.cacheSize, cmgr.maxBytes),
+		metrics:       cmgr.metrics,
+		labels:        []gometrics.Label{{Name: "store_key", Value: name}},
+	}
+}
+
+func NewCommitKVStoreCacheManager(size uint, opts ...CommitKVStoreCacheManagerOption) *CommitKVStoreCacheManager {
+	cmgr := &CommitKVStoreCacheManager{
 		cacheSize: size,
+		metrics:   metrics.NewNoOpMetrics(),
 		caches:    make(map[string]types.CommitKVStore),
 	}
+	for _, opt := range opts {
+		opt(cmgr)
+	}
+
+	return cmgr
 }
 
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
-// The returned Cache is meant to be used in a persistent manner.
+// The returned Cache is meant to be used in a persistent manner. The store is
+// returned as is if caching is not enabled for the StoreKey.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
+	if cmgr.stores != nil && !cmgr.stores[key.Name()] {
+		return store
+	}
+
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cmgr.caches[key.Name()] = cmgr.newCommitKVStoreCache(key.Name(), store)
 	}
 
 	return cmgr.caches[key.Name()]
@@ -101,14 +174,16 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
 
 	keyStr := string(key)
-	valueI, ok := ckv.cache.Get(keyStr)
+	value, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
-		return valueI.([]byte)
+		ckv.metrics.IncrCounterWithLabels([]string{"store", "cache", "hit"}, 1, ckv.labels)
+		return value
 	}
 
 	// cache miss; write to cache
-	value := ckv.CommitKVStore.Get(key)
+	ckv.metrics.IncrCounterWithLabels([]string{"store", "cache", "miss"}, 1, ckv.labels)
+	value = ckv.CommitKVStore.Get(key)
 	ckv.cache.Add(keyStr, value)
 
 	return value
@@ -2,8 +2,10 @@ package cache_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	gometrics "github.com/armon/go-metrics"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
@@ -11,6 +13,7 @@ import (
 	"cosmossdk.io/store/cache"
 	"cosmossdk.io/store/cachekv"
 	iavlstore "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/types"
 )
 
@@ -102,3 +105,84 @@ func TestCacheWrap(t *testing.T) {
 	cacheWrapper := mngr.GetStoreCache(sKey, store).CacheWrap()
 	require.IsType(t, &cachekv.Store{}, cacheWrapper)
 }
+
+func TestStoreCacheMaxBytes(t *testing.T) {
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.WithMaxBytes(100))
+
+	sKey := types.NewKVStoreKey("test")
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+	kvStore := mngr.GetStoreCache(sKey, store)
+
+	// each entry is 10 bytes long, only the last 10 entries fit in the cache
+	for i := 0; i < 20; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key_%d", i)), []byte(fmt.Sprintf("val_%d", i)))
+	}
+
+	// write to the underlying store only, the cached values are served until
+	// they are evicted
+	for i := 0; i < 20; i++ {
+		store.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("new"))
+	}
+	require.Equal(t, []byte("new"), kvStore.Get([]byte("key_0")), "evicted entries should be read from the store")
+	require.Equal(t, []byte("val_19"), kvStore.Get([]byte("key_19")), "recent entries should be cached")
+
+	// values larger than the budget are not cached
+	large := make([]byte, 200)
+	kvStore.Set([]byte("large"), large)
+	store.Set([]byte("large"), []byte("new"))
+	require.Equal(t, []byte("new"), kvStore.Get([]byte("large")))
+}
+
+func TestStoreCacheStores(t *testing.T) {
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.WithStores("cached"))
+
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	cachedKey, uncachedKey := types.NewKVStoreKey("cached"), types.NewKVStoreKey("uncached")
+	require.IsType(t, &cache.CommitKVStoreCache{}, mngr.GetStoreCache(cachedKey, store))
+	require.Equal(t, store, mngr.Unwrap(cachedKey))
+
+	require.Equal(t, store, mngr.GetStoreCache(uncachedKey, store))
+	require.Nil(t, mngr.Unwrap(uncachedKey))
+}
+
+type counterMetrics struct {
+	metrics.NoOpMetrics
+	counters map[string]float32
+}
+
+func (m counterMetrics) IncrCounterWithLabels(keys []string, val float32, labels []gometrics.Label) {
+	name := strings.Join(keys, ".")
+	for _, l := range labels {
+		name += "," + l.Name + "=" + l.Value
+	}
+	m.counters[name] += val
+}
+
+func TestStoreCacheMetrics(t *testing.T) {
+	db := dbm.NewMemDB()
+	m := counterMetrics{counters: make(map[string]float32)}
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.WithMetrics(m))
+
+	sKey := types.NewKVStoreKey("test")
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+	store.Set([]byte("key"), []byte("value"))
+	kvStore := mngr.GetStoreCache(sKey, store)
+
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key")))
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key")))
+	require.Nil(t, kvStore.Get([]byte("missing")))
+
+	require.Equal(t, map[string]float32{
+		"store.cache.hit,store_key=test":  1,
+		"store.cache.miss,store_key=test": 2,
+	}, m.counters)
+}
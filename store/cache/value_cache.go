@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+var (
+	_ valueCache = arcCache{}
+	_ valueCache = (*sizedCache)(nil)
+)
+
+// valueCache is the cache of the values of a CommitKVStoreCache, by key. A nil
+// value caches the absence of the key in the store.
+type valueCache interface {
+	Get(key string) ([]byte, bool)
+	Add(key string, value []byte)
+	Remove(key string)
+}
+
+// arcCache is a valueCache bounded by its number of entries.
+type arcCache struct {
+	cache *lru.ARCCache
+}
+
+func (c arcCache) Get(key string) ([]byte, bool) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return value.([]byte), true
+}
+
+func (c arcCache) Add(key string, value []byte) {
+	c.cache.Add(key, value)
+}
+
+func (c arcCache) Remove(key string) {
+	c.cache.Remove(key)
+}
+
+// sizedCache is a valueCache bounded by both its number of entries and the
+// total size of its keys and values. The least recently used entries are
+// evicted first.
+type sizedCache struct {
+	cache    *lru.Cache
+	maxBytes uint64
+	bytes    atomic.Uint64
+}
+
+func newSizedCache(size uint, maxBytes uint64) *sizedCache {
+	c := &sizedCache{maxBytes: maxBytes}
+
+	cache, err := lru.NewWithEvict(int(size), func(key, value interface{}) {
+		// subtract the size of the evicted entry
+		c.bytes.Add(^(entrySize(key.(string), value.([]byte)) - 1))
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to create KVStore cache: %s", err))
+	}
+
+	c.cache = cache
+	return c
+}
+
+func (c *sizedCache) Get(key string) ([]byte, bool) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return value.([]byte), true
+}
+
+// Add caches value, evicting the least recently used entries until the cache
+// fits its byte budget. An entry larger than the budget is not cached.
+func (c *sizedCache) Add(key string, value []byte) {
+	// remove the previous value first, updating an entry does not call the
+	// eviction callback
+	c.cache.Remove(key)
+
+	size := entrySize(key, value)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.bytes.Add(size)
+	c.cache.Add(key, value)
+	for c.bytes.Load() > c.maxBytes {
+		if _, _, ok := c.cache.RemoveOldest(); !ok {
+			break
+		}
+	}
+}
+
+func (c *sizedCache) Remove(key string) {
+	c.cache.Remove(key)
+}
+
+// Bytes returns the total size of the cached keys and values.
+func (c *sizedCache) Bytes() uint64 {
+	return c.bytes.Load()
+}
+
+// entrySize returns the size accounted for a cached key and value.
+func entrySize(key string, value []byte) uint64 {
+	return uint64(len(key) + len(value))
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
+	gometrics "github.com/armon/go-metrics"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	dbm "github.com/cosmos/cosmos-db"
@@ -38,6 +40,21 @@ type Store struct {
 	tree    Tree
 	logger  log.Logger
 	metrics metrics.StoreMetrics
+
+	// treeMtx guards the replacement of the tree when its cache is resized
+	// against the concurrent queries.
+	treeMtx sync.RWMutex
+
+	// The fields below are only set on the stores loaded from a database, and
+	// are used to reload the tree with another cache size.
+	db              dbm.DB
+	name            string
+	cacheSize       int
+	initialVersion  uint64
+	disableFastNode bool
+	stats           *iavl.Statistics
+	lastCacheHits   uint64
+	lastCacheMisses uint64
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -52,7 +69,8 @@ func LoadStore(db dbm.DB, logger log.Logger, key types.StoreKey, id types.Commit
 // provided DB. An error is returned if the version fails to load, or if called with a positive
 // version on an empty tree.
 func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, lazyLoading bool, initialVersion uint64, cacheSize int, disableFastNode bool, metrics metrics.StoreMetrics) (types.CommitKVStore, error) {
-	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion}, disableFastNode)
+	stats := &iavl.Statistics{}
+	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion, Stat: stats}, disableFastNode)
 	if err != nil {
 		return nil, err
 	}
@@ -87,9 +105,15 @@ func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKe
 	}
 
 	return &Store{
-		tree:    tree,
-		logger:  logger,
-		metrics: metrics,
+		tree:            tree,
+		logger:          logger,
+		metrics:         metrics,
+		db:              db,
+		name:            key.Name(),
+		cacheSize:       cacheSize,
+		initialVersion:  initialVersion,
+		disableFastNode: disableFastNode,
+		stats:           stats,
 	}, nil
 }
 
@@ -116,7 +140,7 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 		return nil, errors.New("version mismatch on immutable IAVL tree; version does not exist. Version has either been pruned, or is for a future block height")
 	}
 
-	iTree, err := st.tree.GetImmutable(version)
+	iTree, err := st.getTree().GetImmutable(version)
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +161,8 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	st.reportCacheStats()
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -279,6 +305,7 @@ func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
 // starting a new chain at an arbitrary height.
 func (st *Store) SetInitialVersion(version int64) {
 	st.tree.SetInitialVersion(uint64(version))
+	st.initialVersion = uint64(version)
 }
 
 // Exports the IAVL store at the given version, returning an iavl.Exporter for the tree.
@@ -331,7 +358,7 @@ func (st *Store) Query(req *abci.RequestQuery) (res *abci.ResponseQuery) {
 		return types.QueryResult(errorsmod.Wrap(types.ErrTxDecode, "query cannot be zero length"), false)
 	}
 
-	tree := st.tree
+	tree := st.getTree()
 
 	// store the height we chose in the response, with 0 being changed to the
 	// latest height
@@ -406,6 +433,84 @@ func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(ve
 	return st.tree.TraverseStateChanges(startVersion, endVersion, fn)
 }
 
+// getTree returns the tree of the store, guarded against its replacement by
+// SetCacheSize.
+func (st *Store) getTree() Tree {
+	st.treeMtx.RLock()
+	defer st.treeMtx.RUnlock()
+
+	return st.tree
+}
+
+// CacheSize returns the size of the node cache of the tree, in number of nodes.
+func (st *Store) CacheSize() int {
+	st.treeMtx.RLock()
+	defer st.treeMtx.RUnlock()
+
+	return st.cacheSize
+}
+
+// CacheStats returns the number of hits and misses of the node cache of the
+// tree since the store was loaded.
+func (st *Store) CacheStats() (hits, misses uint64) {
+	if st.stats == nil {
+		return 0, 0
+	}
+
+	return st.stats.GetCacheHitCnt(), st.stats.GetCacheMissCnt()
+}
+
+// SetCacheSize reloads the latest version of the tree with a node cache of the
+// given size. The tree must not have uncommitted changes, so it is meant to be
+// called right after Commit.
+func (st *Store) SetCacheSize(cacheSize int) error {
+	if cacheSize < 0 {
+		return fmt.Errorf("invalid iavl cache size %d", cacheSize)
+	}
+	if st.db == nil {
+		return errors.New("the iavl cache of a store not loaded from a database cannot be resized")
+	}
+
+	mtree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return errors.New("the iavl cache of an immutable store cannot be resized")
+	}
+
+	tree, err := iavl.NewMutableTreeWithOpts(st.db, cacheSize, &iavl.Options{
+		InitialVersion: st.initialVersion,
+		Stat:           st.stats,
+	}, st.disableFastNode)
+	if err != nil {
+		return err
+	}
+	if _, err := tree.LoadVersion(mtree.Version()); err != nil {
+		return err
+	}
+
+	st.treeMtx.Lock()
+	defer st.treeMtx.Unlock()
+
+	st.tree = tree
+	st.cacheSize = cacheSize
+	return nil
+}
+
+// reportCacheStats reports the hits and misses of the node cache since the
+// previous commit.
+func (st *Store) reportCacheStats() {
+	hits, misses := st.CacheStats()
+	labels := []gometrics.Label{{Name: "store_key", Value: st.name}}
+
+	if hits > st.lastCacheHits {
+		st.metrics.IncrCounterWithLabels([]string{"store", "iavl", "cache", "hit"}, float32(hits-st.lastCacheHits), labels)
+	}
+	if misses > st.lastCacheMisses {
+		st.metrics.IncrCounterWithLabels([]string{"store", "iavl", "cache", "miss"}, float32(misses-st.lastCacheMisses), labels)
+	}
+
+	st.lastCacheHits, st.lastCacheMisses = hits, misses
+}
+
 // Takes a MutableTree, a key, and a flag for creating existence or absence proof and returns the
 // appropriate merkle.Proof. Since this must be called after querying for the value, this function should never error
 // Thus, it will panic on error rather than returning it
@@ -657,3 +657,39 @@ func TestCacheWraps(t *testing.T) {
 	cacheWrappedWithTrace := store.CacheWrapWithTrace(nil, nil)
 	require.IsType(t, &cachekv.Store{}, cacheWrappedWithTrace)
 }
+
+func TestSetCacheSize(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := types.NewKVStoreKey("test")
+	store, err := LoadStore(db, log.NewNopLogger(), key, types.CommitID{}, false, cacheSize, false, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	st := store.(*Store)
+	require.Equal(t, cacheSize, st.CacheSize())
+
+	for k, v := range treeData {
+		st.Set([]byte(k), []byte(v))
+	}
+	cID := st.Commit()
+
+	require.NoError(t, st.SetCacheSize(2*cacheSize))
+	require.Equal(t, 2*cacheSize, st.CacheSize())
+	require.Equal(t, cID, st.LastCommitID())
+	require.Equal(t, []byte("goodbye"), st.Get([]byte("hello")))
+
+	// the reloaded tree reads its nodes from the database
+	_, misses := st.CacheStats()
+	_, err = st.GetImmutable(cID.Version)
+	require.NoError(t, err)
+	res := st.Query(&abci.RequestQuery{Path: "/key", Data: []byte("aloha"), Height: cID.Version, Prove: true})
+	require.Equal(t, []byte("shalom"), res.Value)
+	_, misses2 := st.CacheStats()
+	require.Greater(t, misses2, misses)
+
+	st.Set([]byte("hello"), []byte("hallo"))
+	cID2 := st.Commit()
+	require.Equal(t, cID.Version+1, cID2.Version)
+	require.Equal(t, []byte("hallo"), st.Get([]byte("hello")))
+
+	require.Error(t, st.SetCacheSize(-1))
+	require.Error(t, UnsafeNewStore(st.tree.(*iavl.MutableTree)).SetCacheSize(cacheSize))
+}
@@ -9,6 +9,7 @@ import (
 // StoreMetrics defines the set of metrics for the store package
 type StoreMetrics interface {
 	MeasureSince(keys ...string)
+	IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label)
 }
 
 var (
@@ -44,6 +45,15 @@ func (m Metrics) MeasureSince(keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// IncrCounterWithLabels provides a wrapper functionality for emitting a counter
+// metric with the given labels and the global labels (if any).
+func (m Metrics) IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if len(m.Labels) > 0 {
+		labels = append(labels[:len(labels):len(labels)], m.Labels...)
+	}
+	metrics.IncrCounterWithLabels(keys, val, labels)
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -54,3 +64,6 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(keys ...string) {}
+
+// IncrCounterWithLabels is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {}
@@ -0,0 +1,127 @@
+package rootmulti
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// IAVLCacheInfo describes the node cache of the IAVL tree of a store.
+type IAVLCacheInfo struct {
+	// Name is the name of the store.
+	Name string
+	// Size is the size of the cache, in number of nodes.
+	Size int
+	// Hits and Misses are the number of cache hits and misses since the store
+	// was loaded.
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRate returns the ratio of the cache lookups which were hits, or 0 if the
+// cache was never looked up.
+func (info IAVLCacheInfo) HitRate() float64 {
+	if total := info.Hits + info.Misses; total > 0 {
+		return float64(info.Hits) / float64(total)
+	}
+
+	return 0
+}
+
+// IAVLCaches returns the node caches of the IAVL stores, sorted by store name.
+func (rs *Store) IAVLCaches() []IAVLCacheInfo {
+	infos := make([]IAVLCacheInfo, 0, len(rs.stores))
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		store, ok := rs.iavlStore(key)
+		if !ok {
+			continue
+		}
+
+		hits, misses := store.CacheStats()
+		infos = append(infos, IAVLCacheInfo{
+			Name:   key.Name(),
+			Size:   store.CacheSize(),
+			Hits:   hits,
+			Misses: misses,
+		})
+	}
+
+	return infos
+}
+
+// ResizeIAVLCache schedules the resize of the node cache of the IAVL tree of
+// the store with the given name. The cache is resized on the next commit, by
+// reloading the tree, so that it is safe to call while blocks are executed.
+func (rs *Store) ResizeIAVLCache(name string, cacheSize int) error {
+	if cacheSize < 0 {
+		return fmt.Errorf("invalid iavl cache size %d", cacheSize)
+	}
+
+	key, ok := rs.keysByName[name]
+	if !ok {
+		return fmt.Errorf("unknown store %q", name)
+	}
+	if _, ok := rs.iavlStore(key); !ok {
+		return fmt.Errorf("store %q is not an iavl store", name)
+	}
+
+	rs.cacheResizesMtx.Lock()
+	defer rs.cacheResizesMtx.Unlock()
+
+	if rs.cacheResizes == nil {
+		rs.cacheResizes = make(map[string]int)
+	}
+	rs.cacheResizes[name] = cacheSize
+	return nil
+}
+
+// applyCacheResizes resizes the IAVL caches scheduled by ResizeIAVLCache. A
+// failed resize is logged, and the store keeps its cache.
+func (rs *Store) applyCacheResizes() {
+	rs.cacheResizesMtx.Lock()
+	resizes := rs.cacheResizes
+	rs.cacheResizes = nil
+	rs.cacheResizesMtx.Unlock()
+
+	names := make([]string, 0, len(resizes))
+	for name := range resizes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cacheSize := resizes[name]
+
+		key, ok := rs.keysByName[name]
+		if !ok {
+			continue
+		}
+		store, ok := rs.iavlStore(key)
+		if !ok {
+			continue
+		}
+
+		previous := store.CacheSize()
+		if err := store.SetCacheSize(cacheSize); err != nil {
+			rs.logger.Error("failed to resize iavl cache", "store_key", name, "size", cacheSize, "err", err)
+			continue
+		}
+
+		rs.iavlStoreCacheSizes[name] = cacheSize
+		rs.logger.Info("resized iavl cache", "store_key", name, "size", cacheSize, "previous", previous)
+	}
+}
+
+// iavlStore returns the IAVL store of the given key, unwrapped from the
+// inter-block cache.
+func (rs *Store) iavlStore(key types.StoreKey) (*iavl.Store, bool) {
+	store, ok := rs.stores[key]
+	if !ok || store.GetStoreType() != types.StoreTypeIAVL {
+		return nil, false
+	}
+
+	iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	return iavlStore, ok
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -61,6 +62,7 @@ type Store struct {
 	lastCommitInfo      *types.CommitInfo
 	pruningManager      *pruning.Manager
 	iavlCacheSize       int
+	iavlStoreCacheSizes map[string]int
 	iavlDisableFastNode bool
 	storesParams        map[types.StoreKey]storeParams
 	stores              map[types.StoreKey]types.CommitKVStore
@@ -75,6 +77,15 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+
+	// cacheResizes holds the IAVL cache sizes set at runtime by store name,
+	// which are applied on the next commit.
+	cacheResizes    map[string]int
+	cacheResizesMtx sync.Mutex
+
+	// pruningInterval holds the pruning interval set at runtime, which is
+	// applied on the next commit, or 0 if there is none.
+	pruningInterval atomic.Uint64
 }
 
 var (
@@ -91,6 +102,7 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
+		iavlStoreCacheSizes: make(map[string]int),
 		iavlDisableFastNode: iavlDisablefastNodeDefault,
 		storesParams:        make(map[types.StoreKey]storeParams),
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
@@ -114,6 +126,39 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// UpdatePruningInterval schedules a change of the interval at which the custom
+// pruning strategy prunes the heights. The interval is changed on the next
+// commit, so that it is safe to call while blocks are executed. The change is
+// logged and dropped if the pruning strategy is not custom.
+func (rs *Store) UpdatePruningInterval(interval uint64) error {
+	if err := pruningtypes.NewCustomPruningOptions(math.MaxUint64, interval).Validate(); err != nil {
+		return err
+	}
+
+	rs.pruningInterval.Store(interval)
+	return nil
+}
+
+// applyPruningInterval changes the pruning interval scheduled by
+// UpdatePruningInterval.
+func (rs *Store) applyPruningInterval() {
+	interval := rs.pruningInterval.Swap(0)
+	if interval == 0 {
+		return
+	}
+
+	opts := rs.pruningManager.GetOptions()
+	if opts.GetPruningStrategy() != pruningtypes.PruningCustom {
+		rs.logger.Error("cannot change the pruning interval of a non-custom pruning strategy", "interval", interval)
+		return
+	}
+
+	previous := opts.Interval
+	opts.Interval = interval
+	rs.pruningManager.SetOptions(opts)
+	rs.logger.Info("changed pruning interval", "interval", interval, "previous", previous)
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -129,6 +174,23 @@ func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
 
+// SetIAVLStoreCacheSize sets the cache size of the IAVL tree of the store with
+// the given name, overriding the cache size set with SetIAVLCacheSize. It must
+// be called before the stores are loaded, see ResizeIAVLCache to resize the
+// cache of a loaded store.
+func (rs *Store) SetIAVLStoreCacheSize(name string, cacheSize int) {
+	rs.iavlStoreCacheSizes[name] = cacheSize
+}
+
+// iavlCacheSizeOf returns the cache size of the IAVL tree of the given store.
+func (rs *Store) iavlCacheSizeOf(key types.StoreKey) int {
+	if cacheSize, ok := rs.iavlStoreCacheSizes[key.Name()]; ok {
+		return cacheSize
+	}
+
+	return rs.iavlCacheSize
+}
+
 func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
@@ -491,6 +553,9 @@ func (rs *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	rs.applyCacheResizes()
+	rs.applyPruningInterval()
+
 	return types.CommitID{
 		Version: version,
 		Hash:    rs.lastCommitInfo.Hash(),
@@ -1017,9 +1082,9 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var err error
 
 		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
+			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSizeOf(key), rs.iavlDisableFastNode, rs.metrics)
 		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
+			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSizeOf(key), rs.iavlDisableFastNode, rs.metrics)
 		}
 
 		if err != nil {
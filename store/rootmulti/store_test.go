@@ -994,3 +994,58 @@ func TestCommitStores(t *testing.T) {
 		})
 	}
 }
+
+func TestIAVLCacheSizes(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetIAVLCacheSize(1000)
+	ms.SetIAVLStoreCacheSize("store2", 2000)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cacheSizes := func() map[string]int {
+		sizes := map[string]int{}
+		for _, info := range ms.IAVLCaches() {
+			sizes[info.Name] = info.Size
+		}
+		return sizes
+	}
+	require.Equal(t, map[string]int{"store1": 1000, "store2": 2000, "store3": 1000}, cacheSizes())
+
+	require.Error(t, ms.ResizeIAVLCache("unknown", 10))
+	require.Error(t, ms.ResizeIAVLCache("store1", -1))
+
+	// the cache is resized on the next commit
+	require.NoError(t, ms.ResizeIAVLCache("store1", 3000))
+	require.Equal(t, 1000, cacheSizes()["store1"])
+
+	ms.GetKVStore(testStoreKey1).Set([]byte("k"), []byte("v"))
+	cID := ms.Commit()
+	require.Equal(t, map[string]int{"store1": 3000, "store2": 2000, "store3": 1000}, cacheSizes())
+	require.Equal(t, []byte("v"), ms.GetKVStore(testStoreKey1).Get([]byte("k")))
+
+	// the resized cache is kept when the stores are reloaded
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, cID, ms.LastCommitID())
+	require.Equal(t, 3000, cacheSizes()["store1"])
+}
+
+func TestUpdatePruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.ErrorIs(t, ms.UpdatePruningInterval(0), pruningtypes.ErrPruningIntervalZero)
+	require.ErrorIs(t, ms.UpdatePruningInterval(5), pruningtypes.ErrPruningIntervalTooSmall)
+
+	// the interval is changed on the next commit
+	require.NoError(t, ms.UpdatePruningInterval(20))
+	require.Equal(t, uint64(10), ms.GetPruning().Interval)
+	ms.Commit()
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 20), ms.GetPruning())
+
+	// the interval of the other strategies cannot be changed
+	ms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningDefault))
+	require.NoError(t, ms.UpdatePruningInterval(20))
+	ms.Commit()
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningDefault), ms.GetPruning())
+}
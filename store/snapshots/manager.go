@@ -46,6 +46,14 @@ type Manager struct {
 	chRestoreDone     <-chan restoreDone
 	restoreSnapshot   *types.Snapshot
 	restoreChunkIndex uint32
+
+	// closed is set by Close, after which no operation can begin. chClose is
+	// closed at the same time to abort the snapshot in progress, if any.
+	closed  bool
+	chClose chan struct{}
+	// operations tracks the snapshot and prune operations in progress, which
+	// Close waits for.
+	operations sync.WaitGroup
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -82,6 +90,7 @@ func NewManager(store *Store, opts types.SnapshotOptions, multistore types.Snaps
 		multistore: multistore,
 		extensions: extensions,
 		logger:     logger,
+		chClose:    make(chan struct{}),
 	}
 }
 
@@ -107,7 +116,11 @@ func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) e
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	return m.beginLocked(op)
+	if err := m.beginLocked(op); err != nil {
+		return err
+	}
+	m.operations.Add(1)
+	return nil
 }
 
 // beginLocked begins an operation while already holding the mutex.
@@ -115,6 +128,9 @@ func (m *Manager) beginLocked(op operation) error {
 	if op == opNone {
 		return errorsmod.Wrap(storetypes.ErrLogic, "can't begin a none operation")
 	}
+	if m.closed {
+		return errorsmod.Wrap(storetypes.ErrLogic, "snapshot manager is closed")
+	}
 	if m.operation != opNone {
 		return errorsmod.Wrapf(storetypes.ErrConflict, "a %v operation is in progress", m.operation)
 	}
@@ -122,8 +138,10 @@ func (m *Manager) beginLocked(op operation) error {
 	return nil
 }
 
-// end ends the current operation.
+// end ends the current operation started by begin.
 func (m *Manager) end() {
+	defer m.operations.Done()
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 	m.endLocked()
@@ -186,7 +204,58 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, types.CurrentFormat, m.abortOnClose(ch))
+}
+
+// abortOnClose forwards the given chunks until the manager is closed. The
+// chunk being saved is then completed, and the next one fails with
+// ErrSnapshotAborted, which aborts the snapshot.
+func (m *Manager) abortOnClose(chunks <-chan io.ReadCloser) <-chan io.ReadCloser {
+	out := make(chan io.ReadCloser)
+	go func() {
+		defer close(out)
+		for chunk := range chunks {
+			select {
+			case <-m.chClose:
+			default:
+				select {
+				case out <- chunk:
+					continue
+				case <-m.chClose:
+				}
+			}
+
+			// closing the chunk stops the snapshot generation
+			_ = chunk.Close()
+			DrainChunks(chunks)
+
+			pr, pw := io.Pipe()
+			_ = pw.CloseWithError(types.ErrSnapshotAborted)
+			out <- pr
+			return
+		}
+	}()
+	return out
+}
+
+// Close prevents any new operation from beginning, aborts the snapshot in
+// progress, if any, once its current chunk is saved, and waits for the
+// snapshot and prune operations in progress to end. It is called when the node
+// shuts down, so that it does not leave partial snapshots behind.
+func (m *Manager) Close() error {
+	if m == nil {
+		return nil
+	}
+
+	m.mtx.Lock()
+	if !m.closed {
+		m.closed = true
+		close(m.chClose)
+	}
+	m.mtx.Unlock()
+
+	m.operations.Wait()
+	return nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -532,6 +601,10 @@ func (m *Manager) snapshot(height int64) {
 	}
 
 	snapshot, err := m.Create(uint64(height))
+	if errors.Is(err, types.ErrSnapshotAborted) {
+		m.logger.Info("aborted state snapshot as the node is shutting down", "height", height)
+		return
+	}
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
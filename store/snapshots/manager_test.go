@@ -2,8 +2,13 @@ package snapshots_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	db "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -112,6 +117,53 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_Close(t *testing.T) {
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(db.NewMemDB(), dir)
+	require.NoError(t, err)
+	hung := newHungSnapshotter()
+	manager := snapshots.NewManager(store, opts, hung, nil, log.NewNopLogger())
+
+	created := make(chan error)
+	go func() {
+		_, err := manager.Create(1)
+		created <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		require.NoError(t, manager.Close())
+	}()
+
+	// no operation can begin once the manager is closed
+	require.Eventually(t, func() bool {
+		_, err := manager.Prune(2)
+		return err != nil && strings.Contains(err.Error(), "snapshot manager is closed")
+	}, time.Second, time.Millisecond)
+
+	// Close waits for the snapshot in progress, which is aborted
+	select {
+	case <-closed:
+		t.Fatal("Close returned while a snapshot is in progress")
+	default:
+	}
+	hung.Close()
+	require.ErrorIs(t, <-created, types.ErrSnapshotAborted)
+	<-closed
+
+	// the aborted snapshot is not saved
+	list, err := manager.List()
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	_, err = os.Stat(filepath.Join(dir, "1", "1"))
+	require.True(t, os.IsNotExist(err))
+
+	// closing again is a no-op
+	require.NoError(t, manager.Close())
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
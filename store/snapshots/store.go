@@ -224,7 +224,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
-) (*types.Snapshot, error) {
+) (_ *types.Snapshot, rerr error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
@@ -259,6 +259,14 @@ func (s *Store) Save(
 	}
 
 	dirCreated := false
+	defer func() {
+		// remove the chunks saved so far, so that a failed snapshot does not
+		// leave partial state behind
+		if rerr != nil && dirCreated {
+			_ = os.RemoveAll(s.pathSnapshot(height, format))
+		}
+	}()
+
 	index := uint32(0)
 	snapshotHasher := sha256.New()
 	chunkHasher := sha256.New()
@@ -16,4 +16,8 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrSnapshotAborted is returned when a snapshot is aborted as the snapshot
+	// manager is closed.
+	ErrSnapshotAborted = errors.New("snapshot aborted")
 )
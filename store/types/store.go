@@ -188,6 +188,10 @@ type CommitMultiStore interface {
 	// SetIAVLCacheSize sets the cache size of the IAVL tree.
 	SetIAVLCacheSize(size int)
 
+	// SetIAVLStoreCacheSize sets the cache size of the IAVL tree of the store
+	// with the given name, overriding SetIAVLCacheSize for this store.
+	SetIAVLStoreCacheSize(name string, size int)
+
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
@@ -61,7 +61,9 @@ type Config struct {
 // dump of formatted recent metrics will be sent to STDERR.
 type Metrics struct {
 	memSink           *metrics.InmemSink
+	inMemSig          *metrics.InmemSignal
 	prometheusEnabled bool
+	sink              *switchSink
 }
 
 // GatherResponse is the response type of registered metrics
@@ -97,7 +99,7 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 		}
 	}()
 
-	m := &Metrics{memSink: memSink}
+	m := &Metrics{memSink: memSink, inMemSig: inMemSig}
 	fanout := metrics.FanoutSink{memSink}
 
 	if cfg.PrometheusRetentionTime > 0 {
@@ -114,13 +116,32 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 		fanout = append(fanout, promSink)
 	}
 
-	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
+	m.sink = &switchSink{sink: fanout}
+	if _, err := metrics.NewGlobal(metricsConf, m.sink); err != nil {
 		return nil, err
 	}
 
 	return m, nil
 }
 
+// SetEnabled resumes or pauses the emission of the metrics at runtime. The
+// metrics emitted while paused are dropped, those already gathered are kept.
+func (m *Metrics) SetEnabled(enabled bool) {
+	m.sink.disabled.Store(!enabled)
+}
+
+// Enabled returns false if the emission of the metrics is paused.
+func (m *Metrics) Enabled() bool {
+	return !m.sink.disabled.Load()
+}
+
+// Shutdown stops the collection of metrics and flushes the sinks supporting it.
+// It is meant to be called right before the process exits.
+func (m *Metrics) Shutdown() {
+	metrics.Shutdown()
+	m.inMemSig.Stop()
+}
+
 // Gather collects all registered metrics and returns a GatherResponse where the
 // metrics are encoded depending on the type. Metrics are either encoded via
 // Prometheus or JSON if in-memory.
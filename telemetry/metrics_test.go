@@ -61,6 +61,34 @@ func TestMetrics_Prom(t *testing.T) {
 	require.True(t, strings.Contains(string(gr.Metrics), "test_dummy_counter 30"))
 }
 
+func TestMetrics_SetEnabled(t *testing.T) {
+	m, err := New(Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	require.True(t, m.Enabled())
+
+	counter := func() float64 {
+		var count float64
+		for _, interval := range m.memSink.Data() {
+			for name, sample := range interval.Counters {
+				if name == "test.paused_counter" {
+					count += sample.Sum
+				}
+			}
+		}
+		return count
+	}
+
+	// the metrics emitted while paused are dropped
+	m.SetEnabled(false)
+	require.False(t, m.Enabled())
+	metrics.IncrCounter([]string{"paused_counter"}, 1.0)
+	require.Zero(t, counter())
+
+	m.SetEnabled(true)
+	metrics.IncrCounter([]string{"paused_counter"}, 2.0)
+	require.Equal(t, 2.0, counter())
+}
+
 func emitMetrics() {
 	ticker := time.NewTicker(time.Second)
 	timeout := time.After(30 * time.Second)
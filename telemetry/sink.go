@@ -0,0 +1,63 @@
+package telemetry
+
+import (
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+)
+
+var _ metrics.ShutdownSink = (*switchSink)(nil)
+
+// switchSink forwards the metrics to a sink while it is enabled, and drops them
+// while it is disabled, so that the emission of the metrics can be paused at
+// runtime without replacing the global metrics.
+type switchSink struct {
+	sink     metrics.FanoutSink
+	disabled atomic.Bool
+}
+
+func (s *switchSink) SetGauge(key []string, val float32) {
+	if !s.disabled.Load() {
+		s.sink.SetGauge(key, val)
+	}
+}
+
+func (s *switchSink) SetGaugeWithLabels(key []string, val float32, labels []metrics.Label) {
+	if !s.disabled.Load() {
+		s.sink.SetGaugeWithLabels(key, val, labels)
+	}
+}
+
+func (s *switchSink) EmitKey(key []string, val float32) {
+	if !s.disabled.Load() {
+		s.sink.EmitKey(key, val)
+	}
+}
+
+func (s *switchSink) IncrCounter(key []string, val float32) {
+	if !s.disabled.Load() {
+		s.sink.IncrCounter(key, val)
+	}
+}
+
+func (s *switchSink) IncrCounterWithLabels(key []string, val float32, labels []metrics.Label) {
+	if !s.disabled.Load() {
+		s.sink.IncrCounterWithLabels(key, val, labels)
+	}
+}
+
+func (s *switchSink) AddSample(key []string, val float32) {
+	if !s.disabled.Load() {
+		s.sink.AddSample(key, val)
+	}
+}
+
+func (s *switchSink) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	if !s.disabled.Load() {
+		s.sink.AddSampleWithLabels(key, val, labels)
+	}
+}
+
+func (s *switchSink) Shutdown() {
+	s.sink.Shutdown()
+}
@@ -24,7 +24,7 @@ func (s *E2ETestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","expedited_voting_period":"86400s","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true}}`,
+			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","expedited_voting_period":"86400s","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true,"security_council":"","emergency_voting_period":"14400s","emergency_threshold":"0.750000000000000000","emergency_msg_type_urls":["/cosmos.circuit.v1.MsgTripCircuitBreaker","/cosmos.circuit.v1.MsgTripAll","/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"]}}`,
 		},
 		{
 			"text output",
@@ -39,6 +39,12 @@ params:
   burn_proposal_deposit_prevote: false
   burn_vote_quorum: false
   burn_vote_veto: true
+  emergency_msg_type_urls:
+  - /cosmos.circuit.v1.MsgTripCircuitBreaker
+  - /cosmos.circuit.v1.MsgTripAll
+  - /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade
+  emergency_threshold: "0.750000000000000000"
+  emergency_voting_period: 14400s
   expedited_min_deposit:
   - amount: "50000000"
     denom: stake
@@ -52,6 +58,7 @@ params:
   proposal_cancel_dest: ""
   proposal_cancel_ratio: "0.500000000000000000"
   quorum: "0.334000000000000000"
+  security_council: ""
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
   voting_period: 172800s
@@ -171,6 +171,7 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 		{"empty request", &tx.SimulateRequest{}, true, "empty txBytes is not allowed"},
 		{"valid request with proto tx (deprecated)", &tx.SimulateRequest{Tx: protoTx}, false, ""},
 		{"valid request with tx_bytes", &tx.SimulateRequest{TxBytes: txBytes}, false, ""},
+		{"valid request with gas breakdown", &tx.SimulateRequest{TxBytes: txBytes, GasBreakdown: true}, false, ""},
 	}
 
 	for _, tc := range testCases {
@@ -193,6 +194,18 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 				// - Msg events: message.module=bank and message.action=/cosmos.bank.v1beta1.MsgSend (in one message)
 				s.Require().Equal(12, len(res.GetResult().GetEvents()))
 				s.Require().True(res.GetGasInfo().GetGasUsed() > 0) // Gas used sometimes change, just check it's not empty.
+
+				// The gas consumption is only broken down on request.
+				breakdown := res.GetGasInfo().GasBreakdown
+				if !tc.req.GasBreakdown {
+					s.Require().Nil(breakdown)
+					return
+				}
+				s.Require().NotEmpty(breakdown.AnteDecorators)
+				s.Require().Equal("github.com/cosmos/cosmos-sdk/x/auth/ante.SetUpContextDecorator", breakdown.AnteDecorators[0].Name)
+				s.Require().Len(breakdown.Msgs, 1)
+				s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), breakdown.Msgs[0].Name)
+				s.Require().Positive(breakdown.Msgs[0].GasUsed)
 			}
 		})
 	}
@@ -0,0 +1,93 @@
+package circuit_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/simapp"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// TestSecurityCouncilTrip checks that a security council set up as a group
+// policy can be granted circuit breaker permissions, and that its members trip
+// the circuit breaker through a group proposal.
+func TestSecurityCouncilTrip(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{Time: time.Now()})
+
+	_, _, admin := testdata.KeyTestPubAddr()
+	members := make([]group.MemberRequest, 3)
+	for i := range members {
+		_, _, addr := testdata.KeyTestPubAddr()
+		members[i] = group.MemberRequest{Address: addr.String(), Weight: "1"}
+	}
+
+	// a 2 out of 3 council, whose proposals can be executed as soon as they
+	// pass
+	createMsg, err := group.NewMsgCreateGroupWithPolicy(admin.String(), members, "security council", "", true, group.NewThresholdDecisionPolicy("2", time.Hour, 0))
+	assert.NilError(t, err)
+	createRes, err := app.GroupKeeper.CreateGroupWithPolicy(ctx, createMsg)
+	assert.NilError(t, err)
+	council := createRes.GroupPolicyAddress
+
+	groupID, ok := app.CircuitBreakerKeeper.GetGroupPolicy(ctx, council)
+	assert.Assert(t, ok)
+	assert.Equal(t, createRes.GroupId, groupID)
+
+	// governance grants the council the permission to trip MsgSend
+	circuitMsgServer := circuitkeeper.NewMsgServerImpl(app.CircuitBreakerKeeper)
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	_, err = circuitMsgServer.AuthorizeCircuitBreaker(ctx, &circuittypes.MsgAuthorizeCircuitBreaker{
+		Granter:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Grantee:     council,
+		Permissions: &circuittypes.Permissions{Level: circuittypes.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}},
+	})
+	assert.NilError(t, err)
+
+	// a member alone cannot trip the circuit breaker
+	_, err = circuitMsgServer.TripCircuitBreaker(ctx, &circuittypes.MsgTripCircuitBreaker{Authority: members[0].Address, MsgTypeUrls: []string{msgSend}})
+	assert.ErrorContains(t, err, "account does not have permission")
+
+	// the members propose and vote a trip of the council
+	proposal, err := group.NewMsgSubmitProposal(
+		council, []string{members[0].Address},
+		[]sdk.Msg{&circuittypes.MsgTripCircuitBreaker{Authority: council, MsgTypeUrls: []string{msgSend}}},
+		"", group.Exec_EXEC_UNSPECIFIED, "trip MsgSend", "pause the bank sends",
+	)
+	assert.NilError(t, err)
+	proposalRes, err := app.GroupKeeper.SubmitProposal(ctx, proposal)
+	assert.NilError(t, err)
+
+	_, err = app.GroupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalRes.ProposalId, Voter: members[0].Address, Option: group.VOTE_OPTION_YES, Exec: group.Exec_EXEC_TRY})
+	assert.NilError(t, err)
+	assert.Assert(t, app.CircuitBreakerKeeper.IsAllowed(ctx, msgSend), "the threshold is not reached yet")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = app.GroupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalRes.ProposalId, Voter: members[1].Address, Option: group.VOTE_OPTION_YES, Exec: group.Exec_EXEC_TRY})
+	assert.NilError(t, err)
+	assert.Assert(t, !app.CircuitBreakerKeeper.IsAllowed(ctx, msgSend), "the council should have tripped the circuit breaker")
+
+	var tripped bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "trip_circuit_breaker" {
+			continue
+		}
+		tripped = true
+		attr, ok := event.GetAttribute("group_id")
+		assert.Assert(t, ok)
+		assert.Equal(t, strconv.FormatUint(createRes.GroupId, 10), attr.Value)
+	}
+	assert.Assert(t, tripped)
+}
@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	nftmodule "cosmossdk.io/x/nft/module"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	owner1       = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+	ownerAddr1   = sdk.MustAccAddressFromBech32(owner1)
+	classIDRegex = `[a-zA-Z][a-zA-Z0-9/:-]{2,100}`
+	staticClass  = nft.Class{Id: "kitties", Name: "Kitties", Symbol: "KTY", Description: "Crypto kitties", Uri: "ipfs://kitties", Issuer: owner1}
+	staticNFT    = nft.NFT{ClassId: "kitties", Id: "kitty1", Uri: "ipfs://kitties/kitty1"}
+	staticNFT2   = nft.NFT{ClassId: "kitties", Id: "kitty2", Uri: "ipfs://kitties/kitty2"}
+)
+
+type deterministicFixture struct {
+	ctx         sdk.Context
+	nftKeeper   nftkeeper.Keeper
+	queryClient nft.QueryClient
+}
+
+func initDeterministicFixture(t *testing.T) *deterministicFixture {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, nft.StoreKey)
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, nftmodule.AppModuleBasic{}).Codec
+
+	logger := log.NewTestLogger(t)
+	cms := integration.CreateMultiStore(keys, logger)
+
+	newCtx := sdk.NewContext(cms, cmtproto.Header{}, true, logger)
+
+	authority := authtypes.NewModuleAddress("gov")
+
+	maccPerms := map[string][]string{
+		nft.ModuleName: nil,
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		sdk.Bech32MainPrefix,
+		authority.String(),
+	)
+
+	blockedAddresses := map[string]bool{
+		accountKeeper.GetAuthority(): false,
+	}
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		blockedAddresses,
+		authority.String(),
+		log.NewNopLogger(),
+	)
+
+	nftKeeper := nftkeeper.NewKeeper(runtime.NewKVStoreService(keys[nft.StoreKey]), cdc, accountKeeper, bankKeeper, authority.String())
+
+	authModule := auth.NewAppModule(cdc, accountKeeper, authsims.RandomGenesisAccounts, nil)
+	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper, nil)
+	nftModule := nftmodule.NewAppModule(cdc, nftKeeper, accountKeeper, bankKeeper, cdc.InterfaceRegistry())
+
+	integrationApp := integration.NewIntegrationApp(newCtx, logger, keys, cdc, authModule, bankModule, nftModule)
+
+	sdkCtx := sdk.UnwrapSDKContext(integrationApp.Context())
+
+	// Register QueryServer
+	nft.RegisterQueryServer(integrationApp.QueryHelper(), nftKeeper)
+
+	f := deterministicFixture{
+		ctx:         sdkCtx,
+		nftKeeper:   nftKeeper,
+		queryClient: nft.NewQueryClient(integrationApp.QueryHelper()),
+	}
+
+	return &f
+}
+
+// createClass saves a class with random values.
+func createClass(rt *rapid.T, f *deterministicFixture, t *testing.T) nft.Class {
+	class := nft.Class{
+		Id:          rapid.StringMatching(classIDRegex).Draw(rt, "class-id"),
+		Name:        rapid.StringN(0, 50, 100).Draw(rt, "name"),
+		Symbol:      rapid.StringN(0, 10, 20).Draw(rt, "symbol"),
+		Description: rapid.StringN(0, 100, 200).Draw(rt, "description"),
+		Uri:         rapid.StringN(0, 100, 200).Draw(rt, "uri"),
+	}
+	if rapid.Bool().Draw(rt, "has-issuer") {
+		class.Issuer = sdk.AccAddress(testdata.AddressGenerator(rt).Draw(rt, "issuer")).String()
+	}
+
+	if f.nftKeeper.HasClass(f.ctx, class.Id) {
+		class, _ = f.nftKeeper.GetClass(f.ctx, class.Id)
+		return class
+	}
+
+	assert.NilError(t, f.nftKeeper.SaveClass(f.ctx, class))
+	return class
+}
+
+// mintNFT mints a nft of the given class with random values to owner.
+func mintNFT(rt *rapid.T, f *deterministicFixture, t *testing.T, classID string, owner sdk.AccAddress) nft.NFT {
+	token := nft.NFT{
+		ClassId: classID,
+		Id:      rapid.StringMatching(classIDRegex).Draw(rt, "nft-id"),
+		Uri:     rapid.StringN(0, 100, 200).Draw(rt, "nft-uri"),
+	}
+
+	if f.nftKeeper.HasNFT(f.ctx, classID, token.Id) {
+		token, _ = f.nftKeeper.GetNFT(f.ctx, classID, token.Id)
+		return token
+	}
+
+	assert.NilError(t, f.nftKeeper.Mint(f.ctx, token, owner))
+	return token
+}
+
+// setStaticNFTs saves a class and mints two of its nfts with hard-coded values.
+func setStaticNFTs(f *deterministicFixture, t *testing.T) {
+	assert.NilError(t, f.nftKeeper.SaveClass(f.ctx, staticClass))
+	assert.NilError(t, f.nftKeeper.Mint(f.ctx, staticNFT, ownerAddr1))
+	assert.NilError(t, f.nftKeeper.Mint(f.ctx, staticNFT2, ownerAddr1))
+}
+
+func TestGRPCQueryClass(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		class := createClass(rt, f, t)
+		req := &nft.QueryClassRequest{ClassId: class.Id}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Class, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+	req := &nft.QueryClassRequest{ClassId: staticClass.Id}
+
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Class, 1330, false)
+}
+
+func TestGRPCQueryClasses(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		numClasses := rapid.IntRange(1, 5).Draw(rt, "num-classes")
+		for i := 0; i < numClasses; i++ {
+			createClass(rt, f, t)
+		}
+
+		req := &nft.QueryClassesRequest{
+			Pagination: testdata.PaginationGenerator(rt, uint64(numClasses)).Draw(rt, "pagination"),
+		}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Classes, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+
+	testdata.DeterministicIterations(f.ctx, t, &nft.QueryClassesRequest{}, f.queryClient.Classes, 720, false)
+}
+
+func TestGRPCQueryNFT(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		class := createClass(rt, f, t)
+		owner := testdata.AddressGenerator(rt).Draw(rt, "owner")
+		token := mintNFT(rt, f, t, class.Id, owner)
+		req := &nft.QueryNFTRequest{ClassId: class.Id, Id: token.Id}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.NFT, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+	req := &nft.QueryNFTRequest{ClassId: staticNFT.ClassId, Id: staticNFT.Id}
+
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.NFT, 1165, false)
+}
+
+func TestGRPCQueryNFTs(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		class := createClass(rt, f, t)
+		owner := testdata.AddressGenerator(rt).Draw(rt, "owner")
+		numNFTs := rapid.IntRange(1, 5).Draw(rt, "num-nfts")
+		for i := 0; i < numNFTs; i++ {
+			mintNFT(rt, f, t, class.Id, owner)
+		}
+
+		req := &nft.QueryNFTsRequest{
+			Pagination: testdata.PaginationGenerator(rt, uint64(numNFTs)).Draw(rt, "pagination"),
+		}
+		// query by class, by owner, or by both
+		switch rapid.IntRange(0, 2).Draw(rt, "filter") {
+		case 0:
+			req.ClassId = class.Id
+		case 1:
+			req.Owner = owner.String()
+		default:
+			req.ClassId = class.Id
+			req.Owner = owner.String()
+		}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.NFTs, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+	req := &nft.QueryNFTsRequest{ClassId: staticClass.Id, Owner: owner1}
+
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.NFTs, 2762, false)
+}
+
+func TestGRPCQueryOwner(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		class := createClass(rt, f, t)
+		owner := testdata.AddressGenerator(rt).Draw(rt, "owner")
+		token := mintNFT(rt, f, t, class.Id, owner)
+		req := &nft.QueryOwnerRequest{ClassId: class.Id, Id: token.Id}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Owner, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+	req := &nft.QueryOwnerRequest{ClassId: staticNFT.ClassId, Id: staticNFT.Id}
+
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Owner, 1105, false)
+}
+
+func TestGRPCQuerySupply(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		class := createClass(rt, f, t)
+		owner := testdata.AddressGenerator(rt).Draw(rt, "owner")
+		numNFTs := rapid.IntRange(0, 5).Draw(rt, "num-nfts")
+		for i := 0; i < numNFTs; i++ {
+			mintNFT(rt, f, t, class.Id, owner)
+		}
+		req := &nft.QuerySupplyRequest{ClassId: class.Id}
+
+		testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Supply, 0, true)
+	})
+
+	f = initDeterministicFixture(t) // reset
+	setStaticNFTs(f, t)
+	req := &nft.QuerySupplyRequest{ClassId: staticClass.Id}
+
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Supply, 1048, false)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestLossRecords(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	params, err := f.slashingKeeper.GetParams(f.ctx)
+	assert.NilError(t, err)
+	params.LossRecordRetention = 5
+	assert.NilError(t, f.slashingKeeper.SetParams(f.ctx, params))
+
+	f.ctx = f.ctx.WithBlockHeight(10)
+	pks := simtestutil.CreateTestPubKeys(1)
+	valAddr, val := f.valAddrs[0], pks[0]
+	delegator, unbonder := f.addrDels[1], f.addrDels[2]
+
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, val, 100, true)
+	tstaking.DelegateWithPower(delegator, valAddr, 100)
+	tstaking.DelegateWithPower(unbonder, valAddr, 50)
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+	tstaking.Undelegate(unbonder, valAddr, f.stakingKeeper.TokensFromConsensusPower(f.ctx, 50), true)
+
+	// the unbonding delegation is slashed by 10% of its balance, the remaining
+	// 15 tokens are slashed from the 200 bonded tokens of the validator
+	err = f.slashingKeeper.SlashWithInfractionReason(
+		f.ctx, sdk.GetConsAddress(val), math.LegacyNewDecWithPrec(1, 1), 200, f.ctx.BlockHeight()-1,
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	)
+	assert.NilError(t, err)
+
+	tokens := func(power int64) math.Int {
+		return f.stakingKeeper.TokensFromConsensusPower(f.ctx, power)
+	}
+	expRecord := func(addr sdk.AccAddress, amount math.Int) slashingtypes.LossRecord {
+		return slashingtypes.LossRecord{
+			DelegatorAddress: addr.String(),
+			ValidatorAddress: valAddr.String(),
+			Amount:           amount,
+			Height:           10,
+			Reason:           stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		}
+	}
+
+	queryClient := slashingtypes.NewQueryClient(f.app.QueryHelper())
+	lossRecords := func(addr sdk.AccAddress) []slashingtypes.LossRecord {
+		res, err := queryClient.LossRecords(f.ctx, &slashingtypes.QueryLossRecordsRequest{DelegatorAddress: addr.String()})
+		assert.NilError(t, err)
+		return res.LossRecords
+	}
+
+	assert.DeepEqual(t, []slashingtypes.LossRecord{expRecord(sdk.AccAddress(valAddr), tokens(15).QuoRaw(2))}, lossRecords(sdk.AccAddress(valAddr)))
+	assert.DeepEqual(t, []slashingtypes.LossRecord{expRecord(delegator, tokens(15).QuoRaw(2))}, lossRecords(delegator))
+	assert.DeepEqual(t, []slashingtypes.LossRecord{expRecord(unbonder, tokens(5))}, lossRecords(unbonder))
+	assert.Equal(t, 0, len(lossRecords(f.addrDels[3])))
+
+	// the loss records are exported and imported with the genesis state
+	genState := f.slashingKeeper.ExportGenesis(f.ctx)
+	assert.Equal(t, 3, len(genState.LossRecords))
+
+	// the loss records are pruned once their retention elapsed
+	assert.NilError(t, f.slashingKeeper.PruneLossRecords(f.ctx.WithBlockHeight(14)))
+	assert.Equal(t, 1, len(lossRecords(delegator)))
+
+	assert.NilError(t, f.slashingKeeper.PruneLossRecords(f.ctx.WithBlockHeight(15)))
+	assert.Equal(t, 0, len(lossRecords(delegator)))
+	assert.Equal(t, 0, len(f.slashingKeeper.ExportGenesis(f.ctx).LossRecords))
+
+	f.slashingKeeper.InitGenesis(f.ctx, f.stakingKeeper, genState)
+	assert.Equal(t, 1, len(lossRecords(delegator)))
+}
+
+func TestLossRecordsDisabled(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	params, err := f.slashingKeeper.GetParams(f.ctx)
+	assert.NilError(t, err)
+	params.LossRecordRetention = 0
+	assert.NilError(t, f.slashingKeeper.SetParams(f.ctx, params))
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(f.valAddrs[0], pks[0], 100, true)
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	err = f.slashingKeeper.SlashWithInfractionReason(
+		f.ctx, sdk.GetConsAddress(pks[0]), math.LegacyNewDecWithPrec(1, 1), 100, f.ctx.BlockHeight(),
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(f.slashingKeeper.ExportGenesis(f.ctx).LossRecords))
+}
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"gotest.tools/v3/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -114,3 +116,302 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	assert.Assert(math.IntEq(t, newBonded, oldBonded.SubRaw(1)))
 	assert.Assert(math.IntEq(t, newNotBonded, oldNotBonded.AddRaw(1)))
 }
+
+func TestAutoRedelegation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockTime(time.Unix(1_000_000, 0))
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, jailedValAddr, fallbackValAddr := addrs[2], valAddrs[0], valAddrs[1]
+
+	jailedVal, found := f.stakingKeeper.GetValidator(ctx, jailedValAddr)
+	assert.Assert(t, found)
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 5)
+	_, err := f.stakingKeeper.Delegate(ctx, delAddr, delTokens, types.Unbonded, jailedVal, true)
+	assert.NilError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	_, err = msgServer.SetAutoRedelegation(ctx, types.NewMsgSetAutoRedelegation(delAddr, fallbackValAddr, time.Hour))
+	assert.NilError(t, err)
+
+	// jail the validator, the stake is kept until the jail duration elapses
+	consAddr, err := jailedVal.GetConsAddr()
+	assert.NilError(t, err)
+	f.stakingKeeper.Jail(ctx, consAddr)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, jailedValAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, 1, countAutoRedelegationQueue(ctx, f.stakingKeeper, ctx.BlockTime().Add(time.Hour)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, countAutoRedelegationQueue(ctx, f.stakingKeeper, ctx.BlockTime()))
+
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, jailedValAddr)
+	assert.Assert(t, !found)
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, fallbackValAddr)
+	assert.Assert(t, found)
+	fallbackVal, found := f.stakingKeeper.GetValidator(ctx, fallbackValAddr)
+	assert.Assert(t, found)
+	assert.Assert(math.IntEq(t, delTokens, fallbackVal.TokensFromShares(delegation.Shares).TruncateInt()))
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		emitted = emitted || event.Type == types.EventTypeAutoRedelegate
+	}
+	assert.Assert(t, emitted)
+
+	// the validators delegated to by opted out delegators keep their stake
+	_, err = msgServer.RemoveAutoRedelegation(ctx, types.NewMsgRemoveAutoRedelegation(addrs[0]))
+	assert.ErrorIs(t, err, types.ErrNoAutoRedelegation)
+	_, found = f.stakingKeeper.GetDelegation(ctx, addrs[0], jailedValAddr)
+	assert.Assert(t, found)
+
+	// unjailing the validator clears its jail time
+	f.stakingKeeper.Unjail(ctx, consAddr)
+	_, found = f.stakingKeeper.GetValidatorJailTime(ctx, jailedValAddr)
+	assert.Assert(t, !found)
+}
+
+func TestAutoRedelegationFailureIsDropped(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockTime(time.Unix(1_000_000, 0))
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, jailedValAddr, fallbackValAddr := addrs[2], valAddrs[0], valAddrs[1]
+
+	// the stake redelegated to the jailed validator cannot be redelegated away
+	// until the redelegation completes
+	srcVal, found := f.stakingKeeper.GetValidator(ctx, fallbackValAddr)
+	assert.Assert(t, found)
+	shares, err := f.stakingKeeper.Delegate(ctx, delAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 5), types.Unbonded, srcVal, true)
+	assert.NilError(t, err)
+	completionTime, err := f.stakingKeeper.BeginRedelegation(ctx, delAddr, fallbackValAddr, jailedValAddr, shares)
+	assert.NilError(t, err)
+
+	jailedVal, found := f.stakingKeeper.GetValidator(ctx, jailedValAddr)
+	assert.Assert(t, found)
+	consAddr, err := jailedVal.GetConsAddr()
+	assert.NilError(t, err)
+	f.stakingKeeper.Jail(ctx, consAddr)
+
+	// opting in after the jailing queues the auto-redelegation
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	_, err = msgServer.SetAutoRedelegation(ctx, types.NewMsgSetAutoRedelegation(delAddr, fallbackValAddr, time.Hour))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, countAutoRedelegationQueue(ctx, f.stakingKeeper, ctx.BlockTime().Add(time.Hour)))
+
+	// the redelegation would be transitive, the auto-redelegation is dropped
+	// and reported
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, jailedValAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, 0, countAutoRedelegationQueue(ctx, f.stakingKeeper, ctx.BlockTime()))
+	assertAutoRedelegateFailed(t, ctx, delAddr, jailedValAddr, fallbackValAddr, types.ErrTransitiveRedelegation)
+
+	// and is not attempted again once the redelegation completes
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, jailedValAddr)
+	assert.Assert(t, found)
+
+	// until the delegator sets its preference again
+	_, err = msgServer.SetAutoRedelegation(ctx, types.NewMsgSetAutoRedelegation(delAddr, fallbackValAddr, time.Hour))
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, jailedValAddr)
+	assert.Assert(t, !found)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, fallbackValAddr)
+	assert.Assert(t, found)
+}
+
+func TestMinDelegationAmountInteractions(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockTime(time.Unix(1_000_000, 0)).WithBlockHeight(10)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, srcValAddr, dstValAddr := addrs[2], valAddrs[1], valAddrs[0]
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+
+	srcVal, found := f.stakingKeeper.GetValidator(ctx, srcValAddr)
+	assert.Assert(t, found)
+	_, err := f.stakingKeeper.Delegate(ctx, delAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 7), types.Unbonded, srcVal, true)
+	assert.NilError(t, err)
+
+	params := f.stakingKeeper.GetParams(ctx)
+	params.MinDelegationAmount = f.stakingKeeper.TokensFromConsensusPower(ctx, 3)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	// a redelegation may not leave less than the minimum in the source
+	// delegation, nor create a destination delegation below it
+	sharesOf := func(power int64) math.LegacyDec {
+		return math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, srcValAddr, dstValAddr, sharesOf(5))
+	assert.ErrorIs(t, err, types.ErrDelegationBelowMinimum)
+	// the destination is checked once the source delegation is unbonded, failed
+	// msgs are reverted by the tx
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = f.stakingKeeper.BeginRedelegation(cacheCtx, delAddr, srcValAddr, dstValAddr, sharesOf(2))
+	assert.ErrorIs(t, err, types.ErrDelegationBelowMinimum)
+
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, srcValAddr, dstValAddr, sharesOf(3))
+	assert.NilError(t, err)
+
+	// but the source delegation can be fully redelegated
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, srcValAddr)
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, srcValAddr, dstValAddr, delegation.Shares)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, delAddr, srcValAddr)
+	assert.Assert(t, !found)
+
+	// cancelling an unbonding delegates back to the validator, so the
+	// resulting delegation must be worth the minimum
+	delegation, found = f.stakingKeeper.GetDelegation(ctx, delAddr, dstValAddr)
+	assert.Assert(t, found)
+	_, _, err = f.stakingKeeper.Undelegate(ctx, delAddr, dstValAddr, delegation.Shares)
+	assert.NilError(t, err)
+
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+	cancel := func(power int64) error {
+		amount := sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, power))
+		cacheCtx, write := ctx.CacheContext()
+		_, err := msgServer.CancelUnbondingDelegation(cacheCtx, types.NewMsgCancelUnbondingDelegation(delAddr, dstValAddr, ctx.BlockHeight(), amount))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	assert.ErrorIs(t, cancel(2), types.ErrDelegationBelowMinimum)
+	assert.NilError(t, cancel(3))
+
+	// an auto-redelegation moves the whole delegation, it fails if the
+	// delegation is below a minimum raised since it was made and the stake is
+	// left in place
+	autoDelAddr := addrs[3]
+	dstVal, found := f.stakingKeeper.GetValidator(ctx, dstValAddr)
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.Delegate(ctx, autoDelAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 3), types.Unbonded, dstVal, true)
+	assert.NilError(t, err)
+	_, err = msgServer.SetAutoRedelegation(ctx, types.NewMsgSetAutoRedelegation(autoDelAddr, srcValAddr, time.Hour))
+	assert.NilError(t, err)
+
+	params.MinDelegationAmount = f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	consAddr, err := dstVal.GetConsAddr()
+	assert.NilError(t, err)
+	f.stakingKeeper.Jail(ctx, consAddr)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	_, found = f.stakingKeeper.GetDelegation(ctx, autoDelAddr, dstValAddr)
+	assert.Assert(t, found)
+	_, found = f.stakingKeeper.GetDelegation(ctx, autoDelAddr, srcValAddr)
+	assert.Assert(t, !found)
+	assert.Equal(t, 0, countAutoRedelegationQueue(ctx, f.stakingKeeper, ctx.BlockTime()))
+	assertAutoRedelegateFailed(t, ctx, autoDelAddr, dstValAddr, srcValAddr, types.ErrDelegationBelowMinimum)
+}
+
+func TestAutoRedelegationIndex(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr := addrs[2]
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+
+	delegate := func(valAddr sdk.ValAddress) {
+		validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+		assert.Assert(t, found)
+		_, err := f.stakingKeeper.Delegate(ctx, delAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 1), types.Unbonded, validator, true)
+		assert.NilError(t, err)
+	}
+
+	// only the delegations of the delegators who opted in are indexed
+	delegate(valAddrs[0])
+	assert.Equal(t, 0, len(optedInDelegators(ctx, f, valAddrs[0])))
+	_, err := msgServer.SetAutoRedelegation(ctx, types.NewMsgSetAutoRedelegation(delAddr, valAddrs[1], time.Hour))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []sdk.AccAddress{delAddr}, optedInDelegators(ctx, f, valAddrs[0]))
+
+	// as well as their new delegations
+	delegate(valAddrs[1])
+	assert.DeepEqual(t, []sdk.AccAddress{delAddr}, optedInDelegators(ctx, f, valAddrs[1]))
+
+	// a delegation is removed from the index with the delegation
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	assert.Assert(t, found)
+	_, _, err = f.stakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], delegation.Shares)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(optedInDelegators(ctx, f, valAddrs[0])))
+
+	// and all of them when the delegator opts out
+	_, err = msgServer.RemoveAutoRedelegation(ctx, types.NewMsgRemoveAutoRedelegation(delAddr))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(optedInDelegators(ctx, f, valAddrs[1])))
+}
+
+// optedInDelegators returns the delegators of a validator indexed for the
+// auto-redelegation.
+func optedInDelegators(ctx sdk.Context, f *fixture, valAddr sdk.ValAddress) (delAddrs []sdk.AccAddress) {
+	prefix := types.GetAutoRedelegationsByValPrefixKey(valAddr)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(f.keys[types.StoreKey]), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddrs = append(delAddrs, sdk.AccAddress(iterator.Key()[len(prefix)+1:]))
+	}
+
+	return delAddrs
+}
+
+// assertAutoRedelegateFailed asserts that the failure of an auto-redelegation
+// is reported in the events of the context.
+func assertAutoRedelegateFailed(t *testing.T, ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, expErr error) {
+	t.Helper()
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoRedelegateFailed {
+			failed = append(failed, event)
+		}
+	}
+	assert.Equal(t, 1, len(failed))
+
+	attrs := map[string]string{}
+	for _, attr := range failed[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	assert.Equal(t, delAddr.String(), attrs[types.AttributeKeyDelegator])
+	assert.Equal(t, valSrcAddr.String(), attrs[types.AttributeKeySrcValidator])
+	assert.Equal(t, valDstAddr.String(), attrs[types.AttributeKeyDstValidator])
+	assert.Assert(t, strings.Contains(attrs[types.AttributeKeyError], expErr.Error()))
+}
+
+// countAutoRedelegationQueue returns the number of auto-redelegations queued up
+// to endTime included.
+func countAutoRedelegationQueue(ctx sdk.Context, k *keeper.Keeper, endTime time.Time) int {
+	iterator := k.AutoRedelegationQueueIterator(ctx, endTime)
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
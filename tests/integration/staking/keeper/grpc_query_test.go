@@ -9,9 +9,12 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"cosmossdk.io/math"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -418,6 +421,61 @@ func TestGRPCQueryDelegatorDelegations(t *testing.T) {
 	}
 }
 
+func TestGRPCQueryAllDelegations(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, _ := createValidatorAccs(t, f)
+
+	// turn the second delegator into a module account
+	acc, ok := f.accountKeeper.GetAccount(ctx, addrs[1]).(*authtypes.BaseAccount)
+	assert.Assert(t, ok)
+	f.accountKeeper.SetAccount(ctx, authtypes.NewModuleAccount(acc, "module"))
+
+	queryClient := types.NewQueryClient(f.app.QueryHelper())
+	tokens := func(power int64) math.Int {
+		return f.stakingKeeper.TokensFromConsensusPower(ctx, power)
+	}
+
+	testCases := []struct {
+		msg       string
+		req       *types.QueryAllDelegationsRequest
+		expDels   int
+		expErrMsg string
+	}{
+		{"all delegations", &types.QueryAllDelegationsRequest{}, 3, ""},
+		{"min stake", &types.QueryAllDelegationsRequest{MinStake: tokens(8)}, 2, ""},
+		{"exclude module accounts", &types.QueryAllDelegationsRequest{ExcludeModuleAccounts: true}, 2, ""},
+		{"min stake and exclude module accounts", &types.QueryAllDelegationsRequest{MinStake: tokens(8), ExcludeModuleAccounts: true}, 1, ""},
+		{"negative min stake", &types.QueryAllDelegationsRequest{MinStake: tokens(-1)}, 0, "min stake cannot be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			res, err := queryClient.AllDelegations(gocontext.Background(), tc.req)
+			if tc.expErrMsg != "" {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expDels, len(res.DelegationResponses))
+			for _, del := range res.DelegationResponses {
+				assert.Assert(t, tc.req.MinStake.IsNil() || del.Balance.Amount.GTE(tc.req.MinStake))
+			}
+		})
+	}
+
+	// the delegations can be fetched in batches
+	res, err := queryClient.AllDelegations(gocontext.Background(), &types.QueryAllDelegationsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.DelegationResponses))
+	res, err = queryClient.AllDelegations(gocontext.Background(), &types.QueryAllDelegationsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.DelegationResponses))
+	assert.Assert(t, res.Pagination.NextKey == nil)
+}
+
 func TestGRPCQueryValidatorDelegations(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
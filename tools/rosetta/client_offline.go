@@ -3,12 +3,14 @@ package rosetta
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
 
 	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 // ---------- cosmos-rosetta-gateway.types.NetworkInformationProvider implementation ------------ //
@@ -34,6 +36,50 @@ func (c *Client) SupportedOperations() []string {
 	return c.supportedOperations
 }
 
+func (c *Client) SignatureTypes() []types.SignatureType {
+	var signatureTypes []types.SignatureType
+	seen := make(map[types.SignatureType]bool)
+	for _, curveType := range c.config.CurveTypes {
+		signatureType := signatureTypeOf(curveType)
+		if !seen[signatureType] {
+			seen[signatureType] = true
+			signatureTypes = append(signatureTypes, signatureType)
+		}
+	}
+	return signatureTypes
+}
+
+func (c *Client) CurveTypes() []types.CurveType {
+	return c.config.CurveTypes
+}
+
+// SignModes returns the sign modes of the signing payloads. SIGN_MODE_TEXTUAL
+// is only supported online, as rendering the coins of a transaction requires
+// the metadata of their denoms, which is queried from the node.
+func (c *Client) SignModes() []string {
+	signModes := []string{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON.String()}
+	if !c.config.Offline {
+		signModes = append(signModes, signing.SignMode_SIGN_MODE_TEXTUAL.String())
+	}
+	return signModes
+}
+
+// signMode returns the sign mode of the signing payloads with the given name,
+// SIGN_MODE_LEGACY_AMINO_JSON if empty, if it is one of the supported ones.
+func (c *Client) signMode(name string) (signing.SignMode, error) {
+	if name == "" {
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	}
+
+	for _, signMode := range c.SignModes() {
+		if name == signMode {
+			return signing.SignMode(signing.SignMode_value[name]), nil
+		}
+	}
+
+	return 0, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("unsupported sign mode %s, expected one of %v", name, c.SignModes()))
+}
+
 // ---------- cosmos-rosetta-gateway.types.OfflineClient implementation ------------ //
 
 func (c *Client) SignedTx(_ context.Context, txBytes []byte, signatures []*types.Signature) (signedTxBytes []byte, err error) {
@@ -56,7 +102,18 @@ func (c *Client) ConstructionPayload(_ context.Context, request *types.Construct
 		return nil, err
 	}
 
-	txBytes, payloads, err := c.converter.ToRosetta().SigningComponents(tx, metadata, request.PublicKeys)
+	for _, pubKey := range request.PublicKeys {
+		if err := c.checkCurveType(pubKey); err != nil {
+			return nil, err
+		}
+	}
+
+	signMode, err := c.signMode(metadata.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, payloads, err := c.converter.ToRosetta().SigningComponents(tx, metadata, signMode, request.PublicKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -113,12 +170,18 @@ func (c *Client) PreprocessOperationsToOptions(_ context.Context, req *types.Con
 		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "no gas limit")
 	}
 
+	if _, err := c.signMode(meta.SignMode); err != nil {
+		return nil, err
+	}
+
 	// prepare the options to return
 	options := &PreprocessOperationsOptionsResponse{
 		ExpectedSigners: signersStr,
 		Memo:            meta.Memo,
+		TimeoutHeight:   meta.TimeoutHeight,
 		GasLimit:        meta.GasLimit,
 		GasPrice:        meta.GasPrice,
+		SignMode:        meta.SignMode,
 	}
 
 	metaOptions, err := options.ToMetadata()
@@ -132,12 +195,42 @@ func (c *Client) PreprocessOperationsToOptions(_ context.Context, req *types.Con
 }
 
 func (c *Client) AccountIdentifierFromPublicKey(pubKey *types.PublicKey) (*types.AccountIdentifier, error) {
+	if err := c.checkCurveType(pubKey); err != nil {
+		return nil, err
+	}
+
 	pk, err := c.converter.ToSDK().PubKey(pubKey)
 	if err != nil {
 		return nil, err
 	}
 
+	addressCodec := c.config.InterfaceRegistry.SigningContext().AddressCodec()
+	if c.config.Bech32Prefix != "" {
+		addressCodec = addresscodec.NewBech32Codec(c.config.Bech32Prefix)
+	}
+
+	address, err := addressCodec.BytesToString(pk.Address())
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+	}
+
 	return &types.AccountIdentifier{
-		Address: sdk.AccAddress(pk.Address()).String(),
+		Address: address,
 	}, nil
 }
+
+// checkCurveType returns an error if the curve of the given public key is not
+// accepted by the construction API.
+func (c *Client) checkCurveType(pubKey *types.PublicKey) error {
+	if pubKey == nil {
+		return crgerrs.WrapError(crgerrs.ErrBadArgument, "public key not provided")
+	}
+
+	for _, curveType := range c.config.CurveTypes {
+		if pubKey.CurveType == curveType {
+			return nil
+		}
+	}
+
+	return crgerrs.WrapError(crgerrs.ErrUnsupportedCurve, fmt.Sprintf("curve %s is not enabled, expected one of %v", pubKey.CurveType, c.config.CurveTypes))
+}
@@ -0,0 +1,100 @@
+package rosetta
+
+import (
+	"context"
+	"testing"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+
+	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestAccountIdentifierFromPublicKey(t *testing.T) {
+	cdc, ir := MakeCodec()
+	c, err := NewClient(&Config{
+		Codec:             cdc,
+		InterfaceRegistry: ir,
+		CurveTypes:        []rosettatypes.CurveType{rosettatypes.Secp256k1, rosettatypes.Secp256r1},
+		Bech32Prefix:      "chain",
+	})
+	require.NoError(t, err)
+
+	// only the enabled curves are advertised
+	require.Equal(t, []rosettatypes.CurveType{rosettatypes.Secp256k1, rosettatypes.Secp256r1}, c.CurveTypes())
+	require.Equal(t, []rosettatypes.SignatureType{rosettatypes.Ecdsa}, c.SignatureTypes())
+
+	r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		curveType rosettatypes.CurveType
+		pubKey    cryptotypes.PubKey
+		expErr    error
+	}{
+		{"secp256k1", rosettatypes.Secp256k1, secp256k1.GenPrivKey().PubKey(), nil},
+		{"secp256r1", rosettatypes.Secp256r1, r1PrivKey.PubKey(), nil},
+		{"disabled curve", rosettatypes.Edwards25519, ed25519.GenPrivKey().PubKey(), crgerrs.ErrUnsupportedCurve},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			account, err := c.AccountIdentifierFromPublicKey(&rosettatypes.PublicKey{Bytes: tc.pubKey.Bytes(), CurveType: tc.curveType})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			expected, err := bech32.ConvertAndEncode("chain", tc.pubKey.Address())
+			require.NoError(t, err)
+			require.Equal(t, expected, account.Address)
+		})
+	}
+
+	// without a prefix, the address is encoded with the one of the chain
+	c.config.Bech32Prefix = ""
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	account, err := c.AccountIdentifierFromPublicKey(&rosettatypes.PublicKey{Bytes: pubKey.Bytes(), CurveType: rosettatypes.Secp256k1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pubKey.Address()).String(), account.Address)
+}
+
+func TestSignModes(t *testing.T) {
+	cdc, ir := MakeCodec()
+	c, err := NewClient(&Config{Codec: cdc, InterfaceRegistry: ir})
+	require.NoError(t, err)
+
+	// SIGN_MODE_TEXTUAL is only supported online
+	require.Equal(t, []string{"SIGN_MODE_LEGACY_AMINO_JSON", "SIGN_MODE_TEXTUAL"}, c.SignModes())
+
+	signMode, err := c.signMode("")
+	require.NoError(t, err)
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signMode)
+
+	signMode, err = c.signMode("SIGN_MODE_TEXTUAL")
+	require.NoError(t, err)
+	require.Equal(t, signing.SignMode_SIGN_MODE_TEXTUAL, signMode)
+
+	_, err = c.signMode("SIGN_MODE_DIRECT")
+	require.ErrorIs(t, err, crgerrs.ErrBadArgument)
+
+	// the metadata of the coins cannot be queried before bootstrapping
+	_, err = c.queryCoinMetadata(context.Background(), "stake")
+	require.ErrorIs(t, err, crgerrs.ErrOffline)
+
+	c.config.Offline = true
+	require.Equal(t, []string{"SIGN_MODE_LEGACY_AMINO_JSON"}, c.SignModes())
+	_, err = c.signMode("SIGN_MODE_TEXTUAL")
+	require.ErrorIs(t, err, crgerrs.ErrBadArgument)
+}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/version"
@@ -20,14 +22,21 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	tmcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"google.golang.org/grpc"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
 	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtxconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -42,6 +51,7 @@ var _ crgtypes.Client = (*Client)(nil)
 const (
 	defaultNodeTimeout = time.Minute
 	tmWebsocketPath    = "/websocket"
+	txSearchPerPage    = 100
 )
 
 // Client implements a single network client to interact with cosmos based chains
@@ -54,6 +64,9 @@ type Client struct {
 	bank  bank.QueryClient
 	tmRPC tmrpc.Client
 
+	// coinMetadata queries the metadata of the coins rendered in SIGN_MODE_TEXTUAL
+	coinMetadata textual.CoinMetadataQueryFn
+
 	version string
 
 	converter Converter
@@ -68,7 +81,21 @@ func NewClient(cfg *Config) (*Client, error) {
 		v = "unknown"
 	}
 
-	txConfig := authtx.NewTxConfig(cfg.Codec, authtx.DefaultSignModes)
+	if len(cfg.CurveTypes) == 0 {
+		cfg.CurveTypes = []rosettatypes.CurveType{rosettatypes.Secp256k1}
+	}
+
+	c := &Client{
+		config:  cfg,
+		version: fmt.Sprintf("%s/%s", info.AppName, v),
+	}
+
+	enabledSignModes := append([]signing.SignMode{}, authtx.DefaultSignModes...)
+	enabledSignModes = append(enabledSignModes, signing.SignMode_SIGN_MODE_TEXTUAL)
+	txConfig := authtx.NewTxConfigWithOptions(cfg.Codec, authtx.ConfigOptions{
+		EnabledSignModes:           enabledSignModes,
+		TextualCoinMetadataQueryFn: c.queryCoinMetadata,
+	})
 
 	var supportedOperations []string
 	for _, ii := range cfg.InterfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
@@ -87,27 +114,45 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank.EventTypeCoinBurn,
 	)
 
-	return &Client{
-		supportedOperations: supportedOperations,
-		config:              cfg,
-		auth:                nil,
-		bank:                nil,
-		tmRPC:               nil,
-		version:             fmt.Sprintf("%s/%s", info.AppName, v),
-		converter:           NewConverter(cfg.Codec, cfg.InterfaceRegistry, txConfig),
-	}, nil
+	c.supportedOperations = supportedOperations
+	c.converter = NewConverter(cfg.Codec, cfg.InterfaceRegistry, txConfig)
+
+	return c, nil
+}
+
+// queryCoinMetadata queries the metadata of a denom from the node, which is
+// required to render the coins of the transactions signed in SIGN_MODE_TEXTUAL.
+func (c *Client) queryCoinMetadata(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	if c.coinMetadata == nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrOffline, "the metadata of the coins is required to sign in SIGN_MODE_TEXTUAL")
+	}
+
+	return c.coinMetadata(ctx, denom)
 }
 
 // ---------- cosmos-rosetta-gateway.types.Client implementation ------------ //
 
 // Bootstrap is gonna connect the client to the endpoints
 func (c *Client) Bootstrap() error {
-	grpcConn, err := grpc.Dial(c.config.GRPCEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(c.config.TendermintRPC)
 	if err != nil {
 		return err
 	}
 
-	tmRPC, err := http.New(c.config.TendermintRPC, tmWebsocketPath)
+	// the node calls are traced as children of the request spans, so that the
+	// time spent querying the node can be told apart from the conversions
+	if tracer := c.tracer(); tracer != nil {
+		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(traceGRPCCalls(tracer)))
+		httpClient.Transport = tracingTransport{base: httpClient.Transport, tracer: tracer}
+	}
+
+	grpcConn, err := grpc.Dial(c.config.GRPCEndpoint, dialOpts...)
+	if err != nil {
+		return err
+	}
+
+	tmRPC, err := http.NewWithClient(c.config.TendermintRPC, tmWebsocketPath, httpClient)
 	if err != nil {
 		return err
 	}
@@ -118,6 +163,7 @@ func (c *Client) Bootstrap() error {
 	c.auth = authClient
 	c.bank = bankClient
 	c.tmRPC = tmRPC
+	c.coinMetadata = authtxconfig.NewGRPCCoinMetadataQueryFn(grpcConn)
 
 	return nil
 }
@@ -188,25 +234,58 @@ func (c *Client) accountInfo(ctx context.Context, addr string, height *int64) (*
 	return signerData, nil
 }
 
-func (c *Client) Balances(ctx context.Context, addr string, height *int64) ([]*rosettatypes.Amount, error) {
+func (c *Client) Balances(ctx context.Context, addr string, subAccount *rosettatypes.SubAccountIdentifier, height *int64) ([]*rosettatypes.Amount, error) {
 	if height != nil {
 		strHeight := strconv.FormatInt(*height, 10)
 		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strHeight)
 	}
 
+	balance, err := c.subAccountBalance(ctx, addr, subAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	availableCoins, err := c.coins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.converter.ToRosetta().Amounts(balance, availableCoins), nil
+}
+
+// subAccountBalance returns the balance of addr held by the given sub-account:
+// the whole balance if subAccount is nil, the coins which can be spent for
+// SubAccountSpendable, and the coins locked by vesting for SubAccountLocked.
+func (c *Client) subAccountBalance(ctx context.Context, addr string, subAccount *rosettatypes.SubAccountIdentifier) (sdk.Coins, error) {
+	if subAccount != nil && subAccount.Address != SubAccountSpendable && subAccount.Address != SubAccountLocked {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("unsupported sub-account %s, expected %s or %s", subAccount.Address, SubAccountSpendable, SubAccountLocked))
+	}
+
 	balance, err := c.bank.AllBalances(ctx, &bank.QueryAllBalancesRequest{
 		Address: addr,
 	})
 	if err != nil {
 		return nil, crgerrs.FromGRPCToRosettaError(err)
 	}
+	if subAccount == nil {
+		return balance.Balances, nil
+	}
 
-	availableCoins, err := c.coins(ctx)
+	spendable, err := c.bank.SpendableBalances(ctx, &bank.QuerySpendableBalancesRequest{
+		Address: addr,
+	})
 	if err != nil {
-		return nil, err
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+	if subAccount.Address == SubAccountSpendable {
+		return spendable.Balances, nil
 	}
 
-	return c.converter.ToRosetta().Amounts(balance.Balances, availableCoins), nil
+	locked, hasNeg := balance.Balances.SafeSub(spendable.Balances...)
+	if hasNeg {
+		return nil, crgerrs.WrapError(crgerrs.ErrInterpreting, fmt.Sprintf("spendable balance %s exceeds balance %s", spendable.Balances, balance.Balances))
+	}
+	return locked, nil
 }
 
 func (c *Client) BlockByHash(ctx context.Context, hash string) (crgtypes.BlockResponse, error) {
@@ -293,6 +372,12 @@ func (c *Client) TxOperationsAndSignersAccountIdentifiers(signed bool, txBytes [
 	}
 }
 
+// TxMetadata returns the metadata of a transaction which is not represented by its operations,
+// i.e. its memo, timeout height and extension options.
+func (c *Client) TxMetadata(txBytes []byte) (meta map[string]interface{}, err error) {
+	return c.converter.ToRosetta().TxMetadata(txBytes)
+}
+
 // GetTx returns a transaction given its hash. For Rosetta we  make a synthetic transaction for BeginBlock
 //
 //	and EndBlock to adhere to balance tracking rules.
@@ -328,7 +413,7 @@ func (c *Client) GetTx(ctx context.Context, hash string) (*rosettatypes.Transact
 		if err != nil {
 			return nil, crgerrs.WrapError(crgerrs.ErrUnknown, err.Error())
 		}
-		return c.converter.ToRosetta().Tx(rawTx.Tx, &rawTx.TxResult)
+		return c.deliverTx(ctx, rawTx.Tx, &rawTx.TxResult)
 	// handle end block hash
 	case EndBlockTx:
 		// get block height by hash
@@ -486,11 +571,13 @@ func (c *Client) ConstructionMetadataFromOptions(ctx context.Context, options ma
 	}
 
 	metadataResp := ConstructionMetadata{
-		ChainID:     status.NodeInfo.Network,
-		SignersData: signersData,
-		GasLimit:    constructionOptions.GasLimit,
-		GasPrice:    constructionOptions.GasPrice,
-		Memo:        constructionOptions.Memo,
+		ChainID:       status.NodeInfo.Network,
+		SignersData:   signersData,
+		GasLimit:      constructionOptions.GasLimit,
+		GasPrice:      constructionOptions.GasPrice,
+		Memo:          constructionOptions.Memo,
+		TimeoutHeight: constructionOptions.TimeoutHeight,
+		SignMode:      constructionOptions.SignMode,
 	}
 
 	return metadataResp.ToMetadata()
@@ -512,28 +599,32 @@ func (c *Client) blockTxs(ctx context.Context, height *int64) (crgtypes.BlockTra
 		// wtf?
 		panic("block results transactions do now match block transactions")
 	}
-	// process begin and end block txs
-	finalizeBlockTx := &rosettatypes.Transaction{
+	// process begin and end block txs, which carry the balance changes happening outside the block txs
+	beginBlockOps, endBlockOps := c.converter.ToRosetta().BlockEventsOps(blockResults.FinalizeBlockEvents)
+	beginBlockTx := &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: c.converter.ToRosetta().BeginBlockTxHash(blockInfo.BlockID.Hash)},
-		Operations: AddOperationIndexes(
-			nil,
-			c.converter.ToRosetta().BalanceOps(StatusTxSuccess, blockResults.FinalizeBlockEvents),
-		),
+		Operations:            AddOperationIndexes(nil, beginBlockOps),
+	}
+	endBlockTx := &rosettatypes.Transaction{
+		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: c.converter.ToRosetta().EndBlockTxHash(blockInfo.BlockID.Hash)},
+		Operations:            AddOperationIndexes(nil, endBlockOps),
 	}
 
 	deliverTx := make([]*rosettatypes.Transaction, len(blockInfo.Block.Txs))
 	// process normal txs
 	for i, tx := range blockInfo.Block.Txs {
-		rosTx, err := c.converter.ToRosetta().Tx(tx, blockResults.TxsResults[i])
+		rosTx, err := c.deliverTx(ctx, tx, blockResults.TxsResults[i])
 		if err != nil {
 			return crgtypes.BlockTransactionsResponse{}, err
 		}
 		deliverTx[i] = rosTx
 	}
 
+	// the begin block tx comes first and the end block tx last, as GetTx expects
 	finalTxs := make([]*rosettatypes.Transaction, 0, 2+len(deliverTx))
+	finalTxs = append(finalTxs, beginBlockTx)
 	finalTxs = append(finalTxs, deliverTx...)
-	finalTxs = append(finalTxs, finalizeBlockTx)
+	finalTxs = append(finalTxs, endBlockTx)
 
 	return crgtypes.BlockTransactionsResponse{
 		BlockResponse: c.converter.ToRosetta().BlockResponse(blockInfo),
@@ -541,6 +632,305 @@ func (c *Client) blockTxs(ctx context.Context, height *int64) (crgtypes.BlockTra
 	}, nil
 }
 
+// SearchTransactions searches the transactions indexed by the node which match the given filters. As the node tx
+// indexer supports neither alternatives nor filtering by result, all the matching transactions are fetched, then
+// filtered, sorted and paginated.
+func (c *Client) SearchTransactions(ctx context.Context, filters crgtypes.TxSearchFilters, offset, limit int64) (crgtypes.SearchTransactionsResponse, error) {
+	queries, err := c.txSearchQueries(filters)
+	if err != nil {
+		return crgtypes.SearchTransactionsResponse{}, err
+	}
+
+	seen := make(map[string]struct{})
+	var txs []*tmcoretypes.ResultTx
+	for _, query := range queries {
+		queryTxs, err := c.searchTxs(ctx, query)
+		if err != nil {
+			return crgtypes.SearchTransactionsResponse{}, err
+		}
+
+		for _, tx := range queryTxs {
+			if _, ok := seen[tx.Hash.String()]; ok {
+				continue
+			}
+			seen[tx.Hash.String()] = struct{}{}
+
+			if filters.Success != nil && (tx.TxResult.Code == abcitypes.CodeTypeOK) != *filters.Success {
+				continue
+			}
+
+			txs = append(txs, tx)
+		}
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height > txs[j].Height
+		}
+		return txs[i].Index > txs[j].Index
+	})
+
+	total := int64(len(txs))
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+
+	blocks := make(map[int64]*rosettatypes.BlockIdentifier)
+	blockTxs := make([]*rosettatypes.BlockTransaction, 0, end-offset)
+	for _, tx := range txs[offset:end] {
+		block, ok := blocks[tx.Height]
+		if !ok {
+			header, err := c.tmRPC.Header(ctx, &tx.Height)
+			if err != nil {
+				return crgtypes.SearchTransactionsResponse{}, crgerrs.WrapError(crgerrs.ErrUnknown, err.Error())
+			}
+
+			block = &rosettatypes.BlockIdentifier{
+				Index: tx.Height,
+				Hash:  header.Header.Hash().String(),
+			}
+			blocks[tx.Height] = block
+		}
+
+		rosTx, err := c.deliverTx(ctx, tx.Tx, &tx.TxResult)
+		if err != nil {
+			return crgtypes.SearchTransactionsResponse{}, err
+		}
+
+		blockTxs = append(blockTxs, &rosettatypes.BlockTransaction{
+			BlockIdentifier: block,
+			Transaction:     rosTx,
+		})
+	}
+
+	return crgtypes.SearchTransactionsResponse{
+		Transactions: blockTxs,
+		TotalCount:   total,
+	}, nil
+}
+
+// deliverTx converts a transaction executed in a block and its result to a rosetta transaction, along with its related
+// transactions
+func (c *Client) deliverTx(ctx context.Context, rawTx cmttypes.Tx, txResult *abcitypes.ExecTxResult) (*rosettatypes.Transaction, error) {
+	rosTx, err := c.converter.ToRosetta().Tx(rawTx, txResult)
+	if err != nil {
+		return nil, err
+	}
+
+	if txResult.Code == abcitypes.CodeTypeOK {
+		rosTx.RelatedTransactions, err = c.relatedTransactions(ctx, rosTx.TransactionIdentifier.Hash, txResult.Events)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rosTx, nil
+}
+
+// relatedTransactions returns the transactions linked to the one with the given hash and events by the IBC packets
+// flowing through both: the transactions acknowledging or timing out the packets it sends, e.g. ICS-20 transfers,
+// come forward, and the transactions sending the packets it acknowledges or times out come backward. The receipt of
+// the packets happens on the counterparty chain, whose transactions are unknown to the node.
+func (c *Client) relatedTransactions(ctx context.Context, hash string, events []abcitypes.Event) ([]*rosettatypes.RelatedTransaction, error) {
+	seen := map[string]struct{}{hash: {}}
+	var related []*rosettatypes.RelatedTransaction
+	for _, e := range events {
+		var (
+			eventTypes []string
+			direction  rosettatypes.Direction
+		)
+		switch e.Type {
+		case EventTypeSendPacket:
+			eventTypes, direction = []string{EventTypeAcknowledgePacket, EventTypeTimeoutPacket}, rosettatypes.Forward
+		case EventTypeAcknowledgePacket, EventTypeTimeoutPacket:
+			eventTypes, direction = []string{EventTypeSendPacket}, rosettatypes.Backward
+		default:
+			continue
+		}
+
+		packet, ok := packetFromEvent(e)
+		if !ok {
+			continue
+		}
+
+		for _, eventType := range eventTypes {
+			txs, err := c.searchTxs(ctx, packet.query(eventType))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, tx := range txs {
+				// the tx indexer may match attributes of distinct events of the same type
+				if tx.TxResult.Code != abcitypes.CodeTypeOK || !packet.in(eventType, tx.TxResult.Events) {
+					continue
+				}
+				if _, ok := seen[tx.Hash.String()]; ok {
+					continue
+				}
+				seen[tx.Hash.String()] = struct{}{}
+
+				related = append(related, &rosettatypes.RelatedTransaction{
+					TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: tx.Hash.String()},
+					Direction:             direction,
+				})
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// packet identifies an IBC packet by its source port and channel, and its sequence
+type packet struct {
+	port, channel, sequence string
+}
+
+// packetFromEvent returns the packet sent, acknowledged or timed out by the given event
+func packetFromEvent(e abcitypes.Event) (p packet, ok bool) {
+	for _, attr := range e.Attributes {
+		switch attr.Key {
+		case AttributeKeyPacketSrcPort:
+			p.port = attr.Value
+		case AttributeKeyPacketSrcChannel:
+			p.channel = attr.Value
+		case AttributeKeyPacketSequence:
+			p.sequence = attr.Value
+		}
+	}
+
+	if p.port == "" || p.channel == "" || p.sequence == "" || strings.ContainsAny(p.port+p.channel+p.sequence, `'"\`) {
+		return packet{}, false
+	}
+	return p, true
+}
+
+// query returns the tx indexer query matching the transactions with an event of the given type for the packet
+func (p packet) query(eventType string) string {
+	return fmt.Sprintf("%[1]s.%[2]s='%[3]s' AND %[1]s.%[4]s='%[5]s' AND %[1]s.%[6]s='%[7]s'",
+		eventType, AttributeKeyPacketSrcPort, p.port, AttributeKeyPacketSrcChannel, p.channel, AttributeKeyPacketSequence, p.sequence)
+}
+
+// in returns whether one of the events is of the given type for the packet
+func (p packet) in(eventType string, events []abcitypes.Event) bool {
+	for _, e := range events {
+		if e.Type != eventType {
+			continue
+		}
+		if eventPacket, ok := packetFromEvent(e); ok && eventPacket == p {
+			return true
+		}
+	}
+	return false
+}
+
+// searchTxs fetches all the pages of the transactions matching the given tx indexer query
+func (c *Client) searchTxs(ctx context.Context, query string) ([]*tmcoretypes.ResultTx, error) {
+	var txs []*tmcoretypes.ResultTx
+
+	perPage := txSearchPerPage
+	for page := 1; ; page++ {
+		res, err := c.tmRPC.TxSearch(ctx, query, false, &page, &perPage, "desc")
+		if err != nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrUnknown, err.Error())
+		}
+
+		txs = append(txs, res.Txs...)
+		if len(res.Txs) == 0 || len(txs) >= res.TotalCount {
+			return txs, nil
+		}
+	}
+}
+
+// txSearchQueries converts the filters to tx indexer queries, the transactions matching the filters are the ones
+// matching any of the queries.
+func (c *Client) txSearchQueries(filters crgtypes.TxSearchFilters) ([]string, error) {
+	var conditions []string
+
+	if filters.Hash != "" {
+		hash, err := hex.DecodeString(filters.Hash)
+		if err != nil || len(hash) != DeliverTxSize {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid tx hash provided: %s", filters.Hash))
+		}
+		conditions = append(conditions, fmt.Sprintf("%s='%X'", cmttypes.TxHashKey, hash))
+	}
+
+	if filters.Type != "" {
+		condition, err := c.txTypeCondition(filters.Type)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// an address can appear in several events, so each of them is a distinct query
+	var addressConditions []string
+	if filters.Address != "" {
+		if strings.ContainsAny(filters.Address, `'"\`) {
+			return nil, crgerrs.WrapError(crgerrs.ErrInvalidAddress, filters.Address)
+		}
+
+		addressConditions = []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, filters.Address),
+			fmt.Sprintf("%s.%s='%s'", bank.EventTypeCoinSpent, bank.AttributeKeySpender, filters.Address),
+			fmt.Sprintf("%s.%s='%s'", bank.EventTypeCoinReceived, bank.AttributeKeyReceiver, filters.Address),
+		}
+	}
+
+	var queries []string
+	switch filters.Operator {
+	case "", rosettatypes.AND:
+		if len(addressConditions) == 0 {
+			queries = []string{strings.Join(conditions, " AND ")}
+			break
+		}
+
+		for _, addressCondition := range addressConditions {
+			queries = append(queries, strings.Join(append(conditions[:len(conditions):len(conditions)], addressCondition), " AND "))
+		}
+	case rosettatypes.OR:
+		queries = append(conditions, addressConditions...)
+	default:
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid operator: %s", filters.Operator))
+	}
+
+	if len(queries) == 0 || queries[0] == "" {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "no search filter provided")
+	}
+
+	if filters.MaxBlock != nil {
+		for i := range queries {
+			queries[i] = fmt.Sprintf("%s AND %s<=%d", queries[i], cmttypes.TxHeightKey, *filters.MaxBlock)
+		}
+	}
+
+	return queries, nil
+}
+
+// txTypeCondition returns the tx indexer condition matching the transactions which contain an operation of the
+// given type
+func (c *Client) txTypeCondition(opType string) (string, error) {
+	switch opType {
+	case bank.EventTypeCoinSpent:
+		return fmt.Sprintf("%s.%s EXISTS", bank.EventTypeCoinSpent, bank.AttributeKeySpender), nil
+	case bank.EventTypeCoinReceived:
+		return fmt.Sprintf("%s.%s EXISTS", bank.EventTypeCoinReceived, bank.AttributeKeyReceiver), nil
+	case bank.EventTypeCoinBurn:
+		return fmt.Sprintf("%s.%s EXISTS", bank.EventTypeCoinBurn, bank.AttributeKeyBurner), nil
+	}
+
+	for _, supported := range c.supportedOperations {
+		if supported == opType {
+			return fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, opType), nil
+		}
+	}
+
+	return "", crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("unsupported operation type: %s", opType))
+}
+
 var initialHeightRE = regexp.MustCompile(`"initial_height":"(\d+)"`)
 
 func extractInitialHeightFromGenesisChunk(genesisChunk string) (int64, error) {
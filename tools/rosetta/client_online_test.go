@@ -1,10 +1,23 @@
 package rosetta
 
 import (
+	"context"
 	"encoding/base64"
+	"fmt"
 	"testing"
 
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpc "github.com/cometbft/cometbft/rpc/client"
+	tmcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestRegex(t *testing.T) {
@@ -13,3 +26,170 @@ func TestRegex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, height, int64(5900001))
 }
+
+func TestTxSearchQueries(t *testing.T) {
+	c := &Client{supportedOperations: []string{"/cosmos.bank.v1beta1.MsgSend", bank.EventTypeCoinSpent}}
+	addr := "cosmos1hdmjfmqmf8ck4pv4evu0s3up0ucm0yjjqfl87e"
+	hash := "6A8BE4A3B50D4D09A3FA35F1BD28D4C1F3B2FF4F2D4B1F8A4C0E3B2F6A8BE4A3"
+	maxBlock := int64(100)
+
+	testCases := []struct {
+		name    string
+		filters crgtypes.TxSearchFilters
+		expErr  bool
+		expect  []string
+	}{
+		{
+			name:    "no filter",
+			filters: crgtypes.TxSearchFilters{MaxBlock: &maxBlock},
+			expErr:  true,
+		},
+		{
+			name:    "address and type up to max block",
+			filters: crgtypes.TxSearchFilters{Address: addr, Type: "/cosmos.bank.v1beta1.MsgSend", MaxBlock: &maxBlock},
+			expect: []string{
+				"message.action='/cosmos.bank.v1beta1.MsgSend' AND message.sender='" + addr + "' AND tx.height<=100",
+				"message.action='/cosmos.bank.v1beta1.MsgSend' AND coin_spent.spender='" + addr + "' AND tx.height<=100",
+				"message.action='/cosmos.bank.v1beta1.MsgSend' AND coin_received.receiver='" + addr + "' AND tx.height<=100",
+			},
+		},
+		{
+			name:    "hash or balance operation type",
+			filters: crgtypes.TxSearchFilters{Operator: rosettatypes.OR, Hash: hash, Type: bank.EventTypeCoinReceived},
+			expect:  []string{"tx.hash='" + hash + "'", "coin_received.receiver EXISTS"},
+		},
+		{
+			name:    "unsupported type",
+			filters: crgtypes.TxSearchFilters{Type: "/cosmos.gov.v1.MsgVote"},
+			expErr:  true,
+		},
+		{
+			name:    "invalid hash",
+			filters: crgtypes.TxSearchFilters{Hash: "ff"},
+			expErr:  true,
+		},
+		{
+			name:    "address with quotes",
+			filters: crgtypes.TxSearchFilters{Address: "cosmos1' OR tx.height>0"},
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			queries, err := c.txSearchQueries(tc.filters)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, queries)
+		})
+	}
+}
+
+// mockBankClient serves the balances of a single account.
+type mockBankClient struct {
+	bank.QueryClient
+	balance, spendable sdk.Coins
+}
+
+func (m mockBankClient) AllBalances(context.Context, *bank.QueryAllBalancesRequest, ...grpc.CallOption) (*bank.QueryAllBalancesResponse, error) {
+	return &bank.QueryAllBalancesResponse{Balances: m.balance}, nil
+}
+
+func (m mockBankClient) SpendableBalances(context.Context, *bank.QuerySpendableBalancesRequest, ...grpc.CallOption) (*bank.QuerySpendableBalancesResponse, error) {
+	return &bank.QuerySpendableBalancesResponse{Balances: m.spendable}, nil
+}
+
+func TestSubAccountBalance(t *testing.T) {
+	c := &Client{bank: mockBankClient{
+		balance:   sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10)),
+		spendable: sdk.NewCoins(sdk.NewInt64Coin("atom", 40), sdk.NewInt64Coin("stake", 10)),
+	}}
+	addr := "cosmos1hdmjfmqmf8ck4pv4evu0s3up0ucm0yjjqfl87e"
+
+	testCases := []struct {
+		name       string
+		subAccount *rosettatypes.SubAccountIdentifier
+		expErr     bool
+		expect     sdk.Coins
+	}{
+		{"whole balance", nil, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10))},
+		{"spendable", &rosettatypes.SubAccountIdentifier{Address: SubAccountSpendable}, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 40), sdk.NewInt64Coin("stake", 10))},
+		{"locked", &rosettatypes.SubAccountIdentifier{Address: SubAccountLocked}, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))},
+		{"unsupported", &rosettatypes.SubAccountIdentifier{Address: "staked"}, true, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			balance, err := c.subAccountBalance(context.Background(), addr, tc.subAccount)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, balance)
+		})
+	}
+}
+
+// mockTxSearchClient serves the transactions indexed for each tx indexer query.
+type mockTxSearchClient struct {
+	tmrpc.Client
+
+	txs map[string][]*tmcoretypes.ResultTx
+}
+
+func (m mockTxSearchClient) TxSearch(_ context.Context, query string, _ bool, _, _ *int, _ string) (*tmcoretypes.ResultTxSearch, error) {
+	return &tmcoretypes.ResultTxSearch{Txs: m.txs[query], TotalCount: len(m.txs[query])}, nil
+}
+
+func TestRelatedTransactions(t *testing.T) {
+	packetEvent := func(eventType, channel, sequence string) abcitypes.Event {
+		return abcitypes.Event{Type: eventType, Attributes: []abcitypes.EventAttribute{
+			{Key: AttributeKeyPacketSequence, Value: sequence},
+			{Key: AttributeKeyPacketSrcPort, Value: "transfer"},
+			{Key: AttributeKeyPacketSrcChannel, Value: channel},
+		}}
+	}
+	resultTx := func(tx string, code uint32, events ...abcitypes.Event) *tmcoretypes.ResultTx {
+		return &tmcoretypes.ResultTx{
+			Hash:     cmttypes.Tx(tx).Hash(),
+			Tx:       cmttypes.Tx(tx),
+			TxResult: abcitypes.ExecTxResult{Code: code, Events: events},
+		}
+	}
+	hash := func(tx string) string { return fmt.Sprintf("%X", cmttypes.Tx(tx).Hash()) }
+
+	send := resultTx("send", 0, packetEvent(EventTypeSendPacket, "channel-0", "1"), packetEvent(EventTypeSendPacket, "channel-1", "7"))
+	ack := resultTx("ack", 0, packetEvent(EventTypeAcknowledgePacket, "channel-0", "1"))
+	failedAck := resultTx("failed ack", 1, packetEvent(EventTypeAcknowledgePacket, "channel-0", "1"))
+	timeout := resultTx("timeout", 0, packetEvent(EventTypeTimeoutPacket, "channel-1", "7"))
+	// matches the query with the attributes of distinct packets
+	mixed := resultTx("mixed", 0, packetEvent(EventTypeAcknowledgePacket, "channel-0", "7"), packetEvent(EventTypeAcknowledgePacket, "channel-1", "1"))
+
+	c := &Client{tmRPC: mockTxSearchClient{txs: map[string][]*tmcoretypes.ResultTx{
+		"acknowledge_packet.packet_src_port='transfer' AND acknowledge_packet.packet_src_channel='channel-0' AND acknowledge_packet.packet_sequence='1'": {ack, failedAck, mixed},
+		"timeout_packet.packet_src_port='transfer' AND timeout_packet.packet_src_channel='channel-1' AND timeout_packet.packet_sequence='7'":             {timeout},
+		"send_packet.packet_src_port='transfer' AND send_packet.packet_src_channel='channel-0' AND send_packet.packet_sequence='1'":                      {send},
+	}}}
+
+	related, err := c.relatedTransactions(context.Background(), hash("send"), send.TxResult.Events)
+	require.NoError(t, err)
+	require.Equal(t, []*rosettatypes.RelatedTransaction{
+		{TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: hash("ack")}, Direction: rosettatypes.Forward},
+		{TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: hash("timeout")}, Direction: rosettatypes.Forward},
+	}, related)
+
+	related, err = c.relatedTransactions(context.Background(), hash("ack"), ack.TxResult.Events)
+	require.NoError(t, err)
+	require.Equal(t, []*rosettatypes.RelatedTransaction{
+		{TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: hash("send")}, Direction: rosettatypes.Backward},
+	}, related)
+
+	// the packets with identifiers which cannot be queried are skipped
+	related, err = c.relatedTransactions(context.Background(), hash("other"), []abcitypes.Event{packetEvent(EventTypeSendPacket, "channel-0' OR tx.height>0", "1")})
+	require.NoError(t, err)
+	require.Empty(t, related)
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
@@ -33,7 +35,10 @@ func RosettaCommand(ir codectypes.InterfaceRegistry, cdc codec.Codec) *cobra.Com
 				fmt.Printf("[Rosetta]- Error while creating server: %s", err.Error())
 				return err
 			}
-			return rosettaSrv.Start()
+			// drain the in-flight requests on SIGINT and SIGTERM
+			ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+			return rosettaSrv.Serve(ctx)
 		},
 	}
 	rosetta.SetFlags(cmd.Flags())
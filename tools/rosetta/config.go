@@ -7,6 +7,7 @@ import (
 
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/trace"
 
 	crg "cosmossdk.io/tools/rosetta/lib/server"
 
@@ -38,21 +39,53 @@ const (
 	DenomToSuggest = "uatom"
 	// DefaultPrices defines the default list of prices to suggest
 	DefaultPrices = "1uatom,1stake"
+	// DefaultMetricsAddr defines the default prometheus metrics binding address,
+	// metrics are disabled if empty
+	DefaultMetricsAddr = ""
+	// DefaultShutdownTimeout defines the default time given to the rosetta server
+	// to drain its in-flight requests when it stops
+	DefaultShutdownTimeout = 30 * time.Second
+	// DefaultCurveTypes defines the default curve types of the public keys
+	// accepted by the construction API
+	DefaultCurveTypes = "secp256k1"
+	// DefaultOTLPEndpoint defines the default OTLP/HTTP endpoint the traces are
+	// exported to, tracing is disabled if empty
+	DefaultOTLPEndpoint = ""
+	// DefaultMaxBlockTransactions defines the default maximum number of
+	// transactions returned inline by /block, chunking is disabled if 0
+	DefaultMaxBlockTransactions = 0
+	// DefaultGzip defines the default gzip compression of the responses
+	DefaultGzip = false
 )
 
+// supportedCurveTypes are the curve types of the public keys the construction
+// API can accept, if the chain enables them
+var supportedCurveTypes = map[types.CurveType]bool{
+	types.Secp256k1:    true,
+	types.Secp256r1:    true,
+	types.Edwards25519: true,
+}
+
 // configuration flags
 const (
-	FlagBlockchain          = "blockchain"
-	FlagNetwork             = "network"
-	FlagTendermintEndpoint  = "tendermint"
-	FlagGRPCEndpoint        = "grpc"
-	FlagAddr                = "addr"
-	FlagRetries             = "retries"
-	FlagOffline             = "offline"
-	FlagEnableFeeSuggestion = "enable-fee-suggestion"
-	FlagGasToSuggest        = "gas-to-suggest"
-	FlagDenomToSuggest      = "denom-to-suggest"
-	FlagPricesToSuggest     = "prices-to-suggest"
+	FlagBlockchain           = "blockchain"
+	FlagNetwork              = "network"
+	FlagTendermintEndpoint   = "tendermint"
+	FlagGRPCEndpoint         = "grpc"
+	FlagAddr                 = "addr"
+	FlagRetries              = "retries"
+	FlagOffline              = "offline"
+	FlagEnableFeeSuggestion  = "enable-fee-suggestion"
+	FlagGasToSuggest         = "gas-to-suggest"
+	FlagDenomToSuggest       = "denom-to-suggest"
+	FlagPricesToSuggest      = "prices-to-suggest"
+	FlagMetricsAddr          = "metrics-addr"
+	FlagShutdownTimeout      = "shutdown-timeout"
+	FlagCurveTypes           = "curve-types"
+	FlagBech32Prefix         = "bech32-prefix"
+	FlagOTLPEndpoint         = "otlp-endpoint"
+	FlagMaxBlockTransactions = "max-block-transactions"
+	FlagGzip                 = "gzip"
 )
 
 // Config defines the configuration of the rosetta server
@@ -89,6 +122,34 @@ type Config struct {
 	Codec *codec.ProtoCodec
 	// InterfaceRegistry overrides the default data and construction api interface registry
 	InterfaceRegistry codectypes.InterfaceRegistry
+	// MetricsAddr defines the address to expose the prometheus metrics at,
+	// metrics are disabled if empty
+	MetricsAddr string
+	// ShutdownTimeout defines the time given to the server to drain its
+	// in-flight requests when it stops, defaults to DefaultShutdownTimeout
+	ShutdownTimeout time.Duration
+	// CurveTypes defines the curve types of the public keys accepted by the
+	// construction API, which must be enabled by the chain, defaults to
+	// secp256k1 only
+	CurveTypes []types.CurveType
+	// Bech32Prefix defines the bech32 prefix of the addresses derived from
+	// public keys, defaults to the one of the address codec of the interface
+	// registry
+	Bech32Prefix string
+	// OTLPEndpoint defines the host:port of the OTLP/HTTP collector the traces
+	// of the requests and of their node calls are exported to, unless a
+	// TracerProvider is set. Tracing is disabled if both are empty
+	OTLPEndpoint string
+	// TracerProvider overrides the provider of the tracer of the requests and
+	// of their node calls
+	TracerProvider trace.TracerProvider
+	// MaxBlockTransactions defines the maximum number of transactions returned
+	// inline by /block, the others are listed as other transactions to be
+	// fetched with /block/transaction. Chunking is disabled if 0
+	MaxBlockTransactions int
+	// Gzip enables the gzip compression of the responses to the requests
+	// accepting it
+	Gzip bool
 }
 
 // NetworkIdentifier returns the network identifier given the configuration
@@ -115,10 +176,24 @@ func (c *Config) validate() error {
 	if c.Retries == 0 {
 		c.Retries = DefaultRetries
 	}
+	if c.ShutdownTimeout == 0 {
+		c.ShutdownTimeout = DefaultShutdownTimeout
+	}
+	if len(c.CurveTypes) == 0 {
+		c.CurveTypes = []types.CurveType{types.Secp256k1}
+	}
+	for _, curveType := range c.CurveTypes {
+		if !supportedCurveTypes[curveType] {
+			return fmt.Errorf("unsupported curve type %s", curveType)
+		}
+	}
 	// these are must
 	if c.Network == "" {
 		return fmt.Errorf("network not provided")
 	}
+	if c.MaxBlockTransactions < 0 {
+		return fmt.Errorf("max block transactions cannot be negative")
+	}
 	if c.GasToSuggest <= 0 {
 		return fmt.Errorf("gas to suggest must be positive")
 	}
@@ -135,6 +210,11 @@ func (c *Config) validate() error {
 		}
 	}
 
+	// the node endpoints are not used in offline mode
+	if c.Offline {
+		return nil
+	}
+
 	// these are optional but it must be online
 	if c.GRPCEndpoint == "" {
 		return fmt.Errorf("grpc endpoint not provided")
@@ -197,6 +277,39 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	metricsAddr, err := flags.GetString(FlagMetricsAddr)
+	if err != nil {
+		return nil, err
+	}
+	shutdownTimeout, err := flags.GetDuration(FlagShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+	curveTypeNames, err := flags.GetStringSlice(FlagCurveTypes)
+	if err != nil {
+		return nil, err
+	}
+	bech32Prefix, err := flags.GetString(FlagBech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+	otlpEndpoint, err := flags.GetString(FlagOTLPEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	maxBlockTransactions, err := flags.GetInt(FlagMaxBlockTransactions)
+	if err != nil {
+		return nil, err
+	}
+	gzip, err := flags.GetBool(FlagGzip)
+	if err != nil {
+		return nil, err
+	}
+
+	curveTypes := make([]types.CurveType, len(curveTypeNames))
+	for i, name := range curveTypeNames {
+		curveTypes[i] = types.CurveType(name)
+	}
 
 	var prices sdk.DecCoins
 	if enableDefaultFeeSuggestion {
@@ -211,17 +324,24 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 	}
 
 	conf := &Config{
-		Blockchain:          blockchain,
-		Network:             network,
-		TendermintRPC:       tendermintRPC,
-		GRPCEndpoint:        gRPCEndpoint,
-		Addr:                addr,
-		Retries:             retries,
-		Offline:             offline,
-		EnableFeeSuggestion: enableDefaultFeeSuggestion,
-		GasToSuggest:        gasToSuggest,
-		DenomToSuggest:      denomToSuggest,
-		GasPrices:           prices,
+		Blockchain:           blockchain,
+		Network:              network,
+		TendermintRPC:        tendermintRPC,
+		GRPCEndpoint:         gRPCEndpoint,
+		Addr:                 addr,
+		Retries:              retries,
+		Offline:              offline,
+		EnableFeeSuggestion:  enableDefaultFeeSuggestion,
+		GasToSuggest:         gasToSuggest,
+		DenomToSuggest:       denomToSuggest,
+		GasPrices:            prices,
+		MetricsAddr:          metricsAddr,
+		ShutdownTimeout:      shutdownTimeout,
+		CurveTypes:           curveTypes,
+		Bech32Prefix:         bech32Prefix,
+		OTLPEndpoint:         otlpEndpoint,
+		MaxBlockTransactions: maxBlockTransactions,
+		Gzip:                 gzip,
 	}
 	err = conf.validate()
 	if err != nil {
@@ -235,22 +355,41 @@ func ServerFromConfig(conf *Config) (crg.Server, error) {
 	if err != nil {
 		return crg.Server{}, err
 	}
+	if conf.TracerProvider == nil && conf.OTLPEndpoint != "" {
+		conf.TracerProvider, err = newOTLPTracerProvider(conf.OTLPEndpoint)
+		if err != nil {
+			return crg.Server{}, fmt.Errorf("cannot build the tracer provider: %w", err)
+		}
+	}
 	client, err := NewClient(conf)
 	if err != nil {
 		return crg.Server{}, err
 	}
-	return crg.NewServer(
-		crg.Settings{
-			Network: &types.NetworkIdentifier{
-				Blockchain: conf.Blockchain,
-				Network:    conf.Network,
-			},
-			Client:    client,
-			Listen:    conf.Addr,
-			Offline:   conf.Offline,
-			Retries:   conf.Retries,
-			RetryWait: 15 * time.Second,
-		})
+	settings := crg.Settings{
+		Network: &types.NetworkIdentifier{
+			Blockchain: conf.Blockchain,
+			Network:    conf.Network,
+		},
+		Listen:    conf.Addr,
+		Offline:   conf.Offline,
+		Retries:   conf.Retries,
+		RetryWait: 15 * time.Second,
+
+		MetricsListen:   conf.MetricsAddr,
+		ShutdownTimeout: conf.ShutdownTimeout,
+		TracerProvider:  conf.TracerProvider,
+
+		MaxBlockTransactions: conf.MaxBlockTransactions,
+		Compression:          conf.Gzip,
+	}
+	// in offline mode the client is only exposed through its offline
+	// functionalities, so it is never connected to the node
+	if conf.Offline {
+		settings.OfflineClient = client
+	} else {
+		settings.Client = client
+	}
+	return crg.NewServer(settings)
 }
 
 // SetFlags sets the configuration flags to the given flagset
@@ -261,9 +400,16 @@ func SetFlags(flags *pflag.FlagSet) {
 	flags.String(FlagGRPCEndpoint, DefaultGRPCEndpoint, "the app gRPC endpoint")
 	flags.String(FlagAddr, DefaultAddr, "the address rosetta will bind to")
 	flags.Int(FlagRetries, DefaultRetries, "the number of retries that will be done before quitting")
-	flags.Bool(FlagOffline, DefaultOffline, "run rosetta only with construction API")
+	flags.Bool(FlagOffline, DefaultOffline, "run rosetta without connecting to the node, exposing only the offline construction API")
 	flags.Bool(FlagEnableFeeSuggestion, DefaultEnableFeeSuggestion, "enable default fee suggestion")
 	flags.Int(FlagGasToSuggest, clientflags.DefaultGasLimit, "default gas for fee suggestion")
 	flags.String(FlagDenomToSuggest, DenomToSuggest, "default denom for fee suggestion")
 	flags.String(FlagPricesToSuggest, DefaultPrices, "default prices for fee suggestion")
+	flags.String(FlagMetricsAddr, DefaultMetricsAddr, "the address the prometheus metrics will be exposed at, metrics are disabled if empty")
+	flags.Duration(FlagShutdownTimeout, DefaultShutdownTimeout, "the time given to the server to drain its in-flight requests when it stops")
+	flags.StringSlice(FlagCurveTypes, []string{DefaultCurveTypes}, "the curve types of the public keys accepted by the construction API, among secp256k1, secp256r1 and edwards25519, which must be enabled by the chain")
+	flags.String(FlagBech32Prefix, "", "the bech32 prefix of the addresses derived from public keys, defaults to the one of the app")
+	flags.String(FlagOTLPEndpoint, DefaultOTLPEndpoint, "the host:port of the OTLP/HTTP collector the request traces are exported to, tracing is disabled if empty")
+	flags.Int(FlagMaxBlockTransactions, DefaultMaxBlockTransactions, "the maximum number of transactions returned inline by /block, the others are only listed as other transactions to fetch with /block/transaction, chunking is disabled if 0")
+	flags.Bool(FlagGzip, DefaultGzip, "gzip compress the responses to the requests accepting it")
 }
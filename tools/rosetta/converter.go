@@ -22,11 +22,15 @@ import (
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -61,20 +65,29 @@ type ToRosettaConverter interface {
 	Amounts(ownedCoins []sdk.Coin, availableCoins sdk.Coins) []*rosettatypes.Amount
 	// Ops converts an sdk.Msg to rosetta operations
 	Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error)
+	// ExecOps converts the messages executed by an authz exec to rosetta operations related to the exec operations
+	ExecOps(status string, msg sdk.Msg, firstIndex int64, numOps int) ([]*rosettatypes.Operation, error)
 	// OpsAndSigners takes raw transaction bytes and returns rosetta operations and the expected signers
 	OpsAndSigners(txBytes []byte) (ops []*rosettatypes.Operation, signers []*rosettatypes.AccountIdentifier, err error)
+	// TxMetadata takes raw transaction bytes and returns the rosetta metadata of the transaction
+	// which is not represented by its operations
+	TxMetadata(txBytes []byte) (meta map[string]interface{}, err error)
 	// Meta converts an sdk.Msg to rosetta metadata
 	Meta(msg sdk.Msg) (meta map[string]interface{}, err error)
 	// SignerData returns account signing data from a queried any account
 	SignerData(anyAccount *codectypes.Any) (*SignerData, error)
-	// SigningComponents returns rosetta's components required to build a signable transaction
-	SigningComponents(tx authsigning.Tx, metadata *ConstructionMetadata, rosPubKeys []*rosettatypes.PublicKey) (txBytes []byte, payloadsToSign []*rosettatypes.SigningPayload, err error)
+	// SigningComponents returns rosetta's components required to build a transaction signable
+	// in the given sign mode
+	SigningComponents(tx authsigning.Tx, metadata *ConstructionMetadata, signMode signing.SignMode, rosPubKeys []*rosettatypes.PublicKey) (txBytes []byte, payloadsToSign []*rosettatypes.SigningPayload, err error)
 	// Tx converts a CometBFT transaction and tx result if provided to a rosetta tx
 	Tx(rawTx cmttypes.Tx, txResult *abci.ExecTxResult) (*rosettatypes.Transaction, error)
 	// TxIdentifiers converts a CometBFT tx to transaction identifiers
 	TxIdentifiers(txs []cmttypes.Tx) []*rosettatypes.TransactionIdentifier
 	// BalanceOps converts events to balance operations
 	BalanceOps(status string, events []abci.Event) []*rosettatypes.Operation
+	// BlockEventsOps converts the FinalizeBlock events to the balance operations of the synthetic begin block and
+	// end block transactions
+	BlockEventsOps(events []abci.Event) (beginBlockOps, endBlockOps []*rosettatypes.Operation)
 	// SyncStatus converts a CometBFT status to sync status
 	SyncStatus(status *tmcoretypes.ResultStatus) *rosettatypes.SyncStatus
 	// Peers converts CometBFT peers to rosetta
@@ -104,7 +117,7 @@ type converter struct {
 	txBuilderFromTx func(tx sdk.Tx) (sdkclient.TxBuilder, error)
 	txDecode        sdk.TxDecoder
 	txEncode        sdk.TxEncoder
-	bytesToSign     func(tx authsigning.Tx, signerData authsigning.SignerData) (b []byte, err error)
+	bytesToSign     func(tx authsigning.Tx, signMode signing.SignMode, signerData authsigning.SignerData) (b []byte, err error)
 	ir              codectypes.InterfaceRegistry
 	cdc             *codec.ProtoCodec
 }
@@ -115,10 +128,10 @@ func NewConverter(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sd
 		txBuilderFromTx: cfg.WrapTxBuilder,
 		txDecode:        cfg.TxDecoder(),
 		txEncode:        cfg.TxEncoder(),
-		bytesToSign: func(tx authsigning.Tx, signerData authsigning.SignerData) (b []byte, err error) {
+		bytesToSign: func(tx authsigning.Tx, signMode signing.SignMode, signerData authsigning.SignerData) (b []byte, err error) {
 			bytesToSign, err := authsigning.GetSignBytesAdapter(
 				context.Background(), cfg.SignModeHandler(),
-				signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
+				signMode, signerData, tx)
 			if err != nil {
 				return nil, err
 			}
@@ -274,6 +287,49 @@ func (c converter) Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, e
 	return ops, nil
 }
 
+// ExecOps returns the operations of the messages executed by msg on behalf of their signers if it is an authz exec,
+// nested execs included. Their related operations are the operations of msg, which are given the indexes following
+// firstIndex, and the indexes of the returned operations follow them.
+func (c converter) ExecOps(status string, msg sdk.Msg, firstIndex int64, numOps int) ([]*rosettatypes.Operation, error) {
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil, nil
+	}
+
+	execMsgs, err := exec.GetMessages()
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+	}
+
+	related := make([]*rosettatypes.OperationIdentifier, numOps)
+	for i := range related {
+		related[i] = &rosettatypes.OperationIdentifier{Index: firstIndex + int64(i)}
+	}
+
+	var ops []*rosettatypes.Operation
+	nextIndex := firstIndex + int64(numOps)
+	for _, execMsg := range execMsgs {
+		msgOps, err := c.Ops(status, execMsg)
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range msgOps {
+			op.RelatedOperations = related
+		}
+
+		nestedOps, err := c.ExecOps(status, execMsg, nextIndex, len(msgOps))
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, msgOps...)
+		ops = append(ops, nestedOps...)
+		nextIndex += int64(len(msgOps) + len(nestedOps))
+	}
+
+	return ops, nil
+}
+
 // Tx converts a CometBFT raw transaction and its result (if provided) to a rosetta transaction
 func (c converter) Tx(rawTx cmttypes.Tx, txResult *abci.ExecTxResult) (*rosettatypes.Transaction, error) {
 	// decode tx
@@ -306,7 +362,17 @@ func (c converter) Tx(rawTx cmttypes.Tx, txResult *abci.ExecTxResult) (*rosettat
 		if err != nil {
 			return nil, err
 		}
+		// the messages executed by the authz execs are only represented once the tx is executed, as they are not
+		// part of the operations the tx is constructed from
+		var execOps []*rosettatypes.Operation
+		if txResult != nil {
+			execOps, err = c.ExecOps(status, msg, int64(len(rawTxOps)), len(ops))
+			if err != nil {
+				return nil, err
+			}
+		}
 		rawTxOps = append(rawTxOps, ops...)
+		rawTxOps = append(rawTxOps, execOps...)
 	}
 
 	// now get balance events from response deliver tx
@@ -339,6 +405,34 @@ func (c converter) BalanceOps(status string, events []abci.Event) []*rosettatype
 	return ops
 }
 
+// BlockEventsOps converts the FinalizeBlock events to the balance operations of the synthetic begin block and end block
+// transactions, e.g. the inflation mint and the fee distribution happening at begin block, or the slashing burns and
+// unbonding releases happening at end block. The events are split by the mode attribute set by baseapp, and the
+// events without mode are attributed to the begin block.
+func (c converter) BlockEventsOps(events []abci.Event) (beginBlockOps, endBlockOps []*rosettatypes.Operation) {
+	var beginBlockEvents, endBlockEvents []abci.Event
+	for _, e := range events {
+		if blockEventMode(e) == EventModeEndBlock {
+			endBlockEvents = append(endBlockEvents, e)
+		} else {
+			beginBlockEvents = append(beginBlockEvents, e)
+		}
+	}
+
+	return c.BalanceOps(StatusTxSuccess, beginBlockEvents), c.BalanceOps(StatusTxSuccess, endBlockEvents)
+}
+
+// blockEventMode returns the mode attribute of a FinalizeBlock event, or an empty string if it is not set.
+func blockEventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == EventAttributeMode {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
 // sdkEventToBalanceOperations converts an event to a rosetta balance operation
 // it will panic if the event is malformed because it might mean the sdk spec
 // has changed and rosetta needs to reflect those changes too.
@@ -624,6 +718,33 @@ func (c converter) OpsAndSigners(txBytes []byte) (ops []*rosettatypes.Operation,
 	return ops, signers, nil
 }
 
+// TxMetadata takes transaction bytes and returns its memo, timeout height and the type URLs of
+// its extension options as rosetta metadata
+func (c converter) TxMetadata(txBytes []byte) (meta map[string]interface{}, err error) {
+	sdkTx, err := c.txDecode(txBytes)
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+	}
+
+	var txMeta TxMetadata
+	if tx, ok := sdkTx.(sdk.TxWithMemo); ok {
+		txMeta.Memo = tx.GetMemo()
+	}
+	if tx, ok := sdkTx.(sdk.TxWithTimeoutHeight); ok {
+		txMeta.TimeoutHeight = tx.GetTimeoutHeight()
+	}
+	if tx, ok := sdkTx.(ante.HasExtensionOptionsTx); ok {
+		for _, opt := range tx.GetExtensionOptions() {
+			txMeta.ExtensionOptions = append(txMeta.ExtensionOptions, opt.TypeUrl)
+		}
+		for _, opt := range tx.GetNonCriticalExtensionOptions() {
+			txMeta.NonCriticalExtensionOptions = append(txMeta.NonCriticalExtensionOptions, opt.TypeUrl)
+		}
+	}
+
+	return txMeta.ToMetadata()
+}
+
 func (c converter) SignedTx(txBytes []byte, signatures []*rosettatypes.Signature) (signedTxBytes []byte, err error) {
 	rawTx, err := c.txDecode(txBytes)
 	if err != nil {
@@ -648,11 +769,18 @@ func (c converter) SignedTx(txBytes []byte, signatures []*rosettatypes.Signature
 
 	signedSigs := make([]signing.SignatureV2, len(notSignedSigs))
 	for i, signature := range signatures {
+		// the payloads are signed in SIGN_MODE_TEXTUAL if it was recorded in the
+		// signer infos by SigningComponents, in SIGN_MODE_LEGACY_AMINO_JSON otherwise
+		signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		if sigData, ok := notSignedSigs[i].Data.(*signing.SingleSignatureData); ok && sigData.SignMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+			signMode = sigData.SignMode
+		}
+
 		// TODO(fdymylja): here we should check that the public key matches...
 		signedSigs[i] = signing.SignatureV2{
 			PubKey: notSignedSigs[i].PubKey,
 			Data: &signing.SingleSignatureData{
-				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				SignMode:  signMode,
 				Signature: signature.Bytes,
 			},
 			Sequence: notSignedSigs[i].Sequence,
@@ -672,25 +800,58 @@ func (c converter) SignedTx(txBytes []byte, signatures []*rosettatypes.Signature
 }
 
 func (c converter) PubKey(pubKey *rosettatypes.PublicKey) (cryptotypes.PubKey, error) {
-	if pubKey.CurveType != "secp256k1" {
-		return nil, crgerrs.WrapError(crgerrs.ErrUnsupportedCurve, "only secp256k1 supported")
-	}
+	switch pubKey.CurveType {
+	case rosettatypes.Secp256k1:
+		cmp, err := secp.ParsePubKey(pubKey.Bytes)
+		if err != nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+		}
 
-	cmp, err := secp.ParsePubKey(pubKey.Bytes)
-	if err != nil {
-		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
-	}
+		compressedPublicKey := make([]byte, secp256k1.PubKeySize)
+		copy(compressedPublicKey, cmp.SerializeCompressed())
+
+		pk := &secp256k1.PubKey{Key: compressedPublicKey}
 
-	compressedPublicKey := make([]byte, secp256k1.PubKeySize)
-	copy(compressedPublicKey, cmp.SerializeCompressed())
+		return pk, nil
 
-	pk := &secp256k1.PubKey{Key: compressedPublicKey}
+	case rosettatypes.Secp256r1:
+		if len(pubKey.Bytes) > 127 {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid secp256r1 public key size %d", len(pubKey.Bytes)))
+		}
+
+		// the key of a secp256r1 public key can only be set by unmarshaling the
+		// proto message, whose key field (1, length-delimited) holds the
+		// compressed public key
+		pk := new(secp256r1.PubKey)
+		if err := pk.Unmarshal(append([]byte{0x0a, byte(len(pubKey.Bytes))}, pubKey.Bytes...)); err != nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+		}
+
+		return pk, nil
+
+	case rosettatypes.Edwards25519:
+		if len(pubKey.Bytes) != ed25519.PubKeySize {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid ed25519 public key size %d, expected %d", len(pubKey.Bytes), ed25519.PubKeySize))
+		}
+
+		return &ed25519.PubKey{Key: pubKey.Bytes}, nil
+
+	default:
+		return nil, crgerrs.WrapError(crgerrs.ErrUnsupportedCurve, fmt.Sprintf("curve %s is not supported", pubKey.CurveType))
+	}
+}
 
-	return pk, nil
+// signatureTypeOf returns the type of the signatures made with the keys of the
+// given curve.
+func signatureTypeOf(curveType rosettatypes.CurveType) rosettatypes.SignatureType {
+	if curveType == rosettatypes.Edwards25519 {
+		return rosettatypes.Ed25519
+	}
+	return rosettatypes.Ecdsa
 }
 
 // SigningComponents takes a sdk tx and construction metadata and returns signable components
-func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMetadata, rosPubKeys []*rosettatypes.PublicKey) (txBytes []byte, payloadsToSign []*rosettatypes.SigningPayload, err error) {
+func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMetadata, signMode signing.SignMode, rosPubKeys []*rosettatypes.PublicKey) (txBytes []byte, payloadsToSign []*rosettatypes.SigningPayload, err error) {
 	// verify metadata correctness
 	feeAmount, err := sdk.ParseCoinsNormalized(metadata.GasPrice)
 	if err != nil {
@@ -716,10 +877,11 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 	builder.SetFeeAmount(feeAmount)
 	builder.SetGasLimit(metadata.GasLimit)
 	builder.SetMemo(metadata.Memo)
+	builder.SetTimeoutHeight(metadata.TimeoutHeight)
 
 	// build signatures
 	partialSignatures := make([]signing.SignatureV2, len(signers))
-	payloadsToSign = make([]*rosettatypes.SigningPayload, len(signers))
+	signersData := make([]authsigning.SignerData, len(signers))
 
 	// pub key ordering matters, in a future release this check might be relaxed
 	for i, signer := range signers {
@@ -742,7 +904,7 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 		}
 
 		// set the signer data
-		signerData := authsigning.SignerData{
+		signersData[i] = authsigning.SignerData{
 			Address:       signerStr,
 			ChainID:       metadata.ChainID,
 			AccountNumber: metadata.SignersData[i].AccountNumber,
@@ -750,26 +912,18 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 			PubKey:        pubKey,
 		}
 
-		// get signature bytes
-		signBytes, err := c.bytesToSign(tx, signerData)
-		if err != nil {
-			return nil, nil, crgerrs.WrapError(crgerrs.ErrUnknown, fmt.Sprintf("unable to sign tx: %s", err.Error()))
-		}
-
-		// set payload
-		payloadsToSign[i] = &rosettatypes.SigningPayload{
-			AccountIdentifier: &rosettatypes.AccountIdentifier{Address: signerStr},
-			Bytes:             signBytes,
-			SignatureType:     rosettatypes.Ecdsa,
+		// set partial signature, the textual sign mode is recorded in the signer
+		// infos as SIGN_MODE_TEXTUAL signs over them, while the legacy amino json
+		// one is set by SignedTx
+		sigData := &signing.SingleSignatureData{} // needs to be set to empty otherwise the codec will cry
+		if signMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+			sigData.SignMode = signMode
 		}
-
-		// set partial signature
 		partialSignatures[i] = signing.SignatureV2{
 			PubKey:   pubKey,
-			Data:     &signing.SingleSignatureData{}, // needs to be set to empty otherwise the codec will cry
+			Data:     sigData,
 			Sequence: metadata.SignersData[i].Sequence,
 		}
-
 	}
 
 	// now we set the partial signatures in the tx
@@ -780,6 +934,22 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 		return nil, nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
 	}
 
+	payloadsToSign = make([]*rosettatypes.SigningPayload, len(signers))
+	for i, signerData := range signersData {
+		// get signature bytes
+		signBytes, err := c.bytesToSign(builder.GetTx(), signMode, signerData)
+		if err != nil {
+			return nil, nil, crgerrs.WrapError(crgerrs.ErrUnknown, fmt.Sprintf("unable to sign tx: %s", err.Error()))
+		}
+
+		// set payload
+		payloadsToSign[i] = &rosettatypes.SigningPayload{
+			AccountIdentifier: &rosettatypes.AccountIdentifier{Address: signerData.Address},
+			Bytes:             signBytes,
+			SignatureType:     signatureTypeOf(rosPubKeys[i].CurveType),
+		}
+	}
+
 	// finally encode the tx
 	txBytes, err = c.txEncode(builder.GetTx())
 	if err != nil {
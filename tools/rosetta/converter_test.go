@@ -1,23 +1,33 @@
 package rosetta_test
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"testing"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/tools/rosetta"
 	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
 
 	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -222,12 +232,12 @@ func (s *ConverterTestSuite) TestBeginEndBlockAndHashToTxType() {
 
 func (s *ConverterTestSuite) TestSigningComponents() {
 	s.Run("invalid metadata coins", func() {
-		_, _, err := s.c.ToRosetta().SigningComponents(nil, &rosetta.ConstructionMetadata{GasPrice: "invalid"}, nil)
+		_, _, err := s.c.ToRosetta().SigningComponents(nil, &rosetta.ConstructionMetadata{GasPrice: "invalid"}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil)
 		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
 	})
 
 	s.Run("length signers data does not match signers", func() {
-		_, _, err := s.c.ToRosetta().SigningComponents(s.unsignedTx, &rosetta.ConstructionMetadata{GasPrice: "10stake"}, nil)
+		_, _, err := s.c.ToRosetta().SigningComponents(s.unsignedTx, &rosetta.ConstructionMetadata{GasPrice: "10stake"}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil)
 		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
 	})
 
@@ -240,6 +250,7 @@ func (s *ConverterTestSuite) TestSigningComponents() {
 					Sequence:      0,
 				},
 			}},
+			signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 			nil)
 		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
 	})
@@ -256,6 +267,7 @@ func (s *ConverterTestSuite) TestSigningComponents() {
 					Sequence:      0,
 				},
 			}},
+			signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 			[]*rosettatypes.PublicKey{
 				{
 					Bytes:     validButUnexpected,
@@ -277,6 +289,7 @@ func (s *ConverterTestSuite) TestSigningComponents() {
 					Sequence:      0,
 				},
 			}},
+			signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 			[]*rosettatypes.PublicKey{
 				{
 					Bytes:     expectedPubKey,
@@ -287,6 +300,151 @@ func (s *ConverterTestSuite) TestSigningComponents() {
 	})
 }
 
+func (s *ConverterTestSuite) TestSigningComponentsTextual() {
+	expectedPubKey, err := hex.DecodeString("034c92046950c876f4a5cb6c7797d6eeb9ef80d67ced4d45fb62b1e859240ba9ad")
+	s.Require().NoError(err)
+	metadata := &rosetta.ConstructionMetadata{
+		ChainID:     "test-chain",
+		GasPrice:    "10stake",
+		SignersData: []*rosetta.SignerData{{AccountNumber: 1, Sequence: 2}},
+	}
+	pubKeys := []*rosettatypes.PublicKey{{Bytes: expectedPubKey, CurveType: rosettatypes.Secp256k1}}
+
+	// SIGN_MODE_TEXTUAL is not enabled in the tx config of the suite
+	_, _, err = s.c.ToRosetta().SigningComponents(s.unsignedTx, metadata, signing.SignMode_SIGN_MODE_TEXTUAL, pubKeys)
+	s.Require().ErrorIs(err, crgerrs.ErrUnknown)
+
+	txConfig := authtx.NewTxConfigWithOptions(s.cdc, authtx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+	c := rosetta.NewConverter(s.cdc, s.ir, txConfig)
+
+	txBytes, payloads, err := c.ToRosetta().SigningComponents(s.unsignedTx, metadata, signing.SignMode_SIGN_MODE_TEXTUAL, pubKeys)
+	s.Require().NoError(err)
+	s.Require().Len(payloads, 1)
+
+	// the sign mode is recorded in the signer infos of the unsigned tx, which are
+	// part of the textual sign bytes
+	unsignedTx, err := txConfig.TxDecoder()(txBytes)
+	s.Require().NoError(err)
+	sigs, err := unsignedTx.(authsigning.Tx).GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Equal(signing.SignMode_SIGN_MODE_TEXTUAL, sigs[0].Data.(*signing.SingleSignatureData).SignMode)
+
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_TEXTUAL, authsigning.SignerData{
+		Address:       payloads[0].AccountIdentifier.Address,
+		ChainID:       metadata.ChainID,
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        sigs[0].PubKey,
+	}, unsignedTx.(authsigning.Tx))
+	s.Require().NoError(err)
+	s.Require().Equal(crypto.Sha256(signBytes), payloads[0].Bytes)
+
+	_, aminoPayloads, err := c.ToRosetta().SigningComponents(s.unsignedTx, metadata, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, pubKeys)
+	s.Require().NoError(err)
+	s.Require().NotEqual(aminoPayloads[0].Bytes, payloads[0].Bytes)
+
+	// the signed tx keeps the textual sign mode
+	signedTxBytes, err := c.ToSDK().SignedTx(txBytes, []*rosettatypes.Signature{{Bytes: []byte("signature")}})
+	s.Require().NoError(err)
+	signedTx, err := txConfig.TxDecoder()(signedTxBytes)
+	s.Require().NoError(err)
+	sigs, err = signedTx.(authsigning.Tx).GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Equal(&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL, Signature: []byte("signature")}, sigs[0].Data)
+}
+
+func (s *ConverterTestSuite) TestPubKey() {
+	s.Run("secp256k1", func() {
+		pubKey := secp256k1.GenPrivKey().PubKey()
+		pk, err := s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: pubKey.Bytes(), CurveType: rosettatypes.Secp256k1})
+		s.Require().NoError(err)
+		s.Require().True(pubKey.Equals(pk))
+	})
+
+	s.Run("secp256r1", func() {
+		privKey, err := secp256r1.GenPrivKey()
+		s.Require().NoError(err)
+		pubKey := privKey.PubKey()
+		pk, err := s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: pubKey.Bytes(), CurveType: rosettatypes.Secp256r1})
+		s.Require().NoError(err)
+		s.Require().True(pubKey.Equals(pk))
+
+		_, err = s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: []byte("invalid"), CurveType: rosettatypes.Secp256r1})
+		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+	})
+
+	s.Run("edwards25519", func() {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		pk, err := s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: pubKey.Bytes(), CurveType: rosettatypes.Edwards25519})
+		s.Require().NoError(err)
+		s.Require().True(pubKey.Equals(pk))
+
+		_, err = s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: []byte("invalid"), CurveType: rosettatypes.Edwards25519})
+		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+	})
+
+	s.Run("unsupported curve", func() {
+		_, err := s.c.ToSDK().PubKey(&rosettatypes.PublicKey{Bytes: []byte("key"), CurveType: rosettatypes.Tweedle})
+		s.Require().ErrorIs(err, crgerrs.ErrUnsupportedCurve)
+	})
+}
+
+func (s *ConverterTestSuite) TestTxMetadata() {
+	expectedPubKey, err := hex.DecodeString("034c92046950c876f4a5cb6c7797d6eeb9ef80d67ced4d45fb62b1e859240ba9ad")
+	s.Require().NoError(err)
+
+	// the memo and timeout height given in the construction metadata are set on the tx
+	txBytes, _, err := s.c.ToRosetta().SigningComponents(
+		s.unsignedTx,
+		&rosetta.ConstructionMetadata{
+			GasPrice:      "10stake",
+			SignersData:   []*rosetta.SignerData{{AccountNumber: 0, Sequence: 0}},
+			Memo:          "deposit-42",
+			TimeoutHeight: 100,
+		},
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		[]*rosettatypes.PublicKey{{Bytes: expectedPubKey, CurveType: rosettatypes.Secp256k1}},
+	)
+	s.Require().NoError(err)
+
+	meta, err := s.c.ToRosetta().TxMetadata(txBytes)
+	s.Require().NoError(err)
+
+	var txMeta rosetta.TxMetadata
+	s.Require().NoError(txMeta.FromMetadata(meta))
+	s.Require().Equal(rosetta.TxMetadata{Memo: "deposit-42", TimeoutHeight: 100}, txMeta)
+
+	// the extension options are reported by type url
+	s.ir.RegisterImplementations((*tx.TxExtensionOptionI)(nil), &testdata.Cat{})
+	extOpt, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "ext"})
+	s.Require().NoError(err)
+
+	builder, err := s.txConf.WrapTxBuilder(s.unsignedTx)
+	s.Require().NoError(err)
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	s.Require().True(ok)
+	extBuilder.SetExtensionOptions(extOpt)
+	extBuilder.SetNonCriticalExtensionOptions(extOpt)
+	txBytes, err = s.txConf.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	meta, err = s.c.ToRosetta().TxMetadata(txBytes)
+	s.Require().NoError(err)
+
+	txMeta = rosetta.TxMetadata{}
+	s.Require().NoError(txMeta.FromMetadata(meta))
+	s.Require().Equal([]string{"/testpb.Cat"}, txMeta.ExtensionOptions)
+	s.Require().Equal([]string{"/testpb.Cat"}, txMeta.NonCriticalExtensionOptions)
+
+	_, err = s.c.ToRosetta().TxMetadata([]byte("invalid"))
+	s.Require().ErrorIs(err, crgerrs.ErrCodec)
+}
+
 func (s *ConverterTestSuite) TestBalanceOps() {
 	s.Run("not a balance op", func() {
 		notBalanceOp := abci.Event{
@@ -336,6 +494,76 @@ func (s *ConverterTestSuite) TestBalanceOps() {
 	})
 }
 
+func (s *ConverterTestSuite) TestBlockEventsOps() {
+	withMode := func(event abci.Event, mode string) abci.Event {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: rosetta.EventAttributeMode, Value: mode})
+		return event
+	}
+
+	feeCollector := sdk.AccAddress("fee_collector")
+	delegator := sdk.AccAddress("delegator")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	events := []abci.Event{
+		withMode((abci.Event)(bank.NewCoinReceivedEvent(feeCollector, coins)), rosetta.EventModeBeginBlock),
+		withMode(abci.Event{Type: "not-a-balance-op"}, rosetta.EventModeBeginBlock),
+		withMode((abci.Event)(bank.NewCoinSpentEvent(feeCollector, coins)), rosetta.EventModeEndBlock),
+		withMode((abci.Event)(bank.NewCoinReceivedEvent(delegator, coins)), rosetta.EventModeEndBlock),
+		(abci.Event)(bank.NewCoinReceivedEvent(delegator, coins)),
+	}
+
+	beginBlockOps, endBlockOps := s.c.ToRosetta().BlockEventsOps(events)
+	s.Require().Len(beginBlockOps, 2)
+	s.Require().Equal(feeCollector.String(), beginBlockOps[0].Account.Address)
+	s.Require().Equal(delegator.String(), beginBlockOps[1].Account.Address)
+
+	s.Require().Len(endBlockOps, 2)
+	s.Require().Equal("-10", endBlockOps[0].Amount.Value)
+	s.Require().Equal(feeCollector.String(), endBlockOps[0].Account.Address)
+	s.Require().Equal("10", endBlockOps[1].Amount.Value)
+	s.Require().Equal(delegator.String(), endBlockOps[1].Account.Address)
+}
+
+func (s *ConverterTestSuite) TestExecOps() {
+	authz.RegisterInterfaces(s.ir)
+
+	grantee, granter := sdk.AccAddress("grantee"), sdk.AccAddress("granter")
+	send := bank.NewMsgSend(granter, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	nested := authz.NewMsgExec(granter, []sdk.Msg{send})
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{send, &nested})
+
+	builder := s.txConf.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(&exec))
+	txBytes, err := s.txConf.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	// the executed messages are not part of the operations the tx is constructed from
+	rosTx, err := s.c.ToRosetta().Tx(txBytes, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rosTx.Operations, 1)
+
+	rosTx, err = s.c.ToRosetta().Tx(txBytes, &abci.ExecTxResult{})
+	s.Require().NoError(err)
+	s.Require().Len(rosTx.Operations, 4)
+
+	ops := rosTx.Operations
+	s.Require().Equal(sdk.MsgTypeURL(&exec), ops[0].Type)
+	s.Require().Equal(grantee.String(), ops[0].Account.Address)
+	s.Require().Nil(ops[0].RelatedOperations)
+
+	for i, expect := range []struct {
+		msg     sdk.Msg
+		related int64
+	}{{send, 0}, {&nested, 0}, {send, 2}} {
+		op := ops[i+1]
+		s.Require().Equal(int64(i+1), op.OperationIdentifier.Index)
+		s.Require().Equal(sdk.MsgTypeURL(expect.msg), op.Type)
+		s.Require().Equal(granter.String(), op.Account.Address)
+		s.Require().Equal(rosetta.StatusTxSuccess, *op.Status)
+		s.Require().Equal([]*rosettatypes.OperationIdentifier{{Index: expect.related}}, op.RelatedOperations)
+	}
+}
+
 func TestConverterTestSuite(t *testing.T) {
 	suite.Run(t, new(ConverterTestSuite))
 }
@@ -41,7 +41,7 @@ func (on OnlineNetwork) AccountBalance(ctx context.Context, request *types.Accou
 		}
 	}
 
-	accountCoins, err := on.client.Balances(ctx, request.AccountIdentifier.Address, &height)
+	accountCoins, err := on.client.Balances(ctx, request.AccountIdentifier.Address, request.AccountIdentifier.SubAccount, &height)
 	if err != nil {
 		return nil, errors.ToRosetta(err)
 	}
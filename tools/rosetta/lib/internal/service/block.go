@@ -52,18 +52,41 @@ func (on OnlineNetwork) Block(ctx context.Context, request *types.BlockRequest)
 		return nil, errors.ToRosetta(err)
 	}
 
+	transactions, otherTransactions, metadata := chunkTransactions(blockResponse.Transactions, on.maxBlockTransactions)
 	return &types.BlockResponse{
 		Block: &types.Block{
 			BlockIdentifier:       blockResponse.Block,
 			ParentBlockIdentifier: blockResponse.ParentBlock,
 			Timestamp:             blockResponse.MillisecondTimestamp,
-			Transactions:          blockResponse.Transactions,
-			Metadata:              nil,
+			Transactions:          transactions,
+			Metadata:              metadata,
 		},
-		OtherTransactions: nil,
+		OtherTransactions: otherTransactions,
 	}, nil
 }
 
+// chunkTransactions keeps the first maxTxs transactions of a block inline, and only
+// lists the identifiers of the others, which the client fetches one by one with
+// /block/transaction as defined by the rosetta specification, so that the
+// responses of the blocks with thousands of transactions do not exceed the body
+// limits of the proxies. The metadata describing the chunking is nil if the
+// block is returned whole.
+func chunkTransactions(txs []*types.Transaction, maxTxs int) ([]*types.Transaction, []*types.TransactionIdentifier, map[string]interface{}) {
+	if maxTxs <= 0 || len(txs) <= maxTxs {
+		return txs, nil, nil
+	}
+
+	others := make([]*types.TransactionIdentifier, 0, len(txs)-maxTxs)
+	for _, tx := range txs[maxTxs:] {
+		others = append(others, tx.TransactionIdentifier)
+	}
+
+	return txs[:maxTxs], others, map[string]interface{}{
+		"total_transactions": len(txs),
+		"other_transactions": len(others),
+	}
+}
+
 // BlockTransaction gets the given transaction in the specified block, we do not need to check the block itself too
 // due to the fact that CometBFT achieves instant finality
 func (on OnlineNetwork) BlockTransaction(ctx context.Context, request *types.BlockTransactionRequest) (*types.BlockTransactionResponse, *types.Error) {
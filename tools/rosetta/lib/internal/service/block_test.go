@@ -0,0 +1,35 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkTransactions(t *testing.T) {
+	txs := make([]*types.Transaction, 5)
+	for i := range txs {
+		txs[i] = &types.Transaction{TransactionIdentifier: &types.TransactionIdentifier{Hash: fmt.Sprintf("tx%d", i)}}
+	}
+
+	// the block is returned whole if chunking is disabled or not needed
+	for _, maxTxs := range []int{0, 5, 10} {
+		inline, others, metadata := chunkTransactions(txs, maxTxs)
+		require.Equal(t, txs, inline)
+		require.Nil(t, others)
+		require.Nil(t, metadata)
+	}
+
+	// otherwise the transactions above the maximum are only identified
+	inline, others, metadata := chunkTransactions(txs, 2)
+	require.Equal(t, txs[:2], inline)
+	require.Equal(t, []*types.TransactionIdentifier{
+		{Hash: "tx2"}, {Hash: "tx3"}, {Hash: "tx4"},
+	}, others)
+	require.Equal(t, map[string]interface{}{
+		"total_transactions": 5,
+		"other_transactions": 3,
+	}, metadata)
+}
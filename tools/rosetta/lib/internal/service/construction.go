@@ -124,10 +124,14 @@ func (on OnlineNetwork) ConstructionParse(ctx context.Context, request *types.Co
 	if err != nil {
 		return nil, errors.ToRosetta(err)
 	}
+	meta, err := on.client.TxMetadata(txBytes)
+	if err != nil {
+		return nil, errors.ToRosetta(err)
+	}
 	return &types.ConstructionParseResponse{
 		Operations:               ops,
 		AccountIdentifierSigners: signers,
-		Metadata:                 nil,
+		Metadata:                 meta,
 	}, nil
 }
 
@@ -156,19 +160,59 @@ func (on OnlineNetwork) ConstructionPreprocess(ctx context.Context, request *typ
 // ConstructionSubmit Submit a pre-signed transaction to the node. This call does not block on the
 // transaction being included in a block. Rather, it returns immediately with an indication of
 // whether or not the transaction was included in the mempool.
+//
+// The resubmission of a transaction, identified by the idempotency key supplied in the metadata
+// of the request or otherwise by the hash of the transaction, returns the identifier of the
+// original submission rather than the error of the node, e.g. a sequence mismatch.
 func (on OnlineNetwork) ConstructionSubmit(ctx context.Context, request *types.ConstructionSubmitRequest) (*types.TransactionIdentifierResponse, *types.Error) {
 	txBytes, err := hex.DecodeString(request.SignedTransaction)
 	if err != nil {
 		return nil, errors.ToRosetta(err)
 	}
 
+	hash := txHash(txBytes)
+	key := idempotencyKey(ctx, hash)
+	id, ok, err := on.submissions.get(key, hash)
+	if err != nil {
+		return nil, errors.ToRosetta(errors.WrapError(errors.ErrBadArgument, err.Error()))
+	}
+	if ok {
+		return resubmitted(id), nil
+	}
+
 	res, meta, err := on.client.PostTx(txBytes)
 	if err != nil {
+		// the transaction may have been submitted before the server restarted, or through
+		// another server, in which case the node already knows it
+		if on.isKnownTx(ctx, hash) {
+			id := &types.TransactionIdentifier{Hash: hash}
+			on.submissions.add(key, hash, id)
+			return resubmitted(id), nil
+		}
 		return nil, errors.ToRosetta(err)
 	}
+	on.submissions.add(key, hash, res)
 
 	return &types.TransactionIdentifierResponse{
 		TransactionIdentifier: res,
 		Metadata:              meta,
 	}, nil
 }
+
+// isKnownTx returns whether the node knows the transaction with the given hash,
+// whether it is included in a block or still in the mempool
+func (on OnlineNetwork) isKnownTx(ctx context.Context, hash string) bool {
+	if _, err := on.client.GetTx(ctx, hash); err == nil {
+		return true
+	}
+	_, err := on.client.GetUnconfirmedTx(ctx, hash)
+	return err == nil
+}
+
+// resubmitted returns the response to the resubmission of the transaction with the given identifier
+func resubmitted(id *types.TransactionIdentifier) *types.TransactionIdentifierResponse {
+	return &types.TransactionIdentifierResponse{
+		TransactionIdentifier: id,
+		Metadata:              map[string]interface{}{"resubmitted": true},
+	}
+}
@@ -12,12 +12,13 @@ import (
 // NewOffline instantiates the instance of an offline network
 // whilst the offline network does not support the DataAPI,
 // it supports a subset of the construction API.
-func NewOffline(network *types.NetworkIdentifier, client crgtypes.Client) (crgtypes.API, error) {
+// Only the offline client is required, so the node is never reached.
+func NewOffline(network *types.NetworkIdentifier, client crgtypes.OfflineClient) (crgtypes.API, error) {
 	return OfflineNetwork{
 		OnlineNetwork{
-			client:         client,
+			client:         offlineClient{client},
 			network:        network,
-			networkOptions: networkOptionsFromClient(client, nil),
+			networkOptions: networkOptionsFromClient(client, nil, true),
 		},
 	}, nil
 }
@@ -50,6 +51,14 @@ func (o OfflineNetwork) MempoolTransaction(_ context.Context, _ *types.MempoolTr
 	return nil, crgerrs.ToRosetta(crgerrs.ErrOffline)
 }
 
+func (o OfflineNetwork) SearchTransactions(_ context.Context, _ *types.SearchTransactionsRequest) (*types.SearchTransactionsResponse, *types.Error) {
+	return nil, crgerrs.ToRosetta(crgerrs.ErrOffline)
+}
+
+func (o OfflineNetwork) AccountCoins(_ context.Context, _ *types.AccountCoinsRequest) (*types.AccountCoinsResponse, *types.Error) {
+	return nil, crgerrs.ToRosetta(crgerrs.ErrOffline)
+}
+
 func (o OfflineNetwork) NetworkStatus(_ context.Context, _ *types.NetworkRequest) (*types.NetworkStatusResponse, *types.Error) {
 	return nil, crgerrs.ToRosetta(crgerrs.ErrOffline)
 }
@@ -61,3 +70,80 @@ func (o OfflineNetwork) ConstructionSubmit(_ context.Context, _ *types.Construct
 func (o OfflineNetwork) ConstructionMetadata(_ context.Context, _ *types.ConstructionMetadataRequest) (*types.ConstructionMetadataResponse, *types.Error) {
 	return nil, crgerrs.ToRosetta(crgerrs.ErrOffline)
 }
+
+// offlineClient extends an offline client to a client whose
+// methods requiring access to the node fail with ErrOffline,
+// so that no online endpoint can ever reach the node.
+type offlineClient struct {
+	crgtypes.OfflineClient
+}
+
+var _ crgtypes.Client = offlineClient{}
+
+func (offlineClient) Bootstrap() error { return crgerrs.ErrOffline }
+
+func (offlineClient) Ready() error { return crgerrs.ErrOffline }
+
+func (offlineClient) GenesisBlock(_ context.Context) (crgtypes.BlockResponse, error) {
+	return crgtypes.BlockResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) InitialHeightBlock(_ context.Context) (crgtypes.BlockResponse, error) {
+	return crgtypes.BlockResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) OldestBlock(_ context.Context) (crgtypes.BlockResponse, error) {
+	return crgtypes.BlockResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) Balances(_ context.Context, _ string, _ *types.SubAccountIdentifier, _ *int64) ([]*types.Amount, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) BlockByHash(_ context.Context, _ string) (crgtypes.BlockResponse, error) {
+	return crgtypes.BlockResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) BlockByHeight(_ context.Context, _ *int64) (crgtypes.BlockResponse, error) {
+	return crgtypes.BlockResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) BlockTransactionsByHash(_ context.Context, _ string) (crgtypes.BlockTransactionsResponse, error) {
+	return crgtypes.BlockTransactionsResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) BlockTransactionsByHeight(_ context.Context, _ *int64) (crgtypes.BlockTransactionsResponse, error) {
+	return crgtypes.BlockTransactionsResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) GetTx(_ context.Context, _ string) (*types.Transaction, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) GetUnconfirmedTx(_ context.Context, _ string) (*types.Transaction, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) Mempool(_ context.Context) ([]*types.TransactionIdentifier, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) Peers(_ context.Context) ([]*types.Peer, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) Status(_ context.Context) (*types.SyncStatus, error) {
+	return nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) SearchTransactions(_ context.Context, _ crgtypes.TxSearchFilters, _, _ int64) (crgtypes.SearchTransactionsResponse, error) {
+	return crgtypes.SearchTransactionsResponse{}, crgerrs.ErrOffline
+}
+
+func (offlineClient) PostTx(_ []byte) (*types.TransactionIdentifier, map[string]interface{}, error) {
+	return nil, nil, crgerrs.ErrOffline
+}
+
+func (offlineClient) ConstructionMetadataFromOptions(_ context.Context, _ map[string]interface{}) (map[string]interface{}, error) {
+	return nil, crgerrs.ErrOffline
+}
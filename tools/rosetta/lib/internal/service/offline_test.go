@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+
+	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
+	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+)
+
+type mockOfflineClient struct {
+	crgtypes.OfflineClient
+}
+
+func (mockOfflineClient) SupportedOperations() []string {
+	return []string{"/cosmos.bank.v1beta1.MsgSend"}
+}
+
+func (mockOfflineClient) OperationStatuses() []*types.OperationStatus { return nil }
+
+func (mockOfflineClient) Version() string { return "simd/v1" }
+
+func (mockOfflineClient) SignatureTypes() []types.SignatureType {
+	return []types.SignatureType{types.Ecdsa}
+}
+
+func (mockOfflineClient) CurveTypes() []types.CurveType { return []types.CurveType{types.Secp256k1} }
+
+func (mockOfflineClient) SignModes() []string { return []string{"SIGN_MODE_LEGACY_AMINO_JSON"} }
+
+func TestOfflineNetwork(t *testing.T) {
+	ctx := context.Background()
+	network := &types.NetworkIdentifier{Blockchain: "app", Network: "network"}
+	api, err := NewOffline(network, mockOfflineClient{})
+	require.NoError(t, err)
+
+	options, rosErr := api.NetworkOptions(ctx, &types.NetworkRequest{NetworkIdentifier: network})
+	require.Nil(t, rosErr)
+	require.False(t, options.Allow.HistoricalBalanceLookup)
+	require.Equal(t, map[string]interface{}{
+		"offline":         true,
+		"signature_types": []types.SignatureType{types.Ecdsa},
+		"curve_types":     []types.CurveType{types.Secp256k1},
+		"sign_modes":      []string{"SIGN_MODE_LEGACY_AMINO_JSON"},
+	}, options.Version.Metadata)
+
+	// the endpoints requiring access to the node are refused
+	offlineErrs := []func() *types.Error{
+		func() *types.Error { _, err := api.NetworkStatus(ctx, nil); return err },
+		func() *types.Error { _, err := api.AccountBalance(ctx, nil); return err },
+		func() *types.Error { _, err := api.AccountCoins(ctx, nil); return err },
+		func() *types.Error { _, err := api.Block(ctx, nil); return err },
+		func() *types.Error { _, err := api.BlockTransaction(ctx, nil); return err },
+		func() *types.Error { _, err := api.Mempool(ctx, nil); return err },
+		func() *types.Error { _, err := api.MempoolTransaction(ctx, nil); return err },
+		func() *types.Error { _, err := api.SearchTransactions(ctx, nil); return err },
+		func() *types.Error { _, err := api.ConstructionMetadata(ctx, nil); return err },
+		func() *types.Error { _, err := api.ConstructionSubmit(ctx, nil); return err },
+	}
+	for _, call := range offlineErrs {
+		require.Equal(t, crgerrs.ToRosetta(crgerrs.ErrOffline), call())
+	}
+
+	// whilst the offline construction endpoints are served
+	_, rosErr = api.ConstructionHash(ctx, &types.ConstructionHashRequest{SignedTransaction: "0a"})
+	require.Nil(t, rosErr)
+}
@@ -18,7 +18,9 @@ const (
 
 // NewOnlineNetwork builds a single network adapter.
 // It will get the Genesis block on the beginning to avoid calling it everytime.
-func NewOnlineNetwork(network *types.NetworkIdentifier, client crgtypes.Client, logger log.Logger) (crgtypes.API, error) {
+// The /block responses are chunked above maxBlockTransactions transactions, 0
+// disables the chunking.
+func NewOnlineNetwork(network *types.NetworkIdentifier, client crgtypes.Client, logger log.Logger, maxBlockTransactions int) (crgtypes.API, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), genesisBlockFetchTimeout)
 	defer cancel()
 
@@ -31,7 +33,10 @@ func NewOnlineNetwork(network *types.NetworkIdentifier, client crgtypes.Client,
 	return OnlineNetwork{
 		client:         client,
 		network:        network,
-		networkOptions: networkOptionsFromClient(client, genesisBlock.Block),
+		networkOptions: networkOptionsFromClient(client, genesisBlock.Block, false),
+
+		maxBlockTransactions: maxBlockTransactions,
+		submissions:          newSubmissionCache(maxSubmissions),
 	}, nil
 }
 
@@ -41,10 +46,16 @@ type OnlineNetwork struct {
 
 	network        *types.NetworkIdentifier      // identifies the network, it's static
 	networkOptions *types.NetworkOptionsResponse // identifies the network options, it's static
+
+	maxBlockTransactions int // maximum number of transactions returned inline by /block, 0 means no maximum
+
+	submissions *submissionCache // recent submissions, to detect the resubmissions of transactions
 }
 
-// networkOptionsFromClient builds network options given the client
-func networkOptionsFromClient(client crgtypes.Client, genesisBlock *types.BlockIdentifier) *types.NetworkOptionsResponse {
+// networkOptionsFromClient builds network options given the client.
+// The signing capabilities of the client are advertised in the version metadata,
+// as the rosetta specification does not define a field for them.
+func networkOptionsFromClient(client crgtypes.NetworkInformationProvider, genesisBlock *types.BlockIdentifier, offline bool) *types.NetworkOptionsResponse {
 	var tsi *int64
 	if genesisBlock != nil {
 		tsi = &(genesisBlock.Index)
@@ -53,12 +64,18 @@ func networkOptionsFromClient(client crgtypes.Client, genesisBlock *types.BlockI
 		Version: &types.Version{
 			RosettaVersion: crgtypes.SpecVersion,
 			NodeVersion:    client.Version(),
+			Metadata: map[string]interface{}{
+				"offline":         offline,
+				"signature_types": client.SignatureTypes(),
+				"curve_types":     client.CurveTypes(),
+				"sign_modes":      client.SignModes(),
+			},
 		},
 		Allow: &types.Allow{
 			OperationStatuses:       client.OperationStatuses(),
 			OperationTypes:          client.SupportedOperations(),
 			Errors:                  crgerrs.SealAndListErrors(),
-			HistoricalBalanceLookup: true,
+			HistoricalBalanceLookup: !offline,
 			TimestampStartIndex:     tsi,
 		},
 	}
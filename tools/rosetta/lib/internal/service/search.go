@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"cosmossdk.io/tools/rosetta/lib/errors"
+	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const (
+	// defaultSearchLimit is the number of transactions returned by a search which does not specify a limit
+	defaultSearchLimit int64 = 100
+	// maxSearchLimit is the maximum number of transactions returned by a search
+	maxSearchLimit int64 = 1000
+)
+
+// SearchTransactions searches the transactions indexed by the node matching the given filters, with the most recent
+// transactions first. The status and success filters are always combined with the others, regardless of the operator.
+func (on OnlineNetwork) SearchTransactions(ctx context.Context, request *types.SearchTransactionsRequest) (*types.SearchTransactionsResponse, *types.Error) {
+	filters, err := on.txSearchFilters(request)
+	if err != nil {
+		return nil, errors.ToRosetta(err)
+	}
+
+	var offset int64
+	if request.Offset != nil {
+		offset = *request.Offset
+	}
+
+	limit := defaultSearchLimit
+	switch {
+	case request.Limit == nil || *request.Limit == 0:
+	case *request.Limit > maxSearchLimit:
+		limit = maxSearchLimit
+	default:
+		limit = *request.Limit
+	}
+
+	res, err := on.client.SearchTransactions(ctx, filters, offset, limit)
+	if err != nil {
+		return nil, errors.ToRosetta(err)
+	}
+
+	var nextOffset *int64
+	if next := offset + int64(len(res.Transactions)); next < res.TotalCount {
+		nextOffset = &next
+	}
+
+	return &types.SearchTransactionsResponse{
+		Transactions: res.Transactions,
+		TotalCount:   res.TotalCount,
+		NextOffset:   nextOffset,
+	}, nil
+}
+
+// txSearchFilters builds the transaction search filters from the request
+func (on OnlineNetwork) txSearchFilters(request *types.SearchTransactionsRequest) (crgtypes.TxSearchFilters, error) {
+	filters := crgtypes.TxSearchFilters{
+		Operator: types.AND,
+		Success:  request.Success,
+		MaxBlock: request.MaxBlock,
+	}
+
+	if request.Operator != nil {
+		filters.Operator = *request.Operator
+	}
+
+	if request.CoinIdentifier != nil || request.Currency != nil {
+		return filters, errors.WrapError(errors.ErrBadArgument, "searching transactions by coin identifier or currency is not supported")
+	}
+
+	if request.TransactionIdentifier != nil {
+		filters.Hash = request.TransactionIdentifier.Hash
+	}
+
+	if request.AccountIdentifier != nil {
+		filters.Address = request.AccountIdentifier.Address
+	}
+
+	if request.Address != nil {
+		if filters.Address != "" && filters.Address != *request.Address {
+			return filters, errors.WrapError(errors.ErrBadArgument, "mismatching address and account identifier")
+		}
+		filters.Address = *request.Address
+	}
+
+	if request.Type != nil {
+		filters.Type = *request.Type
+	}
+
+	if filters.Hash == "" && filters.Address == "" && filters.Type == "" {
+		return filters, errors.WrapError(errors.ErrBadArgument, "at least one of transaction identifier, account identifier, address or type must be specified")
+	}
+
+	if request.Status != nil {
+		success, found := false, false
+		for _, status := range on.client.OperationStatuses() {
+			if status.Status == *request.Status {
+				success, found = status.Successful, true
+				break
+			}
+		}
+
+		if !found {
+			return filters, errors.WrapError(errors.ErrBadArgument, "unknown status: "+*request.Status)
+		}
+
+		// the status and success filters must both match
+		if filters.Success != nil && *filters.Success != success {
+			return filters, errors.WrapError(errors.ErrBadArgument, "mismatching status and success")
+		}
+		filters.Success = &success
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const (
+	// IdempotencyKeyMetadata is the key of the optional idempotency key in the
+	// metadata of the /construction/submit requests
+	IdempotencyKeyMetadata = "idempotency_key"
+	// maxSubmissions is the number of submissions remembered to detect resubmissions
+	maxSubmissions = 10_000
+)
+
+type idempotencyKeyCtxKey struct{}
+
+// ContextWithIdempotencyKey returns a context carrying the idempotency key
+// supplied by the client with the submitted transaction.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key supplied by the client,
+// if any.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyCtxKey{}).(string)
+	return key, ok && key != ""
+}
+
+// idempotencyKey returns the idempotency key supplied by the client, or the hash
+// of the transaction, which identifies its payload deterministically
+func idempotencyKey(ctx context.Context, txHash string) string {
+	if key, ok := IdempotencyKeyFromContext(ctx); ok {
+		return key
+	}
+	return txHash
+}
+
+// txHash returns the hash of the transaction bytes as CometBFT computes it
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", sha256.Sum256(txBytes))
+}
+
+// submission is a transaction submitted by the rosetta server
+type submission struct {
+	txHash string
+	id     *types.TransactionIdentifier
+}
+
+// submissionCache remembers the most recent submissions by idempotency key, so
+// that the resubmission of a transaction returns its original identifier
+type submissionCache struct {
+	mu          sync.Mutex
+	size        int
+	submissions map[string]submission
+	keys        []string // in submission order, to evict the oldest ones
+}
+
+func newSubmissionCache(size int) *submissionCache {
+	return &submissionCache{size: size, submissions: make(map[string]submission)}
+}
+
+// get returns the identifier of the transaction submitted with the key, and an
+// error if the key was used to submit another transaction
+func (c *submissionCache) get(key, txHash string) (*types.TransactionIdentifier, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.submissions[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if s.txHash != txHash {
+		return nil, false, fmt.Errorf("idempotency key %s was used to submit transaction %s", key, s.txHash)
+	}
+	return s.id, true, nil
+}
+
+// add remembers the submission of the transaction with the key, evicting the
+// oldest submission if the cache is full
+func (c *submissionCache) add(key, txHash string, id *types.TransactionIdentifier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.submissions[key]; ok {
+		return
+	}
+	if len(c.keys) >= c.size {
+		delete(c.submissions, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.submissions[key] = submission{txHash: txHash, id: id}
+	c.keys = append(c.keys, key)
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+
+	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
+	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+)
+
+// submitClient is a client accepting each transaction once, as a node rejects
+// the resubmission of a transaction whose sequence was already used
+type submitClient struct {
+	crgtypes.Client
+
+	posted map[string]bool
+	known  map[string]bool
+}
+
+func (c *submitClient) PostTx(txBytes []byte) (*types.TransactionIdentifier, map[string]interface{}, error) {
+	hash := txHash(txBytes)
+	if c.posted[hash] || c.known[hash] {
+		return nil, nil, crgerrs.WrapError(crgerrs.ErrUnknown, "transaction broadcast failure: (32) account sequence mismatch")
+	}
+	c.posted[hash] = true
+	return &types.TransactionIdentifier{Hash: hash}, map[string]interface{}{"log": ""}, nil
+}
+
+func (c *submitClient) GetTx(_ context.Context, hash string) (*types.Transaction, error) {
+	if !c.known[hash] {
+		return nil, crgerrs.ErrNotFound
+	}
+	return &types.Transaction{TransactionIdentifier: &types.TransactionIdentifier{Hash: hash}}, nil
+}
+
+func (c *submitClient) GetUnconfirmedTx(context.Context, string) (*types.Transaction, error) {
+	return nil, crgerrs.ErrNotFound
+}
+
+func TestConstructionSubmit_Resubmission(t *testing.T) {
+	client := &submitClient{posted: make(map[string]bool), known: make(map[string]bool)}
+	on := OnlineNetwork{client: client, submissions: newSubmissionCache(2)}
+	submit := func(ctx context.Context, tx string) (*types.TransactionIdentifierResponse, *types.Error) {
+		return on.ConstructionSubmit(ctx, &types.ConstructionSubmitRequest{SignedTransaction: hex.EncodeToString([]byte(tx))})
+	}
+	ctx := context.Background()
+
+	// the resubmission of a transaction returns its original identifier
+	res, err := submit(ctx, "tx1")
+	require.Nil(t, err)
+	require.Equal(t, txHash([]byte("tx1")), res.TransactionIdentifier.Hash)
+	require.Nil(t, res.Metadata["resubmitted"])
+	res, err = submit(ctx, "tx1")
+	require.Nil(t, err)
+	require.Equal(t, txHash([]byte("tx1")), res.TransactionIdentifier.Hash)
+	require.Equal(t, true, res.Metadata["resubmitted"])
+
+	// as well as with an idempotency key, which cannot be used for another transaction
+	keyCtx := ContextWithIdempotencyKey(ctx, "retry-1")
+	_, err = submit(keyCtx, "tx2")
+	require.Nil(t, err)
+	res, err = submit(keyCtx, "tx2")
+	require.Nil(t, err)
+	require.Equal(t, txHash([]byte("tx2")), res.TransactionIdentifier.Hash)
+	_, err = submit(keyCtx, "tx3")
+	require.Equal(t, crgerrs.ToRosetta(crgerrs.ErrBadArgument).Code, err.Code)
+
+	// the transactions known by the node are detected once forgotten by the server
+	client.known[txHash([]byte("tx4"))] = true
+	res, err = submit(ctx, "tx4")
+	require.Nil(t, err)
+	require.Equal(t, txHash([]byte("tx4")), res.TransactionIdentifier.Hash)
+	require.Equal(t, true, res.Metadata["resubmitted"])
+
+	// tx1 was evicted, and is unknown to the node
+	_, err = submit(ctx, "tx1")
+	require.NotNil(t, err)
+}
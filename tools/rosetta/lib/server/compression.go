@@ -0,0 +1,63 @@
+package server
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// compress wraps the given handler, gzip compressing the responses to the
+// requests accepting it, as the responses of the blocks with thousands of
+// transactions can exceed the body limits of the proxies.
+func compress(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+
+		h.ServeHTTP(gzipResponseWriter{ResponseWriter: w, writer: gz}, r)
+	})
+}
+
+// acceptsGzip returns whether the Accept-Encoding header of the request lists
+// gzip, and does not refuse it with a zero quality value
+func acceptsGzip(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept-Encoding") {
+		for _, encoding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(encoding, ";")
+			if strings.TrimSpace(name) != "gzip" {
+				continue
+			}
+			value, found := strings.CutPrefix(strings.TrimSpace(params), "q=")
+			if !found {
+				return true
+			}
+			quality, err := strconv.ParseFloat(value, 64)
+			return err == nil && quality > 0
+		}
+	}
+	return false
+}
+
+// gzipResponseWriter writes the body of the response through a gzip writer
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	writer *gzip.Writer
+}
+
+func (w gzipResponseWriter) WriteHeader(status int) {
+	// the length of the compressed body is not known in advance
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w gzipResponseWriter) Write(b []byte) (int, error) {
+	return w.writer.Write(b)
+}
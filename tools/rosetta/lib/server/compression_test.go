@@ -0,0 +1,46 @@
+package server
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	const body = `{"block":{"transactions":[]}}`
+	h := compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	// the responses to the requests accepting gzip are compressed
+	req := httptest.NewRequest(http.MethodPost, "/block", nil)
+	req.Header.Set("Accept-Encoding", "deflate, gzip;q=0.8")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, body, string(decompressed))
+
+	// the others are not
+	for _, acceptEncoding := range []string{"", "deflate", "gzip;q=0"} {
+		req := httptest.NewRequest(http.MethodPost, "/block", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		require.Empty(t, rec.Header().Get("Content-Encoding"), acceptEncoding)
+		require.Equal(t, body, rec.Body.String(), acceptEncoding)
+	}
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"cosmossdk.io/tools/rosetta/lib/internal/service"
+)
+
+// constructionSubmitPath is the path of the /construction/submit endpoint
+const constructionSubmitPath = "/construction/submit"
+
+// idempotencyKeys wraps the given handler, passing the idempotency key of the
+// metadata of the /construction/submit requests, if any, to the construction
+// API through the context of the request, as the rosetta specification does not
+// define metadata for the request.
+func idempotencyKeys(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != constructionSubmitPath || r.Body == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var request struct {
+			Metadata map[string]interface{} `json:"metadata"`
+		}
+		// a malformed request is rejected by the construction API
+		if err := json.Unmarshal(body, &request); err == nil {
+			if key, ok := request.Metadata[service.IdempotencyKeyMetadata].(string); ok && key != "" {
+				r = r.WithContext(service.ContextWithIdempotencyKey(r.Context(), key))
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tools/rosetta/lib/internal/service"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	var (
+		key  string
+		body string
+	)
+	h := idempotencyKeys(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, _ = service.IdempotencyKeyFromContext(r.Context())
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(bz)
+	}))
+
+	testCases := []struct {
+		name   string
+		path   string
+		body   string
+		expKey string
+	}{
+		{"key", constructionSubmitPath, `{"signed_transaction":"aa","metadata":{"idempotency_key":"retry-1"}}`, "retry-1"},
+		{"no metadata", constructionSubmitPath, `{"signed_transaction":"aa"}`, ""},
+		{"key of another type", constructionSubmitPath, `{"signed_transaction":"aa","metadata":{"idempotency_key":1}}`, ""},
+		{"malformed", constructionSubmitPath, `{"signed_transaction":`, ""},
+		{"other endpoint", "/construction/hash", `{"signed_transaction":"aa","metadata":{"idempotency_key":"retry-1"}}`, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, body = "", ""
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body)))
+			require.Equal(t, tc.expKey, key)
+			// the body is still read by the construction API
+			require.Equal(t, tc.body, body)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/cosmos/rosetta-sdk-go/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"cosmossdk.io/log"
+	crgtypes "cosmossdk.io/tools/rosetta/lib/types"
+)
+
+const (
+	// metricsNamespace is the namespace of the rosetta metrics
+	metricsNamespace = "rosetta"
+	// unknownEndpoint is the endpoint label of the requests which match no rosetta route
+	unknownEndpoint = "unknown"
+	// DefaultNodeHeightPollInterval is the default interval at which the node height is polled
+	DefaultNodeHeightPollInterval = 5 * time.Second
+)
+
+// metrics groups the prometheus collectors instrumenting the rosetta server
+type metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+
+	nodeHeight   prometheus.Gauge
+	servedHeight prometheus.Gauge
+	nodeLag      prometheus.Gauge
+
+	mu                 sync.Mutex
+	lastNodeHeight     int64
+	lastServedHeight   int64
+	knownEndpointPaths map[string]struct{}
+}
+
+// newMetrics builds the rosetta metrics
+func newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of requests served by rosetta, by endpoint and http status code.",
+		}, []string{"endpoint", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests served by rosetta, by endpoint.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		nodeHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "node_height",
+			Help:      "Latest block height of the node rosetta is connected to.",
+		}),
+		servedHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_served_height",
+			Help:      "Highest block height served by the rosetta block endpoint.",
+		}),
+		nodeLag: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "node_lag_blocks",
+			Help:      "Number of blocks between the node height and the last height served by rosetta.",
+		}),
+		knownEndpointPaths: make(map[string]struct{}),
+	}
+
+	m.registry.MustRegister(m.requests, m.latency, m.nodeHeight, m.servedHeight, m.nodeLag)
+	return m
+}
+
+// addEndpoints makes the requests to the paths of the given routers labeled
+// with their path, the other requests are labeled with unknownEndpoint
+func (m *metrics) addEndpoints(routers ...server.Router) {
+	for path := range endpointPaths(routers...) {
+		m.knownEndpointPaths[path] = struct{}{}
+	}
+}
+
+// handler returns the http handler exposing the metrics
+func (m *metrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// instrument wraps the given handler, counting the requests and observing their latency
+func (m *metrics) instrument(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		endpoint := endpointOf(m.knownEndpointPaths, r)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		h.ServeHTTP(rec, r)
+
+		m.latency.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+		m.requests.WithLabelValues(endpoint, strconv.Itoa(rec.status)).Inc()
+	})
+}
+
+// setNodeHeight records the latest block height of the node
+func (m *metrics) setNodeHeight(height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastNodeHeight = height
+	m.nodeHeight.Set(float64(height))
+	m.updateLag()
+}
+
+// observeServedHeight records a block height served by rosetta,
+// only the highest served height is kept
+func (m *metrics) observeServedHeight(height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if height <= m.lastServedHeight {
+		return
+	}
+	m.lastServedHeight = height
+	m.servedHeight.Set(float64(height))
+	m.updateLag()
+}
+
+// updateLag updates the node lag once both the node and the served heights are known
+func (m *metrics) updateLag() {
+	if m.lastNodeHeight == 0 || m.lastServedHeight == 0 {
+		return
+	}
+	m.nodeLag.Set(float64(m.lastNodeHeight - m.lastServedHeight))
+}
+
+// pollNodeHeight records the node height every interval until the context is done
+func (m *metrics) pollNodeHeight(ctx context.Context, client crgtypes.Client, interval time.Duration, logger log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		block, err := client.BlockByHeight(ctx, nil)
+		switch {
+		case err != nil:
+			logger.Error("failed to get the node height", "err", err)
+		case block.Block != nil:
+			m.setNodeHeight(block.Block.Index)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// statusRecorder records the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// instrumentedAPI records the block heights served by the wrapped API
+type instrumentedAPI struct {
+	crgtypes.API
+	metrics *metrics
+}
+
+func (a instrumentedAPI) Block(ctx context.Context, req *types.BlockRequest) (*types.BlockResponse, *types.Error) {
+	res, err := a.API.Block(ctx, req)
+	if err == nil && res != nil && res.Block != nil && res.Block.BlockIdentifier != nil {
+		a.metrics.observeServedHeight(res.Block.BlockIdentifier.Index)
+	}
+	return res, err
+}
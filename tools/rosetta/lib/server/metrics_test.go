@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/rosetta-sdk-go/server"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRouter struct{}
+
+func (testRouter) Routes() server.Routes {
+	return server.Routes{{Name: "Block", Method: http.MethodPost, Pattern: "/block"}}
+}
+
+func TestMetricsInstrument(t *testing.T) {
+	m := newMetrics()
+	m.addEndpoints(testRouter{})
+
+	h := m.instrument(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/block" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	for _, path := range []string{"/block", "/block", "/not-a-route"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.requests.WithLabelValues("/block", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues(unknownEndpoint, "404")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.latency))
+}
+
+func TestMetricsNodeLag(t *testing.T) {
+	m := newMetrics()
+
+	// the lag is unknown until rosetta served a block
+	m.setNodeHeight(10)
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.nodeLag))
+
+	m.observeServedHeight(7)
+	assert.Equal(t, float64(3), testutil.ToFloat64(m.nodeLag))
+
+	// serving an older block does not lower the last served height
+	m.observeServedHeight(5)
+	assert.Equal(t, float64(7), testutil.ToFloat64(m.servedHeight))
+	assert.Equal(t, float64(3), testutil.ToFloat64(m.nodeLag))
+
+	m.setNodeHeight(12)
+	assert.Equal(t, float64(5), testutil.ToFloat64(m.nodeLag))
+}
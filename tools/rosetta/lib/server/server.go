@@ -1,7 +1,10 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"time"
@@ -9,6 +12,7 @@ import (
 	"github.com/coinbase/rosetta-sdk-go/types"
 	assert "github.com/cosmos/rosetta-sdk-go/asserter"
 	"github.com/cosmos/rosetta-sdk-go/server"
+	"go.opentelemetry.io/otel/trace"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/tools/rosetta/lib/internal/service"
@@ -18,6 +22,9 @@ import (
 const (
 	DefaultRetries   = 5
 	DefaultRetryWait = 5 * time.Second
+	// DefaultShutdownTimeout is the default time given to the server to drain
+	// its in-flight requests when it stops
+	DefaultShutdownTimeout = 30 * time.Second
 )
 
 // Settings define the rosetta server settings
@@ -26,6 +33,8 @@ type Settings struct {
 	Network *types.NetworkIdentifier
 	// Client is the online API handler
 	Client crgtypes.Client
+	// OfflineClient is the offline API handler, used in place of Client in offline mode
+	OfflineClient crgtypes.OfflineClient
 	// Listen is the address the handler will listen at
 	Listen string
 	// Offline defines if the rosetta service should be exposed in offline mode
@@ -35,22 +44,139 @@ type Settings struct {
 	Retries int
 	// RetryWait is the time that will be waited between retries
 	RetryWait time.Duration
+	// MetricsListen is the address the prometheus metrics will be exposed at,
+	// metrics are disabled if empty
+	MetricsListen string
+	// NodeHeightPollInterval is the interval at which the node height is polled
+	// to compute the node lag metric, valid only for online API
+	NodeHeightPollInterval time.Duration
+	// ShutdownTimeout is the time given to the server to drain its in-flight
+	// requests when it stops, defaults to DefaultShutdownTimeout
+	ShutdownTimeout time.Duration
+	// TracerProvider provides the tracer of the requests, tracing is disabled
+	// if nil. The provider is shut down when the server stops, if it can be
+	TracerProvider trace.TracerProvider
+	// MaxBlockTransactions is the maximum number of transactions returned
+	// inline by /block, the others are only listed as other transactions to be
+	// fetched with /block/transaction. Chunking is disabled if 0, valid only
+	// for online API
+	MaxBlockTransactions int
+	// Compression enables the gzip compression of the responses to the
+	// requests accepting it
+	Compression bool
 }
 
 type Server struct {
-	h      http.Handler
-	addr   string
-	logger log.Logger
+	h               http.Handler
+	addr            string
+	logger          log.Logger
+	shutdownTimeout time.Duration
+
+	metrics      *metrics
+	metricsAddr  string
+	client       crgtypes.Client
+	pollInterval time.Duration
+
+	tracerProvider trace.TracerProvider
 }
 
+// Start starts the rosetta server and serves requests until it fails.
 func (h Server) Start() error {
+	return h.Serve(context.Background())
+}
+
+// Serve starts the rosetta server and serves requests until ctx is done. The
+// server then stops accepting requests and drains the in-flight ones within
+// the shutdown timeout, after which the metrics server is stopped.
+func (h Server) Serve(ctx context.Context) error {
+	listener, err := net.Listen("tcp", h.addr)
+	if err != nil {
+		return err
+	}
 	h.logger.Info(fmt.Sprintf("Rosetta server listening on add %s", h.addr))
-	return http.ListenAndServe(h.addr, h.h) //nolint:gosec // users are recommended to operate a proxy in front of this server
+	return h.serve(ctx, listener)
+}
+
+func (h Server) serve(ctx context.Context, listener net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var metricsSrv *http.Server
+	if h.metrics != nil {
+		metricsSrv = h.startMetrics(ctx)
+	}
+
+	srv := &http.Server{Handler: h.h} //nolint:gosec // users are recommended to operate a proxy in front of this server
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	h.logger.Info("stopping rosetta server, draining the in-flight requests", "timeout", h.shutdownTimeout)
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), h.shutdownTimeout)
+	defer cancelShutdown()
+
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		_ = srv.Close()
+		err = fmt.Errorf("failed to drain the rosetta requests: %w", err)
+	}
+	if h.tracerProvider != nil {
+		if tracingErr := shutdownTracerProvider(shutdownCtx, h.tracerProvider); tracingErr != nil {
+			h.logger.Error("failed to flush the rosetta traces", "err", tracingErr)
+		}
+	}
+	if metricsSrv != nil {
+		// the metrics server is stopped last so that the drained requests are
+		// still scraped
+		if metricsErr := metricsSrv.Shutdown(shutdownCtx); metricsErr != nil {
+			_ = metricsSrv.Close()
+		}
+	}
+	return err
+}
+
+// startMetrics exposes the metrics and, in online mode, starts polling the node
+// height until ctx is done
+func (h Server) startMetrics(ctx context.Context) *http.Server {
+	metricsSrv := &http.Server{Addr: h.metricsAddr, Handler: h.metrics.handler()} //nolint:gosec // the metrics server is meant to be scraped from a private network
+	go func() {
+		h.logger.Info(fmt.Sprintf("Rosetta metrics listening on add %s", h.metricsAddr))
+		err := metricsSrv.ListenAndServe()
+		if !errors.Is(err, http.ErrServerClosed) {
+			h.logger.Error("rosetta metrics server stopped", "err", err)
+		}
+	}()
+
+	if h.client != nil {
+		go h.metrics.pollNodeHeight(ctx, h.client, h.pollInterval, h.logger)
+	}
+	return metricsSrv
 }
 
 func NewServer(settings Settings) (Server, error) {
+	var info crgtypes.NetworkInformationProvider
+	switch settings.Offline {
+	case true:
+		if settings.OfflineClient == nil {
+			return Server{}, fmt.Errorf("offline client is nil")
+		}
+		info = settings.OfflineClient
+	case false:
+		if settings.Client == nil {
+			return Server{}, fmt.Errorf("client is nil")
+		}
+		info = settings.Client
+	}
+
 	asserter, err := assert.NewServer(
-		settings.Client.SupportedOperations(),
+		info.SupportedOperations(),
 		true,
 		[]*types.NetworkIdentifier{settings.Network},
 		nil,
@@ -73,32 +199,84 @@ func NewServer(settings Settings) (Server, error) {
 	if err != nil {
 		return Server{}, err
 	}
-	h := server.NewRouter(
+
+	var m *metrics
+	if settings.MetricsListen != "" {
+		m = newMetrics()
+		adapter = instrumentedAPI{API: adapter, metrics: m}
+	}
+
+	routers := []server.Router{
 		server.NewAccountAPIController(adapter, asserter),
 		server.NewBlockAPIController(adapter, asserter),
 		server.NewNetworkAPIController(adapter, asserter),
 		server.NewMempoolAPIController(adapter, asserter),
+		server.NewSearchAPIController(adapter, asserter),
 		server.NewConstructionAPIController(adapter, asserter),
-	)
+	}
+	var h http.Handler = server.NewRouter(routers...)
+	if !settings.Offline {
+		h = idempotencyKeys(h)
+	}
+	if settings.Compression {
+		h = compress(h)
+	}
+
+	srv := Server{
+		h:               h,
+		addr:            settings.Listen,
+		logger:          logger,
+		shutdownTimeout: settings.ShutdownTimeout,
+	}
+	if srv.shutdownTimeout <= 0 {
+		srv.shutdownTimeout = DefaultShutdownTimeout
+	}
+	if m != nil {
+		m.addEndpoints(routers...)
+		srv.h = m.instrument(h)
+		srv.metrics = m
+		srv.metricsAddr = settings.MetricsListen
+		srv.pollInterval = settings.NodeHeightPollInterval
+		if srv.pollInterval <= 0 {
+			srv.pollInterval = DefaultNodeHeightPollInterval
+		}
+		if !settings.Offline {
+			srv.client = settings.Client
+		}
+	}
+	if settings.TracerProvider != nil {
+		srv.h = newTracing(settings.TracerProvider, endpointPaths(routers...)).instrument(srv.h)
+		srv.tracerProvider = settings.TracerProvider
+	}
 
-	return Server{
-		h:      h,
-		addr:   settings.Listen,
-		logger: logger,
-	}, nil
+	return srv, nil
 }
 
-func newOfflineAdapter(settings Settings) (crgtypes.API, error) {
-	if settings.Client == nil {
-		return nil, fmt.Errorf("client is nil")
+// endpointPaths returns the paths of the routes of the given routers
+func endpointPaths(routers ...server.Router) map[string]struct{} {
+	paths := make(map[string]struct{})
+	for _, router := range routers {
+		for _, route := range router.Routes() {
+			paths[route.Pattern] = struct{}{}
+		}
 	}
-	return service.NewOffline(settings.Network, settings.Client)
+	return paths
 }
 
-func newOnlineAdapter(settings Settings, logger log.Logger) (crgtypes.API, error) {
-	if settings.Client == nil {
-		return nil, fmt.Errorf("client is nil")
+// endpointOf returns the path of the request if it is one of the given
+// endpoint paths, unknownEndpoint otherwise
+func endpointOf(paths map[string]struct{}, r *http.Request) string {
+	if _, ok := paths[r.URL.Path]; !ok {
+		return unknownEndpoint
 	}
+	return r.URL.Path
+}
+
+func newOfflineAdapter(settings Settings) (crgtypes.API, error) {
+	return service.NewOffline(settings.Network, settings.OfflineClient)
+}
+
+func newOnlineAdapter(settings Settings, logger log.Logger) (crgtypes.API, error) {
 	if settings.Retries <= 0 {
 		settings.Retries = DefaultRetries
 	}
@@ -119,7 +297,7 @@ func newOnlineAdapter(settings Settings, logger log.Logger) (crgtypes.API, error
 			time.Sleep(settings.RetryWait)
 			continue
 		}
-		return service.NewOnlineNetwork(settings.Network, settings.Client, logger)
+		return service.NewOnlineNetwork(settings.Network, settings.Client, logger, settings.MaxBlockTransactions)
 	}
 	return nil, fmt.Errorf("maximum number of retries exceeded, last error: %w", err)
 }
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func TestServeDrainsInFlightRequests(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	srv := Server{
+		h: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		}),
+		logger:          log.NewNopLogger(),
+		shutdownTimeout: time.Second,
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error)
+	go func() {
+		served <- srv.serve(ctx, listener)
+	}()
+
+	responded := make(chan int)
+	go func() {
+		res, err := http.Post("http://"+listener.Addr().String()+"/block", "application/json", nil)
+		if err != nil {
+			responded <- 0
+			return
+		}
+		res.Body.Close()
+		responded <- res.StatusCode
+	}()
+	<-started
+
+	// the server waits for the in-flight request once stopped
+	cancel()
+	select {
+	case <-served:
+		t.Fatal("server stopped with a request in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.Equal(t, http.StatusOK, <-responded)
+	require.NoError(t, <-served)
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer of the rosetta spans
+const TracerName = "cosmossdk.io/tools/rosetta"
+
+// tracing traces the requests served by rosetta
+type tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	endpoints  map[string]struct{}
+}
+
+// newTracing builds the tracing of the requests from the given provider
+func newTracing(provider trace.TracerProvider, endpoints map[string]struct{}) tracing {
+	return tracing{
+		tracer:     provider.Tracer(TracerName),
+		propagator: propagation.TraceContext{},
+		endpoints:  endpoints,
+	}
+}
+
+// instrument wraps the given handler, starting a server span for every request.
+// The span continues the trace propagated by the caller in the W3C trace context
+// headers, if any, and its trace context is set in the response headers so that
+// the caller can find the trace of its request.
+func (t tracing) instrument(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		endpoint := endpointOf(t.endpoints, r)
+
+		ctx := t.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := t.tracer.Start(ctx, endpoint,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", r.Method),
+				attribute.String("http.route", endpoint),
+			),
+		)
+		defer span.End()
+
+		t.propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rec.status))
+		}
+	})
+}
+
+// shutdownTracerProvider flushes and stops the given provider, if it can be
+func shutdownTracerProvider(ctx context.Context, provider trace.TracerProvider) error {
+	p, ok := provider.(interface {
+		Shutdown(ctx context.Context) error
+	})
+	if !ok {
+		return nil
+	}
+	return p.Shutdown(ctx)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingInstrument(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var handlerSpan trace.SpanContext
+	h := newTracing(provider, endpointPaths(testRouter{})).instrument(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		if r.URL.Path != "/block" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	// the trace propagated by the caller is continued
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	req := httptest.NewRequest(http.MethodPost, "/block", nil)
+	req.Header.Set("traceparent", traceparent)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "/block", spans[0].Name())
+	assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+	assert.Equal(t, spans[0].SpanContext(), handlerSpan)
+	assert.Contains(t, rec.Header().Get("traceparent"), spans[0].SpanContext().SpanID().String())
+
+	// a new trace is started otherwise, and the failed requests are flagged
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/not-a-route", nil))
+
+	spans = recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, unknownEndpoint, spans[1].Name())
+	assert.False(t, spans[1].Parent().IsValid())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -19,6 +19,12 @@ type NetworkInformationProvider interface {
 	OperationStatuses() []*types.OperationStatus
 	// Version returns the version of the node
 	Version() string
+	// SignatureTypes returns the signature types the signing payloads can be signed with
+	SignatureTypes() []types.SignatureType
+	// CurveTypes returns the curve types of the public keys accepted by the construction API
+	CurveTypes() []types.CurveType
+	// SignModes returns the sign modes of the signing payloads returned by the construction API
+	SignModes() []string
 }
 
 // Client defines the API the client implementation should provide.
@@ -43,7 +49,9 @@ type Client interface {
 	// Balances fetches the balance of the given address
 	// if height is not nil, then the balance will be displayed
 	// at the provided height, otherwise last block balance will be returned
-	Balances(ctx context.Context, addr string, height *int64) ([]*types.Amount, error)
+	// if subAccount is not nil, only the part of the balance held by the
+	// sub-account is returned
+	Balances(ctx context.Context, addr string, subAccount *types.SubAccountIdentifier, height *int64) ([]*types.Amount, error)
 	// BlockByHash gets a block and its transaction at the provided height
 	BlockByHash(ctx context.Context, hash string) (BlockResponse, error)
 	// BlockByHeight gets a block given its height, if height is nil then last block is returned
@@ -65,6 +73,10 @@ type Client interface {
 	Peers(ctx context.Context) ([]*types.Peer, error)
 	// Status returns the node status, such as sync data, version etc
 	Status(ctx context.Context) (*types.SyncStatus, error)
+	// SearchTransactions searches the transactions indexed by the node which match the given filters,
+	// it returns the limit transactions starting at offset, sorted by most recent first, and the total
+	// number of matching transactions
+	SearchTransactions(ctx context.Context, filters TxSearchFilters, offset, limit int64) (SearchTransactionsResponse, error)
 
 	// Construction API
 
@@ -84,6 +96,9 @@ type OfflineClient interface {
 	// TxOperationsAndSignersAccountIdentifiers returns the operations related to a transaction and the account
 	// identifiers if the transaction is signed
 	TxOperationsAndSignersAccountIdentifiers(signed bool, hexBytes []byte) (ops []*types.Operation, signers []*types.AccountIdentifier, err error)
+	// TxMetadata returns the metadata of a transaction which is not represented by its operations,
+	// such as its memo and timeout height
+	TxMetadata(txBytes []byte) (meta map[string]interface{}, err error)
 	// ConstructionPayload returns the construction payload given the request
 	ConstructionPayload(ctx context.Context, req *types.ConstructionPayloadsRequest) (resp *types.ConstructionPayloadsResponse, err error)
 	// PreprocessOperationsToOptions returns the options given the preprocess operations
@@ -92,6 +107,27 @@ type OfflineClient interface {
 	AccountIdentifierFromPublicKey(pubKey *types.PublicKey) (*types.AccountIdentifier, error)
 }
 
+// TxSearchFilters defines the filters used to search transactions, empty filters are ignored
+type TxSearchFilters struct {
+	// Operator defines how Hash, Address and Type are combined, defaults to types.AND
+	Operator types.Operator
+	// Hash is the hash of the transaction
+	Hash string
+	// Address is an address which is the sender of a message or the spender or receiver of coins in the transaction
+	Address string
+	// Type is an operation type of the transaction
+	Type string
+	// Success restricts the search to the successful or failed transactions
+	Success *bool
+	// MaxBlock restricts the search to the transactions included up to the given height
+	MaxBlock *int64
+}
+
+type SearchTransactionsResponse struct {
+	Transactions []*types.BlockTransaction
+	TotalCount   int64
+}
+
 type BlockTransactionsResponse struct {
 	BlockResponse
 	Transactions []*types.Transaction
@@ -117,6 +153,7 @@ type DataAPI interface {
 	server.AccountAPIServicer
 	server.BlockAPIServicer
 	server.MempoolAPIServicer
+	server.SearchAPIServicer
 }
 
 var _ server.ConstructionAPIServicer = ConstructionAPI(nil)
@@ -0,0 +1,169 @@
+package rosetta
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	crg "cosmossdk.io/tools/rosetta/lib/server"
+)
+
+// tracingServiceName is the service name of the traces exported over OTLP
+const tracingServiceName = "rosetta"
+
+// newOTLPTracerProvider returns a tracer provider exporting the spans in batches
+// to the OTLP/HTTP collector at the given host:port endpoint
+func newOTLPTracerProvider(endpoint string) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracehttp.New(
+		context.Background(),
+		otlptracehttp.WithEndpoint(endpoint),
+		otlptracehttp.WithInsecure(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", tracingServiceName))),
+	), nil
+}
+
+// traceGRPCCalls returns an interceptor starting a client span for every gRPC
+// call to the node, and propagating its trace context in the call metadata
+func traceGRPCCalls(tracer trace.Tracer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attribute.String("rpc.system", "grpc")),
+		)
+		defer span.End()
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		propagation.TraceContext{}.Inject(ctx, metadataCarrier(md))
+
+		err := invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// tracingTransport starts a client span for every CometBFT RPC request, named
+// after its JSON-RPC method, and propagates its trace context in the request
+// headers. The span ends once the response body is closed.
+type tracingTransport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+func (t tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(req.Context(), "cometbft "+jsonRPCMethod(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("rpc.system", "jsonrpc")),
+	)
+
+	req = req.Clone(ctx)
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+	res.Body = spanEndingBody{ReadCloser: res.Body, span: span}
+	return res, nil
+}
+
+// jsonRPCMethod returns the method of the JSON-RPC request, or "batch" for a
+// batch of requests
+func jsonRPCMethod(req *http.Request) string {
+	if req.GetBody == nil {
+		return "unknown"
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "unknown"
+	}
+	defer body.Close()
+
+	var call struct {
+		Method string `json:"method"`
+	}
+	err = json.NewDecoder(body).Decode(&call)
+	switch {
+	case err != nil:
+		return "batch"
+	case call.Method == "":
+		return "unknown"
+	default:
+		return call.Method
+	}
+}
+
+// spanEndingBody ends the span of a request when its response body is closed
+type spanEndingBody struct {
+	io.ReadCloser
+	span trace.Span
+}
+
+func (b spanEndingBody) Close() error {
+	defer b.span.End()
+	return b.ReadCloser.Close()
+}
+
+// tracer returns the tracer of the node calls, or nil if tracing is disabled
+func (c *Client) tracer() trace.Tracer {
+	if c.config.TracerProvider == nil {
+		return nil
+	}
+	return c.config.TracerProvider.Tracer(crg.TracerName)
+}
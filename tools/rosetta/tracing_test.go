@@ -0,0 +1,78 @@
+package rosetta
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceGRPCCalls(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	parentCtx, parent := tracer.Start(context.Background(), "request")
+	parentCtx = metadata.AppendToOutgoingContext(parentCtx, "x-cosmos-block-height", "10")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	err := traceGRPCCalls(tracer)(parentCtx, "/cosmos.bank.v1beta1.Query/AllBalances", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "/cosmos.bank.v1beta1.Query/AllBalances", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	// the trace context is propagated along the existing metadata
+	assert.Equal(t, []string{"10"}, md.Get("x-cosmos-block-height"))
+	require.Len(t, md.Get("traceparent"), 1)
+	assert.Contains(t, md.Get("traceparent")[0], spans[0].SpanContext().SpanID().String())
+}
+
+func TestTracingTransport(t *testing.T) {
+	var traceparent string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	}))
+	defer node.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+	client := &http.Client{Transport: tracingTransport{base: http.DefaultTransport, tracer: tracer}}
+
+	for _, body := range []string{`{"jsonrpc":"2.0","id":1,"method":"block","params":{}}`, `[{"method":"block"},{"method":"status"}]`} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, node.URL, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		res, err := client.Do(req)
+		require.NoError(t, err)
+
+		// the span ends once the response is read
+		assert.Len(t, recorder.Ended(), len(recorder.Started())-1)
+		_, err = io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+	}
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "cometbft block", spans[0].Name())
+	assert.Equal(t, "cometbft batch", spans[1].Name())
+	assert.Contains(t, traceparent, spans[1].SpanContext().SpanID().String())
+}
@@ -23,6 +23,25 @@ const (
 	BeginBlockHashStart = 0x1
 )
 
+// the mode attribute set by baseapp on the FinalizeBlock events, which tells
+// whether they were emitted at begin block or at end block.
+const (
+	EventAttributeMode  = "mode"
+	EventModeBeginBlock = "BeginBlock"
+	EventModeEndBlock   = "EndBlock"
+)
+
+// the events emitted by IBC core when a packet is sent, acknowledged or timed
+// out, e.g. the ICS-20 transfers, and their attributes identifying the packet.
+const (
+	EventTypeSendPacket          = "send_packet"
+	EventTypeAcknowledgePacket   = "acknowledge_packet"
+	EventTypeTimeoutPacket       = "timeout_packet"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyPacketSrcPort    = "packet_src_port"
+	AttributeKeyPacketSrcChannel = "packet_src_channel"
+)
+
 const (
 	// BurnerAddressIdentifier mocks the account identifier of a burner address
 	// all coins burned in the sdk will be sent to this identifier, which per sdk.AccAddress
@@ -31,6 +50,13 @@ const (
 	BurnerAddressIdentifier = "burner"
 )
 
+// sub-accounts of an account, splitting its balance between the coins it can
+// spend and the coins locked by vesting.
+const (
+	SubAccountSpendable = "spendable"
+	SubAccountLocked    = "locked"
+)
+
 // TransactionType is used to distinguish if a rosetta provided hash
 // represents endblock, beginblock or deliver tx
 type TransactionType int
@@ -52,9 +78,13 @@ const (
 // ConstructionPreprocessMetadata is used to represent
 // the metadata rosetta can provide during preprocess options
 type ConstructionPreprocessMetadata struct {
-	Memo     string `json:"memo"`
-	GasLimit uint64 `json:"gas_limit"`
-	GasPrice string `json:"gas_price"`
+	Memo          string `json:"memo"`
+	TimeoutHeight uint64 `json:"timeout_height"`
+	GasLimit      uint64 `json:"gas_limit"`
+	GasPrice      string `json:"gas_price"`
+	// SignMode is the sign mode of the signing payloads, one of the sign modes
+	// advertised by the network options, SIGN_MODE_LEGACY_AMINO_JSON if empty
+	SignMode string `json:"sign_mode,omitempty"`
 }
 
 func (c *ConstructionPreprocessMetadata) FromMetadata(meta map[string]interface{}) error {
@@ -65,8 +95,10 @@ func (c *ConstructionPreprocessMetadata) FromMetadata(meta map[string]interface{
 type PreprocessOperationsOptionsResponse struct {
 	ExpectedSigners []string `json:"expected_signers"`
 	Memo            string   `json:"memo"`
+	TimeoutHeight   uint64   `json:"timeout_height"`
 	GasLimit        uint64   `json:"gas_limit"`
 	GasPrice        string   `json:"gas_price"`
+	SignMode        string   `json:"sign_mode,omitempty"`
 }
 
 func (c PreprocessOperationsOptionsResponse) ToMetadata() (map[string]interface{}, error) {
@@ -88,11 +120,13 @@ type SignerData struct {
 // construct a transaction. It is returned by ConstructionMetadataFromOptions
 // and fed to ConstructionPayload to process the bytes to sign.
 type ConstructionMetadata struct {
-	ChainID     string        `json:"chain_id"`
-	SignersData []*SignerData `json:"signer_data"`
-	GasLimit    uint64        `json:"gas_limit"`
-	GasPrice    string        `json:"gas_price"`
-	Memo        string        `json:"memo"`
+	ChainID       string        `json:"chain_id"`
+	SignersData   []*SignerData `json:"signer_data"`
+	GasLimit      uint64        `json:"gas_limit"`
+	GasPrice      string        `json:"gas_price"`
+	Memo          string        `json:"memo"`
+	TimeoutHeight uint64        `json:"timeout_height"`
+	SignMode      string        `json:"sign_mode,omitempty"`
 }
 
 func (c ConstructionMetadata) ToMetadata() (map[string]interface{}, error) {
@@ -102,3 +136,22 @@ func (c ConstructionMetadata) ToMetadata() (map[string]interface{}, error) {
 func (c *ConstructionMetadata) FromMetadata(meta map[string]interface{}) error {
 	return unmarshalMetadata(meta, c)
 }
+
+// TxMetadata is the metadata of a transaction which is not represented by
+// its operations, returned by the construction parse endpoint.
+type TxMetadata struct {
+	Memo          string `json:"memo"`
+	TimeoutHeight uint64 `json:"timeout_height"`
+	// ExtensionOptions are the type URLs of the extension options of the transaction
+	ExtensionOptions []string `json:"extension_options,omitempty"`
+	// NonCriticalExtensionOptions are the type URLs of the non critical extension options of the transaction
+	NonCriticalExtensionOptions []string `json:"non_critical_extension_options,omitempty"`
+}
+
+func (c TxMetadata) ToMetadata() (map[string]interface{}, error) {
+	return marshalMetadata(c)
+}
+
+func (c *TxMetadata) FromMetadata(meta map[string]interface{}) error {
+	return unmarshalMetadata(meta, c)
+}
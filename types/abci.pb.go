@@ -273,6 +273,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// GasBreakdown is the gas consumed by each ante decorator and msg of the tx.
+	// It is only reported by the simulations.
+	GasBreakdown *GasBreakdown `protobuf:"bytes,3,opt,name=gas_breakdown,json=gasBreakdown,proto3" json:"gas_breakdown,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -321,6 +324,124 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasBreakdown() *GasBreakdown {
+	if m != nil {
+		return m.GasBreakdown
+	}
+	return nil
+}
+
+// GasBreakdown is the gas consumed by the ante decorators and msgs of a tx. The
+// gas consumed by an ante decorator excludes the gas consumed by the decorators
+// it wraps.
+type GasBreakdown struct {
+	// AnteDecorators is the gas consumed by the ante decorators, in the order
+	// they were run.
+	AnteDecorators []GasConsumption `protobuf:"bytes,1,rep,name=ante_decorators,json=anteDecorators,proto3" json:"ante_decorators"`
+	// Msgs is the gas consumed by the msgs, in the order they were run.
+	Msgs []GasConsumption `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`
+}
+
+func (m *GasBreakdown) Reset()      { *m = GasBreakdown{} }
+func (*GasBreakdown) ProtoMessage() {}
+func (*GasBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *GasBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasBreakdown.Merge(m, src)
+}
+func (m *GasBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasBreakdown proto.InternalMessageInfo
+
+func (m *GasBreakdown) GetAnteDecorators() []GasConsumption {
+	if m != nil {
+		return m.AnteDecorators
+	}
+	return nil
+}
+
+func (m *GasBreakdown) GetMsgs() []GasConsumption {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// GasConsumption is the gas consumed by an ante decorator or a msg.
+type GasConsumption struct {
+	// Name is the type name of the ante decorator, or the type URL of the msg.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// GasUsed is the amount of gas consumed.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *GasConsumption) Reset()      { *m = GasConsumption{} }
+func (*GasConsumption) ProtoMessage() {}
+func (*GasConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+}
+func (m *GasConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConsumption.Merge(m, src)
+}
+func (m *GasConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConsumption proto.InternalMessageInfo
+
+func (m *GasConsumption) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GasConsumption) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -342,7 +463,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +502,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +550,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +607,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +670,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +760,7 @@ type SearchBlocksResult struct {
 func (m *SearchBlocksResult) Reset()      { *m = SearchBlocksResult{} }
 func (*SearchBlocksResult) ProtoMessage() {}
 func (*SearchBlocksResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{10}
+	return fileDescriptor_4e37629bc7eb0df8, []int{12}
 }
 func (m *SearchBlocksResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -710,18 +831,123 @@ func (m *SearchBlocksResult) GetBlocks() []*types2.Block {
 	return nil
 }
 
+// ComposedVoteExtension defines the vote extension composed by baseapp from the
+// vote extensions of the modules registered in its vote extension registry.
+type ComposedVoteExtension struct {
+	// extensions are the vote extensions of the modules, ordered by module name.
+	// The modules without vote extension are omitted.
+	Extensions []ModuleVoteExtension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions"`
+}
+
+func (m *ComposedVoteExtension) Reset()      { *m = ComposedVoteExtension{} }
+func (*ComposedVoteExtension) ProtoMessage() {}
+func (*ComposedVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{13}
+}
+func (m *ComposedVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComposedVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComposedVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComposedVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComposedVoteExtension.Merge(m, src)
+}
+func (m *ComposedVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComposedVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComposedVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComposedVoteExtension proto.InternalMessageInfo
+
+func (m *ComposedVoteExtension) GetExtensions() []ModuleVoteExtension {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+// ModuleVoteExtension defines the vote extension of a module.
+type ModuleVoteExtension struct {
+	// module is the name the module registered its vote extension handler under.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// extension is the vote extension of the module.
+	Extension []byte `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (m *ModuleVoteExtension) Reset()      { *m = ModuleVoteExtension{} }
+func (*ModuleVoteExtension) ProtoMessage() {}
+func (*ModuleVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{14}
+}
+func (m *ModuleVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVoteExtension.Merge(m, src)
+}
+func (m *ModuleVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVoteExtension proto.InternalMessageInfo
+
+func (m *ModuleVoteExtension) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleVoteExtension) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TxResponse)(nil), "cosmos.base.abci.v1beta1.TxResponse")
 	proto.RegisterType((*ABCIMessageLog)(nil), "cosmos.base.abci.v1beta1.ABCIMessageLog")
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*GasBreakdown)(nil), "cosmos.base.abci.v1beta1.GasBreakdown")
+	proto.RegisterType((*GasConsumption)(nil), "cosmos.base.abci.v1beta1.GasConsumption")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
 	proto.RegisterType((*TxMsgData)(nil), "cosmos.base.abci.v1beta1.TxMsgData")
 	proto.RegisterType((*SearchTxsResult)(nil), "cosmos.base.abci.v1beta1.SearchTxsResult")
 	proto.RegisterType((*SearchBlocksResult)(nil), "cosmos.base.abci.v1beta1.SearchBlocksResult")
+	proto.RegisterType((*ComposedVoteExtension)(nil), "cosmos.base.abci.v1beta1.ComposedVoteExtension")
+	proto.RegisterType((*ModuleVoteExtension)(nil), "cosmos.base.abci.v1beta1.ModuleVoteExtension")
 }
 
 func init() {
@@ -729,68 +955,78 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
-	0x13, 0xd5, 0x6a, 0x37, 0x2b, 0x8b, 0xb2, 0x7e, 0xf9, 0x81, 0x30, 0x6c, 0x3a, 0x4d, 0x25, 0x55,
-	0x49, 0x01, 0xa1, 0x40, 0x57, 0x88, 0x13, 0x14, 0x4d, 0x4e, 0x89, 0xdc, 0x7f, 0x06, 0x92, 0x1e,
-	0xd6, 0x0a, 0x0a, 0xf4, 0x22, 0x50, 0x12, 0x43, 0x2d, 0xac, 0x5d, 0x0a, 0x4b, 0xca, 0x96, 0x6f,
-	0xbd, 0xb5, 0xc7, 0x9e, 0x7a, 0xee, 0xb5, 0xfd, 0x24, 0x39, 0xf4, 0xe0, 0xa3, 0x0f, 0x81, 0xdb,
-	0xda, 0xb7, 0x7e, 0x8a, 0x62, 0x86, 0xd4, 0x9f, 0xd4, 0x95, 0x9b, 0x93, 0x87, 0x6f, 0x86, 0xd4,
-	0xbc, 0x37, 0x6f, 0x49, 0x93, 0x7b, 0x03, 0xa5, 0x53, 0xa5, 0xdb, 0x7d, 0xae, 0x45, 0x9b, 0xf7,
-	0x07, 0x49, 0xfb, 0xf8, 0x41, 0x5f, 0x18, 0xfe, 0x00, 0x17, 0xd1, 0x24, 0x57, 0x46, 0x51, 0x66,
-	0x8b, 0x22, 0x28, 0x8a, 0x10, 0x77, 0x45, 0x77, 0xb6, 0xa4, 0x92, 0x0a, 0x8b, 0xda, 0x10, 0xd9,
-	0xfa, 0x3b, 0xef, 0x19, 0x91, 0x0d, 0x45, 0x9e, 0x26, 0x99, 0xb1, 0x67, 0x9a, 0xd3, 0x89, 0xd0,
-	0x2e, 0x79, 0x77, 0x25, 0x89, 0x78, 0xbb, 0x3f, 0x56, 0x83, 0x23, 0x97, 0xdd, 0x95, 0x4a, 0xc9,
-	0xb1, 0x68, 0xe3, 0xaa, 0x3f, 0x7d, 0xd5, 0xe6, 0xd9, 0xa9, 0x4d, 0x35, 0x7f, 0xf3, 0x09, 0xe9,
-	0xce, 0x62, 0xa1, 0x27, 0x2a, 0xd3, 0x82, 0x6e, 0x93, 0x70, 0x24, 0x12, 0x39, 0x32, 0xcc, 0x6b,
-	0x78, 0x2d, 0x3f, 0x76, 0x2b, 0xda, 0x24, 0xa1, 0x99, 0x8d, 0xb8, 0x1e, 0xb1, 0x62, 0xc3, 0x6b,
-	0x95, 0x3b, 0xe4, 0xf2, 0xa2, 0x1e, 0x76, 0x67, 0x5f, 0x71, 0x3d, 0x8a, 0x5d, 0x86, 0xde, 0x25,
-	0xe5, 0x81, 0x1a, 0x0a, 0x3d, 0xe1, 0x03, 0xc1, 0x7c, 0x28, 0x8b, 0x97, 0x00, 0xa5, 0x24, 0x80,
-	0x05, 0x0b, 0x1a, 0x5e, 0xab, 0x1a, 0x63, 0x0c, 0xd8, 0x90, 0x1b, 0xce, 0x6e, 0x61, 0x31, 0xc6,
-	0x74, 0x87, 0x94, 0x72, 0x7e, 0xd2, 0x1b, 0x2b, 0xc9, 0x42, 0x84, 0xc3, 0x9c, 0x9f, 0x3c, 0x57,
-	0x92, 0xbe, 0x24, 0xc1, 0x58, 0x49, 0xcd, 0x4a, 0x0d, 0xbf, 0x55, 0xd9, 0x6b, 0x45, 0xeb, 0xe4,
-	0x8b, 0x9e, 0x75, 0xf6, 0x0f, 0x5e, 0x08, 0xad, 0xb9, 0x14, 0xcf, 0x95, 0xec, 0xec, 0xbc, 0xbe,
-	0xa8, 0x17, 0x7e, 0xfd, 0xbd, 0x7e, 0xfb, 0x6d, 0x5c, 0xc7, 0x78, 0x1c, 0xf4, 0x90, 0x64, 0xaf,
-	0x14, 0xdb, 0xb0, 0x3d, 0x40, 0x4c, 0xdf, 0x27, 0x44, 0x72, 0xdd, 0x3b, 0xe1, 0x99, 0x11, 0x43,
-	0x56, 0x46, 0x25, 0xca, 0x92, 0xeb, 0x6f, 0x10, 0xa0, 0xbb, 0x64, 0x03, 0xd2, 0x53, 0x2d, 0x86,
-	0x8c, 0x60, 0xb2, 0x24, 0xb9, 0x7e, 0xa9, 0xc5, 0x90, 0xde, 0x27, 0x45, 0x33, 0x63, 0x95, 0x86,
-	0xd7, 0xaa, 0xec, 0x6d, 0x45, 0x56, 0xf6, 0x68, 0x2e, 0x7b, 0xf4, 0x2c, 0x3b, 0x8d, 0x8b, 0x66,
-	0x06, 0x4a, 0x99, 0x24, 0x15, 0xda, 0xf0, 0x74, 0xc2, 0x36, 0xad, 0x52, 0x0b, 0x80, 0x3e, 0x22,
-	0xa1, 0x38, 0x16, 0x99, 0xd1, 0xac, 0x8a, 0x54, 0xb7, 0xa3, 0xe5, 0x70, 0x2d, 0xd3, 0xcf, 0x21,
-	0xdd, 0x09, 0x80, 0x58, 0xec, 0x6a, 0x9f, 0x04, 0x3f, 0xfc, 0x5c, 0x2f, 0x34, 0x7f, 0xf1, 0xc8,
-	0xff, 0xde, 0xe6, 0x49, 0x3f, 0x22, 0xe5, 0x54, 0xcb, 0x5e, 0x92, 0x0d, 0xc5, 0x0c, 0xa7, 0x5a,
-	0xed, 0x54, 0xff, 0xba, 0xa8, 0x2f, 0xc1, 0x78, 0x23, 0xd5, 0xf2, 0x00, 0x22, 0xfa, 0x7f, 0xe2,
-	0x83, 0xf0, 0x38, 0xe3, 0x18, 0x42, 0x7a, 0xb8, 0x68, 0xc6, 0xc7, 0x66, 0x3e, 0x5c, 0xaf, 0xfb,
-	0xa1, 0xc9, 0x93, 0x4c, 0xda, 0xde, 0xb6, 0x9c, 0xe8, 0x9b, 0x2b, 0xa0, 0x5e, 0xf6, 0xfa, 0xdd,
-	0x9b, 0x86, 0xd7, 0xcc, 0x49, 0x65, 0x25, 0x0b, 0x83, 0x00, 0xe7, 0x62, 0x8b, 0xe5, 0x18, 0x63,
-	0x7a, 0x40, 0x08, 0x37, 0x26, 0x4f, 0xfa, 0x53, 0x23, 0x34, 0x2b, 0x62, 0x07, 0xf7, 0x6e, 0x98,
-	0xfc, 0xbc, 0xd6, 0x69, 0xb3, 0xb2, 0xd9, 0xfd, 0xe6, 0x43, 0x52, 0x5e, 0x14, 0x01, 0xdb, 0x23,
-	0x71, 0xea, 0x7e, 0x10, 0x42, 0xba, 0x45, 0x6e, 0x1d, 0xf3, 0xf1, 0x54, 0x38, 0x05, 0xec, 0xa2,
-	0xb9, 0x4f, 0x4a, 0x5f, 0x72, 0x7d, 0x70, 0xdd, 0x19, 0xb0, 0x33, 0x58, 0xe7, 0x8c, 0x22, 0x26,
-	0xe7, 0xce, 0x80, 0xc9, 0x84, 0xb1, 0xd0, 0xd3, 0xb1, 0xa1, 0xdb, 0xce, 0xf6, 0xb0, 0x7d, 0xb3,
-	0x53, 0x64, 0x9e, 0xb3, 0xfe, 0x75, 0xf5, 0x1f, 0xfd, 0x43, 0xfd, 0x77, 0xb2, 0x02, 0x7d, 0x4c,
-	0xaa, 0x30, 0xdc, 0xdc, 0x7d, 0xd4, 0x9a, 0x05, 0xb8, 0xf9, 0xdf, 0xfd, 0xb8, 0x99, 0x6a, 0x39,
-	0xff, 0xfc, 0xe7, 0x2e, 0xfa, 0xc9, 0x23, 0xf4, 0x30, 0x49, 0xa7, 0x63, 0x6e, 0x12, 0x95, 0x2d,
-	0x2e, 0x87, 0x2f, 0x2c, 0x3b, 0xfc, 0x5c, 0x3c, 0xb4, 0xf8, 0x07, 0xeb, 0x67, 0xe1, 0x14, 0xeb,
-	0x6c, 0x40, 0x6b, 0x67, 0x17, 0x75, 0x0f, 0xa5, 0x40, 0x11, 0x3f, 0x25, 0x61, 0x8e, 0x4a, 0x20,
-	0xd5, 0xca, 0x5e, 0x63, 0xfd, 0x29, 0x56, 0xb1, 0xd8, 0xd5, 0x37, 0x9f, 0x92, 0xd2, 0x0b, 0x2d,
-	0x3f, 0x03, 0xb1, 0x76, 0x09, 0xd8, 0xb6, 0xb7, 0x62, 0x99, 0x52, 0xaa, 0x65, 0x17, 0x5c, 0x33,
-	0xbf, 0x56, 0xe0, 0xf4, 0x4d, 0xab, 0xed, 0x93, 0x10, 0xc6, 0xcf, 0xbc, 0xe6, 0xf7, 0x1e, 0x29,
-	0x77, 0x67, 0xf3, 0x43, 0x1e, 0x2f, 0x26, 0xe1, 0xdf, 0xcc, 0xc6, 0x6d, 0x58, 0x19, 0xd6, 0x35,
-	0x91, 0x8b, 0xef, 0x2e, 0x32, 0x5a, 0xf1, 0x8d, 0x47, 0x6e, 0x1f, 0x0a, 0x9e, 0x0f, 0x46, 0xdd,
-	0x99, 0x76, 0xce, 0xa8, 0x93, 0x8a, 0x51, 0x86, 0x8f, 0x7b, 0x03, 0x35, 0xcd, 0x8c, 0xf3, 0x17,
-	0x41, 0x68, 0x1f, 0x10, 0x30, 0xa8, 0x4d, 0x59, 0x77, 0xd9, 0x05, 0x6c, 0x9b, 0x70, 0x29, 0x7a,
-	0xd9, 0x34, 0xed, 0x8b, 0x1c, 0xef, 0xde, 0x20, 0x26, 0x00, 0x7d, 0x8d, 0x08, 0xd8, 0x16, 0x0b,
-	0xf0, 0x24, 0xbc, 0x82, 0x83, 0xb8, 0x0c, 0x48, 0x17, 0x00, 0x38, 0x75, 0x9c, 0xa4, 0x89, 0xc1,
-	0x8b, 0x38, 0x88, 0xed, 0x82, 0x7e, 0x42, 0x7c, 0x33, 0xd3, 0x2c, 0x44, 0x5e, 0xf7, 0xd7, 0x6b,
-	0xb3, 0x7c, 0x3e, 0x62, 0xd8, 0xe0, 0xe8, 0x9d, 0x83, 0x87, 0x90, 0x5e, 0x07, 0x5e, 0xa2, 0x1b,
-	0x18, 0xfa, 0xeb, 0x19, 0xfa, 0x37, 0x30, 0xf4, 0xff, 0x83, 0xa1, 0xbf, 0x96, 0xa1, 0x3f, 0x67,
-	0xd8, 0x26, 0x21, 0x3e, 0x93, 0x73, 0x92, 0x3b, 0xab, 0x9f, 0x97, 0x7d, 0x5e, 0xb1, 0xf9, 0xd8,
-	0x95, 0x59, 0x6a, 0x9d, 0xa7, 0xe7, 0x7f, 0xd6, 0x0a, 0xaf, 0x2f, 0x6b, 0xde, 0xd9, 0x65, 0xcd,
-	0xfb, 0xe3, 0xb2, 0xe6, 0xfd, 0x78, 0x55, 0x2b, 0x9c, 0x5d, 0xd5, 0x0a, 0xe7, 0x57, 0xb5, 0xc2,
-	0xb7, 0x4d, 0x99, 0x98, 0xd1, 0xb4, 0x1f, 0x0d, 0x54, 0xda, 0x76, 0xff, 0x07, 0xd8, 0x3f, 0x1f,
-	0xeb, 0xe1, 0x91, 0x7d, 0x9c, 0xfb, 0x21, 0xba, 0xe3, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x51, 0x55, 0xd9, 0x29, 0x08, 0x00, 0x00,
+	// 1130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x7a, 0xdd, 0x75, 0xfc, 0x6c, 0x37, 0x68, 0x08, 0xc9, 0xa6, 0x14, 0xdb, 0xb8, 0x05,
+	0x59, 0x48, 0xb5, 0xd5, 0xb4, 0x42, 0xb4, 0x17, 0x5a, 0xa7, 0x25, 0x44, 0xb4, 0x48, 0x6c, 0x5c,
+	0x2a, 0x71, 0xb1, 0xc6, 0xde, 0xe9, 0x78, 0x15, 0xef, 0x8e, 0xb5, 0x33, 0x4e, 0x9c, 0x1b, 0x37,
+	0x38, 0xf6, 0xc4, 0x99, 0x2b, 0xf0, 0x45, 0x7a, 0xe0, 0x90, 0x63, 0x0e, 0x55, 0x80, 0xe4, 0xc6,
+	0xa7, 0x40, 0x6f, 0x76, 0xd6, 0x76, 0x08, 0x76, 0xcb, 0xc9, 0xf3, 0xfe, 0xcc, 0xf3, 0xfb, 0xfd,
+	0xde, 0x9f, 0x1d, 0xb8, 0xd1, 0x17, 0x32, 0x14, 0xb2, 0xd5, 0xa3, 0x92, 0xb5, 0x68, 0xaf, 0x1f,
+	0xb4, 0x0e, 0x6e, 0xf7, 0x98, 0xa2, 0xb7, 0xb5, 0xd0, 0x1c, 0xc5, 0x42, 0x09, 0xe2, 0x26, 0x4e,
+	0x4d, 0x74, 0x6a, 0x6a, 0xbd, 0x71, 0xba, 0xb6, 0xc6, 0x05, 0x17, 0xda, 0xa9, 0x85, 0xa7, 0xc4,
+	0xff, 0xda, 0xfb, 0x8a, 0x45, 0x3e, 0x8b, 0xc3, 0x20, 0x52, 0x49, 0x4c, 0x75, 0x34, 0x62, 0xd2,
+	0x18, 0xaf, 0xcf, 0x19, 0xb5, 0xbe, 0xd5, 0x1b, 0x8a, 0xfe, 0xbe, 0xb1, 0x6e, 0x72, 0x21, 0xf8,
+	0x90, 0xb5, 0xb4, 0xd4, 0x1b, 0xbf, 0x68, 0xd1, 0xe8, 0x28, 0x31, 0xd5, 0x7f, 0xb7, 0x01, 0x3a,
+	0x13, 0x8f, 0xc9, 0x91, 0x88, 0x24, 0x23, 0xeb, 0xe0, 0x0c, 0x58, 0xc0, 0x07, 0xca, 0xb5, 0x6a,
+	0x56, 0xc3, 0xf6, 0x8c, 0x44, 0xea, 0xe0, 0xa8, 0xc9, 0x80, 0xca, 0x81, 0x9b, 0xad, 0x59, 0x8d,
+	0x42, 0x1b, 0xce, 0x4e, 0xab, 0x4e, 0x67, 0xf2, 0x25, 0x95, 0x03, 0xcf, 0x58, 0xc8, 0x75, 0x28,
+	0xf4, 0x85, 0xcf, 0xe4, 0x88, 0xf6, 0x99, 0x6b, 0xa3, 0x9b, 0x37, 0x53, 0x10, 0x02, 0x39, 0x14,
+	0xdc, 0x5c, 0xcd, 0x6a, 0x94, 0x3d, 0x7d, 0x46, 0x9d, 0x4f, 0x15, 0x75, 0xaf, 0x68, 0x67, 0x7d,
+	0x26, 0x1b, 0x90, 0x8f, 0xe9, 0x61, 0x77, 0x28, 0xb8, 0xeb, 0x68, 0xb5, 0x13, 0xd3, 0xc3, 0x27,
+	0x82, 0x93, 0x67, 0x90, 0x1b, 0x0a, 0x2e, 0xdd, 0x7c, 0xcd, 0x6e, 0x14, 0xb7, 0x1a, 0xcd, 0x45,
+	0xf4, 0x35, 0x1f, 0xb6, 0xb7, 0x77, 0x9f, 0x32, 0x29, 0x29, 0x67, 0x4f, 0x04, 0x6f, 0x6f, 0xbc,
+	0x3a, 0xad, 0x66, 0x7e, 0xfd, 0xa3, 0xba, 0x7a, 0x51, 0x2f, 0x3d, 0x1d, 0x0e, 0x73, 0x08, 0xa2,
+	0x17, 0xc2, 0x5d, 0x49, 0x72, 0xc0, 0x33, 0xf9, 0x00, 0x80, 0x53, 0xd9, 0x3d, 0xa4, 0x91, 0x62,
+	0xbe, 0x5b, 0xd0, 0x4c, 0x14, 0x38, 0x95, 0xcf, 0xb5, 0x82, 0x6c, 0xc2, 0x0a, 0x9a, 0xc7, 0x92,
+	0xf9, 0x2e, 0x68, 0x63, 0x9e, 0x53, 0xf9, 0x4c, 0x32, 0x9f, 0xdc, 0x84, 0xac, 0x9a, 0xb8, 0xc5,
+	0x9a, 0xd5, 0x28, 0x6e, 0xad, 0x35, 0x13, 0xda, 0x9b, 0x29, 0xed, 0xcd, 0x87, 0xd1, 0x91, 0x97,
+	0x55, 0x13, 0x64, 0x4a, 0x05, 0x21, 0x93, 0x8a, 0x86, 0x23, 0xb7, 0x94, 0x30, 0x35, 0x55, 0x90,
+	0xbb, 0xe0, 0xb0, 0x03, 0x16, 0x29, 0xe9, 0x96, 0x35, 0xd4, 0xf5, 0xe6, 0xac, 0xb8, 0x09, 0xd2,
+	0xc7, 0x68, 0x6e, 0xe7, 0x10, 0x98, 0x67, 0x7c, 0xef, 0xe7, 0x7e, 0xfc, 0xb9, 0x9a, 0xa9, 0xff,
+	0x62, 0xc1, 0xd5, 0x8b, 0x38, 0xc9, 0x27, 0x50, 0x08, 0x25, 0xef, 0x06, 0x91, 0xcf, 0x26, 0xba,
+	0xaa, 0xe5, 0x76, 0xf9, 0xef, 0xd3, 0xea, 0x4c, 0xe9, 0xad, 0x84, 0x92, 0xef, 0xe2, 0x89, 0xbc,
+	0x03, 0x36, 0x12, 0xaf, 0x6b, 0xec, 0xe1, 0x91, 0xec, 0x4d, 0x93, 0xb1, 0x75, 0x32, 0x1f, 0x2d,
+	0xe6, 0x7d, 0x4f, 0xc5, 0x41, 0xc4, 0x93, 0xdc, 0xd6, 0x0c, 0xe9, 0xa5, 0x39, 0xa5, 0x9c, 0xe5,
+	0xfa, 0xfd, 0xeb, 0x9a, 0x55, 0x8f, 0xa1, 0x38, 0x67, 0xc5, 0x42, 0x60, 0xe7, 0xea, 0x14, 0x0b,
+	0x9e, 0x3e, 0x93, 0x5d, 0x00, 0xaa, 0x54, 0x1c, 0xf4, 0xc6, 0x8a, 0x49, 0x37, 0xab, 0x33, 0xb8,
+	0xb1, 0xa4, 0xf2, 0xa9, 0xaf, 0xe1, 0x66, 0xee, 0xb2, 0xf9, 0xcf, 0x3b, 0x50, 0x98, 0x3a, 0x21,
+	0xda, 0x7d, 0x76, 0x64, 0xfe, 0x10, 0x8f, 0x64, 0x0d, 0xae, 0x1c, 0xd0, 0xe1, 0x98, 0x19, 0x06,
+	0x12, 0xa1, 0xfe, 0xd2, 0x82, 0xfc, 0x0e, 0x95, 0xbb, 0x97, 0x5b, 0x03, 0xaf, 0xe6, 0x16, 0xb5,
+	0x46, 0x56, 0x1b, 0xa7, 0xad, 0xf1, 0x15, 0x94, 0xd1, 0xd4, 0x8b, 0x19, 0xdd, 0xf7, 0xc5, 0x61,
+	0xa4, 0x47, 0xa4, 0xb8, 0xf5, 0xf1, 0x62, 0x38, 0x3b, 0x54, 0xb6, 0x53, 0x6f, 0xaf, 0xc4, 0xe7,
+	0xa4, 0xfa, 0x6f, 0x16, 0x94, 0xe6, 0xcd, 0xe4, 0x39, 0xac, 0x62, 0x06, 0x5d, 0x9f, 0xf5, 0x45,
+	0x4c, 0x95, 0x88, 0xa5, 0x6b, 0xbd, 0x69, 0x50, 0x76, 0xa8, 0xdc, 0x16, 0x91, 0x1c, 0x87, 0x23,
+	0x15, 0x88, 0xc8, 0x70, 0x76, 0x15, 0xc3, 0x3c, 0x9a, 0x46, 0x21, 0x6d, 0xc8, 0x85, 0x92, 0xa7,
+	0xe4, 0xff, 0xdf, 0x68, 0xfa, 0x6e, 0xfd, 0x73, 0xb8, 0x7a, 0xd1, 0x8a, 0xc5, 0x8e, 0x68, 0x38,
+	0x2d, 0x36, 0x9e, 0x97, 0x70, 0x87, 0x6d, 0xed, 0x78, 0x4c, 0x8e, 0x87, 0x8a, 0xac, 0x9b, 0x9d,
+	0x81, 0x37, 0x4b, 0xed, 0xac, 0x6b, 0x99, 0xbd, 0x71, 0xb9, 0x75, 0xef, 0xfe, 0xab, 0x75, 0xdf,
+	0x6a, 0x8e, 0xc8, 0x3d, 0x28, 0xe3, 0x64, 0xc4, 0x66, 0x23, 0x4a, 0x37, 0x57, 0xb3, 0x17, 0x0e,
+	0x73, 0x29, 0x94, 0x3c, 0xdd, 0x9d, 0xe9, 0x08, 0xfe, 0x64, 0x01, 0xd9, 0x0b, 0xc2, 0xf1, 0x90,
+	0x22, 0xd2, 0xd4, 0x4a, 0xbe, 0x48, 0xd0, 0xe9, 0x5d, 0x63, 0xe9, 0xca, 0x7f, 0xb8, 0x94, 0x4b,
+	0xec, 0xb6, 0xf6, 0x0a, 0xa6, 0x76, 0x7c, 0x5a, 0xb5, 0x34, 0x15, 0xba, 0x01, 0x3f, 0x03, 0x27,
+	0xd6, 0x4c, 0x68, 0xa8, 0xc5, 0xad, 0xda, 0xe2, 0x28, 0x09, 0x63, 0x9e, 0xf1, 0xaf, 0x3f, 0x80,
+	0xfc, 0x53, 0xc9, 0x1f, 0x21, 0x59, 0x9b, 0x80, 0x33, 0xdf, 0x9d, 0x9b, 0xb7, 0x7c, 0x28, 0x79,
+	0xe7, 0x68, 0x34, 0xdb, 0xc9, 0x18, 0xbd, 0x94, 0x70, 0x7b, 0xdf, 0xc1, 0xd9, 0x71, 0xad, 0xfa,
+	0x0f, 0x16, 0x14, 0x3a, 0x93, 0x34, 0xc8, 0xbd, 0x69, 0x25, 0xec, 0xe5, 0x68, 0xcc, 0x85, 0xb9,
+	0x62, 0x5d, 0x22, 0x39, 0xfb, 0xf6, 0x24, 0xeb, 0x39, 0x7e, 0x6d, 0xc1, 0xea, 0x1e, 0xa3, 0x71,
+	0x7f, 0xd0, 0x99, 0x48, 0xd3, 0x19, 0x55, 0x28, 0x2a, 0xa1, 0xe8, 0xb0, 0xdb, 0x17, 0xe3, 0x48,
+	0x99, 0xd9, 0x04, 0xad, 0xda, 0x46, 0x0d, 0x4e, 0x77, 0x62, 0x4a, 0xba, 0x2b, 0x11, 0xf0, 0xda,
+	0x88, 0x72, 0xd6, 0x8d, 0xc6, 0x61, 0x8f, 0xc5, 0x7a, 0x2a, 0x73, 0x1e, 0xa0, 0xea, 0x6b, 0xad,
+	0xc1, 0x91, 0xd7, 0x0e, 0x3a, 0x92, 0xfe, 0x7e, 0xe5, 0xbc, 0x02, 0x6a, 0x3a, 0xa8, 0xc0, 0xa8,
+	0xc3, 0x20, 0x0c, 0x94, 0xfe, 0x8a, 0xe5, 0xbc, 0x44, 0x20, 0x9f, 0x82, 0xad, 0x26, 0xd2, 0x75,
+	0x34, 0xae, 0x9b, 0x8b, 0xb9, 0x99, 0x7d, 0x7b, 0x3d, 0xbc, 0x60, 0xe0, 0x9d, 0x60, 0x0f, 0x69,
+	0x78, 0x6d, 0xfc, 0x8c, 0x2f, 0x41, 0x68, 0x2f, 0x46, 0x68, 0x2f, 0x41, 0x68, 0xbf, 0x01, 0xa1,
+	0xbd, 0x10, 0xa1, 0x9d, 0x22, 0x6c, 0x81, 0xa3, 0xdf, 0x18, 0x29, 0xc8, 0x8d, 0xf9, 0xf1, 0x4a,
+	0xde, 0x26, 0x3a, 0x79, 0xcf, 0xb8, 0x4d, 0xb7, 0xfe, 0x7b, 0xdb, 0x22, 0x1c, 0x09, 0xc9, 0xfc,
+	0x6f, 0x85, 0x62, 0x8f, 0x27, 0x8a, 0x45, 0x12, 0x57, 0xc2, 0x1e, 0x00, 0x4b, 0x85, 0x74, 0x79,
+	0xdd, 0x5a, 0xd2, 0x54, 0xc2, 0x1f, 0x0f, 0xd9, 0x85, 0x10, 0xe9, 0xd6, 0x9f, 0x85, 0x31, 0xff,
+	0xf9, 0x0d, 0xbc, 0xfb, 0x1f, 0xee, 0xf8, 0xd8, 0x09, 0xb5, 0xda, 0xcc, 0x80, 0x91, 0xf0, 0xf3,
+	0x3c, 0x0d, 0x61, 0xe6, 0x60, 0xa6, 0x48, 0x42, 0xb6, 0x1f, 0x9c, 0xfc, 0x55, 0xc9, 0xbc, 0x3a,
+	0xab, 0x58, 0xc7, 0x67, 0x15, 0xeb, 0xcf, 0xb3, 0x8a, 0xf5, 0xf2, 0xbc, 0x92, 0x39, 0x3e, 0xaf,
+	0x64, 0x4e, 0xce, 0x2b, 0x99, 0xef, 0xea, 0x3c, 0x50, 0x83, 0x71, 0xaf, 0xd9, 0x17, 0x61, 0xcb,
+	0xbc, 0x05, 0x93, 0x9f, 0x5b, 0xd2, 0xdf, 0x4f, 0x1e, 0x68, 0x3d, 0x47, 0x37, 0xf9, 0x9d, 0x7f,
+	0x06, 0x00, 0x68, 0xd7, 0x3c, 0x40, 0x2d, 0x0a, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1068,6 +1304,18 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasBreakdown != nil {
+		{
+			size, err := m.GasBreakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAbci(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1081,6 +1329,92 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AnteDecorators) > 0 {
+		for iNdEx := len(m.AnteDecorators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnteDecorators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1403,25 +1737,99 @@ func (m *SearchBlocksResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAbci(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAbci(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ComposedVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ComposedVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComposedVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovAbci(uint64(m.Height))
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAbci(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAbci(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAbci(uint64(m.Height))
 	}
 	l = len(m.TxHash)
 	if l > 0 {
@@ -1545,6 +1953,47 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if m.GasBreakdown != nil {
+		l = m.GasBreakdown.Size()
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	return n
+}
+
+func (m *GasBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AnteDecorators) > 0 {
+		for _, e := range m.AnteDecorators {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovAbci(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -1690,6 +2139,38 @@ func (m *SearchBlocksResult) Size() (n int) {
 	return n
 }
 
+func (m *ComposedVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	return n
+}
+
 func sovAbci(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1801,6 +2282,32 @@ func (this *SearchBlocksResult) String() string {
 	}, "")
 	return s
 }
+func (this *ComposedVoteExtension) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForExtensions := "[]ModuleVoteExtension{"
+	for _, f := range this.Extensions {
+		repeatedStringForExtensions += strings.Replace(strings.Replace(f.String(), "ModuleVoteExtension", "ModuleVoteExtension", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExtensions += "}"
+	s := strings.Join([]string{`&ComposedVoteExtension{`,
+		`Extensions:` + repeatedStringForExtensions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ModuleVoteExtension) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ModuleVoteExtension{`,
+		`Module:` + fmt.Sprintf("%v", this.Module) + `,`,
+		`Extension:` + fmt.Sprintf("%v", this.Extension) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringAbci(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2414,22 +2921,258 @@ func (m *StringEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAbci
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAbci
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBreakdown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2456,8 +3199,10 @@ func (m *StringEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasBreakdown == nil {
+				m.GasBreakdown = &GasBreakdown{}
+			}
+			if err := m.GasBreakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2482,7 +3227,7 @@ func (m *StringEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *GasBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2505,17 +3250,17 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnteDecorators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAbci
@@ -2525,29 +3270,31 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAbci
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAbci
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.AnteDecorators = append(m.AnteDecorators, GasConsumption{})
+			if err := m.AnteDecorators[len(m.AnteDecorators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAbci
@@ -2557,23 +3304,25 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAbci
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAbci
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Msgs = append(m.Msgs, GasConsumption{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2596,7 +3345,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasInfo) Unmarshal(dAtA []byte) error {
+func (m *GasConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2619,17 +3368,17 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.GasWanted = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAbci
@@ -2639,11 +3388,24 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasWanted |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
@@ -3579,6 +4341,206 @@ func (m *SearchBlocksResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ComposedVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComposedVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComposedVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, ModuleVoteExtension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAbci(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
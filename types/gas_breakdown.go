@@ -0,0 +1,102 @@
+package types
+
+import (
+	"reflect"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// gasBreakdownKey is the key of the GasBreakdown collected in a Context.
+type gasBreakdownKey struct{}
+
+// WithGasBreakdown returns a Context collecting the gas consumed by each ante
+// decorator chained with ChainAnteDecorators, and by each msg, into breakdown.
+func (c Context) WithGasBreakdown(breakdown *GasBreakdown) Context {
+	return c.WithValue(gasBreakdownKey{}, breakdown)
+}
+
+// GasBreakdown returns the GasBreakdown collected in the Context, or nil if the
+// gas consumption is not broken down.
+func (c Context) GasBreakdown() *GasBreakdown {
+	if c.baseCtx == nil {
+		return nil
+	}
+	breakdown, _ := c.baseCtx.Value(gasBreakdownKey{}).(*GasBreakdown)
+	return breakdown
+}
+
+func (b GasBreakdown) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &b)
+	return string(bz)
+}
+
+func (c GasConsumption) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &c)
+	return string(bz)
+}
+
+// AddMsg records the gas consumed by a msg.
+func (b *GasBreakdown) AddMsg(msg Msg, gasUsed storetypes.Gas) {
+	b.Msgs = append(b.Msgs, GasConsumption{Name: MsgTypeURL(msg), GasUsed: gasUsed})
+}
+
+// anteHandle runs the ante decorator and records the gas it consumes, excluding
+// the gas consumed by next. The gas is measured on the gas meter of the Context
+// passed to the decorator, or on the gas meter the decorator replaces it with.
+func (b *GasBreakdown) anteHandle(decorator AnteDecorator, ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, error) {
+	i := len(b.AnteDecorators)
+	b.AnteDecorators = append(b.AnteDecorators, GasConsumption{Name: typeName(decorator)})
+
+	meter := ctx.GasMeter()
+	start := gasConsumed(meter)
+	newCtx, err := decorator.AnteHandle(ctx, tx, simulate, func(nextCtx Context, tx Tx, simulate bool) (Context, error) {
+		b.AnteDecorators[i].GasUsed += gasConsumedSince(meter, start, nextCtx.GasMeter())
+
+		retCtx, err := next(nextCtx, tx, simulate)
+		if retCtx.IsZero() {
+			retCtx = nextCtx
+		}
+		meter = retCtx.GasMeter()
+		start = gasConsumed(meter)
+		return retCtx, err
+	})
+	if !newCtx.IsZero() {
+		b.AnteDecorators[i].GasUsed += gasConsumedSince(meter, start, newCtx.GasMeter())
+	}
+
+	return newCtx, err
+}
+
+// gasConsumedSince returns the gas consumed on meter since start if current is
+// the same gas meter, or all the gas consumed on current if it replaced meter.
+func gasConsumedSince(meter storetypes.GasMeter, start storetypes.Gas, current storetypes.GasMeter) storetypes.Gas {
+	if current == nil {
+		return 0
+	}
+	if current == meter {
+		return current.GasConsumed() - start
+	}
+	return current.GasConsumed()
+}
+
+func gasConsumed(meter storetypes.GasMeter) storetypes.Gas {
+	if meter == nil {
+		return 0
+	}
+	return meter.GasConsumed()
+}
+
+// typeName returns the fully qualified name of the type of v, dereferencing
+// pointers.
+func typeName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
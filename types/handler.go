@@ -48,6 +48,9 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	for i := 0; i < len(chain); i++ {
 		ii := i
 		handlerChain[ii] = func(ctx Context, tx Tx, simulate bool) (Context, error) {
+			if breakdown := ctx.GasBreakdown(); breakdown != nil {
+				return breakdown.anteHandle(chain[ii], ctx, tx, simulate, handlerChain[ii+1])
+			}
 			return chain[ii].AnteHandle(ctx, tx, simulate, handlerChain[ii+1])
 		}
 	}
@@ -6,6 +6,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +37,49 @@ func TestChainAnteDecorators(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// gasDecorator consumes gas before and after calling the next ante handler.
+type gasDecorator struct{ before, after storetypes.Gas }
+
+func (d gasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(d.before, "before")
+	newCtx, err := next(ctx, tx, simulate)
+	newCtx.GasMeter().ConsumeGas(d.after, "after")
+	return newCtx, err
+}
+
+// meterDecorator replaces the gas meter with a new one.
+type meterDecorator struct{}
+
+func (meterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(100, "discarded")
+	newCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	newCtx.GasMeter().ConsumeGas(3, "setup")
+	return next(newCtx, tx, simulate)
+}
+
+func TestChainAnteDecoratorsGasBreakdown(t *testing.T) {
+	key := storetypes.NewKVStoreKey(t.Name())
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+t.Name())).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	anteHandler := sdk.ChainAnteDecorators(meterDecorator{}, gasDecorator{before: 10, after: 20}, &gasDecorator{before: 5})
+
+	// the gas consumption is not broken down by default
+	newCtx, err := anteHandler(ctx, nil, true)
+	require.NoError(t, err)
+	require.Nil(t, newCtx.GasBreakdown())
+	require.Equal(t, storetypes.Gas(38), newCtx.GasMeter().GasConsumed())
+
+	breakdown := &sdk.GasBreakdown{}
+	newCtx, err = anteHandler(ctx.WithGasBreakdown(breakdown), nil, true)
+	require.NoError(t, err)
+	require.Same(t, breakdown, newCtx.GasBreakdown())
+	require.Equal(t, []sdk.GasConsumption{
+		{Name: "github.com/cosmos/cosmos-sdk/types_test.meterDecorator", GasUsed: 3},
+		{Name: "github.com/cosmos/cosmos-sdk/types_test.gasDecorator", GasUsed: 30},
+		{Name: "github.com/cosmos/cosmos-sdk/types_test.gasDecorator", GasUsed: 5},
+	}, breakdown.AnteDecorators)
+}
+
 func TestChainPostDecorators(t *testing.T) {
 	// test panic when passing an empty sclice of PostDecorators
 	require.Nil(t, sdk.ChainPostDecorators([]sdk.PostDecorator{}...))
@@ -0,0 +1,220 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoLane is returned when inserting a tx which is matched by none of the
+// lanes of a LaneMempool.
+var ErrNoLane = errors.New("tx does not match any mempool lane")
+
+// Lane is a class of transactions, e.g. oracle or IBC relayer messages,
+// governance messages or all the other ones, given its own mempool and share of
+// the block space.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+
+	// Match returns whether a tx belongs to the lane. A nil Match matches all
+	// the txs, so that it can only be used by the last lane, the default one.
+	Match func(sdk.Tx) bool
+
+	// Mempool holds the txs of the lane, in the order they are proposed in.
+	Mempool Mempool
+
+	// MaxBlockSpace is the share of the bytes of a block, between 0 and 1, the
+	// txs of the lane may use.
+	MaxBlockSpace math.LegacyDec
+}
+
+// LaneMempool is a mempool splitting the txs between lanes. A tx is inserted in
+// the first lane matching it, and the lanes are selected from in order, so that
+// the txs of a lane are proposed before the ones of the next lanes. Each lane
+// only uses up to its share of the block space when the proposal is prepared by
+// the DefaultProposalHandler, so that a lane can neither starve the others nor
+// be starved, e.g. during a rush of NFT mints.
+//
+// Note that the txs of a sender are only ordered by nonce within a lane, so the
+// lanes should match whole classes of txs a sender is unlikely to interleave.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a mempool splitting the txs between the given lanes,
+// ordered by priority.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one mempool lane is required")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for i, lane := range lanes {
+		if lane.Name == "" {
+			return nil, fmt.Errorf("mempool lane %d has no name", i)
+		}
+		if names[lane.Name] {
+			return nil, fmt.Errorf("duplicate mempool lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+
+		if lane.Match == nil && i != len(lanes)-1 {
+			return nil, fmt.Errorf("mempool lane %s matches all the txs but is not the last lane", lane.Name)
+		}
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("mempool lane %s has no mempool", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("block space of mempool lane %s must be between 0 and 1, got %s", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// MatchMsgTypeURLs returns a Lane.Match matching the txs whose messages all
+// have one of the given type URLs, so that a tx cannot get in the lane by
+// carrying a single of its messages.
+func MatchMsgTypeURLs(typeURLs ...string) func(sdk.Tx) bool {
+	allowed := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		allowed[typeURL] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !allowed[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Lanes returns the lanes of the mempool, ordered by priority.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// laneOf returns the index of the lane of the given tx, or -1 if no lane
+// matches it.
+func (mp *LaneMempool) laneOf(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert inserts the tx in the mempool of the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.laneOf(tx)
+	if i < 0 {
+		return ErrNoLane
+	}
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the txs of all the lanes, those of a lane
+// coming before the ones of the next lanes.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return mp.selectFrom(ctx, txs, 0)
+}
+
+// selectFrom returns an iterator over the txs of the lanes starting with the
+// given one, or nil if they are all empty.
+func (mp *LaneMempool) selectFrom(ctx context.Context, txs [][]byte, lane int) Iterator {
+	for ; lane < len(mp.lanes); lane++ {
+		if iterator := mp.lanes[lane].Mempool.Select(ctx, txs); iterator != nil {
+			return &laneIterator{mp: mp, ctx: ctx, txs: txs, lane: lane, iterator: iterator}
+		}
+	}
+	return nil
+}
+
+// CountTx returns the number of txs in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes the tx from the mempool of its lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i := mp.laneOf(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// NewBlockSpace returns the block space of the lanes for a proposal of at most
+// maxTxBytes bytes of txs.
+func (mp *LaneMempool) NewBlockSpace(maxTxBytes int64) *LaneBlockSpace {
+	space := &LaneBlockSpace{
+		mp:   mp,
+		max:  make([]int64, len(mp.lanes)),
+		used: make([]int64, len(mp.lanes)),
+	}
+	for i, lane := range mp.lanes {
+		space.max[i] = lane.MaxBlockSpace.MulInt64(maxTxBytes).TruncateInt64()
+	}
+	return space
+}
+
+// LaneBlockSpace tracks the block space used by the txs of each lane while a
+// proposal is prepared.
+type LaneBlockSpace struct {
+	mp   *LaneMempool
+	max  []int64
+	used []int64
+}
+
+// Reserve reserves txSize bytes for the given tx in the block space of its
+// lane, and returns false if they do not fit in it.
+func (s *LaneBlockSpace) Reserve(tx sdk.Tx, txSize int64) bool {
+	i := s.mp.laneOf(tx)
+	if i < 0 || s.used[i]+txSize > s.max[i] {
+		return false
+	}
+	s.used[i] += txSize
+	return true
+}
+
+// laneIterator iterates over the txs of the lanes of a LaneMempool in order.
+type laneIterator struct {
+	mp       *LaneMempool
+	ctx      context.Context
+	txs      [][]byte
+	lane     int
+	iterator Iterator
+}
+
+func (i *laneIterator) Next() Iterator {
+	if next := i.iterator.Next(); next != nil {
+		i.iterator = next
+		return i
+	}
+	return i.mp.selectFrom(i.ctx, i.txs, i.lane+1)
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iterator.Tx()
+}
@@ -0,0 +1,109 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// laneTestTx is a testTx carrying messages.
+type laneTestTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx laneTestTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestNewLaneMempool(t *testing.T) {
+	lane := func(name string, match func(sdk.Tx) bool, space math.LegacyDec) mempool.Lane {
+		return mempool.Lane{Name: name, Match: match, Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: space}
+	}
+	matchAll := func(sdk.Tx) bool { return true }
+
+	testCases := []struct {
+		name   string
+		lanes  []mempool.Lane
+		expErr string
+	}{
+		{"no lane", nil, "at least one mempool lane is required"},
+		{"no name", []mempool.Lane{lane("", nil, math.LegacyOneDec())}, "has no name"},
+		{"duplicate name", []mempool.Lane{lane("a", matchAll, math.LegacyOneDec()), lane("a", nil, math.LegacyOneDec())}, "duplicate mempool lane"},
+		{"default lane not last", []mempool.Lane{lane("a", nil, math.LegacyOneDec()), lane("b", matchAll, math.LegacyOneDec())}, "is not the last lane"},
+		{"no mempool", []mempool.Lane{{Name: "a", MaxBlockSpace: math.LegacyOneDec()}}, "has no mempool"},
+		{"no block space", []mempool.Lane{lane("a", nil, math.LegacyDec{})}, "must be between 0 and 1"},
+		{"negative block space", []mempool.Lane{lane("a", nil, math.LegacyNewDec(-1))}, "must be between 0 and 1"},
+		{"block space above 1", []mempool.Lane{lane("a", nil, math.LegacyNewDec(2))}, "must be between 0 and 1"},
+		{"valid", []mempool.Lane{lane("a", matchAll, math.LegacyZeroDec()), lane("b", nil, math.LegacyOneDec())}, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mp, err := mempool.NewLaneMempool(tc.lanes...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.lanes, mp.Lanes())
+		})
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	priorityMsg, defaultMsg := &testdata.TestMsg{}, &testdata.MsgCreateDog{}
+	priorityLane := mempool.Lane{
+		Name:          "priority",
+		Match:         mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(priorityMsg)),
+		Mempool:       mempool.NewSenderNonceMempool(),
+		MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+	}
+	defaultLane := mempool.Lane{
+		Name:          "default",
+		Mempool:       mempool.NewSenderNonceMempool(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+	mp, err := mempool.NewLaneMempool(priorityLane, defaultLane)
+	require.NoError(t, err)
+
+	newTx := func(account int, nonce uint64, msgs ...sdk.Msg) laneTestTx {
+		return laneTestTx{testTx: testTx{nonce: nonce, address: accounts[account].Address}, msgs: msgs}
+	}
+	defaultTx := newTx(0, 0, defaultMsg)
+	mixedTx := newTx(0, 1, priorityMsg, defaultMsg)
+	priorityTx := newTx(1, 0, priorityMsg)
+	for _, tx := range []sdk.Tx{defaultTx, mixedTx, priorityTx} {
+		require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	}
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, 1, priorityLane.Mempool.CountTx())
+	require.Equal(t, 2, defaultLane.Mempool.CountTx())
+
+	// the txs of the priority lane are selected first
+	require.Equal(t, []sdk.Tx{priorityTx, defaultTx, mixedTx}, fetchTxs(mp.Select(sdk.Context{}, nil), 10))
+
+	// each lane only uses its share of the block space
+	space := mp.NewBlockSpace(100)
+	require.True(t, space.Reserve(priorityTx, 20))
+	require.False(t, space.Reserve(priorityTx, 1))
+	require.True(t, space.Reserve(defaultTx, 80))
+
+	require.NoError(t, mp.Remove(priorityTx))
+	require.ErrorIs(t, mp.Remove(priorityTx), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{defaultTx, mixedTx}, fetchTxs(mp.Select(sdk.Context{}, nil), 10))
+
+	// the txs are rejected if no lane matches them
+	mp, err = mempool.NewLaneMempool(priorityLane)
+	require.NoError(t, err)
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, defaultTx), mempool.ErrNoLane)
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, newTx(0, 2)), mempool.ErrNoLane)
+}
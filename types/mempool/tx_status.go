@@ -0,0 +1,103 @@
+package mempool
+
+import (
+	"container/list"
+	"sync"
+)
+
+// TxStatus defines the status of a tx in the lifecycle of the mempool of the
+// node.
+type TxStatus int32
+
+const (
+	// TxStatusUnknown defines a tx never received, or no longer tracked, by the
+	// node.
+	TxStatusUnknown TxStatus = iota
+	// TxStatusAccepted defines a tx which passed CheckTx, and is only kept by the
+	// CometBFT mempool as the app-side mempool is a no-op mempool.
+	TxStatusAccepted
+	// TxStatusPending defines a tx which passed CheckTx and was inserted in the
+	// app-side mempool, waiting to be included in a block.
+	TxStatusPending
+	// TxStatusEvicted defines a tx removed from the mempool without being
+	// included in a block, as it is no longer valid.
+	TxStatusEvicted
+	// TxStatusIncluded defines a tx included in a block.
+	TxStatusIncluded
+)
+
+// TxStatusRecord defines the status of a tracked tx.
+type TxStatusRecord struct {
+	Status TxStatus
+	// Height is the height of the block the tx was included in, or the last
+	// committed height when the tx reached any other status.
+	Height int64
+}
+
+// TxStatusTracker tracks the status of the txs seen by the mempool of the node,
+// by tx hash. It keeps at most a given number of txs, forgetting the txs whose
+// status was updated least recently first.
+type TxStatusTracker struct {
+	mtx     sync.Mutex
+	maxSize int
+	records map[string]*list.Element
+	order   *list.List
+}
+
+type txStatusEntry struct {
+	hash   string
+	record TxStatusRecord
+}
+
+// NewTxStatusTracker returns a tracker of the status of at most maxSize txs.
+func NewTxStatusTracker(maxSize int) *TxStatusTracker {
+	if maxSize <= 0 {
+		panic("tx status tracker size must be positive")
+	}
+
+	return &TxStatusTracker{
+		maxSize: maxSize,
+		records: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Track sets the status of the tx with the given hash.
+func (t *TxStatusTracker) Track(hash []byte, status TxStatus, height int64) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	record := TxStatusRecord{Status: status, Height: height}
+	if e, ok := t.records[string(hash)]; ok {
+		e.Value.(*txStatusEntry).record = record
+		t.order.MoveToBack(e)
+		return
+	}
+
+	t.records[string(hash)] = t.order.PushBack(&txStatusEntry{hash: string(hash), record: record})
+	if t.order.Len() > t.maxSize {
+		oldest := t.order.Remove(t.order.Front()).(*txStatusEntry)
+		delete(t.records, oldest.hash)
+	}
+}
+
+// Get returns the status of the tx with the given hash, and false if the tx is
+// not tracked.
+func (t *TxStatusTracker) Get(hash []byte) (TxStatusRecord, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	e, ok := t.records[string(hash)]
+	if !ok {
+		return TxStatusRecord{Status: TxStatusUnknown}, false
+	}
+	return e.Value.(*txStatusEntry).record, true
+}
+
+// Len returns the number of tracked txs.
+func (t *TxStatusTracker) Len() int {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.order.Len()
+}
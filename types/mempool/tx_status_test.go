@@ -0,0 +1,37 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestTxStatusTracker(t *testing.T) {
+	tracker := mempool.NewTxStatusTracker(2)
+
+	record, ok := tracker.Get([]byte("tx1"))
+	require.False(t, ok)
+	require.Equal(t, mempool.TxStatusUnknown, record.Status)
+
+	tracker.Track([]byte("tx1"), mempool.TxStatusPending, 1)
+	tracker.Track([]byte("tx2"), mempool.TxStatusPending, 1)
+	tracker.Track([]byte("tx1"), mempool.TxStatusIncluded, 2)
+
+	record, ok = tracker.Get([]byte("tx1"))
+	require.True(t, ok)
+	require.Equal(t, mempool.TxStatusRecord{Status: mempool.TxStatusIncluded, Height: 2}, record)
+
+	// the tx updated least recently is forgotten once the tracker is full
+	tracker.Track([]byte("tx3"), mempool.TxStatusEvicted, 2)
+	require.Equal(t, 2, tracker.Len())
+
+	_, ok = tracker.Get([]byte("tx2"))
+	require.False(t, ok)
+	_, ok = tracker.Get([]byte("tx1"))
+	require.True(t, ok)
+	record, ok = tracker.Get([]byte("tx3"))
+	require.True(t, ok)
+	require.Equal(t, mempool.TxStatusEvicted, record.Status)
+}
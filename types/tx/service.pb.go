@@ -7,8 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -393,6 +396,9 @@ type SimulateRequest struct {
 	//
 	// Since: cosmos-sdk 0.43
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// gas_breakdown requests the gas consumed by each ante decorator and msg of
+	// the transaction to be reported in gas_info.
+	GasBreakdown bool `protobuf:"varint,3,opt,name=gas_breakdown,json=gasBreakdown,proto3" json:"gas_breakdown,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -443,6 +449,13 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetGasBreakdown() bool {
+	if m != nil {
+		return m.GasBreakdown
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -1121,6 +1134,172 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// GetFeeSuggestionRequest is the request type for the Service.GetFeeSuggestion
+// RPC method.
+type GetFeeSuggestionRequest struct {
+}
+
+func (m *GetFeeSuggestionRequest) Reset()         { *m = GetFeeSuggestionRequest{} }
+func (m *GetFeeSuggestionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeeSuggestionRequest) ProtoMessage()    {}
+func (*GetFeeSuggestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{18}
+}
+func (m *GetFeeSuggestionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeeSuggestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeeSuggestionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFeeSuggestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeSuggestionRequest.Merge(m, src)
+}
+func (m *GetFeeSuggestionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeeSuggestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeSuggestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeSuggestionRequest proto.InternalMessageInfo
+
+// GetFeeSuggestionResponse is the response type for the Service.GetFeeSuggestion
+// RPC method.
+type GetFeeSuggestionResponse struct {
+	// suggestions defines the gas price suggestions, one per fee denom paid in
+	// the blocks from from_height to to_height.
+	Suggestions []DenomFeeSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions"`
+	// from_height is the first block height the suggestions are computed from.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last block height the suggestions are computed from.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *GetFeeSuggestionResponse) Reset()         { *m = GetFeeSuggestionResponse{} }
+func (m *GetFeeSuggestionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeeSuggestionResponse) ProtoMessage()    {}
+func (*GetFeeSuggestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{19}
+}
+func (m *GetFeeSuggestionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeeSuggestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeeSuggestionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFeeSuggestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeSuggestionResponse.Merge(m, src)
+}
+func (m *GetFeeSuggestionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeeSuggestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeSuggestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeSuggestionResponse proto.InternalMessageInfo
+
+func (m *GetFeeSuggestionResponse) GetSuggestions() []DenomFeeSuggestion {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+func (m *GetFeeSuggestionResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetFeeSuggestionResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// DenomFeeSuggestion defines the percentiles of the gas prices, i.e. the fee
+// amount divided by the gas limit, paid in a denom by recent transactions.
+type DenomFeeSuggestion struct {
+	// denom is the fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// p10 is the 10th percentile gas price, suitable for low priority transactions.
+	P10 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=p10,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p10"`
+	// p50 is the median gas price.
+	P50 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=p50,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p50"`
+	// p90 is the 90th percentile gas price, suitable for high priority transactions.
+	P90 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=p90,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p90"`
+	// tx_count is the number of transactions the percentiles are computed from.
+	TxCount uint64 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *DenomFeeSuggestion) Reset()         { *m = DenomFeeSuggestion{} }
+func (m *DenomFeeSuggestion) String() string { return proto.CompactTextString(m) }
+func (*DenomFeeSuggestion) ProtoMessage()    {}
+func (*DenomFeeSuggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{20}
+}
+func (m *DenomFeeSuggestion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFeeSuggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFeeSuggestion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFeeSuggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFeeSuggestion.Merge(m, src)
+}
+func (m *DenomFeeSuggestion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFeeSuggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFeeSuggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFeeSuggestion proto.InternalMessageInfo
+
+func (m *DenomFeeSuggestion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomFeeSuggestion) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
@@ -1142,90 +1321,108 @@ func init() {
 	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
 	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
 	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+	proto.RegisterType((*GetFeeSuggestionRequest)(nil), "cosmos.tx.v1beta1.GetFeeSuggestionRequest")
+	proto.RegisterType((*GetFeeSuggestionResponse)(nil), "cosmos.tx.v1beta1.GetFeeSuggestionResponse")
+	proto.RegisterType((*DenomFeeSuggestion)(nil), "cosmos.tx.v1beta1.DenomFeeSuggestion")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x6f, 0x13, 0xc7,
+	0x16, 0xcf, 0xda, 0x4e, 0xec, 0x1c, 0x27, 0x60, 0x06, 0x03, 0xce, 0x02, 0xb6, 0xb3, 0x21, 0x89,
+	0xf1, 0xbd, 0xf1, 0x86, 0x5c, 0x72, 0x45, 0x10, 0xd2, 0x55, 0xfc, 0x87, 0x10, 0xb8, 0x10, 0xb4,
+	0x4e, 0x55, 0x51, 0x55, 0xb2, 0xd6, 0xf6, 0x64, 0xb3, 0x25, 0xde, 0x31, 0xde, 0x31, 0x5d, 0x0b,
+	0x50, 0xab, 0x3e, 0xf6, 0xa1, 0xaa, 0x54, 0xa9, 0xfd, 0x08, 0xfd, 0x02, 0xed, 0x27, 0xe8, 0x0b,
+	0x8f, 0xa8, 0x7d, 0xa9, 0xfa, 0x80, 0x2a, 0xe8, 0x53, 0x9f, 0x2a, 0xf5, 0x0b, 0x54, 0x3b, 0x3b,
+	0x6b, 0x7b, 0xed, 0xb5, 0x9d, 0x20, 0x5e, 0x92, 0x9d, 0x39, 0xbf, 0x73, 0x7e, 0xbf, 0x39, 0xf3,
+	0xe7, 0x1c, 0x43, 0xaa, 0x46, 0xcc, 0x06, 0x31, 0x65, 0x6a, 0xc9, 0x4f, 0xaf, 0x55, 0x31, 0x55,
+	0xaf, 0xc9, 0x26, 0x6e, 0x3d, 0xd5, 0x6b, 0x38, 0xd7, 0x6c, 0x11, 0x4a, 0xd0, 0x19, 0x07, 0x90,
+	0xa3, 0x56, 0x8e, 0x03, 0xc4, 0xb8, 0x46, 0x34, 0xc2, 0xac, 0xb2, 0xfd, 0xe5, 0x00, 0xc5, 0x4b,
+	0x1a, 0x21, 0xda, 0x11, 0x96, 0xd5, 0xa6, 0x2e, 0xab, 0x86, 0x41, 0xa8, 0x4a, 0x75, 0x62, 0x98,
+	0xdc, 0xba, 0xe0, 0x84, 0xa9, 0x38, 0x6e, 0x3c, 0xa6, 0x63, 0x5a, 0xe2, 0x12, 0xaa, 0xaa, 0x89,
+	0x65, 0xb5, 0x5a, 0xd3, 0xbb, 0x4a, 0xec, 0x01, 0x07, 0x89, 0xc3, 0x3a, 0xa9, 0xc5, 0x6d, 0xd9,
+	0xfe, 0x00, 0x4f, 0xda, 0xb8, 0xd5, 0xe9, 0x62, 0x9a, 0xaa, 0xa6, 0x1b, 0x4c, 0x88, 0xab, 0x92,
+	0x62, 0xa3, 0x8e, 0x5b, 0x0d, 0xdd, 0xa0, 0x32, 0xed, 0x34, 0xb1, 0x29, 0x57, 0x8f, 0x48, 0xed,
+	0xf1, 0x48, 0x2b, 0xfb, 0xeb, 0x58, 0xa5, 0xbf, 0x05, 0x40, 0x3b, 0x98, 0xee, 0x5b, 0x66, 0xe9,
+	0x29, 0x36, 0xa8, 0x82, 0x9f, 0xb4, 0xb1, 0x49, 0x91, 0x08, 0x33, 0xd8, 0x1e, 0x9b, 0x09, 0x21,
+	0x1d, 0xcc, 0xcc, 0xe6, 0x03, 0x09, 0x41, 0xe1, 0x33, 0xe8, 0x2e, 0x40, 0x4f, 0x42, 0x22, 0x90,
+	0x16, 0x32, 0xd1, 0x8d, 0x95, 0x1c, 0x5f, 0xbe, 0xad, 0x37, 0xc7, 0xf4, 0xba, 0xa9, 0xcd, 0x3d,
+	0x54, 0x35, 0xcc, 0xe3, 0xb2, 0x38, 0x7d, 0xde, 0x68, 0x13, 0x22, 0xa4, 0x55, 0xc7, 0xad, 0x4a,
+	0xb5, 0x93, 0x08, 0xa6, 0x85, 0xcc, 0xa9, 0x0d, 0x31, 0x37, 0xb4, 0x39, 0xb9, 0x3d, 0x1b, 0x92,
+	0xef, 0x28, 0x61, 0xe2, 0x7c, 0x20, 0x04, 0xa1, 0xa6, 0xaa, 0xe1, 0x44, 0x28, 0x2d, 0x64, 0x42,
+	0x0a, 0xfb, 0x46, 0x71, 0x98, 0x3e, 0xd2, 0x1b, 0x3a, 0x4d, 0x4c, 0xb3, 0x49, 0x67, 0x60, 0xcf,
+	0x32, 0x35, 0x89, 0x99, 0xb4, 0x90, 0x99, 0x55, 0x9c, 0x81, 0xf4, 0xa7, 0x00, 0x67, 0x3d, 0xab,
+	0x36, 0x9b, 0xc4, 0x30, 0x31, 0x5a, 0x85, 0x20, 0xb5, 0x9c, 0x35, 0x47, 0x37, 0xce, 0xf9, 0x28,
+	0xd9, 0xb7, 0x14, 0x1b, 0x81, 0x76, 0x60, 0x8e, 0x5a, 0x95, 0x16, 0xf7, 0x33, 0x13, 0x01, 0xe6,
+	0x71, 0xc5, 0x93, 0x05, 0xb6, 0xd3, 0x7d, 0x8e, 0x1c, 0xac, 0x44, 0x69, 0xf7, 0xdb, 0x44, 0xf7,
+	0x3c, 0xc9, 0x0c, 0xb2, 0x64, 0xae, 0x4e, 0x4c, 0xa6, 0xe3, 0x3d, 0x94, 0xcd, 0x38, 0x4c, 0x53,
+	0x42, 0xd5, 0x23, 0x9e, 0x17, 0x67, 0x20, 0x61, 0x40, 0xf9, 0x16, 0x51, 0xeb, 0x35, 0xd5, 0xa4,
+	0xfb, 0x16, 0xdf, 0x09, 0xb4, 0x00, 0x11, 0x6a, 0x55, 0xaa, 0x1d, 0x8a, 0xed, 0xf5, 0x0a, 0x99,
+	0x39, 0x25, 0x4c, 0xad, 0xbc, 0x3d, 0x44, 0xd7, 0x21, 0xd4, 0x20, 0x75, 0xcc, 0xb6, 0xf6, 0xd4,
+	0x46, 0xda, 0x27, 0x0d, 0xdd, 0x78, 0xf7, 0x49, 0x1d, 0x2b, 0x0c, 0x2d, 0x7d, 0x0c, 0x67, 0x3d,
+	0x34, 0x3c, 0xa5, 0x25, 0x88, 0xf6, 0x65, 0x8a, 0x51, 0x1d, 0x37, 0x51, 0xd0, 0x4b, 0x94, 0xf4,
+	0x1c, 0x4e, 0x97, 0xf5, 0x46, 0xfb, 0x48, 0xa5, 0xee, 0x59, 0x42, 0x57, 0x21, 0x40, 0x2d, 0x1e,
+	0xd0, 0x7f, 0xaf, 0x58, 0x82, 0x02, 0xd4, 0xf2, 0x2c, 0x36, 0xe0, 0x5d, 0xec, 0x12, 0xcc, 0x6b,
+	0xaa, 0x59, 0xa9, 0xb6, 0xb0, 0xfa, 0xb8, 0x4e, 0x3e, 0x75, 0xf6, 0x20, 0xa2, 0xcc, 0x69, 0xaa,
+	0x99, 0x77, 0xe7, 0xa4, 0x2f, 0x05, 0x88, 0xf5, 0xe8, 0xf9, 0xca, 0x6e, 0x41, 0xc4, 0xf6, 0xd4,
+	0x8d, 0x03, 0xc2, 0x55, 0x2c, 0x8e, 0x5e, 0xd6, 0x8e, 0x6a, 0xee, 0x1a, 0x07, 0x44, 0x09, 0x6b,
+	0xce, 0x07, 0xba, 0x01, 0x33, 0x2d, 0x6c, 0xb6, 0x8f, 0x28, 0xbf, 0x41, 0xe9, 0xd1, 0xbe, 0x0a,
+	0xc3, 0x29, 0x1c, 0x2f, 0x49, 0x30, 0xc7, 0xce, 0xae, 0x9b, 0x07, 0x04, 0xa1, 0x43, 0xd5, 0x3c,
+	0x64, 0x1a, 0x66, 0x15, 0xf6, 0x2d, 0xbd, 0x80, 0x79, 0x8e, 0xe1, 0x62, 0x97, 0x27, 0x26, 0x8b,
+	0x25, 0x6a, 0x60, 0xb7, 0x02, 0xef, 0xb8, 0x5b, 0x16, 0x9c, 0xdf, 0xc1, 0x34, 0x6f, 0xbf, 0x42,
+	0x1f, 0xea, 0xf4, 0x70, 0xdf, 0x32, 0x5d, 0xb1, 0xe7, 0x61, 0xe6, 0x10, 0xeb, 0xda, 0x21, 0x65,
+	0x5a, 0x82, 0x0a, 0x1f, 0xa1, 0xdb, 0xef, 0xfe, 0xa8, 0xf4, 0x5f, 0x01, 0xe9, 0x2f, 0x01, 0x2e,
+	0x0c, 0x51, 0x9f, 0xf4, 0x76, 0x5f, 0x87, 0x08, 0x7b, 0x41, 0x2b, 0x7a, 0x9d, 0x4b, 0x59, 0xc8,
+	0xf5, 0x5e, 0xd1, 0x9c, 0xf3, 0x7e, 0x32, 0x8a, 0xdd, 0xa2, 0x12, 0x66, 0xd0, 0xdd, 0x3a, 0x5a,
+	0x83, 0x69, 0xf6, 0xc9, 0x6f, 0xf1, 0x85, 0x11, 0x2e, 0x8a, 0x83, 0x42, 0x3b, 0x9e, 0x15, 0x87,
+	0x4e, 0x74, 0xf3, 0x3d, 0x4b, 0xfe, 0x37, 0x9c, 0xde, 0xb7, 0x8a, 0xb8, 0x46, 0xea, 0x6e, 0x46,
+	0xc6, 0x5c, 0x6e, 0x69, 0x0b, 0x62, 0x3d, 0xf4, 0x89, 0x0e, 0x87, 0x74, 0xc3, 0x26, 0x2a, 0x19,
+	0xfd, 0x44, 0xc7, 0xf4, 0x5c, 0x83, 0x58, 0xcf, 0x93, 0x93, 0x8e, 0xd1, 0xb8, 0x09, 0x71, 0x17,
+	0xbe, 0xdd, 0xd0, 0x0d, 0xe2, 0xb2, 0x5d, 0x06, 0x50, 0xed, 0x71, 0xe5, 0x13, 0x93, 0x18, 0xfc,
+	0xbc, 0xcf, 0xb2, 0x99, 0xbb, 0x26, 0x31, 0xa4, 0x9b, 0x70, 0x6e, 0xc0, 0x8d, 0x53, 0x2d, 0xc2,
+	0x9c, 0xe3, 0x57, 0xd5, 0x0d, 0xb5, 0xd5, 0xe1, 0x74, 0x51, 0x36, 0x97, 0x67, 0x53, 0xd2, 0x16,
+	0xc4, 0xdd, 0xb4, 0x78, 0x28, 0x8f, 0xe1, 0xfa, 0x5f, 0x38, 0x37, 0xe0, 0xca, 0x69, 0x27, 0xc8,
+	0x5d, 0x60, 0x27, 0xf5, 0x36, 0xc6, 0xe5, 0xb6, 0xa6, 0x61, 0xd3, 0xde, 0x4b, 0xce, 0x2a, 0x7d,
+	0x2f, 0x40, 0x62, 0xd8, 0xc6, 0xc3, 0xde, 0x87, 0xa8, 0xd9, 0x9d, 0x75, 0x8f, 0xf3, 0xb2, 0x4f,
+	0xf2, 0x8b, 0xd8, 0x20, 0x0d, 0x4f, 0x8c, 0x7c, 0xe8, 0xe5, 0xeb, 0xd4, 0x94, 0xd2, 0xef, 0x8f,
+	0x52, 0x10, 0x3d, 0x68, 0x91, 0x46, 0x85, 0x5f, 0xcb, 0x00, 0xbb, 0x96, 0x60, 0x4f, 0xdd, 0x71,
+	0xae, 0xe6, 0x45, 0x98, 0xa5, 0xc4, 0x35, 0x07, 0x99, 0x39, 0x42, 0x89, 0x63, 0x94, 0x7e, 0x0c,
+	0x00, 0x1a, 0xe6, 0xb1, 0x2b, 0x51, 0xdd, 0x9e, 0xe5, 0xab, 0x76, 0x06, 0xe8, 0x01, 0x04, 0x9b,
+	0xd7, 0xd6, 0x19, 0xc5, 0x6c, 0xfe, 0x96, 0x2d, 0xe5, 0xb7, 0xd7, 0xa9, 0x15, 0x4d, 0xa7, 0x87,
+	0xed, 0x6a, 0xae, 0x46, 0x1a, 0xbc, 0x87, 0xe2, 0xff, 0xd6, 0xcc, 0xfa, 0x63, 0xde, 0xab, 0x14,
+	0x71, 0xed, 0xe7, 0x1f, 0xd6, 0x80, 0x2f, 0xb1, 0x88, 0x6b, 0x8a, 0x1d, 0x88, 0xc5, 0xdb, 0x5c,
+	0x4f, 0x04, 0xdf, 0x4b, 0xbc, 0x4d, 0x27, 0xde, 0xd6, 0x7a, 0x22, 0xf4, 0x5e, 0xe2, 0x6d, 0xad,
+	0xf3, 0x23, 0x5e, 0x23, 0x6d, 0xc3, 0xed, 0x4a, 0xc2, 0xd4, 0x2a, 0xd8, 0xc3, 0xec, 0x1d, 0x08,
+	0xf3, 0xae, 0x06, 0x25, 0x20, 0xbe, 0xa7, 0x14, 0x4b, 0x4a, 0x25, 0xff, 0xa8, 0xf2, 0xc1, 0x83,
+	0xf2, 0xc3, 0x52, 0x61, 0xf7, 0xf6, 0x6e, 0xa9, 0x18, 0x9b, 0x42, 0x31, 0x98, 0xeb, 0x5a, 0xb6,
+	0xcb, 0x85, 0x98, 0x80, 0xce, 0xc0, 0x7c, 0x77, 0xa6, 0x58, 0x2a, 0x17, 0x62, 0x81, 0xec, 0xe7,
+	0x02, 0xcc, 0x7b, 0xea, 0x31, 0x4a, 0x82, 0x98, 0x57, 0xf6, 0xb6, 0x8b, 0x85, 0xed, 0xf2, 0x7e,
+	0xe5, 0xfe, 0x5e, 0xb1, 0x34, 0x10, 0xf6, 0x12, 0xc4, 0x07, 0xec, 0xf9, 0xff, 0xef, 0x15, 0xee,
+	0xc5, 0x04, 0x31, 0x10, 0x11, 0xd0, 0x05, 0x38, 0x3b, 0x60, 0x2d, 0x3f, 0x7a, 0x50, 0x88, 0x05,
+	0x6c, 0x9d, 0x03, 0x86, 0x6d, 0x66, 0x09, 0x6e, 0xfc, 0x04, 0x10, 0x2e, 0x3b, 0x1d, 0x36, 0x7a,
+	0x06, 0x11, 0xb7, 0x52, 0x22, 0xc9, 0xe7, 0x50, 0x0e, 0x54, 0x71, 0x71, 0x69, 0x2c, 0x86, 0xd7,
+	0x93, 0x95, 0x2f, 0x7e, 0xf9, 0xe3, 0x9b, 0x40, 0xfa, 0xa6, 0x90, 0x95, 0x2e, 0xca, 0x3e, 0xdd,
+	0xbd, 0x4b, 0xf8, 0x04, 0xa6, 0x59, 0xd9, 0x43, 0x29, 0x9f, 0xa8, 0xfd, 0x45, 0x53, 0x4c, 0x8f,
+	0x06, 0x70, 0xce, 0x65, 0xc6, 0x99, 0x42, 0x97, 0x65, 0xbf, 0x36, 0xdd, 0x94, 0x9f, 0xd9, 0x85,
+	0xf6, 0x05, 0xfa, 0x0c, 0xa2, 0x7d, 0x6d, 0x0f, 0x5a, 0x1e, 0xd7, 0x2d, 0xf5, 0xe8, 0x57, 0x26,
+	0xc1, 0xb8, 0x88, 0x45, 0x26, 0xe2, 0xa2, 0x74, 0xde, 0x5f, 0xc4, 0x4d, 0x21, 0x8b, 0x9e, 0x43,
+	0xb4, 0xaf, 0x95, 0xf5, 0x15, 0x30, 0xdc, 0xe0, 0x8b, 0x2b, 0x93, 0x60, 0x5c, 0x40, 0x92, 0x09,
+	0x48, 0xa0, 0x11, 0x02, 0xd0, 0x77, 0x02, 0x9c, 0x1e, 0xa8, 0xb7, 0xe8, 0xaa, 0x7f, 0x6c, 0x9f,
+	0x76, 0x40, 0xcc, 0x1e, 0x07, 0xca, 0xa5, 0xac, 0x31, 0x29, 0xab, 0x68, 0x79, 0xc4, 0x86, 0xb0,
+	0xb2, 0x2a, 0x3f, 0x73, 0x1e, 0xaa, 0x17, 0xa8, 0x03, 0x11, 0xf7, 0x59, 0xf6, 0x3d, 0x88, 0x03,
+	0x35, 0x53, 0x5c, 0x1a, 0x8b, 0xe1, 0x1a, 0xae, 0x30, 0x0d, 0x49, 0x69, 0xc1, 0x47, 0x43, 0x9d,
+	0x41, 0xed, 0x2d, 0x61, 0xd4, 0x25, 0x63, 0x0c, 0x75, 0xc9, 0x98, 0x4c, 0x5d, 0x32, 0xfc, 0xa8,
+	0xed, 0x3b, 0xe0, 0xc7, 0x8e, 0x1d, 0xba, 0xaf, 0x04, 0x98, 0xf7, 0x14, 0x41, 0xb4, 0x3a, 0x26,
+	0x78, 0x7f, 0xa9, 0x13, 0x33, 0x93, 0x81, 0x5c, 0x4a, 0x96, 0x49, 0xb9, 0x22, 0xa5, 0x46, 0xea,
+	0x90, 0x59, 0x99, 0xb3, 0x73, 0xe1, 0x08, 0x2a, 0xe2, 0x49, 0x82, 0x8a, 0xf8, 0x98, 0x82, 0x8a,
+	0xf8, 0x64, 0x82, 0xea, 0xd8, 0x2b, 0xe8, 0x5b, 0x01, 0x62, 0x83, 0xb5, 0x15, 0x8d, 0x38, 0x87,
+	0x7e, 0xc5, 0x59, 0xfc, 0xd7, 0xb1, 0xb0, 0x5c, 0xd9, 0x55, 0xa6, 0x6c, 0x09, 0x2d, 0xfa, 0x28,
+	0x3b, 0xc0, 0xb8, 0xd2, 0xab, 0xc4, 0xf9, 0xff, 0xbd, 0x7c, 0x93, 0x14, 0x5e, 0xbd, 0x49, 0x0a,
+	0xbf, 0xbf, 0x49, 0x0a, 0x5f, 0xbf, 0x4d, 0x4e, 0xbd, 0x7a, 0x9b, 0x9c, 0xfa, 0xf5, 0x6d, 0x72,
+	0xea, 0xa3, 0xe5, 0xc9, 0x25, 0x48, 0xa6, 0x56, 0x75, 0x86, 0xfd, 0xa4, 0xff, 0xcf, 0x3f, 0x03,
+	0x00, 0xf5, 0x46, 0x57, 0xb2, 0x00, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1268,6 +1465,10 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// GetFeeSuggestion returns percentile based gas price suggestions per fee
+	// denom, computed from the transactions included in the most recent blocks
+	// seen by the node.
+	GetFeeSuggestion(ctx context.Context, in *GetFeeSuggestionRequest, opts ...grpc.CallOption) (*GetFeeSuggestionResponse, error)
 }
 
 type serviceClient struct {
@@ -1359,6 +1560,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) GetFeeSuggestion(ctx context.Context, in *GetFeeSuggestionRequest, opts ...grpc.CallOption) (*GetFeeSuggestionResponse, error) {
+	out := new(GetFeeSuggestionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetFeeSuggestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -1389,6 +1599,10 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// GetFeeSuggestion returns percentile based gas price suggestions per fee
+	// denom, computed from the transactions included in the most recent blocks
+	// seen by the node.
+	GetFeeSuggestion(context.Context, *GetFeeSuggestionRequest) (*GetFeeSuggestionResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1422,6 +1636,9 @@ func (*UnimplementedServiceServer) TxEncodeAmino(ctx context.Context, req *TxEnc
 func (*UnimplementedServiceServer) TxDecodeAmino(ctx context.Context, req *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (*UnimplementedServiceServer) GetFeeSuggestion(ctx context.Context, req *GetFeeSuggestionRequest) (*GetFeeSuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSuggestion not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1589,6 +1806,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetFeeSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetFeeSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetFeeSuggestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetFeeSuggestion(ctx, req.(*GetFeeSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1629,6 +1864,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "GetFeeSuggestion",
+			Handler:    _Service_GetFeeSuggestion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1858,6 +2097,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasBreakdown {
+		i--
+		if m.GasBreakdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -2367,6 +2616,141 @@ func (m *TxDecodeAminoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetFeeSuggestionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeeSuggestionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeeSuggestionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeeSuggestionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeeSuggestionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeeSuggestionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Suggestions) > 0 {
+		for iNdEx := len(m.Suggestions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suggestions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomFeeSuggestion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomFeeSuggestion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomFeeSuggestion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.P90.Size()
+		i -= size
+		if _, err := m.P90.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.P50.Size()
+		i -= size
+		if _, err := m.P50.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.P10.Size()
+		i -= size
+		if _, err := m.P10.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -2481,6 +2865,9 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.GasBreakdown {
+		n += 2
+	}
 	return n
 }
 
@@ -2678,6 +3065,58 @@ func (m *TxDecodeAminoResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetFeeSuggestionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetFeeSuggestionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Suggestions) > 0 {
+		for _, e := range m.Suggestions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovService(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovService(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *DenomFeeSuggestion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = m.P10.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.P50.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.P90.Size()
+	n += 1 + l + sovService(uint64(l))
+	if m.TxCount != 0 {
+		n += 1 + sovService(uint64(m.TxCount))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3352,6 +3791,26 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBreakdown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasBreakdown = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -4668,6 +5127,381 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetFeeSuggestionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeeSuggestionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeeSuggestionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeeSuggestionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeeSuggestionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeeSuggestionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suggestions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suggestions = append(m.Suggestions, DenomFeeSuggestion{})
+			if err := m.Suggestions[len(m.Suggestions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomFeeSuggestion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomFeeSuggestion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomFeeSuggestion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P90.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
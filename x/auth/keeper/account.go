@@ -5,7 +5,9 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -54,12 +56,38 @@ func (ak AccountKeeper) GetAllAccounts(ctx context.Context) (accounts []sdk.Acco
 	return accounts
 }
 
-// SetAccount implements AccountKeeperI.
+// SetAccount implements AccountKeeperI. The AfterAccountPubKeyChanged hook is
+// called if an account's public key is replaced.
 func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
+	var oldAcc sdk.AccountI
+	if ak.hooks.AccountHooks != nil {
+		// the previous account is read without gas so that setting the hooks
+		// doesn't change the gas consumption
+		gasFreeCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		oldAcc = ak.GetAccount(gasFreeCtx, acc.GetAddress())
+	}
+
 	err := ak.Accounts.Set(ctx, acc.GetAddress(), acc)
 	if err != nil {
 		panic(err)
 	}
+
+	// setting the first public key of an account isn't a change
+	if oldAcc == nil || oldAcc.GetPubKey() == nil || pubKeysEqual(oldAcc.GetPubKey(), acc.GetPubKey()) {
+		return
+	}
+
+	if err := ak.hooks.AfterAccountPubKeyChanged(ctx, acc.GetAddress(), oldAcc.GetPubKey(), acc.GetPubKey()); err != nil {
+		panic(err)
+	}
+}
+
+func pubKeysEqual(pk1, pk2 cryptotypes.PubKey) bool {
+	if pk1 == nil || pk2 == nil {
+		return pk1 == nil && pk2 == nil
+	}
+
+	return pk1.Equals(pk2)
 }
 
 // RemoveAccount removes an account for the account mapper store.
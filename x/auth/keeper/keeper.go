@@ -100,6 +100,10 @@ type AccountKeeper struct {
 	// should be the x/gov module account.
 	authority string
 
+	// hooks is shared by the copies of the keeper, so that the hooks set after
+	// the keeper was passed to other keepers are called through them as well.
+	hooks *accountHooks
+
 	// State
 	Schema        collections.Schema
 	Params        collections.Item[types.Params]
@@ -109,6 +113,10 @@ type AccountKeeper struct {
 
 var _ AccountKeeperI = &AccountKeeper{}
 
+type accountHooks struct {
+	types.AccountHooks
+}
+
 // NewAccountKeeper returns a new AccountKeeperI that uses go-amino to
 // (binary) encode and decode concrete sdk.Accounts.
 // `maccPerms` is a map that takes accounts' addresses as keys, and their respective permissions as values. This map is used to construct
@@ -134,6 +142,7 @@ func NewAccountKeeper(
 		cdc:           cdc,
 		permAddrs:     permAddrs,
 		authority:     authority,
+		hooks:         &accountHooks{},
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
@@ -146,6 +155,15 @@ func NewAccountKeeper(
 	return ak
 }
 
+// SetHooks sets the account hooks.
+func (ak AccountKeeper) SetHooks(ah types.AccountHooks) {
+	if ak.hooks.AccountHooks != nil {
+		panic("cannot set account hooks twice")
+	}
+
+	ak.hooks.AccountHooks = ah
+}
+
 // GetAuthority returns the x/auth module's authority.
 func (ak AccountKeeper) GetAuthority() string {
 	return ak.authority
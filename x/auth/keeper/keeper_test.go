@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -215,3 +217,44 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+type pubKeyChange struct {
+	addr                 sdk.AccAddress
+	oldPubKey, newPubKey cryptotypes.PubKey
+}
+
+type mockAccountHooks struct {
+	changes []pubKeyChange
+}
+
+func (h *mockAccountHooks) AfterAccountPubKeyChanged(_ context.Context, addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	h.changes = append(h.changes, pubKeyChange{addr, oldPubKey, newPubKey})
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestAfterAccountPubKeyChangedHook() {
+	ctx := suite.ctx
+	hooks := &mockAccountHooks{}
+	suite.accountKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { suite.accountKeeper.SetHooks(hooks) })
+
+	pubKey1 := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey1.Address())
+
+	// creating an account or setting its first public key isn't a change
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(acc.SetPubKey(pubKey1))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Empty(hooks.changes)
+
+	// setting the same public key again isn't a change either
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Empty(hooks.changes)
+
+	pubKey2 := ed25519.GenPrivKey().PubKey()
+	suite.Require().NoError(acc.SetPubKey(pubKey2))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Equal([]pubKeyChange{{addr, pubKey1, pubKey2}}, hooks.changes)
+}
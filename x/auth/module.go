@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/depinject"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
@@ -202,6 +204,7 @@ func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideAddressCodec),
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetAccountHooks),
 	)
 }
 
@@ -257,3 +260,22 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+// InvokeSetAccountHooks sets the account hooks provided by the other modules,
+// run in the alphabetical order of the module names.
+func InvokeSetAccountHooks(keeper keeper.AccountKeeper, accountHooks map[string]types.AccountHooksWrapper) error {
+	if len(accountHooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(accountHooks)
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiAccountHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, accountHooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
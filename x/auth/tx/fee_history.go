@@ -0,0 +1,150 @@
+package tx
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// FeeHistoryBlocks is the number of most recent blocks the fee suggestions
+// returned by the GetFeeSuggestion RPC method are computed from.
+const FeeHistoryBlocks = 20
+
+// feeHistory is a node-local rolling window of the gas prices paid by the
+// transactions included in the most recent blocks, indexed by fee denom.
+type feeHistory struct {
+	mu     sync.Mutex
+	size   int64
+	blocks map[int64]map[string][]math.LegacyDec
+}
+
+func newFeeHistory(size int64) *feeHistory {
+	return &feeHistory{
+		size:   size,
+		blocks: make(map[int64]map[string][]math.LegacyDec),
+	}
+}
+
+// update moves the window so that it ends at latestHeight, fetching the blocks
+// not seen yet from the node. It returns the first height of the window.
+func (h *feeHistory) update(ctx context.Context, clientCtx client.Context, latestHeight int64) (int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fromHeight := latestHeight - h.size + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	for height := range h.blocks {
+		if height < fromHeight || height > latestHeight {
+			delete(h.blocks, height)
+		}
+	}
+
+	for height := fromHeight; height <= latestHeight; height++ {
+		if _, ok := h.blocks[height]; ok {
+			continue
+		}
+
+		prices, err := blockGasPrices(ctx, clientCtx, height)
+		if err != nil {
+			return 0, err
+		}
+		h.blocks[height] = prices
+	}
+
+	return fromHeight, nil
+}
+
+// suggestions returns the gas price percentiles of every fee denom in the window.
+func (h *feeHistory) suggestions() []txtypes.DenomFeeSuggestion {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	pricesByDenom := make(map[string][]math.LegacyDec)
+	for _, prices := range h.blocks {
+		for denom, denomPrices := range prices {
+			pricesByDenom[denom] = append(pricesByDenom[denom], denomPrices...)
+		}
+	}
+
+	return suggestFees(pricesByDenom)
+}
+
+// blockGasPrices returns the gas prices paid by the transactions of the block
+// at the given height, indexed by fee denom.
+func blockGasPrices(ctx context.Context, clientCtx client.Context, height int64) (map[string][]math.LegacyDec, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string][]math.LegacyDec)
+	for _, txBytes := range block.Block.Data.Txs {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			// txs that cannot be decoded by this node are not taken into account
+			continue
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			continue
+		}
+
+		gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+		for _, fee := range feeTx.GetFee() {
+			prices[fee.Denom] = append(prices[fee.Denom], math.LegacyNewDecFromInt(fee.Amount).Quo(gas))
+		}
+	}
+
+	return prices, nil
+}
+
+// suggestFees computes the 10th, 50th and 90th percentiles of the gas prices of
+// every denom, sorted by denom.
+func suggestFees(pricesByDenom map[string][]math.LegacyDec) []txtypes.DenomFeeSuggestion {
+	denoms := make([]string, 0, len(pricesByDenom))
+	for denom := range pricesByDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	suggestions := make([]txtypes.DenomFeeSuggestion, 0, len(denoms))
+	for _, denom := range denoms {
+		prices := pricesByDenom[denom]
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+		suggestions = append(suggestions, txtypes.DenomFeeSuggestion{
+			Denom:   denom,
+			P10:     percentile(prices, 10),
+			P50:     percentile(prices, 50),
+			P90:     percentile(prices, 90),
+			TxCount: uint64(len(prices)),
+		})
+	}
+
+	return suggestions
+}
+
+// percentile returns the p-th percentile of the sorted, non empty prices,
+// using the nearest-rank method.
+func percentile(prices []math.LegacyDec, p int) math.LegacyDec {
+	rank := (p*len(prices) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return prices[rank-1]
+}
@@ -0,0 +1,53 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestSuggestFees(t *testing.T) {
+	decs := func(prices ...string) []math.LegacyDec {
+		res := make([]math.LegacyDec, len(prices))
+		for i, price := range prices {
+			res[i] = math.LegacyMustNewDecFromStr(price)
+		}
+		return res
+	}
+
+	suggestions := suggestFees(map[string][]math.LegacyDec{
+		"uatom":  decs("0.5"),
+		"stake":  decs("0.10", "0.01", "0.09", "0.02", "0.08", "0.03", "0.07", "0.04", "0.06", "0.05"),
+		"uother": decs("1", "3", "2"),
+	})
+
+	require.Equal(t, []txtypes.DenomFeeSuggestion{
+		{
+			Denom:   "stake",
+			P10:     math.LegacyMustNewDecFromStr("0.01"),
+			P50:     math.LegacyMustNewDecFromStr("0.05"),
+			P90:     math.LegacyMustNewDecFromStr("0.09"),
+			TxCount: 10,
+		},
+		{
+			Denom:   "uatom",
+			P10:     math.LegacyMustNewDecFromStr("0.5"),
+			P50:     math.LegacyMustNewDecFromStr("0.5"),
+			P90:     math.LegacyMustNewDecFromStr("0.5"),
+			TxCount: 1,
+		},
+		{
+			Denom:   "uother",
+			P10:     math.LegacyMustNewDecFromStr("1"),
+			P50:     math.LegacyMustNewDecFromStr("2"),
+			P90:     math.LegacyMustNewDecFromStr("3"),
+			TxCount: 3,
+		},
+	}, suggestions)
+
+	require.Empty(t, suggestFees(nil))
+}
@@ -29,6 +29,7 @@ type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	feeHistory        *feeHistory
 }
 
 // NewTxServer creates a new Tx service server.
@@ -37,6 +38,7 @@ func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interface
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
+		feeHistory:        newFeeHistory(FeeHistoryBlocks),
 	}
 }
 
@@ -100,6 +102,10 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
 	}
 
+	if !req.GasBreakdown {
+		gasInfo.GasBreakdown = nil
+	}
+
 	return &txtypes.SimulateResponse{
 		GasInfo: &gasInfo,
 		Result:  result,
@@ -304,6 +310,29 @@ func (s txServer) TxDecodeAmino(ctx context.Context, req *txtypes.TxDecodeAminoR
 	}, nil
 }
 
+// GetFeeSuggestion implements the ServiceServer.GetFeeSuggestion RPC method.
+func (s txServer) GetFeeSuggestion(ctx context.Context, req *txtypes.GetFeeSuggestionRequest) (*txtypes.GetFeeSuggestionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	latestHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if latestHeight < 1 {
+		return nil, sdkerrors.ErrInvalidHeight.Wrap("no block has been committed yet")
+	}
+
+	fromHeight, err := s.feeHistory.update(ctx, s.clientCtx, latestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetFeeSuggestionResponse{
+		Suggestions: s.feeHistory.suggestions(),
+		FromHeight:  fromHeight,
+		ToHeight:    latestHeight,
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
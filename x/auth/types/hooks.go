@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountHooks defines the hooks called by the account keeper.
+type AccountHooks interface {
+	// AfterAccountPubKeyChanged is called after the public key of an account
+	// is replaced by another one.
+	AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error
+}
+
+// AccountHooksWrapper is a wrapper for modules to inject AccountHooks using depinject.
+type AccountHooksWrapper struct{ AccountHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AccountHooksWrapper) IsOnePerModuleType() {}
+
+// combine multiple account hooks, all hook functions are run in array sequence
+var _ AccountHooks = &MultiAccountHooks{}
+
+type MultiAccountHooks []AccountHooks
+
+func NewMultiAccountHooks(hooks ...AccountHooks) MultiAccountHooks {
+	return hooks
+}
+
+func (h MultiAccountHooks) AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterAccountPubKeyChanged(ctx, addr, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -52,7 +52,7 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		return nil, err
 	}
 
-	if s.BankKeeper.BlockedAddr(to) {
+	if s.BankKeeper.BlockedAddr(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -114,7 +114,7 @@ func (s msgServer) CreatePermanentLockedAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	if s.BankKeeper.BlockedAddr(to) {
+	if s.BankKeeper.BlockedAddr(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
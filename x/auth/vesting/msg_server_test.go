@@ -110,7 +110,7 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
 				s.accountKeeper.SetAccount(s.ctx, toAcc)
-				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any(), to1Addr).Return(false)
 			},
 			input: vestingtypes.NewMsgCreateVestingAccount(
 				fromAddr,
@@ -125,7 +125,7 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 		"create a valid delayed vesting account": {
 			preRun: func() {
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
-				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any(), to2Addr).Return(false)
 				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.Coins{fooCoin}).Return(nil)
 			},
 			input: vestingtypes.NewMsgCreateVestingAccount(
@@ -141,7 +141,7 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 		"create a valid continuous vesting account": {
 			preRun: func() {
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
-				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any(), to3Addr).Return(false)
 				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to3Addr, sdk.Coins{fooCoin}).Return(nil)
 			},
 			input: vestingtypes.NewMsgCreateVestingAccount(
@@ -201,7 +201,7 @@ func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 			preRun: func() {
 				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
-				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any(), to1Addr).Return(false)
 				s.accountKeeper.SetAccount(s.ctx, toAcc)
 			},
 			input: vestingtypes.NewMsgCreatePermanentLockedAccount(
@@ -215,7 +215,7 @@ func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 		"create a valid permanent locked account": {
 			preRun: func() {
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
-				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any(), to2Addr).Return(false)
 				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.Coins{fooCoin}).Return(nil)
 			},
 			input: vestingtypes.NewMsgCreatePermanentLockedAccount(
@@ -36,17 +36,17 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // BlockedAddr mocks base method.
-func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
+func (m *MockBankKeeper) BlockedAddr(ctx context.Context, addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret := m.ctrl.Call(m, "BlockedAddr", ctx, addr)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // BlockedAddr indicates an expected call of BlockedAddr.
-func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call {
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), ctx, addr)
 }
 
 // IsSendEnabledCoins mocks base method.
@@ -11,5 +11,5 @@ import (
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool
 }
@@ -7,6 +7,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -36,7 +37,30 @@ var _ cdctypes.UnpackInterfacesMessage = &Grant{}
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (g Grant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	var authorization Authorization
-	return unpacker.UnpackAny(g.Authorization, &authorization)
+	if err := unpacker.UnpackAny(g.Authorization, &authorization); err != nil {
+		return err
+	}
+
+	if g.GranterPubKey == nil {
+		return nil
+	}
+
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(g.GranterPubKey, &pubKey)
+}
+
+// GetGranterPubKey returns the cached public key the grant is bound to, or nil
+// if the grant is not bound to the granter's public key.
+func (g Grant) GetGranterPubKey() (cryptotypes.PubKey, error) {
+	if g.GranterPubKey == nil {
+		return nil, nil
+	}
+
+	pk, ok := g.GranterPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), g.GranterPubKey.GetCachedValue())
+	}
+	return pk, nil
 }
 
 // GetAuthorization returns the cached value from the Grant.Authorization if present.
@@ -78,6 +78,10 @@ type Grant struct {
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// granter_pub_key is the public key of the granter the grant is bound to, if
+	// any. A grant bound to the public key of the granter is revoked as soon as
+	// the granter's public key changes.
+	GranterPubKey *types.Any `protobuf:"bytes,3,opt,name=granter_pub_key,json=granterPubKey,proto3" json:"granter_pub_key,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -120,6 +124,9 @@ type GrantAuthorization struct {
 	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// granter_pub_key is the public key of the granter the grant is bound to, if
+	// any.
+	GranterPubKey *types.Any `protobuf:"bytes,5,opt,name=granter_pub_key,json=granterPubKey,proto3" json:"granter_pub_key,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
@@ -204,35 +211,38 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0x16, 0xe8, 0x54, 0xe1, 0x61, 0x65, 0x61, 0xb2, 0x70, 0x22, 0x0b, 0xa1, 0x0a,
+	0x29, 0xb6, 0x5a, 0x58, 0xb1, 0x22, 0x16, 0x52, 0x05, 0x6c, 0xc0, 0x14, 0x16, 0x6c, 0x2c, 0x3b,
+	0xb9, 0x4c, 0x46, 0xcd, 0x78, 0xac, 0x79, 0xa0, 0xba, 0x7f, 0x00, 0xab, 0x7e, 0x0a, 0x8b, 0x7e,
+	0x44, 0xd4, 0x55, 0xc5, 0x8a, 0x15, 0x8f, 0x64, 0xc1, 0x3f, 0xb0, 0x42, 0x99, 0xb1, 0xd5, 0x84,
+	0x56, 0x90, 0x45, 0x36, 0xd6, 0x5c, 0xdf, 0x73, 0xee, 0x3d, 0x3e, 0x47, 0x63, 0xd4, 0x1d, 0x30,
+	0x41, 0x99, 0x08, 0x12, 0x25, 0x47, 0xc7, 0xc1, 0x87, 0xdd, 0x14, 0x64, 0xb2, 0x6b, 0x2a, 0x3f,
+	0xe7, 0x4c, 0x32, 0xbb, 0x65, 0x10, 0xbe, 0x79, 0x57, 0x22, 0xda, 0x77, 0x12, 0x4a, 0x32, 0x16,
+	0xe8, 0xa7, 0x01, 0xb6, 0xef, 0x1a, 0x60, 0xac, 0xab, 0xa0, 0x64, 0x99, 0x56, 0x07, 0x33, 0x86,
+	0xc7, 0x10, 0xe8, 0x2a, 0x55, 0xef, 0x03, 0x49, 0x28, 0x08, 0x99, 0xd0, 0xbc, 0x04, 0xb4, 0x30,
+	0xc3, 0xcc, 0x10, 0xe7, 0xa7, 0x6a, 0xe2, 0xdf, 0xb4, 0x24, 0x2b, 0x4c, 0xcb, 0x93, 0xa8, 0xb5,
+	0x0f, 0x19, 0x70, 0x32, 0xe8, 0x2b, 0x39, 0x62, 0x9c, 0x1c, 0x27, 0x92, 0xb0, 0xcc, 0xbe, 0x8d,
+	0x1a, 0x54, 0x60, 0xc7, 0xea, 0x5a, 0x3b, 0x5b, 0xd1, 0xfc, 0xf8, 0xf8, 0xf9, 0xd9, 0x69, 0xcf,
+	0xbb, 0xea, 0x1b, 0xfc, 0x25, 0xe6, 0xa7, 0x5f, 0x9f, 0x1f, 0x74, 0x0c, 0xac, 0x27, 0x86, 0x87,
+	0xc1, 0x55, 0xd3, 0xbd, 0x8f, 0x75, 0xb4, 0xb9, 0xcf, 0x93, 0x4c, 0xda, 0x29, 0x6a, 0x26, 0x8b,
+	0x2d, 0xbd, 0x71, 0x7b, 0xaf, 0xe5, 0x1b, 0xc9, 0x7e, 0x25, 0xd9, 0xef, 0x67, 0x45, 0x78, 0x7f,
+	0x35, 0x09, 0xd1, 0xf2, 0x48, 0xfb, 0x29, 0x42, 0x70, 0x94, 0x13, 0x6e, 0x16, 0xd4, 0xf5, 0x82,
+	0xf6, 0xa5, 0x05, 0x07, 0x95, 0x95, 0xe1, 0x8d, 0xc9, 0xb7, 0x8e, 0x75, 0xf2, 0xbd, 0x63, 0x45,
+	0x0b, 0x3c, 0xfb, 0x2d, 0xba, 0x85, 0xe7, 0x92, 0x81, 0xc7, 0xb9, 0x4a, 0xe3, 0x43, 0x28, 0x9c,
+	0xc6, 0x3f, 0xb4, 0x3a, 0x67, 0xa7, 0xbd, 0x2a, 0xf2, 0x01, 0x2f, 0x72, 0xc9, 0xfc, 0x97, 0x2a,
+	0x7d, 0x01, 0x45, 0xd4, 0x2c, 0xc7, 0x98, 0xd2, 0xfb, 0x5d, 0x47, 0xb6, 0xf6, 0x62, 0x39, 0x80,
+	0x3d, 0x74, 0xbd, 0xc4, 0x99, 0x10, 0x42, 0xe7, 0xcb, 0xc5, 0xc0, 0xfe, 0x70, 0xc8, 0x41, 0x88,
+	0xd7, 0x92, 0x93, 0x0c, 0x47, 0x15, 0xf0, 0x82, 0x03, 0x4e, 0x7d, 0x35, 0x0e, 0x5c, 0x0e, 0xa0,
+	0xb1, 0xfe, 0x00, 0x9e, 0x2c, 0x05, 0xb0, 0xf1, 0xdf, 0x00, 0x36, 0x56, 0x31, 0x7f, 0x73, 0x1d,
+	0xe6, 0x3f, 0x42, 0x37, 0xb5, 0xf7, 0xaf, 0x14, 0x28, 0x78, 0x26, 0x81, 0xda, 0x1e, 0x6a, 0x52,
+	0x81, 0x63, 0x59, 0xe4, 0x10, 0x2b, 0x3e, 0x16, 0x8e, 0xd5, 0x6d, 0xec, 0x6c, 0x45, 0xdb, 0x54,
+	0xe0, 0x83, 0x22, 0x87, 0x37, 0x7c, 0x2c, 0xc2, 0x70, 0xf2, 0xd3, 0xad, 0x4d, 0xa6, 0xae, 0x75,
+	0x3e, 0x75, 0xad, 0x1f, 0x53, 0xd7, 0x3a, 0x99, 0xb9, 0xb5, 0xf3, 0x99, 0x5b, 0xfb, 0x3a, 0x73,
+	0x6b, 0xef, 0xee, 0x61, 0x22, 0x47, 0x2a, 0xf5, 0x07, 0x8c, 0x96, 0xb7, 0x37, 0x58, 0xb8, 0x0f,
+	0x47, 0xe6, 0xa7, 0x90, 0x5e, 0xd3, 0x82, 0x1f, 0xfe, 0x19, 0x00, 0xf8, 0x21, 0x26, 0xb0, 0x39,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -285,13 +295,25 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GranterPubKey != nil {
+		{
+			size, err := m.GranterPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -330,13 +352,25 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GranterPubKey != nil {
+		{
+			size, err := m.GranterPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -439,6 +473,10 @@ func (m *Grant) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.GranterPubKey != nil {
+		l = m.GranterPubKey.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -464,6 +502,10 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.GranterPubKey != nil {
+		l = m.GranterPubKey.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -671,6 +713,42 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GranterPubKey == nil {
+				m.GranterPubKey = &types.Any{}
+			}
+			if err := m.GranterPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -857,6 +935,42 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GranterPubKey == nil {
+				m.GranterPubKey = &types.Any{}
+			}
+			if err := m.GranterPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
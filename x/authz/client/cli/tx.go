@@ -28,6 +28,7 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagRevokeOnKeyChange = "revoke-on-key-change"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -202,6 +203,11 @@ Examples:
 				return err
 			}
 
+			msg.RevokeOnKeyChange, err = cmd.Flags().GetBool(FlagRevokeOnKeyChange)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -212,6 +218,7 @@ Examples:
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Bool(FlagRevokeOnKeyChange, false, "Revoke the grant as soon as the public key of the granter changes")
 	return cmd
 }
 
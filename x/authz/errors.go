@@ -20,4 +20,6 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrGranterKeyChanged error if the public key of the granter changed since the grant was bound to it
+	ErrGranterKeyChanged = errors.Register(ModuleName, 13, "granter public key changed")
 )
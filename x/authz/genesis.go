@@ -2,6 +2,7 @@ package authz
 
 import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 // NewGenesisState creates new GenesisState object
@@ -37,5 +38,14 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg GrantAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	var a Authorization
-	return unpacker.UnpackAny(msg.Authorization, &a)
+	if err := unpacker.UnpackAny(msg.Authorization, &a); err != nil {
+		return err
+	}
+
+	if msg.GranterPubKey == nil {
+		return nil
+	}
+
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.GranterPubKey, &pubKey)
 }
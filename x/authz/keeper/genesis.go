@@ -28,7 +28,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err = k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		err = k.saveGrant(ctx, grantee, granter, a, entry.Expiration, entry.GranterPubKey)
 		if err != nil {
 			panic(err)
 		}
@@ -44,6 +44,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			GranterPubKey: grant.GranterPubKey,
 		})
 		return false
 	})
@@ -120,6 +120,7 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			Grantee:       grantee.String(),
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			GranterPubKey: auth.GranterPubKey,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -168,6 +169,7 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 			Expiration:    auth.Expiration,
 			Granter:       granter.String(),
 			Grantee:       req.Grantee,
+			GranterPubKey: auth.GranterPubKey,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Hooks wraps the authz keeper to implement the account hooks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ authtypes.AccountHooks = Hooks{}
+
+// Hooks returns the account hooks revoking the grants bound to a public key of
+// the granter once it changes.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterAccountPubKeyChanged revokes the grants bound to a public key of the
+// granter other than its new one.
+func (h Hooks) AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, _, newPubKey cryptotypes.PubKey) error {
+	return h.k.revokeKeyBoundGrants(ctx, addr, newPubKey)
+}
+
+// revokeKeyBoundGrants revokes the grants of the granter which are bound to a
+// public key other than pubKey.
+func (k Keeper) revokeKeyBoundGrants(ctx context.Context, granter sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	grantKeys, err := k.getKeyBoundGrantKeys(ctx, granter, pubKey)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range grantKeys {
+		_, grantee, msgType := parseGrantStoreKey(key)
+		if err := k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getKeyBoundGrantKeys returns the store keys of the grants of the granter
+// which are bound to a public key other than pubKey.
+func (k Keeper) getKeyBoundGrantKeys(ctx context.Context, granter sdk.AccAddress, pubKey cryptotypes.PubKey) ([][]byte, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, grantStoreKey(nil, granter, ""))
+	defer iter.Close()
+
+	var grantKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		if err := k.cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return nil, err
+		}
+
+		boundPubKey, err := grant.GetGranterPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if boundPubKey != nil && (pubKey == nil || !boundPubKey.Equals(pubKey)) {
+			grantKeys = append(grantKeys, sdk.CopyBytes(iter.Key()))
+		}
+	}
+
+	return grantKeys, nil
+}
@@ -125,6 +125,10 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, authz.ErrAuthorizationExpired
 			}
 
+			if err := k.checkGranterPubKey(ctx, granter, grant); err != nil {
+				return nil, err
+			}
+
 			authorization, err := grant.GetAuthorization()
 			if err != nil {
 				return nil, err
@@ -181,6 +185,27 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	return k.saveGrant(ctx, grantee, granter, authorization, expiration, nil)
+}
+
+// SaveKeyBoundGrant is like SaveGrant, but binds the grant to the current public
+// key of the granter, so that the grant is revoked as soon as the granter's
+// public key changes.
+func (k Keeper) SaveKeyBoundGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	acc := k.authKeeper.GetAccount(ctx, granter)
+	if acc == nil || acc.GetPubKey() == nil {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("granter %s has no public key to bind the grant to", granter)
+	}
+
+	pubKey, err := codectypes.NewAnyWithValue(acc.GetPubKey())
+	if err != nil {
+		return err
+	}
+
+	return k.saveGrant(ctx, grantee, granter, authorization, expiration, pubKey)
+}
+
+func (k Keeper) saveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time, granterPubKey *codectypes.Any) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	msgType := authorization.MsgTypeURL()
 	store := k.storeService.OpenKVStore(ctx)
@@ -190,6 +215,7 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	grant.GranterPubKey = granterPubKey
 
 	var oldExp *time.Time
 	if oldGrant, found := k.getGrant(ctx, skey); found {
@@ -285,6 +311,7 @@ func (k Keeper) GetAuthorizations(ctx context.Context, grantee, granter sdk.AccA
 // A nil Authorization is returned under the following circumstances:
 //   - No grant is found.
 //   - A grant is found, but it is expired.
+//   - A grant is found, but the public key of the granter it is bound to changed.
 //   - There was an error getting the authorization from the grant.
 func (k Keeper) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -293,6 +320,10 @@ func (k Keeper) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAd
 		return nil, nil
 	}
 
+	if err := k.checkGranterPubKey(ctx, granter, grant); err != nil {
+		return nil, nil
+	}
+
 	auth, err := grant.GetAuthorization()
 	if err != nil {
 		return nil, nil
@@ -301,6 +332,26 @@ func (k Keeper) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAd
 	return auth, grant.Expiration
 }
 
+// checkGranterPubKey returns ErrGranterKeyChanged if the grant is bound to a
+// public key of the granter other than its current one.
+func (k Keeper) checkGranterPubKey(ctx context.Context, granter sdk.AccAddress, grant authz.Grant) error {
+	boundPubKey, err := grant.GetGranterPubKey()
+	if err != nil {
+		return err
+	}
+
+	if boundPubKey == nil {
+		return nil
+	}
+
+	acc := k.authKeeper.GetAccount(ctx, granter)
+	if acc == nil || acc.GetPubKey() == nil || !boundPubKey.Equals(acc.GetPubKey()) {
+		return authz.ErrGranterKeyChanged
+	}
+
+	return nil
+}
+
 // IterateGrants iterates over all authorization grants
 // This function should be used with caution because it can involve significant IO operations.
 // It should not be used in query or msg services without charging additional gas.
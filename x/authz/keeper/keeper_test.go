@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,11 +15,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
@@ -478,6 +483,61 @@ func (s *TestSuite) TestGetAuthorization() {
 	}
 }
 
+func (s *TestSuite) TestKeyBoundGrant() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+	recipientAddr := s.addrs[2]
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: coins100}
+
+	granterAcc := authtypes.NewBaseAccountWithAddress(granterAddr)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), granterAddr).DoAndReturn(func(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
+		return granterAcc
+	}).AnyTimes()
+
+	s.T().Log("verify a grant cannot be bound to a granter without public key")
+	err := s.authzKeeper.SaveKeyBoundGrant(s.ctx, granteeAddr, granterAddr, sendAuthz, nil)
+	require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	require.NoError(granterAcc.SetPubKey(oldPubKey))
+	require.NoError(s.authzKeeper.SaveKeyBoundGrant(s.ctx, granteeAddr, granterAddr, sendAuthz, nil))
+
+	grants, err := s.queryClient.GranterGrants(s.ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(grants.Grants, 1)
+	oldPubKeyAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	require.NoError(err)
+	require.Equal(oldPubKeyAny.Value, grants.Grants[0].GranterPubKey.Value)
+
+	auth, _ := s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(sendAuthz, auth)
+
+	s.T().Log("verify a grant bound to a rotated key can neither be fetched nor executed")
+	require.NoError(granterAcc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+
+	auth, _ = s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(auth)
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{
+		Amount:      coins10,
+		FromAddress: granterAddr.String(),
+		ToAddress:   recipientAddr.String(),
+	}}
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.ErrorIs(err, authz.ErrGranterKeyChanged)
+
+	s.T().Log("verify the account hooks revoke the grants bound to a rotated key only")
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, recipientAddr, granterAddr, sendAuthz, nil))
+	require.NoError(s.authzKeeper.Hooks().AfterAccountPubKeyChanged(s.ctx, granterAddr, oldPubKey, granterAcc.GetPubKey()))
+
+	grants, err = s.queryClient.GranterGrants(s.ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(grants.Grants, 1)
+	require.Equal(recipientAddr.String(), grants.Grants[0].Grantee)
+	require.Nil(grants.Grants[0].GranterPubKey)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -33,6 +33,10 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, err
 	}
 
+	if msg.Grant.GranterPubKey != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("the granter public key of a grant cannot be set, use revoke_on_key_change instead")
+	}
+
 	// create the account if it is not in account state
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
@@ -51,7 +55,11 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	if msg.RevokeOnKeyChange {
+		err = k.SaveKeyBoundGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	} else {
+		err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -184,14 +185,15 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	AuthzKeeper keeper.Keeper
-	Module      appmodule.AppModule
+	AuthzKeeper  keeper.Keeper
+	Module       appmodule.AppModule
+	AccountHooks authtypes.AccountHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
-	return ModuleOutputs{AuthzKeeper: k, Module: m}
+	return ModuleOutputs{AuthzKeeper: k, Module: m, AccountHooks: authtypes.AccountHooksWrapper{AccountHooks: k.Hooks()}}
 }
 
 // ____________________________________________________________________________
@@ -23,3 +23,11 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) AddBlockedAddresses(goCtx context.Context, req *bank.MsgAddBlockedAddresses) (*bank.MsgAddBlockedAddressesResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RemoveBlockedAddresses(goCtx context.Context, req *bank.MsgRemoveBlockedAddresses) (*bank.MsgRemoveBlockedAddressesResponse, error) {
+	return nil, nil
+}
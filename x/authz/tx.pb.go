@@ -38,6 +38,9 @@ type MsgGrant struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Grant   Grant  `protobuf:"bytes,3,opt,name=grant,proto3" json:"grant"`
+	// revoke_on_key_change binds the grant to the current public key of the
+	// granter, so that it is revoked as soon as the granter's public key changes.
+	RevokeOnKeyChange bool `protobuf:"varint,4,opt,name=revoke_on_key_change,json=revokeOnKeyChange,proto3" json:"revoke_on_key_change,omitempty"`
 }
 
 func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
@@ -282,42 +285,44 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x12, 0x4f,
-	0x18, 0x66, 0x4a, 0x29, 0x3f, 0xa6, 0x4d, 0x7e, 0x76, 0x4b, 0xe2, 0x76, 0x9b, 0x6e, 0x37, 0x6b,
-	0xab, 0x04, 0xc3, 0x4c, 0xc0, 0x1b, 0xf1, 0x52, 0x92, 0xc6, 0x8b, 0xc4, 0x64, 0xd5, 0x8b, 0x17,
-	0xb2, 0xc0, 0x38, 0x25, 0x65, 0x77, 0xc8, 0xce, 0x42, 0xc0, 0x93, 0xf1, 0xe8, 0xc9, 0x8f, 0xa1,
-	0x37, 0x0e, 0x3d, 0xfa, 0x01, 0x88, 0xa7, 0xc6, 0x83, 0xf1, 0x64, 0x14, 0x0e, 0x7c, 0x0c, 0xcd,
-	0xce, 0x1f, 0xa4, 0x86, 0xd6, 0x9e, 0xbc, 0xc0, 0xbc, 0xef, 0xf3, 0xbc, 0xb3, 0xef, 0xf3, 0x3e,
-	0x6f, 0x06, 0xee, 0xb7, 0x18, 0x0f, 0x18, 0xc7, 0x7e, 0x3f, 0x3e, 0x7d, 0x85, 0x07, 0xe5, 0x26,
-	0x89, 0xfd, 0x32, 0x8e, 0x87, 0xa8, 0x17, 0xb1, 0x98, 0x19, 0x79, 0x09, 0x23, 0x01, 0x23, 0x05,
-	0x5b, 0xbb, 0x32, 0xdb, 0x10, 0x1c, 0xac, 0x28, 0x22, 0xb0, 0xf2, 0x94, 0x51, 0x26, 0xf3, 0xc9,
-	0x49, 0x65, 0x77, 0x29, 0x63, 0xb4, 0x4b, 0xb0, 0x88, 0x9a, 0xfd, 0x97, 0xd8, 0x0f, 0x47, 0x0a,
-	0x72, 0x56, 0x36, 0x20, 0xbf, 0x27, 0x19, 0xb7, 0x15, 0x23, 0xe0, 0x14, 0x0f, 0xca, 0xc9, 0x9f,
-	0x02, 0xb6, 0xfd, 0xa0, 0x13, 0x32, 0x2c, 0x7e, 0x65, 0xca, 0xfd, 0x02, 0xe0, 0x7f, 0x75, 0x4e,
-	0x1f, 0x45, 0x7e, 0x18, 0x1b, 0x15, 0x98, 0xa5, 0xc9, 0x81, 0x44, 0x26, 0x70, 0x40, 0x21, 0x57,
-	0x33, 0x3f, 0x9f, 0x97, 0xb4, 0xa2, 0xe3, 0x76, 0x3b, 0x22, 0x9c, 0x3f, 0x8d, 0xa3, 0x4e, 0x48,
-	0x3d, 0x4d, 0xfc, 0x5d, 0x43, 0xcc, 0xb5, 0x9b, 0xd5, 0x10, 0xe3, 0x21, 0xcc, 0x88, 0xa3, 0x99,
-	0x76, 0x40, 0x61, 0xb3, 0xb2, 0x87, 0x56, 0x0d, 0x0d, 0x89, 0x9e, 0x6a, 0xb9, 0xc9, 0xb7, 0x83,
-	0xd4, 0xfb, 0xf9, 0xb8, 0x08, 0x3c, 0x59, 0x54, 0x3d, 0x7c, 0x33, 0x1f, 0x17, 0xf5, 0xf7, 0xdf,
-	0xce, 0xc7, 0xc5, 0x1d, 0x59, 0x5e, 0xe2, 0xed, 0x33, 0xac, 0xb5, 0xb8, 0xf7, 0xe1, 0xff, 0x75,
-	0x4e, 0x4f, 0x86, 0xa4, 0xe5, 0x11, 0xde, 0x63, 0x21, 0x27, 0x86, 0x09, 0xb3, 0x11, 0xe1, 0xfd,
-	0x6e, 0xcc, 0x4d, 0xe0, 0xa4, 0x0b, 0x5b, 0x9e, 0x0e, 0xdd, 0x0f, 0x00, 0x66, 0x15, 0x7b, 0x59,
-	0x10, 0xb8, 0xa9, 0xa0, 0x13, 0xb8, 0x1e, 0x70, 0xca, 0xcd, 0x35, 0x27, 0x5d, 0xd8, 0xac, 0xe4,
-	0x91, 0x74, 0x0f, 0x69, 0xf7, 0xd0, 0x71, 0x38, 0xaa, 0xed, 0x7d, 0x3a, 0x2f, 0x29, 0x67, 0x50,
-	0xd3, 0xe7, 0x64, 0xa1, 0xb3, 0xce, 0xa9, 0x27, 0xca, 0xab, 0x77, 0x96, 0x94, 0x91, 0x44, 0x99,
-	0x71, 0x59, 0x59, 0xd2, 0x9f, 0x6b, 0xc0, 0x5b, 0x5a, 0xa4, 0x56, 0xe6, 0x7e, 0x04, 0x30, 0x97,
-	0x5c, 0x43, 0x06, 0xec, 0x8c, 0xfc, 0x33, 0x1b, 0x1d, 0xb8, 0x15, 0x70, 0xda, 0x88, 0x47, 0x3d,
-	0xd2, 0xe8, 0x47, 0x5d, 0xe1, 0x66, 0xce, 0x83, 0x01, 0xa7, 0xcf, 0x46, 0x3d, 0xf2, 0x3c, 0xea,
-	0x56, 0x8f, 0xfe, 0xb4, 0x2a, 0x7f, 0x59, 0x90, 0x6c, 0xd8, 0xdd, 0x81, 0xdb, 0x8b, 0x40, 0x6b,
-	0xaa, 0xfc, 0x04, 0x30, 0x5d, 0xe7, 0xd4, 0x78, 0x02, 0x33, 0x72, 0x3b, 0xed, 0xd5, 0x6b, 0xa2,
-	0x87, 0x61, 0xdd, 0xbd, 0x1e, 0x5f, 0xac, 0xc1, 0x63, 0xb8, 0x2e, 0x8c, 0xde, 0xbf, 0x92, 0x9f,
-	0xc0, 0xd6, 0xd1, 0xb5, 0xf0, 0xe2, 0x36, 0x0f, 0x6e, 0xa8, 0xb1, 0x1f, 0x5c, 0x59, 0x20, 0x09,
-	0xd6, 0xbd, 0xbf, 0x10, 0xf4, 0x9d, 0x56, 0xe6, 0x75, 0xb2, 0xef, 0xb5, 0xda, 0xe4, 0x87, 0x9d,
-	0x9a, 0x4c, 0x6d, 0x70, 0x31, 0xb5, 0xc1, 0xf7, 0xa9, 0x0d, 0xde, 0xcd, 0xec, 0xd4, 0xc5, 0xcc,
-	0x4e, 0x7d, 0x9d, 0xd9, 0xa9, 0x17, 0x87, 0xb4, 0x13, 0x9f, 0xf6, 0x9b, 0xa8, 0xc5, 0x02, 0xf5,
-	0xa2, 0xe0, 0xa5, 0xe1, 0x0e, 0xe5, 0x8b, 0xd0, 0xdc, 0x10, 0x3b, 0xf8, 0xe0, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xbb, 0x9d, 0x9d, 0x9e, 0xb7, 0x04, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xe5, 0x47, 0xd3, 0x5c, 0x2b, 0x41, 0xdc, 0x48, 0xb8, 0xae, 0xea, 0x5a, 0xa6, 0x85,
+	0x28, 0x28, 0xb6, 0x12, 0xb6, 0x88, 0xa5, 0x41, 0x15, 0x03, 0x44, 0x95, 0x0c, 0x2c, 0x2c, 0x96,
+	0x93, 0x1c, 0x97, 0x28, 0xb1, 0x2f, 0xf2, 0x39, 0x51, 0xcc, 0x84, 0x58, 0x90, 0x98, 0xf8, 0x33,
+	0x60, 0xcb, 0xd0, 0x91, 0x3f, 0x20, 0x62, 0xaa, 0x98, 0x98, 0x10, 0x24, 0x43, 0xfe, 0x0c, 0x90,
+	0xef, 0x7c, 0x21, 0x45, 0x69, 0xe9, 0xd4, 0x25, 0xb9, 0xf7, 0xbe, 0xef, 0x9d, 0xdf, 0xf7, 0xbd,
+	0x67, 0xc3, 0xfd, 0x16, 0xa1, 0x2e, 0xa1, 0xa6, 0x33, 0x0c, 0x3a, 0x6f, 0xcc, 0x51, 0xa5, 0x89,
+	0x02, 0xa7, 0x62, 0x06, 0x63, 0x63, 0xe0, 0x93, 0x80, 0x48, 0x05, 0x0e, 0x1b, 0x0c, 0x36, 0x62,
+	0x58, 0xd9, 0xe5, 0x59, 0x9b, 0x71, 0xcc, 0x98, 0xc2, 0x02, 0xa5, 0x80, 0x09, 0x26, 0x3c, 0x1f,
+	0x9d, 0xe2, 0xec, 0x2e, 0x26, 0x04, 0xf7, 0x91, 0xc9, 0xa2, 0xe6, 0xf0, 0xb5, 0xe9, 0x78, 0x61,
+	0x0c, 0x69, 0x6b, 0x1b, 0xe0, 0xcf, 0xe3, 0x8c, 0x3b, 0x31, 0xc3, 0xa5, 0xd8, 0x1c, 0x55, 0xa2,
+	0xbf, 0x18, 0xc8, 0x3b, 0x6e, 0xd7, 0x23, 0x26, 0xfb, 0xe5, 0x29, 0xfd, 0x7d, 0x12, 0x6e, 0x36,
+	0x28, 0x7e, 0xe2, 0x3b, 0x5e, 0x20, 0x55, 0x61, 0x16, 0x47, 0x07, 0xe4, 0xcb, 0x40, 0x03, 0xc5,
+	0x5c, 0x5d, 0xfe, 0x76, 0x56, 0x16, 0x8a, 0x8e, 0xdb, 0x6d, 0x1f, 0x51, 0xfa, 0x3c, 0xf0, 0xbb,
+	0x1e, 0xb6, 0x04, 0xf1, 0x6f, 0x0d, 0x92, 0x93, 0xd7, 0xab, 0x41, 0xd2, 0x23, 0x98, 0x61, 0x47,
+	0x39, 0xa5, 0x81, 0xe2, 0x56, 0x75, 0xcf, 0x58, 0x67, 0x9a, 0xc1, 0x7a, 0xaa, 0xe7, 0xa6, 0x3f,
+	0x0e, 0x12, 0x9f, 0x16, 0x93, 0x12, 0xb0, 0x78, 0x91, 0x64, 0xc2, 0x82, 0x8f, 0x46, 0xa4, 0x87,
+	0x6c, 0xe2, 0xd9, 0x3d, 0x14, 0xda, 0xad, 0x8e, 0xe3, 0x61, 0x24, 0xa7, 0x35, 0x50, 0xdc, 0xb4,
+	0xf2, 0x1c, 0x3b, 0xf5, 0x9e, 0xa2, 0xf0, 0x31, 0x03, 0x6a, 0x87, 0xef, 0x16, 0x93, 0x92, 0x68,
+	0xf8, 0xc3, 0x62, 0x52, 0xda, 0xe1, 0xcf, 0x2b, 0xd3, 0x76, 0xcf, 0x14, 0xe2, 0xf5, 0x07, 0xf0,
+	0x56, 0x83, 0xe2, 0x93, 0x31, 0x6a, 0x59, 0x88, 0x0e, 0x88, 0x47, 0x91, 0x24, 0xc3, 0xac, 0x8f,
+	0xe8, 0xb0, 0x1f, 0x50, 0x19, 0x68, 0xa9, 0xe2, 0xb6, 0x25, 0x42, 0xfd, 0x33, 0x80, 0xd9, 0x98,
+	0xbd, 0xea, 0x00, 0xb8, 0xae, 0x03, 0x27, 0x30, 0xed, 0x52, 0x4c, 0xe5, 0xa4, 0x96, 0x2a, 0x6e,
+	0x55, 0x0b, 0x06, 0x1f, 0xb7, 0x21, 0xc6, 0x6d, 0x1c, 0x7b, 0x61, 0x7d, 0xef, 0xeb, 0x59, 0x39,
+	0x1e, 0xa5, 0xd1, 0x74, 0x28, 0x5a, 0x1a, 0xd3, 0xa0, 0xd8, 0x62, 0xe5, 0xb5, 0xbb, 0x2b, 0xca,
+	0x50, 0xa4, 0x4c, 0xba, 0xa8, 0x2c, 0xea, 0x4f, 0x97, 0xe0, 0x6d, 0x21, 0x52, 0x28, 0xd3, 0xbf,
+	0x00, 0x98, 0x8b, 0xae, 0x61, 0x5e, 0xdd, 0xd8, 0xdc, 0x35, 0xb8, 0xed, 0x52, 0x6c, 0x07, 0xe1,
+	0x00, 0xd9, 0x43, 0xbf, 0xcf, 0xc6, 0x9f, 0xb3, 0xa0, 0x4b, 0xf1, 0x8b, 0x70, 0x80, 0x5e, 0xfa,
+	0xfd, 0xda, 0xd1, 0xbf, 0xa3, 0x2a, 0x5c, 0x14, 0xc4, 0x1b, 0xd6, 0x77, 0x60, 0x7e, 0x19, 0x08,
+	0x4d, 0xd5, 0xdf, 0x00, 0xa6, 0x1a, 0x14, 0x4b, 0xa7, 0x30, 0xc3, 0xd7, 0x59, 0x5d, 0xbf, 0x57,
+	0xc2, 0x0c, 0xe5, 0xde, 0xd5, 0xf8, 0x72, 0x0d, 0x9e, 0xc1, 0x34, 0x1b, 0xf4, 0xfe, 0xa5, 0xfc,
+	0x08, 0x56, 0x8e, 0xae, 0x84, 0x97, 0xb7, 0x59, 0x70, 0x23, 0xb6, 0xfd, 0xe0, 0xd2, 0x02, 0x4e,
+	0x50, 0xee, 0xff, 0x87, 0x20, 0xee, 0x54, 0x32, 0x6f, 0xa3, 0x17, 0xa4, 0x5e, 0x9f, 0xfe, 0x52,
+	0x13, 0xd3, 0x99, 0x0a, 0xce, 0x67, 0x2a, 0xf8, 0x39, 0x53, 0xc1, 0xc7, 0xb9, 0x9a, 0x38, 0x9f,
+	0xab, 0x89, 0xef, 0x73, 0x35, 0xf1, 0xea, 0x10, 0x77, 0x83, 0xce, 0xb0, 0x69, 0xb4, 0x88, 0x1b,
+	0x7f, 0x82, 0xcc, 0x15, 0x73, 0xc7, 0xfc, 0x13, 0xd2, 0xdc, 0x60, 0x3b, 0xf8, 0xf0, 0xcf, 0x00,
+	0xe2, 0xe9, 0x27, 0x56, 0xe8, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +515,16 @@ func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevokeOnKeyChange {
+		i--
+		if m.RevokeOnKeyChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -730,6 +745,9 @@ func (m *MsgGrant) Size() (n int) {
 	}
 	l = m.Grant.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.RevokeOnKeyChange {
+		n += 2
+	}
 	return n
 }
 
@@ -938,6 +956,26 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeOnKeyChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevokeOnKeyChange = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
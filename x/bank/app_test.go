@@ -1,6 +1,7 @@
 package bank_test
 
 import (
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/depinject"
@@ -32,6 +33,7 @@ import (
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	_ "github.com/cosmos/cosmos-sdk/x/params"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type (
@@ -445,3 +447,24 @@ func TestMsgSetSendEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRemoveBlockedAddressesModuleAccount(t *testing.T) {
+	s := createTestSuite(t, []authtypes.GenesisAccount{authtypes.NewBaseAccountWithAddress(addr1)})
+	ctx := s.App.BaseApp.NewContext(false, cmtproto.Header{})
+	msgServer := bankkeeper.NewMsgServerImpl(s.BankKeeper)
+	govAddr := s.BankKeeper.GetAuthority()
+
+	// the module accounts are blocked by the app, which governance cannot lift
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	require.True(t, s.BankKeeper.BlockedAddr(ctx, bondedPool))
+
+	_, err := msgServer.RemoveBlockedAddresses(ctx, types.NewMsgRemoveBlockedAddresses(govAddr, []string{bondedPool.String()}))
+	require.ErrorContains(t, err, fmt.Sprintf("address %s is blocked by the app", bondedPool))
+	require.True(t, s.BankKeeper.BlockedAddr(ctx, bondedPool))
+
+	// nor by setting the entry of the address directly
+	s.BankKeeper.SetBlockedAddr(ctx, bondedPool, false)
+	require.True(t, s.BankKeeper.BlockedAddr(ctx, bondedPool))
+	require.Empty(t, s.BankKeeper.GetAllBlockedAddressEntries(ctx))
+	require.Contains(t, s.BankKeeper.GetBlockedAddresses(ctx), bondedPool.String())
+}
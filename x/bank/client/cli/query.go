@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"cosmossdk.io/core/address"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -32,10 +34,12 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(ac),
+		GetBalanceProofCmd(ac),
 		GetSpendableBalancesCmd(ac),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryBlockedAddresses(),
 	)
 
 	return cmd
@@ -80,8 +84,6 @@ Example:
 				return err
 			}
 
-			ctx := cmd.Context()
-
 			if denom == "" {
 				resolveDenom, err := cmd.Flags().GetBool(FlagResolveDenom)
 				if err != nil {
@@ -90,29 +92,75 @@ Example:
 
 				params := types.NewQueryAllBalancesRequest(addr, pageReq, resolveDenom)
 
-				res, err := queryClient.AllBalances(ctx, params)
+				return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+					return queryClient.AllBalances(ctx, params)
+				})
+			}
+
+			params := types.NewQueryBalanceRequest(addr, denom)
+
+			return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+				res, err := queryClient.Balance(ctx, params)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
-				return clientCtx.PrintProto(res)
+				return res.Balance, nil
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve denom to human-readable denom from metadata")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all balances")
+
+	return cmd
+}
+
+// GetBalanceProofCmd returns a CLI command handler for querying the balance of
+// an account for a denom together with its merkle proof.
+func GetBalanceProofCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-proof [address] [denom]",
+		Short: "Query for an account balance by address and denom, with its merkle proof",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of an account for a specific denomination together with
+the IAVL proof of the balance in the bank store and the proof of the bank store
+in the multistore commitment. The proof must be verified against the app hash
+of the block following the returned height.
+
+Example:
+  $ %s query %s balance-proof [address] [denom]
+  $ %s query %s balance-proof [address] [denom] --height=[height]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			params := types.NewQueryBalanceRequest(addr, denom)
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.Balance(ctx, params)
+			queryClient := types.NewProofServiceClient(clientCtx)
+			res, err := queryClient.BalanceProof(cmd.Context(), types.NewQueryBalanceProofRequest(addr, args[1], clientCtx.Height))
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res.Balance)
+			return clientCtx.PrintProto(res)
 		},
 	}
 
-	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
-	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve denom to human-readable denom from metadata")
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
 	return cmd
 }
@@ -347,3 +395,56 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryBlockedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses [address1 ...]",
+		Short: "Query for blocked address entries",
+		Long: strings.TrimSpace(`Query for blocked address entries that have been set by governance.
+
+To look up the blocked status of one or more specific addresses, including the
+addresses blocked by the app, supply them as arguments to this command.
+To look up all the entries set by governance, do not provide any arguments.
+`,
+		),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific address:
+  $ %[1]s query %[2]s blocked-addresses cosmos1...
+
+Getting all entries:
+  $ %[1]s query %[2]s blocked-addresses
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBlockedAddressesRequest{
+				Addresses:  args,
+				Pagination: reqPag,
+			}
+
+			res, err := queryClient.BlockedAddresses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked address entries")
+
+	return cmd
+}
@@ -20,6 +20,13 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, se := range genState.GetAllSendEnabled() {
 		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
 	}
+	for _, ba := range genState.BlockedAddresses {
+		addr, err := k.ak.AddressCodec().StringToBytes(ba.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetBlockedAddr(ctx, addr, ba.Blocked)
+	}
 	totalSupplyMap := sdk.NewMapCoins(sdk.Coins{})
 
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
@@ -69,5 +76,6 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.BlockedAddresses = k.GetAllBlockedAddressEntries(ctx)
 	return rv
 }
@@ -287,3 +287,37 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+func (k BaseKeeper) BlockedAddresses(goCtx context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryBlockedAddressesResponse{}
+	if len(req.Addresses) > 0 {
+		for _, address := range req.Addresses {
+			addr, err := k.ak.AddressCodec().StringToBytes(address)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", address, err)
+			}
+			resp.BlockedAddresses = append(resp.BlockedAddresses, &types.BlockedAddress{
+				Address: address,
+				Blocked: k.BlockedAddr(ctx, addr),
+			})
+		}
+	} else {
+		results, pageResp, err := query.CollectionPaginate[sdk.AccAddress, bool](ctx, k.BaseViewKeeper.BlockedAddresses, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, r := range results {
+			resp.BlockedAddresses = append(resp.BlockedAddresses, &types.BlockedAddress{
+				Address: r.Key.String(),
+				Blocked: r.Value,
+			})
+		}
+		resp.Pagination = pageResp
+	}
+
+	return resp, nil
+}
@@ -674,3 +674,71 @@ func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryBlockedAddresses() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+
+	// accAddrs[4] is blocked by the app
+	bankKeeper.SetBlockedAddr(ctx, accAddrs[0], true)
+	bankKeeper.SetBlockedAddr(ctx, accAddrs[1], true)
+
+	entries := bankKeeper.GetAllBlockedAddressEntries(ctx)
+	suite.Require().Len(entries, 2)
+
+	tests := []struct {
+		name string
+		req  *types.QueryBlockedAddressesRequest
+		exp  *types.QueryBlockedAddressesResponse
+	}{
+		{
+			name: "empty addresses list",
+			req:  &types.QueryBlockedAddressesRequest{},
+			exp: &types.QueryBlockedAddressesResponse{
+				BlockedAddresses: []*types.BlockedAddress{&entries[0], &entries[1]},
+				Pagination: &query.PageResponse{
+					NextKey: nil,
+					Total:   2,
+				},
+			},
+		},
+		{
+			name: "limit 1",
+			req: &types.QueryBlockedAddressesRequest{
+				Pagination: &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				},
+			},
+			exp: &types.QueryBlockedAddressesResponse{
+				BlockedAddresses: []*types.BlockedAddress{&entries[0]},
+				Pagination: &query.PageResponse{
+					NextKey: sdk.MustAccAddressFromBech32(entries[1].Address),
+					Total:   2,
+				},
+			},
+		},
+		{
+			name: "specific addresses including the ones blocked by the app",
+			req:  &types.QueryBlockedAddressesRequest{Addresses: []string{accAddrs[0].String(), accAddrs[2].String(), accAddrs[4].String()}},
+			exp: &types.QueryBlockedAddressesResponse{
+				BlockedAddresses: []*types.BlockedAddress{
+					{Address: accAddrs[0].String(), Blocked: true},
+					{Address: accAddrs[2].String(), Blocked: false},
+					{Address: accAddrs[4].String(), Blocked: true},
+				},
+				Pagination: nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			resp, err := suite.queryClient.BlockedAddresses(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, resp)
+		})
+	}
+
+	_, err := suite.queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{Addresses: []string{"invalid"}})
+	suite.Require().Error(err)
+}
@@ -283,7 +283,7 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	if k.BlockedAddr(recipientAddr) {
+	if k.BlockedAddr(ctx, recipientAddr) {
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
@@ -1822,3 +1822,32 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func (suite *KeeperTestSuite) TestSetBlockedAddr() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+	require := suite.Require()
+
+	// accAddrs[4] is blocked by the app
+	require.True(bankKeeper.BlockedAddr(ctx, accAddrs[4]))
+	require.False(bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+	require.Equal(map[string]bool{accAddrs[4].String(): true}, bankKeeper.GetBlockedAddresses(ctx))
+
+	// governance can only add to the addresses blocked by the app
+	bankKeeper.SetBlockedAddr(ctx, accAddrs[0], true)
+	bankKeeper.SetBlockedAddr(ctx, accAddrs[4], false)
+	require.True(bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+	require.True(bankKeeper.BlockedAddr(ctx, accAddrs[4]))
+	require.Equal(map[string]bool{accAddrs[0].String(): true, accAddrs[4].String(): true}, bankKeeper.GetBlockedAddresses(ctx))
+
+	entry, found := bankKeeper.GetBlockedAddressEntry(ctx, accAddrs[0])
+	require.True(found)
+	require.Equal(banktypes.BlockedAddress{Address: accAddrs[0].String(), Blocked: true}, entry)
+	_, found = bankKeeper.GetBlockedAddressEntry(ctx, accAddrs[4])
+	require.False(found)
+	require.Len(bankKeeper.GetAllBlockedAddressEntries(ctx), 1)
+
+	// unblocking an address deletes its entry
+	bankKeeper.SetBlockedAddr(ctx, accAddrs[0], false)
+	require.Empty(bankKeeper.GetAllBlockedAddressEntries(ctx))
+	require.False(bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+}
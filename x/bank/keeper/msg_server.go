@@ -58,7 +58,7 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, err
 	}
 
-	if k.BlockedAddr(to) {
+	if k.BlockedAddr(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -115,7 +115,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 				return nil, err
 			}
 
-			if k.BlockedAddr(accAddr) {
+			if k.BlockedAddr(ctx, accAddr) {
 				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 			}
 		} else {
@@ -182,3 +182,86 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) AddBlockedAddresses(goCtx context.Context, msg *types.MsgAddBlockedAddresses) (*types.MsgAddBlockedAddressesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addrs, err := k.parseBlockedAddresses(msg.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for i, addr := range addrs {
+		if k.BlockedAddr(ctx, addr) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("address %s is already blocked", msg.Addresses[i])
+		}
+	}
+
+	for _, addr := range addrs {
+		k.SetBlockedAddr(ctx, addr, true)
+	}
+
+	return &types.MsgAddBlockedAddressesResponse{}, nil
+}
+
+func (k msgServer) RemoveBlockedAddresses(goCtx context.Context, msg *types.MsgRemoveBlockedAddresses) (*types.MsgRemoveBlockedAddressesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addrs, err := k.parseBlockedAddresses(msg.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for i, addr := range addrs {
+		if !k.BlockedAddr(ctx, addr) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("address %s is not blocked", msg.Addresses[i])
+		}
+		// only the addresses blocked by governance have an entry, the ones
+		// blocked by the app, e.g. the module accounts, cannot be unblocked
+		if _, found := k.GetBlockedAddressEntry(ctx, addr); !found {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("address %s is blocked by the app", msg.Addresses[i])
+		}
+	}
+
+	for _, addr := range addrs {
+		k.SetBlockedAddr(ctx, addr, false)
+	}
+
+	return &types.MsgRemoveBlockedAddressesResponse{}, nil
+}
+
+// parseBlockedAddresses decodes the addresses of a blocked addresses message,
+// rejecting an empty list and duplicate entries.
+func (k msgServer) parseBlockedAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	if len(addresses) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("addresses cannot be empty")
+	}
+
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	seen := map[string]bool{}
+	addrs := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if seen[address] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate address entries found for %q", address)
+		}
+		seen[address] = true
+
+		addr, err := base.ak.AddressCodec().StringToBytes(address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %q: %s", address, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
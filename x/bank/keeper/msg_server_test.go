@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -360,3 +362,116 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgAddBlockedAddresses() {
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgAddBlockedAddresses
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "all good",
+			req:  banktypes.NewMsgAddBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String(), accAddrs[1].String()}),
+		},
+		{
+			name:     "already blocked by the app",
+			req:      banktypes.NewMsgAddBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String(), accAddrs[4].String()}),
+			isExpErr: true,
+			errMsg:   fmt.Sprintf("address %s is already blocked", accAddrs[4]),
+		},
+		{
+			name:     "duplicate addresses",
+			req:      banktypes.NewMsgAddBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String(), accAddrs[0].String()}),
+			isExpErr: true,
+			errMsg:   fmt.Sprintf("duplicate address entries found for %q", accAddrs[0].String()),
+		},
+		{
+			name:     "invalid address",
+			req:      banktypes.NewMsgAddBlockedAddresses(govAcc.GetAddress().String(), []string{"invalid"}),
+			isExpErr: true,
+			errMsg:   `invalid address "invalid"`,
+		},
+		{
+			name:     "empty addresses",
+			req:      banktypes.NewMsgAddBlockedAddresses(govAcc.GetAddress().String(), nil),
+			isExpErr: true,
+			errMsg:   "addresses cannot be empty",
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgAddBlockedAddresses("invalid", []string{accAddrs[0].String()}),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.msgServer.AddBlockedAddresses(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				suite.Require().False(suite.bankKeeper.BlockedAddr(suite.ctx, accAddrs[0]))
+			} else {
+				suite.Require().NoError(err)
+				for _, addr := range tc.req.Addresses {
+					suite.Require().True(suite.bankKeeper.BlockedAddr(suite.ctx, sdk.MustAccAddressFromBech32(addr)))
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveBlockedAddresses() {
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgRemoveBlockedAddresses
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "all good",
+			req:  banktypes.NewMsgRemoveBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String()}),
+		},
+		{
+			name:     "blocked by the app",
+			req:      banktypes.NewMsgRemoveBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String(), accAddrs[4].String()}),
+			isExpErr: true,
+			errMsg:   fmt.Sprintf("address %s is blocked by the app", accAddrs[4]),
+		},
+		{
+			name:     "not blocked",
+			req:      banktypes.NewMsgRemoveBlockedAddresses(govAcc.GetAddress().String(), []string{accAddrs[0].String(), accAddrs[1].String()}),
+			isExpErr: true,
+			errMsg:   fmt.Sprintf("address %s is not blocked", accAddrs[1]),
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgRemoveBlockedAddresses("invalid", []string{accAddrs[0].String()}),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.bankKeeper.SetBlockedAddr(suite.ctx, accAddrs[0], true)
+			_, err := suite.msgServer.RemoveBlockedAddresses(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				suite.Require().True(suite.bankKeeper.BlockedAddr(suite.ctx, accAddrs[0]))
+			} else {
+				suite.Require().NoError(err)
+				for _, addr := range tc.req.Addresses {
+					suite.Require().False(suite.bankKeeper.BlockedAddr(suite.ctx, sdk.MustAccAddressFromBech32(addr)))
+				}
+			}
+		})
+	}
+}
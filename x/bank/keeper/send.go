@@ -39,8 +39,12 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
-	GetBlockedAddresses() map[string]bool
+	BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool
+	GetBlockedAddresses(ctx context.Context) map[string]bool
+	GetBlockedAddressEntry(ctx context.Context, addr sdk.AccAddress) (types.BlockedAddress, bool)
+	SetBlockedAddr(ctx context.Context, addr sdk.AccAddress, blocked bool)
+	IterateBlockedAddressEntries(ctx context.Context, cb func(addr sdk.AccAddress, blocked bool) (stop bool))
+	GetAllBlockedAddressEntries(ctx context.Context) []types.BlockedAddress
 
 	GetAuthority() string
 }
@@ -57,7 +61,9 @@ type BaseSendKeeper struct {
 	storeService store.KVStoreService
 	logger       log.Logger
 
-	// list of addresses that are restricted from receiving transactions
+	// list of addresses that are restricted from receiving transactions by the
+	// app, governance can only block more addresses through the BlockedAddresses
+	// store
 	blockedAddrs map[string]bool
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -328,14 +334,93 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bo
 }
 
 // BlockedAddr checks if a given address is restricted from
-// receiving funds.
-func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
-	return k.blockedAddrs[addr.String()]
+// receiving funds, either by the app or by governance. The addresses blocked
+// by the app, e.g. the module accounts, cannot be unblocked by governance.
+func (k BaseSendKeeper) BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+
+	blocked, _ := k.getBlockedAddr(ctx, addr)
+	return blocked
+}
+
+// GetBlockedAddresses returns the full list of addresses restricted from receiving funds,
+// that is the addresses blocked by the app merged with the entries set by governance.
+func (k BaseSendKeeper) GetBlockedAddresses(ctx context.Context) map[string]bool {
+	rv := make(map[string]bool, len(k.blockedAddrs))
+	for addr, blocked := range k.blockedAddrs {
+		if blocked {
+			rv[addr] = true
+		}
+	}
+
+	k.IterateBlockedAddressEntries(ctx, func(addr sdk.AccAddress, blocked bool) bool {
+		if blocked {
+			rv[addr.String()] = true
+		}
+		return false
+	})
+
+	return rv
+}
+
+// GetBlockedAddressEntry gets a BlockedAddress entry set by governance for the given address.
+// The second return argument is true iff a specific entry exists for the given address.
+func (k BaseSendKeeper) GetBlockedAddressEntry(ctx context.Context, addr sdk.AccAddress) (types.BlockedAddress, bool) {
+	blocked, found := k.getBlockedAddr(ctx, addr)
+	if !found {
+		return types.BlockedAddress{}, false
+	}
+
+	return types.BlockedAddress{Address: addr.String(), Blocked: blocked}, true
 }
 
-// GetBlockedAddresses returns the full list of addresses restricted from receiving funds.
-func (k BaseSendKeeper) GetBlockedAddresses() map[string]bool {
-	return k.blockedAddrs
+// SetBlockedAddr sets the blocked status of an address to the provided value.
+// Since governance can only block more addresses than the app, the entry is
+// deleted instead if the address is unblocked or already blocked by the app,
+// so that only the additions to the addresses blocked by the app are kept in
+// state. An address blocked by the app stays blocked.
+func (k BaseSendKeeper) SetBlockedAddr(ctx context.Context, addr sdk.AccAddress, blocked bool) {
+	if !blocked || k.blockedAddrs[addr.String()] {
+		_ = k.BlockedAddresses.Remove(ctx, addr)
+		return
+	}
+
+	_ = k.BlockedAddresses.Set(ctx, addr, blocked)
+}
+
+// IterateBlockedAddressEntries iterates over all the BlockedAddress entries set by governance.
+func (k BaseSendKeeper) IterateBlockedAddressEntries(ctx context.Context, cb func(addr sdk.AccAddress, blocked bool) bool) {
+	err := k.BlockedAddresses.Walk(ctx, nil, func(key sdk.AccAddress, value bool) (stop bool, err error) {
+		return cb(key, value), nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+}
+
+// GetAllBlockedAddressEntries gets all the BlockedAddress entries that are stored.
+// Any addresses not returned use the status defined by the app.
+func (k BaseSendKeeper) GetAllBlockedAddressEntries(ctx context.Context) []types.BlockedAddress {
+	var rv []types.BlockedAddress
+	k.IterateBlockedAddressEntries(ctx, func(addr sdk.AccAddress, blocked bool) bool {
+		rv = append(rv, types.BlockedAddress{Address: addr.String(), Blocked: blocked})
+		return false
+	})
+
+	return rv
+}
+
+// getBlockedAddr returns whether an address is blocked and whether that flag
+// was set by governance for the address.
+func (k BaseSendKeeper) getBlockedAddr(ctx context.Context, addr sdk.AccAddress) (bool, bool) {
+	v, err := k.BlockedAddresses.Get(ctx, addr)
+	if err != nil {
+		return false, false
+	}
+
+	return v, true
 }
 
 // IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
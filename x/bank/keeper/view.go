@@ -65,27 +65,29 @@ type BaseViewKeeper struct {
 	ak           types.AccountKeeper
 	logger       log.Logger
 
-	Schema        collections.Schema
-	Supply        collections.Map[string, math.Int]
-	DenomMetadata collections.Map[string, types.Metadata]
-	SendEnabled   collections.Map[string, bool]
-	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
-	Params        collections.Item[types.Params]
+	Schema           collections.Schema
+	Supply           collections.Map[string, math.Int]
+	DenomMetadata    collections.Map[string, types.Metadata]
+	SendEnabled      collections.Map[string, bool]
+	BlockedAddresses collections.Map[sdk.AccAddress, bool]
+	Balances         *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
+	Params           collections.Item[types.Params]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak types.AccountKeeper, logger log.Logger) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := BaseViewKeeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		ak:            ak,
-		logger:        logger,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:              cdc,
+		storeService:     storeService,
+		ak:               ak,
+		logger:           logger,
+		Supply:           collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:    collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:      collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		BlockedAddresses: collections.NewMap(sb, types.BlockedAddressesPrefix, "blocked_addresses", sdk.AccAddressKey, codec.BoolValue),
+		Balances:         collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), newBalancesIndexes(sb)),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
 
 	schema, err := sb.Build()
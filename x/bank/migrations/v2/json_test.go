@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"blocked_addresses": [],
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
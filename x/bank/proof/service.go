@@ -0,0 +1,117 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// abciQueryFn defines the function used to run ABCI queries against the
+// committed multistore, usually the Query method of the CometBFT ABCI wrapper
+// of the application.
+type abciQueryFn = func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+
+var balanceKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+var _ types.ProofServiceServer = proofServer{}
+
+// proofServer implements the bank ProofService by querying the bank store
+// with proofs through ABCI.
+type proofServer struct {
+	addressCodec address.Codec
+	queryFn      abciQueryFn
+}
+
+// NewProofServer creates a new bank ProofService server.
+func NewProofServer(addressCodec address.Codec, queryFn abciQueryFn) types.ProofServiceServer {
+	return proofServer{
+		addressCodec: addressCodec,
+		queryFn:      queryFn,
+	}
+}
+
+// BalanceProof implements the ProofService/BalanceProof gRPC method.
+func (s proofServer) BalanceProof(ctx context.Context, req *types.QueryBalanceProofRequest) (*types.QueryBalanceProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	addr, err := s.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	key, err := BalanceKey(addr, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := s.queryFn(ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   key,
+		Height: req.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to query balance proof: %s", res.Log)
+	}
+
+	amount := math.ZeroInt()
+	if len(res.Value) > 0 {
+		amount, err = types.NewBalanceCompatValueCodec().Decode(res.Value)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode balance: %s", err.Error())
+		}
+	}
+
+	balance := sdk.NewCoin(req.Denom, amount)
+
+	return &types.QueryBalanceProofResponse{
+		Balance:   &balance,
+		Height:    res.Height,
+		StoreName: types.StoreKey,
+		Key:       res.Key,
+		Value:     res.Value,
+		Proof:     res.ProofOps,
+	}, nil
+}
+
+// BalanceKey returns the key of the balance of the given address and denom in
+// the bank store.
+func BalanceKey(addr sdk.AccAddress, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(types.BalancesPrefix, balanceKeyCodec, collections.Join(addr, denom))
+}
+
+// RegisterProofService registers the bank ProofService on the gRPC router.
+func RegisterProofService(server gogogrpc.Server, addressCodec address.Codec, queryFn abciQueryFn) {
+	types.RegisterProofServiceServer(server, NewProofServer(addressCodec, queryFn))
+}
+
+// RegisterGRPCGatewayRoutes mounts the bank ProofService's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = types.RegisterProofServiceHandlerClient(context.Background(), mux, types.NewProofServiceClient(clientConn))
+}
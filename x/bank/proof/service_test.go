@@ -0,0 +1,82 @@
+package proof_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/proof"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBalanceProof(t *testing.T) {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	bankKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("other"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	addr := sdk.AccAddress("addr1_______________")
+	key, err := proof.BalanceKey(addr, "stake")
+	require.NoError(t, err)
+	value, err := types.NewBalanceCompatValueCodec().Encode(math.NewInt(100))
+	require.NoError(t, err)
+
+	ms.GetKVStore(bankKey).Set(key, value)
+	cid := ms.Commit()
+
+	// the multistore expects the query path without the "/store" prefix
+	queryFn := func(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+		req.Path = strings.TrimPrefix(req.Path, "/store")
+		return ms.Query(req), nil
+	}
+
+	ac := addresscodec.NewBech32Codec(sdk.Bech32MainPrefix)
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+	srv := proof.NewProofServer(ac, queryFn)
+	prt := rootmulti.DefaultProofRuntime()
+
+	res, err := srv.BalanceProof(context.Background(), &types.QueryBalanceProofRequest{Address: addrStr, Denom: "stake", Height: cid.Version})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), *res.Balance)
+	require.Equal(t, cid.Version, res.Height)
+	require.Equal(t, types.StoreKey, res.StoreName)
+	require.Equal(t, key, res.Key)
+	require.Equal(t, value, res.Value)
+
+	keyPath := new(merkle.KeyPath).AppendKey([]byte(res.StoreName), merkle.KeyEncodingURL).AppendKey(res.Key, merkle.KeyEncodingHex)
+	require.NoError(t, prt.VerifyValue(res.Proof, cid.Hash, keyPath.String(), res.Value))
+	require.Error(t, prt.VerifyValue(res.Proof, cid.Hash, keyPath.String(), []byte("1000")))
+
+	// no balance for the denom, the proof is an absence proof
+	res, err = srv.BalanceProof(context.Background(), &types.QueryBalanceProofRequest{Address: addrStr, Denom: "atom"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atom", 0), *res.Balance)
+	require.Empty(t, res.Value)
+
+	keyPath = new(merkle.KeyPath).AppendKey([]byte(res.StoreName), merkle.KeyEncodingURL).AppendKey(res.Key, merkle.KeyEncodingHex)
+	require.NoError(t, prt.VerifyAbsence(res.Proof, cid.Hash, keyPath.String()))
+
+	_, err = srv.BalanceProof(context.Background(), &types.QueryBalanceProofRequest{Address: "invalid", Denom: "stake"})
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = srv.BalanceProof(context.Background(), &types.QueryBalanceProofRequest{Address: addrStr, Denom: "0"})
+	require.Error(t, err)
+
+	_, err = srv.BalanceProof(context.Background(), &types.QueryBalanceProofRequest{Address: addrStr, Denom: "stake", Height: -1})
+	require.ErrorContains(t, err, "invalid height")
+}
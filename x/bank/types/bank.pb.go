@@ -141,6 +141,61 @@ func (m *SendEnabled) GetEnabled() bool {
 	return false
 }
 
+// BlockedAddress maps an address to a blocked status (whether it is restricted
+// from receiving funds), set by governance in addition to the addresses blocked
+// by the app.
+type BlockedAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Blocked bool   `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *BlockedAddress) Reset()         { *m = BlockedAddress{} }
+func (m *BlockedAddress) String() string { return proto.CompactTextString(m) }
+func (*BlockedAddress) ProtoMessage()    {}
+func (*BlockedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{2}
+}
+func (m *BlockedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAddress.Merge(m, src)
+}
+func (m *BlockedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAddress proto.InternalMessageInfo
+
+func (m *BlockedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
 // Input models transaction input.
 type Input struct {
 	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -151,7 +206,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{3}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +245,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +287,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +335,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +420,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +508,7 @@ func (m *Metadata) GetURIHash() string {
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
+	proto.RegisterType((*BlockedAddress)(nil), "cosmos.bank.v1beta1.BlockedAddress")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
@@ -463,50 +519,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0xcd, 0x47, 0x2f, 0x65, 0xe0, 0x88, 0xe0, 0x5a, 0x84, 0x13, 0x65, 0x40, 0x69,
-	0xa4, 0x26, 0xb4, 0x6c, 0x59, 0x10, 0x29, 0x5f, 0x19, 0x10, 0xc8, 0x55, 0x85, 0xc4, 0x12, 0x5d,
-	0xe2, 0x23, 0x39, 0xd5, 0xbe, 0xb3, 0x7c, 0xe7, 0xd2, 0xac, 0x4c, 0xa8, 0x13, 0x33, 0x53, 0xc5,
-	0x84, 0x10, 0x43, 0x86, 0xee, 0xac, 0x55, 0xa7, 0x8a, 0x89, 0xa9, 0xa0, 0x74, 0x48, 0xff, 0x0c,
-	0xe4, 0x3b, 0x3b, 0x4d, 0xa5, 0xc2, 0x88, 0xc4, 0x92, 0xbc, 0xf7, 0x7e, 0x3f, 0xbf, 0xf7, 0x7b,
-	0x1f, 0x36, 0xb4, 0xfa, 0x42, 0x7a, 0x42, 0x36, 0x7b, 0x84, 0xef, 0x34, 0x77, 0xd7, 0x7b, 0x54,
-	0x91, 0x75, 0xed, 0x34, 0xfc, 0x40, 0x28, 0x81, 0x6e, 0x18, 0xbc, 0xa1, 0x43, 0x31, 0xbe, 0x52,
-	0x1a, 0x88, 0x81, 0xd0, 0x78, 0x33, 0xb2, 0x0c, 0x75, 0x65, 0xd9, 0x50, 0xbb, 0x06, 0x88, 0x9f,
-	0x33, 0xd0, 0x45, 0x15, 0x49, 0x67, 0x55, 0xfa, 0x82, 0xf1, 0x18, 0xbf, 0x15, 0xe3, 0x9e, 0x1c,
-	0x34, 0x77, 0xd7, 0xa3, 0xbf, 0x18, 0xb8, 0x4e, 0x3c, 0xc6, 0x45, 0x53, 0xff, 0x9a, 0x50, 0xf5,
-	0x13, 0x80, 0xb9, 0x97, 0x24, 0x20, 0x9e, 0x44, 0x4f, 0xe1, 0x92, 0xa4, 0xdc, 0xe9, 0x52, 0x4e,
-	0x7a, 0x2e, 0x75, 0x30, 0xa8, 0x64, 0x6a, 0xc5, 0x8d, 0x4a, 0xe3, 0x0a, 0xcd, 0x8d, 0x2d, 0xca,
-	0x9d, 0xc7, 0x86, 0xd7, 0x4e, 0x63, 0x60, 0x17, 0xe5, 0x45, 0x00, 0xdd, 0x83, 0x25, 0x87, 0xbe,
-	0x21, 0xa1, 0xab, 0xba, 0x97, 0x12, 0xa6, 0x2b, 0xa0, 0x56, 0xb0, 0x51, 0x8c, 0xcd, 0xa5, 0x68,
-	0xdd, 0xd9, 0x9f, 0x8e, 0xeb, 0xd8, 0x14, 0x5a, 0x93, 0xce, 0x4e, 0x73, 0xcf, 0x8c, 0xd0, 0x28,
-	0xab, 0x6e, 0xc2, 0xe2, 0x1c, 0x1b, 0x95, 0x60, 0xd6, 0xa1, 0x5c, 0x78, 0x18, 0x54, 0x40, 0x6d,
-	0xd1, 0x36, 0x0e, 0xc2, 0x30, 0x7f, 0xb9, 0x50, 0xe2, 0xb6, 0x16, 0xce, 0x0f, 0xca, 0xa0, 0x7a,
-	0x0c, 0x60, 0xb6, 0xc3, 0xfd, 0x50, 0xa1, 0x0d, 0x98, 0x27, 0x8e, 0x13, 0x50, 0x29, 0x4d, 0x86,
-	0x36, 0xfe, 0x7e, 0xb8, 0x56, 0x8a, 0xdb, 0x7c, 0x68, 0x90, 0x2d, 0x15, 0x30, 0x3e, 0xb0, 0x13,
-	0x22, 0x7a, 0x0b, 0xb3, 0xd1, 0x84, 0x25, 0x4e, 0xeb, 0xa9, 0x2c, 0x5f, 0x4c, 0x45, 0xd2, 0xd9,
-	0x54, 0x36, 0x05, 0xe3, 0xed, 0x27, 0x47, 0xa7, 0xe5, 0xd4, 0x97, 0x9f, 0xe5, 0xda, 0x80, 0xa9,
-	0x61, 0xd8, 0x6b, 0xf4, 0x85, 0x17, 0xaf, 0xaf, 0x39, 0xd7, 0xa0, 0x1a, 0xf9, 0x54, 0xea, 0x07,
-	0xe4, 0xc7, 0xe9, 0xb8, 0xbe, 0xe4, 0xd2, 0x01, 0xe9, 0x8f, 0xba, 0xba, 0xc6, 0xe7, 0xe9, 0xb8,
-	0x0e, 0x6c, 0x53, 0xaf, 0x55, 0x7a, 0x7f, 0x50, 0x4e, 0x9d, 0x1f, 0x94, 0x53, 0xef, 0xa6, 0xe3,
-	0x7a, 0x22, 0xa7, 0xfa, 0x0d, 0xc0, 0xdc, 0x8b, 0x50, 0xfd, 0x77, 0xdd, 0x14, 0x92, 0x6e, 0xaa,
-	0x5f, 0x01, 0xcc, 0x6d, 0x85, 0xbe, 0xef, 0x8e, 0x22, 0x35, 0x4a, 0x28, 0xe2, 0xc6, 0x17, 0xf7,
-	0x2f, 0xd4, 0xe8, 0x7a, 0xad, 0xd5, 0x58, 0x0d, 0x38, 0x3e, 0x5c, 0xbb, 0x7d, 0xe5, 0x99, 0x6b,
-	0x81, 0x1d, 0x0c, 0xaa, 0xaf, 0xe0, 0xe2, 0xa3, 0xe8, 0xcc, 0xb6, 0x39, 0x53, 0x7f, 0x38, 0xc0,
-	0x15, 0x58, 0xa0, 0x7b, 0xbe, 0xe0, 0x94, 0x2b, 0x7d, 0x81, 0xd7, 0xec, 0x99, 0x1f, 0x1d, 0x27,
-	0x71, 0x19, 0x91, 0x54, 0xe2, 0x4c, 0x25, 0x53, 0x5b, 0xb4, 0x13, 0xb7, 0xba, 0x9f, 0x86, 0x85,
-	0xe7, 0x54, 0x11, 0x87, 0x28, 0x82, 0x2a, 0xb0, 0xe8, 0x50, 0xd9, 0x0f, 0x98, 0xaf, 0x98, 0xe0,
-	0x71, 0xfa, 0xf9, 0x10, 0x7a, 0x10, 0x31, 0xb8, 0xf0, 0xba, 0x21, 0x67, 0x2a, 0xd9, 0x9f, 0x75,
-	0xe5, 0x3b, 0x3a, 0xd3, 0x6b, 0x43, 0x27, 0x31, 0x25, 0x42, 0x70, 0x21, 0x9a, 0x2b, 0xce, 0xe8,
-	0xdc, 0xda, 0x8e, 0xd4, 0x39, 0x4c, 0xfa, 0x2e, 0x19, 0xe1, 0x05, 0x1d, 0x4e, 0xdc, 0x88, 0xcd,
-	0x89, 0x47, 0x71, 0xd6, 0xb0, 0x23, 0x1b, 0xdd, 0x84, 0x39, 0x39, 0xf2, 0x7a, 0xc2, 0xc5, 0x39,
-	0x1d, 0x8d, 0x3d, 0xb4, 0x0c, 0x33, 0x61, 0xc0, 0x70, 0x5e, 0x1f, 0x61, 0x7e, 0x72, 0x5a, 0xce,
-	0x6c, 0xdb, 0x1d, 0x3b, 0x8a, 0xa1, 0xbb, 0xb0, 0x10, 0x06, 0xac, 0x3b, 0x24, 0x72, 0x88, 0x0b,
-	0x1a, 0x2f, 0x4e, 0x4e, 0xcb, 0xf9, 0x6d, 0xbb, 0xf3, 0x8c, 0xc8, 0xa1, 0x9d, 0x0f, 0x03, 0x16,
-	0x19, 0xed, 0xcd, 0xa3, 0x89, 0x05, 0x4e, 0x26, 0x16, 0xf8, 0x35, 0xb1, 0xc0, 0x87, 0x33, 0x2b,
-	0x75, 0x72, 0x66, 0xa5, 0x7e, 0x9c, 0x59, 0xa9, 0xd7, 0xab, 0x7f, 0xdd, 0x78, 0xfc, 0xb9, 0xd0,
-	0x8b, 0xef, 0xe5, 0xf4, 0x97, 0xed, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x47, 0xd8,
-	0x49, 0x8d, 0x05, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3d, 0x6f, 0x13, 0x4b,
+	0x14, 0xf5, 0xd8, 0xf1, 0x47, 0xc6, 0x79, 0x4f, 0x7a, 0xfb, 0x2c, 0x98, 0x04, 0xb1, 0xb6, 0x5c,
+	0x20, 0xc7, 0x52, 0x6c, 0x12, 0x3a, 0x37, 0x08, 0x87, 0x2f, 0x17, 0x08, 0xb4, 0x51, 0x84, 0x44,
+	0x63, 0x8d, 0xbd, 0x83, 0xbd, 0xca, 0xee, 0xcc, 0x6a, 0x67, 0x36, 0xc4, 0x2d, 0x15, 0x4a, 0x45,
+	0x4d, 0x15, 0x51, 0x21, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x2a, 0xa2, 0xa2, 0x0a, 0xc8, 0x29,
+	0x9c, 0x9f, 0x81, 0xe6, 0x63, 0x1d, 0x47, 0x0a, 0x14, 0x14, 0x48, 0x34, 0xc9, 0xbd, 0xf7, 0x9c,
+	0xbd, 0xe7, 0xcc, 0x9d, 0xeb, 0x81, 0x76, 0x9f, 0xf1, 0x80, 0xf1, 0x66, 0x0f, 0xd3, 0x9d, 0xe6,
+	0xee, 0x7a, 0x8f, 0x08, 0xbc, 0xae, 0x92, 0x46, 0x18, 0x31, 0xc1, 0xac, 0xff, 0x35, 0xde, 0x50,
+	0x25, 0x83, 0xaf, 0x94, 0x06, 0x6c, 0xc0, 0x14, 0xde, 0x94, 0x91, 0xa6, 0xae, 0x2c, 0x6b, 0x6a,
+	0x57, 0x03, 0xe6, 0x3b, 0x0d, 0x5d, 0xa8, 0x70, 0x32, 0x53, 0xe9, 0x33, 0x8f, 0x1a, 0xfc, 0xba,
+	0xc1, 0x03, 0x3e, 0x68, 0xee, 0xae, 0xcb, 0x7f, 0x06, 0xf8, 0x0f, 0x07, 0x1e, 0x65, 0x4d, 0xf5,
+	0x57, 0x97, 0xaa, 0xef, 0x01, 0xcc, 0x3d, 0xc3, 0x11, 0x0e, 0xb8, 0xf5, 0x08, 0x2e, 0x71, 0x42,
+	0xdd, 0x2e, 0xa1, 0xb8, 0xe7, 0x13, 0x17, 0x81, 0x4a, 0xa6, 0x56, 0xdc, 0xa8, 0x34, 0xae, 0xf0,
+	0xdc, 0xd8, 0x22, 0xd4, 0x7d, 0xa0, 0x79, 0xed, 0x34, 0x02, 0x4e, 0x91, 0x5f, 0x14, 0xac, 0xdb,
+	0xb0, 0xe4, 0x92, 0x97, 0x38, 0xf6, 0x45, 0xf7, 0x52, 0xc3, 0x74, 0x05, 0xd4, 0x0a, 0x8e, 0x65,
+	0xb0, 0xb9, 0x16, 0xad, 0x9b, 0xfb, 0xd3, 0x71, 0x1d, 0x69, 0xa1, 0x35, 0xee, 0xee, 0x34, 0xf7,
+	0xf4, 0x08, 0xb5, 0xb3, 0xea, 0x26, 0x2c, 0xce, 0xb1, 0xad, 0x12, 0xcc, 0xba, 0x84, 0xb2, 0x00,
+	0x81, 0x0a, 0xa8, 0x2d, 0x3a, 0x3a, 0xb1, 0x10, 0xcc, 0x5f, 0x16, 0x4a, 0xd2, 0xd6, 0xc2, 0xf9,
+	0x41, 0x19, 0x54, 0x5d, 0xf8, 0x6f, 0xdb, 0x67, 0xfd, 0x1d, 0xe2, 0xde, 0x73, 0xdd, 0x88, 0x70,
+	0x6e, 0x6d, 0xc0, 0x3c, 0xd6, 0xa1, 0xee, 0xd4, 0x46, 0x5f, 0x0e, 0xd7, 0x4a, 0xe6, 0xb8, 0x86,
+	0xb4, 0x25, 0x22, 0x8f, 0x0e, 0x9c, 0x84, 0x28, 0x55, 0x7a, 0xba, 0x4b, 0xa2, 0x62, 0x52, 0xa3,
+	0x72, 0x0c, 0x60, 0xb6, 0x43, 0xc3, 0x58, 0xfc, 0x56, 0xf7, 0x57, 0x30, 0x2b, 0xef, 0x91, 0xa3,
+	0xb4, 0x9a, 0xfd, 0xf2, 0xc5, 0xec, 0x39, 0x99, 0xcd, 0x7e, 0x93, 0x79, 0xb4, 0xfd, 0xf0, 0xe8,
+	0xb4, 0x9c, 0xfa, 0xf8, 0xad, 0x5c, 0x1b, 0x78, 0x62, 0x18, 0xf7, 0x1a, 0x7d, 0x16, 0x98, 0x25,
+	0x69, 0xce, 0x8d, 0x51, 0x8c, 0x42, 0xc2, 0xd5, 0x07, 0xfc, 0xdd, 0x74, 0x5c, 0x5f, 0xf2, 0xc9,
+	0x00, 0xf7, 0x47, 0x5d, 0xa5, 0xf1, 0x61, 0x3a, 0xae, 0x03, 0x47, 0xeb, 0xb5, 0x4a, 0x6f, 0x0e,
+	0xca, 0xa9, 0xf3, 0x83, 0x72, 0xea, 0xf5, 0x74, 0x5c, 0x4f, 0xec, 0x54, 0x3f, 0x03, 0x98, 0x7b,
+	0x1a, 0x8b, 0xbf, 0xee, 0x34, 0x85, 0xe4, 0x34, 0xd5, 0x4f, 0x00, 0xe6, 0xb6, 0xe2, 0x30, 0xf4,
+	0x47, 0xd2, 0x8d, 0x60, 0x02, 0xfb, 0x08, 0xfc, 0x31, 0x37, 0x4a, 0xaf, 0xb5, 0x6a, 0xdc, 0x80,
+	0xe3, 0xc3, 0xb5, 0x1b, 0x57, 0xfe, 0x98, 0x94, 0xc1, 0x0e, 0x02, 0xd5, 0xe7, 0x70, 0xf1, 0xbe,
+	0x5c, 0xe6, 0x6d, 0xea, 0x89, 0x9f, 0xac, 0xf9, 0x0a, 0x2c, 0x90, 0xbd, 0x90, 0x51, 0x42, 0x85,
+	0xda, 0xc0, 0x7f, 0x9c, 0x59, 0x2e, 0x97, 0x13, 0xfb, 0x1e, 0xe6, 0x84, 0xa3, 0x4c, 0x25, 0x53,
+	0x5b, 0x74, 0x92, 0xb4, 0xba, 0x9f, 0x86, 0x85, 0x27, 0x44, 0x60, 0x17, 0x0b, 0x6c, 0x55, 0x60,
+	0xd1, 0x25, 0xbc, 0x1f, 0x79, 0xa1, 0xf0, 0x18, 0x35, 0xed, 0xe7, 0x4b, 0xd6, 0x5d, 0xc9, 0xa0,
+	0x2c, 0xe8, 0xc6, 0xd4, 0x13, 0xc9, 0xfd, 0xd9, 0x57, 0xbe, 0x04, 0x33, 0xbf, 0x0e, 0x74, 0x93,
+	0x90, 0x5b, 0x16, 0x5c, 0x90, 0x73, 0x45, 0x19, 0xd5, 0x5b, 0xc5, 0xd2, 0x9d, 0xeb, 0xf1, 0xd0,
+	0xc7, 0x23, 0xb4, 0xa0, 0xca, 0x49, 0x2a, 0xd9, 0x14, 0x07, 0x04, 0x65, 0x35, 0x5b, 0xc6, 0xd6,
+	0x35, 0x98, 0xe3, 0xa3, 0xa0, 0xc7, 0x7c, 0x94, 0x53, 0x55, 0x93, 0x59, 0xcb, 0x30, 0x13, 0x47,
+	0x1e, 0xca, 0xab, 0x25, 0xcc, 0x4f, 0x4e, 0xcb, 0x99, 0x6d, 0xa7, 0xe3, 0xc8, 0x9a, 0x75, 0x0b,
+	0x16, 0xe2, 0xc8, 0xeb, 0x0e, 0x31, 0x1f, 0xa2, 0x82, 0xc2, 0x8b, 0x93, 0xd3, 0x72, 0x7e, 0xdb,
+	0xe9, 0x3c, 0xc6, 0x7c, 0xe8, 0xe4, 0xe3, 0xc8, 0x93, 0x41, 0x7b, 0xf3, 0x68, 0x62, 0x83, 0x93,
+	0x89, 0x0d, 0xbe, 0x4f, 0x6c, 0xf0, 0xf6, 0xcc, 0x4e, 0x9d, 0x9c, 0xd9, 0xa9, 0xaf, 0x67, 0x76,
+	0xea, 0xc5, 0xea, 0x2f, 0x6f, 0xdc, 0x3c, 0x4a, 0xea, 0xe2, 0x7b, 0x39, 0xf5, 0x7e, 0xde, 0xf9,
+	0x31, 0x00, 0xd1, 0x2c, 0x84, 0xfa, 0xf3, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -536,6 +593,33 @@ func (this *SendEnabled) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BlockedAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockedAddress)
+	if !ok {
+		that2, ok := that.(BlockedAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Blocked != that1.Blocked {
+		return false
+	}
+	return true
+}
 func (this *Supply) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -652,6 +736,46 @@ func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -952,6 +1076,22 @@ func (m *SendEnabled) Size() (n int) {
 	return n
 }
 
+func (m *BlockedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
 func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1282,6 +1422,108 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
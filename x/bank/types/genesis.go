@@ -21,6 +21,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	seenSendEnabled := make(map[string]bool)
+	seenBlockedAddresses := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
@@ -36,6 +37,16 @@ func (gs GenesisState) Validate() error {
 		seenSendEnabled[p.Denom] = true
 	}
 
+	for _, ba := range gs.BlockedAddresses {
+		if _, exists := seenBlockedAddresses[ba.Address]; exists {
+			return fmt.Errorf("duplicate blocked address found: '%s'", ba.Address)
+		}
+		if err := ba.Validate(); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", ba.Address, err)
+		}
+		seenBlockedAddresses[ba.Address] = true
+	}
+
 	for _, balance := range gs.Balances {
 		if seenBalances[balance.Address] {
 			return fmt.Errorf("duplicate balance for address %s", balance.Address)
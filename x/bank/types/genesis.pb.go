@@ -42,6 +42,9 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// blocked_addresses defines the addresses which are blocked by governance, in
+	// addition to the addresses blocked by the app.
+	BlockedAddresses []BlockedAddress `protobuf:"bytes,6,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +115,13 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetBlockedAddresses() []BlockedAddress {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -162,36 +172,38 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0x54,
-	0xaa, 0xad, 0x86, 0x8d, 0x01, 0x09, 0x47, 0xc0, 0xc4, 0x0f, 0x35, 0x1b, 0x4b, 0x74, 0xf6, 0x3d,
-	0x19, 0xab, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x1f, 0x30, 0x32, 0x33, 0x75, 0x44, 0x2c, 0x74,
-	0xe0, 0x0f, 0x60, 0xec, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x67, 0x20, 0xdf, 0x5d, 0x5d,
-	0x4b, 0x58, 0x8c, 0x5d, 0x12, 0xeb, 0xbe, 0xdf, 0xf7, 0xf9, 0xbe, 0xf7, 0xee, 0xc8, 0xdd, 0x44,
-	0x62, 0x2e, 0x31, 0x8c, 0x99, 0x38, 0x08, 0xdf, 0xec, 0xc5, 0xa0, 0xd8, 0x5e, 0x98, 0x82, 0x00,
-	0xcc, 0x30, 0x28, 0x66, 0x52, 0x49, 0x7a, 0xd3, 0x58, 0x82, 0xd2, 0x12, 0x58, 0xcb, 0xd6, 0x46,
-	0x2a, 0x53, 0xa9, 0xf5, 0xb0, 0xfc, 0x32, 0xd6, 0x2d, 0xbf, 0xa2, 0x21, 0x54, 0xb4, 0x44, 0x66,
-	0xe2, 0x1f, 0xbd, 0x96, 0xa6, 0xb9, 0x46, 0xdf, 0x34, 0xfa, 0xc4, 0x80, 0x6d, 0xae, 0x91, 0x6e,
-	0xb0, 0x3c, 0x13, 0x32, 0xd4, 0xbf, 0xe6, 0x68, 0xfb, 0x4b, 0x8b, 0x74, 0x9f, 0x9a, 0x56, 0xc7,
-	0x8a, 0x29, 0xa0, 0x0f, 0x49, 0xbb, 0x60, 0x33, 0x96, 0xa3, 0xe7, 0xf6, 0xdd, 0x41, 0x67, 0x78,
-	0x2b, 0x68, 0x68, 0x3d, 0x78, 0xa9, 0x2d, 0xd1, 0xfa, 0xc9, 0xcf, 0x9e, 0xf3, 0xe9, 0xec, 0x78,
-	0xc7, 0xdd, 0xb7, 0x55, 0x74, 0x44, 0xd6, 0x62, 0x36, 0x65, 0x22, 0x01, 0xf4, 0xae, 0xf4, 0x5b,
-	0x83, 0xce, 0xf0, 0x76, 0x23, 0x21, 0x32, 0xa6, 0x3a, 0xa2, 0x2a, 0xa4, 0x73, 0xd2, 0xc6, 0xc3,
-	0xa2, 0x98, 0xce, 0xbd, 0x96, 0x46, 0x6c, 0x5e, 0x20, 0x10, 0x2a, 0xc4, 0x48, 0x66, 0x22, 0x7a,
-	0x52, 0xd6, 0x7f, 0xfe, 0xd5, 0x1b, 0xa4, 0x99, 0x7a, 0x7d, 0x18, 0x07, 0x89, 0xcc, 0xed, 0xd0,
-	0xf6, 0x6f, 0x17, 0xf9, 0x41, 0xa8, 0xe6, 0x05, 0xa0, 0x2e, 0xc0, 0x8f, 0x67, 0xc7, 0x3b, 0xdd,
-	0x29, 0xa4, 0x2c, 0x99, 0x4f, 0xca, 0xb5, 0xa2, 0xed, 0xdf, 0x04, 0xd2, 0x17, 0xe4, 0x3a, 0x07,
-	0x21, 0xf3, 0x49, 0x0e, 0x8a, 0x71, 0xa6, 0x98, 0x77, 0x55, 0xb7, 0x70, 0xa7, 0x71, 0x8a, 0x67,
-	0xd6, 0x54, 0x1f, 0xe3, 0x9a, 0xae, 0x3f, 0x57, 0xe8, 0x73, 0xd2, 0x45, 0x10, 0x7c, 0x02, 0x82,
-	0xc5, 0x53, 0xe0, 0xde, 0x8a, 0xc6, 0xf5, 0x1b, 0x71, 0x63, 0x10, 0xfc, 0xb1, 0xf1, 0xd5, 0x89,
-	0x1d, 0xbc, 0x38, 0xdf, 0xfe, 0xe6, 0x92, 0x55, 0xbb, 0x3c, 0x3a, 0x24, 0xab, 0x8c, 0xf3, 0x19,
-	0xa0, 0xb9, 0xad, 0xf5, 0xc8, 0xfb, 0xfe, 0x75, 0x77, 0xc3, 0x92, 0x1f, 0x19, 0x65, 0xac, 0x66,
-	0x99, 0x48, 0xf7, 0xcf, 0x8d, 0xf4, 0x2d, 0x59, 0xd1, 0x63, 0xdb, 0xdb, 0xb9, 0x84, 0xd5, 0x9a,
-	0xbc, 0x07, 0x6b, 0xef, 0x8f, 0x7a, 0xce, 0x9f, 0xa3, 0x9e, 0x13, 0x8d, 0x4e, 0x16, 0xbe, 0x7b,
-	0xba, 0xf0, 0xdd, 0xdf, 0x0b, 0xdf, 0xfd, 0xb0, 0xf4, 0x9d, 0xd3, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0xab, 0x7b, 0xff, 0x8d, 0x7a, 0x67, 0x1e, 0xbd, 0x4e, 0x8c, 0xdb, 0xfa, 0x01, 0xdf, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x65, 0xc7, 0x6b, 0x7e, 0x03, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xfe, 0x49, 0xdb, 0x4b, 0x40, 0xd4, 0x74, 0x70, 0x0b, 0x38, 0xa1, 0x2c, 0xa1,
+	0x52, 0x6d, 0x35, 0x6c, 0x0c, 0x48, 0x38, 0x02, 0x26, 0xfe, 0xa8, 0xd9, 0x60, 0xb0, 0xce, 0xbe,
+	0x57, 0xc6, 0x8a, 0x7d, 0x67, 0xe5, 0xbd, 0x02, 0xf9, 0x06, 0x0c, 0x0c, 0xcc, 0x4c, 0x1d, 0x11,
+	0x53, 0x07, 0x3e, 0x00, 0x63, 0xc7, 0x8a, 0x89, 0x09, 0x50, 0x32, 0x94, 0x8f, 0x81, 0x72, 0x77,
+	0x4d, 0x8c, 0xb0, 0x18, 0x59, 0x6c, 0xeb, 0x9e, 0xe7, 0xfd, 0x3d, 0xef, 0xbd, 0xe7, 0x23, 0x37,
+	0x13, 0x81, 0x85, 0xc0, 0x20, 0xa6, 0x7c, 0x18, 0xbc, 0x3a, 0x88, 0x41, 0xd2, 0x83, 0x20, 0x05,
+	0x0e, 0x98, 0xa1, 0x5f, 0x8e, 0x84, 0x14, 0xce, 0x55, 0x6d, 0xf1, 0x67, 0x16, 0xdf, 0x58, 0x76,
+	0xb6, 0x52, 0x91, 0x0a, 0xa5, 0x07, 0xb3, 0x2f, 0x6d, 0xdd, 0xf1, 0xe6, 0x34, 0x84, 0x39, 0x2d,
+	0x11, 0x19, 0xff, 0x4b, 0xaf, 0xa4, 0x29, 0xae, 0xd6, 0xb7, 0xb5, 0x1e, 0x69, 0xb0, 0xc9, 0xd5,
+	0xd2, 0x26, 0x2d, 0x32, 0x2e, 0x02, 0xf5, 0xd4, 0x4b, 0xbb, 0xef, 0x56, 0x48, 0xeb, 0x91, 0x6e,
+	0x75, 0x20, 0xa9, 0x04, 0xe7, 0x1e, 0x69, 0x94, 0x74, 0x44, 0x0b, 0x74, 0xed, 0x8e, 0xdd, 0x6d,
+	0xf6, 0xae, 0xf9, 0x35, 0xad, 0xfb, 0xcf, 0x94, 0x25, 0xdc, 0x38, 0xfd, 0xde, 0xb6, 0x3e, 0x9e,
+	0x9f, 0xec, 0xd9, 0x87, 0xa6, 0xca, 0xe9, 0x93, 0xf5, 0x98, 0xe6, 0x94, 0x27, 0x80, 0xee, 0x52,
+	0x67, 0xb9, 0xdb, 0xec, 0x5d, 0xaf, 0x25, 0x84, 0xda, 0x54, 0x45, 0xcc, 0x0b, 0x9d, 0x31, 0x69,
+	0xe0, 0x51, 0x59, 0xe6, 0x63, 0x77, 0x59, 0x21, 0xb6, 0x17, 0x08, 0x84, 0x39, 0xa2, 0x2f, 0x32,
+	0x1e, 0x3e, 0x9c, 0xd5, 0x7f, 0xfa, 0xd1, 0xee, 0xa6, 0x99, 0x7c, 0x79, 0x14, 0xfb, 0x89, 0x28,
+	0xcc, 0xa6, 0xcd, 0x6b, 0x1f, 0xd9, 0x30, 0x90, 0xe3, 0x12, 0x50, 0x15, 0xe0, 0x87, 0xf3, 0x93,
+	0xbd, 0x56, 0x0e, 0x29, 0x4d, 0xc6, 0xd1, 0x6c, 0xac, 0x68, 0xfa, 0xd7, 0x81, 0xce, 0x53, 0x72,
+	0x99, 0x01, 0x17, 0x45, 0x54, 0x80, 0xa4, 0x8c, 0x4a, 0xea, 0xae, 0xa8, 0x16, 0x6e, 0xd4, 0xee,
+	0xe2, 0xb1, 0x31, 0x55, 0xb7, 0x71, 0x49, 0xd5, 0x5f, 0x28, 0xce, 0x13, 0xd2, 0x42, 0xe0, 0x2c,
+	0x02, 0x4e, 0xe3, 0x1c, 0x98, 0xbb, 0xaa, 0x70, 0x9d, 0x5a, 0xdc, 0x00, 0x38, 0x7b, 0xa0, 0x7d,
+	0x55, 0x62, 0x13, 0x17, 0xeb, 0xce, 0x0b, 0xb2, 0x19, 0xe7, 0x22, 0x19, 0x02, 0x8b, 0x28, 0x63,
+	0x23, 0x40, 0x04, 0x74, 0x1b, 0x0a, 0x7a, 0xab, 0x7e, 0xd2, 0xda, 0x7d, 0x5f, 0x9b, 0xab, 0xdc,
+	0x2b, 0xf1, 0x1f, 0x12, 0xe0, 0xee, 0x17, 0x9b, 0xac, 0x99, 0x93, 0x71, 0x7a, 0x64, 0xcd, 0x04,
+	0xa8, 0x5f, 0x61, 0x23, 0x74, 0xbf, 0x7e, 0xde, 0xdf, 0x32, 0x09, 0xa6, 0x64, 0x20, 0x47, 0x19,
+	0x4f, 0x0f, 0x2f, 0x8c, 0xce, 0x6b, 0xb2, 0xaa, 0x66, 0xea, 0x2e, 0xfd, 0xaf, 0x73, 0xd3, 0x79,
+	0x77, 0xd7, 0xdf, 0x1e, 0xb7, 0xad, 0x5f, 0xc7, 0x6d, 0x2b, 0xec, 0x9f, 0x4e, 0x3c, 0xfb, 0x6c,
+	0xe2, 0xd9, 0x3f, 0x27, 0x9e, 0xfd, 0x7e, 0xea, 0x59, 0x67, 0x53, 0xcf, 0xfa, 0x36, 0xf5, 0xac,
+	0xe7, 0xb7, 0xff, 0x19, 0xf5, 0x46, 0xdf, 0x28, 0x95, 0x18, 0x37, 0xd4, 0xed, 0xb8, 0xf3, 0x7b,
+	0x00, 0x5c, 0x2a, 0x29, 0xa5, 0xdb, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +226,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +396,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, e := range m.BlockedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -596,6 +628,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, BlockedAddress{})
+			if err := m.BlockedAddresses[len(m.BlockedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -135,6 +135,25 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup blocked addresses",
+			GenesisState{
+				BlockedAddresses: []BlockedAddress{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Blocked: true},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Blocked: false},
+				},
+			},
+			true,
+		},
+		{
+			"invalid blocked address",
+			GenesisState{
+				BlockedAddresses: []BlockedAddress{
+					{Address: "invalid", Blocked: true},
+				},
+			},
+			true,
+		},
 		{
 			"invalid supply",
 			GenesisState{
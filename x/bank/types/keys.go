@@ -31,6 +31,10 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// BlockedAddressesPrefix is the prefix for the blocked flags of addresses
+	// set by governance.
+	BlockedAddressesPrefix = collections.NewPrefix(6)
 )
 
 // NewBalanceCompatValueCodec is a codec for encoding Balances in a backwards compatible way
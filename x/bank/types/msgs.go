@@ -85,3 +85,41 @@ func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgAddBlockedAddresses constructs a message to block one or more addresses.
+func NewMsgAddBlockedAddresses(authority string, addresses []string) *MsgAddBlockedAddresses {
+	return &MsgAddBlockedAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAddBlockedAddresses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgAddBlockedAddresses.
+func (msg MsgAddBlockedAddresses) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveBlockedAddresses constructs a message to unblock one or more addresses.
+func NewMsgRemoveBlockedAddresses(authority string, addresses []string) *MsgRemoveBlockedAddresses {
+	return &MsgRemoveBlockedAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveBlockedAddresses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgRemoveBlockedAddresses.
+func (msg MsgRemoveBlockedAddresses) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
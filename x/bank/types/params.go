@@ -48,6 +48,12 @@ func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
 	}
 }
 
+// Validate gets any errors with this BlockedAddress entry.
+func (ba BlockedAddress) Validate() error {
+	_, err := sdk.AccAddressFromBech32(ba.Address)
+	return err
+}
+
 // validateIsBool is used by the x/params module to validate that a thing is a bool.
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/bank/v1beta1/proof.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBalanceProofRequest is the request type for the ProofService/BalanceProof RPC method.
+type QueryBalanceProofRequest struct {
+	// address is the address to query the balance for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the coin denom to query the balance for.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the block height to query the balance at. The latest height is
+	// used if it is zero.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBalanceProofRequest) Reset()         { *m = QueryBalanceProofRequest{} }
+func (m *QueryBalanceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceProofRequest) ProtoMessage()    {}
+func (*QueryBalanceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05123bd345e25700, []int{0}
+}
+func (m *QueryBalanceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceProofRequest.Merge(m, src)
+}
+func (m *QueryBalanceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceProofRequest proto.InternalMessageInfo
+
+// QueryBalanceProofResponse is the response type for the ProofService/BalanceProof RPC method.
+type QueryBalanceProofResponse struct {
+	// balance is the balance of the coin at the queried height.
+	Balance *types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// height is the block height the balance and the proof were queried at. The
+	// proof must be verified against the app hash of the block at height + 1.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// store_name is the name of the bank store in the multistore.
+	StoreName string `protobuf:"bytes,3,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// key is the key of the balance in the bank store.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the raw value of the balance in the bank store. It is empty if
+	// the account has no balance for the denom, in which case the proof is an
+	// absence proof.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// proof contains the IAVL proof of the key in the bank store followed by the
+	// proof of the bank store root in the multistore commitment.
+	Proof *crypto.ProofOps `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBalanceProofResponse) Reset()         { *m = QueryBalanceProofResponse{} }
+func (m *QueryBalanceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceProofResponse) ProtoMessage()    {}
+func (*QueryBalanceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05123bd345e25700, []int{1}
+}
+func (m *QueryBalanceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceProofResponse.Merge(m, src)
+}
+func (m *QueryBalanceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceProofResponse proto.InternalMessageInfo
+
+func (m *QueryBalanceProofResponse) GetBalance() *types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryBalanceProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBalanceProofResponse) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *QueryBalanceProofResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryBalanceProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryBalanceProofResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBalanceProofRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceProofRequest")
+	proto.RegisterType((*QueryBalanceProofResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceProofResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/bank/v1beta1/proof.proto", fileDescriptor_05123bd345e25700) }
+
+var fileDescriptor_05123bd345e25700 = []byte{
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x8f, 0xd3, 0x4e,
+	0x10, 0xcd, 0x26, 0xbf, 0xe4, 0x7e, 0x59, 0x52, 0xa0, 0x25, 0x42, 0x4e, 0xe0, 0x9c, 0x28, 0x55,
+	0x28, 0xb2, 0xab, 0xe4, 0x24, 0x84, 0x28, 0x90, 0xc8, 0xf5, 0xfc, 0xf1, 0x75, 0x34, 0xd1, 0xda,
+	0x1e, 0x1c, 0x2b, 0xf1, 0xae, 0xf1, 0x6e, 0x22, 0x2c, 0x44, 0x43, 0x81, 0x28, 0x91, 0xf8, 0x02,
+	0xf7, 0x05, 0xe8, 0xa8, 0xa9, 0x29, 0x4f, 0xd0, 0x50, 0xa2, 0x84, 0x82, 0x8f, 0x81, 0xbc, 0xeb,
+	0x84, 0x14, 0x41, 0xa2, 0xf2, 0xce, 0xbc, 0xe7, 0x79, 0x33, 0x6f, 0x06, 0xf7, 0x02, 0xa9, 0x12,
+	0xa9, 0x98, 0xcf, 0xc5, 0x82, 0xad, 0xc7, 0x3e, 0x68, 0x3e, 0x66, 0x69, 0x26, 0xe5, 0x73, 0x9a,
+	0x66, 0x52, 0x4b, 0x72, 0xc3, 0x12, 0x68, 0x41, 0xa0, 0x25, 0xa1, 0xdb, 0x8e, 0x64, 0x24, 0x0d,
+	0xce, 0x8a, 0x97, 0xa5, 0x76, 0x6f, 0x47, 0x52, 0x46, 0x4b, 0x60, 0x3c, 0x8d, 0x19, 0x17, 0x42,
+	0x6a, 0xae, 0x63, 0x29, 0x54, 0x89, 0xba, 0x7b, 0x25, 0x05, 0x7b, 0xa5, 0x40, 0xc6, 0xa2, 0xc4,
+	0x3b, 0x16, 0x9f, 0xd9, 0xb2, 0xa5, 0xaa, 0x85, 0x4e, 0x35, 0x88, 0x10, 0xb2, 0x24, 0x16, 0x9a,
+	0x05, 0x59, 0x9e, 0x6a, 0x79, 0xd8, 0xe2, 0xe0, 0x2d, 0xc2, 0xce, 0xd3, 0x15, 0x64, 0xf9, 0x94,
+	0x2f, 0xb9, 0x08, 0xe0, 0x49, 0x81, 0x79, 0xf0, 0x62, 0x05, 0x4a, 0x93, 0x09, 0x3e, 0xe1, 0x61,
+	0x98, 0x81, 0x52, 0x0e, 0xea, 0xa3, 0x61, 0x73, 0xea, 0x7c, 0xfd, 0x34, 0x6a, 0x97, 0xe5, 0x1f,
+	0x5a, 0xe4, 0x42, 0x67, 0xb1, 0x88, 0xbc, 0x1d, 0x91, 0xb4, 0x71, 0x3d, 0x04, 0x21, 0x13, 0xa7,
+	0x5a, 0xfc, 0xe1, 0xd9, 0x80, 0xdc, 0xc4, 0x8d, 0x39, 0xc4, 0xd1, 0x5c, 0x3b, 0xb5, 0x3e, 0x1a,
+	0xd6, 0xbc, 0x32, 0xba, 0xff, 0xff, 0xbb, 0xcb, 0x5e, 0xe5, 0xd7, 0x65, 0xaf, 0x32, 0xd8, 0x20,
+	0xdc, 0x39, 0xd2, 0x88, 0x4a, 0xa5, 0x50, 0x40, 0xce, 0xf0, 0x89, 0x6f, 0xf3, 0xa6, 0x93, 0x6b,
+	0x93, 0x0e, 0xdd, 0x7b, 0xab, 0x60, 0xe7, 0x2d, 0x3d, 0x97, 0xb1, 0xf0, 0x76, 0xcc, 0x03, 0xd1,
+	0xea, 0xa1, 0x28, 0x39, 0xc5, 0x58, 0x69, 0x99, 0xc1, 0x4c, 0xf0, 0x04, 0x4c, 0x43, 0x4d, 0xaf,
+	0x69, 0x32, 0x8f, 0x78, 0x02, 0xe4, 0x3a, 0xae, 0x2d, 0x20, 0x77, 0xfe, 0xeb, 0xa3, 0x61, 0xcb,
+	0x2b, 0x9e, 0xc5, 0x4c, 0x6b, 0xbe, 0x5c, 0x81, 0x53, 0x37, 0x39, 0x1b, 0x90, 0x31, 0xae, 0x1b,
+	0x27, 0x9d, 0x86, 0xe9, 0xe8, 0x16, 0xfd, 0xe3, 0x34, 0xb5, 0x4e, 0x53, 0x33, 0xc4, 0xe3, 0x54,
+	0x79, 0x96, 0x39, 0xf9, 0x8c, 0x70, 0xcb, 0xe4, 0x2e, 0x20, 0x5b, 0xc7, 0x01, 0x90, 0x8f, 0x08,
+	0xb7, 0x0e, 0x07, 0x26, 0x23, 0x7a, 0xe4, 0x66, 0xe8, 0xdf, 0x36, 0xd4, 0xa5, 0xff, 0x4a, 0xb7,
+	0x3e, 0x0e, 0x1e, 0xbc, 0xf9, 0xf6, 0xf3, 0x43, 0xf5, 0x1e, 0xb9, 0xcb, 0x8e, 0xdd, 0x6e, 0x69,
+	0x9c, 0x62, 0xaf, 0xca, 0x6d, 0xbe, 0x66, 0x7e, 0x3e, 0x33, 0x1b, 0xb4, 0x47, 0x33, 0x3d, 0xff,
+	0xb2, 0x71, 0xd1, 0xd5, 0xc6, 0x45, 0x3f, 0x36, 0x2e, 0x7a, 0xbf, 0x75, 0x2b, 0x57, 0x5b, 0xb7,
+	0xf2, 0x7d, 0xeb, 0x56, 0x9e, 0xdd, 0x89, 0x62, 0x3d, 0x5f, 0xf9, 0x34, 0x90, 0xc9, 0xae, 0xb6,
+	0xfd, 0x8c, 0x54, 0xb8, 0x60, 0x2f, 0xad, 0x90, 0xce, 0x53, 0x50, 0x7e, 0xc3, 0x9c, 0xde, 0xd9,
+	0xef, 0x01, 0x00, 0x84, 0x55, 0x8f, 0x21, 0x40, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProofServiceClient is the client API for ProofService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProofServiceClient interface {
+	// BalanceProof queries the balance of a single coin for a single account
+	// together with the merkle proof of the balance against the app hash.
+	BalanceProof(ctx context.Context, in *QueryBalanceProofRequest, opts ...grpc.CallOption) (*QueryBalanceProofResponse, error)
+}
+
+type proofServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProofServiceClient(cc grpc1.ClientConn) ProofServiceClient {
+	return &proofServiceClient{cc}
+}
+
+func (c *proofServiceClient) BalanceProof(ctx context.Context, in *QueryBalanceProofRequest, opts ...grpc.CallOption) (*QueryBalanceProofResponse, error) {
+	out := new(QueryBalanceProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.ProofService/BalanceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofServiceServer is the server API for ProofService service.
+type ProofServiceServer interface {
+	// BalanceProof queries the balance of a single coin for a single account
+	// together with the merkle proof of the balance against the app hash.
+	BalanceProof(context.Context, *QueryBalanceProofRequest) (*QueryBalanceProofResponse, error)
+}
+
+// UnimplementedProofServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProofServiceServer struct {
+}
+
+func (*UnimplementedProofServiceServer) BalanceProof(ctx context.Context, req *QueryBalanceProofRequest) (*QueryBalanceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceProof not implemented")
+}
+
+func RegisterProofServiceServer(s grpc1.Server, srv ProofServiceServer) {
+	s.RegisterService(&_ProofService_serviceDesc, srv)
+}
+
+func _ProofService_BalanceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).BalanceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.ProofService/BalanceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).BalanceProof(ctx, req.(*QueryBalanceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProofService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.ProofService",
+	HandlerType: (*ProofServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BalanceProof",
+			Handler:    _ProofService_BalanceProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/bank/v1beta1/proof.proto",
+}
+
+func (m *QueryBalanceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBalanceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProof = fmt.Errorf("proto: unexpected end of group")
+)
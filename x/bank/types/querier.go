@@ -18,6 +18,11 @@ func NewQueryBalanceRequest(addr sdk.AccAddress, denom string) *QueryBalanceRequ
 	return &QueryBalanceRequest{Address: addr.String(), Denom: denom}
 }
 
+// NewQueryBalanceProofRequest creates a new instance of QueryBalanceProofRequest.
+func NewQueryBalanceProofRequest(addr sdk.AccAddress, denom string, height int64) *QueryBalanceProofRequest {
+	return &QueryBalanceProofRequest{Address: addr.String(), Denom: denom, Height: height}
+}
+
 // NewQueryAllBalancesRequest creates a new instance of QueryAllBalancesRequest.
 func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest, resolveDenom bool) *QueryAllBalancesRequest {
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req, ResolveDenom: resolveDenom}
@@ -1173,6 +1173,118 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBlockedAddressesRequest defines the RPC request for looking up BlockedAddress entries.
+type QueryBlockedAddressesRequest struct {
+	// addresses is the specific addresses you want look up, including the ones
+	// blocked by the app. Leave empty to get all the entries set by governance.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines an optional pagination for the request. This field is
+	// only read if the addresses field is empty.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{23}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAddressesResponse defines the RPC response of a BlockedAddresses query.
+type QueryBlockedAddressesResponse struct {
+	BlockedAddresses []*BlockedAddress `protobuf:"bytes,1,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// pagination defines the pagination in the response. This field is only
+	// populated if the addresses field in the request is empty.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{24}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetBlockedAddresses() []*BlockedAddress {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1197,89 +1309,96 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "cosmos.bank.v1beta1.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "cosmos.bank.v1beta1.QueryBlockedAddressesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0xaa, 0x93, 0x3c, 0xa7, 0x95, 0x3a, 0x0d, 0x34, 0xd9, 0x10, 0x3b, 0x6c, 0xaa,
-	0xc6, 0x09, 0xc9, 0x6e, 0xe3, 0x20, 0x44, 0xab, 0x12, 0xa9, 0x4e, 0x49, 0x0f, 0x08, 0xb5, 0x38,
-	0xf4, 0x02, 0x07, 0x6b, 0xed, 0x1d, 0x8c, 0x15, 0x7b, 0xd7, 0xf5, 0xac, 0x5b, 0xac, 0x2a, 0x12,
-	0x42, 0x42, 0xea, 0x11, 0x89, 0x9e, 0x2a, 0x21, 0x45, 0x48, 0x40, 0x05, 0x12, 0xaa, 0x10, 0x47,
-	0x8e, 0x1c, 0x7a, 0xac, 0xe0, 0x00, 0xa7, 0x82, 0x12, 0xa4, 0xf6, 0xcf, 0x40, 0x9e, 0x1f, 0xde,
-	0x5d, 0x7b, 0xbc, 0xd9, 0xa4, 0x06, 0x21, 0x2e, 0xad, 0x3d, 0xf3, 0xde, 0xbc, 0xef, 0x7d, 0xef,
-	0xcd, 0xbc, 0xcf, 0x81, 0x4c, 0xd9, 0xa5, 0x75, 0x97, 0x9a, 0x25, 0xcb, 0xd9, 0x36, 0x6f, 0xad,
-	0x96, 0x88, 0x67, 0xad, 0x9a, 0x37, 0x5b, 0xa4, 0xd9, 0x36, 0x1a, 0x4d, 0xd7, 0x73, 0xf1, 0x69,
-	0x6e, 0x60, 0x74, 0x0c, 0x0c, 0x61, 0xa0, 0x2d, 0x75, 0xbd, 0x28, 0xe1, 0xd6, 0x5d, 0xdf, 0x86,
-	0x55, 0xa9, 0x3a, 0x96, 0x57, 0x75, 0x1d, 0x7e, 0x80, 0x36, 0x59, 0x71, 0x2b, 0x2e, 0xfb, 0x68,
-	0x76, 0x3e, 0x89, 0xd5, 0x97, 0x2a, 0xae, 0x5b, 0xa9, 0x11, 0xd3, 0x6a, 0x54, 0x4d, 0xcb, 0x71,
-	0x5c, 0x8f, 0xb9, 0x50, 0xb1, 0x9b, 0x0e, 0x9e, 0x2f, 0x4f, 0x2e, 0xbb, 0x55, 0xa7, 0x6f, 0x3f,
-	0x80, 0x9a, 0x21, 0xe4, 0xfb, 0xd3, 0x7c, 0xbf, 0xc8, 0xc3, 0x8a, 0x0c, 0xf8, 0xd6, 0x8c, 0x70,
-	0x95, 0xa8, 0x83, 0xc9, 0x6a, 0xa7, 0xac, 0x7a, 0xd5, 0x71, 0x4d, 0xf6, 0x2f, 0x5f, 0xd2, 0xab,
-	0x70, 0xfa, 0x9d, 0x8e, 0x45, 0xde, 0xaa, 0x59, 0x4e, 0x99, 0x14, 0xc8, 0xcd, 0x16, 0xa1, 0x1e,
-	0xce, 0xc1, 0xa8, 0x65, 0xdb, 0x4d, 0x42, 0xe9, 0x14, 0x9a, 0x43, 0xd9, 0xf1, 0xfc, 0xd4, 0x2f,
-	0x3f, 0xae, 0x4c, 0x8a, 0x48, 0x97, 0xf9, 0xce, 0x96, 0xd7, 0xac, 0x3a, 0x95, 0x82, 0x34, 0xc4,
-	0x93, 0x70, 0xdc, 0x26, 0x8e, 0x5b, 0x9f, 0x1a, 0xe9, 0x78, 0x14, 0xf8, 0x97, 0x8b, 0x63, 0x77,
-	0x77, 0x33, 0x89, 0x67, 0xbb, 0x99, 0x84, 0xfe, 0x16, 0x4c, 0x86, 0x43, 0xd1, 0x86, 0xeb, 0x50,
-	0x82, 0xd7, 0x60, 0xb4, 0xc4, 0x97, 0x58, 0xac, 0x54, 0x6e, 0xda, 0xe8, 0x16, 0x85, 0x12, 0x59,
-	0x14, 0x63, 0xc3, 0xad, 0x3a, 0x05, 0x69, 0xa9, 0xff, 0x8c, 0xe0, 0x0c, 0x3b, 0xed, 0x72, 0xad,
-	0x26, 0x0e, 0xa4, 0xcf, 0x03, 0x7e, 0x13, 0xc0, 0x2f, 0x2d, 0xcb, 0x20, 0x95, 0x3b, 0x17, 0xc2,
-	0xc1, 0x89, 0x94, 0x68, 0xae, 0x5b, 0x15, 0x49, 0x56, 0x21, 0xe0, 0x89, 0xe7, 0xe1, 0x44, 0x93,
-	0x50, 0xb7, 0x76, 0x8b, 0x14, 0x39, 0x19, 0xc7, 0xe6, 0x50, 0x76, 0xac, 0x30, 0x21, 0x16, 0xaf,
-	0xf4, 0x70, 0xb2, 0x87, 0x60, 0xaa, 0x3f, 0x0d, 0x41, 0xcc, 0x0e, 0x8c, 0x89, 0x74, 0x3b, 0x89,
-	0x1c, 0x8b, 0x64, 0x26, 0xbf, 0xf9, 0xe8, 0x49, 0x26, 0xf1, 0xed, 0x1f, 0x99, 0x6c, 0xa5, 0xea,
-	0x7d, 0xd8, 0x2a, 0x19, 0x65, 0xb7, 0x2e, 0x3a, 0x43, 0xfc, 0xb7, 0x42, 0xed, 0x6d, 0xd3, 0x6b,
-	0x37, 0x08, 0x65, 0x0e, 0xf4, 0xfe, 0xd3, 0x87, 0x4b, 0x13, 0x35, 0x52, 0xb1, 0xca, 0xed, 0x62,
-	0xa7, 0xf7, 0xe8, 0x83, 0xa7, 0x0f, 0x97, 0x50, 0xa1, 0x1b, 0x12, 0x5f, 0x55, 0x50, 0xb2, 0x70,
-	0x20, 0x25, 0x1c, 0x7b, 0x90, 0x13, 0xfd, 0x2b, 0x04, 0xb3, 0x2c, 0xc9, 0xad, 0x06, 0x71, 0x6c,
-	0xab, 0x54, 0x23, 0xff, 0xa1, 0x8a, 0x05, 0x8a, 0xf1, 0x0c, 0x41, 0x7a, 0x10, 0xce, 0xff, 0x59,
-	0x49, 0xda, 0x30, 0xaf, 0xcc, 0x34, 0xdf, 0x66, 0x1d, 0xfa, 0x4f, 0x3e, 0x03, 0xef, 0xc3, 0xd9,
-	0xe8, 0xd0, 0xcf, 0xf3, 0x2c, 0x6c, 0x8b, 0x57, 0xe1, 0x5d, 0xd7, 0xb3, 0x6a, 0x5b, 0xad, 0x46,
-	0xa3, 0xd6, 0x96, 0xb9, 0x84, 0xfb, 0x05, 0x0d, 0xa1, 0x5f, 0x9e, 0xc8, 0xcb, 0x1b, 0x8a, 0x26,
-	0xe0, 0xb7, 0x21, 0x49, 0xd9, 0xca, 0xbf, 0xd7, 0x27, 0x22, 0xe0, 0xf0, 0xba, 0x64, 0x59, 0xbc,
-	0xd8, 0x3c, 0xb5, 0x6b, 0x1f, 0x48, 0x2a, 0xbb, 0x25, 0x46, 0x81, 0x12, 0xeb, 0x37, 0xe0, 0x85,
-	0x1e, 0x6b, 0x41, 0xc5, 0x25, 0x48, 0x5a, 0x75, 0xb7, 0xe5, 0x78, 0x07, 0x16, 0x32, 0x3f, 0xde,
-	0xa1, 0x42, 0x64, 0xc3, 0x7d, 0xf4, 0x49, 0xc0, 0xec, 0xd8, 0xeb, 0x56, 0xd3, 0xaa, 0xcb, 0x17,
-	0x43, 0xbf, 0x21, 0xe6, 0x96, 0x5c, 0x15, 0xa1, 0xd6, 0x21, 0xd9, 0x60, 0x2b, 0x22, 0xd4, 0x8c,
-	0xa1, 0x98, 0xef, 0x06, 0x77, 0x0a, 0x05, 0xe3, 0x5e, 0xba, 0x0d, 0x1a, 0x3b, 0x96, 0xb5, 0x22,
-	0x7d, 0x9b, 0x78, 0x96, 0x6d, 0x79, 0xd6, 0x90, 0x5b, 0x48, 0xff, 0x1e, 0xc1, 0x8c, 0x32, 0x8c,
-	0xc8, 0x62, 0x13, 0xc6, 0xeb, 0x62, 0x4d, 0x3e, 0x33, 0xb3, 0xca, 0x44, 0xa4, 0x67, 0x30, 0x15,
-	0xdf, 0x75, 0x78, 0x8d, 0xb0, 0x0a, 0xd3, 0x3e, 0xde, 0x5e, 0x56, 0xd4, 0xdd, 0x50, 0x0a, 0x32,
-	0xd9, 0x97, 0xe1, 0x15, 0x18, 0x93, 0x30, 0x05, 0x8f, 0xf1, 0x13, 0xec, 0x7a, 0xea, 0xb7, 0xc5,
-	0x6d, 0x67, 0x31, 0xae, 0xdd, 0x76, 0x48, 0x93, 0x46, 0x82, 0x1a, 0xd6, 0xcc, 0xd0, 0x3f, 0x46,
-	0x00, 0x7e, 0xd0, 0x23, 0x3d, 0x93, 0xeb, 0xfe, 0xf3, 0x36, 0x72, 0x88, 0x5b, 0xd1, 0x7d, 0xe9,
-	0xbe, 0x91, 0x8f, 0x4f, 0x28, 0x79, 0x41, 0x6f, 0x1e, 0x26, 0x58, 0xc2, 0x45, 0x97, 0xad, 0x8b,
-	0x1e, 0xca, 0x28, 0x29, 0xf6, 0xfd, 0x0b, 0x29, 0xdb, 0x3f, 0x6b, 0x98, 0xb3, 0x86, 0x57, 0x69,
-	0x8b, 0x38, 0xf6, 0x9b, 0x4e, 0xe7, 0xc5, 0xb7, 0x65, 0x95, 0x5e, 0x84, 0x24, 0x0b, 0xc9, 0x11,
-	0x8e, 0x17, 0xc4, 0xb7, 0x9e, 0x3a, 0x95, 0x8f, 0x5c, 0xa7, 0x07, 0x92, 0xa4, 0x50, 0x6c, 0x41,
-	0xd2, 0x06, 0x4c, 0x50, 0xe2, 0xd8, 0x45, 0xc2, 0xd7, 0x05, 0x49, 0x73, 0x4a, 0x92, 0x82, 0xfe,
-	0x29, 0xea, 0x7f, 0xe9, 0x61, 0xa9, 0x7c, 0x64, 0x96, 0x72, 0x3f, 0x9c, 0x84, 0xe3, 0x0c, 0x2a,
-	0xfe, 0x02, 0xc1, 0xa8, 0x98, 0x89, 0x38, 0xab, 0x44, 0xa3, 0x50, 0xec, 0xda, 0x62, 0x0c, 0x4b,
-	0x1e, 0x56, 0x7f, 0xe3, 0x6e, 0xa7, 0x95, 0x3e, 0xf9, 0xf5, 0xaf, 0xcf, 0x47, 0x72, 0xf8, 0xbc,
-	0xa9, 0xfe, 0xb1, 0xc1, 0x15, 0x87, 0x79, 0x47, 0xf4, 0xeb, 0x8e, 0x59, 0x6a, 0x73, 0x45, 0x8b,
-	0x77, 0x11, 0xa4, 0x02, 0x72, 0x15, 0x2f, 0x0f, 0x8e, 0xdc, 0x2f, 0xce, 0xb5, 0x95, 0x98, 0xd6,
-	0x02, 0xeb, 0xab, 0x3e, 0xd6, 0x45, 0xbc, 0x10, 0x13, 0x2b, 0xfe, 0x09, 0xc1, 0xa9, 0x3e, 0x11,
-	0x87, 0x73, 0x83, 0x43, 0x0f, 0x52, 0xa6, 0xda, 0xda, 0xa1, 0x7c, 0x04, 0xe8, 0x75, 0x1f, 0xf4,
-	0x1a, 0x5e, 0x55, 0x82, 0xa6, 0xd2, 0xb9, 0xa8, 0x80, 0xff, 0x1b, 0x82, 0x33, 0x03, 0xe4, 0x11,
-	0x7e, 0x3d, 0x3e, 0xa0, 0xb0, 0x98, 0xd3, 0x2e, 0x1c, 0xc1, 0x53, 0x24, 0x74, 0xd5, 0x4f, 0xe8,
-	0x12, 0xbe, 0x78, 0xe8, 0x84, 0xfc, 0xde, 0xb9, 0x87, 0x20, 0x15, 0x50, 0x4b, 0x51, 0xbd, 0xd3,
-	0x2f, 0xe1, 0xa2, 0x7a, 0x47, 0x21, 0xc1, 0xf4, 0xac, 0x8f, 0x7a, 0x16, 0xcf, 0xa8, 0x51, 0x73,
-	0x18, 0xf7, 0x10, 0x8c, 0x49, 0xd9, 0x82, 0x23, 0x6e, 0x52, 0x8f, 0x10, 0xd2, 0x96, 0xe2, 0x98,
-	0x0a, 0x34, 0xab, 0x3e, 0x9a, 0x73, 0xf8, 0x6c, 0x04, 0x1a, 0x9f, 0xad, 0x4f, 0x11, 0x24, 0xb9,
-	0x56, 0xc1, 0x0b, 0x83, 0x23, 0x85, 0x84, 0x91, 0x96, 0x3d, 0xd8, 0x30, 0x3e, 0x3d, 0x5c, 0x15,
-	0xe1, 0xef, 0x10, 0x9c, 0x08, 0xcd, 0x71, 0x6c, 0x0c, 0x8e, 0xa2, 0xd2, 0x08, 0x9a, 0x19, 0xdb,
-	0x5e, 0x80, 0xbb, 0xe0, 0x83, 0x33, 0xf0, 0xb2, 0x12, 0x1c, 0x9f, 0x15, 0x45, 0xa9, 0x06, 0xcc,
-	0x3b, 0x6c, 0x61, 0x07, 0x7f, 0x8d, 0xe0, 0x64, 0x58, 0x58, 0xe1, 0x83, 0xc2, 0xf7, 0x2a, 0x3d,
-	0xed, 0x7c, 0x7c, 0x87, 0xf8, 0xe5, 0xed, 0x01, 0x8c, 0xbf, 0x44, 0x90, 0x0a, 0x4c, 0xef, 0xa8,
-	0xcb, 0xd0, 0xaf, 0x70, 0xa2, 0x2e, 0x83, 0x42, 0x12, 0xe8, 0xaf, 0xf9, 0xf8, 0x5e, 0xc1, 0x8b,
-	0x83, 0xf1, 0x09, 0xc9, 0xd0, 0x65, 0xf3, 0x3e, 0x82, 0x54, 0x60, 0xfa, 0x45, 0x81, 0xec, 0x1f,
-	0xf0, 0x51, 0x20, 0x15, 0x23, 0x59, 0x37, 0x7c, 0x90, 0xf3, 0xf8, 0x65, 0xf5, 0x1d, 0x09, 0x8c,
-	0xec, 0xfc, 0xc6, 0xa3, 0xbd, 0x34, 0x7a, 0xbc, 0x97, 0x46, 0x7f, 0xee, 0xa5, 0xd1, 0x67, 0xfb,
-	0xe9, 0xc4, 0xe3, 0xfd, 0x74, 0xe2, 0xf7, 0xfd, 0x74, 0xe2, 0xbd, 0xc5, 0xc8, 0xdf, 0x52, 0x1f,
-	0xf1, 0x33, 0xd9, 0x4f, 0xaa, 0x52, 0x92, 0xfd, 0x25, 0x6c, 0xed, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x4c, 0x05, 0x91, 0x2c, 0x14, 0x00, 0x00,
+	// 1312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb4, 0xaa, 0x9b, 0x3c, 0xa7, 0xd0, 0x4e, 0x02, 0x49, 0x36, 0x8d, 0x1d, 0x36, 0x55,
+	0xe2, 0x84, 0xd8, 0x5b, 0x3b, 0xa8, 0xa2, 0x55, 0x89, 0x54, 0xa7, 0x24, 0x07, 0x84, 0x1a, 0x1c,
+	0x7a, 0x81, 0x83, 0xb5, 0xf6, 0x0e, 0xc6, 0x8a, 0xbd, 0xeb, 0x7a, 0x36, 0x2d, 0x56, 0x15, 0x09,
+	0x21, 0x21, 0xf5, 0x88, 0x44, 0x4f, 0x95, 0x40, 0x11, 0x12, 0x50, 0x81, 0x54, 0xf5, 0xc0, 0x09,
+	0x71, 0xe4, 0xd0, 0x63, 0x05, 0x07, 0x38, 0x15, 0x94, 0x20, 0xb5, 0x3f, 0x03, 0x79, 0x66, 0xd6,
+	0xb3, 0x6b, 0x8f, 0x9d, 0x4d, 0x6a, 0x10, 0xe2, 0xd2, 0x7a, 0x67, 0xde, 0x9b, 0xf7, 0xbd, 0xef,
+	0xbd, 0x99, 0xf9, 0x26, 0x90, 0x28, 0x39, 0xb4, 0xe6, 0x50, 0xa3, 0x68, 0xda, 0x5b, 0xc6, 0xcd,
+	0x4c, 0x91, 0xb8, 0x66, 0xc6, 0xb8, 0xb1, 0x4d, 0x1a, 0xcd, 0x74, 0xbd, 0xe1, 0xb8, 0x0e, 0x1e,
+	0xe5, 0x06, 0xe9, 0x96, 0x41, 0x5a, 0x18, 0x68, 0x8b, 0x6d, 0x2f, 0x4a, 0xb8, 0x75, 0xdb, 0xb7,
+	0x6e, 0x96, 0x2b, 0xb6, 0xe9, 0x56, 0x1c, 0x9b, 0x2f, 0xa0, 0x8d, 0x95, 0x9d, 0xb2, 0xc3, 0x7e,
+	0x1a, 0xad, 0x5f, 0x62, 0xf4, 0x6c, 0xd9, 0x71, 0xca, 0x55, 0x62, 0x98, 0xf5, 0x8a, 0x61, 0xda,
+	0xb6, 0xe3, 0x32, 0x17, 0x2a, 0x66, 0xe3, 0xfe, 0xf5, 0xbd, 0x95, 0x4b, 0x4e, 0xc5, 0xee, 0x9a,
+	0xf7, 0xa1, 0x66, 0x08, 0xf9, 0xfc, 0x24, 0x9f, 0x2f, 0xf0, 0xb0, 0x22, 0x03, 0x3e, 0x35, 0x25,
+	0x5c, 0x3d, 0xd4, 0xfe, 0x64, 0xb5, 0x33, 0x66, 0xad, 0x62, 0x3b, 0x06, 0xfb, 0x97, 0x0f, 0xe9,
+	0x15, 0x18, 0x7d, 0xa7, 0x65, 0x91, 0x33, 0xab, 0xa6, 0x5d, 0x22, 0x79, 0x72, 0x63, 0x9b, 0x50,
+	0x17, 0x67, 0xe1, 0xa4, 0x69, 0x59, 0x0d, 0x42, 0xe9, 0x04, 0x9a, 0x41, 0xc9, 0xe1, 0xdc, 0xc4,
+	0x2f, 0x3f, 0xa4, 0xc6, 0x44, 0xa4, 0x2b, 0x7c, 0x66, 0xd3, 0x6d, 0x54, 0xec, 0x72, 0xde, 0x33,
+	0xc4, 0x63, 0x70, 0xc2, 0x22, 0xb6, 0x53, 0x9b, 0x38, 0xd6, 0xf2, 0xc8, 0xf3, 0x8f, 0x4b, 0x43,
+	0x77, 0x76, 0x13, 0x91, 0x67, 0xbb, 0x89, 0x88, 0xfe, 0x16, 0x8c, 0x05, 0x43, 0xd1, 0xba, 0x63,
+	0x53, 0x82, 0x97, 0xe1, 0x64, 0x91, 0x0f, 0xb1, 0x58, 0xb1, 0xec, 0x64, 0xba, 0x5d, 0x14, 0x4a,
+	0xbc, 0xa2, 0xa4, 0x57, 0x9d, 0x8a, 0x9d, 0xf7, 0x2c, 0xf5, 0x9f, 0x11, 0x8c, 0xb3, 0xd5, 0xae,
+	0x54, 0xab, 0x62, 0x41, 0xfa, 0x3c, 0xe0, 0xd7, 0x00, 0x64, 0x69, 0x59, 0x06, 0xb1, 0xec, 0x5c,
+	0x00, 0x07, 0x27, 0xd2, 0x43, 0xb3, 0x61, 0x96, 0x3d, 0xb2, 0xf2, 0x3e, 0x4f, 0x3c, 0x0b, 0xa7,
+	0x1a, 0x84, 0x3a, 0xd5, 0x9b, 0xa4, 0xc0, 0xc9, 0x38, 0x3e, 0x83, 0x92, 0x43, 0xf9, 0x11, 0x31,
+	0x78, 0xb5, 0x83, 0x93, 0x3d, 0x04, 0x13, 0xdd, 0x69, 0x08, 0x62, 0x76, 0x60, 0x48, 0xa4, 0xdb,
+	0x4a, 0xe4, 0x78, 0x5f, 0x66, 0x72, 0x6b, 0x8f, 0x9e, 0x24, 0x22, 0xdf, 0xfd, 0x91, 0x48, 0x96,
+	0x2b, 0xee, 0x87, 0xdb, 0xc5, 0x74, 0xc9, 0xa9, 0x89, 0xce, 0x10, 0xff, 0xa5, 0xa8, 0xb5, 0x65,
+	0xb8, 0xcd, 0x3a, 0xa1, 0xcc, 0x81, 0xde, 0x7b, 0xfa, 0x70, 0x71, 0xa4, 0x4a, 0xca, 0x66, 0xa9,
+	0x59, 0x68, 0xf5, 0x1e, 0xbd, 0xff, 0xf4, 0xe1, 0x22, 0xca, 0xb7, 0x43, 0xe2, 0x75, 0x05, 0x25,
+	0xf3, 0x07, 0x52, 0xc2, 0xb1, 0xfb, 0x39, 0xd1, 0xbf, 0x46, 0x30, 0xcd, 0x92, 0xdc, 0xac, 0x13,
+	0xdb, 0x32, 0x8b, 0x55, 0xf2, 0x1f, 0xaa, 0x98, 0xaf, 0x18, 0xcf, 0x10, 0xc4, 0x7b, 0xe1, 0xfc,
+	0x9f, 0x95, 0xa4, 0x09, 0xb3, 0xca, 0x4c, 0x73, 0x4d, 0xd6, 0xa1, 0xff, 0xe4, 0x31, 0xf0, 0x3e,
+	0x9c, 0xeb, 0x1f, 0xfa, 0x79, 0x8e, 0x85, 0x2d, 0x71, 0x2a, 0xbc, 0xeb, 0xb8, 0x66, 0x75, 0x73,
+	0xbb, 0x5e, 0xaf, 0x36, 0xbd, 0x5c, 0x82, 0xfd, 0x82, 0x06, 0xd0, 0x2f, 0x4f, 0xbc, 0xcd, 0x1b,
+	0x88, 0x26, 0xe0, 0x37, 0x21, 0x4a, 0xd9, 0xc8, 0xbf, 0xd7, 0x27, 0x22, 0xe0, 0xe0, 0xba, 0x64,
+	0x49, 0x9c, 0xd8, 0x3c, 0xb5, 0x6b, 0x1f, 0x78, 0x54, 0xb6, 0x4b, 0x8c, 0x7c, 0x25, 0xd6, 0xaf,
+	0xc3, 0x4b, 0x1d, 0xd6, 0x82, 0x8a, 0xcb, 0x10, 0x35, 0x6b, 0xce, 0xb6, 0xed, 0x1e, 0x58, 0xc8,
+	0xdc, 0x70, 0x8b, 0x0a, 0x91, 0x0d, 0xf7, 0xd1, 0xc7, 0x00, 0xb3, 0x65, 0x37, 0xcc, 0x86, 0x59,
+	0xf3, 0x4e, 0x0c, 0xfd, 0x3a, 0x8c, 0x06, 0x46, 0x45, 0xa8, 0x15, 0x88, 0xd6, 0xd9, 0x88, 0x08,
+	0x35, 0x95, 0x56, 0xdc, 0xef, 0x69, 0xee, 0x14, 0x08, 0xc6, 0xbd, 0x74, 0x0b, 0x34, 0xb6, 0x2c,
+	0x6b, 0x45, 0xfa, 0x36, 0x71, 0x4d, 0xcb, 0x74, 0xcd, 0x01, 0xb7, 0x90, 0xfe, 0x00, 0xc1, 0x94,
+	0x32, 0x8c, 0xc8, 0x62, 0x0d, 0x86, 0x6b, 0x62, 0xcc, 0x3b, 0x66, 0xa6, 0x95, 0x89, 0x78, 0x9e,
+	0xfe, 0x54, 0xa4, 0xeb, 0xe0, 0x1a, 0x21, 0x03, 0x93, 0x12, 0x6f, 0x27, 0x2b, 0xea, 0x6e, 0x28,
+	0x82, 0xa6, 0x72, 0x11, 0x19, 0x5e, 0x85, 0x21, 0x0f, 0xa6, 0xe0, 0x31, 0x7c, 0x82, 0x6d, 0x4f,
+	0xfd, 0x16, 0x8c, 0xcb, 0x18, 0xd7, 0x6e, 0xd9, 0xa4, 0x41, 0xfb, 0x82, 0x1a, 0xd4, 0x9d, 0xa1,
+	0x7f, 0x8c, 0x00, 0x64, 0xd0, 0x23, 0x1d, 0x93, 0x2b, 0xf2, 0x78, 0x3b, 0x76, 0x88, 0x5d, 0xd1,
+	0x3e, 0xe9, 0xbe, 0xf5, 0x0e, 0x9f, 0x40, 0xf2, 0x82, 0xde, 0x1c, 0x8c, 0xb0, 0x84, 0x0b, 0x0e,
+	0x1b, 0x17, 0x3d, 0x94, 0x50, 0x52, 0x2c, 0xfd, 0xf3, 0x31, 0x4b, 0xae, 0x35, 0xc8, 0xbb, 0x86,
+	0x57, 0x69, 0x93, 0xd8, 0xd6, 0x9b, 0x76, 0xeb, 0xc4, 0xb7, 0xbc, 0x2a, 0xbd, 0x0c, 0x51, 0x16,
+	0x92, 0x23, 0x1c, 0xce, 0x8b, 0xaf, 0x8e, 0x3a, 0x95, 0x8e, 0x5c, 0xa7, 0xfb, 0x1e, 0x49, 0x81,
+	0xd8, 0x82, 0xa4, 0x55, 0x18, 0xa1, 0xc4, 0xb6, 0x0a, 0x84, 0x8f, 0x0b, 0x92, 0x66, 0x94, 0x24,
+	0xf9, 0xfd, 0x63, 0x54, 0x7e, 0xe0, 0x75, 0x05, 0xd2, 0x23, 0xb1, 0xf4, 0x25, 0x82, 0xb3, 0x5c,
+	0x1e, 0x57, 0x9d, 0xd2, 0x16, 0xb1, 0x44, 0xdb, 0x48, 0x8d, 0x74, 0x01, 0x86, 0x4d, 0x6f, 0x8c,
+	0xd3, 0xd5, 0xa7, 0xcd, 0xa4, 0xe9, 0xc0, 0xb8, 0xfc, 0xd1, 0x53, 0x71, 0xdd, 0x00, 0x05, 0xa1,
+	0x1b, 0x70, 0xa6, 0xc8, 0xe7, 0x0a, 0x41, 0xa4, 0xb1, 0xec, 0xac, 0x92, 0xd5, 0xe0, 0x4a, 0xf9,
+	0xd3, 0xc5, 0x8e, 0x95, 0x07, 0xc6, 0x6e, 0x76, 0xff, 0x45, 0x38, 0xc1, 0xc0, 0xe3, 0x2f, 0x10,
+	0x9c, 0x14, 0x8a, 0x03, 0x27, 0x95, 0xa8, 0x14, 0xef, 0x21, 0x6d, 0x21, 0x84, 0x25, 0x0f, 0xab,
+	0xbf, 0x71, 0xa7, 0xb5, 0x51, 0x3f, 0xf9, 0xf5, 0xaf, 0xcf, 0x8f, 0x65, 0xf1, 0x79, 0x43, 0xfd,
+	0x94, 0x63, 0x2e, 0xd4, 0xb8, 0x2d, 0x78, 0xda, 0x31, 0x8a, 0x4d, 0xfe, 0x5e, 0xc0, 0xbb, 0x08,
+	0x62, 0xbe, 0xc7, 0x00, 0x5e, 0xea, 0x1d, 0xb9, 0xfb, 0xe9, 0xa3, 0xa5, 0x42, 0x5a, 0x0b, 0xac,
+	0xaf, 0x49, 0xac, 0x0b, 0x78, 0x3e, 0x24, 0x56, 0xfc, 0x13, 0x82, 0x33, 0x5d, 0x12, 0x19, 0x67,
+	0x7b, 0x87, 0xee, 0xa5, 0xfb, 0xb5, 0xe5, 0x43, 0xf9, 0x08, 0xd0, 0x2b, 0x12, 0xf4, 0x32, 0xce,
+	0x28, 0x41, 0x53, 0xcf, 0xb9, 0xa0, 0x80, 0xff, 0x1b, 0x82, 0xf1, 0x1e, 0xe2, 0x13, 0xbf, 0x1e,
+	0x1e, 0x50, 0x50, 0x2a, 0x6b, 0x17, 0x8f, 0xe0, 0x29, 0x12, 0x5a, 0x97, 0x09, 0x5d, 0xc6, 0x97,
+	0x0e, 0x9d, 0x90, 0xec, 0x9d, 0xbb, 0x08, 0x62, 0x3e, 0x2d, 0xda, 0xaf, 0x77, 0xba, 0x05, 0xb2,
+	0x96, 0x0a, 0x69, 0x2d, 0x50, 0x27, 0x25, 0xea, 0x69, 0x3c, 0xa5, 0x46, 0xcd, 0x61, 0xdc, 0x45,
+	0x30, 0xe4, 0x89, 0x42, 0xdc, 0x67, 0x27, 0x75, 0xc8, 0x4c, 0x6d, 0x31, 0x8c, 0xa9, 0x40, 0x93,
+	0x91, 0x68, 0xe6, 0xf0, 0xb9, 0x3e, 0x68, 0x24, 0x5b, 0x9f, 0x22, 0x88, 0x72, 0x25, 0x88, 0xe7,
+	0x7b, 0x47, 0x0a, 0xc8, 0x4e, 0x2d, 0x79, 0xb0, 0x61, 0x78, 0x7a, 0xb8, 0xe6, 0xc4, 0xdf, 0x23,
+	0x38, 0x15, 0x50, 0x49, 0x38, 0xdd, 0x3b, 0x8a, 0x4a, 0x81, 0x69, 0x46, 0x68, 0x7b, 0x01, 0xee,
+	0xa2, 0x04, 0x97, 0xc6, 0x4b, 0x4a, 0x70, 0xfc, 0x26, 0x2e, 0x78, 0x5a, 0xcb, 0xb8, 0xcd, 0x06,
+	0x76, 0xf0, 0x37, 0x08, 0x5e, 0x08, 0xca, 0x56, 0x7c, 0x50, 0xf8, 0x4e, 0x1d, 0xad, 0x9d, 0x0f,
+	0xef, 0x10, 0xbe, 0xbc, 0x1d, 0x80, 0xf1, 0x57, 0x08, 0x62, 0x3e, 0x6d, 0xd4, 0x6f, 0x33, 0x74,
+	0xeb, 0x47, 0x2d, 0x15, 0xd2, 0x5a, 0xe0, 0xbb, 0x20, 0xf1, 0xbd, 0x8a, 0x17, 0x7a, 0xe3, 0x13,
+	0x82, 0xac, 0xcd, 0xe6, 0x3d, 0x04, 0x31, 0x9f, 0xb6, 0xe8, 0x07, 0xb2, 0x5b, 0x3e, 0x69, 0xa9,
+	0x90, 0xd6, 0x02, 0x64, 0x5a, 0x82, 0x9c, 0xc5, 0xaf, 0xa8, 0xf7, 0x88, 0x4f, 0x10, 0xe1, 0x07,
+	0x08, 0x4e, 0x77, 0x5e, 0xf6, 0x38, 0xd3, 0xe7, 0x26, 0x54, 0x2b, 0x17, 0x2d, 0x7b, 0x18, 0x17,
+	0x81, 0x75, 0x59, 0x62, 0x4d, 0xe2, 0x39, 0xf5, 0xcd, 0xd4, 0xa9, 0x35, 0x72, 0xab, 0x8f, 0xf6,
+	0xe2, 0xe8, 0xf1, 0x5e, 0x1c, 0xfd, 0xb9, 0x17, 0x47, 0x9f, 0xed, 0xc7, 0x23, 0x8f, 0xf7, 0xe3,
+	0x91, 0xdf, 0xf7, 0xe3, 0x91, 0xf7, 0x16, 0xfa, 0x3e, 0xad, 0x3f, 0xe2, 0x0b, 0xb3, 0x17, 0x76,
+	0x31, 0xca, 0xfe, 0x30, 0xba, 0xfc, 0xf7, 0x00, 0x7a, 0x92, 0x3e, 0x0a, 0x3b, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1350,6 +1469,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BlockedAddresses queries for the BlockedAddress entries set by governance.
+	//
+	// This query only returns the addresses which were blocked by governance. The
+	// addresses blocked by the app, which cannot be unblocked, are resolved when
+	// looking up specific addresses.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
 }
 
 type queryClient struct {
@@ -1459,6 +1584,15 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1517,6 +1651,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BlockedAddresses queries for the BlockedAddress entries set by governance.
+	//
+	// This query only returns the addresses which were blocked by governance. The
+	// addresses blocked by the app, which cannot be unblocked, are resolved when
+	// looking up specific addresses.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1556,6 +1696,9 @@ func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1759,6 +1902,24 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1807,6 +1968,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2726,6 +2891,103 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3093,6 +3355,44 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for _, e := range m.BlockedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5461,6 +5761,244 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, &BlockedAddress{})
+			if err := m.BlockedAddresses[len(m.BlockedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
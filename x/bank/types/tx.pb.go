@@ -412,6 +412,190 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgAddBlockedAddresses is the Msg/AddBlockedAddresses request type.
+type MsgAddBlockedAddresses struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses is the list of addresses to block, none of them may already be blocked.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgAddBlockedAddresses) Reset()         { *m = MsgAddBlockedAddresses{} }
+func (m *MsgAddBlockedAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlockedAddresses) ProtoMessage()    {}
+func (*MsgAddBlockedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgAddBlockedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlockedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlockedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBlockedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlockedAddresses.Merge(m, src)
+}
+func (m *MsgAddBlockedAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlockedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlockedAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBlockedAddresses proto.InternalMessageInfo
+
+func (m *MsgAddBlockedAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddBlockedAddresses) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgAddBlockedAddressesResponse defines the Msg/AddBlockedAddresses response type.
+type MsgAddBlockedAddressesResponse struct {
+}
+
+func (m *MsgAddBlockedAddressesResponse) Reset()         { *m = MsgAddBlockedAddressesResponse{} }
+func (m *MsgAddBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlockedAddressesResponse) ProtoMessage()    {}
+func (*MsgAddBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgAddBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlockedAddressesResponse.Merge(m, src)
+}
+func (m *MsgAddBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBlockedAddressesResponse proto.InternalMessageInfo
+
+// MsgRemoveBlockedAddresses is the Msg/RemoveBlockedAddresses request type.
+type MsgRemoveBlockedAddresses struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses is the list of addresses to unblock, all of them must be blocked by governance.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgRemoveBlockedAddresses) Reset()         { *m = MsgRemoveBlockedAddresses{} }
+func (m *MsgRemoveBlockedAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlockedAddresses) ProtoMessage()    {}
+func (*MsgRemoveBlockedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{10}
+}
+func (m *MsgRemoveBlockedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlockedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlockedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBlockedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlockedAddresses.Merge(m, src)
+}
+func (m *MsgRemoveBlockedAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlockedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlockedAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBlockedAddresses proto.InternalMessageInfo
+
+func (m *MsgRemoveBlockedAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveBlockedAddresses) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgRemoveBlockedAddressesResponse defines the Msg/RemoveBlockedAddresses response type.
+type MsgRemoveBlockedAddressesResponse struct {
+}
+
+func (m *MsgRemoveBlockedAddressesResponse) Reset()         { *m = MsgRemoveBlockedAddressesResponse{} }
+func (m *MsgRemoveBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlockedAddressesResponse) ProtoMessage()    {}
+func (*MsgRemoveBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{11}
+}
+func (m *MsgRemoveBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlockedAddressesResponse.Merge(m, src)
+}
+func (m *MsgRemoveBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBlockedAddressesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -421,56 +605,67 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgAddBlockedAddresses)(nil), "cosmos.bank.v1beta1.MsgAddBlockedAddresses")
+	proto.RegisterType((*MsgAddBlockedAddressesResponse)(nil), "cosmos.bank.v1beta1.MsgAddBlockedAddressesResponse")
+	proto.RegisterType((*MsgRemoveBlockedAddresses)(nil), "cosmos.bank.v1beta1.MsgRemoveBlockedAddresses")
+	proto.RegisterType((*MsgRemoveBlockedAddressesResponse)(nil), "cosmos.bank.v1beta1.MsgRemoveBlockedAddressesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
-	0x1c, 0x5f, 0x99, 0x8e, 0xec, 0x31, 0x25, 0x54, 0x22, 0xac, 0x90, 0x0e, 0x16, 0x43, 0x00, 0xa5,
-	0x15, 0x34, 0x9a, 0xcc, 0x68, 0x74, 0x28, 0x89, 0x26, 0x8b, 0x66, 0xc4, 0x83, 0x5e, 0x96, 0xd7,
-	0xf5, 0x51, 0x1a, 0xd6, 0xbe, 0xa6, 0xef, 0x95, 0xb0, 0x9b, 0x7a, 0x32, 0x9e, 0x3c, 0x7b, 0xe2,
-	0x68, 0x8c, 0x07, 0x0e, 0x1e, 0x4d, 0xbc, 0x72, 0x24, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xfa, 0x5f,
-	0x98, 0xf7, 0xa3, 0xa5, 0x8c, 0x8d, 0x11, 0x2f, 0x6b, 0xf7, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0xed,
-	0xf7, 0x3d, 0x30, 0xd9, 0xc4, 0xc4, 0xc3, 0xc4, 0xb4, 0xa0, 0xbf, 0x61, 0x6e, 0x2e, 0x5a, 0x88,
-	0xc2, 0x45, 0x93, 0x6e, 0x19, 0x41, 0x88, 0x29, 0x56, 0x2f, 0x09, 0xd4, 0x60, 0xa8, 0x21, 0x51,
-	0x6d, 0xd4, 0xc1, 0x0e, 0xe6, 0xb8, 0xc9, 0xde, 0x04, 0x55, 0xd3, 0x13, 0x23, 0x82, 0x12, 0xa3,
-	0x26, 0x76, 0xfd, 0x13, 0x78, 0xaa, 0x10, 0xf7, 0x15, 0x78, 0x51, 0xe0, 0x0d, 0x61, 0x2c, 0xeb,
-	0x0a, 0x68, 0x4c, 0x4a, 0x3d, 0xe2, 0x98, 0x9b, 0x8b, 0xec, 0x21, 0x81, 0x11, 0xe8, 0xb9, 0x3e,
-	0x36, 0xf9, 0xaf, 0x58, 0x2a, 0x7f, 0x1e, 0x00, 0x83, 0x35, 0xe2, 0xac, 0x22, 0xdf, 0x56, 0xef,
-	0x80, 0xc2, 0x5a, 0x88, 0xbd, 0x06, 0xb4, 0xed, 0x10, 0x11, 0x32, 0xae, 0x4c, 0x29, 0xb3, 0xf9,
-	0xea, 0xf8, 0xf7, 0x2f, 0x0b, 0xa3, 0xd2, 0xff, 0x81, 0x40, 0x56, 0x69, 0xe8, 0xfa, 0x4e, 0x7d,
-	0x88, 0xb1, 0xe5, 0x92, 0x7a, 0x1b, 0x00, 0x8a, 0x13, 0xe9, 0x40, 0x1f, 0x69, 0x9e, 0xe2, 0x58,
-	0xd8, 0x06, 0x39, 0xe8, 0xe1, 0xc8, 0xa7, 0xe3, 0xd9, 0xa9, 0xec, 0xec, 0xd0, 0x52, 0xd1, 0x48,
-	0x9a, 0x48, 0x50, 0xdc, 0x44, 0x63, 0x19, 0xbb, 0x7e, 0x75, 0x65, 0xf7, 0x67, 0x29, 0xf3, 0xe9,
-	0x57, 0x69, 0xd6, 0x71, 0xe9, 0x7a, 0x64, 0x19, 0x4d, 0xec, 0xc9, 0xe4, 0xf2, 0xb1, 0x40, 0xec,
-	0x0d, 0x93, 0xb6, 0x03, 0x44, 0xb8, 0x80, 0x7c, 0x38, 0xdc, 0x99, 0x2f, 0xb4, 0x90, 0x03, 0x9b,
-	0xed, 0x06, 0xeb, 0x2d, 0xf9, 0x78, 0xb8, 0x33, 0xaf, 0xd4, 0x65, 0xc1, 0xca, 0xf5, 0xb7, 0xdb,
-	0xa5, 0xcc, 0x9f, 0xed, 0x52, 0xe6, 0x0d, 0xe3, 0xa5, 0xb3, 0xbf, 0x3b, 0xdc, 0x99, 0x57, 0x53,
-	0x9e, 0xb2, 0x45, 0xe5, 0x11, 0x30, 0x2c, 0x5f, 0xeb, 0x88, 0x04, 0xd8, 0x27, 0xa8, 0xfc, 0x55,
-	0x01, 0x85, 0x1a, 0x71, 0x6a, 0x51, 0x8b, 0xba, 0xbc, 0x8d, 0x77, 0x41, 0xce, 0xf5, 0x83, 0x88,
-	0xb2, 0x06, 0xb2, 0x40, 0x9a, 0xd1, 0x65, 0x2a, 0x8c, 0xc7, 0x8c, 0x52, 0xcd, 0xb3, 0x44, 0x72,
-	0x53, 0x42, 0xa4, 0xde, 0x07, 0x83, 0x38, 0xa2, 0x5c, 0x3f, 0xc0, 0xf5, 0x13, 0x5d, 0xf5, 0x4f,
-	0x39, 0x27, 0x6d, 0x10, 0xcb, 0x2a, 0x57, 0xe3, 0x48, 0xd2, 0x92, 0x85, 0x19, 0x3b, 0x1e, 0x26,
-	0xd9, 0x6d, 0xf9, 0x32, 0x18, 0x4d, 0xff, 0x4f, 0x62, 0x7d, 0x53, 0x78, 0xd4, 0xe7, 0x81, 0x0d,
-	0x29, 0x7a, 0x06, 0x43, 0xe8, 0x11, 0xf5, 0x16, 0xc8, 0xc3, 0x88, 0xae, 0xe3, 0xd0, 0xa5, 0xed,
-	0xbe, 0xd3, 0x71, 0x44, 0x55, 0xef, 0x81, 0x5c, 0xc0, 0x1d, 0xf8, 0x5c, 0xf4, 0x4a, 0x24, 0x8a,
-	0x1c, 0x6b, 0x89, 0x50, 0x55, 0x6e, 0xb2, 0x30, 0x47, 0x7e, 0x2c, 0xcf, 0x74, 0x2a, 0xcf, 0x96,
-	0x38, 0x24, 0x1d, 0xbb, 0x2d, 0x17, 0xc1, 0x58, 0xc7, 0x52, 0x12, 0xee, 0xaf, 0x02, 0x46, 0xf8,
-	0x77, 0xa4, 0x2c, 0xf3, 0x23, 0x1f, 0x5a, 0x2d, 0x64, 0xff, 0x77, 0xbc, 0x65, 0x50, 0x20, 0xc8,
-	0xb7, 0x1b, 0x48, 0xf8, 0xc8, 0xcf, 0x36, 0xd5, 0x35, 0x64, 0xaa, 0x5e, 0x7d, 0x88, 0xa4, 0x8a,
-	0xcf, 0x80, 0xe1, 0x88, 0xa0, 0x86, 0x8d, 0xd6, 0x60, 0xd4, 0xa2, 0x8d, 0x35, 0x1c, 0xf2, 0xf3,
-	0x90, 0xaf, 0x5f, 0x88, 0x08, 0x7a, 0x28, 0x56, 0x57, 0x70, 0x58, 0x31, 0x4f, 0xf6, 0x62, 0xb2,
-	0x73, 0x50, 0xd3, 0xa9, 0xca, 0x13, 0xa0, 0x78, 0x62, 0x31, 0x6e, 0xc4, 0xd2, 0xeb, 0x2c, 0xc8,
-	0xd6, 0x88, 0xa3, 0x3e, 0x01, 0xe7, 0xf8, 0xec, 0x4e, 0x76, 0xdd, 0xb4, 0x1c, 0x79, 0xed, 0xca,
-	0x69, 0x68, 0xec, 0xa9, 0xbe, 0x00, 0xf9, 0xa3, 0xc3, 0x30, 0xdd, 0x4b, 0x92, 0x50, 0xb4, 0xb9,
-	0xbe, 0x94, 0xc4, 0xda, 0x02, 0x85, 0x63, 0x03, 0xd9, 0x73, 0x43, 0x69, 0x96, 0x76, 0xed, 0x2c,
-	0xac, 0xa4, 0xc6, 0x3a, 0xb8, 0xd8, 0x31, 0x17, 0x33, 0xbd, 0x63, 0xa7, 0x79, 0x9a, 0x71, 0x36,
-	0x5e, 0x5c, 0x49, 0x3b, 0xff, 0x8a, 0x4d, 0x79, 0x75, 0x79, 0x77, 0x5f, 0x57, 0xf6, 0xf6, 0x75,
-	0xe5, 0xf7, 0xbe, 0xae, 0xbc, 0x3f, 0xd0, 0x33, 0x7b, 0x07, 0x7a, 0xe6, 0xc7, 0x81, 0x9e, 0x79,
-	0x39, 0x77, 0xea, 0x3d, 0x27, 0xc7, 0x9e, 0x5f, 0x77, 0x56, 0x8e, 0x5f, 0xe7, 0x37, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0x43, 0xa9, 0xa0, 0x06, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0xdb, 0x56,
+	0x1c, 0x8f, 0x81, 0x05, 0xe5, 0x91, 0x0d, 0x61, 0x10, 0x10, 0x83, 0x9c, 0xe0, 0x21, 0xc4, 0x8f,
+	0x61, 0x0f, 0x98, 0x40, 0xca, 0xb4, 0x69, 0x84, 0x0d, 0x69, 0x93, 0xa2, 0x4d, 0x41, 0x3b, 0x6c,
+	0x97, 0xc8, 0x89, 0x1f, 0xc6, 0x22, 0xf6, 0x8b, 0xfc, 0x9e, 0x29, 0xb9, 0xa1, 0x9e, 0xaa, 0x9e,
+	0x7a, 0xee, 0x89, 0x63, 0x55, 0xf5, 0xc0, 0xa1, 0xc7, 0xaa, 0xbd, 0xf4, 0xc0, 0x11, 0xf5, 0xd4,
+	0x53, 0x5b, 0xc1, 0x81, 0xf6, 0x8f, 0xa8, 0x54, 0xbd, 0xe7, 0x67, 0xe3, 0x24, 0x36, 0x01, 0x2e,
+	0xbd, 0x60, 0xf3, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0x7d, 0x3f, 0x1c, 0x30, 0x5d, 0x47, 0xd8, 0x46,
+	0x58, 0xab, 0xe9, 0xce, 0xbe, 0x76, 0xb0, 0x52, 0x83, 0x44, 0x5f, 0xd1, 0xc8, 0xa1, 0xda, 0x74,
+	0x11, 0x41, 0xe2, 0xa8, 0x8f, 0xaa, 0x14, 0x55, 0x39, 0x2a, 0x8d, 0x99, 0xc8, 0x44, 0x0c, 0xd7,
+	0xe8, 0x9b, 0x4f, 0x95, 0xe4, 0xd0, 0x08, 0xc3, 0xd0, 0xa8, 0x8e, 0x2c, 0xa7, 0x0b, 0x8f, 0x14,
+	0x62, 0xbe, 0x3e, 0x9e, 0xf3, 0xf1, 0xaa, 0x6f, 0xcc, 0xeb, 0xfa, 0xd0, 0x04, 0x97, 0xda, 0xd8,
+	0xd4, 0x0e, 0x56, 0xe8, 0x83, 0x03, 0x23, 0xba, 0x6d, 0x39, 0x48, 0x63, 0x7f, 0xfd, 0x21, 0xe5,
+	0x59, 0x1f, 0x18, 0x2c, 0x63, 0x73, 0x07, 0x3a, 0x86, 0xf8, 0x33, 0xc8, 0xee, 0xba, 0xc8, 0xae,
+	0xea, 0x86, 0xe1, 0x42, 0x8c, 0x27, 0x85, 0x82, 0x30, 0x9f, 0x29, 0x4d, 0xbe, 0x79, 0xbe, 0x3c,
+	0xc6, 0xfd, 0x37, 0x7d, 0x64, 0x87, 0xb8, 0x96, 0x63, 0x56, 0x86, 0x28, 0x9b, 0x0f, 0x89, 0x1b,
+	0x00, 0x10, 0x14, 0x4a, 0xfb, 0x7a, 0x48, 0x33, 0x04, 0x05, 0xc2, 0x16, 0x48, 0xeb, 0x36, 0xf2,
+	0x1c, 0x32, 0xd9, 0x5f, 0xe8, 0x9f, 0x1f, 0x5a, 0xcd, 0xa9, 0x61, 0x13, 0x31, 0x0c, 0x9a, 0xa8,
+	0x6e, 0x21, 0xcb, 0x29, 0x6d, 0x9f, 0xbe, 0xcb, 0xa7, 0x9e, 0xbe, 0xcf, 0xcf, 0x9b, 0x16, 0xd9,
+	0xf3, 0x6a, 0x6a, 0x1d, 0xd9, 0x3c, 0x39, 0x7f, 0x2c, 0x63, 0x63, 0x5f, 0x23, 0xad, 0x26, 0xc4,
+	0x4c, 0x80, 0x1f, 0x5f, 0x9e, 0x2c, 0x66, 0x1b, 0xd0, 0xd4, 0xeb, 0xad, 0x2a, 0xed, 0x2d, 0x7e,
+	0x72, 0x79, 0xb2, 0x28, 0x54, 0x78, 0xc1, 0xe2, 0x8f, 0x0f, 0x8e, 0xf3, 0xa9, 0x8f, 0xc7, 0xf9,
+	0xd4, 0x7d, 0xca, 0x8b, 0x66, 0x7f, 0x78, 0x79, 0xb2, 0x28, 0x46, 0x3c, 0x79, 0x8b, 0x94, 0x11,
+	0x30, 0xcc, 0x5f, 0x2b, 0x10, 0x37, 0x91, 0x83, 0xa1, 0xf2, 0x42, 0x00, 0xd9, 0x32, 0x36, 0xcb,
+	0x5e, 0x83, 0x58, 0xac, 0x8d, 0xbf, 0x80, 0xb4, 0xe5, 0x34, 0x3d, 0x42, 0x1b, 0x48, 0x03, 0x49,
+	0x6a, 0xcc, 0xae, 0x50, 0xff, 0xa4, 0x94, 0x52, 0x86, 0x26, 0xe2, 0x93, 0xf2, 0x45, 0xe2, 0x6f,
+	0x60, 0x10, 0x79, 0x84, 0xe9, 0xfb, 0x98, 0x7e, 0x2a, 0x56, 0xff, 0xb7, 0x47, 0x3a, 0x0c, 0x02,
+	0x59, 0x71, 0x29, 0x88, 0xc4, 0x2d, 0x69, 0x98, 0x89, 0xf6, 0x30, 0xe1, 0x6c, 0x95, 0x71, 0x30,
+	0x16, 0xfd, 0x3f, 0x8c, 0xf5, 0x4a, 0x60, 0x51, 0xff, 0x6d, 0x1a, 0x3a, 0x81, 0xff, 0xe8, 0xae,
+	0x6e, 0x63, 0x71, 0x1d, 0x64, 0x74, 0x8f, 0xec, 0x21, 0xd7, 0x22, 0xad, 0x9e, 0xbb, 0xe3, 0x8a,
+	0x2a, 0xfe, 0x0a, 0xd2, 0x4d, 0xe6, 0xc0, 0xf6, 0x45, 0x52, 0x22, 0xbf, 0x48, 0x5b, 0x4b, 0x7c,
+	0x55, 0xf1, 0x27, 0x1a, 0xe6, 0xca, 0x8f, 0xe6, 0x99, 0x89, 0xe4, 0x39, 0xf4, 0x0f, 0x49, 0xc7,
+	0x6c, 0x95, 0x1c, 0x98, 0xe8, 0x18, 0x0a, 0xc3, 0x7d, 0x12, 0xc0, 0x08, 0x5b, 0x47, 0x42, 0x33,
+	0xff, 0xe1, 0xe8, 0xb5, 0x06, 0x34, 0xee, 0x1c, 0x6f, 0x0b, 0x64, 0x31, 0x74, 0x8c, 0x2a, 0xf4,
+	0x7d, 0xf8, 0xb2, 0x15, 0x62, 0x43, 0x46, 0xea, 0x55, 0x86, 0x70, 0xa4, 0xf8, 0x1c, 0x18, 0xf6,
+	0x30, 0xac, 0x1a, 0x70, 0x57, 0xf7, 0x1a, 0xa4, 0xba, 0x8b, 0x5c, 0x76, 0x1e, 0x32, 0x95, 0x6f,
+	0x3d, 0x0c, 0x7f, 0xf7, 0x47, 0xb7, 0x91, 0x5b, 0xd4, 0xba, 0x7b, 0x31, 0xdd, 0xb9, 0x51, 0xa3,
+	0xa9, 0x94, 0x29, 0x90, 0xeb, 0x1a, 0x0c, 0x1b, 0xf1, 0x52, 0x00, 0xe3, 0x65, 0x6c, 0x6e, 0x1a,
+	0x46, 0xa9, 0x81, 0xea, 0xfb, 0xd0, 0xe0, 0x21, 0xe1, 0xdd, 0x17, 0x9b, 0xea, 0x02, 0x13, 0xd6,
+	0x8a, 0xeb, 0x75, 0x01, 0xb5, 0xd7, 0x22, 0xc7, 0xcf, 0x52, 0x29, 0x00, 0x39, 0x1e, 0x09, 0x23,
+	0xbe, 0x16, 0x58, 0x03, 0x2a, 0xd0, 0x46, 0x07, 0xf0, 0xab, 0xa7, 0xdc, 0xe8, 0x4e, 0x39, 0xdb,
+	0x9e, 0x32, 0x7e, 0xa2, 0xca, 0xf7, 0x60, 0x26, 0x11, 0x0c, 0xb2, 0xae, 0x7e, 0x1e, 0x00, 0xfd,
+	0x65, 0x6c, 0x8a, 0x7f, 0x81, 0x01, 0x76, 0x15, 0x4d, 0xc7, 0xee, 0x41, 0x7e, 0x83, 0x49, 0xb3,
+	0xd7, 0xa1, 0x81, 0xa7, 0xf8, 0x1f, 0xc8, 0x5c, 0xdd, 0x6d, 0x33, 0x49, 0x92, 0x90, 0x22, 0x2d,
+	0xf4, 0xa4, 0x84, 0xd6, 0x35, 0x90, 0x6d, 0xbb, 0x5f, 0x12, 0x27, 0x14, 0x65, 0x49, 0x3f, 0xdc,
+	0x84, 0x15, 0xd6, 0xd8, 0x03, 0xdf, 0x75, 0x1c, 0xf3, 0xb9, 0xe4, 0xd8, 0x51, 0x9e, 0xa4, 0xde,
+	0x8c, 0x17, 0x56, 0xba, 0x07, 0x46, 0xe3, 0xce, 0xd1, 0x52, 0x92, 0x4d, 0x0c, 0x59, 0x5a, 0xbb,
+	0x05, 0x39, 0x2c, 0x7c, 0x24, 0x80, 0xf1, 0x84, 0xed, 0x9d, 0x98, 0x21, 0x9e, 0x2f, 0xad, 0xdf,
+	0x8e, 0x1f, 0x4c, 0x41, 0xfa, 0xe6, 0x88, 0x5e, 0xd8, 0xa5, 0xad, 0xd3, 0x73, 0x59, 0x38, 0x3b,
+	0x97, 0x85, 0x0f, 0xe7, 0xb2, 0xf0, 0xe8, 0x42, 0x4e, 0x9d, 0x5d, 0xc8, 0xa9, 0xb7, 0x17, 0x72,
+	0xea, 0xff, 0x85, 0x6b, 0x3f, 0xd9, 0xfc, 0x06, 0x67, 0x5f, 0xee, 0x5a, 0x9a, 0xfd, 0x32, 0x59,
+	0xfb, 0x32, 0x00, 0x38, 0xc6, 0x3d, 0x2c, 0x6b, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +696,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// AddBlockedAddresses is a governance operation for restricting addresses
+	// from receiving funds, in addition to the addresses blocked by the app.
+	AddBlockedAddresses(ctx context.Context, in *MsgAddBlockedAddresses, opts ...grpc.CallOption) (*MsgAddBlockedAddressesResponse, error)
+	// RemoveBlockedAddresses is a governance operation for lifting the
+	// restriction of addresses from receiving funds, whether they were blocked
+	// by governance or by the app.
+	RemoveBlockedAddresses(ctx context.Context, in *MsgRemoveBlockedAddresses, opts ...grpc.CallOption) (*MsgRemoveBlockedAddressesResponse, error)
 }
 
 type msgClient struct {
@@ -547,6 +749,24 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) AddBlockedAddresses(ctx context.Context, in *MsgAddBlockedAddresses, opts ...grpc.CallOption) (*MsgAddBlockedAddressesResponse, error) {
+	out := new(MsgAddBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/AddBlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBlockedAddresses(ctx context.Context, in *MsgRemoveBlockedAddresses, opts ...grpc.CallOption) (*MsgRemoveBlockedAddressesResponse, error) {
+	out := new(MsgRemoveBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/RemoveBlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -565,6 +785,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// AddBlockedAddresses is a governance operation for restricting addresses
+	// from receiving funds, in addition to the addresses blocked by the app.
+	AddBlockedAddresses(context.Context, *MsgAddBlockedAddresses) (*MsgAddBlockedAddressesResponse, error)
+	// RemoveBlockedAddresses is a governance operation for lifting the
+	// restriction of addresses from receiving funds, whether they were blocked
+	// by governance or by the app.
+	RemoveBlockedAddresses(context.Context, *MsgRemoveBlockedAddresses) (*MsgRemoveBlockedAddressesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +810,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) AddBlockedAddresses(ctx context.Context, req *MsgAddBlockedAddresses) (*MsgAddBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedAddresses not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBlockedAddresses(ctx context.Context, req *MsgRemoveBlockedAddresses) (*MsgRemoveBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedAddresses not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -660,6 +893,42 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddBlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlockedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/AddBlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlockedAddresses(ctx, req.(*MsgAddBlockedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlockedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/RemoveBlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlockedAddresses(ctx, req.(*MsgRemoveBlockedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -680,6 +949,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "AddBlockedAddresses",
+			Handler:    _Msg_AddBlockedAddresses_Handler,
+		},
+		{
+			MethodName: "RemoveBlockedAddresses",
+			Handler:    _Msg_RemoveBlockedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -972,6 +1249,130 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddBlockedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlockedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlockedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlockedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlockedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlockedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1103,6 +1504,62 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddBlockedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBlockedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1838,6 +2295,334 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAddBlockedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBlockedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBlockedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBlockedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package ante
 import (
 	"github.com/cockroachdb/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
@@ -10,6 +12,12 @@ type CircuitBreaker interface {
 	IsAllowed(ctx sdk.Context, typeURL string) bool
 }
 
+// RateLimiter is implemented by the circuit breakers which can also throttle
+// the Msg's of a type URL instead of disabling them.
+type RateLimiter interface {
+	ConsumeRateLimit(ctx sdk.Context, typeURL string) error
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -22,12 +30,36 @@ func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
 }
 
 func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	// loop through all the messages and check if the message type is allowed
+	// loop through all the messages and check if the message type is allowed,
+	// along with the types of the messages they wrap, e.g. in an authz MsgExec
+	isAllowed := func(msgURL string) bool {
+		return cbd.circuitKeeper.IsAllowed(ctx, msgURL)
+	}
 	for _, msg := range tx.GetMsgs() {
-		if !cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg)) {
+		if !types.IsAllowedRecursive(msg, isAllowed) {
 			return ctx, errors.New("tx type not allowed")
 		}
 	}
 
+	if rl, ok := cbd.circuitKeeper.(RateLimiter); ok {
+		// the rate limits are only consumed by the txs executed in blocks, the
+		// other txs are checked against them without consuming them
+		rlCtx := ctx
+		if ctx.IsCheckTx() || simulate {
+			rlCtx, _ = ctx.CacheContext()
+		}
+
+		// the Msg's wrapped in other Msg's consume the rate limits of their
+		// own type URLs too, so that they cannot be used to bypass them
+		consume := func(msg sdk.Msg) error {
+			return rl.ConsumeRateLimit(rlCtx, sdk.MsgTypeURL(msg))
+		}
+		for _, msg := range tx.GetMsgs() {
+			if err := types.WalkMsgsRecursive(msg, consume); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
@@ -13,7 +13,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"cosmossdk.io/x/circuit/ante"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,3 +92,120 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+// MockRateLimiter allows a single execution of every Msg, counted in the store.
+type MockRateLimiter struct {
+	storeKey storetypes.StoreKey
+}
+
+func (m MockRateLimiter) IsAllowed(ctx sdk.Context, typeURL string) bool { return true }
+
+func (m MockRateLimiter) ConsumeRateLimit(ctx sdk.Context, typeURL string) error {
+	store := ctx.KVStore(m.storeKey)
+	if store.Has([]byte(typeURL)) {
+		return cbtypes.ErrRateLimited
+	}
+	store.Set([]byte(typeURL), []byte{})
+	return nil
+}
+
+func TestCircuitBreakerDecoratorRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	decorator := ante.NewCircuitBreakerDecorator(MockRateLimiter{storeKey: f.mockStoreKey})
+	require.NoError(t, f.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	tx := f.txBuilder.GetTx()
+
+	anteHandle := func(ctx sdk.Context, simulate bool) error {
+		_, err := decorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// checking and simulating the tx do not consume the rate limit
+	require.NoError(t, anteHandle(f.ctx.WithIsCheckTx(true), false))
+	require.NoError(t, anteHandle(f.ctx.WithIsCheckTx(true), false))
+	require.NoError(t, anteHandle(f.ctx, true))
+
+	require.NoError(t, anteHandle(f.ctx, false))
+	require.ErrorIs(t, anteHandle(f.ctx, false), cbtypes.ErrRateLimited)
+	require.ErrorIs(t, anteHandle(f.ctx.WithIsCheckTx(true), false), cbtypes.ErrRateLimited)
+}
+
+func TestCircuitBreakerDecoratorRateLimitNestedMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	msgSend := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	execSend := authz.NewMsgExec(addr2, []sdk.Msg{msgSend})
+	decorator := ante.NewCircuitBreakerDecorator(MockRateLimiter{storeKey: f.mockStoreKey})
+
+	anteHandle := func(msg sdk.Msg) error {
+		require.NoError(t, f.txBuilder.SetMsgs(msg))
+		_, err := decorator.AnteHandle(f.ctx, f.txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// the msg wrapped in an authz exec consumes the rate limit of its type url,
+	// which a msg sent directly then exceeds
+	require.NoError(t, anteHandle(&execSend))
+	require.ErrorIs(t, anteHandle(msgSend), cbtypes.ErrRateLimited)
+	require.True(t, f.ctx.KVStore(f.mockStoreKey).Has([]byte(sdk.MsgTypeURL(&execSend))))
+}
+
+// MockDisabledMsgs disables the Msg's of the given type URLs.
+type MockDisabledMsgs map[string]bool
+
+func (m MockDisabledMsgs) IsAllowed(ctx sdk.Context, typeURL string) bool {
+	return !m[typeURL]
+}
+
+func TestCircuitBreakerDecoratorNestedMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	msgSend := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	decorator := ante.NewCircuitBreakerDecorator(MockDisabledMsgs{sdk.MsgTypeURL(msgSend): true})
+
+	execSend := authz.NewMsgExec(addr2, []sdk.Msg{msgSend})
+	execExecSend := authz.NewMsgExec(addr2, []sdk.Msg{&execSend})
+	proposeSend, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msgSend}, nil, addr1.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+	proposeNothing, err := govv1.NewMsgSubmitProposal(nil, nil, addr1.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name    string
+		msg     sdk.Msg
+		allowed bool
+	}{
+		{name: "disabled msg", msg: msgSend},
+		{name: "disabled msg in authz exec", msg: &execSend},
+		{name: "disabled msg in nested authz exec", msg: &execExecSend},
+		{name: "disabled msg in gov proposal", msg: proposeSend},
+		{name: "gov proposal without msgs", msg: proposeNothing, allowed: true},
+		{name: "allowed msg in authz exec", msg: &authz.MsgExec{Grantee: addr2.String()}, allowed: true},
+	}
+
+	for _, tc := range testcases {
+		require.NoError(t, f.txBuilder.SetMsgs(tc.msg), tc.name)
+		_, err := decorator.AnteHandle(f.ctx, f.txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+
+		if tc.allowed {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.EqualError(t, err, "tx type not allowed", tc.name)
+		}
+	}
+}
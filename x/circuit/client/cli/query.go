@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/circuit/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +27,13 @@ func GetQueryCmd() *cobra.Command {
 		GetDisabeListCmd(),
 		GetAccountCmd(),
 		GetAccountsCmd(),
+		GetReadOnlyModeCmd(),
+		GetRateLimitsCmd(),
+		GetDisabledNFTsCmd(),
+		GetDisabledQueriesCmd(),
+		GetTripCapabilitiesCmd(),
+		GetRecoveryAccountCmd(),
+		GetCheckAuthorizationCmd(),
 	)
 
 	return cmd
@@ -117,3 +129,218 @@ func GetAccountsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetReadOnlyModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "read-only-mode",
+		Short: "Query whether the chain is in read-only mode and which message types are exempt",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReadOnlyMode(cmd.Context(), &types.QueryReadOnlyModeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Query the rate limited message types",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDisabledNFTsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disabled-nfts",
+		Short: "Query the paused nft classes and nfts",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisabledNFTs(cmd.Context(), &types.QueryDisabledNFTsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDisabledQueriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disabled-queries",
+		Short: "Query the disabled gRPC query routes",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisabledQueries(cmd.Context(), &types.QueryDisabledQueriesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTripCapabilitiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-capabilities",
+		Short: "Query the modules allowed to trip the circuit breaker, and the messages they can trip",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TripCapabilities(cmd.Context(), &types.QueryTripCapabilitiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetRecoveryAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-account",
+		Short: "Query the recovery account, which can only reset the circuit breaker",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecoveryAccount(cmd.Context(), &types.QueryRecoveryAccountRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCheckAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-authorization [address] [type_url] [permission_level] [limit_type_urls]",
+		Short: "Query whether an account may trip or reset the circuit breaker for a message",
+		Long: `Query whether an account may trip or reset the circuit breaker for a message,
+without submitting a transaction. The current permissions of the account are
+checked, unless a permission level is given, e.g. to check permissions before
+they are granted:
+		"SOME_MSGS" =     1,
+		"ALL_MSGS" =      2,
+		"SUPER_ADMIN" =   3,`,
+		Example: fmt.Sprintf(`%s query circuit check-authorization [address] cosmos.bank.v1beta1.MsgSend
+%s query circuit check-authorization [address] cosmos.bank.v1beta1.MsgSend 1 "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckAuthorizationRequest{Address: addr.String(), MsgTypeUrl: args[1]}
+			if len(args) > 2 {
+				lvl, err := math.ParseUint(args[2])
+				if err != nil {
+					return err
+				}
+				req.Level = types.Permissions_Level(lvl.Uint64())
+			}
+			if len(args) > 3 {
+				req.LimitTypeUrls = strings.Split(args[3], ",")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CheckAuthorization(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
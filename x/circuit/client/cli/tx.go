@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/version"
@@ -28,6 +29,14 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		AuthorizeCircuitBreakerCmd(),
 		TripCircuitBreakerCmd(),
+		TripAllCmd(),
+		ResetAllCmd(),
+		SetRateLimitCmd(),
+		RemoveRateLimitCmd(),
+		TripNFTCmd(),
+		ResetNFTCmd(),
+		TripQueryCmd(),
+		ResetQueryCmd(),
 	)
 
 	return txCmd
@@ -81,10 +90,15 @@ func AuthorizeCircuitBreakerCmd() *cobra.Command {
 // TripCircuitBreakerCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
 func TripCircuitBreakerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "disable [type_url]",
-		Short:   "disable a message from being executed",
-		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "disable [type_url]",
+		Short: "disable a message from being executed",
+		Long: `Disable one or more messages from being executed.
+Type urls may be glob patterns, e.g. "*.MsgSend", which are expanded against the
+messages registered in the app when the transaction is executed. Already
+disabled messages matching a pattern are skipped.`,
+		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"
+%s circuit disable "cosmos.staking.v1beta1.*,*.MsgSend"`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -105,10 +119,14 @@ func TripCircuitBreakerCmd() *cobra.Command {
 // ResetCircuitBreakerCmd returns a CLI command handler for creating a MsgRestCircuitBreaker transaction.
 func ResetCircuitBreakerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "reset [type_url]",
-		Short:   "Enable a message to be executed",
-		Example: fmt.Sprintf(`%s circuit reset "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "reset [type_url]",
+		Short: "Enable a message to be executed",
+		Long: `Enable one or more disabled messages to be executed again.
+Type urls may be glob patterns, e.g. "cosmos.bank.*", which are expanded against
+the disabled messages when the transaction is executed.`,
+		Example: fmt.Sprintf(`%s circuit reset "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"
+%s circuit reset "cosmos.bank.*"`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -127,3 +145,239 @@ func ResetCircuitBreakerCmd() *cobra.Command {
 
 	return cmd
 }
+
+// TripAllCmd returns a CLI command handler for creating a MsgTripAll transaction.
+func TripAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-all [exempt_type_urls]",
+		Short: "Put the chain in read-only mode, disabling all messages except the exempt ones",
+		Long: `Put the chain in read-only mode, disabling all messages except the exempt ones.
+Queries remain functional. The circuit module's reset and authorize messages are always exempt.`,
+		Example: fmt.Sprintf(`%s circuit disable-all "/cosmos.gov.v1.MsgVote,/cosmos.gov.v1beta1.MsgVote"`, version.AppName),
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var exemptURLs []string
+			if len(args) == 1 {
+				exemptURLs = strings.Split(args[0], ",")
+			}
+
+			msg := types.NewMsgTripAll(clientCtx.GetFromAddress().String(), exemptURLs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResetAllCmd returns a CLI command handler for creating a MsgResetAll transaction.
+func ResetAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reset-all",
+		Short:   "Lift the read-only mode enabled with disable-all",
+		Example: fmt.Sprintf(`%s circuit reset-all`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetAll(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SetRateLimitCmd returns a CLI command handler for creating a MsgSetRateLimit transaction.
+func SetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [type_url] [max_executions] [window_blocks]",
+		Short: "Limit the number of messages executed per window of blocks",
+		Long: `Limit the number of messages of each type url executed per window of blocks.
+The excess messages are rejected with a retriable error until the next window.
+Type urls may be glob patterns, e.g. "*.MsgSend", which are expanded against the
+messages registered in the app when the transaction is executed.`,
+		Example: fmt.Sprintf(`%s circuit rate-limit "/cosmos.staking.v1beta1.MsgCreateValidator" 10 1
+%s circuit rate-limit "/ibc.core.channel.v1.MsgChannelOpen*" 5 100`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxExecutions, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max executions: %w", err)
+			}
+
+			windowBlocks, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window blocks: %w", err)
+			}
+
+			msg := types.NewMsgSetRateLimit(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","), maxExecutions, windowBlocks)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveRateLimitCmd returns a CLI command handler for creating a MsgRemoveRateLimit transaction.
+func RemoveRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit [type_url]",
+		Short:   "Lift the rate limit of one or more messages",
+		Example: fmt.Sprintf(`%s circuit remove-rate-limit "/cosmos.staking.v1beta1.MsgCreateValidator"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRateLimit(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TripNFTCmd returns a CLI command handler for creating a MsgTripNFT transaction.
+func TripNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-nft [class_id] [nft_ids]",
+		Short: "Pause the nft messages acting on a nft class, or on some nfts of the class",
+		Long: `Pause the nft messages acting on a nft class, or on some nfts of the class.
+Nft ids are comma separated, the whole class is paused if they are omitted.`,
+		Example: fmt.Sprintf(`%s circuit trip-nft kitties
+%s circuit trip-nft kitties kitty1,kitty2`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nftIDs []string
+			if len(args) == 2 {
+				nftIDs = strings.Split(args[1], ",")
+			}
+
+			msg := types.NewMsgTripNFT(clientCtx.GetFromAddress().String(), args[0], nftIDs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResetNFTCmd returns a CLI command handler for creating a MsgResetNFT transaction.
+func ResetNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-nft [class_id] [nft_ids]",
+		Short: "Resume the nft messages paused on a nft class, or on some nfts of the class",
+		Long: `Resume the nft messages paused on a nft class, or on some nfts of the class.
+Nft ids are comma separated, the pause of the whole class is lifted if they are omitted.`,
+		Example: fmt.Sprintf(`%s circuit reset-nft kitties
+%s circuit reset-nft kitties kitty1,kitty2`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nftIDs []string
+			if len(args) == 2 {
+				nftIDs = strings.Split(args[1], ",")
+			}
+
+			msg := types.NewMsgResetNFT(clientCtx.GetFromAddress().String(), args[0], nftIDs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TripQueryCmd returns a CLI command handler for creating a MsgTripQuery transaction.
+func TripQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-query [query_routes]",
+		Short: "Disable some gRPC query routes on every node",
+		Long: `Disable some gRPC query routes on every node, e.g. expensive queries slowing
+down the nodes. Query routes are comma separated full gRPC method names, or glob
+patterns disabling a whole service or module.`,
+		Example: fmt.Sprintf(`%s circuit trip-query "/cosmos.bank.v1beta1.Query/DenomOwners"
+%s circuit trip-query "/cosmos.bank.v1beta1.Query/*"`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripQuery(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResetQueryCmd returns a CLI command handler for creating a MsgResetQuery transaction.
+func ResetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-query [query_routes]",
+		Short: "Enable the gRPC query routes disabled using trip-query",
+		Long: `Enable the gRPC query routes disabled using trip-query. Query routes are comma
+separated, as given to trip-query.`,
+		Example: fmt.Sprintf(`%s circuit reset-query "/cosmos.bank.v1beta1.Query/DenomOwners"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetQuery(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
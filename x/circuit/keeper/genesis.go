@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+
 	"cosmossdk.io/x/circuit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,6 +15,11 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	)
 
 	k.IteratePermissions(ctx, func(address []byte, perm types.Permissions) (stop bool) {
+		// an account whose permissions were revoked has none to export
+		if perm.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+			return false
+		}
+
 		add, err := k.addressCodec.BytesToString(address)
 		if err != nil {
 			panic(err)
@@ -25,19 +33,91 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	k.IterateDisableLists(ctx, func(address []byte, perm types.Permissions) (stop bool) {
-		disabledMsgs = append(disabledMsgs, perm.LimitTypeUrls...)
+	k.IterateDisabledMsgs(ctx, func(msgURL string) (stop bool) {
+		disabledMsgs = append(disabledMsgs, msgURL)
+		return false
+	})
+
+	readOnlyMode, _ := k.GetReadOnlyMode(ctx)
+
+	var rateLimits []*types.RateLimit
+	k.IterateRateLimits(ctx, func(rl types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, &rl)
+		return false
+	})
+
+	var disabledNFTs []*types.DisabledNFT
+	k.IterateDisabledNFTs(ctx, func(d types.DisabledNFT) (stop bool) {
+		disabledNFTs = append(disabledNFTs, &d)
+		return false
+	})
+
+	var tripCapabilities []*types.TripCapability
+	k.IterateTripCapabilities(ctx, func(c types.TripCapability) (stop bool) {
+		tripCapabilities = append(tripCapabilities, &c)
+		return false
+	})
+
+	var disabledQueries []string
+	k.IterateDisabledQueries(ctx, func(route string) (stop bool) {
+		disabledQueries = append(disabledQueries, route)
 		return false
 	})
 
+	var recoveryAccount string
+	if recovery := k.GetRecoveryAccount(ctx); len(recovery) > 0 {
+		var err error
+		if recoveryAccount, err = k.addressCodec.BytesToString(recovery); err != nil {
+			panic(err)
+		}
+	}
+
 	return &types.GenesisState{
-		AccountPermissions: permissions,
-		DisabledTypeUrls:   disabledMsgs,
+		AccountPermissions:  permissions,
+		DisabledTypeUrls:    disabledMsgs,
+		ReadOnlyMode:        readOnlyMode,
+		RateLimits:          rateLimits,
+		DisabledNfts:        disabledNFTs,
+		TripCapabilities:    tripCapabilities,
+		DisabledQueryRoutes: disabledQueries,
+		RecoveryAccount:     recoveryAccount,
 	}
 }
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k *Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err))
+	}
+
+	for _, accounts := range genState.AccountPermissions {
+		if err := k.validatePermissions(accounts.Permissions); err != nil {
+			panic(fmt.Errorf("invalid %s genesis state, account address: %s: %w", types.ModuleName, accounts.Address, err))
+		}
+	}
+	if err := k.CheckMsgTypeURLsRegistered(genState.DisabledTypeUrls); err != nil {
+		panic(fmt.Errorf("invalid %s genesis state, disabled type urls: %w", types.ModuleName, err))
+	}
+	rateLimitURLs := make([]string, len(genState.RateLimits))
+	for i, rl := range genState.RateLimits {
+		rateLimitURLs[i] = rl.MsgTypeUrl
+	}
+	if err := k.CheckMsgTypeURLsRegistered(rateLimitURLs); err != nil {
+		panic(fmt.Errorf("invalid %s genesis state, rate limited type urls: %w", types.ModuleName, err))
+	}
+
+	var recovery []byte
+	if genState.RecoveryAccount != "" {
+		var err error
+		if recovery, err = k.addressCodec.StringToBytes(genState.RecoveryAccount); err != nil {
+			panic(fmt.Errorf("invalid %s genesis state, recovery account: %w", types.ModuleName, err))
+		}
+		// the authorities may trip the circuit breaker, which the recovery account never does
+		if _, found := k.authorities[string(recovery)]; found || bytes.Equal(recovery, k.authority) {
+			panic(fmt.Errorf("invalid %s genesis state, recovery account %s is an authority", types.ModuleName, genState.RecoveryAccount))
+		}
+	}
+
 	for _, accounts := range genState.AccountPermissions {
 		add, err := k.addressCodec.StringToBytes(accounts.Address)
 		if err != nil {
@@ -53,4 +133,32 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		// Set the disabled type urls
 		k.DisableMsg(ctx, url)
 	}
+	if genState.ReadOnlyMode != nil {
+		if err := k.SetReadOnlyMode(ctx, genState.ReadOnlyMode); err != nil {
+			panic(err)
+		}
+	}
+	for _, rl := range genState.RateLimits {
+		if err := k.SetRateLimit(ctx, *rl); err != nil {
+			panic(err)
+		}
+	}
+	for _, d := range genState.DisabledNfts {
+		if err := k.DisableNFT(ctx, d.ClassId, d.NftId); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genState.TripCapabilities {
+		if err := k.SetTripCapability(ctx, *c); err != nil {
+			panic(fmt.Errorf("invalid %s genesis state, trip capability of %s: %w", types.ModuleName, c.Module, err))
+		}
+	}
+	for _, route := range genState.DisabledQueryRoutes {
+		if err := k.DisableQuery(ctx, route); err != nil {
+			panic(err)
+		}
+	}
+	if len(recovery) > 0 {
+		k.SetRecoveryAccount(ctx, recovery)
+	}
 }
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/types"
+)
+
+func TestInitGenesisValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   string
+	}{
+		{
+			name: "valid",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}},
+					{Address: addresses[2], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}},
+				},
+				DisabledTypeUrls: []string{"/" + msgSend},
+			},
+		},
+		{
+			name: "some msgs without type urls",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS}},
+				},
+			},
+			expErr: "requires at least one message type url",
+		},
+		{
+			name: "duplicate accounts",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}},
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}},
+				},
+			},
+			expErr: "duplicate account permissions",
+		},
+		{
+			name: "duplicate type urls",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend, "/" + msgSend}}},
+				},
+			},
+			expErr: "duplicate message type url",
+		},
+		{
+			name:     "duplicate disabled type urls",
+			genState: &types.GenesisState{DisabledTypeUrls: []string{msgSend, msgSend}},
+			expErr:   "duplicate disabled message type url",
+		},
+		{
+			name: "unknown type urls",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend, "cosmos.unknown.v1.MsgFoo"}}},
+				},
+			},
+			expErr: "message type urls are not registered in the app: cosmos.unknown.v1.MsgFoo",
+		},
+		{
+			name:     "duplicate disabled nfts",
+			genState: &types.GenesisState{DisabledNfts: []*types.DisabledNFT{{ClassId: "kitties"}, {ClassId: "kitties"}}},
+			expErr:   "duplicate disabled nft",
+		},
+		{
+			name: "duplicate trip capabilities",
+			genState: &types.GenesisState{TripCapabilities: []*types.TripCapability{
+				{Module: "evidence", MsgTypeUrls: []string{msgSend}},
+				{Module: "evidence", MsgTypeUrls: []string{msgSend}},
+			}},
+			expErr: "duplicate trip capability, module: evidence",
+		},
+		{
+			name:     "unknown disabled type urls",
+			genState: &types.GenesisState{DisabledTypeUrls: []string{"cosmos.unknown.v1.MsgFoo"}},
+			expErr:   "disabled type urls: message type urls are not registered in the app",
+		},
+		{
+			name:     "invalid disabled query routes",
+			genState: &types.GenesisState{DisabledQueryRoutes: []string{"cosmos.bank.v1beta1.Query"}},
+			expErr:   "invalid query route cosmos.bank.v1beta1.Query",
+		},
+		{
+			name:     "duplicate disabled query routes",
+			genState: &types.GenesisState{DisabledQueryRoutes: []string{"/cosmos.bank.v1beta1.Query/*", "cosmos.bank.v1beta1.Query/*"}},
+			expErr:   "duplicate disabled query route",
+		},
+		{
+			name: "recovery account with permissions",
+			genState: &types.GenesisState{
+				AccountPermissions: []*types.GenesisAccountPermissions{
+					{Address: addresses[4], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}},
+				},
+				RecoveryAccount: addresses[4],
+			},
+			expErr: "recovery account cannot be granted permissions",
+		},
+		{
+			name:     "recovery account is the authority",
+			genState: &types.GenesisState{RecoveryAccount: addresses[0]},
+			expErr:   "recovery account " + addresses[0] + " is an authority",
+		},
+		{
+			name:     "invalid recovery account",
+			genState: &types.GenesisState{RecoveryAccount: "invalid"},
+			expErr:   "recovery account",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ft := setupFixture(t)
+
+			if tc.expErr == "" {
+				require.NoError(t, tc.genState.Validate())
+				require.NotPanics(t, func() { ft.Keeper.InitGenesis(ft.Ctx, tc.genState) })
+				return
+			}
+
+			defer func() {
+				r := recover()
+				require.NotNil(t, r)
+				require.ErrorContains(t, r.(error), tc.expErr)
+			}()
+			ft.Keeper.InitGenesis(ft.Ctx, tc.genState)
+		})
+	}
+}
+
+func TestExportGenesis(t *testing.T) {
+	ft := setupFixture(t)
+	srv := msgServer{Keeper: ft.Keeper}
+
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err := srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+	revoked := &types.Permissions{Level: types.Permissions_LEVEL_NONE_UNSPECIFIED}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[2], Permissions: revoked})
+	require.NoError(t, err)
+	ft.Keeper.DisableMsg(ft.Ctx, "/"+msgSend)
+
+	// the disabled Msg type URLs are exported, not the permissions of the
+	// accounts, and the accounts without permissions are left out
+	genState := ft.Keeper.ExportGenesis(ft.Ctx)
+	require.Equal(t, []string{"/" + msgSend}, genState.DisabledTypeUrls)
+	require.Len(t, genState.AccountPermissions, 1)
+	require.Equal(t, somemsgs, genState.AccountPermissions[0].Permissions)
+	require.NoError(t, genState.Validate())
+
+	imported := setupFixture(t)
+	require.NotPanics(t, func() { imported.Keeper.InitGenesis(imported.Ctx, genState) })
+	require.False(t, imported.Keeper.IsAllowed(imported.Ctx, "/"+msgSend))
+}
+
+func TestPermissionsInvariant(t *testing.T) {
+	ft := setupFixture(t)
+	invariant := PermissionsInvariant(&ft.Keeper)
+
+	add1, err := ft.Keeper.addressCodec.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	add2, err := ft.Keeper.addressCodec.StringToBytes(addresses[2])
+	require.NoError(t, err)
+
+	require.NoError(t, ft.Keeper.SetPermissions(ft.Ctx, add1, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}))
+	require.NoError(t, ft.Keeper.SetPermissions(ft.Ctx, add2, &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN, LimitTypeUrls: []string{""}}))
+	_, broken := invariant(ft.Ctx)
+	require.False(t, broken)
+
+	require.NoError(t, ft.Keeper.SetPermissions(ft.Ctx, add1, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS}))
+	msg, broken := invariant(ft.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "requires at least one message type url")
+
+	require.NoError(t, ft.Keeper.SetPermissions(ft.Ctx, add1, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"cosmos.unknown.v1.MsgFoo"}}))
+	msg, broken = invariant(ft.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "cosmos.unknown.v1.MsgFoo")
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// GetGroupPolicy returns the id of the group of the given group policy
+// account, and false if the account is not a group policy account or no group
+// keeper is set.
+func (k *Keeper) GetGroupPolicy(ctx sdk.Context, address string) (uint64, bool) {
+	if k.groupKeeper == nil {
+		return 0, false
+	}
+
+	res, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: address})
+	if err != nil || res.Info == nil {
+		return 0, false
+	}
+
+	return res.Info.GroupId, true
+}
+
+// groupPolicyAttributes returns the event attribute with the given key holding
+// the id of the group of the given account, if it is a group policy account.
+// It lets the trips and resets decided by a group, e.g. a security council, be
+// attributed to the group.
+func (k *Keeper) groupPolicyAttributes(ctx sdk.Context, key, address string) []sdk.Attribute {
+	groupID, ok := k.GetGroupPolicy(ctx, address)
+	if !ok {
+		return nil
+	}
+
+	return []sdk.Attribute{sdk.NewAttribute(key, strconv.FormatUint(groupID, 10))}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// mockGroupKeeper knows the group of the given group policy accounts.
+type mockGroupKeeper map[string]uint64
+
+func (m mockGroupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	groupID, ok := m[req.Address]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+
+	return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: req.Address, GroupId: groupID}}, nil
+}
+
+func Test_GroupPolicyPermissions(t *testing.T) {
+	ft := setupFixture(t)
+
+	_, ok := ft.Keeper.GetGroupPolicy(ft.Ctx, addresses[1])
+	require.False(t, ok, "no group keeper is set")
+
+	ft.Keeper.SetGroupKeeper(mockGroupKeeper{addresses[1]: 7})
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	groupID, ok := ft.Keeper.GetGroupPolicy(ft.Ctx, addresses[1])
+	require.True(t, ok)
+	require.Equal(t, uint64(7), groupID)
+	_, ok = ft.Keeper.GetGroupPolicy(ft.Ctx, addresses[2])
+	require.False(t, ok)
+
+	// the group policy account of a security council is granted permissions
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err := srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	events := ft.Ctx.EventManager().Events()
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: "grantee_group_id", Value: "7"})
+	for _, attr := range events[len(events)-1].Attributes {
+		require.NotEqual(t, "granter_group_id", attr.Key)
+	}
+
+	// the trips and resets decided by the group are attributed to the group
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend))
+
+	events = ft.Ctx.EventManager().Events()
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: "group_id", Value: "7"})
+
+	_, err = srv.ResetCircuitBreaker(ft.Ctx, &types.MsgResetCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend))
+
+	events = ft.Ctx.EventManager().Events()
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: "group_id", Value: "7"})
+
+	// the group policy only holds the permissions it was granted
+	_, err = srv.TripAll(ft.Ctx, &types.MsgTripAll{Authority: addresses[1]})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all circuit invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "permissions", PermissionsInvariant(k))
+}
+
+// PermissionsInvariant checks that the permissions of every account are
+// consistent: accounts with LEVEL_SOME_MSGS list at least one Msg type URL, and
+// the listed Msg type URLs are neither duplicated nor unknown to the app.
+func PermissionsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IteratePermissions(ctx, func(address []byte, perms types.Permissions) (stop bool) {
+			if err := k.validatePermissions(&perms); err != nil {
+				broken++
+				addr, addrErr := k.addressCodec.BytesToString(address)
+				if addrErr != nil {
+					addr = fmt.Sprintf("%X", address)
+				}
+				msg += fmt.Sprintf("\t%s: %s\n", addr, err)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "permissions",
+			fmt.Sprintf("found %d accounts with inconsistent permissions\n%s", broken, msg)), broken != 0
+	}
+}
+
+// validatePermissions checks the Msg type URLs of the given permissions, they
+// must be registered in the app if the permissions are limited to them.
+func (k *Keeper) validatePermissions(perms *types.Permissions) error {
+	if err := perms.ValidateLimitTypeURLs(); err != nil {
+		return err
+	}
+
+	if perms.Level != types.Permissions_LEVEL_SOME_MSGS {
+		return nil
+	}
+
+	return k.CheckMsgTypeURLsRegistered(perms.LimitTypeUrls)
+}
@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
 	proto "github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,27 +21,93 @@ type Keeper struct {
 
 	authority []byte
 
+	// authorities are the additional authorities by address, with their
+	// maximum permissions, see AddAuthority.
+	authorities map[string]*types.Permissions
+
 	addressCodec address.Codec
+
+	// interfaceRegistry is used to expand Msg type URL glob patterns into the
+	// Msg type URLs registered in the app.
+	interfaceRegistry codectypes.InterfaceRegistry
+
+	// groupKeeper is optional, when set the events of the Msg's sent by group
+	// policy accounts are tagged with the id of the group of the policy.
+	groupKeeper types.GroupKeeper
+
+	// listeners are notified of the trips and resets once committed, see
+	// BreakerNotifier.
+	listeners []types.BreakerListener
 }
 
-// NewKeeper constructs a new Circuit Keeper instance
-func NewKeeper(storeKey storetypes.StoreKey, authority string, addressCodec address.Codec) Keeper {
+// NewKeeper constructs a new Circuit Keeper instance, notifying the given
+// breaker listeners of the trips and resets.
+func NewKeeper(storeKey storetypes.StoreKey, authority string, addressCodec address.Codec, interfaceRegistry codectypes.InterfaceRegistry, listeners ...types.BreakerListener) Keeper {
 	auth, err := addressCodec.StringToBytes(authority)
 	if err != nil {
 		panic(err)
 	}
 
 	return Keeper{
-		storekey:     storeKey,
-		authority:    auth,
-		addressCodec: addressCodec,
+		storekey:          storeKey,
+		authority:         auth,
+		authorities:       make(map[string]*types.Permissions),
+		addressCodec:      addressCodec,
+		interfaceRegistry: interfaceRegistry,
+		listeners:         listeners,
 	}
 }
 
+// SetGroupKeeper sets the optional group keeper, used to tell whether the
+// accounts holding circuit breaker permissions are group policy accounts.
+func (k *Keeper) SetGroupKeeper(groupKeeper types.GroupKeeper) {
+	k.groupKeeper = groupKeeper
+}
+
 func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
+// AddAuthority adds an authority holding the given circuit breaker
+// permissions in addition to the module authority, e.g. the module account of
+// a security council. Unlike the module authority, which can take every
+// action, the additional authority is restricted to these permissions, which
+// cannot be granted nor revoked on chain. It must be called before the msg
+// server is created, and panics if the authority is invalid or already added,
+// or if the permissions are invalid.
+func (k *Keeper) AddAuthority(authority string, perms types.Permissions) {
+	auth, err := k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	if bytes.Equal(auth, k.authority) {
+		panic(fmt.Sprintf("%s is already the module authority", authority))
+	}
+
+	if _, found := k.authorities[string(auth)]; found {
+		panic(fmt.Sprintf("authority %s is added twice", authority))
+	}
+
+	if err := types.CheckPermission(&types.GenesisAccountPermissions{Address: authority, Permissions: &perms}); err != nil {
+		panic(err)
+	}
+
+	k.authorities[string(auth)] = &perms
+}
+
+// GetAccountPermissions returns the permissions the account with the given
+// address acts with: the maximum permissions of an additional authority, or
+// the permissions granted to any other account. The module authority takes
+// every action regardless of its permissions.
+func (k *Keeper) GetAccountPermissions(ctx sdk.Context, address []byte) (*types.Permissions, error) {
+	if perms, found := k.authorities[string(address)]; found {
+		return perms, nil
+	}
+
+	return k.GetPermissions(ctx, address)
+}
+
 func (k *Keeper) GetPermissions(ctx sdk.Context, address []byte) (*types.Permissions, error) {
 	store := ctx.KVStore(k.storekey)
 
@@ -66,7 +138,125 @@ func (k *Keeper) SetPermissions(ctx sdk.Context, address []byte, perms *types.Pe
 
 func (k *Keeper) IsAllowed(ctx sdk.Context, msgURL string) bool {
 	store := ctx.KVStore(k.storekey)
-	return !store.Has(types.CreateDisableMsgPrefix(msgURL))
+	if store.Has(types.CreateDisableMsgPrefix(msgURL)) {
+		return false
+	}
+
+	mode, enabled := k.GetReadOnlyMode(ctx)
+	return !enabled || types.IsExemptFromReadOnlyMode(mode, msgURL)
+}
+
+// IsAllowedRecursive returns true if the given Msg and all the Msg's it wraps,
+// e.g. the Msg's of an authz MsgExec or of a gov MsgSubmitProposal, are
+// allowed.
+func (k *Keeper) IsAllowedRecursive(ctx sdk.Context, msg sdk.Msg) bool {
+	return types.IsAllowedRecursive(msg, func(msgURL string) bool {
+		return k.IsAllowed(ctx, msgURL)
+	})
+}
+
+// GetReadOnlyMode returns the read-only mode state and whether the chain is
+// currently in read-only mode.
+func (k *Keeper) GetReadOnlyMode(ctx sdk.Context) (*types.ReadOnlyMode, bool) {
+	bz := ctx.KVStore(k.storekey).Get(types.ReadOnlyModeKey)
+	if bz == nil {
+		return nil, false
+	}
+
+	mode := &types.ReadOnlyMode{}
+	if err := proto.Unmarshal(bz, mode); err != nil {
+		panic(err)
+	}
+
+	return mode, true
+}
+
+// SetReadOnlyMode puts the chain in read-only mode, pausing every Msg that is
+// not exempted by the given mode.
+func (k *Keeper) SetReadOnlyMode(ctx sdk.Context, mode *types.ReadOnlyMode) error {
+	bz, err := proto.Marshal(mode)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storekey).Set(types.ReadOnlyModeKey, bz)
+	return nil
+}
+
+// ResetReadOnlyMode lifts the read-only mode.
+func (k *Keeper) ResetReadOnlyMode(ctx sdk.Context) {
+	ctx.KVStore(k.storekey).Delete(types.ReadOnlyModeKey)
+}
+
+// ExpandMsgTypeURLs resolves the glob patterns among the given Msg type URLs,
+// e.g. "cosmos.staking.v1beta1.Msg*Delegate" or "*.MsgSend", into the
+// matching Msg type URLs registered in the app for which include returns true.
+// Entries that are not patterns are returned as is. An error is returned if a
+// pattern is malformed or does not match any Msg.
+func (k *Keeper) ExpandMsgTypeURLs(msgURLs []string, include func(msgURL string) bool) ([]string, error) {
+	var registered []string
+	seen := make(map[string]bool, len(msgURLs))
+	expanded := make([]string, 0, len(msgURLs))
+
+	for _, msgURL := range msgURLs {
+		if !types.IsMsgTypeURLPattern(msgURL) {
+			if !seen[msgURL] {
+				seen[msgURL] = true
+				expanded = append(expanded, msgURL)
+			}
+			continue
+		}
+
+		if registered == nil {
+			registered = k.interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName)
+			sort.Strings(registered)
+		}
+
+		var matched bool
+		for _, registeredURL := range registered {
+			ok, err := types.MatchMsgTypeURL(msgURL, registeredURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid message type url pattern %s: %w", msgURL, err)
+			}
+			if !ok || !include(registeredURL) {
+				continue
+			}
+
+			matched = true
+			if !seen[registeredURL] {
+				seen[registeredURL] = true
+				expanded = append(expanded, registeredURL)
+			}
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("no applicable message matches the pattern %s", msgURL)
+		}
+	}
+
+	return expanded, nil
+}
+
+// CheckMsgTypeURLsRegistered returns an error listing the given Msg type URLs
+// which are not registered in the app.
+func (k *Keeper) CheckMsgTypeURLsRegistered(msgURLs []string) error {
+	registered := make(map[string]bool)
+	for _, msgURL := range k.interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		registered[strings.TrimPrefix(msgURL, "/")] = true
+	}
+
+	var unknown []string
+	for _, msgURL := range msgURLs {
+		if !registered[strings.TrimPrefix(msgURL, "/")] {
+			unknown = append(unknown, msgURL)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("message type urls are not registered in the app: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
 }
 
 func (k *Keeper) DisableMsg(ctx sdk.Context, msgURL string) {
@@ -100,26 +290,12 @@ func (k *Keeper) IteratePermissions(ctx sdk.Context, cb func(address []byte, per
 	}
 }
 
+// IterateDisableLists iterates over the Msg type URLs disabled by the circuit
+// breaker. The disable list stores no permissions, so perms is always empty.
+//
+// Deprecated: use IterateDisabledMsgs instead.
 func (k *Keeper) IterateDisableLists(ctx sdk.Context, cb func(url []byte, perms types.Permissions) (stop bool)) {
-	store := ctx.KVStore(k.storekey)
-
-	iter := storetypes.KVStorePrefixIterator(store, types.AccountPermissionPrefix)
-	defer func(iter storetypes.Iterator) {
-		err := iter.Close()
-		if err != nil {
-			return
-		}
-	}(iter)
-
-	for ; iter.Valid(); iter.Next() {
-		var perms types.Permissions
-		err := proto.Unmarshal(iter.Value(), &perms)
-		if err != nil {
-			panic(err)
-		}
-
-		if cb(iter.Key()[len(types.DisableListPrefix):], perms) {
-			break
-		}
-	}
+	k.IterateDisabledMsgs(ctx, func(msgURL string) (stop bool) {
+		return cb([]byte(msgURL), types.Permissions{})
+	})
 }
@@ -11,9 +11,12 @@ import (
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type fixture struct {
@@ -27,7 +30,7 @@ type fixture struct {
 func initFixture(t *testing.T) *fixture {
 	ac := addresscodec.NewBech32Codec("cosmos")
 	mockStoreKey := storetypes.NewKVStoreKey("test")
-	k := keeper.NewKeeper(mockStoreKey, authtypes.NewModuleAddress("gov").String(), ac)
+	k := keeper.NewKeeper(mockStoreKey, authtypes.NewModuleAddress("gov").String(), ac, codectypes.NewInterfaceRegistry())
 
 	bz, err := ac.StringToBytes(authtypes.NewModuleAddress("gov").String())
 	require.NoError(t, err)
@@ -99,37 +102,66 @@ func TestIteratePermissions(t *testing.T) {
 	require.Equal(t, mockPerms, returnedPerms)
 }
 
-func TestIterateDisabledList(t *testing.T) {
+func TestIterateDisabledMsgs(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
 
-	mockPerms := []types.Permissions{
-		{Level: types.Permissions_LEVEL_SUPER_ADMIN, LimitTypeUrls: []string{"url1", "url2"}},
-		{Level: types.Permissions_LEVEL_ALL_MSGS},
-		{Level: types.Permissions_LEVEL_NONE_UNSPECIFIED},
-	}
+	// the permissions of an account are not part of the disable list
+	f.keeper.SetPermissions(f.ctx, []byte("mock_address_1"), &types.Permissions{
+		Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"url1", "url2"},
+	})
 
-	// Set the permissions for a set of mock addresses
-	mockAddrs := [][]byte{
-		[]byte("mock_address_1"),
-		[]byte("mock_address_2"),
-		[]byte("mock_address_3"),
-	}
+	f.keeper.DisableMsg(f.ctx, "url3")
+	f.keeper.DisableMsg(f.ctx, "url4")
 
-	for i, addr := range mockAddrs {
-		f.keeper.SetPermissions(f.ctx, addr, &mockPerms[i])
-	}
+	var disabled []string
+	f.keeper.IterateDisabledMsgs(f.ctx, func(msgURL string) bool {
+		disabled = append(disabled, msgURL)
+		return false
+	})
+
+	require.Equal(t, []string{"url3", "url4"}, disabled)
+}
+
+func TestIterateDisableLists(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
 
-	// Define a variable to store the returned disabled URLs
-	var returnedDisabled []types.Permissions
+	f.keeper.DisableMsg(f.ctx, "url1")
+	f.keeper.DisableMsg(f.ctx, "url2")
 
-	f.keeper.IterateDisableLists(f.ctx, func(address []byte, perms types.Permissions) bool {
-		returnedDisabled = append(returnedDisabled, perms)
+	var disabled []string
+	f.keeper.IterateDisableLists(f.ctx, func(url []byte, perms types.Permissions) bool { //nolint:staticcheck // testing deprecated code
+		require.Equal(t, types.Permissions{}, perms)
+		disabled = append(disabled, string(url))
 		return false
 	})
 
-	// Assert that the returned disabled URLs match the set mock disabled URLs
-	require.Equal(t, mockPerms[0].LimitTypeUrls, returnedDisabled[0].LimitTypeUrls)
-	require.Equal(t, mockPerms[1].LimitTypeUrls, returnedDisabled[1].LimitTypeUrls)
-	require.Equal(t, mockPerms[2].LimitTypeUrls, returnedDisabled[2].LimitTypeUrls)
+	require.Equal(t, []string{"url1", "url2"}, disabled)
+}
+
+func TestIsAllowedRecursive(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	grantee := sdk.AccAddress("grantee")
+	msgSend := &banktypes.MsgSend{FromAddress: sdk.AccAddress("from").String(), ToAddress: sdk.AccAddress("to").String()}
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{msgSend})
+	require.True(t, f.keeper.IsAllowedRecursive(f.ctx, &exec))
+
+	// a disabled msg cannot be executed through an authz exec
+	f.keeper.DisableMsg(f.ctx, sdk.MsgTypeURL(msgSend))
+	require.True(t, f.keeper.IsAllowed(f.ctx, sdk.MsgTypeURL(&exec)))
+	require.False(t, f.keeper.IsAllowedRecursive(f.ctx, &exec))
+
+	// the msgs nested too deep are disallowed
+	f.keeper.EnableMsg(f.ctx, sdk.MsgTypeURL(msgSend))
+	msg := sdk.Msg(msgSend)
+	for i := 0; i < types.MaxNestedMsgDepth; i++ {
+		nested := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		msg = &nested
+	}
+	require.True(t, f.keeper.IsAllowedRecursive(f.ctx, msg))
+	nested := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+	require.False(t, f.keeper.IsAllowedRecursive(f.ctx, &nested))
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = breakerNotifier{}
+
+// BreakerNotifier returns the ABCIListener notifying the breaker listeners of
+// the keeper of the trips and resets committed in every block, or nil if the
+// keeper has no listeners. It must be registered with the streaming manager of
+// the app, which must stream the changes of the circuit store, as done by
+// circuit.RegisterBreakerNotifier.
+func (k *Keeper) BreakerNotifier() storetypes.ABCIListener {
+	if len(k.listeners) == 0 {
+		return nil
+	}
+
+	return breakerNotifier{storeKey: k.storekey.Name(), listeners: k.listeners}
+}
+
+// StoreKey returns the key of the circuit store.
+func (k *Keeper) StoreKey() storetypes.StoreKey {
+	return k.storekey
+}
+
+// breakerNotifier reads the trips and resets from the changes committed to the
+// circuit store, and passes them to the breaker listeners.
+type breakerNotifier struct {
+	storeKey  string
+	listeners []types.BreakerListener
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, the trips and resets
+// are only notified once committed.
+func (n breakerNotifier) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (n breakerNotifier) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var errs []error
+	for _, pair := range changeSet {
+		if pair.StoreKey != n.storeKey || !bytes.HasPrefix(pair.Key, types.DisableListPrefix) {
+			continue
+		}
+
+		// the keys created by CreateDisableMsgPrefix end with a zero byte
+		key := pair.Key[len(types.DisableListPrefix):]
+		msgURL := string(key[:len(key)-1])
+
+		for _, listener := range n.listeners {
+			if pair.Delete {
+				if err := listener.OnReset(ctx, height, msgURL); err != nil {
+					errs = append(errs, fmt.Errorf("failed to notify the reset of %s: %w", msgURL, err))
+				}
+				continue
+			}
+
+			if err := listener.OnTrip(ctx, height, msgURL); err != nil {
+				errs = append(errs, fmt.Errorf("failed to notify the trip of %s: %w", msgURL, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
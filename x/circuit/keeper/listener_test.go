@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+)
+
+// recordingListener records the trips and resets it is notified of, and fails
+// to be notified of the Msg type URLs in fail
+type recordingListener struct {
+	notified []string
+	fail     map[string]bool
+}
+
+func (l *recordingListener) OnTrip(_ context.Context, height int64, msgURL string) error {
+	return l.record("trip", height, msgURL)
+}
+
+func (l *recordingListener) OnReset(_ context.Context, height int64, msgURL string) error {
+	return l.record("reset", height, msgURL)
+}
+
+func (l *recordingListener) record(action string, height int64, msgURL string) error {
+	if l.fail[msgURL] {
+		return errors.New("pager unavailable")
+	}
+	l.notified = append(l.notified, fmt.Sprintf("%s %s at %d", action, msgURL, height))
+	return nil
+}
+
+func TestBreakerNotifier(t *testing.T) {
+	ft := setupFixture(t)
+	require.Nil(t, ft.Keeper.BreakerNotifier())
+
+	first := &recordingListener{}
+	second := &recordingListener{fail: map[string]bool{msgDelegateURL: true}}
+	k := NewKeeper(ft.Keeper.storekey, addresses[0], ft.Keeper.addressCodec, ft.Keeper.interfaceRegistry, first, second)
+	notifier := k.BreakerNotifier()
+	require.NotNil(t, notifier)
+
+	ctx := ft.Ctx.WithBlockHeight(10)
+	require.NoError(t, notifier.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}))
+
+	storeKey := ft.Keeper.storekey.Name()
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: storeKey, Key: types.CreateDisableMsgPrefix(msgSendURL), Value: []byte{}},
+		// the changes to the other stores and to the other circuit state are ignored
+		{StoreKey: "bank", Key: types.CreateDisableMsgPrefix(msgDelegateURL), Value: []byte{}},
+		{StoreKey: storeKey, Key: types.ReadOnlyModeKey, Value: []byte{}},
+		{StoreKey: storeKey, Key: types.CreateDisableMsgPrefix(msgSendURL), Delete: true},
+		{StoreKey: storeKey, Key: types.CreateDisableMsgPrefix(msgDelegateURL), Value: []byte{}},
+	}
+	err := notifier.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+	require.ErrorContains(t, err, "failed to notify the trip of "+msgDelegateURL)
+
+	// the failure of a listener does not prevent the others from being notified
+	expected := []string{
+		"trip " + msgSendURL + " at 10",
+		"reset " + msgSendURL + " at 10",
+		"trip " + msgDelegateURL + " at 10",
+	}
+	require.Equal(t, expected, first.notified)
+	require.Equal(t, expected[:2], second.notified)
+}
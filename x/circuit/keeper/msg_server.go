@@ -4,6 +4,7 @@ import (
 	"bytes"
 	context "context"
 	fmt "fmt"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -36,7 +37,7 @@ func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.M
 	// if the granter is the module authority no need to check perms
 	if !bytes.Equal(address, srv.GetAuthority()) {
 		// Check that the authorizer has the permission level of "super admin"
-		perms, err := srv.GetPermissions(ctx, address)
+		perms, err := srv.GetAccountPermissions(ctx, address)
 		if err != nil {
 			return nil, fmt.Errorf("user permission does not exist %w", err)
 		}
@@ -51,6 +52,29 @@ func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.M
 		return nil, err
 	}
 
+	// the recovery account can only reset the circuit breaker, so it is never
+	// granted permissions
+	if srv.IsRecoveryAccount(ctx, grantee) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot grant permissions to the recovery account %s", msg.Grantee)
+	}
+
+	// the permissions of the additional authorities are fixed by the app config
+	if _, found := srv.authorities[string(grantee)]; found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot change the permissions of the authority %s", msg.Grantee)
+	}
+
+	// the permissions are checked like InitGenesis does, so that the exported
+	// genesis can always be imported
+	if msg.Permissions == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be empty")
+	}
+	if _, ok := types.Permissions_Level_name[int32(msg.Permissions.Level)]; !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permission level %s", msg.Permissions.Level)
+	}
+	if err := srv.validatePermissions(msg.Permissions); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	// Append the account in the msg to the store's set of authorized super admins
 	if err = srv.SetPermissions(ctx, grantee, msg.Permissions); err != nil {
 		return nil, err
@@ -62,7 +86,9 @@ func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.M
 			sdk.NewAttribute("granter", msg.Granter),
 			sdk.NewAttribute("grantee", msg.Grantee),
 			sdk.NewAttribute("permission", msg.Permissions.String()),
-		),
+		).
+			AppendAttributes(srv.groupPolicyAttributes(ctx, "granter_group_id", msg.Granter)...).
+			AppendAttributes(srv.groupPolicyAttributes(ctx, "grantee_group_id", msg.Grantee)...),
 	})
 
 	return &types.MsgAuthorizeCircuitBreakerResponse{
@@ -79,51 +105,39 @@ func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTri
 	}
 
 	// Check that the account has the permissions
-	perms, err := srv.GetPermissions(ctx, address)
+	perms, err := srv.GetAccountPermissions(ctx, address)
 	if err != nil {
 		return nil, fmt.Errorf("user permission does not exist %w", err)
 	}
 
-	store := ctx.KVStore(srv.storekey)
+	// expand the patterns into the messages that are not disabled yet
+	msgTypeURLs, err := srv.ExpandMsgTypeURLs(msg.MsgTypeUrls, func(msgURL string) bool {
+		return srv.IsAllowed(ctx, msgURL)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	switch {
-	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
-		for _, msgTypeURL := range msg.MsgTypeUrls {
-			// check if the message is in the list of allowed messages
-			if !srv.IsAllowed(ctx, msgTypeURL) {
-				return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
-			}
-			store.Set(types.CreateDisableMsgPrefix(msgTypeURL), []byte{0x01})
-		}
-	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
-		for _, msgTypeURL := range msg.MsgTypeUrls {
-			// check if the message is in the list of allowed messages
-			if !srv.IsAllowed(ctx, msgTypeURL) {
-				return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
-			}
-			for _, msgurl := range perms.LimitTypeUrls {
-				if msgTypeURL == msgurl {
-					store.Set(types.CreateDisableMsgPrefix(msgTypeURL), []byte{0x01})
-				} else {
-					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
-				}
-			}
-		}
-	default:
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
+	if err := srv.checkTripPermission(ctx, address, perms, msgTypeURLs); err != nil {
+		return nil, err
 	}
 
-	var urls string
-	if len(msg.GetMsgTypeUrls()) > 1 {
-		urls = strings.Join(msg.GetMsgTypeUrls(), ",")
+	store := ctx.KVStore(srv.storekey)
+
+	for _, msgTypeURL := range msgTypeURLs {
+		// check if the message is in the list of allowed messages
+		if !srv.IsAllowed(ctx, msgTypeURL) {
+			return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
+		}
+		store.Set(types.CreateDisableMsgPrefix(msgTypeURL), []byte{0x01})
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			"trip_circuit_breaker",
 			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
-		),
+			sdk.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
 	})
 
 	return &types.MsgTripCircuitBreakerResponse{
@@ -143,37 +157,469 @@ func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgRe
 	}
 
 	// Get the permissions for the account specified in the msg.Authority field
-	perms, err := keeper.GetPermissions(ctx, address)
+	perms, err := keeper.GetAccountPermissions(ctx, address)
 	if err != nil {
 		return nil, fmt.Errorf("user permission does not exist %w", err)
 	}
 
+	// expand the patterns into the messages that are currently disabled
+	msgTypeURLs, err := keeper.ExpandMsgTypeURLs(msg.MsgTypeUrls, func(msgURL string) bool {
+		return !keeper.IsAllowed(ctx, msgURL)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.checkResetPermission(ctx, address, perms); err != nil {
+		return nil, err
+	}
+
+	// remove all msg type urls from the disable list
 	store := ctx.KVStore(srv.storekey)
+	for _, msgTypeURL := range msgTypeURLs {
+		if srv.IsAllowed(ctx, msgTypeURL) {
+			return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
+		}
+		store.Delete(types.CreateDisableMsgPrefix(msgTypeURL))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"reset_circuit_breaker",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+}
+
+// TripAll puts the chain in read-only mode: processing of every Msg whose type
+// URL is not exempted is paused, while queries remain functional.
+func (srv msgServer) TripAll(goCtx context.Context, msg *types.MsgTripAll) (*types.MsgTripAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := srv.checkAllMsgsPermission(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, enabled := srv.GetReadOnlyMode(ctx); enabled {
+		return nil, fmt.Errorf("read-only mode is already enabled")
+	}
+
+	mode := &types.ReadOnlyMode{ExemptMsgTypeUrls: msg.ExemptMsgTypeUrls}
+	if err := srv.SetReadOnlyMode(ctx, mode); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"trip_all_circuit_breaker",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("exempt_msg_urls", strings.Join(msg.ExemptMsgTypeUrls, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgTripAllResponse{Success: true}, nil
+}
+
+// ResetAll lifts the read-only mode enabled using TripAll.
+func (srv msgServer) ResetAll(goCtx context.Context, msg *types.MsgResetAll) (*types.MsgResetAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := srv.checkAllMsgsResetPermission(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
 
-	if perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || perms.Level == types.Permissions_LEVEL_SOME_MSGS || bytes.Equal(address, srv.GetAuthority()) {
-		// add all msg type urls to the disable list
-		for _, msgTypeURL := range msg.MsgTypeUrls {
-			if srv.IsAllowed(ctx, msgTypeURL) {
-				return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
+	if _, enabled := srv.GetReadOnlyMode(ctx); !enabled {
+		return nil, fmt.Errorf("read-only mode is not enabled")
+	}
+
+	srv.ResetReadOnlyMode(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"reset_all_circuit_breaker",
+			sdk.NewAttribute("authority", msg.Authority),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgResetAllResponse{Success: true}, nil
+}
+
+// SetRateLimit throttles the processing of Msg's in the state machine: at most
+// a given number of Msg's of each type URL are processed per window of blocks.
+func (srv msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := srv.GetAccountPermissions(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("user permission does not exist %w", err)
+	}
+
+	msgTypeURLs, err := srv.ExpandMsgTypeURLs(msg.MsgTypeUrls, func(string) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	// rate limiting requires the same permissions as tripping the circuit breaker
+	switch {
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		for _, msgTypeURL := range msgTypeURLs {
+			if !isLimitTypeURL(perms, msgTypeURL) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit message %s", msgTypeURL)
 			}
-			store.Delete(types.CreateDisableMsgPrefix(msgTypeURL))
 		}
-	} else {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit messages")
 	}
 
-	var urls string
-	if len(msg.GetMsgTypeUrls()) > 1 {
-		urls = strings.Join(msg.GetMsgTypeUrls(), ",")
+	for _, msgTypeURL := range msgTypeURLs {
+		rl := types.RateLimit{MsgTypeUrl: msgTypeURL, MaxExecutions: msg.MaxExecutions, WindowBlocks: msg.WindowBlocks}
+		if err := srv.Keeper.SetRateLimit(ctx, rl); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			"reset_circuit_breaker",
+			"set_rate_limit",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+			sdk.NewAttribute("max_executions", strconv.FormatUint(msg.MaxExecutions, 10)),
+			sdk.NewAttribute("window_blocks", strconv.FormatUint(msg.WindowBlocks, 10)),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgSetRateLimitResponse{Success: true}, nil
+}
+
+// RemoveRateLimit lifts the rate limits set using SetRateLimit.
+func (srv msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := srv.GetAccountPermissions(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("user permission does not exist %w", err)
+	}
+
+	// expand the patterns into the messages that are currently rate limited
+	msgTypeURLs, err := srv.ExpandMsgTypeURLs(msg.MsgTypeUrls, func(msgURL string) bool {
+		_, found := srv.GetRateLimit(ctx, msgURL)
+		return found
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// removing rate limits requires the same permissions as resetting the circuit breaker
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN && perms.Level != types.Permissions_LEVEL_ALL_MSGS && perms.Level != types.Permissions_LEVEL_SOME_MSGS && !bytes.Equal(address, srv.GetAuthority()) && !srv.IsRecoveryAccount(ctx, address) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to remove rate limits")
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if _, found := srv.GetRateLimit(ctx, msgTypeURL); !found {
+			return nil, fmt.Errorf("message %s is not rate limited", msgTypeURL)
+		}
+		srv.Keeper.RemoveRateLimit(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"remove_rate_limit",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgRemoveRateLimitResponse{Success: true}, nil
+}
+
+// TripNFT pauses the processing of the nft Msg's acting on a nft class, or on
+// some nfts of a class.
+func (srv msgServer) TripNFT(goCtx context.Context, msg *types.MsgTripNFT) (*types.MsgTripNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// nft classes are not bound to Msg type URLs, so pausing them requires the
+	// permissions to trip the circuit breaker for all Msg's
+	if err := srv.checkAllMsgsPermission(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	nftIDs := msg.NftIds
+	if len(nftIDs) == 0 {
+		nftIDs = []string{""}
+	}
+
+	for _, nftID := range nftIDs {
+		if srv.IsNFTDisabled(ctx, msg.ClassId, nftID) {
+			return nil, fmt.Errorf("%s is already disabled", describeNFT(msg.ClassId, nftID))
+		}
+		if err := srv.DisableNFT(ctx, msg.ClassId, nftID); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"trip_nft",
 			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
+			sdk.NewAttribute("class_id", msg.ClassId),
+			sdk.NewAttribute("nft_ids", strings.Join(msg.NftIds, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgTripNFTResponse{Success: true}, nil
+}
+
+// ResetNFT resumes the processing of the nft Msg's paused using TripNFT.
+func (srv msgServer) ResetNFT(goCtx context.Context, msg *types.MsgResetNFT) (*types.MsgResetNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := srv.GetAccountPermissions(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("user permission does not exist %w", err)
+	}
+
+	// resuming nfts requires the same permissions as resetting the circuit breaker
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN && perms.Level != types.Permissions_LEVEL_ALL_MSGS && perms.Level != types.Permissions_LEVEL_SOME_MSGS && !bytes.Equal(address, srv.GetAuthority()) && !srv.IsRecoveryAccount(ctx, address) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset the circuit breaker for nfts")
+	}
+
+	nftIDs := msg.NftIds
+	if len(nftIDs) == 0 {
+		nftIDs = []string{""}
+	}
+
+	for _, nftID := range nftIDs {
+		if !srv.IsNFTDisabled(ctx, msg.ClassId, nftID) {
+			return nil, fmt.Errorf("%s is not disabled", describeNFT(msg.ClassId, nftID))
+		}
+		srv.EnableNFT(ctx, msg.ClassId, nftID)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"reset_nft",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("class_id", msg.ClassId),
+			sdk.NewAttribute("nft_ids", strings.Join(msg.NftIds, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgResetNFTResponse{Success: true}, nil
+}
+
+// SetTripCapability grants a module the capability to trip the circuit breaker
+// for some Msg type URLs through the keeper RequestTrip method, or revokes it.
+func (srv msgServer) SetTripCapability(goCtx context.Context, msg *types.MsgSetTripCapability) (*types.MsgSetTripCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	// the modules trip the circuit breaker without any account signing, so only
+	// governance decides which of them may do so
+	if !bytes.Equal(address, srv.GetAuthority()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can set the trip capabilities")
+	}
+
+	if msg.Module == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip capability module cannot be empty")
+	}
+
+	c := types.TripCapability{Module: msg.Module, MsgTypeUrls: msg.MsgTypeUrls}
+	if err := srv.Keeper.SetTripCapability(ctx, c); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_trip_capability",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("module", msg.Module),
+			sdk.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
 		),
 	})
 
-	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+	return &types.MsgSetTripCapabilityResponse{Success: true}, nil
+}
+
+// TripQuery disables some gRPC query routes, or patterns of routes, on every
+// node.
+func (srv msgServer) TripQuery(goCtx context.Context, msg *types.MsgTripQuery) (*types.MsgTripQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// query routes are not bound to Msg type URLs, so disabling them requires
+	// the permissions to trip the circuit breaker for all Msg's
+	if err := srv.checkAllMsgsPermission(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if len(msg.QueryRoutes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "query routes cannot be empty")
+	}
+
+	for _, route := range msg.QueryRoutes {
+		if srv.IsQueryDisabled(ctx, route) {
+			return nil, fmt.Errorf("query route %s is already disabled", route)
+		}
+		if err := srv.DisableQuery(ctx, route); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"trip_query",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("query_routes", strings.Join(msg.QueryRoutes, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgTripQueryResponse{Success: true}, nil
+}
+
+// ResetQuery enables the gRPC query routes disabled using TripQuery.
+func (srv msgServer) ResetQuery(goCtx context.Context, msg *types.MsgResetQuery) (*types.MsgResetQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := srv.GetAccountPermissions(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("user permission does not exist %w", err)
+	}
+
+	if err := srv.checkResetPermission(ctx, address, perms); err != nil {
+		return nil, err
+	}
+
+	if len(msg.QueryRoutes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "query routes cannot be empty")
+	}
+
+	for _, route := range msg.QueryRoutes {
+		if !srv.IsQueryDisabled(ctx, route) {
+			return nil, fmt.Errorf("query route %s is not disabled", route)
+		}
+		srv.EnableQuery(ctx, route)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"reset_query",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("query_routes", strings.Join(msg.QueryRoutes, ",")),
+		).AppendAttributes(srv.groupPolicyAttributes(ctx, "group_id", msg.Authority)...),
+	})
+
+	return &types.MsgResetQueryResponse{Success: true}, nil
+}
+
+// isLimitTypeURL returns true if the given Msg type URL is one of the type URLs
+// the permissions are limited to.
+func isLimitTypeURL(perms *types.Permissions, msgURL string) bool {
+	for _, limitURL := range perms.LimitTypeUrls {
+		if types.EqualMsgTypeURLs(limitURL, msgURL) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkTripPermission returns an error if the account with the given address
+// and permissions may not trip the circuit breaker for the given Msg type URLs.
+func (k *Keeper) checkTripPermission(ctx sdk.Context, address []byte, perms *types.Permissions, msgTypeURLs []string) error {
+	switch {
+	case k.IsRecoveryAccount(ctx, address):
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the recovery account can only reset the circuit breaker")
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, k.GetAuthority()):
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		for _, msgTypeURL := range msgTypeURLs {
+			if !isLimitTypeURL(perms, msgTypeURL) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
+			}
+		}
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
+	}
+
+	return nil
+}
+
+// checkResetPermission returns an error if the account with the given address
+// and permissions may not reset the circuit breaker. The recovery account may
+// always reset it.
+func (k *Keeper) checkResetPermission(ctx sdk.Context, address []byte, perms *types.Permissions) error {
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN && perms.Level != types.Permissions_LEVEL_ALL_MSGS && perms.Level != types.Permissions_LEVEL_SOME_MSGS && !bytes.Equal(address, k.GetAuthority()) && !k.IsRecoveryAccount(ctx, address) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+	}
+
+	return nil
+}
+
+// checkAllMsgsPermission returns an error if the given account is neither the
+// module authority nor has permissions to trip the circuit breaker for all Msg's.
+func (srv msgServer) checkAllMsgsPermission(ctx sdk.Context, authority string) error {
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	if srv.IsRecoveryAccount(ctx, address) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the recovery account can only reset the circuit breaker")
+	}
+
+	perms, err := srv.GetAccountPermissions(ctx, address)
+	if err != nil {
+		return fmt.Errorf("user permission does not exist %w", err)
+	}
+
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN && perms.Level != types.Permissions_LEVEL_ALL_MSGS {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip or reset the circuit breaker for all messages")
+	}
+
+	return nil
+}
+
+// checkAllMsgsResetPermission returns an error if the given account may not
+// reset the circuit breaker for all Msg's, which the recovery account may.
+func (srv msgServer) checkAllMsgsResetPermission(ctx sdk.Context, authority string) error {
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if srv.IsRecoveryAccount(ctx, address) {
+		return nil
+	}
+
+	return srv.checkAllMsgsPermission(ctx, authority)
 }
@@ -3,8 +3,11 @@ package keeper
 import (
 	"testing"
 
-	"cosmossdk.io/x/circuit/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "cosmos.bank.v1beta1.MsgSend"
@@ -84,6 +87,19 @@ func Test_AuthorizeCircuitBreaker(t *testing.T) {
 	msg = &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[4], Permissions: invalidmsgs}
 	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, msg)
 	require.NoError(t, err)
+
+	// permissions which could not be imported from genesis are rejected
+	for _, perms := range []*types.Permissions{
+		nil,
+		{Level: types.Permissions_LEVEL_SOME_MSGS},
+		{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend, "/" + msgSend}},
+		{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"cosmos.unknown.v1.MsgFoo"}},
+		{Level: types.Permissions_Level(42)},
+	} {
+		msg = &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[4], Permissions: perms}
+		_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
 }
 
 func Test_TripCircuitBreaker(t *testing.T) {
@@ -221,3 +237,470 @@ func Test_ResetCircuitBreaker(t *testing.T) {
 	_, err = srv.TripCircuitBreaker(ft.Ctx, admintrip)
 	require.Error(t, err)
 }
+
+func Test_TripCircuitBreakerGlob(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+	send, multiSend := "/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"
+	delegate, undelegate := "/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.staking.v1beta1.MsgUndelegate"
+
+	// patterns are expanded against the registered messages
+	globTrip := &types.MsgTripCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"*.MsgSend", "cosmos.staking.v1beta1.Msg*elegate"}}
+	_, err := srv.TripCircuitBreaker(ft.Ctx, globTrip)
+	require.NoError(t, err)
+
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, send))
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, multiSend))
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, delegate))
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, undelegate))
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, "/cosmos.staking.v1beta1.MsgBeginRedelegate"))
+
+	events := ft.Ctx.EventManager().Events()
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{
+		Key:   "msg_url",
+		Value: "/cosmos.bank.v1beta1.MsgSend,/cosmos.staking.v1beta1.MsgBeginRedelegate,/cosmos.staking.v1beta1.MsgDelegate,/cosmos.staking.v1beta1.MsgUndelegate",
+	})
+
+	// already disabled messages are skipped
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"/cosmos.bank.*"}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, multiSend))
+
+	// patterns must match at least one message that is not disabled yet
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"*.MsgSend"}})
+	require.ErrorContains(t, err, "no applicable message")
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"*.MsgTokenizeShares*"}})
+	require.ErrorContains(t, err, "no applicable message")
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"cosmos.[bank"}})
+	require.ErrorContains(t, err, "invalid message type url pattern")
+
+	// user with some messages can only trip the messages it is limited to
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"cosmos.staking.v1beta1.MsgCreateValidator"}}
+	msg := &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, msg)
+	require.NoError(t, err)
+
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{"*Validator"}})
+	require.ErrorContains(t, err, "MsgEditValidator")
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{"*.MsgCreateVal*"}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, "/cosmos.staking.v1beta1.MsgCreateValidator"))
+
+	// patterns are expanded against the disabled messages on reset
+	_, err = srv.ResetCircuitBreaker(ft.Ctx, &types.MsgResetCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"cosmos.staking.*"}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, delegate))
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, undelegate))
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, "/cosmos.staking.v1beta1.MsgCreateValidator"))
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, send))
+
+	_, err = srv.ResetCircuitBreaker(ft.Ctx, &types.MsgResetCircuitBreaker{Authority: addresses[0], MsgTypeUrls: []string{"cosmos.staking.*"}})
+	require.ErrorContains(t, err, "no applicable message")
+}
+
+func Test_TripAll(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+	vote := "/cosmos.gov.v1.MsgVote"
+	resetAll := "/cosmos.circuit.v1.MsgResetAll"
+
+	// user with no permission attempts to enable read-only mode
+	unknownTrip := &types.MsgTripAll{Authority: addresses[4]}
+	_, err := srv.TripAll(ft.Ctx, unknownTrip)
+	require.Error(t, err)
+
+	// user with some messages attempts to enable read-only mode
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	msg := &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[2], Permissions: somemsgs}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, msg)
+	require.NoError(t, err)
+
+	someTrip := &types.MsgTripAll{Authority: addresses[2]}
+	_, err = srv.TripAll(ft.Ctx, someTrip)
+	require.Error(t, err)
+
+	// user with all messages enables read-only mode
+	allmsgs := &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS, LimitTypeUrls: []string{""}}
+	msg = &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: allmsgs}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, msg)
+	require.NoError(t, err)
+
+	allTrip := &types.MsgTripAll{Authority: addresses[1], ExemptMsgTypeUrls: []string{vote}}
+	_, err = srv.TripAll(ft.Ctx, allTrip)
+	require.NoError(t, err)
+
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend), "non exempt messages should be disabled")
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, vote), "exempt messages should be allowed")
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, resetAll), "reset all should always be allowed")
+
+	// read-only mode is already enabled
+	_, err = srv.TripAll(ft.Ctx, allTrip)
+	require.Error(t, err)
+
+	// user with some messages cannot lift read-only mode
+	_, err = srv.ResetAll(ft.Ctx, &types.MsgResetAll{Authority: addresses[2]})
+	require.Error(t, err)
+
+	// admin lifts read-only mode
+	_, err = srv.ResetAll(ft.Ctx, &types.MsgResetAll{Authority: addresses[0]})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend), "read-only mode should be lifted")
+
+	// read-only mode is not enabled
+	_, err = srv.ResetAll(ft.Ctx, &types.MsgResetAll{Authority: addresses[0]})
+	require.Error(t, err)
+}
+
+func Test_RateLimit(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+	delegate := "/cosmos.staking.v1beta1.MsgDelegate"
+
+	// user with some messages can only rate limit the messages it is limited to
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err := srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	_, err = srv.SetRateLimit(ft.Ctx, &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrls: []string{delegate}, MaxExecutions: 1, WindowBlocks: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetRateLimit(ft.Ctx, &types.MsgSetRateLimit{Authority: addresses[4], MsgTypeUrls: []string{msgSend}, MaxExecutions: 1, WindowBlocks: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the rate limit must allow at least one execution per window
+	_, err = srv.SetRateLimit(ft.Ctx, &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrls: []string{msgSend}, MaxExecutions: 0, WindowBlocks: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SetRateLimit(ft.Ctx, &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrls: []string{msgSend}, MaxExecutions: 2, WindowBlocks: 10})
+	require.NoError(t, err)
+
+	res, err := QueryServer{keeper: ft.Keeper}.RateLimits(ft.Ctx, &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.RateLimit{{MsgTypeUrl: msgSend, MaxExecutions: 2, WindowBlocks: 10}}, res.RateLimits)
+
+	// at most two messages are executed per window of ten blocks
+	ctx := ft.Ctx.WithBlockHeight(12)
+	require.NoError(t, ft.Keeper.ConsumeRateLimit(ctx, msgSend))
+	require.NoError(t, ft.Keeper.ConsumeRateLimit(ctx.WithBlockHeight(15), msgSend))
+	require.ErrorIs(t, ft.Keeper.ConsumeRateLimit(ctx.WithBlockHeight(19), msgSend), types.ErrRateLimited)
+	require.NoError(t, ft.Keeper.ConsumeRateLimit(ctx, delegate), "other messages should not be rate limited")
+
+	require.NoError(t, ft.Keeper.ConsumeRateLimit(ctx.WithBlockHeight(20), msgSend), "the rate limit should be reset in the next window")
+
+	// the rate limit is exported and imported with the genesis state
+	genState := ft.Keeper.ExportGenesis(ft.Ctx)
+	require.Equal(t, res.RateLimits, genState.RateLimits)
+
+	// user with some messages lifts the rate limit
+	_, err = srv.RemoveRateLimit(ft.Ctx, &types.MsgRemoveRateLimit{Authority: addresses[4], MsgTypeUrls: []string{msgSend}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RemoveRateLimit(ft.Ctx, &types.MsgRemoveRateLimit{Authority: addresses[1], MsgTypeUrls: []string{"*.MsgSend"}})
+	require.NoError(t, err)
+	require.NoError(t, ft.Keeper.ConsumeRateLimit(ctx.WithBlockHeight(20), msgSend))
+
+	// the message is no longer rate limited
+	_, err = srv.RemoveRateLimit(ft.Ctx, &types.MsgRemoveRateLimit{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.Error(t, err)
+
+	ft.Keeper.InitGenesis(ft.Ctx, genState)
+	_, found := ft.Keeper.GetRateLimit(ft.Ctx, msgSend)
+	require.True(t, found)
+}
+
+func Test_TripNFT(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	// user with some messages cannot pause nft classes
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err := srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	_, err = srv.TripNFT(ft.Ctx, &types.MsgTripNFT{Authority: addresses[1], ClassId: "kitties"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// admin pauses a nft of a class
+	_, err = srv.TripNFT(ft.Ctx, &types.MsgTripNFT{Authority: addresses[0], ClassId: "kitties", NftIds: []string{"kitty1"}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty1"))
+	require.True(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty2"), "other nfts of the class should be allowed")
+	require.True(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", ""), "the class should be allowed")
+
+	// admin pauses the whole class
+	_, err = srv.TripNFT(ft.Ctx, &types.MsgTripNFT{Authority: addresses[0], ClassId: "kitties"})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", ""))
+	require.False(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty2"))
+	require.True(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "puppies", "puppy1"), "other classes should be allowed")
+
+	// the class is already paused
+	_, err = srv.TripNFT(ft.Ctx, &types.MsgTripNFT{Authority: addresses[0], ClassId: "kitties"})
+	require.Error(t, err)
+
+	res, err := QueryServer{keeper: ft.Keeper}.DisabledNFTs(ft.Ctx, &types.QueryDisabledNFTsRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*types.DisabledNFT{{ClassId: "kitties"}, {ClassId: "kitties", NftId: "kitty1"}}, res.DisabledNfts)
+
+	// the paused classes and nfts are exported with the genesis state
+	genState := ft.Keeper.ExportGenesis(ft.Ctx)
+	require.ElementsMatch(t, res.DisabledNfts, genState.DisabledNfts)
+
+	// user with no permission cannot resume the class
+	_, err = srv.ResetNFT(ft.Ctx, &types.MsgResetNFT{Authority: addresses[4], ClassId: "kitties"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// resuming the class does not resume the nfts paused individually
+	_, err = srv.ResetNFT(ft.Ctx, &types.MsgResetNFT{Authority: addresses[1], ClassId: "kitties"})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty2"))
+	require.False(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty1"))
+
+	_, err = srv.ResetNFT(ft.Ctx, &types.MsgResetNFT{Authority: addresses[0], ClassId: "kitties", NftIds: []string{"kitty1"}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty1"))
+
+	// the nft is not paused
+	_, err = srv.ResetNFT(ft.Ctx, &types.MsgResetNFT{Authority: addresses[0], ClassId: "kitties", NftIds: []string{"kitty1"}})
+	require.Error(t, err)
+
+	ft.Keeper.InitGenesis(ft.Ctx, genState)
+	require.False(t, ft.Keeper.IsNFTAllowed(ft.Ctx, "kitties", "kitty1"))
+}
+
+func Test_TripQuery(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	const (
+		denomOwners = "/cosmos.bank.v1beta1.Query/DenomOwners"
+		balance     = "/cosmos.bank.v1beta1.Query/Balance"
+		validators  = "/cosmos.staking.v1beta1.Query/Validators"
+	)
+
+	// user with some messages cannot disable query routes
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err := srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: addresses[1], QueryRoutes: []string{denomOwners}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the routes must be full gRPC method names
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: addresses[0], QueryRoutes: []string{"cosmos.bank.v1beta1.Query"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// admin disables a single route
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: addresses[0], QueryRoutes: []string{denomOwners}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsQueryAllowed(ft.Ctx, denomOwners))
+	require.True(t, ft.Keeper.IsQueryAllowed(ft.Ctx, balance), "other routes of the service should be allowed")
+
+	// admin disables the whole bank query service
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: addresses[0], QueryRoutes: []string{"cosmos.bank.v1beta1.Query/*"}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsQueryAllowed(ft.Ctx, balance))
+	require.True(t, ft.Keeper.IsQueryAllowed(ft.Ctx, validators), "other services should be allowed")
+
+	// the route is already disabled
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: addresses[0], QueryRoutes: []string{denomOwners}})
+	require.Error(t, err)
+
+	res, err := QueryServer{keeper: ft.Keeper}.DisabledQueries(ft.Ctx, &types.QueryDisabledQueriesRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{denomOwners, "/cosmos.bank.v1beta1.Query/*"}, res.QueryRoutes)
+
+	// the disabled routes are exported with the genesis state
+	genState := ft.Keeper.ExportGenesis(ft.Ctx)
+	require.ElementsMatch(t, res.QueryRoutes, genState.DisabledQueryRoutes)
+
+	// user with no permission cannot enable the routes
+	_, err = srv.ResetQuery(ft.Ctx, &types.MsgResetQuery{Authority: addresses[4], QueryRoutes: []string{denomOwners}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// enabling the service does not enable the routes disabled individually
+	_, err = srv.ResetQuery(ft.Ctx, &types.MsgResetQuery{Authority: addresses[1], QueryRoutes: []string{"/cosmos.bank.v1beta1.Query/*"}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsQueryAllowed(ft.Ctx, balance))
+	require.False(t, ft.Keeper.IsQueryAllowed(ft.Ctx, denomOwners))
+
+	_, err = srv.ResetQuery(ft.Ctx, &types.MsgResetQuery{Authority: addresses[0], QueryRoutes: []string{denomOwners}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsQueryAllowed(ft.Ctx, denomOwners))
+
+	// the route is not disabled
+	_, err = srv.ResetQuery(ft.Ctx, &types.MsgResetQuery{Authority: addresses[0], QueryRoutes: []string{denomOwners}})
+	require.Error(t, err)
+
+	ft.Keeper.InitGenesis(ft.Ctx, genState)
+	require.False(t, ft.Keeper.IsQueryAllowed(ft.Ctx, denomOwners))
+	require.False(t, ft.Keeper.IsQueryAllowed(ft.Ctx, balance))
+}
+
+func Test_RequestTrip(t *testing.T) {
+	ft := setupFixture(t)
+
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	// modules hold no capability by default
+	err := ft.Keeper.RequestTrip(ft.Ctx, "evidence", []string{msgSend}, "mass double signing")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// only the module authority can grant the capability
+	_, err = srv.SetTripCapability(ft.Ctx, &types.MsgSetTripCapability{Authority: addresses[1], Module: "evidence", MsgTypeUrls: []string{msgSend}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetTripCapability(ft.Ctx, &types.MsgSetTripCapability{Authority: addresses[0], Module: "evidence", MsgTypeUrls: []string{"cosmos.unknown.v1.MsgFoo"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SetTripCapability(ft.Ctx, &types.MsgSetTripCapability{Authority: addresses[0], Module: "evidence", MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	// the capability does not cover other messages
+	err = ft.Keeper.RequestTrip(ft.Ctx, "evidence", []string{msgSend, "cosmos.staking.v1beta1.MsgDelegate"}, "mass double signing")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend), "nothing should be tripped")
+
+	// other modules hold no capability
+	err = ft.Keeper.RequestTrip(ft.Ctx, "slashing", []string{msgSend}, "mass double signing")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = ft.Keeper.RequestTrip(ft.Ctx, "evidence", []string{msgSend}, "mass double signing")
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend))
+
+	events := ft.Ctx.EventManager().Events()
+	require.Equal(t, "request_trip", events[len(events)-1].Type)
+
+	// tripping a disabled message again is a no-op
+	err = ft.Keeper.RequestTrip(ft.Ctx, "evidence", []string{msgSend}, "mass double signing")
+	require.NoError(t, err)
+
+	res, err := QueryServer{keeper: ft.Keeper}.TripCapabilities(ft.Ctx, &types.QueryTripCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TripCapability{{Module: "evidence", MsgTypeUrls: []string{msgSend}}}, res.TripCapabilities)
+
+	// the capabilities are exported with the genesis state
+	genState := ft.Keeper.ExportGenesis(ft.Ctx)
+	require.Equal(t, res.TripCapabilities, genState.TripCapabilities)
+
+	// governance revokes the capability
+	_, err = srv.SetTripCapability(ft.Ctx, &types.MsgSetTripCapability{Authority: addresses[0], Module: "evidence"})
+	require.NoError(t, err)
+	_, found := ft.Keeper.GetTripCapability(ft.Ctx, "evidence")
+	require.False(t, found)
+
+	ft.Keeper.InitGenesis(ft.Ctx, genState)
+	_, found = ft.Keeper.GetTripCapability(ft.Ctx, "evidence")
+	require.True(t, found)
+}
+
+func Test_AdditionalAuthorities(t *testing.T) {
+	ft := setupFixture(t)
+
+	// the security council may trip the circuit breaker but not grant permissions
+	ft.Keeper.AddAuthority(addresses[1], types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS})
+	ft.Keeper.AddAuthority(addresses[2], types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}})
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	_, err := srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend), "circuit breaker should be tripped")
+
+	_, err = srv.TripAll(ft.Ctx, &types.MsgTripAll{Authority: addresses[1]})
+	require.NoError(t, err)
+
+	allmsgs := &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[1], Grantee: addresses[3], Permissions: allmsgs})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetTripCapability(ft.Ctx, &types.MsgSetTripCapability{Authority: addresses[1], Module: "evidence", MsgTypeUrls: []string{msgSend}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an authority with limited permissions is restricted to its messages
+	url := "cosmos.staking.v1beta1.MsgDelegate"
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[2], MsgTypeUrls: []string{url}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the permissions of the authorities cannot be changed on chain, even by the module authority
+	superPerms := &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[2], Permissions: superPerms})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// invalid authorities are rejected when they are added
+	require.Panics(t, func() { ft.Keeper.AddAuthority(addresses[0], *allmsgs) })
+	require.Panics(t, func() { ft.Keeper.AddAuthority(addresses[1], *allmsgs) })
+	require.Panics(t, func() { ft.Keeper.AddAuthority(addresses[3], types.Permissions{}) })
+	require.Panics(t, func() { ft.Keeper.AddAuthority("invalid", *allmsgs) })
+}
+
+func Test_RecoveryAccount(t *testing.T) {
+	ft := setupFixture(t)
+	recovery := addresses[4]
+	ft.Keeper.InitGenesis(ft.Ctx, &types.GenesisState{
+		AccountPermissions: []*types.GenesisAccountPermissions{
+			{Address: addresses[1], Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}},
+		},
+		RecoveryAccount: recovery,
+	})
+	srv := msgServer{
+		Keeper: ft.Keeper,
+	}
+
+	// the recovery account never trips the circuit breaker
+	_, err := srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: recovery, MsgTypeUrls: []string{msgSend}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TripAll(ft.Ctx, &types.MsgTripAll{Authority: recovery})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TripQuery(ft.Ctx, &types.MsgTripQuery{Authority: recovery, QueryRoutes: []string{"/cosmos.bank.v1beta1.Query/*"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the recovery account neither grants nor is granted permissions
+	allmsgs := &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: recovery, Grantee: addresses[3], Permissions: allmsgs})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AuthorizeCircuitBreaker(ft.Ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: recovery, Permissions: allmsgs})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the recovery account resets the circuit breaker when the admins are disabled
+	_, err = srv.TripCircuitBreaker(ft.Ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	_, err = srv.TripAll(ft.Ctx, &types.MsgTripAll{Authority: addresses[1]})
+	require.NoError(t, err)
+	_, err = srv.TripNFT(ft.Ctx, &types.MsgTripNFT{Authority: addresses[1], ClassId: "kitties"})
+	require.NoError(t, err)
+
+	_, err = srv.ResetAll(ft.Ctx, &types.MsgResetAll{Authority: recovery})
+	require.NoError(t, err)
+	_, err = srv.ResetCircuitBreaker(ft.Ctx, &types.MsgResetCircuitBreaker{Authority: recovery, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSend), "circuit breaker should be reset")
+	_, err = srv.ResetNFT(ft.Ctx, &types.MsgResetNFT{Authority: recovery, ClassId: "kitties"})
+	require.NoError(t, err)
+	require.False(t, ft.Keeper.IsNFTDisabled(ft.Ctx, "kitties", ""))
+
+	// the recovery account is exported and visible via query
+	require.Equal(t, recovery, ft.Keeper.ExportGenesis(ft.Ctx).RecoveryAccount)
+	res, err := NewQueryServer(ft.Keeper).RecoveryAccount(ft.Ctx, &types.QueryRecoveryAccountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, recovery, res.Address)
+}
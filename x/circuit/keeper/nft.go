@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsNFTAllowed returns false if the given nft class, or the given nft of the
+// class, is paused. It is consulted by the nft module before processing a Msg
+// acting on a class or a nft, nftID being empty for the Msg's acting on a
+// class.
+func (k Keeper) IsNFTAllowed(ctx sdk.Context, classID, nftID string) bool {
+	store := ctx.KVStore(k.storekey)
+	if store.Has(types.CreateDisabledNFTKey(classID, "")) {
+		return false
+	}
+
+	return nftID == "" || !store.Has(types.CreateDisabledNFTKey(classID, nftID))
+}
+
+// IsNFTDisabled returns true if the given nft class, or nft of the class if
+// nftID is not empty, has been paused itself.
+func (k *Keeper) IsNFTDisabled(ctx sdk.Context, classID, nftID string) bool {
+	return ctx.KVStore(k.storekey).Has(types.CreateDisabledNFTKey(classID, nftID))
+}
+
+// DisableNFT pauses the given nft class, or nft of the class if nftID is not
+// empty.
+func (k *Keeper) DisableNFT(ctx sdk.Context, classID, nftID string) error {
+	if err := (types.DisabledNFT{ClassId: classID, NftId: nftID}).Validate(); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storekey).Set(types.CreateDisabledNFTKey(classID, nftID), []byte{0x01})
+	return nil
+}
+
+// EnableNFT lifts the pause of the given nft class, or nft of the class if
+// nftID is not empty.
+func (k *Keeper) EnableNFT(ctx sdk.Context, classID, nftID string) {
+	ctx.KVStore(k.storekey).Delete(types.CreateDisabledNFTKey(classID, nftID))
+}
+
+// IterateDisabledNFTs iterates over the paused nft classes and nfts.
+func (k *Keeper) IterateDisabledNFTs(ctx sdk.Context, cb func(d types.DisabledNFT) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storekey), types.DisabledNFTPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		classID, nftID := types.ParseDisabledNFTKey(iter.Key())
+		if cb(types.DisabledNFT{ClassId: classID, NftId: nftID}) {
+			break
+		}
+	}
+}
+
+// describeNFT returns a description of a nft class, or of a nft of the class if
+// nftID is not empty.
+func describeNFT(classID, nftID string) string {
+	if nftID == "" {
+		return fmt.Sprintf("nft class %s", classID)
+	}
+	return fmt.Sprintf("nft %s of class %s", nftID, classID)
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/circuit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/proto"
 )
@@ -32,7 +34,7 @@ func (qs QueryServer) Account(c context.Context, req *types.QueryAccountRequest)
 		return nil, err
 	}
 
-	perms, err := qs.keeper.GetPermissions(sdkCtx, add)
+	perms, err := qs.keeper.GetAccountPermissions(sdkCtx, add)
 	if err != nil {
 		return nil, err
 	}
@@ -81,10 +83,139 @@ func (qs QueryServer) DisabledList(c context.Context, req *types.QueryDisabledLi
 	// Iterate over disabled list and perform the callback
 
 	var msgs []string
-	qs.keeper.IterateDisableLists(sdkCtx, func(address []byte, perm types.Permissions) (stop bool) {
-		msgs = append(msgs, perm.LimitTypeUrls...)
+	qs.keeper.IterateDisabledMsgs(sdkCtx, func(msgURL string) (stop bool) {
+		msgs = append(msgs, msgURL)
 		return false
 	})
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// ReadOnlyMode returns whether the chain is in read-only mode and which Msg
+// type URLs are exempt from it.
+func (qs QueryServer) ReadOnlyMode(c context.Context, req *types.QueryReadOnlyModeRequest) (*types.ReadOnlyModeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	mode, enabled := qs.keeper.GetReadOnlyMode(sdkCtx)
+	if !enabled {
+		return &types.ReadOnlyModeResponse{}, nil
+	}
+
+	return &types.ReadOnlyModeResponse{Enabled: true, ExemptMsgTypeUrls: mode.ExemptMsgTypeUrls}, nil
+}
+
+// RateLimits returns the rate limited Msg type URLs.
+func (qs QueryServer) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.RateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []*types.RateLimit
+	qs.keeper.IterateRateLimits(sdkCtx, func(rl types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, &rl)
+		return false
+	})
+
+	return &types.RateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+// DisabledNFTs returns the paused nft classes and nfts.
+func (qs QueryServer) DisabledNFTs(c context.Context, req *types.QueryDisabledNFTsRequest) (*types.DisabledNFTsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	var disabled []*types.DisabledNFT
+	qs.keeper.IterateDisabledNFTs(sdkCtx, func(d types.DisabledNFT) (stop bool) {
+		disabled = append(disabled, &d)
+		return false
+	})
+
+	return &types.DisabledNFTsResponse{DisabledNfts: disabled}, nil
+}
+
+// DisabledQueries returns the disabled gRPC query routes and patterns.
+func (qs QueryServer) DisabledQueries(c context.Context, req *types.QueryDisabledQueriesRequest) (*types.DisabledQueriesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	var routes []string
+	qs.keeper.IterateDisabledQueries(sdkCtx, func(route string) (stop bool) {
+		routes = append(routes, route)
+		return false
+	})
+
+	return &types.DisabledQueriesResponse{QueryRoutes: routes}, nil
+}
+
+// TripCapabilities returns the capabilities of the modules to trip the circuit
+// breaker.
+func (qs QueryServer) TripCapabilities(c context.Context, req *types.QueryTripCapabilitiesRequest) (*types.TripCapabilitiesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	var capabilities []*types.TripCapability
+	qs.keeper.IterateTripCapabilities(sdkCtx, func(c types.TripCapability) (stop bool) {
+		capabilities = append(capabilities, &c)
+		return false
+	})
+
+	return &types.TripCapabilitiesResponse{TripCapabilities: capabilities}, nil
+}
+
+// RecoveryAccount returns the account which can only reset the circuit breaker.
+func (qs QueryServer) RecoveryAccount(c context.Context, req *types.QueryRecoveryAccountRequest) (*types.RecoveryAccountResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	recovery := qs.keeper.GetRecoveryAccount(sdkCtx)
+	if len(recovery) == 0 {
+		return &types.RecoveryAccountResponse{}, nil
+	}
+
+	address, err := qs.keeper.addressCodec.BytesToString(recovery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RecoveryAccountResponse{Address: address}, nil
+}
+
+// CheckAuthorization returns whether an account may trip or reset the circuit
+// breaker for a Msg type URL, without submitting a tx.
+func (qs QueryServer) CheckAuthorization(c context.Context, req *types.QueryCheckAuthorizationRequest) (*types.CheckAuthorizationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(c)
+
+	address, err := qs.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+	}
+	if types.IsMsgTypeURLPattern(req.MsgTypeUrl) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg type url %s cannot be a pattern", req.MsgTypeUrl)
+	}
+	if err := qs.keeper.CheckMsgTypeURLsRegistered([]string{req.MsgTypeUrl}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	perms, err := qs.keeper.GetAccountPermissions(sdkCtx, address)
+	if err != nil {
+		return nil, err
+	}
+	if req.Level != types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		perms = &types.Permissions{Level: req.Level, LimitTypeUrls: req.LimitTypeUrls}
+	}
+
+	res := &types.CheckAuthorizationResponse{
+		Level:    perms.Level,
+		Disabled: !qs.keeper.IsAllowed(sdkCtx, req.MsgTypeUrl),
+		CanTrip:  true,
+		CanReset: true,
+	}
+	if err := qs.keeper.checkTripPermission(sdkCtx, address, perms, []string{req.MsgTypeUrl}); err != nil {
+		res.CanTrip = false
+		res.Reasons = append(res.Reasons, err.Error())
+	}
+	if err := qs.keeper.checkResetPermission(sdkCtx, address, perms); err != nil {
+		res.CanReset = false
+		res.Reasons = append(res.Reasons, err.Error())
+	}
+
+	return res, nil
+}
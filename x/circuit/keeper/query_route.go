@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsQueryAllowed returns false if the given gRPC query route is disabled,
+// itself or by a disabled pattern matching it. It implements the
+// baseapp.QueryCircuitBreaker interface, consulted by the nodes before serving
+// the queries made through ABCI, gRPC or the REST API.
+func (k *Keeper) IsQueryAllowed(ctx sdk.Context, route string) bool {
+	allowed := true
+	k.IterateDisabledQueries(ctx, func(disabled string) (stop bool) {
+		if types.EqualMsgTypeURLs(disabled, route) {
+			allowed = false
+		} else if types.IsMsgTypeURLPattern(disabled) {
+			matched, _ := types.MatchMsgTypeURL(disabled, route)
+			allowed = !matched
+		}
+		return !allowed
+	})
+
+	return allowed
+}
+
+// IsQueryDisabled returns true if the given query route or pattern has been
+// disabled itself.
+func (k *Keeper) IsQueryDisabled(ctx sdk.Context, route string) bool {
+	return ctx.KVStore(k.storekey).Has(types.CreateDisabledQueryKey(route))
+}
+
+// DisableQuery disables the given query route or pattern.
+func (k *Keeper) DisableQuery(ctx sdk.Context, route string) error {
+	if err := types.ValidateQueryRoute(route); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storekey).Set(types.CreateDisabledQueryKey(route), []byte{0x01})
+	return nil
+}
+
+// EnableQuery enables the given query route or pattern disabled using
+// DisableQuery.
+func (k *Keeper) EnableQuery(ctx sdk.Context, route string) {
+	ctx.KVStore(k.storekey).Delete(types.CreateDisabledQueryKey(route))
+}
+
+// IterateDisabledQueries iterates over the disabled query routes and patterns,
+// with their leading "/".
+func (k *Keeper) IterateDisabledQueries(ctx sdk.Context, cb func(route string) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storekey), types.DisabledQueryPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb("/" + string(iter.Key()[len(types.DisabledQueryPrefix):])) {
+			break
+		}
+	}
+}
@@ -66,6 +66,7 @@ func TestQueryDisabledList(t *testing.T) {
 
 	err = f.Keeper.SetPermissions(f.Ctx, add, &f.MockPerms)
 	require.NoError(t, err)
+	f.Keeper.DisableMsg(f.Ctx, "disabled")
 
 	// create a new query server
 	qs := QueryServer{keeper: f.Keeper}
@@ -73,5 +74,99 @@ func TestQueryDisabledList(t *testing.T) {
 	// test the DisabledList method
 	disabledList, err := qs.DisabledList(f.Ctx, &types.QueryDisabledListRequest{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"test"}, disabledList.DisabledList)
+	require.Equal(t, []string{"disabled"}, disabledList.DisabledList)
+}
+
+func TestQueryReadOnlyMode(t *testing.T) {
+	t.Parallel()
+	f := setupFixture(t)
+
+	// create a new query server
+	qs := QueryServer{keeper: f.Keeper}
+
+	res, err := qs.ReadOnlyMode(f.Ctx, &types.QueryReadOnlyModeRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Enabled)
+
+	err = f.Keeper.SetReadOnlyMode(f.Ctx, &types.ReadOnlyMode{ExemptMsgTypeUrls: []string{"/cosmos.gov.v1.MsgVote"}})
+	require.NoError(t, err)
+
+	res, err = qs.ReadOnlyMode(f.Ctx, &types.QueryReadOnlyModeRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+	require.Equal(t, []string{"/cosmos.gov.v1.MsgVote"}, res.ExemptMsgTypeUrls)
+}
+
+func TestQueryCheckAuthorization(t *testing.T) {
+	t.Parallel()
+	f := setupFixture(t)
+
+	someMsgs, err := f.Keeper.addressCodec.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	err = f.Keeper.SetPermissions(f.Ctx, someMsgs, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	qs := QueryServer{keeper: f.Keeper}
+	multiSend := "cosmos.bank.v1beta1.MsgMultiSend"
+
+	testCases := []struct {
+		name string
+		req  *types.QueryCheckAuthorizationRequest
+		exp  *types.CheckAuthorizationResponse
+	}{
+		{
+			"authority",
+			&types.QueryCheckAuthorizationRequest{Address: addresses[0], MsgTypeUrl: msgSend},
+			&types.CheckAuthorizationResponse{CanTrip: true, CanReset: true},
+		},
+		{
+			"some msgs, limit type url",
+			&types.QueryCheckAuthorizationRequest{Address: addresses[1], MsgTypeUrl: msgSend},
+			&types.CheckAuthorizationResponse{Level: types.Permissions_LEVEL_SOME_MSGS, CanTrip: true, CanReset: true},
+		},
+		{
+			"some msgs, other type url",
+			&types.QueryCheckAuthorizationRequest{Address: addresses[1], MsgTypeUrl: multiSend},
+			&types.CheckAuthorizationResponse{
+				Level:    types.Permissions_LEVEL_SOME_MSGS,
+				CanReset: true,
+				Reasons:  []string{"account does not have permission to trip circuit breaker for message cosmos.bank.v1beta1.MsgMultiSend: unauthorized"},
+			},
+		},
+		{
+			"no permissions",
+			&types.QueryCheckAuthorizationRequest{Address: addresses[2], MsgTypeUrl: msgSend},
+			&types.CheckAuthorizationResponse{
+				Reasons: []string{
+					"account does not have permission to trip circuit breaker: unauthorized",
+					"account does not have permission to reset circuit breaker: unauthorized",
+				},
+			},
+		},
+		{
+			"planned permissions",
+			&types.QueryCheckAuthorizationRequest{Address: addresses[2], MsgTypeUrl: multiSend, Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{multiSend}},
+			&types.CheckAuthorizationResponse{Level: types.Permissions_LEVEL_SOME_MSGS, CanTrip: true, CanReset: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := qs.CheckAuthorization(f.Ctx, tc.req)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+
+	// the current state of the msg type url is reported
+	f.Keeper.DisableMsg(f.Ctx, msgSend)
+	res, err := qs.CheckAuthorization(f.Ctx, &types.QueryCheckAuthorizationRequest{Address: addresses[1], MsgTypeUrl: msgSend})
+	require.NoError(t, err)
+	require.True(t, res.Disabled)
+
+	// the msg type url must be registered, and not be a pattern
+	_, err = qs.CheckAuthorization(f.Ctx, &types.QueryCheckAuthorizationRequest{Address: addresses[1], MsgTypeUrl: "cosmos.bank.v1beta1.MsgUnknown"})
+	require.ErrorContains(t, err, "not registered")
+	_, err = qs.CheckAuthorization(f.Ctx, &types.QueryCheckAuthorizationRequest{Address: addresses[1], MsgTypeUrl: "*.MsgSend"})
+	require.ErrorContains(t, err, "cannot be a pattern")
 }
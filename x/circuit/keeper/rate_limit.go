@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimit returns the rate limit of the given Msg type URL, and false if
+// the Msg type URL is not rate limited.
+func (k *Keeper) GetRateLimit(ctx sdk.Context, msgURL string) (types.RateLimit, bool) {
+	bz := ctx.KVStore(k.storekey).Get(types.CreateRateLimitKey(msgURL))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rl types.RateLimit
+	if err := proto.Unmarshal(bz, &rl); err != nil {
+		panic(err)
+	}
+
+	return rl, true
+}
+
+// SetRateLimit rate limits the Msg type URL of the given rate limit, replacing
+// its previous rate limit if any.
+func (k *Keeper) SetRateLimit(ctx sdk.Context, rl types.RateLimit) error {
+	if err := rl.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&rl)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storekey).Set(types.CreateRateLimitKey(rl.MsgTypeUrl), bz)
+	return nil
+}
+
+// RemoveRateLimit lifts the rate limit of the given Msg type URL.
+func (k *Keeper) RemoveRateLimit(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(k.storekey)
+	store.Delete(types.CreateRateLimitKey(msgURL))
+	store.Delete(types.CreateRateLimitUsageKey(msgURL))
+}
+
+// IterateRateLimits iterates over the rate limited Msg type URLs.
+func (k *Keeper) IterateRateLimits(ctx sdk.Context, cb func(rl types.RateLimit) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storekey), types.RateLimitPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rl types.RateLimit
+		if err := proto.Unmarshal(iter.Value(), &rl); err != nil {
+			panic(err)
+		}
+
+		if cb(rl) {
+			break
+		}
+	}
+}
+
+// ConsumeRateLimit counts the execution of a Msg of the given type URL against
+// its rate limit, if any. ErrRateLimited is returned if the Msg exceeds the
+// rate limit in the current window.
+func (k *Keeper) ConsumeRateLimit(ctx sdk.Context, msgURL string) error {
+	rl, found := k.GetRateLimit(ctx, msgURL)
+	if !found {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storekey)
+	usageKey := types.CreateRateLimitUsageKey(msgURL)
+
+	var usage types.RateLimitUsage
+	if bz := store.Get(usageKey); bz != nil {
+		if err := proto.Unmarshal(bz, &usage); err != nil {
+			return err
+		}
+	}
+
+	// start a new window once the current one is over
+	windowStart := rl.WindowStart(ctx.BlockHeight())
+	if usage.WindowStart != windowStart {
+		usage = types.RateLimitUsage{WindowStart: windowStart}
+	}
+
+	if usage.Executions >= rl.MaxExecutions {
+		return errorsmod.Wrapf(types.ErrRateLimited, "%s is limited to %d executions per %d blocks, retry from height %d",
+			msgURL, rl.MaxExecutions, rl.WindowBlocks, windowStart+int64(rl.WindowBlocks))
+	}
+
+	usage.Executions++
+	bz, err := proto.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	store.Set(usageKey, bz)
+
+	return nil
+}
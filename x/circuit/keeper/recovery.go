@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRecoveryAccount returns the address of the recovery account, which can
+// only reset the circuit breaker, or nil if none is set.
+func (k *Keeper) GetRecoveryAccount(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storekey).Get(types.RecoveryAccountKey)
+}
+
+// SetRecoveryAccount sets the recovery account. It is only set at genesis, so
+// that the recovery account can be kept as a cold key.
+func (k *Keeper) SetRecoveryAccount(ctx sdk.Context, address []byte) {
+	ctx.KVStore(k.storekey).Set(types.RecoveryAccountKey, address)
+}
+
+// IsRecoveryAccount returns true if the given address is the recovery account.
+func (k *Keeper) IsRecoveryAccount(ctx sdk.Context, address []byte) bool {
+	recovery := k.GetRecoveryAccount(ctx)
+	return len(recovery) > 0 && bytes.Equal(recovery, address)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetTripCapability returns the trip capability of the given module, and false
+// if the module does not hold any.
+func (k *Keeper) GetTripCapability(ctx sdk.Context, module string) (types.TripCapability, bool) {
+	bz := ctx.KVStore(k.storekey).Get(types.CreateTripCapabilityKey(module))
+	if bz == nil {
+		return types.TripCapability{}, false
+	}
+
+	var c types.TripCapability
+	if err := proto.Unmarshal(bz, &c); err != nil {
+		panic(err)
+	}
+
+	return c, true
+}
+
+// SetTripCapability grants the module of the given trip capability the right
+// to trip its Msg type URLs, replacing its previous capability if any. The
+// capability of the module is revoked if it lists no Msg type URL.
+func (k *Keeper) SetTripCapability(ctx sdk.Context, c types.TripCapability) error {
+	store := ctx.KVStore(k.storekey)
+	if c.Module != "" && len(c.MsgTypeUrls) == 0 {
+		store.Delete(types.CreateTripCapabilityKey(c.Module))
+		return nil
+	}
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.CheckMsgTypeURLsRegistered(c.MsgTypeUrls); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&c)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.CreateTripCapabilityKey(c.Module), bz)
+	return nil
+}
+
+// IterateTripCapabilities iterates over the trip capabilities of the modules.
+func (k *Keeper) IterateTripCapabilities(ctx sdk.Context, cb func(c types.TripCapability) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storekey), types.TripCapabilityPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var c types.TripCapability
+		if err := proto.Unmarshal(iter.Value(), &c); err != nil {
+			panic(err)
+		}
+
+		if cb(c) {
+			break
+		}
+	}
+}
+
+// RequestTrip trips the circuit breaker for the given Msg type URLs on behalf
+// of another module, e.g. the evidence module when it detects mass double
+// signing. The requestor module must hold a trip capability covering every Msg
+// type URL, otherwise nothing is tripped and ErrUnauthorized is returned. The
+// Msg type URLs already disabled are left untouched.
+func (k *Keeper) RequestTrip(ctx sdk.Context, requestorModule string, msgURLs []string, reason string) error {
+	c, found := k.GetTripCapability(ctx, requestorModule)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s does not have the capability to trip the circuit breaker", requestorModule)
+	}
+
+	for _, msgURL := range msgURLs {
+		if !c.Allows(msgURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s does not have the capability to trip the circuit breaker for %s", requestorModule, msgURL)
+		}
+	}
+
+	store := ctx.KVStore(k.storekey)
+	for _, msgURL := range msgURLs {
+		if store.Has(types.CreateDisableMsgPrefix(msgURL)) {
+			continue
+		}
+		k.DisableMsg(ctx, msgURL)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			"request_trip",
+			sdk.NewAttribute("module", requestorModule),
+			sdk.NewAttribute("msg_url", msgURL),
+			sdk.NewAttribute("reason", reason),
+		))
+	}
+
+	return nil
+}
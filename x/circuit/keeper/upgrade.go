@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotTrips returns a snapshot of the trips of the circuit breaker.
+func (k *Keeper) SnapshotTrips(ctx sdk.Context) types.TripSnapshot {
+	var snapshot types.TripSnapshot
+	k.IterateDisabledMsgs(ctx, func(msgURL string) (stop bool) {
+		snapshot.DisabledMsgs = append(snapshot.DisabledMsgs, msgURL)
+		return false
+	})
+	snapshot.ReadOnlyMode, _ = k.GetReadOnlyMode(ctx)
+
+	return snapshot
+}
+
+// IterateDisabledMsgs iterates over the Msg type URLs disabled by the circuit
+// breaker.
+func (k *Keeper) IterateDisabledMsgs(ctx sdk.Context, cb func(msgURL string) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storekey), types.DisableListPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the keys created by CreateDisableMsgPrefix end with a zero byte
+		key := iter.Key()[len(types.DisableListPrefix):]
+		if cb(string(key[:len(key)-1])) {
+			break
+		}
+	}
+}
+
+// RestoreTrips sets the trips of the circuit breaker to the given snapshot:
+// the Msg type URLs of the snapshot are disabled, the other ones are enabled,
+// and the read-only mode is restored. The Msg type URLs of the snapshot which
+// are no longer registered in the app are dropped. An event is emitted for
+// every trip that is kept, restored, reset or dropped.
+func (k *Keeper) RestoreTrips(ctx sdk.Context, snapshot types.TripSnapshot) error {
+	registered := make(map[string]bool)
+	for _, msgURL := range k.interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		registered[strings.TrimPrefix(msgURL, "/")] = true
+	}
+
+	keep := make(map[string]bool, len(snapshot.DisabledMsgs))
+	dropped := make(map[string]bool)
+	for _, msgURL := range snapshot.DisabledMsgs {
+		if !registered[strings.TrimPrefix(msgURL, "/")] {
+			dropped[msgURL] = true
+			continue
+		}
+
+		keep[msgURL] = true
+	}
+
+	var reset []string
+	k.IterateDisabledMsgs(ctx, func(msgURL string) (stop bool) {
+		if !keep[msgURL] {
+			reset = append(reset, msgURL)
+		}
+		return false
+	})
+	for _, msgURL := range reset {
+		k.EnableMsg(ctx, msgURL)
+		if dropped[msgURL] {
+			emitUpgradeTripEvent(ctx, "dropped", msgURL)
+		} else {
+			emitUpgradeTripEvent(ctx, "reset", msgURL)
+		}
+	}
+
+	store := ctx.KVStore(k.storekey)
+	for _, msgURL := range snapshot.DisabledMsgs {
+		switch {
+		case !keep[msgURL]:
+		case store.Has(types.CreateDisableMsgPrefix(msgURL)):
+			emitUpgradeTripEvent(ctx, "kept", msgURL)
+		default:
+			k.DisableMsg(ctx, msgURL)
+			emitUpgradeTripEvent(ctx, "restored", msgURL)
+		}
+	}
+
+	if snapshot.ReadOnlyMode == nil {
+		if _, enabled := k.GetReadOnlyMode(ctx); enabled {
+			k.ResetReadOnlyMode(ctx)
+			emitUpgradeTripEvent(ctx, "reset", "")
+		}
+		return nil
+	}
+
+	if err := k.SetReadOnlyMode(ctx, snapshot.ReadOnlyMode); err != nil {
+		return err
+	}
+	emitUpgradeTripEvent(ctx, "restored", "")
+	return nil
+}
+
+// ClearTrips resets every trip of the circuit breaker, emitting an event for
+// each of them, and returns the snapshot of the cleared trips.
+func (k *Keeper) ClearTrips(ctx sdk.Context) types.TripSnapshot {
+	snapshot := k.SnapshotTrips(ctx)
+	for _, msgURL := range snapshot.DisabledMsgs {
+		k.EnableMsg(ctx, msgURL)
+		emitUpgradeTripEvent(ctx, "cleared", msgURL)
+	}
+
+	if snapshot.ReadOnlyMode != nil {
+		k.ResetReadOnlyMode(ctx)
+		emitUpgradeTripEvent(ctx, "cleared", "")
+	}
+
+	return snapshot
+}
+
+// RunMigrationsWithTrips is a helper for the upgrade handlers, which snapshots
+// the trips of the circuit breaker, runs the migrations, then restores or
+// clears the trips depending on the policy. The trips are otherwise kept as is
+// across an upgrade, e.g.
+//
+//	app.UpgradeKeeper.SetUpgradeHandler(name, func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//		var toVM module.VersionMap
+//		err := app.CircuitKeeper.RunMigrationsWithTrips(ctx, circuittypes.TripsUpgradeClear, func(ctx context.Context) (err error) {
+//			toVM, err = app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+//			return err
+//		})
+//		return toVM, err
+//	})
+func (k *Keeper) RunMigrationsWithTrips(goCtx context.Context, policy types.TripsUpgradePolicy, migrate func(ctx context.Context) error) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	snapshot := k.SnapshotTrips(ctx)
+
+	if err := migrate(goCtx); err != nil {
+		return err
+	}
+
+	switch policy {
+	case types.TripsUpgradeRestore:
+		if err := k.RestoreTrips(ctx, snapshot); err != nil {
+			return err
+		}
+	case types.TripsUpgradeClear:
+		k.ClearTrips(ctx)
+	default:
+		return fmt.Errorf("unknown circuit breaker trips upgrade policy %d", policy)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"upgrade_circuit_breaker",
+		sdk.NewAttribute("policy", policy.String()),
+		sdk.NewAttribute("disabled_msgs_before", fmt.Sprint(len(snapshot.DisabledMsgs))),
+		sdk.NewAttribute("read_only_mode_before", fmt.Sprint(snapshot.ReadOnlyMode != nil)),
+	))
+
+	return nil
+}
+
+// emitUpgradeTripEvent emits the event of a trip handled when an upgrade is
+// applied. An empty Msg type URL stands for the read-only mode.
+func emitUpgradeTripEvent(ctx sdk.Context, action, msgURL string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"upgrade_trip",
+		sdk.NewAttribute("action", action),
+		sdk.NewAttribute("msg_url", msgURL),
+	))
+}
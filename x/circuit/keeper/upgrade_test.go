@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/types"
+)
+
+const (
+	msgSendURL     = "/cosmos.bank.v1beta1.MsgSend"
+	msgDelegateURL = "/cosmos.staking.v1beta1.MsgDelegate"
+	removedMsgURL  = "/cosmos.removed.v1beta1.MsgRemoved"
+)
+
+func TestSnapshotAndClearTrips(t *testing.T) {
+	ft := setupFixture(t)
+
+	ft.Keeper.DisableMsg(ft.Ctx, msgSendURL)
+	ft.Keeper.DisableMsg(ft.Ctx, msgDelegateURL)
+	require.NoError(t, ft.Keeper.SetReadOnlyMode(ft.Ctx, &types.ReadOnlyMode{}))
+
+	snapshot := ft.Keeper.SnapshotTrips(ft.Ctx)
+	require.ElementsMatch(t, []string{msgSendURL, msgDelegateURL}, snapshot.DisabledMsgs)
+	require.NotNil(t, snapshot.ReadOnlyMode)
+
+	require.Equal(t, snapshot, ft.Keeper.ClearTrips(ft.Ctx))
+	require.Equal(t, types.TripSnapshot{}, ft.Keeper.SnapshotTrips(ft.Ctx))
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSendURL))
+}
+
+func TestRunMigrationsWithTrips(t *testing.T) {
+	ft := setupFixture(t)
+	ft.Keeper.DisableMsg(ft.Ctx, msgSendURL)
+	ft.Keeper.DisableMsg(ft.Ctx, removedMsgURL)
+
+	// the trips made by the migrations are reset, and the unregistered Msg's
+	// are dropped
+	err := ft.Keeper.RunMigrationsWithTrips(ft.Ctx, types.TripsUpgradeRestore, func(ctx context.Context) error {
+		ft.Keeper.DisableMsg(ft.Ctx, msgDelegateURL)
+		ft.Keeper.EnableMsg(ft.Ctx, msgSendURL)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{msgSendURL}, ft.Keeper.SnapshotTrips(ft.Ctx).DisabledMsgs)
+
+	var actions []string
+	for _, event := range ft.Ctx.EventManager().Events() {
+		if event.Type == "upgrade_trip" {
+			actions = append(actions, event.Attributes[0].Value+" "+event.Attributes[1].Value)
+		}
+	}
+	require.Equal(t, []string{"dropped " + removedMsgURL, "reset " + msgDelegateURL, "restored " + msgSendURL}, actions)
+
+	// the trips are left untouched if the migrations fail
+	err = ft.Keeper.RunMigrationsWithTrips(ft.Ctx, types.TripsUpgradeClear, func(ctx context.Context) error {
+		return errors.New("migration failed")
+	})
+	require.Error(t, err)
+	require.False(t, ft.Keeper.IsAllowed(ft.Ctx, msgSendURL))
+
+	require.NoError(t, ft.Keeper.RunMigrationsWithTrips(ft.Ctx, types.TripsUpgradeClear, func(ctx context.Context) error { return nil }))
+	require.True(t, ft.Keeper.IsAllowed(ft.Ctx, msgSendURL))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/circuit/module/v1"
@@ -122,6 +123,7 @@ func (AppModule) Name() string { return types.ModuleName }
 
 // RegisterInvariants registers the circuit module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, &am.keeper)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -159,6 +161,14 @@ type ModuleInputs struct {
 	Key    *store.KVStoreKey
 
 	AddressCodec address.Codec
+
+	// GroupKeeper is optional, it is only used to attribute the Msg's of group
+	// policy accounts to their group in the events.
+	GroupKeeper types.GroupKeeper `optional:"true"`
+
+	// BreakerListeners are notified of the trips and resets of the circuit
+	// breaker, in the alphabetical order of the names of their modules.
+	BreakerListeners map[string]types.BreakerListenerWrapper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -176,16 +186,61 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
+	modNames := make([]string, 0, len(in.BreakerListeners))
+	for name := range in.BreakerListeners {
+		modNames = append(modNames, name)
+	}
+	sort.Strings(modNames)
+
+	listeners := make([]types.BreakerListener, len(modNames))
+	for i, name := range modNames {
+		listeners[i] = in.BreakerListeners[name]
+	}
+
 	circuitkeeper := keeper.NewKeeper(
 		in.Key,
 		authority.String(),
 		in.AddressCodec,
+		in.Cdc.InterfaceRegistry(),
+		listeners...,
 	)
+	for _, a := range in.Config.AdditionalAuthorities {
+		var perms types.Permissions
+		if a.MaxPermissions != nil {
+			perms = types.Permissions{
+				Level:         types.Permissions_Level(a.MaxPermissions.Level),
+				LimitTypeUrls: a.MaxPermissions.LimitTypeUrls,
+			}
+		}
+		circuitkeeper.AddAuthority(authtypes.NewModuleAddressOrBech32Address(a.Address).String(), perms)
+	}
+	if in.GroupKeeper != nil {
+		circuitkeeper.SetGroupKeeper(in.GroupKeeper)
+	}
 	m := NewAppModule(in.Cdc, circuitkeeper)
 
 	baseappOpt := func(app *baseapp.BaseApp) {
 		app.SetCircuitBreaker(&circuitkeeper)
+		app.SetQueryCircuitBreaker(&circuitkeeper)
+		RegisterBreakerNotifier(app, &circuitkeeper)
 	}
 
 	return ModuleOutputs{CircuitKeeper: circuitkeeper, Module: m, BaseappOptions: baseappOpt}
 }
+
+// RegisterBreakerNotifier registers the breaker notifier of the keeper with
+// the streaming manager of the app, after the listeners already registered,
+// and makes the app stream the changes of the circuit store. It does nothing if
+// the keeper has no breaker listeners.
+func RegisterBreakerNotifier(app *baseapp.BaseApp, k *keeper.Keeper) {
+	notifier := k.BreakerNotifier()
+	if notifier == nil {
+		return
+	}
+
+	app.CommitMultiStore().AddListeners([]store.StoreKey{k.StoreKey()})
+
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, notifier)
+	app.SetStreamingManager(streamingManager)
+}
@@ -35,6 +35,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeCircuitBreaker{}, "cosmos-sdk/MsgAuthorizeCircuitBreaker")
 	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker")
 	legacy.RegisterAminoMsg(cdc, &MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgTripAll{}, "cosmos-sdk/MsgTripAll")
+	legacy.RegisterAminoMsg(cdc, &MsgResetAll{}, "cosmos-sdk/MsgResetAll")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRateLimit{}, "cosmos-sdk/MsgSetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRateLimit{}, "cosmos-sdk/MsgRemoveRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgTripNFT{}, "cosmos-sdk/MsgTripNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgResetNFT{}, "cosmos-sdk/MsgResetNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTripCapability{}, "cosmos-sdk/MsgSetTripCapability")
+	legacy.RegisterAminoMsg(cdc, &MsgTripQuery{}, "cosmos-sdk/MsgTripQuery")
+	legacy.RegisterAminoMsg(cdc, &MsgResetQuery{}, "cosmos-sdk/MsgResetQuery")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -43,6 +52,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgTripAll{},
+		&MsgResetAll{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgTripNFT{},
+		&MsgResetNFT{},
+		&MsgSetTripCapability{},
+		&MsgTripQuery{},
+		&MsgResetQuery{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
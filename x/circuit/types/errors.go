@@ -0,0 +1,7 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// ErrRateLimited is returned by the ante handler for the Msg's exceeding the
+// rate limit of their type URL. The Msg's can be retried in a later window.
+var ErrRateLimited = errors.Register(ModuleName, 2, "msg rate limit exceeded")
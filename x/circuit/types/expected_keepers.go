@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// GroupKeeper defines the expected x/group keeper, used to tell whether an
+// account holding circuit breaker permissions is a group policy account.
+type GroupKeeper interface {
+	GroupPolicyInfo(context.Context, *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+}
@@ -1,16 +1,31 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{}
 }
 
+// Validate performs basic genesis state validation. It checks that every
+// account is listed once with valid permissions, that the disabled Msg type
+// URLs are not duplicated and that the rate limits and the paused nfts are
+// valid and not duplicated, as well as the trip capabilities and the disabled
+// query routes, and that the recovery account holds no permissions. Whether the Msg type URLs are registered in the app
+// is checked by the keeper on InitGenesis.
 func (gs *GenesisState) Validate() error {
+	seenAccounts := make(map[string]bool, len(gs.AccountPermissions))
 	for _, account := range gs.AccountPermissions {
 		if account.Address == "" {
 			return fmt.Errorf("invalid account address: %s", account.Address)
 		}
+		if seenAccounts[account.Address] {
+			return fmt.Errorf("duplicate account permissions, account address: %s", account.Address)
+		}
+		seenAccounts[account.Address] = true
+
 		if account.Permissions == nil {
 			return fmt.Errorf("account has empty permissions, account address: %s", account.Address)
 		}
@@ -20,6 +35,67 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	if url, ok := findDuplicateMsgTypeURL(gs.DisabledTypeUrls); ok {
+		return fmt.Errorf("duplicate disabled message type url: %s", url)
+	}
+
+	rateLimitURLs := make([]string, len(gs.RateLimits))
+	for i, rl := range gs.RateLimits {
+		if rl == nil {
+			return fmt.Errorf("rate limit cannot be empty")
+		}
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+		rateLimitURLs[i] = rl.MsgTypeUrl
+	}
+
+	if url, ok := findDuplicateMsgTypeURL(rateLimitURLs); ok {
+		return fmt.Errorf("duplicate rate limited message type url: %s", url)
+	}
+
+	seenNFTs := make(map[DisabledNFT]bool, len(gs.DisabledNfts))
+	for _, d := range gs.DisabledNfts {
+		if d == nil {
+			return fmt.Errorf("disabled nft cannot be empty")
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seenNFTs[*d] {
+			return fmt.Errorf("duplicate disabled nft: class %s, nft %s", d.ClassId, d.NftId)
+		}
+		seenNFTs[*d] = true
+	}
+
+	seenModules := make(map[string]bool, len(gs.TripCapabilities))
+	for _, c := range gs.TripCapabilities {
+		if c == nil {
+			return fmt.Errorf("trip capability cannot be empty")
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seenModules[c.Module] {
+			return fmt.Errorf("duplicate trip capability, module: %s", c.Module)
+		}
+		seenModules[c.Module] = true
+	}
+
+	for _, route := range gs.DisabledQueryRoutes {
+		if err := ValidateQueryRoute(route); err != nil {
+			return err
+		}
+	}
+
+	if route, ok := findDuplicateMsgTypeURL(gs.DisabledQueryRoutes); ok {
+		return fmt.Errorf("duplicate disabled query route: %s", route)
+	}
+
+	if gs.RecoveryAccount != "" && seenAccounts[gs.RecoveryAccount] {
+		return fmt.Errorf("recovery account cannot be granted permissions, account address: %s", gs.RecoveryAccount)
+	}
+
 	return nil
 }
 
@@ -27,5 +103,39 @@ func CheckPermission(account *GenesisAccountPermissions) error {
 	if account.Permissions.Level != Permissions_LEVEL_ALL_MSGS && account.Permissions.Level != Permissions_LEVEL_SOME_MSGS && account.Permissions.Level != Permissions_LEVEL_SUPER_ADMIN {
 		return fmt.Errorf("invalid permission level account address: %s, permission level: %s", account.Address, account.Permissions.Level)
 	}
+
+	if err := account.Permissions.ValidateLimitTypeURLs(); err != nil {
+		return fmt.Errorf("invalid permissions, account address: %s: %w", account.Address, err)
+	}
+	return nil
+}
+
+// ValidateLimitTypeURLs checks that the permissions list at least one Msg type
+// URL when their level is LEVEL_SOME_MSGS, and that the Msg type URLs are not
+// duplicated.
+func (p *Permissions) ValidateLimitTypeURLs() error {
+	if p.Level == Permissions_LEVEL_SOME_MSGS && len(p.LimitTypeUrls) == 0 {
+		return fmt.Errorf("permission level %s requires at least one message type url", p.Level)
+	}
+
+	if url, ok := findDuplicateMsgTypeURL(p.LimitTypeUrls); ok {
+		return fmt.Errorf("duplicate message type url: %s", url)
+	}
+
 	return nil
 }
+
+// findDuplicateMsgTypeURL returns the first Msg type URL which is listed more
+// than once, regardless of its leading "/".
+func findDuplicateMsgTypeURL(msgURLs []string) (string, bool) {
+	seen := make(map[string]bool, len(msgURLs))
+	for _, msgURL := range msgURLs {
+		key := strings.TrimPrefix(msgURL, "/")
+		if seen[key] {
+			return msgURL, true
+		}
+		seen[key] = true
+	}
+
+	return "", false
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"path"
+	"strings"
+)
+
+// IsMsgTypeURLPattern returns true if the given Msg type URL is a glob pattern,
+// e.g. "cosmos.staking.v1beta1.Msg*Delegate" or "*.MsgSend".
+func IsMsgTypeURLPattern(msgURL string) bool {
+	return strings.ContainsAny(msgURL, "*?[")
+}
+
+// MatchMsgTypeURL returns true if the given Msg type URL matches the glob
+// pattern, using the path.Match syntax. The leading "/" of type URLs is
+// optional in both the pattern and the URL.
+func MatchMsgTypeURL(pattern, msgURL string) (bool, error) {
+	return path.Match(strings.TrimPrefix(pattern, "/"), strings.TrimPrefix(msgURL, "/"))
+}
+
+// EqualMsgTypeURLs returns true if the given Msg type URLs are the same,
+// regardless of their leading "/".
+func EqualMsgTypeURLs(a, b string) bool {
+	return strings.TrimPrefix(a, "/") == strings.TrimPrefix(b, "/")
+}
@@ -1,5 +1,7 @@
 package types
 
+import "strings"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "circuit"
@@ -15,6 +17,13 @@ const (
 var (
 	AccountPermissionPrefix = []byte{0x01}
 	DisableListPrefix       = []byte{0x02}
+	ReadOnlyModeKey         = []byte{0x03}
+	RateLimitPrefix         = []byte{0x04}
+	RateLimitUsagePrefix    = []byte{0x05}
+	DisabledNFTPrefix       = []byte{0x06}
+	TripCapabilityPrefix    = []byte{0x07}
+	DisabledQueryPrefix     = []byte{0x08}
+	RecoveryAccountKey      = []byte{0x09}
 )
 
 func CreateAddressPrefix(account []byte) []byte {
@@ -30,3 +39,55 @@ func CreateDisableMsgPrefix(msgURL string) []byte {
 	copy(key[len(DisableListPrefix):], msgURL)
 	return key
 }
+
+// CreateRateLimitKey returns the key of the rate limit of a Msg type URL,
+// regardless of its leading "/".
+func CreateRateLimitKey(msgURL string) []byte {
+	return createMsgTypeURLKey(RateLimitPrefix, msgURL)
+}
+
+// CreateRateLimitUsageKey returns the key of the usage of the rate limit of a
+// Msg type URL, regardless of its leading "/".
+func CreateRateLimitUsageKey(msgURL string) []byte {
+	return createMsgTypeURLKey(RateLimitUsagePrefix, msgURL)
+}
+
+func createMsgTypeURLKey(prefix []byte, msgURL string) []byte {
+	msgURL = strings.TrimPrefix(msgURL, "/")
+	key := make([]byte, len(prefix)+len(msgURL))
+	copy(key, prefix)
+	copy(key[len(prefix):], msgURL)
+	return key
+}
+
+// CreateDisabledNFTKey returns the key of a paused nft class if nftID is empty,
+// or of a paused nft of the class otherwise.
+func CreateDisabledNFTKey(classID, nftID string) []byte {
+	key := make([]byte, 0, len(DisabledNFTPrefix)+1+len(classID)+len(nftID))
+	key = append(key, DisabledNFTPrefix...)
+	key = append(key, byte(len(classID)))
+	key = append(key, classID...)
+	return append(key, nftID...)
+}
+
+// ParseDisabledNFTKey returns the class and nft ids of a key created by
+// CreateDisabledNFTKey.
+func ParseDisabledNFTKey(key []byte) (classID, nftID string) {
+	key = key[len(DisabledNFTPrefix):]
+	classLen := int(key[0])
+	return string(key[1 : 1+classLen]), string(key[1+classLen:])
+}
+
+// CreateTripCapabilityKey returns the key of the trip capability of a module.
+func CreateTripCapabilityKey(module string) []byte {
+	key := make([]byte, len(TripCapabilityPrefix)+len(module))
+	copy(key, TripCapabilityPrefix)
+	copy(key[len(TripCapabilityPrefix):], module)
+	return key
+}
+
+// CreateDisabledQueryKey returns the key of a disabled query route or pattern,
+// regardless of its leading "/".
+func CreateDisabledQueryKey(route string) []byte {
+	return createMsgTypeURLKey(DisabledQueryPrefix, route)
+}
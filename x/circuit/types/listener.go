@@ -0,0 +1,28 @@
+package types
+
+import "context"
+
+// BreakerListener is notified of the trips and resets of the Msg type URLs by
+// the circuit breaker once the block which made them is committed, e.g. to push
+// them to an off-chain pager system without scraping the logs. Trips and resets
+// reverted with their transaction are never notified.
+//
+// The listeners are called in order by the streaming manager of the app, for
+// every trip and reset written in the block, in the order they were written, so
+// that a trip reset in the same block, e.g. by RunMigrationsWithTrips, is
+// notified as a trip followed by a reset. An error returned by a listener is
+// logged by the app, it does not prevent the other listeners from being called.
+type BreakerListener interface {
+	// OnTrip is called when the Msg type URL is disabled at the given height.
+	OnTrip(ctx context.Context, height int64, msgURL string) error
+	// OnReset is called when the Msg type URL is enabled again at the given
+	// height.
+	OnReset(ctx context.Context, height int64, msgURL string) error
+}
+
+// BreakerListenerWrapper is a wrapper for modules to inject a BreakerListener
+// using depinject.
+type BreakerListenerWrapper struct{ BreakerListener }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BreakerListenerWrapper) IsOnePerModuleType() {}
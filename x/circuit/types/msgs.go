@@ -8,6 +8,15 @@ var (
 	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
 	_ sdk.Msg = &MsgTripCircuitBreaker{}
 	_ sdk.Msg = &MsgResetCircuitBreaker{}
+	_ sdk.Msg = &MsgTripAll{}
+	_ sdk.Msg = &MsgResetAll{}
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgTripNFT{}
+	_ sdk.Msg = &MsgResetNFT{}
+	_ sdk.Msg = &MsgSetTripCapability{}
+	_ sdk.Msg = &MsgTripQuery{}
+	_ sdk.Msg = &MsgResetQuery{}
 )
 
 // NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
@@ -88,3 +97,241 @@ func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{granter}
 }
+
+// NewMsgTripAll creates a new MsgTripAll instance.
+func NewMsgTripAll(authority string, exemptURLs []string) *MsgTripAll {
+	return &MsgTripAll{
+		Authority:         authority,
+		ExemptMsgTypeUrls: exemptURLs,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgTripAll) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgTripAll) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgTripAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripAll.
+func (m MsgTripAll) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgResetAll creates a new MsgResetAll instance.
+func NewMsgResetAll(authority string) *MsgResetAll {
+	return &MsgResetAll{
+		Authority: authority,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgResetAll) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgResetAll) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgResetAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetAll.
+func (m MsgResetAll) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance.
+func NewMsgSetRateLimit(authority string, urls []string, maxExecutions, windowBlocks uint64) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority:     authority,
+		MsgTypeUrls:   urls,
+		MaxExecutions: maxExecutions,
+		WindowBlocks:  windowBlocks,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgSetRateLimit) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgSetRateLimit) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgSetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetRateLimit.
+func (m MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance.
+func NewMsgRemoveRateLimit(authority string, urls []string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgRemoveRateLimit) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgRemoveRateLimit) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRateLimit.
+func (m MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgTripNFT creates a new MsgTripNFT instance.
+func NewMsgTripNFT(authority, classID string, nftIDs []string) *MsgTripNFT {
+	return &MsgTripNFT{
+		Authority: authority,
+		ClassId:   classID,
+		NftIds:    nftIDs,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgTripNFT) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgTripNFT) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgTripNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripNFT.
+func (m MsgTripNFT) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgResetNFT creates a new MsgResetNFT instance.
+func NewMsgResetNFT(authority, classID string, nftIDs []string) *MsgResetNFT {
+	return &MsgResetNFT{
+		Authority: authority,
+		ClassId:   classID,
+		NftIds:    nftIDs,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgResetNFT) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgResetNFT) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgResetNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetNFT.
+func (m MsgResetNFT) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgSetTripCapability creates a new MsgSetTripCapability instance.
+func NewMsgSetTripCapability(authority, module string, urls []string) *MsgSetTripCapability {
+	return &MsgSetTripCapability{
+		Authority:   authority,
+		Module:      module,
+		MsgTypeUrls: urls,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgSetTripCapability) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgSetTripCapability) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgSetTripCapability) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetTripCapability.
+func (m MsgSetTripCapability) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgTripQuery creates a new MsgTripQuery instance.
+func NewMsgTripQuery(authority string, routes []string) *MsgTripQuery {
+	return &MsgTripQuery{
+		Authority:   authority,
+		QueryRoutes: routes,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgTripQuery) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgTripQuery) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgTripQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripQuery.
+func (m MsgTripQuery) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgResetQuery creates a new MsgResetQuery instance.
+func NewMsgResetQuery(authority string, routes []string) *MsgResetQuery {
+	return &MsgResetQuery{
+		Authority:   authority,
+		QueryRoutes: routes,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgResetQuery) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgResetQuery) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgResetQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetQuery.
+func (m MsgResetQuery) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(m.Authority)
+
+	return []sdk.AccAddress{granter}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNestedMsgDepth is the maximum depth of the Msg's wrapped in other Msg's
+// inspected by IsAllowedRecursive, the deeper Msg's being disallowed.
+const MaxNestedMsgDepth = 8
+
+// msgsWrapper is implemented by the Msg's wrapping Msg's they execute on
+// behalf of their signer, e.g. the authz MsgExec.
+type msgsWrapper interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// proposalMsgsWrapper is implemented by the Msg's submitting proposals of Msg's
+// executed once the proposal passes, e.g. the gov and group
+// MsgSubmitProposal.
+type proposalMsgsWrapper interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+// NestedMsgs returns the Msg's directly wrapped in the given Msg, if any.
+func NestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch m := msg.(type) {
+	case msgsWrapper:
+		return m.GetMessages()
+	case proposalMsgsWrapper:
+		return m.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
+
+// IsAllowedRecursive returns true if the type URL of the given Msg, and of all
+// the Msg's it wraps recursively, are allowed by isAllowed. The Msg's which
+// cannot be unwrapped, or which are wrapped deeper than MaxNestedMsgDepth, are
+// disallowed, so that a disabled Msg cannot be smuggled inside another Msg.
+func IsAllowedRecursive(msg sdk.Msg, isAllowed func(msgURL string) bool) bool {
+	return isAllowedRecursive(msg, isAllowed, 0)
+}
+
+func isAllowedRecursive(msg sdk.Msg, isAllowed func(msgURL string) bool, depth int) bool {
+	if depth > MaxNestedMsgDepth || !isAllowed(sdk.MsgTypeURL(msg)) {
+		return false
+	}
+
+	msgs, err := NestedMsgs(msg)
+	if err != nil {
+		return false
+	}
+
+	for _, nested := range msgs {
+		if !isAllowedRecursive(nested, isAllowed, depth+1) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WalkMsgsRecursive calls fn on the given Msg and on all the Msg's it wraps
+// recursively, stopping at the first error. The Msg's which cannot be
+// unwrapped, or which are wrapped deeper than MaxNestedMsgDepth, are not walked
+// into, as they are disallowed by IsAllowedRecursive.
+func WalkMsgsRecursive(msg sdk.Msg, fn func(msg sdk.Msg) error) error {
+	return walkMsgsRecursive(msg, fn, 0)
+}
+
+func walkMsgsRecursive(msg sdk.Msg, fn func(msg sdk.Msg) error, depth int) error {
+	if depth > MaxNestedMsgDepth {
+		return nil
+	}
+
+	if err := fn(msg); err != nil {
+		return err
+	}
+
+	msgs, err := NestedMsgs(msg)
+	if err != nil {
+		return nil
+	}
+
+	for _, nested := range msgs {
+		if err := walkMsgsRecursive(nested, fn, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+	"math"
+)
+
+// Validate checks that the paused nft class id is set and short enough to be
+// length-prefixed in the store key.
+func (d DisabledNFT) Validate() error {
+	if d.ClassId == "" {
+		return fmt.Errorf("disabled nft class id cannot be empty")
+	}
+
+	if len(d.ClassId) > math.MaxUint8 {
+		return fmt.Errorf("disabled nft class id is longer than %d bytes: %s", math.MaxUint8, d.ClassId)
+	}
+
+	return nil
+}
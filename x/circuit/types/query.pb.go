@@ -301,516 +301,3283 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
-	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
-	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.circuit.v1.QueryAccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
-	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
-	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+// QueryReadOnlyModeRequest is the request type for the Query/ReadOnlyMode RPC method.
+type QueryReadOnlyModeRequest struct {
 }
 
-func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
-
-var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0x33, 0x2d, 0xbf, 0x5f, 0xdb, 0xd7, 0x8a, 0x3a, 0xf6, 0x10, 0xb6, 0xed, 0x5a, 0xb7,
-	0xd8, 0x84, 0x5a, 0x76, 0x48, 0x04, 0x2f, 0x82, 0xa0, 0x88, 0xf5, 0xe0, 0xa1, 0xdd, 0xa3, 0x07,
-	0x65, 0xb2, 0x3b, 0x84, 0xc1, 0x74, 0x67, 0xbb, 0x6f, 0x13, 0x2c, 0xe2, 0xa5, 0x27, 0xbd, 0x89,
-	0xfe, 0x0d, 0x1e, 0x05, 0xff, 0x0c, 0x8f, 0x05, 0x2f, 0x1e, 0x25, 0x11, 0xfc, 0x37, 0xa4, 0xb3,
-	0x33, 0x9b, 0x8d, 0x9d, 0xda, 0xe3, 0xcc, 0xbc, 0xef, 0xcb, 0xe7, 0xfb, 0xbe, 0x2f, 0x0b, 0x1b,
-	0xb1, 0xc2, 0x43, 0x85, 0x2c, 0x96, 0x79, 0x3c, 0x94, 0x05, 0x1b, 0x75, 0xd8, 0xd1, 0x50, 0xe4,
-	0xc7, 0x61, 0x96, 0xab, 0x42, 0xd1, 0xeb, 0xe5, 0x73, 0x68, 0x9e, 0xc3, 0x51, 0xc7, 0xdb, 0x31,
-	0x8a, 0x1e, 0x47, 0x51, 0xd6, 0xb2, 0x51, 0xa7, 0x27, 0x0a, 0xde, 0x61, 0x19, 0xef, 0xcb, 0x94,
-	0x17, 0x52, 0xa5, 0xa5, 0xdc, 0x73, 0x74, 0x2f, 0x8e, 0x33, 0x81, 0xe6, 0x79, 0xbd, 0xaf, 0x54,
-	0x7f, 0x20, 0x18, 0xcf, 0x24, 0xe3, 0x69, 0xaa, 0x0a, 0xad, 0xb5, 0xaf, 0x6b, 0x46, 0x6c, 0x7f,
-	0xa3, 0x0e, 0x16, 0x30, 0xb8, 0x71, 0x70, 0x76, 0x7c, 0x18, 0xc7, 0x6a, 0x98, 0x16, 0x91, 0x38,
-	0x1a, 0x0a, 0x2c, 0x68, 0x13, 0x16, 0x78, 0x92, 0xe4, 0x02, 0xb1, 0x49, 0x36, 0x49, 0x7b, 0x29,
-	0xb2, 0xc7, 0xe0, 0x00, 0xae, 0x56, 0xb5, 0x98, 0xa9, 0x14, 0x05, 0x7d, 0x00, 0x90, 0x89, 0xfc,
-	0x50, 0x22, 0x4a, 0x95, 0xea, 0xfa, 0xe5, 0xae, 0x1f, 0x9e, 0x73, 0x1c, 0xee, 0x57, 0x45, 0x18,
-	0xd5, 0x14, 0xc1, 0x0b, 0x58, 0xad, 0x33, 0xa0, 0x85, 0x78, 0x02, 0x30, 0x9d, 0x84, 0xe9, 0xbb,
-	0x6d, 0xfb, 0x9e, 0x8d, 0x2d, 0x2c, 0x9d, 0x98, 0xb1, 0x85, 0xfb, 0xbc, 0x2f, 0x8c, 0x36, 0xaa,
-	0x29, 0x83, 0xcf, 0x04, 0xae, 0x4d, 0x7b, 0x1b, 0xe8, 0xa7, 0xb0, 0xc8, 0xcd, 0x5d, 0x93, 0x6c,
-	0xce, 0xb7, 0x97, 0xbb, 0xbb, 0x0e, 0xe4, 0x3d, 0x91, 0x0a, 0x94, 0x68, 0xd4, 0x75, 0x03, 0x95,
-	0x9a, 0xee, 0xcd, 0x60, 0xce, 0x69, 0xcc, 0xd6, 0xa5, 0x98, 0x25, 0xc6, 0x0c, 0xa7, 0x07, 0x4d,
-	0x3d, 0x87, 0xc7, 0x12, 0x79, 0x6f, 0x20, 0x92, 0x67, 0x12, 0x6d, 0x20, 0xc1, 0x7d, 0x58, 0x9d,
-	0xbd, 0x36, 0x36, 0xb6, 0xe0, 0x4a, 0x62, 0xee, 0x5f, 0x0e, 0x24, 0x16, 0xda, 0xcb, 0x52, 0xb4,
-	0x92, 0xd4, 0x8a, 0xbb, 0x5f, 0xe6, 0xe1, 0x3f, 0xdd, 0x99, 0xbe, 0x27, 0xb0, 0x60, 0xcc, 0xd0,
-	0x6d, 0x87, 0x5f, 0xc7, 0x2e, 0x78, 0x81, 0xa3, 0xee, 0xaf, 0x15, 0x08, 0xba, 0xef, 0x7e, 0x7f,
-	0xdd, 0x21, 0x27, 0xdf, 0x7f, 0x7d, 0x9a, 0x6b, 0xd1, 0xdb, 0xec, 0xfc, 0xba, 0xda, 0x69, 0xb1,
-	0x37, 0x66, 0x91, 0xde, 0xd2, 0x13, 0x02, 0x8b, 0x36, 0x16, 0xda, 0xba, 0x04, 0xc6, 0x2e, 0x85,
-	0xb7, 0x75, 0x31, 0x4d, 0x15, 0x6e, 0xd0, 0x9e, 0xe2, 0x6c, 0xd0, 0xb5, 0x7f, 0xe0, 0xd0, 0x8f,
-	0x04, 0x56, 0xea, 0x83, 0xa5, 0x77, 0x2e, 0x02, 0x71, 0xa4, 0xe2, 0xb9, 0xa8, 0x5d, 0x31, 0x05,
-	0xbb, 0x53, 0xa0, 0x5b, 0xf4, 0xa6, 0x03, 0xc8, 0xe4, 0xa5, 0x33, 0x7c, 0x74, 0xef, 0xdb, 0xd8,
-	0x27, 0xa7, 0x63, 0x9f, 0xfc, 0x1c, 0xfb, 0xe4, 0xc3, 0xc4, 0x6f, 0x9c, 0x4e, 0xfc, 0xc6, 0x8f,
-	0x89, 0xdf, 0x78, 0xbe, 0x5e, 0x2a, 0x31, 0x79, 0x15, 0x4a, 0xc5, 0x5e, 0x57, 0x1d, 0xf4, 0xd7,
-	0xa0, 0xf7, 0xbf, 0xfe, 0x4f, 0xdf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdd, 0xdb, 0x03,
-	0x8d, 0x04, 0x00, 0x00,
+func (m *QueryReadOnlyModeRequest) Reset()         { *m = QueryReadOnlyModeRequest{} }
+func (m *QueryReadOnlyModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReadOnlyModeRequest) ProtoMessage()    {}
+func (*QueryReadOnlyModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryReadOnlyModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadOnlyModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadOnlyModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReadOnlyModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadOnlyModeRequest.Merge(m, src)
+}
+func (m *QueryReadOnlyModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadOnlyModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadOnlyModeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryReadOnlyModeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Account returns account permissions.
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
-	// Account returns account permissions.
-	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// DisabledList returns a list of disabled message urls
-	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+// ReadOnlyModeResponse is the response type for the Query/ReadOnlyMode RPC method.
+type ReadOnlyModeResponse struct {
+	// enabled is true if the chain is in read-only mode.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// exempt_msg_type_urls are the Msg type URLs that keep being processed.
+	ExemptMsgTypeUrls []string `protobuf:"bytes,2,rep,name=exempt_msg_type_urls,json=exemptMsgTypeUrls,proto3" json:"exempt_msg_type_urls,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ReadOnlyModeResponse) Reset()         { *m = ReadOnlyModeResponse{} }
+func (m *ReadOnlyModeResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadOnlyModeResponse) ProtoMessage()    {}
+func (*ReadOnlyModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ReadOnlyModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
-	out := new(AccountResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/Account", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReadOnlyModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadOnlyModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ReadOnlyModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadOnlyModeResponse.Merge(m, src)
+}
+func (m *ReadOnlyModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadOnlyModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadOnlyModeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReadOnlyModeResponse proto.InternalMessageInfo
+
+func (m *ReadOnlyModeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error) {
-	out := new(DisabledListResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/DisabledList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReadOnlyModeResponse) GetExemptMsgTypeUrls() []string {
+	if m != nil {
+		return m.ExemptMsgTypeUrls
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Account returns account permissions.
-	Account(context.Context, *QueryAccountRequest) (*AccountResponse, error)
-	// Account returns account permissions.
-	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
-	// DisabledList returns a list of disabled message urls
-	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+// QueryRateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type QueryRateLimitsRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{8}
 }
-
-func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*AccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*AccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Account(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.circuit.v1.Query/Account",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Accounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.circuit.v1.Query/Accounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+// RateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type RateLimitsResponse struct {
+	RateLimits []*RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
 }
 
-func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDisabledListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DisabledList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.circuit.v1.Query/DisabledList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DisabledList(ctx, req.(*QueryDisabledListRequest))
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{9}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.circuit.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "Accounts",
-			Handler:    _Query_Accounts_Handler,
-		},
-		{
-			MethodName: "DisabledList",
-			Handler:    _Query_DisabledList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/circuit/v1/query.proto",
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetRateLimits() []*RateLimit {
+	if m != nil {
+		return m.RateLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDisabledNFTsRequest is the request type for the Query/DisabledNFTs RPC method.
+type QueryDisabledNFTsRequest struct {
 }
 
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDisabledNFTsRequest) Reset()         { *m = QueryDisabledNFTsRequest{} }
+func (m *QueryDisabledNFTsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledNFTsRequest) ProtoMessage()    {}
+func (*QueryDisabledNFTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{10}
 }
-
-func (m *AccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDisabledNFTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledNFTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledNFTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDisabledNFTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledNFTsRequest.Merge(m, src)
+}
+func (m *QueryDisabledNFTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledNFTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledNFTsRequest.DiscardUnknown(m)
 }
 
-func (m *AccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDisabledNFTsRequest proto.InternalMessageInfo
+
+// DisabledNFTsResponse is the response type for the Query/DisabledNFTs RPC method.
+type DisabledNFTsResponse struct {
+	DisabledNfts []*DisabledNFT `protobuf:"bytes,1,rep,name=disabled_nfts,json=disabledNfts,proto3" json:"disabled_nfts,omitempty"`
 }
 
-func (m *AccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permission != nil {
-		{
-			size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DisabledNFTsResponse) Reset()         { *m = DisabledNFTsResponse{} }
+func (m *DisabledNFTsResponse) String() string { return proto.CompactTextString(m) }
+func (*DisabledNFTsResponse) ProtoMessage()    {}
+func (*DisabledNFTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{11}
+}
+func (m *DisabledNFTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisabledNFTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisabledNFTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DisabledNFTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisabledNFTsResponse.Merge(m, src)
+}
+func (m *DisabledNFTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisabledNFTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisabledNFTsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DisabledNFTsResponse proto.InternalMessageInfo
+
+func (m *DisabledNFTsResponse) GetDisabledNfts() []*DisabledNFT {
+	if m != nil {
+		return m.DisabledNfts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDisabledQueriesRequest is the request type for the Query/DisabledQueries RPC method.
+type QueryDisabledQueriesRequest struct {
 }
 
-func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDisabledQueriesRequest) Reset()         { *m = QueryDisabledQueriesRequest{} }
+func (m *QueryDisabledQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledQueriesRequest) ProtoMessage()    {}
+func (*QueryDisabledQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{12}
+}
+func (m *QueryDisabledQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDisabledQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledQueriesRequest.Merge(m, src)
+}
+func (m *QueryDisabledQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledQueriesRequest.DiscardUnknown(m)
 }
 
-func (m *AccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDisabledQueriesRequest proto.InternalMessageInfo
+
+// DisabledQueriesResponse is the response type for the Query/DisabledQueries RPC method.
+type DisabledQueriesResponse struct {
+	QueryRoutes []string `protobuf:"bytes,1,rep,name=query_routes,json=queryRoutes,proto3" json:"query_routes,omitempty"`
 }
 
-func (m *AccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DisabledQueriesResponse) Reset()         { *m = DisabledQueriesResponse{} }
+func (m *DisabledQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*DisabledQueriesResponse) ProtoMessage()    {}
+func (*DisabledQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{13}
+}
+func (m *DisabledQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisabledQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisabledQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DisabledQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisabledQueriesResponse.Merge(m, src)
+}
+func (m *DisabledQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisabledQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisabledQueriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDisabledListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DisabledQueriesResponse proto.InternalMessageInfo
+
+func (m *DisabledQueriesResponse) GetQueryRoutes() []string {
+	if m != nil {
+		return m.QueryRoutes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDisabledListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTripCapabilitiesRequest is the request type for the Query/TripCapabilities RPC method.
+type QueryTripCapabilitiesRequest struct {
 }
 
-func (m *QueryDisabledListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTripCapabilitiesRequest) Reset()         { *m = QueryTripCapabilitiesRequest{} }
+func (m *QueryTripCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripCapabilitiesRequest) ProtoMessage()    {}
+func (*QueryTripCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{14}
 }
-
-func (m *DisabledListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTripCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripCapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTripCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripCapabilitiesRequest.Merge(m, src)
+}
+func (m *QueryTripCapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripCapabilitiesRequest.DiscardUnknown(m)
 }
 
-func (m *DisabledListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTripCapabilitiesRequest proto.InternalMessageInfo
+
+// TripCapabilitiesResponse is the response type for the Query/TripCapabilities RPC method.
+type TripCapabilitiesResponse struct {
+	TripCapabilities []*TripCapability `protobuf:"bytes,1,rep,name=trip_capabilities,json=tripCapabilities,proto3" json:"trip_capabilities,omitempty"`
 }
 
-func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DisabledList) > 0 {
-		for iNdEx := len(m.DisabledList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DisabledList[iNdEx])
-			copy(dAtA[i:], m.DisabledList[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *TripCapabilitiesResponse) Reset()         { *m = TripCapabilitiesResponse{} }
+func (m *TripCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*TripCapabilitiesResponse) ProtoMessage()    {}
+func (*TripCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{15}
+}
+func (m *TripCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *TripCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripCapabilitiesResponse.Merge(m, src)
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *TripCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *AccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Permission != nil {
-		l = m.Permission.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *TripCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripCapabilitiesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_TripCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *TripCapabilitiesResponse) GetTripCapabilities() []*TripCapability {
+	if m != nil {
+		return m.TripCapabilities
 	}
-	return n
+	return nil
 }
 
-func (m *AccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryCheckAuthorizationRequest is the request type for the
+// Query/CheckAuthorization RPC method.
+type QueryRecoveryAccountRequest struct {
 }
 
-func (m *QueryDisabledListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecoveryAccountRequest) Reset()         { *m = QueryRecoveryAccountRequest{} }
+func (m *QueryRecoveryAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryAccountRequest) ProtoMessage()    {}
+func (*QueryRecoveryAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{16}
+}
+func (m *QueryRecoveryAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryRecoveryAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryAccountRequest.Merge(m, src)
+}
+func (m *QueryRecoveryAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryAccountRequest.DiscardUnknown(m)
 }
 
-func (m *DisabledListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRecoveryAccountRequest proto.InternalMessageInfo
+
+type RecoveryAccountResponse struct {
+	// address is the recovery account, empty if none is set.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RecoveryAccountResponse) Reset()         { *m = RecoveryAccountResponse{} }
+func (m *RecoveryAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*RecoveryAccountResponse) ProtoMessage()    {}
+func (*RecoveryAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{17}
+}
+func (m *RecoveryAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.DisabledList) > 0 {
-		for _, s := range m.DisabledList {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *RecoveryAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryAccountResponse.Merge(m, src)
+}
+func (m *RecoveryAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryAccountResponse proto.InternalMessageInfo
+
+func (m *RecoveryAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryCheckAuthorizationRequest struct {
+	// address is the account to check.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// msg_type_url is the Msg type URL the account would trip or reset the
+	// circuit breaker for.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// level, if not LEVEL_NONE_UNSPECIFIED, is the permission level checked
+	// instead of the current one of the account, e.g. to check permissions
+	// before they are granted.
+	Level Permissions_Level `protobuf:"varint,3,opt,name=level,proto3,enum=cosmos.circuit.v1.Permissions_Level" json:"level,omitempty"`
+	// limit_type_urls are the Msg type URLs the checked level is limited to, when
+	// level is LEVEL_SOME_MSGS.
+	LimitTypeUrls []string `protobuf:"bytes,4,rep,name=limit_type_urls,json=limitTypeUrls,proto3" json:"limit_type_urls,omitempty"`
+}
+
+func (m *QueryCheckAuthorizationRequest) Reset()         { *m = QueryCheckAuthorizationRequest{} }
+func (m *QueryCheckAuthorizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckAuthorizationRequest) ProtoMessage()    {}
+func (*QueryCheckAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{18}
+}
+func (m *QueryCheckAuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckAuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckAuthorizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCheckAuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckAuthorizationRequest.Merge(m, src)
+}
+func (m *QueryCheckAuthorizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckAuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckAuthorizationRequest.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryCheckAuthorizationRequest proto.InternalMessageInfo
+
+func (m *QueryCheckAuthorizationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryCheckAuthorizationRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryCheckAuthorizationRequest) GetLevel() Permissions_Level {
+	if m != nil {
+		return m.Level
+	}
+	return Permissions_LEVEL_NONE_UNSPECIFIED
+}
+
+func (m *QueryCheckAuthorizationRequest) GetLimitTypeUrls() []string {
+	if m != nil {
+		return m.LimitTypeUrls
+	}
+	return nil
+}
+
+// CheckAuthorizationResponse is the response type for the
+// Query/CheckAuthorization RPC method.
+type CheckAuthorizationResponse struct {
+	// level is the permission level checked.
+	Level Permissions_Level `protobuf:"varint,1,opt,name=level,proto3,enum=cosmos.circuit.v1.Permissions_Level" json:"level,omitempty"`
+	// can_trip is true if the account may trip the circuit breaker for the Msg
+	// type URL.
+	CanTrip bool `protobuf:"varint,2,opt,name=can_trip,json=canTrip,proto3" json:"can_trip,omitempty"`
+	// can_reset is true if the account may reset the circuit breaker for the Msg
+	// type URL.
+	CanReset bool `protobuf:"varint,3,opt,name=can_reset,json=canReset,proto3" json:"can_reset,omitempty"`
+	// disabled is true if the Msg type URL is currently disabled, in which case it
+	// can only be reset, or else it can only be tripped.
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// reasons explain why the account may not trip or reset the circuit breaker.
+	Reasons []string `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *CheckAuthorizationResponse) Reset()         { *m = CheckAuthorizationResponse{} }
+func (m *CheckAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckAuthorizationResponse) ProtoMessage()    {}
+func (*CheckAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{19}
+}
+func (m *CheckAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAuthorizationResponse.Merge(m, src)
+}
+func (m *CheckAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckAuthorizationResponse proto.InternalMessageInfo
+
+func (m *CheckAuthorizationResponse) GetLevel() Permissions_Level {
+	if m != nil {
+		return m.Level
+	}
+	return Permissions_LEVEL_NONE_UNSPECIFIED
+}
+
+func (m *CheckAuthorizationResponse) GetCanTrip() bool {
+	if m != nil {
+		return m.CanTrip
+	}
+	return false
+}
+
+func (m *CheckAuthorizationResponse) GetCanReset() bool {
+	if m != nil {
+		return m.CanReset
+	}
+	return false
+}
+
+func (m *CheckAuthorizationResponse) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *CheckAuthorizationResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
+	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
+	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.circuit.v1.QueryAccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
+	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
+	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryReadOnlyModeRequest)(nil), "cosmos.circuit.v1.QueryReadOnlyModeRequest")
+	proto.RegisterType((*ReadOnlyModeResponse)(nil), "cosmos.circuit.v1.ReadOnlyModeResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "cosmos.circuit.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "cosmos.circuit.v1.RateLimitsResponse")
+	proto.RegisterType((*QueryDisabledNFTsRequest)(nil), "cosmos.circuit.v1.QueryDisabledNFTsRequest")
+	proto.RegisterType((*DisabledNFTsResponse)(nil), "cosmos.circuit.v1.DisabledNFTsResponse")
+	proto.RegisterType((*QueryDisabledQueriesRequest)(nil), "cosmos.circuit.v1.QueryDisabledQueriesRequest")
+	proto.RegisterType((*DisabledQueriesResponse)(nil), "cosmos.circuit.v1.DisabledQueriesResponse")
+	proto.RegisterType((*QueryTripCapabilitiesRequest)(nil), "cosmos.circuit.v1.QueryTripCapabilitiesRequest")
+	proto.RegisterType((*TripCapabilitiesResponse)(nil), "cosmos.circuit.v1.TripCapabilitiesResponse")
+	proto.RegisterType((*QueryRecoveryAccountRequest)(nil), "cosmos.circuit.v1.QueryRecoveryAccountRequest")
+	proto.RegisterType((*RecoveryAccountResponse)(nil), "cosmos.circuit.v1.RecoveryAccountResponse")
+	proto.RegisterType((*QueryCheckAuthorizationRequest)(nil), "cosmos.circuit.v1.QueryCheckAuthorizationRequest")
+	proto.RegisterType((*CheckAuthorizationResponse)(nil), "cosmos.circuit.v1.CheckAuthorizationResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
+
+var fileDescriptor_87c65073a3d3c1e1 = []byte{
+	// 1114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xc7, 0xe3, 0xb4, 0xf9, 0x65, 0xf3, 0x64, 0xfb, 0x4b, 0x32, 0x44, 0xd4, 0x38, 0x89, 0xd9,
+	0x38, 0xcd, 0x0b, 0x49, 0x6b, 0x77, 0xb7, 0x12, 0x07, 0xa0, 0x48, 0x25, 0xa8, 0xe5, 0x90, 0x86,
+	0xd6, 0x84, 0x0b, 0x48, 0x58, 0x13, 0xef, 0x74, 0x6b, 0xea, 0xb5, 0x1d, 0x8f, 0x77, 0xd5, 0x05,
+	0x71, 0xe9, 0x89, 0x97, 0x0b, 0xa2, 0xdc, 0x39, 0x20, 0xee, 0x5c, 0xb8, 0x73, 0x42, 0x1c, 0x2b,
+	0x71, 0xe1, 0x88, 0x12, 0x24, 0xfe, 0x0d, 0xe4, 0xf1, 0xd8, 0x9e, 0xdd, 0x1d, 0xef, 0x8a, 0xe3,
+	0xbc, 0x3c, 0xcf, 0x7c, 0x9e, 0x17, 0x3f, 0x5f, 0x19, 0x36, 0xdc, 0x90, 0x76, 0x43, 0x6a, 0xb9,
+	0x5e, 0xec, 0xf6, 0xbc, 0xc4, 0xea, 0x37, 0xad, 0xb3, 0x1e, 0x89, 0x07, 0x66, 0x14, 0x87, 0x49,
+	0x88, 0x56, 0xb2, 0x63, 0x93, 0x1f, 0x9b, 0xfd, 0xa6, 0xb6, 0xcf, 0x2d, 0x4e, 0x31, 0x25, 0xd9,
+	0x5d, 0xab, 0xdf, 0x3c, 0x25, 0x09, 0x6e, 0x5a, 0x11, 0xee, 0x78, 0x01, 0x4e, 0xbc, 0x30, 0xc8,
+	0xcc, 0x35, 0x89, 0xf7, 0x64, 0x10, 0x11, 0xca, 0x8f, 0xd7, 0x3b, 0x61, 0xd8, 0xf1, 0x89, 0x85,
+	0x23, 0xcf, 0xc2, 0x41, 0x10, 0x26, 0xcc, 0x36, 0x3f, 0x5d, 0xe3, 0xc6, 0xf9, 0x1b, 0x22, 0x98,
+	0x61, 0xc1, 0x4b, 0x0f, 0xd3, 0xe5, 0x1d, 0xd7, 0x0d, 0x7b, 0x41, 0x62, 0x93, 0xb3, 0x1e, 0xa1,
+	0x09, 0x52, 0x61, 0x1e, 0xb7, 0xdb, 0x31, 0xa1, 0x54, 0x55, 0x1a, 0xca, 0xde, 0x82, 0x9d, 0x2f,
+	0x8d, 0x87, 0xb0, 0x54, 0xdc, 0xa5, 0x51, 0x18, 0x50, 0x82, 0xde, 0x06, 0x88, 0x48, 0xdc, 0xf5,
+	0x28, 0xf5, 0xc2, 0x80, 0xdd, 0x5f, 0x6c, 0xe9, 0xe6, 0x58, 0xc4, 0xe6, 0x83, 0xe2, 0x12, 0xb5,
+	0x05, 0x0b, 0xe3, 0x13, 0x58, 0x15, 0x19, 0x68, 0x0e, 0x71, 0x17, 0xa0, 0xcc, 0x04, 0xf7, 0xbb,
+	0x93, 0xfb, 0x4d, 0xd3, 0x66, 0x66, 0x91, 0xf0, 0xb4, 0x99, 0x0f, 0x70, 0x87, 0x70, 0x5b, 0x5b,
+	0xb0, 0x34, 0x7e, 0x52, 0x60, 0xb9, 0xf4, 0xcd, 0xa1, 0xdf, 0x83, 0x1a, 0xe6, 0x7b, 0xaa, 0xd2,
+	0xb8, 0xb4, 0xb7, 0xd8, 0xba, 0x2e, 0x41, 0xbe, 0x47, 0x02, 0x42, 0x3d, 0xca, 0xad, 0xc5, 0x00,
+	0x0a, 0x6b, 0x74, 0x6f, 0x08, 0x73, 0x96, 0x61, 0xee, 0x4e, 0xc5, 0xcc, 0x30, 0x86, 0x38, 0x35,
+	0x50, 0x59, 0x1e, 0xde, 0xf5, 0x28, 0x3e, 0xf5, 0x49, 0xfb, 0xc8, 0xa3, 0x79, 0x41, 0x8c, 0x37,
+	0x61, 0x75, 0x78, 0x9b, 0x87, 0xb1, 0x05, 0x57, 0xda, 0x7c, 0xdf, 0xf1, 0x3d, 0x9a, 0xb0, 0x58,
+	0x16, 0xec, 0x7a, 0x5b, 0xb8, 0x5c, 0x38, 0xb6, 0x09, 0x6e, 0xbf, 0x1f, 0xf8, 0x83, 0xfb, 0x61,
+	0x3b, 0x4f, 0x94, 0x81, 0x61, 0x75, 0x78, 0x9b, 0x3b, 0x56, 0x61, 0x9e, 0x04, 0xcc, 0x05, 0xcb,
+	0x7c, 0xcd, 0xce, 0x97, 0xc8, 0x82, 0x55, 0xf2, 0x94, 0x74, 0xa3, 0xc4, 0xe9, 0xd2, 0x8e, 0x93,
+	0xf6, 0xa1, 0xd3, 0x8b, 0x7d, 0xaa, 0xce, 0xb2, 0x97, 0x57, 0xb2, 0xb3, 0xfb, 0xb4, 0x73, 0x32,
+	0x88, 0xc8, 0x87, 0xb1, 0x4f, 0x0d, 0x15, 0x5e, 0xce, 0x9e, 0xc7, 0x09, 0x39, 0xf2, 0xba, 0x5e,
+	0x51, 0x61, 0xe3, 0x03, 0x40, 0xe2, 0x26, 0x7f, 0xfa, 0x36, 0x2c, 0xc6, 0x38, 0x21, 0x8e, 0xcf,
+	0xb6, 0x79, 0x75, 0xd6, 0x25, 0xd5, 0x29, 0x6c, 0x6d, 0x88, 0x0b, 0x37, 0x63, 0x69, 0x3c, 0xbe,
+	0x7b, 0x52, 0x3c, 0xf8, 0x31, 0xac, 0x0e, 0x6f, 0xf3, 0x27, 0x0f, 0x85, 0x34, 0x06, 0x8f, 0x8a,
+	0x47, 0x65, 0x5d, 0x2c, 0xd8, 0x97, 0x69, 0x3e, 0x7e, 0x94, 0x50, 0x63, 0x03, 0xd6, 0x86, 0x1e,
+	0x4e, 0x17, 0x1e, 0x29, 0xde, 0x7e, 0x0b, 0xae, 0x8e, 0x9d, 0xf0, 0xe7, 0x37, 0xa1, 0xce, 0x7a,
+	0xc4, 0x89, 0xc3, 0x5e, 0x42, 0x28, 0x2f, 0xe2, 0x22, 0xdb, 0xb3, 0xd9, 0x96, 0xa1, 0xc3, 0x3a,
+	0x73, 0x7e, 0x12, 0x7b, 0xd1, 0x21, 0x8e, 0xf0, 0xa9, 0xe7, 0x7b, 0x89, 0xe0, 0xfd, 0x53, 0x50,
+	0xc7, 0x8f, 0xb8, 0xfb, 0x63, 0x58, 0x49, 0x62, 0x2f, 0x72, 0x5c, 0xe1, 0x90, 0x47, 0xb8, 0x29,
+	0x89, 0x70, 0xc8, 0xcf, 0xc0, 0x5e, 0x4e, 0x46, 0xfc, 0x16, 0x81, 0xda, 0xc4, 0x0d, 0xfb, 0x63,
+	0xc3, 0xc3, 0xb8, 0x05, 0x57, 0xc7, 0x4e, 0xca, 0xae, 0xaa, 0x98, 0x2b, 0xbf, 0x2a, 0xa0, 0x33,
+	0xa7, 0x87, 0x8f, 0x89, 0xfb, 0xe4, 0x4e, 0x2f, 0x79, 0x1c, 0xc6, 0xde, 0x67, 0xec, 0xc3, 0x98,
+	0x3a, 0x94, 0x50, 0x03, 0xea, 0x62, 0x2f, 0xb2, 0x8f, 0x70, 0xc1, 0x86, 0x6e, 0xd1, 0x84, 0xe8,
+	0x0d, 0x98, 0xf3, 0x49, 0x9f, 0xf8, 0xea, 0xa5, 0x86, 0xb2, 0xf7, 0xff, 0xd6, 0xb5, 0xc9, 0xe3,
+	0xc9, 0x3c, 0x4a, 0xef, 0xda, 0x99, 0x09, 0xda, 0x81, 0x25, 0xd6, 0x8a, 0x42, 0xaf, 0x5f, 0x66,
+	0x05, 0xba, 0xc2, 0xb6, 0x8b, 0x3e, 0xff, 0x4d, 0x01, 0x4d, 0x46, 0xcf, 0x63, 0x2f, 0x10, 0x94,
+	0xff, 0x8e, 0xf0, 0x0a, 0xd4, 0x5c, 0x1c, 0x38, 0x69, 0x25, 0x58, 0x70, 0x35, 0x7b, 0xde, 0xc5,
+	0x41, 0x5a, 0x28, 0xb4, 0x06, 0x0b, 0xe9, 0x51, 0x4c, 0x28, 0x49, 0x58, 0x74, 0x35, 0x3b, 0xbd,
+	0x6b, 0xa7, 0x6b, 0xa4, 0x41, 0x2d, 0x6f, 0x51, 0xf5, 0x72, 0x76, 0x96, 0xaf, 0xd3, 0x74, 0xc6,
+	0x04, 0xd3, 0x30, 0xa0, 0xea, 0x1c, 0x0b, 0x27, 0x5f, 0xb6, 0xbe, 0xae, 0xc3, 0x1c, 0xab, 0x05,
+	0xfa, 0x4a, 0x81, 0x79, 0x5e, 0x43, 0xb4, 0x23, 0x01, 0x96, 0x68, 0x87, 0x66, 0x48, 0xee, 0x8d,
+	0xf4, 0x81, 0xd1, 0xfa, 0xf2, 0x9f, 0x9f, 0xf7, 0x95, 0x67, 0x7f, 0xfc, 0xfd, 0x7c, 0x76, 0x17,
+	0x6d, 0x5b, 0xe3, 0xf2, 0x96, 0x4f, 0x57, 0xeb, 0x73, 0x5e, 0xe3, 0x2f, 0xd0, 0x33, 0x05, 0x6a,
+	0xdc, 0x0f, 0x45, 0xbb, 0x53, 0x60, 0xf2, 0xef, 0x42, 0xdb, 0xaa, 0xa6, 0x29, 0x3e, 0x10, 0x63,
+	0xaf, 0xc4, 0xd9, 0x40, 0x6b, 0x13, 0x70, 0xd0, 0x77, 0x0a, 0xd4, 0xc5, 0x41, 0x8c, 0x0e, 0xaa,
+	0x40, 0x24, 0x53, 0x5c, 0xdb, 0x9d, 0x30, 0x4f, 0xc4, 0xb1, 0x6e, 0x5c, 0x2f, 0x81, 0x36, 0xd1,
+	0xab, 0x12, 0x20, 0x5e, 0x45, 0x36, 0xf3, 0xd1, 0xf7, 0x0a, 0xd4, 0xc5, 0x21, 0x5e, 0x0d, 0x25,
+	0x51, 0x00, 0x29, 0x94, 0x4c, 0x12, 0x0c, 0xb3, 0x84, 0xda, 0x42, 0x9b, 0x12, 0xa8, 0x98, 0xe0,
+	0xb6, 0x13, 0x06, 0xfe, 0xc0, 0xe9, 0xa6, 0x14, 0xdf, 0x28, 0x00, 0xe5, 0x78, 0x47, 0xaf, 0x55,
+	0x42, 0x8d, 0xea, 0x82, 0xb6, 0x3d, 0x69, 0xd8, 0x97, 0x65, 0x3b, 0x28, 0x81, 0x1a, 0x48, 0x97,
+	0x01, 0x95, 0x32, 0x82, 0x9e, 0x0b, 0x95, 0x4b, 0x67, 0xff, 0xf4, 0xca, 0x09, 0xc2, 0x31, 0xb1,
+	0x72, 0xa2, 0x92, 0x18, 0x37, 0x4a, 0x26, 0x03, 0x35, 0xaa, 0x2b, 0x97, 0xe9, 0x0c, 0xfa, 0x41,
+	0x81, 0xa5, 0x11, 0x55, 0x40, 0xe6, 0x34, 0xb0, 0x61, 0x61, 0xd1, 0xf6, 0x27, 0xb0, 0x8d, 0x28,
+	0x8d, 0x71, 0xb3, 0xc4, 0xdb, 0x46, 0x5b, 0x93, 0xf0, 0xce, 0x38, 0xcd, 0x8f, 0x0a, 0x2c, 0x8f,
+	0x2a, 0x0b, 0xb2, 0xaa, 0x10, 0x2b, 0xe4, 0x49, 0x3b, 0x98, 0xa6, 0x33, 0x22, 0x64, 0xb3, 0x84,
+	0xdc, 0x41, 0xd7, 0x24, 0x90, 0x63, 0x6a, 0xc6, 0xf2, 0x38, 0x22, 0x3a, 0xd5, 0x79, 0x94, 0xeb,
+	0x96, 0x34, 0x8f, 0x15, 0x42, 0x36, 0x3d, 0x8f, 0x31, 0x37, 0x74, 0xf8, 0xe8, 0x40, 0xbf, 0x28,
+	0x80, 0xc6, 0xd5, 0x01, 0x35, 0xab, 0x20, 0x2b, 0x75, 0x50, 0xbb, 0x21, 0x31, 0xa9, 0xd6, 0x1d,
+	0xe3, 0x76, 0x89, 0xda, 0x42, 0x37, 0x25, 0xa8, 0x6e, 0x6a, 0xeb, 0x60, 0xd1, 0xb8, 0x1c, 0xbb,
+	0xef, 0xbc, 0xfe, 0xfb, 0xb9, 0xae, 0xbc, 0x38, 0xd7, 0x95, 0xbf, 0xce, 0x75, 0xe5, 0xdb, 0x0b,
+	0x7d, 0xe6, 0xc5, 0x85, 0x3e, 0xf3, 0xe7, 0x85, 0x3e, 0xf3, 0xd1, 0x7a, 0xe6, 0x8a, 0xb6, 0x9f,
+	0x98, 0x5e, 0x68, 0x3d, 0x2d, 0x5c, 0xb2, 0x5f, 0x93, 0xd3, 0xff, 0xb1, 0x1f, 0x8c, 0x5b, 0xff,
+	0x0e, 0x00, 0x9e, 0x01, 0x7e, 0xc0, 0x1a, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Account returns account permissions.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	// Account returns account permissions.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// DisabledList returns a list of disabled message urls
+	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// ReadOnlyMode returns whether the chain is in read-only mode and which
+	// Msg type URLs are exempt from it.
+	ReadOnlyMode(ctx context.Context, in *QueryReadOnlyModeRequest, opts ...grpc.CallOption) (*ReadOnlyModeResponse, error)
+	// RateLimits returns the rate limited Msg type URLs.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	// DisabledNFTs returns the paused nft classes and nfts.
+	DisabledNFTs(ctx context.Context, in *QueryDisabledNFTsRequest, opts ...grpc.CallOption) (*DisabledNFTsResponse, error)
+	// DisabledQueries returns the disabled gRPC query routes and patterns.
+	DisabledQueries(ctx context.Context, in *QueryDisabledQueriesRequest, opts ...grpc.CallOption) (*DisabledQueriesResponse, error)
+	// TripCapabilities returns the capabilities of the modules to trip the
+	// circuit breaker.
+	TripCapabilities(ctx context.Context, in *QueryTripCapabilitiesRequest, opts ...grpc.CallOption) (*TripCapabilitiesResponse, error)
+	// RecoveryAccount returns the account which can only reset the circuit
+	// breaker, set at genesis.
+	RecoveryAccount(ctx context.Context, in *QueryRecoveryAccountRequest, opts ...grpc.CallOption) (*RecoveryAccountResponse, error)
+	// CheckAuthorization returns whether an account may trip or reset the
+	// circuit breaker for a Msg type URL, without submitting a tx.
+	CheckAuthorization(ctx context.Context, in *QueryCheckAuthorizationRequest, opts ...grpc.CallOption) (*CheckAuthorizationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error) {
+	out := new(DisabledListResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/DisabledList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReadOnlyMode(ctx context.Context, in *QueryReadOnlyModeRequest, opts ...grpc.CallOption) (*ReadOnlyModeResponse, error) {
+	out := new(ReadOnlyModeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/ReadOnlyMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledNFTs(ctx context.Context, in *QueryDisabledNFTsRequest, opts ...grpc.CallOption) (*DisabledNFTsResponse, error) {
+	out := new(DisabledNFTsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/DisabledNFTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledQueries(ctx context.Context, in *QueryDisabledQueriesRequest, opts ...grpc.CallOption) (*DisabledQueriesResponse, error) {
+	out := new(DisabledQueriesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/DisabledQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripCapabilities(ctx context.Context, in *QueryTripCapabilitiesRequest, opts ...grpc.CallOption) (*TripCapabilitiesResponse, error) {
+	out := new(TripCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/TripCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryAccount(ctx context.Context, in *QueryRecoveryAccountRequest, opts ...grpc.CallOption) (*RecoveryAccountResponse, error) {
+	out := new(RecoveryAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/RecoveryAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckAuthorization(ctx context.Context, in *QueryCheckAuthorizationRequest, opts ...grpc.CallOption) (*CheckAuthorizationResponse, error) {
+	out := new(CheckAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/CheckAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Account returns account permissions.
+	Account(context.Context, *QueryAccountRequest) (*AccountResponse, error)
+	// Account returns account permissions.
+	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
+	// DisabledList returns a list of disabled message urls
+	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// ReadOnlyMode returns whether the chain is in read-only mode and which
+	// Msg type URLs are exempt from it.
+	ReadOnlyMode(context.Context, *QueryReadOnlyModeRequest) (*ReadOnlyModeResponse, error)
+	// RateLimits returns the rate limited Msg type URLs.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*RateLimitsResponse, error)
+	// DisabledNFTs returns the paused nft classes and nfts.
+	DisabledNFTs(context.Context, *QueryDisabledNFTsRequest) (*DisabledNFTsResponse, error)
+	// DisabledQueries returns the disabled gRPC query routes and patterns.
+	DisabledQueries(context.Context, *QueryDisabledQueriesRequest) (*DisabledQueriesResponse, error)
+	// TripCapabilities returns the capabilities of the modules to trip the
+	// circuit breaker.
+	TripCapabilities(context.Context, *QueryTripCapabilitiesRequest) (*TripCapabilitiesResponse, error)
+	// RecoveryAccount returns the account which can only reset the circuit
+	// breaker, set at genesis.
+	RecoveryAccount(context.Context, *QueryRecoveryAccountRequest) (*RecoveryAccountResponse, error)
+	// CheckAuthorization returns whether an account may trip or reset the
+	// circuit breaker for a Msg type URL, without submitting a tx.
+	CheckAuthorization(context.Context, *QueryCheckAuthorizationRequest) (*CheckAuthorizationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*AccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
+}
+func (*UnimplementedQueryServer) ReadOnlyMode(ctx context.Context, req *QueryReadOnlyModeRequest) (*ReadOnlyModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadOnlyMode not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) DisabledNFTs(ctx context.Context, req *QueryDisabledNFTsRequest) (*DisabledNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledNFTs not implemented")
+}
+func (*UnimplementedQueryServer) DisabledQueries(ctx context.Context, req *QueryDisabledQueriesRequest) (*DisabledQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledQueries not implemented")
+}
+func (*UnimplementedQueryServer) TripCapabilities(ctx context.Context, req *QueryTripCapabilitiesRequest) (*TripCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCapabilities not implemented")
+}
+func (*UnimplementedQueryServer) RecoveryAccount(ctx context.Context, req *QueryRecoveryAccountRequest) (*RecoveryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryAccount not implemented")
+}
+func (*UnimplementedQueryServer) CheckAuthorization(ctx context.Context, req *QueryCheckAuthorizationRequest) (*CheckAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAuthorization not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/DisabledList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledList(ctx, req.(*QueryDisabledListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReadOnlyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadOnlyModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReadOnlyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/ReadOnlyMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReadOnlyMode(ctx, req.(*QueryReadOnlyModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/DisabledNFTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledNFTs(ctx, req.(*QueryDisabledNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/DisabledQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledQueries(ctx, req.(*QueryDisabledQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/TripCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripCapabilities(ctx, req.(*QueryTripCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/RecoveryAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryAccount(ctx, req.(*QueryRecoveryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/CheckAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckAuthorization(ctx, req.(*QueryCheckAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.circuit.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "DisabledList",
+			Handler:    _Query_DisabledList_Handler,
+		},
+		{
+			MethodName: "ReadOnlyMode",
+			Handler:    _Query_ReadOnlyMode_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "DisabledNFTs",
+			Handler:    _Query_DisabledNFTs_Handler,
+		},
+		{
+			MethodName: "DisabledQueries",
+			Handler:    _Query_DisabledQueries_Handler,
+		},
+		{
+			MethodName: "TripCapabilities",
+			Handler:    _Query_TripCapabilities_Handler,
+		},
+		{
+			MethodName: "RecoveryAccount",
+			Handler:    _Query_RecoveryAccount_Handler,
+		},
+		{
+			MethodName: "CheckAuthorization",
+			Handler:    _Query_CheckAuthorization_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/circuit/v1/query.proto",
+}
+
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != nil {
+		{
+			size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DisabledListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisabledListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledList) > 0 {
+		for iNdEx := len(m.DisabledList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledList[iNdEx])
+			copy(dAtA[i:], m.DisabledList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadOnlyModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadOnlyModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadOnlyModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadOnlyModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadOnlyModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadOnlyModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ExemptMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ExemptMsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ExemptMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledNFTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledNFTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledNFTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DisabledNFTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisabledNFTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisabledNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledNfts) > 0 {
+		for iNdEx := len(m.DisabledNfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledNfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DisabledQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisabledQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisabledQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryRoutes) > 0 {
+		for iNdEx := len(m.QueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryRoutes[iNdEx])
+			copy(dAtA[i:], m.QueryRoutes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripCapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripCapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripCapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TripCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TripCapabilities) > 0 {
+		for iNdEx := len(m.TripCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitTypeUrls) > 0 {
+		for iNdEx := len(m.LimitTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitTypeUrls[iNdEx])
+			copy(dAtA[i:], m.LimitTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.LimitTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CanReset {
+		i--
+		if m.CanReset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CanTrip {
+		i--
+		if m.CanTrip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Level != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != nil {
+		l = m.Permission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisabledListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisabledListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledList) > 0 {
+		for _, s := range m.DisabledList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReadOnlyModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReadOnlyModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.ExemptMsgTypeUrls) > 0 {
+		for _, s := range m.ExemptMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDisabledNFTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisabledNFTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledNfts) > 0 {
+		for _, e := range m.DisabledNfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDisabledQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisabledQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueryRoutes) > 0 {
+		for _, s := range m.QueryRoutes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTripCapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TripCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TripCapabilities) > 0 {
+		for _, e := range m.TripCapabilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecoveryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RecoveryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Level != 0 {
+		n += 1 + sovQuery(uint64(m.Level))
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for _, s := range m.LimitTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovQuery(uint64(m.Level))
+	}
+	if m.CanTrip {
+		n += 2
+	}
+	if m.CanReset {
+		n += 2
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permission == nil {
+				m.Permission = &Permissions{}
+			}
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &GenesisAccountPermissions{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisabledListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisabledListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledList = append(m.DisabledList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReadOnlyModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReadOnlyModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReadOnlyModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadOnlyModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadOnlyModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadOnlyModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptMsgTypeUrls = append(m.ExemptMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, &RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledNFTsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledNFTsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledNFTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisabledNFTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisabledNFTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisabledNFTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledNfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledNfts = append(m.DisabledNfts, &DisabledNFT{})
+			if err := m.DisabledNfts[len(m.DisabledNfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisabledQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisabledQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisabledQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryRoutes = append(m.QueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripCapabilitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripCapabilitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripCapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripCapabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,17 +3600,17 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TripCapabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TripCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCapabilities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -853,23 +3620,25 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TripCapabilities = append(m.TripCapabilities, &TripCapability{})
+			if err := m.TripCapabilities[len(m.TripCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -892,7 +3661,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoveryAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -915,48 +3684,12 @@ func (m *AccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoveryAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoveryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Permission == nil {
-				m.Permission = &Permissions{}
-			}
-			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -978,7 +3711,7 @@ func (m *AccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecoveryAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,17 +3734,17 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecoveryAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecoveryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1021,27 +3754,23 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1064,7 +3793,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckAuthorizationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,17 +3816,17 @@ func (m *AccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckAuthorizationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1107,31 +3836,29 @@ func (m *AccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &GenesisAccountPermissions{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1141,78 +3868,75 @@ func (m *AccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDisabledListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDisabledListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDisabledListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1234,7 +3958,7 @@ func (m *QueryDisabledListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,15 +3981,94 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DisabledListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DisabledListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTrip", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanTrip = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanReset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanReset = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisabledList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1293,7 +4096,7 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisabledList = append(m.DisabledList, string(dAtA[iNdEx:postIndex]))
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ValidateQueryRoute checks that the query route is the full gRPC method name
+// of a query, e.g. "/cosmos.bank.v1beta1.Query/DenomOwners", or a valid glob
+// pattern of such names, e.g. "/cosmos.bank.v1beta1.Query/*". Whether the
+// route is served by the app is not checked, as the query routes are not part
+// of the state machine.
+func ValidateQueryRoute(route string) error {
+	service, method, ok := strings.Cut(strings.TrimPrefix(route, "/"), "/")
+	if !ok || service == "" || method == "" || strings.Contains(method, "/") {
+		return fmt.Errorf("invalid query route %s, expected /<service>/<method>", route)
+	}
+
+	if _, err := path.Match(route, ""); err != nil {
+		return fmt.Errorf("invalid query route pattern %s: %w", route, err)
+	}
+
+	return nil
+}
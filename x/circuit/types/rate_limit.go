@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+// Validate checks that the rate limit applies to a Msg type URL, and allows at
+// least one Msg per window of at least one block.
+func (rl RateLimit) Validate() error {
+	if rl.MsgTypeUrl == "" {
+		return fmt.Errorf("rate limit message type url cannot be empty")
+	}
+
+	if IsMsgTypeURLPattern(rl.MsgTypeUrl) {
+		return fmt.Errorf("rate limit message type url cannot be a pattern: %s", rl.MsgTypeUrl)
+	}
+
+	if rl.MaxExecutions == 0 {
+		return fmt.Errorf("rate limit of %s must allow at least one execution", rl.MsgTypeUrl)
+	}
+
+	if rl.WindowBlocks == 0 {
+		return fmt.Errorf("rate limit window of %s must be at least one block", rl.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// WindowStart returns the height the window of the rate limit containing the
+// given height started at.
+func (rl RateLimit) WindowStart(height int64) int64 {
+	return height - height%int64(rl.WindowBlocks)
+}
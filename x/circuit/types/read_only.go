@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// alwaysExemptMsgs are the Msg's that are processed even in read-only mode,
+// so that the circuit breaker can always be reset.
+var alwaysExemptMsgs = []sdk.Msg{
+	&MsgAuthorizeCircuitBreaker{},
+	&MsgResetCircuitBreaker{},
+	&MsgResetAll{},
+}
+
+// IsExemptFromReadOnlyMode returns true if the given Msg type URL keeps being
+// processed while the chain is in the given read-only mode.
+func IsExemptFromReadOnlyMode(mode *ReadOnlyMode, msgURL string) bool {
+	for _, msg := range alwaysExemptMsgs {
+		if sdk.MsgTypeURL(msg) == msgURL {
+			return true
+		}
+	}
+
+	if mode == nil {
+		return false
+	}
+
+	for _, url := range mode.ExemptMsgTypeUrls {
+		if url == msgURL {
+			return true
+		}
+	}
+
+	return false
+}
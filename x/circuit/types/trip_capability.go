@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// Validate checks that the trip capability is held by a module, and lists at
+// least one Msg type URL, none of them being a pattern or duplicated.
+func (c TripCapability) Validate() error {
+	if c.Module == "" {
+		return fmt.Errorf("trip capability module cannot be empty")
+	}
+
+	if len(c.MsgTypeUrls) == 0 {
+		return fmt.Errorf("trip capability of %s requires at least one message type url", c.Module)
+	}
+
+	for _, msgURL := range c.MsgTypeUrls {
+		if msgURL == "" || IsMsgTypeURLPattern(msgURL) {
+			return fmt.Errorf("invalid trip capability message type url of %s: %q", c.Module, msgURL)
+		}
+	}
+
+	if url, ok := findDuplicateMsgTypeURL(c.MsgTypeUrls); ok {
+		return fmt.Errorf("duplicate trip capability message type url of %s: %s", c.Module, url)
+	}
+
+	return nil
+}
+
+// Allows returns true if the trip capability covers the given Msg type URL.
+func (c TripCapability) Allows(msgURL string) bool {
+	for _, capURL := range c.MsgTypeUrls {
+		if EqualMsgTypeURLs(capURL, msgURL) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -175,17 +175,319 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 	return nil
 }
 
+// ReadOnlyMode is the state of the global circuit breaker tripped using
+// Msg/TripAll. While it is set, only the exempted Msg type URLs are processed.
+type ReadOnlyMode struct {
+	// exempt_msg_type_urls are the Msg type URLs that keep being processed.
+	ExemptMsgTypeUrls []string `protobuf:"bytes,1,rep,name=exempt_msg_type_urls,json=exemptMsgTypeUrls,proto3" json:"exempt_msg_type_urls,omitempty"`
+}
+
+func (m *ReadOnlyMode) Reset()         { *m = ReadOnlyMode{} }
+func (m *ReadOnlyMode) String() string { return proto.CompactTextString(m) }
+func (*ReadOnlyMode) ProtoMessage()    {}
+func (*ReadOnlyMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+}
+func (m *ReadOnlyMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadOnlyMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadOnlyMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadOnlyMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadOnlyMode.Merge(m, src)
+}
+func (m *ReadOnlyMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadOnlyMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadOnlyMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadOnlyMode proto.InternalMessageInfo
+
+func (m *ReadOnlyMode) GetExemptMsgTypeUrls() []string {
+	if m != nil {
+		return m.ExemptMsgTypeUrls
+	}
+	return nil
+}
+
+// RateLimit is the rate limit of a Msg type URL set using Msg/SetRateLimit. At
+// most max_executions Msg's of the type URL are processed per window of
+// window_blocks blocks, the excess Msg's are rejected by the ante handler until
+// the next window.
+type RateLimit struct {
+	// msg_type_url is the rate limited Msg type URL.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// max_executions is the maximum number of Msg's processed per window.
+	MaxExecutions uint64 `protobuf:"varint,2,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// window_blocks is the length of a window in blocks, 1 to limit the Msg's per
+	// block. The windows start at the heights which are multiples of
+	// window_blocks.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *RateLimit) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+func (m *RateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// RateLimitUsage is the number of Msg's of a rate limited Msg type URL
+// processed in the current window.
+type RateLimitUsage struct {
+	// window_start is the height the current window started at.
+	WindowStart int64 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// executions is the number of Msg's processed since window_start.
+	Executions uint64 `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{4}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *RateLimitUsage) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+// DisabledNFT is a nft class, or a single nft of a class, paused using
+// Msg/TripNFT. The nft Msg's acting on a paused class or nft are rejected by
+// the nft module.
+type DisabledNFT struct {
+	// class_id is the id of the paused class, or of the class of the paused nft.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the id of the paused nft, empty if the whole class is paused.
+	NftId string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *DisabledNFT) Reset()         { *m = DisabledNFT{} }
+func (m *DisabledNFT) String() string { return proto.CompactTextString(m) }
+func (*DisabledNFT) ProtoMessage()    {}
+func (*DisabledNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{5}
+}
+func (m *DisabledNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisabledNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisabledNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisabledNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisabledNFT.Merge(m, src)
+}
+func (m *DisabledNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisabledNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisabledNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisabledNFT proto.InternalMessageInfo
+
+func (m *DisabledNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *DisabledNFT) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+// TripCapability is the capability of a module to trip the circuit breaker for
+// some Msg type URLs, e.g. when it detects an anomaly, using the keeper
+// RequestTrip method.
+type TripCapability struct {
+	// module is the name of the module holding the capability.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// msg_type_urls are the Msg type URLs the module can trip.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *TripCapability) Reset()         { *m = TripCapability{} }
+func (m *TripCapability) String() string { return proto.CompactTextString(m) }
+func (*TripCapability) ProtoMessage()    {}
+func (*TripCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{6}
+}
+func (m *TripCapability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripCapability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripCapability.Merge(m, src)
+}
+func (m *TripCapability) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripCapability proto.InternalMessageInfo
+
+func (m *TripCapability) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *TripCapability) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
 	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	// read_only_mode is set if the chain is in read-only mode.
+	ReadOnlyMode *ReadOnlyMode `protobuf:"bytes,3,opt,name=read_only_mode,json=readOnlyMode,proto3" json:"read_only_mode,omitempty"`
+	// rate_limits are the rate limited Msg type URLs.
+	RateLimits []*RateLimit `protobuf:"bytes,4,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// disabled_nfts are the paused nft classes and nfts.
+	DisabledNfts []*DisabledNFT `protobuf:"bytes,5,rep,name=disabled_nfts,json=disabledNfts,proto3" json:"disabled_nfts,omitempty"`
+	// trip_capabilities are the capabilities of the modules to trip the circuit
+	// breaker.
+	TripCapabilities []*TripCapability `protobuf:"bytes,6,rep,name=trip_capabilities,json=tripCapabilities,proto3" json:"trip_capabilities,omitempty"`
+	// disabled_query_routes are the disabled gRPC query routes and patterns.
+	DisabledQueryRoutes []string `protobuf:"bytes,7,rep,name=disabled_query_routes,json=disabledQueryRoutes,proto3" json:"disabled_query_routes,omitempty"`
+	// recovery_account, if set, is the "break glass" account which can only
+	// reset the circuit breaker, e.g. a cold key kept for the worst case where
+	// the accounts holding permissions are themselves disabled or compromised.
+	// It can never trip the circuit breaker nor be granted permissions.
+	RecoveryAccount string `protobuf:"bytes,8,opt,name=recovery_account,json=recoveryAccount,proto3" json:"recovery_account,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,42 +530,111 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetReadOnlyMode() *ReadOnlyMode {
+	if m != nil {
+		return m.ReadOnlyMode
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []*RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledNfts() []*DisabledNFT {
+	if m != nil {
+		return m.DisabledNfts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTripCapabilities() []*TripCapability {
+	if m != nil {
+		return m.TripCapabilities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledQueryRoutes() []string {
+	if m != nil {
+		return m.DisabledQueryRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecoveryAccount() string {
+	if m != nil {
+		return m.RecoveryAccount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
+	proto.RegisterType((*ReadOnlyMode)(nil), "cosmos.circuit.v1.ReadOnlyMode")
+	proto.RegisterType((*RateLimit)(nil), "cosmos.circuit.v1.RateLimit")
+	proto.RegisterType((*RateLimitUsage)(nil), "cosmos.circuit.v1.RateLimitUsage")
+	proto.RegisterType((*DisabledNFT)(nil), "cosmos.circuit.v1.DisabledNFT")
+	proto.RegisterType((*TripCapability)(nil), "cosmos.circuit.v1.TripCapability")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x5d, 0x6f, 0xe2, 0x46,
+	0x14, 0xc5, 0x10, 0xd8, 0xcd, 0x35, 0x10, 0x98, 0x34, 0x2b, 0x6f, 0xb5, 0x75, 0x59, 0xf7, 0x43,
+	0xa9, 0xb4, 0x02, 0x2d, 0x95, 0xfa, 0x50, 0xa9, 0xda, 0x66, 0x13, 0xef, 0x0a, 0x09, 0x48, 0x6a,
+	0x42, 0x1f, 0x2a, 0x55, 0xa3, 0xc1, 0x1e, 0xa2, 0xd1, 0xfa, 0xab, 0x33, 0x03, 0x81, 0x7f, 0xd1,
+	0x97, 0xfe, 0xa7, 0x3e, 0xee, 0x53, 0xd5, 0xc7, 0x2a, 0xf9, 0x23, 0x95, 0xc7, 0x1f, 0x75, 0x04,
+	0xe9, 0x9b, 0xef, 0x39, 0xf7, 0xce, 0xdc, 0x7b, 0xce, 0xf5, 0xc0, 0x67, 0x6e, 0x24, 0x82, 0x48,
+	0x0c, 0x5c, 0xc6, 0xdd, 0x15, 0x93, 0x83, 0xf5, 0xeb, 0x81, 0xdc, 0xc6, 0x54, 0xf4, 0x63, 0x1e,
+	0xc9, 0x08, 0x75, 0x53, 0xba, 0x9f, 0xd1, 0xfd, 0xf5, 0x6b, 0xeb, 0x2f, 0x0d, 0xf4, 0x2b, 0xca,
+	0x03, 0x26, 0x04, 0x8b, 0x42, 0x81, 0xbe, 0x87, 0xba, 0x4f, 0xd7, 0xd4, 0x37, 0xb4, 0x9e, 0x76,
+	0xda, 0x1e, 0x7e, 0xd9, 0xdf, 0x29, 0xe9, 0x97, 0xd2, 0xfb, 0xe3, 0x24, 0xd7, 0x49, 0x4b, 0xd0,
+	0xd7, 0x70, 0xe4, 0xb3, 0x80, 0x49, 0x9c, 0xdc, 0x89, 0x57, 0xdc, 0x17, 0x46, 0xb5, 0x57, 0x3b,
+	0x3d, 0x74, 0x5a, 0x0a, 0xbe, 0xde, 0xc6, 0x74, 0xce, 0x7d, 0x61, 0xb9, 0x50, 0x57, 0x75, 0xe8,
+	0x53, 0x78, 0x36, 0xb6, 0x7f, 0xb6, 0xc7, 0x78, 0x7a, 0x39, 0xb5, 0xf1, 0x7c, 0x3a, 0xbb, 0xb2,
+	0xcf, 0x47, 0xef, 0x46, 0xf6, 0x45, 0xa7, 0x82, 0x8e, 0xe1, 0x28, 0xe5, 0x66, 0x97, 0x13, 0x1b,
+	0x4f, 0x66, 0xef, 0x67, 0x1d, 0x0d, 0x21, 0x68, 0xa7, 0xe0, 0xd9, 0x78, 0x9c, 0x62, 0x55, 0x74,
+	0x02, 0xdd, 0x2c, 0x71, 0x7e, 0x65, 0x3b, 0xf8, 0xec, 0x62, 0x32, 0x9a, 0x76, 0x6a, 0xd6, 0x2d,
+	0x3c, 0x7f, 0x4f, 0x43, 0x2a, 0x98, 0x38, 0x73, 0xdd, 0x68, 0x15, 0xca, 0xf2, 0x94, 0x06, 0x3c,
+	0x21, 0x9e, 0xc7, 0xa9, 0x10, 0x6a, 0xce, 0x43, 0x27, 0x0f, 0xd1, 0x8f, 0xa0, 0xc7, 0xff, 0x25,
+	0x1a, 0xd5, 0x9e, 0x76, 0xaa, 0x0f, 0xcd, 0xff, 0x57, 0xc1, 0x29, 0x97, 0x58, 0x6f, 0xa0, 0xe9,
+	0x50, 0xe2, 0x5d, 0x86, 0xfe, 0x76, 0x12, 0x79, 0x14, 0x0d, 0xe0, 0x13, 0xba, 0xa1, 0x41, 0x2c,
+	0x71, 0x20, 0x6e, 0x4a, 0xd2, 0x68, 0x4a, 0x9a, 0x6e, 0xca, 0x4d, 0xc4, 0x4d, 0x21, 0xcf, 0x16,
+	0x0e, 0x1d, 0x22, 0xe9, 0x38, 0xd1, 0x0c, 0xf5, 0xa0, 0x59, 0x2e, 0xcb, 0xda, 0x85, 0xa0, 0xc8,
+	0x47, 0x5f, 0x41, 0x3b, 0x20, 0x1b, 0x4c, 0x37, 0xd4, 0x5d, 0xc9, 0xa2, 0xe9, 0x03, 0xa7, 0x15,
+	0x90, 0x8d, 0x5d, 0x80, 0xe8, 0x0b, 0x68, 0xdd, 0xb2, 0xd0, 0x8b, 0x6e, 0xf1, 0xc2, 0x8f, 0xdc,
+	0x0f, 0xc2, 0xa8, 0xa9, 0xac, 0x66, 0x0a, 0xbe, 0x55, 0x98, 0x35, 0x83, 0x76, 0x71, 0xf5, 0x5c,
+	0x90, 0x1b, 0x8a, 0x5e, 0x42, 0x96, 0x81, 0x85, 0x24, 0x5c, 0xaa, 0xfb, 0x6b, 0x8e, 0x9e, 0x62,
+	0xb3, 0x04, 0x42, 0x26, 0xc0, 0xce, 0xe5, 0x25, 0xc4, 0x7a, 0x03, 0xfa, 0x05, 0x13, 0x64, 0xe1,
+	0x53, 0x6f, 0xfa, 0xee, 0x1a, 0x3d, 0x87, 0xa7, 0xae, 0x4f, 0x84, 0xc0, 0xcc, 0xcb, 0xc5, 0x57,
+	0xf1, 0xc8, 0x43, 0x27, 0xd0, 0x08, 0x97, 0x32, 0x21, 0xaa, 0x8a, 0xa8, 0x87, 0x4b, 0x39, 0xf2,
+	0xac, 0x31, 0xb4, 0xaf, 0x39, 0x8b, 0xcf, 0x49, 0x4c, 0x16, 0xcc, 0x67, 0x72, 0x8b, 0x9e, 0x41,
+	0x23, 0x88, 0xbc, 0x95, 0x4f, 0xb3, 0x13, 0xb2, 0x08, 0x59, 0xd0, 0x7a, 0x28, 0x72, 0xba, 0x7f,
+	0x7a, 0x50, 0x92, 0xf7, 0x8f, 0x03, 0x68, 0x66, 0x9b, 0x31, 0x93, 0x44, 0x52, 0xf4, 0x2b, 0x1c,
+	0x93, 0x74, 0x45, 0x70, 0xd9, 0xfa, 0xc4, 0x1f, 0x7d, 0xf8, 0x6a, 0x8f, 0xf5, 0x8f, 0xee, 0x95,
+	0x83, 0xc8, 0xee, 0xae, 0xbd, 0x02, 0xe4, 0x65, 0xe3, 0xef, 0x34, 0xd6, 0xc9, 0x99, 0xbc, 0x3b,
+	0x64, 0x43, 0x9b, 0x53, 0xe2, 0xe1, 0x28, 0xf4, 0xb7, 0x38, 0x88, 0x3c, 0xaa, 0x7c, 0xd2, 0x87,
+	0x9f, 0xef, 0xe9, 0xa3, 0xbc, 0x66, 0x4e, 0x93, 0x97, 0x22, 0xf4, 0x03, 0xe8, 0x9c, 0x48, 0x8a,
+	0xd5, 0x8f, 0x27, 0x8c, 0x03, 0x35, 0xcb, 0x8b, 0x7d, 0x67, 0xe4, 0x76, 0x3b, 0xc0, 0xf3, 0x4f,
+	0x81, 0xce, 0xa1, 0x55, 0xf4, 0x1c, 0x2e, 0xa5, 0x30, 0xea, 0xbd, 0xda, 0x23, 0xff, 0x41, 0xc9,
+	0x5a, 0xa7, 0x99, 0x17, 0x4d, 0x97, 0x52, 0xa0, 0x29, 0x74, 0x25, 0x67, 0x31, 0x76, 0x73, 0xdf,
+	0x18, 0x15, 0x46, 0x43, 0x1d, 0xf4, 0x72, 0xcf, 0x41, 0x0f, 0x2d, 0x76, 0x3a, 0xb2, 0x1c, 0x33,
+	0x2a, 0xd0, 0x10, 0x4e, 0x8a, 0xa6, 0x7e, 0x5b, 0x51, 0xbe, 0xc5, 0x3c, 0x5a, 0x49, 0x2a, 0x8c,
+	0x27, 0x4a, 0xcb, 0xe3, 0x9c, 0xfc, 0x29, 0xe1, 0x1c, 0x45, 0xa1, 0x6f, 0xa0, 0xc3, 0xa9, 0x1b,
+	0xad, 0x93, 0xec, 0xcc, 0x1b, 0xe3, 0xa9, 0x5a, 0x99, 0xa3, 0x1c, 0xcf, 0x6c, 0x7c, 0xfb, 0xdd,
+	0x9f, 0x77, 0xa6, 0xf6, 0xf1, 0xce, 0xd4, 0xfe, 0xb9, 0x33, 0xb5, 0xdf, 0xef, 0xcd, 0xca, 0xc7,
+	0x7b, 0xb3, 0xf2, 0xf7, 0xbd, 0x59, 0xf9, 0xe5, 0x45, 0xda, 0xac, 0xf0, 0x3e, 0xf4, 0x59, 0x34,
+	0xd8, 0x14, 0xaf, 0xab, 0x7a, 0x5a, 0x17, 0x0d, 0xf5, 0xb6, 0x7e, 0xfb, 0xef, 0x00, 0x60, 0x96,
+	0x64, 0xf8, 0x7c, 0x05, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -345,7 +716,7 @@ func (m *GenesisAccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *ReadOnlyMode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -355,35 +726,21 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadOnlyMode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadOnlyMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DisabledTypeUrls) > 0 {
-		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DisabledTypeUrls[iNdEx])
-			copy(dAtA[i:], m.DisabledTypeUrls[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledTypeUrls[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.AccountPermissions) > 0 {
-		for iNdEx := len(m.AccountPermissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
+	if len(m.ExemptMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ExemptMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ExemptMsgTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ExemptMsgTypeUrls[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -391,42 +748,307 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Permissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Level != 0 {
-		n += 1 + sovTypes(uint64(m.Level))
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.LimitTypeUrls) > 0 {
-		for _, s := range m.LimitTypeUrls {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisAccountPermissions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisabledNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisabledNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisabledNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TripCapability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripCapability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryAccount) > 0 {
+		i -= len(m.RecoveryAccount)
+		copy(dAtA[i:], m.RecoveryAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecoveryAccount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DisabledQueryRoutes) > 0 {
+		for iNdEx := len(m.DisabledQueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledQueryRoutes[iNdEx])
+			copy(dAtA[i:], m.DisabledQueryRoutes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledQueryRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TripCapabilities) > 0 {
+		for iNdEx := len(m.TripCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DisabledNfts) > 0 {
+		for iNdEx := len(m.DisabledNfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledNfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ReadOnlyMode != nil {
+		{
+			size, err := m.ReadOnlyMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisabledTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountPermissions) > 0 {
+		for iNdEx := len(m.AccountPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovTypes(uint64(m.Level))
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for _, s := range m.LimitTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -437,34 +1059,660 @@ func (m *GenesisAccountPermissions) Size() (n int) {
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *ReadOnlyMode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AccountPermissions) > 0 {
-		for _, e := range m.AccountPermissions {
-			l = e.Size()
+	if len(m.ExemptMsgTypeUrls) > 0 {
+		for _, s := range m.ExemptMsgTypeUrls {
+			l = len(s)
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	if len(m.DisabledTypeUrls) > 0 {
-		for _, s := range m.DisabledTypeUrls {
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovTypes(uint64(m.MaxExecutions))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStart))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovTypes(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *DisabledNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TripCapability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
 			l = len(s)
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountPermissions) > 0 {
+		for _, e := range m.AccountPermissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledTypeUrls) > 0 {
+		for _, s := range m.DisabledTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ReadOnlyMode != nil {
+		l = m.ReadOnlyMode.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledNfts) > 0 {
+		for _, e := range m.DisabledNfts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TripCapabilities) > 0 {
+		for _, e := range m.TripCapabilities {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledQueryRoutes) > 0 {
+		for _, s := range m.DisabledQueryRoutes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.RecoveryAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadOnlyMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadOnlyMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadOnlyMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptMsgTypeUrls = append(m.ExemptMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Permissions) Unmarshal(dAtA []byte) error {
+func (m *DisabledNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,17 +1735,17 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisabledNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisabledNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			m.Level = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -507,14 +1755,27 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= Permissions_Level(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -542,7 +1803,7 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -565,7 +1826,7 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
+func (m *TripCapability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,15 +1849,15 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisAccountPermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: TripCapability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TripCapability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,13 +1885,13 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -640,27 +1901,23 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &Permissions{}
-			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -778,6 +2035,208 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnlyMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadOnlyMode == nil {
+				m.ReadOnlyMode = &ReadOnlyMode{}
+			}
+			if err := m.ReadOnlyMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, &RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledNfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledNfts = append(m.DisabledNfts, &DisabledNFT{})
+			if err := m.DisabledNfts[len(m.DisabledNfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripCapabilities = append(m.TripCapabilities, &TripCapability{})
+			if err := m.TripCapabilities[len(m.TripCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledQueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledQueryRoutes = append(m.DisabledQueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
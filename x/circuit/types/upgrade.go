@@ -0,0 +1,35 @@
+package types
+
+// TripSnapshot is a snapshot of the trips of the circuit breaker, i.e. of the
+// Msg type URLs it disables and of its read-only mode.
+type TripSnapshot struct {
+	// DisabledMsgs are the disabled Msg type URLs, in store order.
+	DisabledMsgs []string
+	// ReadOnlyMode is the read-only mode, nil if it is not enabled.
+	ReadOnlyMode *ReadOnlyMode
+}
+
+// TripsUpgradePolicy defines what happens to the trips of the circuit breaker
+// when an upgrade is applied.
+type TripsUpgradePolicy int
+
+const (
+	// TripsUpgradeRestore restores the trips as they were before the upgrade
+	// migrations. The trips made by the migrations are reset, and the disabled
+	// Msg type URLs which are no longer registered in the app are dropped.
+	TripsUpgradeRestore TripsUpgradePolicy = iota
+	// TripsUpgradeClear resets every trip once the upgrade migrations ran.
+	TripsUpgradeClear
+)
+
+// String implements the fmt.Stringer interface.
+func (p TripsUpgradePolicy) String() string {
+	switch p {
+	case TripsUpgradeRestore:
+		return "restore"
+	case TripsUpgradeClear:
+		return "clear"
+	default:
+		return "unknown"
+	}
+}
@@ -30,6 +30,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorDistributionInfo(),
 		GetCmdQueryValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
+		GetCmdQueryValidatorAPR(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(ac),
 		GetCmdQueryCommunityPool(),
@@ -199,6 +200,52 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorAPR implements the query validator APR command.
+func GetCmdQueryValidatorAPR() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "apr [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the nominal APR of the delegations to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current nominal APR, as a fraction, of the rewards the delegations to a
+validator earn from inflation, after community tax and validator commission.
+
+Example:
+$ %s query distribution apr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorAPR(
+				cmd.Context(),
+				&types.QueryValidatorAPRRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryValidatorSlashes implements the query validator slashes command.
 func GetCmdQueryValidatorSlashes() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
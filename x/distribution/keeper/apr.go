@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CalculateValidatorAPR computes the current nominal APR of the delegations to the
+// validator: the rewards a block provision allocates to their tokens, over a
+// year of blocks. The block provision is split as AllocateTokens and
+// AllocateTokensToValidator split the collected fees, with the same rounding,
+// so that the APR matches the rewards actually distributed by the chain. The
+// transaction fees, which vary from block to block, are not taken into account.
+func (k Keeper) CalculateValidatorAPR(ctx context.Context, val stakingtypes.ValidatorI) (math.LegacyDec, error) {
+	if k.mintKeeper == nil {
+		return math.LegacyDec{}, types.ErrNoMintKeeper
+	}
+
+	// only the bonded validators are allocated rewards
+	if !val.IsBonded() || !val.GetTokens().IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	totalPower := k.stakingKeeper.GetLastTotalPower(sdkCtx)
+	if !totalPower.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	provision, err := k.mintKeeper.BlockProvision(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	blocksPerYear, err := k.mintKeeper.BlocksPerYear(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	communityTax, err := k.GetCommunityTax(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// split the block provision as AllocateTokens splits the collected fees
+	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
+	feeMultiplier := math.LegacyNewDecFromInt(provision.Amount).MulTruncate(voteMultiplier)
+
+	power := k.stakingKeeper.ConsensusPower(sdkCtx, val)
+	powerFraction := math.LegacyNewDec(power).QuoTruncate(math.LegacyNewDecFromInt(totalPower))
+	reward := feeMultiplier.MulTruncate(powerFraction)
+
+	// split the reward of the validator as AllocateTokensToValidator does
+	commission := reward.Mul(val.GetCommission())
+	shared := reward.Sub(commission)
+
+	return shared.MulInt(math.NewIntFromUint64(blocksPerYear)).QuoInt(val.GetTokens()), nil
+}
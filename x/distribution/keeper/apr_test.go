@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestCalculateValidatorAPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	mintKeeper := distrtestutil.NewMockMintKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create a bonded validator with 10% commission, which has a quarter of the
+	// total power
+	val, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(0))
+
+	// the mint keeper is required
+	_, err = distrKeeper.CalculateValidatorAPR(ctx, val)
+	require.ErrorIs(t, err, disttypes.ErrNoMintKeeper)
+	distrKeeper.SetMintKeeper(mintKeeper)
+
+	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(400)).AnyTimes()
+	stakingKeeper.EXPECT().ConsensusPower(gomock.Any(), gomock.Any()).Return(int64(100)).AnyTimes()
+	mintKeeper.EXPECT().BlockProvision(gomock.Any()).Return(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)), nil).AnyTimes()
+	mintKeeper.EXPECT().BlocksPerYear(gomock.Any()).Return(uint64(1000), nil).AnyTimes()
+
+	// the validator is allocated 1000 * (1 - 2%) / 4 = 245 tokens per block, of
+	// which 220.5 are shared with its delegators, i.e. 220500 tokens a year for
+	// 100000000 tokens
+	apr, err := distrKeeper.CalculateValidatorAPR(ctx, val)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.002205"), apr)
+
+	// unbonded validators are not allocated rewards
+	val.Status = stakingtypes.Unbonded
+	apr, err = distrKeeper.CalculateValidatorAPR(ctx, val)
+	require.NoError(t, err)
+	require.True(t, apr.IsZero())
+}
@@ -148,6 +148,36 @@ func (k Querier) ValidatorCommission(c context.Context, req *types.QueryValidato
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
 }
 
+// ValidatorAPR queries the current nominal APR of the delegations to a validator
+func (k Querier) ValidatorAPR(c context.Context, req *types.QueryValidatorAPRRequest) (*types.QueryValidatorAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := k.stakingKeeper.Validator(ctx, valAdr)
+	if validator == nil {
+		return nil, errors.Wrapf(types.ErrNoValidatorExists, valAdr.String())
+	}
+
+	apr, err := k.CalculateValidatorAPR(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorAPRResponse{Apr: apr}, nil
+}
+
 // ValidatorSlashes queries slash events of a validator
 func (k Querier) ValidatorSlashes(c context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
 	if req == nil {
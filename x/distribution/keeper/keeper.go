@@ -22,6 +22,7 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	mintKeeper    types.MintKeeper // optional, used to compute the nominal APR of the validators
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -65,6 +66,12 @@ func NewKeeper(
 	return k
 }
 
+// SetMintKeeper sets the mint keeper the nominal APR of the validators is
+// computed from.
+func (k *Keeper) SetMintKeeper(mk types.MintKeeper) {
+	k.mintKeeper = mk
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -78,7 +85,7 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr sdk.AccAddress) error {
-	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+	if k.bankKeeper.BlockedAddr(ctx, withdrawAddr) {
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
 	}
 
@@ -41,8 +41,8 @@ func TestSetWithdrawAddr(t *testing.T) {
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
 
-	bankKeeper.EXPECT().BlockedAddr(withdrawAddr).Return(false).AnyTimes()
-	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any(), withdrawAddr).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any(), distrAcc.GetAddress()).Return(true).AnyTimes()
 
 	distrKeeper := keeper.NewKeeper(
 		encCfg.Codec,
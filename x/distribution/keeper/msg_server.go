@@ -153,7 +153,7 @@ func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, err
 	}
 
-	if k.bankKeeper.BlockedAddr(recipient) {
+	if k.bankKeeper.BlockedAddr(ctx, recipient) {
 		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Recipient)
 	}
 
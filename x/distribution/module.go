@@ -229,6 +229,9 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
 
+	// MintKeeper is used solely to compute the nominal APR of the validators
+	MintKeeper types.MintKeeper `optional:"true"`
+
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
 }
@@ -262,6 +265,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		feeCollectorName,
 		authority.String(),
 	)
+	if in.MintKeeper != nil {
+		k.SetMintKeeper(in.MintKeeper)
+	}
 
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.LegacySubspace)
 
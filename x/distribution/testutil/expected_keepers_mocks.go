@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	address "cosmossdk.io/core/address"
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
@@ -129,17 +130,17 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // BlockedAddr mocks base method.
-func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
+func (m *MockBankKeeper) BlockedAddr(ctx context.Context, addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret := m.ctrl.Call(m, "BlockedAddr", ctx, addr)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // BlockedAddr indicates an expected call of BlockedAddr.
-func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call {
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), ctx, addr)
 }
 
 // GetAllBalances mocks base method.
@@ -235,6 +236,20 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// ConsensusPower mocks base method.
+func (m *MockStakingKeeper) ConsensusPower(ctx types.Context, validator types0.ValidatorI) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsensusPower", ctx, validator)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// ConsensusPower indicates an expected call of ConsensusPower.
+func (mr *MockStakingKeeperMockRecorder) ConsensusPower(ctx, validator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsensusPower", reflect.TypeOf((*MockStakingKeeper)(nil).ConsensusPower), ctx, validator)
+}
+
 // Delegation mocks base method.
 func (m *MockStakingKeeper) Delegation(arg0 types.Context, arg1 types.AccAddress, arg2 types.ValAddress) types0.DelegationI {
 	m.ctrl.T.Helper()
@@ -291,6 +306,20 @@ func (mr *MockStakingKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllValidators), ctx)
 }
 
+// GetLastTotalPower mocks base method.
+func (m *MockStakingKeeper) GetLastTotalPower(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastTotalPower", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetLastTotalPower indicates an expected call of GetLastTotalPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastTotalPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTotalPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastTotalPower), ctx)
+}
+
 // IterateDelegations mocks base method.
 func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delegator types.AccAddress, fn func(int64, types0.DelegationI) bool) {
 	m.ctrl.T.Helper()
@@ -343,6 +372,59 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockMintKeeper is a mock of MintKeeper interface.
+type MockMintKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockMintKeeperMockRecorder
+}
+
+// MockMintKeeperMockRecorder is the mock recorder for MockMintKeeper.
+type MockMintKeeperMockRecorder struct {
+	mock *MockMintKeeper
+}
+
+// NewMockMintKeeper creates a new mock instance.
+func NewMockMintKeeper(ctrl *gomock.Controller) *MockMintKeeper {
+	mock := &MockMintKeeper{ctrl: ctrl}
+	mock.recorder = &MockMintKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMintKeeper) EXPECT() *MockMintKeeperMockRecorder {
+	return m.recorder
+}
+
+// BlockProvision mocks base method.
+func (m *MockMintKeeper) BlockProvision(ctx context.Context) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockProvision", ctx)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockProvision indicates an expected call of BlockProvision.
+func (mr *MockMintKeeperMockRecorder) BlockProvision(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockProvision", reflect.TypeOf((*MockMintKeeper)(nil).BlockProvision), ctx)
+}
+
+// BlocksPerYear mocks base method.
+func (m *MockMintKeeper) BlocksPerYear(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlocksPerYear", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlocksPerYear indicates an expected call of BlocksPerYear.
+func (mr *MockMintKeeperMockRecorder) BlocksPerYear(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlocksPerYear", reflect.TypeOf((*MockMintKeeper)(nil).BlocksPerYear), ctx)
+}
+
 // MockStakingHooks is a mock of StakingHooks interface.
 type MockStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -16,4 +16,5 @@ var (
 	ErrEmptyProposalRecipient  = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = errors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
+	ErrNoMintKeeper            = errors.Register(ModuleName, 14, "mint keeper is not set")
 )
@@ -4,6 +4,7 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,7 +30,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool
 }
 
 // StakingKeeper expected staking keeper (noalias)
@@ -51,6 +52,16 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
 	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+
+	GetLastTotalPower(ctx sdk.Context) math.Int
+	ConsensusPower(ctx sdk.Context, validator stakingtypes.ValidatorI) int64
+}
+
+// MintKeeper defines the expected mint keeper used to compute the nominal APR
+// of the validators (noalias)
+type MintKeeper interface {
+	BlockProvision(ctx context.Context) (sdk.Coin, error)
+	BlocksPerYear(ctx context.Context) (uint64, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -420,6 +420,97 @@ func (m *QueryValidatorCommissionResponse) GetCommission() ValidatorAccumulatedC
 	return ValidatorAccumulatedCommission{}
 }
 
+// QueryValidatorAPRRequest is the request type for the
+// Query/ValidatorAPR RPC method
+type QueryValidatorAPRRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorAPRRequest) Reset()         { *m = QueryValidatorAPRRequest{} }
+func (m *QueryValidatorAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAPRRequest) ProtoMessage()    {}
+func (*QueryValidatorAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{8}
+}
+func (m *QueryValidatorAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAPRRequest.Merge(m, src)
+}
+func (m *QueryValidatorAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAPRRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAPRRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorAPRResponse is the response type for the
+// Query/ValidatorAPR RPC method
+type QueryValidatorAPRResponse struct {
+	// apr defines the nominal annual percentage rate, as a fraction, of the
+	// rewards the delegations to the validator earn from inflation at the current
+	// block provision, after community tax and commission. It is computed with
+	// the rounding of the reward allocation of each block, and is zero for
+	// validators which are not bonded.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+}
+
+func (m *QueryValidatorAPRResponse) Reset()         { *m = QueryValidatorAPRResponse{} }
+func (m *QueryValidatorAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAPRResponse) ProtoMessage()    {}
+func (*QueryValidatorAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{9}
+}
+func (m *QueryValidatorAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAPRResponse.Merge(m, src)
+}
+func (m *QueryValidatorAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAPRResponse proto.InternalMessageInfo
+
 // QueryValidatorSlashesRequest is the request type for the
 // Query/ValidatorSlashes RPC method
 type QueryValidatorSlashesRequest struct {
@@ -437,7 +528,7 @@ func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlas
 func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
 func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{8}
+	return fileDescriptor_5efd02cbc06efdc9, []int{10}
 }
 func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +570,7 @@ func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSla
 func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
 func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{9}
+	return fileDescriptor_5efd02cbc06efdc9, []int{11}
 }
 func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +626,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +666,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +713,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +755,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +809,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +849,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +889,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +929,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +967,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1007,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,6 +1052,8 @@ func init() {
 	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
 	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionRequest")
 	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionResponse")
+	proto.RegisterType((*QueryValidatorAPRRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorAPRRequest")
+	proto.RegisterType((*QueryValidatorAPRResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorAPRResponse")
 	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesRequest")
 	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
 	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
@@ -980,87 +1073,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	// 1342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc5,
+	0x17, 0xcf, 0x38, 0x69, 0xfb, 0xef, 0x6b, 0xfb, 0x4f, 0x32, 0xad, 0x90, 0xbb, 0x6d, 0x9d, 0xb0,
+	0x21, 0x1f, 0x6a, 0x14, 0x6f, 0x93, 0x88, 0x52, 0x12, 0xa2, 0xe2, 0x8f, 0xa4, 0x45, 0x8d, 0xd2,
+	0xd4, 0x29, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0x38, 0x5b, 0xec, 0x9d, 0xcd, 0xee, 0x3a, 0x26,
+	0xaa, 0x7a, 0x29, 0x97, 0x52, 0x09, 0x09, 0xc1, 0x85, 0x23, 0x47, 0xc4, 0x89, 0x43, 0x38, 0xc3,
+	0x09, 0xf5, 0x58, 0x05, 0x09, 0x21, 0x0e, 0x05, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xb4,
+	0x33, 0xb3, 0xf6, 0xae, 0x3f, 0xd6, 0x5f, 0xf2, 0xa5, 0xb5, 0x66, 0xe7, 0xfd, 0x7e, 0xef, 0xf7,
+	0xde, 0xdb, 0x99, 0xdf, 0x06, 0x26, 0xb3, 0xc4, 0x2a, 0x10, 0x4b, 0x52, 0x34, 0xcb, 0x36, 0xb5,
+	0x4c, 0xd1, 0xd6, 0x88, 0x2e, 0xed, 0xce, 0x66, 0x54, 0x5b, 0x9e, 0x95, 0x76, 0x8a, 0xaa, 0xb9,
+	0x17, 0x35, 0x4c, 0x62, 0x13, 0x7c, 0x81, 0x6d, 0x8c, 0x7a, 0x37, 0x46, 0xf9, 0x46, 0xe1, 0x32,
+	0x47, 0xc9, 0xc8, 0x96, 0xca, 0xa2, 0xca, 0x18, 0x86, 0x9c, 0xd3, 0x74, 0x99, 0xee, 0xa6, 0x40,
+	0xc2, 0xb9, 0x1c, 0xc9, 0x11, 0xfa, 0x53, 0x72, 0x7e, 0xf1, 0xd5, 0x8b, 0x39, 0x42, 0x72, 0x79,
+	0x55, 0x92, 0x0d, 0x4d, 0x92, 0x75, 0x9d, 0xd8, 0x34, 0xc4, 0xe2, 0x4f, 0x23, 0x5e, 0x7c, 0x17,
+	0x39, 0x4b, 0x34, 0x17, 0x33, 0x1a, 0xa4, 0xc2, 0x97, 0x31, 0xdb, 0x7f, 0x9e, 0xed, 0x4f, 0xb3,
+	0x34, 0xb8, 0x32, 0xf6, 0x68, 0x58, 0x2e, 0x68, 0x3a, 0x91, 0xe8, 0xbf, 0x6c, 0x49, 0x3c, 0x07,
+	0xf8, 0x8e, 0xa3, 0x69, 0x5d, 0x36, 0xe5, 0x82, 0x95, 0x52, 0x77, 0x8a, 0xaa, 0x65, 0x8b, 0xf7,
+	0xe0, 0xac, 0x6f, 0xd5, 0x32, 0x88, 0x6e, 0xa9, 0x78, 0x05, 0x8e, 0x1b, 0x74, 0x25, 0x8c, 0x46,
+	0xd1, 0xd4, 0xa9, 0xb9, 0xb1, 0x68, 0x40, 0xe1, 0xa2, 0x2c, 0x38, 0x7e, 0xf2, 0xe9, 0xf3, 0x91,
+	0xbe, 0xaf, 0xfe, 0xf8, 0xe6, 0x32, 0x4a, 0xf1, 0x68, 0xb1, 0x04, 0xe3, 0x14, 0xfe, 0x1d, 0x39,
+	0xaf, 0x29, 0xb2, 0x4d, 0xcc, 0xa4, 0x27, 0xfe, 0x2d, 0x7d, 0x8b, 0xf0, 0x3c, 0xf0, 0x1a, 0x0c,
+	0xef, 0xba, 0x7b, 0xd2, 0xb2, 0xa2, 0x98, 0xaa, 0xc5, 0xb8, 0x4f, 0xc6, 0x5f, 0x3e, 0xd8, 0x9f,
+	0xb9, 0xc4, 0xe9, 0xcb, 0x38, 0x31, 0xb6, 0x65, 0xc3, 0x36, 0x35, 0x3d, 0x97, 0x1a, 0xda, 0xad,
+	0x5a, 0x17, 0xff, 0x0e, 0xc1, 0x44, 0x33, 0x66, 0xae, 0x75, 0x15, 0x86, 0x88, 0xa1, 0x9a, 0x9d,
+	0x31, 0x0f, 0xba, 0xa1, 0x7c, 0x19, 0x3f, 0x42, 0x30, 0x6c, 0xa9, 0xf9, 0xad, 0x74, 0x86, 0xe8,
+	0x4a, 0xda, 0x54, 0x4b, 0xb2, 0xa9, 0x58, 0xe1, 0xd0, 0x68, 0xff, 0xd4, 0xa9, 0xb9, 0x8b, 0x6e,
+	0x15, 0x9d, 0x09, 0x28, 0x57, 0x2f, 0xa9, 0x66, 0x13, 0x44, 0xd3, 0xe3, 0xd7, 0x9c, 0xf2, 0x7d,
+	0xfd, 0xeb, 0xc8, 0x74, 0x4e, 0xb3, 0xb7, 0x8b, 0x99, 0x68, 0x96, 0x14, 0x78, 0x53, 0xf9, 0x7f,
+	0x33, 0x96, 0xf2, 0x81, 0x64, 0xef, 0x19, 0xaa, 0xe5, 0xc6, 0x58, 0xac, 0xda, 0x83, 0x0e, 0x61,
+	0x9c, 0xe8, 0x4a, 0x8a, 0xd1, 0xe1, 0x1d, 0x80, 0x2c, 0x29, 0x14, 0x34, 0xcb, 0xd2, 0x88, 0x1e,
+	0xee, 0x6f, 0x81, 0x7c, 0xbe, 0x03, 0xf2, 0x94, 0x87, 0x44, 0xdc, 0x83, 0x49, 0x7f, 0xbd, 0x6f,
+	0x17, 0x6d, 0xcb, 0x96, 0x75, 0xc5, 0xa9, 0x12, 0x4b, 0xab, 0x57, 0xbd, 0xfe, 0x18, 0xc1, 0x54,
+	0x73, 0x6e, 0xde, 0xed, 0x7b, 0x70, 0xc2, 0x6d, 0x0a, 0x1b, 0xed, 0x6b, 0x81, 0xa3, 0x1d, 0x00,
+	0xe9, 0x9d, 0x77, 0x17, 0x53, 0xdc, 0x81, 0x11, 0x7f, 0x2a, 0x89, 0x72, 0x89, 0x7a, 0x25, 0xff,
+	0x09, 0x82, 0xd1, 0xc6, 0x9c, 0x5c, 0xf6, 0x96, 0x6f, 0x22, 0x98, 0xf2, 0xc5, 0xd6, 0x94, 0xc7,
+	0xb2, 0xd9, 0x62, 0xa1, 0x98, 0x97, 0x6d, 0x55, 0xa9, 0x00, 0x7b, 0xc5, 0x7b, 0xc7, 0xe0, 0x3e,
+	0x84, 0xfd, 0xb9, 0xc4, 0xd6, 0x53, 0xbd, 0x12, 0x6e, 0xc0, 0xf9, 0x3a, 0x5c, 0x5c, 0xf0, 0x06,
+	0xf4, 0xcb, 0x86, 0xc9, 0xe1, 0x63, 0x4e, 0xb2, 0xbf, 0x3c, 0x1f, 0x99, 0x68, 0x6d, 0xba, 0x0f,
+	0xf6, 0x67, 0x80, 0x27, 0x93, 0x54, 0xb3, 0x4c, 0xa4, 0x83, 0x26, 0x3e, 0x09, 0xc1, 0x45, 0x3f,
+	0xe5, 0x46, 0x5e, 0xb6, 0xb6, 0xd5, 0x5e, 0x8d, 0x36, 0x9e, 0x84, 0x41, 0xcb, 0x96, 0x4d, 0x5b,
+	0xd3, 0x73, 0xe9, 0x6d, 0x55, 0xcb, 0x6d, 0xdb, 0xe1, 0xd0, 0x28, 0x9a, 0x1a, 0x48, 0xfd, 0xdf,
+	0x5d, 0xbe, 0x49, 0x57, 0xf1, 0x18, 0x9c, 0x51, 0x75, 0xc5, 0xb3, 0xad, 0x9f, 0x6e, 0x3b, 0xcd,
+	0x16, 0xf9, 0xa6, 0x15, 0x80, 0xca, 0x45, 0x16, 0x1e, 0xa0, 0x43, 0x30, 0xe1, 0x3b, 0x16, 0xd8,
+	0x5d, 0x59, 0x39, 0xd7, 0x73, 0x2a, 0x57, 0x96, 0xf2, 0x44, 0x2e, 0x0c, 0x3c, 0xfe, 0x72, 0xa4,
+	0x4f, 0xfc, 0x0e, 0xc1, 0xa5, 0x06, 0xc5, 0xe0, 0x3d, 0x78, 0x1b, 0x4e, 0x58, 0x6c, 0x29, 0x8c,
+	0xe8, 0x19, 0x74, 0xa5, 0xb5, 0x89, 0xa3, 0x38, 0xcb, 0xbb, 0xaa, 0x6e, 0xfb, 0xde, 0x31, 0x8e,
+	0x85, 0x6f, 0xf8, 0x64, 0x84, 0xa8, 0x8c, 0xc9, 0xa6, 0x32, 0x58, 0x4e, 0x5e, 0x1d, 0xe2, 0xf7,
+	0xae, 0x82, 0xa4, 0x9a, 0x57, 0x73, 0x74, 0xad, 0xea, 0xa8, 0x5a, 0x86, 0x61, 0x85, 0x3d, 0xab,
+	0xe9, 0x67, 0xf8, 0x60, 0x7f, 0xe6, 0x1c, 0x27, 0xad, 0x6a, 0x63, 0x39, 0xc4, 0x6d, 0x63, 0xdd,
+	0xb1, 0x08, 0x75, 0x3c, 0x16, 0x0b, 0xff, 0x73, 0x1a, 0xf0, 0xc2, 0x69, 0xc2, 0x67, 0x08, 0x22,
+	0x8d, 0x24, 0xf0, 0x2e, 0x18, 0xde, 0x13, 0xaf, 0x97, 0xd7, 0x50, 0xf9, 0x10, 0x2c, 0x82, 0x58,
+	0x95, 0xd3, 0x5d, 0x62, 0xcb, 0xf9, 0x9e, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0x8c, 0x05, 0xf2,
+	0xf2, 0x82, 0xbc, 0x5f, 0x5d, 0x90, 0xab, 0x81, 0x63, 0x59, 0x41, 0x4b, 0xba, 0xdc, 0x0c, 0xb1,
+	0xde, 0x05, 0x80, 0xf3, 0x70, 0xcc, 0x76, 0x48, 0x7b, 0x7c, 0xe5, 0x33, 0x12, 0xd1, 0xe4, 0xd7,
+	0x4d, 0x39, 0xb3, 0xf2, 0x08, 0xf5, 0xae, 0xcc, 0xab, 0x30, 0xda, 0x98, 0x93, 0x97, 0x38, 0x02,
+	0x50, 0x1e, 0x5a, 0x56, 0xe5, 0x93, 0x29, 0xcf, 0x8a, 0x07, 0xad, 0x04, 0xaf, 0xf8, 0xd1, 0x36,
+	0x35, 0x7b, 0x5b, 0x31, 0xe5, 0x12, 0x27, 0xee, 0x99, 0x8c, 0x5d, 0x18, 0x6f, 0x42, 0xcc, 0xb5,
+	0x24, 0x60, 0xa8, 0xc4, 0x1f, 0xb5, 0x4c, 0x3c, 0x58, 0xf2, 0x83, 0x79, 0x78, 0x2f, 0xf0, 0x5b,
+	0xcb, 0xb9, 0x4b, 0x8b, 0xba, 0x66, 0xef, 0xad, 0x13, 0x92, 0x77, 0xed, 0xf8, 0x63, 0x04, 0x42,
+	0xbd, 0xa7, 0x3c, 0x95, 0xfb, 0x30, 0x60, 0x10, 0x92, 0xef, 0xf1, 0x7b, 0x4c, 0x39, 0xe6, 0x3e,
+	0x39, 0x0b, 0xc7, 0x68, 0x2a, 0xf8, 0x0b, 0x04, 0xc7, 0x99, 0xc5, 0xc7, 0x52, 0xe0, 0x9b, 0x52,
+	0xfb, 0x7d, 0x21, 0x5c, 0x69, 0x3d, 0x80, 0x69, 0x14, 0xa7, 0x1f, 0xfd, 0xf8, 0xfb, 0xe7, 0xa1,
+	0x71, 0x3c, 0x26, 0x05, 0x7d, 0x0e, 0xb1, 0xef, 0x0b, 0xfc, 0x27, 0x82, 0xf3, 0x0d, 0x1d, 0x3e,
+	0x8e, 0x37, 0x27, 0x6f, 0xf6, 0x61, 0x22, 0x24, 0xba, 0xc2, 0xe0, 0x9a, 0x12, 0x54, 0xd3, 0x12,
+	0x5e, 0x0c, 0xd4, 0x54, 0x79, 0x3f, 0xa4, 0x07, 0x35, 0xd7, 0xc5, 0x43, 0xfc, 0x51, 0x08, 0x2e,
+	0x04, 0xd8, 0x51, 0x9c, 0x6c, 0x23, 0xd3, 0x86, 0xe6, 0x5c, 0x58, 0xee, 0x12, 0x85, 0x2b, 0xde,
+	0xa4, 0x8a, 0xef, 0xe0, 0xdb, 0x5d, 0x28, 0x96, 0x48, 0x05, 0xdf, 0xfd, 0x92, 0xc2, 0x87, 0x08,
+	0xce, 0xd6, 0x31, 0xba, 0xf8, 0x8d, 0x36, 0xf2, 0xae, 0xf1, 0xe4, 0xc2, 0x52, 0x87, 0xd1, 0x5c,
+	0xed, 0x1a, 0x55, 0x7b, 0x13, 0xaf, 0x74, 0xa3, 0xb6, 0xe2, 0xa2, 0xf1, 0x0f, 0x08, 0x4e, 0x7b,
+	0x5d, 0x2d, 0x7e, 0xb5, 0x8d, 0xfc, 0x2a, 0x8e, 0x5b, 0xb8, 0xda, 0x6e, 0x18, 0xd7, 0x73, 0x83,
+	0xea, 0x89, 0xe1, 0xeb, 0xdd, 0xe8, 0x91, 0x0d, 0x13, 0xff, 0x84, 0x60, 0xa8, 0xda, 0x1e, 0xe2,
+	0xd7, 0xdb, 0xc8, 0xca, 0xef, 0xaf, 0x85, 0x85, 0x4e, 0x42, 0xb9, 0xa8, 0x5b, 0x54, 0xd4, 0x32,
+	0x4e, 0x74, 0x23, 0xca, 0xf5, 0xa0, 0x7f, 0x21, 0x18, 0xae, 0xb1, 0x5c, 0xb8, 0x85, 0xf4, 0x1a,
+	0x59, 0x4d, 0x61, 0xb1, 0xa3, 0x58, 0xae, 0x2d, 0x4d, 0xb5, 0xbd, 0x8b, 0x37, 0x03, 0xb5, 0x95,
+	0x6f, 0x43, 0x4b, 0x7a, 0x50, 0x73, 0x99, 0x3e, 0x94, 0xf8, 0x2b, 0x56, 0xf7, 0xf0, 0x79, 0x81,
+	0xe0, 0xa5, 0xfa, 0xb6, 0x0a, 0x5f, 0x6f, 0x27, 0xf1, 0x3a, 0x46, 0x50, 0x78, 0xb3, 0x73, 0x80,
+	0xb6, 0x5a, 0xdb, 0x9a, 0x7c, 0x7a, 0xc2, 0xd4, 0xf1, 0x36, 0xad, 0x9c, 0x30, 0x8d, 0x6d, 0x98,
+	0xb0, 0xd4, 0x61, 0x74, 0x5b, 0x27, 0x4c, 0x13, 0x85, 0x95, 0xd9, 0xc6, 0xff, 0x22, 0x08, 0x37,
+	0x72, 0x3e, 0x38, 0xd6, 0x46, 0xae, 0xf5, 0xed, 0x9a, 0x10, 0xef, 0x06, 0x82, 0x6b, 0xbe, 0x4b,
+	0x35, 0xaf, 0xe1, 0xd5, 0x6e, 0x34, 0x57, 0x5b, 0x37, 0xfc, 0x2d, 0x82, 0x33, 0x3e, 0x77, 0x85,
+	0x5b, 0x38, 0x25, 0xeb, 0x99, 0x35, 0xe1, 0xb5, 0xb6, 0xe3, 0xb8, 0xb0, 0x79, 0x2a, 0x6c, 0x06,
+	0x4f, 0x07, 0x0a, 0xcb, 0xba, 0xb1, 0x69, 0xc7, 0x8f, 0xc5, 0x6f, 0x3d, 0x3d, 0x8c, 0xa0, 0x67,
+	0x87, 0x11, 0xf4, 0xdb, 0x61, 0x04, 0x7d, 0x7a, 0x14, 0xe9, 0x7b, 0x76, 0x14, 0xe9, 0xfb, 0xf9,
+	0x28, 0xd2, 0xf7, 0xde, 0x6c, 0xa0, 0xc3, 0xfb, 0xd0, 0x8f, 0x4e, 0x0d, 0x5f, 0xe6, 0x38, 0xfd,
+	0x9b, 0xf0, 0xfc, 0x7f, 0x03, 0x00, 0xc1, 0xa3, 0x5e, 0x2f, 0x39, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1083,6 +1180,9 @@ type QueryClient interface {
 	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// ValidatorAPR queries the current nominal APR of the delegations to a
+	// validator.
+	ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -1142,6 +1242,15 @@ func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidato
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error) {
+	out := new(QueryValidatorAPRResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
 	out := new(QueryValidatorSlashesResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorSlashes", in, out, opts...)
@@ -1206,6 +1315,9 @@ type QueryServer interface {
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorAPR queries the current nominal APR of the delegations to a
+	// validator.
+	ValidatorAPR(context.Context, *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -1237,6 +1349,9 @@ func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context
 func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorAPR(ctx context.Context, req *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAPR not implemented")
+}
 func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
 }
@@ -1332,6 +1447,24 @@ func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAPR(ctx, req.(*QueryValidatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorSlashesRequest)
 	if err := dec(in); err != nil {
@@ -1460,6 +1593,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorCommission",
 			Handler:    _Query_ValidatorCommission_Handler,
 		},
+		{
+			MethodName: "ValidatorAPR",
+			Handler:    _Query_ValidatorAPR_Handler,
+		},
 		{
 			MethodName: "ValidatorSlashes",
 			Handler:    _Query_ValidatorSlashes_Handler,
@@ -1759,6 +1896,69 @@ func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2314,6 +2514,30 @@ func (m *QueryValidatorCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryValidatorSlashesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3201,6 +3425,172 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,90 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxFeeExemptGas is the default gas limit above which transactions
+// submitting evidence are not exempted from fees.
+const DefaultMaxFeeExemptGas uint64 = 300_000
+
+// EvidenceValidator defines the expected evidence keeper used to pre-validate
+// the evidence of fee exempt transactions.
+type EvidenceValidator interface {
+	ValidateEvidence(ctx context.Context, evidence exported.Evidence) error
+}
+
+// FeeExemptionDecorator wraps the AnteDecorator responsible for fees, usually
+// the DeductFeeDecorator, and skips it for transactions that only submit valid
+// equivocation evidence, so that honest reporters are not deterred by fees.
+// Transactions with a gas limit above maxGas or with evidence that does not
+// pass pre-validation go through the wrapped decorator as usual.
+//
+// Since the same evidence cannot be submitted twice and invalid evidence pays
+// fees, the exemption cannot be abused to include free transactions.
+type FeeExemptionDecorator struct {
+	evidenceKeeper EvidenceValidator
+	feeDecorator   sdk.AnteDecorator
+	maxGas         uint64
+}
+
+func NewFeeExemptionDecorator(ek EvidenceValidator, feeDecorator sdk.AnteDecorator, maxGas uint64) FeeExemptionDecorator {
+	return FeeExemptionDecorator{
+		evidenceKeeper: ek,
+		feeDecorator:   feeDecorator,
+		maxGas:         maxGas,
+	}
+}
+
+func (fed FeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if fed.isFeeExempt(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	return fed.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// isFeeExempt returns true if every message of the transaction submits valid
+// equivocation evidence and the transaction gas limit is at most maxGas.
+func (fed FeeExemptionDecorator) isFeeExempt(ctx sdk.Context, tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() > fed.maxGas {
+		return false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		submitMsg, ok := msg.(*types.MsgSubmitEvidence)
+		if !ok {
+			return false
+		}
+
+		evidence, ok := submitMsg.GetEvidence().(*types.Equivocation)
+		if !ok {
+			return false
+		}
+
+		// the same evidence submitted twice makes the transaction fail
+		hash := string(evidence.Hash())
+		if seen[hash] {
+			return false
+		}
+		seen[hash] = true
+
+		if err := fed.evidenceKeeper.ValidateEvidence(ctx, evidence); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,94 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/evidence"
+	"cosmossdk.io/x/evidence/ante"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type mockEvidenceValidator struct {
+	invalidHeight int64
+}
+
+func (m mockEvidenceValidator) ValidateEvidence(_ context.Context, evidence exported.Evidence) error {
+	if evidence.GetHeight() == m.invalidHeight {
+		return errors.New("invalid evidence")
+	}
+	return nil
+}
+
+type mockFeeDecorator struct {
+	called *bool
+}
+
+func (m mockFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*m.called = true
+	return next(ctx, tx, simulate)
+}
+
+func TestFeeExemptionDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(evidence.AppModuleBasic{})
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	_, _, submitter := testdata.KeyTestPubAddr()
+	newSubmitMsg := func(height int64) sdk.Msg {
+		msg, err := types.NewMsgSubmitEvidence(submitter, &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		})
+		require.NoError(t, err)
+		return msg
+	}
+	duplicate := newSubmitMsg(1)
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		gas    uint64
+		exempt bool
+	}{
+		{"valid equivocation evidence", []sdk.Msg{newSubmitMsg(1)}, 200_000, true},
+		{"several valid equivocation evidence", []sdk.Msg{newSubmitMsg(1), newSubmitMsg(2)}, 200_000, true},
+		{"invalid evidence", []sdk.Msg{newSubmitMsg(1), newSubmitMsg(3)}, 200_000, false},
+		{"duplicate evidence", []sdk.Msg{duplicate, duplicate}, 200_000, false},
+		{"gas limit above max", []sdk.Msg{newSubmitMsg(1)}, 300_001, false},
+		{"other message", []sdk.Msg{newSubmitMsg(1), testdata.NewTestMsg(submitter)}, 200_000, false},
+		{"no message", nil, 200_000, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(tc.gas)
+
+			var feeCalled, nextCalled bool
+			decorator := ante.NewFeeExemptionDecorator(mockEvidenceValidator{invalidHeight: 3}, mockFeeDecorator{&feeCalled}, ante.DefaultMaxFeeExemptGas)
+
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			})
+			require.NoError(t, err)
+			require.True(t, nextCalled)
+			require.Equal(t, !tc.exempt, feeCalled)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
@@ -82,3 +83,17 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// ValidateEvidence implements the Query/ValidateEvidence gRPC method
+func (k Querier) ValidateEvidence(ctx context.Context, req *types.QueryValidateEvidenceRequest) (*types.QueryValidateEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	evidence := req.GetEvidence()
+	if err := k.k.ValidateEvidence(ctx, evidence); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidateEvidenceResponse{Hash: strings.ToUpper(hex.EncodeToString(evidence.Hash()))}, nil
+}
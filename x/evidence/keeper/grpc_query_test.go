@@ -4,11 +4,19 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
 
 	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryEvidence() {
@@ -156,3 +164,123 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryValidateEvidence() {
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address())
+	newEquivocation := func(height int64, t time.Time) *types.Equivocation {
+		return &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             t,
+			ConsensusAddress: consAddr.String(),
+		}
+	}
+
+	var ctx sdk.Context
+	now := time.Now().UTC()
+	bonded := stakingtypes.Validator{Status: stakingtypes.Bonded}
+
+	testCases := []struct {
+		msg       string
+		evidence  exported.Evidence
+		malleate  func()
+		expErrMsg string
+	}{
+		{
+			"missing evidence",
+			nil,
+			func() {},
+			"missing evidence",
+		},
+		{
+			"invalid evidence",
+			newEquivocation(0, now),
+			func() {},
+			"invalid equivocation height",
+		},
+		{
+			"height in the future",
+			newEquivocation(101, now),
+			func() {},
+			"evidence height 101 is greater than the current height 100",
+		},
+		{
+			"too old",
+			newEquivocation(89, now.Add(-2*time.Hour)),
+			func() {},
+			"evidence is too old",
+		},
+		{
+			"already submitted",
+			newEquivocation(99, now),
+			func() {
+				suite.Require().NoError(suite.evidenceKeeper.Evidences.Set(ctx, newEquivocation(99, now).Hash(), newEquivocation(99, now)))
+			},
+			"evidence already exists",
+		},
+		{
+			"unknown validator",
+			newEquivocation(99, now),
+			func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(nil)
+			},
+			"does not exist or is unbonded",
+		},
+		{
+			"tombstoned validator",
+			newEquivocation(99, now),
+			func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(bonded)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+			},
+			"already tombstoned",
+		},
+		{
+			"old by blocks but not by time",
+			newEquivocation(89, now.Add(-time.Minute)),
+			func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(bonded)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+			},
+			"",
+		},
+		{
+			"valid",
+			newEquivocation(99, now),
+			func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(bonded)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			ctx = suite.ctx.WithBlockHeight(100).WithBlockTime(now).WithConsensusParams(cmtproto.ConsensusParams{
+				Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour},
+			})
+			tc.malleate()
+
+			req := &types.QueryValidateEvidenceRequest{}
+			if tc.evidence != nil {
+				var err error
+				req, err = types.NewQueryValidateEvidenceRequest(tc.evidence)
+				suite.Require().NoError(err)
+			}
+
+			querier := keeper.NewQuerier(&suite.evidenceKeeper)
+			res, err := querier.ValidateEvidence(ctx, req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(strings.ToUpper(hex.EncodeToString(tc.evidence.Hash())), res.Hash)
+		})
+	}
+}
@@ -129,7 +129,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		err = k.slashingKeeper.Jail(ctx, consAddr)
+		err = k.slashingKeeper.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateEvidence pre-validates evidence before it is submitted, so that an
+// error is returned for evidence that would be rejected or ignored once
+// submitted. The evidence is considered invalid if:
+// - it fails basic validation
+// - no handler is registered for it or it was already submitted
+// - its height is in the future or it is too old per the consensus params
+// - for evidence against a validator, the validator is unbonded, does not
+// exist or is already tombstoned
+func (k Keeper) ValidateEvidence(ctx context.Context, evidence exported.Evidence) error {
+	if evidence == nil {
+		return errors.Wrap(types.ErrInvalidEvidence, "missing evidence")
+	}
+
+	if err := evidence.ValidateBasic(); err != nil {
+		return errors.Wrapf(types.ErrInvalidEvidence, "failed basic validation: %s", err)
+	}
+
+	if k.router == nil || !k.router.HasRoute(evidence.Route()) {
+		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
+	}
+
+	if has, err := k.Evidences.Has(ctx, evidence.Hash()); err != nil {
+		return err
+	} else if has {
+		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := validateEvidenceAge(sdkCtx, evidence); err != nil {
+		return err
+	}
+
+	valEvidence, ok := evidence.(exported.ValidatorEvidence)
+	if !ok {
+		return nil
+	}
+
+	consAddr := valEvidence.GetConsensusAddress()
+	validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return errors.Wrapf(types.ErrInvalidEvidence, "validator %s does not exist or is unbonded", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return errors.Wrapf(types.ErrInvalidEvidence, "validator %s is already tombstoned", consAddr)
+	}
+
+	return nil
+}
+
+// validateEvidenceAge returns an error if the evidence height is in the future
+// or if the evidence is older than both the max age duration and the max age
+// number of blocks of the consensus params.
+func validateEvidenceAge(ctx sdk.Context, evidence exported.Evidence) error {
+	height := evidence.GetHeight()
+	if height > ctx.BlockHeight() {
+		return errors.Wrapf(types.ErrInvalidEvidence, "evidence height %d is greater than the current height %d", height, ctx.BlockHeight())
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp.Evidence == nil {
+		return nil
+	}
+
+	ageBlocks := ctx.BlockHeight() - height
+	if ageBlocks <= cp.Evidence.MaxAgeNumBlocks {
+		return nil
+	}
+
+	// evidence without a time is only bounded by its number of blocks
+	timedEvidence, ok := evidence.(interface{ GetTime() time.Time })
+	if ok && ctx.BlockTime().Sub(timedEvidence.GetTime()) <= cp.Evidence.MaxAgeDuration {
+		return nil
+	}
+
+	return errors.Wrapf(
+		types.ErrInvalidEvidence, "evidence is too old; age %d blocks, max age %d blocks and %s",
+		ageBlocks, cp.Evidence.MaxAgeNumBlocks, cp.Evidence.MaxAgeDuration,
+	)
+}
@@ -148,6 +148,20 @@ func (mr *MockSlashingKeeperMockRecorder) Jail(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), arg0, arg1)
 }
 
+// JailWithReason mocks base method.
+func (m *MockSlashingKeeper) JailWithReason(arg0 context.Context, arg1 types0.ConsAddress, arg2 types1.JailReason) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailWithReason", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailWithReason indicates an expected call of JailWithReason.
+func (mr *MockSlashingKeeperMockRecorder) JailWithReason(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailWithReason", reflect.TypeOf((*MockSlashingKeeper)(nil).JailWithReason), arg0, arg1, arg2)
+}
+
 // JailUntil mocks base method.
 func (m *MockSlashingKeeper) JailUntil(arg0 context.Context, arg1 types0.ConsAddress, arg2 time.Time) error {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ type (
 		SlashWithInfractionReason(context.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64, stakingtypes.Infraction) error
 		SlashFractionDoubleSign(context.Context) (sdkmath.LegacyDec, error)
 		Jail(context.Context, sdk.ConsAddress) error
+		JailWithReason(context.Context, sdk.ConsAddress, stakingtypes.JailReason) error
 		JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 	}
 
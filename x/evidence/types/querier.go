@@ -1,9 +1,18 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/x/evidence/exported"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 )
 
+var _ codectypes.UnpackInterfacesMessage = QueryValidateEvidenceRequest{}
+
 // Querier routes for the evidence module
 const (
 	QueryEvidence    = "evidence"
@@ -20,6 +29,41 @@ func NewQueryAllEvidenceRequest(pageReq *query.PageRequest) *QueryAllEvidenceReq
 	return &QueryAllEvidenceRequest{Pagination: pageReq}
 }
 
+// NewQueryValidateEvidenceRequest creates a new instance of QueryValidateEvidenceRequest.
+func NewQueryValidateEvidenceRequest(evi exported.Evidence) (*QueryValidateEvidenceRequest, error) {
+	msg, ok := evi.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", evi)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryValidateEvidenceRequest{Evidence: any}, nil
+}
+
+// GetEvidence returns the evidence to validate, or nil if it is missing or not
+// an Evidence.
+func (m QueryValidateEvidenceRequest) GetEvidence() exported.Evidence {
+	if m.Evidence == nil {
+		return nil
+	}
+
+	evi, ok := m.Evidence.GetCachedValue().(exported.Evidence)
+	if !ok {
+		return nil
+	}
+
+	return evi
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryValidateEvidenceRequest) UnpackInterfaces(ctx codectypes.AnyUnpacker) error {
+	var evi exported.Evidence
+	return ctx.UnpackAny(m.Evidence, &evi)
+}
+
 // QueryAllEvidenceParams defines the parameters necessary for querying for all Evidence.
 type QueryAllEvidenceParams struct {
 	Page  int `json:"page" yaml:"page"`
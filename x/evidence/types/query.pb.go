@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -238,11 +240,104 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryValidateEvidenceRequest is the request type for the Query/ValidateEvidence
+// RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryValidateEvidenceRequest struct {
+	// evidence defines the evidence to validate.
+	Evidence *types.Any `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *QueryValidateEvidenceRequest) Reset()         { *m = QueryValidateEvidenceRequest{} }
+func (m *QueryValidateEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateEvidenceRequest) ProtoMessage()    {}
+func (*QueryValidateEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryValidateEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateEvidenceRequest.Merge(m, src)
+}
+func (m *QueryValidateEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateEvidenceRequest proto.InternalMessageInfo
+
+// QueryValidateEvidenceResponse is the response type for the
+// Query/ValidateEvidence RPC method. It is only returned for valid evidence.
+//
+// Since: cosmos-sdk 0.50
+type QueryValidateEvidenceResponse struct {
+	// hash defines the HEX encoded hash of the evidence.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryValidateEvidenceResponse) Reset()         { *m = QueryValidateEvidenceResponse{} }
+func (m *QueryValidateEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateEvidenceResponse) ProtoMessage()    {}
+func (*QueryValidateEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryValidateEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateEvidenceResponse.Merge(m, src)
+}
+func (m *QueryValidateEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryValidateEvidenceResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryValidateEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryValidateEvidenceRequest")
+	proto.RegisterType((*QueryValidateEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryValidateEvidenceResponse")
 }
 
 func init() {
@@ -250,34 +345,41 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x31, 0x4a, 0x9d, 0x56, 0x90, 0x21, 0xd2, 0xb8, 0xb4, 0x6b, 0xdc, 0x8a, 0x8d,
+	0x81, 0xce, 0x24, 0x2d, 0x55, 0xe8, 0xad, 0x01, 0x7f, 0xdd, 0x74, 0x85, 0x1e, 0xbc, 0x84, 0x49,
+	0x33, 0x6e, 0x16, 0xe3, 0xcc, 0xb6, 0xb3, 0x09, 0x06, 0x15, 0xc4, 0x93, 0x47, 0x41, 0x3c, 0x89,
+	0xe0, 0x1f, 0xe1, 0xc1, 0x3f, 0x41, 0x3c, 0x15, 0xbc, 0x78, 0x94, 0xc4, 0x3f, 0xa4, 0x64, 0x7e,
+	0x6c, 0xda, 0x6d, 0xb7, 0x69, 0x6e, 0x3b, 0xf3, 0xde, 0xf7, 0xfb, 0x3e, 0xef, 0xcd, 0x5b, 0xb8,
+	0xb2, 0x2b, 0xe4, 0x2b, 0x21, 0x09, 0xeb, 0x87, 0x6d, 0xc6, 0x77, 0x19, 0xe9, 0xd7, 0x5b, 0x2c,
+	0xa6, 0x75, 0xb2, 0xd7, 0x63, 0xfb, 0x03, 0x1c, 0xed, 0x8b, 0x58, 0xa0, 0x45, 0x9d, 0x84, 0x6d,
+	0x12, 0x36, 0x49, 0x4e, 0xd5, 0xa8, 0x5b, 0x54, 0x32, 0xad, 0x48, 0xf4, 0x11, 0x0d, 0x42, 0x4e,
+	0xe3, 0x50, 0x70, 0x6d, 0xe2, 0x5c, 0x0f, 0x84, 0x08, 0xba, 0x8c, 0xa8, 0x53, 0xab, 0xf7, 0x82,
+	0x50, 0x6e, 0xfc, 0x9d, 0x25, 0x13, 0xa2, 0x51, 0x48, 0x28, 0xe7, 0x22, 0x56, 0x3a, 0x69, 0x85,
+	0xba, 0x48, 0x53, 0x9d, 0x88, 0x41, 0xd1, 0xa1, 0x62, 0x20, 0x02, 0xa1, 0xef, 0xc7, 0x5f, 0xfa,
+	0xd6, 0x7b, 0x06, 0x8b, 0x4f, 0xc7, 0x2c, 0xf7, 0x0d, 0xae, 0xcf, 0xf6, 0x7a, 0x4c, 0xc6, 0x68,
+	0x15, 0x5e, 0xb1, 0x1d, 0x34, 0x3b, 0x54, 0x76, 0x4a, 0xa0, 0x0c, 0x2a, 0x0b, 0x8d, 0x7c, 0x09,
+	0xf8, 0x0b, 0x36, 0xf0, 0x88, 0xca, 0x0e, 0x42, 0xb0, 0xa0, 0xe2, 0xf9, 0x32, 0xa8, 0x5c, 0xf6,
+	0xd5, 0xb7, 0xf7, 0x18, 0x5e, 0x4b, 0x99, 0xca, 0x48, 0x70, 0xc9, 0x50, 0x0d, 0xce, 0x59, 0xb1,
+	0x32, 0x9c, 0x5f, 0x2f, 0x62, 0xdd, 0x0f, 0xb6, 0xad, 0xe2, 0x6d, 0x3e, 0xf0, 0x93, 0x2c, 0x8f,
+	0xc2, 0x45, 0x65, 0xb5, 0xdd, 0xed, 0xa6, 0x11, 0x1f, 0x40, 0x38, 0x19, 0x9c, 0xb1, 0xbb, 0x8d,
+	0x4d, 0xcf, 0xe3, 0x29, 0x63, 0xfd, 0x2e, 0x66, 0xca, 0xf8, 0x09, 0x0d, 0xac, 0xd6, 0x3f, 0xa2,
+	0xf4, 0xbe, 0x00, 0x58, 0x3a, 0x59, 0xe3, 0x54, 0xe2, 0x0b, 0xd3, 0x89, 0xd1, 0xc3, 0x63, 0x58,
+	0x79, 0x85, 0xb5, 0x3a, 0x15, 0x4b, 0x97, 0x3b, 0xc6, 0xf5, 0x16, 0x2e, 0x29, 0xac, 0x1d, 0xda,
+	0x0d, 0xdb, 0x34, 0x66, 0xe9, 0xfe, 0x77, 0xce, 0x37, 0xcc, 0xc6, 0xad, 0xdf, 0x3f, 0xd6, 0xca,
+	0x19, 0x5b, 0x89, 0x13, 0xd3, 0xc4, 0x6b, 0xab, 0xf0, 0xf1, 0xfb, 0x8d, 0x9c, 0xb7, 0x01, 0x97,
+	0x33, 0xaa, 0x9b, 0xc9, 0xd8, 0x87, 0x07, 0x93, 0x87, 0x5f, 0x7f, 0x5f, 0x80, 0x17, 0x95, 0x0a,
+	0x7d, 0x05, 0x70, 0xce, 0x4a, 0xd0, 0x1a, 0xce, 0x2a, 0x7f, 0xda, 0xee, 0x39, 0xf8, 0xbc, 0xe9,
+	0x9a, 0xc4, 0xab, 0x7d, 0xf8, 0xf3, 0xff, 0x73, 0xbe, 0x8a, 0x2a, 0x24, 0xeb, 0x07, 0x4d, 0x2e,
+	0xde, 0x8c, 0x31, 0xdf, 0xa1, 0x6f, 0x00, 0xce, 0x1f, 0x79, 0x6d, 0x54, 0x3b, 0xbb, 0xe2, 0xc9,
+	0xe5, 0x73, 0xea, 0x33, 0x28, 0x0c, 0xe6, 0x1d, 0x85, 0xb9, 0x82, 0x6e, 0x4e, 0xc5, 0x44, 0x3f,
+	0x01, 0xbc, 0x9a, 0x1e, 0x3c, 0xda, 0x3c, 0xbb, 0x64, 0xc6, 0x9a, 0x38, 0x77, 0x67, 0x95, 0x19,
+	0xdc, 0x4d, 0x85, 0x4b, 0xb6, 0x40, 0xd5, 0xab, 0x66, 0x12, 0xf7, 0x8d, 0xba, 0x69, 0x23, 0x8d,
+	0x7b, 0xbf, 0x86, 0x2e, 0x38, 0x18, 0xba, 0xe0, 0xdf, 0xd0, 0x05, 0x9f, 0x46, 0x6e, 0xee, 0x60,
+	0xe4, 0xe6, 0xfe, 0x8e, 0xdc, 0xdc, 0xf3, 0x65, 0x6d, 0x22, 0xdb, 0x2f, 0x71, 0x28, 0xc8, 0xeb,
+	0x89, 0x59, 0x3c, 0x88, 0x98, 0x6c, 0x5d, 0x52, 0x4b, 0xbb, 0x71, 0x38, 0x00, 0x7a, 0x38, 0x0c,
+	0x2e, 0x66, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +398,13 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// ValidateEvidence pre-validates evidence before it is submitted. It checks
+	// the evidence structure, that it is handled by the app and was not submitted
+	// yet, that its height is within the bounds accepted by the chain and, for
+	// evidence against a validator, that the validator can still be punished.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidateEvidence(ctx context.Context, in *QueryValidateEvidenceRequest, opts ...grpc.CallOption) (*QueryValidateEvidenceResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +433,28 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) ValidateEvidence(ctx context.Context, in *QueryValidateEvidenceRequest, opts ...grpc.CallOption) (*QueryValidateEvidenceResponse, error) {
+	out := new(QueryValidateEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/ValidateEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// ValidateEvidence pre-validates evidence before it is submitted. It checks
+	// the evidence structure, that it is handled by the app and was not submitted
+	// yet, that its height is within the bounds accepted by the chain and, for
+	// evidence against a validator, that the validator can still be punished.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidateEvidence(context.Context, *QueryValidateEvidenceRequest) (*QueryValidateEvidenceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +467,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) ValidateEvidence(ctx context.Context, req *QueryValidateEvidenceRequest) (*QueryValidateEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateEvidence not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +511,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidateEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/ValidateEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateEvidence(ctx, req.(*QueryValidateEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +541,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "ValidateEvidence",
+			Handler:    _Query_ValidateEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -556,6 +706,71 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidateEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -629,6 +844,32 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidateEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1043,6 +1284,174 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidateEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types.Any{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -200,6 +200,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			"proposal", proposal.Id,
 			"status", proposal.Status.String(),
 			"expedited", proposal.Expedited,
+			"emergency", proposal.Emergency,
 			"title", proposal.Title,
 			"results", logMsg,
 		)
@@ -1,11 +1,13 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -74,19 +76,22 @@ $ %s query gov proposal 1
 			}
 
 			// Query the proposal
-			res, err := queryClient.Proposal(
-				cmd.Context(),
-				&v1.QueryProposalRequest{ProposalId: proposalID},
-			)
-			if err != nil {
-				return err
-			}
+			return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+				res, err := queryClient.Proposal(
+					ctx,
+					&v1.QueryProposalRequest{ProposalId: proposalID},
+				)
+				if err != nil {
+					return nil, err
+				}
 
-			return clientCtx.PrintProto(res.Proposal)
+				return res.Proposal, nil
+			})
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
 
 	return cmd
 }
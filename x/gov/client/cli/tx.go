@@ -111,7 +111,9 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // emergency proposals can only be submitted by the security council, e.g. from a x/group policy account
+  "emergency": false
 }
 
 metadata example: 
@@ -142,6 +144,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Emergency = proposal.Emergency
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -88,6 +88,7 @@ type proposal struct {
 	Title     string            `json:"title"`
 	Summary   string            `json:"summary"`
 	Expedited bool              `json:"expedited"`
+	Emergency bool              `json:"emergency"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -278,6 +278,15 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ProposalTemplates queries the registered proposal templates
+func (q queryServer) ProposalTemplates(ctx context.Context, req *v1.QueryProposalTemplatesRequest) (*v1.QueryProposalTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &v1.QueryProposalTemplatesResponse{Templates: q.k.GetProposalTemplates()}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -43,6 +43,9 @@ type Keeper struct {
 
 	config types.Config
 
+	// Proposal templates, by Msg type URL
+	proposalTemplates map[string]proposalTemplate
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -100,6 +103,7 @@ func NewKeeper(
 		cdc:                    cdc,
 		router:                 router,
 		config:                 config,
+		proposalTemplates:      make(map[string]proposalTemplate),
 		authority:              authority,
 		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
@@ -78,11 +78,21 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
-		return nil, err
-	}
+	var proposal v1.Proposal
+	if msg.Emergency {
+		if msg.Expedited {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "emergency proposals cannot be expedited")
+		}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+		// emergency proposals do not require any deposit to enter the voting period
+		proposal, err = k.Keeper.SubmitEmergencyProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
+			return nil, err
+		}
+
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
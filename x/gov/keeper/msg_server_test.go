@@ -1716,3 +1716,72 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitProposal_Emergency() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	council, proposer := suite.addrs[0], suite.addrs[1]
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   council.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+	}
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	newMsg := func(proposer sdk.AccAddress, msgs []sdk.Msg, expedited bool) *v1.MsgSubmitProposal {
+		msg, err := v1.NewMsgSubmitProposal(msgs, nil, proposer.String(), "", "Proposal", "description of proposal", expedited)
+		suite.Require().NoError(err)
+		msg.Emergency = true
+		return msg
+	}
+
+	// emergency proposals are disabled by default
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, newMsg(council, []sdk.Msg{bankMsg}, false))
+	suite.Require().ErrorContains(err, "emergency proposals are disabled")
+
+	params.SecurityCouncil = council.String()
+	params.EmergencyMsgTypeUrls = []string{sdk.MsgTypeURL(bankMsg)}
+	suite.Require().NoError(params.ValidateBasic())
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	cases := map[string]struct {
+		msg       *v1.MsgSubmitProposal
+		expErrMsg string
+	}{
+		"not the security council": {
+			msg:       newMsg(proposer, []sdk.Msg{bankMsg}, false),
+			expErrMsg: "only the security council",
+		},
+		"expedited": {
+			msg:       newMsg(council, []sdk.Msg{bankMsg}, true),
+			expErrMsg: "emergency proposals cannot be expedited",
+		},
+		"msg type not allowed": {
+			msg:       newMsg(council, []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct.String(), Params: params}}, false),
+			expErrMsg: "is not allowed in emergency proposals",
+		},
+		"all good": {
+			msg: newMsg(council, []sdk.Msg{bankMsg}, false),
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// emergency proposals enter the voting period without any deposit
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.Emergency)
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+			suite.Require().Equal(proposal.VotingStartTime.Add(*params.EmergencyVotingPeriod), *proposal.VotingEndTime)
+		})
+	}
+}
@@ -16,6 +16,47 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, expedited, false)
+}
+
+// SubmitEmergencyProposal creates a new emergency proposal given an array of
+// messages. Only the security council set in the params can submit emergency
+// proposals, and only with the Msg types allowed by the params. Emergency
+// proposals do not require a deposit and are tallied with the emergency
+// threshold after the emergency voting period.
+func (keeper Keeper) SubmitEmergencyProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if len(params.SecurityCouncil) == 0 {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "emergency proposals are disabled")
+	}
+
+	council, err := keeper.authKeeper.AddressCodec().StringToBytes(params.SecurityCouncil)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if !bytes.Equal(council, proposer) {
+		return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposer, "only the security council %s can submit emergency proposals", params.SecurityCouncil)
+	}
+
+	if len(messages) == 0 {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrNoProposalMsgs, "emergency proposals must contain messages")
+	}
+
+	for _, msg := range messages {
+		if msgURL := sdk.MsgTypeURL(msg); !params.IsEmergencyMsgTypeURL(msgURL) {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalMsg, "%s is not allowed in emergency proposals", msgURL)
+		}
+	}
+
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, false, true)
+}
+
+func (keeper Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited, emergency bool) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -62,6 +103,11 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
 		}
 
+		// validate the message against its proposal template, if any
+		if err := keeper.validateProposalTemplate(ctx, msg); err != nil {
+			return v1.Proposal{}, err
+		}
+
 		// use the msg service router to see that there is a valid route for that message.
 		handler := keeper.router.Handler(msg)
 		if handler == nil {
@@ -102,6 +148,7 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Emergency = emergency
 
 	err = keeper.SetProposal(ctx, proposal)
 	if err != nil {
@@ -244,9 +291,12 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 		return err
 	}
 
-	if proposal.Expedited {
+	switch {
+	case proposal.Emergency:
+		votingPeriod = params.EmergencyVotingPeriod
+	case proposal.Expedited:
 		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
+	default:
 		votingPeriod = params.VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
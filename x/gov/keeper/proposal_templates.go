@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ProposalTemplateValidator validates the structure of a proposal message
+// matching a template, beyond its stateless ValidateBasic.
+type ProposalTemplateValidator func(ctx context.Context, msg sdk.Msg) error
+
+type proposalTemplate struct {
+	v1.ProposalTemplate
+	validate ProposalTemplateValidator
+}
+
+// RegisterProposalTemplate registers a template for the proposal messages of
+// the same type as msg. The messages of the submitted proposals matching the
+// template are validated by validate, so that malformed proposals are rejected
+// upon submission instead of failing upon execution.
+//
+// Templates must be registered when wiring the app, before the keeper is used.
+func (keeper Keeper) RegisterProposalTemplate(name, description string, msg sdk.Msg, validate ProposalTemplateValidator) error {
+	if name == "" {
+		return fmt.Errorf("proposal template name cannot be empty")
+	}
+
+	if validate == nil {
+		return fmt.Errorf("proposal template %s has no validator", name)
+	}
+
+	msgURL := sdk.MsgTypeURL(msg)
+	if existing, ok := keeper.proposalTemplates[msgURL]; ok {
+		return fmt.Errorf("proposal template %s is already registered for %s", existing.Name, msgURL)
+	}
+
+	for _, t := range keeper.proposalTemplates {
+		if t.Name == name {
+			return fmt.Errorf("proposal template %s is already registered", name)
+		}
+	}
+
+	schema, err := keeper.cdc.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	keeper.proposalTemplates[msgURL] = proposalTemplate{
+		ProposalTemplate: v1.ProposalTemplate{
+			Name:        name,
+			Description: description,
+			MsgTypeUrl:  msgURL,
+			Schema:      string(schema),
+		},
+		validate: validate,
+	}
+
+	return nil
+}
+
+// GetProposalTemplates returns the registered proposal templates, sorted by
+// name.
+func (keeper Keeper) GetProposalTemplates() []*v1.ProposalTemplate {
+	templates := make([]*v1.ProposalTemplate, 0, len(keeper.proposalTemplates))
+	for _, t := range keeper.proposalTemplates {
+		template := t.ProposalTemplate
+		templates = append(templates, &template)
+	}
+
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+
+	return templates
+}
+
+// validateProposalTemplate validates a proposal message against the template
+// registered for its type, if any.
+func (keeper Keeper) validateProposalTemplate(ctx context.Context, msg sdk.Msg) error {
+	t, ok := keeper.proposalTemplates[sdk.MsgTypeURL(msg)]
+	if !ok {
+		return nil
+	}
+
+	if err := t.validate(ctx, msg); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidProposalMsg, "%s does not match the %s template: %s", sdk.MsgTypeURL(msg), t.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestProposalTemplates() {
+	validateSend := func(_ context.Context, msg sdk.Msg) error {
+		if msg.(*banktypes.MsgSend).Amount.IsZero() {
+			return fmt.Errorf("amount cannot be zero")
+		}
+		return nil
+	}
+
+	suite.Require().NoError(suite.govKeeper.RegisterProposalTemplate("send", "Send funds", &banktypes.MsgSend{}, validateSend))
+	suite.Require().Error(suite.govKeeper.RegisterProposalTemplate("other-send", "", &banktypes.MsgSend{}, validateSend))
+	suite.Require().Error(suite.govKeeper.RegisterProposalTemplate("send", "", &banktypes.MsgMultiSend{}, validateSend))
+	suite.Require().Error(suite.govKeeper.RegisterProposalTemplate("multi-send", "", &banktypes.MsgMultiSend{}, nil))
+
+	res, err := suite.queryClient.ProposalTemplates(suite.ctx, &v1.QueryProposalTemplatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Templates, 1)
+	suite.Require().Equal("send", res.Templates[0].Name)
+	suite.Require().Equal("Send funds", res.Templates[0].Description)
+	suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Templates[0].MsgTypeUrl)
+	suite.Require().JSONEq(`{"from_address":"","to_address":"","amount":[]}`, res.Templates[0].Schema)
+
+	// the messages matching a template are validated upon submission
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	msg := &banktypes.MsgSend{FromAddress: govAcct, ToAddress: recipient.String()}
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msg}, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMsg)
+
+	msg.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msg}, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+}
@@ -133,11 +133,14 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	// For expedited 2/3
+	// For expedited 2/3, for emergency 3/4
 	var thresholdStr string
-	if proposal.Expedited {
+	switch {
+	case proposal.Emergency:
+		thresholdStr = params.GetEmergencyThreshold()
+	case proposal.Expedited:
 		thresholdStr = params.GetExpeditedThreshold()
-	} else {
+	default:
 		thresholdStr = params.GetThreshold()
 	}
 
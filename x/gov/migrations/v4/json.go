@@ -30,6 +30,10 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 	)
+	params.SecurityCouncil = defaultParams.SecurityCouncil
+	params.EmergencyVotingPeriod = defaultParams.EmergencyVotingPeriod
+	params.EmergencyThreshold = defaultParams.EmergencyThreshold
+	params.EmergencyMsgTypeUrls = defaultParams.EmergencyMsgTypeUrls
 
 	return &v1.GenesisState{
 		StartingProposalId: oldState.StartingProposalId,
@@ -63,6 +63,13 @@ func TestMigrateJSON(t *testing.T) {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"emergency_msg_type_urls": [
+			"/cosmos.circuit.v1.MsgTripCircuitBreaker",
+			"/cosmos.circuit.v1.MsgTripAll",
+			"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		],
+		"emergency_threshold": "0.750000000000000000",
+		"emergency_voting_period": "14400s",
 		"expedited_min_deposit": [
 			{
 				"amount": "50000000",
@@ -82,6 +89,7 @@ func TestMigrateJSON(t *testing.T) {
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
+		"security_council": "",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
 		"voting_period": "172800s"
@@ -43,6 +43,10 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, legacySubspace exp
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 	)
+	params.SecurityCouncil = defaultParams.SecurityCouncil
+	params.EmergencyVotingPeriod = defaultParams.EmergencyVotingPeriod
+	params.EmergencyThreshold = defaultParams.EmergencyThreshold
+	params.EmergencyMsgTypeUrls = defaultParams.EmergencyMsgTypeUrls
 
 	bz, err := cdc.Marshal(&params)
 	if err != nil {
@@ -175,17 +175,32 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 interface{}) *gomock.Ca
 }
 
 // BlockedAddr mocks base method.
-func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
+func (m *MockBankKeeper) BlockedAddr(ctx context.Context, addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret := m.ctrl.Call(m, "BlockedAddr", ctx, addr)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // BlockedAddr indicates an expected call of BlockedAddr.
-func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call {
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), ctx, addr)
+}
+
+// BlockedAddresses mocks base method.
+func (m *MockBankKeeper) BlockedAddresses(arg0 context.Context, arg1 *types0.QueryBlockedAddressesRequest) (*types0.QueryBlockedAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockedAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryBlockedAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockedAddresses indicates an expected call of BlockedAddresses.
+func (mr *MockBankKeeperMockRecorder) BlockedAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddresses), arg0, arg1)
 }
 
 // BurnCoins mocks base method.
@@ -320,6 +335,20 @@ func (mr *MockBankKeeperMockRecorder) GetAccountsBalances(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountsBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAccountsBalances), ctx)
 }
 
+// GetAllBlockedAddressEntries mocks base method.
+func (m *MockBankKeeper) GetAllBlockedAddressEntries(ctx context.Context) []types0.BlockedAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBlockedAddressEntries", ctx)
+	ret0, _ := ret[0].([]types0.BlockedAddress)
+	return ret0
+}
+
+// GetAllBlockedAddressEntries indicates an expected call of GetAllBlockedAddressEntries.
+func (mr *MockBankKeeperMockRecorder) GetAllBlockedAddressEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBlockedAddressEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBlockedAddressEntries), ctx)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -390,18 +419,33 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// GetBlockedAddressEntry mocks base method.
+func (m *MockBankKeeper) GetBlockedAddressEntry(ctx context.Context, addr types.AccAddress) (types0.BlockedAddress, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedAddressEntry", ctx, addr)
+	ret0, _ := ret[0].(types0.BlockedAddress)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetBlockedAddressEntry indicates an expected call of GetBlockedAddressEntry.
+func (mr *MockBankKeeperMockRecorder) GetBlockedAddressEntry(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddressEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddressEntry), ctx, addr)
+}
+
 // GetBlockedAddresses mocks base method.
-func (m *MockBankKeeper) GetBlockedAddresses() map[string]bool {
+func (m *MockBankKeeper) GetBlockedAddresses(ctx context.Context) map[string]bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockedAddresses")
+	ret := m.ctrl.Call(m, "GetBlockedAddresses", ctx)
 	ret0, _ := ret[0].(map[string]bool)
 	return ret0
 }
 
 // GetBlockedAddresses indicates an expected call of GetBlockedAddresses.
-func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
+func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses), ctx)
 }
 
 // GetDenomMetaData mocks base method.
@@ -629,6 +673,18 @@ func (mr *MockBankKeeperMockRecorder) IterateAllDenomMetaData(ctx, cb interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllDenomMetaData), ctx, cb)
 }
 
+// IterateBlockedAddressEntries mocks base method.
+func (m *MockBankKeeper) IterateBlockedAddressEntries(ctx context.Context, cb func(types.AccAddress, bool) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateBlockedAddressEntries", ctx, cb)
+}
+
+// IterateBlockedAddressEntries indicates an expected call of IterateBlockedAddressEntries.
+func (mr *MockBankKeeperMockRecorder) IterateBlockedAddressEntries(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBlockedAddressEntries", reflect.TypeOf((*MockBankKeeper)(nil).IterateBlockedAddressEntries), ctx, cb)
+}
+
 // IterateSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) IterateSendEnabledEntries(ctx context.Context, cb func(string, bool) bool) {
 	m.ctrl.T.Helper()
@@ -779,6 +835,18 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetBlockedAddr mocks base method.
+func (m *MockBankKeeper) SetBlockedAddr(ctx context.Context, addr types.AccAddress, blocked bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBlockedAddr", ctx, addr, blocked)
+}
+
+// SetBlockedAddr indicates an expected call of SetBlockedAddr.
+func (mr *MockBankKeeperMockRecorder) SetBlockedAddr(ctx, addr, blocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).SetBlockedAddr), ctx, addr, blocked)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types0.Metadata) {
 	m.ctrl.T.Helper()
@@ -282,6 +282,8 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// emergency defines if the proposal was fast-tracked by the security council
+	Emergency bool `protobuf:"varint,15,opt,name=emergency,proto3" json:"emergency,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -415,6 +417,13 @@ func (m *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (m *Proposal) GetEmergency() bool {
+	if m != nil {
+		return m.Emergency
+	}
+	return false
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -748,15 +757,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -775,7 +784,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -783,6 +792,16 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// security_council is the account, typically a x/group policy account, allowed to
+	// submit emergency proposals. If empty, emergency proposals are disabled.
+	SecurityCouncil string `protobuf:"bytes,16,opt,name=security_council,json=securityCouncil,proto3" json:"security_council,omitempty"`
+	// Duration of the voting period of an emergency proposal.
+	EmergencyVotingPeriod *time.Duration `protobuf:"bytes,17,opt,name=emergency_voting_period,json=emergencyVotingPeriod,proto3,stdduration" json:"emergency_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for an emergency proposal to pass. Default value: 0.75.
+	EmergencyThreshold string `protobuf:"bytes,18,opt,name=emergency_threshold,json=emergencyThreshold,proto3" json:"emergency_threshold,omitempty"`
+	// emergency_msg_type_urls are the Msg type URLs an emergency proposal is allowed to contain,
+	// e.g. circuit breaker trips or chain halts.
+	EmergencyMsgTypeUrls []string `protobuf:"bytes,19,rep,name=emergency_msg_type_urls,json=emergencyMsgTypeUrls,proto3" json:"emergency_msg_type_urls,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -923,6 +942,110 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetSecurityCouncil() string {
+	if m != nil {
+		return m.SecurityCouncil
+	}
+	return ""
+}
+
+func (m *Params) GetEmergencyVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.EmergencyVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetEmergencyThreshold() string {
+	if m != nil {
+		return m.EmergencyThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetEmergencyMsgTypeUrls() []string {
+	if m != nil {
+		return m.EmergencyMsgTypeUrls
+	}
+	return nil
+}
+
+// ProposalTemplate defines the template of a common proposal message. The
+// messages of a proposal matching the Msg type of a template are validated by
+// the template upon submission.
+type ProposalTemplate struct {
+	// name defines the unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description defines a human readable description of the template.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// msg_type_url defines the type URL of the Msg of the template.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// schema defines the JSON skeleton of the Msg of the template, listing all
+	// its fields with their default values.
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *ProposalTemplate) Reset()         { *m = ProposalTemplate{} }
+func (m *ProposalTemplate) String() string { return proto.CompactTextString(m) }
+func (*ProposalTemplate) ProtoMessage()    {}
+func (*ProposalTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *ProposalTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTemplate.Merge(m, src)
+}
+func (m *ProposalTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTemplate proto.InternalMessageInfo
+
+func (m *ProposalTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProposalTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProposalTemplate) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ProposalTemplate) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -935,99 +1058,110 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposalTemplate)(nil), "cosmos.gov.v1.ProposalTemplate")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0xd7, 0xd1, 0xc5, 0xca, 0xd8, 0x89, 0x69, 0x27, 0x96, 0x1c, 0x21, 0x08, 0xfc,
-	0xe7, 0x22, 0xfd, 0x4e, 0x9a, 0x2e, 0x9a, 0x02, 0x85, 0x64, 0x31, 0x0d, 0x8d, 0xc4, 0x52, 0x29,
-	0x45, 0x4e, 0xba, 0x21, 0x28, 0x71, 0x22, 0x13, 0x15, 0x39, 0x2a, 0x39, 0x52, 0xac, 0x47, 0xe8,
-	0x2e, 0xcb, 0xae, 0x8a, 0x2e, 0xbb, 0xec, 0x22, 0xe8, 0x33, 0x64, 0x55, 0x04, 0xe9, 0xa2, 0xdd,
-	0x34, 0x29, 0x92, 0x45, 0x81, 0x3c, 0x45, 0xc1, 0xe1, 0x50, 0x94, 0x64, 0xb5, 0xb6, 0xb3, 0xb1,
-	0xc5, 0x73, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0x32, 0x43, 0xc2, 0x5a, 0x97, 0x38, 0x26, 0x71, 0xca,
-	0x3d, 0x32, 0x2a, 0x8f, 0x76, 0xdc, 0x7f, 0xa5, 0x81, 0x4d, 0x28, 0x41, 0x19, 0xcf, 0x51, 0x72,
-	0x2d, 0xa3, 0x9d, 0x8d, 0x3c, 0xc7, 0x75, 0x34, 0x07, 0x97, 0x47, 0x3b, 0x1d, 0x4c, 0xb5, 0x9d,
-	0x72, 0x97, 0x18, 0x96, 0x07, 0xdf, 0x58, 0xed, 0x91, 0x1e, 0x61, 0x3f, 0xcb, 0xee, 0x2f, 0x6e,
-	0x2d, 0xf4, 0x08, 0xe9, 0xf5, 0x71, 0x99, 0x3d, 0x75, 0x86, 0x4f, 0xcb, 0xd4, 0x30, 0xb1, 0x43,
-	0x35, 0x73, 0xc0, 0x01, 0xeb, 0xf3, 0x00, 0xcd, 0x1a, 0x73, 0x57, 0x7e, 0xde, 0xa5, 0x0f, 0x6d,
-	0x8d, 0x1a, 0xc4, 0x5f, 0x71, 0xdd, 0x8b, 0x48, 0xf5, 0x16, 0xe5, 0xd1, 0x7a, 0xae, 0x73, 0x9a,
-	0x69, 0x58, 0xa4, 0xcc, 0xfe, 0x7a, 0xa6, 0x22, 0x01, 0x74, 0x80, 0x8d, 0xde, 0x21, 0xc5, 0x7a,
-	0x9b, 0x50, 0x5c, 0x1f, 0xb8, 0x4a, 0x68, 0x07, 0x62, 0x84, 0xfd, 0x12, 0x85, 0x2d, 0x61, 0x3b,
-	0x7b, 0x6b, 0xbd, 0x34, 0xb3, 0xeb, 0x52, 0x00, 0x55, 0x38, 0x10, 0x5d, 0x85, 0xd8, 0x33, 0x26,
-	0x24, 0x86, 0xb6, 0x84, 0xed, 0x64, 0x35, 0xfb, 0xfa, 0xc5, 0x4d, 0xe0, 0xac, 0x1a, 0xee, 0x2a,
-	0xdc, 0x5b, 0xfc, 0x51, 0x80, 0x78, 0x0d, 0x0f, 0x88, 0x63, 0x50, 0x54, 0x80, 0xd4, 0xc0, 0x26,
-	0x03, 0xe2, 0x68, 0x7d, 0xd5, 0xd0, 0xd9, 0x5a, 0x11, 0x05, 0x7c, 0x93, 0xac, 0xa3, 0x4f, 0x21,
-	0xa9, 0x7b, 0x58, 0x62, 0x73, 0x5d, 0xf1, 0xf5, 0x8b, 0x9b, 0xab, 0x5c, 0xb7, 0xa2, 0xeb, 0x36,
-	0x76, 0x9c, 0x26, 0xb5, 0x0d, 0xab, 0xa7, 0x04, 0x50, 0xf4, 0x39, 0xc4, 0x34, 0x93, 0x0c, 0x2d,
-	0x2a, 0x86, 0xb7, 0xc2, 0xdb, 0xa9, 0x20, 0x7e, 0xb7, 0x4c, 0x25, 0x5e, 0xa6, 0xd2, 0x2e, 0x31,
-	0xac, 0x6a, 0xf2, 0xe5, 0x9b, 0xc2, 0xd2, 0x4f, 0x7f, 0xff, 0x7c, 0x4d, 0x50, 0x38, 0xa7, 0xf8,
-	0x36, 0x0a, 0x89, 0x06, 0x0f, 0x02, 0x65, 0x21, 0x34, 0x09, 0x2d, 0x64, 0xe8, 0xe8, 0xff, 0x90,
-	0x30, 0xb1, 0xe3, 0x68, 0x3d, 0xec, 0x88, 0x21, 0x26, 0xbe, 0x5a, 0xf2, 0x2a, 0x52, 0xf2, 0x2b,
-	0x52, 0xaa, 0x58, 0x63, 0x65, 0x82, 0x42, 0x77, 0x20, 0xe6, 0x50, 0x8d, 0x0e, 0x1d, 0x31, 0xcc,
-	0x92, 0xb9, 0x39, 0x97, 0x4c, 0x7f, 0xa9, 0x26, 0x03, 0x29, 0x1c, 0x8c, 0xee, 0x03, 0x7a, 0x6a,
-	0x58, 0x5a, 0x5f, 0xa5, 0x5a, 0xbf, 0x3f, 0x56, 0x6d, 0xec, 0x0c, 0xfb, 0x54, 0x8c, 0x6c, 0x09,
-	0xdb, 0xa9, 0x5b, 0x1b, 0x73, 0x12, 0x2d, 0x17, 0xa2, 0x30, 0x84, 0x92, 0x63, 0xac, 0x29, 0x0b,
-	0xaa, 0x40, 0xca, 0x19, 0x76, 0x4c, 0x83, 0xaa, 0x6e, 0x9b, 0x89, 0x51, 0x2e, 0x31, 0x1f, 0x75,
-	0xcb, 0xef, 0xc1, 0x6a, 0xe4, 0xf9, 0xdb, 0x82, 0xa0, 0x80, 0x47, 0x72, 0xcd, 0x68, 0x0f, 0x72,
-	0x3c, 0xbb, 0x2a, 0xb6, 0x74, 0x4f, 0x27, 0x76, 0x4a, 0x9d, 0x2c, 0x67, 0x4a, 0x96, 0xce, 0xb4,
-	0x64, 0xc8, 0x50, 0x42, 0xb5, 0xbe, 0xca, 0xed, 0x62, 0xfc, 0x0c, 0x35, 0x4a, 0x33, 0xaa, 0xdf,
-	0x40, 0x0f, 0xe0, 0xdc, 0x88, 0x50, 0xc3, 0xea, 0xa9, 0x0e, 0xd5, 0x6c, 0xbe, 0xbf, 0xc4, 0x29,
-	0xe3, 0x5a, 0xf6, 0xa8, 0x4d, 0x97, 0xc9, 0x02, 0xbb, 0x0f, 0xdc, 0x14, 0xec, 0x31, 0x79, 0x4a,
-	0xad, 0x8c, 0x47, 0xf4, 0xb7, 0xb8, 0xe1, 0x36, 0x09, 0xd5, 0x74, 0x8d, 0x6a, 0x22, 0xb8, 0x6d,
-	0xab, 0x4c, 0x9e, 0xd1, 0x2a, 0x44, 0xa9, 0x41, 0xfb, 0x58, 0x4c, 0x31, 0x87, 0xf7, 0x80, 0x44,
-	0x88, 0x3b, 0x43, 0xd3, 0xd4, 0xec, 0xb1, 0x98, 0x66, 0x76, 0xff, 0x11, 0x7d, 0x02, 0x09, 0x6f,
-	0x22, 0xb0, 0x2d, 0x66, 0x4e, 0x18, 0x81, 0x09, 0x12, 0x5d, 0x82, 0x24, 0x3e, 0x1a, 0x60, 0xdd,
-	0xa0, 0x58, 0x17, 0xb3, 0x5b, 0xc2, 0x76, 0x42, 0x09, 0x0c, 0xc5, 0xdf, 0x05, 0x48, 0x4d, 0x77,
-	0xc8, 0x75, 0x48, 0x8e, 0xb1, 0xa3, 0x76, 0xd9, 0xc8, 0x08, 0xc7, 0xe6, 0x57, 0xb6, 0xa8, 0x92,
-	0x18, 0x63, 0x67, 0xd7, 0xf5, 0xa3, 0xdb, 0x90, 0xd1, 0x3a, 0x0e, 0xd5, 0x0c, 0x8b, 0x13, 0x42,
-	0x0b, 0x09, 0x69, 0x0e, 0xf2, 0x48, 0xff, 0x83, 0x84, 0x45, 0x38, 0x3e, 0xbc, 0x10, 0x1f, 0xb7,
-	0x88, 0x07, 0xbd, 0x0b, 0xc8, 0x22, 0xea, 0x33, 0x83, 0x1e, 0xaa, 0x23, 0x4c, 0x7d, 0x52, 0x64,
-	0x21, 0x69, 0xd9, 0x22, 0x07, 0x06, 0x3d, 0x6c, 0x63, 0xea, 0x91, 0x8b, 0xbf, 0x08, 0x10, 0x71,
-	0x4f, 0xa7, 0x93, 0xcf, 0x96, 0x12, 0x44, 0x47, 0x84, 0xe2, 0x93, 0xcf, 0x15, 0x0f, 0x86, 0xee,
-	0x42, 0xdc, 0x3b, 0xea, 0x1c, 0x31, 0xc2, 0x1a, 0xf6, 0xf2, 0xdc, 0x10, 0x1e, 0x3f, 0x47, 0x15,
-	0x9f, 0x31, 0xd3, 0x10, 0xd1, 0xd9, 0x86, 0xd8, 0x8b, 0x24, 0xc2, 0xb9, 0x48, 0xf1, 0x4f, 0x01,
-	0x32, 0xbc, 0xad, 0x1b, 0x9a, 0xad, 0x99, 0x0e, 0x7a, 0x02, 0x29, 0xd3, 0xb0, 0x26, 0x53, 0x22,
-	0x9c, 0x34, 0x25, 0x9b, 0xee, 0x94, 0x7c, 0x78, 0x53, 0x38, 0x3f, 0xc5, 0xba, 0x41, 0x4c, 0x83,
-	0x62, 0x73, 0x40, 0xc7, 0x0a, 0x98, 0x86, 0xe5, 0xcf, 0x8d, 0x09, 0xc8, 0xd4, 0x8e, 0x7c, 0x90,
-	0x3a, 0xc0, 0xb6, 0x41, 0x74, 0x96, 0x08, 0x77, 0x85, 0xf9, 0x66, 0xaf, 0xf1, 0x0b, 0xa6, 0x7a,
-	0xe5, 0xc3, 0x9b, 0xc2, 0xa5, 0xe3, 0xc4, 0x60, 0x91, 0xef, 0xdd, 0x59, 0xc8, 0x99, 0xda, 0x91,
-	0xbf, 0x13, 0xe6, 0xff, 0x2c, 0x24, 0x0a, 0xc5, 0xc7, 0x90, 0x6e, 0xb3, 0x19, 0xe1, 0xbb, 0xab,
-	0x01, 0x9f, 0x19, 0x7f, 0x75, 0xe1, 0xa4, 0xd5, 0x23, 0x4c, 0x3d, 0xed, 0xb1, 0xa6, 0x94, 0x7f,
-	0xf0, 0x9b, 0x99, 0x2b, 0x5f, 0x85, 0xd8, 0xb7, 0x43, 0x62, 0x0f, 0xcd, 0x05, 0x9d, 0xcc, 0x6e,
-	0x22, 0xcf, 0x8b, 0x6e, 0x40, 0x92, 0x1e, 0xda, 0xd8, 0x39, 0x24, 0x7d, 0xfd, 0x5f, 0x2e, 0xad,
-	0x00, 0x80, 0xee, 0x40, 0x96, 0x75, 0x63, 0x40, 0x09, 0x2f, 0xa4, 0x64, 0x5c, 0x54, 0xcb, 0x07,
-	0xb1, 0x00, 0x7f, 0x8b, 0x43, 0x8c, 0xc7, 0x26, 0x9d, 0xb1, 0xa6, 0x53, 0x27, 0xdf, 0x74, 0xfd,
-	0x1e, 0x7e, 0x5c, 0xfd, 0x22, 0x8b, 0xeb, 0x73, 0xbc, 0x16, 0xe1, 0x8f, 0xa8, 0xc5, 0x54, 0xde,
-	0x23, 0xa7, 0xcf, 0x7b, 0xf4, 0xec, 0x79, 0x8f, 0x9d, 0x22, 0xef, 0x48, 0x86, 0x75, 0x37, 0xd1,
-	0x86, 0x65, 0x50, 0x23, 0xb8, 0x6a, 0x54, 0x16, 0xbe, 0x18, 0x5f, 0xa8, 0x70, 0xc1, 0x34, 0x2c,
-	0xd9, 0xc3, 0xf3, 0xf4, 0x28, 0x2e, 0x1a, 0x55, 0xe1, 0xfc, 0xe4, 0x24, 0xe9, 0x6a, 0x56, 0x17,
-	0xf7, 0xb9, 0x4c, 0x62, 0xa1, 0xcc, 0x8a, 0x0f, 0xde, 0x65, 0x58, 0x4f, 0x63, 0x0f, 0x56, 0xe7,
-	0x35, 0x74, 0xec, 0x50, 0x76, 0xbf, 0xfc, 0xd7, 0xd9, 0x83, 0x66, 0xc5, 0x6a, 0xd8, 0xa1, 0xe8,
-	0x00, 0xd6, 0x26, 0x27, 0xb9, 0x3a, 0x5b, 0x37, 0x38, 0x5d, 0xdd, 0xce, 0x4f, 0xf8, 0xed, 0xe9,
-	0x02, 0x7e, 0x01, 0x2b, 0x81, 0x70, 0x90, 0xef, 0xd4, 0xc2, 0x6d, 0xa2, 0x09, 0x34, 0x48, 0xfa,
-	0x63, 0x08, 0x94, 0xd5, 0xe9, 0x3e, 0x4f, 0x9f, 0xa1, 0xcf, 0x83, 0x18, 0x1e, 0x06, 0x0d, 0xbf,
-	0x0d, 0xb9, 0xce, 0xd0, 0xb6, 0xdc, 0xed, 0x62, 0x95, 0x77, 0x59, 0x86, 0xdd, 0x6a, 0x59, 0xd7,
-	0xee, 0x1e, 0xb9, 0x5f, 0x79, 0xdd, 0x55, 0x81, 0x4d, 0x86, 0x9c, 0xa4, 0x7b, 0x32, 0x24, 0x36,
-	0x76, 0xd9, 0xfc, 0x32, 0xdc, 0x70, 0x41, 0xfe, 0x9b, 0x97, 0x3f, 0x0d, 0x1e, 0x02, 0x5d, 0x81,
-	0x6c, 0xb0, 0x98, 0xdb, 0x56, 0xe2, 0x32, 0xe3, 0xa4, 0xfd, 0xa5, 0xdc, 0xeb, 0xe6, 0xda, 0x77,
-	0x02, 0xc0, 0xd4, 0x2b, 0xf3, 0x45, 0x58, 0x6b, 0xd7, 0x5b, 0x92, 0x5a, 0x6f, 0xb4, 0xe4, 0xfa,
-	0xbe, 0xfa, 0x68, 0xbf, 0xd9, 0x90, 0x76, 0xe5, 0x7b, 0xb2, 0x54, 0xcb, 0x2d, 0xa1, 0x15, 0x58,
-	0x9e, 0x76, 0x3e, 0x91, 0x9a, 0x39, 0x01, 0xad, 0xc1, 0xca, 0xb4, 0xb1, 0x52, 0x6d, 0xb6, 0x2a,
-	0xf2, 0x7e, 0x2e, 0x84, 0x10, 0x64, 0xa7, 0x1d, 0xfb, 0xf5, 0x5c, 0x18, 0x5d, 0x02, 0x71, 0xd6,
-	0xa6, 0x1e, 0xc8, 0xad, 0xfb, 0x6a, 0x5b, 0x6a, 0xd5, 0x73, 0x91, 0x6b, 0xbf, 0x0a, 0x90, 0x9d,
-	0x7d, 0x8d, 0x44, 0x05, 0xb8, 0xd8, 0x50, 0xea, 0x8d, 0x7a, 0xb3, 0xf2, 0x40, 0x6d, 0xb6, 0x2a,
-	0xad, 0x47, 0xcd, 0xb9, 0x98, 0x8a, 0x90, 0x9f, 0x07, 0xd4, 0xa4, 0x46, 0xbd, 0x29, 0xb7, 0xd4,
-	0x86, 0xa4, 0xc8, 0xf5, 0x5a, 0x4e, 0x40, 0x97, 0x61, 0x73, 0x1e, 0xd3, 0xae, 0xb7, 0xe4, 0xfd,
-	0x2f, 0x7d, 0x48, 0x08, 0x6d, 0xc0, 0x85, 0x79, 0x48, 0xa3, 0xd2, 0x6c, 0x4a, 0x35, 0x2f, 0xe8,
-	0x79, 0x9f, 0x22, 0xed, 0x49, 0xbb, 0x2d, 0xa9, 0x96, 0x8b, 0x2c, 0x62, 0xde, 0xab, 0xc8, 0x0f,
-	0xa4, 0x5a, 0x2e, 0x5a, 0x95, 0x5e, 0xbe, 0xcb, 0x0b, 0xaf, 0xde, 0xe5, 0x85, 0xbf, 0xde, 0xe5,
-	0x85, 0xe7, 0xef, 0xf3, 0x4b, 0xaf, 0xde, 0xe7, 0x97, 0xfe, 0x78, 0x9f, 0x5f, 0xfa, 0xfa, 0x7a,
-	0xcf, 0xa0, 0x87, 0xc3, 0x4e, 0xa9, 0x4b, 0x4c, 0xfe, 0x71, 0xc3, 0xff, 0xdd, 0x74, 0xf4, 0x6f,
-	0xca, 0x47, 0xec, 0x83, 0x8d, 0x8e, 0x07, 0xd8, 0x71, 0xbf, 0xc6, 0x62, 0x6c, 0x02, 0x6e, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe0, 0x97, 0xe3, 0xce, 0x0d, 0x00, 0x00,
+	// 1540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xdb, 0xcc,
+	0x11, 0x36, 0x25, 0x59, 0x96, 0x46, 0x1f, 0x66, 0xd6, 0x4e, 0x4c, 0x3b, 0xb1, 0xac, 0x08, 0x41,
+	0xe0, 0xe6, 0x43, 0xaa, 0x93, 0xa6, 0x87, 0xa6, 0x40, 0x21, 0x5b, 0x4a, 0x23, 0x23, 0xb1, 0x54,
+	0x8a, 0xb1, 0x93, 0x5e, 0x08, 0x5a, 0xdc, 0xc8, 0x44, 0x45, 0xae, 0xca, 0x5d, 0x39, 0xd6, 0xb5,
+	0xb7, 0xde, 0x72, 0xec, 0xa9, 0xe8, 0xb1, 0xc7, 0x1e, 0x82, 0xfe, 0x86, 0x9c, 0x8a, 0x20, 0x97,
+	0xf6, 0xd2, 0xb4, 0x48, 0x0e, 0x45, 0xf3, 0x1f, 0x5e, 0xe0, 0x05, 0x77, 0x97, 0x22, 0x2d, 0x2b,
+	0xb0, 0x9d, 0x8b, 0x4d, 0xce, 0x3c, 0xcf, 0xcc, 0xec, 0x7c, 0xad, 0x08, 0x2b, 0x3d, 0x42, 0x5d,
+	0x42, 0x6b, 0x7d, 0x72, 0x5c, 0x3b, 0xde, 0x0a, 0xfe, 0x55, 0x87, 0x3e, 0x61, 0x04, 0x15, 0x84,
+	0xa2, 0x1a, 0x48, 0x8e, 0xb7, 0xd6, 0x4a, 0x12, 0x77, 0x68, 0x51, 0x5c, 0x3b, 0xde, 0x3a, 0xc4,
+	0xcc, 0xda, 0xaa, 0xf5, 0x88, 0xe3, 0x09, 0xf8, 0xda, 0x72, 0x9f, 0xf4, 0x09, 0x7f, 0xac, 0x05,
+	0x4f, 0x52, 0xba, 0xd1, 0x27, 0xa4, 0x3f, 0xc0, 0x35, 0xfe, 0x76, 0x38, 0x7a, 0x5d, 0x63, 0x8e,
+	0x8b, 0x29, 0xb3, 0xdc, 0xa1, 0x04, 0xac, 0x4e, 0x03, 0x2c, 0x6f, 0x2c, 0x55, 0xa5, 0x69, 0x95,
+	0x3d, 0xf2, 0x2d, 0xe6, 0x90, 0xd0, 0xe3, 0xaa, 0x88, 0xc8, 0x14, 0x4e, 0x65, 0xb4, 0x42, 0x75,
+	0xc5, 0x72, 0x1d, 0x8f, 0xd4, 0xf8, 0x5f, 0x21, 0xaa, 0x10, 0x40, 0x07, 0xd8, 0xe9, 0x1f, 0x31,
+	0x6c, 0xef, 0x13, 0x86, 0xdb, 0xc3, 0xc0, 0x12, 0xda, 0x82, 0x34, 0xe1, 0x4f, 0x9a, 0x52, 0x56,
+	0x36, 0x8b, 0x0f, 0x56, 0xab, 0xa7, 0x4e, 0x5d, 0x8d, 0xa0, 0xba, 0x04, 0xa2, 0xdb, 0x90, 0x7e,
+	0xc3, 0x0d, 0x69, 0x89, 0xb2, 0xb2, 0x99, 0xdd, 0x2e, 0x7e, 0x7c, 0x77, 0x1f, 0x24, 0xab, 0x81,
+	0x7b, 0xba, 0xd4, 0x56, 0xfe, 0xa2, 0xc0, 0x42, 0x03, 0x0f, 0x09, 0x75, 0x18, 0xda, 0x80, 0xdc,
+	0xd0, 0x27, 0x43, 0x42, 0xad, 0x81, 0xe9, 0xd8, 0xdc, 0x57, 0x4a, 0x87, 0x50, 0xd4, 0xb2, 0xd1,
+	0xcf, 0x21, 0x6b, 0x0b, 0x2c, 0xf1, 0xa5, 0x5d, 0xed, 0xe3, 0xbb, 0xfb, 0xcb, 0xd2, 0x6e, 0xdd,
+	0xb6, 0x7d, 0x4c, 0x69, 0x97, 0xf9, 0x8e, 0xd7, 0xd7, 0x23, 0x28, 0xfa, 0x25, 0xa4, 0x2d, 0x97,
+	0x8c, 0x3c, 0xa6, 0x25, 0xcb, 0xc9, 0xcd, 0x5c, 0x14, 0x7f, 0x50, 0xa6, 0xaa, 0x2c, 0x53, 0x75,
+	0x87, 0x38, 0xde, 0x76, 0xf6, 0xfd, 0xa7, 0x8d, 0xb9, 0xbf, 0xfe, 0xef, 0x6f, 0x77, 0x14, 0x5d,
+	0x72, 0x2a, 0x3f, 0xcc, 0x43, 0xa6, 0x23, 0x83, 0x40, 0x45, 0x48, 0x4c, 0x42, 0x4b, 0x38, 0x36,
+	0xfa, 0x29, 0x64, 0x5c, 0x4c, 0xa9, 0xd5, 0xc7, 0x54, 0x4b, 0x70, 0xe3, 0xcb, 0x55, 0x51, 0x91,
+	0x6a, 0x58, 0x91, 0x6a, 0xdd, 0x1b, 0xeb, 0x13, 0x14, 0x7a, 0x04, 0x69, 0xca, 0x2c, 0x36, 0xa2,
+	0x5a, 0x92, 0x27, 0x73, 0x7d, 0x2a, 0x99, 0xa1, 0xab, 0x2e, 0x07, 0xe9, 0x12, 0x8c, 0x9e, 0x02,
+	0x7a, 0xed, 0x78, 0xd6, 0xc0, 0x64, 0xd6, 0x60, 0x30, 0x36, 0x7d, 0x4c, 0x47, 0x03, 0xa6, 0xa5,
+	0xca, 0xca, 0x66, 0xee, 0xc1, 0xda, 0x94, 0x09, 0x23, 0x80, 0xe8, 0x1c, 0xa1, 0xab, 0x9c, 0x15,
+	0x93, 0xa0, 0x3a, 0xe4, 0xe8, 0xe8, 0xd0, 0x75, 0x98, 0x19, 0xb4, 0x99, 0x36, 0x2f, 0x4d, 0x4c,
+	0x47, 0x6d, 0x84, 0x3d, 0xb8, 0x9d, 0x7a, 0xfb, 0x9f, 0x0d, 0x45, 0x07, 0x41, 0x0a, 0xc4, 0x68,
+	0x17, 0x54, 0x99, 0x5d, 0x13, 0x7b, 0xb6, 0xb0, 0x93, 0xbe, 0xa0, 0x9d, 0xa2, 0x64, 0x36, 0x3d,
+	0x9b, 0xdb, 0x6a, 0x41, 0x81, 0x11, 0x66, 0x0d, 0x4c, 0x29, 0xd7, 0x16, 0x2e, 0x51, 0xa3, 0x3c,
+	0xa7, 0x86, 0x0d, 0xf4, 0x0c, 0xae, 0x1c, 0x13, 0xe6, 0x78, 0x7d, 0x93, 0x32, 0xcb, 0x97, 0xe7,
+	0xcb, 0x5c, 0x30, 0xae, 0x45, 0x41, 0xed, 0x06, 0x4c, 0x1e, 0xd8, 0x53, 0x90, 0xa2, 0xe8, 0x8c,
+	0xd9, 0x0b, 0xda, 0x2a, 0x08, 0x62, 0x78, 0xc4, 0xb5, 0xa0, 0x49, 0x98, 0x65, 0x5b, 0xcc, 0xd2,
+	0x20, 0x68, 0x5b, 0x7d, 0xf2, 0x8e, 0x96, 0x61, 0x9e, 0x39, 0x6c, 0x80, 0xb5, 0x1c, 0x57, 0x88,
+	0x17, 0xa4, 0xc1, 0x02, 0x1d, 0xb9, 0xae, 0xe5, 0x8f, 0xb5, 0x3c, 0x97, 0x87, 0xaf, 0xe8, 0x67,
+	0x90, 0x11, 0x13, 0x81, 0x7d, 0xad, 0x70, 0xce, 0x08, 0x4c, 0x90, 0xe8, 0x06, 0x64, 0xf1, 0xc9,
+	0x10, 0xdb, 0x0e, 0xc3, 0xb6, 0x56, 0x2c, 0x2b, 0x9b, 0x19, 0x3d, 0x12, 0x70, 0xad, 0x8b, 0xfd,
+	0x3e, 0xf6, 0x7a, 0x63, 0x6d, 0x51, 0x6a, 0x43, 0x41, 0xe5, 0x9f, 0x0a, 0xe4, 0xe2, 0xfd, 0x73,
+	0x17, 0xb2, 0x63, 0x4c, 0xcd, 0x1e, 0x1f, 0x28, 0xe5, 0xcc, 0x74, 0xb7, 0x3c, 0xa6, 0x67, 0xc6,
+	0x98, 0xee, 0x04, 0x7a, 0xf4, 0x10, 0x0a, 0xd6, 0x21, 0x65, 0x96, 0xe3, 0x49, 0x42, 0x62, 0x26,
+	0x21, 0x2f, 0x41, 0x82, 0xf4, 0x13, 0xc8, 0x78, 0x44, 0xe2, 0x93, 0x33, 0xf1, 0x0b, 0x1e, 0x11,
+	0xd0, 0xc7, 0x80, 0x3c, 0x62, 0xbe, 0x71, 0xd8, 0x91, 0x79, 0x8c, 0x59, 0x48, 0x4a, 0xcd, 0x24,
+	0x2d, 0x7a, 0xe4, 0xc0, 0x61, 0x47, 0xfb, 0x98, 0x09, 0x72, 0xe5, 0xef, 0x0a, 0xa4, 0x82, 0xdd,
+	0x75, 0xfe, 0xe6, 0xa9, 0xc2, 0xfc, 0x31, 0x61, 0xf8, 0xfc, 0xad, 0x23, 0x60, 0xe8, 0x31, 0x2c,
+	0x88, 0x45, 0x48, 0xb5, 0x14, 0x6f, 0xe7, 0x9b, 0x53, 0x23, 0x7a, 0x76, 0xcb, 0xea, 0x21, 0xe3,
+	0x54, 0xbb, 0xcc, 0x9f, 0x6e, 0x97, 0xdd, 0x54, 0x26, 0xa9, 0xa6, 0x2a, 0xff, 0x56, 0xa0, 0x20,
+	0x9b, 0xbe, 0x63, 0xf9, 0x96, 0x4b, 0xd1, 0x2b, 0xc8, 0xb9, 0x8e, 0x37, 0x99, 0x21, 0xe5, 0xbc,
+	0x19, 0x5a, 0x0f, 0x66, 0xe8, 0xeb, 0xa7, 0x8d, 0xab, 0x31, 0xd6, 0x3d, 0xe2, 0x3a, 0x0c, 0xbb,
+	0x43, 0x36, 0xd6, 0xc1, 0x75, 0xbc, 0x70, 0xaa, 0x5c, 0x40, 0xae, 0x75, 0x12, 0x82, 0xcc, 0x21,
+	0xf6, 0x1d, 0x62, 0xf3, 0x44, 0x04, 0x1e, 0xa6, 0x47, 0xa1, 0x21, 0xaf, 0x9f, 0xed, 0x5b, 0x5f,
+	0x3f, 0x6d, 0xdc, 0x38, 0x4b, 0x8c, 0x9c, 0xfc, 0x29, 0x98, 0x14, 0xd5, 0xb5, 0x4e, 0xc2, 0x93,
+	0x70, 0xfd, 0x2f, 0x12, 0x9a, 0x52, 0x79, 0x09, 0xf9, 0x7d, 0x3e, 0x41, 0xf2, 0x74, 0x0d, 0x90,
+	0x13, 0x15, 0x7a, 0x57, 0xce, 0xf3, 0x9e, 0xe2, 0xd6, 0xf3, 0x82, 0x15, 0xb3, 0xfc, 0xe7, 0xb0,
+	0x99, 0xa5, 0xe5, 0xdb, 0x90, 0xfe, 0xfd, 0x88, 0xf8, 0x23, 0x57, 0x53, 0x66, 0xdf, 0x53, 0x42,
+	0x8b, 0xee, 0x41, 0x96, 0x1d, 0xf9, 0x98, 0x1e, 0x91, 0x81, 0xfd, 0x8d, 0x2b, 0x2d, 0x02, 0xa0,
+	0x47, 0x50, 0xe4, 0xdd, 0x18, 0x51, 0x92, 0x33, 0x29, 0x85, 0x00, 0x65, 0x84, 0x20, 0x1e, 0xe0,
+	0xff, 0xb3, 0x90, 0x96, 0xb1, 0x35, 0x2f, 0x59, 0xd3, 0xd8, 0x5e, 0x8c, 0xd7, 0xef, 0xf9, 0xf7,
+	0xd5, 0x2f, 0x35, 0xbb, 0x3e, 0x67, 0x6b, 0x91, 0xfc, 0x8e, 0x5a, 0xc4, 0xf2, 0x9e, 0xba, 0x78,
+	0xde, 0xe7, 0x2f, 0x9f, 0xf7, 0xf4, 0x05, 0xf2, 0x8e, 0x5a, 0xb0, 0x1a, 0x24, 0xda, 0xf1, 0x1c,
+	0xe6, 0x44, 0x17, 0x91, 0xc9, 0xc3, 0xd7, 0x16, 0x66, 0x5a, 0xb8, 0xe6, 0x3a, 0x5e, 0x4b, 0xe0,
+	0x65, 0x7a, 0xf4, 0x00, 0x8d, 0xb6, 0xe1, 0xea, 0x64, 0x93, 0xf4, 0x2c, 0xaf, 0x87, 0x07, 0xd2,
+	0x4c, 0x66, 0xa6, 0x99, 0xa5, 0x10, 0xbc, 0xc3, 0xb1, 0xc2, 0xc6, 0x2e, 0x2c, 0x4f, 0xdb, 0xb0,
+	0x31, 0x65, 0x5a, 0xf6, 0x9c, 0xdd, 0x83, 0x4e, 0x1b, 0x6b, 0x60, 0xca, 0xd0, 0x01, 0xac, 0x4c,
+	0xf6, 0xbc, 0x79, 0xba, 0x6e, 0x70, 0xb1, 0xba, 0x5d, 0x9d, 0xf0, 0xf7, 0xe3, 0x05, 0xfc, 0x15,
+	0x2c, 0x45, 0x86, 0xa3, 0x7c, 0xe7, 0x66, 0x1e, 0x13, 0x4d, 0xa0, 0x51, 0xd2, 0x5f, 0x42, 0x64,
+	0xd9, 0x8c, 0xf7, 0x79, 0xfe, 0x12, 0x7d, 0x1e, 0xc5, 0xf0, 0x3c, 0x6a, 0xf8, 0x4d, 0x50, 0x0f,
+	0x47, 0xbe, 0x17, 0x1c, 0x17, 0x9b, 0xb2, 0xcb, 0x0a, 0xfc, 0x56, 0x2b, 0x06, 0xf2, 0x60, 0xe5,
+	0xfe, 0x46, 0x74, 0x57, 0x1d, 0xd6, 0x39, 0x72, 0x92, 0xee, 0xc9, 0x90, 0xf8, 0x38, 0x60, 0xcb,
+	0xab, 0x72, 0x2d, 0x00, 0x85, 0xbf, 0xcb, 0xc2, 0x69, 0x10, 0x08, 0x74, 0x0b, 0x8a, 0x91, 0xb3,
+	0xa0, 0xad, 0xe4, 0x05, 0x9a, 0x0f, 0x5d, 0x05, 0xd7, 0x0d, 0xda, 0x01, 0x95, 0xe2, 0xde, 0xc8,
+	0x77, 0xd8, 0x98, 0x5f, 0x51, 0x3d, 0x67, 0xa0, 0xa9, 0xe7, 0x94, 0x73, 0x31, 0x64, 0xec, 0x08,
+	0x02, 0xaf, 0x65, 0x78, 0x2b, 0x4f, 0xd5, 0xf2, 0xca, 0x45, 0x6b, 0x19, 0xf2, 0xcf, 0xd4, 0x72,
+	0x62, 0x38, 0xaa, 0x25, 0xfa, 0x46, 0x2d, 0x43, 0xa8, 0x11, 0x9b, 0xbb, 0x58, 0x64, 0x2e, 0xed,
+	0x9b, 0x6c, 0x3c, 0xc4, 0xe6, 0xc8, 0x1f, 0x50, 0x6d, 0xa9, 0x9c, 0xdc, 0xcc, 0xea, 0xcb, 0x13,
+	0xf5, 0x73, 0xda, 0x37, 0xc6, 0x43, 0xfc, 0xc2, 0x1f, 0xd0, 0xca, 0x1f, 0x14, 0x50, 0xc3, 0xb4,
+	0x1a, 0xd8, 0x1d, 0x0e, 0x2c, 0x86, 0x11, 0x82, 0x94, 0x67, 0xb9, 0x58, 0xec, 0x63, 0x9d, 0x3f,
+	0xa3, 0x32, 0xe4, 0x6c, 0x4c, 0x7b, 0xbe, 0x23, 0xbe, 0x42, 0xf8, 0xfe, 0xd5, 0xe3, 0x22, 0x54,
+	0x86, 0x7c, 0xdc, 0xaf, 0xd8, 0xb7, 0x3a, 0xb8, 0x13, 0x6f, 0xe8, 0x1a, 0xa4, 0x69, 0xef, 0x08,
+	0xbb, 0x96, 0xd8, 0x38, 0xba, 0x7c, 0xbb, 0xf3, 0x47, 0x05, 0x20, 0xf6, 0xad, 0x73, 0x1d, 0x56,
+	0xf6, 0xdb, 0x46, 0xd3, 0x6c, 0x77, 0x8c, 0x56, 0x7b, 0xcf, 0x7c, 0xb1, 0xd7, 0xed, 0x34, 0x77,
+	0x5a, 0x4f, 0x5a, 0xcd, 0x86, 0x3a, 0x87, 0x96, 0x60, 0x31, 0xae, 0x7c, 0xd5, 0xec, 0xaa, 0x0a,
+	0x5a, 0x81, 0xa5, 0xb8, 0xb0, 0xbe, 0xdd, 0x35, 0xea, 0xad, 0x3d, 0x35, 0x81, 0x10, 0x14, 0xe3,
+	0x8a, 0xbd, 0xb6, 0x9a, 0x44, 0x37, 0x40, 0x3b, 0x2d, 0x33, 0x0f, 0x5a, 0xc6, 0x53, 0x73, 0xbf,
+	0x69, 0xb4, 0xd5, 0xd4, 0x9d, 0x7f, 0x28, 0x50, 0x3c, 0xfd, 0xfb, 0x1f, 0x6d, 0xc0, 0xf5, 0x8e,
+	0xde, 0xee, 0xb4, 0xbb, 0xf5, 0x67, 0x66, 0xd7, 0xa8, 0x1b, 0x2f, 0xba, 0x53, 0x31, 0x55, 0xa0,
+	0x34, 0x0d, 0x68, 0x34, 0x3b, 0xed, 0x6e, 0xcb, 0x30, 0x3b, 0x4d, 0xbd, 0xd5, 0x6e, 0xa8, 0x0a,
+	0xba, 0x09, 0xeb, 0xd3, 0x98, 0xfd, 0xb6, 0xd1, 0xda, 0xfb, 0x75, 0x08, 0x49, 0xa0, 0x35, 0xb8,
+	0x36, 0x0d, 0xe9, 0xd4, 0xbb, 0xdd, 0x66, 0x43, 0x04, 0x3d, 0xad, 0xd3, 0x9b, 0xbb, 0xcd, 0x1d,
+	0xa3, 0xd9, 0x50, 0x53, 0xb3, 0x98, 0x4f, 0xea, 0xad, 0x67, 0xcd, 0x86, 0x3a, 0xbf, 0xdd, 0x7c,
+	0xff, 0xb9, 0xa4, 0x7c, 0xf8, 0x5c, 0x52, 0xfe, 0xfb, 0xb9, 0xa4, 0xbc, 0xfd, 0x52, 0x9a, 0xfb,
+	0xf0, 0xa5, 0x34, 0xf7, 0xaf, 0x2f, 0xa5, 0xb9, 0xdf, 0xde, 0xed, 0x3b, 0xec, 0x68, 0x74, 0x58,
+	0xed, 0x11, 0x57, 0x7e, 0x95, 0xca, 0x7f, 0xf7, 0xa9, 0xfd, 0xbb, 0xda, 0x09, 0xff, 0xd2, 0x0e,
+	0xaa, 0x49, 0x83, 0xcf, 0xe8, 0x34, 0x6f, 0xe8, 0x87, 0x3f, 0x0e, 0x00, 0x1a, 0xa7, 0x45, 0x2f,
+	0x87, 0x0f, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1134,6 +1268,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Emergency {
+		i--
+		if m.Emergency {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1516,6 +1660,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmergencyMsgTypeUrls) > 0 {
+		for iNdEx := len(m.EmergencyMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencyMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.EmergencyMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.EmergencyMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.EmergencyThreshold) > 0 {
+		i -= len(m.EmergencyThreshold)
+		copy(dAtA[i:], m.EmergencyThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.EmergencyThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.EmergencyVotingPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.EmergencyVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.EmergencyVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.SecurityCouncil) > 0 {
+		i -= len(m.SecurityCouncil)
+		copy(dAtA[i:], m.SecurityCouncil)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SecurityCouncil)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1568,12 +1753,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1620,22 +1805,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1656,6 +1841,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1768,6 +2004,9 @@ func (m *Proposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Emergency {
+		n += 2
+	}
 	return n
 }
 
@@ -1942,6 +2181,49 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	l = len(m.SecurityCouncil)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.EmergencyVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.EmergencyVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.EmergencyThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.EmergencyMsgTypeUrls) > 0 {
+		for _, s := range m.EmergencyMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2650,6 +2932,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Emergency = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3857,6 +4159,316 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityCouncil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityCouncil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmergencyVotingPeriod == nil {
+				m.EmergencyVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.EmergencyVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyMsgTypeUrls = append(m.EmergencyMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -13,6 +13,7 @@ import (
 const (
 	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
 	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
+	DefaultEmergencyPeriod                time.Duration = time.Hour * 4      // 4 hours
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
@@ -30,6 +31,13 @@ var (
 	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultEmergencyThreshold        = sdkmath.LegacyNewDecWithPrec(75, 2)
+	DefaultSecurityCouncil           = "" // emergency proposals are disabled by default
+	DefaultEmergencyMsgTypeURLs      = []string{
+		"/cosmos.circuit.v1.MsgTripCircuitBreaker",
+		"/cosmos.circuit.v1.MsgTripAll",
+		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+	}
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -82,7 +90,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -99,6 +107,14 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+
+	emergencyVotingPeriod := DefaultEmergencyPeriod
+	params.SecurityCouncil = DefaultSecurityCouncil
+	params.EmergencyVotingPeriod = &emergencyVotingPeriod
+	params.EmergencyThreshold = DefaultEmergencyThreshold.String()
+	params.EmergencyMsgTypeUrls = DefaultEmergencyMsgTypeURLs
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -215,5 +231,61 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	if len(p.SecurityCouncil) != 0 {
+		return p.validateEmergencyParams(threshold)
+	}
+
+	return nil
+}
+
+// validateEmergencyParams validates the emergency proposal parameters, which
+// are only used when a security council is set.
+func (p Params) validateEmergencyParams(threshold sdkmath.LegacyDec) error {
+	if _, err := sdk.AccAddressFromBech32(p.SecurityCouncil); err != nil {
+		return fmt.Errorf("security council address is invalid: %s", p.SecurityCouncil)
+	}
+
+	emergencyThreshold, err := sdkmath.LegacyNewDecFromStr(p.EmergencyThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid emergency threshold string: %w", err)
+	}
+	if emergencyThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("emergency vote threshold too large: %s", emergencyThreshold)
+	}
+	if emergencyThreshold.LTE(threshold) {
+		return fmt.Errorf("emergency vote threshold %s, must be greater than the regular threshold %s", emergencyThreshold, threshold)
+	}
+
+	if p.EmergencyVotingPeriod == nil {
+		return fmt.Errorf("emergency voting period must not be nil: %d", p.EmergencyVotingPeriod)
+	}
+	if p.EmergencyVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("emergency voting period must be positive: %s", p.EmergencyVotingPeriod)
+	}
+	if p.ExpeditedVotingPeriod != nil && p.EmergencyVotingPeriod.Seconds() > p.ExpeditedVotingPeriod.Seconds() {
+		return fmt.Errorf("emergency voting period %s must not be greater than the expedited voting period %s", p.EmergencyVotingPeriod, p.ExpeditedVotingPeriod)
+	}
+
+	if len(p.EmergencyMsgTypeUrls) == 0 {
+		return fmt.Errorf("emergency msg type urls must not be empty when a security council is set")
+	}
+	for _, url := range p.EmergencyMsgTypeUrls {
+		if len(url) == 0 {
+			return fmt.Errorf("emergency msg type url must not be empty")
+		}
+	}
+
 	return nil
 }
+
+// IsEmergencyMsgTypeURL returns true if the given Msg type URL is allowed in
+// an emergency proposal.
+func (p Params) IsEmergencyMsgTypeURL(msgURL string) bool {
+	for _, url := range p.EmergencyMsgTypeUrls {
+		if url == msgURL {
+			return true
+		}
+	}
+
+	return false
+}
@@ -54,9 +54,12 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 }
 
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
-// the proposal is expedited. Otherwise, returns the regular min deposit from
-// gov params.
+// the proposal is expedited, and no min deposit if the proposal is an emergency
+// proposal. Otherwise, returns the regular min deposit from gov params.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
+	if p.Emergency {
+		return sdk.NewCoins()
+	}
 	if p.Expedited {
 		return params.ExpeditedMinDeposit
 	}
@@ -975,6 +975,89 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryProposalTemplatesRequest is the request type for the Query/ProposalTemplates RPC method.
+type QueryProposalTemplatesRequest struct {
+}
+
+func (m *QueryProposalTemplatesRequest) Reset()         { *m = QueryProposalTemplatesRequest{} }
+func (m *QueryProposalTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTemplatesRequest) ProtoMessage()    {}
+func (*QueryProposalTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryProposalTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTemplatesRequest.Merge(m, src)
+}
+func (m *QueryProposalTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTemplatesRequest proto.InternalMessageInfo
+
+// QueryProposalTemplatesResponse is the response type for the Query/ProposalTemplates RPC method.
+type QueryProposalTemplatesResponse struct {
+	// templates defines the registered proposal templates, sorted by name.
+	Templates []*ProposalTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (m *QueryProposalTemplatesResponse) Reset()         { *m = QueryProposalTemplatesResponse{} }
+func (m *QueryProposalTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTemplatesResponse) ProtoMessage()    {}
+func (*QueryProposalTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryProposalTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTemplatesResponse.Merge(m, src)
+}
+func (m *QueryProposalTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTemplatesResponse proto.InternalMessageInfo
+
+func (m *QueryProposalTemplatesResponse) GetTemplates() []*ProposalTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -994,76 +1077,82 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryProposalTemplatesRequest)(nil), "cosmos.gov.v1.QueryProposalTemplatesRequest")
+	proto.RegisterType((*QueryProposalTemplatesResponse)(nil), "cosmos.gov.v1.QueryProposalTemplatesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x97, 0x66, 0x4f, 0x2e, 0xc0, 0xe9, 0x25, 0xae, 0xdb, 0x6e, 0x83, 0x43, 0x93,
-	0x40, 0x89, 0xcd, 0xa6, 0x37, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b, 0x1e,
-	0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x1e, 0x77, 0x67, 0x76, 0x45, 0x48, 0x23, 0xa4, 0x4a,
-	0x5c, 0x9e, 0x00, 0x89, 0x0a, 0xf8, 0x21, 0xfc, 0x08, 0x1e, 0x2b, 0x78, 0xe1, 0x11, 0x25, 0xfc,
-	0x10, 0xe4, 0x99, 0x63, 0xaf, 0xed, 0x78, 0x6f, 0x55, 0xd5, 0xa7, 0x95, 0x67, 0xbe, 0xf3, 0x9d,
-	0xef, 0x9c, 0x39, 0x73, 0xce, 0x2c, 0x9c, 0xdf, 0x65, 0x7c, 0x8f, 0x71, 0xdb, 0x67, 0x1d, 0xbb,
-	0x53, 0xb7, 0x1f, 0xb5, 0xbd, 0xd6, 0xbe, 0x15, 0xb5, 0x98, 0x60, 0x38, 0xa7, 0xb6, 0x2c, 0x9f,
-	0x75, 0xac, 0x4e, 0xdd, 0x78, 0x8b, 0x90, 0x3b, 0x2e, 0xf7, 0x14, 0xce, 0xee, 0xd4, 0x77, 0x3c,
-	0xe1, 0xd6, 0xed, 0xc8, 0xf5, 0x83, 0xd0, 0x15, 0x01, 0x0b, 0x95, 0xa9, 0x71, 0xd1, 0x67, 0xcc,
-	0x6f, 0x7a, 0xb6, 0x1b, 0x05, 0xb6, 0x1b, 0x86, 0x4c, 0xc8, 0x4d, 0x4e, 0xbb, 0x0b, 0x79, 0x9f,
-	0x31, 0xbf, 0xda, 0x20, 0x31, 0xdb, 0xf2, 0xcb, 0x26, 0xf7, 0xf2, 0xc3, 0x34, 0x40, 0xff, 0x34,
-	0xf6, 0x79, 0x97, 0x85, 0x5c, 0x04, 0xa2, 0x1d, 0xf3, 0x39, 0xde, 0xa3, 0xb6, 0xc7, 0x85, 0xf9,
-	0x01, 0x9c, 0x2f, 0xd9, 0xe3, 0x11, 0x0b, 0xb9, 0x87, 0x26, 0xcc, 0xee, 0x66, 0xd6, 0x75, 0x6d,
-	0x51, 0x5b, 0xad, 0x3a, 0xb9, 0x35, 0xf3, 0x16, 0x9c, 0x91, 0x04, 0x5b, 0x2d, 0x16, 0x31, 0xee,
-	0x36, 0x89, 0x18, 0x2f, 0xc3, 0x4c, 0x44, 0x4b, 0xdb, 0x41, 0x43, 0x9a, 0x4e, 0x38, 0x90, 0x2c,
-	0x7d, 0xdc, 0x30, 0x3f, 0x81, 0xb3, 0x05, 0x43, 0xf2, 0x7a, 0x0d, 0xa6, 0x13, 0x98, 0x34, 0x9b,
-	0x59, 0x5f, 0xb0, 0x72, 0xe9, 0xb4, 0x52, 0x93, 0x14, 0x68, 0xfe, 0x54, 0x29, 0xd0, 0xf1, 0x44,
-	0xc8, 0x26, 0xbc, 0x92, 0x0a, 0xe1, 0xc2, 0x15, 0x6d, 0x2e, 0x59, 0xe7, 0xd7, 0x2f, 0xf5, 0x60,
-	0x7d, 0x20, 0x41, 0xce, 0x7c, 0x94, 0xfb, 0x46, 0x0b, 0x26, 0x3b, 0x4c, 0x78, 0x2d, 0xbd, 0x12,
-	0x67, 0x61, 0x43, 0xff, 0xeb, 0x8f, 0xb5, 0x33, 0x44, 0x70, 0xa7, 0xd1, 0x68, 0x79, 0x9c, 0x3f,
-	0x10, 0xad, 0x20, 0xf4, 0x1d, 0x05, 0xc3, 0x9b, 0x50, 0x6d, 0x78, 0x11, 0xe3, 0x81, 0x60, 0x2d,
-	0x7d, 0x7c, 0x80, 0x4d, 0x17, 0x8a, 0x9b, 0x00, 0xdd, 0x9a, 0xd0, 0x27, 0x64, 0x02, 0x96, 0x13,
-	0xa9, 0x71, 0x01, 0x59, 0xaa, 0xd0, 0xa8, 0x80, 0xac, 0x2d, 0xd7, 0xf7, 0x28, 0x56, 0x27, 0x63,
-	0x69, 0xfe, 0xae, 0xc1, 0xb9, 0x62, 0x46, 0x28, 0xc3, 0x37, 0xa0, 0x9a, 0x04, 0x17, 0x27, 0x63,
-	0xbc, 0x5f, 0x8a, 0xbb, 0x48, 0xbc, 0x9f, 0x53, 0x56, 0x91, 0xca, 0x56, 0x06, 0x2a, 0x53, 0x3e,
-	0x73, 0xd2, 0x76, 0xe1, 0x55, 0xa9, 0xec, 0x33, 0x26, 0xbc, 0x61, 0xeb, 0x65, 0xd4, 0xfc, 0x9b,
-	0xb7, 0xe1, 0xb5, 0x8c, 0x13, 0x8a, 0x7c, 0x05, 0x26, 0xe2, 0x5d, 0xaa, 0xab, 0xd3, 0x85, 0xa0,
-	0x25, 0x54, 0x02, 0xcc, 0xc7, 0x19, 0x6b, 0x3e, 0xb4, 0xc6, 0xcd, 0x92, 0x0c, 0x3d, 0xcf, 0xd9,
-	0xfd, 0xa0, 0x01, 0x66, 0xdd, 0x93, 0xfa, 0x37, 0x55, 0x0a, 0x92, 0x33, 0x2b, 0x95, 0xaf, 0x10,
-	0x2f, 0xee, 0xac, 0x6e, 0x90, 0x92, 0x2d, 0xb7, 0xe5, 0xee, 0xe5, 0x32, 0x21, 0x17, 0xb6, 0xc5,
-	0x7e, 0xe4, 0x51, 0x63, 0x00, 0xb5, 0xf4, 0x70, 0x3f, 0xf2, 0xcc, 0x5f, 0x2b, 0x70, 0x3a, 0x67,
-	0x47, 0x21, 0xdc, 0x83, 0xb9, 0x0e, 0x13, 0x41, 0xe8, 0x6f, 0x2b, 0x30, 0x9d, 0xc4, 0x85, 0x93,
-	0xa1, 0x04, 0xa1, 0xaf, 0x6c, 0x37, 0x2a, 0xba, 0xe6, 0xcc, 0x76, 0x32, 0x2b, 0x78, 0x1f, 0xe6,
-	0xe9, 0xc2, 0x24, 0x34, 0x2a, 0xc2, 0x8b, 0x05, 0x9a, 0x7b, 0x0a, 0x94, 0xe1, 0x99, 0x6b, 0x64,
-	0x97, 0xf0, 0x0e, 0xcc, 0x0a, 0xb7, 0xd9, 0xdc, 0x4f, 0x68, 0xc6, 0x25, 0x8d, 0x51, 0xa0, 0x79,
-	0x18, 0x43, 0x32, 0x24, 0x33, 0xa2, 0xbb, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xae, 0x9e, 0x2d,
-	0xde, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x0d, 0x5d, 0x5a, 0xb9, 0x76, 0x52,
-	0x19, 0xba, 0x9d, 0x98, 0x1f, 0x51, 0x7f, 0x4e, 0xfd, 0xd1, 0x41, 0xbc, 0x03, 0xa7, 0x08, 0x44,
-	0x47, 0x70, 0xae, 0x3c, 0x77, 0x4e, 0x02, 0x33, 0xbf, 0xc9, 0x33, 0xbd, 0xfc, 0x5b, 0xf1, 0x54,
-	0xa3, 0x1e, 0xdf, 0x55, 0x40, 0xc1, 0xac, 0xc3, 0x34, 0xa9, 0x4c, 0xee, 0x46, 0xaf, 0x68, 0x52,
-	0xdc, 0x8b, 0xbb, 0x21, 0xef, 0xc2, 0x82, 0x54, 0x25, 0xab, 0xc4, 0xf1, 0x78, 0xbb, 0x29, 0x46,
-	0x18, 0x82, 0xfa, 0x49, 0xdb, 0xf4, 0x84, 0x26, 0x65, 0x9d, 0xd1, 0xf9, 0x94, 0x16, 0x25, 0x99,
-	0x28, 0xe0, 0xfa, 0x71, 0x15, 0x26, 0x25, 0x1d, 0x7e, 0xa7, 0xc1, 0x6c, 0x76, 0xa4, 0xe3, 0x4a,
-	0xc1, 0xba, 0xd7, 0x83, 0xc0, 0x58, 0x1d, 0x0c, 0x54, 0xfa, 0xcc, 0xa5, 0x27, 0x7f, 0xff, 0xf7,
-	0x4b, 0xe5, 0x12, 0x5e, 0xb0, 0xf3, 0x6f, 0x92, 0xec, 0xf3, 0x00, 0xbf, 0xd5, 0x60, 0x3a, 0x99,
-	0x25, 0xb8, 0x54, 0xc6, 0x5d, 0x78, 0x38, 0x18, 0x6f, 0xf4, 0x07, 0x91, 0x73, 0x4b, 0x3a, 0x5f,
-	0xc5, 0xe5, 0x82, 0xf3, 0x74, 0x5a, 0xd9, 0x07, 0x99, 0xcc, 0x1f, 0xe2, 0xd7, 0x50, 0x4d, 0xe7,
-	0x20, 0xf6, 0x75, 0x91, 0xd4, 0xb5, 0x71, 0x65, 0x00, 0x8a, 0x94, 0x2c, 0x4a, 0x25, 0x06, 0xea,
-	0xbd, 0x94, 0xe0, 0xf7, 0x1a, 0x4c, 0xc4, 0xbd, 0x19, 0x2f, 0x97, 0x31, 0x66, 0x86, 0xa0, 0xb1,
-	0xd8, 0x1b, 0x40, 0xde, 0x6e, 0x4b, 0x6f, 0x37, 0xf1, 0xfa, 0x70, 0x71, 0xdb, 0x72, 0x1a, 0xd8,
-	0x07, 0x72, 0x24, 0x1e, 0xe2, 0x13, 0x0d, 0x26, 0xe5, 0x48, 0xc1, 0x9e, 0x9e, 0xd2, 0xf0, 0x5f,
-	0xef, 0x83, 0x20, 0x31, 0xd7, 0xa5, 0x18, 0x0b, 0xdf, 0x1e, 0x45, 0x0c, 0x3e, 0x86, 0x29, 0x6a,
-	0x9d, 0xa5, 0x2e, 0x72, 0x83, 0xc6, 0x30, 0xfb, 0x41, 0x48, 0xc6, 0x55, 0x29, 0xe3, 0x0a, 0x2e,
-	0x15, 0x65, 0x48, 0x98, 0x7d, 0x90, 0x99, 0x54, 0x87, 0xf8, 0x9b, 0x06, 0xa7, 0xa8, 0x19, 0x60,
-	0x29, 0x79, 0xbe, 0x31, 0x1b, 0x4b, 0x7d, 0x31, 0xa4, 0xe0, 0xae, 0x54, 0xf0, 0x3e, 0xbe, 0x37,
-	0x64, 0x22, 0x92, 0x26, 0x64, 0x1f, 0xa4, 0x8d, 0xfa, 0x10, 0x7f, 0xd4, 0x60, 0x3a, 0xe9, 0x6c,
-	0xd8, 0xcf, 0x2d, 0xef, 0x7b, 0x55, 0x8a, 0xcd, 0xd1, 0xbc, 0x25, 0xc5, 0xd5, 0xd1, 0x1e, 0x51,
-	0x1c, 0x3e, 0xd5, 0x60, 0x26, 0xd3, 0x65, 0x70, 0xb9, 0xcc, 0xdd, 0xc9, 0xae, 0x67, 0xac, 0x0c,
-	0xc4, 0x3d, 0x67, 0xfd, 0xc8, 0x2e, 0xb7, 0xf1, 0xe1, 0x9f, 0x47, 0x35, 0xed, 0xd9, 0x51, 0x4d,
-	0xfb, 0xf7, 0xa8, 0xa6, 0xfd, 0x7c, 0x5c, 0x1b, 0x7b, 0x76, 0x5c, 0x1b, 0xfb, 0xe7, 0xb8, 0x36,
-	0xf6, 0xf9, 0x55, 0x3f, 0x10, 0x5f, 0xb4, 0x77, 0xac, 0x5d, 0xb6, 0x97, 0x30, 0xaa, 0x9f, 0x35,
-	0xde, 0xf8, 0xd2, 0xfe, 0x4a, 0xd2, 0xc7, 0x55, 0xc0, 0xe3, 0x7f, 0x5f, 0x53, 0xf2, 0xcf, 0xd1,
-	0xb5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfb, 0x7f, 0x51, 0xc6, 0x0d, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0xd3, 0x3f, 0x6b, 0x4e, 0xda, 0xc2, 0xce, 0xda, 0xd5, 0xf3, 0xd6, 0xb4, 0x73, 0x58,
+	0xdb, 0xb1, 0xd5, 0x26, 0xdd, 0x3f, 0x09, 0x36, 0xa1, 0x75, 0xa3, 0x03, 0x89, 0x87, 0xe2, 0x55,
+	0x3c, 0xf0, 0x12, 0xb9, 0x8d, 0x65, 0x2c, 0x52, 0x5f, 0x2f, 0xf7, 0x26, 0xa2, 0x74, 0x15, 0x62,
+	0x12, 0x7f, 0x9e, 0x00, 0x89, 0x09, 0x90, 0xf8, 0x1a, 0x7c, 0x08, 0x1e, 0x27, 0x78, 0xe1, 0x11,
+	0xb5, 0x7c, 0x10, 0xe4, 0xeb, 0xe3, 0xc4, 0x76, 0xe3, 0x24, 0x9d, 0x26, 0x9e, 0x22, 0xdf, 0xfb,
+	0x3b, 0xbf, 0xf3, 0x3b, 0xe7, 0x9e, 0x7b, 0xce, 0x0d, 0x5c, 0xd8, 0x65, 0x7c, 0x8f, 0x71, 0xd3,
+	0x65, 0x6d, 0xb3, 0x5d, 0x35, 0x9f, 0xb4, 0x9c, 0xe6, 0xbe, 0x11, 0x34, 0x99, 0x60, 0x38, 0x1d,
+	0x6d, 0x19, 0x2e, 0x6b, 0x1b, 0xed, 0xaa, 0xf6, 0x26, 0x21, 0x77, 0x6c, 0xee, 0x44, 0x38, 0xb3,
+	0x5d, 0xdd, 0x71, 0x84, 0x5d, 0x35, 0x03, 0xdb, 0xf5, 0x7c, 0x5b, 0x78, 0xcc, 0x8f, 0x4c, 0xb5,
+	0x4b, 0x2e, 0x63, 0x6e, 0xc3, 0x31, 0xed, 0xc0, 0x33, 0x6d, 0xdf, 0x67, 0x42, 0x6e, 0x72, 0xda,
+	0x9d, 0x4f, 0xfb, 0x0c, 0xf9, 0xa3, 0x0d, 0x12, 0x53, 0x93, 0x5f, 0x26, 0xb9, 0x97, 0x1f, 0xba,
+	0x06, 0xea, 0x47, 0xa1, 0xcf, 0x07, 0xcc, 0xe7, 0xc2, 0x13, 0xad, 0x90, 0xcf, 0x72, 0x9e, 0xb4,
+	0x1c, 0x2e, 0xf4, 0x77, 0xe1, 0x42, 0x8f, 0x3d, 0x1e, 0x30, 0x9f, 0x3b, 0xa8, 0xc3, 0xd4, 0x6e,
+	0x62, 0x5d, 0x55, 0x96, 0x94, 0xd5, 0xa2, 0x95, 0x5a, 0xd3, 0xef, 0xc0, 0xac, 0x24, 0xd8, 0x6a,
+	0xb2, 0x80, 0x71, 0xbb, 0x41, 0xc4, 0xb8, 0x08, 0xa5, 0x80, 0x96, 0x6a, 0x5e, 0x5d, 0x9a, 0x8e,
+	0x59, 0x10, 0x2f, 0x7d, 0x50, 0xd7, 0x3f, 0x84, 0xb9, 0x8c, 0x21, 0x79, 0xbd, 0x01, 0x93, 0x31,
+	0x4c, 0x9a, 0x95, 0xd6, 0xe7, 0x8d, 0x54, 0x3a, 0x8d, 0x8e, 0x49, 0x07, 0xa8, 0xff, 0x50, 0xc8,
+	0xd0, 0xf1, 0x58, 0xc8, 0x26, 0xbc, 0xd6, 0x11, 0xc2, 0x85, 0x2d, 0x5a, 0x5c, 0xb2, 0xce, 0xac,
+	0x2f, 0xe4, 0xb0, 0x3e, 0x96, 0x20, 0x6b, 0x26, 0x48, 0x7d, 0xa3, 0x01, 0xe3, 0x6d, 0x26, 0x9c,
+	0xa6, 0x5a, 0x08, 0xb3, 0xb0, 0xa1, 0xfe, 0xf9, 0xfb, 0xda, 0x2c, 0x11, 0xdc, 0xaf, 0xd7, 0x9b,
+	0x0e, 0xe7, 0x8f, 0x45, 0xd3, 0xf3, 0x5d, 0x2b, 0x82, 0xe1, 0x6d, 0x28, 0xd6, 0x9d, 0x80, 0x71,
+	0x4f, 0xb0, 0xa6, 0x3a, 0x3a, 0xc0, 0xa6, 0x0b, 0xc5, 0x4d, 0x80, 0x6e, 0x4d, 0xa8, 0x63, 0x32,
+	0x01, 0xcb, 0xb1, 0xd4, 0xb0, 0x80, 0x8c, 0xa8, 0xd0, 0xa8, 0x80, 0x8c, 0x2d, 0xdb, 0x75, 0x28,
+	0x56, 0x2b, 0x61, 0xa9, 0xff, 0xaa, 0xc0, 0xf9, 0x6c, 0x46, 0x28, 0xc3, 0xb7, 0xa0, 0x18, 0x07,
+	0x17, 0x26, 0x63, 0xb4, 0x5f, 0x8a, 0xbb, 0x48, 0x7c, 0x94, 0x52, 0x56, 0x90, 0xca, 0x56, 0x06,
+	0x2a, 0x8b, 0x7c, 0xa6, 0xa4, 0xed, 0xc2, 0xeb, 0x52, 0xd9, 0xc7, 0x4c, 0x38, 0xc3, 0xd6, 0xcb,
+	0x69, 0xf3, 0xaf, 0xdf, 0x85, 0xb3, 0x09, 0x27, 0x14, 0xf9, 0x0a, 0x8c, 0x85, 0xbb, 0x54, 0x57,
+	0xe7, 0x32, 0x41, 0x4b, 0xa8, 0x04, 0xe8, 0x4f, 0x13, 0xd6, 0x7c, 0x68, 0x8d, 0x9b, 0x3d, 0x32,
+	0xf4, 0x32, 0x67, 0xf7, 0x9d, 0x02, 0x98, 0x74, 0x4f, 0xea, 0xaf, 0x46, 0x29, 0x88, 0xcf, 0xac,
+	0xa7, 0xfc, 0x08, 0xf1, 0xea, 0xce, 0xea, 0x16, 0x29, 0xd9, 0xb2, 0x9b, 0xf6, 0x5e, 0x2a, 0x13,
+	0x72, 0xa1, 0x26, 0xf6, 0x03, 0x87, 0x1a, 0x03, 0x44, 0x4b, 0xdb, 0xfb, 0x81, 0xa3, 0xff, 0x5c,
+	0x80, 0x73, 0x29, 0x3b, 0x0a, 0xe1, 0x21, 0x4c, 0xb7, 0x99, 0xf0, 0x7c, 0xb7, 0x16, 0x81, 0xe9,
+	0x24, 0x2e, 0x9e, 0x0c, 0xc5, 0xf3, 0xdd, 0xc8, 0x76, 0xa3, 0xa0, 0x2a, 0xd6, 0x54, 0x3b, 0xb1,
+	0x82, 0x8f, 0x60, 0x86, 0x2e, 0x4c, 0x4c, 0x13, 0x45, 0x78, 0x29, 0x43, 0xf3, 0x30, 0x02, 0x25,
+	0x78, 0xa6, 0xeb, 0xc9, 0x25, 0xbc, 0x0f, 0x53, 0xc2, 0x6e, 0x34, 0xf6, 0x63, 0x9a, 0x51, 0x49,
+	0xa3, 0x65, 0x68, 0xb6, 0x43, 0x48, 0x82, 0xa4, 0x24, 0xba, 0x0b, 0xb8, 0x06, 0x13, 0x64, 0x1c,
+	0xdd, 0xd5, 0xb9, 0xec, 0x4d, 0x8a, 0x12, 0x40, 0x20, 0xdd, 0xa7, 0xbc, 0x90, 0xb4, 0xa1, 0x4b,
+	0x2b, 0xd5, 0x4e, 0x0a, 0x43, 0xb7, 0x13, 0xfd, 0x7d, 0x98, 0x4d, 0xfb, 0xa3, 0x83, 0x78, 0x0b,
+	0xce, 0x10, 0x88, 0x8e, 0xe0, 0x7c, 0xef, 0xdc, 0x59, 0x31, 0x4c, 0xff, 0x32, 0xcd, 0xf4, 0xff,
+	0xdf, 0x8a, 0xe7, 0x0a, 0xcc, 0x65, 0x14, 0x50, 0x30, 0xeb, 0x30, 0x49, 0x2a, 0xe3, 0xbb, 0x91,
+	0x17, 0x4d, 0x07, 0xf7, 0xea, 0x6e, 0xc8, 0xdb, 0x30, 0x2f, 0x55, 0xc9, 0x2a, 0xb1, 0x1c, 0xde,
+	0x6a, 0x88, 0x53, 0x0c, 0x41, 0xf5, 0xa4, 0x6d, 0xe7, 0x84, 0xc6, 0x65, 0x9d, 0xa9, 0x4a, 0x7e,
+	0x51, 0x92, 0x49, 0x04, 0xd4, 0x17, 0x61, 0x21, 0xd5, 0xf1, 0xb7, 0x9d, 0xbd, 0xa0, 0x61, 0x77,
+	0x1b, 0x98, 0x5e, 0x83, 0x72, 0x1e, 0x80, 0x9c, 0xde, 0x83, 0xa2, 0x88, 0x17, 0x29, 0x95, 0x8b,
+	0x39, 0xa3, 0x21, 0x36, 0xb6, 0xba, 0x16, 0xeb, 0x5f, 0x95, 0x60, 0x5c, 0x7a, 0xc0, 0x6f, 0x14,
+	0x98, 0x4a, 0x3e, 0x2a, 0x70, 0x25, 0x43, 0x93, 0xf7, 0x24, 0xd1, 0x56, 0x07, 0x03, 0x23, 0xb1,
+	0x7a, 0xe5, 0xd9, 0x5f, 0xff, 0xfe, 0x54, 0x58, 0xc0, 0x8b, 0x66, 0xfa, 0x55, 0x94, 0x7c, 0xa0,
+	0xe0, 0xd7, 0x0a, 0x4c, 0xc6, 0x92, 0xb1, 0xd2, 0x8b, 0x3b, 0xf3, 0x74, 0xd1, 0xde, 0xe8, 0x0f,
+	0x22, 0xe7, 0x86, 0x74, 0xbe, 0x8a, 0xcb, 0x19, 0xe7, 0x9d, 0x79, 0x69, 0x1e, 0x24, 0xce, 0xfe,
+	0x10, 0xbf, 0x80, 0x62, 0xcc, 0xc1, 0xb1, 0xaf, 0x8b, 0xf8, 0xb8, 0xb4, 0x2b, 0x03, 0x50, 0xa4,
+	0x64, 0x49, 0x2a, 0xd1, 0x50, 0xcd, 0x53, 0x82, 0xdf, 0x2a, 0x30, 0x16, 0x4e, 0x07, 0x5c, 0xec,
+	0xc5, 0x98, 0x18, 0xc3, 0xda, 0x52, 0x3e, 0x80, 0xbc, 0xdd, 0x95, 0xde, 0x6e, 0xe3, 0xcd, 0xe1,
+	0xe2, 0x36, 0xe5, 0x3c, 0x32, 0x0f, 0xc2, 0x9f, 0xe6, 0x21, 0x3e, 0x53, 0x60, 0x3c, 0xa4, 0xe3,
+	0x98, 0xeb, 0xa9, 0x13, 0xfe, 0xe5, 0x3e, 0x08, 0x12, 0x73, 0x53, 0x8a, 0x31, 0xf0, 0xfa, 0x69,
+	0xc4, 0xe0, 0x53, 0x98, 0xa0, 0xe6, 0xdd, 0xd3, 0x45, 0x6a, 0xd4, 0x69, 0x7a, 0x3f, 0x08, 0xc9,
+	0xb8, 0x26, 0x65, 0x5c, 0xc1, 0x4a, 0x56, 0x86, 0x84, 0x99, 0x07, 0x89, 0x59, 0x79, 0x88, 0xbf,
+	0x28, 0x70, 0x86, 0xda, 0x11, 0xf6, 0x24, 0x4f, 0x8f, 0x06, 0xad, 0xd2, 0x17, 0x43, 0x0a, 0x1e,
+	0x48, 0x05, 0xf7, 0xf0, 0x9d, 0x21, 0x13, 0x11, 0xb7, 0x41, 0xf3, 0xa0, 0x33, 0x2a, 0x0e, 0xf1,
+	0x7b, 0x05, 0x26, 0x89, 0x98, 0x63, 0x3f, 0xb7, 0xbc, 0xef, 0x55, 0xc9, 0xb6, 0x67, 0xfd, 0x8e,
+	0x14, 0x57, 0x45, 0xf3, 0x94, 0xe2, 0xf0, 0xb9, 0x02, 0xa5, 0x44, 0x9f, 0xc3, 0xe5, 0x5e, 0xee,
+	0x4e, 0xf6, 0x5d, 0x6d, 0x65, 0x20, 0xee, 0x25, 0xeb, 0x47, 0xf6, 0x59, 0xfc, 0x4d, 0x81, 0xb3,
+	0x27, 0x5a, 0x28, 0x5e, 0xef, 0x77, 0x5b, 0xb3, 0xad, 0x58, 0x5b, 0x1b, 0x12, 0x4d, 0x42, 0xaf,
+	0x4a, 0xa1, 0x15, 0xbc, 0x9c, 0x23, 0xb4, 0xd6, 0xe9, 0xc1, 0x1b, 0xef, 0xfd, 0x71, 0x54, 0x56,
+	0x5e, 0x1c, 0x95, 0x95, 0x7f, 0x8e, 0xca, 0xca, 0x8f, 0xc7, 0xe5, 0x91, 0x17, 0xc7, 0xe5, 0x91,
+	0xbf, 0x8f, 0xcb, 0x23, 0x9f, 0x5c, 0x73, 0x3d, 0xf1, 0x69, 0x6b, 0xc7, 0xd8, 0x65, 0x7b, 0x31,
+	0x4d, 0xf4, 0xb3, 0xc6, 0xeb, 0x9f, 0x99, 0x9f, 0x4b, 0xce, 0xb0, 0x46, 0x79, 0xf8, 0xef, 0x74,
+	0x42, 0xfe, 0x79, 0xbc, 0xf1, 0xdf, 0x00, 0x8a, 0x43, 0x29, 0xf0, 0xe6, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1185,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalTemplates queries the templates of the common proposal messages,
+	// which are validated against the template upon submission.
+	ProposalTemplates(ctx context.Context, in *QueryProposalTemplatesRequest, opts ...grpc.CallOption) (*QueryProposalTemplatesResponse, error)
 }
 
 type queryClient struct {
@@ -1187,6 +1279,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalTemplates(ctx context.Context, in *QueryProposalTemplatesRequest, opts ...grpc.CallOption) (*QueryProposalTemplatesResponse, error) {
+	out := new(QueryProposalTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1207,6 +1308,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalTemplates queries the templates of the common proposal messages,
+	// which are validated against the template upon submission.
+	ProposalTemplates(context.Context, *QueryProposalTemplatesRequest) (*QueryProposalTemplatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1240,6 +1344,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalTemplates(ctx context.Context, req *QueryProposalTemplatesRequest) (*QueryProposalTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTemplates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1407,6 +1514,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTemplates(ctx, req.(*QueryProposalTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1447,6 +1572,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalTemplates",
+			Handler:    _Query_ProposalTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2153,6 +2282,66 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2445,6 +2634,30 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposalTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4282,6 +4495,140 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &ProposalTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
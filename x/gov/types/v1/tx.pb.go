@@ -60,6 +60,10 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// emergency defines if the proposal is an emergency proposal fast-tracked by
+	// the security council. Emergency proposals enter the voting period
+	// immediately and cannot be expedited.
+	Emergency bool `protobuf:"varint,8,opt,name=emergency,proto3" json:"emergency,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -144,6 +148,13 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetEmergency() bool {
+	if m != nil {
+		return m.Emergency
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -844,73 +855,73 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1042 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x4d, 0xa2, 0xac, 0xdc, 0x76, 0xbd, 0x2a, 0x6b, 0x77, 0x8b,
-	0xc0, 0x4a, 0xc8, 0x2e, 0x0e, 0xb4, 0x42, 0xa6, 0x42, 0xaa, 0x43, 0x05, 0x95, 0x30, 0x54, 0x5b,
-	0x28, 0x12, 0x42, 0x8a, 0xc6, 0xde, 0x61, 0xb3, 0xc2, 0xbb, 0xb3, 0xf2, 0x8c, 0xad, 0xf8, 0x86,
-	0x38, 0xf6, 0xd4, 0x3f, 0x83, 0x63, 0x0e, 0xbd, 0xf5, 0xc4, 0xad, 0xe2, 0x54, 0x71, 0xe2, 0x54,
-	0x50, 0x22, 0x08, 0xe2, 0x9f, 0x00, 0xcd, 0xc7, 0xae, 0xf7, 0x2b, 0x1f, 0x70, 0xe0, 0x62, 0xed,
-	0xfc, 0xde, 0xc7, 0xbc, 0xf7, 0x7b, 0xf3, 0xde, 0x33, 0xb8, 0x36, 0xc0, 0x24, 0xc0, 0xc4, 0xf6,
-	0xf0, 0xc4, 0x9e, 0xb4, 0x6d, 0x7a, 0x68, 0x45, 0x23, 0x4c, 0xb1, 0xba, 0x26, 0x70, 0xcb, 0xc3,
-	0x13, 0x6b, 0xd2, 0xd6, 0x0d, 0xa9, 0xd6, 0x87, 0x04, 0xd9, 0x93, 0x76, 0x1f, 0x51, 0xd8, 0xb6,
-	0x07, 0xd8, 0x0f, 0x85, 0xba, 0x7e, 0x3d, 0xeb, 0x86, 0x59, 0x09, 0x41, 0xcd, 0xc3, 0x1e, 0xe6,
-	0x9f, 0x36, 0xfb, 0x92, 0x68, 0x5d, 0xa8, 0xef, 0x0b, 0x81, 0xbc, 0x4a, 0x8a, 0x3c, 0x8c, 0xbd,
-	0x21, 0xb2, 0xf9, 0xa9, 0x3f, 0xfe, 0xc6, 0x86, 0xe1, 0x34, 0x77, 0x49, 0x40, 0x3c, 0x76, 0x49,
-	0x40, 0x3c, 0x29, 0xd8, 0x84, 0x81, 0x1f, 0x62, 0x9b, 0xff, 0x4a, 0xa8, 0x91, 0x77, 0x43, 0xfd,
-	0x00, 0x11, 0x0a, 0x83, 0x48, 0x28, 0x98, 0xa7, 0xf3, 0x60, 0xb3, 0x47, 0xbc, 0x47, 0xe3, 0x7e,
-	0xe0, 0xd3, 0x87, 0x23, 0x1c, 0x61, 0x02, 0x87, 0xea, 0xdb, 0x60, 0x39, 0x40, 0x84, 0x40, 0x0f,
-	0x11, 0x4d, 0x69, 0x2e, 0xb4, 0x56, 0x77, 0x6b, 0x96, 0xf0, 0x64, 0xc5, 0x9e, 0xac, 0x7b, 0xe1,
-	0xd4, 0x49, 0xb4, 0xd4, 0x1e, 0xd8, 0xf0, 0x43, 0x9f, 0xfa, 0x70, 0xb8, 0xef, 0xa2, 0x08, 0x13,
-	0x9f, 0x6a, 0xf3, 0xdc, 0xb0, 0x6e, 0xc9, 0xbc, 0x18, 0x67, 0x96, 0xe4, 0xcc, 0xda, 0xc3, 0x7e,
-	0xd8, 0x5d, 0x79, 0xf1, 0xaa, 0x31, 0xf7, 0xc3, 0xe9, 0xd1, 0x96, 0xe2, 0xac, 0x4b, 0xe3, 0x0f,
-	0x85, 0xad, 0xfa, 0x2e, 0x58, 0x8e, 0x78, 0x30, 0x68, 0xa4, 0x2d, 0x34, 0x95, 0xd6, 0x4a, 0x57,
-	0xfb, 0xf9, 0xd9, 0x4e, 0x4d, 0xba, 0xba, 0xe7, 0xba, 0x23, 0x44, 0xc8, 0x23, 0x3a, 0xf2, 0x43,
-	0xcf, 0x49, 0x34, 0x55, 0x9d, 0x85, 0x4d, 0xa1, 0x0b, 0x29, 0xd4, 0x16, 0x99, 0x95, 0x93, 0x9c,
-	0xd5, 0x1a, 0x58, 0xa2, 0x3e, 0x1d, 0x22, 0x6d, 0x89, 0x0b, 0xc4, 0x41, 0xd5, 0x40, 0x95, 0x8c,
-	0x83, 0x00, 0x8e, 0xa6, 0x5a, 0x85, 0xe3, 0xf1, 0x51, 0xbd, 0x01, 0x56, 0xd0, 0x61, 0x84, 0x5c,
-	0x9f, 0x22, 0x57, 0xab, 0x36, 0x95, 0xd6, 0xb2, 0x33, 0x03, 0x3a, 0xed, 0xef, 0x4f, 0x8f, 0xb6,
-	0x92, 0x8b, 0x9f, 0x9c, 0x1e, 0x6d, 0x35, 0x44, 0x6c, 0x3b, 0xc4, 0xfd, 0x96, 0x55, 0xa5, 0xc0,
-	0xa9, 0x79, 0x17, 0xd4, 0x0b, 0xa0, 0x83, 0x48, 0x84, 0x43, 0x82, 0xd4, 0x06, 0x58, 0x8d, 0x24,
-	0xb6, 0xef, 0xbb, 0x9a, 0xd2, 0x54, 0x5a, 0x8b, 0x0e, 0x88, 0xa1, 0x07, 0xae, 0xf9, 0x5c, 0x01,
-	0xb5, 0x1e, 0xf1, 0xee, 0x1f, 0xa2, 0xc1, 0x27, 0xc8, 0x83, 0x83, 0xe9, 0x1e, 0x0e, 0x29, 0x0a,
-	0xa9, 0xfa, 0x29, 0xa8, 0x0e, 0xc4, 0x27, 0xb7, 0x3a, 0xa3, 0x52, 0x5d, 0xe3, 0xa7, 0x67, 0x3b,
-	0x7a, 0xe6, 0x31, 0xc7, 0x85, 0xe0, 0xb6, 0x4e, 0xec, 0x84, 0xe5, 0x0d, 0xc7, 0xf4, 0x00, 0x8f,
-	0x7c, 0x3a, 0xd5, 0xe6, 0x39, 0x27, 0x33, 0xa0, 0x73, 0x9b, 0xe5, 0x3d, 0x3b, 0xb3, 0xc4, 0xcd,
-	0x42, 0xe2, 0x85, 0x20, 0x4d, 0x03, 0xdc, 0x28, 0xc3, 0xe3, 0xf4, 0xcd, 0xdf, 0x15, 0x50, 0xed,
-	0x11, 0xef, 0x31, 0xa6, 0x48, 0xbd, 0x5d, 0x42, 0x45, 0xb7, 0xf6, 0xd7, 0xab, 0x46, 0x1a, 0x16,
-	0xaf, 0x26, 0x45, 0x90, 0x6a, 0x81, 0xa5, 0x09, 0xa6, 0x68, 0x24, 0x62, 0x3e, 0xe7, 0xb9, 0x08,
-	0x35, 0xb5, 0x0d, 0x2a, 0x38, 0xa2, 0x3e, 0x0e, 0xf9, 0xfb, 0x5a, 0x9f, 0xbd, 0x53, 0xc1, 0x8e,
-	0xc5, 0x62, 0xf9, 0x8c, 0x2b, 0x38, 0x52, 0xf1, 0xbc, 0xe7, 0xd5, 0x79, 0x9d, 0x11, 0x23, 0x5c,
-	0x33, 0x52, 0xae, 0x16, 0x48, 0x61, 0xfe, 0xcc, 0x4d, 0xb0, 0x21, 0x3f, 0x93, 0xd4, 0xff, 0x56,
-	0x12, 0xec, 0x4b, 0xe4, 0x7b, 0x07, 0x14, 0xb9, 0xff, 0x17, 0x05, 0xef, 0x83, 0xaa, 0xc8, 0x8c,
-	0x68, 0x0b, 0xbc, 0x57, 0x6f, 0xe6, 0x38, 0x88, 0x03, 0x4a, 0x71, 0x11, 0x5b, 0x9c, 0x4b, 0xc6,
-	0x5b, 0x59, 0x32, 0x5e, 0x2b, 0x25, 0x23, 0x76, 0x6e, 0xd6, 0xc1, 0xf5, 0x1c, 0x94, 0x90, 0xf3,
-	0x87, 0x02, 0x40, 0x8f, 0x78, 0xf1, 0x54, 0xf8, 0x8f, 0xbc, 0xdc, 0x01, 0x2b, 0x72, 0x26, 0xe1,
-	0x8b, 0xb9, 0x99, 0xa9, 0xaa, 0x77, 0x41, 0x05, 0x06, 0x78, 0x1c, 0x52, 0x49, 0xcf, 0xe5, 0x46,
-	0x99, 0xb4, 0xe9, 0x6c, 0xf3, 0x56, 0x49, 0xbc, 0x31, 0x22, 0xb4, 0x02, 0x11, 0x32, 0x33, 0xb3,
-	0x06, 0xd4, 0xd9, 0x29, 0x49, 0xff, 0xb9, 0x78, 0x1b, 0x5f, 0x44, 0x2e, 0xa4, 0xe8, 0x21, 0x1c,
-	0xc1, 0x80, 0xb0, 0x64, 0x66, 0xfd, 0xa9, 0x5c, 0x94, 0x4c, 0xa2, 0xaa, 0xbe, 0x07, 0x2a, 0x11,
-	0xf7, 0xc0, 0x19, 0x58, 0xdd, 0xbd, 0x9a, 0xab, 0xb5, 0x70, 0x9f, 0x49, 0x44, 0xe8, 0x77, 0xee,
-	0x14, 0x7b, 0xfe, 0x56, 0x2a, 0x91, 0xc3, 0x78, 0xdb, 0xe5, 0x22, 0x95, 0x75, 0x4d, 0x43, 0x49,
-	0x62, 0x4f, 0x14, 0xbe, 0x75, 0xf6, 0x60, 0x38, 0x40, 0xc3, 0xd4, 0xd6, 0x29, 0x29, 0xef, 0x46,
-	0xae, 0xbc, 0x99, 0xca, 0xa6, 0xd7, 0xc4, 0xfc, 0x65, 0xd7, 0x44, 0x67, 0x2d, 0x33, 0xbc, 0xcd,
-	0x1f, 0x15, 0x3e, 0x99, 0xb3, 0xc1, 0x24, 0x93, 0xf9, 0xdf, 0x07, 0xf5, 0x00, 0xac, 0x0d, 0xb8,
-	0x2f, 0xe4, 0xee, 0xb3, 0x75, 0x2b, 0x09, 0xd7, 0x0b, 0x73, 0xf9, 0xf3, 0x78, 0x17, 0x77, 0x97,
-	0x19, 0xeb, 0x4f, 0x7f, 0x6d, 0x28, 0xce, 0x95, 0xd8, 0x94, 0x09, 0xd5, 0x37, 0xc1, 0x46, 0xe2,
-	0xea, 0x80, 0x37, 0x07, 0x9f, 0x56, 0x8b, 0xce, 0x7a, 0x0c, 0x7f, 0xcc, 0xd1, 0xdd, 0x3f, 0x17,
-	0xc1, 0x42, 0x8f, 0x78, 0xea, 0xd7, 0x60, 0x3d, 0xb7, 0xca, 0x9b, 0xb9, 0x3a, 0x17, 0x76, 0x90,
-	0xde, 0xba, 0x48, 0x23, 0xe1, 0x02, 0x81, 0xcd, 0xe2, 0x02, 0xba, 0x55, 0x34, 0x2f, 0x28, 0xe9,
-	0xdb, 0x97, 0x50, 0x4a, 0xae, 0xf9, 0x00, 0x2c, 0xf2, 0x4d, 0x70, 0xad, 0x68, 0xc4, 0x70, 0xdd,
-	0x28, 0xc7, 0x13, 0xfb, 0xc7, 0xe0, 0x4a, 0x66, 0x9c, 0x9e, 0xa1, 0x1f, 0xcb, 0xf5, 0x37, 0xce,
-	0x97, 0x27, 0x7e, 0x3f, 0x02, 0xd5, 0x78, 0x12, 0xd5, 0x8b, 0x26, 0x52, 0xa4, 0xdf, 0x3c, 0x53,
-	0x94, 0x0e, 0x30, 0xd3, 0xd3, 0x25, 0x01, 0xa6, 0xe5, 0x65, 0x01, 0x96, 0xb5, 0x15, 0xab, 0x7e,
-	0xae, 0xa5, 0x4a, 0xaa, 0x9f, 0xd5, 0x28, 0xab, 0x7e, 0x79, 0x27, 0xe8, 0x4b, 0xdf, 0xb1, 0xb1,
-	0xd0, 0xbd, 0xff, 0xe2, 0xd8, 0x50, 0x5e, 0x1e, 0x1b, 0xca, 0x6f, 0xc7, 0x86, 0xf2, 0xf4, 0xc4,
-	0x98, 0x7b, 0x79, 0x62, 0xcc, 0xfd, 0x72, 0x62, 0xcc, 0x7d, 0xb5, 0xed, 0xf9, 0xf4, 0x60, 0xdc,
-	0xb7, 0x06, 0x38, 0x90, 0x7f, 0x66, 0xed, 0xc2, 0x9c, 0xa0, 0xd3, 0x08, 0x11, 0xf6, 0xd7, 0xb9,
-	0xc2, 0xdb, 0xe0, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xda, 0xbe, 0x64, 0x9b, 0x7a, 0x0b,
-	0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xae, 0xfb, 0x23, 0x49, 0x6f, 0xd7, 0x54, 0xb5, 0xb2, 0xcd, 0xb1, 0x86, 0x93, 0x79, 0x68,
+	0x44, 0x2d, 0xb5, 0x49, 0x61, 0x13, 0x0a, 0x13, 0xd2, 0x52, 0x26, 0x98, 0x44, 0x60, 0xf2, 0x60,
+	0x48, 0x68, 0x52, 0xe5, 0xc4, 0x97, 0x5b, 0x8b, 0xd8, 0xd7, 0xf2, 0xbd, 0x89, 0x9a, 0x37, 0x84,
+	0xc4, 0xcb, 0x9e, 0xf6, 0x67, 0xf0, 0xd8, 0x87, 0xbd, 0xed, 0x89, 0xb7, 0x89, 0xa7, 0x89, 0x27,
+	0x9e, 0x06, 0x6a, 0x05, 0x45, 0xfc, 0x13, 0xa0, 0xfb, 0xc3, 0x4e, 0x62, 0xa7, 0x4d, 0xe1, 0x61,
+	0x2f, 0x91, 0xfd, 0x9d, 0xef, 0x1c, 0x9f, 0xf3, 0x9d, 0x7b, 0xcf, 0x09, 0xb8, 0xd2, 0xc3, 0x24,
+	0xc0, 0xc4, 0x46, 0x78, 0x68, 0x0f, 0x9b, 0x36, 0x3d, 0xb4, 0xa2, 0x18, 0x53, 0xac, 0xae, 0x0b,
+	0xdc, 0x42, 0x78, 0x68, 0x0d, 0x9b, 0xba, 0x21, 0x69, 0x5d, 0x97, 0x40, 0x7b, 0xd8, 0xec, 0x42,
+	0xea, 0x36, 0xed, 0x1e, 0xf6, 0x43, 0x41, 0xd7, 0xaf, 0x4e, 0x87, 0x61, 0x5e, 0xc2, 0x50, 0x41,
+	0x18, 0x61, 0xfe, 0x68, 0xb3, 0x27, 0x89, 0x56, 0x05, 0x7d, 0x5f, 0x18, 0xe4, 0xa7, 0xa4, 0x09,
+	0x61, 0x8c, 0xfa, 0xd0, 0xe6, 0x6f, 0xdd, 0xc1, 0x37, 0xb6, 0x1b, 0x8e, 0x32, 0x1f, 0x09, 0x08,
+	0x62, 0x1f, 0x09, 0x08, 0x92, 0x86, 0x4d, 0x37, 0xf0, 0x43, 0x6c, 0xf3, 0x5f, 0x09, 0xd5, 0xb2,
+	0x61, 0xa8, 0x1f, 0x40, 0x42, 0xdd, 0x20, 0x12, 0x04, 0xf3, 0x87, 0x25, 0xb0, 0xd9, 0x21, 0xe8,
+	0xe1, 0xa0, 0x1b, 0xf8, 0xf4, 0x41, 0x8c, 0x23, 0x4c, 0xdc, 0xbe, 0xfa, 0x0e, 0x28, 0x05, 0x90,
+	0x10, 0x17, 0x41, 0xa2, 0x29, 0xf5, 0xa5, 0xc6, 0xda, 0x6e, 0xc5, 0x12, 0x91, 0xac, 0x24, 0x92,
+	0x75, 0x37, 0x1c, 0x39, 0x29, 0x4b, 0xed, 0x80, 0x0d, 0x3f, 0xf4, 0xa9, 0xef, 0xf6, 0xf7, 0x3d,
+	0x18, 0x61, 0xe2, 0x53, 0x6d, 0x91, 0x3b, 0x56, 0x2d, 0x59, 0x17, 0xd3, 0xcc, 0x92, 0x9a, 0x59,
+	0x7b, 0xd8, 0x0f, 0xdb, 0xab, 0x2f, 0x5e, 0xd5, 0x16, 0x7e, 0x3c, 0x3d, 0xda, 0x52, 0x9c, 0xb2,
+	0x74, 0xfe, 0x48, 0xf8, 0xaa, 0xef, 0x81, 0x52, 0xc4, 0x93, 0x81, 0xb1, 0xb6, 0x54, 0x57, 0x1a,
+	0xab, 0x6d, 0xed, 0x97, 0x67, 0x3b, 0x15, 0x19, 0xea, 0xae, 0xe7, 0xc5, 0x90, 0x90, 0x87, 0x34,
+	0xf6, 0x43, 0xe4, 0xa4, 0x4c, 0x55, 0x67, 0x69, 0x53, 0xd7, 0x73, 0xa9, 0xab, 0x2d, 0x33, 0x2f,
+	0x27, 0x7d, 0x57, 0x2b, 0x60, 0x85, 0xfa, 0xb4, 0x0f, 0xb5, 0x15, 0x6e, 0x10, 0x2f, 0xaa, 0x06,
+	0x8a, 0x64, 0x10, 0x04, 0x6e, 0x3c, 0xd2, 0x0a, 0x1c, 0x4f, 0x5e, 0xd5, 0x6b, 0x60, 0x15, 0x1e,
+	0x46, 0xd0, 0xf3, 0x29, 0xf4, 0xb4, 0x62, 0x5d, 0x69, 0x94, 0x9c, 0x31, 0xc0, 0xad, 0x01, 0x8c,
+	0x11, 0x0c, 0x7b, 0x23, 0xad, 0x24, 0xad, 0x09, 0xd0, 0x6a, 0x7e, 0x7f, 0x7a, 0xb4, 0x95, 0xa6,
+	0xf5, 0xe4, 0xf4, 0x68, 0xab, 0x26, 0x32, 0xdf, 0x21, 0xde, 0xb7, 0xac, 0x67, 0x39, 0xc5, 0xcd,
+	0x3b, 0xa0, 0x9a, 0x03, 0x1d, 0x48, 0x22, 0x1c, 0x12, 0xa8, 0xd6, 0xc0, 0x5a, 0x24, 0xb1, 0x7d,
+	0xdf, 0xd3, 0x94, 0xba, 0xd2, 0x58, 0x76, 0x40, 0x02, 0xdd, 0xf7, 0xcc, 0xe7, 0x0a, 0xa8, 0x74,
+	0x08, 0xba, 0x77, 0x08, 0x7b, 0x9f, 0x42, 0xe4, 0xf6, 0x46, 0x7b, 0x38, 0xa4, 0x30, 0xa4, 0xea,
+	0x67, 0xa0, 0xd8, 0x13, 0x8f, 0xdc, 0xeb, 0x8c, 0x3e, 0xb6, 0x8d, 0x9f, 0x9f, 0xed, 0xe8, 0x53,
+	0x47, 0x3d, 0x69, 0x13, 0xf7, 0x75, 0x92, 0x20, 0xac, 0x6e, 0x77, 0x40, 0x0f, 0x70, 0xec, 0xd3,
+	0x91, 0xb6, 0xc8, 0x15, 0x1b, 0x03, 0xad, 0x5b, 0xac, 0xee, 0xf1, 0x3b, 0x2b, 0xdc, 0xcc, 0x15,
+	0x9e, 0x4b, 0xd2, 0x34, 0xc0, 0xb5, 0x59, 0x78, 0x52, 0xbe, 0xf9, 0x87, 0x02, 0x8a, 0x1d, 0x82,
+	0x1e, 0x61, 0x0a, 0xd5, 0x5b, 0x33, 0xa4, 0x68, 0x57, 0xfe, 0x7e, 0x55, 0x9b, 0x84, 0xc5, 0x99,
+	0x9a, 0x10, 0x48, 0xb5, 0xc0, 0xca, 0x10, 0x53, 0x18, 0x6b, 0x8b, 0x73, 0x0e, 0x93, 0xa0, 0xa9,
+	0x4d, 0x50, 0xc0, 0x11, 0xf5, 0x71, 0xc8, 0x4f, 0x5f, 0x79, 0x7c, 0x8a, 0x85, 0x3a, 0x16, 0xcb,
+	0xe5, 0x73, 0x4e, 0x70, 0x24, 0xf1, 0xbc, 0xc3, 0xd7, 0x7a, 0x93, 0x09, 0x23, 0x42, 0x33, 0x51,
+	0x2e, 0xe7, 0x44, 0x61, 0xf1, 0xcc, 0x4d, 0xb0, 0x21, 0x1f, 0xd3, 0xd2, 0xff, 0x51, 0x52, 0xec,
+	0x2b, 0xe8, 0xa3, 0x03, 0x76, 0xf6, 0x5e, 0x93, 0x04, 0x1f, 0x80, 0xa2, 0xa8, 0x8c, 0x68, 0x4b,
+	0xfc, 0x26, 0x5f, 0xcf, 0x68, 0x90, 0x24, 0x34, 0xa1, 0x45, 0xe2, 0x71, 0xae, 0x18, 0x6f, 0x4f,
+	0x8b, 0xf1, 0xc6, 0x4c, 0x31, 0x92, 0xe0, 0x66, 0x15, 0x5c, 0xcd, 0x40, 0xa9, 0x38, 0x7f, 0x2a,
+	0x00, 0x74, 0x08, 0x4a, 0x66, 0xc6, 0xff, 0xd4, 0xe5, 0x36, 0x58, 0x95, 0x13, 0x0b, 0xcf, 0xd7,
+	0x66, 0x4c, 0x55, 0xef, 0x80, 0x82, 0x1b, 0xe0, 0x41, 0x48, 0xa5, 0x3c, 0x17, 0x1b, 0x74, 0xd2,
+	0xa7, 0xb5, 0xcd, 0xaf, 0x4a, 0x1a, 0x8d, 0x09, 0xa1, 0xe5, 0x84, 0x90, 0x95, 0x99, 0x15, 0xa0,
+	0x8e, 0xdf, 0xd2, 0xf2, 0x9f, 0x8b, 0xb3, 0xf1, 0x65, 0xe4, 0xb9, 0x14, 0x3e, 0x70, 0x63, 0x37,
+	0x20, 0xac, 0x98, 0xf1, 0xfd, 0x54, 0xe6, 0x15, 0x93, 0x52, 0xd5, 0xf7, 0x41, 0x21, 0xe2, 0x11,
+	0xb8, 0x02, 0x6b, 0xbb, 0x97, 0x33, 0xbd, 0x16, 0xe1, 0xa7, 0x0a, 0x11, 0xfc, 0xd6, 0xed, 0xfc,
+	0x9d, 0xbf, 0x31, 0x51, 0xc8, 0x61, 0xb2, 0x0b, 0x33, 0x99, 0xca, 0xbe, 0x4e, 0x42, 0x69, 0x61,
+	0x4f, 0x14, 0xbe, 0x93, 0xf6, 0xdc, 0xb0, 0x07, 0xfb, 0x13, 0x3b, 0x69, 0x46, 0x7b, 0x37, 0x32,
+	0xed, 0x9d, 0xea, 0xec, 0xe4, 0x12, 0x59, 0xbc, 0xe8, 0x12, 0x69, 0xad, 0x4f, 0x0d, 0x6f, 0xf3,
+	0x27, 0x05, 0x54, 0x73, 0xc9, 0xa4, 0x93, 0xf9, 0xbf, 0x27, 0x75, 0x1f, 0xac, 0xf7, 0x78, 0x2c,
+	0xe8, 0xed, 0xb3, 0x65, 0x2c, 0x05, 0xd7, 0x73, 0x73, 0xf9, 0x8b, 0x64, 0x53, 0xb7, 0x4b, 0x4c,
+	0xf5, 0xa7, 0xbf, 0xd5, 0x14, 0xe7, 0x52, 0xe2, 0xca, 0x8c, 0xea, 0x5b, 0x60, 0x23, 0x0d, 0x75,
+	0xc0, 0x2f, 0x07, 0x9f, 0x56, 0xcb, 0x4e, 0x39, 0x81, 0x3f, 0xe1, 0xe8, 0xee, 0x5f, 0xcb, 0x60,
+	0xa9, 0x43, 0x90, 0xfa, 0x18, 0x94, 0x33, 0x8b, 0xbe, 0x9e, 0xe9, 0x73, 0x6e, 0x07, 0xe9, 0x8d,
+	0x79, 0x8c, 0x54, 0x0b, 0x08, 0x36, 0xf3, 0x0b, 0xe8, 0x46, 0xde, 0x3d, 0x47, 0xd2, 0xb7, 0x2f,
+	0x40, 0x4a, 0x3f, 0xf3, 0x21, 0x58, 0xe6, 0x9b, 0xe0, 0x4a, 0xde, 0x89, 0xe1, 0xba, 0x31, 0x1b,
+	0x4f, 0xfd, 0x1f, 0x81, 0x4b, 0x53, 0xe3, 0xf4, 0x0c, 0x7e, 0x62, 0xd7, 0x6f, 0x9e, 0x6f, 0x4f,
+	0xe3, 0x7e, 0x0c, 0x8a, 0xc9, 0x24, 0xaa, 0xe6, 0x5d, 0xa4, 0x49, 0xbf, 0x7e, 0xa6, 0x69, 0x32,
+	0xc1, 0xa9, 0x3b, 0x3d, 0x23, 0xc1, 0x49, 0xbb, 0x7e, 0xf3, 0x7c, 0x7b, 0x1a, 0xf7, 0x31, 0x28,
+	0x67, 0xae, 0xd4, 0x8c, 0xee, 0x4f, 0x33, 0xf4, 0xc6, 0x3c, 0x46, 0x12, 0x5d, 0x5f, 0xf9, 0x8e,
+	0x8d, 0x85, 0xf6, 0xbd, 0x17, 0xc7, 0x86, 0xf2, 0xf2, 0xd8, 0x50, 0x7e, 0x3f, 0x36, 0x94, 0xa7,
+	0x27, 0xc6, 0xc2, 0xcb, 0x13, 0x63, 0xe1, 0xd7, 0x13, 0x63, 0xe1, 0xeb, 0x6d, 0xe4, 0xd3, 0x83,
+	0x41, 0xd7, 0xea, 0xe1, 0x40, 0xfe, 0xd5, 0xb5, 0x73, 0x73, 0x82, 0x8e, 0x22, 0x48, 0xd8, 0x1f,
+	0xeb, 0x02, 0xbf, 0x06, 0xef, 0xfe, 0x3b, 0x00, 0xbc, 0xa4, 0x1c, 0x57, 0x98, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1255,6 +1266,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Emergency {
+		i--
+		if m.Emergency {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1825,6 +1846,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Emergency {
+		n += 2
+	}
 	return n
 }
 
@@ -2272,6 +2296,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Emergency = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
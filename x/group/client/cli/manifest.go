@@ -0,0 +1,467 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+// GroupManifest defines a human-readable description of a group, its members
+// and its group policies, which can be exported from the chain, edited and
+// applied back to the chain.
+type GroupManifest struct {
+	GroupID  uint64           `json:"group_id"`
+	Admin    string           `json:"admin"`
+	Metadata string           `json:"metadata"`
+	Members  []ManifestMember `json:"members"`
+	Policies []ManifestPolicy `json:"policies"`
+}
+
+// ManifestMember defines a group member in a GroupManifest.
+type ManifestMember struct {
+	Address  string `json:"address"`
+	Weight   string `json:"weight"`
+	Metadata string `json:"metadata"`
+}
+
+// ManifestPolicy defines a group policy in a GroupManifest. A policy without
+// address is created, with the group admin as admin, when the manifest is
+// applied.
+type ManifestPolicy struct {
+	Address  string `json:"address,omitempty"`
+	Admin    string `json:"admin"`
+	Metadata string `json:"metadata"`
+	// DecisionPolicy defines the decision policy proto-JSON-encoded as an Any.
+	DecisionPolicy json.RawMessage `json:"decision_policy"`
+}
+
+// queryGroupManifest queries the on-chain state of a group and builds its
+// manifest.
+func queryGroupManifest(ctx context.Context, clientCtx client.Context, groupID uint64) (GroupManifest, error) {
+	queryClient := group.NewQueryClient(clientCtx)
+
+	infoRes, err := queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+	if err != nil {
+		return GroupManifest{}, err
+	}
+
+	var members []*group.GroupMember
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID, Pagination: pageReq})
+		if err != nil {
+			return GroupManifest{}, err
+		}
+
+		members = append(members, res.Members...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	var policies []*group.GroupPolicyInfo
+	pageReq = &query.PageRequest{}
+	for {
+		res, err := queryClient.GroupPoliciesByGroup(ctx, &group.QueryGroupPoliciesByGroupRequest{GroupId: groupID, Pagination: pageReq})
+		if err != nil {
+			return GroupManifest{}, err
+		}
+
+		policies = append(policies, res.GroupPolicies...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	return newGroupManifest(clientCtx.Codec, infoRes.Info, members, policies)
+}
+
+// newGroupManifest builds the manifest of a group from its on-chain state.
+func newGroupManifest(cdc codec.Codec, info *group.GroupInfo, members []*group.GroupMember, policies []*group.GroupPolicyInfo) (GroupManifest, error) {
+	manifest := GroupManifest{
+		GroupID:  info.Id,
+		Admin:    info.Admin,
+		Metadata: info.Metadata,
+		Members:  make([]ManifestMember, 0, len(members)),
+		Policies: make([]ManifestPolicy, 0, len(policies)),
+	}
+
+	for _, member := range members {
+		manifest.Members = append(manifest.Members, ManifestMember{
+			Address:  member.Member.Address,
+			Weight:   member.Member.Weight,
+			Metadata: member.Member.Metadata,
+		})
+	}
+
+	for _, policy := range policies {
+		decisionPolicy, err := policy.GetDecisionPolicy()
+		if err != nil {
+			return GroupManifest{}, err
+		}
+
+		decisionPolicyJSON, err := cdc.MarshalInterfaceJSON(decisionPolicy)
+		if err != nil {
+			return GroupManifest{}, err
+		}
+
+		manifest.Policies = append(manifest.Policies, ManifestPolicy{
+			Address:        policy.Address,
+			Admin:          policy.Admin,
+			Metadata:       policy.Metadata,
+			DecisionPolicy: decisionPolicyJSON,
+		})
+	}
+
+	return manifest, nil
+}
+
+// parseGroupManifest reads and parses a group manifest.
+func parseGroupManifest(manifestFile string) (GroupManifest, error) {
+	contents, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return GroupManifest{}, err
+	}
+
+	var manifest GroupManifest
+	if err := json.Unmarshal(contents, &manifest); err != nil {
+		return GroupManifest{}, fmt.Errorf("failed to parse group manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// validate checks that the manifest describes a valid group.
+func (m GroupManifest) validate(cdc codec.Codec) error {
+	if m.GroupID == 0 {
+		return errZeroGroupID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return fmt.Errorf("invalid group admin %s: %w", m.Admin, err)
+	}
+
+	seenMembers := make(map[string]bool, len(m.Members))
+	for _, member := range m.Members {
+		if _, err := sdk.AccAddressFromBech32(member.Address); err != nil {
+			return fmt.Errorf("invalid member address %s: %w", member.Address, err)
+		}
+		if seenMembers[member.Address] {
+			return fmt.Errorf("duplicate member %s", member.Address)
+		}
+		seenMembers[member.Address] = true
+
+		if _, err := math.NewPositiveDecFromString(member.Weight); err != nil {
+			return fmt.Errorf("invalid weight %s for %s: weight must be positive", member.Weight, member.Address)
+		}
+	}
+
+	seenPolicies := make(map[string]bool, len(m.Policies))
+	for i, policy := range m.Policies {
+		name := fmt.Sprintf("new group policy #%d", i)
+		if policy.Address != "" {
+			name = policy.Address
+			if _, err := sdk.AccAddressFromBech32(policy.Address); err != nil {
+				return fmt.Errorf("invalid group policy address %s: %w", policy.Address, err)
+			}
+			if seenPolicies[policy.Address] {
+				return fmt.Errorf("duplicate group policy %s", policy.Address)
+			}
+			seenPolicies[policy.Address] = true
+		}
+
+		if policy.Address != "" || policy.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(policy.Admin); err != nil {
+				return fmt.Errorf("invalid admin %s of %s: %w", policy.Admin, name, err)
+			}
+		}
+
+		decisionPolicy, err := policy.decisionPolicy(cdc)
+		if err != nil {
+			return fmt.Errorf("invalid decision policy of %s: %w", name, err)
+		}
+		if err := decisionPolicy.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid decision policy of %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// decisionPolicy parses the decision policy of the group policy.
+func (p ManifestPolicy) decisionPolicy(cdc codec.Codec) (group.DecisionPolicy, error) {
+	if len(p.DecisionPolicy) == 0 {
+		return nil, fmt.Errorf("decision policy is required")
+	}
+
+	var decisionPolicy group.DecisionPolicy
+	if err := cdc.UnmarshalInterfaceJSON(p.DecisionPolicy, &decisionPolicy); err != nil {
+		return nil, err
+	}
+
+	return decisionPolicy, nil
+}
+
+// groupManifestDiff defines the changes between the on-chain state of a group
+// and its manifest, together with the messages applying them.
+type groupManifestDiff struct {
+	Changes []string
+	Msgs    []sdk.Msg
+}
+
+// Signers returns the accounts which must sign the messages of the diff.
+func (d groupManifestDiff) Signers() []string {
+	seen := make(map[string]bool)
+	var signers []string
+	for _, msg := range d.Msgs {
+		for _, signer := range msg.(sdk.LegacyMsg).GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				signers = append(signers, signer.String())
+			}
+		}
+	}
+
+	return signers
+}
+
+// diffGroupManifest computes the changes and the messages needed to bring the
+// current state of a group to the desired manifest. The messages are ordered so
+// that they can be executed in a single transaction: the admin changes come
+// last, as the other messages must be signed by the current admins.
+func diffGroupManifest(cdc codec.Codec, current, desired GroupManifest) (groupManifestDiff, error) {
+	var diff groupManifestDiff
+
+	if current.GroupID != desired.GroupID {
+		return diff, fmt.Errorf("manifest of group %d cannot be applied to group %d", desired.GroupID, current.GroupID)
+	}
+
+	if current.Metadata != desired.Metadata {
+		diff.Changes = append(diff.Changes, fmt.Sprintf("~ group metadata: %q -> %q", current.Metadata, desired.Metadata))
+		diff.Msgs = append(diff.Msgs, &group.MsgUpdateGroupMetadata{
+			Admin:    current.Admin,
+			GroupId:  current.GroupID,
+			Metadata: desired.Metadata,
+		})
+	}
+
+	memberUpdates, err := diffManifestMembers(current.Members, desired.Members, &diff.Changes)
+	if err != nil {
+		return diff, err
+	}
+	if len(memberUpdates) > 0 {
+		diff.Msgs = append(diff.Msgs, &group.MsgUpdateGroupMembers{
+			Admin:         current.Admin,
+			GroupId:       current.GroupID,
+			MemberUpdates: memberUpdates,
+		})
+	}
+
+	policyMsgs, err := diffManifestPolicies(cdc, current, desired.Policies, &diff.Changes)
+	if err != nil {
+		return diff, err
+	}
+	diff.Msgs = append(diff.Msgs, policyMsgs...)
+
+	if current.Admin != desired.Admin {
+		diff.Changes = append(diff.Changes, fmt.Sprintf("~ group admin: %s -> %s", current.Admin, desired.Admin))
+		diff.Msgs = append(diff.Msgs, &group.MsgUpdateGroupAdmin{
+			Admin:    current.Admin,
+			GroupId:  current.GroupID,
+			NewAdmin: desired.Admin,
+		})
+	}
+
+	return diff, nil
+}
+
+// diffManifestMembers returns the member updates, members to remove having a
+// zero weight, needed to bring the current members to the desired ones.
+func diffManifestMembers(current, desired []ManifestMember, changes *[]string) ([]group.MemberRequest, error) {
+	currentMembers := make(map[string]ManifestMember, len(current))
+	for _, member := range current {
+		currentMembers[member.Address] = member
+	}
+
+	var updates []group.MemberRequest
+	desiredMembers := make(map[string]bool, len(desired))
+	for _, member := range desired {
+		desiredMembers[member.Address] = true
+
+		currentMember, found := currentMembers[member.Address]
+		if !found {
+			*changes = append(*changes, fmt.Sprintf("+ member %s: weight %s, metadata %q", member.Address, member.Weight, member.Metadata))
+			updates = append(updates, group.MemberRequest{Address: member.Address, Weight: member.Weight, Metadata: member.Metadata})
+			continue
+		}
+
+		sameWeight, err := equalWeights(currentMember.Weight, member.Weight)
+		if err != nil {
+			return nil, err
+		}
+		if sameWeight && currentMember.Metadata == member.Metadata {
+			continue
+		}
+
+		change := fmt.Sprintf("~ member %s:", member.Address)
+		if !sameWeight {
+			change += fmt.Sprintf(" weight %s -> %s", currentMember.Weight, member.Weight)
+		}
+		if currentMember.Metadata != member.Metadata {
+			change += fmt.Sprintf(" metadata %q -> %q", currentMember.Metadata, member.Metadata)
+		}
+		*changes = append(*changes, change)
+		updates = append(updates, group.MemberRequest{Address: member.Address, Weight: member.Weight, Metadata: member.Metadata})
+	}
+
+	var removed []string
+	for address := range currentMembers {
+		if !desiredMembers[address] {
+			removed = append(removed, address)
+		}
+	}
+	sort.Strings(removed)
+
+	for _, address := range removed {
+		*changes = append(*changes, fmt.Sprintf("- member %s", address))
+		updates = append(updates, group.MemberRequest{Address: address, Weight: "0"})
+	}
+
+	return updates, nil
+}
+
+// diffManifestPolicies returns the messages needed to bring the current group
+// policies to the desired ones. Group policies cannot be deleted.
+func diffManifestPolicies(cdc codec.Codec, current GroupManifest, desired []ManifestPolicy, changes *[]string) ([]sdk.Msg, error) {
+	currentPolicies := make(map[string]ManifestPolicy, len(current.Policies))
+	for _, policy := range current.Policies {
+		currentPolicies[policy.Address] = policy
+	}
+
+	var msgs, adminMsgs []sdk.Msg
+	desiredPolicies := make(map[string]bool, len(desired))
+	for _, policy := range desired {
+		decisionPolicy, err := policy.decisionPolicy(cdc)
+		if err != nil {
+			return nil, err
+		}
+
+		if policy.Address == "" {
+			if policy.Admin != "" && policy.Admin != current.Admin {
+				return nil, fmt.Errorf("new group policies are administered by the group admin %s, not %s", current.Admin, policy.Admin)
+			}
+
+			*changes = append(*changes, fmt.Sprintf("+ group policy: metadata %q, decision policy %s", policy.Metadata, compactJSON(policy.DecisionPolicy)))
+			msg := &group.MsgCreateGroupPolicy{Admin: current.Admin, GroupId: current.GroupID, Metadata: policy.Metadata}
+			if err := msg.SetDecisionPolicy(decisionPolicy); err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, msg)
+			continue
+		}
+
+		desiredPolicies[policy.Address] = true
+		currentPolicy, found := currentPolicies[policy.Address]
+		if !found {
+			return nil, fmt.Errorf("group policy %s does not belong to group %d", policy.Address, current.GroupID)
+		}
+
+		if currentPolicy.Metadata != policy.Metadata {
+			*changes = append(*changes, fmt.Sprintf("~ group policy %s: metadata %q -> %q", policy.Address, currentPolicy.Metadata, policy.Metadata))
+			msgs = append(msgs, &group.MsgUpdateGroupPolicyMetadata{
+				Admin:              currentPolicy.Admin,
+				GroupPolicyAddress: policy.Address,
+				Metadata:           policy.Metadata,
+			})
+		}
+
+		// compare the decision policies in their canonical encoding
+		decisionPolicyJSON, err := cdc.MarshalInterfaceJSON(decisionPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(compactJSON(currentPolicy.DecisionPolicy), compactJSON(decisionPolicyJSON)) {
+			*changes = append(*changes, fmt.Sprintf("~ group policy %s: decision policy %s -> %s", policy.Address, compactJSON(currentPolicy.DecisionPolicy), compactJSON(decisionPolicyJSON)))
+			msg := &group.MsgUpdateGroupPolicyDecisionPolicy{Admin: currentPolicy.Admin, GroupPolicyAddress: policy.Address}
+			if err := msg.SetDecisionPolicy(decisionPolicy); err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, msg)
+		}
+
+		if currentPolicy.Admin != policy.Admin {
+			*changes = append(*changes, fmt.Sprintf("~ group policy %s: admin %s -> %s", policy.Address, currentPolicy.Admin, policy.Admin))
+			adminMsgs = append(adminMsgs, &group.MsgUpdateGroupPolicyAdmin{
+				Admin:              currentPolicy.Admin,
+				GroupPolicyAddress: policy.Address,
+				NewAdmin:           policy.Admin,
+			})
+		}
+	}
+
+	for _, policy := range current.Policies {
+		if !desiredPolicies[policy.Address] {
+			return nil, fmt.Errorf("group policy %s is missing from the manifest: group policies cannot be removed", policy.Address)
+		}
+	}
+
+	return append(msgs, adminMsgs...), nil
+}
+
+// equalWeights returns true if the given member weights are the same decimal.
+func equalWeights(x, y string) (bool, error) {
+	xDec, err := math.NewDecFromString(x)
+	if err != nil {
+		return false, err
+	}
+
+	yDec, err := math.NewDecFromString(y)
+	if err != nil {
+		return false, err
+	}
+
+	return xDec.Equal(yDec), nil
+}
+
+// compactJSON returns the given JSON without insignificant whitespaces, or as
+// is if it is not valid.
+func compactJSON(bz []byte) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, bz); err != nil {
+		return bz
+	}
+
+	return buf.Bytes()
+}
+
+// printManifestMsgs prints the messages applying a group manifest as a JSON
+// array.
+func printManifestMsgs(clientCtx client.Context, msgs []sdk.Msg) error {
+	msgsJSON := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		msgJSON, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return err
+		}
+		msgsJSON = append(msgsJSON, msgJSON)
+	}
+
+	bz, err := json.MarshalIndent(msgsJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintString(string(bz) + "\n")
+}
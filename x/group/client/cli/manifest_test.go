@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func manifestTestCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	group.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func thresholdPolicyJSON(t *testing.T, cdc codec.Codec, threshold string) json.RawMessage {
+	t.Helper()
+	bz, err := cdc.MarshalInterfaceJSON(group.NewThresholdDecisionPolicy(threshold, time.Hour, 0))
+	require.NoError(t, err)
+	return bz
+}
+
+func TestGroupManifestValidate(t *testing.T) {
+	cdc := manifestTestCodec()
+	admin := sdk.AccAddress("admin_______________").String()
+	member := sdk.AccAddress("member______________").String()
+
+	valid := func() GroupManifest {
+		return GroupManifest{
+			GroupID:  1,
+			Admin:    admin,
+			Members:  []ManifestMember{{Address: member, Weight: "1"}},
+			Policies: []ManifestPolicy{{DecisionPolicy: thresholdPolicyJSON(t, cdc, "1")}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(m *GroupManifest)
+		expErr   string
+	}{
+		{"valid", func(m *GroupManifest) {}, ""},
+		{"zero group id", func(m *GroupManifest) { m.GroupID = 0 }, "group id cannot be 0"},
+		{"invalid admin", func(m *GroupManifest) { m.Admin = "invalid" }, "invalid group admin"},
+		{"duplicate member", func(m *GroupManifest) { m.Members = append(m.Members, m.Members[0]) }, "duplicate member"},
+		{"zero weight", func(m *GroupManifest) { m.Members[0].Weight = "0" }, "weight must be positive"},
+		{"missing decision policy", func(m *GroupManifest) { m.Policies[0].DecisionPolicy = nil }, "decision policy is required"},
+		{"invalid decision policy", func(m *GroupManifest) { m.Policies[0].DecisionPolicy = thresholdPolicyJSON(t, cdc, "0") }, "invalid decision policy of new group policy #0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := valid()
+			tc.malleate(&manifest)
+
+			err := manifest.validate(cdc)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestDiffGroupManifest(t *testing.T) {
+	cdc := manifestTestCodec()
+	admin := sdk.AccAddress("admin_______________").String()
+	newAdmin := sdk.AccAddress("new_admin___________").String()
+	member1 := sdk.AccAddress("member1_____________").String()
+	member2 := sdk.AccAddress("member2_____________").String()
+	member3 := sdk.AccAddress("member3_____________").String()
+	policyAddr := sdk.AccAddress("policy______________").String()
+
+	current := GroupManifest{
+		GroupID:  1,
+		Admin:    admin,
+		Metadata: "metadata",
+		Members: []ManifestMember{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "2"},
+		},
+		Policies: []ManifestPolicy{
+			{Address: policyAddr, Admin: admin, DecisionPolicy: thresholdPolicyJSON(t, cdc, "1")},
+		},
+	}
+
+	diff, err := diffGroupManifest(cdc, current, current)
+	require.NoError(t, err)
+	require.Empty(t, diff.Changes)
+	require.Empty(t, diff.Msgs)
+
+	desired := GroupManifest{
+		GroupID:  1,
+		Admin:    newAdmin,
+		Metadata: "metadata",
+		Members: []ManifestMember{
+			{Address: member1, Weight: "1.0"},
+			{Address: member3, Weight: "3", Metadata: "new"},
+		},
+		Policies: []ManifestPolicy{
+			{Address: policyAddr, Admin: newAdmin, DecisionPolicy: thresholdPolicyJSON(t, cdc, "2")},
+			{DecisionPolicy: thresholdPolicyJSON(t, cdc, "1"), Metadata: "new policy"},
+		},
+	}
+
+	diff, err = diffGroupManifest(cdc, current, desired)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 6)
+	require.Equal(t, []string{admin}, diff.Signers())
+
+	require.Len(t, diff.Msgs, 5)
+	require.Equal(t, &group.MsgUpdateGroupMembers{
+		Admin:   admin,
+		GroupId: 1,
+		MemberUpdates: []group.MemberRequest{
+			{Address: member3, Weight: "3", Metadata: "new"},
+			{Address: member2, Weight: "0"},
+		},
+	}, diff.Msgs[0])
+	require.IsType(t, &group.MsgUpdateGroupPolicyDecisionPolicy{}, diff.Msgs[1])
+	require.IsType(t, &group.MsgCreateGroupPolicy{}, diff.Msgs[2])
+	require.Equal(t, &group.MsgUpdateGroupPolicyAdmin{Admin: admin, GroupPolicyAddress: policyAddr, NewAdmin: newAdmin}, diff.Msgs[3])
+	require.Equal(t, &group.MsgUpdateGroupAdmin{Admin: admin, GroupId: 1, NewAdmin: newAdmin}, diff.Msgs[4])
+
+	// group policies cannot be removed
+	desired.Policies = desired.Policies[1:]
+	_, err = diffGroupManifest(cdc, current, desired)
+	require.ErrorContains(t, err, "group policies cannot be removed")
+
+	// the manifest of another group cannot be applied
+	desired.GroupID = 2
+	_, err = diffGroupManifest(cdc, current, desired)
+	require.ErrorContains(t, err, "cannot be applied to group 1")
+}
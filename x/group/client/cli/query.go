@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
@@ -35,6 +38,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryGroupManifestCmd(),
 	)
 
 	return queryCmd
@@ -554,3 +558,42 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryGroupManifestCmd creates a CLI command exporting the manifest of a group.
+func QueryGroupManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-manifest [group-id]",
+		Short: "Export a group, its members and its group policies into an editable JSON manifest",
+		Long: fmt.Sprintf(`Export a group, its members and its group policies into an editable JSON manifest.
+The edited manifest can be applied back to the chain with the "%s tx group apply-manifest" command.`, version.AppName),
+		Example: fmt.Sprintf("%s query group export-manifest 1 > manifest.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := queryGroupManifest(cmd.Context(), clientCtx, groupID)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -21,6 +22,7 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagDiffOnly           = "diff-only"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -51,6 +53,7 @@ func TxCmd(name string, ac address.Codec) *cobra.Command {
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
 		NewCmdDraftProposal(),
+		MsgApplyGroupManifestCmd(),
 	)
 
 	return txCmd
@@ -835,3 +838,103 @@ Parameters:
 
 	return cmd
 }
+
+// MsgApplyGroupManifestCmd creates a CLI command applying a group manifest.
+func MsgApplyGroupManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-manifest [manifest-json-file]",
+		Short: "Apply the changes of an edited group manifest",
+		Long: fmt.Sprintf(`Validate an edited group manifest, exported with "%[1]s query group export-manifest",
+print the changes from the on-chain state of the group and generate the messages applying them.
+
+Members missing from the manifest are removed, group policies without address are created and
+group policies cannot be removed. The messages are sent in a single transaction signed by the
+current admin, the '--from' flag is ignored as it is implied from the manifest. If the group
+admin is a group policy, a group proposal is printed instead, which can be completed and
+submitted with "%[1]s tx group submit-proposal".
+
+Use the --%[2]s flag to only print the changes and the messages.`, version.AppName, FlagDiffOnly),
+		Example: fmt.Sprintf("%s tx group apply-manifest manifest.json --%s", version.AppName, FlagDiffOnly),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			desired, err := parseGroupManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := desired.validate(clientCtx.Codec); err != nil {
+				return err
+			}
+
+			current, err := queryGroupManifest(cmd.Context(), clientCtx, desired.GroupID)
+			if err != nil {
+				return err
+			}
+
+			diff, err := diffGroupManifest(clientCtx.Codec, current, desired)
+			if err != nil {
+				return err
+			}
+
+			if len(diff.Msgs) == 0 {
+				return clientCtx.PrintString("no changes\n")
+			}
+
+			cmd.PrintErrf("changes to group %d:\n  %s\n", desired.GroupID, strings.Join(diff.Changes, "\n  "))
+
+			diffOnly, _ := cmd.Flags().GetBool(FlagDiffOnly)
+			if diffOnly {
+				return printManifestMsgs(clientCtx, diff.Msgs)
+			}
+
+			signers := diff.Signers()
+			if len(signers) != 1 {
+				return fmt.Errorf("the changes must be signed by different admins %s: apply them in separate manifests", strings.Join(signers, ", "))
+			}
+
+			// a group policy admin applies the changes through a group proposal
+			if _, err := group.NewQueryClient(clientCtx).GroupPolicyInfo(cmd.Context(), &group.QueryGroupPolicyInfoRequest{Address: signers[0]}); err == nil {
+				proposal := Proposal{
+					GroupPolicyAddress: signers[0],
+					Title:              fmt.Sprintf("Update group %d", desired.GroupID),
+					Summary:            strings.Join(diff.Changes, "\n"),
+				}
+				for _, msg := range diff.Msgs {
+					msgJSON, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+					if err != nil {
+						return err
+					}
+					proposal.Messages = append(proposal.Messages, msgJSON)
+				}
+
+				bz, err := json.MarshalIndent(proposal, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(string(bz) + "\n")
+			}
+
+			if err := cmd.Flags().Set(flags.FlagFrom, signers[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err = client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), diff.Msgs...)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDiffOnly, false, "Only print the changes and the messages applying them")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
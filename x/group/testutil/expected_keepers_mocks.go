@@ -126,6 +126,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddBlockedAddresses mocks base method.
+func (m *MockBankKeeper) AddBlockedAddresses(arg0 context.Context, arg1 *types0.MsgAddBlockedAddresses) (*types0.MsgAddBlockedAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlockedAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgAddBlockedAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBlockedAddresses indicates an expected call of AddBlockedAddresses.
+func (mr *MockBankKeeperMockRecorder) AddBlockedAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).AddBlockedAddresses), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -169,6 +184,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RemoveBlockedAddresses mocks base method.
+func (m *MockBankKeeper) RemoveBlockedAddresses(arg0 context.Context, arg1 *types0.MsgRemoveBlockedAddresses) (*types0.MsgRemoveBlockedAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlockedAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgRemoveBlockedAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBlockedAddresses indicates an expected call of RemoveBlockedAddresses.
+func (mr *MockBankKeeperMockRecorder) RemoveBlockedAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).RemoveBlockedAddresses), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types0.MsgSend) (*types0.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
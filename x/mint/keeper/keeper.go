@@ -108,3 +108,39 @@ func (k Keeper) MintCoins(ctx context.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx context.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// BlockProvision returns the provisions minted for a block at the current
+// annual provisions rate.
+func (k Keeper) BlockProvision(ctx context.Context) (sdk.Coin, error) {
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return minter.BlockProvision(params), nil
+}
+
+// Inflation returns the current annual inflation rate.
+func (k Keeper) Inflation(ctx context.Context) (math.LegacyDec, error) {
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return minter.Inflation, nil
+}
+
+// BlocksPerYear returns the expected number of blocks per year.
+func (k Keeper) BlocksPerYear(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.BlocksPerYear, nil
+}
@@ -90,3 +90,20 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestBlockProvision() {
+	minter := types.NewMinter(math.LegacyNewDecWithPrec(13, 2), math.LegacyNewDec(6311520000))
+	s.Require().NoError(s.mintKeeper.Minter.Set(s.ctx, minter))
+
+	provision, err := s.mintKeeper.BlockProvision(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)), provision)
+
+	blocksPerYear, err := s.mintKeeper.BlocksPerYear(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().BlocksPerYear, blocksPerYear)
+
+	inflation, err := s.mintKeeper.Inflation(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(13, 2), inflation)
+}
@@ -0,0 +1,69 @@
+package nft
+
+import (
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAuctionDuration is the maximum duration of an auction.
+const MaxAuctionDuration = 30 * 24 * time.Hour
+
+// Validate checks that the auction is consistent: an english auction has no end price and a valid bid if it has a
+// bidder, and a dutch auction ends at a lower price than it starts at, in the same denom
+func (a Auction) Validate(ac address.Codec) error {
+	if a.Id == 0 {
+		return ErrInvalidAuction.Wrap("zero id")
+	}
+	if len(a.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+	if len(a.NftId) == 0 {
+		return ErrEmptyNFTID
+	}
+	if _, err := ac.StringToBytes(a.Seller); err != nil {
+		return ErrInvalidAuction.Wrapf("invalid seller address (%s)", a.Seller)
+	}
+	if !a.StartPrice.IsValid() || !a.StartPrice.IsPositive() {
+		return ErrInvalidAuction.Wrapf("invalid start price %s", a.StartPrice)
+	}
+	if duration := a.EndTime.Sub(a.StartTime); duration <= 0 || duration > MaxAuctionDuration {
+		return ErrInvalidAuction.Wrapf("duration %s must be positive and at most %s", duration, MaxAuctionDuration)
+	}
+
+	switch a.AuctionType {
+	case AuctionTypeEnglish:
+		if !IsUnsetCoin(a.EndPrice) {
+			return ErrInvalidAuction.Wrap("english auction cannot have an end price")
+		}
+		if len(a.HighestBidder) == 0 {
+			if !IsUnsetCoin(a.HighestBid) {
+				return ErrInvalidAuction.Wrap("highest bid without bidder")
+			}
+			return nil
+		}
+		if _, err := ac.StringToBytes(a.HighestBidder); err != nil {
+			return ErrInvalidAuction.Wrapf("invalid highest bidder address (%s)", a.HighestBidder)
+		}
+		if !a.HighestBid.IsValid() || a.HighestBid.Denom != a.StartPrice.Denom || a.HighestBid.IsLT(a.StartPrice) {
+			return ErrInvalidAuction.Wrapf("highest bid %s is below the start price %s", a.HighestBid, a.StartPrice)
+		}
+	case AuctionTypeDutch:
+		if !a.EndPrice.IsValid() || a.EndPrice.Denom != a.StartPrice.Denom || !a.EndPrice.IsLT(a.StartPrice) {
+			return ErrInvalidAuction.Wrapf("end price %s must be lower than the start price %s", a.EndPrice, a.StartPrice)
+		}
+		if len(a.HighestBidder) > 0 || !IsUnsetCoin(a.HighestBid) {
+			return ErrInvalidAuction.Wrap("dutch auction cannot have a bid")
+		}
+	default:
+		return ErrInvalidAuction.Wrapf("invalid auction type %s", a.AuctionType)
+	}
+	return nil
+}
+
+// IsUnsetCoin returns true if the coin is the zero value of a non-nullable coin field.
+func IsUnsetCoin(coin sdk.Coin) bool {
+	return len(coin.Denom) == 0 && (coin.Amount.IsNil() || coin.Amount.IsZero())
+}
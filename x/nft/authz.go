@@ -0,0 +1,108 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas consumed per class or nft of the authorization checked against a Msg/Send, as in the
+// SendAuthorization of the bank module.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &TransferNFTAuthorization{}
+
+// NewTransferNFTAuthorization creates a new TransferNFTAuthorization. The nfts are not restricted if no class id and
+// no nft are given, and their number is not limited if maxUses is 0.
+func NewTransferNFTAuthorization(classIDs []string, nfts []NFTReference, maxUses uint64) *TransferNFTAuthorization {
+	return &TransferNFTAuthorization{
+		ClassIds: classIDs,
+		Nfts:     nfts,
+		MaxUses:  maxUses,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferNFTAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a TransferNFTAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !a.allows(sdk.UnwrapSDKContext(ctx), mSend.ClassId, mSend.Id) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send nft %s of class %s", mSend.Id, mSend.ClassId)
+	}
+
+	switch a.MaxUses {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		return authz.AcceptResponse{Accept: true, Updated: &TransferNFTAuthorization{
+			ClassIds: a.ClassIds,
+			Nfts:     a.Nfts,
+			MaxUses:  a.MaxUses - 1,
+		}}, nil
+	}
+}
+
+// allows returns true if the authorization allows to send the given nft.
+func (a TransferNFTAuthorization) allows(ctx sdk.Context, classID, nftID string) bool {
+	if len(a.ClassIds) == 0 && len(a.Nfts) == 0 {
+		return true
+	}
+
+	for _, id := range a.ClassIds {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer nft authorization")
+		if id == classID {
+			return true
+		}
+	}
+
+	for _, ref := range a.Nfts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer nft authorization")
+		if ref.ClassId == classID && ref.Id == nftID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferNFTAuthorization) ValidateBasic() error {
+	classIDs := make(map[string]bool, len(a.ClassIds))
+	for _, id := range a.ClassIds {
+		if len(id) == 0 {
+			return ErrEmptyClassID
+		}
+		if classIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class id %s", id)
+		}
+		classIDs[id] = true
+	}
+
+	nfts := make(map[NFTReference]bool, len(a.Nfts))
+	for _, ref := range a.Nfts {
+		if len(ref.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(ref.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		if nfts[ref] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate nft %s of class %s", ref.Id, ref.ClassId)
+		}
+		nfts[ref] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/nft/v1beta1/authz.proto
+
+package nft
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferNFTAuthorization allows the grantee to send nfts of the granter with Msg/Send. The nfts are restricted by
+// class_ids and nfts: when any of them is set, a nft can be sent if its class is listed in class_ids or if it is
+// listed in nfts. Any nft of the granter can be sent otherwise.
+type TransferNFTAuthorization struct {
+	// class_ids are the classes whose nfts can be sent
+	ClassIds []string `protobuf:"bytes,1,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	// nfts are the nfts which can be sent, regardless of class_ids
+	Nfts []NFTReference `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
+	// max_uses is the number of nfts which can still be sent, the authorization being deleted once it reaches 0. The
+	// number of nfts is not limited if it is 0 when the authorization is granted.
+	MaxUses uint64 `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+}
+
+func (m *TransferNFTAuthorization) Reset()         { *m = TransferNFTAuthorization{} }
+func (m *TransferNFTAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TransferNFTAuthorization) ProtoMessage()    {}
+func (*TransferNFTAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_571894d4d5167360, []int{0}
+}
+func (m *TransferNFTAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferNFTAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferNFTAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferNFTAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferNFTAuthorization.Merge(m, src)
+}
+func (m *TransferNFTAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferNFTAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferNFTAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferNFTAuthorization proto.InternalMessageInfo
+
+func (m *TransferNFTAuthorization) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
+	}
+	return nil
+}
+
+func (m *TransferNFTAuthorization) GetNfts() []NFTReference {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+func (m *TransferNFTAuthorization) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+// NFTReference identifies a nft by its class and id.
+type NFTReference struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is the identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *NFTReference) Reset()         { *m = NFTReference{} }
+func (m *NFTReference) String() string { return proto.CompactTextString(m) }
+func (*NFTReference) ProtoMessage()    {}
+func (*NFTReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_571894d4d5167360, []int{1}
+}
+func (m *NFTReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTReference.Merge(m, src)
+}
+func (m *NFTReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTReference proto.InternalMessageInfo
+
+func (m *NFTReference) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTReference) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TransferNFTAuthorization)(nil), "cosmos.nft.v1beta1.TransferNFTAuthorization")
+	proto.RegisterType((*NFTReference)(nil), "cosmos.nft.v1beta1.NFTReference")
+}
+
+func init() { proto.RegisterFile("cosmos/nft/v1beta1/authz.proto", fileDescriptor_571894d4d5167360) }
+
+var fileDescriptor_571894d4d5167360 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0xc7, 0xaf, 0x07, 0x11, 0xa8, 0xc6, 0xa1, 0x71, 0x28, 0x98, 0xd4, 0x0b, 0x83, 0xb9, 0x41,
+	0x7b, 0x41, 0x27, 0xd9, 0x64, 0x20, 0x71, 0x61, 0xb8, 0xe0, 0xe2, 0x42, 0x0a, 0xd7, 0x83, 0x46,
+	0x69, 0xcd, 0x7d, 0xc5, 0x10, 0x9e, 0xc2, 0x87, 0x31, 0x3e, 0x03, 0x71, 0x62, 0x74, 0x32, 0x06,
+	0x5e, 0xc4, 0x5c, 0xef, 0x48, 0x34, 0x6e, 0xed, 0xef, 0xfb, 0xfa, 0xcf, 0xaf, 0x7f, 0xcc, 0x26,
+	0x06, 0xe6, 0x06, 0x22, 0x9d, 0xda, 0xe8, 0xa5, 0x33, 0x96, 0x56, 0x74, 0x22, 0xb1, 0xb0, 0xb3,
+	0x15, 0x7f, 0xce, 0x8c, 0x35, 0x84, 0x14, 0x73, 0xae, 0x53, 0xcb, 0xcb, 0x79, 0xab, 0x59, 0xb0,
+	0x91, 0xdb, 0x88, 0xca, 0x05, 0x77, 0x69, 0x9d, 0x4c, 0xcd, 0xd4, 0x14, 0x3c, 0x3f, 0x15, 0xb4,
+	0xfd, 0x8e, 0x30, 0x1d, 0x66, 0x42, 0x43, 0x2a, 0xb3, 0x41, 0x7f, 0x78, 0xbb, 0xb0, 0x33, 0x93,
+	0xa9, 0x95, 0xb0, 0xca, 0x68, 0x72, 0x8a, 0x1b, 0x93, 0x27, 0x01, 0x30, 0x52, 0x09, 0x50, 0x14,
+	0x54, 0xc2, 0x46, 0x5c, 0x77, 0xe0, 0x2e, 0x01, 0xd2, 0xc5, 0x55, 0x9d, 0x5a, 0xa0, 0x7e, 0x50,
+	0x09, 0x0f, 0xaf, 0x02, 0xfe, 0xdf, 0x86, 0x0f, 0xfa, 0xc3, 0x58, 0xa6, 0x32, 0x93, 0x7a, 0x22,
+	0x7b, 0xd5, 0xf5, 0xd7, 0x99, 0x17, 0xbb, 0x37, 0xa4, 0x89, 0xeb, 0x73, 0xb1, 0x1c, 0x2d, 0x40,
+	0x02, 0xad, 0x04, 0x28, 0xac, 0xc6, 0xb5, 0xb9, 0x58, 0xde, 0x83, 0x84, 0xee, 0xf9, 0xc7, 0xdb,
+	0x65, 0xbb, 0xcc, 0x2a, 0x7e, 0xbb, 0x4f, 0xfb, 0xe3, 0xd6, 0xbe, 0xc1, 0x47, 0xbf, 0xe3, 0xf3,
+	0xc8, 0xbd, 0x2b, 0x45, 0x01, 0x0a, 0x1b, 0x71, 0xad, 0x54, 0x25, 0xc7, 0xd8, 0x57, 0x09, 0xf5,
+	0x1d, 0xf4, 0x55, 0xd2, 0xbb, 0x58, 0x6f, 0x19, 0xda, 0x6c, 0x19, 0xfa, 0xde, 0x32, 0xf4, 0xba,
+	0x63, 0xde, 0x66, 0xc7, 0xbc, 0xcf, 0x1d, 0xf3, 0x1e, 0xca, 0x4a, 0x21, 0x79, 0xe4, 0xca, 0x44,
+	0xcb, 0xbc, 0xfa, 0xf1, 0x81, 0x2b, 0xea, 0xfa, 0x67, 0x00, 0x63, 0x4d, 0x64, 0x69, 0x8f, 0x01,
+	0x00, 0x00,
+}
+
+func (m *TransferNFTAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferNFTAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	return n
+}
+
+func (m *NFTReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferNFTAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferNFTAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferNFTAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, NFTReference{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package nft_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestTransferNFTAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(nft.StoreKey), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(cmtproto.Header{})
+	sender := sdk.AccAddress("sender").String()
+	receiver := sdk.AccAddress("receiver").String()
+	send := func(classID, id string) *nft.MsgSend {
+		return &nft.MsgSend{ClassId: classID, Id: id, Sender: sender, Receiver: receiver}
+	}
+
+	authorization := nft.NewTransferNFTAuthorization([]string{"kitty"}, []nft.NFTReference{{ClassId: "doggy", Id: "rex"}}, 2)
+	require.Equal(t, "/cosmos.nft.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	_, err := authorization.Accept(ctx, send("doggy", "fido"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = authorization.Accept(ctx, &nft.MsgUpdateURI{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	resp, err := authorization.Accept(ctx, send("kitty", "kitty1"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, uint64(1), resp.Updated.(*nft.TransferNFTAuthorization).MaxUses)
+
+	resp, err = resp.Updated.Accept(ctx, send("doggy", "rex"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// any nft can be sent any number of times without restriction
+	authorization = nft.NewTransferNFTAuthorization(nil, nil, 0)
+	resp, err = authorization.Accept(ctx, send("doggy", "fido"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
+
+func TestTransferNFTAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		msg           string
+		authorization *nft.TransferNFTAuthorization
+		expErr        error
+	}{
+		{"valid", nft.NewTransferNFTAuthorization([]string{"kitty"}, []nft.NFTReference{{ClassId: "kitty", Id: "kitty1"}}, 1), nil},
+		{"empty class id", nft.NewTransferNFTAuthorization([]string{""}, nil, 0), nft.ErrEmptyClassID},
+		{"duplicate class id", nft.NewTransferNFTAuthorization([]string{"kitty", "kitty"}, nil, 0), sdkerrors.ErrInvalidRequest},
+		{"empty nft id", nft.NewTransferNFTAuthorization(nil, []nft.NFTReference{{ClassId: "kitty"}}, 0), nft.ErrEmptyNFTID},
+		{"duplicate nft", nft.NewTransferNFTAuthorization(nil, []nft.NFTReference{{ClassId: "kitty", Id: "kitty1"}, {ClassId: "kitty", Id: "kitty1"}}, 0), sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package nft
+
+import (
+	"strings"
+
+	"cosmossdk.io/core/address"
+)
+
+// Validate checks that the reserved class prefix is not empty, and that its owner, if any, is a valid address
+func (p ReservedClassPrefix) Validate(ac address.Codec) error {
+	if len(strings.TrimSpace(p.Prefix)) == 0 {
+		return ErrInvalidClassPrefix.Wrap("empty prefix")
+	}
+
+	if len(p.Owner) > 0 {
+		if _, err := ac.StringToBytes(p.Owner); err != nil {
+			return ErrInvalidClassPrefix.Wrapf("invalid owner address (%s) of prefix %s", p.Owner, p.Prefix)
+		}
+	}
+	return nil
+}
@@ -2,15 +2,55 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/nft"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// FlagID is the flag for the id of the nft to update the uri of
+	FlagID = "id"
+	// FlagEndPrice is the flag for the end price of a dutch auction
+	FlagEndPrice = "end-price"
+	// FlagClassIDs is the flag for the classes of the nfts a transfer authorization allows to send
+	FlagClassIDs = "class-ids"
+	// FlagNFTs is the flag for the nfts a transfer authorization allows to send
+	FlagNFTs = "nfts"
+	// FlagMaxUses is the flag for the number of nfts a transfer authorization allows to send
+	FlagMaxUses = "max-uses"
+	// FlagExpiration is the flag for the expiration of a transfer authorization
+	FlagExpiration = "expiration"
+	// FlagAuthority is the flag for the address of the module authority importing cw721 snapshots
+	FlagAuthority = "authority"
+	// FlagName is the flag for the JSON pointer of the name of the nfts in their data
+	FlagName = "name"
+	// FlagDescription is the flag for the JSON pointer of the description of the nfts in their data
+	FlagDescription = "description"
+	// FlagImage is the flag for the JSON pointer of the image of the nfts in their data
+	FlagImage = "image"
+	// FlagExternalURL is the flag for the JSON pointer of the external url of the nfts in their data
+	FlagExternalURL = "external-url"
+	// FlagAttribute is the flag for the traits of the nfts and the JSON pointers of their values in the data of the nfts
+	FlagAttribute = "attribute"
+	// FlagReleaseHeight is the flag for the height at which the nft of a timelocked transfer is released
+	FlagReleaseHeight = "release-height"
+	// FlagReleaseTime is the flag for the time at which the nft of a timelocked transfer is released
+	FlagReleaseTime = "release-time"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -26,6 +66,24 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdUpdateURI(),
+		NewCmdGrantUse(),
+		NewCmdRevoke(),
+		NewCmdFreezeClass(),
+		NewCmdUnfreezeClass(),
+		NewCmdCreateAuction(),
+		NewCmdBid(),
+		NewCmdCancelAuction(),
+		NewCmdGrantTransfer(),
+		NewCmdSetClassRoyalty(),
+		NewCmdRemoveClassRoyalty(),
+		NewCmdImportCw721(),
+		NewCmdSetClassMetadataMapping(),
+		NewCmdRemoveClassMetadataMapping(),
+		NewCmdTransferWithTimelock(),
+		NewCmdReclaimTimelockedTransfer(),
+		NewCmdSetClassTransferFee(),
+		NewCmdRemoveClassTransferFee(),
 	)
 
 	return nftTxCmd
@@ -63,3 +121,802 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdUpdateURI creates a CLI command for MsgUpdateURI.
+func NewCmdUpdateURI() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-uri [class-id] [uri] [uri-hash] --id [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "update the uri and uri hash of a class or of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The uri hash is the hex encoded SHA-256 hash of the content the uri points to.
+			The uri of the nft is updated by its owner when --id is set, the uri of the class by the module authority otherwise.
+
+			$ %s tx %s update-uri <class-id> <uri> <uri-hash> --id <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return fmt.Errorf("class-id cannot be empty")
+			}
+
+			if err := nft.ValidateURIHash(args[2]); err != nil {
+				return err
+			}
+
+			nftID, err := cmd.Flags().GetString(FlagID)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateURI{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      nftID,
+				Uri:     args[1],
+				UriHash: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagID, "", "The id of the nft to update the uri of, the uri of the class is updated if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdGrantUse creates a CLI command for MsgGrantUse.
+func NewCmdGrantUse() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-use [class-id] [nft-id] [grantee] [expiry] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "grant the usage of a nft to another account until the given expiry",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The expiry is a RFC3339 timestamp. The ownership of the nft is not transferred, and the grant is removed
+			when the nft changes owner or is burned.
+
+			$ %s tx %s grant-use <class-id> <nft-id> <grantee> 2024-01-01T00:00:00Z --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" || args[2] == "" {
+				return fmt.Errorf("class-id, nft-id and grantee cannot be empty")
+			}
+
+			expiry, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid expiry: %w", err)
+			}
+
+			msg := nft.MsgGrantUse{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+				Grantee: args[2],
+				Expiry:  expiry,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRevoke creates a CLI command for MsgRevoke.
+func NewCmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "revoke a nft by burning it, as the issuer of its class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke <class-id> <nft-id> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			msg := nft.MsgRevoke{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdFreezeClass creates a CLI command for MsgFreezeClass.
+func NewCmdFreezeClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-class [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "halt the mint, transfer and burn of the nfts of a class, as its admin",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s freeze-class <class-id> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return fmt.Errorf("class-id cannot be empty")
+			}
+
+			msg := nft.MsgFreezeClass{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUnfreezeClass creates a CLI command for MsgUnfreezeClass.
+func NewCmdUnfreezeClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-class [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "lift the freeze of a class, as its admin",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unfreeze-class <class-id> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return fmt.Errorf("class-id cannot be empty")
+			}
+
+			msg := nft.MsgUnfreezeClass{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCreateAuction creates a CLI command for MsgCreateAuction.
+func NewCmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [class-id] [nft-id] [english|dutch] [start-price] [duration] --from [seller]",
+		Args:  cobra.ExactArgs(5),
+		Short: "put a nft up for auction, escrowing it until the auction ends",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			An english auction is won by the highest bid, of at least the start price, when it ends. The price of a
+			dutch auction decreases linearly from the start price to the end price, and the first bid at the current
+			price buys the nft.
+
+			$ %s tx %s create-auction <class-id> <nft-id> english 100stake 24h --from <seller> --chain-id <chain-id>
+			$ %s tx %s create-auction <class-id> <nft-id> dutch 100stake 24h --end-price 10stake --from <seller> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			auctionType, ok := nft.AuctionType_value["AUCTION_TYPE_"+strings.ToUpper(args[2])]
+			if !ok || auctionType == int32(nft.AuctionTypeUnspecified) {
+				return fmt.Errorf("invalid auction type %s, expected english or dutch", args[2])
+			}
+
+			startPrice, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid start price: %w", err)
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %w", err)
+			}
+
+			msg := nft.MsgCreateAuction{
+				Seller:      clientCtx.GetFromAddress().String(),
+				ClassId:     args[0],
+				NftId:       args[1],
+				AuctionType: nft.AuctionType(auctionType),
+				StartPrice:  startPrice,
+				Duration:    duration,
+			}
+
+			endPrice, err := cmd.Flags().GetString(FlagEndPrice)
+			if err != nil {
+				return err
+			}
+			if endPrice != "" {
+				if msg.EndPrice, err = sdk.ParseCoinNormalized(endPrice); err != nil {
+					return fmt.Errorf("invalid end price: %w", err)
+				}
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagEndPrice, "", "end price of a dutch auction")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBid creates a CLI command for MsgBid.
+func NewCmdBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [auction-id] [amount] --from [bidder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "bid on a nft auction, escrowing the bid until it is outbid or the auction ends",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			A bid on a dutch auction buys the nft right away at the current price.
+
+			$ %s tx %s bid <auction-id> 100stake --from <bidder> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction-id: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := nft.MsgBid{
+				Bidder:    clientCtx.GetFromAddress().String(),
+				AuctionId: auctionID,
+				Amount:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCancelAuction creates a CLI command for MsgCancelAuction.
+func NewCmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [auction-id] --from [seller]",
+		Args:  cobra.ExactArgs(1),
+		Short: "cancel a nft auction without bid, as its seller",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s cancel-auction <auction-id> --from <seller> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction-id: %w", err)
+			}
+
+			msg := nft.MsgCancelAuction{
+				Seller:    clientCtx.GetFromAddress().String(),
+				AuctionId: auctionID,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdGrantTransfer creates a CLI command for an authz MsgGrant of a TransferNFTAuthorization.
+func NewCmdGrantTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-transfer [grantee] --from [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "authorize an account to send nfts on behalf of the granter, through x/authz",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The nfts the grantee can send are restricted to the given classes and nfts, if any, and their number is
+			limited by --max-uses, if set.
+
+			$ %s tx %s grant-transfer <grantee> --class-ids <class-id>,<class-id> --nfts <class-id>/<nft-id> --max-uses 10 --from <granter> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			classIDs, err := cmd.Flags().GetStringSlice(FlagClassIDs)
+			if err != nil {
+				return err
+			}
+
+			nftFlags, err := cmd.Flags().GetStringSlice(FlagNFTs)
+			if err != nil {
+				return err
+			}
+			nfts := make([]nft.NFTReference, len(nftFlags))
+			for i, ref := range nftFlags {
+				classID, nftID, ok := strings.Cut(ref, "/")
+				if !ok {
+					return fmt.Errorf("invalid nft %s, expected <class-id>/<nft-id>", ref)
+				}
+				nfts[i] = nft.NFTReference{ClassId: classID, Id: nftID}
+			}
+
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			authorization := nft.NewTransferNFTAuthorization(classIDs, nfts, maxUses)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagClassIDs, []string{}, "classes whose nfts the grantee can send")
+	cmd.Flags().StringSlice(FlagNFTs, []string{}, "nfts the grantee can send, as <class-id>/<nft-id>")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "number of nfts the grantee can send, 0 for no limit")
+	cmd.Flags().Int64(FlagExpiration, 0, "expiration of the authorization as a unix timestamp, 0 for no expiration")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSetClassRoyalty creates a CLI command for MsgSetClassRoyalty setting the royalty of a class.
+func NewCmdSetClassRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-class-royalty [class-id] [rate] [recipient:weight]... --from [admin]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "set the default royalty of the nfts of a class, split among recipients according to their weights",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The rate is the fraction of the sale price due as royalty, and the weights of the recipients must sum to 1.
+
+			$ %s tx %s set-class-royalty <class-id> 0.05 <recipient>:0.7 <recipient>:0.3 --from <admin> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid rate %s: %w", args[1], err)
+			}
+
+			recipients := make([]nft.RoyaltyRecipient, len(args)-2)
+			for i, arg := range args[2:] {
+				address, weightStr, ok := strings.Cut(arg, ":")
+				if !ok {
+					return fmt.Errorf("invalid recipient %s, expected <recipient>:<weight>", arg)
+				}
+				weight, err := math.LegacyNewDecFromStr(weightStr)
+				if err != nil {
+					return fmt.Errorf("invalid weight of recipient %s: %w", address, err)
+				}
+				recipients[i] = nft.RoyaltyRecipient{Address: address, Weight: weight}
+			}
+
+			msg := nft.MsgSetClassRoyalty{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				Rate:       rate,
+				Recipients: recipients,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRemoveClassRoyalty creates a CLI command for MsgSetClassRoyalty removing the royalty of a class.
+func NewCmdRemoveClassRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-class-royalty [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove the default royalty of the nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s remove-class-royalty <class-id> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetClassRoyalty{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Rate:    math.LegacyZeroDec(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdImportCw721 creates a CLI command submitting a governance proposal with a MsgImportCw721.
+func NewCmdImportCw721() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-cw721 [snapshot-file] --from [proposer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "submit a proposal to import the snapshot of cw721 contracts as nft classes",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The snapshot file is a JSON file of the form:
+			{"collections": [{"contract": "...", "class_id": "...", "name": "...", "symbol": "...",
+			  "tokens": [{"token_id": "...", "owner": "...", "token_uri": "...", "extension": {...}}]}]}
+			where class_id defaults to cw721/<contract>, and token_uri and extension are optional.
+
+			$ %s tx %s import-cw721 <snapshot-file> --from <proposer> --deposit <deposit> --title <title> --summary <summary> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			collections, err := nft.ParseCw721Snapshot(bz)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(FlagAuthority)
+			if err != nil {
+				return err
+			}
+			if authority == "" {
+				authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			}
+
+			msg := nft.MsgImportCw721{
+				Authority:   authority,
+				Collections: collections,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{&msg}); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the nft module authority (defaults to gov)")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSetClassMetadataMapping creates a CLI command for MsgSetClassMetadataMapping setting the mapping of the data of
+// the nfts of a class to ERC-721 metadata.
+func NewCmdSetClassMetadataMapping() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-class-metadata-mapping [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the mapping of the data of the nfts of a class to ERC-721 metadata",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The values are JSON pointers into the JSON form of the data of the nfts, and the attributes are given as
+			<trait-type>:<json-pointer>.
+
+			$ %s tx %s set-class-metadata-mapping <class-id> --name=/name --image=/image --attribute=color:/traits/color --from <admin> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mapping := nft.ClassMetadataMapping{ClassId: args[0]}
+			for flag, ptr := range map[string]*string{
+				FlagName:        &mapping.Name,
+				FlagDescription: &mapping.Description,
+				FlagImage:       &mapping.Image,
+				FlagExternalURL: &mapping.ExternalUrl,
+			} {
+				if *ptr, err = cmd.Flags().GetString(flag); err != nil {
+					return err
+				}
+			}
+
+			attributes, err := cmd.Flags().GetStringArray(FlagAttribute)
+			if err != nil {
+				return err
+			}
+			for _, attr := range attributes {
+				traitType, ptr, ok := strings.Cut(attr, ":")
+				if !ok {
+					return fmt.Errorf("invalid attribute %s, expected <trait-type>:<json-pointer>", attr)
+				}
+				mapping.Attributes = append(mapping.Attributes, nft.MetadataAttributeMapping{TraitType: traitType, Pointer: ptr})
+			}
+			if mapping.IsEmpty() {
+				return fmt.Errorf("the mapping maps nothing, use remove-class-metadata-mapping to remove it")
+			}
+
+			msg := nft.MsgSetClassMetadataMapping{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Mapping: mapping,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "JSON pointer of the name of the nfts in their data")
+	cmd.Flags().String(FlagDescription, "", "JSON pointer of the description of the nfts in their data")
+	cmd.Flags().String(FlagImage, "", "JSON pointer of the image of the nfts in their data")
+	cmd.Flags().String(FlagExternalURL, "", "JSON pointer of the external url of the nfts in their data")
+	cmd.Flags().StringArray(FlagAttribute, nil, "trait of the nfts as <trait-type>:<json-pointer>, can be repeated")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRemoveClassMetadataMapping creates a CLI command for MsgSetClassMetadataMapping removing the mapping of the data
+// of the nfts of a class to ERC-721 metadata.
+func NewCmdRemoveClassMetadataMapping() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-class-metadata-mapping [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove the mapping of the data of the nfts of a class to ERC-721 metadata",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s remove-class-metadata-mapping <class-id> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetClassMetadataMapping{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Mapping: nft.ClassMetadataMapping{ClassId: args[0]},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdTransferWithTimelock creates a CLI command for MsgTransferWithTimelock.
+func NewCmdTransferWithTimelock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-with-timelock [class-id] [nft-id] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer a nft released to the receiver at a given height or time, and reclaimable by the sender before",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The nft is escrowed by the module until the release height (--release-height) or the release time, a RFC3339
+			timestamp (--release-time), exactly one of which must be set.
+
+			$ %s tx %s transfer-with-timelock <class-id> <nft-id> <receiver> --release-height 1000000 --from <sender> --chain-id <chain-id>
+			$ %s tx %s transfer-with-timelock <class-id> <nft-id> <receiver> --release-time 2024-01-01T00:00:00Z --from <sender> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" || args[2] == "" {
+				return fmt.Errorf("class-id, nft-id and receiver cannot be empty")
+			}
+
+			releaseHeight, err := cmd.Flags().GetInt64(FlagReleaseHeight)
+			if err != nil {
+				return err
+			}
+
+			var releaseTime *time.Time
+			rt, err := cmd.Flags().GetString(FlagReleaseTime)
+			if err != nil {
+				return err
+			}
+			if rt != "" {
+				t, err := time.Parse(time.RFC3339, rt)
+				if err != nil {
+					return fmt.Errorf("invalid release time: %w", err)
+				}
+				releaseTime = &t
+			}
+
+			msg := nft.MsgTransferWithTimelock{
+				ClassId:       args[0],
+				Id:            args[1],
+				Sender:        clientCtx.GetFromAddress().String(),
+				Receiver:      args[2],
+				ReleaseHeight: releaseHeight,
+				ReleaseTime:   releaseTime,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagReleaseHeight, 0, "height at which the nft is released to the receiver")
+	cmd.Flags().String(FlagReleaseTime, "", "time at which the nft is released to the receiver, as a RFC3339 timestamp")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdReclaimTimelockedTransfer creates a CLI command for MsgReclaimTimelockedTransfer.
+func NewCmdReclaimTimelockedTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-timelocked-transfer [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "reclaim the nft of a timelocked transfer before its release",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s reclaim-timelocked-transfer <class-id> <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			msg := nft.MsgReclaimTimelockedTransfer{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSetClassTransferFee creates a CLI command for MsgSetClassTransferFee setting the transfer fee of a class.
+func NewCmdSetClassTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-class-transfer-fee [class-id] [flat-fee|rate] [admin|community-pool] --from [admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "set the fee charged on the transfers of the nfts of a class, collected by its admin or the community pool",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The fee is either a flat fee, charged to the sender of every transfer, or a rate, i.e. the fraction of the sale
+			price charged on the sales of the nfts.
+
+			$ %s tx %s set-class-transfer-fee <class-id> 100stake admin --from <admin> --chain-id <chain-id>
+			$ %s tx %s set-class-transfer-fee <class-id> 0.01 community-pool --from <admin> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee := nft.ClassTransferFee{ClassId: args[0], Rate: math.LegacyZeroDec()}
+			if rate, err := math.LegacyNewDecFromStr(args[1]); err == nil {
+				fee.Rate = rate
+			} else if fee.Flat, err = sdk.ParseCoinsNormalized(args[1]); err != nil {
+				return fmt.Errorf("invalid flat fee or rate %s: %w", args[1], err)
+			}
+
+			switch args[2] {
+			case "admin":
+				fee.Destination = nft.TransferFeeDestinationClassAdmin
+			case "community-pool":
+				fee.Destination = nft.TransferFeeDestinationCommunityPool
+			default:
+				return fmt.Errorf("invalid destination %s, expected admin or community-pool", args[2])
+			}
+
+			msg := nft.MsgSetClassTransferFee{
+				Sender: clientCtx.GetFromAddress().String(),
+				Fee:    fee,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRemoveClassTransferFee creates a CLI command for MsgSetClassTransferFee removing the transfer fee of a class.
+func NewCmdRemoveClassTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-class-transfer-fee [class-id] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove the fee charged on the transfers of the nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s remove-class-transfer-fee <class-id> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetClassTransferFee{
+				Sender: clientCtx.GetFromAddress().String(),
+				Fee:    nft.ClassTransferFee{ClassId: args[0], Rate: math.LegacyZeroDec()},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
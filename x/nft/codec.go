@@ -1,15 +1,42 @@
 package nft
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgUpdateURI{},
+		&MsgGrantUse{},
+		&MsgRevoke{},
+		&MsgFreezeClass{},
+		&MsgUnfreezeClass{},
+		&MsgReserveClassPrefix{},
+		&MsgReleaseClassPrefix{},
+		&MsgCreateAuction{},
+		&MsgBid{},
+		&MsgCancelAuction{},
+		&MsgSetClassRoyalty{},
+		&MsgImportCw721{},
+		&MsgSetClassMetadataMapping{},
+		&MsgTransferWithTimelock{},
+		&MsgReclaimTimelockedTransfer{},
+		&MsgSetClassTransferFee{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&TransferNFTAuthorization{},
+	)
+	// the data of the nfts imported from cw721 contracts resolves like the data of the other nfts
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&Cw721Metadata{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	msgservice.RegisterMsgServiceDesc(registry, &_AuctionMsg_serviceDesc)
 }
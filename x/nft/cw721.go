@@ -0,0 +1,124 @@
+package nft
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"cosmossdk.io/core/address"
+)
+
+// Cw721ClassIDPrefix is the prefix of the ids of the classes imported from cw721 contracts without an explicit class id
+const Cw721ClassIDPrefix = "cw721/"
+
+// ClassID returns the id of the class the collection is imported as, cw721/<contract> if it is not set
+func (c Cw721Collection) ClassID() string {
+	if len(c.ClassId) > 0 {
+		return c.ClassId
+	}
+	return Cw721ClassIDPrefix + c.Contract
+}
+
+// Validate checks that the contract of the collection is a valid address, that the class id is valid, and that the
+// tokens have valid and unique ids, valid owners and uris, and a JSON encoded extension, if any
+func (c Cw721Collection) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(c.Contract); err != nil {
+		return ErrInvalidCw721Snapshot.Wrapf("invalid contract address (%s)", c.Contract)
+	}
+	if err := ValidateClassID(c.ClassID()); err != nil {
+		return err
+	}
+
+	tokens := make(map[string]bool, len(c.Tokens))
+	for _, token := range c.Tokens {
+		if err := ValidateNFTID(token.TokenId); err != nil {
+			return err
+		}
+		if tokens[token.TokenId] {
+			return ErrInvalidCw721Snapshot.Wrapf("duplicate token %s of contract %s", token.TokenId, c.Contract)
+		}
+		tokens[token.TokenId] = true
+
+		if _, err := ac.StringToBytes(token.Owner); err != nil {
+			return ErrInvalidCw721Snapshot.Wrapf("invalid owner address (%s) of token %s", token.Owner, token.TokenId)
+		}
+		if err := ValidateURI(token.TokenUri); err != nil {
+			return err
+		}
+		if len(token.Extension) > 0 && !json.Valid([]byte(token.Extension)) {
+			return ErrInvalidCw721Snapshot.Wrapf("extension of token %s is not valid JSON", token.TokenId)
+		}
+	}
+	return nil
+}
+
+// cw721Snapshot is the JSON form of a snapshot of cw721 contracts, as dumped from the queries of the contracts: the
+// metadata extension of a token is embedded as a JSON value rather than as a string.
+type cw721Snapshot struct {
+	Collections []struct {
+		Contract string `json:"contract"`
+		ClassID  string `json:"class_id"`
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Tokens   []struct {
+			TokenID   string          `json:"token_id"`
+			Owner     string          `json:"owner"`
+			TokenURI  string          `json:"token_uri"`
+			Extension json.RawMessage `json:"extension"`
+		} `json:"tokens"`
+	} `json:"collections"`
+}
+
+// ParseCw721Snapshot parses a JSON snapshot of cw721 contracts, of the form:
+//
+//	{"collections": [{"contract": "...", "class_id": "...", "name": "...", "symbol": "...",
+//	  "tokens": [{"token_id": "...", "owner": "...", "token_uri": "...", "extension": {...}}]}]}
+//
+// where class_id, token_uri and extension are optional.
+func ParseCw721Snapshot(bz []byte) ([]Cw721Collection, error) {
+	var snapshot cw721Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, ErrInvalidCw721Snapshot.Wrap(err.Error())
+	}
+
+	collections := make([]Cw721Collection, len(snapshot.Collections))
+	for i, c := range snapshot.Collections {
+		collections[i] = Cw721Collection{
+			Contract: c.Contract,
+			ClassId:  c.ClassID,
+			Name:     c.Name,
+			Symbol:   c.Symbol,
+			Tokens:   make([]Cw721Token, len(c.Tokens)),
+		}
+		for j, t := range c.Tokens {
+			collections[i].Tokens[j] = Cw721Token{
+				TokenId:  t.TokenID,
+				Owner:    t.Owner,
+				TokenUri: t.TokenURI,
+			}
+			if len(t.Extension) > 0 && string(t.Extension) != "null" {
+				var extension bytes.Buffer
+				if err := json.Compact(&extension, t.Extension); err != nil {
+					return nil, ErrInvalidCw721Snapshot.Wrap(err.Error())
+				}
+				collections[i].Tokens[j].Extension = extension.String()
+			}
+		}
+	}
+	return collections, nil
+}
+
+// validateCw721Collections checks that the collections are valid and are imported as distinct classes
+func validateCw721Collections(collections []Cw721Collection, ac address.Codec) error {
+	classes := make(map[string]bool, len(collections))
+	for _, collection := range collections {
+		if err := collection.Validate(ac); err != nil {
+			return err
+		}
+		if classes[collection.ClassID()] {
+			return ErrInvalidCw721Snapshot.Wrapf("duplicate class %s", collection.ClassID())
+		}
+		classes[collection.ClassID()] = true
+	}
+	return nil
+}
+
@@ -0,0 +1,88 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestParseCw721Snapshot(t *testing.T) {
+	contract := sdk.AccAddress("contract").String()
+	alice := sdk.AccAddress("alice").String()
+
+	collections, err := nft.ParseCw721Snapshot([]byte(`{"collections": [{
+		"contract": "` + contract + `", "name": "Kitties", "symbol": "KIT",
+		"tokens": [
+			{"token_id": "1", "owner": "` + alice + `", "token_uri": "ipfs://kitty1", "extension": {"color": "grey", "traits": [1, 2]}},
+			{"token_id": "2", "owner": "` + alice + `", "extension": null}
+		]
+	}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []nft.Cw721Collection{{
+		Contract: contract,
+		Name:     "Kitties",
+		Symbol:   "KIT",
+		Tokens: []nft.Cw721Token{
+			{TokenId: "1", Owner: alice, TokenUri: "ipfs://kitty1", Extension: `{"color":"grey","traits":[1,2]}`},
+			{TokenId: "2", Owner: alice},
+		},
+	}}, collections)
+	require.Equal(t, "cw721/"+contract, collections[0].ClassID())
+
+	_, err = nft.ParseCw721Snapshot([]byte(`{"collections": {}}`))
+	require.ErrorIs(t, err, nft.ErrInvalidCw721Snapshot)
+}
+
+func TestCw721CollectionValidate(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+	contract := sdk.AccAddress("contract").String()
+	alice := sdk.AccAddress("alice").String()
+	valid := func() nft.Cw721Collection {
+		return nft.Cw721Collection{
+			Contract: contract,
+			Tokens:   []nft.Cw721Token{{TokenId: "1", Owner: alice, TokenUri: "ipfs://kitty1", Extension: `{}`}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*nft.Cw721Collection)
+		expErr   error
+	}{
+		{"valid", func(*nft.Cw721Collection) {}, nil},
+		{"invalid contract", func(c *nft.Cw721Collection) { c.Contract = "invalid" }, nft.ErrInvalidCw721Snapshot},
+		{"invalid class id", func(c *nft.Cw721Collection) { c.ClassId = "1kitty" }, nft.ErrInvalidClassID},
+		{"empty token id", func(c *nft.Cw721Collection) { c.Tokens[0].TokenId = "" }, nft.ErrEmptyNFTID},
+		{"duplicate token", func(c *nft.Cw721Collection) { c.Tokens = append(c.Tokens, c.Tokens[0]) }, nft.ErrInvalidCw721Snapshot},
+		{"invalid owner", func(c *nft.Cw721Collection) { c.Tokens[0].Owner = "invalid" }, nft.ErrInvalidCw721Snapshot},
+		{"invalid uri", func(c *nft.Cw721Collection) { c.Tokens[0].TokenUri = "javascript:alert(1)" }, nft.ErrInvalidURI},
+		{"invalid extension", func(c *nft.Cw721Collection) { c.Tokens[0].Extension = "{" }, nft.ErrInvalidCw721Snapshot},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			collection := valid()
+			tc.malleate(&collection)
+			if tc.expErr != nil {
+				require.ErrorIs(t, collection.Validate(ac), tc.expErr)
+				return
+			}
+			require.NoError(t, collection.Validate(ac))
+		})
+	}
+
+	msg := nft.MsgImportCw721{Authority: alice, Collections: []nft.Cw721Collection{valid()}}
+	require.NoError(t, msg.ValidateBasic())
+	msg.Collections = append(msg.Collections, valid())
+	require.ErrorIs(t, msg.ValidateBasic(), nft.ErrInvalidCw721Snapshot)
+	msg.Collections = nil
+	require.ErrorIs(t, msg.ValidateBasic(), nft.ErrInvalidCw721Snapshot)
+	msg.Authority = "invalid"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -6,10 +6,43 @@ import (
 
 // x/nft module sentinel errors
 var (
-	ErrClassExists    = errors.Register(ModuleName, 3, "nft class already exists")
-	ErrClassNotExists = errors.Register(ModuleName, 4, "nft class does not exist")
-	ErrNFTExists      = errors.Register(ModuleName, 5, "nft already exists")
-	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
-	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
-	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrClassExists          = errors.Register(ModuleName, 3, "nft class already exists")
+	ErrClassNotExists       = errors.Register(ModuleName, 4, "nft class does not exist")
+	ErrNFTExists            = errors.Register(ModuleName, 5, "nft already exists")
+	ErrNFTNotExists         = errors.Register(ModuleName, 6, "nft does not exist")
+	ErrEmptyClassID         = errors.Register(ModuleName, 7, "empty class id")
+	ErrEmptyNFTID           = errors.Register(ModuleName, 8, "empty nft id")
+	ErrInvalidSchema        = errors.Register(ModuleName, 9, "invalid nft data schema")
+	ErrInvalidNFTData       = errors.Register(ModuleName, 10, "nft data does not match class data schema")
+	ErrInvalidClassStats    = errors.Register(ModuleName, 11, "invalid nft class stats")
+	ErrInvalidURIHash       = errors.Register(ModuleName, 12, "invalid uri hash")
+	ErrURIHashMismatch      = errors.Register(ModuleName, 13, "uri content does not match uri hash")
+	ErrUsageGrantExists     = errors.Register(ModuleName, 14, "nft usage grant already exists")
+	ErrUsageGrantNotExists  = errors.Register(ModuleName, 15, "nft usage grant does not exist")
+	ErrInvalidUsageExpiry   = errors.Register(ModuleName, 16, "invalid nft usage grant expiry")
+	ErrNonTransferable      = errors.Register(ModuleName, 17, "nft class is non-transferable")
+	ErrNotRevocable         = errors.Register(ModuleName, 18, "nft class is not revocable")
+	ErrClassFrozen          = errors.Register(ModuleName, 19, "nft class is frozen")
+	ErrClassNotFrozen       = errors.Register(ModuleName, 20, "nft class is not frozen")
+	ErrNotFreezable         = errors.Register(ModuleName, 21, "nft class is not freezable")
+	ErrPaused               = errors.Register(ModuleName, 22, "nft class or nft is paused by the circuit breaker")
+	ErrInvalidClassData     = errors.Register(ModuleName, 23, "nft class data does not match class json schema")
+	ErrInvalidClassPrefix   = errors.Register(ModuleName, 24, "invalid nft class id prefix")
+	ErrClassPrefixReserved  = errors.Register(ModuleName, 25, "nft class id prefix is reserved")
+	ErrClassPrefixNotFound  = errors.Register(ModuleName, 26, "nft class id prefix is not reserved")
+	ErrInvalidAuction       = errors.Register(ModuleName, 27, "invalid nft auction")
+	ErrAuctionNotFound      = errors.Register(ModuleName, 28, "nft auction does not exist")
+	ErrInvalidBid           = errors.Register(ModuleName, 29, "invalid nft auction bid")
+	ErrAuctionHasBid        = errors.Register(ModuleName, 30, "nft auction has a bid")
+	ErrInvalidRoyalty       = errors.Register(ModuleName, 31, "invalid nft class royalty")
+	ErrInvalidClassID       = errors.Register(ModuleName, 32, "invalid nft class id")
+	ErrInvalidNFTID         = errors.Register(ModuleName, 33, "invalid nft id")
+	ErrInvalidURI           = errors.Register(ModuleName, 34, "invalid nft uri")
+	ErrInvalidCw721Snapshot = errors.Register(ModuleName, 35, "invalid cw721 snapshot")
+
+	ErrInvalidMetadataMapping = errors.Register(ModuleName, 36, "invalid nft class metadata mapping")
+	ErrInvalidOwnerProof      = errors.Register(ModuleName, 37, "invalid nft owner proof")
+	ErrInvalidTimelock        = errors.Register(ModuleName, 38, "invalid nft timelocked transfer")
+	ErrTimelockNotFound       = errors.Register(ModuleName, 39, "nft timelocked transfer does not exist")
+	ErrInvalidTransferFee     = errors.Register(ModuleName, 40, "invalid nft class transfer fee")
 )
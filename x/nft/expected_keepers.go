@@ -6,12 +6,28 @@ import (
 	"cosmossdk.io/core/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
 // dependencies.
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error
+}
+
+// AuctionBankKeeper defines the contract needed by the auctions to escrow the
+// bids in the nft module account.
+type AuctionBankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// CommunityPoolKeeper defines the contract needed to route the transfer fees
+// of the classes to the community pool, usually fulfilled by the distribution
+// keeper.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // AccountKeeper defines the contract required for account APIs.
@@ -20,3 +36,11 @@ type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() address.Codec
 }
+
+// CircuitBreaker defines the contract required to pause nft classes and nfts,
+// usually fulfilled by the circuit keeper.
+type CircuitBreaker interface {
+	// IsNFTAllowed returns false if the given class, or the given nft of the
+	// class, is paused. nftID is empty for the Msg's acting on a class.
+	IsNFTAllowed(ctx sdk.Context, classID, nftID string) bool
+}
@@ -10,7 +10,38 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if len(class.Issuer) > 0 {
+			if _, err := ac.StringToBytes(class.Issuer); err != nil {
+				return err
+			}
+		}
+		if len(class.Admin) > 0 {
+			if _, err := ac.StringToBytes(class.Admin); err != nil {
+				return err
+			}
+		} else if class.Frozen {
+			return ErrNotFreezable.Wrapf("frozen class %s has no admin", class.Id)
+		}
+	}
+	for _, schema := range data.DataSchemas {
+		if len(schema.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(schema.TypeUrl) == 0 {
+			return ErrInvalidSchema.Wrapf("empty type url for class %s", schema.ClassId)
+		}
+	}
+	jsonSchemas := make(map[string]bool)
+	for _, schema := range data.JsonSchemas {
+		if err := schema.Validate(); err != nil {
+			return err
+		}
+		if jsonSchemas[schema.ClassId] {
+			return ErrInvalidSchema.Wrapf("duplicate json schema for class %s", schema.ClassId)
+		}
+		jsonSchemas[schema.ClassId] = true
 	}
+	supplies := make(map[string]uint64)
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
@@ -19,9 +50,106 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			if _, err := ac.StringToBytes(entry.Owner); err != nil {
 				return err
 			}
+			supplies[nft.ClassId]++
+		}
+	}
+	for _, stats := range data.ClassStats {
+		if len(stats.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if stats.TotalMinted < stats.TotalBurned || stats.TotalMinted-stats.TotalBurned != supplies[stats.ClassId] {
+			return ErrInvalidClassStats.Wrapf("minted %d and burned %d nfts of class %s, but %d nfts exist",
+				stats.TotalMinted, stats.TotalBurned, stats.ClassId, supplies[stats.ClassId])
+		}
+	}
+	granted := make(map[string]bool)
+	for _, grant := range data.UsageGrants {
+		if len(grant.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(grant.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		if _, err := ac.StringToBytes(grant.Grantee); err != nil {
+			return err
+		}
+		key := grant.ClassId + "/" + grant.Id
+		if granted[key] {
+			return ErrUsageGrantExists.Wrapf("duplicate usage grant of nft %s of class %s", grant.Id, grant.ClassId)
+		}
+		granted[key] = true
+	}
+	reserved := make(map[string]bool)
+	for _, reservation := range data.ReservedClassPrefixes {
+		if err := reservation.Validate(ac); err != nil {
+			return err
+		}
+		if reserved[reservation.Prefix] {
+			return ErrInvalidClassPrefix.Wrapf("duplicate reserved prefix %s", reservation.Prefix)
+		}
+		reserved[reservation.Prefix] = true
+	}
+	auctions := make(map[uint64]bool)
+	auctioned := make(map[string]bool)
+	for _, auction := range data.Auctions {
+		if err := auction.Validate(ac); err != nil {
+			return err
+		}
+		if auction.Id >= data.NextAuctionId {
+			return ErrInvalidAuction.Wrapf("auction id %d is not below the next auction id %d", auction.Id, data.NextAuctionId)
+		}
+		if auctions[auction.Id] {
+			return ErrInvalidAuction.Wrapf("duplicate auction %d", auction.Id)
+		}
+		auctions[auction.Id] = true
+		key := auction.ClassId + "/" + auction.NftId
+		if auctioned[key] {
+			return ErrInvalidAuction.Wrapf("duplicate auction of nft %s of class %s", auction.NftId, auction.ClassId)
+		}
+		auctioned[key] = true
+	}
+	royalties := make(map[string]bool)
+	for _, royalty := range data.Royalties {
+		if err := royalty.Validate(ac); err != nil {
+			return err
+		}
+		if royalties[royalty.ClassId] {
+			return ErrInvalidRoyalty.Wrapf("duplicate royalty of class %s", royalty.ClassId)
+		}
+		royalties[royalty.ClassId] = true
+	}
+	mappings := make(map[string]bool)
+	for _, mapping := range data.MetadataMappings {
+		if err := mapping.Validate(); err != nil {
+			return err
+		}
+		if mappings[mapping.ClassId] {
+			return ErrInvalidMetadataMapping.Wrapf("duplicate metadata mapping of class %s", mapping.ClassId)
+		}
+		mappings[mapping.ClassId] = true
+	}
+	timelocked := make(map[string]bool)
+	for _, transfer := range data.TimelockedTransfers {
+		if err := transfer.Validate(ac); err != nil {
+			return err
+		}
+		key := transfer.ClassId + "/" + transfer.Id
+		if timelocked[key] {
+			return ErrInvalidTimelock.Wrapf("duplicate timelocked transfer of nft %s of class %s", transfer.Id, transfer.ClassId)
+		}
+		timelocked[key] = true
+	}
+	fees := make(map[string]bool)
+	for _, fee := range data.TransferFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+		if fees[fee.ClassId] {
+			return ErrInvalidTransferFee.Wrapf("duplicate transfer fee of class %s", fee.ClassId)
 		}
+		fees[fee.ClassId] = true
 	}
-	return nil
+	return validateCw721Collections(data.Cw721Imports, ac)
 }
 
 // DefaultGenesisState - Returns a default genesis state
@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,31 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// data_schemas defines the registered NFT data schemas of the classes.
+	DataSchemas []*ClassDataSchema `protobuf:"bytes,3,rep,name=data_schemas,json=dataSchemas,proto3" json:"data_schemas,omitempty"`
+	// class_stats defines the supply and holder statistics of the classes.
+	ClassStats []*ClassStats `protobuf:"bytes,4,rep,name=class_stats,json=classStats,proto3" json:"class_stats,omitempty"`
+	// usage_grants defines the unexpired usage grants of the nfts.
+	UsageGrants []*UsageGrant `protobuf:"bytes,5,rep,name=usage_grants,json=usageGrants,proto3" json:"usage_grants,omitempty"`
+	// json_schemas defines the registered JSON schemas of the classes.
+	JsonSchemas []*ClassJSONSchema `protobuf:"bytes,6,rep,name=json_schemas,json=jsonSchemas,proto3" json:"json_schemas,omitempty"`
+	// reserved_class_prefixes defines the class id prefixes reserved by governance.
+	ReservedClassPrefixes []*ReservedClassPrefix `protobuf:"bytes,7,rep,name=reserved_class_prefixes,json=reservedClassPrefixes,proto3" json:"reserved_class_prefixes,omitempty"`
+	// auctions defines the open auctions, if the auctions are enabled.
+	Auctions []*Auction `protobuf:"bytes,8,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// next_auction_id defines the id of the next auction to be created.
+	NextAuctionId uint64 `protobuf:"varint,9,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
+	// royalties defines the default royalties of the classes.
+	Royalties []*ClassRoyalty `protobuf:"bytes,10,rep,name=royalties,proto3" json:"royalties,omitempty"`
+	// cw721_imports defines the snapshots of cw721 contracts imported as classes at genesis. They are not exported, as
+	// the imported classes and nfts are exported with the others.
+	Cw721Imports []Cw721Collection `protobuf:"bytes,11,rep,name=cw721_imports,json=cw721Imports,proto3" json:"cw721_imports"`
+	// metadata_mappings defines the mappings of the data of the nfts of the classes to ERC-721 metadata.
+	MetadataMappings []ClassMetadataMapping `protobuf:"bytes,12,rep,name=metadata_mappings,json=metadataMappings,proto3" json:"metadata_mappings"`
+	// timelocked_transfers defines the pending time-locked transfers, whose nfts are escrowed by the module account.
+	TimelockedTransfers []TimelockedTransfer `protobuf:"bytes,13,rep,name=timelocked_transfers,json=timelockedTransfers,proto3" json:"timelocked_transfers"`
+	// transfer_fees defines the transfer fees of the classes.
+	TransferFees []ClassTransferFee `protobuf:"bytes,14,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +103,90 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetDataSchemas() []*ClassDataSchema {
+	if m != nil {
+		return m.DataSchemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassStats() []*ClassStats {
+	if m != nil {
+		return m.ClassStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsageGrants() []*UsageGrant {
+	if m != nil {
+		return m.UsageGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJsonSchemas() []*ClassJSONSchema {
+	if m != nil {
+		return m.JsonSchemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReservedClassPrefixes() []*ReservedClassPrefix {
+	if m != nil {
+		return m.ReservedClassPrefixes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctions() []*Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAuctionId() uint64 {
+	if m != nil {
+		return m.NextAuctionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRoyalties() []*ClassRoyalty {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCw721Imports() []Cw721Collection {
+	if m != nil {
+		return m.Cw721Imports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMetadataMappings() []ClassMetadataMapping {
+	if m != nil {
+		return m.MetadataMappings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedTransfers() []TimelockedTransfer {
+	if m != nil {
+		return m.TimelockedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferFees() []ClassTransferFee {
+	if m != nil {
+		return m.TransferFees
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -140,22 +250,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x44, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x40,
-	0x3e, 0x4f, 0x62, 0x03, 0x7b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8, 0x93, 0x50,
-	0x50, 0x01, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4d, 0x4f, 0x14, 0x4d,
+	0x10, 0xc7, 0x77, 0x1e, 0x96, 0xb7, 0xde, 0xdd, 0x47, 0x6d, 0x31, 0xb4, 0x68, 0xc6, 0x0d, 0x1a,
+	0xdc, 0x44, 0xb3, 0x1b, 0xe0, 0xc0, 0x4d, 0x03, 0x28, 0x04, 0x12, 0xc0, 0x34, 0x78, 0xd1, 0xc3,
+	0xa4, 0x99, 0xa9, 0x5d, 0x47, 0x76, 0xba, 0x37, 0x5d, 0xcd, 0xdb, 0xb7, 0xf0, 0x63, 0x71, 0xe4,
+	0xe8, 0xc9, 0x18, 0x38, 0xfb, 0x1d, 0x4c, 0xf7, 0xbc, 0x10, 0xb3, 0x33, 0x7a, 0xab, 0xad, 0xfe,
+	0xfd, 0xff, 0x55, 0x35, 0x5b, 0xdd, 0xa4, 0x1d, 0x2a, 0x4c, 0x14, 0xf6, 0x64, 0xdf, 0xf4, 0xce,
+	0x96, 0x8f, 0xc1, 0x88, 0xe5, 0xde, 0x00, 0x24, 0x60, 0x8c, 0xdd, 0x91, 0x56, 0x46, 0x51, 0x9a,
+	0x12, 0x5d, 0xd9, 0x37, 0xdd, 0x8c, 0x58, 0x28, 0x53, 0x89, 0xd3, 0xd0, 0xc4, 0x4a, 0xa6, 0xaa,
+	0x05, 0xbf, 0x84, 0x08, 0xcf, 0xd7, 0x56, 0x96, 0xb3, 0xf3, 0xa7, 0x25, 0xe7, 0xb6, 0x42, 0x7a,
+	0x3a, 0x37, 0x50, 0x03, 0xe5, 0xc2, 0x9e, 0x8d, 0xd2, 0xec, 0xe2, 0xaf, 0x69, 0xd2, 0xdc, 0x4e,
+	0x7b, 0x3b, 0x34, 0xc2, 0x00, 0x5d, 0x25, 0xd3, 0xe1, 0x50, 0x20, 0x02, 0x32, 0xaf, 0x3d, 0xd1,
+	0x69, 0xac, 0x3c, 0xee, 0x8e, 0x37, 0xdb, 0xdd, 0xb4, 0x08, 0xcf, 0x49, 0x2b, 0x02, 0x69, 0x74,
+	0x0c, 0xc8, 0xfe, 0xab, 0x16, 0xbd, 0x97, 0x46, 0x5f, 0xf2, 0x9c, 0xa4, 0x5b, 0xa4, 0x19, 0x09,
+	0x23, 0x02, 0x0c, 0xbf, 0x40, 0x22, 0x90, 0x4d, 0x38, 0xe5, 0xf3, 0xca, 0x72, 0xef, 0x84, 0x11,
+	0x87, 0x8e, 0xe5, 0x8d, 0xa8, 0x88, 0x91, 0xbe, 0x25, 0x0d, 0xd7, 0x47, 0x80, 0x46, 0x18, 0x64,
+	0x75, 0x67, 0xe3, 0x57, 0xda, 0xd8, 0x31, 0x91, 0x93, 0xb0, 0x88, 0xe9, 0x3a, 0x69, 0x9e, 0xa2,
+	0x18, 0x40, 0x30, 0xd0, 0x42, 0x1a, 0x64, 0x93, 0xd5, 0x0e, 0x1f, 0x2d, 0xb7, 0x6d, 0x31, 0xde,
+	0x38, 0x2d, 0x62, 0x37, 0xcb, 0x57, 0x54, 0xb2, 0x98, 0x65, 0xea, 0x1f, 0xb3, 0xec, 0x1e, 0x1e,
+	0xec, 0xe7, 0xb3, 0x58, 0x61, 0x3e, 0x4b, 0x40, 0xe6, 0x35, 0x20, 0xe8, 0x33, 0x88, 0x82, 0x74,
+	0xa8, 0x91, 0x86, 0x7e, 0x7c, 0x01, 0xc8, 0xa6, 0x9d, 0xe5, 0xcb, 0x32, 0x4b, 0x9e, 0x49, 0x9c,
+	0xf5, 0x07, 0x27, 0xe0, 0x8f, 0xf4, 0x78, 0x12, 0x90, 0xae, 0x91, 0x99, 0x6c, 0xa9, 0x90, 0xcd,
+	0x38, 0xc7, 0x27, 0x65, 0x8e, 0xeb, 0x29, 0xc3, 0x0b, 0x98, 0x2e, 0x91, 0x7b, 0x12, 0x2e, 0x4c,
+	0x90, 0x25, 0x82, 0x38, 0x62, 0xb3, 0x6d, 0xaf, 0x53, 0xe7, 0x2d, 0x9b, 0xce, 0xf8, 0x9d, 0x88,
+	0xbe, 0x21, 0xb3, 0x5a, 0x5d, 0x8a, 0xa1, 0xb1, 0xcb, 0x40, 0x5c, 0x85, 0x76, 0xf5, 0x06, 0x39,
+	0xf2, 0x92, 0xdf, 0x49, 0xe8, 0x3e, 0x69, 0xb9, 0x9d, 0x0e, 0xe2, 0x64, 0xa4, 0xb4, 0x41, 0xd6,
+	0xf8, 0xcb, 0xa7, 0xb4, 0xe0, 0xa6, 0x1a, 0x0e, 0xc1, 0x55, 0xdf, 0xa8, 0x5f, 0xfd, 0x78, 0x56,
+	0xe3, 0x4d, 0xa7, 0xdf, 0x49, 0xe5, 0xf4, 0x33, 0x79, 0x90, 0x80, 0x11, 0x6e, 0xd3, 0x12, 0x31,
+	0x1a, 0xc5, 0x72, 0x80, 0xac, 0xe9, 0x3c, 0x3b, 0x95, 0x7d, 0xed, 0x65, 0x8a, 0xbd, 0x54, 0x90,
+	0x19, 0xdf, 0x4f, 0xfe, 0x4c, 0xdb, 0xbf, 0x6b, 0xce, 0xc4, 0x09, 0x0c, 0x55, 0x78, 0x02, 0x51,
+	0x60, 0xb4, 0x90, 0xd8, 0x07, 0x8d, 0xac, 0xe5, 0xfc, 0x97, 0xca, 0xfc, 0x8f, 0x0a, 0xfe, 0x28,
+	0xc3, 0x33, 0xf7, 0x87, 0x66, 0xec, 0x04, 0xe9, 0x01, 0x69, 0xe5, 0xae, 0x41, 0x1f, 0x00, 0xd9,
+	0xff, 0xce, 0xf9, 0x45, 0x65, 0xe7, 0xb9, 0x74, 0x0b, 0x20, 0xff, 0x1c, 0xe6, 0x2e, 0x85, 0x8b,
+	0xbb, 0x64, 0xd2, 0x5d, 0x43, 0x3a, 0x47, 0x26, 0xd5, 0xb9, 0x04, 0xcd, 0xbc, 0xb6, 0xd7, 0x99,
+	0xe5, 0xe9, 0x0f, 0xfa, 0x8a, 0xd4, 0x65, 0xdf, 0xe4, 0xb7, 0x78, 0xbe, 0xac, 0xcc, 0xfe, 0xd6,
+	0x11, 0x77, 0xd0, 0xc6, 0xeb, 0xab, 0x1b, 0xdf, 0xbb, 0xbe, 0xf1, 0xbd, 0x9f, 0x37, 0xbe, 0xf7,
+	0xed, 0xd6, 0xaf, 0x5d, 0xdf, 0xfa, 0xb5, 0xef, 0xb7, 0x7e, 0xed, 0x53, 0xf6, 0xbe, 0x61, 0x74,
+	0xd2, 0x8d, 0x55, 0xef, 0xc2, 0xbe, 0x42, 0xc7, 0x53, 0xee, 0xc1, 0x59, 0xfd, 0x3d, 0x00, 0x52,
+	0xee, 0xa9, 0x2c, 0x1e, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +311,165 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TimelockedTransfers) > 0 {
+		for iNdEx := len(m.TimelockedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MetadataMappings) > 0 {
+		for iNdEx := len(m.MetadataMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Cw721Imports) > 0 {
+		for iNdEx := len(m.Cw721Imports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cw721Imports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextAuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAuctionId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReservedClassPrefixes) > 0 {
+		for iNdEx := len(m.ReservedClassPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedClassPrefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.JsonSchemas) > 0 {
+		for iNdEx := len(m.JsonSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JsonSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UsageGrants) > 0 {
+		for iNdEx := len(m.UsageGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClassStats) > 0 {
+		for iNdEx := len(m.ClassStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DataSchemas) > 0 {
+		for iNdEx := len(m.DataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +574,75 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DataSchemas) > 0 {
+		for _, e := range m.DataSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassStats) > 0 {
+		for _, e := range m.ClassStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsageGrants) > 0 {
+		for _, e := range m.UsageGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JsonSchemas) > 0 {
+		for _, e := range m.JsonSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReservedClassPrefixes) > 0 {
+		for _, e := range m.ReservedClassPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAuctionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextAuctionId))
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Cw721Imports) > 0 {
+		for _, e := range m.Cw721Imports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MetadataMappings) > 0 {
+		for _, e := range m.MetadataMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedTransfers) > 0 {
+		for _, e := range m.TimelockedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -407,6 +768,399 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSchemas = append(m.DataSchemas, &ClassDataSchema{})
+			if err := m.DataSchemas[len(m.DataSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassStats = append(m.ClassStats, &ClassStats{})
+			if err := m.ClassStats[len(m.ClassStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageGrants = append(m.UsageGrants, &UsageGrant{})
+			if err := m.UsageGrants[len(m.UsageGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchemas = append(m.JsonSchemas, &ClassJSONSchema{})
+			if err := m.JsonSchemas[len(m.JsonSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedClassPrefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedClassPrefixes = append(m.ReservedClassPrefixes, &ReservedClassPrefix{})
+			if err := m.ReservedClassPrefixes[len(m.ReservedClassPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuctionId", wireType)
+			}
+			m.NextAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, &ClassRoyalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw721Imports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cw721Imports = append(m.Cw721Imports, Cw721Collection{})
+			if err := m.Cw721Imports[len(m.Cw721Imports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataMappings = append(m.MetadataMappings, ClassMetadataMapping{})
+			if err := m.MetadataMappings[len(m.MetadataMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedTransfers = append(m.TimelockedTransfers, TimelockedTransfer{})
+			if err := m.TimelockedTransfers[len(m.TimelockedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, ClassTransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
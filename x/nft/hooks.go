@@ -0,0 +1,77 @@
+package nft
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTHooks defines the hooks external modules, e.g. royalty, rental or
+// indexing modules, can register on the nft keeper to react to the nft
+// lifecycle. They are called on every mint, burn and transfer, whether it is
+// made by a Msg, a batch Msg or another module. An error returned by a hook
+// aborts the operation.
+type NFTHooks interface {
+	// BeforeNFTTransfer is called before a nft is transferred from sender to
+	// receiver. Returning an error prevents the transfer.
+	BeforeNFTTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	// AfterNFTTransfer is called after a nft is transferred from sender to
+	// receiver.
+	AfterNFTTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	// AfterNFTMint is called after a nft is minted to owner.
+	AfterNFTMint(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error
+	// AfterNFTBurn is called after a nft owned by owner is burned.
+	AfterNFTBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error
+}
+
+// NFTHooksWrapper is a wrapper for modules to inject NFTHooks using depinject.
+type NFTHooksWrapper struct{ NFTHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (NFTHooksWrapper) IsOnePerModuleType() {}
+
+var _ NFTHooks = MultiNFTHooks{}
+
+// MultiNFTHooks combines multiple nft hooks, called in order.
+type MultiNFTHooks []NFTHooks
+
+// NewMultiNFTHooks returns the given hooks combined.
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+func (h MultiNFTHooks) BeforeNFTTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeNFTTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterNFTTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterNFTTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterNFTMint(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterNFTMint(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterNFTBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterNFTBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
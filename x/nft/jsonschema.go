@@ -0,0 +1,464 @@
+package nft
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxJSONSchemaDepth is the maximum nesting depth of the subschemas of a JSON schema
+	MaxJSONSchemaDepth = 32
+
+	// maxJSONNumberExponent bounds the exponent of the JSON numbers, so that comparing them as exact rationals stays
+	// cheap
+	maxJSONNumberExponent = 1000
+)
+
+// jsonSchemaAnnotations are the keywords of a JSON schema which do not take part in the validation
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+}
+
+// jsonTypes are the JSON schema type names
+var jsonTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"integer": true,
+	"string":  true,
+}
+
+// JSONSchema is a parsed JSON schema. Only the following subset of the JSON schema keywords is supported, so that the
+// validation is deterministic and cheap: type, enum, const, properties, required, additionalProperties (a boolean),
+// items (a single schema), minItems, maxItems, minLength, maxLength, pattern, minimum and maximum, besides the
+// annotations ($schema, $id, $comment, title, description, default, examples). A schema using any other keyword is
+// rejected rather than partially enforced. Numbers are compared as exact rationals.
+type JSONSchema struct {
+	Types                []string
+	Enum                 []interface{}
+	Properties           map[string]*JSONSchema
+	Required             []string
+	AdditionalProperties *bool
+	Items                *JSONSchema
+	MinItems, MaxItems   *uint64
+	MinLength, MaxLength *uint64
+	Pattern              *regexp.Regexp
+	Minimum, Maximum     *big.Rat
+}
+
+// JSONSchemaViolation describes where, as a JSON pointer, and why a JSON document does not conform to a JSON schema
+type JSONSchemaViolation struct {
+	Path    string
+	Message string
+}
+
+func (v JSONSchemaViolation) String() string {
+	return fmt.Sprintf("%s: %s", pointer(v.Path), v.Message)
+}
+
+// JSONSchemaViolations are the violations of a JSON schema by a JSON document, in a deterministic order: the violations
+// of a value come before the ones of its items, and the properties of an object are visited by name
+type JSONSchemaViolations []JSONSchemaViolation
+
+func (vs JSONSchemaViolations) Error() string {
+	msgs := make([]string, len(vs))
+	for i, v := range vs {
+		msgs[i] = v.String()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ParseJSONSchema parses the given JSON schema document
+func ParseJSONSchema(doc string) (*JSONSchema, error) {
+	value, err := decodeJSON([]byte(doc))
+	if err != nil {
+		return nil, fmt.Errorf("malformed json schema: %w", err)
+	}
+	return parseJSONSchema(value, "", 0)
+}
+
+func parseJSONSchema(value interface{}, path string, depth int) (*JSONSchema, error) {
+	if depth > MaxJSONSchemaDepth {
+		return nil, fmt.Errorf("%s: json schema is nested deeper than %d levels", pointer(path), MaxJSONSchemaDepth)
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: json schema must be an object", pointer(path))
+	}
+
+	schema := &JSONSchema{}
+	for _, keyword := range sortedKeys(obj) {
+		raw := obj[keyword]
+		at := path + "/" + escapePointer(keyword)
+		var err error
+		switch keyword {
+		case "type":
+			schema.Types, err = parseTypes(raw)
+		case "enum":
+			enum, ok := raw.([]interface{})
+			if !ok || len(enum) == 0 {
+				err = fmt.Errorf("must be a non-empty array")
+			}
+			schema.Enum = enum
+		case "const":
+			schema.Enum = []interface{}{raw}
+		case "properties":
+			props, ok := raw.(map[string]interface{})
+			if !ok {
+				err = fmt.Errorf("must be an object")
+				break
+			}
+			schema.Properties = make(map[string]*JSONSchema, len(props))
+			for _, name := range sortedKeys(props) {
+				prop, err := parseJSONSchema(props[name], at+"/"+escapePointer(name), depth+1)
+				if err != nil {
+					return nil, err
+				}
+				schema.Properties[name] = prop
+			}
+		case "required":
+			schema.Required, err = parseStrings(raw)
+		case "additionalProperties":
+			allowed, ok := raw.(bool)
+			if !ok {
+				err = fmt.Errorf("only boolean values are supported")
+			}
+			schema.AdditionalProperties = &allowed
+		case "items":
+			schema.Items, err = parseJSONSchema(raw, at, depth+1)
+			if err != nil {
+				return nil, err
+			}
+		case "minItems":
+			schema.MinItems, err = parseCount(raw)
+		case "maxItems":
+			schema.MaxItems, err = parseCount(raw)
+		case "minLength":
+			schema.MinLength, err = parseCount(raw)
+		case "maxLength":
+			schema.MaxLength, err = parseCount(raw)
+		case "pattern":
+			pattern, ok := raw.(string)
+			if !ok {
+				err = fmt.Errorf("must be a string")
+				break
+			}
+			schema.Pattern, err = regexp.Compile(pattern)
+		case "minimum":
+			schema.Minimum, err = parseNumber(raw)
+		case "maximum":
+			schema.Maximum, err = parseNumber(raw)
+		default:
+			if !jsonSchemaAnnotations[keyword] {
+				err = fmt.Errorf("unsupported json schema keyword")
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", at, err)
+		}
+	}
+	return schema, nil
+}
+
+// ValidateJSON returns the violations of the schema by the given JSON document
+func (s *JSONSchema) ValidateJSON(doc []byte) (JSONSchemaViolations, error) {
+	value, err := decodeJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations JSONSchemaViolations
+	s.validate(value, "", &violations)
+	return violations, nil
+}
+
+func (s *JSONSchema) validate(value interface{}, path string, violations *JSONSchemaViolations) {
+	report := func(format string, args ...interface{}) {
+		*violations = append(*violations, JSONSchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(s.Types) > 0 && !hasType(value, s.Types) {
+		report("expected %s, got %s", strings.Join(s.Types, " or "), typeOf(value))
+		return
+	}
+
+	if len(s.Enum) > 0 {
+		found := false
+		for _, allowed := range s.Enum {
+			if equalJSON(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report("value is not one of the allowed values")
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			report("string is shorter than %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			report("string is longer than %d characters", *s.MaxLength)
+		}
+		if s.Pattern != nil && !s.Pattern.MatchString(v) {
+			report("string does not match the pattern %s", s.Pattern)
+		}
+	case json.Number:
+		n, ok := parseRat(v)
+		if !ok {
+			report("number is out of the supported range")
+			return
+		}
+		if s.Minimum != nil && n.Cmp(s.Minimum) < 0 {
+			report("number is less than the minimum %s", s.Minimum.RatString())
+		}
+		if s.Maximum != nil && n.Cmp(s.Maximum) > 0 {
+			report("number is greater than the maximum %s", s.Maximum.RatString())
+		}
+	case []interface{}:
+		if s.MinItems != nil && uint64(len(v)) < *s.MinItems {
+			report("array has fewer than %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && uint64(len(v)) > *s.MaxItems {
+			report("array has more than %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(item, path+"/"+strconv.Itoa(i), violations)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				report("missing required property %q", name)
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			at := path + "/" + escapePointer(name)
+			if prop, ok := s.Properties[name]; ok {
+				prop.validate(v[name], at, violations)
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				*violations = append(*violations, JSONSchemaViolation{Path: at, Message: "additional property is not allowed"})
+			}
+		}
+	}
+}
+
+// Validate checks that the json schema has a sha256 hash, and that its inline schema, if any, hashes to it and is a
+// supported JSON schema
+func (s ClassJSONSchema) Validate() error {
+	if len(s.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(s.Hash) != sha256.Size {
+		return ErrInvalidSchema.Wrapf("json schema hash of class %s must be %d bytes long", s.ClassId, sha256.Size)
+	}
+
+	if len(s.Schema) == 0 {
+		return nil
+	}
+
+	if hash := sha256.Sum256([]byte(s.Schema)); !bytes.Equal(hash[:], s.Hash) {
+		return ErrInvalidSchema.Wrapf("json schema of class %s does not match its hash", s.ClassId)
+	}
+
+	if _, err := ParseJSONSchema(s.Schema); err != nil {
+		return ErrInvalidSchema.Wrapf("class %s: %s", s.ClassId, err)
+	}
+	return nil
+}
+
+// decodeJSON decodes a single JSON value, keeping the numbers exact
+func decodeJSON(doc []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after the json value")
+	}
+	return value, nil
+}
+
+func parseTypes(raw interface{}) ([]string, error) {
+	types, err := parseStrings(raw)
+	if name, ok := raw.(string); ok {
+		types, err = []string{name}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range types {
+		if !jsonTypes[name] {
+			return nil, fmt.Errorf("unknown type %q", name)
+		}
+	}
+	return types, nil
+}
+
+func parseStrings(raw interface{}) ([]string, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("must be an array of strings")
+	}
+
+	strs := make([]string, len(items))
+	for i, item := range items {
+		if strs[i], ok = item.(string); !ok {
+			return nil, fmt.Errorf("must be an array of strings")
+		}
+	}
+	return strs, nil
+}
+
+func parseCount(raw interface{}) (*uint64, error) {
+	num, ok := raw.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("must be a non-negative integer")
+	}
+
+	count, err := strconv.ParseUint(num.String(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("must be a non-negative integer")
+	}
+	return &count, nil
+}
+
+func parseNumber(raw interface{}) (*big.Rat, error) {
+	num, ok := raw.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("must be a number")
+	}
+
+	n, ok := parseRat(num)
+	if !ok {
+		return nil, fmt.Errorf("must be a number within the supported range")
+	}
+	return n, nil
+}
+
+func hasType(value interface{}, types []string) bool {
+	actual := typeOf(value)
+	for _, name := range types {
+		if name == actual || (name == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if n, ok := parseRat(v); ok && n.IsInt() {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func equalJSON(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, okA := parseRat(a)
+		y, okB := parseRat(b)
+		return okA && okB && x.Cmp(y) == 0
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalJSON(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if w, ok := b[k]; !ok || !equalJSON(v, w) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// parseRat parses a JSON number as an exact rational, refusing the numbers with an exponent out of bounds
+func parseRat(num json.Number) (*big.Rat, bool) {
+	str := num.String()
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxJSONNumberExponent || exp < -maxJSONNumberExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(str)
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapePointer escapes a property name as a JSON pointer reference token
+func escapePointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
+
+func pointer(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
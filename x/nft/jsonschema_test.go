@@ -0,0 +1,117 @@
+package nft_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/nft"
+)
+
+func TestParseJSONSchema(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		schema string
+		expErr string
+	}{
+		{"empty schema", `{}`, ""},
+		{"annotations", `{"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "kitty", "description": "a kitty"}`, ""},
+		{"type list", `{"type": ["string", "null"]}`, ""},
+		{"not an object", `[]`, "/: json schema must be an object"},
+		{"malformed", `{"type": `, "malformed json schema"},
+		{"trailing data", `{} {}`, "malformed json schema"},
+		{"unknown type", `{"type": "float"}`, `/type: unknown type "float"`},
+		{"unsupported keyword", `{"properties": {"name": {"format": "email"}}}`, "/properties/name/format: unsupported json schema keyword"},
+		{"schema additional properties", `{"additionalProperties": {"type": "string"}}`, "/additionalProperties: only boolean values are supported"},
+		{"empty enum", `{"enum": []}`, "/enum: must be a non-empty array"},
+		{"negative length", `{"minLength": -1}`, "/minLength: must be a non-negative integer"},
+		{"invalid pattern", `{"pattern": "["}`, "/pattern: error parsing regexp"},
+		{"huge exponent", `{"maximum": 1e100000}`, "/maximum: must be a number within the supported range"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := nft.ParseJSONSchema(tc.schema)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	deep := `{"items": `
+	for i := 0; i < nft.MaxJSONSchemaDepth; i++ {
+		deep += `{"items": `
+	}
+	deep += `{}`
+	for i := 0; i <= nft.MaxJSONSchemaDepth; i++ {
+		deep += `}`
+	}
+	_, err := nft.ParseJSONSchema(deep)
+	require.ErrorContains(t, err, "json schema is nested deeper than")
+}
+
+func TestJSONSchemaValidateJSON(t *testing.T) {
+	schema, err := nft.ParseJSONSchema(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 4, "pattern": "^[a-z]+$"},
+			"level": {"type": "integer", "minimum": 1, "maximum": 99},
+			"ratio": {"type": "number", "maximum": 0.5},
+			"rarity": {"enum": ["common", "rare"]},
+			"tags": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+			"a/b": {"type": "boolean"}
+		},
+		"required": ["name"],
+		"additionalProperties": false
+	}`)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		msg           string
+		doc           string
+		expViolations nft.JSONSchemaViolations
+	}{
+		{"valid", `{"name": "cat", "level": 10, "ratio": 0.25, "rarity": "rare", "tags": ["a", "b"], "a/b": true}`, nil},
+		{"integer written as a decimal", `{"name": "cat", "level": 10.0}`, nil},
+		{"multibyte characters", `{"name": "чаша"}`, []nft.JSONSchemaViolation{{Path: "/name", Message: "string does not match the pattern ^[a-z]+$"}}},
+		{"wrong type", `[]`, []nft.JSONSchemaViolation{{Path: "", Message: "expected object, got array"}}},
+		{"missing required", `{}`, []nft.JSONSchemaViolation{{Path: "", Message: `missing required property "name"`}}},
+		{
+			"several violations", `{"name": "kitty", "level": 1.5, "ratio": 0.75, "rarity": "epic", "tags": ["a", 1, "c"], "a/b": 1, "color": "red"}`,
+			[]nft.JSONSchemaViolation{
+				{Path: "/a~1b", Message: "expected boolean, got integer"},
+				{Path: "/color", Message: "additional property is not allowed"},
+				{Path: "/level", Message: "expected integer, got number"},
+				{Path: "/name", Message: "string is longer than 4 characters"},
+				{Path: "/rarity", Message: "value is not one of the allowed values"},
+				{Path: "/ratio", Message: "number is greater than the maximum 1/2"},
+				{Path: "/tags", Message: "array has more than 2 items"},
+				{Path: "/tags/1", Message: "expected string, got integer"},
+			},
+		},
+		{"number out of range", `{"name": "cat", "ratio": 1e100000}`, []nft.JSONSchemaViolation{{Path: "/ratio", Message: "number is out of the supported range"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			violations, err := schema.ValidateJSON([]byte(tc.doc))
+			require.NoError(t, err)
+			require.Equal(t, tc.expViolations, violations)
+		})
+	}
+
+	_, err = schema.ValidateJSON([]byte(`{`))
+	require.Error(t, err)
+}
+
+func TestClassJSONSchemaValidate(t *testing.T) {
+	doc := `{"type": "object"}`
+	hash := sha256.Sum256([]byte(doc))
+
+	require.NoError(t, nft.ClassJSONSchema{ClassId: "kitty", Hash: hash[:], Schema: doc}.Validate())
+	require.NoError(t, nft.ClassJSONSchema{ClassId: "kitty", Hash: hash[:]}.Validate())
+	require.ErrorIs(t, nft.ClassJSONSchema{Hash: hash[:]}.Validate(), nft.ErrEmptyClassID)
+	require.ErrorIs(t, nft.ClassJSONSchema{ClassId: "kitty", Hash: hash[:4]}.Validate(), nft.ErrInvalidSchema)
+	require.ErrorIs(t, nft.ClassJSONSchema{ClassId: "kitty", Hash: hash[:], Schema: `{"type": "array"}`}.Validate(), nft.ErrInvalidSchema)
+}
@@ -0,0 +1,498 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	_ nft.AuctionMsgServer   = AuctionKeeper{}
+	_ nft.AuctionQueryServer = AuctionKeeper{}
+)
+
+// AuctionKeeper is the optional subkeeper of the english and dutch auctions of nfts. The auctioned nfts and the bids
+// are escrowed by the nft module account until the auctions are settled by the end blocker.
+type AuctionKeeper struct {
+	k      Keeper
+	bk     nft.AuctionBankKeeper
+	escrow sdk.AccAddress
+}
+
+// NewAuctionKeeper creates a new nft AuctionKeeper instance
+func NewAuctionKeeper(k Keeper, ak nft.AccountKeeper, bk nft.AuctionBankKeeper) AuctionKeeper {
+	// ensure nft module account is set
+	escrow := ak.GetModuleAddress(nft.ModuleName)
+	if escrow == nil {
+		panic("the nft module account has not been set")
+	}
+
+	return AuctionKeeper{k: k, bk: bk, escrow: escrow}
+}
+
+// CreateAuction implements CreateAuction method of the types.AuctionMsgServer.
+func (k AuctionKeeper) CreateAuction(goCtx context.Context, msg *nft.MsgCreateAuction) (*nft.MsgCreateAuctionResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.NftId) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	seller, err := k.k.ac.StringToBytes(msg.Seller)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid seller address (%s)", msg.Seller)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.k.checkNotPaused(ctx, msg.ClassId, msg.NftId); err != nil {
+		return nil, err
+	}
+
+	owner := k.k.GetOwner(ctx, msg.ClassId, msg.NftId)
+	if !bytes.Equal(owner, seller) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Seller, msg.NftId)
+	}
+
+	auction := nft.Auction{
+		Id:          k.k.nextAuctionID(ctx),
+		AuctionType: msg.AuctionType,
+		ClassId:     msg.ClassId,
+		NftId:       msg.NftId,
+		Seller:      msg.Seller,
+		StartPrice:  msg.StartPrice,
+		EndPrice:    msg.EndPrice,
+		StartTime:   ctx.BlockTime(),
+		EndTime:     ctx.BlockTime().Add(msg.Duration),
+	}
+	if err := auction.Validate(k.k.ac); err != nil {
+		return nil, err
+	}
+
+	if err := k.k.Transfer(ctx, msg.ClassId, msg.NftId, k.escrow); err != nil {
+		return nil, err
+	}
+	k.k.setNextAuctionID(ctx, auction.Id+1)
+	k.k.setAuction(ctx, auction)
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventAuctionCreated{
+		AuctionId:   auction.Id,
+		AuctionType: auction.AuctionType,
+		ClassId:     auction.ClassId,
+		NftId:       auction.NftId,
+		Seller:      auction.Seller,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgCreateAuctionResponse{AuctionId: auction.Id}, nil
+}
+
+// Bid implements Bid method of the types.AuctionMsgServer.
+func (k AuctionKeeper) Bid(goCtx context.Context, msg *nft.MsgBid) (*nft.MsgBidResponse, error) {
+	bidder, err := k.k.ac.StringToBytes(msg.Bidder)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid bidder address (%s)", msg.Bidder)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auction, has := k.k.GetAuction(ctx, msg.AuctionId)
+	if !has {
+		return nil, errorsmod.Wrapf(nft.ErrAuctionNotFound, "%d", msg.AuctionId)
+	}
+
+	if err := k.k.checkNotPaused(ctx, auction.ClassId, auction.NftId); err != nil {
+		return nil, err
+	}
+
+	if !ctx.BlockTime().Before(auction.EndTime) {
+		return nil, errorsmod.Wrapf(nft.ErrInvalidBid, "auction %d ended at %s", auction.Id, auction.EndTime)
+	}
+
+	if auction.Seller == msg.Bidder {
+		return nil, errorsmod.Wrapf(nft.ErrInvalidBid, "seller cannot bid on auction %d", auction.Id)
+	}
+
+	minBid := MinBid(auction, ctx.BlockTime())
+	if !msg.Amount.IsValid() || msg.Amount.Denom != minBid.Denom || msg.Amount.IsLT(minBid) {
+		return nil, errorsmod.Wrapf(nft.ErrInvalidBid, "bid %s is below the minimum bid %s", msg.Amount, minBid)
+	}
+	if err := k.checkSaleCharges(ctx, auction, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if auction.AuctionType == nft.AuctionTypeDutch {
+		// only the current price is paid, and the nft is bought right away
+		if err := k.bk.SendCoinsFromAccountToModule(ctx, bidder, nft.ModuleName, sdk.NewCoins(minBid)); err != nil {
+			return nil, err
+		}
+		if err := k.sell(ctx, auction, bidder, minBid); err != nil {
+			return nil, err
+		}
+		return &nft.MsgBidResponse{}, nil
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, bidder, nft.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	if err := k.refundBid(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	auction.HighestBidder = msg.Bidder
+	auction.HighestBid = msg.Amount
+	k.k.setAuction(ctx, auction)
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventAuctionBid{
+		AuctionId: auction.Id,
+		Bidder:    msg.Bidder,
+		Amount:    msg.Amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBidResponse{}, nil
+}
+
+// CancelAuction implements CancelAuction method of the types.AuctionMsgServer.
+func (k AuctionKeeper) CancelAuction(goCtx context.Context, msg *nft.MsgCancelAuction) (*nft.MsgCancelAuctionResponse, error) {
+	seller, err := k.k.ac.StringToBytes(msg.Seller)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid seller address (%s)", msg.Seller)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auction, has := k.k.GetAuction(ctx, msg.AuctionId)
+	if !has {
+		return nil, errorsmod.Wrapf(nft.ErrAuctionNotFound, "%d", msg.AuctionId)
+	}
+
+	if auction.Seller != msg.Seller {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the seller of auction %d", msg.Seller, auction.Id)
+	}
+
+	if len(auction.HighestBidder) > 0 {
+		return nil, errorsmod.Wrapf(nft.ErrAuctionHasBid, "auction %d", auction.Id)
+	}
+
+	if err := k.k.Transfer(ctx, auction.ClassId, auction.NftId, seller); err != nil {
+		return nil, err
+	}
+	k.k.deleteAuction(ctx, auction)
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventAuctionCanceled{
+		AuctionId: auction.Id,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgCancelAuctionResponse{}, nil
+}
+
+// Auction return an open auction based on its id, with the minimum amount of the next bid
+func (k AuctionKeeper) Auction(goCtx context.Context, r *nft.QueryAuctionRequest) (*nft.QueryAuctionResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auction, has := k.k.GetAuction(ctx, r.AuctionId)
+	if !has {
+		return nil, errorsmod.Wrapf(nft.ErrAuctionNotFound, "%d", r.AuctionId)
+	}
+	return &nft.QueryAuctionResponse{
+		Auction: &auction,
+		MinBid:  MinBid(auction, ctx.BlockTime()),
+	}, nil
+}
+
+// Auctions return all the open auctions, or the auctions of a seller
+func (k AuctionKeeper) Auctions(goCtx context.Context, r *nft.QueryAuctionsRequest) (*nft.QueryAuctionsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.Seller) > 0 {
+		if _, err := k.k.ac.StringToBytes(r.Seller); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.k.storeService.OpenKVStore(ctx)
+	auctionStore := prefix.NewStore(runtime.KVStoreAdapter(store), AuctionKey)
+
+	var auctions []*nft.Auction
+	pageRes, err := query.FilteredPaginate(auctionStore, r.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var auction nft.Auction
+		if err := k.k.cdc.Unmarshal(value, &auction); err != nil {
+			return false, err
+		}
+		if len(r.Seller) > 0 && auction.Seller != r.Seller {
+			return false, nil
+		}
+		if accumulate {
+			auctions = append(auctions, &auction)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SettleAuctions settles the auctions which ended at or before the block time: the nft of an english auction goes to
+// its highest bidder, whose bid goes to the seller, and the nft of an auction without bid goes back to its seller. An
+// auction which cannot be settled, e.g. because its class is frozen, is left as is and retried on the next block.
+func (k AuctionKeeper) SettleAuctions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.k.storeService.OpenKVStore(ctx)
+	// the end is exclusive, so it is set past all the entries of the auctions ending at the block time
+	iterator, err := store.Iterator(AuctionQueueKey, storetypes.PrefixEndBytes(auctionQueueByTimeKey(sdkCtx.BlockTime())))
+	if err != nil {
+		return err
+	}
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, parseAuctionQueueStoreKey(iterator.Key()))
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.settle(cacheCtx, id); err != nil {
+			sdkCtx.Logger().Error("failed to settle nft auction", "auction_id", id, "err", err)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// settle settles the ended auction with the given id
+func (k AuctionKeeper) settle(ctx sdk.Context, id uint64) error {
+	auction, has := k.k.GetAuction(ctx, id)
+	if !has {
+		return errorsmod.Wrapf(nft.ErrAuctionNotFound, "%d", id)
+	}
+
+	// the nft may have been revoked by its issuer while escrowed
+	if !k.k.HasNFT(ctx, auction.ClassId, auction.NftId) {
+		if err := k.refundBid(ctx, auction); err != nil {
+			return err
+		}
+		return k.unsold(ctx, auction)
+	}
+
+	if len(auction.HighestBidder) > 0 {
+		bidder, err := k.k.ac.StringToBytes(auction.HighestBidder)
+		if err != nil {
+			return err
+		}
+		return k.sell(ctx, auction, bidder, auction.HighestBid)
+	}
+
+	seller, err := k.k.ac.StringToBytes(auction.Seller)
+	if err != nil {
+		return err
+	}
+	if err := k.k.Transfer(ctx, auction.ClassId, auction.NftId, seller); err != nil {
+		return err
+	}
+	return k.unsold(ctx, auction)
+}
+
+// checkSaleCharges checks that a sale of the nft of the auction at the given price covers the royalty and the
+// transfer fee of its class, which are paid out of the price
+func (k AuctionKeeper) checkSaleCharges(ctx sdk.Context, auction nft.Auction, price sdk.Coin) error {
+	prices := sdk.NewCoins(price)
+	charges, _ := k.k.ComputeRoyalty(ctx, auction.ClassId, prices)
+	if fee, has := k.k.GetClassTransferFee(ctx, auction.ClassId); has {
+		charges = charges.Add(fee.Fee(prices)...)
+	}
+	if !prices.IsAllGTE(charges) {
+		return errorsmod.Wrapf(nft.ErrInvalidBid, "price %s does not cover the royalty and the transfer fee %s of class %s",
+			price, charges, auction.ClassId)
+	}
+	return nil
+}
+
+// sell transfers the nft of the auction to the buyer, pays the royalty and the transfer fee of its class out of the
+// price escrowed by the module, and the rest of the price to the seller
+func (k AuctionKeeper) sell(ctx sdk.Context, auction nft.Auction, buyer sdk.AccAddress, price sdk.Coin) error {
+	seller, err := k.k.ac.StringToBytes(auction.Seller)
+	if err != nil {
+		return err
+	}
+	// the charges of the class may have changed since the bid
+	if err := k.checkSaleCharges(ctx, auction, price); err != nil {
+		return err
+	}
+	if err := k.k.Transfer(ctx, auction.ClassId, auction.NftId, buyer); err != nil {
+		return err
+	}
+
+	proceeds := sdk.NewCoins(price)
+	royalty, err := k.k.PayRoyalty(ctx, auction.ClassId, k.escrow, proceeds)
+	if err != nil {
+		return err
+	}
+	fee, err := k.k.PayTransferFee(ctx, auction.ClassId, auction.NftId, k.escrow, proceeds)
+	if err != nil {
+		return err
+	}
+	proceeds = proceeds.Sub(royalty...).Sub(fee...)
+	if !proceeds.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, nft.ModuleName, seller, proceeds); err != nil {
+			return err
+		}
+	}
+	k.k.deleteAuction(ctx, auction)
+
+	buyerStr, err := k.k.ac.BytesToString(buyer)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&nft.EventAuctionSettled{
+		AuctionId: auction.Id,
+		Buyer:     buyerStr,
+		Price:     price.String(),
+	})
+}
+
+// unsold removes the auction whose nft is not sold
+func (k AuctionKeeper) unsold(ctx sdk.Context, auction nft.Auction) error {
+	k.k.deleteAuction(ctx, auction)
+	return ctx.EventManager().EmitTypedEvent(&nft.EventAuctionSettled{
+		AuctionId: auction.Id,
+	})
+}
+
+// refundBid refunds the highest bid of the auction, if any, to its bidder
+func (k AuctionKeeper) refundBid(ctx sdk.Context, auction nft.Auction) error {
+	if len(auction.HighestBidder) == 0 {
+		return nil
+	}
+
+	bidder, err := k.k.ac.StringToBytes(auction.HighestBidder)
+	if err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, nft.ModuleName, bidder, sdk.NewCoins(auction.HighestBid))
+}
+
+// MinBid returns the minimum amount of the next bid on the auction at the given time: the start price or above the
+// highest bid of an english auction, and the current price of a dutch auction, which decreases linearly from its
+// start price to its end price
+func MinBid(auction nft.Auction, blockTime time.Time) sdk.Coin {
+	if auction.AuctionType == nft.AuctionTypeDutch {
+		elapsed, duration := blockTime.Sub(auction.StartTime), auction.EndTime.Sub(auction.StartTime)
+		switch {
+		case elapsed <= 0:
+			return auction.StartPrice
+		case elapsed >= duration:
+			return auction.EndPrice
+		}
+
+		drop := auction.StartPrice.Amount.Sub(auction.EndPrice.Amount).
+			Mul(math.NewInt(elapsed.Nanoseconds())).
+			Quo(math.NewInt(duration.Nanoseconds()))
+		return sdk.NewCoin(auction.StartPrice.Denom, auction.StartPrice.Amount.Sub(drop))
+	}
+
+	if len(auction.HighestBidder) == 0 {
+		return auction.StartPrice
+	}
+	return sdk.NewCoin(auction.HighestBid.Denom, auction.HighestBid.Amount.AddRaw(1))
+}
+
+// GetAuction returns the open auction with the given id
+func (k Keeper) GetAuction(ctx context.Context, id uint64) (nft.Auction, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var auction nft.Auction
+
+	bz, err := store.Get(auctionStoreKey(id))
+	if err != nil || len(bz) == 0 {
+		return auction, false
+	}
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+// GetAuctions returns all the open auctions, ordered by id
+func (k Keeper) GetAuctions(ctx context.Context) (auctions []*nft.Auction) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), AuctionKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auction nft.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, &auction)
+	}
+	return
+}
+
+func (k Keeper) setAuction(ctx context.Context, auction nft.Auction) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(auctionStoreKey(auction.Id), k.cdc.MustMarshal(&auction)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(auctionQueueStoreKey(auction.EndTime, auction.Id), Placeholder); err != nil {
+		panic(err)
+	}
+}
+
+// deleteAuction removes the auction together with its end time queue entry
+func (k Keeper) deleteAuction(ctx context.Context, auction nft.Auction) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(auctionStoreKey(auction.Id)); err != nil {
+		panic(err)
+	}
+	if err := store.Delete(auctionQueueStoreKey(auction.EndTime, auction.Id)); err != nil {
+		panic(err)
+	}
+}
+
+// getNextAuctionID returns the stored id of the next auction, or 0 if no auction was ever created
+func (k Keeper) getNextAuctionID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(AuctionSequenceKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// nextAuctionID returns the id of the next auction, starting at 1
+func (k Keeper) nextAuctionID(ctx context.Context) uint64 {
+	if id := k.getNextAuctionID(ctx); id > 0 {
+		return id
+	}
+	return 1
+}
+
+func (k Keeper) setNextAuctionID(ctx context.Context, id uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(AuctionSequenceKey, sdk.Uint64ToBigEndian(id)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+	nfttestutil "cosmossdk.io/x/nft/testutil"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// newAuctionKeeper returns an auction keeper escrowing the bids with the returned mock bank keeper
+func (s *TestSuite) newAuctionKeeper() (keeper.AuctionKeeper, *nfttestutil.MockAuctionBankKeeper) {
+	bankKeeper := nfttestutil.NewMockAuctionBankKeeper(gomock.NewController(s.T()))
+	return keeper.NewAuctionKeeper(s.nftKeeper, s.accountKeeper, bankKeeper), bankKeeper
+}
+
+func (s *TestSuite) TestCreateAuction() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	auctionKeeper, _ := s.newAuctionKeeper()
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	seller := s.addrs[1].String()
+	price := sdk.NewInt64Coin("stake", 100)
+	valid := func(modify func(msg *nft.MsgCreateAuction)) *nft.MsgCreateAuction {
+		msg := &nft.MsgCreateAuction{
+			Seller:      seller,
+			ClassId:     testClassID,
+			NftId:       testID,
+			AuctionType: nft.AuctionTypeEnglish,
+			StartPrice:  price,
+			Duration:    time.Hour,
+		}
+		modify(msg)
+		return msg
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgCreateAuction
+		expErr error
+	}{
+		{"empty class id", valid(func(msg *nft.MsgCreateAuction) { msg.ClassId = "" }), nft.ErrEmptyClassID},
+		{"invalid seller", valid(func(msg *nft.MsgCreateAuction) { msg.Seller = "invalid" }), sdkerrors.ErrInvalidAddress},
+		{"seller is not the owner", valid(func(msg *nft.MsgCreateAuction) { msg.Seller = s.addrs[2].String() }), sdkerrors.ErrUnauthorized},
+		{"unspecified type", valid(func(msg *nft.MsgCreateAuction) { msg.AuctionType = nft.AuctionTypeUnspecified }), nft.ErrInvalidAuction},
+		{"zero start price", valid(func(msg *nft.MsgCreateAuction) { msg.StartPrice = sdk.NewInt64Coin("stake", 0) }), nft.ErrInvalidAuction},
+		{"zero duration", valid(func(msg *nft.MsgCreateAuction) { msg.Duration = 0 }), nft.ErrInvalidAuction},
+		{"duration too long", valid(func(msg *nft.MsgCreateAuction) { msg.Duration = nft.MaxAuctionDuration + time.Second }), nft.ErrInvalidAuction},
+		{"english with end price", valid(func(msg *nft.MsgCreateAuction) { msg.EndPrice = sdk.NewInt64Coin("stake", 10) }), nft.ErrInvalidAuction},
+		{"dutch without end price", valid(func(msg *nft.MsgCreateAuction) { msg.AuctionType = nft.AuctionTypeDutch }), nft.ErrInvalidAuction},
+		{"dutch end price above start price", valid(func(msg *nft.MsgCreateAuction) {
+			msg.AuctionType, msg.EndPrice = nft.AuctionTypeDutch, sdk.NewInt64Coin("stake", 200)
+		}), nft.ErrInvalidAuction},
+		{"dutch end price in another denom", valid(func(msg *nft.MsgCreateAuction) {
+			msg.AuctionType, msg.EndPrice = nft.AuctionTypeDutch, sdk.NewInt64Coin("atom", 10)
+		}), nft.ErrInvalidAuction},
+		{"valid", valid(func(msg *nft.MsgCreateAuction) {}), nil},
+		{"nft already escrowed", valid(func(msg *nft.MsgCreateAuction) {}), sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := auctionKeeper.CreateAuction(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), res.AuctionId)
+		})
+	}
+
+	// the nft is escrowed by the module account
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	auction, has := s.nftKeeper.GetAuction(ctx, 1)
+	s.Require().True(has)
+	s.Require().Equal(seller, auction.Seller)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour), auction.EndTime)
+
+	var created []*nft.EventAuctionCreated
+	for _, event := range ctx.EventManager().Events() {
+		if msg, err := sdk.ParseTypedEvent(abci.Event(event)); err == nil {
+			if event, ok := msg.(*nft.EventAuctionCreated); ok {
+				created = append(created, event)
+			}
+		}
+	}
+	s.Require().Equal([]*nft.EventAuctionCreated{{
+		AuctionId:   1,
+		AuctionType: nft.AuctionTypeEnglish,
+		ClassId:     testClassID,
+		NftId:       testID,
+		Seller:      seller,
+	}}, created)
+}
+
+func (s *TestSuite) TestEnglishAuction() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	auctionKeeper, bankKeeper := s.newAuctionKeeper()
+
+	seller, bidder := s.addrs[1], s.addrs[2]
+	otherBidder := simtestutil.CreateIncrementalAccounts(4)[3]
+	res, err := auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+		Seller:      seller.String(),
+		ClassId:     testClassID,
+		NftId:       testID,
+		AuctionType: nft.AuctionTypeEnglish,
+		StartPrice:  sdk.NewInt64Coin("stake", 100),
+		Duration:    time.Hour,
+	})
+	s.Require().NoError(err)
+	id := res.AuctionId
+
+	bid := func(bidder sdk.AccAddress, amount sdk.Coin) error {
+		_, err := auctionKeeper.Bid(s.ctx, &nft.MsgBid{Bidder: bidder.String(), AuctionId: id, Amount: amount})
+		return err
+	}
+	s.Require().ErrorIs(bid(bidder, sdk.NewInt64Coin("stake", 99)), nft.ErrInvalidBid)
+	s.Require().ErrorIs(bid(bidder, sdk.NewInt64Coin("atom", 100)), nft.ErrInvalidBid)
+	s.Require().ErrorIs(bid(seller, sdk.NewInt64Coin("stake", 100)), nft.ErrInvalidBid)
+	_, err = auctionKeeper.Bid(s.ctx, &nft.MsgBid{Bidder: bidder.String(), AuctionId: id + 1, Amount: sdk.NewInt64Coin("stake", 100)})
+	s.Require().ErrorIs(err, nft.ErrAuctionNotFound)
+
+	// the first bid is escrowed
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), bidder, nft.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil)
+	s.Require().NoError(bid(bidder, sdk.NewInt64Coin("stake", 100)))
+
+	queryRes, err := auctionKeeper.Auction(s.ctx, &nft.QueryAuctionRequest{AuctionId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(bidder.String(), queryRes.Auction.HighestBidder)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 101), queryRes.MinBid)
+
+	// an auction with a bid cannot be canceled
+	_, err = auctionKeeper.CancelAuction(s.ctx, &nft.MsgCancelAuction{Seller: seller.String(), AuctionId: id})
+	s.Require().ErrorIs(err, nft.ErrAuctionHasBid)
+
+	// a higher bid refunds the previous one
+	s.Require().ErrorIs(bid(otherBidder, sdk.NewInt64Coin("stake", 100)), nft.ErrInvalidBid)
+	gomock.InOrder(
+		bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), otherBidder, nft.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 150))).Return(nil),
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), nft.ModuleName, bidder, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil),
+	)
+	s.Require().NoError(bid(otherBidder, sdk.NewInt64Coin("stake", 150)))
+
+	// the auction is not settled before it ends
+	s.Require().NoError(auctionKeeper.SettleAuctions(s.ctx))
+	_, has := s.nftKeeper.GetAuction(s.ctx, id)
+	s.Require().True(has)
+
+	// a failed settlement is retried on the next block
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = auctionKeeper.Bid(ctx, &nft.MsgBid{Bidder: bidder.String(), AuctionId: id, Amount: sdk.NewInt64Coin("stake", 200)})
+	s.Require().ErrorIs(err, nft.ErrInvalidBid)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), nft.ModuleName, seller, gomock.Any()).Return(errors.New("send failed"))
+	s.Require().NoError(auctionKeeper.SettleAuctions(ctx))
+	_, has = s.nftKeeper.GetAuction(ctx, id)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	s.Require().Empty(ctx.EventManager().Events())
+
+	// the nft goes to the highest bidder and the bid to the seller
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), nft.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin("stake", 150))).Return(nil)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	s.Require().NoError(auctionKeeper.SettleAuctions(ctx))
+	_, has = s.nftKeeper.GetAuction(ctx, id)
+	s.Require().False(has)
+	s.Require().Equal(otherBidder, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+
+	var settled []*nft.EventAuctionSettled
+	for _, event := range ctx.EventManager().Events() {
+		if msg, err := sdk.ParseTypedEvent(abci.Event(event)); err == nil {
+			if event, ok := msg.(*nft.EventAuctionSettled); ok {
+				settled = append(settled, event)
+			}
+		}
+	}
+	s.Require().Equal([]*nft.EventAuctionSettled{{AuctionId: id, Buyer: otherBidder.String(), Price: "150stake"}}, settled)
+}
+
+func (s *TestSuite) TestDutchAuction() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	auctionKeeper, bankKeeper := s.newAuctionKeeper()
+
+	seller, bidder := s.addrs[1], s.addrs[2]
+	res, err := auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+		Seller:      seller.String(),
+		ClassId:     testClassID,
+		NftId:       testID,
+		AuctionType: nft.AuctionTypeDutch,
+		StartPrice:  sdk.NewInt64Coin("stake", 1000),
+		EndPrice:    sdk.NewInt64Coin("stake", 200),
+		Duration:    4 * time.Hour,
+	})
+	s.Require().NoError(err)
+	id := res.AuctionId
+
+	// the price decreases linearly
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	queryRes, err := auctionKeeper.Auction(ctx, &nft.QueryAuctionRequest{AuctionId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 800), queryRes.MinBid)
+
+	_, err = auctionKeeper.Bid(ctx, &nft.MsgBid{Bidder: bidder.String(), AuctionId: id, Amount: sdk.NewInt64Coin("stake", 799)})
+	s.Require().ErrorIs(err, nft.ErrInvalidBid)
+
+	// a bid above the current price only pays the current price, and buys the nft right away
+	gomock.InOrder(
+		bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), bidder, nft.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 800))).Return(nil),
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), nft.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin("stake", 800))).Return(nil),
+	)
+	_, err = auctionKeeper.Bid(ctx, &nft.MsgBid{Bidder: bidder.String(), AuctionId: id, Amount: sdk.NewInt64Coin("stake", 900)})
+	s.Require().NoError(err)
+	s.Require().Equal(bidder, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	_, has := s.nftKeeper.GetAuction(ctx, id)
+	s.Require().False(has)
+}
+
+func (s *TestSuite) TestUnsoldAuction() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2"}, s.addrs[1]))
+	auctionKeeper, _ := s.newAuctionKeeper()
+
+	seller := s.addrs[1]
+	for _, nftID := range []string{testID, "kitty2"} {
+		_, err := auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+			Seller:      seller.String(),
+			ClassId:     testClassID,
+			NftId:       nftID,
+			AuctionType: nft.AuctionTypeEnglish,
+			StartPrice:  sdk.NewInt64Coin("stake", 100),
+			Duration:    time.Hour,
+		})
+		s.Require().NoError(err)
+	}
+
+	auctionsRes, err := auctionKeeper.Auctions(s.ctx, &nft.QueryAuctionsRequest{Seller: seller.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(auctionsRes.Auctions, 1)
+	s.Require().Equal(uint64(2), auctionsRes.Pagination.Total)
+	auctionsRes, err = auctionKeeper.Auctions(s.ctx, &nft.QueryAuctionsRequest{Seller: s.addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(auctionsRes.Auctions)
+
+	// only the seller can cancel an auction
+	_, err = auctionKeeper.CancelAuction(s.ctx, &nft.MsgCancelAuction{Seller: s.addrs[2].String(), AuctionId: 1})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = auctionKeeper.CancelAuction(s.ctx, &nft.MsgCancelAuction{Seller: seller.String(), AuctionId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(seller, s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the nft of an auction without bid goes back to the seller when it ends
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(auctionKeeper.SettleAuctions(ctx))
+	s.Require().Equal(seller, s.nftKeeper.GetOwner(ctx, testClassID, "kitty2"))
+	s.Require().Empty(s.nftKeeper.GetAuctions(ctx))
+}
+
+func (s *TestSuite) TestAuctionGenesis() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	auctionKeeper, _ := s.newAuctionKeeper()
+
+	_, err := auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+		Seller:      s.addrs[1].String(),
+		ClassId:     testClassID,
+		NftId:       testID,
+		AuctionType: nft.AuctionTypeEnglish,
+		StartPrice:  sdk.NewInt64Coin("stake", 100),
+		Duration:    time.Hour,
+	})
+	s.Require().NoError(err)
+
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.Auctions, 1)
+	s.Require().Equal(uint64(2), genesis.NextAuctionId)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	exported := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(genesis.Auctions, exported.Auctions)
+	s.Require().Equal(genesis.NextAuctionId, exported.NextAuctionId)
+
+	genesis.NextAuctionId = 1
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidAuction)
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/nft"
+	nfttestutil "cosmossdk.io/x/nft/testutil"
+)
+
+func (s *TestSuite) TestCircuitBreaker() {
+	owner, receiver := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, owner))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2"}, owner))
+
+	// the circuit breaker pauses the first nft of the class
+	circuitBreaker := nfttestutil.NewMockCircuitBreaker(gomock.NewController(s.T()))
+	circuitBreaker.EXPECT().IsNFTAllowed(gomock.Any(), testClassID, gomock.Any()).DoAndReturn(
+		func(_ any, _, nftID string) bool { return nftID != testID },
+	).AnyTimes()
+	s.nftKeeper.SetCircuitBreaker(circuitBreaker)
+
+	_, err := s.nftKeeper.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: owner.String(), Receiver: receiver.String()})
+	s.Require().ErrorIs(err, nft.ErrPaused)
+	_, err = s.nftKeeper.UpdateURI(s.ctx, &nft.MsgUpdateURI{ClassId: testClassID, Id: testID, Sender: owner.String(), Uri: testURI, UriHash: nft.URIHash([]byte(testURI))})
+	s.Require().ErrorIs(err, nft.ErrPaused)
+	_, err = s.nftKeeper.GrantUse(s.ctx, &nft.MsgGrantUse{ClassId: testClassID, Id: testID, Sender: owner.String(), Grantee: receiver.String()})
+	s.Require().ErrorIs(err, nft.ErrPaused)
+	_, err = s.nftKeeper.Revoke(s.ctx, &nft.MsgRevoke{ClassId: testClassID, Id: testID, Sender: owner.String()})
+	s.Require().ErrorIs(err, nft.ErrPaused)
+	s.Require().Equal(owner, s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the other nfts of the class are not paused
+	_, err = s.nftKeeper.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: "kitty2", Sender: owner.String(), Receiver: receiver.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, s.nftKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+}
@@ -8,32 +8,88 @@ import (
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// SaveClass defines a method for creating a new nft class
+// SaveClass defines a method for creating a new nft class. Its id cannot start with a reserved prefix, see
+// SaveClassAs.
 func (k Keeper) SaveClass(ctx context.Context, class nft.Class) error {
+	return k.SaveClassAs(ctx, nil, class)
+}
+
+// SaveClassAs defines a method for creating a new nft class on behalf of creator, which must be the owner of the
+// reserved prefix the id of the class starts with, if any.
+// Note: When the upper module uses this method, it needs to authenticate the creator
+func (k Keeper) SaveClassAs(ctx context.Context, creator sdk.AccAddress, class nft.Class) error {
 	if k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassExists, class.Id)
 	}
+	if err := k.checkClassCreator(ctx, class.Id, creator); err != nil {
+		return err
+	}
+	if err := k.validateRoles(class); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
 	}
 	store := k.storeService.OpenKVStore(ctx)
-	return store.Set(classStoreKey(class.Id), bz)
+	if err := store.Set(classStoreKey(class.Id), bz); err != nil {
+		return err
+	}
+
+	k.setURIUpdateHeight(ctx, class.Id, "")
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassCreated{
+		ClassId:         class.Id,
+		Name:            class.Name,
+		Symbol:          class.Symbol,
+		NonTransferable: class.NonTransferable,
+		Issuer:          class.Issuer,
+		Admin:           class.Admin,
+	})
 }
 
 // UpdateClass defines a method for updating an exist nft class
 func (k Keeper) UpdateClass(ctx context.Context, class nft.Class) error {
-	if !k.HasClass(ctx, class.Id) {
+	prev, has := k.GetClass(ctx, class.Id)
+	if !has {
 		return errors.Wrap(nft.ErrClassNotExists, class.Id)
 	}
+	if err := k.validateRoles(class); err != nil {
+		return err
+	}
+	if err := k.validateClassData(ctx, class); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
 	}
 	store := k.storeService.OpenKVStore(ctx)
-	return store.Set(classStoreKey(class.Id), bz)
+	if err := store.Set(classStoreKey(class.Id), bz); err != nil {
+		return err
+	}
+
+	// the changes of the uri are reported by an EventUpdateURI, the freezes by an EventClassFrozen or
+	// EventClassUnfrozen
+	if prev.Name != class.Name || prev.Symbol != class.Symbol || prev.Description != class.Description ||
+		!prev.Data.Equal(class.Data) || prev.NonTransferable != class.NonTransferable ||
+		prev.Issuer != class.Issuer || prev.Admin != class.Admin {
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassUpdated{
+			ClassId:         class.Id,
+			Name:            class.Name,
+			Symbol:          class.Symbol,
+			Description:     class.Description,
+			NonTransferable: class.NonTransferable,
+			Issuer:          class.Issuer,
+			Admin:           class.Admin,
+		}); err != nil {
+			return err
+		}
+	}
+	return k.trackURIChange(ctx, class.Id, "", prev.Uri, prev.UriHash, class.Uri, class.UriHash)
 }
 
 // GetClass defines a method for returning the class information of the specified id
@@ -75,3 +131,117 @@ func (k Keeper) HasClass(ctx context.Context, classID string) bool {
 	}
 	return has
 }
+
+// IsTransferable returns false if the nfts of the specified class are soulbound, i.e. cannot change owner once minted
+func (k Keeper) IsTransferable(ctx context.Context, classID string) bool {
+	class, _ := k.GetClass(ctx, classID)
+	return !class.NonTransferable
+}
+
+// IsFrozen returns true if the specified class is frozen, i.e. its nfts can only be minted, transferred or burned by
+// its admin
+func (k Keeper) IsFrozen(ctx context.Context, classID string) bool {
+	class, _ := k.GetClass(ctx, classID)
+	return class.Frozen
+}
+
+// Freeze halts the mint, transfer and burn of the nfts of a class, except for its admin.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) Freeze(ctx context.Context, classID string, admin sdk.AccAddress) error {
+	class, err := k.getClassOfAdmin(ctx, classID, admin)
+	if err != nil {
+		return err
+	}
+
+	if class.Frozen {
+		return errors.Wrap(nft.ErrClassFrozen, classID)
+	}
+
+	class.Frozen = true
+	if err := k.UpdateClass(ctx, class); err != nil {
+		return err
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassFrozen{
+		ClassId: classID,
+		Admin:   class.Admin,
+	})
+}
+
+// Unfreeze lifts the freeze of a class.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) Unfreeze(ctx context.Context, classID string, admin sdk.AccAddress) error {
+	class, err := k.getClassOfAdmin(ctx, classID, admin)
+	if err != nil {
+		return err
+	}
+
+	if !class.Frozen {
+		return errors.Wrap(nft.ErrClassNotFrozen, classID)
+	}
+
+	class.Frozen = false
+	if err := k.UpdateClass(ctx, class); err != nil {
+		return err
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassUnfrozen{
+		ClassId: classID,
+		Admin:   class.Admin,
+	})
+}
+
+// getClassOfAdmin returns the specified class, provided that it has an admin and that it is the given account
+func (k Keeper) getClassOfAdmin(ctx context.Context, classID string, admin sdk.AccAddress) (nft.Class, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if len(class.Admin) == 0 {
+		return nft.Class{}, errors.Wrap(nft.ErrNotFreezable, classID)
+	}
+
+	adminStr, err := k.ac.BytesToString(admin)
+	if err != nil {
+		return nft.Class{}, err
+	}
+	if class.Admin != adminStr {
+		return nft.Class{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of class %s", adminStr, classID)
+	}
+	return class, nil
+}
+
+// checkNotFrozen returns ErrClassFrozen if the specified class is frozen, unless the account acting on its nfts, i.e.
+// the receiver of a mint or the owner of a transferred or burned nft, is the admin of the class
+func (k Keeper) checkNotFrozen(ctx context.Context, classID string, account sdk.AccAddress) error {
+	class, _ := k.GetClass(ctx, classID)
+	if !class.Frozen {
+		return nil
+	}
+
+	accountStr, err := k.ac.BytesToString(account)
+	if err != nil {
+		return err
+	}
+	if class.Admin != accountStr {
+		return errors.Wrap(nft.ErrClassFrozen, classID)
+	}
+	return nil
+}
+
+// validateRoles checks that the issuer and the admin of the class, if any, are valid addresses, and that a frozen
+// class has an admin to unfreeze it
+func (k Keeper) validateRoles(class nft.Class) error {
+	if len(class.Issuer) > 0 {
+		if _, err := k.ac.StringToBytes(class.Issuer); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", class.Issuer)
+		}
+	}
+	if len(class.Admin) > 0 {
+		if _, err := k.ac.StringToBytes(class.Admin); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", class.Admin)
+		}
+	} else if class.Frozen {
+		return errors.Wrap(nft.ErrNotFreezable, class.Id)
+	}
+	return nil
+}
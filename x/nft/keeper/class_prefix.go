@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetReservedClassPrefix reserves the class ids starting with the prefix to its owner, or changes the owner of the prefix
+// if it is already reserved. The classes already created under the prefix are left untouched.
+// Note: When the upper module uses this method, it needs to authenticate the module authority
+func (k Keeper) SetReservedClassPrefix(ctx context.Context, reservation nft.ReservedClassPrefix) error {
+	if err := reservation.Validate(k.ac); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&reservation)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ReservedClassPrefix failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(reservedClassPrefixStoreKey(reservation.Prefix), bz); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassPrefixReserved{
+		Prefix: reservation.Prefix,
+		Owner:  reservation.Owner,
+	})
+}
+
+// DeleteReservedClassPrefix releases a reserved class id prefix.
+// Note: When the upper module uses this method, it needs to authenticate the module authority
+func (k Keeper) DeleteReservedClassPrefix(ctx context.Context, prefix string) error {
+	if _, has := k.GetReservedClassPrefix(ctx, prefix); !has {
+		return errors.Wrap(nft.ErrClassPrefixNotFound, prefix)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(reservedClassPrefixStoreKey(prefix)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassPrefixReleased{
+		Prefix: prefix,
+	})
+}
+
+// GetReservedClassPrefix returns the reservation of the given class id prefix
+func (k Keeper) GetReservedClassPrefix(ctx context.Context, prefix string) (nft.ReservedClassPrefix, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var reservation nft.ReservedClassPrefix
+
+	bz, err := store.Get(reservedClassPrefixStoreKey(prefix))
+	if err != nil || len(bz) == 0 {
+		return reservation, false
+	}
+	k.cdc.MustUnmarshal(bz, &reservation)
+	return reservation, true
+}
+
+// GetReservedClassPrefixes returns all the reserved class id prefixes, ordered by prefix
+func (k Keeper) GetReservedClassPrefixes(ctx context.Context) (reservations []*nft.ReservedClassPrefix) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ReservedClassPrefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation nft.ReservedClassPrefix
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		reservations = append(reservations, &reservation)
+	}
+	return
+}
+
+// checkClassCreator returns an error if the class id starts with a reserved prefix which is not owned by creator. When
+// the prefixes of the class id are nested, e.g. `ibc/` and `ibc/transfer/`, the longest one decides.
+func (k Keeper) checkClassCreator(ctx context.Context, classID string, creator sdk.AccAddress) error {
+	var (
+		reserved bool
+		longest  nft.ReservedClassPrefix
+	)
+	// the reserved prefixes are set by governance, so there are few of them to iterate
+	for _, reservation := range k.GetReservedClassPrefixes(ctx) {
+		if strings.HasPrefix(classID, reservation.Prefix) && len(reservation.Prefix) >= len(longest.Prefix) {
+			reserved, longest = true, *reservation
+		}
+	}
+	if !reserved {
+		return nil
+	}
+
+	if len(longest.Owner) == 0 || creator.Empty() {
+		return errors.Wrapf(nft.ErrClassPrefixReserved, "class id %s starts with reserved prefix %s", classID, longest.Prefix)
+	}
+	owner, err := k.ac.StringToBytes(longest.Owner)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(owner, creator) {
+		return errors.Wrapf(nft.ErrClassPrefixReserved, "class id %s starts with prefix %s reserved to %s", classID, longest.Prefix, longest.Owner)
+	}
+	return nil
+}
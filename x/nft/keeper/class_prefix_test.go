@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/nft"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSuite) TestReserveClassPrefix() {
+	authority := s.nftKeeper.GetAuthority()
+	owner := s.addrs[1]
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgReserveClassPrefix
+		expErr error
+	}{
+		{"invalid authority", &nft.MsgReserveClassPrefix{Authority: owner.String(), Prefix: "ibc/"}, sdkerrors.ErrUnauthorized},
+		{"empty prefix", &nft.MsgReserveClassPrefix{Authority: authority, Prefix: " "}, nft.ErrInvalidClassPrefix},
+		{"invalid owner", &nft.MsgReserveClassPrefix{Authority: authority, Prefix: "ibc/", Owner: "invalid"}, nft.ErrInvalidClassPrefix},
+		{"without owner", &nft.MsgReserveClassPrefix{Authority: authority, Prefix: "ibc/"}, nil},
+		{"with owner", &nft.MsgReserveClassPrefix{Authority: authority, Prefix: "chain/", Owner: owner.String()}, nil},
+		{"nested prefix", &nft.MsgReserveClassPrefix{Authority: authority, Prefix: "chain/open/", Owner: s.addrs[2].String()}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.ReserveClassPrefix(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	res, err := s.queryClient.ReservedClassPrefixes(s.ctx, &nft.QueryReservedClassPrefixesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	s.Require().NoError(err)
+	s.Require().Equal([]*nft.ReservedClassPrefix{
+		{Prefix: "chain/", Owner: owner.String()},
+		{Prefix: "chain/open/", Owner: s.addrs[2].String()},
+	}, res.ReservedClassPrefixes)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	// the classes under a reserved prefix can only be created by its owner
+	s.Require().ErrorIs(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "ibc/ABCD"}), nft.ErrClassPrefixReserved)
+	s.Require().ErrorIs(s.nftKeeper.SaveClassAs(s.ctx, owner, nft.Class{Id: "ibc/ABCD"}), nft.ErrClassPrefixReserved)
+	s.Require().ErrorIs(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "chain/kitties"}), nft.ErrClassPrefixReserved)
+	s.Require().ErrorIs(s.nftKeeper.SaveClassAs(s.ctx, s.addrs[2], nft.Class{Id: "chain/kitties"}), nft.ErrClassPrefixReserved)
+	s.Require().NoError(s.nftKeeper.SaveClassAs(s.ctx, owner, nft.Class{Id: "chain/kitties"}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "chain"}))
+
+	// the longest reserved prefix decides
+	s.Require().ErrorIs(s.nftKeeper.SaveClassAs(s.ctx, owner, nft.Class{Id: "chain/open/art"}), nft.ErrClassPrefixReserved)
+	s.Require().NoError(s.nftKeeper.SaveClassAs(s.ctx, s.addrs[2], nft.Class{Id: "chain/open/art"}))
+
+	// the classes can be created once the prefix is released
+	_, err = s.nftKeeper.ReleaseClassPrefix(s.ctx, &nft.MsgReleaseClassPrefix{Authority: owner.String(), Prefix: "ibc/"})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.nftKeeper.ReleaseClassPrefix(s.ctx, &nft.MsgReleaseClassPrefix{Authority: authority, Prefix: "ibc"})
+	s.Require().ErrorIs(err, nft.ErrClassPrefixNotFound)
+	_, err = s.nftKeeper.ReleaseClassPrefix(s.ctx, &nft.MsgReleaseClassPrefix{Authority: authority, Prefix: "ibc/"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "ibc/ABCD"}))
+
+	// the existing classes are kept when their prefix is reserved
+	_, err = s.nftKeeper.ReserveClassPrefix(s.ctx, &nft.MsgReserveClassPrefix{Authority: authority, Prefix: "ibc/"})
+	s.Require().NoError(err)
+	s.Require().True(s.nftKeeper.HasClass(s.ctx, "ibc/ABCD"))
+}
+
+func (s *TestSuite) TestReservedClassPrefixesGenesis() {
+	class := nft.Class{Id: "ibc/ABCD"}
+	reservation := nft.ReservedClassPrefix{Prefix: "ibc/", Owner: s.addrs[1].String()}
+	genesis := &nft.GenesisState{
+		Classes:               []*nft.Class{&class},
+		ReservedClassPrefixes: []*nft.ReservedClassPrefix{&reservation},
+	}
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	// the classes existing under a reserved prefix are imported
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().True(s.nftKeeper.HasClass(s.ctx, class.Id))
+	s.Require().Equal(genesis.ReservedClassPrefixes, s.nftKeeper.ExportGenesis(s.ctx).ReservedClassPrefixes)
+
+	genesis.ReservedClassPrefixes = append(genesis.ReservedClassPrefixes, &nft.ReservedClassPrefix{Prefix: "ibc/"})
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidClassPrefix)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// DataCompressionThreshold is the size in bytes of the Data value of a nft
+// above which the nft is stored compressed.
+const DataCompressionThreshold = 1024
+
+// nftEncodingZstd is the version byte prefixing the nfts stored compressed with
+// zstd. The nfts stored uncompressed are plain protobuf encoded, which never
+// starts with this byte as it would encode the invalid field number 0.
+const nftEncodingZstd byte = 0x01
+
+var (
+	// the encoder is single threaded so that the compressed nfts, which are part
+	// of the state, are the same on every node
+	zstdEncoder = mustZstd(zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1)))
+	zstdDecoder = mustZstd(zstd.NewReader(nil, zstd.WithDecoderConcurrency(1)))
+)
+
+func mustZstd[T any](v T, err error) T {
+	if err != nil {
+		panic(fmt.Errorf("failed to create zstd codec: %w", err))
+	}
+	return v
+}
+
+// marshalNFT encodes a nft as stored, compressed with zstd if its Data value is
+// larger than DataCompressionThreshold and compression makes it smaller.
+func (k Keeper) marshalNFT(token nft.NFT) []byte {
+	bz := k.cdc.MustMarshal(&token)
+	if token.Data == nil || len(token.Data.Value) <= DataCompressionThreshold {
+		return bz
+	}
+
+	compressed := zstdEncoder.EncodeAll(bz, []byte{nftEncodingZstd})
+	if len(compressed) >= len(bz) {
+		return bz
+	}
+	return compressed
+}
+
+// UnmarshalNFT decodes a nft as stored by the keeper, compressed or not.
+func UnmarshalNFT(cdc codec.BinaryCodec, bz []byte) (nft.NFT, error) {
+	if len(bz) > 0 && bz[0] == nftEncodingZstd {
+		var err error
+		if bz, err = zstdDecoder.DecodeAll(bz[1:], nil); err != nil {
+			return nft.NFT{}, fmt.Errorf("failed to decompress nft: %w", err)
+		}
+	}
+
+	var token nft.NFT
+	if err := cdc.Unmarshal(bz, &token); err != nil {
+		return nft.NFT{}, err
+	}
+	return token, nil
+}
+
+// mustUnmarshalNFT decodes a nft as stored, and panics on error.
+func (k Keeper) mustUnmarshalNFT(bz []byte) nft.NFT {
+	token, err := UnmarshalNFT(k.cdc, bz)
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
+// compressNFTs stores compressed the nfts stored uncompressed although their
+// Data value is larger than DataCompressionThreshold, e.g. the nfts minted
+// before the compression was introduced, and returns their number.
+func (k Keeper) compressNFTs(ctx context.Context) (int, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), NFTKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		if len(bz) > 0 && bz[0] == nftEncodingZstd {
+			continue
+		}
+
+		token, err := UnmarshalNFT(k.cdc, bz)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode nft %s: %w", iterator.Key(), err)
+		}
+		if compressed := k.marshalNFT(token); compressed[0] == nftEncodingZstd {
+			keys, values = append(keys, iterator.Key()), append(values, compressed)
+		}
+	}
+
+	// the store cannot be written while iterated over
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	return len(keys), nil
+}
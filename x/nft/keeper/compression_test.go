@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func (s *TestSuite) TestDataCompression() {
+	large := s.nftData(strings.Repeat("kitty", keeper.DataCompressionThreshold))
+	small := s.nftData(testClassID)
+
+	tokens := []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1", Data: large},
+		{ClassId: testClassID, Id: "kitty2", Data: small},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0]))
+
+	// only the nft with a large Data value is stored compressed
+	store := s.ctx.KVStore(s.storeKey)
+	raw := store.Get(nftKey(testClassID, "kitty1"))
+	s.Require().Equal(byte(0x01), raw[0])
+	s.Require().Less(len(raw), len(large.Value))
+	s.Require().Equal(s.encCfg.Codec.MustMarshal(&tokens[1]), store.Get(nftKey(testClassID, "kitty2")))
+
+	for _, token := range tokens {
+		actual, has := s.nftKeeper.GetNFT(s.ctx, token.ClassId, token.Id)
+		s.Require().True(has)
+		s.Require().Equal(token, actual)
+	}
+	s.Require().Equal(tokens, s.nftKeeper.GetNFTsOfClass(s.ctx, testClassID))
+
+	decoded, err := keeper.UnmarshalNFT(s.encCfg.Codec, raw)
+	s.Require().NoError(err)
+	s.Require().Equal(tokens[0], decoded)
+}
+
+func (s *TestSuite) TestMigrate2to3() {
+	data := s.nftData(strings.Repeat("kitty", keeper.DataCompressionThreshold))
+
+	tokens := []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1", Data: data},
+		{ClassId: testClassID, Id: "kitty2"},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0]))
+
+	// store the nfts uncompressed to emulate a store of consensus version 2
+	store := s.ctx.KVStore(s.storeKey)
+	for _, token := range tokens {
+		store.Set(nftKey(token.ClassId, token.Id), s.encCfg.Codec.MustMarshal(&token))
+	}
+	actual, _ := s.nftKeeper.GetNFT(s.ctx, testClassID, "kitty1")
+	s.Require().Equal(tokens[0], actual)
+
+	s.Require().NoError(keeper.NewMigrator(s.nftKeeper).Migrate2to3(s.ctx))
+	s.Require().Equal(byte(0x01), store.Get(nftKey(testClassID, "kitty1"))[0])
+	s.Require().Equal(s.encCfg.Codec.MustMarshal(&tokens[1]), store.Get(nftKey(testClassID, "kitty2")))
+	s.Require().Equal(tokens, s.nftKeeper.GetNFTsOfClass(s.ctx, testClassID))
+}
+
+// nftData returns a Data value without cached value, as decoded from the store.
+func (s *TestSuite) nftData(classID string) *codectypes.Any {
+	data, err := codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: classID, Id: testID})
+	s.Require().NoError(err)
+	return &codectypes.Any{TypeUrl: data.TypeUrl, Value: data.Value}
+}
+
+func nftKey(classID, nftID string) []byte {
+	key := append([]byte{}, keeper.NFTKey...)
+	key = append(key, classID...)
+	key = append(key, keeper.Delimiter...)
+	return append(key, nftID...)
+}
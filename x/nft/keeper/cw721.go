@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ImportCw721Collection imports the snapshot of a cw721 contract as a new class: the tokens are minted to their owners
+// as the nfts of the class, with their metadata extension, if any, as data of type nft.Cw721Metadata. The class id
+// cannot start with a reserved prefix, see SaveClass. It returns the id of the class.
+// Note: When the upper module uses this method, it needs to authenticate the module authority
+func (k Keeper) ImportCw721Collection(ctx context.Context, collection nft.Cw721Collection) (string, error) {
+	if err := collection.Validate(k.ac); err != nil {
+		return "", err
+	}
+
+	classID := collection.ClassID()
+	if err := k.SaveClass(ctx, nft.Class{
+		Id:     classID,
+		Name:   collection.Name,
+		Symbol: collection.Symbol,
+	}); err != nil {
+		return "", err
+	}
+
+	// the class is new and the tokens are unique, so the nfts are minted without further checks
+	for _, token := range collection.Tokens {
+		owner, err := k.ac.StringToBytes(token.Owner)
+		if err != nil {
+			return "", err
+		}
+
+		var data *codectypes.Any
+		if len(token.Extension) > 0 {
+			if data, err = codectypes.NewAnyWithValue(&nft.Cw721Metadata{
+				Contract:  collection.Contract,
+				Extension: token.Extension,
+			}); err != nil {
+				return "", errors.Wrap(err, "failed to pack cw721 metadata")
+			}
+		}
+
+		if err := k.mintWithNoCheck(ctx, nft.NFT{
+			ClassId: classID,
+			Id:      token.TokenId,
+			Uri:     token.TokenUri,
+			Data:    data,
+		}, owner); err != nil {
+			return "", err
+		}
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventCw721Imported{
+		ClassId:  classID,
+		Contract: collection.Contract,
+		Nfts:     uint64(len(collection.Tokens)),
+	}); err != nil {
+		return "", err
+	}
+	return classID, nil
+}
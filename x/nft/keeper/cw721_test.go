@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestImportCw721() {
+	authority := s.nftKeeper.GetAuthority()
+	contract := s.addrs[2].String()
+	collection := nft.Cw721Collection{
+		Contract: contract,
+		Name:     "Kitties",
+		Symbol:   "KIT",
+		Tokens: []nft.Cw721Token{
+			{TokenId: "1", Owner: s.addrs[0].String(), TokenUri: "ipfs://kitty1", Extension: `{"color":"grey"}`},
+			{TokenId: "2", Owner: s.addrs[1].String()},
+		},
+	}
+
+	_, err := s.nftKeeper.ImportCw721(s.ctx, &nft.MsgImportCw721{Authority: contract, Collections: []nft.Cw721Collection{collection}})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	res, err := s.nftKeeper.ImportCw721(s.ctx, &nft.MsgImportCw721{Authority: authority, Collections: []nft.Cw721Collection{collection}})
+	s.Require().NoError(err)
+	classID := "cw721/" + contract
+	s.Require().Equal([]string{classID}, res.ClassIds)
+
+	class, has := s.nftKeeper.GetClass(s.ctx, classID)
+	s.Require().True(has)
+	s.Require().Equal(nft.Class{Id: classID, Name: "Kitties", Symbol: "KIT"}, class)
+
+	// the tokens are minted to their owners, with their metadata extension
+	data, err := codectypes.NewAnyWithValue(&nft.Cw721Metadata{Contract: contract, Extension: `{"color":"grey"}`})
+	s.Require().NoError(err)
+	token, has := s.nftKeeper.GetNFT(s.ctx, classID, "1")
+	s.Require().True(has)
+	s.Require().Equal(nft.NFT{ClassId: classID, Id: "1", Uri: "ipfs://kitty1", Data: &codectypes.Any{TypeUrl: data.TypeUrl, Value: data.Value}}, token)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, classID, "1"))
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, classID, "2"))
+	s.Require().Equal(uint64(2), s.nftKeeper.GetTotalSupply(s.ctx, classID))
+
+	// a contract cannot be imported twice as the same class
+	_, err = s.nftKeeper.ImportCw721Collection(s.ctx, collection)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	collection.ClassId = "kitties"
+	collection.Tokens = append(collection.Tokens, nft.Cw721Token{TokenId: "1", Owner: s.addrs[0].String()})
+	_, err = s.nftKeeper.ImportCw721Collection(s.ctx, collection)
+	s.Require().ErrorIs(err, nft.ErrInvalidCw721Snapshot)
+	s.Require().False(s.nftKeeper.HasClass(s.ctx, "kitties"))
+}
+
+func (s *TestSuite) TestImportCw721Genesis() {
+	genesis := &nft.GenesisState{
+		Cw721Imports: []nft.Cw721Collection{{
+			Contract: s.addrs[2].String(),
+			ClassId:  testClassID,
+			Tokens:   []nft.Cw721Token{{TokenId: testID, Owner: s.addrs[0].String()}},
+		}},
+	}
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+
+	// the imported classes are exported with the others
+	exported := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Empty(exported.Cw721Imports)
+	s.Require().Equal([]*nft.Class{{Id: testClassID}}, exported.Classes)
+	s.Require().Equal([]*nft.Entry{{Owner: s.addrs[0].String(), Nfts: []*nft.NFT{{ClassId: testClassID, Id: testID}}}}, exported.Entries)
+
+	genesis.Cw721Imports = append(genesis.Cw721Imports, genesis.Cw721Imports[0])
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidCw721Snapshot)
+}
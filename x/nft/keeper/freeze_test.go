@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestFreezeClass() {
+	admin := s.addrs[2]
+	s.Require().ErrorIs(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: "invalid"}), sdkerrors.ErrInvalidAddress)
+	s.Require().ErrorIs(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Frozen: true}), nft.ErrNotFreezable)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "unfreezable"}))
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgFreezeClass
+		expErr error
+	}{
+		{"empty class id", &nft.MsgFreezeClass{Sender: admin.String()}, nft.ErrEmptyClassID},
+		{"class does not exist", &nft.MsgFreezeClass{Sender: admin.String(), ClassId: "unknown"}, nft.ErrClassNotExists},
+		{"class has no admin", &nft.MsgFreezeClass{Sender: admin.String(), ClassId: "unfreezable"}, nft.ErrNotFreezable},
+		{"sender is not the admin", &nft.MsgFreezeClass{Sender: s.addrs[0].String(), ClassId: testClassID}, sdkerrors.ErrUnauthorized},
+		{"valid", &nft.MsgFreezeClass{Sender: admin.String(), ClassId: testClassID}, nil},
+		{"class already frozen", &nft.MsgFreezeClass{Sender: admin.String(), ClassId: testClassID}, nft.ErrClassFrozen},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.FreezeClass(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	s.Require().True(s.nftKeeper.IsFrozen(ctx, testClassID))
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventClassFrozen{ClassId: testClassID, Admin: admin.String()}, event)
+
+	_, err = s.nftKeeper.UnfreezeClass(ctx, &nft.MsgUnfreezeClass{Sender: s.addrs[0].String(), ClassId: testClassID})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.nftKeeper.UnfreezeClass(ctx, &nft.MsgUnfreezeClass{Sender: admin.String(), ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.IsFrozen(ctx, testClassID))
+	_, err = s.nftKeeper.UnfreezeClass(ctx, &nft.MsgUnfreezeClass{Sender: admin.String(), ClassId: testClassID})
+	s.Require().ErrorIs(err, nft.ErrClassNotFrozen)
+}
+
+func (s *TestSuite) TestFrozenClassOperations() {
+	admin := s.addrs[2]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String(), Issuer: s.addrs[1].String()}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "owned"}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "admin"}, admin))
+	s.Require().NoError(s.nftKeeper.Freeze(s.ctx, testClassID, admin))
+
+	// the nfts cannot be minted, transferred or burned by anyone else than the admin
+	s.Require().ErrorIs(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "new"}, s.addrs[0]), nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{{ClassId: testClassID, Id: "new"}}, s.addrs[0]), nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.Transfer(s.ctx, testClassID, "owned", s.addrs[1]), nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{"owned"}, s.addrs[1]), nft.ErrClassFrozen)
+	_, err := s.nftKeeper.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: "owned", Sender: s.addrs[0].String(), Receiver: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.Burn(s.ctx, testClassID, "owned"), nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.BatchBurn(s.ctx, testClassID, []string{"owned"}), nft.ErrClassFrozen)
+	s.Require().ErrorIs(s.nftKeeper.RevokeNFT(s.ctx, testClassID, "owned", s.addrs[1]), nft.ErrClassFrozen)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, "owned"))
+
+	// the admin is exempted
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "new"}, admin))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "admin", s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, "new"))
+
+	// the freeze is preserved across genesis export and import
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().True(s.nftKeeper.IsFrozen(s.ctx, testClassID))
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, "owned"))
+
+	s.Require().NoError(s.nftKeeper.Unfreeze(s.ctx, testClassID, admin))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "owned", s.addrs[1]))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"sort"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -10,8 +11,21 @@ import (
 // InitGenesis initializes the nft module's genesis state from a given
 // genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
+	// the frozen classes are frozen once their nfts are minted
 	for _, class := range data.Classes {
-		if err := k.SaveClass(ctx, *class); err != nil {
+		unfrozen := *class
+		unfrozen.Frozen = false
+		if err := k.SaveClass(ctx, unfrozen); err != nil {
+			panic(err)
+		}
+	}
+	for _, schema := range data.DataSchemas {
+		if err := k.SetClassDataSchema(ctx, schema.ClassId, schema.TypeUrl); err != nil {
+			panic(err)
+		}
+	}
+	for _, schema := range data.JsonSchemas {
+		if err := k.SetClassJSONSchema(ctx, *schema); err != nil {
 			panic(err)
 		}
 	}
@@ -27,6 +41,82 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	// the cw721 collections are imported before the prefixes are reserved, like the classes
+	for _, collection := range data.Cw721Imports {
+		if _, err := k.ImportCw721Collection(ctx, collection); err != nil {
+			panic(err)
+		}
+	}
+	for _, class := range data.Classes {
+		if class.Frozen {
+			if err := k.UpdateClass(ctx, *class); err != nil {
+				panic(err)
+			}
+		}
+	}
+	// the holders are counted while minting, but the minted and burned totals
+	// also account for the nfts burned before the export
+	for _, classStats := range data.ClassStats {
+		stats := k.GetClassStats(ctx, classStats.ClassId)
+		stats.TotalMinted = classStats.TotalMinted
+		stats.TotalBurned = classStats.TotalBurned
+		k.setClassStats(ctx, stats)
+	}
+	// the prefixes are reserved once the classes are created, as they do not apply to the existing classes
+	for _, reservation := range data.ReservedClassPrefixes {
+		if err := k.SetReservedClassPrefix(ctx, *reservation); err != nil {
+			panic(err)
+		}
+	}
+	// the grants which expired before the genesis time are removed by the first end blocker
+	for _, grant := range data.UsageGrants {
+		if !k.HasNFT(ctx, grant.ClassId, grant.Id) {
+			panic(errors.Wrap(nft.ErrNFTNotExists, grant.Id))
+		}
+		k.setUsageGrant(ctx, *grant)
+	}
+	// the auctioned nfts and the bids are escrowed by the module account
+	for _, auction := range data.Auctions {
+		if !k.HasNFT(ctx, auction.ClassId, auction.NftId) {
+			panic(errors.Wrap(nft.ErrNFTNotExists, auction.NftId))
+		}
+		k.setAuction(ctx, *auction)
+	}
+	if data.NextAuctionId > 0 {
+		k.setNextAuctionID(ctx, data.NextAuctionId)
+	}
+	for _, royalty := range data.Royalties {
+		if !k.HasClass(ctx, royalty.ClassId) {
+			panic(errors.Wrap(nft.ErrClassNotExists, royalty.ClassId))
+		}
+		if err := k.setClassRoyalty(ctx, *royalty); err != nil {
+			panic(err)
+		}
+	}
+	for _, mapping := range data.MetadataMappings {
+		if !k.HasClass(ctx, mapping.ClassId) {
+			panic(errors.Wrap(nft.ErrClassNotExists, mapping.ClassId))
+		}
+		if err := k.setClassMetadataMapping(ctx, mapping); err != nil {
+			panic(err)
+		}
+	}
+	// the nfts of the timelocked transfers are escrowed by the module account, the due ones are released by the first
+	// end blocker
+	for _, transfer := range data.TimelockedTransfers {
+		if !k.HasNFT(ctx, transfer.ClassId, transfer.Id) {
+			panic(errors.Wrap(nft.ErrNFTNotExists, transfer.Id))
+		}
+		k.setTimelockedTransfer(ctx, transfer)
+	}
+	for _, fee := range data.TransferFees {
+		if !k.HasClass(ctx, fee.ClassId) {
+			panic(errors.Wrap(nft.ErrClassNotExists, fee.ClassId))
+		}
+		if err := k.setClassTransferFee(ctx, fee); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -59,7 +149,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:     classes,
+		Entries:     entries,
+		DataSchemas: k.GetClassDataSchemas(ctx),
+		ClassStats:  k.GetAllClassStats(ctx),
+		UsageGrants: k.GetUsageGrants(ctx),
+		JsonSchemas: k.GetClassJSONSchemas(ctx),
+
+		ReservedClassPrefixes: k.GetReservedClassPrefixes(ctx),
+		Auctions:              k.GetAuctions(ctx),
+		NextAuctionId:         k.getNextAuctionID(ctx),
+		Royalties:             k.GetClassRoyalties(ctx),
+		MetadataMappings:      k.GetClassMetadataMappings(ctx),
+		TimelockedTransfers:   k.GetTimelockedTransfers(ctx),
+		TransferFees:          k.GetClassTransferFees(ctx),
 	}
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/nft"
@@ -101,8 +102,8 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 	case len(r.ClassId) > 0 && len(r.Owner) == 0:
 		nftStore := k.getNFTStore(ctx, r.ClassId)
 		if pageRes, err = query.Paginate(nftStore, r.Pagination, func(_, value []byte) error {
-			var nft nft.NFT
-			if err := k.cdc.Unmarshal(value, &nft); err != nil {
+			nft, err := UnmarshalNFT(k.cdc, value)
+			if err != nil {
 				return err
 			}
 			nfts = append(nfts, &nft)
@@ -129,6 +130,59 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 	}, nil
 }
 
+// NFTsOfOwner return the NFTs of an owner across all classes following the cursor, ordered by class id and id
+func (k Keeper) NFTsOfOwner(goCtx context.Context, r *nft.QueryNFTsOfOwnerRequest) (*nft.QueryNFTsOfOwnerResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := k.ac.StringToBytes(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var start []byte
+	if len(r.Cursor) > 0 {
+		key, err := parseNFTOwnerCursor(owner, r.Cursor)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		// resume right after the nft of the cursor, which may have been transferred or burned since
+		start = make([]byte, len(key)+1)
+		copy(start, key)
+	}
+
+	limit := r.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	iterator := k.prefixStoreNftOfClassByOwner(ctx, owner).Iterator(start, nil)
+	defer iterator.Close()
+
+	var (
+		nfts    []*nft.NFT
+		lastKey []byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(nfts)) == limit {
+			return &nft.QueryNFTsOfOwnerResponse{
+				Nfts:       nfts,
+				NextCursor: nftOwnerCursor(owner, lastKey),
+			}, nil
+		}
+
+		lastKey = append(lastKey[:0], iterator.Key()...)
+		classID, nftID := parseNftOfClassByOwnerStoreKey(lastKey)
+		if n, has := k.GetNFT(ctx, classID, nftID); has {
+			nfts = append(nfts, &n)
+		}
+	}
+
+	return &nft.QueryNFTsOfOwnerResponse{Nfts: nfts}, nil
+}
+
 // NFT return an NFT based on its class and id.
 func (k Keeper) NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error) {
 	if r == nil {
@@ -178,14 +232,25 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 	store := k.storeService.OpenKVStore(ctx)
 	classStore := prefix.NewStore(runtime.KVStoreAdapter(store), ClassKey)
 
+	if len(r.Issuer) > 0 {
+		if _, err := k.ac.StringToBytes(r.Issuer); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid issuer address (%s)", r.Issuer)
+		}
+	}
+
 	var classes []*nft.Class
-	pageRes, err := query.Paginate(classStore, r.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(classStore, r.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var class nft.Class
 		if err := k.cdc.Unmarshal(value, &class); err != nil {
-			return err
+			return false, err
 		}
-		classes = append(classes, &class)
-		return nil
+		if len(r.Issuer) > 0 && class.Issuer != r.Issuer {
+			return false, nil
+		}
+		if accumulate {
+			classes = append(classes, &class)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -195,3 +260,260 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassDataSchema return the protobuf type the NFT data of a class must decode to, and the json schema of the class
+func (k Keeper) ClassDataSchema(goCtx context.Context, r *nft.QueryClassDataSchemaRequest) (*nft.QueryClassDataSchemaResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	res := &nft.QueryClassDataSchemaResponse{}
+	res.TypeUrl, _ = k.GetClassDataSchema(ctx, r.ClassId)
+	if schema, ok := k.GetClassJSONSchema(ctx, r.ClassId); ok {
+		res.JsonSchema = &schema
+	}
+	return res, nil
+}
+
+// ClassStats return the supply and holder statistics of a class
+func (k Keeper) ClassStats(goCtx context.Context, r *nft.QueryClassStatsRequest) (*nft.QueryClassStatsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	stats := k.GetClassStats(ctx, r.ClassId)
+	return &nft.QueryClassStatsResponse{Stats: &stats}, nil
+}
+
+// ClassRoyalty return the default royalty of the nfts of a class
+func (k Keeper) ClassRoyalty(goCtx context.Context, r *nft.QueryClassRoyaltyRequest) (*nft.QueryClassRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	royalty, has := k.GetClassRoyalty(ctx, r.ClassId)
+	if !has {
+		return &nft.QueryClassRoyaltyResponse{}, nil
+	}
+	return &nft.QueryClassRoyaltyResponse{Royalty: &royalty}, nil
+}
+
+// ClassMetadataMapping return the mapping of the data of the nfts of a class to ERC-721 metadata
+func (k Keeper) ClassMetadataMapping(goCtx context.Context, r *nft.QueryClassMetadataMappingRequest) (*nft.QueryClassMetadataMappingResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	mapping, has := k.GetClassMetadataMapping(ctx, r.ClassId)
+	if !has {
+		return &nft.QueryClassMetadataMappingResponse{}, nil
+	}
+	return &nft.QueryClassMetadataMappingResponse{Mapping: &mapping}, nil
+}
+
+// ERC721Metadata return the data of a nft rendered as ERC-721 metadata JSON, through the metadata mapping of its class
+func (k Keeper) ERC721Metadata(goCtx context.Context, r *nft.QueryERC721MetadataRequest) (*nft.QueryERC721MetadataResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.RenderERC721Metadata(ctx, r.ClassId, r.Id)
+}
+
+// URI return the uri and uri hash of a class or of a nft, with the height they were last updated at
+func (k Keeper) URI(goCtx context.Context, r *nft.QueryURIRequest) (*nft.QueryURIResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var uri, uriHash string
+	if len(r.Id) == 0 {
+		class, has := k.GetClass(ctx, r.ClassId)
+		if !has {
+			return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+		}
+		uri, uriHash = class.Uri, class.UriHash
+	} else {
+		n, has := k.GetNFT(ctx, r.ClassId, r.Id)
+		if !has {
+			return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+		}
+		uri, uriHash = n.Uri, n.UriHash
+	}
+
+	height, _ := k.GetURIUpdateHeight(ctx, r.ClassId, r.Id)
+	return &nft.QueryURIResponse{
+		Uri:               uri,
+		UriHash:           uriHash,
+		LastUpdatedHeight: height,
+	}, nil
+}
+
+// UsageGrant return the unexpired usage grant of a nft
+func (k Keeper) UsageGrant(goCtx context.Context, r *nft.QueryUsageGrantRequest) (*nft.QueryUsageGrantResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	grant, has := k.GetUsageGrant(goCtx, r.ClassId, r.Id)
+	if !has {
+		return nil, nft.ErrUsageGrantNotExists.Wrapf("not found usage grant: class: %s, id: %s", r.ClassId, r.Id)
+	}
+	return &nft.QueryUsageGrantResponse{Grant: &grant}, nil
+}
+
+// ReservedClassPrefixes return the class id prefixes reserved by governance
+func (k Keeper) ReservedClassPrefixes(goCtx context.Context, r *nft.QueryReservedClassPrefixesRequest) (*nft.QueryReservedClassPrefixesResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.storeService.OpenKVStore(ctx)
+	reservationStore := prefix.NewStore(runtime.KVStoreAdapter(store), ReservedClassPrefixKey)
+
+	var reservations []*nft.ReservedClassPrefix
+	pageRes, err := query.Paginate(reservationStore, r.Pagination, func(_, value []byte) error {
+		var reservation nft.ReservedClassPrefix
+		if err := k.cdc.Unmarshal(value, &reservation); err != nil {
+			return err
+		}
+		reservations = append(reservations, &reservation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryReservedClassPrefixesResponse{
+		ReservedClassPrefixes: reservations,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// ClassTransferFee return the fee charged on the transfers of the nfts of a class
+func (k Keeper) ClassTransferFee(goCtx context.Context, r *nft.QueryClassTransferFeeRequest) (*nft.QueryClassTransferFeeResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	fee, has := k.GetClassTransferFee(ctx, r.ClassId)
+	if !has {
+		return &nft.QueryClassTransferFeeResponse{}, nil
+	}
+	return &nft.QueryClassTransferFeeResponse{Fee: &fee}, nil
+}
+
+// NFTsByID return the nfts with a given id, or with an id matching a prefix pattern ending with "*", across all
+// classes, ordered by id and class id
+func (k Keeper) NFTsByID(goCtx context.Context, r *nft.QueryNFTsByIDRequest) (*nft.QueryNFTsByIDResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	idPrefix, isPattern := strings.CutSuffix(r.Id, "*")
+	if len(idPrefix) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	// the index is keyed by <nftID><Delimiter><classID>, so the exact id is followed by the delimiter
+	keyPrefix := []byte(idPrefix)
+	if !isPattern {
+		keyPrefix = append(keyPrefix, Delimiter...)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.storeService.OpenKVStore(ctx)
+	indexStore := prefix.NewStore(runtime.KVStoreAdapter(store), append(append([]byte{}, NFTIDIndexKey...), keyPrefix...))
+
+	var nfts []*nft.NFT
+	pageRes, err := query.FilteredPaginate(indexStore, r.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		classID, nftID := parseNFTIDIndexStoreKey(append(append([]byte{}, keyPrefix...), key...))
+		// the nft ids may contain the delimiter, so an exact id also matches the ids it is a prefix of
+		if !isPattern && nftID != r.Id {
+			return false, nil
+		}
+
+		token, has := k.GetNFT(ctx, classID, nftID)
+		if !has {
+			return false, nil
+		}
+		if accumulate {
+			nfts = append(nfts, &token)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryNFTsByIDResponse{
+		Nfts:       nfts,
+		Pagination: pageRes,
+	}, nil
+}
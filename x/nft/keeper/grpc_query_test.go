@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -379,6 +381,138 @@ func (s *TestSuite) TestNFTs() {
 	}
 }
 
+func (s *TestSuite) TestNFTsOfOwner() {
+	require := s.Require()
+	owner := s.addrs[0]
+
+	for _, classID := range []string{"kitty", "puppy"} {
+		require.NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: classID}))
+	}
+	for _, n := range []nft.NFT{
+		{ClassId: "kitty", Id: "a"},
+		{ClassId: "kitty", Id: "b"},
+		{ClassId: "kitty", Id: "c"},
+		{ClassId: "puppy", Id: "a"},
+		{ClassId: "puppy", Id: "b"},
+	} {
+		require.NoError(s.nftKeeper.Mint(s.ctx, n, owner))
+	}
+
+	ids := func(nfts []*nft.NFT) (ids []string) {
+		for _, n := range nfts {
+			ids = append(ids, n.ClassId+"/"+n.Id)
+		}
+		return ids
+	}
+
+	res, err := s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Limit: 2})
+	require.NoError(err)
+	require.Equal([]string{"kitty/a", "kitty/b"}, ids(res.Nfts))
+	require.NotEmpty(res.NextCursor)
+
+	// the nfts of the first page, including the one of the cursor, are transferred before the next page
+	require.NoError(s.nftKeeper.Transfer(s.ctx, "kitty", "a", s.addrs[1]))
+	require.NoError(s.nftKeeper.Transfer(s.ctx, "kitty", "b", s.addrs[1]))
+
+	res, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Cursor: res.NextCursor, Limit: 2})
+	require.NoError(err)
+	require.Equal([]string{"kitty/c", "puppy/a"}, ids(res.Nfts))
+
+	res, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Cursor: res.NextCursor, Limit: 2})
+	require.NoError(err)
+	require.Equal([]string{"puppy/b"}, ids(res.Nfts))
+	require.Empty(res.NextCursor)
+
+	// the receiver enumerates the transferred nfts
+	res, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: s.addrs[1].String()})
+	require.NoError(err)
+	require.Equal([]string{"kitty/a", "kitty/b"}, ids(res.Nfts))
+
+	// a cursor over the nfts of another owner is rejected
+	res, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Limit: 1})
+	require.NoError(err)
+	_, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: s.addrs[1].String(), Cursor: res.NextCursor})
+	require.ErrorContains(err, "cursor does not belong to the owner")
+
+	_, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Cursor: res.NextCursor[:len(res.NextCursor)-2]})
+	require.ErrorContains(err, "malformed cursor")
+	_, err = s.queryClient.NFTsOfOwner(s.ctx, &nft.QueryNFTsOfOwnerRequest{Owner: owner.String(), Cursor: []byte("kitty")})
+	require.ErrorContains(err, "cursor does not belong to the owner")
+}
+
+func (s *TestSuite) TestNFTsByID() {
+	require := s.Require()
+
+	for _, classID := range []string{"eth/kitty", "ibc/kitty", "puppy"} {
+		require.NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: classID}))
+	}
+	for _, n := range []nft.NFT{
+		{ClassId: "eth/kitty", Id: "token-1"},
+		{ClassId: "eth/kitty", Id: "token-12"},
+		{ClassId: "ibc/kitty", Id: "token-1"},
+		{ClassId: "puppy", Id: "token-1"},
+		{ClassId: "puppy", Id: "other"},
+	} {
+		require.NoError(s.nftKeeper.Mint(s.ctx, n, s.addrs[0]))
+	}
+
+	ids := func(nfts []*nft.NFT) (ids []string) {
+		for _, n := range nfts {
+			ids = append(ids, n.ClassId+"/"+n.Id)
+		}
+		return ids
+	}
+
+	_, err := s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{})
+	require.ErrorIs(err, nft.ErrEmptyNFTID)
+	_, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "*"})
+	require.ErrorIs(err, nft.ErrEmptyNFTID)
+
+	// an exact id does not match the ids it is a prefix of
+	res, err := s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1"})
+	require.NoError(err)
+	require.Equal([]string{"eth/kitty/token-1", "ibc/kitty/token-1", "puppy/token-1"}, ids(res.Nfts))
+
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(err)
+	require.Equal([]string{"eth/kitty/token-1", "ibc/kitty/token-1"}, ids(res.Nfts))
+	require.EqualValues(3, res.Pagination.Total)
+
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1*"})
+	require.NoError(err)
+	require.Equal([]string{"eth/kitty/token-1", "ibc/kitty/token-1", "puppy/token-1", "eth/kitty/token-12"}, ids(res.Nfts))
+
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "unknown"})
+	require.NoError(err)
+	require.Empty(res.Nfts)
+
+	// burned nfts are removed from the index
+	require.NoError(s.nftKeeper.Burn(s.ctx, "ibc/kitty", "token-1"))
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1"})
+	require.NoError(err)
+	require.Equal([]string{"eth/kitty/token-1", "puppy/token-1"}, ids(res.Nfts))
+
+	// the index is rebuilt by the migration from consensus version 3
+	store := s.ctx.KVStore(s.storeKey)
+	iterator := store.Iterator(keeper.NFTIDIndexKey, []byte{keeper.NFTIDIndexKey[0] + 1})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1"})
+	require.NoError(err)
+	require.Empty(res.Nfts)
+
+	require.NoError(keeper.NewMigrator(s.nftKeeper).Migrate3to4(s.ctx))
+	res, err = s.queryClient.NFTsByID(s.ctx, &nft.QueryNFTsByIDRequest{Id: "token-1"})
+	require.NoError(err)
+	require.Equal([]string{"eth/kitty/token-1", "puppy/token-1"}, ids(res.Nfts))
+}
+
 func (s *TestSuite) TestNFT() {
 	var (
 		req    *nft.QueryNFTRequest
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ nft.NFTHooks = &recordingHooks{}
+
+// recordingHooks records the hooks called, and fails the transfers of blocked
+// nfts.
+type recordingHooks struct {
+	calls   []string
+	blocked string
+}
+
+func (h *recordingHooks) BeforeNFTTransfer(_ context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if nftID == h.blocked {
+		return errors.New("transfer blocked")
+	}
+	h.calls = append(h.calls, fmt.Sprintf("before transfer %s/%s %s->%s", classID, nftID, sender, receiver))
+	return nil
+}
+
+func (h *recordingHooks) AfterNFTTransfer(_ context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("after transfer %s/%s %s->%s", classID, nftID, sender, receiver))
+	return nil
+}
+
+func (h *recordingHooks) AfterNFTMint(_ context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("mint %s/%s %s", classID, nftID, owner))
+	return nil
+}
+
+func (h *recordingHooks) AfterNFTBurn(_ context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("burn %s/%s %s", classID, nftID, owner))
+	return nil
+}
+
+func (s *TestSuite) TestHooks() {
+	owner, receiver := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+
+	hooks := &recordingHooks{blocked: "kitty3"}
+	s.nftKeeper.SetHooks(nft.NewMultiNFTHooks(hooks))
+	s.Require().Panics(func() { s.nftKeeper.SetHooks(hooks) })
+
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, owner))
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{{ClassId: testClassID, Id: "kitty2"}, {ClassId: testClassID, Id: "kitty3"}}, owner))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, receiver))
+	s.Require().NoError(s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{"kitty2"}, receiver))
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+
+	s.Require().Equal([]string{
+		fmt.Sprintf("mint %s/%s %s", testClassID, testID, owner),
+		fmt.Sprintf("mint %s/kitty2 %s", testClassID, owner),
+		fmt.Sprintf("mint %s/kitty3 %s", testClassID, owner),
+		fmt.Sprintf("before transfer %s/%s %s->%s", testClassID, testID, owner, receiver),
+		fmt.Sprintf("after transfer %s/%s %s->%s", testClassID, testID, owner, receiver),
+		fmt.Sprintf("before transfer %s/kitty2 %s->%s", testClassID, owner, receiver),
+		fmt.Sprintf("after transfer %s/kitty2 %s->%s", testClassID, owner, receiver),
+		fmt.Sprintf("burn %s/%s %s", testClassID, testID, receiver),
+	}, hooks.calls)
+
+	// an error returned by BeforeNFTTransfer prevents the transfer
+	_, err := s.nftKeeper.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: "kitty3", Sender: owner.String(), Receiver: receiver.String()})
+	s.Require().ErrorContains(err, "transfer blocked")
+	s.Require().Equal(owner, s.nftKeeper.GetOwner(s.ctx, testClassID, "kitty3"))
+}
@@ -3,25 +3,43 @@ package keeper
 import (
 	"cosmossdk.io/core/address"
 	store "cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper of the nft store
 type Keeper struct {
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	storeService store.KVStoreService
 	bk           nft.BankKeeper
 	ac           address.Codec
+
+	// the nft module account, escrowing the nfts of the timelocked transfers
+	escrow sdk.AccAddress
+
+	// the optional keeper of the community pool collecting the transfer fees of the classes routing them to it
+	communityPool nft.CommunityPoolKeeper
+
+	// the optional circuit breaker consulted before processing the Msg's acting on a class or a nft
+	circuitBreaker nft.CircuitBreaker
+
+	// the hooks called on the nft lifecycle, shared by the copies of the keeper
+	hooks *nft.NFTHooks
+
+	// the address capable of updating the uri of classes, usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(storeService store.KVStoreService,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.Codec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
-	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
+	escrow := ak.GetModuleAddress(nft.ModuleName)
+	if escrow == nil {
 		panic("the nft module account has not been set")
 	}
 
@@ -30,5 +48,54 @@ func NewKeeper(storeService store.KVStoreService,
 		storeService: storeService,
 		bk:           bk,
 		ac:           ak.AddressCodec(),
+		escrow:       escrow,
+		hooks:        new(nft.NFTHooks),
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetCircuitBreaker sets the circuit breaker able to pause classes and nfts.
+// It must be called before the keeper is passed to the module and msg server.
+func (k *Keeper) SetCircuitBreaker(cb nft.CircuitBreaker) {
+	k.circuitBreaker = cb
+}
+
+// SetCommunityPoolKeeper sets the keeper of the community pool, without which the
+// transfer fees of the classes cannot be routed to the community pool.
+// It must be called before the keeper is passed to the module and msg server.
+func (k *Keeper) SetCommunityPoolKeeper(cpk nft.CommunityPoolKeeper) {
+	k.communityPool = cpk
+}
+
+// SetHooks sets the hooks called on the nft lifecycle. As they are shared by
+// the copies of the keeper, it can be called after the keeper is passed to the
+// modules registering the hooks. It panics if the hooks are already set.
+func (k Keeper) SetHooks(hooks nft.NFTHooks) {
+	if *k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+	*k.hooks = hooks
+}
+
+// Hooks returns the hooks called on the nft lifecycle, or nil if none are set.
+func (k Keeper) Hooks() nft.NFTHooks {
+	return *k.hooks
+}
+
+// checkNotPaused returns an error if the circuit breaker paused the given class,
+// or the given nft of the class.
+func (k Keeper) checkNotPaused(ctx sdk.Context, classID, nftID string) error {
+	if k.circuitBreaker == nil || k.circuitBreaker.IsNFTAllowed(ctx, classID, nftID) {
+		return nil
+	}
+
+	if nftID == "" {
+		return errors.Wrapf(nft.ErrPaused, "class %s", classID)
 	}
+	return errors.Wrapf(nft.ErrPaused, "nft %s of class %s", nftID, classID)
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/golang/mock/gomock"
@@ -21,6 +22,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -39,10 +42,12 @@ type TestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	storeKey      *storetypes.KVStoreKey
 	addrs         []sdk.AccAddress
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
 	accountKeeper *nfttestutil.MockAccountKeeper
+	bankKeeper    *nfttestutil.MockBankKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -65,14 +70,16 @@ func (s *TestSuite) SetupTest() {
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
 
-	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
+	s.storeKey = key
 }
 
 func TestTestSuite(t *testing.T) {
@@ -133,12 +140,23 @@ func (s *TestSuite) TestUpdateClass() {
 		UriHash:     testClassURIHash,
 	}
 
-	err = s.nftKeeper.UpdateClass(s.ctx, except)
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.nftKeeper.UpdateClass(ctx, except)
 	s.Require().NoError(err)
 
 	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
 	s.Require().True(has)
 	s.Require().EqualValues(except, actual)
+
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(ctx.EventManager().Events()[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventClassUpdated{
+		ClassId:     testClassID,
+		Name:        "My crypto Kitty",
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+	}, event)
 }
 
 func (s *TestSuite) TestMint() {
@@ -307,8 +325,19 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().NoError(err)
 
 	// valid owner
-	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.nftKeeper.Transfer(ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(ctx.EventManager().Events()[0]))
 	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventTransfer{
+		ClassId:  testClassID,
+		Id:       testID,
+		OldOwner: s.addrs[0].String(),
+		NewOwner: s.addrs[1].String(),
+	}, event)
 
 	// test GetOwner
 	owner := s.nftKeeper.GetOwner(s.ctx, testClassID, testID)
@@ -351,6 +380,11 @@ func (s *TestSuite) TestExportGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassStats: []*nft.ClassStats{{
+			ClassId:     testClassID,
+			TotalMinted: 1,
+			Holders:     1,
+		}},
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
+	"time"
 
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/internal/conv"
@@ -10,11 +12,29 @@ import (
 )
 
 var (
-	ClassKey             = []byte{0x01}
-	NFTKey               = []byte{0x02}
-	NFTOfClassByOwnerKey = []byte{0x03}
-	OwnerKey             = []byte{0x04}
-	ClassTotalSupply     = []byte{0x05}
+	ClassKey                = []byte{0x01}
+	NFTKey                  = []byte{0x02}
+	NFTOfClassByOwnerKey    = []byte{0x03}
+	OwnerKey                = []byte{0x04}
+	ClassTotalSupply        = []byte{0x05}
+	ClassDataSchemaKey      = []byte{0x06}
+	ClassStatsKey           = []byte{0x07}
+	ClassOwnerBalanceKey    = []byte{0x08}
+	URIUpdateHeightKey      = []byte{0x09}
+	UsageGrantKey           = []byte{0x0A}
+	UsageGrantQueueKey      = []byte{0x0B}
+	ClassJSONSchemaKey      = []byte{0x0C}
+	ReservedClassPrefixKey  = []byte{0x0D}
+	AuctionKey              = []byte{0x0E}
+	AuctionQueueKey         = []byte{0x0F}
+	AuctionSequenceKey      = []byte{0x10}
+	ClassRoyaltyKey         = []byte{0x11}
+	ClassMetadataMappingKey = []byte{0x12}
+	TimelockKey             = []byte{0x13}
+	TimelockHeightQueueKey  = []byte{0x14}
+	TimelockTimeQueueKey    = []byte{0x15}
+	ClassTransferFeeKey     = []byte{0x16}
+	NFTIDIndexKey           = []byte{0x17}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -48,6 +68,275 @@ func classTotalSupply(classID string) []byte {
 	return key
 }
 
+// classDataSchemaStoreKey returns the byte representation of the nft class data schema key
+func classDataSchemaStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassDataSchemaKey)+len(classID))
+	copy(key, ClassDataSchemaKey)
+	copy(key[len(ClassDataSchemaKey):], classID)
+	return key
+}
+
+// classJSONSchemaStoreKey returns the byte representation of the nft class json schema key
+func classJSONSchemaStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassJSONSchemaKey)+len(classID))
+	copy(key, ClassJSONSchemaKey)
+	copy(key[len(ClassJSONSchemaKey):], classID)
+	return key
+}
+
+// reservedClassPrefixStoreKey returns the byte representation of the reserved class id prefix key
+func reservedClassPrefixStoreKey(prefix string) []byte {
+	key := make([]byte, len(ReservedClassPrefixKey)+len(prefix))
+	copy(key, ReservedClassPrefixKey)
+	copy(key[len(ReservedClassPrefixKey):], prefix)
+	return key
+}
+
+// classTransferFeeStoreKey returns the byte representation of the nft class transfer fee key
+func classTransferFeeStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassTransferFeeKey)+len(classID))
+	copy(key, ClassTransferFeeKey)
+	copy(key[len(ClassTransferFeeKey):], classID)
+	return key
+}
+
+// classRoyaltyStoreKey returns the byte representation of the nft class royalty key
+func classRoyaltyStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassRoyaltyKey)+len(classID))
+	copy(key, ClassRoyaltyKey)
+	copy(key[len(ClassRoyaltyKey):], classID)
+	return key
+}
+
+// classMetadataMappingStoreKey returns the byte representation of the nft class metadata mapping key
+func classMetadataMappingStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassMetadataMappingKey)+len(classID))
+	copy(key, ClassMetadataMappingKey)
+	copy(key[len(ClassMetadataMappingKey):], classID)
+	return key
+}
+
+// auctionStoreKey returns the byte representation of the auction key
+// Items are stored with the following key: values
+// 0x0E<id(8 Bytes)>
+func auctionStoreKey(id uint64) []byte {
+	return append(append([]byte{}, AuctionKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// auctionQueueStoreKey returns the byte representation of the entry of an auction in the end time queue
+// Items are stored with the following key: values
+// 0x0F<endTime><id(8 Bytes)>
+func auctionQueueStoreKey(endTime time.Time, id uint64) []byte {
+	return append(auctionQueueByTimeKey(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// auctionQueueByTimeKey returns the prefix of the entries of the end time queue of the auctions ending at the given
+// time
+// 0x0F<endTime>
+func auctionQueueByTimeKey(endTime time.Time) []byte {
+	// no need of a length prefix here, FormatTimeBytes returns a fixed length
+	return append(append([]byte{}, AuctionQueueKey...), sdk.FormatTimeBytes(endTime)...)
+}
+
+// parseAuctionQueueStoreKey extracts the auction id from a key of the end time queue of the auctions
+func parseAuctionQueueStoreKey(key []byte) uint64 {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	return sdk.BigEndianToUint64(key[len(AuctionQueueKey)+lenTime:])
+}
+
+// classStatsStoreKey returns the byte representation of the nft class stats key
+func classStatsStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassStatsKey)+len(classID))
+	copy(key, ClassStatsKey)
+	copy(key[len(ClassStatsKey):], classID)
+	return key
+}
+
+// classOwnerBalanceStoreKey returns the byte representation of the number of nfts of a class held by an owner
+// Items are stored with the following key: values
+// 0x08<classID><Delimiter(1 Byte)><owner>
+func classOwnerBalanceStoreKey(classID string, owner sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+
+	key := make([]byte, len(ClassOwnerBalanceKey)+len(classIDBz)+len(Delimiter)+len(owner))
+	copy(key, ClassOwnerBalanceKey)
+	copy(key[len(ClassOwnerBalanceKey):], classIDBz)
+	copy(key[len(ClassOwnerBalanceKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ClassOwnerBalanceKey)+len(classIDBz)+len(Delimiter):], owner)
+	return key
+}
+
+// uriUpdateHeightStoreKey returns the byte representation of the height at which the uri of a class, if nftID is
+// empty, or of a nft was last updated
+// Items are stored with the following key: values
+// 0x09<classID><Delimiter(1 Byte)><nftID>
+func uriUpdateHeightStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(URIUpdateHeightKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, URIUpdateHeightKey)
+	copy(key[len(URIUpdateHeightKey):], classIDBz)
+	copy(key[len(URIUpdateHeightKey)+len(classIDBz):], Delimiter)
+	copy(key[len(URIUpdateHeightKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// usageGrantStoreKey returns the byte representation of the usage grant of a nft
+// Items are stored with the following key: values
+// 0x0A<classID><Delimiter(1 Byte)><nftID>
+func usageGrantStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(UsageGrantKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, UsageGrantKey)
+	copy(key[len(UsageGrantKey):], classIDBz)
+	copy(key[len(UsageGrantKey)+len(classIDBz):], Delimiter)
+	copy(key[len(UsageGrantKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// usageGrantQueueStoreKey returns the byte representation of the entry of a usage grant in the expiry queue
+// Items are stored with the following key: values
+// 0x0B<expiry><classID><Delimiter(1 Byte)><nftID>
+func usageGrantQueueStoreKey(expiry time.Time, classID, nftID string) []byte {
+	expiryBz := usageGrantQueueByTimeKey(expiry)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(expiryBz)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, expiryBz)
+	copy(key[len(expiryBz):], classIDBz)
+	copy(key[len(expiryBz)+len(classIDBz):], Delimiter)
+	copy(key[len(expiryBz)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// usageGrantQueueByTimeKey returns the prefix of the entries of the expiry queue of the usage grants expiring at the
+// given time
+// 0x0B<expiry>
+func usageGrantQueueByTimeKey(expiry time.Time) []byte {
+	// no need of a length prefix here, FormatTimeBytes returns a fixed length
+	return append(append([]byte{}, UsageGrantQueueKey...), sdk.FormatTimeBytes(expiry)...)
+}
+
+// parseUsageGrantQueueStoreKey extracts the class id and the nft id from a key of the expiry queue of the usage grants
+func parseUsageGrantQueueStoreKey(key []byte) (classID, nftID string) {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	ret := bytes.SplitN(key[len(UsageGrantQueueKey)+lenTime:], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid usageGrantQueueStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// timelockStoreKey returns the byte representation of the timelocked transfer of a nft
+// Items are stored with the following key: values
+// 0x13<classID><Delimiter(1 Byte)><nftID>
+func timelockStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(TimelockKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, TimelockKey)
+	copy(key[len(TimelockKey):], classIDBz)
+	copy(key[len(TimelockKey)+len(classIDBz):], Delimiter)
+	copy(key[len(TimelockKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// timelockHeightQueueStoreKey returns the byte representation of the entry of a timelocked transfer in the release
+// height queue
+// Items are stored with the following key: values
+// 0x14<height(8 Bytes)><classID><Delimiter(1 Byte)><nftID>
+func timelockHeightQueueStoreKey(height int64, classID, nftID string) []byte {
+	return appendNFTKey(timelockHeightQueueByHeightKey(height), classID, nftID)
+}
+
+// timelockHeightQueueByHeightKey returns the prefix of the entries of the release height queue of the timelocked
+// transfers released at the given height
+// 0x14<height(8 Bytes)>
+func timelockHeightQueueByHeightKey(height int64) []byte {
+	return append(append([]byte{}, TimelockHeightQueueKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// parseTimelockHeightQueueStoreKey extracts the class id and the nft id from a key of the release height queue of the
+// timelocked transfers
+func parseTimelockHeightQueueStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.SplitN(key[len(TimelockHeightQueueKey)+8:], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid timelockHeightQueueStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// timelockTimeQueueStoreKey returns the byte representation of the entry of a timelocked transfer in the release time
+// queue
+// Items are stored with the following key: values
+// 0x15<releaseTime><classID><Delimiter(1 Byte)><nftID>
+func timelockTimeQueueStoreKey(releaseTime time.Time, classID, nftID string) []byte {
+	return appendNFTKey(timelockTimeQueueByTimeKey(releaseTime), classID, nftID)
+}
+
+// timelockTimeQueueByTimeKey returns the prefix of the entries of the release time queue of the timelocked transfers
+// released at the given time
+// 0x15<releaseTime>
+func timelockTimeQueueByTimeKey(releaseTime time.Time) []byte {
+	// no need of a length prefix here, FormatTimeBytes returns a fixed length
+	return append(append([]byte{}, TimelockTimeQueueKey...), sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// parseTimelockTimeQueueStoreKey extracts the class id and the nft id from a key of the release time queue of the
+// timelocked transfers
+func parseTimelockTimeQueueStoreKey(key []byte) (classID, nftID string) {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	ret := bytes.SplitN(key[len(TimelockTimeQueueKey)+lenTime:], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid timelockTimeQueueStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// nftIDIndexStoreKey returns the byte representation of the entry of a nft in the index of the nfts by id
+// Items are stored with the following key: values
+// 0x17<nftID><Delimiter(1 Byte)><classID>
+func nftIDIndexStoreKey(classID, nftID string) []byte {
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+
+	key := make([]byte, len(NFTIDIndexKey)+len(nftIDBz)+len(Delimiter)+len(classIDBz))
+	copy(key, NFTIDIndexKey)
+	copy(key[len(NFTIDIndexKey):], nftIDBz)
+	copy(key[len(NFTIDIndexKey)+len(nftIDBz):], Delimiter)
+	copy(key[len(NFTIDIndexKey)+len(nftIDBz)+len(Delimiter):], classIDBz)
+	return key
+}
+
+// parseNFTIDIndexStoreKey extracts the class id and the nft id from a key of the index of the nfts by id, without
+// its 0x17 prefix. The class ids never contain the delimiter, unlike the nft ids, so the key is split at the last one
+func parseNFTIDIndexStoreKey(key []byte) (classID, nftID string) {
+	i := bytes.LastIndex(key, Delimiter)
+	if i < 0 {
+		panic("invalid nftIDIndexStoreKey")
+	}
+	return string(key[i+len(Delimiter):]), string(key[:i])
+}
+
+// appendNFTKey returns the prefix followed by <classID><Delimiter(1 Byte)><nftID>
+func appendNFTKey(prefix []byte, classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(prefix)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], classIDBz)
+	copy(key[len(prefix)+len(classIDBz):], Delimiter)
+	copy(key[len(prefix)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
 // nftOfClassByOwnerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x03<owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)>
@@ -89,6 +378,40 @@ func parseNftOfClassByOwnerStoreKey(key []byte) (classID, nftID string) {
 	return
 }
 
+// nftOwnerCursor returns the cursor of the NFTsOfOwner query positioned at the nft of the owner whose key in the
+// prefix store of the owner, constructed using the prefixNftOfClassByOwnerStoreKey function, is the given key
+// The cursor is the full nftOfClassByOwnerStoreKey path without the 0x03 prefix:
+// <owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)><nftID>
+func nftOwnerCursor(owner sdk.AccAddress, key []byte) []byte {
+	ownerPrefix := prefixNftOfClassByOwnerStoreKey(owner)[len(NFTOfClassByOwnerKey):]
+
+	cursor := make([]byte, len(ownerPrefix)+len(key))
+	copy(cursor, ownerPrefix)
+	copy(cursor[len(ownerPrefix):], key)
+	return cursor
+}
+
+// parseNFTOwnerCursor returns the key in the prefix store of the owner of the nft the cursor is positioned at,
+// failing if the cursor is malformed or is not a cursor over the nfts of the owner
+func parseNFTOwnerCursor(owner sdk.AccAddress, cursor []byte) ([]byte, error) {
+	ownerPrefix := prefixNftOfClassByOwnerStoreKey(owner)[len(NFTOfClassByOwnerKey):]
+	if !bytes.HasPrefix(cursor, ownerPrefix) {
+		return nil, errors.New("cursor does not belong to the owner")
+	}
+
+	key := cursor[len(ownerPrefix):]
+	if ret := bytes.Split(key, Delimiter); len(ret) != 2 || len(ret[0]) == 0 || len(ret[1]) == 0 {
+		return nil, errors.New("malformed cursor")
+	}
+	return key, nil
+}
+
+// OwnerStoreKey returns the key of the owner of an nft in the nft store, e.g.
+// to query the ownership record with a merkle proof.
+func OwnerStoreKey(classID, nftID string) []byte {
+	return ownerStoreKey(classID, nftID)
+}
+
 // ownerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x04<classID><Delimiter(1 Byte)><nftID>
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetMetadataMapping sets the mapping of the data of the nfts of a class to ERC-721 metadata, replacing the previous one
+// if any.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) SetMetadataMapping(ctx context.Context, mapping nft.ClassMetadataMapping, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, mapping.ClassId, admin)
+	if err != nil {
+		return err
+	}
+
+	if err := mapping.Validate(); err != nil {
+		return err
+	}
+	if err := k.setClassMetadataMapping(ctx, mapping); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassMetadataMappingSet{
+		ClassId: mapping.ClassId,
+		Admin:   class.Admin,
+	})
+}
+
+// RemoveMetadataMapping removes the mapping of the data of the nfts of a class to ERC-721 metadata.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) RemoveMetadataMapping(ctx context.Context, classID string, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, classID, admin)
+	if err != nil {
+		return err
+	}
+
+	if _, has := k.GetClassMetadataMapping(ctx, classID); !has {
+		return errors.Wrapf(nft.ErrInvalidMetadataMapping, "class %s has no metadata mapping", classID)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(classMetadataMappingStoreKey(classID)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassMetadataMappingSet{
+		ClassId: classID,
+		Admin:   class.Admin,
+		Removed: true,
+	})
+}
+
+// GetClassMetadataMapping returns the mapping of the data of the nfts of the specified class to ERC-721 metadata
+func (k Keeper) GetClassMetadataMapping(ctx context.Context, classID string) (nft.ClassMetadataMapping, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var mapping nft.ClassMetadataMapping
+
+	bz, err := store.Get(classMetadataMappingStoreKey(classID))
+	if err != nil || len(bz) == 0 {
+		return mapping, false
+	}
+	k.cdc.MustUnmarshal(bz, &mapping)
+	return mapping, true
+}
+
+// GetClassMetadataMappings returns the metadata mappings of all classes
+func (k Keeper) GetClassMetadataMappings(ctx context.Context) (mappings []nft.ClassMetadataMapping) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassMetadataMappingKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var mapping nft.ClassMetadataMapping
+		k.cdc.MustUnmarshal(iterator.Value(), &mapping)
+		mappings = append(mappings, mapping)
+	}
+	return
+}
+
+// RenderERC721Metadata renders the specified nft as ERC-721 metadata, through the metadata mapping of its class. The
+// nfts of the classes without a mapping are rendered with the defaults only, see nft.ClassMetadataMapping. The mapping
+// of the nfts imported from cw721 contracts applies to their metadata extension.
+func (k Keeper) RenderERC721Metadata(ctx context.Context, classID, nftID string) (*nft.QueryERC721MetadataResponse, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	token, has := k.GetNFT(ctx, classID, nftID)
+	if !has {
+		return nil, errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	doc, err := k.nftDataJSON(token)
+	if err != nil {
+		return nil, errors.Wrap(nft.ErrInvalidNFTData, err.Error())
+	}
+
+	mapping, _ := k.GetClassMetadataMapping(ctx, classID)
+	return mapping.ERC721Metadata(class, token, doc)
+}
+
+// nftDataJSON returns the JSON form of the data of the given nft, or its metadata extension if it is imported from a
+// cw721 contract
+func (k Keeper) nftDataJSON(token nft.NFT) ([]byte, error) {
+	if token.Data == nil || token.Data.TypeUrl != sdk.MsgTypeURL(&nft.Cw721Metadata{}) {
+		return k.dataJSON(token.Data)
+	}
+
+	var metadata nft.Cw721Metadata
+	if err := k.cdc.Unmarshal(token.Data.Value, &metadata); err != nil {
+		return nil, err
+	}
+	if len(metadata.Extension) == 0 {
+		return []byte("null"), nil
+	}
+	return []byte(metadata.Extension), nil
+}
+
+// setClassMetadataMapping stores the metadata mapping of a class
+func (k Keeper) setClassMetadataMapping(ctx context.Context, mapping nft.ClassMetadataMapping) error {
+	bz, err := k.cdc.Marshal(&mapping)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassMetadataMapping failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classMetadataMappingStoreKey(mapping.ClassId), bz)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestSetClassMetadataMapping() {
+	admin := s.addrs[2]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Name: "Kitties", Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "adminless"}))
+
+	mapping := nft.ClassMetadataMapping{
+		ClassId:    testClassID,
+		Name:       "/name",
+		Attributes: []nft.MetadataAttributeMapping{{TraitType: "color", Pointer: "/color"}},
+	}
+	withClassID := func(classID string) nft.ClassMetadataMapping {
+		m := mapping
+		m.ClassId = classID
+		return m
+	}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgSetClassMetadataMapping
+		expErr error
+	}{
+		{"empty class id", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: withClassID("")}, nft.ErrEmptyClassID},
+		{"invalid sender", &nft.MsgSetClassMetadataMapping{Sender: "invalid", Mapping: mapping}, sdkerrors.ErrInvalidAddress},
+		{"class does not exist", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: withClassID("unknown")}, nft.ErrClassNotExists},
+		{"class has no admin", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: withClassID("adminless")}, sdkerrors.ErrUnauthorized},
+		{"sender is not the admin", &nft.MsgSetClassMetadataMapping{Sender: s.addrs[0].String(), Mapping: mapping}, sdkerrors.ErrUnauthorized},
+		{"invalid mapping", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: nft.ClassMetadataMapping{ClassId: testClassID, Name: "name"}}, nft.ErrInvalidMetadataMapping},
+		{"no mapping to remove", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: nft.ClassMetadataMapping{ClassId: testClassID}}, nft.ErrInvalidMetadataMapping},
+		{"valid", &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: mapping}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.SetClassMetadataMapping(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	stored, has := s.nftKeeper.GetClassMetadataMapping(ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(mapping, stored)
+	res, err := s.queryClient.ClassMetadataMapping(ctx, &nft.QueryClassMetadataMappingRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&mapping, res.Mapping)
+	res, err = s.queryClient.ClassMetadataMapping(ctx, &nft.QueryClassMetadataMappingRequest{ClassId: "adminless"})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Mapping)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventClassMetadataMappingSet{ClassId: testClassID, Admin: admin.String()}, event)
+
+	// the mapping is preserved across genesis export and import
+	genesis := s.nftKeeper.ExportGenesis(ctx)
+	s.Require().Equal([]nft.ClassMetadataMapping{mapping}, genesis.MetadataMappings)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	stored, has = s.nftKeeper.GetClassMetadataMapping(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(mapping, stored)
+
+	genesis.MetadataMappings = append(genesis.MetadataMappings, mapping)
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidMetadataMapping)
+
+	// an empty mapping removes the mapping
+	_, err = s.nftKeeper.SetClassMetadataMapping(s.ctx, &nft.MsgSetClassMetadataMapping{Sender: admin.String(), Mapping: nft.ClassMetadataMapping{ClassId: testClassID}})
+	s.Require().NoError(err)
+	_, has = s.nftKeeper.GetClassMetadataMapping(s.ctx, testClassID)
+	s.Require().False(has)
+	s.Require().Empty(s.nftKeeper.GetClassMetadataMappings(s.ctx))
+}
+
+func (s *TestSuite) TestERC721Metadata() {
+	authority := s.nftKeeper.GetAuthority()
+	contract := s.addrs[2].String()
+	_, err := s.nftKeeper.ImportCw721(s.ctx, &nft.MsgImportCw721{Authority: authority, Collections: []nft.Cw721Collection{{
+		Contract: contract,
+		ClassId:  testClassID,
+		Name:     "Kitties",
+		Tokens: []nft.Cw721Token{
+			{TokenId: testID, Owner: s.addrs[0].String(), TokenUri: "ipfs://kitty1", Extension: `{"name":"Grumpy","image":"ipfs://grumpy","color":"grey"}`},
+		},
+	}}})
+	s.Require().NoError(err)
+
+	_, err = s.queryClient.ERC721Metadata(s.ctx, &nft.QueryERC721MetadataRequest{ClassId: testClassID})
+	s.Require().ErrorIs(err, nft.ErrEmptyNFTID)
+	_, err = s.queryClient.ERC721Metadata(s.ctx, &nft.QueryERC721MetadataRequest{ClassId: testClassID, Id: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	// without a mapping, the nft is rendered with the defaults
+	res, err := s.queryClient.ERC721Metadata(s.ctx, &nft.QueryERC721MetadataRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal("Kitties #"+testID, res.Name)
+	s.Require().Equal("ipfs://kitty1", res.Image)
+	s.Require().Empty(res.Attributes)
+
+	// the mapping applies to the metadata extension of the nfts imported from cw721 contracts
+	class, _ := s.nftKeeper.GetClass(s.ctx, testClassID)
+	class.Admin = s.addrs[2].String()
+	s.Require().NoError(s.nftKeeper.UpdateClass(s.ctx, class))
+	s.Require().NoError(s.nftKeeper.SetMetadataMapping(s.ctx, nft.ClassMetadataMapping{
+		ClassId:    testClassID,
+		Name:       "/name",
+		Image:      "/image",
+		Attributes: []nft.MetadataAttributeMapping{{TraitType: "color", Pointer: "/color"}},
+	}, s.addrs[2]))
+	res, err = s.queryClient.ERC721Metadata(s.ctx, &nft.QueryERC721MetadataRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.QueryERC721MetadataResponse{
+		Name:       "Grumpy",
+		Image:      "ipfs://grumpy",
+		Attributes: []nft.ERC721Attribute{{TraitType: "color", Value: "grey"}},
+	}, res)
+}
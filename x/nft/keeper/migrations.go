@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It builds the class stats and per owner class balances from the existing nfts.
+// The nfts burned before the migration are unknown, so the total minted of a
+// class starts at its current supply.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, class := range m.keeper.GetClasses(ctx) {
+		stats := m.keeper.GetClassStats(ctx, class.Id)
+		stats.TotalMinted = m.keeper.GetTotalSupply(ctx, class.Id)
+		m.keeper.setClassStats(ctx, stats)
+
+		for _, token := range m.keeper.GetNFTsOfClass(ctx, class.Id) {
+			owner := m.keeper.GetOwner(ctx, class.Id, token.Id)
+			m.keeper.incrClassOwnerBalance(ctx, class.Id, owner)
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It compresses the nfts whose Data value is larger than
+// DataCompressionThreshold, which were stored uncompressed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	compressed, err := m.keeper.compressNFTs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("compressed the data of the nfts", "module", nft.ModuleName, "nfts", compressed)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It builds the index of the nfts by id from the existing nfts.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var indexed int
+	for _, class := range m.keeper.GetClasses(ctx) {
+		for _, token := range m.keeper.GetNFTsOfClass(ctx, class.Id) {
+			m.keeper.setNFTIDIndex(ctx, class.Id, token.Id)
+			indexed++
+		}
+	}
+
+	ctx.Logger().Info("indexed the nfts by id", "module", nft.ModuleName, "nfts", indexed)
+	return nil
+}
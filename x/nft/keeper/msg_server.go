@@ -34,20 +34,288 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
 	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
 	if !bytes.Equal(owner, sender) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
 	}
 
-	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+	if err := k.TransferWithFee(ctx, msg.ClassId, msg.Id, receiver); err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventSend{
 		ClassId:  msg.ClassId,
 		Id:       msg.Id,
 		Sender:   msg.Sender,
 		Receiver: msg.Receiver,
-	})
+	}); err != nil {
+		return nil, err
+	}
 	return &nft.MsgSendResponse{}, nil
 }
+
+// UpdateURI implements UpdateURI method of the types.MsgServer.
+func (k Keeper) UpdateURI(goCtx context.Context, msg *nft.MsgUpdateURI) (*nft.MsgUpdateURIResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if err := nft.ValidateURIHash(msg.UriHash); err != nil {
+		return nil, err
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if len(msg.Id) == 0 {
+		if k.authority != msg.Sender {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Sender)
+		}
+
+		if err := k.UpdateClassURI(ctx, msg.ClassId, msg.Uri, msg.UriHash); err != nil {
+			return nil, err
+		}
+		return &nft.MsgUpdateURIResponse{}, nil
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	}
+
+	if err := k.UpdateNFTURI(ctx, msg.ClassId, msg.Id, msg.Uri, msg.UriHash); err != nil {
+		return nil, err
+	}
+	return &nft.MsgUpdateURIResponse{}, nil
+}
+
+// GrantUse implements GrantUse method of the types.MsgServer.
+func (k Keeper) GrantUse(goCtx context.Context, msg *nft.MsgGrantUse) (*nft.MsgGrantUseResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	grantee, err := k.ac.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", msg.Grantee)
+	}
+
+	if bytes.Equal(sender, grantee) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot grant the usage of a nft to its owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	}
+
+	if err := k.GrantUsage(ctx, msg.ClassId, msg.Id, grantee, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventGrantUse{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Sender,
+		Grantee: msg.Grantee,
+		Expiry:  msg.Expiry,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgGrantUseResponse{}, nil
+}
+
+// Revoke implements Revoke method of the types.MsgServer.
+func (k Keeper) Revoke(goCtx context.Context, msg *nft.MsgRevoke) (*nft.MsgRevokeResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if err := k.checkNotPaused(sdk.UnwrapSDKContext(goCtx), msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := k.RevokeNFT(goCtx, msg.ClassId, msg.Id, sender); err != nil {
+		return nil, err
+	}
+	return &nft.MsgRevokeResponse{}, nil
+}
+
+// FreezeClass implements FreezeClass method of the types.MsgServer.
+func (k Keeper) FreezeClass(goCtx context.Context, msg *nft.MsgFreezeClass) (*nft.MsgFreezeClassResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if err := k.Freeze(goCtx, msg.ClassId, sender); err != nil {
+		return nil, err
+	}
+	return &nft.MsgFreezeClassResponse{}, nil
+}
+
+// UnfreezeClass implements UnfreezeClass method of the types.MsgServer.
+func (k Keeper) UnfreezeClass(goCtx context.Context, msg *nft.MsgUnfreezeClass) (*nft.MsgUnfreezeClassResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if err := k.Unfreeze(goCtx, msg.ClassId, sender); err != nil {
+		return nil, err
+	}
+	return &nft.MsgUnfreezeClassResponse{}, nil
+}
+
+// ReserveClassPrefix implements ReserveClassPrefix method of the types.MsgServer.
+func (k Keeper) ReserveClassPrefix(goCtx context.Context, msg *nft.MsgReserveClassPrefix) (*nft.MsgReserveClassPrefixResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.SetReservedClassPrefix(goCtx, nft.ReservedClassPrefix{Prefix: msg.Prefix, Owner: msg.Owner}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgReserveClassPrefixResponse{}, nil
+}
+
+// ReleaseClassPrefix implements ReleaseClassPrefix method of the types.MsgServer.
+func (k Keeper) ReleaseClassPrefix(goCtx context.Context, msg *nft.MsgReleaseClassPrefix) (*nft.MsgReleaseClassPrefixResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.DeleteReservedClassPrefix(goCtx, msg.Prefix); err != nil {
+		return nil, err
+	}
+	return &nft.MsgReleaseClassPrefixResponse{}, nil
+}
+
+// SetClassRoyalty implements SetClassRoyalty method of the types.MsgServer.
+func (k Keeper) SetClassRoyalty(goCtx context.Context, msg *nft.MsgSetClassRoyalty) (*nft.MsgSetClassRoyaltyResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if len(msg.Recipients) == 0 {
+		err = k.RemoveRoyalty(goCtx, msg.ClassId, sender)
+	} else {
+		err = k.SetRoyalty(goCtx, nft.ClassRoyalty{
+			ClassId:    msg.ClassId,
+			Rate:       msg.Rate,
+			Recipients: msg.Recipients,
+		}, sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgSetClassRoyaltyResponse{}, nil
+}
+
+// ImportCw721 implements ImportCw721 method of the types.MsgServer.
+func (k Keeper) ImportCw721(goCtx context.Context, msg *nft.MsgImportCw721) (*nft.MsgImportCw721Response, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	classIDs := make([]string, len(msg.Collections))
+	for i, collection := range msg.Collections {
+		classID, err := k.ImportCw721Collection(goCtx, collection)
+		if err != nil {
+			return nil, err
+		}
+		classIDs[i] = classID
+	}
+	return &nft.MsgImportCw721Response{ClassIds: classIDs}, nil
+}
+
+// SetClassMetadataMapping implements SetClassMetadataMapping method of the types.MsgServer.
+func (k Keeper) SetClassMetadataMapping(goCtx context.Context, msg *nft.MsgSetClassMetadataMapping) (*nft.MsgSetClassMetadataMappingResponse, error) {
+	if len(msg.Mapping.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if msg.Mapping.IsEmpty() {
+		err = k.RemoveMetadataMapping(goCtx, msg.Mapping.ClassId, sender)
+	} else {
+		err = k.SetMetadataMapping(goCtx, msg.Mapping, sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgSetClassMetadataMappingResponse{}, nil
+}
+
+// SetClassTransferFee implements SetClassTransferFee method of the types.MsgServer.
+func (k Keeper) SetClassTransferFee(goCtx context.Context, msg *nft.MsgSetClassTransferFee) (*nft.MsgSetClassTransferFeeResponse, error) {
+	if len(msg.Fee.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if msg.Fee.IsEmpty() {
+		err = k.RemoveTransferFee(goCtx, msg.Fee.ClassId, sender)
+	} else {
+		err = k.SetTransferFee(goCtx, msg.Fee, sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgSetClassTransferFeeResponse{}, nil
+}
@@ -40,6 +40,11 @@ func (s *TestSuite) TestSend() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&ExpNFT},
 		}},
+		ClassStats: []*nft.ClassStats{{
+			ClassId:     testClassID,
+			TotalMinted: 1,
+			Holders:     1,
+		}},
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
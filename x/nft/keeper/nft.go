@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Mint defines a method for minting a new nft
@@ -21,23 +22,44 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 		return errors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
-	k.mintWithNoCheck(ctx, token, receiver)
-	return nil
+	if err := k.checkNotFrozen(ctx, token.ClassId, receiver); err != nil {
+		return err
+	}
+
+	if err := k.validateNFTData(ctx, token); err != nil {
+		return err
+	}
+
+	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
 // mintWithNoCheck defines a method for minting a new nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
-func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) {
+func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
 	k.setNFT(ctx, token)
+	k.setNFTIDIndex(ctx, token.ClassId, token.Id)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
+	k.incrTotalMinted(ctx, token.ClassId)
+	k.setURIUpdateHeight(ctx, token.ClassId, token.Id)
 
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventMint{
+	receiverStr, err := k.ac.BytesToString(receiver)
+	if err != nil {
+		return err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventMint{
 		ClassId: token.ClassId,
 		Id:      token.Id,
-		Owner:   receiver.String(),
-	})
+		Owner:   receiverStr,
+	}); err != nil {
+		return err
+	}
+
+	if hooks := k.Hooks(); hooks != nil {
+		return hooks.AfterNFTMint(ctx, token.ClassId, token.Id, receiver)
+	}
+	return nil
 }
 
 // Burn defines a method for burning a nft from a specific account.
@@ -51,8 +73,55 @@ func (k Keeper) Burn(ctx context.Context, classID, nftID string) error {
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.burnWithNoCheck(ctx, classID, nftID)
-	return nil
+	if err := k.checkNotFrozen(ctx, classID, k.GetOwner(ctx, classID, nftID)); err != nil {
+		return err
+	}
+
+	return k.burnWithNoCheck(ctx, classID, nftID)
+}
+
+// RevokeNFT burns a nft on behalf of the issuer of its class, which must allow the revocation of its nfts.
+// Note: When the upper module uses this method, it needs to authenticate the issuer
+func (k Keeper) RevokeNFT(ctx context.Context, classID, nftID string, issuer sdk.AccAddress) error {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if len(class.Issuer) == 0 {
+		return errors.Wrap(nft.ErrNotRevocable, classID)
+	}
+
+	issuerStr, err := k.ac.BytesToString(issuer)
+	if err != nil {
+		return err
+	}
+	if class.Issuer != issuerStr {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", issuerStr, classID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner == nil {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkNotFrozen(ctx, classID, issuer); err != nil {
+		return err
+	}
+
+	if err := k.burnWithNoCheck(ctx, classID, nftID); err != nil {
+		return err
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventRevoke{
+		ClassId: classID,
+		Id:      nftID,
+		Owner:   ownerStr,
+		Issuer:  issuerStr,
+	})
 }
 
 // burnWithNoCheck defines a method for burning a nft from a specific account.
@@ -60,16 +129,30 @@ func (k Keeper) Burn(ctx context.Context, classID, nftID string) error {
 // The upper-layer application needs to check it when it needs to use it
 func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return err
+	}
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
+	k.deleteNFTIDIndex(ctx, classID, nftID)
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventBurn{
+	k.incrTotalBurned(ctx, classID)
+	k.deleteURIUpdateHeight(ctx, classID, nftID)
+	k.deleteUsageGrant(ctx, classID, nftID)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventBurn{
 		ClassId: classID,
 		Id:      nftID,
-		Owner:   owner.String(),
-	})
+		Owner:   ownerStr,
+	}); err != nil {
+		return err
+	}
+
+	if hooks := k.Hooks(); hooks != nil {
+		return hooks.AfterNFTBurn(ctx, classID, nftID, owner)
+	}
 	return nil
 }
 
@@ -83,24 +166,75 @@ func (k Keeper) Update(ctx context.Context, token nft.NFT) error {
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
-	k.updateWithNoCheck(ctx, token)
-	return nil
+
+	if err := k.validateNFTData(ctx, token); err != nil {
+		return err
+	}
+	return k.updateWithNoCheck(ctx, token)
 }
 
 // Update defines a method for updating an exist nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
-func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) {
+func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) error {
+	prev, _ := k.GetNFT(ctx, token.ClassId, token.Id)
 	k.setNFT(ctx, token)
+	if err := k.trackURIChange(ctx, token.ClassId, token.Id, prev.Uri, prev.UriHash, token.Uri, token.UriHash); err != nil {
+		return err
+	}
+
+	if prev.Data.Equal(token.Data) {
+		return nil
+	}
+	var typeURL string
+	if token.Data != nil {
+		typeURL = token.Data.TypeUrl
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventAttributeSet{
+		ClassId: token.ClassId,
+		Id:      token.Id,
+		Key:     nft.AttributeKeyData,
+		Value:   typeURL,
+	})
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The transfer fee of the class is not charged, see TransferWithFee.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx context.Context,
 	classID string,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
+	if err := k.checkTransfer(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	return k.transferWithNoCheck(ctx, classID, nftID, receiver)
+}
+
+// TransferWithFee defines a method for sending a nft from one account to another account, charging the flat transfer
+// fee of its class, if any, to the owner. The nft is not sold, so the rate of the fee does not apply. It is used by
+// MsgSend and MsgTransferWithTimelock.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) TransferWithFee(ctx context.Context,
+	classID string,
+	nftID string,
+	receiver sdk.AccAddress,
+) error {
+	if err := k.checkTransfer(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	if _, err := k.PayTransferFee(ctx, classID, nftID, k.GetOwner(ctx, classID, nftID), nil); err != nil {
+		return err
+	}
+
+	return k.transferWithNoCheck(ctx, classID, nftID, receiver)
+}
+
+// checkTransfer checks that a nft exists and can be transferred by its owner
+func (k Keeper) checkTransfer(ctx context.Context, classID, nftID string) error {
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
@@ -109,8 +243,11 @@ func (k Keeper) Transfer(ctx context.Context,
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.transferWithNoCheck(ctx, classID, nftID, receiver)
-	return nil
+	if !k.IsTransferable(ctx, classID) {
+		return errors.Wrap(nft.ErrNonTransferable, classID)
+	}
+
+	return k.checkNotFrozen(ctx, classID, k.GetOwner(ctx, classID, nftID))
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -122,8 +259,39 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return err
+	}
+	receiverStr, err := k.ac.BytesToString(receiver)
+	if err != nil {
+		return err
+	}
+	hooks := k.Hooks()
+	if hooks != nil {
+		if err := hooks.BeforeNFTTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
+	if !owner.Equals(receiver) {
+		// the usage granted by the previous owner does not bind the receiver
+		k.deleteUsageGrant(ctx, classID, nftID)
+	}
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventTransfer{
+		ClassId:  classID,
+		Id:       nftID,
+		OldOwner: ownerStr,
+		NewOwner: receiverStr,
+	}); err != nil {
+		return err
+	}
+
+	if hooks != nil {
+		return hooks.AfterNFTTransfer(ctx, classID, nftID, owner, receiver)
+	}
 	return nil
 }
 
@@ -134,9 +302,7 @@ func (k Keeper) GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, boo
 	if len(bz) == 0 {
 		return nft.NFT{}, false
 	}
-	var nft nft.NFT
-	k.cdc.MustUnmarshal(bz, &nft)
-	return nft, true
+	return k.mustUnmarshalNFT(bz), true
 }
 
 // GetNFTsOfClassByOwner returns all nft information of the specified classID under the specified owner
@@ -159,9 +325,7 @@ func (k Keeper) GetNFTsOfClass(ctx context.Context, classID string) (nfts []nft.
 	iterator := nftStore.Iterator(nil, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var nft nft.NFT
-		k.cdc.MustUnmarshal(iterator.Value(), &nft)
-		nfts = append(nfts, nft)
+		nfts = append(nfts, k.mustUnmarshalNFT(iterator.Value()))
 	}
 	return nfts
 }
@@ -200,8 +364,19 @@ func (k Keeper) HasNFT(ctx context.Context, classID, id string) bool {
 
 func (k Keeper) setNFT(ctx context.Context, token nft.NFT) {
 	nftStore := k.getNFTStore(ctx, token.ClassId)
-	bz := k.cdc.MustMarshal(&token)
-	nftStore.Set([]byte(token.Id), bz)
+	nftStore.Set([]byte(token.Id), k.marshalNFT(token))
+}
+
+// setNFTIDIndex adds a nft to the index of the nfts by id
+func (k Keeper) setNFTIDIndex(ctx context.Context, classID, nftID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(nftIDIndexStoreKey(classID, nftID), Placeholder)
+}
+
+// deleteNFTIDIndex removes a nft from the index of the nfts by id
+func (k Keeper) deleteNFTIDIndex(ctx context.Context, classID, nftID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Delete(nftIDIndexStoreKey(classID, nftID))
 }
 
 func (k Keeper) setOwner(ctx context.Context, classID, nftID string, owner sdk.AccAddress) {
@@ -210,6 +385,8 @@ func (k Keeper) setOwner(ctx context.Context, classID, nftID string, owner sdk.A
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Set([]byte(nftID), Placeholder)
+
+	k.incrClassOwnerBalance(ctx, classID, owner)
 }
 
 func (k Keeper) deleteOwner(ctx context.Context, classID, nftID string, owner sdk.AccAddress) {
@@ -218,6 +395,8 @@ func (k Keeper) deleteOwner(ctx context.Context, classID, nftID string, owner sd
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Delete([]byte(nftID))
+
+	k.decrClassOwnerBalance(ctx, classID, owner)
 }
 
 func (k Keeper) getNFTStore(ctx context.Context, classID string) prefix.Store {
@@ -24,8 +24,18 @@ func (k Keeper) BatchMint(ctx context.Context,
 			return errors.Wrap(nft.ErrNFTExists, token.Id)
 		}
 
+		if err := k.checkNotFrozen(ctx, token.ClassId, receiver); err != nil {
+			return err
+		}
+
+		if err := k.validateNFTData(ctx, token); err != nil {
+			return err
+		}
+
 		checked[token.ClassId] = true
-		k.mintWithNoCheck(ctx, token, receiver)
+		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -40,6 +50,9 @@ func (k Keeper) BatchBurn(ctx context.Context, classID string, nftIDs []string)
 		if !k.HasNFT(ctx, classID, nftID) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
+		if err := k.checkNotFrozen(ctx, classID, k.GetOwner(ctx, classID, nftID)); err != nil {
+			return err
+		}
 		if err := k.burnWithNoCheck(ctx, classID, nftID); err != nil {
 			return err
 		}
@@ -59,13 +72,20 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 		if !k.HasNFT(ctx, token.ClassId, token.Id) {
 			return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 		}
+
+		if err := k.validateNFTData(ctx, token); err != nil {
+			return err
+		}
 		checked[token.ClassId] = true
-		k.updateWithNoCheck(ctx, token)
+		if err := k.updateWithNoCheck(ctx, token); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // BatchTransfer defines a method for sending a batch of nfts from one account to another account from a specific classID.
+// Like Transfer, it does not charge the transfer fee of the class.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) BatchTransfer(ctx context.Context,
 	classID string,
@@ -75,12 +95,18 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
+	if !k.IsTransferable(ctx, classID) {
+		return errors.Wrap(nft.ErrNonTransferable, classID)
+	}
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
+		if err := k.checkNotFrozen(ctx, classID, k.GetOwner(ctx, classID, nftID)); err != nil {
+			return err
+		}
 		if err := k.transferWithNoCheck(ctx, classID, nftID, receiver); err != nil {
-			return errors.Wrap(nft.ErrNFTNotExists, nftID)
+			return err
 		}
 	}
 	return nil
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SetRoyalty sets the default royalty of the nfts of a class, replacing the previous one if any.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) SetRoyalty(ctx context.Context, royalty nft.ClassRoyalty, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, royalty.ClassId, admin)
+	if err != nil {
+		return err
+	}
+
+	if err := royalty.Validate(k.ac); err != nil {
+		return err
+	}
+	if err := k.setClassRoyalty(ctx, royalty); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassRoyaltySet{
+		ClassId: royalty.ClassId,
+		Admin:   class.Admin,
+		Rate:    royalty.Rate.String(),
+	})
+}
+
+// RemoveRoyalty removes the default royalty of the nfts of a class.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) RemoveRoyalty(ctx context.Context, classID string, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, classID, admin)
+	if err != nil {
+		return err
+	}
+
+	if _, has := k.GetClassRoyalty(ctx, classID); !has {
+		return errors.Wrapf(nft.ErrInvalidRoyalty, "class %s has no royalty", classID)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(classRoyaltyStoreKey(classID)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassRoyaltySet{
+		ClassId: classID,
+		Admin:   class.Admin,
+	})
+}
+
+// GetClassRoyalty returns the default royalty of the nfts of the specified class
+func (k Keeper) GetClassRoyalty(ctx context.Context, classID string) (nft.ClassRoyalty, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var royalty nft.ClassRoyalty
+
+	bz, err := store.Get(classRoyaltyStoreKey(classID))
+	if err != nil || len(bz) == 0 {
+		return royalty, false
+	}
+	k.cdc.MustUnmarshal(bz, &royalty)
+	return royalty, true
+}
+
+// GetClassRoyalties returns the default royalties of all classes
+func (k Keeper) GetClassRoyalties(ctx context.Context) (royalties []*nft.ClassRoyalty) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassRoyaltyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var royalty nft.ClassRoyalty
+		k.cdc.MustUnmarshal(iterator.Value(), &royalty)
+		royalties = append(royalties, &royalty)
+	}
+	return
+}
+
+// ComputeRoyalty returns the royalty of the given class due on a sale at the given price, and the outputs paying every
+// recipient its share of it. The royalty is empty, and there are no outputs, if the class has no royalty or if the
+// royalty rounds down to zero.
+func (k Keeper) ComputeRoyalty(ctx context.Context, classID string, price sdk.Coins) (sdk.Coins, []banktypes.Output) {
+	royalty, has := k.GetClassRoyalty(ctx, classID)
+	if !has {
+		return sdk.NewCoins(), nil
+	}
+
+	amount := royalty.Royalty(price)
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	var outputs []banktypes.Output
+	for i, share := range royalty.Split(amount) {
+		if share.IsZero() {
+			continue
+		}
+		outputs = append(outputs, banktypes.Output{Address: royalty.Recipients[i].Address, Coins: share})
+	}
+	return amount, outputs
+}
+
+// PayRoyalty pays the royalty of the given class due on a sale at the given price from the payer to the recipients of
+// the royalty, in a single bank transfer so that either every recipient is paid its share or none is. It returns the
+// royalty paid, which is empty if the class has no royalty.
+// Note: When the upper module uses this method, it needs to authenticate the payer
+func (k Keeper) PayRoyalty(ctx context.Context, classID string, payer sdk.AccAddress, price sdk.Coins) (sdk.Coins, error) {
+	if !price.IsValid() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", price)
+	}
+
+	amount, outputs := k.ComputeRoyalty(ctx, classID, price)
+	if len(outputs) == 0 {
+		return amount, nil
+	}
+
+	payerStr, err := k.ac.BytesToString(payer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bk.InputOutputCoins(ctx, banktypes.Input{Address: payerStr, Coins: amount}, outputs); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventRoyaltyPaid{
+		ClassId: classID,
+		Payer:   payerStr,
+		Amount:  amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
+
+// setClassRoyalty stores the default royalty of a class
+func (k Keeper) setClassRoyalty(ctx context.Context, royalty nft.ClassRoyalty) error {
+	bz, err := k.cdc.Marshal(&royalty)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassRoyalty failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classRoyaltyStoreKey(royalty.ClassId), bz)
+}
+
+// getClassOfSettingsAdmin returns the class whose settings, e.g. its royalty, are set by the given admin, or an error
+// if the class has no admin or if it is not the given one
+func (k Keeper) getClassOfSettingsAdmin(ctx context.Context, classID string, admin sdk.AccAddress) (nft.Class, error) {
+	if class, has := k.GetClass(ctx, classID); has && len(class.Admin) == 0 {
+		return nft.Class{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "class %s has no admin", classID)
+	}
+	return k.getClassOfAdmin(ctx, classID, admin)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *TestSuite) TestSetClassRoyalty() {
+	admin := s.addrs[2]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "adminless"}))
+
+	rate := math.LegacyMustNewDecFromStr("0.05")
+	recipients := []nft.RoyaltyRecipient{
+		{Address: s.addrs[0].String(), Weight: math.LegacyMustNewDecFromStr("0.7")},
+		{Address: s.addrs[1].String(), Weight: math.LegacyMustNewDecFromStr("0.3")},
+	}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgSetClassRoyalty
+		expErr error
+	}{
+		{"empty class id", &nft.MsgSetClassRoyalty{Sender: admin.String(), Rate: rate, Recipients: recipients}, nft.ErrEmptyClassID},
+		{"invalid sender", &nft.MsgSetClassRoyalty{Sender: "invalid", ClassId: testClassID, Rate: rate, Recipients: recipients}, sdkerrors.ErrInvalidAddress},
+		{"class does not exist", &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: "unknown", Rate: rate, Recipients: recipients}, nft.ErrClassNotExists},
+		{"class has no admin", &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: "adminless", Rate: rate, Recipients: recipients}, sdkerrors.ErrUnauthorized},
+		{"sender is not the admin", &nft.MsgSetClassRoyalty{Sender: s.addrs[0].String(), ClassId: testClassID, Rate: rate, Recipients: recipients}, sdkerrors.ErrUnauthorized},
+		{"invalid royalty", &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: testClassID, Rate: math.LegacyNewDec(2), Recipients: recipients}, nft.ErrInvalidRoyalty},
+		{"no royalty to remove", &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: testClassID, Rate: math.LegacyZeroDec()}, nft.ErrInvalidRoyalty},
+		{"valid", &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: testClassID, Rate: rate, Recipients: recipients}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.SetClassRoyalty(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	expRoyalty := nft.ClassRoyalty{ClassId: testClassID, Rate: rate, Recipients: recipients}
+	royalty, has := s.nftKeeper.GetClassRoyalty(ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expRoyalty, royalty)
+	res, err := s.queryClient.ClassRoyalty(ctx, &nft.QueryClassRoyaltyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&expRoyalty, res.Royalty)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventClassRoyaltySet{ClassId: testClassID, Admin: admin.String(), Rate: rate.String()}, event)
+
+	// the royalty is preserved across genesis export and import
+	genesis := s.nftKeeper.ExportGenesis(ctx)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	royalty, has = s.nftKeeper.GetClassRoyalty(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expRoyalty, royalty)
+
+	// an empty list of recipients removes the royalty
+	_, err = s.nftKeeper.SetClassRoyalty(s.ctx, &nft.MsgSetClassRoyalty{Sender: admin.String(), ClassId: testClassID, Rate: math.LegacyZeroDec()})
+	s.Require().NoError(err)
+	_, has = s.nftKeeper.GetClassRoyalty(s.ctx, testClassID)
+	s.Require().False(has)
+	res, err = s.queryClient.ClassRoyalty(s.ctx, &nft.QueryClassRoyaltyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Royalty)
+	_, err = s.queryClient.ClassRoyalty(s.ctx, &nft.QueryClassRoyaltyRequest{ClassId: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestPayRoyalty() {
+	admin, payer := s.addrs[2], s.addrs[1]
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	// there is nothing to pay without a royalty
+	paid, err := s.nftKeeper.PayRoyalty(s.ctx, testClassID, payer, price)
+	s.Require().NoError(err)
+	s.Require().True(paid.IsZero())
+
+	s.Require().NoError(s.nftKeeper.SetRoyalty(s.ctx, nft.ClassRoyalty{
+		ClassId: testClassID,
+		Rate:    math.LegacyMustNewDecFromStr("0.05"),
+		Recipients: []nft.RoyaltyRecipient{
+			{Address: alice.String(), Weight: math.LegacyMustNewDecFromStr("0.7")},
+			{Address: bob.String(), Weight: math.LegacyMustNewDecFromStr("0.3")},
+		},
+	}, admin))
+
+	_, err = s.nftKeeper.PayRoyalty(s.ctx, testClassID, payer, sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// a royalty rounding down to zero is not paid
+	paid, err = s.nftKeeper.PayRoyalty(s.ctx, testClassID, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 19)))
+	s.Require().NoError(err)
+	s.Require().True(paid.IsZero())
+
+	// the royalty is paid to all the recipients at once
+	expPaid := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), banktypes.Input{Address: payer.String(), Coins: expPaid}, []banktypes.Output{
+		{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 35))},
+		{Address: bob.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 15))},
+	}).Return(nil)
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	paid, err = s.nftKeeper.PayRoyalty(ctx, testClassID, payer, price)
+	s.Require().NoError(err)
+	s.Require().Equal(expPaid, paid)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventRoyaltyPaid{ClassId: testClassID, Payer: payer.String(), Amount: expPaid.String()}, event)
+
+	// a failed payment is reported
+	s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("insufficient funds"))
+	_, err = s.nftKeeper.PayRoyalty(s.ctx, testClassID, payer, price)
+	s.Require().Error(err)
+}
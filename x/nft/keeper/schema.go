@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetClassDataSchema registers the protobuf type that the Data of every NFT of the given class must decode to.
+// The type URL must be resolvable by the app's interface registry. A schema can only be registered while the
+// class has no NFTs, so that every existing token of the class is guaranteed to conform to it.
+// Note: When the upper module uses this method, it needs to authenticate the class
+func (k Keeper) SetClassDataSchema(ctx context.Context, classID, typeURL string) error {
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if len(typeURL) == 0 {
+		return errors.Wrap(nft.ErrInvalidSchema, "empty type url")
+	}
+
+	if _, err := k.cdc.InterfaceRegistry().Resolve(typeURL); err != nil {
+		return errors.Wrapf(nft.ErrInvalidSchema, "unresolvable type url %s: %s", typeURL, err)
+	}
+
+	if supply := k.GetTotalSupply(ctx, classID); supply > 0 {
+		return errors.Wrapf(nft.ErrInvalidSchema, "class %s already has %d nfts", classID, supply)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(classDataSchemaStoreKey(classID), []byte(typeURL)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventAttributeSet{
+		ClassId: classID,
+		Key:     nft.AttributeKeyDataSchema,
+		Value:   typeURL,
+	})
+}
+
+// GetClassDataSchema returns the type URL registered as the data schema of the specified class
+func (k Keeper) GetClassDataSchema(ctx context.Context, classID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(classDataSchemaStoreKey(classID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetClassDataSchemas returns the data schemas of all classes
+func (k Keeper) GetClassDataSchemas(ctx context.Context) (schemas []*nft.ClassDataSchema) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassDataSchemaKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		schemas = append(schemas, &nft.ClassDataSchema{
+			ClassId: string(iterator.Key()[len(ClassDataSchemaKey):]),
+			TypeUrl: string(iterator.Value()),
+		})
+	}
+	return
+}
+
+// SetClassJSONSchema registers the JSON schema that the JSON form of the Data of the given class and of its NFTs must
+// conform to. A schema with no inline schema only commits to the hash of the schema and is not enforced, it can be set
+// at any time. An inline schema is enforced on the class data, which must already conform to it, and on the NFT data,
+// so it can only be registered while the class has no NFTs.
+// Note: When the upper module uses this method, it needs to authenticate the class
+func (k Keeper) SetClassJSONSchema(ctx context.Context, schema nft.ClassJSONSchema) error {
+	if err := schema.Validate(); err != nil {
+		return err
+	}
+
+	class, has := k.GetClass(ctx, schema.ClassId)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, schema.ClassId)
+	}
+
+	if len(schema.Schema) > 0 {
+		if supply := k.GetTotalSupply(ctx, schema.ClassId); supply > 0 {
+			return errors.Wrapf(nft.ErrInvalidSchema, "class %s already has %d nfts", schema.ClassId, supply)
+		}
+		if err := k.validateJSONData(ctx, schema, class.Data, nft.ErrInvalidClassData); err != nil {
+			return err
+		}
+	}
+
+	bz, err := k.cdc.Marshal(&schema)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassJSONSchema failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(classJSONSchemaStoreKey(schema.ClassId), bz); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventAttributeSet{
+		ClassId: schema.ClassId,
+		Key:     nft.AttributeKeyJSONSchema,
+		Value:   hex.EncodeToString(schema.Hash),
+	})
+}
+
+// GetClassJSONSchema returns the json schema registered for the specified class
+func (k Keeper) GetClassJSONSchema(ctx context.Context, classID string) (nft.ClassJSONSchema, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(classJSONSchemaStoreKey(classID))
+	if err != nil {
+		panic(err)
+	}
+
+	var schema nft.ClassJSONSchema
+	if len(bz) == 0 {
+		return schema, false
+	}
+	k.cdc.MustUnmarshal(bz, &schema)
+	return schema, true
+}
+
+// GetClassJSONSchemas returns the json schemas of all classes
+func (k Keeper) GetClassJSONSchemas(ctx context.Context) (schemas []*nft.ClassJSONSchema) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassJSONSchemaKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var schema nft.ClassJSONSchema
+		k.cdc.MustUnmarshal(iterator.Value(), &schema)
+		schemas = append(schemas, &schema)
+	}
+	return
+}
+
+// validateClassData checks that the data of the given class conforms to the json schema of the class, if any
+func (k Keeper) validateClassData(ctx context.Context, class nft.Class) error {
+	schema, ok := k.GetClassJSONSchema(ctx, class.Id)
+	if !ok {
+		return nil
+	}
+	return k.validateJSONData(ctx, schema, class.Data, nft.ErrInvalidClassData)
+}
+
+// validateNFTData checks that the data of the given nft decodes to the data schema of its class, and conforms to the
+// json schema of its class, if any
+func (k Keeper) validateNFTData(ctx context.Context, token nft.NFT) error {
+	if typeURL, ok := k.GetClassDataSchema(ctx, token.ClassId); ok {
+		if token.Data == nil {
+			return errors.Wrapf(nft.ErrInvalidNFTData, "class %s requires data of type %s", token.ClassId, typeURL)
+		}
+
+		if token.Data.TypeUrl != typeURL {
+			return errors.Wrapf(nft.ErrInvalidNFTData, "expected %s, got %s", typeURL, token.Data.TypeUrl)
+		}
+
+		msg, err := k.cdc.InterfaceRegistry().Resolve(typeURL)
+		if err != nil {
+			return errors.Wrap(nft.ErrInvalidNFTData, err.Error())
+		}
+
+		if err := k.cdc.Unmarshal(token.Data.Value, msg); err != nil {
+			return errors.Wrap(nft.ErrInvalidNFTData, err.Error())
+		}
+	}
+
+	schema, ok := k.GetClassJSONSchema(ctx, token.ClassId)
+	if !ok {
+		return nil
+	}
+	return k.validateJSONData(ctx, schema, token.Data, nft.ErrInvalidNFTData)
+}
+
+// validateJSONData checks that the JSON form of the given data conforms to the inline json schema, if any. Missing
+// data is validated as a JSON null. The violations are reported with their JSON pointer, wrapped in the given error.
+func (k Keeper) validateJSONData(ctx context.Context, schema nft.ClassJSONSchema, data *codectypes.Any, errType *errors.Error) error {
+	if len(schema.Schema) == 0 {
+		return nil
+	}
+
+	parsed, err := nft.ParseJSONSchema(schema.Schema)
+	if err != nil {
+		return errors.Wrap(nft.ErrInvalidSchema, err.Error())
+	}
+
+	doc, err := k.dataJSON(data)
+	if err != nil {
+		return errors.Wrap(errType, err.Error())
+	}
+
+	violations, err := parsed.ValidateJSON(doc)
+	if err != nil {
+		return errors.Wrap(errType, err.Error())
+	}
+	if len(violations) > 0 {
+		return errors.Wrapf(errType, "json schema of class %s: %s", schema.ClassId, violations)
+	}
+	return nil
+}
+
+// dataJSON returns the JSON form of the given data, a JSON null if there is no data
+func (k Keeper) dataJSON(data *codectypes.Any) ([]byte, error) {
+	if data == nil {
+		return []byte("null"), nil
+	}
+
+	msg, err := k.cdc.InterfaceRegistry().Resolve(data.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.cdc.Unmarshal(data.Value, msg); err != nil {
+		return nil, err
+	}
+	return k.cdc.MarshalJSON(msg)
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"crypto/sha256"
+
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+const testDataTypeURL = "/cosmos.nft.v1beta1.MsgSend"
+
+func (s *TestSuite) TestSetClassDataSchema() {
+	err := s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, testDataTypeURL)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.TestSaveClass()
+
+	err = s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, "")
+	s.Require().ErrorIs(err, nft.ErrInvalidSchema)
+
+	err = s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, "/cosmos.nft.v1beta1.Unknown")
+	s.Require().ErrorIs(err, nft.ErrInvalidSchema)
+
+	_, has := s.nftKeeper.GetClassDataSchema(s.ctx, testClassID)
+	s.Require().False(has)
+
+	err = s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, testDataTypeURL)
+	s.Require().NoError(err)
+
+	typeURL, has := s.nftKeeper.GetClassDataSchema(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(testDataTypeURL, typeURL)
+	s.Require().Equal([]*nft.ClassDataSchema{{ClassId: testClassID, TypeUrl: testDataTypeURL}}, s.nftKeeper.GetClassDataSchemas(s.ctx))
+
+	res, err := s.queryClient.ClassDataSchema(gocontext.Background(), &nft.QueryClassDataSchemaRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(testDataTypeURL, res.TypeUrl)
+
+	data, err := codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: data}, s.addrs[0])
+	s.Require().NoError(err)
+
+	// a schema can no longer be registered once the class has nfts
+	err = s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, "/cosmos.nft.v1beta1.MsgSendResponse")
+	s.Require().ErrorIs(err, nft.ErrInvalidSchema)
+}
+
+func (s *TestSuite) TestValidateNFTData() {
+	s.TestSaveClass()
+	s.Require().NoError(s.nftKeeper.SetClassDataSchema(s.ctx, testClassID, testDataTypeURL))
+
+	valid, err := codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	wrongType, err := codectypes.NewAnyWithValue(&nft.MsgSendResponse{})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		msg    string
+		data   *codectypes.Any
+		expErr bool
+	}{
+		{"missing data", nil, true},
+		{"wrong type url", wrongType, true},
+		{"garbage payload", &codectypes.Any{TypeUrl: testDataTypeURL, Value: []byte{0xff, 0xff, 0xff}}, true},
+		{"valid data", valid, false},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			token := nft.NFT{ClassId: testClassID, Id: testID, Data: tc.data}
+			err := s.nftKeeper.Mint(s.ctx, token, s.addrs[0])
+			if tc.expErr {
+				s.Require().ErrorIs(err, nft.ErrInvalidNFTData)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	err = s.nftKeeper.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: wrongType})
+	s.Require().ErrorIs(err, nft.ErrInvalidNFTData)
+
+	err = s.nftKeeper.BatchMint(s.ctx, []nft.NFT{{ClassId: testClassID, Id: "kitty2"}}, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrInvalidNFTData)
+}
+
+// testJSONSchema requires the data to be a MsgSend of the kitty class with a non-empty id
+const testJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "class_id": {"const": "kitty"},
+    "id": {"type": "string", "minLength": 1},
+    "sender": {"type": "string"},
+    "receiver": {"type": "string"}
+  },
+  "required": ["class_id", "id"],
+  "additionalProperties": false
+}`
+
+func (s *TestSuite) TestSetClassJSONSchema() {
+	hash := sha256.Sum256([]byte(testJSONSchema))
+	schema := nft.ClassJSONSchema{ClassId: testClassID, Hash: hash[:], Schema: testJSONSchema}
+
+	err := s.nftKeeper.SetClassJSONSchema(s.ctx, schema)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.TestSaveClass()
+
+	err = s.nftKeeper.SetClassJSONSchema(s.ctx, nft.ClassJSONSchema{ClassId: testClassID, Hash: hash[:], Schema: `{"type": "object"}`})
+	s.Require().ErrorIs(err, nft.ErrInvalidSchema, "the schema does not match the hash")
+
+	unsupported := `{"type": "object", "oneOf": []}`
+	unsupportedHash := sha256.Sum256([]byte(unsupported))
+	err = s.nftKeeper.SetClassJSONSchema(s.ctx, nft.ClassJSONSchema{ClassId: testClassID, Hash: unsupportedHash[:], Schema: unsupported})
+	s.Require().ErrorContains(err, "/oneOf: unsupported json schema keyword")
+
+	// the class data must conform to the schema
+	err = s.nftKeeper.SetClassJSONSchema(s.ctx, schema)
+	s.Require().ErrorIs(err, nft.ErrInvalidClassData)
+
+	class, _ := s.nftKeeper.GetClass(s.ctx, testClassID)
+	class.Data, err = codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: testClassID, Id: "class"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.UpdateClass(s.ctx, class))
+
+	_, has := s.nftKeeper.GetClassJSONSchema(s.ctx, testClassID)
+	s.Require().False(has)
+
+	s.Require().NoError(s.nftKeeper.SetClassJSONSchema(s.ctx, schema))
+
+	stored, has := s.nftKeeper.GetClassJSONSchema(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(schema, stored)
+	s.Require().Equal([]*nft.ClassJSONSchema{&schema}, s.nftKeeper.GetClassJSONSchemas(s.ctx))
+
+	res, err := s.queryClient.ClassDataSchema(gocontext.Background(), &nft.QueryClassDataSchemaRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&schema, res.JsonSchema)
+
+	// the class data keeps being validated on update
+	class.Data, err = codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: "other", Id: "class"})
+	s.Require().NoError(err)
+	err = s.nftKeeper.UpdateClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrInvalidClassData)
+	s.Require().ErrorContains(err, "/class_id: value is not one of the allowed values")
+
+	invalid, err := codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: "other"})
+	s.Require().NoError(err)
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: invalid}, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrInvalidNFTData)
+	s.Require().ErrorContains(err, "/class_id: value is not one of the allowed values; /id: string is shorter than 1 characters")
+
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().ErrorContains(err, "/: expected object, got null")
+
+	valid, err := codectypes.NewAnyWithValue(&nft.MsgSend{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: valid}, s.addrs[0]))
+
+	err = s.nftKeeper.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: invalid})
+	s.Require().ErrorIs(err, nft.ErrInvalidNFTData)
+
+	// an inline schema can no longer be registered once the class has nfts, but a hash can
+	err = s.nftKeeper.SetClassJSONSchema(s.ctx, schema)
+	s.Require().ErrorIs(err, nft.ErrInvalidSchema)
+	s.Require().NoError(s.nftKeeper.SetClassJSONSchema(s.ctx, nft.ClassJSONSchema{ClassId: testClassID, Hash: hash[:]}))
+	s.Require().NoError(s.nftKeeper.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Data: invalid}))
+}
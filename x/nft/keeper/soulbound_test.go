@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestNonTransferable() {
+	issuer := s.addrs[2]
+	s.Require().ErrorIs(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, NonTransferable: true, Issuer: "invalid"}), sdkerrors.ErrInvalidAddress)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, NonTransferable: true, Issuer: issuer.String()}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().False(s.nftKeeper.IsTransferable(s.ctx, testClassID))
+
+	// the nft cannot change owner, whether through the keeper or Msg/Send
+	s.Require().ErrorIs(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1]), nft.ErrNonTransferable)
+	s.Require().ErrorIs(s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{testID}, s.addrs[1]), nft.ErrNonTransferable)
+	_, err := s.nftKeeper.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Receiver: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the classes can be queried by issuer
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "other"}))
+	res, err := s.queryClient.Classes(gocontext.Background(), &nft.QueryClassesRequest{Issuer: issuer.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Classes, 1)
+	s.Require().Equal(testClassID, res.Classes[0].Id)
+}
+
+func (s *TestSuite) TestRevoke() {
+	issuer := s.addrs[2]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, NonTransferable: true, Issuer: issuer.String()}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "irrevocable"}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "irrevocable", Id: testID}, s.addrs[0]))
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgRevoke
+		expErr error
+	}{
+		{"empty class id", &nft.MsgRevoke{Sender: issuer.String(), Id: testID}, nft.ErrEmptyClassID},
+		{"empty nft id", &nft.MsgRevoke{Sender: issuer.String(), ClassId: testClassID}, nft.ErrEmptyNFTID},
+		{"class does not exist", &nft.MsgRevoke{Sender: issuer.String(), ClassId: "unknown", Id: testID}, nft.ErrClassNotExists},
+		{"class is not revocable", &nft.MsgRevoke{Sender: issuer.String(), ClassId: "irrevocable", Id: testID}, nft.ErrNotRevocable},
+		{"sender is not the issuer", &nft.MsgRevoke{Sender: s.addrs[0].String(), ClassId: testClassID, Id: testID}, sdkerrors.ErrUnauthorized},
+		{"nft does not exist", &nft.MsgRevoke{Sender: issuer.String(), ClassId: testClassID, Id: "unknown"}, nft.ErrNFTNotExists},
+		{"valid", &nft.MsgRevoke{Sender: issuer.String(), ClassId: testClassID, Id: testID}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.Revoke(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	s.Require().False(s.nftKeeper.HasNFT(ctx, testClassID, testID))
+	s.Require().EqualValues(1, s.nftKeeper.GetClassStats(ctx, testClassID).TotalBurned)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 2)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventRevoke{
+		ClassId: testClassID,
+		Id:      testID,
+		Owner:   s.addrs[0].String(),
+		Issuer:  issuer.String(),
+	}, event)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetClassStats returns the supply and holder statistics of the specified class
+func (k Keeper) GetClassStats(ctx context.Context, classID string) nft.ClassStats {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(classStatsStoreKey(classID))
+	if err != nil {
+		panic(err)
+	}
+
+	stats := nft.ClassStats{ClassId: classID}
+	if len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+// GetAllClassStats returns the supply and holder statistics of all classes
+func (k Keeper) GetAllClassStats(ctx context.Context) (stats []*nft.ClassStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassStatsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var classStats nft.ClassStats
+		k.cdc.MustUnmarshal(iterator.Value(), &classStats)
+		stats = append(stats, &classStats)
+	}
+	return stats
+}
+
+// getClassOwnerBalance returns the number of nfts of the specified class held by owner
+func (k Keeper) getClassOwnerBalance(ctx context.Context, classID string, owner sdk.AccAddress) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(classOwnerBalanceStoreKey(classID, owner))
+	if err != nil {
+		panic(err)
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setClassStats(ctx context.Context, stats nft.ClassStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(classStatsStoreKey(stats.ClassId), k.cdc.MustMarshal(&stats)); err != nil {
+		panic(err)
+	}
+}
+
+// incrClassOwnerBalance increments the number of nfts of the class held by owner,
+// counting owner as a new holder of the class if it held none before.
+func (k Keeper) incrClassOwnerBalance(ctx context.Context, classID string, owner sdk.AccAddress) {
+	balance := k.getClassOwnerBalance(ctx, classID, owner) + 1
+	k.updateClassOwnerBalance(ctx, classID, owner, balance)
+
+	if balance == 1 {
+		stats := k.GetClassStats(ctx, classID)
+		stats.Holders++
+		k.setClassStats(ctx, stats)
+	}
+}
+
+// decrClassOwnerBalance decrements the number of nfts of the class held by owner,
+// no longer counting owner as a holder of the class if it holds none anymore.
+func (k Keeper) decrClassOwnerBalance(ctx context.Context, classID string, owner sdk.AccAddress) {
+	balance := k.getClassOwnerBalance(ctx, classID, owner) - 1
+	k.updateClassOwnerBalance(ctx, classID, owner, balance)
+
+	if balance == 0 {
+		stats := k.GetClassStats(ctx, classID)
+		stats.Holders--
+		k.setClassStats(ctx, stats)
+	}
+}
+
+func (k Keeper) updateClassOwnerBalance(ctx context.Context, classID string, owner sdk.AccAddress, balance uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := classOwnerBalanceStoreKey(classID, owner)
+
+	var err error
+	if balance == 0 {
+		err = store.Delete(key)
+	} else {
+		err = store.Set(key, sdk.Uint64ToBigEndian(balance))
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) incrTotalMinted(ctx context.Context, classID string) {
+	stats := k.GetClassStats(ctx, classID)
+	stats.TotalMinted++
+	k.setClassStats(ctx, stats)
+}
+
+func (k Keeper) incrTotalBurned(ctx context.Context, classID string) {
+	stats := k.GetClassStats(ctx, classID)
+	stats.TotalBurned++
+	k.setClassStats(ctx, stats)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+)
+
+func (s *TestSuite) TestClassStats() {
+	tokens := []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+		{ClassId: testClassID, Id: "kitty3"},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0]))
+	s.requireClassStats(3, 0, 1)
+
+	// transferring part of the holdings adds a holder
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty1", s.addrs[1]))
+	s.requireClassStats(3, 0, 2)
+
+	// transferring to the current owner changes nothing
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty1", s.addrs[1]))
+	s.requireClassStats(3, 0, 2)
+
+	// transferring the last nft of an owner to a new one keeps the holder count
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty1", s.addrs[2]))
+	s.requireClassStats(3, 0, 2)
+
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, "kitty1"))
+	s.requireClassStats(3, 1, 1)
+
+	s.Require().NoError(s.nftKeeper.BatchBurn(s.ctx, testClassID, []string{"kitty2", "kitty3"}))
+	s.requireClassStats(3, 3, 0)
+
+	_, err := s.queryClient.ClassStats(gocontext.Background(), &nft.QueryClassStatsRequest{})
+	s.Require().ErrorIs(err, nft.ErrEmptyClassID)
+
+	_, err = s.queryClient.ClassStats(gocontext.Background(), &nft.QueryClassStatsRequest{ClassId: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestClassStatsGenesis() {
+	tokens := []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, "kitty1"))
+
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	s.requireClassStats(2, 1, 1)
+
+	genesis.ClassStats[0].TotalBurned = 0
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidClassStats)
+}
+
+func (s *TestSuite) TestMigrate1to2() {
+	tokens := []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[1]))
+
+	// drop the stats and balances to emulate a store of consensus version 1
+	store := s.ctx.KVStore(s.storeKey)
+	for _, prefix := range [][]byte{keeper.ClassStatsKey, keeper.ClassOwnerBalanceKey} {
+		iterator := store.Iterator(prefix, []byte{prefix[0] + 1})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	s.requireClassStats(0, 0, 0)
+
+	s.Require().NoError(keeper.NewMigrator(s.nftKeeper).Migrate1to2(s.ctx))
+	s.requireClassStats(2, 0, 2)
+
+	// the rebuilt balances are maintained from then on
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[0]))
+	s.requireClassStats(2, 0, 1)
+}
+
+func (s *TestSuite) requireClassStats(minted, burned, holders uint64) {
+	res, err := s.queryClient.ClassStats(gocontext.Background(), &nft.QueryClassStatsRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.ClassStats{
+		ClassId:     testClassID,
+		TotalMinted: minted,
+		TotalBurned: burned,
+		Holders:     holders,
+	}, res.Stats)
+}
@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// TransferWithTimelock implements TransferWithTimelock method of the types.MsgServer.
+func (k Keeper) TransferWithTimelock(goCtx context.Context, msg *nft.MsgTransferWithTimelock) (*nft.MsgTransferWithTimelockResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	}
+
+	transfer := nft.TimelockedTransfer{
+		ClassId:       msg.ClassId,
+		Id:            msg.Id,
+		Sender:        msg.Sender,
+		Receiver:      msg.Receiver,
+		ReleaseHeight: msg.ReleaseHeight,
+		ReleaseTime:   msg.ReleaseTime,
+	}
+	if err := transfer.Validate(k.ac); err != nil {
+		return nil, err
+	}
+	if transfer.IsReleasable(ctx.BlockHeight(), ctx.BlockTime()) {
+		return nil, errorsmod.Wrap(nft.ErrInvalidTimelock, "release height or release time is not in the future")
+	}
+
+	// the transfer fee is paid by the sender on escrowing, as the escrow cannot pay it on release
+	if err := k.TransferWithFee(ctx, msg.ClassId, msg.Id, k.escrow); err != nil {
+		return nil, err
+	}
+	k.setTimelockedTransfer(ctx, transfer)
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventTransferWithTimelock{
+		ClassId:       transfer.ClassId,
+		Id:            transfer.Id,
+		Sender:        transfer.Sender,
+		Receiver:      transfer.Receiver,
+		ReleaseHeight: transfer.ReleaseHeight,
+		ReleaseTime:   transfer.ReleaseTime,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgTransferWithTimelockResponse{}, nil
+}
+
+// ReclaimTimelockedTransfer implements ReclaimTimelockedTransfer method of the types.MsgServer.
+func (k Keeper) ReclaimTimelockedTransfer(goCtx context.Context, msg *nft.MsgReclaimTimelockedTransfer) (*nft.MsgReclaimTimelockedTransferResponse, error) {
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	transfer, has := k.GetTimelockedTransfer(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, errorsmod.Wrapf(nft.ErrTimelockNotFound, "class: %s, id: %s", msg.ClassId, msg.Id)
+	}
+
+	if transfer.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of nft %s", msg.Sender, msg.Id)
+	}
+
+	if err := k.checkNotPaused(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	// the nft may have been revoked by its issuer while escrowed
+	if k.HasNFT(ctx, transfer.ClassId, transfer.Id) {
+		if err := k.Transfer(ctx, transfer.ClassId, transfer.Id, sender); err != nil {
+			return nil, err
+		}
+	}
+	k.deleteTimelockedTransfer(ctx, transfer)
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventTimelockedTransferReclaimed{
+		ClassId: transfer.ClassId,
+		Id:      transfer.Id,
+		Sender:  transfer.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgReclaimTimelockedTransferResponse{}, nil
+}
+
+// TimelockedTransfer return the pending timelocked transfer of a nft
+func (k Keeper) TimelockedTransfer(goCtx context.Context, r *nft.QueryTimelockedTransferRequest) (*nft.QueryTimelockedTransferResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	transfer, has := k.GetTimelockedTransfer(goCtx, r.ClassId, r.Id)
+	if !has {
+		return nil, errorsmod.Wrapf(nft.ErrTimelockNotFound, "class: %s, id: %s", r.ClassId, r.Id)
+	}
+	return &nft.QueryTimelockedTransferResponse{Transfer: &transfer}, nil
+}
+
+// TimelockedTransfers return all the pending timelocked transfers, or the ones of a sender and/or a receiver
+func (k Keeper) TimelockedTransfers(goCtx context.Context, r *nft.QueryTimelockedTransfersRequest) (*nft.QueryTimelockedTransfersResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.Sender) > 0 {
+		if _, err := k.ac.StringToBytes(r.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.Receiver) > 0 {
+		if _, err := k.ac.StringToBytes(r.Receiver); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.storeService.OpenKVStore(ctx)
+	timelockStore := prefix.NewStore(runtime.KVStoreAdapter(store), TimelockKey)
+
+	var transfers []*nft.TimelockedTransfer
+	pageRes, err := query.FilteredPaginate(timelockStore, r.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var transfer nft.TimelockedTransfer
+		if err := k.cdc.Unmarshal(value, &transfer); err != nil {
+			return false, err
+		}
+		if len(r.Sender) > 0 && transfer.Sender != r.Sender {
+			return false, nil
+		}
+		if len(r.Receiver) > 0 && transfer.Receiver != r.Receiver {
+			return false, nil
+		}
+		if accumulate {
+			transfers = append(transfers, &transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryTimelockedTransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// maxReleaseBackoffExp bounds the backoff of the release of a timelocked transfer to 2^13 blocks, i.e. about 14 hours
+// with 6 second blocks
+const maxReleaseBackoffExp = 13
+
+// ReleaseTimelockedTransfers releases to their receivers the nfts of the timelocked transfers whose release height or
+// release time is reached at the current block. A transfer which cannot be released, e.g. because its class is frozen,
+// is requeued to be retried after a backoff, until the sender reclaims it.
+func (k Keeper) ReleaseTimelockedTransfers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.storeService.OpenKVStore(ctx)
+
+	type nftKey struct{ classID, nftID string }
+	var keys []nftKey
+
+	// the ends are exclusive, so they are set past all the entries released at the block height and time
+	iterator, err := store.Iterator(TimelockHeightQueueKey, storetypes.PrefixEndBytes(timelockHeightQueueByHeightKey(sdkCtx.BlockHeight())))
+	if err != nil {
+		return err
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseTimelockHeightQueueStoreKey(iterator.Key())
+		keys = append(keys, nftKey{classID, nftID})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	iterator, err = store.Iterator(TimelockTimeQueueKey, storetypes.PrefixEndBytes(timelockTimeQueueByTimeKey(sdkCtx.BlockTime())))
+	if err != nil {
+		return err
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseTimelockTimeQueueStoreKey(iterator.Key())
+		keys = append(keys, nftKey{classID, nftID})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.release(cacheCtx, key.classID, key.nftID); err != nil {
+			sdkCtx.Logger().Error("failed to release nft timelocked transfer", "class_id", key.classID, "id", key.nftID, "err", err)
+			if err := k.deferRelease(sdkCtx, key.classID, key.nftID, err); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// deferRelease requeues a timelocked transfer whose release failed, at a block height doubling the backoff of the
+// previous failure, from one block up to 2^maxReleaseBackoffExp blocks.
+func (k Keeper) deferRelease(ctx sdk.Context, classID, nftID string, releaseErr error) error {
+	transfer, has := k.GetTimelockedTransfer(ctx, classID, nftID)
+	if !has {
+		return nil
+	}
+
+	k.deleteTimelockedTransfer(ctx, transfer)
+	backoffExp := transfer.FailedReleases
+	if backoffExp > maxReleaseBackoffExp {
+		backoffExp = maxReleaseBackoffExp
+	}
+	transfer.FailedReleases++
+	transfer.RetryHeight = ctx.BlockHeight() + int64(1)<<backoffExp
+	k.setTimelockedTransfer(ctx, transfer)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventTimelockedTransferReleaseFailed{
+		ClassId:        transfer.ClassId,
+		Id:             transfer.Id,
+		Receiver:       transfer.Receiver,
+		FailedReleases: transfer.FailedReleases,
+		RetryHeight:    transfer.RetryHeight,
+		Error:          releaseErr.Error(),
+	})
+}
+
+// release transfers the escrowed nft of the timelocked transfer to its receiver
+func (k Keeper) release(ctx sdk.Context, classID, nftID string) error {
+	transfer, has := k.GetTimelockedTransfer(ctx, classID, nftID)
+	if !has {
+		return errorsmod.Wrapf(nft.ErrTimelockNotFound, "class: %s, id: %s", classID, nftID)
+	}
+
+	// the nft may have been revoked by its issuer while escrowed
+	if !k.HasNFT(ctx, classID, nftID) {
+		k.deleteTimelockedTransfer(ctx, transfer)
+		return nil
+	}
+
+	receiver, err := k.ac.StringToBytes(transfer.Receiver)
+	if err != nil {
+		return err
+	}
+	if err := k.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+	k.deleteTimelockedTransfer(ctx, transfer)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventTimelockedTransferReleased{
+		ClassId:  transfer.ClassId,
+		Id:       transfer.Id,
+		Receiver: transfer.Receiver,
+	})
+}
+
+// GetTimelockedTransfer returns the pending timelocked transfer of a nft
+func (k Keeper) GetTimelockedTransfer(ctx context.Context, classID, nftID string) (nft.TimelockedTransfer, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var transfer nft.TimelockedTransfer
+
+	bz, err := store.Get(timelockStoreKey(classID, nftID))
+	if err != nil || len(bz) == 0 {
+		return transfer, false
+	}
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetTimelockedTransfers returns all the pending timelocked transfers
+func (k Keeper) GetTimelockedTransfers(ctx context.Context) (transfers []nft.TimelockedTransfer) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), TimelockKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer nft.TimelockedTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return
+}
+
+func (k Keeper) setTimelockedTransfer(ctx context.Context, transfer nft.TimelockedTransfer) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(timelockStoreKey(transfer.ClassId, transfer.Id), k.cdc.MustMarshal(&transfer)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(timelockQueueStoreKey(transfer), Placeholder); err != nil {
+		panic(err)
+	}
+}
+
+// deleteTimelockedTransfer removes the timelocked transfer together with its release queue entry
+func (k Keeper) deleteTimelockedTransfer(ctx context.Context, transfer nft.TimelockedTransfer) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(timelockStoreKey(transfer.ClassId, transfer.Id)); err != nil {
+		panic(err)
+	}
+	if err := store.Delete(timelockQueueStoreKey(transfer)); err != nil {
+		panic(err)
+	}
+}
+
+// timelockQueueStoreKey returns the key of the entry of the timelocked transfer in the release height queue at its
+// retry height if its release failed, in the release time queue if it has a release time, and in the release height
+// queue otherwise
+func timelockQueueStoreKey(transfer nft.TimelockedTransfer) []byte {
+	if transfer.RetryHeight > 0 {
+		return timelockHeightQueueStoreKey(transfer.RetryHeight, transfer.ClassId, transfer.Id)
+	}
+	if transfer.ReleaseTime != nil {
+		return timelockTimeQueueStoreKey(*transfer.ReleaseTime, transfer.ClassId, transfer.Id)
+	}
+	return timelockHeightQueueStoreKey(transfer.ReleaseHeight, transfer.ClassId, transfer.Id)
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSuite) TestTransferWithTimelock() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+
+	ctx := s.ctx.WithBlockHeight(10)
+	releaseTime := ctx.BlockTime().Add(time.Hour)
+	pastTime := ctx.BlockTime()
+	valid := func(modify func(msg *nft.MsgTransferWithTimelock)) *nft.MsgTransferWithTimelock {
+		msg := &nft.MsgTransferWithTimelock{
+			ClassId:       testClassID,
+			Id:            testID,
+			Sender:        s.addrs[1].String(),
+			Receiver:      s.addrs[2].String(),
+			ReleaseHeight: 20,
+		}
+		modify(msg)
+		return msg
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgTransferWithTimelock
+		expErr error
+	}{
+		{"empty class id", valid(func(msg *nft.MsgTransferWithTimelock) { msg.ClassId = "" }), nft.ErrEmptyClassID},
+		{"invalid sender", valid(func(msg *nft.MsgTransferWithTimelock) { msg.Sender = "invalid" }), sdkerrors.ErrInvalidAddress},
+		{"sender is not the owner", valid(func(msg *nft.MsgTransferWithTimelock) { msg.Sender = s.addrs[2].String() }), sdkerrors.ErrUnauthorized},
+		{"invalid receiver", valid(func(msg *nft.MsgTransferWithTimelock) { msg.Receiver = "invalid" }), nft.ErrInvalidTimelock},
+		{"no release", valid(func(msg *nft.MsgTransferWithTimelock) { msg.ReleaseHeight = 0 }), nft.ErrInvalidTimelock},
+		{"release height and time", valid(func(msg *nft.MsgTransferWithTimelock) { msg.ReleaseTime = &releaseTime }), nft.ErrInvalidTimelock},
+		{"past release height", valid(func(msg *nft.MsgTransferWithTimelock) { msg.ReleaseHeight = 10 }), nft.ErrInvalidTimelock},
+		{"past release time", valid(func(msg *nft.MsgTransferWithTimelock) { msg.ReleaseHeight, msg.ReleaseTime = 0, &pastTime }), nft.ErrInvalidTimelock},
+		{"valid", valid(func(msg *nft.MsgTransferWithTimelock) {}), nil},
+		{"nft already escrowed", valid(func(msg *nft.MsgTransferWithTimelock) {}), sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.TransferWithTimelock(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(ctx, testClassID, testID))
+		})
+	}
+
+	res, err := s.nftKeeper.TimelockedTransfer(ctx, &nft.QueryTimelockedTransferRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2].String(), res.Transfer.Receiver)
+	s.Require().Equal(int64(20), res.Transfer.ReleaseHeight)
+}
+
+func (s *TestSuite) TestReleaseTimelockedTransfers() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	for _, nftID := range []string{testID, "kitty2", "kitty3"} {
+		s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: nftID}, s.addrs[1]))
+	}
+
+	ctx := s.ctx.WithBlockHeight(10)
+	sender, receiver := s.addrs[1], s.addrs[2]
+	releaseTime := ctx.BlockTime().Add(time.Hour)
+	for _, msg := range []*nft.MsgTransferWithTimelock{
+		{ClassId: testClassID, Id: testID, Sender: sender.String(), Receiver: receiver.String(), ReleaseHeight: 20},
+		{ClassId: testClassID, Id: "kitty2", Sender: sender.String(), Receiver: receiver.String(), ReleaseTime: &releaseTime},
+		{ClassId: testClassID, Id: "kitty3", Sender: sender.String(), Receiver: s.addrs[0].String(), ReleaseHeight: 30},
+	} {
+		_, err := s.nftKeeper.TransferWithTimelock(ctx, msg)
+		s.Require().NoError(err)
+	}
+
+	transfersRes, err := s.nftKeeper.TimelockedTransfers(ctx, &nft.QueryTimelockedTransfersRequest{Receiver: receiver.String(), Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(transfersRes.Transfers, 2)
+	s.Require().Equal(uint64(2), transfersRes.Pagination.Total)
+
+	// nothing is released before the release height or time
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx.WithBlockHeight(19)))
+	s.Require().Len(s.nftKeeper.GetTimelockedTransfers(ctx), 3)
+
+	// the transfer released at a height is released once the height is reached
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx))
+	s.Require().Equal(receiver, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(ctx, testClassID, "kitty2"))
+
+	var released []*nft.EventTimelockedTransferReleased
+	for _, event := range ctx.EventManager().Events() {
+		if msg, err := sdk.ParseTypedEvent(abci.Event(event)); err == nil {
+			if event, ok := msg.(*nft.EventTimelockedTransferReleased); ok {
+				released = append(released, event)
+			}
+		}
+	}
+	s.Require().Equal([]*nft.EventTimelockedTransferReleased{{ClassId: testClassID, Id: testID, Receiver: receiver.String()}}, released)
+
+	// the transfer released at a time is released once the time is reached, and the one of a revoked nft is removed
+	s.Require().NoError(s.nftKeeper.Burn(ctx, testClassID, "kitty3"))
+	ctx = ctx.WithBlockHeight(30).WithBlockTime(releaseTime)
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx))
+	s.Require().Equal(receiver, s.nftKeeper.GetOwner(ctx, testClassID, "kitty2"))
+	s.Require().Empty(s.nftKeeper.GetTimelockedTransfers(ctx))
+}
+
+func (s *TestSuite) TestReleaseTimelockedTransferFailure() {
+	admin := s.addrs[1]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+
+	ctx := s.ctx.WithBlockHeight(10)
+	sender, receiver := s.addrs[1], s.addrs[2]
+	releaseTime := ctx.BlockTime().Add(time.Hour)
+	_, err := s.nftKeeper.TransferWithTimelock(ctx, &nft.MsgTransferWithTimelock{
+		ClassId:     testClassID,
+		Id:          testID,
+		Sender:      sender.String(),
+		Receiver:    receiver.String(),
+		ReleaseTime: &releaseTime,
+	})
+	s.Require().NoError(err)
+
+	// releaseFailures returns the failed releases reported in a context
+	releaseFailures := func(ctx sdk.Context) (failures []*nft.EventTimelockedTransferReleaseFailed) {
+		for _, event := range ctx.EventManager().Events() {
+			if msg, err := sdk.ParseTypedEvent(abci.Event(event)); err == nil {
+				if event, ok := msg.(*nft.EventTimelockedTransferReleaseFailed); ok {
+					failures = append(failures, event)
+				}
+			}
+		}
+		return failures
+	}
+
+	// the release fails while the class is frozen, and is retried with a doubling backoff
+	s.Require().NoError(s.nftKeeper.Freeze(ctx, testClassID, admin))
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(releaseTime)
+	for i, retryHeight := range []int64{21, 23, 27} {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx))
+		failures := releaseFailures(ctx)
+		s.Require().Len(failures, 1)
+		s.Require().Equal(uint32(i+1), failures[0].FailedReleases)
+		s.Require().Equal(retryHeight, failures[0].RetryHeight)
+		s.Require().NotEmpty(failures[0].Error)
+
+		// the transfer is not retried before its retry height
+		ctx = ctx.WithBlockHeight(retryHeight - 1).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx))
+		s.Require().Empty(releaseFailures(ctx))
+		ctx = ctx.WithBlockHeight(retryHeight)
+	}
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(ctx, testClassID, testID))
+
+	transfer, has := s.nftKeeper.GetTimelockedTransfer(ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(uint32(3), transfer.FailedReleases)
+	s.Require().Equal(int64(27), transfer.RetryHeight)
+
+	// the backoff is restored with the transfer
+	genesis := s.nftKeeper.ExportGenesis(ctx)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.Require().Equal([]nft.TimelockedTransfer{transfer}, genesis.TimelockedTransfers)
+
+	// the nft is released on the retry height once the class is unfrozen
+	s.Require().NoError(s.nftKeeper.Unfreeze(ctx, testClassID, admin))
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx))
+	s.Require().Equal(receiver, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	s.Require().Empty(s.nftKeeper.GetTimelockedTransfers(ctx))
+}
+
+func (s *TestSuite) TestReclaimTimelockedTransfer() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+
+	ctx := s.ctx.WithBlockHeight(10)
+	sender := s.addrs[1]
+	_, err := s.nftKeeper.TransferWithTimelock(ctx, &nft.MsgTransferWithTimelock{
+		ClassId:       testClassID,
+		Id:            testID,
+		Sender:        sender.String(),
+		Receiver:      s.addrs[2].String(),
+		ReleaseHeight: 20,
+	})
+	s.Require().NoError(err)
+
+	// only the sender can reclaim the nft
+	_, err = s.nftKeeper.ReclaimTimelockedTransfer(ctx, &nft.MsgReclaimTimelockedTransfer{ClassId: testClassID, Id: testID, Sender: s.addrs[2].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.nftKeeper.ReclaimTimelockedTransfer(ctx, &nft.MsgReclaimTimelockedTransfer{ClassId: testClassID, Id: testID, Sender: sender.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sender, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+
+	// the reclaimed transfer is not released anymore
+	_, err = s.nftKeeper.ReclaimTimelockedTransfer(ctx, &nft.MsgReclaimTimelockedTransfer{ClassId: testClassID, Id: testID, Sender: sender.String()})
+	s.Require().ErrorIs(err, nft.ErrTimelockNotFound)
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx.WithBlockHeight(20)))
+	s.Require().Equal(sender, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestTimelockGenesis() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+
+	ctx := s.ctx.WithBlockHeight(10)
+	_, err := s.nftKeeper.TransferWithTimelock(ctx, &nft.MsgTransferWithTimelock{
+		ClassId:       testClassID,
+		Id:            testID,
+		Sender:        s.addrs[1].String(),
+		Receiver:      s.addrs[2].String(),
+		ReleaseHeight: 20,
+	})
+	s.Require().NoError(err)
+
+	genesis := s.nftKeeper.ExportGenesis(ctx)
+	s.Require().Len(genesis.TimelockedTransfers, 1)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	exported := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(genesis.TimelockedTransfers, exported.TimelockedTransfers)
+
+	// the queue entry is restored with the transfer
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(s.ctx.WithBlockHeight(20)))
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	genesis.TimelockedTransfers = append(genesis.TimelockedTransfers, genesis.TimelockedTransfers[0])
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()), nft.ErrInvalidTimelock)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetTransferFee sets the fee charged on the transfers of the nfts of a class, replacing the previous one if any.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) SetTransferFee(ctx context.Context, fee nft.ClassTransferFee, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, fee.ClassId, admin)
+	if err != nil {
+		return err
+	}
+
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	if fee.Destination == nft.TransferFeeDestinationCommunityPool && k.communityPool == nil {
+		return errors.Wrap(nft.ErrInvalidTransferFee, "the transfer fees cannot be routed to the community pool")
+	}
+	if err := k.setClassTransferFee(ctx, fee); err != nil {
+		return err
+	}
+
+	event := nft.EventClassTransferFeeSet{
+		ClassId:     fee.ClassId,
+		Admin:       class.Admin,
+		Destination: fee.Destination.String(),
+	}
+	if len(fee.Flat) > 0 {
+		event.Flat = fee.Flat.String()
+	} else {
+		event.Rate = fee.Rate.String()
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&event)
+}
+
+// RemoveTransferFee removes the fee charged on the transfers of the nfts of a class.
+// Note: When the upper module uses this method, it needs to authenticate the admin
+func (k Keeper) RemoveTransferFee(ctx context.Context, classID string, admin sdk.AccAddress) error {
+	class, err := k.getClassOfSettingsAdmin(ctx, classID, admin)
+	if err != nil {
+		return err
+	}
+
+	if _, has := k.GetClassTransferFee(ctx, classID); !has {
+		return errors.Wrapf(nft.ErrInvalidTransferFee, "class %s has no transfer fee", classID)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(classTransferFeeStoreKey(classID)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventClassTransferFeeSet{
+		ClassId: classID,
+		Admin:   class.Admin,
+	})
+}
+
+// GetClassTransferFee returns the fee charged on the transfers of the nfts of the specified class
+func (k Keeper) GetClassTransferFee(ctx context.Context, classID string) (nft.ClassTransferFee, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var fee nft.ClassTransferFee
+
+	bz, err := store.Get(classTransferFeeStoreKey(classID))
+	if err != nil || len(bz) == 0 {
+		return fee, false
+	}
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// GetClassTransferFees returns the transfer fees of all classes
+func (k Keeper) GetClassTransferFees(ctx context.Context) (fees []nft.ClassTransferFee) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassTransferFeeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee nft.ClassTransferFee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+		fees = append(fees, fee)
+	}
+	return
+}
+
+// PayTransferFee pays the fee of the given class due on the transfer of a nft sold at the given price, or not sold if
+// the price is empty, from the payer to the admin of the class or to the community pool. It returns the fee paid,
+// which is empty if the class has no transfer fee, or if it has a rate and the nft is not sold.
+// Note: When the upper module uses this method, it needs to authenticate the payer
+func (k Keeper) PayTransferFee(ctx context.Context, classID, nftID string, payer sdk.AccAddress, price sdk.Coins) (sdk.Coins, error) {
+	if !price.IsValid() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", price)
+	}
+
+	fee, has := k.GetClassTransferFee(ctx, classID)
+	if !has {
+		return sdk.NewCoins(), nil
+	}
+	amount := fee.Fee(price)
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	payerStr, err := k.ac.BytesToString(payer)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipient string
+	switch fee.Destination {
+	case nft.TransferFeeDestinationClassAdmin:
+		class, _ := k.GetClass(ctx, classID)
+		if len(class.Admin) == 0 {
+			return nil, errors.Wrapf(nft.ErrInvalidTransferFee, "class %s has no admin collecting its transfer fees", classID)
+		}
+		recipient = class.Admin
+		if err := k.bk.InputOutputCoins(ctx, banktypes.Input{Address: payerStr, Coins: amount},
+			[]banktypes.Output{{Address: recipient, Coins: amount}}); err != nil {
+			return nil, err
+		}
+	case nft.TransferFeeDestinationCommunityPool:
+		if k.communityPool == nil {
+			return nil, errors.Wrap(nft.ErrInvalidTransferFee, "the transfer fees cannot be routed to the community pool")
+		}
+		if recipient, err = k.ac.BytesToString(authtypes.NewModuleAddress(distrtypes.ModuleName)); err != nil {
+			return nil, err
+		}
+		if err := k.communityPool.FundCommunityPool(ctx, amount, payer); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrapf(nft.ErrInvalidTransferFee, "invalid destination %s of class %s", fee.Destination, classID)
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventTransferFeePaid{
+		ClassId:   classID,
+		Id:        nftID,
+		Payer:     payerStr,
+		Amount:    amount.String(),
+		Recipient: recipient,
+	}); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
+
+// setClassTransferFee stores the transfer fee of a class
+func (k Keeper) setClassTransferFee(ctx context.Context, fee nft.ClassTransferFee) error {
+	bz, err := k.cdc.Marshal(&fee)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassTransferFee failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classTransferFeeStoreKey(fee.ClassId), bz)
+}
@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	nfttestutil "cosmossdk.io/x/nft/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (s *TestSuite) TestSetClassTransferFee() {
+	admin := s.addrs[2]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "adminless"}))
+
+	flat := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	fee := func(classID string, flat sdk.Coins, rate string, destination nft.TransferFeeDestination) nft.ClassTransferFee {
+		return nft.ClassTransferFee{ClassId: classID, Flat: flat, Rate: math.LegacyMustNewDecFromStr(rate), Destination: destination}
+	}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgSetClassTransferFee
+		expErr error
+	}{
+		{"empty class id", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee("", flat, "0", nft.TransferFeeDestinationClassAdmin)}, nft.ErrEmptyClassID},
+		{"invalid sender", &nft.MsgSetClassTransferFee{Sender: "invalid", Fee: fee(testClassID, flat, "0", nft.TransferFeeDestinationClassAdmin)}, sdkerrors.ErrInvalidAddress},
+		{"class does not exist", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee("unknown", flat, "0", nft.TransferFeeDestinationClassAdmin)}, nft.ErrClassNotExists},
+		{"class has no admin", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee("adminless", flat, "0", nft.TransferFeeDestinationClassAdmin)}, sdkerrors.ErrUnauthorized},
+		{"sender is not the admin", &nft.MsgSetClassTransferFee{Sender: s.addrs[0].String(), Fee: fee(testClassID, flat, "0", nft.TransferFeeDestinationClassAdmin)}, sdkerrors.ErrUnauthorized},
+		{"invalid fee", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee(testClassID, nil, "2", nft.TransferFeeDestinationClassAdmin)}, nft.ErrInvalidTransferFee},
+		{"no community pool", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee(testClassID, flat, "0", nft.TransferFeeDestinationCommunityPool)}, nft.ErrInvalidTransferFee},
+		{"no fee to remove", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee(testClassID, nil, "0", nft.TransferFeeDestinationUnspecified)}, nft.ErrInvalidTransferFee},
+		{"valid", &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee(testClassID, flat, "0", nft.TransferFeeDestinationClassAdmin)}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.SetClassTransferFee(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	expFee := fee(testClassID, flat, "0", nft.TransferFeeDestinationClassAdmin)
+	actual, has := s.nftKeeper.GetClassTransferFee(ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expFee, actual)
+	res, err := s.queryClient.ClassTransferFee(ctx, &nft.QueryClassTransferFeeRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&expFee, res.Fee)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventClassTransferFeeSet{
+		ClassId:     testClassID,
+		Admin:       admin.String(),
+		Flat:        flat.String(),
+		Destination: nft.TransferFeeDestinationClassAdmin.String(),
+	}, event)
+
+	// the fee is preserved across genesis export and import
+	genesis := s.nftKeeper.ExportGenesis(ctx)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	actual, has = s.nftKeeper.GetClassTransferFee(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expFee, actual)
+
+	// a fee without flat fee nor rate removes the fee
+	_, err = s.nftKeeper.SetClassTransferFee(s.ctx, &nft.MsgSetClassTransferFee{Sender: admin.String(), Fee: fee(testClassID, nil, "0", nft.TransferFeeDestinationUnspecified)})
+	s.Require().NoError(err)
+	_, has = s.nftKeeper.GetClassTransferFee(s.ctx, testClassID)
+	s.Require().False(has)
+	res, err = s.queryClient.ClassTransferFee(s.ctx, &nft.QueryClassTransferFeeRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Fee)
+	_, err = s.queryClient.ClassTransferFee(s.ctx, &nft.QueryClassTransferFeeRequest{ClassId: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestPayTransferFee() {
+	admin, payer := s.addrs[2], s.addrs[1]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, payer))
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	communityPool := nfttestutil.NewMockCommunityPoolKeeper(gomock.NewController(s.T()))
+	k := s.nftKeeper
+	k.SetCommunityPoolKeeper(communityPool)
+
+	// there is nothing to pay without a fee
+	paid, err := k.PayTransferFee(s.ctx, testClassID, testID, payer, price)
+	s.Require().NoError(err)
+	s.Require().True(paid.IsZero())
+
+	// a flat fee routed to the admin is paid by the sender of Msg/Send
+	flat := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(k.SetTransferFee(s.ctx, nft.ClassTransferFee{
+		ClassId:     testClassID,
+		Flat:        flat,
+		Rate:        math.LegacyZeroDec(),
+		Destination: nft.TransferFeeDestinationClassAdmin,
+	}, admin))
+	s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), banktypes.Input{Address: payer.String(), Coins: flat},
+		[]banktypes.Output{{Address: admin.String(), Coins: flat}}).Return(nil)
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.Send(ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: payer.String(), Receiver: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0], k.GetOwner(ctx, testClassID, testID))
+
+	event, err := sdk.ParseTypedEvent(abci.Event(ctx.EventManager().Events()[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventTransferFeePaid{
+		ClassId:   testClassID,
+		Id:        testID,
+		Payer:     payer.String(),
+		Amount:    flat.String(),
+		Recipient: admin.String(),
+	}, event)
+
+	// the nft is not sent if the fee is not paid
+	s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("insufficient funds"))
+	_, err = k.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Receiver: payer.String()})
+	s.Require().Error(err)
+	s.Require().Equal(s.addrs[0], k.GetOwner(s.ctx, testClassID, testID))
+
+	// a percentage fee routed to the community pool is only due on sales
+	s.Require().NoError(k.SetTransferFee(s.ctx, nft.ClassTransferFee{
+		ClassId:     testClassID,
+		Rate:        math.LegacyMustNewDecFromStr("0.05"),
+		Destination: nft.TransferFeeDestinationCommunityPool,
+	}, admin))
+	paid, err = k.PayTransferFee(s.ctx, testClassID, testID, payer, nil)
+	s.Require().NoError(err)
+	s.Require().True(paid.IsZero())
+
+	_, err = k.PayTransferFee(s.ctx, testClassID, testID, payer, sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	expPaid := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	communityPool.EXPECT().FundCommunityPool(gomock.Any(), expPaid, payer).Return(nil)
+	ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	paid, err = k.PayTransferFee(ctx, testClassID, testID, payer, price)
+	s.Require().NoError(err)
+	s.Require().Equal(expPaid, paid)
+
+	event, err = sdk.ParseTypedEvent(abci.Event(ctx.EventManager().Events()[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventTransferFeePaid{
+		ClassId:   testClassID,
+		Id:        testID,
+		Payer:     payer.String(),
+		Amount:    expPaid.String(),
+		Recipient: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+	}, event)
+
+	// the fees cannot be routed to the community pool without its keeper
+	_, err = s.nftKeeper.PayTransferFee(s.ctx, testClassID, testID, payer, price)
+	s.Require().ErrorIs(err, nft.ErrInvalidTransferFee)
+}
+
+func (s *TestSuite) TestTransferFeeOnAllTransfers() {
+	admin, owner, escrow := s.addrs[2], s.addrs[1], s.addrs[0]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Admin: admin.String()}))
+	for _, id := range []string{testID, "kitty2", "kitty3"} {
+		s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: id}, owner))
+	}
+
+	flat := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.nftKeeper.SetTransferFee(s.ctx, nft.ClassTransferFee{
+		ClassId:     testClassID,
+		Flat:        flat,
+		Rate:        math.LegacyZeroDec(),
+		Destination: nft.TransferFeeDestinationClassAdmin,
+	}, admin))
+	expFlatFee := func(payer sdk.AccAddress) *gomock.Call {
+		return s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), banktypes.Input{Address: payer.String(), Coins: flat},
+			[]banktypes.Output{{Address: admin.String(), Coins: flat}}).Return(nil)
+	}
+
+	// the fee is not charged by the transfers of the keeper, e.g. the moves of other modules, but by TransferWithFee
+	s.Require().NoError(s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{"kitty2", "kitty3"}, admin))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", owner))
+	expFlatFee(owner)
+	s.Require().NoError(s.nftKeeper.TransferWithFee(s.ctx, testClassID, "kitty2", admin))
+	s.Require().Equal(admin, s.nftKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+
+	// the fee of a timelocked transfer is paid by the sender on escrowing, and not on release
+	expFlatFee(owner)
+	ctx := s.ctx.WithBlockHeight(10)
+	_, err := s.nftKeeper.TransferWithTimelock(ctx, &nft.MsgTransferWithTimelock{
+		ClassId:       testClassID,
+		Id:            testID,
+		Sender:        owner.String(),
+		Receiver:      s.addrs[2].String(),
+		ReleaseHeight: 20,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(escrow, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+	s.Require().NoError(s.nftKeeper.ReleaseTimelockedTransfers(ctx.WithBlockHeight(20)))
+	s.Require().Equal(admin, s.nftKeeper.GetOwner(ctx, testClassID, testID))
+
+	// the percentage fee and the royalty of an auction sale are paid out of the price, and the seller gets the rest
+	auctionKeeper, auctionBankKeeper := s.newAuctionKeeper()
+	seller, buyer, recipient := admin, s.addrs[1], s.addrs[0]
+	s.Require().NoError(s.nftKeeper.SetTransferFee(s.ctx, nft.ClassTransferFee{
+		ClassId:     testClassID,
+		Rate:        math.LegacyMustNewDecFromStr("0.05"),
+		Destination: nft.TransferFeeDestinationClassAdmin,
+	}, admin))
+	s.Require().NoError(s.nftKeeper.SetRoyalty(s.ctx, nft.ClassRoyalty{
+		ClassId:    testClassID,
+		Rate:       math.LegacyMustNewDecFromStr("0.1"),
+		Recipients: []nft.RoyaltyRecipient{{Address: recipient.String(), Weight: math.LegacyOneDec()}},
+	}, admin))
+
+	res, err := auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+		Seller:      seller.String(),
+		ClassId:     testClassID,
+		NftId:       testID,
+		AuctionType: nft.AuctionTypeDutch,
+		StartPrice:  sdk.NewInt64Coin("stake", 1000),
+		EndPrice:    sdk.NewInt64Coin("stake", 500),
+		Duration:    time.Hour,
+	})
+	s.Require().NoError(err)
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	royalty, fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	gomock.InOrder(
+		auctionBankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), buyer, nft.ModuleName, price).Return(nil),
+		s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), banktypes.Input{Address: escrow.String(), Coins: royalty},
+			[]banktypes.Output{{Address: recipient.String(), Coins: royalty}}).Return(nil),
+		s.bankKeeper.EXPECT().InputOutputCoins(gomock.Any(), banktypes.Input{Address: escrow.String(), Coins: fee},
+			[]banktypes.Output{{Address: admin.String(), Coins: fee}}).Return(nil),
+		auctionBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), nft.ModuleName, seller,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 850))).Return(nil),
+	)
+	_, err = auctionKeeper.Bid(s.ctx, &nft.MsgBid{Bidder: buyer.String(), AuctionId: res.AuctionId, Amount: price[0]})
+	s.Require().NoError(err)
+	s.Require().Equal(buyer, s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// a price which does not cover the royalty and the fee is rejected
+	s.Require().NoError(s.nftKeeper.SetTransferFee(s.ctx, nft.ClassTransferFee{
+		ClassId:     testClassID,
+		Rate:        math.LegacyOneDec(),
+		Destination: nft.TransferFeeDestinationClassAdmin,
+	}, admin))
+	res, err = auctionKeeper.CreateAuction(s.ctx, &nft.MsgCreateAuction{
+		Seller:      buyer.String(),
+		ClassId:     testClassID,
+		NftId:       testID,
+		AuctionType: nft.AuctionTypeEnglish,
+		StartPrice:  sdk.NewInt64Coin("stake", 1000),
+		Duration:    time.Hour,
+	})
+	s.Require().NoError(err)
+	_, err = auctionKeeper.Bid(s.ctx, &nft.MsgBid{Bidder: seller.String(), AuctionId: res.AuctionId, Amount: price[0]})
+	s.Require().ErrorIs(err, nft.ErrInvalidBid)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateClassURI updates the uri of an existing class, together with the hash of the content it points to
+// Note: When the upper module uses this method, it needs to authenticate the class
+func (k Keeper) UpdateClassURI(ctx context.Context, classID, uri, uriHash string) error {
+	if err := nft.ValidateURIHash(uriHash); err != nil {
+		return err
+	}
+
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	class.Uri = uri
+	class.UriHash = uriHash
+	return k.UpdateClass(ctx, class)
+}
+
+// UpdateNFTURI updates the uri of an existing nft, together with the hash of the content it points to
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) UpdateNFTURI(ctx context.Context, classID, nftID, uri, uriHash string) error {
+	if err := nft.ValidateURIHash(uriHash); err != nil {
+		return err
+	}
+
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	token, has := k.GetNFT(ctx, classID, nftID)
+	if !has {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	token.Uri = uri
+	token.UriHash = uriHash
+	return k.Update(ctx, token)
+}
+
+// GetURIUpdateHeight returns the height at which the uri of the specified class, if nftID is empty, or nft was last
+// set. It returns false if the uri was not set since the module started tracking uri updates.
+func (k Keeper) GetURIUpdateHeight(ctx context.Context, classID, nftID string) (int64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(uriUpdateHeightStoreKey(classID, nftID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) setURIUpdateHeight(ctx context.Context, classID, nftID string) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(uriUpdateHeightStoreKey(classID, nftID), sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) deleteURIUpdateHeight(ctx context.Context, classID, nftID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(uriUpdateHeightStoreKey(classID, nftID)); err != nil {
+		panic(err)
+	}
+}
+
+// trackURIChange records the current height as the last uri update height of the class, if nftID is empty, or of the
+// nft and emits an EventUpdateURI, if the uri or the uri hash changed.
+func (k Keeper) trackURIChange(ctx context.Context, classID, nftID, prevURI, prevURIHash, uri, uriHash string) error {
+	if prevURI == uri && prevURIHash == uriHash {
+		return nil
+	}
+
+	k.setURIUpdateHeight(ctx, classID, nftID)
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventUpdateURI{
+		ClassId:         classID,
+		Id:              nftID,
+		Uri:             uri,
+		UriHash:         uriHash,
+		PreviousUri:     prevURI,
+		PreviousUriHash: prevURIHash,
+	})
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestUpdateURI() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[0]))
+
+	content := []byte(`{"name":"kitty"}`)
+	uriHash := nft.URIHash(content)
+	authority := s.nftKeeper.GetAuthority()
+	ctx := s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgUpdateURI
+		expErr error
+	}{
+		{"empty class id", &nft.MsgUpdateURI{Sender: authority, UriHash: uriHash}, nft.ErrEmptyClassID},
+		{"missing uri hash", &nft.MsgUpdateURI{Sender: authority, ClassId: testClassID, Uri: "new uri"}, nft.ErrInvalidURIHash},
+		{"invalid uri hash", &nft.MsgUpdateURI{Sender: authority, ClassId: testClassID, Uri: "new uri", UriHash: testURIHash}, nft.ErrInvalidURIHash},
+		{"class: sender is not the authority", &nft.MsgUpdateURI{Sender: s.addrs[0].String(), ClassId: testClassID, Uri: "new uri", UriHash: uriHash}, sdkerrors.ErrUnauthorized},
+		{"class: not found", &nft.MsgUpdateURI{Sender: authority, ClassId: "unknown", Uri: "new uri", UriHash: uriHash}, nft.ErrClassNotExists},
+		{"nft: sender is not the owner", &nft.MsgUpdateURI{Sender: s.addrs[1].String(), ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: uriHash}, sdkerrors.ErrUnauthorized},
+		{"nft: not found", &nft.MsgUpdateURI{Sender: s.addrs[0].String(), ClassId: testClassID, Id: "unknown", Uri: "new uri", UriHash: uriHash}, sdkerrors.ErrUnauthorized},
+		{"class: valid", &nft.MsgUpdateURI{Sender: authority, ClassId: testClassID, Uri: "new class uri", UriHash: uriHash}, nil},
+		{"nft: valid", &nft.MsgUpdateURI{Sender: s.addrs[0].String(), ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: uriHash}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.UpdateURI(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	class, _ := s.nftKeeper.GetClass(ctx, testClassID)
+	s.Require().Equal("new class uri", class.Uri)
+	s.Require().NoError(class.VerifyURIHash(content))
+
+	token, _ := s.nftKeeper.GetNFT(ctx, testClassID, testID)
+	s.Require().Equal("new uri", token.Uri)
+	s.Require().NoError(token.VerifyURIHash(content))
+	s.Require().ErrorIs(token.VerifyURIHash([]byte("swapped")), nft.ErrURIHashMismatch)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 2)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventUpdateURI{
+		ClassId:     testClassID,
+		Id:          testID,
+		Uri:         "new uri",
+		UriHash:     uriHash,
+		PreviousUri: testURI,
+	}, event)
+
+	// setting the same uri and hash again is not a change
+	s.Require().NoError(s.nftKeeper.UpdateNFTURI(ctx.WithBlockHeight(11), testClassID, testID, "new uri", uriHash))
+	s.Require().Len(ctx.EventManager().Events(), 2)
+
+	// updating the nft through Update is tracked as well
+	token.Uri = "other uri"
+	s.Require().NoError(s.nftKeeper.Update(ctx.WithBlockHeight(12), token))
+	s.Require().Len(ctx.EventManager().Events(), 3)
+
+	res, err := s.queryClient.URI(gocontext.Background(), &nft.QueryURIRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.QueryURIResponse{Uri: "new class uri", UriHash: uriHash, LastUpdatedHeight: 10}, res)
+
+	res, err = s.queryClient.URI(gocontext.Background(), &nft.QueryURIRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.QueryURIResponse{Uri: "other uri", UriHash: uriHash, LastUpdatedHeight: 12}, res)
+
+	_, err = s.queryClient.URI(gocontext.Background(), &nft.QueryURIRequest{})
+	s.Require().ErrorIs(err, nft.ErrEmptyClassID)
+
+	_, err = s.queryClient.URI(gocontext.Background(), &nft.QueryURIRequest{ClassId: testClassID, Id: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	// burning the nft removes its last update height
+	s.Require().NoError(s.nftKeeper.Burn(ctx, testClassID, testID))
+	_, has := s.nftKeeper.GetURIUpdateHeight(ctx, testClassID, testID)
+	s.Require().False(has)
+}
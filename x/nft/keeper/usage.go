@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantUsage grants the usage of an existing nft to the grantee until the given expiry, without transferring its
+// ownership. The grant is removed when it expires, or when the nft changes owner or is burned. A nft can only have
+// one unexpired usage grant at a time.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) GrantUsage(ctx context.Context, classID, nftID string, grantee sdk.AccAddress, expiry time.Time) error {
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !expiry.After(blockTime) {
+		return errors.Wrapf(nft.ErrInvalidUsageExpiry, "expiry %s must be after the block time %s", expiry, blockTime)
+	}
+
+	if grant, has := k.GetUsageGrant(ctx, classID, nftID); has {
+		return errors.Wrapf(nft.ErrUsageGrantExists, "nft %s is granted to %s until %s", nftID, grant.Grantee, grant.Expiry)
+	}
+
+	granteeStr, err := k.ac.BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+
+	// an expired grant, not yet removed by the end blocker, is replaced
+	k.deleteUsageGrant(ctx, classID, nftID)
+	k.setUsageGrant(ctx, nft.UsageGrant{
+		ClassId: classID,
+		Id:      nftID,
+		Grantee: granteeStr,
+		Expiry:  expiry,
+	})
+	return nil
+}
+
+// GetUsageGrant returns the unexpired usage grant of the specified nft
+func (k Keeper) GetUsageGrant(ctx context.Context, classID, nftID string) (nft.UsageGrant, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(usageGrantStoreKey(classID, nftID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nft.UsageGrant{}, false
+	}
+
+	var grant nft.UsageGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	if !grant.Expiry.After(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return nft.UsageGrant{}, false
+	}
+	return grant, true
+}
+
+// HasUsage returns true if the account is allowed to use the specified nft, either because it owns it or because it
+// holds an unexpired usage grant of it
+func (k Keeper) HasUsage(ctx context.Context, classID, nftID string, account sdk.AccAddress) bool {
+	if owner := k.GetOwner(ctx, classID, nftID); owner != nil && owner.Equals(account) {
+		return true
+	}
+
+	grant, has := k.GetUsageGrant(ctx, classID, nftID)
+	if !has {
+		return false
+	}
+
+	grantee, err := k.ac.StringToBytes(grant.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return account.Equals(sdk.AccAddress(grantee))
+}
+
+// GetUsageGrants returns all the unexpired usage grants
+func (k Keeper) GetUsageGrants(ctx context.Context) (grants []*nft.UsageGrant) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), UsageGrantKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant nft.UsageGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &grant)
+		if grant.Expiry.After(blockTime) {
+			grants = append(grants, &grant)
+		}
+	}
+	return
+}
+
+// RemoveExpiredUsageGrants removes all the usage grants which expired at or before the block time
+func (k Keeper) RemoveExpiredUsageGrants(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	store := k.storeService.OpenKVStore(ctx)
+	// the end is exclusive, so it is set past all the entries of the grants expiring at the block time
+	iterator, err := store.Iterator(UsageGrantQueueKey, storetypes.PrefixEndBytes(usageGrantQueueByTimeKey(blockTime)))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := store.Delete(iterator.Key()); err != nil {
+			return err
+		}
+
+		classID, nftID := parseUsageGrantQueueStoreKey(iterator.Key())
+		if err := store.Delete(usageGrantStoreKey(classID, nftID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) setUsageGrant(ctx context.Context, grant nft.UsageGrant) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(usageGrantStoreKey(grant.ClassId, grant.Id), k.cdc.MustMarshal(&grant)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(usageGrantQueueStoreKey(grant.Expiry, grant.ClassId, grant.Id), Placeholder); err != nil {
+		panic(err)
+	}
+}
+
+// deleteUsageGrant removes the usage grant of the nft, whether expired or not, together with its expiry queue entry
+func (k Keeper) deleteUsageGrant(ctx context.Context, classID, nftID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := usageGrantStoreKey(classID, nftID)
+	bz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return
+	}
+
+	var grant nft.UsageGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	if err := store.Delete(key); err != nil {
+		panic(err)
+	}
+	if err := store.Delete(usageGrantQueueStoreKey(grant.Expiry, classID, nftID)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestGrantUse() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[0]))
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	owner, grantee := s.addrs[0].String(), s.addrs[1].String()
+	expiry := ctx.BlockTime().Add(time.Hour)
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgGrantUse
+		expErr error
+	}{
+		{"empty class id", &nft.MsgGrantUse{Sender: owner, Id: testID, Grantee: grantee, Expiry: expiry}, nft.ErrEmptyClassID},
+		{"empty nft id", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Grantee: grantee, Expiry: expiry}, nft.ErrEmptyNFTID},
+		{"invalid grantee", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Id: testID, Grantee: "invalid", Expiry: expiry}, sdkerrors.ErrInvalidAddress},
+		{"grantee is the owner", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Id: testID, Grantee: owner, Expiry: expiry}, sdkerrors.ErrInvalidRequest},
+		{"sender is not the owner", &nft.MsgGrantUse{Sender: grantee, ClassId: testClassID, Id: testID, Grantee: s.addrs[2].String(), Expiry: expiry}, sdkerrors.ErrUnauthorized},
+		{"expiry in the past", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Id: testID, Grantee: grantee, Expiry: ctx.BlockTime()}, nft.ErrInvalidUsageExpiry},
+		{"valid", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Id: testID, Grantee: grantee, Expiry: expiry}, nil},
+		{"already granted", &nft.MsgGrantUse{Sender: owner, ClassId: testClassID, Id: testID, Grantee: s.addrs[2].String(), Expiry: expiry}, nft.ErrUsageGrantExists},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.GrantUse(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventGrantUse{
+		ClassId: testClassID,
+		Id:      testID,
+		Owner:   owner,
+		Grantee: grantee,
+		Expiry:  expiry,
+	}, event)
+
+	res, err := s.queryClient.UsageGrant(gocontext.Background(), &nft.QueryUsageGrantRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(grantee, res.Grant.Grantee)
+	s.Require().True(expiry.Equal(res.Grant.Expiry))
+
+	_, err = s.queryClient.UsageGrant(gocontext.Background(), &nft.QueryUsageGrantRequest{ClassId: testClassID, Id: "unknown"})
+	s.Require().ErrorIs(err, nft.ErrUsageGrantNotExists)
+
+	s.Require().True(s.nftKeeper.HasUsage(ctx, testClassID, testID, s.addrs[0]))
+	s.Require().True(s.nftKeeper.HasUsage(ctx, testClassID, testID, s.addrs[1]))
+	s.Require().False(s.nftKeeper.HasUsage(ctx, testClassID, testID, s.addrs[2]))
+
+	// the grant is exported with the genesis state
+	s.Require().Len(s.nftKeeper.ExportGenesis(ctx).UsageGrants, 1)
+
+	// the grant is no longer active once it expires, and removed by the end blocker
+	expiredCtx := ctx.WithBlockTime(expiry)
+	s.Require().False(s.nftKeeper.HasUsage(expiredCtx, testClassID, testID, s.addrs[1]))
+	s.Require().Empty(s.nftKeeper.ExportGenesis(expiredCtx).UsageGrants)
+
+	s.Require().NoError(s.nftKeeper.RemoveExpiredUsageGrants(ctx))
+	_, has := s.nftKeeper.GetUsageGrant(ctx, testClassID, testID)
+	s.Require().True(has)
+
+	s.Require().NoError(s.nftKeeper.RemoveExpiredUsageGrants(expiredCtx))
+	_, has = s.nftKeeper.GetUsageGrant(ctx, testClassID, testID)
+	s.Require().False(has)
+	s.Require().Empty(s.nftKeeper.ExportGenesis(ctx).UsageGrants)
+
+	// a new grant can be made after the previous one expired
+	s.Require().NoError(s.nftKeeper.GrantUsage(ctx, testClassID, testID, s.addrs[2], expiry))
+	s.Require().True(s.nftKeeper.HasUsage(ctx, testClassID, testID, s.addrs[2]))
+}
+
+func (s *TestSuite) TestUsageGrantRemovedOnTransferAndBurn() {
+	s.saveClass([]nft.NFT{{ClassId: testClassID}})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[0]))
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+
+	// sending the nft to its owner keeps the grant
+	s.Require().NoError(s.nftKeeper.GrantUsage(s.ctx, testClassID, testID, s.addrs[1], expiry))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[0]))
+	s.Require().True(s.nftKeeper.HasUsage(s.ctx, testClassID, testID, s.addrs[1]))
+
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2]))
+	s.Require().False(s.nftKeeper.HasUsage(s.ctx, testClassID, testID, s.addrs[1]))
+
+	s.Require().NoError(s.nftKeeper.GrantUsage(s.ctx, testClassID, testID, s.addrs[1], expiry))
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+	_, has := s.nftKeeper.GetUsageGrant(s.ctx, testClassID, testID)
+	s.Require().False(has)
+
+	// the expiry queue entries were removed with the grants
+	iterator := storetypes.KVStorePrefixIterator(s.ctx.KVStore(s.storeKey), keeper.UsageGrantQueueKey)
+	defer iterator.Close()
+	s.Require().False(iterator.Valid())
+}
@@ -10,3 +10,15 @@ const (
 	// RouterKey is the message route for nft
 	RouterKey = ModuleName
 )
+
+const (
+	// AttributeKeyData is the key of the EventAttributeSet emitted when the data of a nft is updated
+	AttributeKeyData = "data"
+
+	// AttributeKeyDataSchema is the key of the EventAttributeSet emitted when the data schema of a class is set
+	AttributeKeyDataSchema = "data_schema"
+
+	// AttributeKeyJSONSchema is the key of the EventAttributeSet emitted when the json schema of a class is set, its
+	// value being the hex encoded hash of the schema
+	AttributeKeyJSONSchema = "json_schema"
+)
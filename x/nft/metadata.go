@@ -0,0 +1,175 @@
+package nft
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxMetadataAttributes is the maximum number of attributes of a metadata mapping
+	MaxMetadataAttributes = 32
+
+	// MaxMetadataFieldLength is the maximum length of the JSON pointers and trait types of a metadata mapping
+	MaxMetadataFieldLength = 256
+)
+
+// IsEmpty returns true if the mapping maps nothing, so that the nfts of its class are rendered with the defaults only
+func (m ClassMetadataMapping) IsEmpty() bool {
+	return len(m.Name) == 0 && len(m.Description) == 0 && len(m.Image) == 0 && len(m.ExternalUrl) == 0 &&
+		len(m.Attributes) == 0
+}
+
+// Validate checks that the class id of the mapping is valid, that its fields are empty or valid JSON pointers, and
+// that its attributes, at most MaxMetadataAttributes, have distinct trait types and valid JSON pointers
+func (m ClassMetadataMapping) Validate() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+
+	for _, field := range m.fields() {
+		if len(field.ptr) == 0 {
+			continue
+		}
+		if err := validateJSONPointer(field.ptr); err != nil {
+			return ErrInvalidMetadataMapping.Wrapf("%s of class %s: %s", field.name, m.ClassId, err)
+		}
+	}
+
+	if len(m.Attributes) > MaxMetadataAttributes {
+		return ErrInvalidMetadataMapping.Wrapf("%d attributes, the maximum is %d", len(m.Attributes), MaxMetadataAttributes)
+	}
+	traits := make(map[string]bool, len(m.Attributes))
+	for _, attr := range m.Attributes {
+		if len(attr.TraitType) == 0 || len(attr.TraitType) > MaxMetadataFieldLength {
+			return ErrInvalidMetadataMapping.Wrapf("trait type of class %s must have 1 to %d characters", m.ClassId, MaxMetadataFieldLength)
+		}
+		if traits[attr.TraitType] {
+			return ErrInvalidMetadataMapping.Wrapf("duplicate trait type %s of class %s", attr.TraitType, m.ClassId)
+		}
+		traits[attr.TraitType] = true
+
+		if err := validateJSONPointer(attr.Pointer); err != nil {
+			return ErrInvalidMetadataMapping.Wrapf("trait type %s of class %s: %s", attr.TraitType, m.ClassId, err)
+		}
+	}
+	return nil
+}
+
+// ERC721Metadata renders a nft of the class of the mapping as ERC-721 metadata, reading the mapped values from the
+// JSON form of the data of the nft. The values missing from the data fall back to "<class name> #<nft id>" for the
+// name, to the class description for the description and to the nft uri for the image, and the attributes missing
+// from the data are omitted. The string values are rendered as is, the other values as their JSON form.
+func (m ClassMetadataMapping) ERC721Metadata(class Class, token NFT, data []byte) (*QueryERC721MetadataResponse, error) {
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return nil, ErrInvalidNFTData.Wrap(err.Error())
+	}
+
+	metadata := &QueryERC721MetadataResponse{
+		Name:        strings.TrimSpace(class.Name + " #" + token.Id),
+		Description: class.Description,
+		Image:       token.Uri,
+		Attributes:  []ERC721Attribute{},
+	}
+	values := []*string{&metadata.Name, &metadata.Description, &metadata.Image, &metadata.ExternalUrl}
+	for i, field := range m.fields() {
+		if len(field.ptr) == 0 {
+			continue
+		}
+		if value, ok := resolveJSONPointer(doc, field.ptr); ok {
+			if *values[i], err = metadataValue(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, attr := range m.Attributes {
+		value, ok := resolveJSONPointer(doc, attr.Pointer)
+		if !ok {
+			continue
+		}
+		str, err := metadataValue(value)
+		if err != nil {
+			return nil, err
+		}
+		metadata.Attributes = append(metadata.Attributes, ERC721Attribute{TraitType: attr.TraitType, Value: str})
+	}
+	return metadata, nil
+}
+
+// metadataField is a field of the ERC-721 metadata mapped to a JSON pointer
+type metadataField struct {
+	name string
+	ptr  string
+}
+
+// fields returns the JSON pointers of the name, description, image and external url, in this order
+func (m ClassMetadataMapping) fields() []metadataField {
+	return []metadataField{
+		{"name", m.Name},
+		{"description", m.Description},
+		{"image", m.Image},
+		{"external_url", m.ExternalUrl},
+	}
+}
+
+// metadataValue renders a JSON value as a metadata value: a string as is, any other value as its JSON form
+func metadataValue(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return "", ErrInvalidNFTData.Wrap(err.Error())
+	}
+	return string(bz), nil
+}
+
+// validateJSONPointer checks that ptr is a JSON pointer (RFC 6901) of at most MaxMetadataFieldLength characters
+func validateJSONPointer(ptr string) error {
+	if len(ptr) > MaxMetadataFieldLength {
+		return fmt.Errorf("json pointer has %d characters, the maximum is %d", len(ptr), MaxMetadataFieldLength)
+	}
+	if !strings.HasPrefix(ptr, "/") {
+		return fmt.Errorf("json pointer %q must start with /", ptr)
+	}
+	for _, token := range strings.Split(ptr[1:], "/") {
+		for i := 0; i < len(token); i++ {
+			if token[i] == '~' && (i+1 == len(token) || (token[i+1] != '0' && token[i+1] != '1')) {
+				return fmt.Errorf("json pointer %q has an invalid escape", ptr)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveJSONPointer returns the value referenced by a valid JSON pointer in a decoded JSON document, and false if
+// there is none
+func resolveJSONPointer(doc interface{}, ptr string) (interface{}, bool) {
+	if len(ptr) == 0 {
+		return doc, true
+	}
+
+	value := doc
+	for _, token := range strings.Split(ptr[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[token]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.ParseUint(token, 10, 64)
+			if err != nil || (len(token) > 1 && token[0] == '0') || i >= uint64(len(v)) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
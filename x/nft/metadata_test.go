@@ -0,0 +1,101 @@
+package nft_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/nft"
+)
+
+func TestClassMetadataMappingValidate(t *testing.T) {
+	tooManyAttributes := make([]nft.MetadataAttributeMapping, nft.MaxMetadataAttributes+1)
+	for i := range tooManyAttributes {
+		tooManyAttributes[i] = nft.MetadataAttributeMapping{TraitType: strconv.Itoa(i), Pointer: "/" + strconv.Itoa(i)}
+	}
+
+	testCases := []struct {
+		name    string
+		mapping nft.ClassMetadataMapping
+		expErr  error
+	}{
+		{"valid", nft.ClassMetadataMapping{ClassId: "kitties", Name: "/name", Image: "/media/0/url", Attributes: []nft.MetadataAttributeMapping{{TraitType: "color", Pointer: "/color"}}}, nil},
+		{"escaped pointer", nft.ClassMetadataMapping{ClassId: "kitties", Name: "/a~1b/c~0d"}, nil},
+		{"invalid class id", nft.ClassMetadataMapping{ClassId: "1", Name: "/name"}, nft.ErrInvalidClassID},
+		{"relative pointer", nft.ClassMetadataMapping{ClassId: "kitties", Name: "name"}, nft.ErrInvalidMetadataMapping},
+		{"invalid escape", nft.ClassMetadataMapping{ClassId: "kitties", ExternalUrl: "/a~2"}, nft.ErrInvalidMetadataMapping},
+		{"empty trait type", nft.ClassMetadataMapping{ClassId: "kitties", Attributes: []nft.MetadataAttributeMapping{{Pointer: "/color"}}}, nft.ErrInvalidMetadataMapping},
+		{"empty attribute pointer", nft.ClassMetadataMapping{ClassId: "kitties", Attributes: []nft.MetadataAttributeMapping{{TraitType: "color"}}}, nft.ErrInvalidMetadataMapping},
+		{"duplicate trait type", nft.ClassMetadataMapping{ClassId: "kitties", Attributes: []nft.MetadataAttributeMapping{{TraitType: "color", Pointer: "/color"}, {TraitType: "color", Pointer: "/colour"}}}, nft.ErrInvalidMetadataMapping},
+		{"too many attributes", nft.ClassMetadataMapping{ClassId: "kitties", Attributes: tooManyAttributes}, nft.ErrInvalidMetadataMapping},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.mapping.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestERC721Metadata(t *testing.T) {
+	class := nft.Class{Id: "kitties", Name: "Kitties", Description: "cute kitties"}
+	token := nft.NFT{ClassId: "kitties", Id: "1", Uri: "ipfs://kitty1"}
+	mapping := nft.ClassMetadataMapping{
+		ClassId:     "kitties",
+		Name:        "/name",
+		Image:       "/media/0/url",
+		ExternalUrl: "/links/a~1b",
+		Attributes: []nft.MetadataAttributeMapping{
+			{TraitType: "color", Pointer: "/color"},
+			{TraitType: "age", Pointer: "/age"},
+			{TraitType: "traits", Pointer: "/traits"},
+			{TraitType: "missing", Pointer: "/missing"},
+		},
+	}
+
+	// the defaults apply to the nfts of the classes without a mapping or without data
+	metadata, err := nft.ClassMetadataMapping{}.ERC721Metadata(class, token, []byte("null"))
+	require.NoError(t, err)
+	require.Equal(t, &nft.QueryERC721MetadataResponse{
+		Name:        "Kitties #1",
+		Description: "cute kitties",
+		Image:       "ipfs://kitty1",
+		Attributes:  []nft.ERC721Attribute{},
+	}, metadata)
+
+	metadata, err = mapping.ERC721Metadata(class, token, []byte("null"))
+	require.NoError(t, err)
+	require.Equal(t, "Kitties #1", metadata.Name)
+	require.Empty(t, metadata.Attributes)
+
+	metadata, err = mapping.ERC721Metadata(class, token, []byte(`{
+		"name": "Grumpy", "media": [{"url": "ipfs://grumpy"}], "links": {"a/b": "https://grumpy.cat"},
+		"color": "grey", "age": 3, "traits": {"mood": "grumpy"}, "missing": null
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, &nft.QueryERC721MetadataResponse{
+		Name:        "Grumpy",
+		Description: "cute kitties",
+		Image:       "ipfs://grumpy",
+		ExternalUrl: "https://grumpy.cat",
+		Attributes: []nft.ERC721Attribute{
+			{TraitType: "color", Value: "grey"},
+			{TraitType: "age", Value: "3"},
+			{TraitType: "traits", Value: `{"mood":"grumpy"}`},
+		},
+	}, metadata)
+
+	// out of range array indices resolve to nothing
+	metadata, err = mapping.ERC721Metadata(class, token, []byte(`{"media": []}`))
+	require.NoError(t, err)
+	require.Equal(t, "ipfs://kitty1", metadata.Image)
+
+	_, err = mapping.ERC721Metadata(class, token, []byte(`{`))
+	require.ErrorIs(t, err, nft.ErrInvalidNFTData)
+}
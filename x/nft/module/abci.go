@@ -0,0 +1,24 @@
+package module
+
+import (
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker removes the expired usage grants and releases the due timelocked transfers.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.RemoveExpiredUsageGrants(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.ReleaseTimelockedTransfers(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// AuctionEndBlocker settles the ended auctions.
+func AuctionEndBlocker(ctx sdk.Context, k keeper.AuctionKeeper) {
+	if err := k.SettleAuctions(ctx); err != nil {
+		panic(err)
+	}
+}
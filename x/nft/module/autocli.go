@@ -53,6 +53,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "class_id"},
 					},
 				},
+				{
+					RpcMethod: "NFTsOfOwner",
+					Use:       "nfts-of-owner [owner]",
+					Short:     "Query the NFTs of an owner across all classes, paginated with a cursor stable under transfers.",
+					Example:   fmt.Sprintf(`%s query %s nfts-of-owner <owner> --cursor=<next-cursor>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 				{
 					RpcMethod: "NFT",
 					Use:       "nft [class-id] [nft-id]",
@@ -75,8 +84,136 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "Classes",
 					Use:       "classes",
-					Short:     "Query all NFT classes.",
-					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
+					Short:     "Query all NFT classes, or the classes of an issuer.",
+					Example:   fmt.Sprintf(`%s query %s classes --issuer=<issuer>`, version.AppName, nft.ModuleName),
+				},
+				{
+					RpcMethod: "ClassDataSchema",
+					Use:       "class-data-schema [class-id]",
+					Short:     "Query the protobuf type the NFT data of a class must decode to.",
+					Example:   fmt.Sprintf(`%s query %s class-data-schema <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "ClassStats",
+					Use:       "class-stats [class-id]",
+					Short:     "Query the number of NFTs minted and burned and the number of unique holders of a class.",
+					Example:   fmt.Sprintf(`%s query %s class-stats <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "URI",
+					Use:       "uri [class-id]",
+					Short:     "Query the uri and uri hash of a class or of an NFT, with the height they were last updated at.",
+					Example:   fmt.Sprintf(`%s query %s uri <class-id> --id=<nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "UsageGrant",
+					Use:       "usage-grant [class-id] [nft-id]",
+					Short:     "Query the unexpired usage grant of an NFT.",
+					Example:   fmt.Sprintf(`%s query %s usage-grant <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ClassRoyalty",
+					Use:       "class-royalty [class-id]",
+					Short:     "Query the default royalty of the nfts of a class, split among its recipients.",
+					Example:   fmt.Sprintf(`%s query %s class-royalty <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "ClassTransferFee",
+					Use:       "class-transfer-fee [class-id]",
+					Short:     "Query the fee charged on the transfers of the nfts of a class.",
+					Example:   fmt.Sprintf(`%s query %s class-transfer-fee <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "NFTsByID",
+					Use:       "nfts-by-id [id]",
+					Short:     "Query the nfts with an id, or with an id starting with a prefix followed by *, across all classes.",
+					Example: fmt.Sprintf(`%s query %s nfts-by-id <id>
+%s query %s nfts-by-id '<id-prefix>*'`, version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ClassMetadataMapping",
+					Use:       "class-metadata-mapping [class-id]",
+					Short:     "Query the mapping of the data of the nfts of a class to ERC-721 metadata.",
+					Example:   fmt.Sprintf(`%s query %s class-metadata-mapping <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "ERC721Metadata",
+					Use:       "erc721-metadata [class-id] [nft-id]",
+					Short:     "Query the data of an NFT rendered as ERC-721 metadata, through the metadata mapping of its class.",
+					Example:   fmt.Sprintf(`%s query %s erc721-metadata <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ReservedClassPrefixes",
+					Use:       "reserved-class-prefixes",
+					Short:     "Query the class id prefixes reserved by governance.",
+					Example:   fmt.Sprintf(`%s query %s reserved-class-prefixes`, version.AppName, nft.ModuleName),
+				},
+				{
+					RpcMethod: "TimelockedTransfer",
+					Use:       "timelocked-transfer [class-id] [nft-id]",
+					Short:     "Query the pending timelocked transfer of an NFT.",
+					Example:   fmt.Sprintf(`%s query %s timelocked-transfer <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TimelockedTransfers",
+					Use:       "timelocked-transfers",
+					Short:     "Query all the pending timelocked transfers, or the ones of a sender and/or a receiver.",
+					Example:   fmt.Sprintf(`%s query %s timelocked-transfers --sender=<sender> --receiver=<receiver>`, version.AppName, nft.ModuleName),
+				},
+			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"auction": {
+					Service: nftv1beta1.AuctionQuery_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "Auction",
+							Use:       "auction [auction-id]",
+							Short:     "Query an open auction based on its id, with the minimum amount of the next bid.",
+							Example:   fmt.Sprintf(`%s query %s auction auction <auction-id>`, version.AppName, nft.ModuleName),
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "auction_id"},
+							},
+						},
+						{
+							RpcMethod: "Auctions",
+							Use:       "auctions",
+							Short:     "Query all the open auctions, or the auctions of a seller.",
+							Example:   fmt.Sprintf(`%s query %s auction auctions --seller=<seller>`, version.AppName, nft.ModuleName),
+						},
+					},
 				},
 			},
 		},
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -21,6 +22,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 
@@ -52,6 +55,24 @@ func (AppModuleBasic) Name() string {
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	nft.RegisterMsgServer(registrar, am.keeper)
 	nft.RegisterQueryServer(registrar, am.keeper)
+	if am.auctionKeeper != nil {
+		nft.RegisterAuctionMsgServer(registrar, *am.auctionKeeper)
+		nft.RegisterAuctionQueryServer(registrar, *am.auctionKeeper)
+	}
+
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(nft.ModuleName, 1, m.Migrate1to2); err != nil {
+			return errors.Wrapf(err, "failed to migrate x/%s from version 1 to 2", nft.ModuleName)
+		}
+		if err := cfg.RegisterMigration(nft.ModuleName, 2, m.Migrate2to3); err != nil {
+			return errors.Wrapf(err, "failed to migrate x/%s from version 2 to 3", nft.ModuleName)
+		}
+		if err := cfg.RegisterMigration(nft.ModuleName, 3, m.Migrate3to4); err != nil {
+			return errors.Wrapf(err, "failed to migrate x/%s from version 3 to 4", nft.ModuleName)
+		}
+	}
+
 	return nil
 }
 
@@ -84,6 +105,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux
 	if err := nft.RegisterQueryHandlerClient(context.Background(), mux, nft.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := nft.RegisterAuctionQueryHandlerClient(context.Background(), mux, nft.NewAuctionQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetQueryCmd returns a no-op command for the nft module.
@@ -101,6 +125,8 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 	keeper keeper.Keeper
+	// the optional auctions, disabled if nil
+	auctionKeeper *keeper.AuctionKeeper
 	// TODO accountKeeper,bankKeeper will be replaced by query service
 	accountKeeper nft.AccountKeeper
 	bankKeeper    nft.BankKeeper
@@ -118,9 +144,17 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak nft.AccountKeeper, b
 	}
 }
 
+// WithAuctionKeeper returns the module with the auctions of nfts enabled, their
+// Msg's and queries being served and their settlement run by the end blocker.
+func (am AppModule) WithAuctionKeeper(k keeper.AuctionKeeper) AppModule {
+	am.auctionKeeper = &k
+	return am
+}
+
 var (
-	_ appmodule.AppModule   = AppModule{}
-	_ appmodule.HasServices = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasServices   = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -151,7 +185,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// EndBlock returns the end blocker for the nft module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	EndBlocker(c, am.keeper)
+	if am.auctionKeeper != nil {
+		AuctionEndBlocker(c, *am.auctionKeeper)
+	}
+	return nil
+}
 
 // ____________________________________________________________________________
 
@@ -183,18 +228,23 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetNFTHooks),
 	)
 }
 
 type NftInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	StoreService store.KVStoreService
 	Cdc          codec.Codec
 	Registry     cdctypes.InterfaceRegistry
 
-	AccountKeeper nft.AccountKeeper
-	BankKeeper    nft.BankKeeper
+	AccountKeeper       nft.AccountKeeper
+	BankKeeper          nft.BankKeeper
+	AuctionBankKeeper   nft.AuctionBankKeeper   `optional:"true"`
+	CircuitBreaker      nft.CircuitBreaker      `optional:"true"`
+	CommunityPoolKeeper nft.CommunityPoolKeeper `optional:"true"`
 }
 
 type NftOutputs struct {
@@ -205,8 +255,43 @@ type NftOutputs struct {
 }
 
 func ProvideModule(in NftInputs) NftOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// the uri of classes can be updated by the governance module
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper, authority.String())
+	if in.CircuitBreaker != nil {
+		k.SetCircuitBreaker(in.CircuitBreaker)
+	}
+	if in.CommunityPoolKeeper != nil {
+		k.SetCommunityPoolKeeper(in.CommunityPoolKeeper)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
+	if in.Config.EnableAuctions {
+		if in.AuctionBankKeeper == nil {
+			panic("the nft auctions require a bank keeper able to escrow the bids")
+		}
+		m = m.WithAuctionKeeper(keeper.NewAuctionKeeper(k, in.AccountKeeper, in.AuctionBankKeeper))
+	}
 
 	return NftOutputs{NFTKeeper: k, Module: m}
 }
+
+// InvokeSetNFTHooks sets the nft hooks provided by the other modules, called in
+// the alphabetical order of the module names.
+func InvokeSetNFTHooks(k keeper.Keeper, nftHooks map[string]nft.NFTHooksWrapper) {
+	if len(nftHooks) == 0 {
+		return
+	}
+
+	modNames := make([]string, 0, len(nftHooks))
+	for modName := range nftHooks {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	multiHooks := make(nft.MultiNFTHooks, 0, len(modNames))
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, nftHooks[modName])
+	}
+	k.SetHooks(multiHooks)
+}
@@ -1,18 +1,418 @@
 package nft
 
 import (
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
 	// TypeMsgSend nft message types
 	TypeMsgSend = "send"
+	// TypeMsgUpdateURI nft message types
+	TypeMsgUpdateURI = "update_uri"
+	// TypeMsgGrantUse nft message types
+	TypeMsgGrantUse = "grant_use"
+	// TypeMsgRevoke nft message types
+	TypeMsgRevoke = "revoke"
+	// TypeMsgFreezeClass nft message types
+	TypeMsgFreezeClass = "freeze_class"
+	// TypeMsgUnfreezeClass nft message types
+	TypeMsgUnfreezeClass = "unfreeze_class"
+	// TypeMsgReserveClassPrefix nft message types
+	TypeMsgReserveClassPrefix = "reserve_class_prefix"
+	// TypeMsgReleaseClassPrefix nft message types
+	TypeMsgReleaseClassPrefix = "release_class_prefix"
+	// TypeMsgCreateAuction nft message types
+	TypeMsgCreateAuction = "create_auction"
+	// TypeMsgBid nft message types
+	TypeMsgBid = "bid"
+	// TypeMsgCancelAuction nft message types
+	TypeMsgCancelAuction = "cancel_auction"
+	// TypeMsgSetClassRoyalty nft message types
+	TypeMsgSetClassRoyalty = "set_class_royalty"
+	// TypeMsgImportCw721 nft message types
+	TypeMsgImportCw721 = "import_cw721"
+	// TypeMsgSetClassMetadataMapping nft message types
+	TypeMsgSetClassMetadataMapping = "set_class_metadata_mapping"
+	// TypeMsgTransferWithTimelock nft message types
+	TypeMsgTransferWithTimelock = "transfer_with_timelock"
+	// TypeMsgReclaimTimelockedTransfer nft message types
+	TypeMsgReclaimTimelockedTransfer = "reclaim_timelocked_transfer"
+	// TypeMsgSetClassTransferFee nft message types
+	TypeMsgSetClassTransferFee = "set_class_transfer_fee"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgUpdateURI{}
+	_ sdk.Msg = &MsgGrantUse{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgFreezeClass{}
+	_ sdk.Msg = &MsgUnfreezeClass{}
+	_ sdk.Msg = &MsgReserveClassPrefix{}
+	_ sdk.Msg = &MsgReleaseClassPrefix{}
+	_ sdk.Msg = &MsgCreateAuction{}
+	_ sdk.Msg = &MsgBid{}
+	_ sdk.Msg = &MsgCancelAuction{}
+	_ sdk.Msg = &MsgSetClassRoyalty{}
+	_ sdk.Msg = &MsgImportCw721{}
+	_ sdk.Msg = &MsgSetClassMetadataMapping{}
+	_ sdk.Msg = &MsgTransferWithTimelock{}
+	_ sdk.Msg = &MsgReclaimTimelockedTransfer{}
+	_ sdk.Msg = &MsgSetClassTransferFee{}
+)
 
 // GetSigners returns the expected signers for MsgSend.
 func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// GetSigners returns the expected signers for MsgUpdateURI.
+func (m MsgUpdateURI) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgGrantUse.
+func (m MsgGrantUse) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgRevoke.
+func (m MsgRevoke) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgFreezeClass.
+func (m MsgFreezeClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgUnfreezeClass.
+func (m MsgUnfreezeClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgReserveClassPrefix.
+func (m MsgReserveClassPrefix) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgReleaseClassPrefix.
+func (m MsgReleaseClassPrefix) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgImportCw721.
+func (m MsgImportCw721) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgSetClassMetadataMapping.
+func (m MsgSetClassMetadataMapping) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgCreateAuction.
+func (m MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgBid.
+func (m MsgBid) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgCancelAuction.
+func (m MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgSetClassRoyalty.
+func (m MsgSetClassRoyalty) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgTransferWithTimelock.
+func (m MsgTransferWithTimelock) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgReclaimTimelockedTransfer.
+func (m MsgReclaimTimelockedTransfer) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgSetClassTransferFee.
+func (m MsgSetClassTransferFee) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic, so that the messages with unbounded or malformed fields, including the
+// ones executed on behalf of interchain accounts, are rejected before reaching the keeper.
+func (m MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.Id); err != nil {
+		return err
+	}
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	return validateAddress("receiver", m.Receiver)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. The nft id is empty when updating the uri of the class.
+func (m MsgUpdateURI) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if len(m.Id) > 0 {
+		if err := ValidateNFTID(m.Id); err != nil {
+			return err
+		}
+	}
+	if err := ValidateURI(m.Uri); err != nil {
+		return err
+	}
+	return ValidateURIHash(m.UriHash)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgGrantUse) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.Id); err != nil {
+		return err
+	}
+	if err := validateAddress("grantee", m.Grantee); err != nil {
+		return err
+	}
+	if m.Sender == m.Grantee {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot grant the usage of a nft to its owner")
+	}
+	if m.Expiry.IsZero() {
+		return errors.Wrap(ErrInvalidUsageExpiry, "missing expiry")
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgRevoke) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	return ValidateNFTID(m.Id)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgFreezeClass) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	return ValidateClassID(m.ClassId)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgUnfreezeClass) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	return ValidateClassID(m.ClassId)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. The prefix must be a valid class id, so that it can be matched by
+// the ids of the classes it reserves.
+func (m MsgReserveClassPrefix) ValidateBasic() error {
+	if err := validateAddress("authority", m.Authority); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.Prefix); err != nil {
+		return errors.Wrap(ErrInvalidClassPrefix, err.Error())
+	}
+	if len(m.Owner) > 0 {
+		return validateAddress("owner", m.Owner)
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgReleaseClassPrefix) ValidateBasic() error {
+	if err := validateAddress("authority", m.Authority); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.Prefix); err != nil {
+		return errors.Wrap(ErrInvalidClassPrefix, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgCreateAuction) ValidateBasic() error {
+	if err := validateAddress("seller", m.Seller); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.NftId); err != nil {
+		return err
+	}
+	if !m.StartPrice.IsValid() || !m.StartPrice.IsPositive() {
+		return ErrInvalidAuction.Wrapf("invalid start price %s", m.StartPrice)
+	}
+	if m.Duration <= 0 || m.Duration > MaxAuctionDuration {
+		return ErrInvalidAuction.Wrapf("duration %s must be positive and at most %s", m.Duration, MaxAuctionDuration)
+	}
+
+	switch m.AuctionType {
+	case AuctionTypeEnglish:
+		if !IsUnsetCoin(m.EndPrice) {
+			return ErrInvalidAuction.Wrap("english auction cannot have an end price")
+		}
+	case AuctionTypeDutch:
+		if !m.EndPrice.IsValid() || m.EndPrice.Denom != m.StartPrice.Denom || !m.EndPrice.IsLT(m.StartPrice) {
+			return ErrInvalidAuction.Wrapf("end price %s must be lower than the start price %s", m.EndPrice, m.StartPrice)
+		}
+	default:
+		return ErrInvalidAuction.Wrapf("invalid auction type %s", m.AuctionType)
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgBid) ValidateBasic() error {
+	if err := validateAddress("bidder", m.Bidder); err != nil {
+		return err
+	}
+	if m.AuctionId == 0 {
+		return ErrInvalidBid.Wrap("zero auction id")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return ErrInvalidBid.Wrapf("invalid amount %s", m.Amount)
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgCancelAuction) ValidateBasic() error {
+	if err := validateAddress("seller", m.Seller); err != nil {
+		return err
+	}
+	if m.AuctionId == 0 {
+		return ErrInvalidAuction.Wrap("zero auction id")
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. A message without recipients removes the royalty of the class.
+func (m MsgSetClassRoyalty) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if len(m.Recipients) == 0 {
+		return nil
+	}
+
+	royalty := ClassRoyalty{ClassId: m.ClassId, Rate: m.Rate, Recipients: m.Recipients}
+	return royalty.Validate(address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()))
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgImportCw721) ValidateBasic() error {
+	if err := validateAddress("authority", m.Authority); err != nil {
+		return err
+	}
+	if len(m.Collections) == 0 {
+		return ErrInvalidCw721Snapshot.Wrap("no collections")
+	}
+	return validateCw721Collections(m.Collections, address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()))
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. A message with a mapping mapping nothing removes the mapping of the
+// class.
+func (m MsgSetClassMetadataMapping) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	return m.Mapping.Validate()
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. Exactly one of the release height and the release time must be set.
+func (m MsgTransferWithTimelock) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.Id); err != nil {
+		return err
+	}
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := validateAddress("receiver", m.Receiver); err != nil {
+		return err
+	}
+	if m.Sender == m.Receiver {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot transfer a nft with a timelock to its owner")
+	}
+	return validateRelease(m.ReleaseHeight, m.ReleaseTime)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (m MsgReclaimTimelockedTransfer) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	return ValidateNFTID(m.Id)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic. A message with a fee having neither a flat fee nor a rate removes the
+// transfer fee of the class.
+func (m MsgSetClassTransferFee) ValidateBasic() error {
+	if err := validateAddress("sender", m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateClassID(m.Fee.ClassId); err != nil {
+		return err
+	}
+	if m.Fee.IsEmpty() {
+		return nil
+	}
+	return m.Fee.Validate()
+}
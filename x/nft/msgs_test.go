@@ -0,0 +1,90 @@
+package nft_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestValidateClassID(t *testing.T) {
+	require.NoError(t, nft.ValidateClassID("kitty"))
+	require.NoError(t, nft.ValidateClassID("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+	require.NoError(t, nft.ValidateClassID("collection:kitty_1.v2-final"))
+	require.ErrorIs(t, nft.ValidateClassID(""), nft.ErrEmptyClassID)
+	require.ErrorIs(t, nft.ValidateClassID("1kitty"), nft.ErrInvalidClassID)
+	require.ErrorIs(t, nft.ValidateClassID("kitty cat"), nft.ErrInvalidClassID)
+	require.ErrorIs(t, nft.ValidateClassID("kitty\x00"), nft.ErrInvalidClassID)
+	require.ErrorIs(t, nft.ValidateClassID("k"+strings.Repeat("a", nft.MaxClassIDLength)), nft.ErrInvalidClassID)
+}
+
+func TestValidateURI(t *testing.T) {
+	for _, uri := range []string{"", "https://kitty.io/1.json", "HTTP://kitty.io", "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", "ar://kitty"} {
+		require.NoError(t, nft.ValidateURI(uri), uri)
+	}
+	for _, uri := range []string{"kitty.io", "data:application/json;base64,e30=", "javascript:alert(1)", "https://kitty.io/" + strings.Repeat("a", nft.MaxURILength), "https://kitty.io/%zz"} {
+		require.ErrorIs(t, nft.ValidateURI(uri), nft.ErrInvalidURI, uri)
+	}
+}
+
+func TestMsgsValidateBasic(t *testing.T) {
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+	uriHash := nft.URIHash([]byte("kitty"))
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin("stake", amount) }
+	oneRecipient := []nft.RoyaltyRecipient{{Address: bob, Weight: math.LegacyOneDec()}}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.HasValidateBasic
+		expErr error
+	}{
+		{"send", &nft.MsgSend{ClassId: "kitty", Id: "kitty1", Sender: alice, Receiver: bob}, nil},
+		{"send invalid class id", &nft.MsgSend{ClassId: "kitty\n", Id: "kitty1", Sender: alice, Receiver: bob}, nft.ErrInvalidClassID},
+		{"send too long nft id", &nft.MsgSend{ClassId: "kitty", Id: strings.Repeat("a", nft.MaxNFTIDLength+1), Sender: alice, Receiver: bob}, nft.ErrInvalidNFTID},
+		{"send invalid receiver", &nft.MsgSend{ClassId: "kitty", Id: "kitty1", Sender: alice, Receiver: "bob"}, sdkerrors.ErrInvalidAddress},
+		{"update class uri", &nft.MsgUpdateURI{Sender: alice, ClassId: "kitty", Uri: "ipfs://kitty", UriHash: uriHash}, nil},
+		{"update uri with unknown scheme", &nft.MsgUpdateURI{Sender: alice, ClassId: "kitty", Id: "kitty1", Uri: "ftp://kitty", UriHash: uriHash}, nft.ErrInvalidURI},
+		{"update uri without hash", &nft.MsgUpdateURI{Sender: alice, ClassId: "kitty", Id: "kitty1", Uri: "ipfs://kitty"}, nft.ErrInvalidURIHash},
+		{"grant use", &nft.MsgGrantUse{Sender: alice, ClassId: "kitty", Id: "kitty1", Grantee: bob, Expiry: time.Unix(1, 0)}, nil},
+		{"grant use to self", &nft.MsgGrantUse{Sender: alice, ClassId: "kitty", Id: "kitty1", Grantee: alice, Expiry: time.Unix(1, 0)}, sdkerrors.ErrInvalidRequest},
+		{"grant use without expiry", &nft.MsgGrantUse{Sender: alice, ClassId: "kitty", Id: "kitty1", Grantee: bob}, nft.ErrInvalidUsageExpiry},
+		{"revoke empty nft id", &nft.MsgRevoke{Sender: alice, ClassId: "kitty"}, nft.ErrEmptyNFTID},
+		{"freeze class", &nft.MsgFreezeClass{Sender: alice, ClassId: "kitty"}, nil},
+		{"unfreeze empty class id", &nft.MsgUnfreezeClass{Sender: alice}, nft.ErrEmptyClassID},
+		{"reserve class prefix", &nft.MsgReserveClassPrefix{Authority: alice, Prefix: "kitty/", Owner: bob}, nil},
+		{"reserve invalid class prefix", &nft.MsgReserveClassPrefix{Authority: alice, Prefix: "/kitty"}, nft.ErrInvalidClassPrefix},
+		{"release class prefix invalid authority", &nft.MsgReleaseClassPrefix{Authority: "gov", Prefix: "kitty/"}, sdkerrors.ErrInvalidAddress},
+		{"create english auction", &nft.MsgCreateAuction{Seller: alice, ClassId: "kitty", NftId: "kitty1", AuctionType: nft.AuctionTypeEnglish, StartPrice: stake(10), Duration: time.Hour}, nil},
+		{"create dutch auction", &nft.MsgCreateAuction{Seller: alice, ClassId: "kitty", NftId: "kitty1", AuctionType: nft.AuctionTypeDutch, StartPrice: stake(10), EndPrice: stake(1), Duration: time.Hour}, nil},
+		{"create auction too long", &nft.MsgCreateAuction{Seller: alice, ClassId: "kitty", NftId: "kitty1", AuctionType: nft.AuctionTypeEnglish, StartPrice: stake(10), Duration: nft.MaxAuctionDuration + 1}, nft.ErrInvalidAuction},
+		{"create dutch auction rising", &nft.MsgCreateAuction{Seller: alice, ClassId: "kitty", NftId: "kitty1", AuctionType: nft.AuctionTypeDutch, StartPrice: stake(10), EndPrice: stake(20), Duration: time.Hour}, nft.ErrInvalidAuction},
+		{"bid", &nft.MsgBid{Bidder: bob, AuctionId: 1, Amount: stake(10)}, nil},
+		{"bid zero amount", &nft.MsgBid{Bidder: bob, AuctionId: 1, Amount: stake(0)}, nft.ErrInvalidBid},
+		{"cancel auction zero id", &nft.MsgCancelAuction{Seller: alice}, nft.ErrInvalidAuction},
+		{"set class royalty", &nft.MsgSetClassRoyalty{Sender: alice, ClassId: "kitty", Rate: math.LegacyNewDecWithPrec(5, 2), Recipients: oneRecipient}, nil},
+		{"remove class royalty", &nft.MsgSetClassRoyalty{Sender: alice, ClassId: "kitty", Rate: math.LegacyZeroDec()}, nil},
+		{"set class royalty above 1", &nft.MsgSetClassRoyalty{Sender: alice, ClassId: "kitty", Rate: math.LegacyNewDec(2), Recipients: oneRecipient}, nft.ErrInvalidRoyalty},
+		{"set class transfer fee", &nft.MsgSetClassTransferFee{Sender: alice, Fee: nft.ClassTransferFee{ClassId: "kitty", Flat: sdk.NewCoins(stake(1)), Destination: nft.TransferFeeDestinationClassAdmin}}, nil},
+		{"remove class transfer fee", &nft.MsgSetClassTransferFee{Sender: alice, Fee: nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyZeroDec()}}, nil},
+		{"set class transfer fee without destination", &nft.MsgSetClassTransferFee{Sender: alice, Fee: nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyNewDecWithPrec(5, 2)}}, nft.ErrInvalidTransferFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,18 +4,27 @@
 package nft
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +32,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferFeeDestination defines the account collecting the transfer fees of a class.
+type TransferFeeDestination int32
+
+const (
+	// TRANSFER_FEE_DESTINATION_UNSPECIFIED defines an invalid destination.
+	TransferFeeDestinationUnspecified TransferFeeDestination = 0
+	// TRANSFER_FEE_DESTINATION_CLASS_ADMIN routes the transfer fees to the admin of the class.
+	TransferFeeDestinationClassAdmin TransferFeeDestination = 1
+	// TRANSFER_FEE_DESTINATION_COMMUNITY_POOL routes the transfer fees to the community pool.
+	TransferFeeDestinationCommunityPool TransferFeeDestination = 2
+)
+
+var TransferFeeDestination_name = map[int32]string{
+	0: "TRANSFER_FEE_DESTINATION_UNSPECIFIED",
+	1: "TRANSFER_FEE_DESTINATION_CLASS_ADMIN",
+	2: "TRANSFER_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var TransferFeeDestination_value = map[string]int32{
+	"TRANSFER_FEE_DESTINATION_UNSPECIFIED":    0,
+	"TRANSFER_FEE_DESTINATION_CLASS_ADMIN":    1,
+	"TRANSFER_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x TransferFeeDestination) String() string {
+	return proto.EnumName(TransferFeeDestination_name, int32(x))
+}
+
+func (TransferFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -39,6 +80,16 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// non_transferable defines whether the NFTs of the class are soulbound, i.e. cannot change owner once minted.
+	// Optional
+	NonTransferable bool `protobuf:"varint,8,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	// issuer is the address allowed to revoke the NFTs of the class by burning them. Revocation is disabled if empty.
+	// Optional
+	Issuer string `protobuf:"bytes,9,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// admin is the address allowed to freeze and unfreeze the class. Freezing is disabled if empty. Optional
+	Admin string `protobuf:"bytes,10,opt,name=admin,proto3" json:"admin,omitempty"`
+	// frozen defines whether the class is frozen, i.e. its NFTs can only be minted, transferred or burned by its admin
+	Frozen bool `protobuf:"varint,11,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +174,34 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
+func (m *Class) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Class) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Class) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -205,261 +284,3742 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
-	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+// ClassDataSchema binds an NFT class to the protobuf type its tokens' `Data` must decode to.
+type ClassDataSchema struct {
+	// class_id associated with the schema
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// type_url is the protobuf type URL, resolvable by the app's interface registry, that the `Data` of every NFT
+	// of the class must be packed with
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
+func (m *ClassDataSchema) Reset()         { *m = ClassDataSchema{} }
+func (m *ClassDataSchema) String() string { return proto.CompactTextString(m) }
+func (*ClassDataSchema) ProtoMessage()    {}
+func (*ClassDataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassDataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassDataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassDataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassDataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassDataSchema.Merge(m, src)
+}
+func (m *ClassDataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassDataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassDataSchema.DiscardUnknown(m)
+}
 
-var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+var xxx_messageInfo_ClassDataSchema proto.InternalMessageInfo
+
+func (m *ClassDataSchema) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
 }
 
-func (m *Class) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClassDataSchema) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Class) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClassJSONSchema binds an NFT class to a JSON schema that the JSON form of the `Data` of the class and of its NFTs
+// must conform to.
+type ClassJSONSchema struct {
+	// class_id associated with the schema
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// hash is the sha256 hash of the JSON schema document
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// schema is the inline JSON schema document, which must hash to hash. Optional, the `Data` payloads are only
+	// validated against the schema when it is set
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintNft(dAtA, i, uint64(size))
+func (m *ClassJSONSchema) Reset()         { *m = ClassJSONSchema{} }
+func (m *ClassJSONSchema) String() string { return proto.CompactTextString(m) }
+func (*ClassJSONSchema) ProtoMessage()    {}
+func (*ClassJSONSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{3}
+}
+func (m *ClassJSONSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassJSONSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassJSONSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ClassJSONSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassJSONSchema.Merge(m, src)
+}
+func (m *ClassJSONSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassJSONSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassJSONSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassJSONSchema proto.InternalMessageInfo
+
+func (m *ClassJSONSchema) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ClassJSONSchema) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *NFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClassJSONSchema) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClassStats defines the supply and holder statistics of an NFT class. They are maintained incrementally on every
+// mint, transfer and burn, so that they can be queried without iterating the NFTs of the class.
+type ClassStats struct {
+	// class_id associated with the statistics
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// total_minted is the number of NFTs ever minted in the class
+	TotalMinted uint64 `protobuf:"varint,2,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	// total_burned is the number of NFTs ever burned in the class
+	TotalBurned uint64 `protobuf:"varint,3,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
+	// holders is the number of unique accounts currently owning at least one NFT of the class
+	Holders uint64 `protobuf:"varint,4,opt,name=holders,proto3" json:"holders,omitempty"`
 }
 
-func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintNft(dAtA, i, uint64(size))
+func (m *ClassStats) Reset()         { *m = ClassStats{} }
+func (m *ClassStats) String() string { return proto.CompactTextString(m) }
+func (*ClassStats) ProtoMessage()    {}
+func (*ClassStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{4}
+}
+func (m *ClassStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ClassStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassStats.Merge(m, src)
+}
+func (m *ClassStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassStats.DiscardUnknown(m)
 }
 
-func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNft(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ClassStats proto.InternalMessageInfo
+
+func (m *ClassStats) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Class) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ClassStats) GetTotalMinted() uint64 {
+	if m != nil {
+		return m.TotalMinted
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	return 0
+}
+
+func (m *ClassStats) GetTotalBurned() uint64 {
+	if m != nil {
+		return m.TotalBurned
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	return 0
+}
+
+func (m *ClassStats) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	return 0
+}
+
+// UsageGrant defines a time-boxed grant of the usage of an NFT to an account other than its owner, e.g. to rent it
+// out. It does not give the grantee any right over the NFT itself, and it is removed when the NFT changes owner, is
+// burned, or expires.
+type UsageGrant struct {
+	// class_id associated with the NFT
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// grantee is the address of the account allowed to use the NFT
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// expiry is the time at which the grant expires
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *UsageGrant) Reset()         { *m = UsageGrant{} }
+func (m *UsageGrant) String() string { return proto.CompactTextString(m) }
+func (*UsageGrant) ProtoMessage()    {}
+func (*UsageGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{5}
+}
+func (m *UsageGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+}
+func (m *UsageGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageGrant.Merge(m, src)
+}
+func (m *UsageGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageGrant proto.InternalMessageInfo
+
+func (m *UsageGrant) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	return ""
+}
+
+func (m *UsageGrant) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	return ""
+}
+
+func (m *UsageGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovNft(uint64(l))
+	return ""
+}
+
+func (m *UsageGrant) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// ReservedClassPrefix reserves the class ids starting with a prefix, e.g. `ibc/` or a chain-branded namespace, so that
+// only its owner can create classes under it.
+type ReservedClassPrefix struct {
+	// prefix is the beginning of the reserved class ids
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// owner is the address of the only account allowed to create classes whose id starts with the prefix. No class can
+	// be created under the prefix if it is empty. Optional
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *ReservedClassPrefix) Reset()         { *m = ReservedClassPrefix{} }
+func (m *ReservedClassPrefix) String() string { return proto.CompactTextString(m) }
+func (*ReservedClassPrefix) ProtoMessage()    {}
+func (*ReservedClassPrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{6}
+}
+func (m *ReservedClassPrefix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedClassPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedClassPrefix.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedClassPrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedClassPrefix.Merge(m, src)
+}
+func (m *ReservedClassPrefix) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedClassPrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedClassPrefix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedClassPrefix proto.InternalMessageInfo
+
+func (m *ReservedClassPrefix) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ReservedClassPrefix) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// ClassRoyalty defines the default royalty of the nfts of a class, split among weighted recipients. The royalty is
+// not enforced by the module: the modules selling the nfts, e.g. marketplaces, compute and pay it through the keeper.
+type ClassRoyalty struct {
+	// class_id associated with the royalty
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// rate is the fraction of the sale price of a nft paid as royalty, between 0 and 1
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// recipients are the recipients of the royalty, whose weights sum to 1
+	Recipients []RoyaltyRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *ClassRoyalty) Reset()         { *m = ClassRoyalty{} }
+func (m *ClassRoyalty) String() string { return proto.CompactTextString(m) }
+func (*ClassRoyalty) ProtoMessage()    {}
+func (*ClassRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{7}
+}
+func (m *ClassRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassRoyalty.Merge(m, src)
+}
+func (m *ClassRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassRoyalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassRoyalty proto.InternalMessageInfo
+
+func (m *ClassRoyalty) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassRoyalty) GetRecipients() []RoyaltyRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// RoyaltyRecipient defines a recipient of a royalty and its share of it.
+type RoyaltyRecipient struct {
+	// address is the address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of the royalty paid to the recipient, between 0 exclusive and 1
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *RoyaltyRecipient) Reset()         { *m = RoyaltyRecipient{} }
+func (m *RoyaltyRecipient) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyRecipient) ProtoMessage()    {}
+func (*RoyaltyRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{8}
+}
+func (m *RoyaltyRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyRecipient.Merge(m, src)
+}
+func (m *RoyaltyRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyRecipient proto.InternalMessageInfo
+
+func (m *RoyaltyRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ClassMetadataMapping defines how the nfts of a class are rendered as ERC-721 metadata JSON, e.g. for marketplaces.
+// Its fields are JSON pointers into the JSON form of the data of a nft, the extension of the nfts imported from cw721
+// contracts. The values which are not mapped, or missing from the data, fall back to the class and the nft.
+type ClassMetadataMapping struct {
+	// class_id associated with the mapping
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// name is the JSON pointer of the name of a nft, "<class name> #<nft id>" by default
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description is the JSON pointer of the description of a nft, the description of the class by default
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// image is the JSON pointer of the image of a nft, the uri of the nft by default
+	Image string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	// external_url is the JSON pointer of the external url of a nft, empty by default
+	ExternalUrl string `protobuf:"bytes,5,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	// attributes are the traits of a nft, in order, the ones missing from the data being omitted
+	Attributes []MetadataAttributeMapping `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes"`
+}
+
+func (m *ClassMetadataMapping) Reset()         { *m = ClassMetadataMapping{} }
+func (m *ClassMetadataMapping) String() string { return proto.CompactTextString(m) }
+func (*ClassMetadataMapping) ProtoMessage()    {}
+func (*ClassMetadataMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{9}
+}
+func (m *ClassMetadataMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassMetadataMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassMetadataMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassMetadataMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassMetadataMapping.Merge(m, src)
+}
+func (m *ClassMetadataMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassMetadataMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassMetadataMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassMetadataMapping proto.InternalMessageInfo
+
+func (m *ClassMetadataMapping) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassMetadataMapping) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClassMetadataMapping) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ClassMetadataMapping) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ClassMetadataMapping) GetExternalUrl() string {
+	if m != nil {
+		return m.ExternalUrl
+	}
+	return ""
+}
+
+func (m *ClassMetadataMapping) GetAttributes() []MetadataAttributeMapping {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// MetadataAttributeMapping defines a trait of the ERC-721 metadata of the nfts of a class.
+type MetadataAttributeMapping struct {
+	// trait_type is the name of the trait
+	TraitType string `protobuf:"bytes,1,opt,name=trait_type,json=traitType,proto3" json:"trait_type,omitempty"`
+	// pointer is the JSON pointer of the value of the trait in the data of a nft
+	Pointer string `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
+}
+
+func (m *MetadataAttributeMapping) Reset()         { *m = MetadataAttributeMapping{} }
+func (m *MetadataAttributeMapping) String() string { return proto.CompactTextString(m) }
+func (*MetadataAttributeMapping) ProtoMessage()    {}
+func (*MetadataAttributeMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{10}
+}
+func (m *MetadataAttributeMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataAttributeMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataAttributeMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataAttributeMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataAttributeMapping.Merge(m, src)
+}
+func (m *MetadataAttributeMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataAttributeMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataAttributeMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataAttributeMapping proto.InternalMessageInfo
+
+func (m *MetadataAttributeMapping) GetTraitType() string {
+	if m != nil {
+		return m.TraitType
+	}
+	return ""
+}
+
+func (m *MetadataAttributeMapping) GetPointer() string {
+	if m != nil {
+		return m.Pointer
+	}
+	return ""
+}
+
+// TimelockedTransfer defines a nft escrowed by the module until it is released to its receiver at a given height or
+// time, unless its sender reclaims it before.
+type TimelockedTransfer struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the owner of the nft when it was transferred, who may reclaim it until it is released
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver is the address the nft is released to
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// release_height is the block height at which the nft is released, zero if it is released at a time
+	ReleaseHeight int64 `protobuf:"varint,5,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// release_time is the time at which the nft is released, unset if it is released at a height
+	ReleaseTime *time.Time `protobuf:"bytes,6,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time,omitempty"`
+	// failed_releases is the number of times the release of the nft failed, e.g. because its class was paused
+	FailedReleases uint32 `protobuf:"varint,7,opt,name=failed_releases,json=failedReleases,proto3" json:"failed_releases,omitempty"`
+	// retry_height is the block height at which the release is retried after it failed, zero if it never failed
+	RetryHeight int64 `protobuf:"varint,8,opt,name=retry_height,json=retryHeight,proto3" json:"retry_height,omitempty"`
+}
+
+func (m *TimelockedTransfer) Reset()         { *m = TimelockedTransfer{} }
+func (m *TimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*TimelockedTransfer) ProtoMessage()    {}
+func (*TimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{11}
+}
+func (m *TimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedTransfer.Merge(m, src)
+}
+func (m *TimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedTransfer proto.InternalMessageInfo
+
+func (m *TimelockedTransfer) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *TimelockedTransfer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TimelockedTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TimelockedTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TimelockedTransfer) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *TimelockedTransfer) GetReleaseTime() *time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return nil
+}
+
+func (m *TimelockedTransfer) GetFailedReleases() uint32 {
+	if m != nil {
+		return m.FailedReleases
+	}
+	return 0
+}
+
+func (m *TimelockedTransfer) GetRetryHeight() int64 {
+	if m != nil {
+		return m.RetryHeight
+	}
+	return 0
+}
+
+// ClassTransferFee defines the fee charged on the transfers of the nfts of a class, either a flat fee or a percentage
+// of the sale price. The flat fee is charged to the sender of every Msg/Send, while the percentage fee is due on sales
+// and, like the royalty, is paid by the modules selling the nfts through the keeper.
+type ClassTransferFee struct {
+	// class_id associated with the fee
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// flat is the fee charged on every transfer. Exactly one of flat and rate must be set
+	Flat github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=flat,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat"`
+	// rate is the fraction of the sale price of a nft charged as fee, between 0 exclusive and 1
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// destination is the account collecting the fees
+	Destination TransferFeeDestination `protobuf:"varint,4,opt,name=destination,proto3,enum=cosmos.nft.v1beta1.TransferFeeDestination" json:"destination,omitempty"`
+}
+
+func (m *ClassTransferFee) Reset()         { *m = ClassTransferFee{} }
+func (m *ClassTransferFee) String() string { return proto.CompactTextString(m) }
+func (*ClassTransferFee) ProtoMessage()    {}
+func (*ClassTransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{12}
+}
+func (m *ClassTransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassTransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassTransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassTransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassTransferFee.Merge(m, src)
+}
+func (m *ClassTransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassTransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassTransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassTransferFee proto.InternalMessageInfo
+
+func (m *ClassTransferFee) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassTransferFee) GetFlat() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Flat
+	}
+	return nil
+}
+
+func (m *ClassTransferFee) GetDestination() TransferFeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return TransferFeeDestinationUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.TransferFeeDestination", TransferFeeDestination_name, TransferFeeDestination_value)
+	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassDataSchema)(nil), "cosmos.nft.v1beta1.ClassDataSchema")
+	proto.RegisterType((*ClassJSONSchema)(nil), "cosmos.nft.v1beta1.ClassJSONSchema")
+	proto.RegisterType((*ClassStats)(nil), "cosmos.nft.v1beta1.ClassStats")
+	proto.RegisterType((*UsageGrant)(nil), "cosmos.nft.v1beta1.UsageGrant")
+	proto.RegisterType((*ReservedClassPrefix)(nil), "cosmos.nft.v1beta1.ReservedClassPrefix")
+	proto.RegisterType((*ClassRoyalty)(nil), "cosmos.nft.v1beta1.ClassRoyalty")
+	proto.RegisterType((*RoyaltyRecipient)(nil), "cosmos.nft.v1beta1.RoyaltyRecipient")
+	proto.RegisterType((*ClassMetadataMapping)(nil), "cosmos.nft.v1beta1.ClassMetadataMapping")
+	proto.RegisterType((*MetadataAttributeMapping)(nil), "cosmos.nft.v1beta1.MetadataAttributeMapping")
+	proto.RegisterType((*TimelockedTransfer)(nil), "cosmos.nft.v1beta1.TimelockedTransfer")
+	proto.RegisterType((*ClassTransferFee)(nil), "cosmos.nft.v1beta1.ClassTransferFee")
+}
+
+func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
+
+var fileDescriptor_eb8ebf8e8053172c = []byte{
+	// 1243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xda, 0x8e, 0x63, 0xc6, 0x21, 0x58, 0xd3, 0x08, 0x6d, 0xdc, 0xd6, 0x31, 0x2e, 0x88,
+	0x14, 0x81, 0x0d, 0x69, 0x8f, 0xbd, 0xd8, 0xb1, 0x03, 0x46, 0x89, 0x13, 0xad, 0x1d, 0xa9, 0xad,
+	0x54, 0xad, 0xc6, 0xde, 0x67, 0x7b, 0xc4, 0x7a, 0xc6, 0x9a, 0x19, 0x43, 0xdc, 0x2f, 0xd0, 0x8a,
+	0x13, 0x3d, 0xf4, 0xc8, 0x89, 0x53, 0x7b, 0xe6, 0xd8, 0x0f, 0xc0, 0x11, 0x71, 0xaa, 0x7a, 0x80,
+	0x16, 0xbe, 0x42, 0x3f, 0x40, 0x35, 0xb3, 0xb3, 0x26, 0x25, 0x26, 0x16, 0xed, 0xc9, 0x33, 0xbf,
+	0xf9, 0xbd, 0xb7, 0x6f, 0x7e, 0xef, 0x8f, 0x07, 0x7d, 0xd2, 0xe3, 0x72, 0xc4, 0x65, 0x85, 0xf5,
+	0x55, 0xe5, 0xfe, 0xad, 0x2e, 0x28, 0x72, 0x4b, 0xaf, 0xcb, 0x63, 0xc1, 0x15, 0xc7, 0x38, 0x3a,
+	0x2d, 0x6b, 0xc4, 0x9e, 0xe6, 0x37, 0x06, 0x9c, 0x0f, 0x42, 0xa8, 0x18, 0x46, 0x77, 0xd2, 0xaf,
+	0x10, 0x36, 0x8d, 0xe8, 0xf9, 0xcd, 0x77, 0x8f, 0x14, 0x1d, 0x81, 0x54, 0x64, 0x34, 0xb6, 0x84,
+	0xf5, 0x01, 0x1f, 0x70, 0xb3, 0xac, 0xe8, 0x95, 0x45, 0x37, 0xa2, 0xaf, 0xf8, 0xd1, 0x81, 0xfd,
+	0x64, 0x74, 0x54, 0xb0, 0xe1, 0x75, 0x89, 0x84, 0x59, 0x7c, 0x3d, 0x4e, 0x59, 0x74, 0x5e, 0xfa,
+	0x2b, 0x81, 0x96, 0x77, 0x42, 0x22, 0x25, 0x5e, 0x43, 0x09, 0x1a, 0xb8, 0x4e, 0xd1, 0xd9, 0x3a,
+	0xe7, 0x25, 0x68, 0x80, 0x31, 0x4a, 0x31, 0x32, 0x02, 0x37, 0x61, 0x10, 0xb3, 0xc6, 0x17, 0x51,
+	0x5a, 0x4e, 0x47, 0x5d, 0x1e, 0xba, 0x49, 0x83, 0xda, 0x1d, 0x2e, 0xa2, 0x6c, 0x00, 0xb2, 0x27,
+	0xe8, 0x58, 0x51, 0xce, 0xdc, 0x94, 0x39, 0x3c, 0x09, 0xe1, 0x1c, 0x4a, 0x4e, 0x04, 0x75, 0x97,
+	0xcd, 0x89, 0x5e, 0xe2, 0x0d, 0x94, 0x99, 0x08, 0xea, 0x0f, 0x89, 0x1c, 0xba, 0x69, 0x03, 0xaf,
+	0x4c, 0x04, 0xbd, 0x43, 0xe4, 0x10, 0x6f, 0xa1, 0x54, 0x40, 0x14, 0x71, 0x57, 0x8a, 0xce, 0x56,
+	0x76, 0x7b, 0xbd, 0x1c, 0xa9, 0x52, 0x8e, 0x55, 0x29, 0x57, 0xd9, 0xd4, 0x33, 0x0c, 0xfc, 0x39,
+	0xca, 0x31, 0xce, 0x7c, 0x25, 0x08, 0x93, 0x7d, 0x10, 0xa4, 0x1b, 0x82, 0x9b, 0x29, 0x3a, 0x5b,
+	0x19, 0xef, 0x02, 0xe3, 0xac, 0x73, 0x02, 0xc6, 0x37, 0x51, 0x9a, 0x4a, 0x39, 0x01, 0xe1, 0x9e,
+	0xd3, 0x5f, 0xab, 0xb9, 0x2f, 0x9e, 0xde, 0x58, 0xb7, 0x5a, 0x55, 0x83, 0x40, 0x80, 0x94, 0x6d,
+	0x25, 0x28, 0x1b, 0x78, 0x96, 0x87, 0xcb, 0x68, 0x99, 0x04, 0x23, 0xca, 0x5c, 0xb4, 0xc0, 0x20,
+	0xa2, 0x69, 0x75, 0xfa, 0x82, 0x7f, 0x0f, 0xcc, 0xcd, 0x9a, 0x10, 0xec, 0xae, 0xf4, 0x83, 0x83,
+	0x92, 0xad, 0xdd, 0x8e, 0xbe, 0x71, 0x4f, 0x4b, 0xed, 0xcf, 0x74, 0x5e, 0x31, 0xfb, 0x66, 0x60,
+	0xc5, 0x4f, 0xcc, 0xc4, 0xb7, 0x72, 0x25, 0xe7, 0xcb, 0x95, 0x9a, 0x2f, 0x17, 0x5a, 0x24, 0x57,
+	0xe9, 0x36, 0xba, 0x60, 0x92, 0x5d, 0x27, 0x8a, 0xb4, 0x7b, 0x43, 0x18, 0x91, 0xb3, 0x82, 0xda,
+	0x40, 0x19, 0x35, 0x1d, 0x83, 0x3f, 0x11, 0xa1, 0x0d, 0x6d, 0x45, 0xef, 0x8f, 0x44, 0x58, 0xfa,
+	0xda, 0x3a, 0xba, 0xdb, 0x3e, 0x68, 0x2d, 0x76, 0x84, 0x51, 0xca, 0xc4, 0xad, 0x9d, 0xac, 0x7a,
+	0x66, 0x6d, 0x4a, 0xc9, 0x18, 0xce, 0x4a, 0xc9, 0xec, 0xb4, 0x58, 0xc8, 0xb8, 0x6e, 0x2b, 0xa2,
+	0xe4, 0x59, 0x5e, 0x2f, 0xa1, 0x55, 0xc5, 0x15, 0x09, 0xfd, 0x11, 0x65, 0x0a, 0x22, 0xf5, 0x52,
+	0x5e, 0xd6, 0x60, 0xfb, 0x06, 0x7a, 0x4b, 0xe9, 0x4e, 0x04, 0x83, 0xc0, 0x4d, 0x9e, 0xa0, 0xd4,
+	0x0c, 0x84, 0x5d, 0xb4, 0x32, 0xe4, 0x61, 0x00, 0x42, 0x1a, 0x59, 0x53, 0x5e, 0xbc, 0x2d, 0xfd,
+	0xe2, 0x20, 0x74, 0x24, 0xc9, 0x00, 0x6e, 0x0b, 0xc2, 0xd4, 0x87, 0x64, 0x6f, 0x1b, 0xad, 0x0c,
+	0xb4, 0x0d, 0x80, 0x9b, 0x5c, 0x50, 0x3a, 0x31, 0x11, 0x7f, 0x85, 0xd2, 0x70, 0x3c, 0xa6, 0x62,
+	0x6a, 0xc2, 0xc8, 0x6e, 0xe7, 0x4f, 0xa5, 0xb1, 0x13, 0xcf, 0x82, 0x5a, 0xe6, 0xd9, 0xcb, 0xcd,
+	0xa5, 0x47, 0xaf, 0x36, 0x1d, 0xcf, 0xda, 0x94, 0xbe, 0x43, 0x1f, 0x79, 0x20, 0x41, 0xdc, 0x87,
+	0xc0, 0x88, 0x77, 0x28, 0xa0, 0x4f, 0x8f, 0xb5, 0xc8, 0x63, 0xb3, 0xb2, 0x11, 0xdb, 0x9d, 0xae,
+	0x6c, 0xfe, 0x80, 0x81, 0x70, 0x13, 0x0b, 0xc2, 0x8b, 0x68, 0xa5, 0xdf, 0x1c, 0xb4, 0x6a, 0xfc,
+	0x7a, 0x7c, 0x4a, 0x42, 0x35, 0x3d, 0x4b, 0x8c, 0x06, 0x4a, 0x09, 0xa2, 0xec, 0xdc, 0xa8, 0xdd,
+	0xd2, 0xa1, 0xfe, 0xf1, 0x72, 0xf3, 0xe3, 0xc8, 0xbd, 0x0c, 0xee, 0x95, 0x29, 0xaf, 0x8c, 0x88,
+	0x1a, 0x96, 0xf7, 0x60, 0x40, 0x7a, 0xd3, 0x3a, 0xf4, 0x5e, 0x3c, 0xbd, 0x81, 0xec, 0xd7, 0xeb,
+	0xd0, 0xf3, 0x8c, 0x39, 0xbe, 0x8b, 0x90, 0x80, 0x1e, 0x1d, 0x53, 0x60, 0x4a, 0xba, 0xc9, 0x62,
+	0x72, 0x2b, 0xbb, 0x7d, 0xb9, 0x7c, 0x7a, 0x9c, 0x96, 0x6d, 0x48, 0x5e, 0x4c, 0xae, 0xa5, 0xf4,
+	0x27, 0xbd, 0x13, 0xd6, 0xa5, 0x9f, 0x1c, 0x94, 0x7b, 0x97, 0xa6, 0x93, 0x44, 0xa2, 0xbb, 0xba,
+	0xce, 0x02, 0x15, 0x62, 0x22, 0x6e, 0xa2, 0xf4, 0x03, 0xa0, 0x83, 0xa1, 0xfa, 0xef, 0xb7, 0xb3,
+	0x0e, 0x4a, 0x7f, 0x3b, 0x68, 0xdd, 0x48, 0xba, 0x0f, 0x8a, 0xe8, 0xe6, 0xdc, 0x27, 0xe3, 0x31,
+	0x65, 0x83, 0x05, 0x7d, 0x74, 0x6a, 0x24, 0xbf, 0x33, 0x7a, 0x93, 0xa7, 0x47, 0xef, 0x3a, 0x5a,
+	0xa6, 0x23, 0x32, 0x00, 0x3b, 0x36, 0xa2, 0x8d, 0x6e, 0x0d, 0x38, 0x56, 0x20, 0x18, 0x09, 0x4d,
+	0x83, 0x47, 0x93, 0x39, 0x1b, 0x63, 0x47, 0x22, 0xc4, 0x1e, 0x42, 0x44, 0x29, 0x41, 0xbb, 0x13,
+	0x05, 0xd2, 0x4d, 0x9b, 0x14, 0x5c, 0x9f, 0x97, 0x82, 0xf8, 0x0a, 0xd5, 0x98, 0x6d, 0xef, 0x12,
+	0xa7, 0xe2, 0xad, 0x97, 0x52, 0x1b, 0xb9, 0xef, 0x63, 0xe3, 0x4f, 0x11, 0x52, 0x82, 0x50, 0xe5,
+	0xeb, 0x29, 0x63, 0xef, 0x7e, 0xce, 0x20, 0x9d, 0xe9, 0x18, 0x74, 0xa7, 0x8e, 0xb9, 0xee, 0x6b,
+	0x11, 0x4f, 0x23, 0xbb, 0x2d, 0xbd, 0x4a, 0x20, 0xac, 0xbb, 0x23, 0xe4, 0xbd, 0x7b, 0x10, 0xc4,
+	0x53, 0xff, 0x43, 0x3a, 0xf6, 0x26, 0x4a, 0x4b, 0x60, 0x01, 0x88, 0x85, 0x0d, 0x6b, 0x79, 0xf8,
+	0x4b, 0x94, 0x11, 0xd0, 0x03, 0x7a, 0x1f, 0x84, 0x9b, 0x5a, 0x60, 0x33, 0x63, 0xe2, 0x2b, 0x68,
+	0x4d, 0x40, 0x08, 0x44, 0x82, 0x3f, 0x8c, 0x0a, 0x49, 0xeb, 0x9e, 0xf4, 0xce, 0x5b, 0xf4, 0x8e,
+	0x01, 0xf1, 0x0e, 0x5a, 0x8d, 0x69, 0xfa, 0x05, 0xe0, 0xa6, 0x17, 0x8e, 0x84, 0x94, 0x19, 0x07,
+	0x59, 0x6b, 0xa5, 0x71, 0x7c, 0x15, 0x5d, 0xe8, 0x13, 0x1a, 0x42, 0xe0, 0x5b, 0x54, 0x9a, 0x3f,
+	0xd4, 0xf3, 0xde, 0x5a, 0x04, 0x7b, 0x16, 0xd5, 0xa5, 0x20, 0x40, 0x89, 0x69, 0x1c, 0x52, 0xc6,
+	0x84, 0x94, 0x35, 0x58, 0x14, 0x50, 0xe9, 0x49, 0x02, 0xe5, 0x4c, 0xb5, 0xc6, 0xe2, 0xee, 0x02,
+	0x9c, 0xa5, 0xaf, 0x8f, 0x52, 0xfd, 0x90, 0xe8, 0x36, 0xd1, 0x45, 0xb3, 0x11, 0x17, 0x8d, 0x7e,
+	0x85, 0xcc, 0xaa, 0x66, 0x87, 0x53, 0x56, 0xbb, 0xa9, 0x2b, 0xe4, 0xd7, 0x57, 0x9b, 0x5b, 0x03,
+	0xaa, 0x86, 0x93, 0x6e, 0xb9, 0xc7, 0x47, 0xf6, 0x01, 0x63, 0x7f, 0x6e, 0xc8, 0xe0, 0x5e, 0x45,
+	0x17, 0x84, 0x34, 0x06, 0xd2, 0x33, 0x8e, 0x67, 0x53, 0x26, 0xf9, 0xff, 0xa6, 0xcc, 0x9e, 0xe9,
+	0x1e, 0x45, 0x19, 0x99, 0x3d, 0x5c, 0xd6, 0xb6, 0xaf, 0xcd, 0xab, 0xf1, 0x13, 0x17, 0xaf, 0xbf,
+	0xb5, 0xf0, 0x4e, 0x9a, 0x5f, 0xfb, 0x39, 0x81, 0x2e, 0xce, 0xe7, 0xe1, 0x03, 0x74, 0xb9, 0xe3,
+	0x55, 0x5b, 0xed, 0xdd, 0x86, 0xe7, 0xef, 0x36, 0x1a, 0x7e, 0xbd, 0xd1, 0xee, 0x34, 0x5b, 0xd5,
+	0x4e, 0xf3, 0xa0, 0xe5, 0x1f, 0xb5, 0xda, 0x87, 0x8d, 0x9d, 0xe6, 0x6e, 0xb3, 0x51, 0xcf, 0x2d,
+	0xe5, 0xaf, 0x3c, 0x7c, 0x5c, 0xbc, 0x34, 0xdf, 0xcb, 0x11, 0x93, 0x63, 0xe8, 0xd1, 0x3e, 0x85,
+	0x00, 0xb7, 0xce, 0x70, 0xb8, 0xb3, 0x57, 0x6d, 0xb7, 0xfd, 0x6a, 0x7d, 0xbf, 0xd9, 0xca, 0x39,
+	0xf9, 0xcb, 0x0f, 0x1f, 0x17, 0x8b, 0xf3, 0x1d, 0x9a, 0x94, 0x56, 0xcd, 0xe3, 0xa5, 0x83, 0xae,
+	0xbe, 0xdf, 0xdf, 0xc1, 0xfe, 0xfe, 0x51, 0xab, 0xd9, 0xf9, 0xc6, 0x3f, 0x3c, 0x38, 0xd8, 0xcb,
+	0x25, 0xf2, 0x57, 0x1f, 0x3e, 0x2e, 0x7e, 0xf6, 0x1e, 0x97, 0x7c, 0x34, 0x9a, 0x30, 0xaa, 0xa6,
+	0x87, 0x9c, 0x87, 0xf9, 0xd4, 0x8f, 0x4f, 0x0a, 0x4b, 0xb5, 0xeb, 0xcf, 0x5e, 0x17, 0x9c, 0xe7,
+	0xaf, 0x0b, 0xce, 0x9f, 0xaf, 0x0b, 0xce, 0xa3, 0x37, 0x85, 0xa5, 0xe7, 0x6f, 0x0a, 0x4b, 0xbf,
+	0xbf, 0x29, 0x2c, 0x7d, 0x8b, 0xff, 0x95, 0xb0, 0x63, 0xfd, 0x72, 0xee, 0xa6, 0x4d, 0x79, 0x7f,
+	0xf1, 0xcf, 0x00, 0xcc, 0x09, 0x1d, 0x82, 0x5a, 0x0b, 0x00, 0x00,
+}
+
+func (m *Class) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Class) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassDataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassDataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassDataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassJSONSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassJSONSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassJSONSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Holders != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalBurned != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.TotalBurned))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalMinted != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.TotalMinted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintNft(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReservedClassPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedClassPrefix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedClassPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoyaltyRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassMetadataMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassMetadataMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassMetadataMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataAttributeMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataAttributeMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataAttributeMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pointer) > 0 {
+		i -= len(m.Pointer)
+		copy(dAtA[i:], m.Pointer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Pointer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraitType) > 0 {
+		i -= len(m.TraitType)
+		copy(dAtA[i:], m.TraitType)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.TraitType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryHeight != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.RetryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FailedReleases != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.FailedReleases))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReleaseTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintNft(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassTransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassTransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassTransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Flat) > 0 {
+		for iNdEx := len(m.Flat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNft(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Class) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.NonTransferable {
+		n += 2
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *NFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassDataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassJSONSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.TotalMinted != 0 {
+		n += 1 + sovNft(uint64(m.TotalMinted))
+	}
+	if m.TotalBurned != 0 {
+		n += 1 + sovNft(uint64(m.TotalBurned))
+	}
+	if m.Holders != 0 {
+		n += 1 + sovNft(uint64(m.Holders))
+	}
+	return n
+}
+
+func (m *UsageGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
+func (m *ReservedClassPrefix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovNft(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoyaltyRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
+func (m *ClassMetadataMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MetadataAttributeMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraitType)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Pointer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *TimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovNft(uint64(m.ReleaseHeight))
+	}
+	if m.ReleaseTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime)
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.FailedReleases != 0 {
+		n += 1 + sovNft(uint64(m.FailedReleases))
+	}
+	if m.RetryHeight != 0 {
+		n += 1 + sovNft(uint64(m.RetryHeight))
+	}
+	return n
+}
+
+func (m *ClassTransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Flat) > 0 {
+		for _, e := range m.Flat {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovNft(uint64(l))
+	if m.Destination != 0 {
+		n += 1 + sovNft(uint64(m.Destination))
+	}
+	return n
+}
+
+func sovNft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNft(x uint64) (n int) {
+	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassDataSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassDataSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassDataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassJSONSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassJSONSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassJSONSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			m.TotalMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			m.TotalBurned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBurned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservedClassPrefix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedClassPrefix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedClassPrefix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, RoyaltyRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassMetadataMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassMetadataMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassMetadataMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, MetadataAttributeMapping{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *NFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovNft(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNft(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNft(x uint64) (n int) {
-	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *MetadataAttributeMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -482,15 +4042,15 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataAttributeMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataAttributeMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -518,11 +4078,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.TraitType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -550,11 +4110,61 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Pointer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -582,11 +4192,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -614,11 +4224,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -646,11 +4256,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -678,11 +4288,30 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -709,13 +4338,51 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
+			if m.ReleaseTime == nil {
+				m.ReleaseTime = new(time.Time)
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReleases", wireType)
+			}
+			m.FailedReleases = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedReleases |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryHeight", wireType)
+			}
+			m.RetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -737,7 +4404,7 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NFT) Unmarshal(dAtA []byte) error {
+func (m *ClassTransferFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -760,10 +4427,10 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClassTransferFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClassTransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -800,9 +4467,9 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -812,27 +4479,29 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Flat = append(m.Flat, types1.Coin{})
+			if err := m.Flat[len(m.Flat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,45 +4529,15 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -908,28 +4547,11 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Destination |= TransferFeeDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
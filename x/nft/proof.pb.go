@@ -0,0 +1,930 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/nft/v1beta1/proof.proto
+
+package nft
+
+import (
+	context "context"
+	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryOwnerProofRequest is the request type for the ProofService/OwnerProof RPC method.
+type QueryOwnerProofRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the block height to query the owner at. The latest height is
+	// used if it is zero.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryOwnerProofRequest) Reset()         { *m = QueryOwnerProofRequest{} }
+func (m *QueryOwnerProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerProofRequest) ProtoMessage()    {}
+func (*QueryOwnerProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a7fffa26b9e60c4, []int{0}
+}
+func (m *QueryOwnerProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerProofRequest.Merge(m, src)
+}
+func (m *QueryOwnerProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerProofRequest proto.InternalMessageInfo
+
+// QueryOwnerProofResponse is the response type for the ProofService/OwnerProof RPC method.
+type QueryOwnerProofResponse struct {
+	// owner is the owner address of the nft at the queried height, empty if the
+	// nft does not exist.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// height is the block height the owner and the proof were queried at. The
+	// proof must be verified against the app hash of the block at height + 1.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// store_name is the name of the nft store in the multistore.
+	StoreName string `protobuf:"bytes,3,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// key is the key of the ownership record in the nft store.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the raw value of the ownership record in the nft store, i.e. the
+	// owner address bytes. It is empty if the nft does not exist, in which case
+	// the proof is an absence proof.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// proof contains the IAVL proof of the key in the nft store followed by the
+	// proof of the nft store root in the multistore commitment.
+	Proof *crypto.ProofOps `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryOwnerProofResponse) Reset()         { *m = QueryOwnerProofResponse{} }
+func (m *QueryOwnerProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerProofResponse) ProtoMessage()    {}
+func (*QueryOwnerProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a7fffa26b9e60c4, []int{1}
+}
+func (m *QueryOwnerProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerProofResponse.Merge(m, src)
+}
+func (m *QueryOwnerProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerProofResponse proto.InternalMessageInfo
+
+func (m *QueryOwnerProofResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryOwnerProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryOwnerProofResponse) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *QueryOwnerProofResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryOwnerProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryOwnerProofResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryOwnerProofRequest)(nil), "cosmos.nft.v1beta1.QueryOwnerProofRequest")
+	proto.RegisterType((*QueryOwnerProofResponse)(nil), "cosmos.nft.v1beta1.QueryOwnerProofResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/nft/v1beta1/proof.proto", fileDescriptor_9a7fffa26b9e60c4) }
+
+var fileDescriptor_9a7fffa26b9e60c4 = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0x3e, 0x5f, 0x48, 0x68, 0x4c, 0x85, 0x90, 0x15, 0x95, 0x6b, 0xa0, 0xd7, 0x28, 0x53, 0xc4,
+	0x0f, 0x5b, 0x29, 0x03, 0x12, 0x1b, 0xdd, 0x58, 0x28, 0x5c, 0x37, 0x96, 0xe8, 0x9a, 0x7b, 0xb9,
+	0x5a, 0x4d, 0xfc, 0x0e, 0xdb, 0x09, 0x44, 0x15, 0x0b, 0x13, 0x23, 0x12, 0x3b, 0xea, 0x7f, 0xc0,
+	0xc2, 0x1f, 0xc1, 0x58, 0xc1, 0x82, 0xc4, 0x82, 0x12, 0x06, 0xfe, 0x0c, 0x74, 0xf6, 0xa1, 0x46,
+	0x6a, 0x86, 0x2e, 0x27, 0xbf, 0xef, 0x7b, 0xf7, 0xbe, 0xcf, 0x9f, 0x1f, 0x8d, 0x87, 0x68, 0x26,
+	0x68, 0x84, 0x1a, 0x59, 0x31, 0xeb, 0x1f, 0x81, 0x4d, 0xfb, 0xa2, 0xd0, 0x88, 0x23, 0x5e, 0x68,
+	0xb4, 0xc8, 0x98, 0xe7, 0xb9, 0x1a, 0x59, 0x5e, 0xf1, 0xed, 0x56, 0x8e, 0x39, 0x3a, 0x5a, 0x94,
+	0x27, 0xdf, 0xd9, 0xbe, 0x9b, 0x23, 0xe6, 0x63, 0x10, 0x69, 0x21, 0x45, 0xaa, 0x14, 0xda, 0xd4,
+	0x4a, 0x54, 0xa6, 0x62, 0xb7, 0xfd, 0x9c, 0x81, 0xff, 0xad, 0x1a, 0xea, 0xa9, 0x1d, 0x0b, 0x2a,
+	0x03, 0x3d, 0x91, 0xca, 0x8a, 0xa1, 0x9e, 0x17, 0x16, 0x57, 0x1d, 0x74, 0x81, 0x6e, 0xbd, 0x9c,
+	0x82, 0x9e, 0x1f, 0xbc, 0x51, 0xa0, 0x5f, 0x94, 0x44, 0x02, 0xaf, 0xa7, 0x60, 0x2c, 0xdb, 0xa6,
+	0x1b, 0xc3, 0x71, 0x6a, 0xcc, 0x40, 0x66, 0x11, 0xe9, 0x90, 0x5e, 0x33, 0xb9, 0xee, 0xea, 0x67,
+	0x19, 0xbb, 0x49, 0x43, 0x99, 0x45, 0xa1, 0x03, 0x43, 0x99, 0xb1, 0x2d, 0xda, 0x38, 0x06, 0x99,
+	0x1f, 0xdb, 0xa8, 0xd6, 0x21, 0xbd, 0x5a, 0x52, 0x55, 0x4f, 0x36, 0x3e, 0x9c, 0xed, 0x06, 0x7f,
+	0xcf, 0x76, 0x83, 0xee, 0x2f, 0x42, 0x6f, 0x5f, 0xd2, 0x31, 0x05, 0x2a, 0x03, 0x8c, 0xd3, 0x3a,
+	0x96, 0xa8, 0x57, 0xd9, 0x8f, 0xbe, 0x7f, 0x7d, 0xd8, 0xaa, 0xae, 0xf0, 0x34, 0xcb, 0x34, 0x18,
+	0x73, 0x68, 0xb5, 0x54, 0x79, 0xe2, 0xdb, 0x56, 0xd4, 0xc2, 0x55, 0x35, 0xb6, 0x43, 0xa9, 0xb1,
+	0xa8, 0x61, 0xa0, 0xd2, 0x09, 0x38, 0x27, 0xcd, 0xa4, 0xe9, 0x90, 0xe7, 0xe9, 0x04, 0xd8, 0x2d,
+	0x5a, 0x3b, 0x81, 0x79, 0x74, 0xad, 0x43, 0x7a, 0x9b, 0x49, 0x79, 0x64, 0x2d, 0x5a, 0x9f, 0xa5,
+	0xe3, 0x29, 0x44, 0x75, 0x87, 0xf9, 0x82, 0xf5, 0x69, 0xdd, 0x05, 0x14, 0x35, 0x3a, 0xa4, 0x77,
+	0x63, 0xef, 0x0e, 0xbf, 0x08, 0x90, 0xfb, 0x00, 0xb9, 0xf3, 0x7f, 0x50, 0x98, 0xc4, 0x77, 0xee,
+	0x7d, 0x21, 0x74, 0xd3, 0x61, 0x87, 0xa0, 0x67, 0x72, 0x08, 0xec, 0x33, 0xa1, 0xf4, 0xe2, 0xa6,
+	0xec, 0x1e, 0xbf, 0xfc, 0xce, 0x7c, 0x7d, 0xec, 0xed, 0xfb, 0x57, 0xea, 0xf5, 0xd1, 0x75, 0x1f,
+	0xbf, 0xff, 0xf1, 0xe7, 0x53, 0xd8, 0x67, 0x42, 0xac, 0x59, 0x34, 0x97, 0x96, 0x38, 0xfd, 0xff,
+	0x88, 0xef, 0xc4, 0x69, 0xf9, 0x71, 0x8e, 0xf7, 0x1f, 0x7c, 0x5b, 0xc4, 0xe4, 0x7c, 0x11, 0x93,
+	0xdf, 0x8b, 0x98, 0x7c, 0x5c, 0xc6, 0xc1, 0xf9, 0x32, 0x0e, 0x7e, 0x2e, 0xe3, 0xe0, 0x55, 0xb5,
+	0x92, 0x26, 0x3b, 0xe1, 0x12, 0xc5, 0xdb, 0x72, 0xe2, 0x51, 0xc3, 0xed, 0xca, 0xa3, 0x7f, 0x03,
+	0x00, 0x1d, 0x6b, 0x08, 0xdc, 0xcf, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProofServiceClient is the client API for ProofService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProofServiceClient interface {
+	// OwnerProof queries the owner of an NFT together with the merkle proof of
+	// the ownership record against the app hash.
+	OwnerProof(ctx context.Context, in *QueryOwnerProofRequest, opts ...grpc.CallOption) (*QueryOwnerProofResponse, error)
+}
+
+type proofServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProofServiceClient(cc grpc1.ClientConn) ProofServiceClient {
+	return &proofServiceClient{cc}
+}
+
+func (c *proofServiceClient) OwnerProof(ctx context.Context, in *QueryOwnerProofRequest, opts ...grpc.CallOption) (*QueryOwnerProofResponse, error) {
+	out := new(QueryOwnerProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.ProofService/OwnerProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofServiceServer is the server API for ProofService service.
+type ProofServiceServer interface {
+	// OwnerProof queries the owner of an NFT together with the merkle proof of
+	// the ownership record against the app hash.
+	OwnerProof(context.Context, *QueryOwnerProofRequest) (*QueryOwnerProofResponse, error)
+}
+
+// UnimplementedProofServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProofServiceServer struct {
+}
+
+func (*UnimplementedProofServiceServer) OwnerProof(ctx context.Context, req *QueryOwnerProofRequest) (*QueryOwnerProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerProof not implemented")
+}
+
+func RegisterProofServiceServer(s grpc1.Server, srv ProofServiceServer) {
+	s.RegisterService(&_ProofService_serviceDesc, srv)
+}
+
+func _ProofService_OwnerProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).OwnerProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.ProofService/OwnerProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).OwnerProof(ctx, req.(*QueryOwnerProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProofService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.nft.v1beta1.ProofService",
+	HandlerType: (*ProofServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OwnerProof",
+			Handler:    _ProofService_OwnerProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/nft/v1beta1/proof.proto",
+}
+
+func (m *QueryOwnerProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOwnerProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryOwnerProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOwnerProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProof = fmt.Errorf("proto: unexpected end of group")
+)
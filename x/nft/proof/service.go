@@ -0,0 +1,98 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+)
+
+// abciQueryFn defines the function used to run ABCI queries against the
+// committed multistore, usually the Query method of the CometBFT ABCI wrapper
+// of the application.
+type abciQueryFn = func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+
+var _ nft.ProofServiceServer = proofServer{}
+
+// proofServer implements the nft ProofService by querying the nft store with
+// proofs through ABCI.
+type proofServer struct {
+	addressCodec address.Codec
+	queryFn      abciQueryFn
+}
+
+// NewProofServer creates a new nft ProofService server.
+func NewProofServer(addressCodec address.Codec, queryFn abciQueryFn) nft.ProofServiceServer {
+	return proofServer{
+		addressCodec: addressCodec,
+		queryFn:      queryFn,
+	}
+}
+
+// OwnerProof implements the ProofService/OwnerProof gRPC method.
+func (s proofServer) OwnerProof(ctx context.Context, req *nft.QueryOwnerProofRequest) (*nft.QueryOwnerProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.ClassId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, nft.ErrEmptyClassID.Error())
+	}
+
+	if len(req.Id) == 0 {
+		return nil, status.Error(codes.InvalidArgument, nft.ErrEmptyNFTID.Error())
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	res, err := s.queryFn(ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", keeper.StoreKey),
+		Data:   keeper.OwnerStoreKey(req.ClassId, req.Id),
+		Height: req.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to query owner proof: %s", res.Log)
+	}
+
+	var owner string
+	if len(res.Value) > 0 {
+		owner, err = s.addressCodec.BytesToString(res.Value)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode owner: %s", err.Error())
+		}
+	}
+
+	return &nft.QueryOwnerProofResponse{
+		Owner:     owner,
+		Height:    res.Height,
+		StoreName: keeper.StoreKey,
+		Key:       res.Key,
+		Value:     res.Value,
+		Proof:     res.ProofOps,
+	}, nil
+}
+
+// RegisterProofService registers the nft ProofService on the gRPC router.
+func RegisterProofService(server gogogrpc.Server, addressCodec address.Codec, queryFn abciQueryFn) {
+	nft.RegisterProofServiceServer(server, NewProofServer(addressCodec, queryFn))
+}
+
+// RegisterGRPCGatewayRoutes mounts the nft ProofService's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = nft.RegisterProofServiceHandlerClient(context.Background(), mux, nft.NewProofServiceClient(clientConn))
+}
@@ -0,0 +1,94 @@
+package proof_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+	"cosmossdk.io/x/nft/proof"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOwnerProof(t *testing.T) {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	nftKey := storetypes.NewKVStoreKey(keeper.StoreKey)
+	ms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("other"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	owner := sdk.AccAddress("owner_______________")
+	ms.GetKVStore(nftKey).Set(keeper.OwnerStoreKey("kitties", "kitty1"), owner)
+	ms.GetKVStore(nftKey).Set(keeper.OwnerStoreKey("kitties", "kitty2"), sdk.AccAddress("other_______________"))
+	cid := ms.Commit()
+
+	// the multistore expects the query path without the "/store" prefix
+	queryFn := func(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+		req.Path = strings.TrimPrefix(req.Path, "/store")
+		return ms.Query(req), nil
+	}
+
+	ac := addresscodec.NewBech32Codec(sdk.Bech32MainPrefix)
+	ownerStr, err := ac.BytesToString(owner)
+	require.NoError(t, err)
+	srv := proof.NewProofServer(ac, queryFn)
+
+	res, err := srv.OwnerProof(context.Background(), &nft.QueryOwnerProofRequest{ClassId: "kitties", Id: "kitty1", Height: cid.Version})
+	require.NoError(t, err)
+	require.Equal(t, ownerStr, res.Owner)
+	require.Equal(t, cid.Version, res.Height)
+	require.Equal(t, keeper.StoreKey, res.StoreName)
+	require.Equal(t, keeper.OwnerStoreKey("kitties", "kitty1"), res.Key)
+
+	proven, err := proof.VerifyOwnerProof(res, "kitties", "kitty1", cid.Hash)
+	require.NoError(t, err)
+	require.Equal(t, owner, proven)
+
+	// the proof does not hold for another nft, another owner or another app hash
+	_, err = proof.VerifyOwnerProof(res, "kitties", "kitty2", cid.Hash)
+	require.ErrorIs(t, err, nft.ErrInvalidOwnerProof)
+	forged := *res
+	forged.Value = sdk.AccAddress("other_______________")
+	_, err = proof.VerifyOwnerProof(&forged, "kitties", "kitty1", cid.Hash)
+	require.ErrorIs(t, err, nft.ErrInvalidOwnerProof)
+	_, err = proof.VerifyOwnerProof(res, "kitties", "kitty1", []byte("invalid app hash"))
+	require.ErrorIs(t, err, nft.ErrInvalidOwnerProof)
+	_, err = proof.VerifyOwnerProof(&nft.QueryOwnerProofResponse{Key: res.Key}, "kitties", "kitty1", cid.Hash)
+	require.ErrorIs(t, err, nft.ErrInvalidOwnerProof)
+
+	// the nft does not exist, the proof is an absence proof
+	res, err = srv.OwnerProof(context.Background(), &nft.QueryOwnerProofRequest{ClassId: "kitties", Id: "kitty3"})
+	require.NoError(t, err)
+	require.Empty(t, res.Owner)
+	require.Empty(t, res.Value)
+
+	proven, err = proof.VerifyOwnerProof(res, "kitties", "kitty3", cid.Hash)
+	require.NoError(t, err)
+	require.Nil(t, proven)
+
+	// an absence proof cannot hide an existing nft
+	forged = *res
+	forged.Key = keeper.OwnerStoreKey("kitties", "kitty1")
+	_, err = proof.VerifyOwnerProof(&forged, "kitties", "kitty1", cid.Hash)
+	require.ErrorIs(t, err, nft.ErrInvalidOwnerProof)
+
+	_, err = srv.OwnerProof(context.Background(), &nft.QueryOwnerProofRequest{Id: "kitty1"})
+	require.ErrorContains(t, err, nft.ErrEmptyClassID.Error())
+
+	_, err = srv.OwnerProof(context.Background(), &nft.QueryOwnerProofRequest{ClassId: "kitties"})
+	require.ErrorContains(t, err, nft.ErrEmptyNFTID.Error())
+
+	_, err = srv.OwnerProof(context.Background(), &nft.QueryOwnerProofRequest{ClassId: "kitties", Id: "kitty1", Height: -1})
+	require.ErrorContains(t, err, "invalid height")
+}
@@ -0,0 +1,46 @@
+package proof
+
+import (
+	"bytes"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyOwnerProof verifies the response of the OwnerProof query for the nft
+// of the given class and id against appHash, the app hash of the block at
+// res.Height + 1 as obtained from a light client, and returns the owner it
+// proves. The owner is nil if the proof is an absence proof, i.e. the nft does
+// not exist at res.Height. Only the returned owner is proven: the Owner field
+// of the response is not checked.
+func VerifyOwnerProof(res *nft.QueryOwnerProofResponse, classID, nftID string, appHash []byte) (sdk.AccAddress, error) {
+	if res == nil || res.Proof == nil {
+		return nil, nft.ErrInvalidOwnerProof.Wrap("missing proof")
+	}
+
+	// the key is rebuilt rather than taken from the response, so that the
+	// proof of another record cannot be passed off as the ownership record
+	key := keeper.OwnerStoreKey(classID, nftID)
+	if !bytes.Equal(res.Key, key) {
+		return nil, nft.ErrInvalidOwnerProof.Wrapf("proof key %X is not the owner key of nft %s/%s", res.Key, classID, nftID)
+	}
+
+	keyPath := new(merkle.KeyPath).AppendKey([]byte(keeper.StoreKey), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex)
+	prt := rootmulti.DefaultProofRuntime()
+	if len(res.Value) == 0 {
+		if err := prt.VerifyAbsence(res.Proof, appHash, keyPath.String()); err != nil {
+			return nil, nft.ErrInvalidOwnerProof.Wrap(err.Error())
+		}
+		return nil, nil
+	}
+
+	if err := prt.VerifyValue(res.Proof, appHash, keyPath.String(), res.Value); err != nil {
+		return nil, nft.ErrInvalidOwnerProof.Wrap(err.Error())
+	}
+	return sdk.AccAddress(res.Value), nil
+}
@@ -0,0 +1,97 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRoyaltyRecipients is the maximum number of recipients of a royalty, which bounds the cost of paying it
+const MaxRoyaltyRecipients = 16
+
+// Validate checks that the rate of the royalty is between 0 and 1, and that its recipients are distinct valid
+// addresses whose positive weights sum to 1
+func (r ClassRoyalty) Validate(ac address.Codec) error {
+	if len(r.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(math.LegacyOneDec()) {
+		return ErrInvalidRoyalty.Wrapf("rate %s of class %s is not between 0 and 1", r.Rate, r.ClassId)
+	}
+
+	if err := ValidateRoyaltyRecipients(r.Recipients, ac); err != nil {
+		return errors.Wrapf(err, "royalty of class %s", r.ClassId)
+	}
+	return nil
+}
+
+// ValidateRoyaltyRecipients checks that the recipients are distinct valid addresses, at most MaxRoyaltyRecipients,
+// and that their positive weights sum to 1
+func ValidateRoyaltyRecipients(recipients []RoyaltyRecipient, ac address.Codec) error {
+	if len(recipients) == 0 {
+		return ErrInvalidRoyalty.Wrap("no recipients")
+	}
+	if len(recipients) > MaxRoyaltyRecipients {
+		return ErrInvalidRoyalty.Wrapf("%d recipients, the maximum is %d", len(recipients), MaxRoyaltyRecipients)
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	total := math.LegacyZeroDec()
+	for _, recipient := range recipients {
+		if _, err := ac.StringToBytes(recipient.Address); err != nil {
+			return ErrInvalidRoyalty.Wrapf("invalid recipient address (%s)", recipient.Address)
+		}
+		if seen[recipient.Address] {
+			return ErrInvalidRoyalty.Wrapf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return ErrInvalidRoyalty.Wrapf("weight of recipient %s must be positive", recipient.Address)
+		}
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return ErrInvalidRoyalty.Wrapf("the weights of the recipients sum to %s instead of 1", total)
+	}
+	return nil
+}
+
+// Royalty returns the royalty due on a sale at the given price, i.e. the price times the rate, truncated
+func (r ClassRoyalty) Royalty(price sdk.Coins) sdk.Coins {
+	royalty := sdk.NewCoins()
+	for _, coin := range price {
+		if amount := r.Rate.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+			royalty = royalty.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return royalty
+}
+
+// Split splits the given amount among the recipients according to their weights, returning the share of every
+// recipient in the order of the recipients. The shares are truncated, and the remainder is added to the share of the
+// first recipient so that the whole amount is split.
+func (r ClassRoyalty) Split(amount sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, len(r.Recipients))
+	if len(r.Recipients) == 0 {
+		return shares
+	}
+
+	remainder := amount
+	for i := len(r.Recipients) - 1; i > 0; i-- {
+		share := sdk.NewCoins()
+		for _, coin := range amount {
+			if shareAmount := r.Recipients[i].Weight.MulInt(coin.Amount).TruncateInt(); shareAmount.IsPositive() {
+				share = share.Add(sdk.NewCoin(coin.Denom, shareAmount))
+			}
+		}
+		shares[i] = share
+		remainder = remainder.Sub(share...)
+	}
+	shares[0] = remainder
+	return shares
+}
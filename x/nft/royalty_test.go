@@ -0,0 +1,89 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestClassRoyaltyValidate(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+	royalty := func(rate string, recipients ...nft.RoyaltyRecipient) nft.ClassRoyalty {
+		return nft.ClassRoyalty{ClassId: "kitty", Rate: math.LegacyMustNewDecFromStr(rate), Recipients: recipients}
+	}
+	recipient := func(address, weight string) nft.RoyaltyRecipient {
+		return nft.RoyaltyRecipient{Address: address, Weight: math.LegacyMustNewDecFromStr(weight)}
+	}
+
+	tooMany := make([]nft.RoyaltyRecipient, nft.MaxRoyaltyRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = recipient(sdk.AccAddress([]byte{byte(i)}).String(), "0.01")
+	}
+
+	testCases := []struct {
+		name    string
+		royalty nft.ClassRoyalty
+		expErr  error
+	}{
+		{"valid", royalty("0.05", recipient(alice, "0.7"), recipient(bob, "0.3")), nil},
+		{"valid zero rate", royalty("0", recipient(alice, "1")), nil},
+		{"empty class id", nft.ClassRoyalty{Rate: math.LegacyOneDec(), Recipients: []nft.RoyaltyRecipient{recipient(alice, "1")}}, nft.ErrEmptyClassID},
+		{"nil rate", nft.ClassRoyalty{ClassId: "kitty", Recipients: []nft.RoyaltyRecipient{recipient(alice, "1")}}, nft.ErrInvalidRoyalty},
+		{"negative rate", royalty("-0.1", recipient(alice, "1")), nft.ErrInvalidRoyalty},
+		{"rate above 1", royalty("1.1", recipient(alice, "1")), nft.ErrInvalidRoyalty},
+		{"no recipients", royalty("0.05"), nft.ErrInvalidRoyalty},
+		{"too many recipients", royalty("0.05", tooMany...), nft.ErrInvalidRoyalty},
+		{"invalid recipient", royalty("0.05", recipient("invalid", "1")), nft.ErrInvalidRoyalty},
+		{"duplicate recipient", royalty("0.05", recipient(alice, "0.5"), recipient(alice, "0.5")), nft.ErrInvalidRoyalty},
+		{"zero weight", royalty("0.05", recipient(alice, "1"), recipient(bob, "0")), nft.ErrInvalidRoyalty},
+		{"weights below 1", royalty("0.05", recipient(alice, "0.5"), recipient(bob, "0.4")), nft.ErrInvalidRoyalty},
+		{"weights above 1", royalty("0.05", recipient(alice, "0.7"), recipient(bob, "0.4")), nft.ErrInvalidRoyalty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.royalty.Validate(ac)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClassRoyaltySplit(t *testing.T) {
+	royalty := nft.ClassRoyalty{
+		ClassId: "kitty",
+		Rate:    math.LegacyMustNewDecFromStr("0.1"),
+		Recipients: []nft.RoyaltyRecipient{
+			{Address: sdk.AccAddress("alice").String(), Weight: math.LegacyMustNewDecFromStr("0.5")},
+			{Address: sdk.AccAddress("bob").String(), Weight: math.LegacyMustNewDecFromStr("0.25")},
+			{Address: sdk.AccAddress("carol").String(), Weight: math.LegacyMustNewDecFromStr("0.25")},
+		},
+	}
+
+	// the royalty is truncated, and a denom whose royalty is zero is dropped
+	amount := royalty.Royalty(sdk.NewCoins(sdk.NewInt64Coin("stake", 1019), sdk.NewInt64Coin("atom", 9)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), amount)
+
+	// the remainder of the truncated shares goes to the first recipient
+	shares := royalty.Split(amount)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 51)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 25)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 25)),
+	}, shares)
+
+	// shares rounding down to zero are empty
+	shares = royalty.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), sdk.NewCoins(), sdk.NewCoins()}, shares)
+}
@@ -22,9 +22,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &classB)
 			return fmt.Sprintf("%v\n%v", classA, classB)
 		case bytes.Equal(kvA.Key[:1], keeper.NFTKey):
-			var nftA, nftB nft.NFT
-			cdc.MustUnmarshal(kvA.Value, &nftA)
-			cdc.MustUnmarshal(kvB.Value, &nftB)
+			nftA, errA := keeper.UnmarshalNFT(cdc, kvA.Value)
+			nftB, errB := keeper.UnmarshalNFT(cdc, kvB.Value)
+			if errA != nil || errB != nil {
+				panic(fmt.Sprintf("invalid nfts: %v, %v", errA, errB))
+			}
 			return fmt.Sprintf("%v\n%v", nftA, nftB)
 		case bytes.Equal(kvA.Key[:1], keeper.NFTOfClassByOwnerKey):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
@@ -38,6 +40,56 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassDataSchemaKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassStatsKey):
+			var statsA, statsB nft.ClassStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassOwnerBalanceKey):
+			var balanceA, balanceB uint64
+			balanceA = sdk.BigEndianToUint64(kvA.Value)
+			balanceB = sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
+		case bytes.Equal(kvA.Key[:1], keeper.URIUpdateHeightKey):
+			var heightA, heightB uint64
+			heightA = sdk.BigEndianToUint64(kvA.Value)
+			heightB = sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("%v\n%v", heightA, heightB)
+		case bytes.Equal(kvA.Key[:1], keeper.UsageGrantKey):
+			var grantA, grantB nft.UsageGrant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], keeper.UsageGrantQueueKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassJSONSchemaKey):
+			var schemaA, schemaB nft.ClassJSONSchema
+			cdc.MustUnmarshal(kvA.Value, &schemaA)
+			cdc.MustUnmarshal(kvB.Value, &schemaB)
+			return fmt.Sprintf("%v\n%v", schemaA, schemaB)
+		case bytes.Equal(kvA.Key[:1], keeper.ReservedClassPrefixKey):
+			var reservationA, reservationB nft.ReservedClassPrefix
+			cdc.MustUnmarshal(kvA.Value, &reservationA)
+			cdc.MustUnmarshal(kvB.Value, &reservationB)
+			return fmt.Sprintf("%v\n%v", reservationA, reservationB)
+		case bytes.Equal(kvA.Key[:1], keeper.AuctionKey):
+			var auctionA, auctionB nft.Auction
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", auctionA, auctionB)
+		case bytes.Equal(kvA.Key[:1], keeper.AuctionQueueKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], keeper.AuctionSequenceKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], keeper.TimelockKey):
+			var transferA, transferB nft.TimelockedTransfer
+			cdc.MustUnmarshal(kvA.Value, &transferA)
+			cdc.MustUnmarshal(kvB.Value, &transferB)
+			return fmt.Sprintf("%v\n%v", transferA, transferB)
+		case bytes.Equal(kvA.Key[:1], keeper.TimelockHeightQueueKey), bytes.Equal(kvA.Key[:1], keeper.TimelockTimeQueueKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
@@ -10,6 +10,7 @@ import (
 
 	address "cosmossdk.io/core/address"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +37,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// InputOutputCoins mocks base method.
+func (m *MockBankKeeper) InputOutputCoins(ctx context.Context, input types0.Input, outputs []types0.Output) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InputOutputCoins", ctx, input, outputs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InputOutputCoins indicates an expected call of InputOutputCoins.
+func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, input, outputs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, input, outputs)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -50,6 +65,94 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// MockAuctionBankKeeper is a mock of AuctionBankKeeper interface.
+type MockAuctionBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuctionBankKeeperMockRecorder
+}
+
+// MockAuctionBankKeeperMockRecorder is the mock recorder for MockAuctionBankKeeper.
+type MockAuctionBankKeeperMockRecorder struct {
+	mock *MockAuctionBankKeeper
+}
+
+// NewMockAuctionBankKeeper creates a new mock instance.
+func NewMockAuctionBankKeeper(ctrl *gomock.Controller) *MockAuctionBankKeeper {
+	mock := &MockAuctionBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuctionBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuctionBankKeeper) EXPECT() *MockAuctionBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockAuctionBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockAuctionBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockAuctionBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockAuctionBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockAuctionBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockAuctionBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// MockCommunityPoolKeeper is a mock of CommunityPoolKeeper interface.
+type MockCommunityPoolKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommunityPoolKeeperMockRecorder
+}
+
+// MockCommunityPoolKeeperMockRecorder is the mock recorder for MockCommunityPoolKeeper.
+type MockCommunityPoolKeeperMockRecorder struct {
+	mock *MockCommunityPoolKeeper
+}
+
+// NewMockCommunityPoolKeeper creates a new mock instance.
+func NewMockCommunityPoolKeeper(ctrl *gomock.Controller) *MockCommunityPoolKeeper {
+	mock := &MockCommunityPoolKeeper{ctrl: ctrl}
+	mock.recorder = &MockCommunityPoolKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommunityPoolKeeper) EXPECT() *MockCommunityPoolKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockCommunityPoolKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockCommunityPoolKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockCommunityPoolKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -114,3 +217,40 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
+
+// MockCircuitBreaker is a mock of CircuitBreaker interface.
+type MockCircuitBreaker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCircuitBreakerMockRecorder
+}
+
+// MockCircuitBreakerMockRecorder is the mock recorder for MockCircuitBreaker.
+type MockCircuitBreakerMockRecorder struct {
+	mock *MockCircuitBreaker
+}
+
+// NewMockCircuitBreaker creates a new mock instance.
+func NewMockCircuitBreaker(ctrl *gomock.Controller) *MockCircuitBreaker {
+	mock := &MockCircuitBreaker{ctrl: ctrl}
+	mock.recorder = &MockCircuitBreakerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCircuitBreaker) EXPECT() *MockCircuitBreakerMockRecorder {
+	return m.recorder
+}
+
+// IsNFTAllowed mocks base method.
+func (m *MockCircuitBreaker) IsNFTAllowed(ctx types.Context, classID, nftID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNFTAllowed", ctx, classID, nftID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNFTAllowed indicates an expected call of IsNFTAllowed.
+func (mr *MockCircuitBreakerMockRecorder) IsNFTAllowed(ctx, classID, nftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNFTAllowed", reflect.TypeOf((*MockCircuitBreaker)(nil).IsNFTAllowed), ctx, classID, nftID)
+}
@@ -0,0 +1,53 @@
+package nft
+
+import (
+	"time"
+
+	"cosmossdk.io/core/address"
+)
+
+// Validate checks that the timelocked transfer has distinct valid sender and receiver addresses, and exactly one of a
+// release height and a release time
+func (t TimelockedTransfer) Validate(ac address.Codec) error {
+	if len(t.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+	if len(t.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+	if _, err := ac.StringToBytes(t.Sender); err != nil {
+		return ErrInvalidTimelock.Wrapf("invalid sender address (%s)", t.Sender)
+	}
+	if _, err := ac.StringToBytes(t.Receiver); err != nil {
+		return ErrInvalidTimelock.Wrapf("invalid receiver address (%s)", t.Receiver)
+	}
+	if t.Sender == t.Receiver {
+		return ErrInvalidTimelock.Wrap("sender and receiver are the same")
+	}
+	if t.RetryHeight < 0 {
+		return ErrInvalidTimelock.Wrapf("negative retry height %d", t.RetryHeight)
+	}
+	return validateRelease(t.ReleaseHeight, t.ReleaseTime)
+}
+
+// IsReleasable returns true if the release height or the release time of the timelocked transfer is reached at the
+// given block height and time.
+func (t TimelockedTransfer) IsReleasable(height int64, blockTime time.Time) bool {
+	if t.ReleaseTime != nil {
+		return !blockTime.Before(*t.ReleaseTime)
+	}
+	return height >= t.ReleaseHeight
+}
+
+// validateRelease checks that exactly one of the release height and the release time is set
+func validateRelease(height int64, releaseTime *time.Time) error {
+	switch {
+	case height < 0:
+		return ErrInvalidTimelock.Wrapf("negative release height %d", height)
+	case height > 0 && releaseTime != nil:
+		return ErrInvalidTimelock.Wrap("both release height and release time are set")
+	case height == 0 && (releaseTime == nil || releaseTime.IsZero()):
+		return ErrInvalidTimelock.Wrap("missing release height or release time")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package nft
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the fee is either a valid positive flat fee or a rate between 0 exclusive and 1, and that its
+// destination is valid
+func (f ClassTransferFee) Validate() error {
+	if len(f.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	hasRate := !f.Rate.IsNil() && !f.Rate.IsZero()
+	switch {
+	case len(f.Flat) > 0 && hasRate:
+		return ErrInvalidTransferFee.Wrapf("transfer fee of class %s has both a flat fee and a rate", f.ClassId)
+	case len(f.Flat) > 0:
+		if !f.Flat.IsValid() {
+			return ErrInvalidTransferFee.Wrapf("invalid flat fee %s of class %s", f.Flat, f.ClassId)
+		}
+	case hasRate:
+		if f.Rate.IsNegative() || f.Rate.GT(math.LegacyOneDec()) {
+			return ErrInvalidTransferFee.Wrapf("rate %s of class %s is not between 0 and 1", f.Rate, f.ClassId)
+		}
+	default:
+		return ErrInvalidTransferFee.Wrapf("transfer fee of class %s has neither a flat fee nor a rate", f.ClassId)
+	}
+
+	if f.Destination != TransferFeeDestinationClassAdmin && f.Destination != TransferFeeDestinationCommunityPool {
+		return ErrInvalidTransferFee.Wrapf("invalid destination %s of class %s", f.Destination, f.ClassId)
+	}
+	return nil
+}
+
+// IsEmpty returns true if the fee has neither a flat fee nor a rate, i.e. if it removes the transfer fee of its class
+func (f ClassTransferFee) IsEmpty() bool {
+	return len(f.Flat) == 0 && (f.Rate.IsNil() || f.Rate.IsZero())
+}
+
+// Fee returns the fee due on a transfer of a nft sold at the given price, i.e. the flat fee, or the price times the
+// rate, truncated. The price is empty for the transfers which are not sales, which are only charged a flat fee.
+func (f ClassTransferFee) Fee(price sdk.Coins) sdk.Coins {
+	if len(f.Flat) > 0 {
+		return f.Flat
+	}
+
+	fee := sdk.NewCoins()
+	if f.Rate.IsNil() {
+		return fee
+	}
+	for _, coin := range price {
+		if amount := f.Rate.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+			fee = fee.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return fee
+}
@@ -0,0 +1,60 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestClassTransferFeeValidate(t *testing.T) {
+	flat := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	rate := math.LegacyMustNewDecFromStr("0.05")
+
+	testCases := []struct {
+		name   string
+		fee    nft.ClassTransferFee
+		expErr error
+	}{
+		{"valid flat fee", nft.ClassTransferFee{ClassId: "kitty", Flat: flat, Destination: nft.TransferFeeDestinationClassAdmin}, nil},
+		{"valid rate", nft.ClassTransferFee{ClassId: "kitty", Rate: rate, Destination: nft.TransferFeeDestinationCommunityPool}, nil},
+		{"valid full rate", nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyOneDec(), Destination: nft.TransferFeeDestinationClassAdmin}, nil},
+		{"empty class id", nft.ClassTransferFee{Flat: flat, Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrEmptyClassID},
+		{"no fee", nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyZeroDec(), Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrInvalidTransferFee},
+		{"flat fee and rate", nft.ClassTransferFee{ClassId: "kitty", Flat: flat, Rate: rate, Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrInvalidTransferFee},
+		{"invalid flat fee", nft.ClassTransferFee{ClassId: "kitty", Flat: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}}, Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrInvalidTransferFee},
+		{"negative rate", nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyMustNewDecFromStr("-0.1"), Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrInvalidTransferFee},
+		{"rate above 1", nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyMustNewDecFromStr("1.1"), Destination: nft.TransferFeeDestinationClassAdmin}, nft.ErrInvalidTransferFee},
+		{"unspecified destination", nft.ClassTransferFee{ClassId: "kitty", Flat: flat}, nft.ErrInvalidTransferFee},
+		{"unknown destination", nft.ClassTransferFee{ClassId: "kitty", Flat: flat, Destination: 3}, nft.ErrInvalidTransferFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fee.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClassTransferFeeFee(t *testing.T) {
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 10))
+
+	// a flat fee is due on every transfer, whatever the price
+	flat := nft.ClassTransferFee{ClassId: "kitty", Flat: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	require.Equal(t, flat.Flat, flat.Fee(nil))
+	require.Equal(t, flat.Flat, flat.Fee(price))
+
+	// a percentage fee is due on sales only, and is truncated
+	percentage := nft.ClassTransferFee{ClassId: "kitty", Rate: math.LegacyMustNewDecFromStr("0.05")}
+	require.True(t, percentage.Fee(nil).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), percentage.Fee(price))
+}
@@ -0,0 +1,56 @@
+package nft
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// URIHash returns the uri hash committing to the given content, i.e. the hex
+// encoded SHA-256 hash of the content.
+func URIHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateURIHash returns an error if the given uri hash is not a hex encoded
+// SHA-256 hash.
+func ValidateURIHash(uriHash string) error {
+	bz, err := hex.DecodeString(uriHash)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidURIHash, "%s is not hex encoded", uriHash)
+	}
+
+	if len(bz) != sha256.Size {
+		return errors.Wrapf(ErrInvalidURIHash, "expected %d bytes, got %d", sha256.Size, len(bz))
+	}
+	return nil
+}
+
+// VerifyURIHash returns an error if the given content, usually fetched from the
+// uri of a class or of a nft, does not match the uri hash committed to on chain.
+// The comparison of hex encoded hashes is case insensitive.
+func VerifyURIHash(uriHash string, content []byte) error {
+	if err := ValidateURIHash(uriHash); err != nil {
+		return err
+	}
+
+	if actual := URIHash(content); !strings.EqualFold(actual, uriHash) {
+		return errors.Wrapf(ErrURIHashMismatch, "expected %s, got %s", uriHash, actual)
+	}
+	return nil
+}
+
+// VerifyURIHash returns an error if the given content does not match the uri
+// hash of the class.
+func (c Class) VerifyURIHash(content []byte) error {
+	return VerifyURIHash(c.UriHash, content)
+}
+
+// VerifyURIHash returns an error if the given content does not match the uri
+// hash of the nft.
+func (n NFT) VerifyURIHash(content []byte) error {
+	return VerifyURIHash(n.UriHash, content)
+}
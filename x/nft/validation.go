@@ -0,0 +1,87 @@
+package nft
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxClassIDLength is the maximum length of a class id, or of a reserved class id prefix
+	MaxClassIDLength = 100
+	// MaxNFTIDLength is the maximum length of a nft id
+	MaxNFTIDLength = 128
+	// MaxURILength is the maximum length of the uri of a class or of a nft
+	MaxURILength = 512
+)
+
+// reClassID matches the class ids: a letter followed by letters, digits and the separators /:._-, which covers the
+// ids of the classes created by the apps and of the classes transferred over IBC (ex: ibc/<hash>)
+var reClassID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]*$`)
+
+// uriSchemes is the allow-list of the schemes of the uris set by messages
+var uriSchemes = map[string]bool{
+	"http":  true,
+	"https": true,
+	"ipfs":  true,
+	"ar":    true,
+}
+
+// ValidateClassID checks that the class id is not empty, is at most MaxClassIDLength long, and starts with a letter
+// followed by letters, digits and the separators /:._-
+func ValidateClassID(classID string) error {
+	if len(classID) == 0 {
+		return ErrEmptyClassID
+	}
+	if len(classID) > MaxClassIDLength {
+		return ErrInvalidClassID.Wrapf("%d characters, the maximum is %d", len(classID), MaxClassIDLength)
+	}
+	if !reClassID.MatchString(classID) {
+		return ErrInvalidClassID.Wrapf("%s must start with a letter followed by letters, digits and /:._-", classID)
+	}
+	return nil
+}
+
+// ValidateNFTID checks that the nft id is not empty and is at most MaxNFTIDLength long
+func ValidateNFTID(nftID string) error {
+	if len(nftID) == 0 {
+		return ErrEmptyNFTID
+	}
+	if len(nftID) > MaxNFTIDLength {
+		return ErrInvalidNFTID.Wrapf("%d characters, the maximum is %d", len(nftID), MaxNFTIDLength)
+	}
+	return nil
+}
+
+// ValidateURI checks that the uri is at most MaxURILength long and, unless empty, is an absolute uri with one of the
+// http, https, ipfs and ar schemes
+func ValidateURI(uri string) error {
+	if len(uri) == 0 {
+		return nil
+	}
+	if len(uri) > MaxURILength {
+		return ErrInvalidURI.Wrapf("%d characters, the maximum is %d", len(uri), MaxURILength)
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return errors.Wrap(ErrInvalidURI, err.Error())
+	}
+	if !uriSchemes[strings.ToLower(u.Scheme)] {
+		return ErrInvalidURI.Wrapf("scheme %q of %s is not one of http, https, ipfs and ar", u.Scheme, uri)
+	}
+	return nil
+}
+
+// validateAddress checks that the address of the given role in a message is a valid account address
+func validateAddress(role, addr string) error {
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid %s address (%s)", role, addr)
+	}
+	return nil
+}
@@ -17,6 +17,12 @@ import (
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// Remove the loss records of the delegators of slashed validators which
+	// are past their retention
+	if err := k.PruneLossRecords(ctx); err != nil {
+		return err
+	}
+
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
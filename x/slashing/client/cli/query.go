@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryLossRecords(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +109,49 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryLossRecords implements the command to query the loss records of a delegator.
+func GetCmdQueryLossRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "loss-records [delegator-addr]",
+		Short: "Query the stake a delegator lost to the slashes of validators",
+		Long: strings.TrimSpace(`Query the stake a delegator lost to the slashes of validators, oldest first.
+The loss records are pruned once the loss record retention elapsed:
+
+$ <appd> query slashing loss-records cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryLossRecordsRequest{DelegatorAddress: args[0], Pagination: pageReq}
+			res, err := queryClient.LossRecords(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "loss records")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
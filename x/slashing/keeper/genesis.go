@@ -42,6 +42,12 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, record := range data.LossRecords {
+		if err := keeper.AddLossRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -77,5 +83,16 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	lossRecords := make([]types.LossRecord, 0)
+	err = keeper.IterateLossRecords(ctx, func(record types.LossRecord) (stop bool) {
+		lossRecords = append(lossRecords, record)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genState.LossRecords = lossRecords
+	return genState
 }
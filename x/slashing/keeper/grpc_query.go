@@ -82,3 +82,36 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// LossRecords returns the loss records of a delegator.
+func (k Keeper) LossRecords(ctx context.Context, req *types.QueryLossRecordsRequest) (*types.QueryLossRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	var records []types.LossRecord
+
+	recordStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.LossRecordsPrefixKey(delAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, value []byte) error {
+		var record types.LossRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLossRecordsResponse{LossRecords: records, Pagination: pageRes}, nil
+}
@@ -120,7 +120,10 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 				return err
 			}
 
-			coinsBurned := k.sk.SlashWithInfractionReason(sdkCtx, consAddr, distributionHeight, power, slashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			coinsBurned, err := k.slash(sdkCtx, consAddr, distributionHeight, power, slashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			if err != nil {
+				return err
+			}
 			sdkCtx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -131,7 +134,7 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 				),
 			)
-			k.sk.Jail(sdkCtx, consAddr)
+			k.sk.JailWithReason(sdkCtx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOWNTIME)
 
 			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
 			if err != nil {
@@ -82,10 +82,14 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction sd
 }
 
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes. It specifies an intraction reason.
+// module to make the necessary validator changes. It specifies an intraction reason. The stake
+// lost by the delegators of the validator is recorded in their loss records.
 func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	coinsBurned := k.sk.SlashWithInfractionReason(sdkCtx, consAddr, distributionHeight, power, fraction, infraction)
+	coinsBurned, err := k.slash(sdkCtx, consAddr, distributionHeight, power, fraction, infraction)
+	if err != nil {
+		return err
+	}
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -101,8 +105,15 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 // Jail attempts to jail a validator. The slash is delegated to the staking module
 // to make the necessary validator changes.
 func (k Keeper) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return k.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED)
+}
+
+// JailWithReason attempts to jail a validator and records why it was jailed.
+// The jailing is delegated to the staking module to make the necessary
+// validator changes.
+func (k Keeper) JailWithReason(ctx context.Context, consAddr sdk.ConsAddress, reason stakingtypes.JailReason) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	k.sk.Jail(sdkCtx, consAddr)
+	k.sk.JailWithReason(sdkCtx, consAddr, reason)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -84,6 +84,7 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
 
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
@@ -100,7 +101,7 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 		s.ctx.BlockHeight(),
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED).Return()
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
@@ -108,6 +109,7 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
 
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
@@ -125,8 +127,8 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
-	s.slashingKeeper.Jail(s.ctx, consAddr)
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN).Return()
+	s.slashingKeeper.JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN)
 }
 
 func TestKeeperTestSuite(t *testing.T) {
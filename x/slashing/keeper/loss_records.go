@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// LossRecordRetention - number of blocks the loss records are kept for
+func (k Keeper) LossRecordRetention(ctx context.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	return params.LossRecordRetention, err
+}
+
+// slash slashes the validator through the staking module and, unless loss
+// records are disabled, records the stake each of its delegators lost to the
+// slash. It returns the amount of tokens burned.
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, distributionHeight, power int64, fraction sdkmath.LegacyDec, infraction stakingtypes.Infraction) (sdkmath.Int, error) {
+	retention, err := k.LossRecordRetention(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	var (
+		valAddr sdk.ValAddress
+		before  map[string]sdkmath.LegacyDec
+	)
+	if retention > 0 {
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+			valAddr = validator.GetOperator()
+			before = k.delegatorStakes(ctx, valAddr)
+		}
+	}
+
+	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+	if len(before) == 0 {
+		return coinsBurned, nil
+	}
+
+	after := k.delegatorStakes(ctx, valAddr)
+
+	delegators := make([]string, 0, len(before))
+	for delegator := range before {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+
+	for _, delegator := range delegators {
+		stakeAfter, ok := after[delegator]
+		if !ok {
+			stakeAfter = sdkmath.LegacyZeroDec()
+		}
+
+		loss := before[delegator].Sub(stakeAfter).TruncateInt()
+		if !loss.IsPositive() {
+			continue
+		}
+
+		record := types.LossRecord{
+			DelegatorAddress: delegator,
+			ValidatorAddress: valAddr.String(),
+			Amount:           loss,
+			Height:           ctx.BlockHeight(),
+			Reason:           infraction,
+		}
+		if err := k.AddLossRecord(ctx, record); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+
+	return coinsBurned, nil
+}
+
+// delegatorStakes returns the tokens each delegator of the validator has at
+// stake in a slash of the validator, by delegator address: the tokens of its
+// delegation, of its unbonding delegations from the validator and of the
+// delegations its redelegations from the validator went to.
+func (k Keeper) delegatorStakes(ctx sdk.Context, valAddr sdk.ValAddress) map[string]sdkmath.LegacyDec {
+	stakes := make(map[string]sdkmath.LegacyDec)
+	add := func(delegator string, tokens sdkmath.LegacyDec) {
+		if stake, ok := stakes[delegator]; ok {
+			tokens = tokens.Add(stake)
+		}
+		stakes[delegator] = tokens
+	}
+
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return stakes
+	}
+
+	for _, delegation := range k.sk.GetValidatorDelegations(ctx, valAddr) {
+		add(delegation.DelegatorAddress, validator.TokensFromShares(delegation.Shares))
+	}
+
+	for _, ubd := range k.sk.GetUnbondingDelegationsFromValidator(ctx, valAddr) {
+		for _, entry := range ubd.Entries {
+			add(ubd.DelegatorAddress, sdkmath.LegacyNewDecFromInt(entry.Balance))
+		}
+	}
+
+	// the slash of a redelegation unbonds shares of the delegation it went to,
+	// without changing the exchange rate of the destination validator
+	for _, red := range k.sk.GetRedelegationsFromSrcValidator(ctx, valAddr) {
+		delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		dstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		dstValidator, found := k.sk.GetValidator(ctx, dstAddr)
+		if !found {
+			continue
+		}
+		delegation, found := k.sk.GetDelegation(ctx, delAddr, dstAddr)
+		if !found {
+			continue
+		}
+		add(red.DelegatorAddress, dstValidator.TokensFromShares(delegation.Shares))
+	}
+
+	return stakes
+}
+
+// AddLossRecord stores a loss record, and queues it for pruning once the loss
+// record retention has elapsed. The amount of a record for the same slash is
+// added to the stored one.
+func (k Keeper) AddLossRecord(ctx context.Context, record types.LossRecord) error {
+	delAddr, err := sdk.AccAddressFromBech32(record.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.LossRecordKey(delAddr, valAddr, record.Height, int32(record.Reason))
+	bz, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		var existing types.LossRecord
+		if err := k.cdc.Unmarshal(bz, &existing); err != nil {
+			return err
+		}
+		record.Amount = record.Amount.Add(existing.Amount)
+	}
+
+	bz, err = k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+	return store.Set(types.LossRecordQueueKey(record.Height, key), key)
+}
+
+// IterateLossRecords iterates over the stored loss records, by delegator and
+// then by height.
+func (k Keeper) IterateLossRecords(ctx context.Context, cb func(record types.LossRecord) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.LossRecordKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.LossRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			return err
+		}
+		if cb(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// PruneLossRecords removes the loss records written loss record retention
+// blocks ago or earlier.
+func (k Keeper) PruneLossRecords(ctx context.Context) error {
+	retention, err := k.LossRecordRetention(ctx)
+	if err != nil {
+		return err
+	}
+
+	// records are kept even when loss records are disabled, so that they can
+	// be enabled again without losing the recent history
+	if retention <= 0 {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height <= retention {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.LossRecordQueueKeyPrefix, types.LossRecordQueuePrefixKey(height-retention+1))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := store.Delete(iter.Value()); err != nil {
+			return err
+		}
+		if err := store.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	LossRecordRetention     = "loss_record_retention"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -46,6 +47,11 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenLossRecordRetention randomized LossRecordRetention
+func GenLossRecordRetention(r *rand.Rand) int64 {
+	return int64(r.Intn(1000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -78,9 +84,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var lossRecordRetention int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LossRecordRetention, &lossRecordRetention, simState.Rand,
+		func(r *rand.Rand) { lossRecordRetention = GenLossRecordRetention(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, lossRecordRetention,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
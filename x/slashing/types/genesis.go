@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -62,5 +64,21 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateLossRecordRetention(data.Params.LossRecordRetention); err != nil {
+		return err
+	}
+
+	for _, record := range data.LossRecords {
+		if _, err := sdk.AccAddressFromBech32(record.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid loss record delegator address: %w", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid loss record validator address: %w", err)
+		}
+		if record.Amount.IsNil() || !record.Amount.IsPositive() {
+			return fmt.Errorf("loss record amount must be positive, is %s", record.Amount)
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,11 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// loss_records defines the loss records of the delegators of slashed
+	// validators which are not pruned yet.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecords []LossRecord `protobuf:"bytes,4,rep,name=loss_records,json=lossRecords,proto3" json:"loss_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +96,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetLossRecords() []LossRecord {
+	if m != nil {
+		return m.LossRecords
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +281,37 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xe6, 0x6c, 0x07, 0xac, 0x32, 0xc2, 0x24, 0xb2, 0x51, 0x01, 0x9a,
-	0x90, 0x92, 0x68, 0xe3, 0xb8, 0x13, 0xe1, 0x30, 0x71, 0x40, 0x42, 0xe9, 0xc4, 0x81, 0x03, 0x91,
-	0xd3, 0x78, 0x9e, 0xb5, 0xc6, 0xae, 0xf2, 0x79, 0x55, 0x79, 0x03, 0x8e, 0x3c, 0x06, 0xe2, 0xc4,
-	0x81, 0x13, 0x4f, 0xd0, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xd8, 0x29, 0x0d,
-	0xa8, 0x51, 0x0f, 0xbb, 0x24, 0xb1, 0xfd, 0xfb, 0xff, 0xbf, 0xcf, 0xff, 0xd8, 0xf8, 0xd1, 0x40,
-	0x41, 0xa1, 0x20, 0x82, 0x21, 0x85, 0x0b, 0x21, 0x79, 0x34, 0x3e, 0xca, 0x98, 0xa6, 0x47, 0x11,
-	0x67, 0x92, 0x81, 0x80, 0x70, 0x54, 0x2a, 0xad, 0xc8, 0x5d, 0x8b, 0x85, 0x4b, 0x2c, 0xac, 0xb0,
-	0xbd, 0x2e, 0x57, 0x5c, 0x19, 0x26, 0xfa, 0xf3, 0x65, 0xf1, 0xbd, 0xc7, 0x4d, 0xae, 0x7f, 0xf5,
-	0x96, 0xbb, 0x67, 0xb9, 0xd4, 0x1a, 0x54, 0x35, 0xec, 0xd2, 0x6d, 0x5a, 0x08, 0xa9, 0x22, 0xf3,
-	0xb4, 0x53, 0xbd, 0xf7, 0x2d, 0xbc, 0x7d, 0x6a, 0xdb, 0xea, 0x6b, 0xaa, 0x19, 0x89, 0x71, 0x67,
-	0x44, 0x4b, 0x5a, 0x80, 0x87, 0x0e, 0xd0, 0xa1, 0x7b, 0xbc, 0x1f, 0x36, 0xb4, 0x19, 0xbe, 0x32,
-	0x58, 0xbc, 0x35, 0xfd, 0xb1, 0xef, 0x7c, 0xfc, 0xf5, 0xf9, 0x09, 0x4a, 0x2a, 0x25, 0x39, 0xc3,
-	0x3b, 0x20, 0xb8, 0x14, 0x92, 0xa7, 0x42, 0x9e, 0x2b, 0xf0, 0x5a, 0x07, 0xed, 0x43, 0xf7, 0xf8,
-	0x61, 0xa3, 0x55, 0xdf, 0xd2, 0x2f, 0xe4, 0xb9, 0xaa, 0xfb, 0x6d, 0xc3, 0x6a, 0x1e, 0xc8, 0x5b,
-	0xbc, 0x53, 0x08, 0x00, 0x96, 0xa7, 0xd9, 0x50, 0x0d, 0x2e, 0xc1, 0x6b, 0x1b, 0xd7, 0xb0, 0xd1,
-	0xf5, 0x35, 0x1d, 0x8a, 0x9c, 0x6a, 0x55, 0xbe, 0x34, 0xb2, 0xd8, 0xa8, 0xfe, 0xf1, 0x2f, 0x6a,
-	0x0b, 0xbd, 0xaf, 0x08, 0xbb, 0xb5, 0x46, 0xc8, 0x09, 0xbe, 0x49, 0xf3, 0xbc, 0x64, 0x60, 0xa3,
-	0xd8, 0x8a, 0x1f, 0x7c, 0xfb, 0x12, 0xdc, 0xaf, 0x8a, 0x3d, 0x57, 0x12, 0x98, 0x84, 0x2b, 0x78,
-	0x66, 0x91, 0xbe, 0x2e, 0x85, 0xe4, 0xc9, 0x52, 0x41, 0x24, 0xde, 0x1d, 0x2f, 0xcb, 0xa7, 0xf5,
-	0x30, 0xbc, 0x96, 0x89, 0x35, 0xd8, 0xdc, 0x75, 0x43, 0x28, 0xdd, 0xf1, 0x1a, 0xa0, 0xf7, 0x09,
-	0xe1, 0x3b, 0x6b, 0xf7, 0x7b, 0xbd, 0x6d, 0x9c, 0xfd, 0x9f, 0xf9, 0xa6, 0x3f, 0x59, 0x2b, 0xdd,
-	0x9c, 0xf4, 0x09, 0x76, 0x6b, 0x1c, 0xe9, 0xe2, 0x1b, 0x42, 0xe6, 0x6c, 0x62, 0xfa, 0x6b, 0x27,
-	0x76, 0x40, 0x76, 0x71, 0xc7, 0x8a, 0x4c, 0x62, 0xb7, 0x92, 0x6a, 0x14, 0x9f, 0x4e, 0xe7, 0x3e,
-	0x9a, 0xcd, 0x7d, 0xf4, 0x73, 0xee, 0xa3, 0x0f, 0x0b, 0xdf, 0x99, 0x2d, 0x7c, 0xe7, 0xfb, 0xc2,
-	0x77, 0xde, 0x04, 0x5c, 0xe8, 0x8b, 0xab, 0x2c, 0x1c, 0xa8, 0xa2, 0x3a, 0xf7, 0xd5, 0x2b, 0x80,
-	0xfc, 0x32, 0x9a, 0xac, 0x6e, 0x8e, 0x7e, 0x37, 0x62, 0x90, 0x75, 0xcc, 0x0d, 0x78, 0xfa, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xf1, 0x72, 0x89, 0xaf, 0x03, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0x2f, 0x77, 0xe5, 0xa0, 0xce, 0x75, 0xc0, 0x3a, 0x4a, 0xa8, 0x44, 0x5a, 0x8e, 0x3f,
+	0xaa, 0x90, 0x2e, 0x51, 0xcb, 0xd8, 0x89, 0x30, 0x54, 0x48, 0x45, 0x42, 0xb9, 0x8a, 0x81, 0x81,
+	0xc8, 0x97, 0xb8, 0xae, 0xd5, 0xc4, 0xef, 0x29, 0xaf, 0x7b, 0x2a, 0xdf, 0x82, 0x8f, 0x81, 0x98,
+	0x18, 0x98, 0xf8, 0x04, 0x1d, 0x2b, 0x06, 0xc4, 0x84, 0xd0, 0xdd, 0xc0, 0xd7, 0x40, 0xb5, 0x73,
+	0x6d, 0x40, 0x8d, 0x3a, 0xb0, 0x24, 0xb1, 0xfd, 0x7b, 0x1e, 0xdb, 0xcf, 0x9b, 0x97, 0x3c, 0x4e,
+	0x01, 0x0b, 0xc0, 0x10, 0x73, 0x86, 0x87, 0x52, 0x89, 0x70, 0xba, 0x35, 0xe6, 0x9a, 0x6d, 0x85,
+	0x82, 0x2b, 0x8e, 0x12, 0x83, 0x49, 0x09, 0x1a, 0xe8, 0x5d, 0x8b, 0x05, 0x0b, 0x2c, 0xa8, 0xb0,
+	0xb5, 0xbe, 0x00, 0x01, 0x86, 0x09, 0xcf, 0xbf, 0x2c, 0xbe, 0xf6, 0xa4, 0xc9, 0xf5, 0x42, 0x6f,
+	0xb9, 0x7b, 0x96, 0x4b, 0xac, 0x41, 0xb5, 0x87, 0x5d, 0xba, 0xcd, 0x0a, 0xa9, 0x20, 0x34, 0x4f,
+	0x3b, 0x35, 0xf8, 0xde, 0x26, 0xbd, 0x5d, 0x7b, 0xac, 0x91, 0x66, 0x9a, 0xd3, 0x88, 0x74, 0x27,
+	0xac, 0x64, 0x05, 0x7a, 0xce, 0x86, 0xb3, 0xe9, 0x6e, 0xaf, 0x07, 0x0d, 0xc7, 0x0c, 0x5e, 0x1b,
+	0x2c, 0x5a, 0x3e, 0xfd, 0xb9, 0xde, 0xfa, 0xf8, 0xfb, 0xf3, 0x53, 0x27, 0xae, 0x94, 0x74, 0x9f,
+	0xac, 0xa0, 0x14, 0x4a, 0x2a, 0x91, 0x48, 0x75, 0x00, 0xe8, 0xb5, 0x37, 0x3a, 0x9b, 0xee, 0xf6,
+	0xa3, 0x46, 0xab, 0x91, 0xa5, 0x5f, 0xaa, 0x03, 0xa8, 0xfb, 0xf5, 0xf0, 0x72, 0x1e, 0xe9, 0x3b,
+	0xb2, 0x52, 0x48, 0x44, 0x9e, 0x25, 0xe3, 0x1c, 0xd2, 0x23, 0xf4, 0x3a, 0xc6, 0x35, 0x68, 0x74,
+	0x7d, 0xc3, 0x72, 0x99, 0x31, 0x0d, 0xe5, 0x2b, 0x23, 0x8b, 0x8c, 0xea, 0x2f, 0xff, 0xa2, 0xb6,
+	0x40, 0xf7, 0x48, 0x2f, 0x07, 0xc4, 0xa4, 0xe4, 0x29, 0x94, 0x19, 0x7a, 0x4b, 0xc6, 0xfe, 0x61,
+	0xa3, 0xfd, 0x1e, 0x20, 0xc6, 0x86, 0x8d, 0x96, 0xce, 0x3d, 0x63, 0x37, 0xbf, 0x98, 0xc1, 0xc1,
+	0x57, 0x87, 0xb8, 0xb5, 0x6b, 0xd1, 0x1d, 0x72, 0x93, 0x65, 0x59, 0xc9, 0xd1, 0x06, 0xbb, 0x1c,
+	0x3d, 0xf8, 0xf6, 0x65, 0x78, 0xbf, 0xf2, 0x7e, 0x01, 0x0a, 0xb9, 0xc2, 0x63, 0x7c, 0x6e, 0x91,
+	0x91, 0x2e, 0xa5, 0x12, 0xf1, 0x42, 0x41, 0x15, 0x59, 0x9d, 0x2e, 0x2e, 0x93, 0xd4, 0xa3, 0xf5,
+	0xda, 0xa6, 0x48, 0xc3, 0xeb, 0x33, 0x68, 0x88, 0xb8, 0x3f, 0xbd, 0x02, 0x18, 0x7c, 0x72, 0xc8,
+	0x9d, 0x2b, 0xd3, 0xfb, 0xbf, 0x6b, 0xec, 0xff, 0x5b, 0xc1, 0xeb, 0xfe, 0x8b, 0xda, 0xd6, 0x8d,
+	0x75, 0x1b, 0xec, 0x10, 0xb7, 0xc6, 0xd1, 0x3e, 0xb9, 0x21, 0x55, 0xc6, 0x4f, 0xcc, 0xf9, 0x3a,
+	0xb1, 0x1d, 0xd0, 0x55, 0xd2, 0xb5, 0x22, 0x93, 0xd8, 0xad, 0xb8, 0x1a, 0x45, 0xbb, 0xa7, 0x33,
+	0xdf, 0x39, 0x9b, 0xf9, 0xce, 0xaf, 0x99, 0xef, 0x7c, 0x98, 0xfb, 0xad, 0xb3, 0xb9, 0xdf, 0xfa,
+	0x31, 0xf7, 0x5b, 0x6f, 0x87, 0x42, 0xea, 0xc3, 0xe3, 0x71, 0x90, 0x42, 0x51, 0x75, 0x51, 0xf5,
+	0x1a, 0x62, 0x76, 0x14, 0x9e, 0x5c, 0xf6, 0xa1, 0x7e, 0x3f, 0xe1, 0x38, 0xee, 0x9a, 0x7e, 0x7a,
+	0xf6, 0x67, 0x00, 0xf9, 0xf7, 0xe8, 0x66, 0xfd, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +334,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LossRecords) > 0 {
+		for iNdEx := len(m.LossRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LossRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +542,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LossRecords) > 0 {
+		for _, e := range m.LossRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -702,6 +736,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossRecords = append(m.LossRecords, LossRecord{})
+			if err := m.LossRecords[len(m.LossRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,12 +46,18 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<delAddrLen (1 Byte)><delAddr_Bytes><height (8 Bytes)><valAddrLen (1 Byte)><valAddr_Bytes><reason (4 Bytes)>: LossRecord
+//
+// - 0x05<height (8 Bytes)><loss_record_key>: loss_record_key
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	LossRecordKeyPrefix                 = []byte{0x04} // Prefix for delegator loss records
+	LossRecordQueueKeyPrefix            = []byte{0x05} // Prefix for the pruning queue of the loss records
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +93,32 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// LossRecordsPrefixKey returns the key prefix of the loss records of a
+// delegator, which are ordered by height.
+func LossRecordsPrefixKey(delAddr sdk.AccAddress) []byte {
+	return append(LossRecordKeyPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// LossRecordKey returns the key of the loss record of a delegator for the
+// slash of a validator at the given height for the given reason.
+func LossRecordKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64, reason int32) []byte {
+	key := append(LossRecordsPrefixKey(delAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, address.MustLengthPrefix(valAddr.Bytes())...)
+
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(reason))
+	return append(key, bz...)
+}
+
+// LossRecordQueuePrefixKey returns the key prefix of the pruning queue entries
+// of the loss records written at the given height.
+func LossRecordQueuePrefixKey(height int64) []byte {
+	return append(LossRecordQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// LossRecordQueueKey returns the key of the pruning queue entry of a loss
+// record written at the given height.
+func LossRecordQueueKey(height int64, recordKey []byte) []byte {
+	return append(LossRecordQueuePrefixKey(height), recordKey...)
+}
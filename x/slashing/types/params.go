@@ -11,6 +11,7 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultLossRecordRetention  = int64(100000)
 )
 
 var (
@@ -22,7 +23,7 @@ var (
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, lossRecordRetention int64,
 ) Params {
 	return Params{
 		SignedBlocksWindow:      signedBlocksWindow,
@@ -30,6 +31,7 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		LossRecordRetention:     lossRecordRetention,
 	}
 }
 
@@ -41,6 +43,7 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultLossRecordRetention,
 	)
 }
 
@@ -61,6 +64,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateLossRecordRetention(p.LossRecordRetention); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +152,16 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateLossRecordRetention(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("loss record retention cannot be negative: %d", v)
+	}
+
+	return nil
+}
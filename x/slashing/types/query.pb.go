@@ -309,6 +309,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryLossRecordsRequest is the request type for the Query/LossRecords RPC
+// method
+type QueryLossRecordsRequest struct {
+	// delegator_address is the address of the delegator to query the loss records of
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLossRecordsRequest) Reset()         { *m = QueryLossRecordsRequest{} }
+func (m *QueryLossRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLossRecordsRequest) ProtoMessage()    {}
+func (*QueryLossRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryLossRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLossRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLossRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLossRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLossRecordsRequest.Merge(m, src)
+}
+func (m *QueryLossRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLossRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLossRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLossRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryLossRecordsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryLossRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLossRecordsResponse is the response type for the Query/LossRecords RPC
+// method
+type QueryLossRecordsResponse struct {
+	// loss_records are the loss records of the delegator
+	LossRecords []LossRecord        `protobuf:"bytes,1,rep,name=loss_records,json=lossRecords,proto3" json:"loss_records"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLossRecordsResponse) Reset()         { *m = QueryLossRecordsResponse{} }
+func (m *QueryLossRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLossRecordsResponse) ProtoMessage()    {}
+func (*QueryLossRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryLossRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLossRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLossRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLossRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLossRecordsResponse.Merge(m, src)
+}
+func (m *QueryLossRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLossRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLossRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLossRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryLossRecordsResponse) GetLossRecords() []LossRecord {
+	if m != nil {
+		return m.LossRecords
+	}
+	return nil
+}
+
+func (m *QueryLossRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +426,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryLossRecordsRequest)(nil), "cosmos.slashing.v1beta1.QueryLossRecordsRequest")
+	proto.RegisterType((*QueryLossRecordsResponse)(nil), "cosmos.slashing.v1beta1.QueryLossRecordsResponse")
 }
 
 func init() {
@@ -323,43 +435,49 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0x33, 0xfd, 0xfd, 0x5a, 0xe8, 0xa4, 0x48, 0x3b, 0x16, 0x9a, 0x06, 0xd9, 0xea, 0x16,
+	0xda, 0x52, 0xcd, 0xae, 0x89, 0x88, 0x48, 0xf1, 0xd0, 0x80, 0x2d, 0x42, 0x0f, 0x36, 0x85, 0x82,
+	0x5e, 0xc2, 0x6c, 0x76, 0x3a, 0x5d, 0xdc, 0xcc, 0x6c, 0x77, 0x36, 0xc1, 0x52, 0xea, 0xc1, 0xb3,
+	0x07, 0xc1, 0xbf, 0x41, 0x28, 0x9e, 0x54, 0xd4, 0xbf, 0xa1, 0xc7, 0xa2, 0x17, 0x4f, 0x22, 0x89,
+	0xe0, 0xbf, 0x21, 0x99, 0x99, 0x24, 0x1b, 0xb6, 0x6b, 0x13, 0xf5, 0x92, 0x2c, 0x6f, 0xde, 0xf7,
+	0xbd, 0xcf, 0x7c, 0x77, 0xde, 0x2c, 0x5c, 0xac, 0x71, 0x51, 0xe7, 0xc2, 0x16, 0x3e, 0x16, 0xfb,
+	0x1e, 0xa3, 0x76, 0xb3, 0xe8, 0x90, 0x08, 0x17, 0xed, 0x83, 0x06, 0x09, 0x0f, 0xad, 0x20, 0xe4,
+	0x11, 0x47, 0x73, 0x2a, 0xc9, 0xea, 0x26, 0x59, 0x3a, 0x29, 0xbf, 0xaa, 0xd5, 0x0e, 0x16, 0x44,
+	0x29, 0x7a, 0xfa, 0x00, 0x53, 0x8f, 0xe1, 0xc8, 0xe3, 0x4c, 0x15, 0xc9, 0xcf, 0x52, 0x4e, 0xb9,
+	0x7c, 0xb4, 0x3b, 0x4f, 0x3a, 0x7a, 0x85, 0x72, 0x4e, 0x7d, 0x62, 0xe3, 0xc0, 0xb3, 0x31, 0x63,
+	0x3c, 0x92, 0x12, 0xa1, 0x57, 0x97, 0xd2, 0xe8, 0x7a, 0x24, 0x2a, 0x6f, 0x5e, 0xe5, 0x55, 0x55,
+	0x79, 0x4d, 0xab, 0x96, 0x66, 0x70, 0xdd, 0x63, 0xdc, 0x96, 0xbf, 0x2a, 0x64, 0xce, 0x42, 0xb4,
+	0xdd, 0x61, 0x7d, 0x88, 0x43, 0x5c, 0x17, 0x15, 0x72, 0xd0, 0x20, 0x22, 0x32, 0x1f, 0xc1, 0xcb,
+	0x03, 0x51, 0x11, 0x70, 0x26, 0x08, 0x2a, 0xc3, 0x89, 0x40, 0x46, 0x72, 0xe0, 0x2a, 0x58, 0xc9,
+	0x96, 0x16, 0xac, 0x14, 0x33, 0x2c, 0x25, 0x2c, 0x4f, 0x9e, 0x7e, 0x5b, 0xc8, 0x9c, 0xfc, 0x7c,
+	0xbb, 0x0a, 0x2a, 0x5a, 0x69, 0xee, 0xc2, 0x39, 0x59, 0x7a, 0xc7, 0xa3, 0xcc, 0x63, 0xf4, 0x01,
+	0xdb, 0xe3, 0xba, 0x2b, 0x5a, 0x83, 0x53, 0x35, 0xce, 0x44, 0x15, 0xbb, 0x6e, 0x48, 0x84, 0x6a,
+	0x32, 0x59, 0xce, 0x7d, 0xfe, 0x50, 0x98, 0xd5, 0x7d, 0xd6, 0xd5, 0xca, 0x4e, 0x14, 0x7a, 0x8c,
+	0x56, 0xb2, 0x9d, 0x6c, 0x1d, 0x32, 0x9f, 0xc1, 0x5c, 0xb2, 0xae, 0xe6, 0x76, 0xe0, 0x74, 0x13,
+	0xfb, 0x55, 0xa1, 0x96, 0xaa, 0x1e, 0xdb, 0xe3, 0x7a, 0x07, 0x85, 0xd4, 0x1d, 0xec, 0x62, 0xdf,
+	0x73, 0x71, 0xc4, 0xc3, 0x58, 0xc1, 0xf8, 0x7e, 0x2e, 0x35, 0xb1, 0x1f, 0x5b, 0x32, 0x9d, 0x64,
+	0xff, 0xae, 0x9d, 0x68, 0x03, 0xc2, 0xfe, 0x11, 0xd0, 0x9d, 0x97, 0xba, 0x9d, 0x3b, 0xe7, 0xc5,
+	0x52, 0x27, 0xac, 0xef, 0x1e, 0x25, 0x5a, 0x5b, 0x89, 0x29, 0xcd, 0xf7, 0x00, 0xce, 0x9f, 0xd3,
+	0x44, 0xef, 0x72, 0x0b, 0xfe, 0xaf, 0x77, 0xf6, 0xdf, 0x5f, 0xed, 0x4c, 0x56, 0x41, 0x9b, 0x03,
+	0xcc, 0x63, 0x92, 0x79, 0xf9, 0x42, 0x66, 0x85, 0x32, 0x00, 0x7d, 0x02, 0xf4, 0x1b, 0xdf, 0xe2,
+	0x42, 0x54, 0x48, 0x8d, 0x87, 0x6e, 0xcf, 0x98, 0xfb, 0x70, 0xc6, 0x25, 0x3e, 0xa1, 0x1d, 0x9a,
+	0xa1, 0x5f, 0xfb, 0x74, 0x4f, 0xa2, 0xe3, 0x68, 0xe3, 0x1c, 0xd6, 0x3f, 0xf1, 0xf7, 0x13, 0x80,
+	0xb9, 0x24, 0xaa, 0xb6, 0x77, 0x1b, 0x4e, 0xf9, 0x5c, 0x88, 0x6a, 0xa8, 0xe2, 0xda, 0xe6, 0xc5,
+	0x54, 0x9b, 0xfb, 0x35, 0xe2, 0xe6, 0x66, 0xfd, 0x7e, 0xe9, 0x7f, 0xe6, 0x71, 0xe9, 0xcd, 0x38,
+	0x1c, 0x97, 0xe0, 0xe8, 0x05, 0x80, 0x13, 0x6a, 0xf8, 0xd0, 0xf5, 0x54, 0xb4, 0xe4, 0xc4, 0xe7,
+	0x6f, 0x0c, 0x97, 0xac, 0x7a, 0x9b, 0xcb, 0xcf, 0xbf, 0xfc, 0x78, 0x35, 0x76, 0x0d, 0x2d, 0xd8,
+	0x69, 0x97, 0x92, 0x9a, 0x76, 0xf4, 0x0e, 0xc0, 0x6c, 0xec, 0x98, 0xa1, 0x9b, 0xbf, 0x6f, 0x93,
+	0xbc, 0x14, 0xf2, 0xc5, 0x11, 0x14, 0x9a, 0xee, 0x9e, 0xa4, 0xbb, 0x83, 0x6e, 0xa7, 0xd2, 0xc5,
+	0x6f, 0x02, 0x61, 0x1f, 0xc5, 0x6f, 0x9d, 0x63, 0xf4, 0x1a, 0xc0, 0xa9, 0x58, 0x59, 0x81, 0x86,
+	0x47, 0xe8, 0xd9, 0x59, 0x1a, 0x45, 0xa2, 0xb1, 0x2d, 0x89, 0xbd, 0x82, 0x96, 0x86, 0xc3, 0x46,
+	0x1f, 0x01, 0xcc, 0xc6, 0x0e, 0xea, 0x45, 0xde, 0x26, 0xc7, 0x2f, 0x5f, 0x1c, 0x41, 0xa1, 0x21,
+	0xd7, 0x25, 0xe4, 0x1a, 0xba, 0x9b, 0x0a, 0x19, 0x1f, 0x12, 0xfb, 0x28, 0x31, 0xde, 0xc7, 0xe5,
+	0xcd, 0xd3, 0x96, 0x01, 0xce, 0x5a, 0x06, 0xf8, 0xde, 0x32, 0xc0, 0xcb, 0xb6, 0x91, 0x39, 0x6b,
+	0x1b, 0x99, 0xaf, 0x6d, 0x23, 0xf3, 0xb8, 0x40, 0xbd, 0x68, 0xbf, 0xe1, 0x58, 0x35, 0x5e, 0xef,
+	0x96, 0x57, 0x7f, 0x05, 0xe1, 0x3e, 0xb1, 0x9f, 0xf6, 0x7b, 0x45, 0x87, 0x01, 0x11, 0xce, 0x84,
+	0xfc, 0x84, 0xdd, 0xfa, 0x35, 0x00, 0xed, 0x66, 0x0e, 0x62, 0xb8, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +498,12 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// LossRecords queries the stake a delegator lost to the slashes of
+	// validators, oldest first. Loss records are only kept for the
+	// loss_record_retention blocks following the slash.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecords(ctx context.Context, in *QueryLossRecordsRequest, opts ...grpc.CallOption) (*QueryLossRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +541,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) LossRecords(ctx context.Context, in *QueryLossRecordsRequest, opts ...grpc.CallOption) (*QueryLossRecordsResponse, error) {
+	out := new(QueryLossRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/LossRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +558,12 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// LossRecords queries the stake a delegator lost to the slashes of
+	// validators, oldest first. Loss records are only kept for the
+	// loss_record_retention blocks following the slash.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecords(context.Context, *QueryLossRecordsRequest) (*QueryLossRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +579,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) LossRecords(ctx context.Context, req *QueryLossRecordsRequest) (*QueryLossRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LossRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +641,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LossRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLossRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LossRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/LossRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LossRecords(ctx, req.(*QueryLossRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +675,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "LossRecords",
+			Handler:    _Query_LossRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +887,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLossRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLossRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLossRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLossRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLossRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLossRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LossRecords) > 0 {
+		for iNdEx := len(m.LossRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LossRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1065,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLossRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLossRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LossRecords) > 0 {
+		for _, e := range m.LossRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1611,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLossRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLossRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLossRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLossRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLossRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLossRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossRecords = append(m.LossRecords, LossRecord{})
+			if err := m.LossRecords[len(m.LossRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
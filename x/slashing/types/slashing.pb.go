@@ -4,10 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -134,6 +136,12 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// loss_record_retention is the number of blocks the loss records of the
+	// delegators of slashed validators are kept for. Loss records are not written
+	// if it is zero.
+	//
+	// Since: cosmos-sdk 0.50
+	LossRecordRetention int64 `protobuf:"varint,6,opt,name=loss_record_retention,json=lossRecordRetention,proto3" json:"loss_record_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,9 +191,95 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetLossRecordRetention() int64 {
+	if m != nil {
+		return m.LossRecordRetention
+	}
+	return 0
+}
+
+// LossRecord records the stake a delegator lost to the slash of a validator,
+// either from its delegation, its unbonding delegations or its redelegations.
+//
+// Since: cosmos-sdk 0.50
+type LossRecord struct {
+	// delegator_address is the address of the delegator who lost stake.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of staking tokens the delegator lost.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// height is the height at which the slash was executed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// reason is the infraction the validator was slashed for.
+	Reason types.Infraction `protobuf:"varint,5,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"reason,omitempty"`
+}
+
+func (m *LossRecord) Reset()         { *m = LossRecord{} }
+func (m *LossRecord) String() string { return proto.CompactTextString(m) }
+func (*LossRecord) ProtoMessage()    {}
+func (*LossRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *LossRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LossRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LossRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LossRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LossRecord.Merge(m, src)
+}
+func (m *LossRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LossRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LossRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LossRecord proto.InternalMessageInfo
+
+func (m *LossRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *LossRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *LossRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LossRecord) GetReason() types.Infraction {
+	if m != nil {
+		return m.Reason
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*LossRecord)(nil), "cosmos.slashing.v1beta1.LossRecord")
 }
 
 func init() {
@@ -193,47 +287,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0x2b, 0x45,
+	0x10, 0xf6, 0x25, 0x7e, 0x86, 0xb7, 0x0e, 0xe8, 0x65, 0x71, 0x5e, 0xee, 0x59, 0x70, 0x76, 0x22,
+	0xf4, 0x64, 0x45, 0xf2, 0x5d, 0x62, 0xba, 0x50, 0xe1, 0x04, 0x14, 0x43, 0x04, 0xd1, 0x85, 0x1f,
+	0x12, 0x05, 0xa7, 0xbd, 0xdb, 0xf5, 0x79, 0xf1, 0xdd, 0xae, 0x75, 0xbb, 0xce, 0x0f, 0xd1, 0xd0,
+	0xd0, 0x50, 0xa5, 0x44, 0x54, 0x94, 0x29, 0x53, 0xe4, 0x1f, 0xa0, 0x4b, 0x19, 0xa5, 0x42, 0x14,
+	0x01, 0x39, 0x45, 0xf8, 0x17, 0xe8, 0xd0, 0xed, 0xee, 0x39, 0x89, 0x23, 0x51, 0xa5, 0xb1, 0x6f,
+	0x67, 0xbe, 0x99, 0x6f, 0xe7, 0xdb, 0x99, 0x01, 0xaf, 0x23, 0x2e, 0x52, 0x2e, 0x3c, 0x91, 0x20,
+	0x31, 0xa0, 0x2c, 0xf6, 0x0e, 0x36, 0x42, 0x22, 0xd1, 0xc6, 0xd4, 0xe0, 0x8e, 0x32, 0x2e, 0x39,
+	0x5c, 0xd6, 0x38, 0x77, 0x6a, 0x36, 0xb8, 0x7a, 0x2d, 0xe6, 0x31, 0x57, 0x18, 0x2f, 0xff, 0xd2,
+	0xf0, 0xba, 0x13, 0x73, 0x1e, 0x27, 0xc4, 0x53, 0xa7, 0x70, 0xdc, 0xf7, 0xf0, 0x38, 0x43, 0x92,
+	0x72, 0x66, 0xfc, 0x8d, 0x59, 0xbf, 0xa4, 0x29, 0x11, 0x12, 0xa5, 0x23, 0x03, 0x78, 0xa5, 0xf9,
+	0x02, 0x9d, 0xd9, 0x90, 0x6b, 0xd7, 0x22, 0x4a, 0x29, 0xe3, 0x9e, 0xfa, 0x35, 0xa6, 0xf7, 0x8b,
+	0x2a, 0x24, 0x1a, 0x3e, 0x28, 0x42, 0x9f, 0x35, 0x6a, 0xf5, 0xf7, 0x39, 0x50, 0xfb, 0x1a, 0x25,
+	0x14, 0x23, 0xc9, 0xb3, 0x7d, 0x1a, 0x33, 0xca, 0xe2, 0x1e, 0xeb, 0x73, 0xf8, 0x21, 0x78, 0x03,
+	0x61, 0x9c, 0x11, 0x21, 0x6c, 0xab, 0x69, 0xb5, 0x9e, 0x77, 0x57, 0xae, 0xce, 0xdb, 0xef, 0x19,
+	0xd2, 0x2d, 0xce, 0x04, 0x61, 0x62, 0x2c, 0x3e, 0xd2, 0x90, 0x7d, 0x99, 0x51, 0x16, 0xfb, 0x45,
+	0x04, 0x5c, 0x01, 0x0b, 0x42, 0xa2, 0x4c, 0x06, 0x03, 0x42, 0xe3, 0x81, 0xb4, 0xe7, 0x9a, 0x56,
+	0x6b, 0xde, 0xaf, 0x2a, 0xdb, 0x8e, 0x32, 0xe5, 0x10, 0xca, 0x30, 0x39, 0x0a, 0x78, 0xbf, 0x2f,
+	0x88, 0xb4, 0xe7, 0x35, 0x44, 0xd9, 0xbe, 0x50, 0x26, 0xb8, 0x0b, 0x16, 0xbe, 0x47, 0x34, 0x21,
+	0x38, 0x18, 0x33, 0x49, 0x13, 0xbb, 0xdc, 0xb4, 0x5a, 0xd5, 0x4e, 0xdd, 0xd5, 0x3a, 0xb9, 0x85,
+	0x4e, 0xee, 0x97, 0x85, 0x4e, 0xdd, 0xb7, 0x2e, 0xae, 0x1b, 0xa5, 0x93, 0xbf, 0x1a, 0xd6, 0xe9,
+	0xed, 0xd9, 0x9a, 0xe5, 0x57, 0x75, 0xf8, 0x57, 0x79, 0x34, 0x74, 0x00, 0x90, 0x3c, 0x0d, 0x85,
+	0xe4, 0x8c, 0x60, 0xfb, 0x59, 0xd3, 0x6a, 0xbd, 0xe9, 0xdf, 0xb3, 0xc0, 0x0e, 0x58, 0x4a, 0xa9,
+	0x10, 0x04, 0x07, 0x61, 0xc2, 0xa3, 0xa1, 0x08, 0x22, 0x3e, 0x66, 0x92, 0x64, 0x76, 0x45, 0xdd,
+	0xec, 0x1d, 0xed, 0xec, 0x2a, 0xdf, 0x96, 0x76, 0x6d, 0x96, 0xff, 0xf9, 0xad, 0x61, 0xad, 0xfe,
+	0x5b, 0x06, 0x95, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0x75, 0x50, 0x13, 0x34, 0x66, 0x77, 0x49, 0x0e,
+	0x29, 0xc3, 0xfc, 0x50, 0x49, 0x38, 0xef, 0x43, 0xed, 0xd3, 0x39, 0xbe, 0x51, 0x1e, 0xf8, 0x43,
+	0x4e, 0xcb, 0x02, 0x13, 0x35, 0x22, 0x59, 0x11, 0x92, 0x6b, 0xb6, 0xd0, 0xdd, 0xc9, 0x2b, 0xfa,
+	0xf3, 0xba, 0xf1, 0x3a, 0xa6, 0x72, 0x30, 0x0e, 0xdd, 0x88, 0xa7, 0xe6, 0xe5, 0xcd, 0x5f, 0x5b,
+	0xe0, 0xa1, 0x27, 0x8f, 0x47, 0x44, 0xb8, 0xdb, 0x24, 0xfa, 0xf5, 0xf6, 0x6c, 0xed, 0x85, 0x69,
+	0x13, 0x4c, 0xa2, 0x20, 0x3c, 0x96, 0x44, 0x68, 0x31, 0x60, 0x4a, 0xd9, 0xbe, 0x62, 0xd9, 0x23,
+	0x99, 0x21, 0xff, 0x0e, 0xbc, 0xc4, 0xfc, 0x90, 0xe5, 0x8d, 0x16, 0xe4, 0x5a, 0x05, 0x45, 0x4b,
+	0xaa, 0xe7, 0xa8, 0x76, 0x5e, 0x3d, 0xd2, 0x7a, 0xdb, 0x00, 0xb4, 0xd4, 0xbf, 0x4c, 0xa5, 0xae,
+	0x15, 0x79, 0x3e, 0x45, 0x34, 0x29, 0x40, 0xf0, 0x27, 0x0b, 0xd4, 0xd5, 0x74, 0x04, 0xfd, 0x0c,
+	0x45, 0xb9, 0x29, 0xc0, 0x7c, 0x1c, 0x26, 0x44, 0xd5, 0x6b, 0x97, 0x9f, 0xb8, 0xc4, 0x65, 0xc5,
+	0xf5, 0x89, 0xa1, 0xda, 0x56, 0x4c, 0x79, 0xc9, 0xf0, 0x47, 0x0b, 0x2c, 0x3f, 0xba, 0x87, 0xbe,
+	0xaf, 0xfd, 0xec, 0x89, 0x2f, 0xb1, 0x34, 0x73, 0x09, 0x4d, 0x93, 0xb7, 0x57, 0xc2, 0x85, 0x08,
+	0x32, 0x12, 0xf1, 0x0c, 0x07, 0x19, 0x91, 0x84, 0x29, 0xa5, 0x4d, 0x7b, 0xe5, 0x4e, 0x5f, 0xf9,
+	0xfc, 0xc2, 0xb5, 0xb9, 0xf2, 0xf3, 0xed, 0xd9, 0xda, 0xbb, 0xf7, 0xf8, 0x8f, 0xee, 0x76, 0x92,
+	0x6e, 0xb8, 0x7c, 0x7e, 0xc1, 0xee, 0x34, 0x14, 0x7e, 0x0c, 0x16, 0x31, 0x49, 0x48, 0x9c, 0x4f,
+	0x73, 0xf0, 0x70, 0x7e, 0xed, 0xab, 0xf3, 0x76, 0xcd, 0xcc, 0xef, 0xc3, 0xb1, 0x7d, 0x31, 0x0d,
+	0x31, 0x76, 0xf8, 0x39, 0x58, 0x3c, 0x28, 0x96, 0xc2, 0x34, 0xcd, 0xdc, 0xa3, 0x35, 0x30, 0x5d,
+	0x1c, 0x33, 0xf9, 0x0e, 0x66, 0xec, 0x70, 0x07, 0x54, 0x50, 0x9a, 0xcf, 0x8c, 0xea, 0xab, 0xe7,
+	0xdd, 0x75, 0xa3, 0xf6, 0x92, 0x4e, 0x24, 0xf0, 0xd0, 0xa5, 0xdc, 0x4b, 0x91, 0x1c, 0xb8, 0x3d,
+	0x26, 0xaf, 0xce, 0xdb, 0xc0, 0x30, 0xf4, 0x98, 0xd4, 0xaa, 0x9a, 0x78, 0xf8, 0x12, 0x54, 0xcc,
+	0x4e, 0x29, 0x2b, 0xdd, 0xcc, 0x09, 0x6e, 0x82, 0x4a, 0x46, 0x90, 0xe0, 0x4c, 0xbd, 0xe7, 0xdb,
+	0x9d, 0x55, 0xd7, 0x64, 0x28, 0xd6, 0x9d, 0x59, 0x7f, 0x6e, 0x8f, 0x15, 0x2d, 0xe0, 0x9b, 0x88,
+	0xee, 0x67, 0xa7, 0x13, 0xc7, 0xba, 0x98, 0x38, 0xd6, 0xe5, 0xc4, 0xb1, 0xfe, 0x9e, 0x38, 0xd6,
+	0xc9, 0x8d, 0x53, 0xba, 0xbc, 0x71, 0x4a, 0x7f, 0xdc, 0x38, 0xa5, 0x6f, 0xdb, 0xff, 0xdb, 0x11,
+	0xf7, 0x5e, 0x44, 0x35, 0x47, 0x58, 0x51, 0xa3, 0xf2, 0xc1, 0x7f, 0x03, 0x00, 0xd3, 0x24, 0x96,
+	0x3d, 0x45, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -309,6 +414,45 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.LossRecordRetention != that1.LossRecordRetention {
+		return false
+	}
+	return true
+}
+func (this *LossRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LossRecord)
+	if !ok {
+		that2, ok := that.(LossRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -394,6 +538,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LossRecordRetention != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LossRecordRetention))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -440,6 +589,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LossRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LossRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LossRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -495,6 +701,34 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.LossRecordRetention != 0 {
+		n += 1 + sovSlashing(uint64(m.LossRecordRetention))
+	}
+	return n
+}
+
+func (m *LossRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
 	return n
 }
 
@@ -876,6 +1110,211 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossRecordRetention", wireType)
+			}
+			m.LossRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossRecordRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LossRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LossRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LossRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
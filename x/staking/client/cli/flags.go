@@ -32,6 +32,14 @@ const (
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
 	FlagP2PPort       = "p2p-port"
+
+	FlagExportFormat          = "format"
+	FlagMinStake              = "min-stake"
+	FlagExcludeModuleAccounts = "exclude-module-accounts"
+	FlagBatchSize             = "batch-size"
+
+	FlagTopN      = "top-n"
+	FlagThreshold = "threshold"
 )
 
 // common flagsets to add to various functions
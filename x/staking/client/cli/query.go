@@ -1,16 +1,26 @@
 package cli
 
 import (
+	"context"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -32,14 +42,30 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryUnbondingDelegations(ac),
 		GetCmdQueryRedelegation(ac),
 		GetCmdQueryRedelegations(ac),
+		GetCmdQueryAutoRedelegation(ac),
 		GetCmdQueryValidator(),
 		GetCmdQueryValidators(),
+		GetCmdQueryValidatorsBelowMinCommission(),
+		GetCmdQueryValidatorsBelowMinSelfDelegation(),
+		GetCmdQueryInactiveValidators(),
+		GetCmdQueryTombstonedValidators(),
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryValidatorJailHistory(),
+		GetCmdQueryValidatorAddresses(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryHistoricalInfoSize(),
+		GetCmdQueryValidatorSetCheckpoint(),
+		GetCmdQueryExportValidatorSetCheckpoints(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryYieldInputs(),
+		GetCmdQueryPowerDistribution(),
+		GetCmdQueryTopDelegators(),
+		GetCmdQueryValidateCreateValidator(),
+		GetCmdQueryExportDelegations(),
+		GetCmdQueryExportValidatorDelegations(),
 	)
 
 	return stakingQueryCmd
@@ -75,16 +101,19 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 
 			params := &types.QueryValidatorRequest{ValidatorAddr: addr.String()}
-			res, err := queryClient.Validator(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
+			return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+				res, err := queryClient.Validator(ctx, params)
+				if err != nil {
+					return nil, err
+				}
 
-			return clientCtx.PrintProto(&res.Validator)
+				return &res.Validator, nil
+			})
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
 
 	return cmd
 }
@@ -133,6 +162,187 @@ $ %s query staking validators
 	return cmd
 }
 
+// GetCmdQueryValidatorsBelowMinCommission implements the query of the
+// validators whose commission rate is below the minimum commission rate.
+func GetCmdQueryValidatorsBelowMinCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-below-min-commission",
+		Short: "Query for the validators whose commission rate is below the minimum commission rate",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose commission rate is below the minimum commission rate of the params.
+
+Example:
+$ %s query staking validators-below-min-commission
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.ValidatorsBelowMinCommission(cmd.Context(), &types.QueryValidatorsBelowMinCommissionRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators below the min commission")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorsBelowMinSelfDelegation implements the query of the
+// validators whose self-delegation is below their minimum command.
+func GetCmdQueryValidatorsBelowMinSelfDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-below-min-self-delegation",
+		Short: "Query for the validators whose self-delegation is below their minimum self-delegation",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose self-delegation fell below their minimum self-delegation and which
+are not jailed yet, with the time at which they are jailed unless they top up their self-delegation.
+
+Example:
+$ %s query staking validators-below-min-self-delegation
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.ValidatorsBelowMinSelfDelegation(cmd.Context(), &types.QueryValidatorsBelowMinSelfDelegationRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators below their min self-delegation")
+
+	return cmd
+}
+
+// GetCmdQueryInactiveValidators implements the query of the validators to be
+// removed by the inactive validator cleanup command.
+func GetCmdQueryInactiveValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inactive-validators",
+		Short: "Query for the validators to be removed by the inactive validator cleanup",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators which have been unbonded with no delegator but their operator for longer
+than the inactive validator cleanup period, and whose self-delegations are force-undelegated before they are removed,
+unless the cleanup runs in dry-run mode. No validator is returned if the cleanup is disabled.
+
+Example:
+$ %s query staking inactive-validators
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.InactiveValidators(cmd.Context(), &types.QueryInactiveValidatorsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inactive validators")
+
+	return cmd
+}
+
+// GetCmdQueryTombstonedValidators implements the query of the tombstoned
+// validators which are still stored command.
+func GetCmdQueryTombstonedValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tombstoned-validators",
+		Short: "Query for the tombstoned validators which can still be delegated to",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators which are tombstoned by the slashing module but are still stored by the
+staking module. They can never be unjailed, so delegations to them never earn rewards. Such delegations are rejected
+if the reject-tombstoned-delegations param is enabled.
+
+Example:
+$ %s query staking tombstoned-validators
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.TombstonedValidators(cmd.Context(), &types.QueryTombstonedValidatorsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tombstoned validators")
+
+	return cmd
+}
+
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -273,16 +483,19 @@ func GetCmdQueryDelegation(ac address.Codec) *cobra.Command {
 				ValidatorAddr: valAddr.String(),
 			}
 
-			res, err := queryClient.Delegation(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
+			return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+				res, err := queryClient.Delegation(ctx, params)
+				if err != nil {
+					return nil, err
+				}
 
-			return clientCtx.PrintProto(res.DelegationResponse)
+				return res.DelegationResponse, nil
+			})
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
 
 	return cmd
 }
@@ -438,16 +651,19 @@ $ %s query staking unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
 				ValidatorAddr: valAddr.String(),
 			}
 
-			res, err := queryClient.UnbondingDelegation(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
+			return client.PrintOrWatchQuery(cmd, clientCtx, func(ctx context.Context) (proto.Message, error) {
+				res, err := queryClient.UnbondingDelegation(ctx, params)
+				if err != nil {
+					return nil, err
+				}
 
-			return clientCtx.PrintProto(&res.Unbond)
+				return &res.Unbond, nil
+			})
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
 
 	return cmd
 }
@@ -665,21 +881,25 @@ $ %s query staking historical-info 5
 	return cmd
 }
 
-// GetCmdQueryPool implements the pool query command.
-func GetCmdQueryPool() *cobra.Command {
+// GetCmdQueryAutoRedelegation implements the command to query the
+// auto-redelegation preference of a delegator.
+func GetCmdQueryAutoRedelegation(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
 	cmd := &cobra.Command{
-		Use:   "pool",
-		Args:  cobra.NoArgs,
-		Short: "Query the current staking pool values",
+		Use:   "auto-redelegation [delegator-addr]",
+		Short: "Query the auto-redelegation preference of a delegator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query values for amounts stored in the staking pool.
+			fmt.Sprintf(`Query the fallback validator and the jail duration after which the stake of a
+delegator is automatically redelegated away from a jailed validator.
 
 Example:
-$ %s query staking pool
+$ %s query staking auto-redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 `,
-				version.AppName,
+				version.AppName, bech32PrefixAccAddr,
 			),
 		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -687,12 +907,17 @@ $ %s query staking pool
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Pool(cmd.Context(), &types.QueryPoolRequest{})
+			_, err = ac.StringToBytes(args[0])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Pool)
+			res, err := queryClient.AutoRedelegation(cmd.Context(), &types.QueryAutoRedelegationRequest{DelegatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -701,21 +926,25 @@ $ %s query staking pool
 	return cmd
 }
 
-// GetCmdQueryParams implements the params query command.
-func GetCmdQueryParams() *cobra.Command {
+// GetCmdQueryValidatorJailHistory implements the command to query the jail
+// history of a validator.
+func GetCmdQueryValidatorJailHistory() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
 	cmd := &cobra.Command{
-		Use:   "params",
-		Args:  cobra.NoArgs,
-		Short: "Query the current staking parameters information",
+		Use:   "jail-history [validator-addr]",
+		Short: "Query the jail history of a validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query values set as staking parameters.
+			fmt.Sprintf(`Query every time a validator was jailed, together with the reason and the
+height at which it was jailed.
 
 Example:
-$ %s query staking params
+$ %s query staking jail-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 `,
-				version.AppName,
+				version.AppName, bech32PrefixValAddr,
 			),
 		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -723,12 +952,68 @@ $ %s query staking params
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorJailHistoryRequest{
+				ValidatorAddr: valAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.ValidatorJailHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "jail records")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorAddresses implements the validator addresses query command.
+func GetCmdQueryValidatorAddresses() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-addresses [address]",
+		Short: "Query the operator, consensus and account addresses of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resolve the operator, consensus and account addresses of a validator from
+any of them.
+
+Example:
+$ %s query staking validator-addresses %s1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryValidatorAddressesRequest{Address: args[0]}
+			res, err := queryClient.ValidatorAddresses(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -736,3 +1021,627 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryValidatorSetCheckpoint implements the validator set checkpoint query command
+func GetCmdQueryValidatorSetCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-checkpoint [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validator set checkpoint taken at given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator set checkpoint taken at given height. Checkpoints are
+taken every validator_set_checkpoint_interval blocks.
+
+Example:
+$ %s query staking validator-set-checkpoint 10000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			params := &types.QueryValidatorSetCheckpointRequest{Height: height}
+			res, err := queryClient.ValidatorSetCheckpoint(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Checkpoint)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExportValidatorSetCheckpoints implements the command to export
+// all the validator set checkpoints.
+func GetCmdQueryExportValidatorSetCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-validator-set-checkpoints",
+		Args:  cobra.NoArgs,
+		Short: "Export all the validator set checkpoints as JSON lines",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the validator set checkpoints, oldest first, one checkpoint per line
+as JSON lines. The checkpoints are fetched in batches.
+
+Example:
+$ %s query staking export-validator-set-checkpoints > checkpoints.jsonl
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorSetCheckpointsRequest{Pagination: &query.PageRequest{}}
+			if req.Pagination.Limit, err = cmd.Flags().GetUint64(FlagBatchSize); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			for {
+				res, err := queryClient.ValidatorSetCheckpoints(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				for i := range res.Checkpoints {
+					bz, err := clientCtx.Codec.MarshalJSON(&res.Checkpoints[i])
+					if err != nil {
+						return err
+					}
+					if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					return nil
+				}
+				req.Pagination.Key = res.Pagination.NextKey
+			}
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBatchSize, 100, "Number of checkpoints fetched per query")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalInfoSize implements the historical info size query command
+func GetCmdQueryHistoricalInfoSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-info-size",
+		Args:  cobra.NoArgs,
+		Short: "Query the current size of the historical info store",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of bytes taken by the stored historical info, and the
+historical info max bytes it is pruned to.
+
+Example:
+$ %s query staking historical-info-size
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HistoricalInfoSize(cmd.Context(), &types.QueryHistoricalInfoSizeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPool implements the pool query command.
+func GetCmdQueryPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the current staking pool values",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values for amounts stored in the staking pool.
+
+Example:
+$ %s query staking pool
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pool(cmd.Context(), &types.QueryPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Pool)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryYieldInputs implements the yield inputs query command.
+func GetCmdQueryYieldInputs() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "yield-inputs [validator-addr]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the inputs of the estimation of the staking yield, at a single height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bonded ratio, the inflation, the community tax and, if a validator is given, its
+commission rate, all at the same height. The nominal APR of the delegations to the validator can be estimated as
+inflation * (1 - community_tax) / bonded_ratio * (1 - commission_rate).
+
+Example:
+$ %s query staking yield-inputs %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryYieldInputsRequest{}
+			if len(args) > 0 {
+				valAddr, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = valAddr.String()
+			}
+
+			res, err := queryClient.YieldInputs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPowerDistribution implements the power distribution query command.
+func GetCmdQueryPowerDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power-distribution",
+		Args:  cobra.NoArgs,
+		Short: "Query the distribution of the consensus power among the bonded validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative share of the total power of the validators with the most power, the
+Nakamoto coefficient, that is the smallest number of validators whose power exceeds the threshold share of the
+total power, and a histogram of the powers of the validators.
+
+Example:
+$ %s query staking power-distribution --%s=20 --%s=0.5
+`,
+				version.AppName, FlagTopN, FlagThreshold,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			topN, err := cmd.Flags().GetUint32(FlagTopN)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPowerDistributionRequest{TopN: topN}
+			if threshold, _ := cmd.Flags().GetString(FlagThreshold); threshold != "" {
+				if req.Threshold, err = math.LegacyNewDecFromStr(threshold); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.PowerDistribution(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagTopN, 0, "The number of top validators to return the cumulative share of, 10 if unset")
+	cmd.Flags().String(FlagThreshold, "", "The share of the total power counted by the Nakamoto coefficient, 1/3 if unset")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTopDelegators implements the top delegators query command.
+func GetCmdQueryTopDelegators() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "top-delegators [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the delegations made to one validator with the most shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations made to one validator with the most shares, in decreasing order of shares,
+at most 1000.
+
+Example:
+$ %s query staking top-delegators %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --%s=20
+`,
+				version.AppName, bech32PrefixValAddr, flags.FlagLimit,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TopDelegators(cmd.Context(), &types.QueryTopDelegatorsRequest{
+				ValidatorAddr: valAddr.String(),
+				Limit:         limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "The number of delegations to return, 10 if unset")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current staking parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as staking parameters.
+
+Example:
+$ %s query staking params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidateCreateValidator implements the command to check a
+// MsgCreateValidator against the chain state without broadcasting it.
+func GetCmdQueryValidateCreateValidator() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validate-create-validator [path/to/validator.json] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Check a create-validator transaction without broadcasting it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check that a create-validator transaction built from the given JSON file
+would pass the moniker length, commission, minimum self delegation, consensus key
+and pubkey type checks, and list every failing check.
+
+The JSON file has the same format as for "%s tx staking create-validator".
+
+Example:
+$ %s query staking validate-create-validator path/to/validator.json %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			val, err := parseAndValidateValidatorJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			description := types.NewDescription(val.Moniker, val.Identity, val.Website, val.Security, val.Details)
+			msg, err := types.NewMsgCreateValidator(valAddr, val.PubKey, val.Amount, description, val.CommissionRates, val.MinSelfDelegation)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidateCreateValidator(cmd.Context(), &types.QueryValidateCreateValidatorRequest{Msg: msg})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExportDelegations implements the command to export the
+// delegations of all the delegators.
+func GetCmdQueryExportDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Export the delegations of all the delegators as CSV or JSON lines",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the delegations of all the delegators, one delegation per line, as CSV
+(the default) or JSON lines. The delegations are fetched in batches, all at the
+height given with --height, or at the latest height when the export starts.
+
+Example:
+$ %s query staking export-delegations --height 1000 --min-stake 1000000 --exclude-module-accounts > delegations.csv
+$ %s query staking export-delegations --format jsonl > delegations.jsonl
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			write, flush, err := newDelegationWriter(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer flush()
+
+			req := &types.QueryAllDelegationsRequest{Pagination: &query.PageRequest{}}
+			if minStake, _ := cmd.Flags().GetString(FlagMinStake); minStake != "" {
+				var ok bool
+				req.MinStake, ok = math.NewIntFromString(minStake)
+				if !ok {
+					return fmt.Errorf("invalid min stake %s", minStake)
+				}
+			}
+
+			if req.ExcludeModuleAccounts, err = cmd.Flags().GetBool(FlagExcludeModuleAccounts); err != nil {
+				return err
+			}
+
+			if req.Pagination.Limit, err = cmd.Flags().GetUint64(FlagBatchSize); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			for {
+				var header metadata.MD
+				res, err := queryClient.AllDelegations(cmd.Context(), req, grpc.Header(&header))
+				if err != nil {
+					return err
+				}
+
+				// pin the height of the following batches to the height of the first one
+				if clientCtx.Height == 0 {
+					if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+						height, err := strconv.ParseInt(heights[0], 10, 64)
+						if err != nil {
+							return err
+						}
+						clientCtx = clientCtx.WithHeight(height)
+						queryClient = types.NewQueryClient(clientCtx)
+					}
+				}
+
+				for _, del := range res.DelegationResponses {
+					if err := write(del); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					return nil
+				}
+				req.Pagination.Key = res.Pagination.NextKey
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagExportFormat, "csv", "Output format of the delegations (csv|jsonl)")
+	cmd.Flags().String(FlagMinStake, "", "Minimum balance, in bond denom, of the exported delegations")
+	cmd.Flags().Bool(FlagExcludeModuleAccounts, false, "Exclude the delegations of module accounts")
+	cmd.Flags().Uint64(FlagBatchSize, 1000, "Number of delegations fetched per query")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExportValidatorDelegations implements the command to export the
+// delegations of a validator through a gRPC stream.
+func GetCmdQueryExportValidatorDelegations() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "export-validator-delegations [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export the delegations of a validator as CSV or JSON lines",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the delegations of a validator, one delegation per line, as CSV (the
+default) or JSON lines. The delegations are streamed by the gRPC endpoint given
+with --%s, all at the height given with --height, or at the latest height when
+the export starts.
+
+Example:
+$ %s query staking export-validator-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --grpc-addr localhost:9090 --grpc-insecure > delegations.csv
+`,
+				flags.FlagGRPC, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			write, flush, err := newDelegationWriter(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer flush()
+
+			req := &types.QueryValidatorDelegationsStreamRequest{ValidatorAddr: args[0]}
+			if req.BatchSize, err = cmd.Flags().GetUint32(FlagBatchSize); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			stream, err := queryClient.ValidatorDelegationsStream(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			for {
+				res, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				for _, del := range res.DelegationResponses {
+					if err := write(del); err != nil {
+						return err
+					}
+				}
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagExportFormat, "csv", "Output format of the delegations (csv|jsonl)")
+	cmd.Flags().Uint32(FlagBatchSize, 100, "Number of delegations streamed per response")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newDelegationWriter returns a function writing the delegations to the output
+// of cmd in the format given by its format flag, and a function flushing the
+// output.
+func newDelegationWriter(cmd *cobra.Command, clientCtx client.Context) (func(types.DelegationResponse) error, func(), error) {
+	format, err := cmd.Flags().GetString(FlagExportFormat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch format {
+	case "csv":
+		w := csv.NewWriter(cmd.OutOrStdout())
+		if err := w.Write([]string{"delegator_address", "validator_address", "shares", "balance", "denom"}); err != nil {
+			return nil, nil, err
+		}
+
+		write := func(del types.DelegationResponse) error {
+			return w.Write([]string{
+				del.Delegation.DelegatorAddress, del.Delegation.ValidatorAddress, del.Delegation.Shares.String(),
+				del.Balance.Amount.String(), del.Balance.Denom,
+			})
+		}
+		return write, w.Flush, nil
+
+	case "jsonl":
+		write := func(del types.DelegationResponse) error {
+			bz, err := clientCtx.Codec.MarshalJSON(&del)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+			return err
+		}
+		return write, func() {}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("invalid format %s, expected csv or jsonl", format)
+	}
+}
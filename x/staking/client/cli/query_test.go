@@ -561,3 +561,54 @@ not_bonded_tokens: "0"`,
 		})
 	}
 }
+
+func (s *CLITestSuite) TestGetCmdQueryExportDelegations() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expErr         bool
+		expectedOutput string
+	}{
+		{
+			"invalid format",
+			[]string{fmt.Sprintf("--%s=xml", cli.FlagExportFormat)},
+			true, "",
+		},
+		{
+			"invalid min stake",
+			[]string{fmt.Sprintf("--%s=abc", cli.FlagMinStake)},
+			true, "",
+		},
+		{
+			"csv",
+			[]string{
+				fmt.Sprintf("--%s=1000", cli.FlagMinStake),
+				fmt.Sprintf("--%s", cli.FlagExcludeModuleAccounts),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false, "delegator_address,validator_address,shares,balance,denom",
+		},
+		{
+			"jsonl",
+			[]string{
+				fmt.Sprintf("--%s=jsonl", cli.FlagExportFormat),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryExportDelegations()
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -49,7 +50,11 @@ func NewTxCmd() *cobra.Command {
 		NewDelegateCmd(),
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
+		NewUnbondTokensCmd(),
 		NewCancelUnbondingDelegation(),
+		NewSetDelegationLabelCmd(),
+		NewSetAutoRedelegationCmd(),
+		NewRemoveAutoRedelegationCmd(),
 	)
 
 	return stakingTxCmd
@@ -312,21 +317,204 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+// NewUnbondTokensCmd returns a CLI command handler for creating a MsgUndelegateTokens transaction.
+func NewUnbondTokensCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbond-tokens [validator-addr] [amount]",
+		Short: "Unbond an exact amount of tokens from a validator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unbond an exact amount of tokens from a validator. The amount is converted to
+shares rounding up, so that at least the given amount is unbonded. The remainder
+of the delegation is unbonded as well if it is below the undelegation dust
+threshold param.
+
+Example:
+$ %s tx staking unbond-tokens %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegateTokens(delAddr, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDelegationLabelCmd returns a CLI command handler for creating a MsgSetDelegationLabel transaction.
+func NewSetDelegationLabelCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-delegation-label [validator-addr] [label]",
+		Short: "Set the label of a delegation to a validator",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the label of a delegation to a validator, which is returned by the delegation
+queries. Omitting the label removes the current label of the delegation.
+
+Example:
+$ %s tx staking set-delegation-label %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj client-42 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var label string
+			if len(args) > 1 {
+				label = args[1]
+			}
+
+			msg := types.NewMsgSetDelegationLabel(delAddr, valAddr, label)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoRedelegationCmd returns a CLI command handler for creating a MsgSetAutoRedelegation transaction.
+func NewSetAutoRedelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-redelegation [fallback-validator-addr] [jail-duration]",
+		Short: "Opt in to the automatic redelegation away from validators jailed for too long",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to the automatic redelegation of your stake to a fallback validator when
+one of your validators stays jailed for longer than the given duration, replacing
+your previous preference if any. Tombstoned validators are never unjailed, so the
+stake delegated to them is always redelegated once the duration elapses.
+
+Example:
+$ %s tx staking set-auto-redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 72h --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			jailDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRedelegation(delAddr, valAddr, jailDuration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveAutoRedelegationCmd returns a CLI command handler for creating a MsgRemoveAutoRedelegation transaction.
+func NewRemoveAutoRedelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-auto-redelegation",
+		Short: "Opt out of the automatic redelegation away from jailed validators",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt out of the automatic redelegation away from jailed validators.
+
+Example:
+$ %s tx staking remove-auto-redelegation --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAutoRedelegation(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCancelUnbondingDelegation returns a CLI command handler for creating a MsgCancelUnbondingDelegation transaction.
 func NewCancelUnbondingDelegation() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "cancel-unbond [validator-addr] [amount] [creation-height]",
+		Use:   "cancel-unbond [validator-addr] [amount] [creation-height] [[amount] [creation-height]...]",
 		Short: "Cancel unbonding delegation and delegate back to the validator",
-		Args:  cobra.ExactArgs(3),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 == 0 {
+				return fmt.Errorf("expected a validator address followed by pairs of amount and creation height, got %d arg(s)", len(args))
+			}
+			return nil
+		},
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Cancel Unbonding Delegation and delegate back to the validator.
+Several unbonding delegation entries can be cancelled at once by passing more
+pairs of amount and creation height. If several entries were created at the same
+height, the amount is cancelled from them in order.
 
 Example:
 $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 2 --from mykey
+$ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 2 50stake 7 --from mykey
 `,
-				version.AppName, bech32PrefixValAddr,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
 			),
 		),
 		Example: fmt.Sprintf(`$ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 2 --from mykey`,
@@ -342,17 +530,23 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 				return err
 			}
 
-			amount, err := sdk.ParseCoinNormalized(args[1])
-			if err != nil {
-				return err
-			}
+			var entries []types.CancelUnbondingEntry
+			for i := 1; i < len(args); i += 2 {
+				amount, err := sdk.ParseCoinNormalized(args[i])
+				if err != nil {
+					return err
+				}
 
-			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
-			if err != nil {
-				return errorsmod.Wrap(fmt.Errorf("invalid height: %d", creationHeight), "invalid height")
+				creationHeight, err := strconv.ParseInt(args[i+1], 10, 64)
+				if err != nil {
+					return errorsmod.Wrap(fmt.Errorf("invalid height: %d", creationHeight), "invalid height")
+				}
+
+				entries = append(entries, types.CancelUnbondingEntry{Amount: amount, CreationHeight: creationHeight})
 			}
 
-			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, amount)
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, entries[0].CreationHeight, entries[0].Amount)
+			msg.Entries = entries[1:]
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -683,6 +683,17 @@ func (s *CLITestSuite) TestNewCancelUnbondingDelegationCmd() {
 			},
 			"invalid height: invalid height: 0",
 		},
+		{
+			"amount without creation height",
+			[]string{
+				sdk.ValAddress(s.addrs[0]).String(),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)).String(),
+				sdk.NewInt(10000).String(),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			},
+			"expected a validator address followed by pairs of amount and creation height, got 4 arg(s)",
+		},
 		{
 			"valid transaction of canceling unbonding delegation",
 			[]string{
@@ -696,6 +707,21 @@ func (s *CLITestSuite) TestNewCancelUnbondingDelegationCmd() {
 			},
 			"",
 		},
+		{
+			"valid transaction of canceling several unbonding delegation entries",
+			[]string{
+				sdk.ValAddress(s.addrs[0]).String(),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5)).String(),
+				sdk.NewInt(10000).String(),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)).String(),
+				sdk.NewInt(10001).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))).String()),
+			},
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
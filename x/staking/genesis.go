@@ -28,7 +28,7 @@ func WriteValidators(ctx sdk.Context, keeper *keeper.Keeper) (vals []cmttypes.Ge
 		vals = append(vals, cmttypes.GenesisValidator{
 			Address: sdk.ConsAddress(cmtPk.Address()).Bytes(),
 			PubKey:  cmtPk,
-			Power:   validator.GetConsensusPower(keeper.PowerReduction(ctx)),
+			Power:   keeper.ConsensusPower(ctx, validator),
 			Name:    validator.GetMoniker(),
 		})
 
@@ -45,6 +45,43 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	for _, label := range data.DelegationLabels {
+		if err := types.ValidateDelegationLabel(label.Label); err != nil {
+			return err
+		}
+	}
+
+	for _, pref := range data.AutoRedelegations {
+		if err := types.ValidateAutoRedelegationJailDuration(pref.JailDuration); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.JailRecords {
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	seenDeficits := make(map[string]bool)
+	for _, deficit := range data.SelfDelegationDeficits {
+		if _, err := sdk.ValAddressFromBech32(deficit.ValidatorAddress); err != nil {
+			return err
+		}
+		if seenDeficits[deficit.ValidatorAddress] {
+			return fmt.Errorf("duplicate self-delegation deficit for validator %s", deficit.ValidatorAddress)
+		}
+		seenDeficits[deficit.ValidatorAddress] = true
+	}
+
+	seenCheckpoints := make(map[int64]bool)
+	for _, checkpoint := range data.ValidatorSetCheckpoints {
+		if seenCheckpoints[checkpoint.Height] {
+			return fmt.Errorf("duplicate validator set checkpoint at height %d", checkpoint.Height)
+		}
+		seenCheckpoints[checkpoint.Height] = true
+	}
+
 	return data.Params.Validate()
 }
 
@@ -16,11 +16,20 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.TrackHistoricalInfo(ctx)
+	k.TrackValidatorSetCheckpoint(ctx)
 }
 
 // Called every block, update validator set
 func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	return k.BlockValidatorUpdates(sdk.UnwrapSDKContext(ctx)), nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.ProcessAutoRedelegations(sdkCtx)
+	k.ProcessSelfDelegationDeficits(sdkCtx)
+	k.RemoveInactiveValidators(sdkCtx)
+
+	updates := k.BlockValidatorUpdates(sdkCtx)
+	k.EmitChurnMetrics(sdkCtx)
+
+	return updates, nil
 }
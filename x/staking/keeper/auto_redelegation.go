@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetAutoRedelegation returns the auto-redelegation preference of a delegator.
+func (k Keeper) GetAutoRedelegation(ctx sdk.Context, delAddr sdk.AccAddress) (pref types.AutoRedelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetAutoRedelegationKey(delAddr))
+	if value == nil {
+		return pref, false
+	}
+
+	return types.MustUnmarshalAutoRedelegation(k.cdc, value), true
+}
+
+// SetAutoRedelegation sets the auto-redelegation preference of a delegator,
+// replacing its previous preference if any. The delegations of the delegator
+// are indexed by validator, and its stake at the validators which are already
+// jailed is scheduled to be redelegated according to the new preference.
+func (k Keeper) SetAutoRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, fallbackValAddr sdk.ValAddress, jailDuration time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	pref := types.NewAutoRedelegation(delAddr, fallbackValAddr, jailDuration)
+	store.Set(types.GetAutoRedelegationKey(delAddr), types.MustMarshalAutoRedelegation(k.cdc, pref))
+
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, delAddr) {
+		store.Set(types.GetAutoRedelegationByValKey(delegation.GetValidatorAddr(), delAddr), []byte{})
+		k.scheduleAutoRedelegation(ctx, delAddr, delegation.GetValidatorAddr(), pref)
+	}
+}
+
+// RemoveAutoRedelegation removes the auto-redelegation preference of a delegator,
+// together with the index of its delegations by validator.
+func (k Keeper) RemoveAutoRedelegation(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRedelegationKey(delAddr))
+
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, delAddr) {
+		store.Delete(types.GetAutoRedelegationByValKey(delegation.GetValidatorAddr(), delAddr))
+	}
+}
+
+// IterateAutoRedelegations iterates through all of the auto-redelegation preferences.
+func (k Keeper) IterateAutoRedelegations(ctx sdk.Context, cb func(pref types.AutoRedelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.AutoRedelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pref := types.MustUnmarshalAutoRedelegation(k.cdc, iterator.Value())
+		if cb(pref) {
+			break
+		}
+	}
+}
+
+// GetAllAutoRedelegations returns all auto-redelegation preferences used during genesis dump.
+func (k Keeper) GetAllAutoRedelegations(ctx sdk.Context) (prefs []types.AutoRedelegation) {
+	k.IterateAutoRedelegations(ctx, func(pref types.AutoRedelegation) bool {
+		prefs = append(prefs, pref)
+		return false
+	})
+
+	return prefs
+}
+
+// GetValidatorJailTime returns the time at which a jailed validator was jailed.
+func (k Keeper) GetValidatorJailTime(ctx sdk.Context, valAddr sdk.ValAddress) (jailTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorJailTimeKey(valAddr))
+	if value == nil {
+		return jailTime, false
+	}
+
+	jailTime, err := sdk.ParseTimeBytes(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return jailTime, true
+}
+
+// SetValidatorJailTime records the time at which a validator was jailed.
+func (k Keeper) SetValidatorJailTime(ctx sdk.Context, valAddr sdk.ValAddress, jailTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorJailTimeKey(valAddr), sdk.FormatTimeBytes(jailTime))
+}
+
+// DeleteValidatorJailTime deletes the jail time of a validator.
+func (k Keeper) DeleteValidatorJailTime(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorJailTimeKey(valAddr))
+}
+
+// IterateValidatorJailTimes iterates through the jail times of the jailed validators.
+func (k Keeper) IterateValidatorJailTimes(ctx sdk.Context, cb func(valAddr sdk.ValAddress, jailTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorJailTimeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		jailTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(types.AddressFromValidatorJailTimeKey(iterator.Key()), jailTime) {
+			break
+		}
+	}
+}
+
+// InsertAutoRedelegationQueue schedules the auto-redelegation of the stake of a
+// delegator away from a jailed validator at the given time.
+func (k Keeper) InsertAutoRedelegationQueue(ctx sdk.Context, dueTime time.Time, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoRedelegationQueueKey(dueTime, valAddr, delAddr), []byte{})
+}
+
+// AutoRedelegationQueueIterator returns all the auto-redelegations due up to
+// endTime included.
+func (k Keeper) AutoRedelegationQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	// the keys of the auto-redelegations due at endTime extend its time key
+	return store.Iterator(types.AutoRedelegationQueueKey,
+		storetypes.PrefixEndBytes(types.GetAutoRedelegationQueueTimeKey(endTime)))
+}
+
+// scheduleAutoRedelegation queues the auto-redelegation of the stake of a
+// delegator away from a validator if the validator is jailed. It is due once the
+// preferred jail duration of the delegator has elapsed since the jailing.
+func (k Keeper) scheduleAutoRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, pref types.AutoRedelegation) {
+	if pref.FallbackValidatorAddress == valAddr.String() {
+		return
+	}
+
+	jailTime, jailed := k.GetValidatorJailTime(ctx, valAddr)
+	if !jailed {
+		return
+	}
+
+	k.InsertAutoRedelegationQueue(ctx, jailTime.Add(pref.JailDuration), valAddr, delAddr)
+}
+
+// scheduleValidatorAutoRedelegations queues the auto-redelegations of the stake
+// of the delegators of a validator which has just been jailed, for those who
+// opted in. It is only called on jailing, and only the opted-in delegators of
+// the validator are read from their index, so the delegations are not scanned.
+func (k Keeper) scheduleValidatorAutoRedelegations(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetAutoRedelegationsByValPrefixKey(valAddr)
+
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the delegator address follows its length byte
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefix)+1:])
+
+		if pref, found := k.GetAutoRedelegation(ctx, delAddr); found {
+			k.scheduleAutoRedelegation(ctx, delAddr, valAddr, pref)
+		}
+	}
+}
+
+// ProcessAutoRedelegations redelegates the stake of the delegators who opted in
+// to the automatic redelegation away from the validators jailed for longer than
+// their preferred jail duration. Tombstoned validators are never unjailed, so the
+// stake delegated to them is always redelegated once the duration elapses.
+//
+// Only the auto-redelegations which are due are processed, and each of them is
+// removed from the queue whatever its outcome. An auto-redelegation which is no
+// longer relevant, e.g. because the validator was unjailed or the delegator
+// changed its preference, is dropped, as well as a redelegation which fails, e.g.
+// because it would be transitive, which is reported by an event: the delegator
+// can set its preference again to schedule it anew.
+func (k Keeper) ProcessAutoRedelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+
+	var keys [][]byte
+	iterator := k.AutoRedelegationQueueIterator(ctx, blockTime)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		valAddr, delAddr, err := types.ParseAutoRedelegationQueueKey(key)
+		if err != nil {
+			panic(err)
+		}
+
+		k.processAutoRedelegation(ctx, delAddr, valAddr)
+	}
+}
+
+// processAutoRedelegation redelegates the stake of a delegator away from a
+// jailed validator if the preference of the delegator still calls for it.
+func (k Keeper) processAutoRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	pref, found := k.GetAutoRedelegation(ctx, delAddr)
+	if !found {
+		return
+	}
+
+	// a validator unjailed, or jailed again since, has no auto-redelegation due
+	jailTime, jailed := k.GetValidatorJailTime(ctx, valAddr)
+	if !jailed || ctx.BlockTime().Before(jailTime.Add(pref.JailDuration)) {
+		return
+	}
+
+	fallbackValAddr, err := sdk.ValAddressFromBech32(pref.FallbackValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return
+	}
+
+	// keep the stake where it is if the fallback validator is jailed as well
+	if _, jailed := k.GetValidatorJailTime(ctx, fallbackValAddr); jailed {
+		k.Logger(ctx).Info(
+			"fallback validator is jailed, skipping auto-redelegation",
+			"delegator", delAddr.String(),
+			"source_validator", valAddr.String(),
+			"destination_validator", fallbackValAddr.String(),
+		)
+		k.emitAutoRedelegateFailed(ctx, delAddr, valAddr, fallbackValAddr, types.ErrValidatorJailed)
+		return
+	}
+
+	k.autoRedelegate(ctx, delAddr, valAddr, fallbackValAddr, delegation.Shares)
+}
+
+// autoRedelegate redelegates the given shares of a delegator to its fallback
+// validator, leaving the state untouched if the redelegation fails.
+func (k Keeper) autoRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, shares math.LegacyDec) {
+	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return
+	}
+
+	amount := sdk.NewCoin(k.BondDenom(ctx), srcValidator.TokensFromShares(shares).TruncateInt())
+
+	cacheCtx, write := ctx.CacheContext()
+	completionTime, err := k.BeginRedelegation(cacheCtx, delAddr, valSrcAddr, valDstAddr, shares)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to auto-redelegate",
+			"delegator", delAddr.String(),
+			"source_validator", valSrcAddr.String(),
+			"destination_validator", valDstAddr.String(),
+			"err", err,
+		)
+		k.emitAutoRedelegateFailed(ctx, delAddr, valSrcAddr, valDstAddr, err)
+		return
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRedelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, valSrcAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, valDstAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+}
+
+// emitAutoRedelegateFailed reports an auto-redelegation which is dropped
+// because the redelegation failed.
+func (k Keeper) emitAutoRedelegateFailed(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRedelegateFailed,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, valSrcAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, valDstAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
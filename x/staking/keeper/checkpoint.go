@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorSetCheckpoint gets the validator set checkpoint taken at a given height
+func (k Keeper) GetValidatorSetCheckpoint(ctx sdk.Context, height int64) (types.ValidatorSetCheckpoint, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorSetCheckpointKey(height))
+	if value == nil {
+		return types.ValidatorSetCheckpoint{}, false
+	}
+
+	return types.MustUnmarshalValidatorSetCheckpoint(k.cdc, value), true
+}
+
+// SetValidatorSetCheckpoint sets a validator set checkpoint at its height
+func (k Keeper) SetValidatorSetCheckpoint(ctx sdk.Context, checkpoint types.ValidatorSetCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorSetCheckpointKey(checkpoint.Height), types.MustMarshalValidatorSetCheckpoint(k.cdc, checkpoint))
+}
+
+// IterateValidatorSetCheckpoints iterates through the validator set checkpoints, oldest first.
+func (k Keeper) IterateValidatorSetCheckpoints(ctx sdk.Context, cb func(checkpoint types.ValidatorSetCheckpoint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorSetCheckpointKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		checkpoint := types.MustUnmarshalValidatorSetCheckpoint(k.cdc, iterator.Value())
+		if cb(checkpoint) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorSetCheckpoints returns all the validator set checkpoints used during genesis dump.
+func (k Keeper) GetAllValidatorSetCheckpoints(ctx sdk.Context) (checkpoints []types.ValidatorSetCheckpoint) {
+	k.IterateValidatorSetCheckpoints(ctx, func(checkpoint types.ValidatorSetCheckpoint) bool {
+		checkpoints = append(checkpoints, checkpoint)
+		return false
+	})
+
+	return checkpoints
+}
+
+// TrackValidatorSetCheckpoint stores a checkpoint of the last validator set
+// every validator set checkpoint interval blocks.
+func (k Keeper) TrackValidatorSetCheckpoint(ctx sdk.Context) {
+	interval := k.ValidatorSetCheckpointInterval(ctx)
+	if interval == 0 || ctx.BlockHeight() <= 0 || uint64(ctx.BlockHeight())%interval != 0 {
+		return
+	}
+
+	consensusPower := func(val types.Validator) int64 { return k.ConsensusPower(ctx, val) }
+	checkpoint, err := types.NewValidatorSetCheckpoint(ctx.BlockHeight(), ctx.BlockTime(), k.GetLastValidators(ctx), consensusPower)
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetValidatorSetCheckpoint(ctx, checkpoint)
+}
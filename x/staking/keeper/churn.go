@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ChurnKind is a kind of stake flow tracked in each block.
+type ChurnKind byte
+
+const (
+	// ChurnBonded are the tokens delegated from accounts.
+	ChurnBonded ChurnKind = iota
+	// ChurnUnbonding are the tokens undelegated, which start unbonding.
+	ChurnUnbonding
+	// ChurnRedelegated are the tokens redelegated between validators.
+	ChurnRedelegated
+)
+
+// churnKinds are the tracked kinds of stake flow, with their metric names.
+var churnKinds = []struct {
+	kind ChurnKind
+	name string
+}{
+	{ChurnBonded, "bonded"},
+	{ChurnUnbonding, "unbonding"},
+	{ChurnRedelegated, "redelegated"},
+}
+
+// trackChurn adds tokens to the given kind of stake flow of the current block.
+// The churn is tracked in the store, so that the stake flow of the failed txs
+// is discarded along with their state changes, and without consuming gas, so
+// that tracking it does not change the gas used by the txs.
+func (k Keeper) trackChurn(ctx sdk.Context, kind ChurnKind, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	k.setBlockChurn(ctx, kind, k.GetBlockChurn(ctx, kind).Add(amount))
+}
+
+// GetBlockChurn returns the tokens of the given kind of stake flow in the
+// current block so far.
+func (k Keeper) GetBlockChurn(ctx sdk.Context, kind ChurnKind) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBlockChurnKey(byte(kind)))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+func (k Keeper) setBlockChurn(ctx sdk.Context, kind ChurnKind, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetBlockChurnKey(byte(kind)), bz)
+}
+
+// EmitChurnMetrics sets the telemetry gauges of the stake flow of the current
+// block, zero for the kinds without any flow, and resets it for the next block.
+// The churn of the txs of a block is deleted by its EndBlocker, so that it
+// does not grow the committed state.
+func (k Keeper) EmitChurnMetrics(ctx sdk.Context) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	labels := []metrics.Label{telemetry.NewLabel("denom", k.BondDenom(ctx))}
+	store := ctx.KVStore(k.storeKey)
+
+	for _, c := range churnKinds {
+		amount := k.GetBlockChurn(ctx, c.kind)
+		store.Delete(types.GetBlockChurnKey(byte(c.kind)))
+
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "churn", c.name},
+			float32(math.LegacyNewDecFromInt(amount).MustFloat64()),
+			labels,
+		)
+	}
+}
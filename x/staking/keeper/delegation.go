@@ -15,6 +15,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+const (
+	// DefaultTopDelegatorsLimit is the default number of delegations returned
+	// by the top delegators query.
+	DefaultTopDelegatorsLimit uint32 = 10
+	// MaxTopDelegatorsLimit is the maximum number of delegations returned by
+	// the top delegators query.
+	MaxTopDelegatorsLimit uint32 = 1000
+)
+
 // GetDelegation returns a specific delegation.
 func (k Keeper) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation types.Delegation, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -81,6 +90,29 @@ func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress)
 	return delegations
 }
 
+// GetTopDelegations returns at most limit delegations to a specific validator,
+// those with the most shares first. The delegations with the same shares are
+// ordered by decreasing delegator address.
+func (k Keeper) GetTopDelegations(ctx sdk.Context, valAddr sdk.ValAddress, limit uint32) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetDelegationsBySharesPrefixKey(valAddr))
+	defer iterator.Close()
+
+	prefixLen := len(types.GetDelegationsBySharesPrefixKey(valAddr))
+	for ; iterator.Valid() && len(delegations) < int(limit); iterator.Next() {
+		delAddr := types.ParseDelegationBySharesKey(iterator.Key()[prefixLen:])
+		delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			panic(fmt.Sprintf("delegation of %s to %s indexed by shares not found", delAddr, valAddr))
+		}
+
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
 // GetDelegatorDelegations returns a given amount of all the delegations from a
 // delegator.
 func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (delegations []types.Delegation) {
@@ -109,11 +141,21 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	}
 
 	store := ctx.KVStore(k.storeKey)
+	// the shares of the delegation may have changed, so it is indexed again by its shares
+	if previous, found := k.GetDelegation(ctx, delegatorAddress, delegation.GetValidatorAddr()); found {
+		store.Delete(types.GetDelegationBySharesKey(delegation.GetValidatorAddr(), previous.Shares, delegatorAddress))
+	} else if store.Has(types.GetAutoRedelegationKey(delegatorAddress)) {
+		// the new delegations of the delegators who opted in to the auto-redelegation
+		// are indexed by validator, so that only they are read when it is jailed
+		store.Set(types.GetAutoRedelegationByValKey(delegation.GetValidatorAddr(), delegatorAddress), []byte{})
+	}
+
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
 
 	// set the delegation in validator delegator index
 	store.Set(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress), []byte{})
+	store.Set(types.GetDelegationBySharesKey(delegation.GetValidatorAddr(), delegation.Shares, delegatorAddress), []byte{})
 }
 
 // RemoveDelegation removes a delegation
@@ -129,8 +171,14 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 	}
 
 	store := ctx.KVStore(k.storeKey)
+	// the delegation is indexed by its stored shares, which the given delegation may no longer hold
+	if stored, found := k.GetDelegation(ctx, delegatorAddress, delegation.GetValidatorAddr()); found {
+		store.Delete(types.GetDelegationBySharesKey(delegation.GetValidatorAddr(), stored.Shares, delegatorAddress))
+	}
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
 	store.Delete(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress))
+	store.Delete(types.GetAutoRedelegationByValKey(delegation.GetValidatorAddr(), delegatorAddress))
+	k.RemoveDelegationLabel(ctx, delegatorAddress, delegation.GetValidatorAddr())
 
 	return nil
 }
@@ -683,6 +731,10 @@ func (k Keeper) Delegate(
 		delegation = types.NewDelegation(delAddr, validator.GetOperator(), math.LegacyZeroDec())
 	}
 
+	if err := k.checkMinDelegationAmount(ctx, validator, delegation.Shares, bondAmt); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
 	// call the appropriate hook if present
 	if found {
 		err = k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, validator.GetOperator())
@@ -722,6 +774,7 @@ func (k Keeper) Delegate(
 		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, sendName, coins); err != nil {
 			return math.LegacyDec{}, err
 		}
+		k.trackChurn(ctx, ChurnBonded, bondAmt)
 	} else {
 		// potentially transfer tokens between pools, if
 		switch {
@@ -746,6 +799,19 @@ func (k Keeper) Delegate(
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// a self-delegation may top up the validator's self-delegation during its grace period
+	if bytes.Equal(delegatorAddress, validator.GetOperator()) {
+		k.checkSelfDelegationRestored(ctx, k.mustGetValidator(ctx, validator.GetOperator()))
+	}
+
+	// the stake delegated to a jailed validator is auto-redelegated like the
+	// stake it had when it was jailed
+	if validator.IsJailed() {
+		if pref, found := k.GetAutoRedelegation(ctx, delegatorAddress); found {
+			k.scheduleAutoRedelegation(ctx, delegatorAddress, validator.GetOperator(), pref)
+		}
+	}
+
 	// Call the after-modification hook
 	if err := k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -754,6 +820,61 @@ func (k Keeper) Delegate(
 	return newShares, nil
 }
 
+// checkMinDelegationAmount returns an error if a delegation holding shares of
+// the validator plus bondAmt tokens would be below the MinDelegationAmount
+// param. The check is skipped when no minimum is set.
+func (k Keeper) checkMinDelegationAmount(
+	ctx sdk.Context, validator types.Validator, shares math.LegacyDec, bondAmt math.Int,
+) error {
+	minAmount := k.MinDelegationAmount(ctx)
+	if minAmount.IsNil() || !minAmount.IsPositive() {
+		return nil
+	}
+
+	delTokens := bondAmt
+	// a validator without delegator shares has no exchange rate to convert with
+	if shares.IsPositive() && validator.GetDelegatorShares().IsPositive() {
+		delTokens = delTokens.Add(validator.TokensFromSharesTruncated(shares).TruncateInt())
+	}
+
+	if delTokens.LT(minAmount) {
+		return errorsmod.Wrapf(
+			types.ErrDelegationBelowMinimum,
+			"the delegation would be worth %s tokens, the minimum is %s", delTokens, minAmount,
+		)
+	}
+
+	return nil
+}
+
+// IsTombstoned returns whether the validator is tombstoned by the slashing
+// module. It is always false if the slashing keeper is not set.
+func (k Keeper) IsTombstoned(ctx sdk.Context, validator types.Validator) bool {
+	if k.slashingKeeper == nil {
+		return false
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return false
+	}
+
+	return k.slashingKeeper.IsTombstoned(ctx, consAddr)
+}
+
+// checkNotTombstoned returns an error if the validator is tombstoned and the
+// RejectTombstonedDelegations param is enabled.
+func (k Keeper) checkNotTombstoned(ctx sdk.Context, validator types.Validator) error {
+	if !k.RejectTombstonedDelegations(ctx) || !k.IsTombstoned(ctx, validator) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrValidatorTombstoned,
+		"validator %s can never be unjailed, delegations to it would never earn rewards", validator.OperatorAddress,
+	)
+}
+
 // Unbond unbonds a particular delegation and perform associated store operations.
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
@@ -791,10 +912,10 @@ func (k Keeper) Unbond(
 	isValidatorOperator := bytes.Equal(delegatorAddress, validator.GetOperator())
 
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
-	// self-delegation below their minimum, we jail the validator.
-	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
-		k.jailValidator(ctx, validator)
+	// self-delegation below their minimum, we jail the validator, after the grace period if any.
+	if selfDelegation := validator.TokensFromShares(delegation.Shares).TruncateInt(); isValidatorOperator &&
+		!validator.Jailed && selfDelegation.LT(validator.MinSelfDelegation) {
+		k.handleSelfDelegationBelowMin(ctx, validator, selfDelegation)
 		validator = k.mustGetValidator(ctx, validator.GetOperator())
 	}
 
@@ -816,6 +937,9 @@ func (k Keeper) Unbond(
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
 		k.RemoveValidator(ctx, validator.GetOperator())
+	} else if delegation.Shares.IsZero() && !isValidatorOperator {
+		// the validator may be left with the self-delegation of its operator only
+		k.QueueInactiveValidator(ctx, validator, ctx.BlockTime())
 	}
 
 	return amount, nil
@@ -866,6 +990,14 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
+	// the delegation may be fully undelegated, but no dust may be left in it
+	if delegation, found := k.GetDelegation(ctx, delAddr, valAddr); found && sharesAmount.LT(delegation.Shares) {
+		remaining := delegation.Shares.Sub(sharesAmount)
+		if err := k.checkMinDelegationAmount(ctx, validator, remaining, math.ZeroInt()); err != nil {
+			return time.Time{}, math.Int{}, err
+		}
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
@@ -879,6 +1011,7 @@ func (k Keeper) Undelegate(
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
+	k.trackChurn(ctx, ChurnUnbonding, returnAmount)
 
 	return completionTime, returnAmount, nil
 }
@@ -961,6 +1094,15 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	// as for undelegations, the source delegation may be fully redelegated,
+	// but no dust may be left in it
+	if delegation, found := k.GetDelegation(ctx, delAddr, valSrcAddr); found && sharesAmount.LT(delegation.Shares) {
+		remaining := delegation.Shares.Sub(sharesAmount)
+		if err := k.checkMinDelegationAmount(ctx, srcValidator, remaining, math.ZeroInt()); err != nil {
+			return time.Time{}, err
+		}
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -974,6 +1116,7 @@ func (k Keeper) BeginRedelegation(
 	if err != nil {
 		return time.Time{}, err
 	}
+	k.trackChurn(ctx, ChurnRedelegated, returnAmount)
 
 	// create the unbonding delegation
 	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
@@ -1071,3 +1214,48 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// ValidateUnbondTokens validates that a given exact token amount can be
+// undelegated from the delegation of a delegator to a validator, and returns
+// the corresponding amount of shares. Unlike ValidateUnbondAmount, the shares
+// are rounded up, so that the undelegated tokens are never below the requested
+// amount, and capped at the shares of the delegation. If the tokens left in
+// the delegation would be below the undelegation dust threshold, all the shares
+// of the delegation are returned so that no dust delegation is left behind.
+func (k Keeper) ValidateUnbondTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int,
+) (shares math.LegacyDec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shares, types.ErrNoValidatorFound
+	}
+
+	del, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return shares, types.ErrNoDelegation
+	}
+
+	delShares := del.GetShares()
+	delTokens := validator.TokensFromSharesTruncated(delShares).TruncateInt()
+	if amt.GT(delTokens) {
+		return shares, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "cannot undelegate %s tokens, the delegation only has %s", amt, delTokens,
+		)
+	}
+
+	shares, err = validator.SharesFromTokensRoundUp(amt)
+	if err != nil {
+		return shares, err
+	}
+
+	if shares.GT(delShares) {
+		shares = delShares
+	}
+
+	remaining := validator.TokensFromSharesTruncated(delShares.Sub(shares)).TruncateInt()
+	if remaining.IsPositive() && remaining.LT(k.UndelegationDustThreshold(ctx)) {
+		shares = delShares
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Delegation labels are stored under their own prefix, apart from the
+// delegations, so that they are never read or written by the staking logic
+// which computes delegations, voting power and rewards.
+
+// GetDelegationLabel returns the label of a delegation, or an empty string if
+// the delegation has no label.
+func (k Keeper) GetDelegationLabel(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) string {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDelegationLabelKey(delAddr, valAddr))
+	if value == nil {
+		return ""
+	}
+
+	return types.MustUnmarshalDelegationLabel(k.cdc, value).Label
+}
+
+// SetDelegationLabel sets the label of a delegation, an empty label removes it.
+func (k Keeper) SetDelegationLabel(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, label string) {
+	if label == "" {
+		k.RemoveDelegationLabel(ctx, delAddr, valAddr)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalDelegationLabel(k.cdc, types.NewDelegationLabel(delAddr, valAddr, label))
+	store.Set(types.GetDelegationLabelKey(delAddr, valAddr), b)
+}
+
+// RemoveDelegationLabel removes the label of a delegation.
+func (k Keeper) RemoveDelegationLabel(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationLabelKey(delAddr, valAddr))
+}
+
+// IterateDelegationLabels iterates through all of the delegation labels.
+func (k Keeper) IterateDelegationLabels(ctx sdk.Context, cb func(label types.DelegationLabel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.DelegationLabelKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		label := types.MustUnmarshalDelegationLabel(k.cdc, iterator.Value())
+		if cb(label) {
+			break
+		}
+	}
+}
+
+// GetAllDelegationLabels returns all delegation labels used during genesis dump.
+func (k Keeper) GetAllDelegationLabels(ctx sdk.Context) (labels []types.DelegationLabel) {
+	k.IterateDelegationLabels(ctx, func(label types.DelegationLabel) bool {
+		labels = append(labels, label)
+		return false
+	})
+
+	return labels
+}
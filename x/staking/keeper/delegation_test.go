@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -218,6 +219,58 @@ func (s *KeeperTestSuite) TestDelegationsByValIndex() {
 	require.Len(dels, 0)
 }
 
+func (s *KeeperTestSuite) TestTopDelegations() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	addrDels, valAddrs := createValAddrs(4)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	for i := 0; i < 2; i++ {
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+		stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	}
+
+	shares := []math.LegacyDec{math.LegacyNewDec(5), math.LegacyMustNewDecFromStr("0.5"), math.LegacyNewDec(300), math.LegacyNewDec(20)}
+	for i, delShares := range shares {
+		keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[i], valAddrs[0], delShares))
+	}
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[0], valAddrs[1], math.LegacyNewDec(1000)))
+
+	delegators := func(delegations []stakingtypes.Delegation) (addrs []string) {
+		for _, delegation := range delegations {
+			addrs = append(addrs, delegation.DelegatorAddress)
+		}
+		return addrs
+	}
+	require.Equal([]string{addrDels[2].String(), addrDels[3].String(), addrDels[0].String(), addrDels[1].String()},
+		delegators(keeper.GetTopDelegations(ctx, valAddrs[0], 10)))
+	require.Equal([]string{addrDels[2].String(), addrDels[3].String()}, delegators(keeper.GetTopDelegations(ctx, valAddrs[0], 2)))
+
+	// the index follows the changes of the shares and the removals of the delegations
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[1], valAddrs[0], math.LegacyNewDec(50)))
+	require.NoError(keeper.RemoveDelegation(ctx, stakingtypes.NewDelegation(addrDels[2], valAddrs[0], math.LegacyZeroDec())))
+	require.Equal([]string{addrDels[1].String(), addrDels[3].String(), addrDels[0].String()},
+		delegators(keeper.GetTopDelegations(ctx, valAddrs[0], 10)))
+
+	res, err := querier.TopDelegators(ctx, &stakingtypes.QueryTopDelegatorsRequest{ValidatorAddr: valAddrs[0].String(), Limit: 1})
+	require.NoError(err)
+	require.Len(res.DelegationResponses, 1)
+	require.Equal(addrDels[1].String(), res.DelegationResponses[0].Delegation.DelegatorAddress)
+	require.Equal(math.LegacyNewDec(50), res.DelegationResponses[0].Delegation.Shares)
+
+	res, err = querier.TopDelegators(ctx, &stakingtypes.QueryTopDelegatorsRequest{ValidatorAddr: valAddrs[1].String()})
+	require.NoError(err)
+	require.Len(res.DelegationResponses, 1)
+
+	_, err = querier.TopDelegators(ctx, &stakingtypes.QueryTopDelegatorsRequest{})
+	require.ErrorContains(err, "validator address cannot be empty")
+	_, err = querier.TopDelegators(ctx, &stakingtypes.QueryTopDelegatorsRequest{ValidatorAddr: valAddrs[0].String(), Limit: stakingkeeper.MaxTopDelegatorsLimit + 1})
+	require.ErrorContains(err, "above the maximum")
+}
+
 // tests Get/Set/Remove UnbondingDelegation
 func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
@@ -363,6 +416,77 @@ func (s *KeeperTestSuite) TestUnbondDelegation() {
 	require.Equal(remainingTokens, validator.BondedTokens())
 }
 
+func (s *KeeperTestSuite) TestMinDelegationAmount() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(validator.IsBonded())
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[0], issuedShares))
+
+	params := keeper.GetParams(ctx)
+	params.MinDelegationAmount = keeper.TokensFromConsensusPower(ctx, 3)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// a new delegation must be worth the minimum
+	_, err := keeper.Delegate(ctx, delAddrs[1], keeper.TokensFromConsensusPower(ctx, 2), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddrs[1], stakingtypes.BondedPoolName, gomock.Any())
+	_, err = keeper.Delegate(ctx, delAddrs[1], keeper.TokensFromConsensusPower(ctx, 3), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	// an existing delegation is topped up by any amount
+	validator, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddrs[1], stakingtypes.BondedPoolName, gomock.Any())
+	_, err = keeper.Delegate(ctx, delAddrs[1], math.NewInt(1), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	// an undelegation may not leave less than the minimum in the delegation
+	_, _, err = keeper.Undelegate(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 8)))
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, _, err = keeper.Undelegate(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 7)))
+	require.NoError(err)
+
+	// but the delegation can be fully undelegated
+	delegation, found := keeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, _, err = keeper.Undelegate(ctx, delAddrs[0], valAddrs[0], delegation.Shares)
+	require.NoError(err)
+
+	_, found = keeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestDelegateToValidatorWithoutShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(1)
+
+	// a delegation record left behind for a validator which has no delegator
+	// shares must not trip the minimum delegation check
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[0], math.LegacyOneDec()))
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddrs[0], stakingtypes.NotBondedPoolName, gomock.Any())
+	_, err := keeper.Delegate(ctx, delAddrs[0], keeper.TokensFromConsensusPower(ctx, 1), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+}
+
 // // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // // shift it from the bonded to unbonding state and jailed
 func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
@@ -413,6 +537,94 @@ func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
 	require.True(validator.Jailed)
 }
 
+// test undelegating self delegation from a validator pushing it below MinSelfDelegation
+// during the grace period, which jails the validator only once the grace period ends
+func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegationGracePeriod() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MinSelfDelegationGracePeriod = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, addrVals := createValAddrs(1)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	// create a validator with a self-delegation
+	validator := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	validator.MinSelfDelegation = delTokens
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(val0AccAddr, addrVals[0], issuedShares))
+
+	// the validator is given the grace period to top up its self-delegation
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+	_, _, err := keeper.Undelegate(ctx, val0AccAddr, addrVals[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 6)))
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	require.False(validator.Jailed)
+
+	jailTime := ctx.BlockTime().Add(time.Hour)
+	deficit, found := keeper.GetSelfDelegationDeficit(ctx, addrVals[0])
+	require.True(found)
+	require.Equal(stakingtypes.NewSelfDelegationDeficit(addrVals[0], jailTime), deficit)
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		stakingtypes.EventTypeSelfDelegationBelowMin,
+		sdk.NewAttribute(stakingtypes.AttributeKeyValidator, addrVals[0].String()),
+		sdk.NewAttribute(stakingtypes.AttributeKeySelfDelegation, keeper.TokensFromConsensusPower(ctx, 4).String()),
+		sdk.NewAttribute(stakingtypes.AttributeKeyMinSelfDelegation, delTokens.String()),
+		sdk.NewAttribute(stakingtypes.AttributeKeyJailTime, jailTime.Format(sdk.SortableTimeFormat)),
+	))
+
+	res, err := s.queryClient.ValidatorsBelowMinSelfDelegation(ctx, &stakingtypes.QueryValidatorsBelowMinSelfDelegationRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.ValidatorSelfDelegationDeficit{{
+		Deficit:           deficit,
+		SelfDelegation:    keeper.TokensFromConsensusPower(ctx, 4),
+		MinSelfDelegation: delTokens,
+	}}, res.Deficits)
+
+	// further undelegations keep the jail time
+	_, _, err = keeper.Undelegate(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), val0AccAddr, addrVals[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 1)))
+	require.NoError(err)
+	deficit, found = keeper.GetSelfDelegationDeficit(ctx, addrVals[0])
+	require.True(found)
+	require.Equal(jailTime, deficit.JailTime)
+
+	// topping up the self-delegation ends the grace period
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), val0AccAddr, gomock.Any(), gomock.Any()).Return(nil)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	_, err = keeper.Delegate(ctx, val0AccAddr, keeper.TokensFromConsensusPower(ctx, 7), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	_, found = keeper.GetSelfDelegationDeficit(ctx, addrVals[0])
+	require.False(found)
+
+	// the validator is jailed once the grace period ends
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 1)))
+	require.NoError(err)
+
+	keeper.ProcessSelfDelegationDeficits(ctx.WithBlockTime(jailTime.Add(-time.Second)))
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.False(validator.Jailed)
+
+	keeper.ProcessSelfDelegationDeficits(ctx.WithBlockTime(jailTime))
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.True(validator.Jailed)
+	_, found = keeper.GetSelfDelegationDeficit(ctx, addrVals[0])
+	require.False(found)
+
+	records := keeper.GetValidatorJailRecords(ctx, addrVals[0])
+	require.Len(records, 1)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_MIN_SELF_DELEGATION, records[0].Reason)
+}
+
 func (s *KeeperTestSuite) TestUndelegateFromUnbondingValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -721,6 +933,54 @@ func (s *KeeperTestSuite) TestRedelegation() {
 	require.Equal(0, len(redelegations))
 }
 
+func (s *KeeperTestSuite) TestBlockChurn() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(2)
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	// create two bonded validators
+	for i := range addrVals {
+		validator := testutil.NewValidator(s.T(), addrVals[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(valTokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+		require.True(validator.IsBonded())
+	}
+
+	for _, kind := range []stakingkeeper.ChurnKind{stakingkeeper.ChurnBonded, stakingkeeper.ChurnUnbonding, stakingkeeper.ChurnRedelegated} {
+		require.True(keeper.GetBlockChurn(ctx, kind).IsZero())
+	}
+
+	// delegate, then undelegate and redelegate part of the delegation
+	delTokens := keeper.TokensFromConsensusPower(ctx, 6)
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[0], stakingtypes.BondedPoolName, gomock.Any())
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	unbondTokens := keeper.TokensFromConsensusPower(ctx, 2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], math.LegacyNewDecFromInt(unbondTokens))
+	require.NoError(err)
+
+	redTokens := keeper.TokensFromConsensusPower(ctx, 3)
+	_, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], math.LegacyNewDecFromInt(redTokens))
+	require.NoError(err)
+
+	require.Equal(delTokens, keeper.GetBlockChurn(ctx, stakingkeeper.ChurnBonded))
+	require.Equal(unbondTokens, keeper.GetBlockChurn(ctx, stakingkeeper.ChurnUnbonding))
+	require.Equal(redTokens, keeper.GetBlockChurn(ctx, stakingkeeper.ChurnRedelegated))
+
+	// the churn is reset once emitted at the end of the block
+	keeper.EmitChurnMetrics(ctx)
+	for _, kind := range []stakingkeeper.ChurnKind{stakingkeeper.ChurnBonded, stakingkeeper.ChurnUnbonding, stakingkeeper.ChurnRedelegated} {
+		require.True(keeper.GetBlockChurn(ctx, kind).IsZero())
+	}
+}
+
 func (s *KeeperTestSuite) TestRedelegateToSameValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -1108,3 +1368,53 @@ func (s *KeeperTestSuite) TestSetUnbondingDelegationEntry() {
 	// unbondingID comes from a global counter -> gaps in unbondingIDs are OK as long as every unbondingID is unique
 	require.Equal(uint64(2), resUnbonding.Entries[1].UnbondingId)
 }
+
+func (s *KeeperTestSuite) TestValidateUnbondTokens() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+
+	// 700 tokens for 1000 shares, so that shares do not convert exactly to tokens
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(math.NewInt(1000))
+	validator = validator.RemoveTokens(math.NewInt(300))
+	keeper.SetValidator(ctx, validator)
+
+	delegation := stakingtypes.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	keeper.SetDelegation(ctx, delegation)
+
+	for _, amt := range []int64{1, 3, 100, 699} {
+		shares, err := keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[0], math.NewInt(amt))
+		require.NoError(err)
+		require.True(validator.TokensFromShares(shares).TruncateInt().GTE(math.NewInt(amt)), amt)
+		require.True(shares.LT(issuedShares), amt)
+	}
+
+	// the whole delegation
+	shares, err := keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[0], math.NewInt(700))
+	require.NoError(err)
+	require.Equal(issuedShares, shares)
+
+	_, err = keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[0], math.NewInt(701))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = keeper.ValidateUnbondTokens(ctx, delAddrs[1], valAddrs[0], math.NewInt(1))
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	_, err = keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[1], math.NewInt(1))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	// with a dust threshold, the remainder of the delegation is undelegated too
+	params := keeper.GetParams(ctx)
+	params.UndelegationDustThreshold = math.NewInt(10)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	shares, err = keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[0], math.NewInt(691))
+	require.NoError(err)
+	require.Equal(issuedShares, shares)
+
+	shares, err = keeper.ValidateUnbondTokens(ctx, delAddrs[0], valAddrs[0], math.NewInt(689))
+	require.NoError(err)
+	require.True(shares.LT(issuedShares))
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// DefaultDelegationsStreamRate is the default maximum number of delegations
+	// sent per second by each ValidatorDelegationsStream.
+	DefaultDelegationsStreamRate = 10_000
+
+	// DefaultMaxDelegationsStreams is the default maximum number of
+	// ValidatorDelegationsStream served concurrently.
+	DefaultMaxDelegationsStreams = 4
+
+	defaultDelegationsStreamBatchSize = 100
+	maxDelegationsStreamBatchSize     = 1_000
+)
+
+// delegationsStreamLimits rate limits the ValidatorDelegationsStream.
+type delegationsStreamLimits struct {
+	// rate is the maximum number of delegations sent per second by a stream, or
+	// 0 if not limited
+	rate int
+	// slots holds a value per stream being served, or is nil if the number of
+	// streams is not limited
+	slots chan struct{}
+}
+
+func newDelegationsStreamLimits(rate, maxStreams int) *delegationsStreamLimits {
+	limits := &delegationsStreamLimits{rate: rate}
+	if maxStreams > 0 {
+		limits.slots = make(chan struct{}, maxStreams)
+	}
+	return limits
+}
+
+// acquire reserves a slot for a stream, and returns false if they are all in
+// use. The slot is freed by release.
+func (l *delegationsStreamLimits) acquire() bool {
+	if l.slots == nil {
+		return true
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *delegationsStreamLimits) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// wait blocks until a stream which started at start and sent sent delegations
+// is back under the rate, or ctx is done.
+func (l *delegationsStreamLimits) wait(ctx context.Context, start time.Time, sent int) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	d := time.Until(start.Add(time.Duration(sent) * time.Second / time.Duration(l.rate)))
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SetDelegationsStreamLimits sets the maximum number of delegations sent per
+// second by each ValidatorDelegationsStream, and the maximum number of streams
+// served concurrently. A value of 0 disables the limit. It must be called
+// before the node serves queries.
+func (k *Keeper) SetDelegationsStreamLimits(rate, maxStreams int) {
+	k.delegationsStreams = newDelegationsStreamLimits(rate, maxStreams)
+}
+
+// ValidatorDelegationsStream streams all the delegations of a validator. The
+// batches are all read from the state at the height of the query, the context
+// of the stream being created once for the whole stream.
+func (k Querier) ValidatorDelegationsStream(req *types.QueryValidatorDelegationsStreamRequest, stream types.Query_ValidatorDelegationsStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	batchSize := int(req.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultDelegationsStreamBatchSize
+	}
+	if batchSize > maxDelegationsStreamBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxDelegationsStreamBatchSize)
+	}
+
+	if !k.delegationsStreams.acquire() {
+		return status.Error(codes.ResourceExhausted, "too many delegations streams, retry later")
+	}
+	defer k.delegationsStreams.release()
+
+	// the stream is bounded by its rate limit rather than the query gas limit
+	ctx := sdk.UnwrapSDKContext(stream.Context()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	val, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+	bondDenom := k.BondDenom(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetDelegationsByValPrefixKey(valAddr))
+	defer iterator.Close()
+
+	var (
+		start = time.Now()
+		sent  int
+		batch = make(types.DelegationResponses, 0, batchSize)
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		_, delAddr, err := types.ParseDelegationsByValKey(iterator.Key())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		del, found := k.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return status.Errorf(codes.Internal, "delegation of %s to %s not found", delAddr, valAddr)
+		}
+
+		delResp := types.NewDelegationResp(delAddr, valAddr, del.Shares, sdk.NewCoin(bondDenom, val.TokensFromShares(del.Shares).TruncateInt()))
+		delResp.Label = k.GetDelegationLabel(ctx, delAddr, valAddr)
+		batch = append(batch, delResp)
+		if len(batch) < batchSize {
+			continue
+		}
+
+		if err := stream.Send(&types.QueryValidatorDelegationsStreamResponse{DelegationResponses: batch}); err != nil {
+			return err
+		}
+		sent += len(batch)
+		batch = make(types.DelegationResponses, 0, batchSize)
+
+		if err := k.delegationsStreams.wait(stream.Context(), start, sent); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+	return stream.Send(&types.QueryValidatorDelegationsStreamResponse{DelegationResponses: batch})
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// delegationsStream records the responses of a ValidatorDelegationsStream,
+// blocking on each send while blocked is not nil.
+type delegationsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	blocked   chan struct{}
+	responses []*types.QueryValidatorDelegationsStreamResponse
+}
+
+func (s *delegationsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *delegationsStream) Send(res *types.QueryValidatorDelegationsStreamResponse) error {
+	if s.blocked != nil {
+		<-s.blocked
+	}
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorDelegationsStream() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Tokens = math.NewInt(500)
+	validator.DelegatorShares = math.LegacyNewDec(500)
+	keeper.SetValidator(ctx, validator)
+
+	delAddrs := simtestutil.CreateIncrementalAccounts(5)
+	for _, delAddr := range delAddrs {
+		keeper.SetDelegation(ctx, types.NewDelegation(delAddr, valAddr, math.LegacyNewDec(100)))
+	}
+
+	// the delegations are streamed in batches
+	stream := &delegationsStream{ctx: ctx}
+	req := &types.QueryValidatorDelegationsStreamRequest{ValidatorAddr: valAddr.String(), BatchSize: 2}
+	require.NoError(querier.ValidatorDelegationsStream(req, stream))
+	require.Len(stream.responses, 3)
+	require.Len(stream.responses[2].DelegationResponses, 1)
+
+	var streamed []string
+	for _, res := range stream.responses {
+		for _, del := range res.DelegationResponses {
+			require.Equal(valAddr.String(), del.Delegation.ValidatorAddress)
+			require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), del.Balance)
+			streamed = append(streamed, del.Delegation.DelegatorAddress)
+		}
+	}
+	for _, delAddr := range delAddrs {
+		require.Contains(streamed, delAddr.String())
+	}
+
+	// invalid requests
+	err := querier.ValidatorDelegationsStream(&types.QueryValidatorDelegationsStreamRequest{}, &delegationsStream{ctx: ctx})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	err = querier.ValidatorDelegationsStream(&types.QueryValidatorDelegationsStreamRequest{ValidatorAddr: valAddr.String(), BatchSize: 1001}, &delegationsStream{ctx: ctx})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	err = querier.ValidatorDelegationsStream(&types.QueryValidatorDelegationsStreamRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address()).String()}, &delegationsStream{ctx: ctx})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// the number of concurrent streams is limited
+	keeper.SetDelegationsStreamLimits(0, 1)
+	blocked := &delegationsStream{ctx: ctx, blocked: make(chan struct{})}
+	done := make(chan error)
+	go func() {
+		done <- querier.ValidatorDelegationsStream(req, blocked)
+	}()
+	require.Eventually(func() bool {
+		err := querier.ValidatorDelegationsStream(req, &delegationsStream{ctx: ctx})
+		return status.Code(err) == codes.ResourceExhausted
+	}, time.Second, time.Millisecond)
+	close(blocked.blocked)
+	require.NoError(<-done)
+	require.NoError(querier.ValidatorDelegationsStream(req, &delegationsStream{ctx: ctx}))
+
+	// the delegations sent per second are limited
+	keeper.SetDelegationsStreamLimits(100, 0)
+	start := time.Now()
+	req.BatchSize = 1
+	require.NoError(querier.ValidatorDelegationsStream(req, &delegationsStream{ctx: ctx}))
+	require.GreaterOrEqual(time.Since(start), 40*time.Millisecond)
+}
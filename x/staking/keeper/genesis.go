@@ -27,6 +27,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
+	if err := k.validateAdditionalBondDenoms(data.Params); err != nil {
+		panic(err)
+	}
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -51,6 +54,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 			k.InsertUnbondingValidatorQueue(ctx, validator)
 		}
 
+		// jail times are not exported, the jailed validators are deemed to be
+		// jailed since genesis
+		if validator.IsJailed() {
+			k.SetValidatorJailTime(ctx, validator.GetOperator(), ctx.BlockTime())
+		}
+
 		switch validator.GetStatus() {
 		case types.Bonded:
 			bondedTokens = bondedTokens.Add(validator.GetTokens())
@@ -86,6 +95,52 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	// the queue of the inactive validators is not exported, the inactive
+	// validators are deemed to be inactive since genesis
+	for _, validator := range data.Validators {
+		k.QueueInactiveValidator(ctx, validator, ctx.BlockTime())
+	}
+
+	for _, label := range data.DelegationLabels {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(label.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %s", err))
+		}
+
+		validatorAddress, err := sdk.ValAddressFromBech32(label.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetDelegationLabel(ctx, delegatorAddress, validatorAddress, label.Label)
+	}
+
+	for _, pref := range data.AutoRedelegations {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(pref.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %s", err))
+		}
+
+		fallbackValidatorAddress, err := sdk.ValAddressFromBech32(pref.FallbackValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetAutoRedelegation(ctx, delegatorAddress, fallbackValidatorAddress, pref.JailDuration)
+	}
+
+	for _, record := range data.JailRecords {
+		k.SetJailRecord(ctx, record)
+	}
+
+	for _, checkpoint := range data.ValidatorSetCheckpoints {
+		k.SetValidatorSetCheckpoint(ctx, checkpoint)
+	}
+
+	for _, deficit := range data.SelfDelegationDeficits {
+		k.SetSelfDelegationDeficit(ctx, deficit)
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		k.SetUnbondingDelegation(ctx, ubd)
 
@@ -197,13 +252,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                  k.GetParams(ctx),
+		LastTotalPower:          k.GetLastTotalPower(ctx),
+		LastValidatorPowers:     lastValidatorPowers,
+		Validators:              k.GetAllValidators(ctx),
+		Delegations:             k.GetAllDelegations(ctx),
+		UnbondingDelegations:    unbondingDelegations,
+		Redelegations:           redelegations,
+		Exported:                true,
+		DelegationLabels:        k.GetAllDelegationLabels(ctx),
+		AutoRedelegations:       k.GetAllAutoRedelegations(ctx),
+		JailRecords:             k.GetAllJailRecords(ctx),
+		ValidatorSetCheckpoints: k.GetAllValidatorSetCheckpoints(ctx),
+		SelfDelegationDeficits:  k.GetAllSelfDelegationDeficits(ctx),
 	}
 }
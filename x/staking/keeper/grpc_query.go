@@ -7,10 +7,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -63,6 +67,168 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	return &types.QueryValidatorsResponse{Validators: vals, Pagination: pageRes}, nil
 }
 
+// ValidatorsBelowMinCommission queries the validators whose commission rate is
+// below the minimum commission rate
+func (k Querier) ValidatorsBelowMinCommission(c context.Context, req *types.QueryValidatorsBelowMinCommissionRequest) (*types.QueryValidatorsBelowMinCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minRate := k.MinCommissionRate(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	valStore := prefix.NewStore(store, types.ValidatorsKey)
+
+	validators, pageRes, err := query.GenericFilteredPaginate(k.cdc, valStore, req.Pagination, func(key []byte, val *types.Validator) (*types.Validator, error) {
+		if !val.Commission.Rate.LT(minRate) {
+			return nil, nil
+		}
+
+		return val, nil
+	}, func() *types.Validator {
+		return &types.Validator{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	vals := types.Validators{}
+	for _, val := range validators {
+		vals = append(vals, *val)
+	}
+
+	return &types.QueryValidatorsBelowMinCommissionResponse{Validators: vals, MinCommissionRate: minRate, Pagination: pageRes}, nil
+}
+
+// InactiveValidators queries the validators to be removed by the inactive
+// validator cleanup
+func (k Querier) InactiveValidators(c context.Context, req *types.QueryInactiveValidatorsRequest) (*types.QueryInactiveValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	res := &types.QueryInactiveValidatorsResponse{
+		Validators:    types.Validators{},
+		CleanupPeriod: params.InactiveValidatorCleanupPeriod,
+		DryRun:        params.InactiveValidatorCleanupDryRun,
+	}
+	if params.InactiveValidatorCleanupPeriod == 0 {
+		return res, nil
+	}
+
+	// the queue is sorted by the time the validators became inactive
+	cutoff := ctx.BlockTime().Add(-params.InactiveValidatorCleanupPeriod)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.InactiveValidatorQueueKey)
+
+	pageRes, err := query.FilteredPaginate(queueStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		since, valAddr, err := types.ParseInactiveValidatorQueueKey(append(types.InactiveValidatorQueueKey, key...))
+		if err != nil {
+			return false, err
+		}
+		if since.After(cutoff) {
+			return false, nil
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || !k.IsInactiveValidator(ctx, validator) {
+			return false, nil
+		}
+
+		if accumulate {
+			res.Validators = append(res.Validators, validator)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// TombstonedValidators queries the validators which are tombstoned but are
+// still stored
+func (k Querier) TombstonedValidators(c context.Context, req *types.QueryTombstonedValidatorsRequest) (*types.QueryTombstonedValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.slashingKeeper == nil {
+		return nil, status.Error(codes.Unavailable, types.ErrNoSlashingKeeper.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	valStore := prefix.NewStore(store, types.ValidatorsKey)
+
+	validators, pageRes, err := query.GenericFilteredPaginate(k.cdc, valStore, req.Pagination, func(key []byte, val *types.Validator) (*types.Validator, error) {
+		if !k.IsTombstoned(ctx, *val) {
+			return nil, nil
+		}
+
+		return val, nil
+	}, func() *types.Validator {
+		return &types.Validator{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryTombstonedValidatorsResponse{
+		Validators:          types.Validators{},
+		DelegationsRejected: k.RejectTombstonedDelegations(ctx),
+		Pagination:          pageRes,
+	}
+	for _, val := range validators {
+		res.Validators = append(res.Validators, *val)
+	}
+
+	return res, nil
+}
+
+// ValidatorsBelowMinSelfDelegation queries the validators whose self-delegation
+// is below their minimum, with the time at which they are jailed
+func (k Querier) ValidatorsBelowMinSelfDelegation(c context.Context, req *types.QueryValidatorsBelowMinSelfDelegationRequest) (*types.QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	deficitStore := prefix.NewStore(store, types.SelfDelegationDeficitKey)
+
+	var deficits []types.ValidatorSelfDelegationDeficit
+	pageRes, err := query.Paginate(deficitStore, req.Pagination, func(key, value []byte) error {
+		deficit := types.MustUnmarshalSelfDelegationDeficit(k.cdc, value)
+
+		valAddr, err := sdk.ValAddressFromBech32(deficit.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrNoValidatorFound
+		}
+
+		deficits = append(deficits, types.ValidatorSelfDelegationDeficit{
+			Deficit:           deficit,
+			SelfDelegation:    k.GetSelfDelegation(ctx, validator),
+			MinSelfDelegation: validator.MinSelfDelegation,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorsBelowMinSelfDelegationResponse{Deficits: deficits, Pagination: pageRes}, nil
+}
+
 // Validator queries validator info for given validator address
 func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
 	if req == nil {
@@ -323,6 +489,58 @@ func (k Querier) DelegatorDelegations(c context.Context, req *types.QueryDelegat
 	return &types.QueryDelegatorDelegationsResponse{DelegationResponses: delegationResps, Pagination: pageRes}, nil
 }
 
+// AllDelegations queries the delegations of all the delegators
+func (k Querier) AllDelegations(c context.Context, req *types.QueryAllDelegationsRequest) (*types.QueryAllDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.MinStake.IsNil() && req.MinStake.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "min stake cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	delStore := prefix.NewStore(store, types.DelegationKey)
+
+	delResponses, pageRes, err := query.GenericFilteredPaginate(k.cdc, delStore, req.Pagination, func(key []byte, del *types.Delegation) (*types.DelegationResponse, error) {
+		if req.ExcludeModuleAccounts {
+			delAddr, err := k.authKeeper.AddressCodec().StringToBytes(del.DelegatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(sdk.ModuleAccountI); ok {
+				return nil, nil
+			}
+		}
+
+		delResp, err := DelegationToDelegationResponse(ctx, k.Keeper, *del)
+		if err != nil {
+			return nil, err
+		}
+
+		if !req.MinStake.IsNil() && delResp.Balance.Amount.LT(req.MinStake) {
+			return nil, nil
+		}
+
+		return &delResp, nil
+	}, func() *types.Delegation {
+		return &types.Delegation{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resps := make(types.DelegationResponses, len(delResponses))
+	for i, delResp := range delResponses {
+		resps[i] = *delResp
+	}
+
+	return &types.QueryAllDelegationsResponse{DelegationResponses: resps, Pagination: pageRes}, nil
+}
+
 // DelegatorValidator queries validator info for given delegator validator pair
 func (k Querier) DelegatorValidator(c context.Context, req *types.QueryDelegatorValidatorRequest) (*types.QueryDelegatorValidatorResponse, error) {
 	if req == nil {
@@ -391,6 +609,108 @@ func (k Querier) DelegatorUnbondingDelegations(c context.Context, req *types.Que
 	}, nil
 }
 
+// AutoRedelegation queries the auto-redelegation preference of a delegator
+func (k Querier) AutoRedelegation(c context.Context, req *types.QueryAutoRedelegationRequest) (*types.QueryAutoRedelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pref, found := k.GetAutoRedelegation(ctx, delAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no auto-redelegation preference for delegator %s", req.DelegatorAddr)
+	}
+
+	return &types.QueryAutoRedelegationResponse{AutoRedelegation: pref}, nil
+}
+
+// ValidatorJailHistory queries the jail history of a validator
+func (k Querier) ValidatorJailHistory(c context.Context, req *types.QueryValidatorJailHistoryRequest) (*types.QueryValidatorJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	var records []types.JailRecord
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.GetJailRecordsKey(valAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, value []byte) error {
+		var record types.JailRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorJailHistoryResponse{
+		JailRecords: records,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// ValidatorAddresses resolves the operator, consensus and account addresses of
+// a validator from any of them
+func (k Querier) ValidatorAddresses(c context.Context, req *types.QueryValidatorAddressesRequest) (*types.QueryValidatorAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		validator types.Validator
+		found     bool
+	)
+	if valAddr, err := sdk.ValAddressFromBech32(req.Address); err == nil {
+		validator, found = k.GetValidator(ctx, valAddr)
+	} else if consAddr, err := sdk.ConsAddressFromBech32(req.Address); err == nil {
+		validator, found = k.GetValidatorByConsAddr(ctx, consAddr)
+	} else if accAddr, err := sdk.AccAddressFromBech32(req.Address); err == nil {
+		validator, found = k.GetValidator(ctx, sdk.ValAddress(accAddr))
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a valid operator, consensus or account address", req.Address)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.Address)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorAddressesResponse{
+		OperatorAddress:  validator.OperatorAddress,
+		ConsensusAddress: consAddr.String(),
+		AccountAddress:   sdk.AccAddress(validator.GetOperator()).String(),
+	}, nil
+}
+
 // HistoricalInfo queries the historical info for given height
 func (k Querier) HistoricalInfo(c context.Context, req *types.QueryHistoricalInfoRequest) (*types.QueryHistoricalInfoResponse, error) {
 	if req == nil {
@@ -409,6 +729,66 @@ func (k Querier) HistoricalInfo(c context.Context, req *types.QueryHistoricalInf
 	return &types.QueryHistoricalInfoResponse{Hist: &hi}, nil
 }
 
+// HistoricalInfoSize queries the current size of the historical info store
+func (k Querier) HistoricalInfoSize(c context.Context, req *types.QueryHistoricalInfoSizeRequest) (*types.QueryHistoricalInfoSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryHistoricalInfoSizeResponse{
+		SizeBytes: k.GetHistoricalInfoSize(ctx),
+		MaxBytes:  k.HistoricalInfoMaxBytes(ctx),
+	}, nil
+}
+
+// ValidatorSetCheckpoint queries the validator set checkpoint taken at a given height
+func (k Querier) ValidatorSetCheckpoint(c context.Context, req *types.QueryValidatorSetCheckpointRequest) (*types.QueryValidatorSetCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	checkpoint, found := k.GetValidatorSetCheckpoint(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator set checkpoint for height %d not found", req.Height)
+	}
+
+	return &types.QueryValidatorSetCheckpointResponse{Checkpoint: checkpoint}, nil
+}
+
+// ValidatorSetCheckpoints queries all the validator set checkpoints
+func (k Querier) ValidatorSetCheckpoints(c context.Context, req *types.QueryValidatorSetCheckpointsRequest) (*types.QueryValidatorSetCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var checkpoints []types.ValidatorSetCheckpoint
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	checkpointStore := prefix.NewStore(store, types.ValidatorSetCheckpointKey)
+	pageRes, err := query.Paginate(checkpointStore, req.Pagination, func(key, value []byte) error {
+		var checkpoint types.ValidatorSetCheckpoint
+		if err := k.cdc.Unmarshal(value, &checkpoint); err != nil {
+			return err
+		}
+		checkpoints = append(checkpoints, checkpoint)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSetCheckpointsResponse{
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // Redelegations queries redelegations of given address
 func (k Querier) Redelegations(c context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
 	if req == nil {
@@ -503,6 +883,189 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// YieldInputs queries the inputs of the estimation of the staking yield, all read at the current height
+func (k Querier) YieldInputs(c context.Context, req *types.QueryYieldInputsRequest) (*types.QueryYieldInputsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.mintKeeper == nil || k.distrKeeper == nil {
+		return nil, status.Error(codes.Unavailable, types.ErrNoYieldKeepers.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	commissionRate := math.LegacyZeroDec()
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+		}
+		commissionRate = validator.Commission.Rate
+	}
+
+	inflation, err := k.mintKeeper.Inflation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	communityTax, err := k.distrKeeper.GetCommunityTax(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryYieldInputsResponse{
+		Height:         ctx.BlockHeight(),
+		BondedTokens:   k.TotalBondedTokens(ctx),
+		TotalSupply:    k.StakingTokenSupply(ctx),
+		BondedRatio:    k.BondedRatio(ctx),
+		Inflation:      inflation,
+		CommunityTax:   communityTax,
+		CommissionRate: commissionRate,
+	}, nil
+}
+
+// PowerDistribution queries a summary of the distribution of the consensus power among the bonded validators
+func (k Querier) PowerDistribution(c context.Context, req *types.QueryPowerDistributionRequest) (*types.QueryPowerDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	topN := req.TopN
+	if topN == 0 {
+		topN = DefaultPowerDistributionTopN
+	}
+
+	threshold := req.Threshold
+	if threshold.IsNil() || threshold.IsZero() {
+		threshold = DefaultNakamotoThreshold
+	}
+	if threshold.IsNegative() || threshold.GTE(math.LegacyOneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "threshold must be in (0, 1): %s", threshold)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.Keeper.PowerDistribution(ctx, topN, threshold), nil
+}
+
+// TopDelegators queries the delegations of a validator with the most shares
+func (k Querier) TopDelegators(c context.Context, req *types.QueryTopDelegatorsRequest) (*types.QueryTopDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultTopDelegatorsLimit
+	}
+	if limit > MaxTopDelegatorsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d is above the maximum of %d", limit, MaxTopDelegatorsLimit)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delResponses, err := DelegationsToDelegationResponses(ctx, k.Keeper, k.GetTopDelegations(ctx, valAddr, limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopDelegatorsResponse{DelegationResponses: delResponses}, nil
+}
+
+// ValidateCreateValidator checks a MsgCreateValidator against the current state without executing it
+func (k Querier) ValidateCreateValidator(c context.Context, req *types.QueryValidateCreateValidatorRequest) (*types.QueryValidateCreateValidatorResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	checkErrs := make([]types.CreateValidatorCheckError, 0)
+	addCheckErr := func(field string, err error) {
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		checkErrs = append(checkErrs, types.CreateValidatorCheckError{
+			Field:     field,
+			Codespace: codespace,
+			Code:      code,
+			Message:   err.Error(),
+		})
+	}
+
+	msg := req.Msg
+	if valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		addCheckErr("validator_address", sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err))
+	} else if _, found := k.GetValidator(ctx, valAddr); found {
+		addCheckErr("validator_address", types.ErrValidatorOwnerExists)
+	}
+
+	if msg.Pubkey == nil {
+		addCheckErr("pubkey", types.ErrEmptyValidatorPubKey)
+	} else if pk, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey); !ok {
+		addCheckErr("pubkey", errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", msg.Pubkey.GetCachedValue()))
+	} else {
+		if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+			addCheckErr("pubkey", types.ErrValidatorPubKeyExists)
+		}
+
+		if cp := ctx.ConsensusParams(); cp.Validator != nil {
+			hasKeyType := false
+			for _, keyType := range cp.Validator.PubKeyTypes {
+				if pk.Type() == keyType {
+					hasKeyType = true
+					break
+				}
+			}
+			if !hasKeyType {
+				addCheckErr("pubkey", errorsmod.Wrapf(
+					types.ErrValidatorPubKeyTypeNotSupported,
+					"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+				))
+			}
+		}
+	}
+
+	if bondDenom := k.BondDenom(ctx); !msg.Value.IsValid() || !msg.Value.Amount.IsPositive() {
+		addCheckErr("value", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid delegation amount"))
+	} else if msg.Value.Denom != bondDenom {
+		addCheckErr("value", errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Value.Denom, bondDenom,
+		))
+	}
+
+	if msg.Description == (types.Description{}) {
+		addCheckErr("description", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty description"))
+	} else if _, err := msg.Description.EnsureLength(); err != nil {
+		addCheckErr("description", err)
+	}
+
+	if msg.Commission.Rate.IsNil() || msg.Commission.MaxRate.IsNil() || msg.Commission.MaxChangeRate.IsNil() {
+		addCheckErr("commission", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty commission"))
+	} else if err := msg.Commission.Validate(); err != nil {
+		addCheckErr("commission", err)
+	} else if minRate := k.MinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		addCheckErr("commission", errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate))
+	}
+
+	if msg.MinSelfDelegation.IsNil() || !msg.MinSelfDelegation.IsPositive() {
+		addCheckErr("min_self_delegation", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minimum self delegation must be a positive integer"))
+	} else if msg.Value.Amount.IsNil() || msg.Value.Amount.LT(msg.MinSelfDelegation) {
+		addCheckErr("min_self_delegation", types.ErrSelfDelegationBelowMinimum)
+	}
+
+	return &types.QueryValidateCreateValidatorResponse{Errors: checkErrs}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -585,12 +1148,15 @@ func DelegationToDelegationResponse(ctx sdk.Context, k *Keeper, del types.Delega
 		return types.DelegationResponse{}, err
 	}
 
-	return types.NewDelegationResp(
+	delResp := types.NewDelegationResp(
 		delegatorAddress,
 		del.GetValidatorAddr(),
 		del.Shares,
 		sdk.NewCoin(k.BondDenom(ctx), val.TokensFromShares(del.Shares).TruncateInt()),
-	), nil
+	)
+	delResp.Label = k.GetDelegationLabel(ctx, delegatorAddress, del.GetValidatorAddr())
+
+	return delResp, nil
 }
 
 func DelegationsToDelegationResponses(ctx sdk.Context, k *Keeper, delegations types.Delegations) (types.DelegationResponses, error) {
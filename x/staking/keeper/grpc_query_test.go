@@ -3,8 +3,14 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -60,3 +66,102 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorAddresses() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	expRes := &types.QueryValidatorAddressesResponse{
+		OperatorAddress:  validator.OperatorAddress,
+		ConsensusAddress: sdk.ConsAddress(PKs[0].Address()).String(),
+		AccountAddress:   sdk.AccAddress(valAddr).String(),
+	}
+
+	testCases := []struct {
+		msg     string
+		address string
+		expPass bool
+	}{
+		{"empty address", "", false},
+		{"invalid address", "invalid", false},
+		{"not existing validator", "cosmosvaloper15jkng8hytwt22lllv6mw4k89qkqehtahd84ptu", false},
+		{"operator address", expRes.OperatorAddress, true},
+		{"consensus address", expRes.ConsensusAddress, true},
+		{"account address", expRes.AccountAddress, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.ValidatorAddresses(gocontext.Background(), &types.QueryValidatorAddressesRequest{Address: tc.address})
+			if tc.expPass {
+				require.NoError(err)
+				require.Equal(expRes, res)
+			} else {
+				require.Error(err)
+				require.Nil(res)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidateCreateValidator() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	newMsg := func(valAddr sdk.ValAddress, pk cryptotypes.PubKey) *types.MsgCreateValidator {
+		msg, err := types.NewMsgCreateValidator(
+			valAddr, pk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			types.NewDescription("moniker", "", "", "", ""),
+			types.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(err)
+		return msg
+	}
+
+	_, err := queryClient.ValidateCreateValidator(gocontext.Background(), &types.QueryValidateCreateValidatorRequest{})
+	require.Error(err)
+
+	res, err := queryClient.ValidateCreateValidator(gocontext.Background(), &types.QueryValidateCreateValidatorRequest{
+		Msg: newMsg(sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1]),
+	})
+	require.NoError(err)
+	require.Empty(res.Errors)
+
+	invalid := newMsg(validator.GetOperator(), PKs[0])
+	invalid.Value = sdk.NewInt64Coin("foo", 100)
+	invalid.Description.Moniker = strings.Repeat("a", types.MaxMonikerLength+1)
+	invalid.Commission.Rate = math.LegacyNewDecWithPrec(3, 1)
+	invalid.MinSelfDelegation = math.NewInt(1000)
+
+	res, err = queryClient.ValidateCreateValidator(gocontext.Background(), &types.QueryValidateCreateValidatorRequest{Msg: invalid})
+	require.NoError(err)
+
+	expErrs := []struct {
+		field string
+		err   error
+	}{
+		{"validator_address", types.ErrValidatorOwnerExists},
+		{"pubkey", types.ErrValidatorPubKeyExists},
+		{"value", sdkerrors.ErrInvalidRequest},
+		{"description", sdkerrors.ErrInvalidRequest},
+		{"commission", types.ErrCommissionGTMaxRate},
+		{"min_self_delegation", types.ErrSelfDelegationBelowMinimum},
+	}
+	require.Len(res.Errors, len(expErrs))
+	for i, exp := range expErrs {
+		codespace, code, _ := errorsmod.ABCIInfo(exp.err, false)
+		require.Equal(exp.field, res.Errors[i].Field)
+		require.Equal(codespace, res.Errors[i].Codespace, exp.field)
+		require.Equal(code, res.Errors[i].Code, exp.field)
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,15 +27,58 @@ func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *types.Histo
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetHistoricalInfoKey(height)
 	value := k.cdc.MustMarshal(hi)
+
+	size := k.GetHistoricalInfoSize(ctx)
+	if old := store.Get(key); old != nil {
+		size = subHistoricalInfoSize(size, key, old)
+	}
 	store.Set(key, value)
+	k.setHistoricalInfoSize(ctx, size+uint64(len(key)+len(value)))
 }
 
 // DeleteHistoricalInfo deletes the historical info at a given height
 func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	k.deleteHistoricalInfo(ctx, types.GetHistoricalInfoKey(height))
+}
+
+func (k Keeper) deleteHistoricalInfo(ctx sdk.Context, key []byte) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetHistoricalInfoKey(height)
+	value := store.Get(key)
+	if value == nil {
+		return
+	}
 
 	store.Delete(key)
+	k.setHistoricalInfoSize(ctx, subHistoricalInfoSize(k.GetHistoricalInfoSize(ctx), key, value))
+}
+
+// GetHistoricalInfoSize returns the number of bytes, keys included, taken by
+// the stored historical info.
+func (k Keeper) GetHistoricalInfoSize(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HistoricalInfoSizeKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setHistoricalInfoSize(ctx sdk.Context, size uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, size)
+	store.Set(types.HistoricalInfoSizeKey, bz)
+}
+
+// subHistoricalInfoSize subtracts the size of a historical info entry from the
+// total size, without underflowing.
+func subHistoricalInfoSize(size uint64, key, value []byte) uint64 {
+	entrySize := uint64(len(key) + len(value))
+	if entrySize > size {
+		return 0
+	}
+	return size - entrySize
 }
 
 // IterateHistoricalInfo provides an interator over all stored HistoricalInfo
@@ -68,7 +113,8 @@ func (k Keeper) GetAllHistoricalInfo(ctx sdk.Context) []types.HistoricalInfo {
 }
 
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
-// heights that are below pruning height
+// heights that are below pruning height, or that do not fit in the historical
+// info max bytes
 func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	entryNum := k.HistoricalEntries(ctx)
 
@@ -99,4 +145,36 @@ func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 
 	// Set latest HistoricalInfo at current height
 	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
+
+	k.pruneHistoricalInfoBySize(ctx, ctx.BlockHeight())
+}
+
+// pruneHistoricalInfoBySize deletes the oldest historical entries until the
+// stored historical info fits in the historical info max bytes. The entry at the
+// given height is always kept, even if it alone does not fit. As the entries
+// are deleted from the oldest, the remaining ones stay in a continuous range.
+func (k Keeper) pruneHistoricalInfoBySize(ctx sdk.Context, height int64) {
+	maxBytes := k.HistoricalInfoMaxBytes(ctx)
+	if maxBytes == 0 {
+		return
+	}
+
+	size := k.GetHistoricalInfoSize(ctx)
+	if size <= maxBytes {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.HistoricalInfoKey, types.GetHistoricalInfoKey(height))
+
+	var keys [][]byte
+	for ; iterator.Valid() && size > maxBytes; iterator.Next() {
+		size = subHistoricalInfoSize(size, iterator.Key(), iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		k.deleteHistoricalInfo(ctx, key)
+	}
 }
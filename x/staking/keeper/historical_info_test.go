@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/math"
 
@@ -40,7 +41,16 @@ func (s *KeeperTestSuite) TestHistoricalInfo() {
 	require.Equal(hi, recv, "HistoricalInfo not equal")
 	require.True(IsValSetSorted(recv.Valset, keeper.PowerReduction(ctx)), "HistoricalInfo validators is not sorted")
 
+	key := stakingtypes.GetHistoricalInfoKey(2)
+	size := uint64(len(key) + hi.Size())
+	require.Equal(size, keeper.GetHistoricalInfoSize(ctx))
+
+	// overwriting the entry does not count it twice
+	keeper.SetHistoricalInfo(ctx, 2, &hi)
+	require.Equal(size, keeper.GetHistoricalInfoSize(ctx))
+
 	keeper.DeleteHistoricalInfo(ctx, 2)
+	require.Zero(keeper.GetHistoricalInfoSize(ctx))
 
 	recv, found = keeper.GetHistoricalInfo(ctx, 2)
 	require.False(found, "HistoricalInfo found after delete")
@@ -125,6 +135,64 @@ func (s *KeeperTestSuite) TestTrackHistoricalInfo() {
 	require.Equal(stakingtypes.HistoricalInfo{}, recv, "GetHistoricalInfo at height 5 is not empty after prune")
 }
 
+func (s *KeeperTestSuite) TestTrackHistoricalInfoBySize() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(1)
+	val := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	val.Status = stakingtypes.Bonded
+	val.Tokens = keeper.TokensFromConsensusPower(ctx, 10)
+	keeper.SetValidator(ctx, val)
+	keeper.SetLastValidatorPower(ctx, val.GetOperator(), 10)
+
+	track := func(height int64) {
+		ctx = ctx.WithBlockHeader(cmtproto.Header{ChainID: "HelloChain", Height: height})
+		keeper.TrackHistoricalInfo(ctx)
+	}
+
+	// without a byte limit, all the historical entries are kept
+	params := stakingtypes.DefaultParams()
+	params.HistoricalEntries = 10
+	params.HistoricalInfoMaxBytes = 0
+	require.NoError(keeper.SetParams(ctx, params))
+	for height := int64(1); height <= 5; height++ {
+		track(height)
+	}
+	require.Len(keeper.GetAllHistoricalInfo(ctx), 5)
+
+	// all the entries have the same size, the limit leaves room for 3 of them
+	entrySize := keeper.GetHistoricalInfoSize(ctx) / 5
+	params.HistoricalInfoMaxBytes = 3*entrySize + entrySize/2
+	require.NoError(keeper.SetParams(ctx, params))
+	track(6)
+
+	infos := keeper.GetAllHistoricalInfo(ctx)
+	require.Len(infos, 3)
+	require.Equal(int64(4), infos[0].Header.Height)
+	require.Equal(int64(6), infos[2].Header.Height)
+	require.Equal(3*entrySize, keeper.GetHistoricalInfoSize(ctx))
+
+	res, err := s.queryClient.HistoricalInfoSize(ctx, &stakingtypes.QueryHistoricalInfoSizeRequest{})
+	require.NoError(err)
+	require.Equal(&stakingtypes.QueryHistoricalInfoSizeResponse{SizeBytes: 3 * entrySize, MaxBytes: params.HistoricalInfoMaxBytes}, res)
+
+	// the count of historical entries still applies
+	params.HistoricalEntries = 2
+	require.NoError(keeper.SetParams(ctx, params))
+	track(7)
+	require.Len(keeper.GetAllHistoricalInfo(ctx), 2)
+
+	// the entry of the current block is kept even if it does not fit
+	params.HistoricalInfoMaxBytes = 1
+	require.NoError(keeper.SetParams(ctx, params))
+	track(8)
+	infos = keeper.GetAllHistoricalInfo(ctx)
+	require.Len(infos, 1)
+	require.Equal(int64(8), infos[0].Header.Height)
+	require.Equal(entrySize, keeper.GetHistoricalInfoSize(ctx))
+}
+
 func (s *KeeperTestSuite) TestGetAllHistoricalInfo() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -153,3 +221,65 @@ func (s *KeeperTestSuite) TestGetAllHistoricalInfo() {
 	infos := keeper.GetAllHistoricalInfo(ctx)
 	require.Equal(expHistInfos, infos)
 }
+
+func (s *KeeperTestSuite) TestTrackValidatorSetCheckpoint() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	_, addrVals := createValAddrs(2)
+
+	params := stakingtypes.DefaultParams()
+	params.ValidatorSetCheckpointInterval = 10
+	keeper.SetParams(ctx, params)
+
+	val1 := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	val1.Status = stakingtypes.Bonded
+	val1.Tokens = keeper.TokensFromConsensusPower(ctx, 10)
+	keeper.SetValidator(ctx, val1)
+	keeper.SetLastValidatorPower(ctx, val1.GetOperator(), 10)
+	val2 := testutil.NewValidator(s.T(), addrVals[1], PKs[1])
+	val2.Status = stakingtypes.Bonded
+	val2.Tokens = keeper.TokensFromConsensusPower(ctx, 80)
+	keeper.SetValidator(ctx, val2)
+	keeper.SetLastValidatorPower(ctx, val2.GetOperator(), 80)
+
+	// no checkpoint is taken out of the interval
+	keeper.TrackValidatorSetCheckpoint(ctx.WithBlockHeight(5))
+	_, found := keeper.GetValidatorSetCheckpoint(ctx, 5)
+	require.False(found)
+
+	keeper.TrackValidatorSetCheckpoint(ctx.WithBlockHeight(10))
+	checkpoint, found := keeper.GetValidatorSetCheckpoint(ctx, 10)
+	require.True(found)
+	require.Equal(int64(10), checkpoint.Height)
+	require.Equal(int64(90), checkpoint.TotalPower)
+
+	cmtVals, err := testutil.ToCmtValidators(stakingtypes.Validators{val1, val2}, keeper.PowerReduction(ctx))
+	require.NoError(err)
+	require.Equal(cmttypes.NewValidatorSet(cmtVals).Hash(), checkpoint.ValidatorsHash)
+
+	// validators are sorted by power as in CometBFT
+	consAddr2, err := val2.GetConsAddr()
+	require.NoError(err)
+	require.Len(checkpoint.Validators, 2)
+	require.Equal(consAddr2.String(), checkpoint.Validators[0].ConsAddress)
+	require.Equal(int64(80), checkpoint.Validators[0].Power)
+
+	// checkpoints are disabled with a zero interval
+	params.ValidatorSetCheckpointInterval = 0
+	keeper.SetParams(ctx, params)
+	keeper.TrackValidatorSetCheckpoint(ctx.WithBlockHeight(20))
+	_, found = keeper.GetValidatorSetCheckpoint(ctx, 20)
+	require.False(found)
+
+	res, err := queryClient.ValidatorSetCheckpoint(ctx, &stakingtypes.QueryValidatorSetCheckpointRequest{Height: 10})
+	require.NoError(err)
+	require.Equal(checkpoint, res.Checkpoint)
+
+	_, err = queryClient.ValidatorSetCheckpoint(ctx, &stakingtypes.QueryValidatorSetCheckpointRequest{Height: 20})
+	require.Error(err)
+
+	allRes, err := queryClient.ValidatorSetCheckpoints(ctx, &stakingtypes.QueryValidatorSetCheckpointsRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.ValidatorSetCheckpoint{checkpoint}, allRes.Checkpoints)
+}
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsInactiveValidator returns whether the validator is unbonded with no
+// delegations other than the self-delegation of its operator.
+func (k Keeper) IsInactiveValidator(ctx sdk.Context, validator types.Validator) bool {
+	if !validator.IsUnbonded() {
+		return false
+	}
+
+	valAddr := validator.GetOperator()
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetDelegationsByValPrefixKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, delAddr, err := types.ParseDelegationsByValKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		if !bytes.Equal(delAddr, valAddr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// QueueInactiveValidator queues a validator for the inactive validator cleanup
+// if it is inactive, as of the given time. It is called when a validator may
+// have just become inactive: when it is created, when it becomes unbonded and
+// when the last delegation to it of another delegator than its operator is
+// removed. A previous entry of the validator, from before it was last active,
+// is replaced.
+func (k Keeper) QueueInactiveValidator(ctx sdk.Context, validator types.Validator, since time.Time) {
+	if !k.IsInactiveValidator(ctx, validator) {
+		return
+	}
+
+	valAddr := validator.GetOperator()
+	k.DequeueInactiveValidator(ctx, valAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInactiveValidatorKey(valAddr), sdk.FormatTimeBytes(since))
+	store.Set(types.GetInactiveValidatorQueueKey(since, valAddr), []byte{})
+}
+
+// DequeueInactiveValidator removes a validator from the queue of the inactive
+// validator cleanup.
+func (k Keeper) DequeueInactiveValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInactiveValidatorKey(valAddr))
+	if bz == nil {
+		return
+	}
+
+	since, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Delete(types.GetInactiveValidatorQueueKey(since, valAddr))
+	store.Delete(types.GetInactiveValidatorKey(valAddr))
+}
+
+// GetInactiveValidators returns the validators to be removed by the inactive
+// validator cleanup, which is none if the cleanup is disabled.
+func (k Keeper) GetInactiveValidators(ctx sdk.Context) (validators []types.Validator) {
+	period := k.InactiveValidatorCleanupPeriod(ctx)
+	if period == 0 {
+		return nil
+	}
+
+	for _, valAddr := range k.getQueuedInactiveValidators(ctx, nil, ctx.BlockTime().Add(-period)) {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if found && k.IsInactiveValidator(ctx, validator) {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
+// getQueuedInactiveValidators returns the validators of the queue which became
+// inactive after the start time, if any, and up to the end time.
+func (k Keeper) getQueuedInactiveValidators(ctx sdk.Context, start *time.Time, end time.Time) (valAddrs []sdk.ValAddress) {
+	startKey := types.InactiveValidatorQueueKey
+	if start != nil {
+		startKey = storetypes.PrefixEndBytes(types.GetInactiveValidatorQueueTimeKey(*start))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(startKey, storetypes.PrefixEndBytes(types.GetInactiveValidatorQueueTimeKey(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr, err := types.ParseInactiveValidatorQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	return valAddrs
+}
+
+// RemoveInactiveValidators force-undelegates the self-delegations of the
+// validators which have been inactive for the cleanup period, and removes the
+// validators, shrinking the validator index. Only the queue of the inactive
+// validators up to the end of the period is read.
+//
+// In dry-run mode, the validators are only reported by events, once: the time
+// up to which they were reported is stored, and the queue is read from it in
+// the next blocks. It is called in the end blocker.
+func (k Keeper) RemoveInactiveValidators(ctx sdk.Context) {
+	period := k.InactiveValidatorCleanupPeriod(ctx)
+	if period == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := ctx.BlockTime().Add(-period)
+	dryRun := k.InactiveValidatorCleanupDryRun(ctx)
+
+	var start *time.Time
+	if bz := store.Get(types.InactiveValidatorDryRunKey); bz != nil && dryRun {
+		reported, err := sdk.ParseTimeBytes(bz)
+		if err != nil {
+			panic(err)
+		}
+		if !cutoff.After(reported) {
+			return
+		}
+		start = &reported
+	}
+
+	for _, valAddr := range k.getQueuedInactiveValidators(ctx, start, cutoff) {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || !k.IsInactiveValidator(ctx, validator) {
+			// the validator became active since it was queued
+			k.DequeueInactiveValidator(ctx, valAddr)
+			continue
+		}
+
+		if !dryRun {
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.removeInactiveValidator(cacheCtx, validator); err != nil {
+				k.Logger(ctx).Error(
+					"failed to remove inactive validator",
+					"validator", validator.OperatorAddress,
+					"err", err,
+				)
+				k.DequeueInactiveValidator(ctx, valAddr)
+				continue
+			}
+			write()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveInactiveValidator,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDryRun, strconv.FormatBool(dryRun)),
+			),
+		)
+	}
+
+	if dryRun {
+		store.Set(types.InactiveValidatorDryRunKey, sdk.FormatTimeBytes(cutoff))
+	} else {
+		store.Delete(types.InactiveValidatorDryRunKey)
+	}
+}
+
+// removeInactiveValidator undelegates the self-delegation of an inactive
+// validator, the tokens of which are returned to its operator after the
+// unbonding period, then removes the validator if undelegating did not.
+func (k Keeper) removeInactiveValidator(ctx sdk.Context, validator types.Validator) error {
+	valAddr := validator.GetOperator()
+
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err := k.Undelegate(ctx, delAddr, valAddr, delegation.Shares); err != nil {
+			return err
+		}
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); found {
+		k.RemoveValidator(ctx, valAddr)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestRemoveInactiveValidators() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+
+	// setValidator stores an unbonded validator with a self-delegation and,
+	// if delegated, a delegation of another delegator, and queues it if it is
+	// inactive as of the given time
+	setValidator := func(i int, since time.Time, delegated bool) {
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator, shares := validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		keeper.SetValidator(ctx, validator)
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+		if delegated {
+			half := shares.QuoInt64(2)
+			keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(valAddrs[i]), valAddrs[i], half))
+			keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[2], valAddrs[i], shares.Sub(half)))
+		} else {
+			keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(valAddrs[i]), valAddrs[i], shares))
+		}
+		keeper.QueueInactiveValidator(ctx, validator, since)
+	}
+	setValidator(0, ctx.BlockTime().Add(-2*time.Hour), false)
+	setValidator(1, ctx.BlockTime().Add(-2*time.Hour), true)
+	setValidator(2, ctx.BlockTime().Add(-30*time.Minute), false)
+
+	// removeEvents returns the events of the cleanup emitted in a context
+	removeEvents := func(ctx sdk.Context) (events sdk.Events) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == stakingtypes.EventTypeRemoveInactiveValidator {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+	removeEvent := func(valAddr sdk.ValAddress, dryRun string) sdk.Event {
+		return sdk.NewEvent(
+			stakingtypes.EventTypeRemoveInactiveValidator,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDryRun, dryRun),
+		)
+	}
+
+	// the cleanup is disabled by default
+	require.Empty(keeper.GetInactiveValidators(ctx))
+	res, err := s.queryClient.InactiveValidators(ctx, &stakingtypes.QueryInactiveValidatorsRequest{})
+	require.NoError(err)
+	require.Empty(res.Validators)
+
+	params := keeper.GetParams(ctx)
+	params.InactiveValidatorCleanupPeriod = time.Hour
+	params.InactiveValidatorCleanupDryRun = true
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// only the validator inactive for longer than the period, with no other
+	// delegator than its operator, is a candidate
+	inactive := keeper.GetInactiveValidators(ctx)
+	require.Len(inactive, 1)
+	require.Equal(valAddrs[0].String(), inactive[0].OperatorAddress)
+	res, err = s.queryClient.InactiveValidators(ctx, &stakingtypes.QueryInactiveValidatorsRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Equal(valAddrs[0].String(), res.Validators[0].OperatorAddress)
+	require.Equal(time.Hour, res.CleanupPeriod)
+	require.True(res.DryRun)
+
+	// in dry-run mode, the validator is only reported, once
+	dryRunCtx := ctx.WithEventManager(sdk.NewEventManager())
+	keeper.RemoveInactiveValidators(dryRunCtx)
+	require.Equal(sdk.Events{removeEvent(valAddrs[0], "true")}, removeEvents(dryRunCtx))
+	_, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+
+	dryRunCtx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.RemoveInactiveValidators(dryRunCtx)
+	require.Empty(removeEvents(dryRunCtx))
+
+	// the other validator is reported once it has been inactive for the
+	// period, and the last delegation of another delegator being removed
+	// makes a validator inactive
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = keeper.Unbond(ctx, delAddrs[2], valAddrs[1], keeper.Delegation(ctx, delAddrs[2], valAddrs[1]).GetShares())
+	require.NoError(err)
+	dryRunCtx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.RemoveInactiveValidators(dryRunCtx)
+	require.Equal(sdk.Events{removeEvent(valAddrs[2], "true")}, removeEvents(dryRunCtx))
+	require.Len(keeper.GetInactiveValidators(ctx), 2)
+
+	// otherwise their self-delegations are undelegated and they are removed
+	params.InactiveValidatorCleanupDryRun = false
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.RemoveInactiveValidators(ctx)
+	require.Equal(sdk.Events{removeEvent(valAddrs[0], "false"), removeEvent(valAddrs[2], "false")}, removeEvents(ctx))
+	for _, i := range []int{0, 2} {
+		_, found = keeper.GetValidator(ctx, valAddrs[i])
+		require.False(found)
+		_, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[i].Address()))
+		require.False(found)
+		require.Empty(keeper.GetValidatorDelegations(ctx, valAddrs[i]))
+		_, found = keeper.GetUnbondingDelegation(ctx, sdk.AccAddress(valAddrs[i]), valAddrs[i])
+		require.True(found)
+	}
+
+	// the validator which became inactive later is kept until its period ends
+	require.Len(keeper.GetAllValidators(ctx), 1)
+	require.Empty(keeper.GetInactiveValidators(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.RemoveInactiveValidators(ctx)
+	require.Equal(sdk.Events{removeEvent(valAddrs[1], "false")}, removeEvents(ctx))
+	require.Empty(keeper.GetAllValidators(ctx))
+}
@@ -112,7 +112,7 @@ func NonNegativePowerInvariant(k *Keeper) sdk.Invariant {
 				broken = true
 				msg += fmt.Sprintf("power store invariance:\n\tvalidator.Power: %v"+
 					"\n\tkey should be: %v\n\tkey in store: %v\n",
-					validator.GetConsensusPower(k.PowerReduction(ctx)), powerKey, iterator.Key())
+					k.PotentialConsensusPower(ctx, validator), powerKey, iterator.Key())
 			}
 
 			if validator.Tokens.IsNegative() {
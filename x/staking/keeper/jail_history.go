@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetJailRecord records a jailing of a validator in its jail history.
+func (k Keeper) SetJailRecord(ctx sdk.Context, record types.JailRecord) {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetJailRecordKey(valAddr, record.Height), types.MustMarshalJailRecord(k.cdc, record))
+}
+
+// GetValidatorJailRecords returns the jail history of a validator, oldest first.
+func (k Keeper) GetValidatorJailRecords(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.JailRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetJailRecordsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalJailRecord(k.cdc, iterator.Value()))
+	}
+
+	return records
+}
+
+// IterateJailRecords iterates through the jail history of all validators.
+func (k Keeper) IterateJailRecords(ctx sdk.Context, cb func(record types.JailRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.JailRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalJailRecord(k.cdc, iterator.Value())
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllJailRecords returns the jail history of all validators used during genesis dump.
+func (k Keeper) GetAllJailRecords(ctx sdk.Context) (records []types.JailRecord) {
+	k.IterateJailRecords(ctx, func(record types.JailRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
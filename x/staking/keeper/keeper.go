@@ -27,6 +27,24 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	authority  string
+
+	// mintKeeper and distrKeeper are optional, used solely to query the yield
+	// inputs
+	mintKeeper  types.MintKeeper
+	distrKeeper types.DistributionKeeper
+
+	// slashingKeeper is optional, used solely to check whether the validators
+	// are tombstoned
+	slashingKeeper types.SlashingKeeper
+
+	// multiAssetStaking is true if the additional bond denoms param may be set
+	multiAssetStaking bool
+
+	// powerStrategy computes the consensus power of the validators, linear if nil
+	powerStrategy types.PowerStrategy
+
+	// delegationsStreams rate limits the ValidatorDelegationsStream
+	delegationsStreams *delegationsStreamLimits
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -58,6 +76,8 @@ func NewKeeper(
 		bankKeeper: bk,
 		hooks:      nil,
 		authority:  authority,
+
+		delegationsStreams: newDelegationsStreamLimits(DefaultDelegationsStreamRate, DefaultMaxDelegationsStreams),
 	}
 }
 
@@ -86,6 +106,38 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetYieldKeepers sets the mint and distribution keepers the inflation and the
+// community tax of the yield inputs are queried from. They cannot be passed to
+// NewKeeper as both keepers depend on the staking keeper.
+func (k *Keeper) SetYieldKeepers(mk types.MintKeeper, dk types.DistributionKeeper) {
+	k.mintKeeper = mk
+	k.distrKeeper = dk
+}
+
+// SetSlashingKeeper sets the slashing keeper the tombstone status of the
+// validators is read from. It cannot be passed to NewKeeper as the slashing
+// keeper depends on the staking keeper.
+func (k *Keeper) SetSlashingKeeper(sk types.SlashingKeeper) {
+	k.slashingKeeper = sk
+}
+
+// SetPowerStrategy sets the strategy the consensus power of the validators is
+// computed with. It panics if the strategy is invalid or if a strategy is
+// already set. Switching strategies changes the validator set, so it must only
+// happen in a chain upgrade: the powers of all the validators are recomputed
+// at the first EndBlock with the new strategy.
+func (k *Keeper) SetPowerStrategy(s types.PowerStrategy) {
+	if k.powerStrategy != nil {
+		panic("cannot set power strategy twice")
+	}
+
+	if err := types.ValidatePowerStrategy(s, sdk.DefaultPowerReduction); err != nil {
+		panic(err)
+	}
+
+	k.powerStrategy = s
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
@@ -34,6 +34,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	key           *storetypes.KVStoreKey
 	stakingKeeper *stakingkeeper.Keeper
 	bankKeeper    *stakingtestutil.MockBankKeeper
 	accountKeeper *stakingtestutil.MockAccountKeeper
@@ -65,6 +66,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	keeper.SetParams(ctx, stakingtypes.DefaultParams())
 
 	s.ctx = ctx
+	s.key = key
 	s.stakingKeeper = keeper
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
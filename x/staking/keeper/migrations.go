@@ -3,10 +3,15 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	v10 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v10"
 	v2 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
 	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	v8 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v8"
+	v9 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -42,3 +47,28 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates x/staking state from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates x/staking state from consensus version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate9to10 migrates x/staking state from consensus version 9 to 10.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -126,6 +126,11 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
+	// the validator is inactive until it is bonded or delegated to, from its
+	// creation
+	validator = k.mustGetValidator(ctx, valAddr)
+	k.QueueInactiveValidator(ctx, validator, ctx.BlockTime())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
@@ -249,6 +254,10 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
+	if err := k.checkNotTombstoned(ctx, validator); err != nil {
+		return nil, err
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
@@ -318,6 +327,12 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		)
 	}
 
+	if dstValidator, found := k.GetValidator(ctx, valDstAddr); found {
+		if err := k.checkNotTombstoned(ctx, dstValidator); err != nil {
+			return nil, err
+		}
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -419,10 +434,10 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 	}, nil
 }
 
-// CancelUnbondingDelegation defines a method for canceling the unbonding delegation
-// and delegate back to the validator.
-func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
-	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+// UndelegateTokens defines a method for performing an undelegation of an exact
+// token amount from a delegate and a validator
+func (k msgServer) UndelegateTokens(goCtx context.Context, msg *types.MsgUndelegateTokens) (*types.MsgUndelegateTokensResponse, error) {
+	addr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
 	}
@@ -435,14 +450,7 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"invalid amount",
-		)
-	}
-
-	if msg.CreationHeight <= 0 {
-		return nil, errorsmod.Wrap(
-			sdkerrors.ErrInvalidRequest,
-			"invalid height",
+			"invalid token amount",
 		)
 	}
 
@@ -455,6 +463,191 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		)
 	}
 
+	shares, err := k.ValidateUnbondTokens(
+		ctx, delegatorAddress, addr, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	undelegatedCoin := sdk.NewCoin(msg.Amount.Denom, undelegatedAmt)
+
+	if undelegatedAmt.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+				float32(undelegatedAmt.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, undelegatedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgUndelegateTokensResponse{
+		CompletionTime: completionTime,
+		Amount:         undelegatedCoin,
+		Shares:         shares,
+	}, nil
+}
+
+// SetDelegationLabel defines a method for setting the label of a delegation
+func (k msgServer) SetDelegationLabel(goCtx context.Context, msg *types.MsgSetDelegationLabel) (*types.MsgSetDelegationLabelResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := types.ValidateDelegationLabel(msg.Label); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetDelegation(ctx, delegatorAddress, valAddr); !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	k.Keeper.SetDelegationLabel(ctx, delegatorAddress, valAddr, msg.Label)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDelegationLabel,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyLabel, msg.Label),
+		),
+	)
+
+	return &types.MsgSetDelegationLabelResponse{}, nil
+}
+
+// SetAutoRedelegation defines a method for setting the auto-redelegation preference of a delegator
+func (k msgServer) SetAutoRedelegation(goCtx context.Context, msg *types.MsgSetAutoRedelegation) (*types.MsgSetAutoRedelegationResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.FallbackValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := types.ValidateAutoRedelegationJailDuration(msg.JailDuration); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	k.Keeper.SetAutoRedelegation(ctx, delegatorAddress, valAddr, msg.JailDuration)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRedelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.FallbackValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyJailDuration, msg.JailDuration.String()),
+		),
+	)
+
+	return &types.MsgSetAutoRedelegationResponse{}, nil
+}
+
+// RemoveAutoRedelegation defines a method for removing the auto-redelegation preference of a delegator
+func (k msgServer) RemoveAutoRedelegation(goCtx context.Context, msg *types.MsgRemoveAutoRedelegation) (*types.MsgRemoveAutoRedelegationResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetAutoRedelegation(ctx, delegatorAddress); !found {
+		return nil, types.ErrNoAutoRedelegation
+	}
+
+	k.Keeper.RemoveAutoRedelegation(ctx, delegatorAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAutoRedelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgRemoveAutoRedelegationResponse{}, nil
+}
+
+// CancelUnbondingDelegation defines a method for canceling the unbonding delegation
+// and delegate back to the validator.
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	cancelEntries := msg.GetCancelEntries()
+	heights := make(map[int64]bool, len(cancelEntries))
+	for _, cancel := range cancelEntries {
+		if !cancel.Amount.IsValid() || !cancel.Amount.Amount.IsPositive() {
+			return nil, errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"invalid amount",
+			)
+		}
+
+		if cancel.CreationHeight <= 0 {
+			return nil, errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"invalid height",
+			)
+		}
+
+		if heights[cancel.CreationHeight] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate creation height %d", cancel.CreationHeight)
+		}
+		heights[cancel.CreationHeight] = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bondDenom := k.BondDenom(ctx)
+	for _, cancel := range cancelEntries {
+		if cancel.Amount.Denom != bondDenom {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", cancel.Amount.Denom, bondDenom,
+			)
+		}
+	}
+
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
 		return nil, types.ErrNoValidatorFound
@@ -480,46 +673,20 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		)
 	}
 
-	var (
-		unbondEntry      types.UnbondingDelegationEntry
-		unbondEntryIndex int64 = -1
-	)
-
-	for i, entry := range ubd.Entries {
-		if entry.CreationHeight == msg.CreationHeight {
-			unbondEntry = entry
-			unbondEntryIndex = int64(i)
-			break
+	total := math.ZeroInt()
+	for _, cancel := range cancelEntries {
+		if err := cancelUnbondingEntries(&ubd, cancel, ctx.BlockTime()); err != nil {
+			return nil, err
 		}
-	}
-	if unbondEntryIndex == -1 {
-		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.CreationHeight)
-	}
-
-	if unbondEntry.Balance.LT(msg.Amount.Amount) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
-	}
-
-	if unbondEntry.CompletionTime.Before(ctx.BlockTime()) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+		total = total.Add(cancel.Amount.Amount)
 	}
 
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
+	_, err = k.Keeper.Delegate(ctx, delegatorAddress, total, types.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
 
-	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
-	if amount.IsZero() {
-		ubd.RemoveEntry(unbondEntryIndex)
-	} else {
-		// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
-		unbondEntry.Balance = amount
-		unbondEntry.InitialBalance = unbondEntry.InitialBalance.Sub(msg.Amount.Amount)
-		ubd.Entries[unbondEntryIndex] = unbondEntry
-	}
-
 	// set the unbonding delegation or remove it if there are no more entries
 	if len(ubd.Entries) == 0 {
 		k.RemoveUnbondingDelegation(ctx, ubd)
@@ -527,19 +694,70 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		k.SetUnbondingDelegation(ctx, ubd)
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCancelUnbondingDelegation,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
-		),
-	)
+	for _, cancel := range cancelEntries {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelUnbondingDelegation,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, cancel.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(cancel.CreationHeight, 10)),
+			),
+		)
+	}
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
 
+// cancelUnbondingEntries cancels the amount from the entries of ubd created at
+// the creation height, in order, and removes the entries left without balance.
+func cancelUnbondingEntries(ubd *types.UnbondingDelegation, cancel types.CancelUnbondingEntry, blockTime time.Time) error {
+	var (
+		found   bool
+		balance = math.ZeroInt()
+	)
+	for _, entry := range ubd.Entries {
+		if entry.CreationHeight == cancel.CreationHeight {
+			found = true
+			balance = balance.Add(entry.Balance)
+		}
+	}
+	if !found {
+		return sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", cancel.CreationHeight)
+	}
+
+	if balance.LT(cancel.Amount.Amount) {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+	}
+
+	remaining := cancel.Amount.Amount
+	entries := make([]types.UnbondingDelegationEntry, 0, len(ubd.Entries))
+	for _, entry := range ubd.Entries {
+		if entry.CreationHeight != cancel.CreationHeight || remaining.IsZero() {
+			entries = append(entries, entry)
+			continue
+		}
+
+		if entry.CompletionTime.Before(blockTime) {
+			return sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+		}
+
+		amount := math.MinInt(entry.Balance, remaining)
+		remaining = remaining.Sub(amount)
+		if amount.Equal(entry.Balance) {
+			continue
+		}
+
+		// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		entries = append(entries, entry)
+	}
+	ubd.Entries = entries
+
+	return nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
@@ -549,12 +767,23 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, err
 	}
 
+	if err := k.validateAdditionalBondDenoms(msg.Params); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	prevMinCommissionRate := k.MinCommissionRate(ctx)
 
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	// bring the existing validators in line with a raised minimum commission
+	// rate, which otherwise only applies to the validators' own changes
+	if msg.Params.MinCommissionRate.GT(prevMinCommissionRate) {
+		k.EnforceMinCommissionRate(ctx)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -826,6 +828,307 @@ func (s *KeeperTestSuite) TestMsgUndelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgUndelegateTokens() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr, pk, amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	res, err := msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+	require.NotNil(res)
+
+	shares := math.LegacyNewDec(100)
+	del := stakingtypes.NewDelegation(Addr, ValAddr, shares)
+	keeper.SetDelegation(ctx, del)
+
+	params := keeper.GetParams(ctx)
+	params.UndelegationDustThreshold = math.NewInt(10)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	testCases := []struct {
+		name      string
+		input     *stakingtypes.MsgUndelegateTokens
+		expErr    bool
+		expErrMsg string
+		expAmount math.Int
+	}{
+		{
+			name: "invalid validator",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: sdk.AccAddress([]byte("invalid")).String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)),
+			},
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name: "invalid delegator",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: "invalid",
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)),
+			},
+			expErr:    true,
+			expErrMsg: "invalid delegator address: decoding bech32 failed",
+		},
+		{
+			name: "zero amount",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(0)),
+			},
+			expErr:    true,
+			expErrMsg: "invalid token amount",
+		},
+		{
+			name: "invalid coin denom",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin("test", math.NewInt(10)),
+			},
+			expErr:    true,
+			expErrMsg: "invalid coin denomination",
+		},
+		{
+			name: "amount greater than delegated tokens",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(101)),
+			},
+			expErr:    true,
+			expErrMsg: "the delegation only has 100",
+		},
+		{
+			name: "valid msg",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(85)),
+			},
+			expAmount: math.NewInt(85),
+		},
+		{
+			name: "valid msg leaving dust",
+			input: &stakingtypes.MsgUndelegateTokens{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)),
+			},
+			expAmount: math.NewInt(15),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.UndelegateTokens(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expAmount, res.Amount.Amount)
+			}
+		})
+	}
+
+	_, found := keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestMsgSetDelegationLabel() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr, pk, amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	res, err := msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+	require.NotNil(res)
+
+	del := stakingtypes.NewDelegation(Addr, ValAddr, math.LegacyNewDec(100))
+	keeper.SetDelegation(ctx, del)
+
+	testCases := []struct {
+		name      string
+		input     *stakingtypes.MsgSetDelegationLabel
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid validator",
+			input: &stakingtypes.MsgSetDelegationLabel{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: sdk.AccAddress([]byte("invalid")).String(),
+				Label:            "label",
+			},
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name: "invalid delegator",
+			input: &stakingtypes.MsgSetDelegationLabel{
+				DelegatorAddress: "invalid",
+				ValidatorAddress: ValAddr.String(),
+				Label:            "label",
+			},
+			expErr:    true,
+			expErrMsg: "invalid delegator address: decoding bech32 failed",
+		},
+		{
+			name:      "label too long",
+			input:     stakingtypes.NewMsgSetDelegationLabel(Addr, ValAddr, strings.Repeat("a", stakingtypes.MaxDelegationLabelLength+1)),
+			expErr:    true,
+			expErrMsg: "delegation label too long",
+		},
+		{
+			name:      "no delegation",
+			input:     stakingtypes.NewMsgSetDelegationLabel(sdk.AccAddress([]byte("nodelegation")), ValAddr, "label"),
+			expErr:    true,
+			expErrMsg: "no delegation for (address, validator) tuple",
+		},
+		{
+			name:  "valid msg",
+			input: stakingtypes.NewMsgSetDelegationLabel(Addr, ValAddr, "client-42"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetDelegationLabel(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	queryRes, err := s.queryClient.Delegation(ctx, &stakingtypes.QueryDelegationRequest{DelegatorAddr: Addr.String(), ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal("client-42", queryRes.DelegationResponse.Label)
+	require.Equal(del, queryRes.DelegationResponse.Delegation)
+
+	// an empty label removes the label
+	_, err = msgServer.SetDelegationLabel(ctx, stakingtypes.NewMsgSetDelegationLabel(Addr, ValAddr, ""))
+	require.NoError(err)
+	require.Empty(keeper.GetDelegationLabel(ctx, Addr, ValAddr))
+
+	// the label is removed together with the delegation
+	_, err = msgServer.SetDelegationLabel(ctx, stakingtypes.NewMsgSetDelegationLabel(Addr, ValAddr, "client-42"))
+	require.NoError(err)
+	require.NoError(keeper.RemoveDelegation(ctx, del))
+	require.Empty(keeper.GetDelegationLabel(ctx, Addr, ValAddr))
+	require.Empty(keeper.GetAllDelegationLabels(ctx))
+}
+
+func (s *KeeperTestSuite) TestMsgSetAutoRedelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr, pk, amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	res, err := msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+	require.NotNil(res)
+
+	testCases := []struct {
+		name      string
+		input     *stakingtypes.MsgSetAutoRedelegation
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid fallback validator",
+			input: &stakingtypes.MsgSetAutoRedelegation{
+				DelegatorAddress:         Addr.String(),
+				FallbackValidatorAddress: sdk.AccAddress([]byte("invalid")).String(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name: "invalid delegator",
+			input: &stakingtypes.MsgSetAutoRedelegation{
+				DelegatorAddress:         "invalid",
+				FallbackValidatorAddress: ValAddr.String(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid delegator address: decoding bech32 failed",
+		},
+		{
+			name:      "negative jail duration",
+			input:     stakingtypes.NewMsgSetAutoRedelegation(Addr, ValAddr, -time.Hour),
+			expErr:    true,
+			expErrMsg: "invalid auto-redelegation jail duration",
+		},
+		{
+			name:      "fallback validator not found",
+			input:     stakingtypes.NewMsgSetAutoRedelegation(Addr, sdk.ValAddress([]byte("novalidator")), time.Hour),
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:  "valid msg",
+			input: stakingtypes.NewMsgSetAutoRedelegation(Addr, ValAddr, time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetAutoRedelegation(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	queryRes, err := s.queryClient.AutoRedelegation(ctx, &stakingtypes.QueryAutoRedelegationRequest{DelegatorAddr: Addr.String()})
+	require.NoError(err)
+	require.Equal(stakingtypes.NewAutoRedelegation(Addr, ValAddr, time.Hour), queryRes.AutoRedelegation)
+
+	// opting out removes the preference
+	_, err = msgServer.RemoveAutoRedelegation(ctx, stakingtypes.NewMsgRemoveAutoRedelegation(Addr))
+	require.NoError(err)
+	_, found := keeper.GetAutoRedelegation(ctx, Addr)
+	require.False(found)
+
+	_, err = msgServer.RemoveAutoRedelegation(ctx, stakingtypes.NewMsgRemoveAutoRedelegation(Addr))
+	require.ErrorIs(err, stakingtypes.ErrNoAutoRedelegation)
+}
+
 func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -988,6 +1291,110 @@ func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegationEntries() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr, pk, amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(Addr, ValAddr, math.LegacyNewDec(100)))
+
+	// two entries were created at height 10, with different completion times
+	completion := ctx.BlockTime().Add(time.Minute * 10)
+	ubd := stakingtypes.NewUnbondingDelegation(Addr, ValAddr, 10, completion, math.NewInt(30), 0)
+	ubd.AddEntry(10, completion.Add(time.Minute), math.NewInt(20), 0)
+	ubd.AddEntry(11, completion, math.NewInt(50), 0)
+	ubd.AddEntry(12, completion, math.NewInt(40), 0)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount) }
+	testCases := []struct {
+		name      string
+		entries   []stakingtypes.CancelUnbondingEntry
+		expErrMsg string
+	}{
+		{
+			name:      "duplicate height",
+			entries:   []stakingtypes.CancelUnbondingEntry{{Amount: coin(10), CreationHeight: 11}, {Amount: coin(10), CreationHeight: 11}},
+			expErrMsg: "duplicate creation height 11",
+		},
+		{
+			name:      "entry not found at height",
+			entries:   []stakingtypes.CancelUnbondingEntry{{Amount: coin(10), CreationHeight: 11}, {Amount: coin(10), CreationHeight: 13}},
+			expErrMsg: "unbonding delegation entry is not found at block height 13",
+		},
+		{
+			name:      "amount is greater than the balance of the entries at height",
+			entries:   []stakingtypes.CancelUnbondingEntry{{Amount: coin(51), CreationHeight: 10}},
+			expErrMsg: "amount is greater than the unbonding delegation entry balance",
+		},
+		{
+			name:      "invalid amount",
+			entries:   []stakingtypes.CancelUnbondingEntry{{Amount: coin(10), CreationHeight: 11}, {Amount: coin(0), CreationHeight: 12}},
+			expErrMsg: "invalid amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+				DelegatorAddress: Addr.String(),
+				ValidatorAddress: ValAddr.String(),
+				Entries:          tc.entries,
+			})
+			require.ErrorContains(err, tc.expErrMsg)
+		})
+	}
+
+	// the failed messages left the unbonding delegation unchanged
+	resUnbond, found := keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Equal(ubd, resUnbond)
+
+	// 40 is cancelled from the entries created at height 10 in order, and all of
+	// the entry created at height 11
+	_, err = msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           coin(40),
+		CreationHeight:   10,
+		Entries:          []stakingtypes.CancelUnbondingEntry{{Amount: coin(50), CreationHeight: 11}},
+	})
+	require.NoError(err)
+
+	resUnbond, found = keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Len(resUnbond.Entries, 2)
+	require.Equal(int64(10), resUnbond.Entries[0].CreationHeight)
+	require.Equal(math.NewInt(10), resUnbond.Entries[0].Balance)
+	require.Equal(math.NewInt(10), resUnbond.Entries[0].InitialBalance)
+	require.Equal(ubd.Entries[3], resUnbond.Entries[1])
+
+	resDel, found := keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(190), resDel.Shares)
+
+	// cancelling the remaining entries removes the unbonding delegation
+	_, err = msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Entries:          []stakingtypes.CancelUnbondingEntry{{Amount: coin(40), CreationHeight: 12}, {Amount: coin(10), CreationHeight: 10}},
+	})
+	require.NoError(err)
+	_, found = keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.False(found)
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -1126,3 +1533,60 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParamsEnforcesMinCommissionRate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(2)
+	rates := []stakingtypes.Commission{
+		stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(2, 2), math.LegacyNewDecWithPrec(1, 2)),
+		stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+	}
+	for i, commission := range rates {
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator.Commission = commission
+		keeper.SetValidator(ctx, validator)
+	}
+
+	// setting the params directly does not enforce the raised minimum rate
+	minRate := math.LegacyNewDecWithPrec(5, 2)
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	require.NoError(keeper.SetParams(ctx, params))
+
+	res, err := s.queryClient.ValidatorsBelowMinCommission(ctx, &stakingtypes.QueryValidatorsBelowMinCommissionRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Equal(valAddrs[0].String(), res.Validators[0].OperatorAddress)
+	require.Equal(minRate, res.MinCommissionRate)
+
+	// raising the minimum rate through a param change bumps the validators below
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(3, 2)
+	require.NoError(keeper.SetParams(ctx, params))
+	params.MinCommissionRate = minRate
+	_, err = msgServer.UpdateParams(ctx, &stakingtypes.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params})
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(minRate, validator.Commission.Rate)
+	require.Equal(minRate, validator.Commission.MaxRate)
+	require.Equal(rates[0].UpdateTime, validator.Commission.UpdateTime)
+
+	validator, found = keeper.GetValidator(ctx, valAddrs[1])
+	require.True(found)
+	require.Equal(rates[1].CommissionRates, validator.Commission.CommissionRates)
+
+	res, err = s.queryClient.ValidatorsBelowMinCommission(ctx, &stakingtypes.QueryValidatorsBelowMinCommissionRequest{})
+	require.NoError(err)
+	require.Empty(res.Validators)
+
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeEnforceMinCommission {
+			events++
+		}
+	}
+	require.Equal(1, events)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// EnableMultiAssetStaking allows the additional bond denoms param to be set, so
+// that app-chains experimenting with multi-asset staking can bond other denoms
+// than the bond denom. The app must register the bonded and not bonded pool
+// module accounts of each additional bond denom, named by BondedPoolNameOf and
+// NotBondedPoolNameOf. It must be called before the keeper is used.
+func (k *Keeper) EnableMultiAssetStaking() {
+	k.multiAssetStaking = true
+}
+
+// MultiAssetStakingEnabled returns true if the app enabled multi-asset staking.
+func (k Keeper) MultiAssetStakingEnabled() bool {
+	return k.multiAssetStaking
+}
+
+// BondDenoms returns the bond denom, with the power reduction of the keeper,
+// followed by the additional bond denoms.
+func (k Keeper) BondDenoms(ctx sdk.Context) []types.BondDenom {
+	params := k.GetParams(ctx)
+	denoms := make([]types.BondDenom, 0, 1+len(params.AdditionalBondDenoms))
+	denoms = append(denoms, types.BondDenom{Denom: params.BondDenom, PowerReduction: k.PowerReduction(ctx)})
+	return append(denoms, params.AdditionalBondDenoms...)
+}
+
+// PowerReductionOf returns the amount of the given bond denom required for 1
+// unit of consensus-engine power. ErrUnknownBondDenom is returned if denom is
+// not a bond denom.
+func (k Keeper) PowerReductionOf(ctx sdk.Context, denom string) (math.Int, error) {
+	for _, d := range k.BondDenoms(ctx) {
+		if d.Denom == denom {
+			return d.PowerReduction, nil
+		}
+	}
+
+	return math.Int{}, errorsmod.Wrap(types.ErrUnknownBondDenom, denom)
+}
+
+// ConvertToBondTokens converts an amount of a bond denom into the amount of the
+// bond denom giving the same consensus-engine power, which is the unit of the
+// validator tokens.
+func (k Keeper) ConvertToBondTokens(ctx sdk.Context, coin sdk.Coin) (math.Int, error) {
+	powerReduction, err := k.PowerReductionOf(ctx, coin.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return coin.Amount.Mul(k.PowerReduction(ctx)).Quo(powerReduction), nil
+}
+
+// TokensToConsensusPowerOf converts an amount of a bond denom into potential
+// consensus-engine power.
+func (k Keeper) TokensToConsensusPowerOf(ctx sdk.Context, coin sdk.Coin) (int64, error) {
+	powerReduction, err := k.PowerReductionOf(ctx, coin.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	return sdk.TokensToConsensusPower(coin.Amount, powerReduction), nil
+}
+
+// GetBondedPoolOf returns the bonded pool module account of a bond denom.
+func (k Keeper) GetBondedPoolOf(ctx sdk.Context, denom string) sdk.ModuleAccountI {
+	if denom == k.BondDenom(ctx) {
+		return k.GetBondedPool(ctx)
+	}
+	return k.authKeeper.GetModuleAccount(ctx, types.BondedPoolNameOf(denom))
+}
+
+// GetNotBondedPoolOf returns the not bonded pool module account of a bond denom.
+func (k Keeper) GetNotBondedPoolOf(ctx sdk.Context, denom string) sdk.ModuleAccountI {
+	if denom == k.BondDenom(ctx) {
+		return k.GetNotBondedPool(ctx)
+	}
+	return k.authKeeper.GetModuleAccount(ctx, types.NotBondedPoolNameOf(denom))
+}
+
+// validateAdditionalBondDenoms returns an error if the given params set
+// additional bond denoms while multi-asset staking is not enabled, or if the
+// pool module accounts of an additional bond denom are not registered.
+func (k Keeper) validateAdditionalBondDenoms(params types.Params) error {
+	if len(params.AdditionalBondDenoms) == 0 {
+		return nil
+	}
+
+	if !k.multiAssetStaking {
+		return errorsmod.Wrap(types.ErrMultiAssetStakingDisabled, "additional bond denoms cannot be set")
+	}
+
+	for _, d := range params.AdditionalBondDenoms {
+		for _, name := range []string{types.BondedPoolNameOf(d.Denom), types.NotBondedPoolNameOf(d.Denom)} {
+			if k.authKeeper.GetModuleAddress(name) == nil {
+				return fmt.Errorf("%s module account has not been set", name)
+			}
+		}
+	}
+
+	return nil
+}
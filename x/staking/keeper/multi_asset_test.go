@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMultiAssetStaking() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	params := stakingtypes.DefaultParams()
+	params.AdditionalBondDenoms = []stakingtypes.BondDenom{{Denom: "uatom", PowerReduction: sdkmath.NewInt(1000)}}
+	msg := &stakingtypes.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params}
+
+	// additional bond denoms cannot be set unless the app enabled multi-asset staking
+	_, err := msgServer.UpdateParams(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrMultiAssetStakingDisabled)
+
+	keeper.EnableMultiAssetStaking()
+	require.True(keeper.MultiAssetStakingEnabled())
+
+	// the pool module accounts of the additional bond denoms must be registered
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolNameOf("uatom")).Return(nil)
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.ErrorContains(err, "bonded_tokens_pool_uatom module account has not been set")
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolNameOf("uatom")).Return(authtypes.NewModuleAddress(stakingtypes.BondedPoolNameOf("uatom")))
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolNameOf("uatom")).Return(authtypes.NewModuleAddress(stakingtypes.NotBondedPoolNameOf("uatom")))
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.NoError(err)
+
+	require.Equal([]stakingtypes.BondDenom{
+		{Denom: sdk.DefaultBondDenom, PowerReduction: sdk.DefaultPowerReduction},
+		{Denom: "uatom", PowerReduction: sdkmath.NewInt(1000)},
+	}, keeper.BondDenoms(ctx))
+
+	// 1000uatom give the same power as 1000000stake
+	power, err := keeper.TokensToConsensusPowerOf(ctx, sdk.NewInt64Coin("uatom", 2500))
+	require.NoError(err)
+	require.Equal(int64(2), power)
+
+	tokens, err := keeper.ConvertToBondTokens(ctx, sdk.NewInt64Coin("uatom", 2500))
+	require.NoError(err)
+	require.Equal(sdkmath.NewInt(2_500_000), tokens)
+
+	tokens, err = keeper.ConvertToBondTokens(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500))
+	require.NoError(err)
+	require.Equal(sdkmath.NewInt(2500), tokens)
+
+	_, err = keeper.ConvertToBondTokens(ctx, sdk.NewInt64Coin("uosmo", 2500))
+	require.ErrorIs(err, stakingtypes.ErrUnknownBondDenom)
+}
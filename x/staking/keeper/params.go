@@ -49,6 +49,54 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// UndelegationDustThreshold - Token amount below which the remainder of a
+// delegation is undelegated as well by Msg/UndelegateTokens
+func (k Keeper) UndelegationDustThreshold(ctx sdk.Context) math.Int {
+	return k.GetParams(ctx).UndelegationDustThreshold
+}
+
+// HistoricalInfoMaxBytes - Maximum number of bytes the persisted historical
+// entries may take
+func (k Keeper) HistoricalInfoMaxBytes(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).HistoricalInfoMaxBytes
+}
+
+// ValidatorSetCheckpointInterval - Number of blocks between two validator set
+// checkpoints
+func (k Keeper) ValidatorSetCheckpointInterval(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).ValidatorSetCheckpointInterval
+}
+
+// MinSelfDelegationGracePeriod - Time a validator whose self-delegation fell
+// below its minimum is given to top it up before being jailed
+func (k Keeper) MinSelfDelegationGracePeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).MinSelfDelegationGracePeriod
+}
+
+// MinDelegationAmount - Minimum token amount a delegation must be worth, unless
+// it is fully undelegated
+func (k Keeper) MinDelegationAmount(ctx sdk.Context) math.Int {
+	return k.GetParams(ctx).MinDelegationAmount
+}
+
+// InactiveValidatorCleanupPeriod - Time a validator must have been unbonded
+// with no tokens left before it is removed
+func (k Keeper) InactiveValidatorCleanupPeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).InactiveValidatorCleanupPeriod
+}
+
+// InactiveValidatorCleanupDryRun - Whether the inactive validator cleanup only
+// reports the validators it would remove
+func (k Keeper) InactiveValidatorCleanupDryRun(ctx sdk.Context) bool {
+	return k.GetParams(ctx).InactiveValidatorCleanupDryRun
+}
+
+// RejectTombstonedDelegations - Whether delegations and redelegations to
+// tombstoned validators are rejected
+func (k Keeper) RejectTombstonedDelegations(ctx sdk.Context) bool {
+	return k.GetParams(ctx).RejectTombstonedDelegations
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
@@ -71,5 +119,15 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	}
 
 	k.cdc.MustUnmarshal(bz, &params)
+
+	// params stored before the undelegation dust threshold was introduced
+	// have none
+	if params.UndelegationDustThreshold.IsNil() {
+		params.UndelegationDustThreshold = types.DefaultUndelegationDustThreshold
+	}
+	// nor the min delegation amount
+	if params.MinDelegationAmount.IsNil() {
+		params.MinDelegationAmount = types.DefaultMinDelegationAmount
+	}
 	return params
 }
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"math/bits"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// DefaultPowerDistributionTopN is the default number of top validators
+	// the power distribution returns the cumulative share of.
+	DefaultPowerDistributionTopN uint32 = 10
+)
+
+// DefaultNakamotoThreshold is the default share of the total power the
+// validators counted by the Nakamoto coefficient must exceed: one third, the
+// share able to halt the chain.
+var DefaultNakamotoThreshold = math.LegacyOneDec().QuoInt64(3)
+
+// IterateLastValidatorPowersByRank iterates over the last validator powers,
+// from the highest to the lowest power.
+func (k Keeper) IterateLastValidatorPowersByRank(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.LastValidatorPowerRankKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operator, power := types.ParseLastValidatorPowerRankKey(iterator.Key())
+		if handler(operator, power) {
+			break
+		}
+	}
+}
+
+// PowerDistribution summarizes the distribution of the last consensus power
+// among the bonded validators: the cumulative share of the topN validators
+// with the most power, the Nakamoto coefficient for the threshold, and a
+// histogram of the powers in buckets between consecutive powers of two.
+func (k Keeper) PowerDistribution(ctx sdk.Context, topN uint32, threshold math.LegacyDec) *types.QueryPowerDistributionResponse {
+	var powers []int64
+	res := &types.QueryPowerDistributionResponse{
+		Height:    ctx.BlockHeight(),
+		Threshold: threshold,
+	}
+	k.IterateLastValidatorPowersByRank(ctx, func(operator sdk.ValAddress, power int64) bool {
+		if uint32(len(res.TopValidators)) < topN {
+			res.TopValidators = append(res.TopValidators, types.ValidatorPowerShare{
+				OperatorAddress: operator.String(),
+				Power:           power,
+			})
+		}
+		powers = append(powers, power)
+		res.TotalPower += power
+		return false
+	})
+	res.Validators = uint32(len(powers))
+
+	// the shares are computed once the total power is known
+	var cumulative int64
+	for i := range res.TopValidators {
+		cumulative += res.TopValidators[i].Power
+		res.TopValidators[i].CumulativeShare = powerShare(cumulative, res.TotalPower)
+	}
+
+	// the Nakamoto coefficient counts the validators with the most power until
+	// their power exceeds the threshold, the powers being sorted from the
+	// highest
+	if res.TotalPower > 0 {
+		minPower := threshold.MulInt64(res.TotalPower)
+		cumulative = 0
+		for _, power := range powers {
+			cumulative += power
+			res.NakamotoCoefficient++
+			if math.LegacyNewDec(cumulative).GT(minPower) {
+				break
+			}
+		}
+	}
+
+	// bucket i holds the powers of bit length i, that is [2^(i-1), 2^i - 1],
+	// and bucket 0 the zero powers
+	var buckets [64]types.PowerHistogramBucket
+	for _, power := range powers {
+		i := bits.Len64(uint64(power))
+		buckets[i].Validators++
+		buckets[i].Power += power
+	}
+	for i, bucket := range buckets {
+		if bucket.Validators == 0 {
+			continue
+		}
+		if i > 0 {
+			bucket.MinPower = int64(1) << (i - 1)
+			bucket.MaxPower = bucket.MinPower + (bucket.MinPower - 1)
+		}
+		res.Histogram = append(res.Histogram, bucket)
+	}
+
+	return res
+}
+
+// powerShare returns the share of the total power, zero if there is no power.
+func powerShare(power, totalPower int64) math.LegacyDec {
+	if totalPower == 0 {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDec(power).QuoInt64(totalPower)
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueryPowerDistribution() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	// there is no power before the first validator set update
+	res, err := querier.PowerDistribution(ctx, &stakingtypes.QueryPowerDistributionRequest{})
+	require.NoError(err)
+	require.Equal(&stakingtypes.QueryPowerDistributionResponse{Threshold: stakingkeeper.DefaultNakamotoThreshold}, res)
+
+	_, valAddrs := createValAddrs(5)
+	for i, power := range []int64{40, 10, 25, 1, 50} {
+		keeper.SetLastValidatorPower(ctx, valAddrs[i], power)
+	}
+	// the index follows the updates of the powers
+	keeper.SetLastValidatorPower(ctx, valAddrs[4], 30)
+	keeper.SetLastValidatorPower(ctx, valAddrs[3], 100)
+	keeper.DeleteLastValidatorPower(ctx, valAddrs[3])
+
+	share := func(power int64) math.LegacyDec { return math.LegacyNewDec(power).QuoInt64(105) }
+	ctx = ctx.WithBlockHeight(42)
+	res, err = querier.PowerDistribution(ctx, &stakingtypes.QueryPowerDistributionRequest{TopN: 3})
+	require.NoError(err)
+	require.Equal(&stakingtypes.QueryPowerDistributionResponse{
+		Height:     42,
+		TotalPower: 105,
+		Validators: 4,
+		TopValidators: []stakingtypes.ValidatorPowerShare{
+			{OperatorAddress: valAddrs[0].String(), Power: 40, CumulativeShare: share(40)},
+			{OperatorAddress: valAddrs[4].String(), Power: 30, CumulativeShare: share(70)},
+			{OperatorAddress: valAddrs[2].String(), Power: 25, CumulativeShare: share(95)},
+		},
+		Threshold:           stakingkeeper.DefaultNakamotoThreshold,
+		NakamotoCoefficient: 1,
+		Histogram: []stakingtypes.PowerHistogramBucket{
+			{MinPower: 8, MaxPower: 15, Validators: 1, Power: 10},
+			{MinPower: 16, MaxPower: 31, Validators: 2, Power: 55},
+			{MinPower: 32, MaxPower: 63, Validators: 1, Power: 40},
+		},
+	}, res)
+
+	// more than 2/3 of the power takes the three validators with the most power
+	res, err = querier.PowerDistribution(ctx, &stakingtypes.QueryPowerDistributionRequest{Threshold: math.LegacyNewDecWithPrec(67, 2)})
+	require.NoError(err)
+	require.Equal(uint32(3), res.NakamotoCoefficient)
+	require.Len(res.TopValidators, 4)
+	require.Equal(math.LegacyOneDec(), res.TopValidators[3].CumulativeShare)
+
+	for _, threshold := range []math.LegacyDec{math.LegacyOneDec(), math.LegacyNewDec(-1)} {
+		_, err = querier.PowerDistribution(ctx, &stakingtypes.QueryPowerDistributionRequest{Threshold: threshold})
+		require.ErrorContains(err, "threshold must be in (0, 1)")
+	}
+	_, err = querier.PowerDistribution(ctx, nil)
+	require.Error(err)
+}
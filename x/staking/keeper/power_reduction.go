@@ -6,11 +6,13 @@ import (
 )
 
 // TokensToConsensusPower - convert input tokens to potential consensus-engine power
+// with the power strategy
 func (k Keeper) TokensToConsensusPower(ctx sdk.Context, tokens math.Int) int64 {
-	return sdk.TokensToConsensusPower(tokens, k.PowerReduction(ctx))
+	return k.GetPowerStrategy().ConsensusPower(tokens, k.PowerReduction(ctx))
 }
 
-// TokensFromConsensusPower - convert input power to tokens
+// TokensFromConsensusPower - convert input power to tokens, ignoring the power
+// strategy
 func (k Keeper) TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int {
 	return sdk.TokensFromConsensusPower(power, k.PowerReduction(ctx))
 }
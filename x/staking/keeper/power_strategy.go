@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetPowerStrategy returns the strategy the consensus power of the validators
+// is computed with, types.LinearPowerStrategy unless another one is set.
+func (k Keeper) GetPowerStrategy() types.PowerStrategy {
+	if k.powerStrategy == nil {
+		return types.LinearPowerStrategy{}
+	}
+
+	return k.powerStrategy
+}
+
+// ConsensusPower returns the consensus power of a validator with the power
+// strategy, zero if it is not bonded.
+func (k Keeper) ConsensusPower(ctx sdk.Context, validator types.ValidatorI) int64 {
+	if !validator.IsBonded() {
+		return 0
+	}
+
+	return k.PotentialConsensusPower(ctx, validator)
+}
+
+// PotentialConsensusPower returns the consensus power a validator has with the
+// power strategy once bonded.
+func (k Keeper) PotentialConsensusPower(ctx sdk.Context, validator types.ValidatorI) int64 {
+	return k.GetPowerStrategy().ConsensusPower(validator.GetTokens(), k.PowerReduction(ctx))
+}
+
+// GetLastPowerStrategyName returns the name of the power strategy the last
+// validator powers were computed with, empty before it is first tracked.
+func (k Keeper) GetLastPowerStrategyName(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.PowerStrategyKey))
+}
+
+// TrackPowerStrategy records the name of the power strategy the validator
+// powers are about to be computed with. On a change of strategy, an event is
+// emitted with the previous and new names: ApplyAndReturnValidatorSetUpdates
+// then recomputes the powers of all the bonded validators, as their last
+// powers differ from their powers with the new strategy. On a change to a
+// strategy other than the linear one, the tokens history of the bonded
+// validators is seeded with their current tokens.
+func (k Keeper) TrackPowerStrategy(ctx sdk.Context) {
+	strategy := k.GetPowerStrategy()
+	name := strategy.Name()
+	last := k.GetLastPowerStrategyName(ctx)
+	if last == name {
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.PowerStrategyKey, []byte(name))
+	if _, linear := strategy.(types.LinearPowerStrategy); !linear {
+		k.seedValidatorTokensHistory(ctx)
+	}
+	if len(last) == 0 {
+		return
+	}
+
+	k.Logger(ctx).Info("power strategy changed", "previous", last, "new", name)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangePowerStrategy,
+			sdk.NewAttribute(types.AttributeKeyPreviousPowerStrategy, last),
+			sdk.NewAttribute(types.AttributeKeyPowerStrategy, name),
+		),
+	)
+}
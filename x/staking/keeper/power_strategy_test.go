@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestPowerStrategy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var validators [2]stakingtypes.Validator
+	for i, power := range []int64{100, 400} {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+	}
+	require.Equal(stakingtypes.LinearPowerStrategy{}.Name(), keeper.GetLastPowerStrategyName(ctx))
+	require.Equal(int64(400), keeper.ConsensusPower(ctx, validators[1]))
+	require.Equal(math.NewInt(500), keeper.GetLastTotalPower(ctx))
+
+	// no tokens history is recorded with the linear strategy
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(s.key), stakingtypes.ValidatorTokensHistoryKey)
+	require.False(iterator.Valid())
+	require.NoError(iterator.Close())
+
+	require.Panics(func() { keeper.SetPowerStrategy(stakingtypes.CappedPowerStrategy{}) })
+	keeper.SetPowerStrategy(stakingtypes.QuadraticPowerStrategy{})
+	require.Panics(func() { keeper.SetPowerStrategy(stakingtypes.LinearPowerStrategy{}) })
+	require.Equal(int64(20), keeper.TokensToConsensusPower(ctx, validators[1].Tokens))
+
+	// the powers of all the validators are recomputed with the new strategy
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(int64(20), updates[0].Power)
+	require.Equal(int64(10), updates[1].Power)
+	require.Equal(int64(20), keeper.GetLastValidatorPower(ctx, validators[1].GetOperator()))
+	require.Equal(math.NewInt(30), keeper.GetLastTotalPower(ctx))
+	require.Equal(stakingtypes.QuadraticPowerStrategy{}.Name(), keeper.GetLastPowerStrategyName(ctx))
+
+	// the tokens history is seeded with the tokens of the bonded validators
+	for _, validator := range validators {
+		tokens, found := keeper.GetValidatorTokensAt(ctx, validator.GetOperator(), ctx.BlockHeight()+1)
+		require.True(found)
+		require.Equal(validator.GetTokens(), tokens)
+	}
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		stakingtypes.EventTypeChangePowerStrategy,
+		sdk.NewAttribute(stakingtypes.AttributeKeyPreviousPowerStrategy, stakingtypes.LinearPowerStrategy{}.Name()),
+		sdk.NewAttribute(stakingtypes.AttributeKeyPowerStrategy, stakingtypes.QuadraticPowerStrategy{}.Name()),
+	))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+	require.Empty(ctx.EventManager().Events())
+}
+
+func (s *KeeperTestSuite) TestSlashWithPowerStrategy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	keeper.SetPowerStrategy(stakingtypes.QuadraticPowerStrategy{})
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	startTime := ctx.BlockTime()
+	fraction := math.LegacyNewDecWithPrec(5, 1)
+
+	// bonded with a power of 20 at height 10, and of 30 from height 20
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 400))
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx.WithBlockHeight(10), validator, true)
+	require.Equal(int64(20), keeper.ConsensusPower(ctx, validator))
+
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 500))
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx.WithBlockHeight(20).WithBlockTime(startTime.Add(time.Hour)), validator, true)
+	require.Equal(int64(30), keeper.ConsensusPower(ctx, validator))
+
+	// an infraction at height 15 slashes the tokens bonded then, not the
+	// power converted linearly nor the current tokens
+	tokens, found := keeper.GetValidatorTokensAt(ctx, valAddr, 15)
+	require.True(found)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 400), tokens)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.BondedPoolName, gomock.Any())
+	keeper.Slash(ctx.WithBlockHeight(30), consAddr, 15, 20, fraction)
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 700), validator.GetTokens())
+
+	// once the entry of height 10 is pruned, an infraction at height 15
+	// slashes the oldest recorded tokens
+	pruneTime := startTime.Add(keeper.UnbondingTime(ctx)).Add(2 * time.Hour)
+	stakingkeeper.TestingUpdateValidator(keeper, ctx.WithBlockHeight(100).WithBlockTime(pruneTime), validator, true)
+	_, found = keeper.GetValidatorTokensAt(ctx, valAddr, 15)
+	require.False(found)
+	tokens, found = keeper.GetValidatorTokensAt(ctx, valAddr, 21)
+	require.True(found)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 900), tokens)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.BondedPoolName, gomock.Any())
+	keeper.Slash(ctx.WithBlockHeight(100), consAddr, 15, 20, fraction)
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 250), validator.GetTokens())
+}
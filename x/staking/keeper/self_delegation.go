@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetSelfDelegationDeficit records a validator whose self-delegation is below
+// its minimum, with the time at which it is jailed.
+func (k Keeper) SetSelfDelegationDeficit(ctx sdk.Context, deficit types.SelfDelegationDeficit) {
+	valAddr, err := sdk.ValAddressFromBech32(deficit.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSelfDelegationDeficitKey(valAddr), types.MustMarshalSelfDelegationDeficit(k.cdc, deficit))
+}
+
+// GetSelfDelegationDeficit returns the self-delegation deficit of a validator.
+func (k Keeper) GetSelfDelegationDeficit(ctx sdk.Context, valAddr sdk.ValAddress) (deficit types.SelfDelegationDeficit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetSelfDelegationDeficitKey(valAddr))
+	if value == nil {
+		return deficit, false
+	}
+
+	return types.MustUnmarshalSelfDelegationDeficit(k.cdc, value), true
+}
+
+// RemoveSelfDelegationDeficit removes the self-delegation deficit of a
+// validator.
+func (k Keeper) RemoveSelfDelegationDeficit(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSelfDelegationDeficitKey(valAddr))
+}
+
+// IterateSelfDelegationDeficits iterates through the self-delegation deficits
+// of all validators.
+func (k Keeper) IterateSelfDelegationDeficits(ctx sdk.Context, cb func(deficit types.SelfDelegationDeficit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SelfDelegationDeficitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		deficit := types.MustUnmarshalSelfDelegationDeficit(k.cdc, iterator.Value())
+		if cb(deficit) {
+			break
+		}
+	}
+}
+
+// GetAllSelfDelegationDeficits returns the self-delegation deficits of all
+// validators used during genesis dump.
+func (k Keeper) GetAllSelfDelegationDeficits(ctx sdk.Context) (deficits []types.SelfDelegationDeficit) {
+	k.IterateSelfDelegationDeficits(ctx, func(deficit types.SelfDelegationDeficit) bool {
+		deficits = append(deficits, deficit)
+		return false
+	})
+
+	return deficits
+}
+
+// GetSelfDelegation returns the amount of tokens self-delegated by the
+// operator of a validator.
+func (k Keeper) GetSelfDelegation(ctx sdk.Context, validator types.Validator) math.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if !found {
+		return math.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// handleSelfDelegationBelowMin is called when the self-delegation of a
+// validator which is not jailed falls below its minimum. The validator is
+// jailed right away if the grace period is zero. Otherwise it is jailed once
+// the grace period ends, unless its self-delegation is topped up by then; a
+// validator already in its grace period keeps its jail time.
+func (k Keeper) handleSelfDelegationBelowMin(ctx sdk.Context, validator types.Validator, selfDelegation math.Int) {
+	gracePeriod := k.MinSelfDelegationGracePeriod(ctx)
+	if gracePeriod == 0 {
+		k.jailValidator(ctx, validator, types.JailReason_JAIL_REASON_MIN_SELF_DELEGATION)
+		return
+	}
+
+	deficit, found := k.GetSelfDelegationDeficit(ctx, validator.GetOperator())
+	if !found {
+		deficit = types.NewSelfDelegationDeficit(validator.GetOperator(), ctx.BlockTime().Add(gracePeriod))
+		k.SetSelfDelegationDeficit(ctx, deficit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfDelegationBelowMin,
+			sdk.NewAttribute(types.AttributeKeyValidator, deficit.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyJailTime, deficit.JailTime.Format(sdk.SortableTimeFormat)),
+		),
+	)
+}
+
+// checkSelfDelegationRestored removes the self-delegation deficit of a
+// validator once its self-delegation is back to its minimum.
+func (k Keeper) checkSelfDelegationRestored(ctx sdk.Context, validator types.Validator) {
+	if _, found := k.GetSelfDelegationDeficit(ctx, validator.GetOperator()); !found {
+		return
+	}
+
+	selfDelegation := k.GetSelfDelegation(ctx, validator)
+	if selfDelegation.LT(validator.MinSelfDelegation) {
+		return
+	}
+
+	k.RemoveSelfDelegationDeficit(ctx, validator.GetOperator())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfDelegationRestored,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+		),
+	)
+}
+
+// ProcessSelfDelegationDeficits jails the validators whose grace period ended
+// while their self-delegation is still below their minimum. It is called in
+// the end blocker.
+func (k Keeper) ProcessSelfDelegationDeficits(ctx sdk.Context) {
+	var matured []types.SelfDelegationDeficit
+	k.IterateSelfDelegationDeficits(ctx, func(deficit types.SelfDelegationDeficit) bool {
+		if !deficit.JailTime.After(ctx.BlockTime()) {
+			matured = append(matured, deficit)
+		}
+		return false
+	})
+
+	for _, deficit := range matured {
+		valAddr, err := sdk.ValAddressFromBech32(deficit.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || validator.Jailed {
+			k.RemoveSelfDelegationDeficit(ctx, valAddr)
+			continue
+		}
+
+		if k.GetSelfDelegation(ctx, validator).LT(validator.MinSelfDelegation) {
+			// jailing the validator removes its deficit
+			k.jailValidator(ctx, validator, types.JailReason_JAIL_REASON_MIN_SELF_DELEGATION)
+			continue
+		}
+
+		k.checkSelfDelegationRestored(ctx, validator)
+	}
+}
@@ -37,13 +37,6 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
 	}
 
-	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := k.TokensFromConsensusPower(ctx, power)
-	slashAmountDec := math.LegacyNewDecFromInt(amount).Mul(slashFactor)
-	slashAmount := slashAmountDec.TruncateInt()
-
-	// ref https://github.com/cosmos/cosmos-sdk/issues/1348
-
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
 	if !found {
 		// If not found, the validator must have been overslashed and removed - so we don't need to do anything
@@ -62,6 +55,13 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic(fmt.Sprintf("should not be slashing unbonded validator: %s", validator.GetOperator()))
 	}
 
+	// Amount of slashing = slash slashFactor * power at time of infraction
+	amount := k.infractionTokens(ctx, validator, infractionHeight, power)
+	slashAmountDec := math.LegacyNewDecFromInt(amount).Mul(slashFactor)
+	slashAmount := slashAmountDec.TruncateInt()
+
+	// ref https://github.com/cosmos/cosmos-sdk/issues/1348
+
 	operatorAddress := validator.GetOperator()
 
 	// call the before-modification hook
@@ -163,10 +163,15 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	k.JailWithReason(ctx, consAddr, types.JailReason_JAIL_REASON_UNSPECIFIED)
+}
+
+// JailWithReason jails a validator and records the reason in its jail history.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, reason types.JailReason) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
-	k.jailValidator(ctx, validator)
+	k.jailValidator(ctx, validator, reason)
 	logger := k.Logger(ctx)
-	logger.Info("validator jailed", "validator", consAddr)
+	logger.Info("validator jailed", "validator", consAddr, "reason", reason.String())
 }
 
 // unjail a validator
@@ -318,3 +323,33 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 
 	return totalSlashAmount
 }
+
+// infractionTokens returns the tokens a validator had at the time of an
+// infraction, from its consensus power then. The power of the power strategies
+// other than the linear one cannot be converted back to tokens, so the tokens
+// the validator was bonded with at the infraction height are read from its
+// tokens history, kept for an unbonding period. For an infraction before the
+// history of the validator starts, e.g. before a chain restarted from an
+// export, its oldest recorded tokens are used, and its current tokens if it
+// was not bonded since.
+func (k Keeper) infractionTokens(ctx sdk.Context, validator types.Validator, infractionHeight, power int64) math.Int {
+	if _, linear := k.GetPowerStrategy().(types.LinearPowerStrategy); linear {
+		return k.TokensFromConsensusPower(ctx, power)
+	}
+
+	if tokens, found := k.GetValidatorTokensAt(ctx, validator.GetOperator(), infractionHeight); found {
+		return tokens
+	}
+
+	k.Logger(ctx).Info(
+		"no tokens recorded at the infraction height, slashing the oldest recorded tokens",
+		"validator", validator.GetOperator().String(),
+		"height", infractionHeight,
+	)
+
+	if tokens, found := k.getFirstValidatorTokens(ctx, validator.GetOperator()); found {
+		return tokens
+	}
+
+	return validator.GetTokens()
+}
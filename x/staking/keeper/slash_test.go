@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // tests Jail, Unjail
@@ -34,6 +35,47 @@ func (s *KeeperTestSuite) TestRevocation() {
 	require.False(val.IsJailed())
 }
 
+// tests JailWithReason and the jail history of a validator
+func (s *KeeperTestSuite) TestJailWithReason() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	// no history before the validator was ever jailed
+	require.Empty(keeper.GetValidatorJailRecords(ctx, valAddr))
+
+	keeper.JailWithReason(ctx.WithBlockHeight(10), consAddr, stakingtypes.JailReason_JAIL_REASON_DOWNTIME)
+	keeper.Unjail(ctx, consAddr)
+	keeper.JailWithReason(ctx.WithBlockHeight(20), consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN)
+
+	val, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.True(val.IsJailed())
+
+	records := keeper.GetValidatorJailRecords(ctx, valAddr)
+	require.Len(records, 2)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_DOWNTIME, records[0].Reason)
+	require.Equal(int64(10), records[0].Height)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, records[1].Reason)
+	require.Equal(int64(20), records[1].Height)
+
+	// unjailing keeps the history
+	keeper.Unjail(ctx, consAddr)
+	require.Len(keeper.GetValidatorJailRecords(ctx, valAddr), 2)
+
+	res, err := queryClient.ValidatorJailHistory(ctx, &stakingtypes.QueryValidatorJailHistoryRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(records, res.JailRecords)
+
+	_, err = queryClient.ValidatorJailHistory(ctx, &stakingtypes.QueryValidatorJailHistoryRequest{})
+	require.Error(err)
+}
+
 // tests Slash at a future height (must panic)
 func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	ctx, keeper := s.ctx, s.stakingKeeper
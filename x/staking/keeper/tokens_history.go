@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TrackValidatorTokens records the tokens of a bonded validator at the end of
+// the current block if they changed since its last entry. Slashing reads them
+// back to convert the power of a validator at an infraction height into
+// tokens, which the power strategies other than the linear one cannot do, so
+// nothing is recorded with the linear strategy.
+//
+// The entries which can no longer be the tokens at a height slashing reaches,
+// that is no later than an unbonding period ago, are pruned.
+func (k Keeper) TrackValidatorTokens(ctx sdk.Context, validator types.Validator) {
+	if _, linear := k.GetPowerStrategy().(types.LinearPowerStrategy); linear {
+		return
+	}
+
+	valAddr := validator.GetOperator()
+	tokens, found := k.getLastValidatorTokens(ctx, valAddr, ctx.BlockHeight()+1)
+	if found && tokens.Equal(validator.GetTokens()) {
+		return
+	}
+
+	k.setValidatorTokens(ctx, valAddr, validator.GetTokens())
+	k.pruneValidatorTokensHistory(ctx, valAddr)
+}
+
+// seedValidatorTokensHistory restarts the tokens history from the current
+// tokens of the bonded validators. It is called when the power strategy
+// switches to one other than the linear one, as no history is recorded with the
+// linear strategy and the entries of an earlier strategy are stale.
+func (k Keeper) seedValidatorTokensHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorTokensHistoryKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, _ int64) (stop bool) {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			panic(fmt.Sprintf("validator record not found for address: %X\n", valAddr))
+		}
+
+		k.setValidatorTokens(ctx, valAddr, validator.GetTokens())
+		return false
+	})
+}
+
+// setValidatorTokens records the tokens of a validator at the current block.
+func (k Keeper) setValidatorTokens(ctx sdk.Context, valAddr sdk.ValAddress, tokens math.Int) {
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorTokensHistoryKey(valAddr, ctx.BlockHeight(), ctx.BlockTime()), bz)
+}
+
+// GetValidatorTokensAt returns the tokens a validator was bonded with at a
+// given height, recorded at the end of the last block before it.
+func (k Keeper) GetValidatorTokensAt(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (math.Int, bool) {
+	return k.getLastValidatorTokens(ctx, valAddr, height)
+}
+
+// getLastValidatorTokens returns the tokens of the last entry of the history
+// of a validator before a given height.
+func (k Keeper) getLastValidatorTokens(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (math.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(
+		types.GetValidatorTokensHistoryPrefixKey(valAddr),
+		types.GetValidatorTokensHistoryHeightKey(valAddr, height),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return math.Int{}, false
+	}
+
+	var tokens sdk.IntProto
+	k.cdc.MustUnmarshal(iterator.Value(), &tokens)
+	return tokens.Int, true
+}
+
+// getFirstValidatorTokens returns the tokens of the oldest entry of the
+// history of a validator.
+func (k Keeper) getFirstValidatorTokens(ctx sdk.Context, valAddr sdk.ValAddress) (math.Int, bool) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetValidatorTokensHistoryPrefixKey(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return math.Int{}, false
+	}
+
+	var tokens sdk.IntProto
+	k.cdc.MustUnmarshal(iterator.Value(), &tokens)
+	return tokens.Int, true
+}
+
+// pruneValidatorTokensHistory deletes the entries of the history of a
+// validator which were replaced by a newer entry more than an unbonding
+// period ago.
+func (k Keeper) pruneValidatorTokensHistory(ctx sdk.Context, valAddr sdk.ValAddress) {
+	cutoff := ctx.BlockTime().Add(-k.UnbondingTime(ctx))
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetValidatorTokensHistoryPrefixKey(valAddr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		_, t, err := types.ParseValidatorTokensHistoryKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		if t.After(cutoff) {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+
+	// the last entry of the cutoff is still the tokens at the cutoff
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// DeleteValidatorTokensHistory deletes the tokens history of a validator.
+func (k Keeper) DeleteValidatorTokensHistory(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetValidatorTokensHistoryPrefixKey(valAddr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
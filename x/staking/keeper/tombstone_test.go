@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTombstonedValidators() {
+	ctx, keeper, msgServer, queryClient := s.ctx, s.stakingKeeper, s.msgServer, s.queryClient
+	require := s.Require()
+	s.execExpectCalls()
+
+	// the query is unavailable until the slashing keeper is set
+	_, err := queryClient.TombstonedValidators(ctx, &stakingtypes.QueryTombstonedValidatorsRequest{})
+	require.ErrorContains(err, stakingtypes.ErrNoSlashingKeeper.Error())
+
+	_, valAddrs := createValAddrs(2)
+	for i, valAddr := range valAddrs {
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		validator.Jailed = i == 0
+		keeper.SetValidator(ctx, validator)
+	}
+
+	ctrl := gomock.NewController(s.T())
+	slashingKeeper := testutil.NewMockSlashingKeeper(ctrl)
+	keeper.SetSlashingKeeper(slashingKeeper)
+	slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), sdk.ConsAddress(PKs[0].Address())).Return(true).AnyTimes()
+	slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), sdk.ConsAddress(PKs[1].Address())).Return(false).AnyTimes()
+
+	res, err := queryClient.TombstonedValidators(ctx, &stakingtypes.QueryTombstonedValidatorsRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Equal(valAddrs[0].String(), res.Validators[0].OperatorAddress)
+	require.False(res.DelegationsRejected)
+
+	params := keeper.GetParams(ctx)
+	params.RejectTombstonedDelegations = true
+	require.NoError(keeper.SetParams(ctx, params))
+
+	res, err = queryClient.TombstonedValidators(ctx, &stakingtypes.QueryTombstonedValidatorsRequest{})
+	require.NoError(err)
+	require.True(res.DelegationsRejected)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 1))
+	_, err = msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: valAddrs[0].String(),
+		Amount:           amount,
+	})
+	require.ErrorIs(err, stakingtypes.ErrValidatorTombstoned)
+
+	// delegations to the validators which are not tombstoned are accepted
+	_, err = msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: valAddrs[1].String(),
+		Amount:           amount,
+	})
+	require.NoError(err)
+
+	// redelegations to a tombstoned validator are rejected as well
+	_, err = msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    Addr.String(),
+		ValidatorSrcAddress: valAddrs[1].String(),
+		ValidatorDstAddress: valAddrs[0].String(),
+		Amount:              amount,
+	})
+	require.ErrorIs(err, stakingtypes.ErrValidatorTombstoned)
+
+	delegation, found := keeper.GetDelegation(ctx, Addr, valAddrs[1])
+	require.True(found)
+	require.Equal(math.LegacyNewDecFromInt(amount.Amount), delegation.Shares)
+}
@@ -113,12 +113,19 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // CONTRACT: Only validators with non-zero power or zero-power that were bonded
 // at the previous block height or were removed from the validator set entirely
 // are returned to CometBFT.
+//
+// An EventValidatorPowerUpdates with the power changes is emitted if there are
+// any updates.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
 	params := k.GetParams(ctx)
 	maxValidators := params.MaxValidators
-	powerReduction := k.PowerReduction(ctx)
 	totalPower := math.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := math.ZeroInt(), math.ZeroInt()
+	var powerUpdates []types.ValidatorPowerUpdate
+
+	// Record the power strategy the powers are computed with, the powers of all
+	// the validators change with it.
+	k.TrackPowerStrategy(ctx)
 
 	// Retrieve the last validator set.
 	// The persistent set is updated later in this function.
@@ -143,8 +150,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 
 		// if we get to a zero-power validator (which we don't bond),
-		// there are no more possible bonded validators
-		if validator.PotentialConsensusPower(k.PowerReduction(ctx)) == 0 {
+		// there are no more possible bonded validators, as the power
+		// strategy is monotonic
+		if k.PotentialConsensusPower(ctx, validator) == 0 {
 			break
 		}
 
@@ -174,16 +182,30 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
-		newPower := validator.ConsensusPower(powerReduction)
+		newPower := k.ConsensusPower(ctx, validator)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+			update := validator.ABCIValidatorUpdate(k.PowerReduction(ctx))
+			update.Power = newPower
+			updates = append(updates, update)
+
+			var oldPower gogotypes.Int64Value
+			if found {
+				k.cdc.MustUnmarshal(oldPowerBytes, &oldPower)
+			}
+			powerUpdate, err := newValidatorPowerUpdate(validator, oldPower.Value, newPower)
+			if err != nil {
+				return nil, err
+			}
+			powerUpdates = append(powerUpdates, powerUpdate)
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
 
+		k.TrackValidatorTokens(ctx, validator)
+
 		delete(last, valAddrStr)
 		count++
 
@@ -202,6 +224,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
+		powerUpdate, err := newValidatorPowerUpdate(validator, k.GetLastValidatorPower(ctx, validator.GetOperator()), 0)
+		if err != nil {
+			return nil, err
+		}
+		powerUpdates = append(powerUpdates, powerUpdate)
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
@@ -229,9 +256,31 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// set the list of validator updates
 	k.SetValidatorUpdates(ctx, updates)
 
+	if len(powerUpdates) > 0 {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorPowerUpdates{Updates: powerUpdates}); err != nil {
+			return nil, err
+		}
+	}
+
 	return updates, err
 }
 
+// newValidatorPowerUpdate returns the change of the consensus power of the
+// validator from oldPower to newPower.
+func newValidatorPowerUpdate(validator types.Validator, oldPower, newPower int64) (types.ValidatorPowerUpdate, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.ValidatorPowerUpdate{}, err
+	}
+
+	return types.ValidatorPowerUpdate{
+		OperatorAddress:  validator.OperatorAddress,
+		ConsensusAddress: consAddr.String(),
+		PreviousPower:    oldPower,
+		Power:            newPower,
+	}, nil
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
@@ -268,7 +317,7 @@ func (k Keeper) UnbondingToUnbonded(ctx sdk.Context, validator types.Validator)
 }
 
 // send a validator to jail
-func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
+func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator, reason types.JailReason) {
 	if validator.Jailed {
 		panic(fmt.Sprintf("cannot jail already jailed validator, validator: %v\n", validator))
 	}
@@ -276,6 +325,10 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+	k.SetValidatorJailTime(ctx, validator.GetOperator(), ctx.BlockTime())
+	k.scheduleValidatorAutoRedelegations(ctx, validator.GetOperator())
+	k.SetJailRecord(ctx, types.NewJailRecord(validator.GetOperator(), reason, ctx.BlockHeight(), ctx.BlockTime()))
+	k.RemoveSelfDelegationDeficit(ctx, validator.GetOperator())
 }
 
 // remove a validator from jail
@@ -287,6 +340,7 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
+	k.DeleteValidatorJailTime(ctx, validator.GetOperator())
 }
 
 // perform all the store operations for when a validator status becomes bonded
@@ -368,6 +422,7 @@ func (k Keeper) BeginUnbondingValidator(ctx sdk.Context, validator types.Validat
 func (k Keeper) completeUnbondingValidator(ctx sdk.Context, validator types.Validator) types.Validator {
 	validator = validator.UpdateStatus(types.Unbonded)
 	k.SetValidator(ctx, validator)
+	k.QueueInactiveValidator(ctx, validator, ctx.BlockTime())
 
 	return validator
 }
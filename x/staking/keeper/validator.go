@@ -155,6 +155,34 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// EnforceMinCommissionRate raises the commission rate of the validators below
+// the minimum commission rate to it, also raising their max commission rate if
+// it is below. Unlike the changes made by the validators, the raise is not
+// bound by the max change rate nor by the time since the last change.
+func (k Keeper) EnforceMinCommissionRate(ctx sdk.Context) {
+	minRate := k.MinCommissionRate(ctx)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if !validator.Commission.Rate.LT(minRate) {
+			continue
+		}
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEnforceMinCommission,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, minRate.String()),
+			),
+		)
+	}
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
@@ -183,6 +211,10 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.DeleteValidatorJailTime(ctx, address)
+	k.RemoveSelfDelegationDeficit(ctx, address)
+	k.DeleteValidatorTokensHistory(ctx, address)
+	k.DequeueInactiveValidator(ctx, address)
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -270,17 +302,31 @@ func (k Keeper) GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress)
 	return intV.GetValue()
 }
 
-// Set the last validator power.
+// Set the last validator power, and index the validator by its power.
 func (k Keeper) SetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress, power int64) {
 	store := ctx.KVStore(k.storeKey)
+	key := types.GetLastValidatorPowerKey(operator)
+	if bz := store.Get(key); bz != nil {
+		intV := gogotypes.Int64Value{}
+		k.cdc.MustUnmarshal(bz, &intV)
+		store.Delete(types.GetLastValidatorPowerRankKey(operator, intV.Value))
+	}
+
 	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: power})
-	store.Set(types.GetLastValidatorPowerKey(operator), bz)
+	store.Set(key, bz)
+	store.Set(types.GetLastValidatorPowerRankKey(operator, power), []byte{})
 }
 
-// Delete the last validator power.
+// Delete the last validator power, and its index by power.
 func (k Keeper) DeleteLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetLastValidatorPowerKey(operator))
+	key := types.GetLastValidatorPowerKey(operator)
+	if bz := store.Get(key); bz != nil {
+		intV := gogotypes.Int64Value{}
+		k.cdc.MustUnmarshal(bz, &intV)
+		store.Delete(types.GetLastValidatorPowerRankKey(operator, intV.Value))
+	}
+	store.Delete(key)
 }
 
 // returns an iterator for the consensus validators in the last block
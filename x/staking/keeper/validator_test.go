@@ -267,6 +267,58 @@ func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesPowerDecrease() {
 	require.Equal(validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 }
 
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesPowerEvent() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var validators [2]stakingtypes.Validator
+	for i := range validators {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 100))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+	}
+
+	powerUpdates := func(ctx sdk.Context) []stakingtypes.ValidatorPowerUpdate {
+		var updates []stakingtypes.ValidatorPowerUpdate
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if event, ok := msg.(*stakingtypes.EventValidatorPowerUpdates); ok {
+				updates = append(updates, event.Updates...)
+			}
+		}
+		return updates
+	}
+	consAddr := func(i int) string {
+		addr, err := validators[i].GetConsAddr()
+		require.NoError(err)
+		return addr.String()
+	}
+
+	// no event is emitted without updates
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+	require.Empty(powerUpdates(ctx))
+
+	// the power of the first validator decreases, and the second one is jailed
+	validators[0], _ = validators[0].RemoveDelShares(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 20)))
+	validators[0] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[0], false)
+	keeper.DeleteValidatorByPowerIndex(ctx, validators[1])
+	validators[1].Jailed = true
+	keeper.SetValidator(ctx, validators[1])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal([]stakingtypes.ValidatorPowerUpdate{
+		{OperatorAddress: validators[0].OperatorAddress, ConsensusAddress: consAddr(0), PreviousPower: 100, Power: 80},
+		{OperatorAddress: validators[1].OperatorAddress, ConsensusAddress: consAddr(1), PreviousPower: 100, Power: 0},
+	}, powerUpdates(ctx))
+}
+
 func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueryYieldInputs() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	// the query is unavailable until the mint and distribution keepers are set
+	_, err := queryClient.YieldInputs(ctx, &stakingtypes.QueryYieldInputsRequest{})
+	require.ErrorContains(err, stakingtypes.ErrNoYieldKeepers.Error())
+
+	ctrl := gomock.NewController(s.T())
+	mintKeeper := testutil.NewMockMintKeeper(ctrl)
+	distrKeeper := testutil.NewMockDistributionKeeper(ctrl)
+	keeper.SetYieldKeepers(mintKeeper, distrKeeper)
+	mintKeeper.EXPECT().Inflation(gomock.Any()).Return(math.LegacyNewDecWithPrec(13, 2), nil).AnyTimes()
+	distrKeeper.EXPECT().GetCommunityTax(gomock.Any()).Return(math.LegacyNewDecWithPrec(2, 2), nil).AnyTimes()
+
+	bondDenom := keeper.BondDenom(ctx)
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 600)).AnyTimes()
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 1000)).AnyTimes()
+
+	_, valAddrs := createValAddrs(1)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator.Commission.Rate = math.LegacyNewDecWithPrec(5, 2)
+	keeper.SetValidator(ctx, validator)
+
+	querier := stakingkeeper.Querier{Keeper: keeper}
+	ctx = ctx.WithBlockHeight(42)
+	expected := &stakingtypes.QueryYieldInputsResponse{
+		Height:         42,
+		BondedTokens:   math.NewInt(600),
+		TotalSupply:    math.NewInt(1000),
+		BondedRatio:    math.LegacyNewDecWithPrec(6, 1),
+		Inflation:      math.LegacyNewDecWithPrec(13, 2),
+		CommunityTax:   math.LegacyNewDecWithPrec(2, 2),
+		CommissionRate: math.LegacyZeroDec(),
+	}
+	res, err := querier.YieldInputs(ctx, &stakingtypes.QueryYieldInputsRequest{})
+	require.NoError(err)
+	require.Equal(expected, res)
+
+	// the commission rate of the requested validator is returned with them
+	res, err = querier.YieldInputs(ctx, &stakingtypes.QueryYieldInputsRequest{ValidatorAddr: valAddrs[0].String()})
+	require.NoError(err)
+	expected.CommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	require.Equal(expected, res)
+
+	_, err = querier.YieldInputs(ctx, &stakingtypes.QueryYieldInputsRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address()).String()})
+	require.ErrorContains(err, "not found")
+}
@@ -0,0 +1,74 @@
+package v10
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	ValidatorsKey                 = []byte{0x21} // prefix for each key to a validator
+	DelegationKey                 = []byte{0x31} // key for a delegation
+	DelegationByValIndexKey       = []byte{0x71} // key for delegations by a validator
+	AutoRedelegationKey           = []byte{0x73} // key for the auto-redelegation preference of a delegator
+	InactiveValidatorQueueKey     = []byte{0x7E} // prefix for the inactive validators by the time they became inactive
+	InactiveValidatorKey          = []byte{0x7F} // prefix for the time at which each inactive validator became inactive
+	AutoRedelegationByValIndexKey = []byte{0x81} // prefix for the opted-in delegators of each validator, for the auto-redelegation
+)
+
+// GetValidatorKey creates the key for the validator with address
+func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetDelegationsByValPrefixKey builds a prefix key bytes with the given validator address bytes.
+func GetDelegationsByValPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationsByValKey creates the key for delegations by validator address
+func GetDelegationsByValKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByValPrefixKey(valAddr), delAddr...)
+}
+
+// GetInactiveValidatorQueueKey creates the key of an inactive validator in
+// the queue of the inactive validator cleanup
+func GetInactiveValidatorQueueKey(t time.Time, operatorAddr sdk.ValAddress) []byte {
+	key := append(InactiveValidatorQueueKey, sdk.FormatTimeBytes(t)...)
+	return append(key, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetInactiveValidatorKey creates the key for the time at which a validator
+// became inactive
+func GetInactiveValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(InactiveValidatorKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetDelegationsKey creates the prefix for a delegator for all validators
+func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationKey creates the key for delegator bond with validator
+func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationsKey(delAddr), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetAutoRedelegationKey creates the key for the auto-redelegation preference
+// of a delegator
+func GetAutoRedelegationKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoRedelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetAutoRedelegationByValKey creates the key of a delegation of a delegator
+// who opted in to the auto-redelegation, by validator
+func GetAutoRedelegationByValKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	key := append(AutoRedelegationByValIndexKey, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
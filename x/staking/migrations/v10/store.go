@@ -0,0 +1,90 @@
+package v10
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v9 to v10. The migration
+// includes:
+//
+// - Queueing the inactive validators, unbonded with no delegations other than
+// the self-delegation of their operator, which are no longer looked for in
+// every block. They are deemed to be inactive since they completed their
+// unbonding, or since the migration if they were never bonded.
+// - Indexing by validator the delegations of the delegators who opted in to the
+// auto-redelegation, which are no longer looked for among all the delegations
+// of a validator when it is jailed.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	if err := queueInactiveValidators(ctx, store, cdc); err != nil {
+		return err
+	}
+	indexAutoRedelegations(store)
+	return nil
+}
+
+func queueInactiveValidators(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := storetypes.KVStorePrefixIterator(store, ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.Validator
+		if err := cdc.Unmarshal(iterator.Value(), &validator); err != nil {
+			return err
+		}
+
+		if !validator.IsUnbonded() || hasDelegators(store, validator.GetOperator()) {
+			continue
+		}
+
+		since := validator.UnbondingTime
+		if since.IsZero() {
+			since = ctx.BlockTime()
+		}
+
+		store.Set(GetInactiveValidatorKey(validator.GetOperator()), sdk.FormatTimeBytes(since))
+		store.Set(GetInactiveValidatorQueueKey(since, validator.GetOperator()), []byte{})
+	}
+	return nil
+}
+
+// hasDelegators returns whether a validator has delegations of other
+// delegators than its operator.
+func hasDelegators(store storetypes.KVStore, valAddr sdk.ValAddress) bool {
+	prefix := GetDelegationsByValPrefixKey(valAddr)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if !bytes.Equal(iterator.Key()[len(prefix):], valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// indexAutoRedelegations indexes by validator the delegations of the delegators
+// who opted in to the auto-redelegation.
+func indexAutoRedelegations(store storetypes.KVStore) {
+	iterator := storetypes.KVStorePrefixIterator(store, AutoRedelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the addresses follow their length byte
+		delAddr := sdk.AccAddress(iterator.Key()[len(AutoRedelegationKey)+1:])
+		prefix := GetDelegationsKey(delAddr)
+
+		delIterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; delIterator.Valid(); delIterator.Next() {
+			valAddr := sdk.ValAddress(delIterator.Key()[len(prefix)+1:])
+			store.Set(GetAutoRedelegationByValKey(valAddr, delAddr), []byte{})
+		}
+		delIterator.Close()
+	}
+}
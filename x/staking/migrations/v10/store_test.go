@@ -0,0 +1,96 @@
+package v10_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v10 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v10"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v10.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	ctx = ctx.WithBlockTime(time.Unix(2_000_000, 0).UTC())
+	store := ctx.KVStore(storeKey)
+
+	unbondingTime := time.Unix(1_000_000, 0).UTC()
+	unbonded, neverBonded := sdk.ValAddress("unbonded"), sdk.ValAddress("never_bonded")
+	delegatedTo, bonded := sdk.ValAddress("delegated_to"), sdk.ValAddress("bonded")
+	for _, val := range []struct {
+		addr          sdk.ValAddress
+		status        types.BondStatus
+		unbondingTime time.Time
+	}{
+		{unbonded, types.Unbonded, unbondingTime},
+		{neverBonded, types.Unbonded, time.Time{}},
+		{delegatedTo, types.Unbonded, unbondingTime},
+		{bonded, types.Bonded, time.Time{}},
+	} {
+		store.Set(v10.GetValidatorKey(val.addr), cdc.MustMarshal(&types.Validator{
+			OperatorAddress: val.addr.String(),
+			Status:          val.status,
+			UnbondingTime:   val.unbondingTime,
+		}))
+		store.Set(v10.GetDelegationsByValKey(val.addr, sdk.AccAddress(val.addr)), []byte{})
+	}
+	store.Set(v10.GetDelegationsByValKey(delegatedTo, sdk.AccAddress("delegator")), []byte{})
+
+	require.NoError(t, v10.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := storetypes.KVStorePrefixIterator(store, v10.InactiveValidatorQueueKey)
+	defer iterator.Close()
+	var queued [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		queued = append(queued, iterator.Key())
+	}
+	require.Equal(t, [][]byte{
+		v10.GetInactiveValidatorQueueKey(unbondingTime, unbonded),
+		v10.GetInactiveValidatorQueueKey(ctx.BlockTime(), neverBonded),
+	}, queued)
+	require.Equal(t, sdk.FormatTimeBytes(ctx.BlockTime()), store.Get(v10.GetInactiveValidatorKey(neverBonded)))
+}
+
+func TestMigrateStoreAutoRedelegationIndex(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v10.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	optedIn, optedOut := sdk.AccAddress("opted_in"), sdk.AccAddress("opted_out")
+	val1, val2 := sdk.ValAddress("val1"), sdk.ValAddress("val2")
+	store.Set(v10.GetAutoRedelegationKey(optedIn), cdc.MustMarshal(&types.AutoRedelegation{
+		DelegatorAddress:         optedIn.String(),
+		FallbackValidatorAddress: val2.String(),
+		JailDuration:             time.Hour,
+	}))
+	for _, delAddr := range []sdk.AccAddress{optedIn, optedOut} {
+		for _, valAddr := range []sdk.ValAddress{val1, val2} {
+			store.Set(v10.GetDelegationKey(delAddr, valAddr), []byte{})
+		}
+	}
+
+	require.NoError(t, v10.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := storetypes.KVStorePrefixIterator(store, v10.AutoRedelegationByValIndexKey)
+	defer iterator.Close()
+	var indexed [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		indexed = append(indexed, iterator.Key())
+	}
+	require.Equal(t, [][]byte{
+		v10.GetAutoRedelegationByValKey(val1, optedIn),
+		v10.GetAutoRedelegationByValKey(val2, optedIn),
+	}, indexed)
+}
@@ -36,20 +36,34 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"auto_redelegations": [],
+	"delegation_labels": [],
 	"delegations": [],
 	"exported": false,
+	"jail_records": [],
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
+		"additional_bond_denoms": [],
 		"bond_denom": "stake",
 		"historical_entries": 10000,
+		"historical_info_max_bytes": "67108864",
+		"inactive_validator_cleanup_dry_run": false,
+		"inactive_validator_cleanup_period": "0s",
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"min_delegation_amount": "0",
+		"min_self_delegation_grace_period": "0s",
+		"reject_tombstoned_delegations": false,
+		"unbonding_time": "1814400s",
+		"undelegation_dust_threshold": "0",
+		"validator_set_checkpoint_interval": "0"
 	},
 	"redelegations": [],
+	"self_delegation_deficits": [],
 	"unbonding_delegations": [],
+	"validator_set_checkpoints": [],
 	"validators": []
 }`
 
@@ -0,0 +1,12 @@
+package v6
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	HistoricalInfoKey     = []byte{0x50} // prefix for the historical info
+	ParamsKey             = []byte{0x51} // prefix for parameters for module x/staking
+	HistoricalInfoSizeKey = []byte{0x52} // key for the total size of the historical info
+)
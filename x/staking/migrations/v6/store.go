@@ -0,0 +1,60 @@
+package v6
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration
+// includes:
+//
+// - Setting the total size of the stored historical info, which the historical
+// info is now pruned by.
+// - Setting the historical info max bytes param to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	migrateHistoricalInfoSize(store)
+	return migrateParams(store, cdc)
+}
+
+// migrateHistoricalInfoSize sums up the size, keys included, of the stored
+// historical info.
+func migrateHistoricalInfoSize(store storetypes.KVStore) {
+	iterator := storetypes.KVStorePrefixIterator(store, HistoricalInfoKey)
+	defer iterator.Close()
+
+	var size uint64
+	for ; iterator.Valid(); iterator.Next() {
+		size += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, size)
+	store.Set(HistoricalInfoSizeKey, bz)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.HistoricalInfoMaxBytes = types.DefaultHistoricalInfoMaxBytes
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,46 @@
+package v6_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	var expSize uint64
+	for height := int64(1); height <= 10; height++ {
+		key := v5.GetHistoricalInfoKey(height)
+		value := cdc.MustMarshal(&types.HistoricalInfo{Header: cmtproto.Header{ChainID: "testChainID", Height: height}})
+		store.Set(key, value)
+		expSize += uint64(len(key) + len(value))
+	}
+
+	params := types.DefaultParams()
+	params.HistoricalInfoMaxBytes = 0
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, expSize, binary.BigEndian.Uint64(store.Get(v6.HistoricalInfoSizeKey)))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(v6.ParamsKey), &migrated)
+	require.Equal(t, types.DefaultHistoricalInfoMaxBytes, migrated.HistoricalInfoMaxBytes)
+}
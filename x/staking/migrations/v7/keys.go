@@ -0,0 +1,28 @@
+package v7
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	LastValidatorPowerKey     = []byte{0x11} // prefix for each key to a validator index, for bonded validators
+	LastValidatorPowerRankKey = []byte{0x78} // prefix for the bonded validators sorted by their last power
+)
+
+// GetLastValidatorPowerKey creates the bonded validator index key for an operator address
+func GetLastValidatorPowerKey(operator sdk.ValAddress) []byte {
+	return append(LastValidatorPowerKey, address.MustLengthPrefix(operator)...)
+}
+
+// GetLastValidatorPowerRankKey creates the index key of a bonded validator
+// sorted by its last power
+func GetLastValidatorPowerRankKey(operator sdk.ValAddress, power int64) []byte {
+	key := append(LastValidatorPowerRankKey, sdk.Uint64ToBigEndian(uint64(power))...)
+	return append(key, address.MustLengthPrefix(operator)...)
+}
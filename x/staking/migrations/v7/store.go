@@ -0,0 +1,33 @@
+package v7
+
+import (
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+//
+// - Indexing the bonded validators by their last power, which the power
+// distribution is now read from.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, LastValidatorPowerKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var power gogotypes.Int64Value
+		if err := cdc.Unmarshal(iterator.Value(), &power); err != nil {
+			return err
+		}
+
+		// remove prefix bytes and address length
+		operator := sdk.ValAddress(iterator.Key()[2:])
+		store.Set(GetLastValidatorPowerRankKey(operator, power.Value), []byte{})
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package v7_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v7.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	powers := map[string]int64{"val1": 10, "val2": 0, "val3": 1 << 40}
+	for operator, power := range powers {
+		store.Set(v7.GetLastValidatorPowerKey(sdk.ValAddress(operator)), cdc.MustMarshal(&gogotypes.Int64Value{Value: power}))
+	}
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	for operator, power := range powers {
+		require.True(t, store.Has(v7.GetLastValidatorPowerRankKey(sdk.ValAddress(operator), power)))
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, v7.LastValidatorPowerRankKey)
+	defer iterator.Close()
+	var indexed int
+	for ; iterator.Valid(); iterator.Next() {
+		indexed++
+	}
+	require.Equal(t, len(powers), indexed)
+}
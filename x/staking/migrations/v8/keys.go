@@ -0,0 +1,39 @@
+package v8
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	DelegationKey         = []byte{0x31} // key for a delegation
+	DelegationBySharesKey = []byte{0x7A} // prefix for the delegations of each validator sorted by their shares
+)
+
+// GetDelegationKey creates the key for delegator bond with validator
+func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseDelegationKey parses the delegator and validator addresses of a
+// delegation from its key
+func ParseDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress) {
+	delAddrLen := int(key[1])
+	return sdk.AccAddress(key[2 : 2+delAddrLen]), sdk.ValAddress(key[3+delAddrLen:])
+}
+
+// GetDelegationBySharesKey creates the index key of a delegation to a
+// validator sorted by its shares
+func GetDelegationBySharesKey(valAddr sdk.ValAddress, shares math.LegacyDec, delAddr sdk.AccAddress) []byte {
+	sharesBz := shares.BigInt().Bytes()
+	key := append(DelegationBySharesKey, address.MustLengthPrefix(valAddr)...)
+	key = append(append(key, byte(len(sharesBz))), sharesBz...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
@@ -0,0 +1,31 @@
+package v8
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The migration
+// includes:
+//
+// - Indexing the delegations of each validator by their shares, which the top
+// delegators are now read from.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		if err := cdc.Unmarshal(iterator.Value(), &delegation); err != nil {
+			return err
+		}
+
+		delAddr, valAddr := ParseDelegationKey(iterator.Key())
+		store.Set(GetDelegationBySharesKey(valAddr, delegation.Shares, delAddr), []byte{})
+	}
+	return nil
+}
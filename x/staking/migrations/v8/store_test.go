@@ -0,0 +1,51 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v8 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v8"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v8.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	val := sdk.ValAddress("val")
+	shares := map[string]math.LegacyDec{
+		"del1": math.LegacyNewDec(10),
+		"del2": math.LegacyMustNewDecFromStr("0.5"),
+		"del3": math.LegacyNewDec(1 << 40),
+	}
+	for del, delShares := range shares {
+		delegation := types.Delegation{DelegatorAddress: del, ValidatorAddress: val.String(), Shares: delShares}
+		store.Set(v8.GetDelegationKey(sdk.AccAddress(del), val), cdc.MustMarshal(&delegation))
+	}
+
+	require.NoError(t, v8.MigrateStore(ctx, storeKey, cdc))
+
+	// the delegations are indexed in increasing order of shares
+	iterator := storetypes.KVStorePrefixIterator(store, v8.DelegationBySharesKey)
+	defer iterator.Close()
+	var indexed [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		indexed = append(indexed, iterator.Key())
+	}
+	require.Equal(t, [][]byte{
+		v8.GetDelegationBySharesKey(val, shares["del2"], sdk.AccAddress("del2")),
+		v8.GetDelegationBySharesKey(val, shares["del1"], sdk.AccAddress("del1")),
+		v8.GetDelegationBySharesKey(val, shares["del3"], sdk.AccAddress("del3")),
+	}, indexed)
+}
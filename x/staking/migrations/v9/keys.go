@@ -0,0 +1,50 @@
+package v9
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	DelegationByValIndexKey  = []byte{0x71} // key for delegations by a validator
+	AutoRedelegationKey      = []byte{0x73} // key for the auto-redelegation preference of a delegator
+	ValidatorJailTimeKey     = []byte{0x74} // prefix for the time at which each jailed validator was jailed
+	AutoRedelegationQueueKey = []byte{0x7C} // prefix for the auto-redelegations due at a given time
+)
+
+// GetDelegationsByValPrefixKey builds a prefix key bytes with the given validator address bytes.
+func GetDelegationsByValPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationsByValKey creates the key for delegations by validator address
+func GetDelegationsByValKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByValPrefixKey(valAddr), delAddr...)
+}
+
+// GetAutoRedelegationKey creates the key for the auto-redelegation preference
+// of a delegator
+func GetAutoRedelegationKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoRedelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetValidatorJailTimeKey creates the key for the time at which a validator
+// was jailed
+func GetValidatorJailTimeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorJailTimeKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetAutoRedelegationQueueKey creates the key of the auto-redelegation of the
+// stake of a delegator away from a jailed validator, due at a given time
+func GetAutoRedelegationQueueKey(dueTime time.Time, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	key := append(AutoRedelegationQueueKey, sdk.FormatTimeBytes(dueTime)...)
+	key = append(key, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
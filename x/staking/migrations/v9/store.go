@@ -0,0 +1,62 @@
+package v9
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v8 to v9. The migration
+// includes:
+//
+// - Queueing the auto-redelegations of the delegators of the jailed validators,
+// which are no longer looked for in every block.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	jailIterator := storetypes.KVStorePrefixIterator(store, ValidatorJailTimeKey)
+	defer jailIterator.Close()
+
+	for ; jailIterator.Valid(); jailIterator.Next() {
+		jailTime, err := sdk.ParseTimeBytes(jailIterator.Value())
+		if err != nil {
+			return err
+		}
+
+		valAddr := sdk.ValAddress(jailIterator.Key()[2:])
+		if err := queueValidatorAutoRedelegations(store, cdc, valAddr, jailTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func queueValidatorAutoRedelegations(store storetypes.KVStore, cdc codec.BinaryCodec, valAddr sdk.ValAddress, jailTime time.Time) error {
+	prefix := GetDelegationsByValPrefixKey(valAddr)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefix):])
+
+		bz := store.Get(GetAutoRedelegationKey(delAddr))
+		if bz == nil {
+			continue
+		}
+
+		var pref types.AutoRedelegation
+		if err := cdc.Unmarshal(bz, &pref); err != nil {
+			return err
+		}
+
+		if pref.FallbackValidatorAddress == valAddr.String() {
+			continue
+		}
+
+		store.Set(GetAutoRedelegationQueueKey(jailTime.Add(pref.JailDuration), valAddr, delAddr), []byte{})
+	}
+	return nil
+}
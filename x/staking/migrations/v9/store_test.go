@@ -0,0 +1,54 @@
+package v9_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v9 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v9"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v9.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	jailedVal, activeVal := sdk.ValAddress("jailed"), sdk.ValAddress("active")
+	jailTime := time.Unix(1_000_000, 0).UTC()
+	store.Set(v9.GetValidatorJailTimeKey(jailedVal), sdk.FormatTimeBytes(jailTime))
+
+	optedIn, optedOut, fallback := sdk.AccAddress("opted_in"), sdk.AccAddress("opted_out"), sdk.AccAddress("fallback")
+	for _, del := range []sdk.AccAddress{optedIn, optedOut, fallback} {
+		store.Set(v9.GetDelegationsByValKey(jailedVal, del), []byte{})
+		store.Set(v9.GetDelegationsByValKey(activeVal, del), []byte{})
+	}
+	store.Set(v9.GetAutoRedelegationKey(optedIn), cdc.MustMarshal(&types.AutoRedelegation{
+		DelegatorAddress: optedIn.String(), FallbackValidatorAddress: activeVal.String(), JailDuration: time.Hour,
+	}))
+	// a delegator whose fallback validator is the jailed one has nothing to redelegate
+	store.Set(v9.GetAutoRedelegationKey(fallback), cdc.MustMarshal(&types.AutoRedelegation{
+		DelegatorAddress: fallback.String(), FallbackValidatorAddress: jailedVal.String(), JailDuration: time.Hour,
+	}))
+
+	require.NoError(t, v9.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := storetypes.KVStorePrefixIterator(store, v9.AutoRedelegationQueueKey)
+	defer iterator.Close()
+	var queued [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		queued = append(queued, iterator.Key())
+	}
+	require.Equal(t, [][]byte{
+		v9.GetAutoRedelegationQueueKey(jailTime.Add(time.Hour), jailedVal, optedIn),
+	}, queued)
+}
@@ -31,7 +31,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 5
+	consensusVersion uint64 = 10
 )
 
 var (
@@ -167,6 +167,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 7 to 8: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 8 to 9: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 9 to 10: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the staking module.
@@ -206,6 +221,8 @@ func init() {
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
 		appmodule.Invoke(InvokeSetStakingHooks),
+		appmodule.Invoke(InvokeSetYieldKeepers),
+		appmodule.Invoke(InvokeSetSlashingKeeper),
 	)
 }
 
@@ -220,6 +237,9 @@ type ModuleInputs struct {
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
+
+	// PowerStrategy computes the consensus power of the validators, linear if not provided
+	PowerStrategy types.PowerStrategy `optional:"true"`
 }
 
 // Dependency Injection Outputs
@@ -244,6 +264,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		authority.String(),
 	)
+	if in.PowerStrategy != nil {
+		k.SetPowerStrategy(in.PowerStrategy)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
 	return ModuleOutputs{StakingKeeper: k, Module: m}
 }
@@ -311,3 +334,31 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		am.accountKeeper, am.bankKeeper, am.keeper,
 	)
 }
+
+// InvokeSetYieldKeepers sets the mint and distribution keepers of the yield
+// inputs query, which cannot be module inputs as both keepers depend on the
+// staking keeper.
+func InvokeSetYieldKeepers(
+	keeper *keeper.Keeper,
+	mintKeeper types.MintKeeper,
+	distrKeeper types.DistributionKeeper,
+) {
+	// all arguments to invokers are optional
+	if keeper == nil || mintKeeper == nil || distrKeeper == nil {
+		return
+	}
+
+	keeper.SetYieldKeepers(mintKeeper, distrKeeper)
+}
+
+// InvokeSetSlashingKeeper sets the slashing keeper the tombstone status of the
+// validators is read from, which cannot be a module input as it depends on the
+// staking keeper.
+func InvokeSetSlashingKeeper(keeper *keeper.Keeper, slashingKeeper types.SlashingKeeper) {
+	// all arguments to invokers are optional
+	if keeper == nil || slashingKeeper == nil {
+		return
+	}
+
+	keeper.SetSlashingKeeper(slashingKeeper)
+}
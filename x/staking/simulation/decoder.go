@@ -22,6 +22,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &powerB)
 
 			return fmt.Sprintf("%v\n%v", powerA, powerB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorTokensHistoryKey):
+			var tokensA, tokensB sdk.IntProto
+
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
 		case bytes.Equal(kvA.Key[:1], types.ValidatorsKey):
 			var validatorA, validatorB types.Validator
 
@@ -31,7 +38,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("%v\n%v", validatorA, validatorB)
 		case bytes.Equal(kvA.Key[:1], types.LastValidatorPowerKey),
 			bytes.Equal(kvA.Key[:1], types.ValidatorsByConsAddrKey),
-			bytes.Equal(kvA.Key[:1], types.ValidatorsByPowerIndexKey):
+			bytes.Equal(kvA.Key[:1], types.ValidatorsByPowerIndexKey),
+			bytes.Equal(kvA.Key[:1], types.LastValidatorPowerRankKey):
 			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
 
 		case bytes.Equal(kvA.Key[:1], types.DelegationKey):
@@ -64,6 +72,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &paramsB)
 
 			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.PowerStrategyKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -94,7 +94,11 @@ func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Acce
 		amount = msg.Amount
 	case *MsgCancelUnbondingDelegation:
 		validatorAddress = msg.ValidatorAddress
-		amount = msg.Amount
+		total, err := msg.GetTotalAmount()
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		amount = total
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
@@ -56,6 +56,9 @@ func TestAuthzAuthorizations(t *testing.T) {
 
 	validators1_2 := []string{val1.String(), val2.String()}
 
+	cancelEntries := stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, val1, ctx.BlockHeight(), coin50)
+	cancelEntries.Entries = []stakingtypes.CancelUnbondingEntry{{Amount: coin50, CreationHeight: ctx.BlockHeight() + 1}}
+
 	testCases := []struct {
 		msg                  string
 		allowed              []sdk.ValAddress
@@ -311,6 +314,28 @@ func TestAuthzAuthorizations(t *testing.T) {
 				AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION,
 			},
 		},
+		{
+			"cancel unbonding delegation: the entries are spent from the limit",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION,
+			&coin100,
+			cancelEntries,
+			false,
+			true,
+			nil,
+		},
+		{
+			"cancel unbonding delegation: fail the entries exceed the limit",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION,
+			&coin50,
+			cancelEntries,
+			true,
+			false,
+			nil,
+		},
 		{
 			"cancel unbonding delegation: testing with invalid validator",
 			[]sdk.ValAddress{val1, val2},
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorSetCheckpoint creates a validator set checkpoint from the given
+// validator set. The validators are listed in the order CometBFT sorts them
+// in, validators without consensus power are left out. The consensus power of
+// each validator is given by consensusPower.
+func NewValidatorSetCheckpoint(height int64, checkpointTime time.Time, valSet Validators, consensusPower func(Validator) int64) (ValidatorSetCheckpoint, error) {
+	cmtVals := make([]*cmttypes.Validator, 0, len(valSet))
+	for _, val := range valSet {
+		power := consensusPower(val)
+		if power <= 0 {
+			continue
+		}
+
+		pk, err := val.ConsPubKey()
+		if err != nil {
+			return ValidatorSetCheckpoint{}, err
+		}
+
+		cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+		if err != nil {
+			return ValidatorSetCheckpoint{}, err
+		}
+
+		cmtVals = append(cmtVals, cmttypes.NewValidator(cmtPk, power))
+	}
+
+	cmtValSet := cmttypes.NewValidatorSet(cmtVals)
+	validators := make([]CheckpointValidator, len(cmtValSet.Validators))
+	for i, val := range cmtValSet.Validators {
+		validators[i] = CheckpointValidator{
+			ConsAddress: sdk.ConsAddress(val.Address).String(),
+			Power:       val.VotingPower,
+		}
+	}
+
+	return ValidatorSetCheckpoint{
+		Height:         height,
+		Time:           checkpointTime,
+		ValidatorsHash: cmtValSet.Hash(),
+		TotalPower:     cmtValSet.TotalVotingPower(),
+		Validators:     validators,
+	}, nil
+}
+
+// MustMarshalValidatorSetCheckpoint returns the validator set checkpoint bytes.
+// Panics if fails
+func MustMarshalValidatorSetCheckpoint(cdc codec.BinaryCodec, checkpoint ValidatorSetCheckpoint) []byte {
+	return cdc.MustMarshal(&checkpoint)
+}
+
+// MustUnmarshalValidatorSetCheckpoint return the unmarshaled validator set
+// checkpoint from bytes. Panics if fails.
+func MustUnmarshalValidatorSetCheckpoint(cdc codec.BinaryCodec, value []byte) ValidatorSetCheckpoint {
+	var checkpoint ValidatorSetCheckpoint
+	cdc.MustUnmarshal(value, &checkpoint)
+	return checkpoint
+}
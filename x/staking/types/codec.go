@@ -20,8 +20,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEditValidator{}, "cosmos-sdk/MsgEditValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgDelegate{}, "cosmos-sdk/MsgDelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgUndelegate{}, "cosmos-sdk/MsgUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateTokens{}, "cosmos-sdk/MsgUndelegateTokens")
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDelegationLabel{}, "cosmos-sdk/MsgSetDelegationLabel")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoRedelegation{}, "cosmos-sdk/MsgSetAutoRedelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAutoRedelegation{}, "cosmos-sdk/MsgRemoveAutoRedelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
@@ -38,8 +42,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditValidator{},
 		&MsgDelegate{},
 		&MsgUndelegate{},
+		&MsgUndelegateTokens{},
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
+		&MsgSetDelegationLabel{},
+		&MsgSetAutoRedelegation{},
+		&MsgRemoveAutoRedelegation{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
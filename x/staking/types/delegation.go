@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,6 +71,72 @@ func (d Delegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// MaxDelegationLabelLength is the maximum length of a delegation label
+const MaxDelegationLabelLength = 64
+
+// NewDelegationLabel creates a new delegation label object
+func NewDelegationLabel(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, label string) DelegationLabel {
+	return DelegationLabel{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Label:            label,
+	}
+}
+
+// ValidateDelegationLabel returns an error if the delegation label is too long
+func ValidateDelegationLabel(label string) error {
+	if len(label) > MaxDelegationLabelLength {
+		return errorsmod.Wrapf(ErrDelegationLabelTooLong, "%d > %d", len(label), MaxDelegationLabelLength)
+	}
+
+	return nil
+}
+
+// MustMarshalDelegationLabel returns the delegation label bytes. Panics if fails
+func MustMarshalDelegationLabel(cdc codec.BinaryCodec, label DelegationLabel) []byte {
+	return cdc.MustMarshal(&label)
+}
+
+// MustUnmarshalDelegationLabel return the unmarshaled delegation label from bytes.
+// Panics if fails.
+func MustUnmarshalDelegationLabel(cdc codec.BinaryCodec, value []byte) DelegationLabel {
+	var label DelegationLabel
+	cdc.MustUnmarshal(value, &label)
+	return label
+}
+
+// NewAutoRedelegation creates a new auto-redelegation preference object
+func NewAutoRedelegation(delegatorAddr sdk.AccAddress, fallbackValidatorAddr sdk.ValAddress, jailDuration time.Duration) AutoRedelegation {
+	return AutoRedelegation{
+		DelegatorAddress:         delegatorAddr.String(),
+		FallbackValidatorAddress: fallbackValidatorAddr.String(),
+		JailDuration:             jailDuration,
+	}
+}
+
+// ValidateAutoRedelegationJailDuration returns an error if the jail duration of
+// an auto-redelegation preference is negative
+func ValidateAutoRedelegationJailDuration(jailDuration time.Duration) error {
+	if jailDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidJailDuration, "%s is negative", jailDuration)
+	}
+
+	return nil
+}
+
+// MustMarshalAutoRedelegation returns the auto-redelegation preference bytes. Panics if fails
+func MustMarshalAutoRedelegation(cdc codec.BinaryCodec, pref AutoRedelegation) []byte {
+	return cdc.MustMarshal(&pref)
+}
+
+// MustUnmarshalAutoRedelegation return the unmarshaled auto-redelegation
+// preference from bytes. Panics if fails.
+func MustUnmarshalAutoRedelegation(cdc codec.BinaryCodec, value []byte) AutoRedelegation {
+	var pref AutoRedelegation
+	cdc.MustUnmarshal(value, &pref)
+	return pref
+}
+
 func NewUnbondingDelegationEntry(creationHeight int64, completionTime time.Time, balance math.Int, unbondingID uint64) UnbondingDelegationEntry {
 	return UnbondingDelegationEntry{
 		CreationHeight:          creationHeight,
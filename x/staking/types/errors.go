@@ -50,4 +50,14 @@ var (
 	ErrCommissionLTMinRate             = errors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = errors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = errors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrDelegationLabelTooLong          = errors.Register(ModuleName, 43, "delegation label too long")
+	ErrNoAutoRedelegation              = errors.Register(ModuleName, 44, "no auto-redelegation preference for delegator")
+	ErrInvalidJailDuration             = errors.Register(ModuleName, 45, "invalid auto-redelegation jail duration")
+	ErrMultiAssetStakingDisabled       = errors.Register(ModuleName, 46, "multi-asset staking is not enabled")
+	ErrUnknownBondDenom                = errors.Register(ModuleName, 47, "unknown bond denom")
+	ErrDelegationBelowMinimum          = errors.Register(ModuleName, 48, "delegation below the minimum delegation amount")
+	ErrNoYieldKeepers                  = errors.Register(ModuleName, 49, "mint or distribution keeper is not set")
+	ErrInvalidPowerStrategy            = errors.Register(ModuleName, 50, "invalid power strategy")
+	ErrValidatorTombstoned             = errors.Register(ModuleName, 51, "validator is tombstoned")
+	ErrNoSlashingKeeper                = errors.Register(ModuleName, 52, "slashing keeper is not set")
 )
@@ -10,14 +10,32 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeSetDelegationLabel        = "set_delegation_label"
+	EventTypeSetAutoRedelegation       = "set_auto_redelegation"
+	EventTypeRemoveAutoRedelegation    = "remove_auto_redelegation"
+	EventTypeAutoRedelegate            = "auto_redelegate"
+	EventTypeAutoRedelegateFailed      = "auto_redelegate_failed"
+	EventTypeEnforceMinCommission      = "enforce_min_commission"
+	EventTypeSelfDelegationBelowMin    = "self_delegation_below_min"
+	EventTypeSelfDelegationRestored    = "self_delegation_restored"
+	EventTypeRemoveInactiveValidator   = "remove_inactive_validator"
+	EventTypeChangePowerStrategy       = "change_power_strategy"
 
-	AttributeKeyValidator         = "validator"
-	AttributeKeyCommissionRate    = "commission_rate"
-	AttributeKeyMinSelfDelegation = "min_self_delegation"
-	AttributeKeySrcValidator      = "source_validator"
-	AttributeKeyDstValidator      = "destination_validator"
-	AttributeKeyDelegator         = "delegator"
-	AttributeKeyCreationHeight    = "creation_height"
-	AttributeKeyCompletionTime    = "completion_time"
-	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyValidator             = "validator"
+	AttributeKeyCommissionRate        = "commission_rate"
+	AttributeKeyMinSelfDelegation     = "min_self_delegation"
+	AttributeKeySelfDelegation        = "self_delegation"
+	AttributeKeyJailTime              = "jail_time"
+	AttributeKeySrcValidator          = "source_validator"
+	AttributeKeyDstValidator          = "destination_validator"
+	AttributeKeyDelegator             = "delegator"
+	AttributeKeyCreationHeight        = "creation_height"
+	AttributeKeyCompletionTime        = "completion_time"
+	AttributeKeyNewShares             = "new_shares"
+	AttributeKeyLabel                 = "label"
+	AttributeKeyJailDuration          = "jail_duration"
+	AttributeKeyDryRun                = "dry_run"
+	AttributeKeyPowerStrategy         = "power_strategy"
+	AttributeKeyPreviousPowerStrategy = "previous_power_strategy"
+	AttributeKeyError                 = "error"
 )
@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/staking/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventValidatorPowerUpdates is emitted at the end of every block which changes the validator set, with all the
+// consensus power changes applied to CometBFT in the block.
+type EventValidatorPowerUpdates struct {
+	// updates are the power changes, ordered as the validator updates returned to CometBFT.
+	Updates []ValidatorPowerUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *EventValidatorPowerUpdates) Reset()         { *m = EventValidatorPowerUpdates{} }
+func (m *EventValidatorPowerUpdates) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorPowerUpdates) ProtoMessage()    {}
+func (*EventValidatorPowerUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cea99e3c3d45aec, []int{0}
+}
+func (m *EventValidatorPowerUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorPowerUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorPowerUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorPowerUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorPowerUpdates.Merge(m, src)
+}
+func (m *EventValidatorPowerUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorPowerUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorPowerUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorPowerUpdates proto.InternalMessageInfo
+
+func (m *EventValidatorPowerUpdates) GetUpdates() []ValidatorPowerUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+// ValidatorPowerUpdate defines the change of the consensus power of a validator.
+type ValidatorPowerUpdate struct {
+	// operator_address is the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_address is the consensus address of the validator.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// previous_power is the consensus power of the validator in the previous validator set, 0 if it was not in it.
+	PreviousPower int64 `protobuf:"varint,3,opt,name=previous_power,json=previousPower,proto3" json:"previous_power,omitempty"`
+	// power is the new consensus power of the validator, 0 if it left the validator set.
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPowerUpdate) Reset()         { *m = ValidatorPowerUpdate{} }
+func (m *ValidatorPowerUpdate) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerUpdate) ProtoMessage()    {}
+func (*ValidatorPowerUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cea99e3c3d45aec, []int{1}
+}
+func (m *ValidatorPowerUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerUpdate.Merge(m, src)
+}
+func (m *ValidatorPowerUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerUpdate proto.InternalMessageInfo
+
+func (m *ValidatorPowerUpdate) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPowerUpdate) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPowerUpdate) GetPreviousPower() int64 {
+	if m != nil {
+		return m.PreviousPower
+	}
+	return 0
+}
+
+func (m *ValidatorPowerUpdate) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventValidatorPowerUpdates)(nil), "cosmos.staking.v1beta1.EventValidatorPowerUpdates")
+	proto.RegisterType((*ValidatorPowerUpdate)(nil), "cosmos.staking.v1beta1.ValidatorPowerUpdate")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/staking/v1beta1/events.proto", fileDescriptor_6cea99e3c3d45aec)
+}
+
+var fileDescriptor_6cea99e3c3d45aec = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x33, 0xb6, 0x2a, 0x8e, 0xa8, 0x35, 0x14, 0xa9, 0x05, 0x63, 0xad, 0x08, 0x5d, 0xb4,
+	0x09, 0xd5, 0x13, 0x58, 0xd1, 0x55, 0x11, 0xa9, 0xe8, 0xc2, 0x4d, 0x49, 0x93, 0x21, 0xc6, 0xda,
+	0xbc, 0x61, 0xde, 0xa4, 0xea, 0x2d, 0x3c, 0x8c, 0x87, 0xe8, 0xb2, 0xb8, 0x72, 0x25, 0xd2, 0x9e,
+	0xc0, 0x1b, 0x48, 0x32, 0x93, 0x82, 0x9a, 0x55, 0x66, 0xbe, 0xf7, 0xbd, 0xff, 0x65, 0x78, 0xf4,
+	0xd0, 0x03, 0x1c, 0x01, 0x3a, 0x28, 0xdd, 0x61, 0x18, 0x05, 0xce, 0xb8, 0x3d, 0x60, 0xd2, 0x6d,
+	0x3b, 0x6c, 0xcc, 0x22, 0x89, 0x36, 0x17, 0x20, 0xc1, 0xdc, 0x51, 0x92, 0xad, 0x25, 0x5b, 0x4b,
+	0xd5, 0x5d, 0xc5, 0xfb, 0xa9, 0xe5, 0x68, 0x29, 0xbd, 0x54, 0xcb, 0x01, 0x04, 0xa0, 0x78, 0x72,
+	0x52, 0xb4, 0xfe, 0x40, 0xab, 0xe7, 0x49, 0xf0, 0xad, 0xfb, 0x18, 0xfa, 0xae, 0x04, 0x71, 0x05,
+	0x4f, 0x4c, 0xdc, 0x70, 0xdf, 0x95, 0x0c, 0xcd, 0x2e, 0x5d, 0x8d, 0xd5, 0xb1, 0x42, 0x6a, 0x85,
+	0xc6, 0xfa, 0x71, 0xd3, 0xce, 0x1f, 0x6c, 0xe7, 0xf5, 0x77, 0x8a, 0x93, 0xcf, 0x7d, 0xa3, 0x97,
+	0x45, 0xd4, 0xbf, 0x09, 0x2d, 0xe7, 0x79, 0x66, 0x97, 0x96, 0x80, 0x33, 0x91, 0xe0, 0xbe, 0xeb,
+	0xfb, 0x82, 0x61, 0x32, 0x8f, 0x34, 0xd6, 0x3a, 0x07, 0xef, 0x6f, 0xad, 0x3d, 0x3d, 0x72, 0xd1,
+	0x7a, 0xaa, 0x94, 0x6b, 0x29, 0xc2, 0x28, 0xe8, 0x6d, 0x65, 0xad, 0x1a, 0x9b, 0x97, 0x74, 0xdb,
+	0x83, 0x08, 0x59, 0x84, 0x31, 0x2e, 0xe2, 0x96, 0xfe, 0xc5, 0x9d, 0x65, 0xce, 0xef, 0xb8, 0x92,
+	0xf7, 0x87, 0x9b, 0x47, 0x74, 0x93, 0x0b, 0x36, 0x0e, 0x21, 0xc6, 0x3e, 0x4f, 0xfe, 0xba, 0x52,
+	0xa8, 0x91, 0x46, 0xa1, 0xb7, 0x91, 0xd1, 0xf4, 0x29, 0x66, 0x99, 0x2e, 0xab, 0x6a, 0x31, 0xad,
+	0xaa, 0x4b, 0xe7, 0x62, 0x32, 0xb3, 0xc8, 0x74, 0x66, 0x91, 0xaf, 0x99, 0x45, 0x5e, 0xe7, 0x96,
+	0x31, 0x9d, 0x5b, 0xc6, 0xc7, 0xdc, 0x32, 0xee, 0x9a, 0x41, 0x28, 0xef, 0xe3, 0x81, 0xed, 0xc1,
+	0x48, 0x2f, 0x4a, 0x7f, 0x5a, 0xe8, 0x0f, 0x9d, 0xe7, 0xc5, 0xfe, 0xe5, 0x0b, 0x67, 0x38, 0x58,
+	0x49, 0xd7, 0x75, 0xf2, 0x33, 0x00, 0x54, 0x99, 0xc3, 0x4f, 0x1e, 0x02, 0x00, 0x00,
+}
+
+func (m *EventValidatorPowerUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorPowerUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorPowerUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPowerUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventValidatorPowerUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPowerUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreviousPower != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousPower))
+	}
+	if m.Power != 0 {
+		n += 1 + sovEvents(uint64(m.Power))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventValidatorPowerUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorPowerUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorPowerUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, ValidatorPowerUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPowerUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPower", wireType)
+			}
+			m.PreviousPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
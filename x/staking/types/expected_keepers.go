@@ -9,10 +9,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DistributionKeeper expected distribution keeper (noalias)
+// DistributionKeeper expected distribution keeper, used solely to query the
+// community tax of the yield inputs (noalias)
 type DistributionKeeper interface {
-	GetFeePoolCommunityCoins(ctx context.Context) sdk.DecCoins
-	GetValidatorOutstandingRewardsCoins(ctx context.Context, val sdk.ValAddress) sdk.DecCoins
+	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
+}
+
+// MintKeeper expected mint keeper, used solely to query the inflation of the
+// yield inputs (noalias)
+type MintKeeper interface {
+	Inflation(ctx context.Context) (math.LegacyDec, error)
+}
+
+// SlashingKeeper expected slashing keeper, used solely to check whether the
+// validators are tombstoned (noalias)
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -70,6 +82,9 @@ type ValidatorSet interface {
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
+	// jail a validator, recording the reason it was jailed for in its jail history
+	JailWithReason(sdk.Context, sdk.ConsAddress, JailReason)
+
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
 	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) DelegationI
@@ -45,6 +45,18 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// delegation_labels defines the labels of the delegations at genesis.
+	DelegationLabels []DelegationLabel `protobuf:"bytes,9,rep,name=delegation_labels,json=delegationLabels,proto3" json:"delegation_labels"`
+	// auto_redelegations defines the auto-redelegation preferences of the
+	// delegators at genesis.
+	AutoRedelegations []AutoRedelegation `protobuf:"bytes,10,rep,name=auto_redelegations,json=autoRedelegations,proto3" json:"auto_redelegations"`
+	// jail_records defines the jail history of the validators at genesis.
+	JailRecords []JailRecord `protobuf:"bytes,11,rep,name=jail_records,json=jailRecords,proto3" json:"jail_records"`
+	// validator_set_checkpoints defines the validator set checkpoints at genesis.
+	ValidatorSetCheckpoints []ValidatorSetCheckpoint `protobuf:"bytes,12,rep,name=validator_set_checkpoints,json=validatorSetCheckpoints,proto3" json:"validator_set_checkpoints"`
+	// self_delegation_deficits defines the validators whose self-delegation is
+	// below their min_self_delegation at genesis, with the time they are jailed.
+	SelfDelegationDeficits []SelfDelegationDeficit `protobuf:"bytes,13,rep,name=self_delegation_deficits,json=selfDelegationDeficits,proto3" json:"self_delegation_deficits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +141,41 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetDelegationLabels() []DelegationLabel {
+	if m != nil {
+		return m.DelegationLabels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoRedelegations() []AutoRedelegation {
+	if m != nil {
+		return m.AutoRedelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailRecords() []JailRecord {
+	if m != nil {
+		return m.JailRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetCheckpoints() []ValidatorSetCheckpoint {
+	if m != nil {
+		return m.ValidatorSetCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSelfDelegationDeficits() []SelfDelegationDeficit {
+	if m != nil {
+		return m.SelfDelegationDeficits
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +227,48 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0xfa, 0x2f, 0xb9, 0xa4, 0xa8, 0x3d, 0xd2, 0xe2, 0x76, 0x70, 0x42, 0x55, 0x41,
+	0x54, 0xa8, 0xa3, 0xb6, 0x1b, 0x5b, 0x43, 0x05, 0x02, 0x2a, 0x51, 0x39, 0x94, 0xa1, 0x12, 0xb2,
+	0x2e, 0xf1, 0xd5, 0xbd, 0xc6, 0xf1, 0x59, 0x7e, 0x2f, 0xa5, 0xcc, 0x2c, 0x8c, 0x7c, 0x84, 0x8e,
+	0x8c, 0x0c, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x62, 0xa8, 0x50, 0x3b, 0xc0, 0xc7, 0x40, 0xbe, 0x73,
+	0x1c, 0x87, 0xc4, 0xed, 0x92, 0xc4, 0xf7, 0x3e, 0xcf, 0xef, 0x79, 0x1d, 0xbf, 0x7e, 0xd1, 0x6a,
+	0x9b, 0x43, 0x97, 0x43, 0x1d, 0x04, 0xe9, 0x30, 0xdf, 0xad, 0x9f, 0x6c, 0xb4, 0xa8, 0x20, 0x1b,
+	0x75, 0x97, 0xfa, 0x14, 0x18, 0x98, 0x41, 0xc8, 0x05, 0xc7, 0x8b, 0x4a, 0x65, 0xc6, 0x2a, 0x33,
+	0x56, 0x2d, 0x97, 0x5d, 0xee, 0x72, 0x29, 0xa9, 0x47, 0xbf, 0x94, 0x7a, 0x39, 0x8b, 0xd9, 0x77,
+	0x2b, 0xd5, 0x92, 0x52, 0xd9, 0xca, 0x1e, 0x07, 0xa8, 0xd2, 0x3c, 0xe9, 0x32, 0x9f, 0xd7, 0xe5,
+	0xa7, 0x3a, 0x5a, 0xf9, 0x54, 0x40, 0xa5, 0x17, 0xaa, 0xa7, 0xa6, 0x20, 0x82, 0xe2, 0x6d, 0x34,
+	0x1d, 0x90, 0x90, 0x74, 0x41, 0xd7, 0xaa, 0x5a, 0xad, 0xb8, 0x69, 0x98, 0xe3, 0x7b, 0x34, 0xf7,
+	0xa4, 0xaa, 0x51, 0x38, 0xbf, 0xac, 0xe4, 0xbe, 0xfe, 0xf9, 0xb6, 0xa6, 0x59, 0xb1, 0x11, 0xbf,
+	0x47, 0x73, 0x1e, 0x01, 0x61, 0x0b, 0x2e, 0x88, 0x67, 0x07, 0xfc, 0x03, 0x0d, 0xf5, 0x3b, 0x55,
+	0xad, 0x56, 0x6a, 0x6c, 0x45, 0xe2, 0x5f, 0x97, 0x95, 0x87, 0x2e, 0x13, 0x47, 0xbd, 0x96, 0xd9,
+	0xe6, 0xdd, 0xb8, 0xc3, 0xf8, 0x6b, 0x1d, 0x9c, 0x4e, 0x5d, 0x7c, 0x0c, 0x28, 0x98, 0x2f, 0x7d,
+	0xa1, 0xb0, 0x77, 0x23, 0xd8, 0xdb, 0x88, 0xb5, 0x17, 0xa1, 0x30, 0x43, 0x0b, 0x12, 0x7f, 0x42,
+	0x3c, 0xe6, 0x10, 0xc1, 0x43, 0x15, 0x01, 0xfa, 0x44, 0x75, 0xa2, 0x56, 0xdc, 0x5c, 0xcb, 0x6a,
+	0x78, 0x97, 0x80, 0x78, 0xd7, 0xf7, 0x48, 0x54, 0xba, 0xf9, 0x7b, 0xde, 0x48, 0x19, 0xf0, 0x2e,
+	0x42, 0x49, 0x0a, 0xe8, 0x93, 0x92, 0xff, 0x20, 0x8b, 0x9f, 0x98, 0xd3, 0xd8, 0x94, 0x1f, 0xbf,
+	0x41, 0x45, 0x87, 0x7a, 0xd4, 0x25, 0x82, 0x71, 0x1f, 0xf4, 0x29, 0x89, 0x5b, 0xc9, 0xc2, 0xed,
+	0x24, 0xd2, 0x34, 0x2f, 0x4d, 0xc0, 0x1d, 0xb4, 0xd0, 0xf3, 0x5b, 0xdc, 0x77, 0x98, 0xef, 0xda,
+	0x69, 0xf4, 0xb4, 0x44, 0x3f, 0xce, 0x42, 0xef, 0xf7, 0x4d, 0xe3, 0x33, 0xca, 0xbd, 0xd1, 0x3a,
+	0xe0, 0x7d, 0x34, 0x1b, 0xd2, 0x74, 0xc8, 0x8c, 0x0c, 0x59, 0xcd, 0x0a, 0xb1, 0xa8, 0x33, 0x96,
+	0x3e, 0x4c, 0xc1, 0xcb, 0x28, 0x4f, 0x4f, 0x03, 0x1e, 0x0a, 0xea, 0xe8, 0xf9, 0xaa, 0x56, 0xcb,
+	0x5b, 0xc9, 0x35, 0x3e, 0x40, 0xf3, 0x03, 0xa9, 0xed, 0x91, 0x16, 0xf5, 0x40, 0x2f, 0xc8, 0xd8,
+	0x47, 0xb7, 0xff, 0x6d, 0xbb, 0x91, 0xbe, 0x31, 0x19, 0x25, 0x5b, 0x73, 0xce, 0xf0, 0x71, 0x34,
+	0xa4, 0x98, 0xf4, 0x04, 0xb7, 0x87, 0xef, 0x09, 0x49, 0x78, 0x2d, 0x0b, 0xbe, 0xdd, 0x13, 0x7c,
+	0xe8, 0xbe, 0x14, 0x7d, 0x9e, 0xfc, 0x77, 0x0e, 0xf8, 0x35, 0x2a, 0x1d, 0x13, 0xe6, 0xd9, 0x21,
+	0x6d, 0xf3, 0xd0, 0x01, 0xbd, 0x78, 0xf3, 0xc3, 0x7e, 0x45, 0x98, 0x67, 0x49, 0x69, 0x8c, 0x2c,
+	0x1e, 0x27, 0x27, 0x80, 0x03, 0xb4, 0x34, 0x18, 0x76, 0xa0, 0xc2, 0x6e, 0x1f, 0xd1, 0x76, 0x27,
+	0xe0, 0xcc, 0x17, 0xa0, 0x97, 0x24, 0xd9, 0xbc, 0x75, 0x2a, 0x9b, 0x54, 0x3c, 0x4b, 0x6c, 0x71,
+	0xca, 0xfd, 0x93, 0xb1, 0x55, 0xc0, 0x5d, 0xa4, 0x03, 0xf5, 0x0e, 0x53, 0x43, 0x65, 0x3b, 0xf4,
+	0x90, 0xb5, 0x99, 0x00, 0x7d, 0x56, 0x06, 0xae, 0x67, 0x05, 0x36, 0xa9, 0x77, 0x38, 0x78, 0x08,
+	0x3b, 0xca, 0x15, 0xe7, 0x2d, 0xc2, 0xb8, 0x22, 0xac, 0x1c, 0x21, 0x3c, 0xfa, 0x76, 0xe2, 0x4d,
+	0x34, 0x43, 0x1c, 0x27, 0xa4, 0xa0, 0x76, 0x51, 0xa1, 0xa1, 0xff, 0xf8, 0xbe, 0x5e, 0x8e, 0x63,
+	0xb7, 0x55, 0xa5, 0x29, 0x42, 0xe6, 0xbb, 0x56, 0x5f, 0x88, 0xcb, 0x68, 0x6a, 0xb0, 0x70, 0x26,
+	0x2c, 0x75, 0xf1, 0x34, 0xff, 0xf9, 0xac, 0x92, 0xfb, 0x7b, 0x56, 0xc9, 0x35, 0x9e, 0x9f, 0x5f,
+	0x19, 0xda, 0xc5, 0x95, 0xa1, 0xfd, 0xbe, 0x32, 0xb4, 0x2f, 0xd7, 0x46, 0xee, 0xe2, 0xda, 0xc8,
+	0xfd, 0xbc, 0x36, 0x72, 0x07, 0x4f, 0x6e, 0xdc, 0x49, 0xa7, 0xc9, 0xd6, 0x95, 0xdb, 0xa9, 0x35,
+	0x2d, 0xd7, 0xe7, 0xd6, 0xbf, 0x01, 0x00, 0x0c, 0xfc, 0xf7, 0xc3, 0xe8, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +291,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SelfDelegationDeficits) > 0 {
+		for iNdEx := len(m.SelfDelegationDeficits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfDelegationDeficits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorSetCheckpoints) > 0 {
+		for iNdEx := len(m.ValidatorSetCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSetCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.JailRecords) > 0 {
+		for iNdEx := len(m.JailRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AutoRedelegations) > 0 {
+		for iNdEx := len(m.AutoRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DelegationLabels) > 0 {
+		for iNdEx := len(m.DelegationLabels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +553,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.DelegationLabels) > 0 {
+		for _, e := range m.DelegationLabels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRedelegations) > 0 {
+		for _, e := range m.AutoRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailRecords) > 0 {
+		for _, e := range m.JailRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSetCheckpoints) > 0 {
+		for _, e := range m.ValidatorSetCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SelfDelegationDeficits) > 0 {
+		for _, e := range m.SelfDelegationDeficits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +893,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationLabels = append(m.DelegationLabels, DelegationLabel{})
+			if err := m.DelegationLabels[len(m.DelegationLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRedelegations = append(m.AutoRedelegations, AutoRedelegation{})
+			if err := m.AutoRedelegations[len(m.AutoRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailRecords = append(m.JailRecords, JailRecord{})
+			if err := m.JailRecords[len(m.JailRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetCheckpoints = append(m.ValidatorSetCheckpoints, ValidatorSetCheckpoint{})
+			if err := m.ValidatorSetCheckpoints[len(m.ValidatorSetCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegationDeficits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfDelegationDeficits = append(m.SelfDelegationDeficits, SelfDelegationDeficit{})
+			if err := m.SelfDelegationDeficits[len(m.SelfDelegationDeficits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewJailRecord creates a new jail record object
+func NewJailRecord(valAddr sdk.ValAddress, reason JailReason, height int64, jailTime time.Time) JailRecord {
+	return JailRecord{
+		ValidatorAddress: valAddr.String(),
+		Reason:           reason,
+		Height:           height,
+		Time:             jailTime,
+	}
+}
+
+// MustMarshalJailRecord returns the jail record bytes. Panics if fails
+func MustMarshalJailRecord(cdc codec.BinaryCodec, record JailRecord) []byte {
+	return cdc.MustMarshal(&record)
+}
+
+// MustUnmarshalJailRecord return the unmarshaled jail record from bytes.
+// Panics if fails.
+func MustUnmarshalJailRecord(cdc codec.BinaryCodec, value []byte) JailRecord {
+	var record JailRecord
+	cdc.MustUnmarshal(value, &record)
+	return record
+}
+
+// NewSelfDelegationDeficit creates a new self-delegation deficit object
+func NewSelfDelegationDeficit(valAddr sdk.ValAddress, jailTime time.Time) SelfDelegationDeficit {
+	return SelfDelegationDeficit{
+		ValidatorAddress: valAddr.String(),
+		JailTime:         jailTime,
+	}
+}
+
+// MustMarshalSelfDelegationDeficit returns the self-delegation deficit bytes.
+// Panics if fails
+func MustMarshalSelfDelegationDeficit(cdc codec.BinaryCodec, deficit SelfDelegationDeficit) []byte {
+	return cdc.MustMarshal(&deficit)
+}
+
+// MustUnmarshalSelfDelegationDeficit return the unmarshaled self-delegation
+// deficit from bytes. Panics if fails.
+func MustUnmarshalSelfDelegationDeficit(cdc codec.BinaryCodec, value []byte) SelfDelegationDeficit {
+	var deficit SelfDelegationDeficit
+	cdc.MustUnmarshal(value, &deficit)
+	return deficit
+}
@@ -54,7 +54,28 @@ var (
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
 
+	HistoricalInfoSizeKey = []byte{0x52} // key for the total size of the historical info
+
 	DelegationByValIndexKey = []byte{0x71} // key for delegations by a validator
+	DelegationLabelKey      = []byte{0x72} // key for the label of a delegation
+	AutoRedelegationKey     = []byte{0x73} // key for the auto-redelegation preference of a delegator
+	ValidatorJailTimeKey    = []byte{0x74} // prefix for the time at which each jailed validator was jailed
+	JailRecordKey           = []byte{0x75} // prefix for the jail history of each validator
+
+	ValidatorSetCheckpointKey = []byte{0x76} // prefix for the validator set checkpoints
+	SelfDelegationDeficitKey  = []byte{0x77} // prefix for the validators whose self-delegation is below their minimum
+	LastValidatorPowerRankKey = []byte{0x78} // prefix for the bonded validators sorted by their last power
+	PowerStrategyKey          = []byte{0x79} // key for the name of the power strategy the last powers were computed with
+	DelegationBySharesKey     = []byte{0x7A} // prefix for the delegations of each validator sorted by their shares
+	BlockChurnKey             = []byte{0x7B} // prefix for the tokens delegated, undelegated and redelegated in the current block
+	AutoRedelegationQueueKey  = []byte{0x7C} // prefix for the auto-redelegations due at a given time
+	ValidatorTokensHistoryKey = []byte{0x7D} // prefix for the tokens of each bonded validator at the heights they changed
+
+	InactiveValidatorQueueKey  = []byte{0x7E} // prefix for the inactive validators by the time they became inactive
+	InactiveValidatorKey       = []byte{0x7F} // prefix for the time at which each inactive validator became inactive
+	InactiveValidatorDryRunKey = []byte{0x80} // key for the time up to which the inactive validators were reported in dry-run mode
+
+	AutoRedelegationByValIndexKey = []byte{0x81} // prefix for the opted-in delegators of each validator, for the auto-redelegation
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -253,6 +274,247 @@ func ParseDelegationsByValKey(bz []byte) (sdk.ValAddress, sdk.AccAddress, error)
 	return val, del, nil
 }
 
+// GetDelegationBySharesKey creates the index key of a delegation to a
+// validator sorted by its shares
+// VALUE: none (key rearrangement used)
+func GetDelegationBySharesKey(valAddr sdk.ValAddress, shares math.LegacyDec, delAddr sdk.AccAddress) []byte {
+	// key is of format prefix || valAddrLen (1byte) || valAddrBytes || sharesLen (1byte) || sharesBytes ||
+	// delAddrLen (1byte) || delAddrBytes, so that the delegations are sorted by shares as the shares are not negative
+	key := append(GetDelegationsBySharesPrefixKey(valAddr), lengthPrefixedShares(shares)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationsBySharesPrefixKey creates the prefix of the index keys of the
+// delegations to a validator sorted by their shares
+func GetDelegationsBySharesPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationBySharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetBlockChurnKey returns the key of the tokens of a kind of stake flow in the
+// current block.
+func GetBlockChurnKey(kind byte) []byte {
+	return append([]byte{BlockChurnKey[0]}, kind)
+}
+
+// ParseDelegationBySharesKey parses the delegator address from the key of a
+// delegation in the prefix store of the delegations of its validator sorted by
+// their shares
+func ParseDelegationBySharesKey(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 1)
+	sharesLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, sharesLen+3)
+	return sdk.AccAddress(key[sharesLen+2:])
+}
+
+// lengthPrefixedShares returns the big-endian representation of the shares,
+// prefixed with its length so that the larger shares sort after the smaller
+// ones
+func lengthPrefixedShares(shares math.LegacyDec) []byte {
+	bz := shares.BigInt().Bytes()
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
+// GetDelegationLabelKey creates the key for the label of a delegation
+// VALUE: staking/DelegationLabel
+func GetDelegationLabelKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationLabelKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetAutoRedelegationKey creates the key for the auto-redelegation preference
+// of a delegator
+// VALUE: staking/AutoRedelegation
+func GetAutoRedelegationKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoRedelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetAutoRedelegationsByValPrefixKey creates the prefix of the delegators of a
+// validator who opted in to the auto-redelegation
+func GetAutoRedelegationsByValPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(AutoRedelegationByValIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetAutoRedelegationByValKey creates the key of a delegation of a delegator
+// who opted in to the auto-redelegation, by validator
+// VALUE: none (key rearrangement used)
+func GetAutoRedelegationByValKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetAutoRedelegationsByValPrefixKey(valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetAutoRedelegationQueueTimeKey creates the prefix of the auto-redelegations
+// due at a given time
+func GetAutoRedelegationQueueTimeKey(dueTime time.Time) []byte {
+	return append(AutoRedelegationQueueKey, sdk.FormatTimeBytes(dueTime)...)
+}
+
+// GetAutoRedelegationQueueKey creates the key of the auto-redelegation of the
+// stake of a delegator away from a jailed validator, due at a given time
+// VALUE: none (key rearrangement used)
+func GetAutoRedelegationQueueKey(dueTime time.Time, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	key := append(GetAutoRedelegationQueueTimeKey(dueTime), address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+// ParseAutoRedelegationQueueKey parses the validator and delegator addresses
+// from the key of an auto-redelegation in the queue
+func ParseAutoRedelegationQueueKey(key []byte) (sdk.ValAddress, sdk.AccAddress, error) {
+	// the time bytes have a fixed length
+	prefixLen := len(AutoRedelegationQueueKey) + len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) < prefixLen+1 {
+		return nil, nil, fmt.Errorf("key is too short to be an auto-redelegation queue key: %X", key)
+	}
+
+	bz := key[prefixLen:]
+	valAddrLen := int(bz[0])
+	if len(bz) < valAddrLen+2 {
+		return nil, nil, fmt.Errorf("no bytes left to parse delegator address: %X", key)
+	}
+
+	valAddr := bz[1 : valAddrLen+1]
+	delAddr := bz[valAddrLen+2:]
+	if len(delAddr) != int(bz[valAddrLen+1]) {
+		return nil, nil, fmt.Errorf("invalid delegator address length in key: %X", key)
+	}
+
+	return valAddr, delAddr, nil
+}
+
+// GetInactiveValidatorQueueTimeKey creates the prefix of the inactive
+// validators which became inactive at a given time
+func GetInactiveValidatorQueueTimeKey(t time.Time) []byte {
+	return append(InactiveValidatorQueueKey, sdk.FormatTimeBytes(t)...)
+}
+
+// GetInactiveValidatorQueueKey creates the key of an inactive validator in
+// the queue of the inactive validator cleanup
+// VALUE: none (key rearrangement used)
+func GetInactiveValidatorQueueKey(t time.Time, operatorAddr sdk.ValAddress) []byte {
+	return append(GetInactiveValidatorQueueTimeKey(t), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// ParseInactiveValidatorQueueKey parses the time and the operator address
+// from the key of an inactive validator in the queue
+func ParseInactiveValidatorQueueKey(key []byte) (time.Time, sdk.ValAddress, error) {
+	// the time bytes have a fixed length
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	prefixLen := len(InactiveValidatorQueueKey) + timeLen
+	if len(key) < prefixLen+1 {
+		return time.Time{}, nil, fmt.Errorf("key is too short to be an inactive validator queue key: %X", key)
+	}
+
+	t, err := sdk.ParseTimeBytes(key[len(InactiveValidatorQueueKey):prefixLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	bz := key[prefixLen:]
+	if len(bz) != int(bz[0])+1 {
+		return time.Time{}, nil, fmt.Errorf("invalid validator address length in key: %X", key)
+	}
+
+	return t, bz[1:], nil
+}
+
+// GetInactiveValidatorKey creates the key for the time at which a validator
+// became inactive
+// VALUE: time bytes
+func GetInactiveValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(InactiveValidatorKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorJailTimeKey creates the key for the time at which a validator
+// was jailed
+// VALUE: time bytes
+func GetValidatorJailTimeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorJailTimeKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromValidatorJailTimeKey creates the validator operator address from ValidatorJailTimeKey
+func AddressFromValidatorJailTimeKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetJailRecordKey creates the key for the jailing of a validator at a given
+// height
+// VALUE: staking/JailRecord
+func GetJailRecordKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	return append(GetJailRecordsKey(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetJailRecordsKey creates the prefix for the jail history of a validator
+func GetJailRecordsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(JailRecordKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorTokensHistoryKey creates the key for the tokens a bonded
+// validator had from the end of the block at a given height and time
+// VALUE: sdk.IntProto
+func GetValidatorTokensHistoryKey(operatorAddr sdk.ValAddress, height int64, t time.Time) []byte {
+	key := append(GetValidatorTokensHistoryPrefixKey(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.FormatTimeBytes(t)...)
+}
+
+// GetValidatorTokensHistoryPrefixKey creates the prefix for the tokens history
+// of a validator
+func GetValidatorTokensHistoryPrefixKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorTokensHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorTokensHistoryHeightKey creates the prefix for the tokens history
+// of a validator from a given height
+func GetValidatorTokensHistoryHeightKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorTokensHistoryPrefixKey(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParseValidatorTokensHistoryKey parses the height and time from the key of
+// an entry of the tokens history of a validator
+func ParseValidatorTokensHistoryKey(key []byte) (int64, time.Time, error) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	prefixLen := 2 + int(key[1])
+	if len(key) < prefixLen+8 {
+		return 0, time.Time{}, fmt.Errorf("key is too short to be a validator tokens history key: %X", key)
+	}
+
+	height := int64(sdk.BigEndianToUint64(key[prefixLen : prefixLen+8]))
+	t, err := sdk.ParseTimeBytes(key[prefixLen+8:])
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return height, t, nil
+}
+
+// GetValidatorSetCheckpointKey creates the key for the validator set checkpoint
+// taken at a given height
+// VALUE: staking/ValidatorSetCheckpoint
+func GetValidatorSetCheckpointKey(height int64) []byte {
+	return append(ValidatorSetCheckpointKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetSelfDelegationDeficitKey creates the key for the self-delegation deficit
+// of a validator
+// VALUE: staking/SelfDelegationDeficit
+func GetSelfDelegationDeficitKey(operatorAddr sdk.ValAddress) []byte {
+	return append(SelfDelegationDeficitKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetLastValidatorPowerRankKey creates the index key of a bonded validator
+// sorted by its last power
+// VALUE: none (key rearrangement used)
+func GetLastValidatorPowerRankKey(operatorAddr sdk.ValAddress, power int64) []byte {
+	// key is of format prefix || powerbytes || addrLen (1byte) || addrBytes
+	key := append(LastValidatorPowerRankKey, sdk.Uint64ToBigEndian(uint64(power))...)
+	return append(key, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// ParseLastValidatorPowerRankKey parses the operator address and the power of
+// a validator from its last power rank key
+func ParseLastValidatorPowerRankKey(key []byte) (sdk.ValAddress, int64) {
+	kv.AssertKeyAtLeastLength(key, 11)
+	power := int64(sdk.BigEndianToUint64(key[1:9]))
+	return sdk.ValAddress(key[10:]), power
+}
+
 // GetDelegationsKey creates the prefix for a delegator for all validators
 func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
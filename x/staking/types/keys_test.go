@@ -132,6 +132,21 @@ func TestTestGetValidatorQueueKeyOrder(t *testing.T) {
 	require.Equal(t, 1, bytes.Compare(keyC, endKey))  // keyB >= endKey
 }
 
+func TestGetAutoRedelegationQueueKey(t *testing.T) {
+	ts := time.Now()
+	valAddr, delAddr := sdk.ValAddress("validator"), sdk.AccAddress("delegator")
+
+	bz := types.GetAutoRedelegationQueueKey(ts, valAddr, delAddr)
+	require.True(t, bytes.HasPrefix(bz, types.GetAutoRedelegationQueueTimeKey(ts)))
+	rValAddr, rDelAddr, err := types.ParseAutoRedelegationQueueKey(bz)
+	require.NoError(t, err)
+	require.Equal(t, valAddr, rValAddr)
+	require.Equal(t, delAddr, rDelAddr)
+
+	_, _, err = types.ParseAutoRedelegationQueueKey(bz[:len(bz)-1])
+	require.Error(t, err)
+}
+
 func TestGetHistoricalInfoKey(t *testing.T) {
 	tests := []struct {
 		height int64
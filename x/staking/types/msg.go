@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -17,16 +19,24 @@ var (
 	_ sdk.Msg                            = &MsgEditValidator{}
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
+	_ sdk.Msg                            = &MsgUndelegateTokens{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgSetDelegationLabel{}
+	_ sdk.Msg                            = &MsgSetAutoRedelegation{}
+	_ sdk.Msg                            = &MsgRemoveAutoRedelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
 
 	_ legacytx.LegacyMsg = &MsgCreateValidator{}
 	_ legacytx.LegacyMsg = &MsgEditValidator{}
 	_ legacytx.LegacyMsg = &MsgDelegate{}
 	_ legacytx.LegacyMsg = &MsgUndelegate{}
+	_ legacytx.LegacyMsg = &MsgUndelegateTokens{}
 	_ legacytx.LegacyMsg = &MsgBeginRedelegate{}
 	_ legacytx.LegacyMsg = &MsgCancelUnbondingDelegation{}
+	_ legacytx.LegacyMsg = &MsgSetDelegationLabel{}
+	_ legacytx.LegacyMsg = &MsgSetAutoRedelegation{}
+	_ legacytx.LegacyMsg = &MsgRemoveAutoRedelegation{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
@@ -207,6 +217,27 @@ func (msg MsgUndelegate) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// NewMsgUndelegateTokens creates a new MsgUndelegateTokens instance.
+func NewMsgUndelegateTokens(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgUndelegateTokens {
+	return &MsgUndelegateTokens{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUndelegateTokens) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUndelegateTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
 func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin) *MsgCancelUnbondingDelegation {
 	return &MsgCancelUnbondingDelegation{
@@ -228,6 +259,35 @@ func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+// GetCancelEntries returns the amounts to cancel by creation height: amount at
+// creation_height, unless both are left empty, followed by the entries.
+func (msg MsgCancelUnbondingDelegation) GetCancelEntries() []CancelUnbondingEntry {
+	primary := CancelUnbondingEntry{Amount: msg.Amount, CreationHeight: msg.CreationHeight}
+	if len(msg.Entries) > 0 && msg.CreationHeight == 0 && msg.Amount.Denom == "" &&
+		(msg.Amount.Amount.IsNil() || msg.Amount.Amount.IsZero()) {
+		return msg.Entries
+	}
+	return append([]CancelUnbondingEntry{primary}, msg.Entries...)
+}
+
+// GetTotalAmount returns the sum of the amounts to cancel, see GetCancelEntries.
+func (msg MsgCancelUnbondingDelegation) GetTotalAmount() (sdk.Coin, error) {
+	var total sdk.Coin
+	for i, entry := range msg.GetCancelEntries() {
+		switch {
+		case !entry.Amount.IsValid():
+			return sdk.Coin{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount %s", entry.Amount)
+		case i == 0:
+			total = entry.Amount
+		case entry.Amount.Denom != total.Denom:
+			return sdk.Coin{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount %s, expected %s denomination", entry.Amount, total.Denom)
+		default:
+			total = total.Add(entry.Amount)
+		}
+	}
+	return total, nil
+}
+
 // GetSignBytes returns the raw bytes for a MsgUpdateParams message that
 // the expected signer needs to sign.
 func (m MsgUpdateParams) GetSignBytes() []byte {
@@ -240,3 +300,64 @@ func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetDelegationLabel creates a new MsgSetDelegationLabel instance.
+func NewMsgSetDelegationLabel(delAddr sdk.AccAddress, valAddr sdk.ValAddress, label string) *MsgSetDelegationLabel {
+	return &MsgSetDelegationLabel{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Label:            label,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetDelegationLabel) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetDelegationLabel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgSetAutoRedelegation creates a new MsgSetAutoRedelegation instance.
+func NewMsgSetAutoRedelegation(delAddr sdk.AccAddress, fallbackValAddr sdk.ValAddress, jailDuration time.Duration) *MsgSetAutoRedelegation {
+	return &MsgSetAutoRedelegation{
+		DelegatorAddress:         delAddr.String(),
+		FallbackValidatorAddress: fallbackValAddr.String(),
+		JailDuration:             jailDuration,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetAutoRedelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetAutoRedelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgRemoveAutoRedelegation creates a new MsgRemoveAutoRedelegation instance.
+func NewMsgRemoveAutoRedelegation(delAddr sdk.AccAddress) *MsgRemoveAutoRedelegation {
+	return &MsgRemoveAutoRedelegation{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveAutoRedelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRemoveAutoRedelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -29,20 +29,38 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultHistoricalInfoMaxBytes is 64MiB, which bounds the size of the
+	// historical info store independently of the size of the validator set.
+	DefaultHistoricalInfoMaxBytes uint64 = 64 << 20
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultUndelegationDustThreshold is set to 0, i.e. dust delegations are
+	// not undelegated
+	DefaultUndelegationDustThreshold = math.ZeroInt()
 
-// NewParams creates a new Params instance
+	// DefaultMinDelegationAmount is set to 0, i.e. delegations of any amount
+	// are allowed
+	DefaultMinDelegationAmount = math.ZeroInt()
+)
+
+// NewParams creates a new Params instance, with the default undelegation dust
+// threshold, historical info max bytes and min delegation amount.
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		UndelegationDustThreshold: DefaultUndelegationDustThreshold,
+		HistoricalInfoMaxBytes:    DefaultHistoricalInfoMaxBytes,
+		MinDelegationAmount:       DefaultMinDelegationAmount,
 	}
 }
 
@@ -104,6 +122,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateUndelegationDustThreshold(p.UndelegationDustThreshold); err != nil {
+		return err
+	}
+
+	if err := validateHistoricalInfoMaxBytes(p.HistoricalInfoMaxBytes); err != nil {
+		return err
+	}
+
+	if err := validateAdditionalBondDenoms(p.BondDenom, p.AdditionalBondDenoms); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegationGracePeriod(p.MinSelfDelegationGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateMinDelegationAmount(p.MinDelegationAmount); err != nil {
+		return err
+	}
+
+	if err := validateInactiveValidatorCleanupPeriod(p.InactiveValidatorCleanupPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -155,6 +197,41 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateMinSelfDelegationGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("min self delegation grace period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInactiveValidatorCleanupPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("inactive validator cleanup period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoricalInfoMaxBytes(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -172,6 +249,31 @@ func validateBondDenom(i interface{}) error {
 	return nil
 }
 
+// validateAdditionalBondDenoms checks that the additional bond denoms are valid
+// denoms with a valid power reduction, and that none of them is listed twice or
+// is the bond denom itself.
+func validateAdditionalBondDenoms(bondDenom string, denoms []BondDenom) error {
+	seen := map[string]bool{bondDenom: true}
+	for _, d := range denoms {
+		if err := validateBondDenom(d.Denom); err != nil {
+			return fmt.Errorf("invalid additional bond denom: %w", err)
+		}
+		if seen[d.Denom] {
+			return fmt.Errorf("duplicate bond denom: %s", d.Denom)
+		}
+		seen[d.Denom] = true
+
+		if d.PowerReduction.IsNil() {
+			return fmt.Errorf("power reduction of bond denom %s cannot be nil", d.Denom)
+		}
+		if err := ValidatePowerReduction(d.PowerReduction); err != nil {
+			return fmt.Errorf("invalid power reduction of bond denom %s: %w", d.Denom, err)
+		}
+	}
+
+	return nil
+}
+
 func ValidatePowerReduction(i interface{}) error {
 	v, ok := i.(math.Int)
 	if !ok {
@@ -203,3 +305,37 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateUndelegationDustThreshold(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params set before the threshold was introduced have none
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("undelegation dust threshold cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinDelegationAmount(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params set before the minimum was introduced have none
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min delegation amount cannot be negative: %s", v)
+	}
+
+	return nil
+}
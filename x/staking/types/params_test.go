@@ -35,4 +35,21 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate additional bond denoms
+	params = types.DefaultParams()
+	params.AdditionalBondDenoms = []types.BondDenom{{Denom: "uatom", PowerReduction: math.NewInt(1000)}}
+	require.NoError(t, params.Validate())
+
+	params.AdditionalBondDenoms = []types.BondDenom{{Denom: params.BondDenom, PowerReduction: math.NewInt(1000)}}
+	require.ErrorContains(t, params.Validate(), "duplicate bond denom")
+
+	params.AdditionalBondDenoms = []types.BondDenom{{Denom: "uatom", PowerReduction: math.NewInt(1000)}, {Denom: "uatom", PowerReduction: math.NewInt(10)}}
+	require.ErrorContains(t, params.Validate(), "duplicate bond denom")
+
+	params.AdditionalBondDenoms = []types.BondDenom{{Denom: "uatom", PowerReduction: math.ZeroInt()}}
+	require.Error(t, params.Validate())
+
+	params.AdditionalBondDenoms = []types.BondDenom{{Denom: "uatom"}}
+	require.Error(t, params.Validate())
 }
@@ -14,6 +14,18 @@ const (
 	BondedPoolName    = "bonded_tokens_pool"
 )
 
+// NotBondedPoolNameOf returns the name of the not bonded pool module account
+// of an additional bond denom, e.g. "not_bonded_tokens_pool_uatom".
+func NotBondedPoolNameOf(denom string) string {
+	return NotBondedPoolName + "_" + denom
+}
+
+// BondedPoolNameOf returns the name of the bonded pool module account of an
+// additional bond denom, e.g. "bonded_tokens_pool_uatom".
+func BondedPoolNameOf(denom string) string {
+	return BondedPoolName + "_" + denom
+}
+
 // NewPool creates a new Pool instance used for queries
 func NewPool(notBonded, bonded math.Int) Pool {
 	return Pool{
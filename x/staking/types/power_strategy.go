@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PowerStrategy computes the consensus power of the bonded validators from
+// their tokens. It lets apps weigh the validators differently than
+// sdk.TokensToConsensusPower, e.g. quadratically or with a cap, without
+// forking the staking module.
+//
+// A strategy must be deterministic, as every node computes the validator set
+// with it, and monotonic: a validator with more tokens never has less power.
+// The power index of the keeper sorts the validators by their tokens, so a
+// non-monotonic strategy would not bond the validators with the most power.
+type PowerStrategy interface {
+	// Name identifies the strategy and its configuration. The keeper stores it
+	// to detect a change of strategy.
+	Name() string
+
+	// ConsensusPower returns the consensus power of a validator with the given
+	// tokens, between zero and sdk.TokensToConsensusPower(tokens, powerReduction).
+	ConsensusPower(tokens, powerReduction math.Int) int64
+}
+
+var (
+	_ PowerStrategy = LinearPowerStrategy{}
+	_ PowerStrategy = QuadraticPowerStrategy{}
+	_ PowerStrategy = CappedPowerStrategy{}
+)
+
+// LinearPowerStrategy is the default power strategy: the power of a validator
+// is its tokens divided by the power reduction.
+type LinearPowerStrategy struct{}
+
+// Name implements PowerStrategy.
+func (LinearPowerStrategy) Name() string { return "linear" }
+
+// ConsensusPower implements PowerStrategy.
+func (LinearPowerStrategy) ConsensusPower(tokens, powerReduction math.Int) int64 {
+	return sdk.TokensToConsensusPower(tokens, powerReduction)
+}
+
+// QuadraticPowerStrategy gives the validators the square root of their linear
+// power, so that doubling the power of a validator takes four times the tokens.
+type QuadraticPowerStrategy struct{}
+
+// Name implements PowerStrategy.
+func (QuadraticPowerStrategy) Name() string { return "quadratic" }
+
+// ConsensusPower implements PowerStrategy.
+func (QuadraticPowerStrategy) ConsensusPower(tokens, powerReduction math.Int) int64 {
+	power := sdk.TokensToConsensusPower(tokens, powerReduction)
+	if power <= 0 {
+		return 0
+	}
+	return new(big.Int).Sqrt(big.NewInt(power)).Int64()
+}
+
+// CappedPowerStrategy gives the validators their linear power, up to MaxPower.
+type CappedPowerStrategy struct {
+	MaxPower int64
+}
+
+// Name implements PowerStrategy.
+func (s CappedPowerStrategy) Name() string { return fmt.Sprintf("capped/%d", s.MaxPower) }
+
+// ConsensusPower implements PowerStrategy.
+func (s CappedPowerStrategy) ConsensusPower(tokens, powerReduction math.Int) int64 {
+	power := sdk.TokensToConsensusPower(tokens, powerReduction)
+	if power > s.MaxPower {
+		return s.MaxPower
+	}
+	return power
+}
+
+// powerStrategySamples are the linear powers ValidatePowerStrategy checks a
+// strategy at, in increasing order.
+var powerStrategySamples = []int64{0, 1, 2, 3, 4, 10, 99, 100, 1_000, 10_000, 1_000_000, 1_000_000_000, 1_000_000_000_000}
+
+// ValidatePowerStrategy checks that the strategy has a name and, at a set of
+// sample token amounts, that it is deterministic, that it is monotonic, and
+// that it returns a power between zero and the linear power, positive for
+// large enough amounts. It cannot prove these properties, only catch the
+// strategies which obviously violate them.
+func ValidatePowerStrategy(s PowerStrategy, powerReduction math.Int) error {
+	if s == nil {
+		return ErrInvalidPowerStrategy.Wrap("power strategy cannot be nil")
+	}
+	if len(s.Name()) == 0 {
+		return ErrInvalidPowerStrategy.Wrap("power strategy must have a name")
+	}
+
+	tokens := make([]math.Int, 0, 2*len(powerStrategySamples))
+	for _, power := range powerStrategySamples {
+		amount := sdk.TokensFromConsensusPower(power, powerReduction)
+		if power > 0 {
+			tokens = append(tokens, amount.Sub(math.OneInt()))
+		}
+		tokens = append(tokens, amount)
+	}
+
+	prev := int64(0)
+	for _, amount := range tokens {
+		power := s.ConsensusPower(amount, powerReduction)
+		if s.ConsensusPower(amount, powerReduction) != power {
+			return ErrInvalidPowerStrategy.Wrapf("%s: power of %s tokens is not deterministic", s.Name(), amount)
+		}
+		if linear := sdk.TokensToConsensusPower(amount, powerReduction); power < 0 || power > linear {
+			return ErrInvalidPowerStrategy.Wrapf("%s: power %d of %s tokens is not between 0 and %d", s.Name(), power, amount, linear)
+		}
+		if power < prev {
+			return ErrInvalidPowerStrategy.Wrapf("%s: power of %s tokens is lower than the power of fewer tokens", s.Name(), amount)
+		}
+		prev = power
+	}
+	if prev == 0 {
+		return ErrInvalidPowerStrategy.Wrapf("%s: no validator would have power", s.Name())
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// testPowerStrategy is a power strategy computing the power with a function
+type testPowerStrategy struct {
+	name  string
+	power func(linear int64) int64
+}
+
+func (s testPowerStrategy) Name() string { return s.name }
+
+func (s testPowerStrategy) ConsensusPower(tokens, powerReduction math.Int) int64 {
+	return s.power(sdk.TokensToConsensusPower(tokens, powerReduction))
+}
+
+func TestPowerStrategies(t *testing.T) {
+	tokens := sdk.TokensFromConsensusPower(150, sdk.DefaultPowerReduction)
+
+	require.Equal(t, int64(150), types.LinearPowerStrategy{}.ConsensusPower(tokens, sdk.DefaultPowerReduction))
+	require.Equal(t, int64(12), types.QuadraticPowerStrategy{}.ConsensusPower(tokens, sdk.DefaultPowerReduction))
+	require.Equal(t, int64(0), types.QuadraticPowerStrategy{}.ConsensusPower(math.ZeroInt(), sdk.DefaultPowerReduction))
+	require.Equal(t, int64(100), types.CappedPowerStrategy{MaxPower: 100}.ConsensusPower(tokens, sdk.DefaultPowerReduction))
+	require.Equal(t, int64(150), types.CappedPowerStrategy{MaxPower: 200}.ConsensusPower(tokens, sdk.DefaultPowerReduction))
+	require.Equal(t, "capped/100", types.CappedPowerStrategy{MaxPower: 100}.Name())
+}
+
+func TestValidatePowerStrategy(t *testing.T) {
+	calls := int64(0)
+	testCases := []struct {
+		name     string
+		strategy types.PowerStrategy
+		expErr   bool
+	}{
+		{"linear", types.LinearPowerStrategy{}, false},
+		{"quadratic", types.QuadraticPowerStrategy{}, false},
+		{"capped", types.CappedPowerStrategy{MaxPower: 1000}, false},
+		{"nil", nil, true},
+		{"capped at zero", types.CappedPowerStrategy{}, true},
+		{"no name", testPowerStrategy{power: func(linear int64) int64 { return linear }}, true},
+		{"negative", testPowerStrategy{name: "negative", power: func(linear int64) int64 { return -linear }}, true},
+		{"above linear", testPowerStrategy{name: "double", power: func(linear int64) int64 { return 2 * linear }}, true},
+		{"not monotonic", testPowerStrategy{name: "modulo", power: func(linear int64) int64 { return linear % 7 }}, true},
+		{"not deterministic", testPowerStrategy{name: "counter", power: func(linear int64) int64 {
+			calls++
+			return linear - calls%2*linear
+		}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidatePowerStrategy(tc.strategy, sdk.DefaultPowerReduction)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidPowerStrategy)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
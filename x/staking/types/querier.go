@@ -1,6 +1,7 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -80,3 +81,11 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QueryValidateCreateValidatorRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if req.Msg == nil {
+		return nil
+	}
+	return req.Msg.UnpackInterfaces(unpacker)
+}
@@ -108,6 +108,43 @@ func (Infraction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
+// JailReason indicates why a validator was jailed.
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines an unknown jail reason.
+	JailReason_JAIL_REASON_UNSPECIFIED JailReason = 0
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	JailReason_JAIL_REASON_DOWNTIME JailReason = 1
+	// DOUBLE_SIGN defines a validator that double-signed a block.
+	JailReason_JAIL_REASON_DOUBLE_SIGN JailReason = 2
+	// MIN_SELF_DELEGATION defines a validator whose self-delegation fell below
+	// its declared minimum.
+	JailReason_JAIL_REASON_MIN_SELF_DELEGATION JailReason = 3
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_DOWNTIME",
+	2: "JAIL_REASON_DOUBLE_SIGN",
+	3: "JAIL_REASON_MIN_SELF_DELEGATION",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED":         0,
+	"JAIL_REASON_DOWNTIME":            1,
+	"JAIL_REASON_DOUBLE_SIGN":         2,
+	"JAIL_REASON_MIN_SELF_DELEGATION": 3,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -164,6 +201,148 @@ func (m *HistoricalInfo) GetValset() []Validator {
 	return nil
 }
 
+// ValidatorSetCheckpoint is a compact record of the bonded validator set at a
+// given height, stored every validator_set_checkpoint_interval blocks. Being
+// part of the application state, it is committed to by the app hash signed by
+// the validators, so light clients can use it as an additional trust anchor
+// against long-range attacks.
+type ValidatorSetCheckpoint struct {
+	// height is the block height at which the checkpoint was taken.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the checkpoint was taken.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// validators_hash is the CometBFT hash of the validator set, it matches the
+	// next_validators_hash of the block header at height.
+	ValidatorsHash []byte `protobuf:"bytes,3,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	// total_power is the total consensus power of the validator set.
+	TotalPower int64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// validators are the validators of the set.
+	Validators []CheckpointValidator `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *ValidatorSetCheckpoint) Reset()         { *m = ValidatorSetCheckpoint{} }
+func (m *ValidatorSetCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetCheckpoint) ProtoMessage()    {}
+func (*ValidatorSetCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+func (m *ValidatorSetCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetCheckpoint.Merge(m, src)
+}
+func (m *ValidatorSetCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetCheckpoint proto.InternalMessageInfo
+
+func (m *ValidatorSetCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorSetCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorSetCheckpoint) GetValidatorsHash() []byte {
+	if m != nil {
+		return m.ValidatorsHash
+	}
+	return nil
+}
+
+func (m *ValidatorSetCheckpoint) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *ValidatorSetCheckpoint) GetValidators() []CheckpointValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// CheckpointValidator is a validator of a ValidatorSetCheckpoint.
+type CheckpointValidator struct {
+	// cons_address is the bech32-encoded consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *CheckpointValidator) Reset()         { *m = CheckpointValidator{} }
+func (m *CheckpointValidator) String() string { return proto.CompactTextString(m) }
+func (*CheckpointValidator) ProtoMessage()    {}
+func (*CheckpointValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+func (m *CheckpointValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointValidator.Merge(m, src)
+}
+func (m *CheckpointValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointValidator proto.InternalMessageInfo
+
+func (m *CheckpointValidator) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *CheckpointValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 // CommissionRates defines the initial commission rates to be used for creating
 // a validator.
 type CommissionRates struct {
@@ -179,7 +358,7 @@ func (m *CommissionRates) Reset()         { *m = CommissionRates{} }
 func (m *CommissionRates) String() string { return proto.CompactTextString(m) }
 func (*CommissionRates) ProtoMessage()    {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +399,7 @@ func (m *Commission) Reset()         { *m = Commission{} }
 func (m *Commission) String() string { return proto.CompactTextString(m) }
 func (*Commission) ProtoMessage()    {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{2}
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +453,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{3}
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +560,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +598,7 @@ func (m *ValAddresses) Reset()         { *m = ValAddresses{} }
 func (m *ValAddresses) String() string { return proto.CompactTextString(m) }
 func (*ValAddresses) ProtoMessage()    {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +646,7 @@ func (m *DVPair) Reset()         { *m = DVPair{} }
 func (m *DVPair) String() string { return proto.CompactTextString(m) }
 func (*DVPair) ProtoMessage()    {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +684,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +734,7 @@ func (m *DVVTriplet) Reset()         { *m = DVVTriplet{} }
 func (m *DVVTriplet) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplet) ProtoMessage()    {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +772,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +824,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,6 +853,187 @@ func (m *Delegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
+// DelegationLabel defines a short label attached by a delegator to one of its
+// delegations. Labels are stored apart from the delegations and play no role in
+// the staking logic.
+type DelegationLabel struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// label is the label of the delegation.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *DelegationLabel) Reset()         { *m = DelegationLabel{} }
+func (m *DelegationLabel) String() string { return proto.CompactTextString(m) }
+func (*DelegationLabel) ProtoMessage()    {}
+func (*DelegationLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
+}
+func (m *DelegationLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationLabel.Merge(m, src)
+}
+func (m *DelegationLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationLabel proto.InternalMessageInfo
+
+// AutoRedelegation defines the preference of a delegator to have its stake
+// redelegated automatically to a fallback validator when one of its validators
+// stays jailed for longer than a given duration.
+type AutoRedelegation struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// fallback_validator_address is the bech32-encoded address of the validator
+	// receiving the redelegated stake.
+	FallbackValidatorAddress string `protobuf:"bytes,2,opt,name=fallback_validator_address,json=fallbackValidatorAddress,proto3" json:"fallback_validator_address,omitempty"`
+	// jail_duration is how long a validator must stay jailed before the stake
+	// delegated to it is redelegated.
+	JailDuration time.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *AutoRedelegation) Reset()         { *m = AutoRedelegation{} }
+func (m *AutoRedelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoRedelegation) ProtoMessage()    {}
+func (*AutoRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
+}
+func (m *AutoRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRedelegation.Merge(m, src)
+}
+func (m *AutoRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRedelegation proto.InternalMessageInfo
+
+// JailRecord records a single jailing of a validator together with the reason
+// it was jailed for.
+type JailRecord struct {
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reason defines why the validator was jailed.
+	Reason JailReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.JailReason" json:"reason,omitempty"`
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
+}
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+// SelfDelegationDeficit records a validator whose self-delegation fell below
+// its min_self_delegation, and the time at which it is jailed unless its
+// self-delegation is topped up by then.
+type SelfDelegationDeficit struct {
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// jail_time is the time at which the validator is jailed.
+	JailTime time.Time `protobuf:"bytes,2,opt,name=jail_time,json=jailTime,proto3,stdtime" json:"jail_time"`
+}
+
+func (m *SelfDelegationDeficit) Reset()         { *m = SelfDelegationDeficit{} }
+func (m *SelfDelegationDeficit) String() string { return proto.CompactTextString(m) }
+func (*SelfDelegationDeficit) ProtoMessage()    {}
+func (*SelfDelegationDeficit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *SelfDelegationDeficit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelfDelegationDeficit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelfDelegationDeficit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SelfDelegationDeficit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfDelegationDeficit.Merge(m, src)
+}
+func (m *SelfDelegationDeficit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelfDelegationDeficit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfDelegationDeficit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfDelegationDeficit proto.InternalMessageInfo
+
 // UnbondingDelegation stores all of a single delegator's unbonding bonds
 // for a single validator in an time-ordered list.
 type UnbondingDelegation struct {
@@ -689,7 +1049,7 @@ func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
 func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegation) ProtoMessage()    {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +1098,7 @@ func (m *UnbondingDelegationEntry) Reset()         { *m = UnbondingDelegationEnt
 func (m *UnbondingDelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationEntry) ProtoMessage()    {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +1175,7 @@ func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
 func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntry) ProtoMessage()    {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1249,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,19 +1286,46 @@ type Params struct {
 	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
 	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
 	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
+	// historical_entries is the maximum number of historical entries to persist.
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// undelegation_dust_threshold is the token amount below which the remainder of a delegation is undelegated as
+	// well by Msg/UndelegateTokens, so that no dust delegation is left behind. Zero disables it.
+	UndelegationDustThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=undelegation_dust_threshold,json=undelegationDustThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undelegation_dust_threshold"`
+	// historical_info_max_bytes is the maximum number of bytes, keys included, the persisted historical entries may
+	// take. The oldest entries are pruned first, the entry of the current block is always kept. Zero disables it.
+	HistoricalInfoMaxBytes uint64 `protobuf:"varint,8,opt,name=historical_info_max_bytes,json=historicalInfoMaxBytes,proto3" json:"historical_info_max_bytes,omitempty"`
+	// validator_set_checkpoint_interval is the number of blocks between two validator set checkpoints. Zero disables
+	// them.
+	ValidatorSetCheckpointInterval uint64 `protobuf:"varint,9,opt,name=validator_set_checkpoint_interval,json=validatorSetCheckpointInterval,proto3" json:"validator_set_checkpoint_interval,omitempty"`
+	// additional_bond_denoms are the bondable coin denominations other than bond_denom, each with its own power
+	// reduction. They can only be set if the app enabled multi-asset staking in the keeper.
+	AdditionalBondDenoms []BondDenom `protobuf:"bytes,10,rep,name=additional_bond_denoms,json=additionalBondDenoms,proto3" json:"additional_bond_denoms"`
+	// min_self_delegation_grace_period is the time a validator whose self-delegation fell below its
+	// min_self_delegation is given to top it up before being jailed. Zero jails the validator immediately.
+	MinSelfDelegationGracePeriod time.Duration `protobuf:"bytes,11,opt,name=min_self_delegation_grace_period,json=minSelfDelegationGracePeriod,proto3,stdduration" json:"min_self_delegation_grace_period"`
+	// min_delegation_amount is the minimum token amount a delegation must be worth after a delegation to it, and that
+	// must be left in it after an undelegation unless it is fully undelegated. Zero disables it.
+	MinDelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount"`
+	// inactive_validator_cleanup_period is the time a validator must have been unbonded with no delegator but its operator
+	// before its self-delegation is force-undelegated and it is removed, at the end of a block. Zero disables the cleanup.
+	InactiveValidatorCleanupPeriod time.Duration `protobuf:"bytes,13,opt,name=inactive_validator_cleanup_period,json=inactiveValidatorCleanupPeriod,proto3,stdduration" json:"inactive_validator_cleanup_period"`
+	// inactive_validator_cleanup_dry_run makes the cleanup only report the validators it would remove, with events,
+	// instead of removing them.
+	InactiveValidatorCleanupDryRun bool `protobuf:"varint,14,opt,name=inactive_validator_cleanup_dry_run,json=inactiveValidatorCleanupDryRun,proto3" json:"inactive_validator_cleanup_dry_run,omitempty"`
+	// reject_tombstoned_delegations rejects delegations and redelegations to tombstoned validators, which can never be
+	// unjailed and therefore never earn rewards. It requires the slashing keeper to be set in the staking keeper.
+	RejectTombstonedDelegations bool `protobuf:"varint,15,opt,name=reject_tombstoned_delegations,json=rejectTombstonedDelegations,proto3" json:"reject_tombstoned_delegations,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,51 +1389,151 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
-// DelegationResponse is equivalent to Delegation except that it contains a
-// balance in addition to shares which is more suitable for client responses.
-type DelegationResponse struct {
-	Delegation Delegation  `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
-	Balance    types2.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+func (m *Params) GetHistoricalInfoMaxBytes() uint64 {
+	if m != nil {
+		return m.HistoricalInfoMaxBytes
+	}
+	return 0
 }
 
-func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
-func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegationResponse) ProtoMessage()    {}
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
-}
-func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Params) GetValidatorSetCheckpointInterval() uint64 {
+	if m != nil {
+		return m.ValidatorSetCheckpointInterval
 	}
+	return 0
 }
-func (m *DelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationResponse.Merge(m, src)
-}
-func (m *DelegationResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *DelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
 
-func (m *DelegationResponse) GetDelegation() Delegation {
+func (m *Params) GetAdditionalBondDenoms() []BondDenom {
 	if m != nil {
-		return m.Delegation
+		return m.AdditionalBondDenoms
 	}
-	return Delegation{}
+	return nil
+}
+
+func (m *Params) GetMinSelfDelegationGracePeriod() time.Duration {
+	if m != nil {
+		return m.MinSelfDelegationGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetInactiveValidatorCleanupPeriod() time.Duration {
+	if m != nil {
+		return m.InactiveValidatorCleanupPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetInactiveValidatorCleanupDryRun() bool {
+	if m != nil {
+		return m.InactiveValidatorCleanupDryRun
+	}
+	return false
+}
+
+func (m *Params) GetRejectTombstonedDelegations() bool {
+	if m != nil {
+		return m.RejectTombstonedDelegations
+	}
+	return false
+}
+
+// BondDenom defines a bondable coin denomination other than the bond_denom param, and the amount of it required for
+// 1 unit of consensus-engine power.
+type BondDenom struct {
+	// denom is the coin denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// power_reduction is the amount of denom required for 1 unit of consensus-engine power.
+	PowerReduction github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=power_reduction,json=powerReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power_reduction"`
+}
+
+func (m *BondDenom) Reset()         { *m = BondDenom{} }
+func (m *BondDenom) String() string { return proto.CompactTextString(m) }
+func (*BondDenom) ProtoMessage()    {}
+func (*BondDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *BondDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenom.Merge(m, src)
+}
+func (m *BondDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenom proto.InternalMessageInfo
+
+func (m *BondDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DelegationResponse is equivalent to Delegation except that it contains a
+// balance in addition to shares which is more suitable for client responses.
+type DelegationResponse struct {
+	Delegation Delegation  `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	Balance    types2.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// label is the label attached to the delegation by the delegator, if any.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
+func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationResponse) ProtoMessage()    {}
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationResponse.Merge(m, src)
+}
+func (m *DelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+
+func (m *DelegationResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return Delegation{}
 }
 
 func (m *DelegationResponse) GetBalance() types2.Coin {
@@ -1056,6 +1543,13 @@ func (m *DelegationResponse) GetBalance() types2.Coin {
 	return types2.Coin{}
 }
 
+func (m *DelegationResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 // RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
 // contains a balance in addition to shares which is more suitable for client
 // responses.
@@ -1068,7 +1562,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1610,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1664,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1703,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{27}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1742,10 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.JailReason", JailReason_name, JailReason_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*ValidatorSetCheckpoint)(nil), "cosmos.staking.v1beta1.ValidatorSetCheckpoint")
+	proto.RegisterType((*CheckpointValidator)(nil), "cosmos.staking.v1beta1.CheckpointValidator")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
@@ -1259,11 +1756,16 @@ func init() {
 	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
 	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
 	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*DelegationLabel)(nil), "cosmos.staking.v1beta1.DelegationLabel")
+	proto.RegisterType((*AutoRedelegation)(nil), "cosmos.staking.v1beta1.AutoRedelegation")
+	proto.RegisterType((*JailRecord)(nil), "cosmos.staking.v1beta1.JailRecord")
+	proto.RegisterType((*SelfDelegationDeficit)(nil), "cosmos.staking.v1beta1.SelfDelegationDeficit")
 	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*BondDenom)(nil), "cosmos.staking.v1beta1.BondDenom")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1276,125 +1778,166 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+	// 2531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xd6, 0x92, 0xb4, 0x1e, 0x3f, 0x25, 0x91, 0x1a, 0xcb, 0xf6, 0x9a, 0x4e, 0x24, 0x99, 0x49,
+	0x13, 0xc7, 0x8d, 0xa9, 0xc6, 0x05, 0x0a, 0x54, 0x4d, 0x5b, 0x88, 0x22, 0x6d, 0xd3, 0xb5, 0x65,
+	0x61, 0x29, 0xa9, 0x4d, 0x1f, 0x58, 0x0c, 0x77, 0x47, 0xe4, 0x46, 0xcb, 0x59, 0x76, 0x67, 0xe8,
+	0x98, 0xc7, 0xbe, 0x80, 0xc0, 0x87, 0x36, 0x45, 0x2e, 0xbd, 0x18, 0x30, 0xd0, 0x4b, 0x8e, 0x39,
+	0x04, 0x0d, 0x8a, 0xa2, 0x28, 0x7a, 0x4b, 0xdb, 0x4b, 0x90, 0x53, 0xd1, 0x83, 0x5b, 0x24, 0x87,
+	0x04, 0x3d, 0x15, 0xbd, 0xb5, 0xa7, 0x62, 0x66, 0x67, 0x1f, 0x7c, 0xc9, 0x96, 0xca, 0x14, 0x01,
+	0x7a, 0x91, 0x38, 0x33, 0xff, 0xff, 0xfd, 0xf3, 0x3f, 0x67, 0xe6, 0x5f, 0x78, 0xd6, 0xf2, 0x58,
+	0xdb, 0x63, 0xeb, 0x8c, 0xe3, 0x43, 0x87, 0x36, 0xd7, 0xef, 0xbe, 0xd4, 0x20, 0x1c, 0xbf, 0x14,
+	0x8e, 0x4b, 0x1d, 0xdf, 0xe3, 0x1e, 0x3a, 0x1b, 0x50, 0x95, 0xc2, 0x59, 0x45, 0x55, 0x58, 0x6e,
+	0x7a, 0x4d, 0x4f, 0x92, 0xac, 0x8b, 0x5f, 0x01, 0x75, 0xe1, 0x7c, 0xd3, 0xf3, 0x9a, 0x2e, 0x59,
+	0x97, 0xa3, 0x46, 0xf7, 0x60, 0x1d, 0xd3, 0x9e, 0x5a, 0x5a, 0x19, 0x5c, 0xb2, 0xbb, 0x3e, 0xe6,
+	0x8e, 0x47, 0xd5, 0xfa, 0xea, 0xe0, 0x3a, 0x77, 0xda, 0x84, 0x71, 0xdc, 0xee, 0x84, 0xd8, 0xc1,
+	0x4e, 0xcc, 0x40, 0xa8, 0xda, 0x96, 0xc2, 0x56, 0xaa, 0x34, 0x30, 0x23, 0x91, 0x1e, 0x96, 0xe7,
+	0x84, 0xd8, 0x4b, 0xb8, 0xed, 0x50, 0x6f, 0x5d, 0xfe, 0x55, 0x53, 0x4f, 0x71, 0x42, 0x6d, 0xe2,
+	0xb7, 0x1d, 0xca, 0xd7, 0x79, 0xaf, 0x43, 0x58, 0xf0, 0x57, 0xad, 0x5e, 0x48, 0xac, 0xe2, 0x86,
+	0xe5, 0x24, 0x17, 0x8b, 0x6f, 0x6a, 0xb0, 0x78, 0xc3, 0x61, 0xdc, 0xf3, 0x1d, 0x0b, 0xbb, 0x35,
+	0x7a, 0xe0, 0xa1, 0xaf, 0xc0, 0x74, 0x8b, 0x60, 0x9b, 0xf8, 0xba, 0xb6, 0xa6, 0x5d, 0xca, 0x5e,
+	0xd5, 0x4b, 0x31, 0x40, 0x29, 0xe0, 0xbd, 0x21, 0xd7, 0xcb, 0x73, 0xef, 0x3d, 0x5a, 0x9d, 0x7a,
+	0xeb, 0xe3, 0xb7, 0x2f, 0x6b, 0x86, 0x62, 0x41, 0x15, 0x98, 0xbe, 0x8b, 0x5d, 0x46, 0xb8, 0x9e,
+	0x5a, 0x4b, 0x5f, 0xca, 0x5e, 0xbd, 0x58, 0x1a, 0x6d, 0xf3, 0xd2, 0x3e, 0x76, 0x1d, 0x1b, 0x73,
+	0xaf, 0x1f, 0x25, 0xe0, 0x2d, 0xfe, 0x3c, 0x05, 0x67, 0x23, 0x82, 0x3a, 0xe1, 0x5b, 0x2d, 0x62,
+	0x1d, 0x76, 0x3c, 0x87, 0x72, 0x74, 0x56, 0xec, 0xce, 0x69, 0xb6, 0xb8, 0xdc, 0x5d, 0xda, 0x50,
+	0x23, 0xf4, 0x55, 0xc8, 0x08, 0x23, 0xeb, 0x29, 0xb9, 0xe7, 0x42, 0x29, 0xf0, 0x40, 0x29, 0xf4,
+	0x40, 0x69, 0x37, 0xf4, 0x40, 0x79, 0x41, 0xc8, 0x7b, 0xe3, 0xaf, 0xab, 0x5a, 0x20, 0x53, 0xb2,
+	0xa1, 0xe7, 0x21, 0x77, 0x37, 0x14, 0xc8, 0xcc, 0x16, 0x66, 0x2d, 0x3d, 0xbd, 0xa6, 0x5d, 0x9a,
+	0x37, 0x16, 0xe3, 0xe9, 0x1b, 0x98, 0xb5, 0xd0, 0x2a, 0x64, 0xb9, 0xc7, 0xb1, 0x6b, 0x76, 0xbc,
+	0xd7, 0x88, 0xaf, 0x67, 0xe4, 0x26, 0x40, 0x4e, 0xed, 0x88, 0x19, 0xb4, 0x0f, 0x10, 0xb3, 0xe8,
+	0xa7, 0xa4, 0x15, 0x3e, 0x3f, 0xce, 0x0a, 0xb1, 0x62, 0x23, 0xed, 0x91, 0x40, 0x2a, 0x7e, 0x1f,
+	0x4e, 0x8f, 0xa0, 0x46, 0x15, 0x98, 0xb7, 0x3c, 0xca, 0x4c, 0x6c, 0xdb, 0x3e, 0x61, 0x4c, 0x5a,
+	0x65, 0xae, 0x7c, 0xf1, 0x83, 0x77, 0xae, 0x3c, 0xad, 0x64, 0x6e, 0x79, 0x94, 0x11, 0xca, 0xba,
+	0x6c, 0x33, 0x20, 0xa9, 0x73, 0xdf, 0xa1, 0x4d, 0x23, 0x2b, 0xd8, 0xd4, 0x14, 0x5a, 0x86, 0x53,
+	0x81, 0x3e, 0x29, 0xa9, 0x4f, 0x30, 0x28, 0xbe, 0x9b, 0x82, 0xdc, 0x96, 0xd7, 0x6e, 0x3b, 0x8c,
+	0x39, 0x1e, 0x35, 0x30, 0x27, 0x0c, 0xed, 0x41, 0xc6, 0xc7, 0x9c, 0x28, 0x39, 0x9b, 0x62, 0xaf,
+	0x7f, 0x79, 0xb4, 0xfa, 0x5c, 0xd3, 0xe1, 0xad, 0x6e, 0xa3, 0x64, 0x79, 0x6d, 0x15, 0xcd, 0xea,
+	0xdf, 0x15, 0x66, 0x1f, 0xaa, 0x80, 0xab, 0x10, 0xeb, 0x83, 0x77, 0xae, 0x80, 0xda, 0x55, 0x85,
+	0x58, 0xca, 0xfe, 0x02, 0x0e, 0x7d, 0x17, 0x66, 0xdb, 0xf8, 0x9e, 0x29, 0xa1, 0x53, 0x93, 0x82,
+	0x9e, 0x69, 0xe3, 0x7b, 0x62, 0xd7, 0xc8, 0x81, 0x9c, 0x40, 0xb7, 0x5a, 0x98, 0x36, 0x49, 0x20,
+	0x24, 0x3d, 0x29, 0x21, 0x0b, 0x6d, 0x7c, 0x6f, 0x4b, 0x02, 0x0b, 0x51, 0x1b, 0x99, 0x4f, 0x1e,
+	0xae, 0x6a, 0xc5, 0xdf, 0x6b, 0x00, 0xb1, 0xe5, 0x10, 0x86, 0xbc, 0x15, 0x8d, 0xa4, 0x7c, 0xa6,
+	0x92, 0xeb, 0xf9, 0xb1, 0x91, 0xd1, 0x6f, 0xf7, 0x20, 0x6a, 0xdf, 0x7f, 0x14, 0x46, 0x6d, 0xce,
+	0x1a, 0xf0, 0xcb, 0x4d, 0xc8, 0x76, 0x3b, 0x36, 0xe6, 0xc4, 0x3c, 0x59, 0x1a, 0x40, 0xc0, 0x2d,
+	0xd6, 0x95, 0x0e, 0x6f, 0x69, 0x90, 0xad, 0x10, 0x66, 0xf9, 0x4e, 0x47, 0x94, 0x36, 0xa4, 0xc3,
+	0x4c, 0xdb, 0xa3, 0xce, 0xa1, 0x2a, 0x0c, 0x73, 0x46, 0x38, 0x44, 0x05, 0x98, 0x75, 0x6c, 0x42,
+	0xb9, 0xc3, 0x7b, 0x81, 0xf3, 0x8c, 0x68, 0x2c, 0xb8, 0x5e, 0x23, 0x0d, 0xe6, 0x84, 0x26, 0x37,
+	0xc2, 0x21, 0x7a, 0x01, 0xf2, 0x8c, 0x58, 0x5d, 0xdf, 0xe1, 0x3d, 0xd3, 0xf2, 0x28, 0xc7, 0x16,
+	0x97, 0xe9, 0x34, 0x67, 0xe4, 0xc2, 0xf9, 0xad, 0x60, 0x5a, 0x80, 0xd8, 0x84, 0x63, 0xc7, 0x15,
+	0x09, 0x25, 0x41, 0xd4, 0x50, 0x6d, 0xf5, 0xdd, 0x19, 0x98, 0x8b, 0x53, 0x62, 0x0b, 0xf2, 0x5e,
+	0x87, 0xf8, 0xe2, 0xf7, 0x40, 0x5a, 0xe8, 0x1f, 0xbc, 0x73, 0x65, 0x59, 0x19, 0xbc, 0x3f, 0x1b,
+	0x72, 0x21, 0x47, 0x98, 0x11, 0xaf, 0x08, 0x97, 0xa9, 0xc4, 0x31, 0x3b, 0xdd, 0xc6, 0x21, 0xe9,
+	0x29, 0xa3, 0x2e, 0x0f, 0x19, 0x75, 0x93, 0xf6, 0xca, 0xfa, 0x1f, 0x63, 0x68, 0xcb, 0xef, 0x75,
+	0xb8, 0x57, 0xda, 0xe9, 0x36, 0xbe, 0x41, 0x7a, 0x46, 0x2e, 0xc2, 0xd9, 0x91, 0x30, 0xa2, 0x84,
+	0xbd, 0x8a, 0x1d, 0x97, 0xd8, 0xd2, 0x22, 0xb3, 0x86, 0x1a, 0xa1, 0x0d, 0x98, 0x66, 0x1c, 0xf3,
+	0x2e, 0x93, 0x66, 0x58, 0xbc, 0x5a, 0x1c, 0x17, 0x1b, 0x65, 0x8f, 0xda, 0x75, 0x49, 0x69, 0x28,
+	0x0e, 0xb4, 0x0b, 0xd3, 0xdc, 0x3b, 0x24, 0x54, 0x19, 0xa8, 0xfc, 0xf2, 0x31, 0x02, 0xbb, 0x46,
+	0x79, 0x22, 0xb0, 0x6b, 0x94, 0x1b, 0x0a, 0x0b, 0x35, 0x21, 0x6f, 0x13, 0x97, 0x34, 0xa5, 0x29,
+	0x59, 0x0b, 0xfb, 0x84, 0xe9, 0xd3, 0xc7, 0xc6, 0x1f, 0x4a, 0x1c, 0x23, 0x17, 0xa1, 0xd6, 0x25,
+	0x28, 0xda, 0x81, 0xac, 0x1d, 0x87, 0x9a, 0x3e, 0x23, 0x0d, 0xfd, 0xcc, 0x38, 0xfd, 0x13, 0x51,
+	0x99, 0xac, 0x96, 0x49, 0x08, 0x11, 0x5d, 0x5d, 0xda, 0xf0, 0xa8, 0xed, 0xd0, 0xa6, 0xa9, 0x4e,
+	0x8c, 0x59, 0x59, 0xdc, 0x72, 0xd1, 0xfc, 0x0d, 0x39, 0x8d, 0x76, 0x60, 0x31, 0x26, 0x95, 0xd9,
+	0x33, 0x77, 0xdc, 0xec, 0x59, 0x88, 0x00, 0x04, 0x09, 0xba, 0x0d, 0x10, 0xe7, 0xa7, 0x0e, 0x12,
+	0xad, 0xf8, 0xf8, 0x4c, 0xef, 0x2b, 0xfd, 0x31, 0x00, 0x72, 0xe1, 0x74, 0xdb, 0xa1, 0x26, 0x23,
+	0xee, 0x81, 0xa9, 0x2c, 0x27, 0x70, 0xb3, 0x13, 0xf0, 0xf4, 0x52, 0xdb, 0xa1, 0x75, 0xe2, 0x1e,
+	0x54, 0x22, 0x58, 0xf4, 0x32, 0x5c, 0x88, 0xcd, 0xe1, 0x51, 0xb3, 0xe5, 0xb9, 0xb6, 0xe9, 0x93,
+	0x03, 0xd3, 0xf2, 0xba, 0x94, 0xeb, 0xf3, 0xd2, 0x88, 0xe7, 0x22, 0x92, 0x3b, 0xf4, 0x86, 0xe7,
+	0xda, 0x06, 0x39, 0xd8, 0x12, 0xcb, 0xe8, 0x19, 0x88, 0x6d, 0x61, 0x3a, 0x36, 0xd3, 0x17, 0xd6,
+	0xd2, 0x97, 0x32, 0xc6, 0x7c, 0x34, 0x59, 0xb3, 0xd9, 0xc6, 0xec, 0xeb, 0x0f, 0x57, 0xa7, 0x3e,
+	0x79, 0xb8, 0x3a, 0x55, 0xbc, 0x06, 0xf3, 0xfb, 0xd8, 0x55, 0x49, 0x47, 0x18, 0xfa, 0x12, 0xcc,
+	0xe1, 0x70, 0xa0, 0x6b, 0x6b, 0xe9, 0x23, 0x93, 0x36, 0x26, 0x2d, 0x3e, 0xd4, 0x60, 0xba, 0xb2,
+	0xbf, 0x83, 0x1d, 0x1f, 0x55, 0x61, 0x29, 0x0e, 0xda, 0x27, 0xcd, 0xff, 0x38, 0xce, 0xd5, 0xbc,
+	0x80, 0x89, 0x4e, 0xdf, 0x08, 0x26, 0xf5, 0x38, 0x98, 0x88, 0x45, 0xcd, 0x27, 0x54, 0xbd, 0x09,
+	0x33, 0xc1, 0x0e, 0x19, 0xfa, 0x3a, 0x9c, 0xea, 0x88, 0x1f, 0x52, 0xc3, 0xec, 0xd5, 0x95, 0xb1,
+	0x81, 0x2e, 0xe9, 0x93, 0x61, 0x11, 0xf0, 0x15, 0xff, 0xa5, 0x01, 0x54, 0xf6, 0xf7, 0x77, 0x7d,
+	0xa7, 0xe3, 0x12, 0x3e, 0x29, 0x95, 0x6f, 0xc1, 0x99, 0x58, 0x65, 0xe6, 0x5b, 0x4f, 0xac, 0xf6,
+	0xe9, 0x88, 0xad, 0xee, 0x5b, 0x23, 0xd1, 0x6c, 0xc6, 0x23, 0xb4, 0xf4, 0x13, 0xa3, 0x55, 0x18,
+	0x1f, 0xb6, 0xe3, 0xb7, 0x20, 0x1b, 0xab, 0xce, 0x50, 0x0d, 0x66, 0xb9, 0xfa, 0xad, 0xcc, 0x59,
+	0x1c, 0x6f, 0xce, 0x90, 0x2d, 0x69, 0xd2, 0x88, 0xbd, 0xf8, 0x6f, 0x61, 0xd5, 0x38, 0x11, 0x3e,
+	0x53, 0x81, 0x24, 0x2a, 0xbc, 0xaa, 0xc0, 0xe9, 0x09, 0x54, 0x60, 0x85, 0x95, 0x30, 0xeb, 0x7b,
+	0x1a, 0xe4, 0x62, 0xe5, 0x6f, 0xe1, 0x06, 0x71, 0x27, 0x65, 0x81, 0xed, 0xf1, 0x16, 0x48, 0x5e,
+	0x54, 0xf7, 0x07, 0x54, 0x1e, 0x6b, 0x8a, 0x65, 0x38, 0xe5, 0x8a, 0xfd, 0xa9, 0x1b, 0x45, 0x30,
+	0x48, 0xa8, 0xf2, 0x66, 0x0a, 0xf2, 0x9b, 0x5d, 0xee, 0x19, 0xc4, 0x9e, 0xb8, 0x37, 0x4d, 0x28,
+	0x1c, 0x60, 0xd7, 0x6d, 0x60, 0xeb, 0xd0, 0xfc, 0x2f, 0x94, 0xd2, 0x43, 0x90, 0xc1, 0x75, 0x74,
+	0x1b, 0x16, 0xc4, 0x7d, 0xc0, 0x0c, 0x9f, 0x94, 0x52, 0xc9, 0xec, 0xd5, 0xf3, 0x43, 0x87, 0x51,
+	0x45, 0x11, 0x04, 0x67, 0xd1, 0x2f, 0xa2, 0xb3, 0x68, 0x5e, 0xb0, 0x87, 0x8b, 0x09, 0xab, 0x88,
+	0x9a, 0x71, 0x13, 0x3b, 0xae, 0x41, 0x2c, 0xcf, 0xb7, 0x47, 0x3b, 0x45, 0x3b, 0xb9, 0x53, 0x36,
+	0x60, 0xda, 0x27, 0x98, 0x79, 0x54, 0x4f, 0x1d, 0x7d, 0x7b, 0x09, 0xf6, 0x20, 0x28, 0x0d, 0xc5,
+	0x91, 0x78, 0xd4, 0xa5, 0x47, 0x3e, 0xea, 0x32, 0x27, 0x7a, 0xd4, 0x25, 0x74, 0xff, 0xb5, 0x06,
+	0x67, 0xfa, 0x8f, 0xb9, 0x0a, 0x39, 0x70, 0x2c, 0x87, 0x4f, 0xdc, 0x0c, 0xd7, 0x60, 0x4e, 0xba,
+	0xef, 0x64, 0xb7, 0xf0, 0x59, 0xc1, 0xbb, 0xdb, 0xbf, 0xf7, 0x1f, 0xa7, 0xe0, 0xf4, 0x5e, 0x78,
+	0x7a, 0x7e, 0x66, 0xcb, 0xd3, 0x1e, 0xcc, 0x10, 0xca, 0x7d, 0x47, 0xd6, 0x27, 0x51, 0x85, 0xbf,
+	0x30, 0xce, 0xff, 0x23, 0x74, 0xa9, 0x52, 0xee, 0xf7, 0x92, 0x35, 0x39, 0xc4, 0x4a, 0x98, 0xe1,
+	0xb7, 0x69, 0xd0, 0xc7, 0xb1, 0x8a, 0xe7, 0xbb, 0xe5, 0x13, 0x39, 0x61, 0xf6, 0xb5, 0x07, 0x16,
+	0xc3, 0x69, 0x75, 0xd7, 0x33, 0x40, 0xbc, 0x9c, 0x44, 0xb9, 0x17, 0xa4, 0x27, 0x73, 0xd2, 0x62,
+	0x8c, 0x20, 0x68, 0x10, 0x81, 0x9c, 0x43, 0x1d, 0xee, 0x60, 0xd7, 0x6c, 0x60, 0x17, 0x53, 0x8b,
+	0xe8, 0xe9, 0x09, 0x5c, 0xcd, 0x16, 0x15, 0x68, 0x39, 0xc0, 0x44, 0xfb, 0x30, 0x13, 0xc2, 0x67,
+	0x26, 0x00, 0x1f, 0x82, 0xa1, 0x8b, 0x30, 0x9f, 0xbc, 0xb1, 0xc9, 0x07, 0x44, 0xc6, 0xc8, 0x26,
+	0x2e, 0x6c, 0x8f, 0xbb, 0x12, 0x4e, 0x1f, 0x79, 0x25, 0x54, 0x6f, 0xb4, 0xdf, 0xa4, 0x61, 0x29,
+	0x59, 0x90, 0xff, 0x7f, 0x1c, 0xf7, 0x1d, 0x80, 0xe0, 0xb4, 0x15, 0xb7, 0x20, 0x3d, 0x33, 0x81,
+	0xd3, 0x7b, 0x2e, 0xc0, 0xab, 0x30, 0xfe, 0xbf, 0xf2, 0xde, 0x9f, 0x52, 0x30, 0xff, 0x69, 0x1c,
+	0xa7, 0x9f, 0xe1, 0x2b, 0x27, 0xda, 0x8e, 0x4b, 0x5a, 0x46, 0x96, 0xb4, 0x17, 0xc6, 0x95, 0xb4,
+	0xa1, 0xb8, 0x7e, 0x4c, 0x2d, 0xfb, 0x11, 0xc0, 0xf4, 0x0e, 0xf6, 0x71, 0x9b, 0xa1, 0x3b, 0x43,
+	0x8f, 0x4f, 0xed, 0x98, 0xe7, 0xfd, 0xc0, 0xdb, 0xf3, 0x73, 0xb0, 0x28, 0x7a, 0x5d, 0x89, 0x1e,
+	0xa4, 0x30, 0xe5, 0x82, 0xec, 0x53, 0x45, 0xa7, 0x18, 0x13, 0x7d, 0x4c, 0x41, 0x16, 0xd7, 0x6c,
+	0x41, 0x03, 0x6d, 0x7c, 0xaf, 0x1a, 0xcc, 0xa0, 0x2b, 0x80, 0x5a, 0x51, 0x63, 0xd8, 0x8c, 0x0d,
+	0x21, 0xe8, 0x96, 0xe2, 0x95, 0x90, 0xfc, 0x69, 0x00, 0xb1, 0x0b, 0xd3, 0x26, 0xd4, 0x6b, 0xab,
+	0x2e, 0xcd, 0x9c, 0x98, 0xa9, 0x88, 0x09, 0xf4, 0x33, 0x2d, 0x78, 0xc3, 0x0e, 0xb4, 0xc1, 0x54,
+	0x37, 0xc1, 0x3c, 0x5e, 0x36, 0xfc, 0xf3, 0xd1, 0x6a, 0xa1, 0x87, 0xdb, 0xee, 0x46, 0x71, 0x04,
+	0x64, 0x71, 0x54, 0x93, 0x4e, 0x3c, 0x73, 0xfb, 0x3b, 0x6a, 0xe8, 0x07, 0x9a, 0x48, 0x8b, 0xd8,
+	0x6f, 0xa6, 0xdd, 0x65, 0xdc, 0xe4, 0x2d, 0x9f, 0x30, 0x91, 0x20, 0xfa, 0xcc, 0xb1, 0x1b, 0x84,
+	0x43, 0x95, 0x20, 0x90, 0x7d, 0x3e, 0x29, 0xa5, 0xd2, 0x65, 0x7c, 0x37, 0x94, 0x81, 0xbe, 0x0c,
+	0xe7, 0x13, 0x36, 0x76, 0xe8, 0x81, 0x67, 0x0a, 0xa7, 0x34, 0x7a, 0x9c, 0x30, 0xd9, 0xad, 0xc8,
+	0x18, 0x67, 0x5b, 0x7d, 0xdd, 0xf9, 0xdb, 0xf8, 0x5e, 0x59, 0xac, 0xa2, 0x1a, 0x5c, 0x4c, 0x24,
+	0x0e, 0xe1, 0xa6, 0x15, 0x35, 0x87, 0x4d, 0x87, 0x72, 0xe2, 0xdf, 0xc5, 0xae, 0xec, 0x63, 0x64,
+	0x8c, 0x95, 0xbb, 0x23, 0x5b, 0xe9, 0x35, 0x45, 0x85, 0x1a, 0x70, 0x16, 0xdb, 0xb6, 0x23, 0xb6,
+	0x27, 0x2a, 0x61, 0xe4, 0x45, 0xa6, 0xc3, 0xd1, 0x3d, 0xfc, 0x72, 0xe8, 0xde, 0x64, 0xb8, 0x2f,
+	0xc7, 0x58, 0xd1, 0x3a, 0x43, 0x1d, 0x58, 0x1b, 0xd1, 0xc2, 0x30, 0x9b, 0x3e, 0xb6, 0x88, 0xd9,
+	0x21, 0xbe, 0xe3, 0xd9, 0x7a, 0xf6, 0x98, 0x81, 0xff, 0xd4, 0x50, 0xef, 0xe2, 0xba, 0x80, 0xdb,
+	0x91, 0x68, 0xa8, 0x0b, 0x67, 0x84, 0xc4, 0x84, 0x30, 0xdc, 0x8e, 0x1a, 0x18, 0x13, 0x71, 0xac,
+	0x08, 0xe8, 0x58, 0xf6, 0xa6, 0x44, 0x47, 0x0c, 0x2e, 0x3a, 0x14, 0x5b, 0xdc, 0xb9, 0x4b, 0x12,
+	0xef, 0x03, 0xcb, 0x25, 0x98, 0x76, 0x3b, 0xa1, 0xa6, 0x0b, 0xc7, 0xd4, 0x74, 0x25, 0x84, 0x8c,
+	0x12, 0x78, 0x2b, 0x00, 0x54, 0xba, 0xde, 0x84, 0xe2, 0x11, 0x42, 0x6d, 0xbf, 0x67, 0xfa, 0x5d,
+	0xaa, 0x2f, 0xca, 0x6e, 0xe3, 0x58, 0xac, 0x8a, 0xdf, 0x33, 0xba, 0x14, 0x95, 0xe1, 0x69, 0x9f,
+	0xbc, 0x4a, 0x2c, 0x6e, 0x72, 0xaf, 0xdd, 0x60, 0xdc, 0xa3, 0xc4, 0x4e, 0x58, 0x91, 0xe9, 0x39,
+	0x09, 0x73, 0x21, 0x20, 0xda, 0x8d, 0x68, 0x62, 0x4b, 0xb0, 0x8d, 0x67, 0xc5, 0x99, 0x71, 0xff,
+	0xe3, 0xb7, 0x2f, 0x5f, 0x48, 0xd8, 0xf2, 0x5e, 0xf4, 0x75, 0x2e, 0x28, 0x7d, 0xe2, 0xdb, 0xd3,
+	0x5c, 0x14, 0x22, 0xe2, 0x51, 0x17, 0xd4, 0x8e, 0xa0, 0xb9, 0x1c, 0x0c, 0xd0, 0xab, 0x90, 0x93,
+	0xdf, 0x22, 0x4c, 0x9f, 0xd8, 0x5d, 0x4b, 0xbe, 0x87, 0x52, 0x93, 0xf2, 0xdf, 0xa2, 0x44, 0x36,
+	0x42, 0x60, 0x75, 0xd2, 0xfd, 0x4e, 0x03, 0x14, 0xeb, 0x62, 0x10, 0xd6, 0xf1, 0x28, 0x93, 0x2d,
+	0xbd, 0x44, 0xeb, 0x4d, 0x3b, 0xba, 0xa5, 0x17, 0xf3, 0xf7, 0xb5, 0xf4, 0x12, 0xc7, 0xe7, 0xd7,
+	0xe2, 0xcb, 0x5c, 0x4a, 0x05, 0x83, 0xc2, 0x12, 0xdf, 0xfd, 0x12, 0xbd, 0x41, 0xa7, 0x0f, 0x22,
+	0x64, 0x1a, 0xf3, 0x04, 0xce, 0xc8, 0xd3, 0xe5, 0x91, 0x06, 0xe7, 0x87, 0x4e, 0xa4, 0x48, 0x11,
+	0x0b, 0x90, 0x9f, 0x58, 0x94, 0x95, 0xbd, 0xa7, 0x14, 0x3a, 0xd9, 0x01, 0xb7, 0xe4, 0x0f, 0xae,
+	0x7e, 0x5a, 0x77, 0x55, 0xe5, 0xa2, 0x3f, 0x68, 0xb0, 0x9c, 0xdc, 0x51, 0xa4, 0x5b, 0x1d, 0xe6,
+	0x93, 0x7b, 0x51, 0x5a, 0x3d, 0xfb, 0x24, 0x5a, 0x25, 0x15, 0xea, 0x03, 0x11, 0xba, 0x84, 0xa7,
+	0x5f, 0xf0, 0x4d, 0xf3, 0xa5, 0x27, 0xb6, 0x52, 0xb8, 0xb1, 0x91, 0xd7, 0x81, 0xc0, 0x59, 0x3f,
+	0x4d, 0x41, 0x66, 0xc7, 0xf3, 0x5c, 0xf4, 0x43, 0x0d, 0x96, 0xa8, 0xc7, 0x65, 0xfd, 0x25, 0xb6,
+	0xa9, 0xba, 0xf9, 0xc1, 0x8d, 0x6a, 0xff, 0x78, 0xd6, 0xfb, 0xfb, 0xa3, 0xd5, 0x61, 0xa8, 0x51,
+	0x19, 0x90, 0xa3, 0x1e, 0x2f, 0x4b, 0xa2, 0x5d, 0x49, 0x83, 0x5e, 0x83, 0x85, 0x7e, 0xf9, 0x41,
+	0xb2, 0x19, 0xc7, 0x96, 0xbf, 0xf0, 0x58, 0xd9, 0xf3, 0x8d, 0x84, 0xe0, 0x8d, 0x59, 0xe1, 0xd8,
+	0x7f, 0x08, 0xe7, 0xbe, 0x02, 0xf9, 0xa8, 0x32, 0xed, 0xc9, 0x6f, 0x52, 0xe2, 0x8d, 0x3a, 0x13,
+	0x7c, 0x9e, 0x0a, 0x5b, 0x7c, 0x6b, 0xc9, 0x6f, 0xd2, 0xe2, 0xa3, 0x76, 0x69, 0x80, 0xa7, 0xcf,
+	0xe2, 0x8a, 0xf7, 0xf2, 0xaf, 0x34, 0x80, 0xf8, 0xdb, 0x09, 0x7a, 0x11, 0xce, 0x95, 0xef, 0x6c,
+	0x57, 0xcc, 0xfa, 0xee, 0xe6, 0xee, 0x5e, 0xdd, 0xdc, 0xdb, 0xae, 0xef, 0x54, 0xb7, 0x6a, 0xd7,
+	0x6a, 0xd5, 0x4a, 0x7e, 0xaa, 0x90, 0xbb, 0xff, 0x60, 0x2d, 0xbb, 0x47, 0x59, 0x87, 0x58, 0xce,
+	0x81, 0x43, 0x6c, 0xf4, 0x1c, 0x2c, 0xf7, 0x53, 0x8b, 0x51, 0xb5, 0x92, 0xd7, 0x0a, 0xf3, 0xf7,
+	0x1f, 0xac, 0xcd, 0x06, 0x4f, 0x53, 0x62, 0xa3, 0x4b, 0x70, 0x66, 0x98, 0xae, 0xb6, 0x7d, 0x3d,
+	0x9f, 0x2a, 0x2c, 0xdc, 0x7f, 0xb0, 0x36, 0x17, 0xbd, 0x61, 0x51, 0x11, 0x50, 0x92, 0x52, 0xe1,
+	0xa5, 0x0b, 0x70, 0xff, 0xc1, 0xda, 0x74, 0xe0, 0x96, 0x42, 0xe6, 0xf5, 0x5f, 0xae, 0x4c, 0x5d,
+	0xfe, 0x1e, 0x40, 0x8d, 0x1e, 0xf8, 0x58, 0xd6, 0x29, 0x54, 0x80, 0xb3, 0xb5, 0xed, 0x6b, 0xc6,
+	0xe6, 0xd6, 0x6e, 0xed, 0xce, 0x76, 0xff, 0xb6, 0x07, 0xd6, 0x2a, 0x77, 0xf6, 0xca, 0xb7, 0xaa,
+	0x66, 0xbd, 0x76, 0x7d, 0x3b, 0xaf, 0xa1, 0x73, 0x70, 0xba, 0x6f, 0xed, 0x9b, 0xdb, 0xbb, 0xb5,
+	0xdb, 0xd5, 0x7c, 0xea, 0xf2, 0x4f, 0xa2, 0xce, 0x90, 0xec, 0xc6, 0x5c, 0x80, 0x73, 0x37, 0x37,
+	0x6b, 0xb7, 0x4c, 0xa3, 0xba, 0x59, 0x1f, 0x12, 0xa0, 0xc3, 0x72, 0x72, 0x31, 0x42, 0xd1, 0x06,
+	0xd9, 0x92, 0xb2, 0x53, 0xe8, 0x19, 0x58, 0x4d, 0x2e, 0xde, 0xae, 0x6d, 0x9b, 0xf5, 0xea, 0xad,
+	0x6b, 0x66, 0xa5, 0x7a, 0xab, 0x7a, 0x7d, 0x53, 0x6c, 0x28, 0x9f, 0x2e, 0x5f, 0x7b, 0xef, 0xc3,
+	0x15, 0xed, 0xfd, 0x0f, 0x57, 0xb4, 0xbf, 0x7d, 0xb8, 0xa2, 0xbd, 0xf1, 0xd1, 0xca, 0xd4, 0xfb,
+	0x1f, 0xad, 0x4c, 0xfd, 0xf9, 0xa3, 0x95, 0xa9, 0x6f, 0xbf, 0x78, 0x64, 0xe0, 0xc5, 0x27, 0x8b,
+	0x0c, 0xc1, 0xc6, 0xb4, 0x3c, 0x50, 0xbf, 0xf8, 0x9f, 0x01, 0x00, 0xfd, 0xd7, 0xfc, 0x65, 0x16,
+	0x22, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,706 +1946,740 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11178 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x1c, 0x47,
-		0x76, 0x1f, 0x3e, 0x7d, 0x4c, 0x1f, 0xaf, 0xaf, 0x9a, 0x9c, 0x01, 0x30, 0x68, 0x90, 0x33, 0xc3,
-		0xe2, 0x92, 0x00, 0x41, 0x72, 0x40, 0x82, 0x04, 0x48, 0x0e, 0x96, 0xcb, 0x7f, 0x77, 0x4f, 0x63,
-		0xd0, 0xe0, 0x5c, 0xac, 0xee, 0x01, 0x0f, 0x1d, 0xa5, 0x9a, 0xea, 0x9c, 0x9e, 0x22, 0xba, 0xab,
-		0x7a, 0xab, 0xaa, 0x81, 0x19, 0xc6, 0x3f, 0x1c, 0x2b, 0xad, 0x64, 0xaf, 0x60, 0x59, 0x5a, 0x1d,
-		0x61, 0xad, 0xa4, 0x85, 0xcc, 0x95, 0x2c, 0xed, 0x4a, 0xbe, 0x74, 0xac, 0xb5, 0x92, 0x15, 0x92,
-		0x25, 0x87, 0x0f, 0x49, 0x76, 0x38, 0xd6, 0xfa, 0x60, 0x2b, 0x1c, 0x61, 0x5a, 0xe2, 0x2a, 0xa4,
-		0xf5, 0x6a, 0x65, 0x5d, 0x94, 0x6d, 0x79, 0xc3, 0x0a, 0x47, 0x5e, 0x75, 0xf4, 0x31, 0xdd, 0x03,
-		0x91, 0xd4, 0xda, 0xf2, 0x17, 0xa0, 0x2b, 0xf3, 0xbd, 0x5f, 0x65, 0xbe, 0x7c, 0xf9, 0xf2, 0xbd,
-		0x97, 0x59, 0x39, 0xf0, 0x5b, 0x65, 0x58, 0x6a, 0x59, 0x56, 0xab, 0x8d, 0x2f, 0x74, 0x6d, 0xcb,
-		0xb5, 0x76, 0x7b, 0x7b, 0x17, 0x9a, 0xd8, 0xd1, 0x6d, 0xa3, 0xeb, 0x5a, 0xf6, 0x32, 0x2d, 0x43,
-		0x05, 0x46, 0xb1, 0x2c, 0x28, 0xe4, 0x0d, 0x98, 0xb9, 0x6a, 0xb4, 0xf1, 0xaa, 0x47, 0x58, 0xc7,
-		0x2e, 0x7a, 0x16, 0xe2, 0x7b, 0x46, 0x1b, 0xcf, 0x47, 0x96, 0x62, 0xe7, 0x32, 0x17, 0x3f, 0xb0,
-		0xdc, 0xc7, 0xb4, 0x1c, 0xe6, 0xd8, 0x26, 0xc5, 0x0a, 0xe5, 0x90, 0xff, 0x3c, 0x0e, 0xb3, 0x43,
-		0x6a, 0x11, 0x82, 0xb8, 0xa9, 0x75, 0x08, 0x62, 0xe4, 0x5c, 0x5a, 0xa1, 0xbf, 0xd1, 0x3c, 0x24,
-		0xbb, 0x9a, 0x7e, 0x53, 0x6b, 0xe1, 0xf9, 0x28, 0x2d, 0x16, 0x8f, 0x68, 0x01, 0xa0, 0x89, 0xbb,
-		0xd8, 0x6c, 0x62, 0x53, 0x3f, 0x9c, 0x8f, 0x2d, 0xc5, 0xce, 0xa5, 0x95, 0x40, 0x09, 0x7a, 0x14,
-		0x66, 0xba, 0xbd, 0xdd, 0xb6, 0xa1, 0xab, 0x01, 0x32, 0x58, 0x8a, 0x9d, 0x9b, 0x56, 0x24, 0x56,
-		0xb1, 0xea, 0x13, 0x9f, 0x85, 0xc2, 0x6d, 0xac, 0xdd, 0x0c, 0x92, 0x66, 0x28, 0x69, 0x9e, 0x14,
-		0x07, 0x08, 0x2b, 0x90, 0xed, 0x60, 0xc7, 0xd1, 0x5a, 0x58, 0x75, 0x0f, 0xbb, 0x78, 0x3e, 0x4e,
-		0x7b, 0xbf, 0x34, 0xd0, 0xfb, 0xfe, 0x9e, 0x67, 0x38, 0x57, 0xe3, 0xb0, 0x8b, 0x51, 0x09, 0xd2,
-		0xd8, 0xec, 0x75, 0x18, 0xc2, 0xf4, 0x08, 0xf9, 0x55, 0xcd, 0x5e, 0xa7, 0x1f, 0x25, 0x45, 0xd8,
-		0x38, 0x44, 0xd2, 0xc1, 0xf6, 0x2d, 0x43, 0xc7, 0xf3, 0x09, 0x0a, 0x70, 0x76, 0x00, 0xa0, 0xce,
-		0xea, 0xfb, 0x31, 0x04, 0x1f, 0xaa, 0x40, 0x1a, 0x1f, 0xb8, 0xd8, 0x74, 0x0c, 0xcb, 0x9c, 0x4f,
-		0x52, 0x90, 0x87, 0x86, 0x8c, 0x22, 0x6e, 0x37, 0xfb, 0x21, 0x7c, 0x3e, 0x74, 0x19, 0x92, 0x56,
-		0xd7, 0x35, 0x2c, 0xd3, 0x99, 0x4f, 0x2d, 0x45, 0xce, 0x65, 0x2e, 0xde, 0x37, 0x54, 0x11, 0xb6,
-		0x18, 0x8d, 0x22, 0x88, 0x51, 0x0d, 0x24, 0xc7, 0xea, 0xd9, 0x3a, 0x56, 0x75, 0xab, 0x89, 0x55,
-		0xc3, 0xdc, 0xb3, 0xe6, 0xd3, 0x14, 0x60, 0x71, 0xb0, 0x23, 0x94, 0xb0, 0x62, 0x35, 0x71, 0xcd,
-		0xdc, 0xb3, 0x94, 0xbc, 0x13, 0x7a, 0x46, 0x27, 0x21, 0xe1, 0x1c, 0x9a, 0xae, 0x76, 0x30, 0x9f,
-		0xa5, 0x1a, 0xc2, 0x9f, 0x88, 0xea, 0xe0, 0xa6, 0x41, 0x5e, 0x37, 0x9f, 0x63, 0xaa, 0xc3, 0x1f,
-		0xe5, 0x9f, 0x4b, 0x40, 0x61, 0x12, 0xe5, 0xbb, 0x02, 0xd3, 0x7b, 0xa4, 0xff, 0xf3, 0xd1, 0xe3,
-		0x48, 0x87, 0xf1, 0x84, 0xc5, 0x9b, 0xb8, 0x47, 0xf1, 0x96, 0x20, 0x63, 0x62, 0xc7, 0xc5, 0x4d,
-		0xa6, 0x2b, 0xb1, 0x09, 0xb5, 0x0d, 0x18, 0xd3, 0xa0, 0xb2, 0xc5, 0xef, 0x49, 0xd9, 0x5e, 0x81,
-		0x82, 0xd7, 0x24, 0xd5, 0xd6, 0xcc, 0x96, 0xd0, 0xda, 0x0b, 0xe3, 0x5a, 0xb2, 0x5c, 0x15, 0x7c,
-		0x0a, 0x61, 0x53, 0xf2, 0x38, 0xf4, 0x8c, 0x56, 0x01, 0x2c, 0x13, 0x5b, 0x7b, 0x6a, 0x13, 0xeb,
-		0xed, 0xf9, 0xd4, 0x08, 0x29, 0x6d, 0x11, 0x92, 0x01, 0x29, 0x59, 0xac, 0x54, 0x6f, 0xa3, 0xe7,
-		0x7c, 0x25, 0x4c, 0x8e, 0xd0, 0xa1, 0x0d, 0x36, 0xfd, 0x06, 0xf4, 0x70, 0x07, 0xf2, 0x36, 0x26,
-		0x33, 0x02, 0x37, 0x79, 0xcf, 0xd2, 0xb4, 0x11, 0xcb, 0x63, 0x7b, 0xa6, 0x70, 0x36, 0xd6, 0xb1,
-		0x9c, 0x1d, 0x7c, 0x44, 0x0f, 0x82, 0x57, 0xa0, 0x52, 0xb5, 0x02, 0x6a, 0x9f, 0xb2, 0xa2, 0x70,
-		0x53, 0xeb, 0xe0, 0xe2, 0x1b, 0x90, 0x0f, 0x8b, 0x07, 0xcd, 0xc1, 0xb4, 0xe3, 0x6a, 0xb6, 0x4b,
-		0xb5, 0x70, 0x5a, 0x61, 0x0f, 0x48, 0x82, 0x18, 0x36, 0x9b, 0xd4, 0xfe, 0x4d, 0x2b, 0xe4, 0x27,
-		0xfa, 0xff, 0xfc, 0x0e, 0xc7, 0x68, 0x87, 0x1f, 0x1e, 0x1c, 0xd1, 0x10, 0x72, 0x7f, 0xbf, 0x8b,
-		0xcf, 0x40, 0x2e, 0xd4, 0x81, 0x49, 0x5f, 0x2d, 0xff, 0xff, 0x70, 0x62, 0x28, 0x34, 0x7a, 0x05,
-		0xe6, 0x7a, 0xa6, 0x61, 0xba, 0xd8, 0xee, 0xda, 0x98, 0x68, 0x2c, 0x7b, 0xd5, 0xfc, 0xef, 0x26,
-		0x47, 0xe8, 0xdc, 0x4e, 0x90, 0x9a, 0xa1, 0x28, 0xb3, 0xbd, 0xc1, 0xc2, 0xf3, 0xe9, 0xd4, 0x17,
-		0x93, 0xd2, 0x47, 0x3e, 0xf2, 0x91, 0x8f, 0x44, 0xe5, 0x5f, 0x4e, 0xc0, 0xdc, 0xb0, 0x39, 0x33,
-		0x74, 0xfa, 0x9e, 0x84, 0x84, 0xd9, 0xeb, 0xec, 0x62, 0x9b, 0x0a, 0x69, 0x5a, 0xe1, 0x4f, 0xa8,
-		0x04, 0xd3, 0x6d, 0x6d, 0x17, 0xb7, 0xe7, 0xe3, 0x4b, 0x91, 0x73, 0xf9, 0x8b, 0x8f, 0x4e, 0x34,
-		0x2b, 0x97, 0xd7, 0x09, 0x8b, 0xc2, 0x38, 0xd1, 0x87, 0x20, 0xce, 0x8d, 0x37, 0x41, 0x38, 0x3f,
-		0x19, 0x02, 0x99, 0x4b, 0x0a, 0xe5, 0x43, 0x67, 0x20, 0x4d, 0xfe, 0x67, 0xba, 0x91, 0xa0, 0x6d,
-		0x4e, 0x91, 0x02, 0xa2, 0x17, 0xa8, 0x08, 0x29, 0x3a, 0x4d, 0x9a, 0x58, 0x2c, 0x7a, 0xde, 0x33,
-		0x51, 0xac, 0x26, 0xde, 0xd3, 0x7a, 0x6d, 0x57, 0xbd, 0xa5, 0xb5, 0x7b, 0x98, 0x2a, 0x7c, 0x5a,
-		0xc9, 0xf2, 0xc2, 0x1b, 0xa4, 0x0c, 0x2d, 0x42, 0x86, 0xcd, 0x2a, 0xc3, 0x6c, 0xe2, 0x03, 0x6a,
-		0x57, 0xa7, 0x15, 0x36, 0xd1, 0x6a, 0xa4, 0x84, 0xbc, 0xfe, 0x75, 0xc7, 0x32, 0x85, 0x6a, 0xd2,
-		0x57, 0x90, 0x02, 0xfa, 0xfa, 0x67, 0xfa, 0x4d, 0xfa, 0xfd, 0xc3, 0xbb, 0x37, 0x30, 0x97, 0xce,
-		0x42, 0x81, 0x52, 0x3c, 0xc5, 0x87, 0x5e, 0x6b, 0xcf, 0xcf, 0x2c, 0x45, 0xce, 0xa5, 0x94, 0x3c,
-		0x2b, 0xde, 0xe2, 0xa5, 0xf2, 0xcf, 0x44, 0x21, 0x4e, 0x0d, 0x4b, 0x01, 0x32, 0x8d, 0x57, 0xb7,
-		0xab, 0xea, 0xea, 0xd6, 0x4e, 0x79, 0xbd, 0x2a, 0x45, 0x50, 0x1e, 0x80, 0x16, 0x5c, 0x5d, 0xdf,
-		0x2a, 0x35, 0xa4, 0xa8, 0xf7, 0x5c, 0xdb, 0x6c, 0x5c, 0x7e, 0x5a, 0x8a, 0x79, 0x0c, 0x3b, 0xac,
-		0x20, 0x1e, 0x24, 0x78, 0xea, 0xa2, 0x34, 0x8d, 0x24, 0xc8, 0x32, 0x80, 0xda, 0x2b, 0xd5, 0xd5,
-		0xcb, 0x4f, 0x4b, 0x89, 0x70, 0xc9, 0x53, 0x17, 0xa5, 0x24, 0xca, 0x41, 0x9a, 0x96, 0x94, 0xb7,
-		0xb6, 0xd6, 0xa5, 0x94, 0x87, 0x59, 0x6f, 0x28, 0xb5, 0xcd, 0x35, 0x29, 0xed, 0x61, 0xae, 0x29,
-		0x5b, 0x3b, 0xdb, 0x12, 0x78, 0x08, 0x1b, 0xd5, 0x7a, 0xbd, 0xb4, 0x56, 0x95, 0x32, 0x1e, 0x45,
-		0xf9, 0xd5, 0x46, 0xb5, 0x2e, 0x65, 0x43, 0xcd, 0x7a, 0xea, 0xa2, 0x94, 0xf3, 0x5e, 0x51, 0xdd,
-		0xdc, 0xd9, 0x90, 0xf2, 0x68, 0x06, 0x72, 0xec, 0x15, 0xa2, 0x11, 0x85, 0xbe, 0xa2, 0xcb, 0x4f,
-		0x4b, 0x92, 0xdf, 0x10, 0x86, 0x32, 0x13, 0x2a, 0xb8, 0xfc, 0xb4, 0x84, 0xe4, 0x0a, 0x4c, 0x53,
-		0x35, 0x44, 0x08, 0xf2, 0xeb, 0xa5, 0x72, 0x75, 0x5d, 0xdd, 0xda, 0x6e, 0xd4, 0xb6, 0x36, 0x4b,
-		0xeb, 0x52, 0xc4, 0x2f, 0x53, 0xaa, 0x2f, 0xed, 0xd4, 0x94, 0xea, 0xaa, 0x14, 0x0d, 0x96, 0x6d,
-		0x57, 0x4b, 0x8d, 0xea, 0xaa, 0x14, 0x93, 0x75, 0x98, 0x1b, 0x66, 0x50, 0x87, 0x4e, 0xa1, 0x80,
-		0x2e, 0x44, 0x47, 0xe8, 0x02, 0xc5, 0xea, 0xd7, 0x05, 0xf9, 0x0b, 0x51, 0x98, 0x1d, 0xb2, 0xa8,
-		0x0c, 0x7d, 0xc9, 0x0b, 0x30, 0xcd, 0x74, 0x99, 0x2d, 0xb3, 0x8f, 0x0c, 0x5d, 0x9d, 0xa8, 0x66,
-		0x0f, 0x2c, 0xb5, 0x94, 0x2f, 0xe8, 0x84, 0xc4, 0x46, 0x38, 0x21, 0x04, 0x62, 0x40, 0x61, 0xbf,
-		0x6e, 0xc0, 0xf8, 0xb3, 0xf5, 0xf1, 0xf2, 0x24, 0xeb, 0x23, 0x2d, 0x3b, 0xde, 0x22, 0x30, 0x3d,
-		0x64, 0x11, 0xb8, 0x02, 0x33, 0x03, 0x40, 0x13, 0x1b, 0xe3, 0x8f, 0x46, 0x60, 0x7e, 0x94, 0x70,
-		0xc6, 0x98, 0xc4, 0x68, 0xc8, 0x24, 0x5e, 0xe9, 0x97, 0xe0, 0x03, 0xa3, 0x07, 0x61, 0x60, 0xac,
-		0x3f, 0x1d, 0x81, 0x93, 0xc3, 0x9d, 0xcd, 0xa1, 0x6d, 0xf8, 0x10, 0x24, 0x3a, 0xd8, 0xdd, 0xb7,
-		0x84, 0x5b, 0xf5, 0xf0, 0x90, 0xc5, 0x9a, 0x54, 0xf7, 0x0f, 0x36, 0xe7, 0x0a, 0xae, 0xf6, 0xb1,
-		0x51, 0x1e, 0x23, 0x6b, 0xcd, 0x40, 0x4b, 0xbf, 0x35, 0x0a, 0x27, 0x86, 0x82, 0x0f, 0x6d, 0xe8,
-		0xfd, 0x00, 0x86, 0xd9, 0xed, 0xb9, 0xcc, 0x75, 0x62, 0x96, 0x38, 0x4d, 0x4b, 0xa8, 0xf1, 0x22,
-		0x56, 0xb6, 0xe7, 0x7a, 0xf5, 0x31, 0x5a, 0x0f, 0xac, 0x88, 0x12, 0x3c, 0xeb, 0x37, 0x34, 0x4e,
-		0x1b, 0xba, 0x30, 0xa2, 0xa7, 0x03, 0x8a, 0xf9, 0x04, 0x48, 0x7a, 0xdb, 0xc0, 0xa6, 0xab, 0x3a,
-		0xae, 0x8d, 0xb5, 0x8e, 0x61, 0xb6, 0xe8, 0x52, 0x93, 0x5a, 0x99, 0xde, 0xd3, 0xda, 0x0e, 0x56,
-		0x0a, 0xac, 0xba, 0x2e, 0x6a, 0x09, 0x07, 0x55, 0x20, 0x3b, 0xc0, 0x91, 0x08, 0x71, 0xb0, 0x6a,
-		0x8f, 0x43, 0xfe, 0xce, 0x34, 0x64, 0x02, 0xae, 0x39, 0x7a, 0x00, 0xb2, 0xaf, 0x6b, 0xb7, 0x34,
-		0x55, 0x84, 0x5b, 0x4c, 0x12, 0x19, 0x52, 0xb6, 0xcd, 0x43, 0xae, 0x27, 0x60, 0x8e, 0x92, 0x58,
-		0x3d, 0x17, 0xdb, 0xaa, 0xde, 0xd6, 0x1c, 0x87, 0x0a, 0x2d, 0x45, 0x49, 0x11, 0xa9, 0xdb, 0x22,
-		0x55, 0x15, 0x51, 0x83, 0x2e, 0xc1, 0x2c, 0xe5, 0xe8, 0xf4, 0xda, 0xae, 0xd1, 0x6d, 0x63, 0x95,
-		0x04, 0x80, 0x0e, 0x5d, 0x72, 0xbc, 0x96, 0xcd, 0x10, 0x8a, 0x0d, 0x4e, 0x40, 0x5a, 0xe4, 0xa0,
-		0x55, 0xb8, 0x9f, 0xb2, 0xb5, 0xb0, 0x89, 0x6d, 0xcd, 0xc5, 0x2a, 0xfe, 0x70, 0x4f, 0x6b, 0x3b,
-		0xaa, 0x66, 0x36, 0xd5, 0x7d, 0xcd, 0xd9, 0x9f, 0x9f, 0x23, 0x00, 0xe5, 0xe8, 0x7c, 0x44, 0x39,
-		0x4d, 0x08, 0xd7, 0x38, 0x5d, 0x95, 0x92, 0x95, 0xcc, 0xe6, 0x35, 0xcd, 0xd9, 0x47, 0x2b, 0x70,
-		0x92, 0xa2, 0x38, 0xae, 0x6d, 0x98, 0x2d, 0x55, 0xdf, 0xc7, 0xfa, 0x4d, 0xb5, 0xe7, 0xee, 0x3d,
-		0x3b, 0x7f, 0x26, 0xf8, 0x7e, 0xda, 0xc2, 0x3a, 0xa5, 0xa9, 0x10, 0x92, 0x1d, 0x77, 0xef, 0x59,
-		0x54, 0x87, 0x2c, 0x19, 0x8c, 0x8e, 0xf1, 0x06, 0x56, 0xf7, 0x2c, 0x9b, 0xae, 0xa1, 0xf9, 0x21,
-		0xa6, 0x29, 0x20, 0xc1, 0xe5, 0x2d, 0xce, 0xb0, 0x61, 0x35, 0xf1, 0xca, 0x74, 0x7d, 0xbb, 0x5a,
-		0x5d, 0x55, 0x32, 0x02, 0xe5, 0xaa, 0x65, 0x13, 0x85, 0x6a, 0x59, 0x9e, 0x80, 0x33, 0x4c, 0xa1,
-		0x5a, 0x96, 0x10, 0xef, 0x25, 0x98, 0xd5, 0x75, 0xd6, 0x67, 0x43, 0x57, 0x79, 0x98, 0xe6, 0xcc,
-		0x4b, 0x21, 0x61, 0xe9, 0xfa, 0x1a, 0x23, 0xe0, 0x3a, 0xee, 0xa0, 0xe7, 0xe0, 0x84, 0x2f, 0xac,
-		0x20, 0xe3, 0xcc, 0x40, 0x2f, 0xfb, 0x59, 0x2f, 0xc1, 0x6c, 0xf7, 0x70, 0x90, 0x11, 0x85, 0xde,
-		0xd8, 0x3d, 0xec, 0x67, 0x7b, 0x06, 0xe6, 0xba, 0xfb, 0xdd, 0x41, 0xbe, 0xf3, 0x41, 0x3e, 0xd4,
-		0xdd, 0xef, 0xf6, 0x33, 0x3e, 0x44, 0x63, 0x76, 0x1b, 0xeb, 0x9a, 0x8b, 0x9b, 0xf3, 0xa7, 0x82,
-		0xe4, 0x81, 0x0a, 0xb4, 0x0c, 0x92, 0xae, 0xab, 0xd8, 0xd4, 0x76, 0xdb, 0x58, 0xd5, 0x6c, 0x6c,
-		0x6a, 0xce, 0xfc, 0x22, 0x25, 0x8e, 0xbb, 0x76, 0x0f, 0x2b, 0x79, 0x5d, 0xaf, 0xd2, 0xca, 0x12,
-		0xad, 0x43, 0xe7, 0x61, 0xc6, 0xda, 0x7d, 0x5d, 0x67, 0x1a, 0xa9, 0x76, 0x6d, 0xbc, 0x67, 0x1c,
-		0xcc, 0x7f, 0x80, 0x8a, 0xb7, 0x40, 0x2a, 0xa8, 0x3e, 0x6e, 0xd3, 0x62, 0xf4, 0x08, 0x48, 0xba,
-		0xb3, 0xaf, 0xd9, 0x5d, 0x6a, 0x92, 0x9d, 0xae, 0xa6, 0xe3, 0xf9, 0x87, 0x18, 0x29, 0x2b, 0xdf,
-		0x14, 0xc5, 0x64, 0x46, 0x38, 0xb7, 0x8d, 0x3d, 0x57, 0x20, 0x9e, 0x65, 0x33, 0x82, 0x96, 0x71,
-		0xb4, 0x73, 0x20, 0x11, 0x49, 0x84, 0x5e, 0x7c, 0x8e, 0x92, 0xe5, 0xbb, 0xfb, 0xdd, 0xe0, 0x7b,
-		0x1f, 0x84, 0x1c, 0xa1, 0xf4, 0x5f, 0xfa, 0x08, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x37, 0x3e, 0x0d,
-		0x27, 0x09, 0x51, 0x07, 0xbb, 0x5a, 0x53, 0x73, 0xb5, 0x00, 0xf5, 0x63, 0x94, 0x9a, 0x88, 0x7d,
-		0x83, 0x57, 0x86, 0xda, 0x69, 0xf7, 0x76, 0x0f, 0x3d, 0xc5, 0x7a, 0x9c, 0xb5, 0x93, 0x94, 0x09,
-		0xd5, 0x7a, 0xcf, 0x9c, 0x73, 0x79, 0x05, 0xb2, 0x41, 0xbd, 0x47, 0x69, 0x60, 0x9a, 0x2f, 0x45,
-		0x88, 0x13, 0x54, 0xd9, 0x5a, 0x25, 0xee, 0xcb, 0x6b, 0x55, 0x29, 0x4a, 0xdc, 0xa8, 0xf5, 0x5a,
-		0xa3, 0xaa, 0x2a, 0x3b, 0x9b, 0x8d, 0xda, 0x46, 0x55, 0x8a, 0x05, 0x1c, 0xfb, 0xeb, 0xf1, 0xd4,
-		0xc3, 0xd2, 0x59, 0xf9, 0xe7, 0x63, 0x90, 0x0f, 0x47, 0x6a, 0xe8, 0x83, 0x70, 0x4a, 0x24, 0x5c,
-		0x1c, 0xec, 0xaa, 0xb7, 0x0d, 0x9b, 0x4e, 0xc8, 0x8e, 0xc6, 0x16, 0x47, 0x4f, 0x7f, 0xe6, 0x38,
-		0x55, 0x1d, 0xbb, 0x2f, 0x1b, 0x36, 0x99, 0x6e, 0x1d, 0xcd, 0x45, 0xeb, 0xb0, 0x68, 0x5a, 0xaa,
-		0xe3, 0x6a, 0x66, 0x53, 0xb3, 0x9b, 0xaa, 0x9f, 0xea, 0x52, 0x35, 0x5d, 0xc7, 0x8e, 0x63, 0xb1,
-		0x85, 0xd0, 0x43, 0xb9, 0xcf, 0xb4, 0xea, 0x9c, 0xd8, 0x5f, 0x21, 0x4a, 0x9c, 0xb4, 0x4f, 0x7d,
-		0x63, 0xa3, 0xd4, 0xf7, 0x0c, 0xa4, 0x3b, 0x5a, 0x57, 0xc5, 0xa6, 0x6b, 0x1f, 0x52, 0xff, 0x3c,
-		0xa5, 0xa4, 0x3a, 0x5a, 0xb7, 0x4a, 0x9e, 0xd1, 0x0d, 0x78, 0xd8, 0x27, 0x55, 0xdb, 0xb8, 0xa5,
-		0xe9, 0x87, 0x2a, 0x75, 0xc6, 0x69, 0xda, 0x40, 0xd5, 0x2d, 0x73, 0xaf, 0x6d, 0xe8, 0xae, 0x43,
-		0xed, 0x03, 0xb3, 0x71, 0xb2, 0xcf, 0xb1, 0x4e, 0x19, 0xae, 0x3b, 0x96, 0x49, 0x7d, 0xf0, 0x8a,
-		0xa0, 0x7e, 0x5f, 0xc2, 0xaf, 0xeb, 0xf1, 0x54, 0x5c, 0x9a, 0xbe, 0x1e, 0x4f, 0x4d, 0x4b, 0x89,
-		0xeb, 0xf1, 0x54, 0x42, 0x4a, 0x5e, 0x8f, 0xa7, 0x52, 0x52, 0xfa, 0x7a, 0x3c, 0x95, 0x96, 0x40,
-		0xfe, 0x5c, 0x0a, 0xb2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x23, 0xd4, 0x7a, 0x3e,
-		0x78, 0x64, 0x1c, 0xb1, 0x5c, 0x21, 0x8b, 0xe6, 0x4a, 0x82, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e,
-		0x0b, 0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x9f, 0xd0, 0x1a, 0x24, 0x5e, 0x77, 0x28, 0x76,
-		0x82, 0x62, 0x7f, 0xe0, 0x68, 0xec, 0xeb, 0x75, 0x0a, 0x9e, 0xbe, 0x5e, 0x57, 0x37, 0xb7, 0x94,
-		0x8d, 0xd2, 0xba, 0xc2, 0xd9, 0xd1, 0x69, 0x88, 0xb7, 0xb5, 0x37, 0x0e, 0xc3, 0xcb, 0x2b, 0x2d,
-		0x42, 0xcb, 0x50, 0xe8, 0x99, 0xb7, 0xb0, 0x6d, 0xec, 0x19, 0x64, 0xa8, 0x08, 0x55, 0x21, 0x48,
-		0x95, 0xf7, 0x6b, 0xd7, 0x09, 0xfd, 0x84, 0xea, 0x71, 0x1a, 0xe2, 0xb7, 0xb1, 0x76, 0x33, 0xbc,
-		0x08, 0xd2, 0x22, 0x74, 0x0e, 0xb2, 0x4d, 0xbc, 0xdb, 0x6b, 0xa9, 0x36, 0x6e, 0x6a, 0xba, 0x1b,
-		0x36, 0xfd, 0x19, 0x5a, 0xa5, 0xd0, 0x1a, 0xf4, 0x22, 0xa4, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0x67,
-		0xa8, 0x08, 0x1e, 0x3f, 0x5a, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0xab, 0x90, 0x70,
-		0x35, 0xbb, 0x85, 0x5d, 0x6a, 0xf9, 0xf3, 0x43, 0x92, 0x1f, 0x43, 0x90, 0x1a, 0x94, 0x83, 0xc6,
-		0xb4, 0x9c, 0xfb, 0x3d, 0xb4, 0x32, 0x17, 0x60, 0x9a, 0xaa, 0x07, 0x02, 0xe0, 0x0a, 0x22, 0x4d,
-		0xa1, 0x14, 0xc4, 0x2b, 0x5b, 0x0a, 0xb1, 0x34, 0x12, 0x64, 0x59, 0xa9, 0xba, 0x5d, 0xab, 0x56,
-		0xaa, 0x52, 0x54, 0xbe, 0x04, 0x09, 0x36, 0xe6, 0xc4, 0x0a, 0x79, 0xa3, 0x2e, 0x4d, 0xf1, 0x47,
-		0x8e, 0x11, 0x11, 0xb5, 0x3b, 0x1b, 0xe5, 0xaa, 0x22, 0x45, 0xe5, 0x1d, 0x28, 0xf4, 0xc9, 0x09,
-		0x9d, 0x80, 0x19, 0xa5, 0xda, 0xa8, 0x6e, 0x92, 0x38, 0x4b, 0xdd, 0xd9, 0x7c, 0x71, 0x73, 0xeb,
-		0xe5, 0x4d, 0x69, 0x2a, 0x5c, 0x2c, 0x4c, 0x5a, 0x04, 0xcd, 0x81, 0xe4, 0x17, 0xd7, 0xb7, 0x76,
-		0x14, 0xda, 0x9a, 0x6f, 0x8b, 0x82, 0xd4, 0x2f, 0x35, 0x74, 0x0a, 0x66, 0x1b, 0x25, 0x65, 0xad,
-		0xda, 0x50, 0x59, 0xec, 0xe8, 0x41, 0xcf, 0x81, 0x14, 0xac, 0xb8, 0x5a, 0xa3, 0xa1, 0xf1, 0x22,
-		0x9c, 0x09, 0x96, 0x56, 0x5f, 0x69, 0x54, 0x37, 0xeb, 0xf4, 0xe5, 0xa5, 0xcd, 0x35, 0x62, 0x5f,
-		0xfb, 0xf0, 0x44, 0xb4, 0x1a, 0x23, 0x4d, 0x0d, 0xe3, 0x55, 0xd7, 0x57, 0xa5, 0x78, 0x7f, 0xf1,
-		0xd6, 0x66, 0x75, 0xeb, 0xaa, 0x34, 0xdd, 0xff, 0x76, 0x1a, 0xc1, 0x26, 0x50, 0x11, 0x4e, 0xf6,
-		0x97, 0xaa, 0xd5, 0xcd, 0x86, 0xf2, 0xaa, 0x94, 0xec, 0x7f, 0x71, 0xbd, 0xaa, 0xdc, 0xa8, 0x55,
-		0xaa, 0x52, 0x0a, 0x9d, 0x04, 0x14, 0x6e, 0x51, 0xe3, 0xda, 0xd6, 0xaa, 0x94, 0x1e, 0xb0, 0x28,
-		0xb2, 0x03, 0xd9, 0x60, 0x18, 0xf9, 0xfe, 0xe4, 0x92, 0x3e, 0x11, 0x85, 0x4c, 0x20, 0x2c, 0x24,
-		0xfe, 0xbc, 0xd6, 0x6e, 0x5b, 0xb7, 0x55, 0xad, 0x6d, 0x68, 0x0e, 0xb7, 0x37, 0x40, 0x8b, 0x4a,
-		0xa4, 0x64, 0xd2, 0xf9, 0x3d, 0xb9, 0x85, 0x4f, 0x7c, 0x35, 0x5a, 0xf8, 0x69, 0x29, 0x21, 0xff,
-		0x60, 0x04, 0xa4, 0xfe, 0x78, 0xaf, 0xaf, 0xfb, 0x91, 0x51, 0xdd, 0x7f, 0x5f, 0xc6, 0xee, 0x93,
-		0x11, 0xc8, 0x87, 0x83, 0xbc, 0xbe, 0xe6, 0x3d, 0xf0, 0x97, 0xda, 0xbc, 0xdf, 0x8c, 0x42, 0x2e,
-		0x14, 0xda, 0x4d, 0xda, 0xba, 0x0f, 0xc3, 0x8c, 0xd1, 0xc4, 0x9d, 0xae, 0xe5, 0x62, 0x53, 0x3f,
-		0x54, 0xdb, 0xf8, 0x16, 0x6e, 0xcf, 0xcb, 0xd4, 0x28, 0x5f, 0x38, 0x3a, 0x78, 0x5c, 0xae, 0xf9,
-		0x7c, 0xeb, 0x84, 0x6d, 0x65, 0xb6, 0xb6, 0x5a, 0xdd, 0xd8, 0xde, 0x6a, 0x54, 0x37, 0x2b, 0xaf,
-		0x0a, 0xeb, 0xa2, 0x48, 0x46, 0x1f, 0xd9, 0x7b, 0x68, 0xb4, 0xb7, 0x41, 0xea, 0x6f, 0x14, 0xb1,
-		0x15, 0x43, 0x9a, 0x25, 0x4d, 0xa1, 0x59, 0x28, 0x6c, 0x6e, 0xa9, 0xf5, 0xda, 0x6a, 0x55, 0xad,
-		0x5e, 0xbd, 0x5a, 0xad, 0x34, 0xea, 0x2c, 0x1d, 0xe8, 0x51, 0x37, 0xa4, 0x68, 0x50, 0xc4, 0xdf,
-		0x1f, 0x83, 0xd9, 0x21, 0x2d, 0x41, 0x25, 0x1e, 0xc8, 0xb3, 0xdc, 0xc2, 0xe3, 0x93, 0xb4, 0x7e,
-		0x99, 0xb8, 0xd2, 0xdb, 0x9a, 0xed, 0xf2, 0xb8, 0xff, 0x11, 0x20, 0x52, 0x32, 0x5d, 0xb2, 0xb2,
-		0xdb, 0x3c, 0xcd, 0xca, 0xa2, 0xfb, 0x82, 0x5f, 0xce, 0x32, 0xad, 0x8f, 0x01, 0xea, 0x5a, 0x8e,
-		0xe1, 0x1a, 0xb7, 0xb0, 0x6a, 0x98, 0x22, 0x27, 0x4b, 0xa2, 0xfd, 0xb8, 0x22, 0x89, 0x9a, 0x9a,
-		0xe9, 0x7a, 0xd4, 0x26, 0x6e, 0x69, 0x7d, 0xd4, 0xc4, 0xf3, 0x88, 0x29, 0x92, 0xa8, 0xf1, 0xa8,
-		0x1f, 0x80, 0x6c, 0xd3, 0xea, 0x91, 0x10, 0x88, 0xd1, 0x11, 0x6b, 0x11, 0x51, 0x32, 0xac, 0xcc,
-		0x23, 0xe1, 0xc1, 0xad, 0x9f, 0x0c, 0xce, 0x2a, 0x19, 0x56, 0xc6, 0x48, 0xce, 0x42, 0x41, 0x6b,
-		0xb5, 0x6c, 0x02, 0x2e, 0x80, 0x58, 0xb8, 0x9e, 0xf7, 0x8a, 0x29, 0x61, 0xf1, 0x3a, 0xa4, 0x84,
-		0x1c, 0x88, 0x07, 0x4b, 0x24, 0xa1, 0x76, 0x59, 0x0e, 0x2a, 0x7a, 0x2e, 0xad, 0xa4, 0x4c, 0x51,
-		0xf9, 0x00, 0x64, 0x0d, 0x47, 0xf5, 0xf7, 0xb6, 0xa2, 0x4b, 0xd1, 0x73, 0x29, 0x25, 0x63, 0x38,
-		0xde, 0xbe, 0x80, 0xfc, 0xe9, 0x28, 0xe4, 0xc3, 0xbb, 0x76, 0x68, 0x15, 0x52, 0x6d, 0x4b, 0xd7,
-		0xa8, 0x6a, 0xb1, 0x2d, 0xe3, 0x73, 0x63, 0x36, 0xfa, 0x96, 0xd7, 0x39, 0xbd, 0xe2, 0x71, 0x16,
-		0xff, 0x6d, 0x04, 0x52, 0xa2, 0x18, 0x9d, 0x84, 0x78, 0x57, 0x73, 0xf7, 0x29, 0xdc, 0x74, 0x39,
-		0x2a, 0x45, 0x14, 0xfa, 0x4c, 0xca, 0x9d, 0xae, 0x66, 0x52, 0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c,
-		0x6b, 0x1b, 0x6b, 0x4d, 0x9a, 0x0b, 0xb0, 0x3a, 0x1d, 0x6c, 0xba, 0x8e, 0x18, 0x57, 0x5e, 0x5e,
-		0xe1, 0xc5, 0xe8, 0x51, 0x98, 0x71, 0x6d, 0xcd, 0x68, 0x87, 0x68, 0xe3, 0x94, 0x56, 0x12, 0x15,
-		0x1e, 0xf1, 0x0a, 0x9c, 0x16, 0xb8, 0x4d, 0xec, 0x6a, 0xfa, 0x3e, 0x6e, 0xfa, 0x4c, 0x09, 0x9a,
-		0xf3, 0x3b, 0xc5, 0x09, 0x56, 0x79, 0xbd, 0xe0, 0x95, 0x3f, 0x1f, 0x85, 0x19, 0x91, 0xbd, 0x68,
-		0x7a, 0xc2, 0xda, 0x00, 0xd0, 0x4c, 0xd3, 0x72, 0x83, 0xe2, 0x1a, 0x54, 0xe5, 0x01, 0xbe, 0xe5,
-		0x92, 0xc7, 0xa4, 0x04, 0x00, 0x8a, 0xbf, 0x17, 0x01, 0xf0, 0xab, 0x46, 0xca, 0x6d, 0x11, 0x32,
-		0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2, 0xab, 0x46, 0x9b, 0xa6, 0x25, 0x77,
-		0x71, 0xcb, 0x30, 0xf9, 0x7e, 0x0a, 0x7b, 0x10, 0x69, 0xc9, 0xb8, 0xbf, 0x3d, 0xa5, 0x40, 0xca,
-		0xc1, 0x1d, 0xcd, 0x74, 0x0d, 0x9d, 0xef, 0x90, 0x5c, 0x3e, 0x56, 0xe3, 0x97, 0xeb, 0x9c, 0x5b,
-		0xf1, 0x70, 0xe4, 0x73, 0x90, 0x12, 0xa5, 0xc4, 0xf1, 0xdb, 0xdc, 0xda, 0xac, 0x4a, 0x53, 0x28,
-		0x09, 0xb1, 0x7a, 0xb5, 0x21, 0x45, 0x48, 0xd8, 0x59, 0x5a, 0xaf, 0x95, 0xea, 0x52, 0xb4, 0xfc,
-		0xd7, 0x60, 0x56, 0xb7, 0x3a, 0xfd, 0x2f, 0x2c, 0x4b, 0x7d, 0x29, 0x3f, 0xe7, 0x5a, 0xe4, 0xb5,
-		0xc7, 0x39, 0x51, 0xcb, 0x6a, 0x6b, 0x66, 0x6b, 0xd9, 0xb2, 0x5b, 0xfe, 0xb1, 0x08, 0x12, 0x1d,
-		0x38, 0x81, 0xc3, 0x11, 0xdd, 0xdd, 0xff, 0x11, 0x89, 0xfc, 0x50, 0x34, 0xb6, 0xb6, 0x5d, 0xfe,
-		0xf1, 0x68, 0x71, 0x8d, 0x31, 0x6e, 0x8b, 0xee, 0x28, 0x78, 0xaf, 0x8d, 0x75, 0xd2, 0x78, 0xf8,
-		0xfd, 0x47, 0x61, 0xae, 0x65, 0xb5, 0x2c, 0x8a, 0x74, 0x81, 0xfc, 0xe2, 0xe7, 0x2a, 0xd2, 0x5e,
-		0x69, 0x71, 0xec, 0x21, 0x8c, 0x95, 0x4d, 0x98, 0xe5, 0xc4, 0x2a, 0xdd, 0xbe, 0x65, 0xc9, 0x05,
-		0x74, 0x64, 0x66, 0x7b, 0xfe, 0x27, 0x7f, 0x9b, 0x7a, 0x25, 0xca, 0x0c, 0x67, 0x25, 0x75, 0x2c,
-		0xff, 0xb0, 0xa2, 0xc0, 0x89, 0x10, 0x1e, 0xb3, 0x11, 0xd8, 0x1e, 0x83, 0xf8, 0x2f, 0x38, 0xe2,
-		0x6c, 0x00, 0xb1, 0xce, 0x59, 0x57, 0x2a, 0x90, 0x3b, 0x0e, 0xd6, 0xbf, 0xe4, 0x58, 0x59, 0x1c,
-		0x04, 0x59, 0x83, 0x02, 0x05, 0xd1, 0x7b, 0x8e, 0x6b, 0x75, 0xa8, 0x01, 0x3e, 0x1a, 0xe6, 0x5f,
-		0xfd, 0x36, 0x9b, 0xb4, 0x79, 0xc2, 0x56, 0xf1, 0xb8, 0x56, 0x56, 0x80, 0xee, 0x58, 0x37, 0xb1,
-		0xde, 0x1e, 0x83, 0xf0, 0x2b, 0xbc, 0x21, 0x1e, 0xfd, 0xca, 0x0d, 0x98, 0x23, 0xbf, 0xa9, 0x7d,
-		0x0c, 0xb6, 0x64, 0x7c, 0x1a, 0x7c, 0xfe, 0xdf, 0x7d, 0x94, 0xd9, 0x85, 0x59, 0x0f, 0x20, 0xd0,
-		0xa6, 0xc0, 0x28, 0xb6, 0xb0, 0xeb, 0x62, 0xdb, 0x51, 0xb5, 0xf6, 0xb0, 0xe6, 0x05, 0xf2, 0x88,
-		0xf3, 0xdf, 0xf7, 0xe5, 0xf0, 0x28, 0xae, 0x31, 0xce, 0x52, 0xbb, 0xbd, 0xb2, 0x03, 0xa7, 0x86,
-		0x68, 0xc5, 0x04, 0x98, 0xdf, 0xcf, 0x31, 0xe7, 0x06, 0x34, 0x83, 0xc0, 0x6e, 0x83, 0x28, 0xf7,
-		0xc6, 0x72, 0x02, 0xcc, 0x1f, 0xe0, 0x98, 0x88, 0xf3, 0x8a, 0x21, 0x25, 0x88, 0xd7, 0x61, 0xe6,
-		0x16, 0xb6, 0x77, 0x2d, 0x87, 0xe7, 0x6e, 0x27, 0x80, 0xfb, 0x24, 0x87, 0x2b, 0x70, 0x46, 0x9a,
-		0xcc, 0x25, 0x58, 0xcf, 0x41, 0x6a, 0x4f, 0xd3, 0xf1, 0x04, 0x10, 0x77, 0x39, 0x44, 0x92, 0xd0,
-		0x13, 0xd6, 0x12, 0x64, 0x5b, 0x16, 0x5f, 0x22, 0xc7, 0xb3, 0xff, 0x20, 0x67, 0xcf, 0x08, 0x1e,
-		0x0e, 0xd1, 0xb5, 0xba, 0xbd, 0x36, 0x59, 0x3f, 0xc7, 0x43, 0xfc, 0x1d, 0x01, 0x21, 0x78, 0x38,
-		0xc4, 0x31, 0xc4, 0xfa, 0xa6, 0x80, 0x70, 0x02, 0xf2, 0x7c, 0x01, 0x32, 0x96, 0xd9, 0x3e, 0xb4,
-		0xcc, 0x49, 0x1a, 0xf1, 0x29, 0x8e, 0x00, 0x9c, 0x85, 0x00, 0x5c, 0x81, 0xf4, 0xa4, 0x03, 0xf1,
-		0x23, 0x5f, 0x16, 0xd3, 0x43, 0x8c, 0xc0, 0x1a, 0x14, 0x84, 0x81, 0x32, 0x2c, 0x73, 0x02, 0x88,
-		0x1f, 0xe5, 0x10, 0xf9, 0x00, 0x1b, 0xef, 0x86, 0x8b, 0x1d, 0xb7, 0x85, 0x27, 0x01, 0xf9, 0xb4,
-		0xe8, 0x06, 0x67, 0xe1, 0xa2, 0xdc, 0xc5, 0xa6, 0xbe, 0x3f, 0x19, 0xc2, 0x67, 0x84, 0x28, 0x05,
-		0x0f, 0x81, 0xa8, 0x40, 0xae, 0xa3, 0xd9, 0xce, 0xbe, 0xd6, 0x9e, 0x68, 0x38, 0x7e, 0x8c, 0x63,
-		0x64, 0x3d, 0x26, 0x2e, 0x91, 0x9e, 0x79, 0x1c, 0x98, 0x1f, 0x17, 0x12, 0x09, 0xb0, 0xf1, 0xa9,
-		0xe7, 0xb8, 0x34, 0xd1, 0x7d, 0x1c, 0xb4, 0xbf, 0x27, 0xa6, 0x1e, 0xe3, 0xdd, 0x08, 0x22, 0x5e,
-		0x81, 0xb4, 0x63, 0xbc, 0x31, 0x11, 0xcc, 0xdf, 0x17, 0x23, 0x4d, 0x19, 0x08, 0xf3, 0xab, 0x70,
-		0x7a, 0xe8, 0x32, 0x31, 0x01, 0xd8, 0x3f, 0xe0, 0x60, 0x27, 0x87, 0x2c, 0x15, 0xdc, 0x24, 0x1c,
-		0x17, 0xf2, 0x1f, 0x0a, 0x93, 0x80, 0xfb, 0xb0, 0xb6, 0x49, 0xd0, 0xe2, 0x68, 0x7b, 0xc7, 0x93,
-		0xda, 0x3f, 0x12, 0x52, 0x63, 0xbc, 0x21, 0xa9, 0x35, 0xe0, 0x24, 0x47, 0x3c, 0xde, 0xb8, 0xfe,
-		0x84, 0x30, 0xac, 0x8c, 0x7b, 0x27, 0x3c, 0xba, 0x5f, 0x03, 0x45, 0x4f, 0x9c, 0xc2, 0x3b, 0x76,
-		0xd4, 0x8e, 0xd6, 0x9d, 0x00, 0xf9, 0x27, 0x39, 0xb2, 0xb0, 0xf8, 0x9e, 0x7b, 0xed, 0x6c, 0x68,
-		0x5d, 0x02, 0xfe, 0x0a, 0xcc, 0x0b, 0xf0, 0x9e, 0x69, 0x63, 0xdd, 0x6a, 0x99, 0xc6, 0x1b, 0xb8,
-		0x39, 0x01, 0xf4, 0x4f, 0xf5, 0x0d, 0xd5, 0x4e, 0x80, 0x9d, 0x20, 0xd7, 0x40, 0xf2, 0x7c, 0x15,
-		0xd5, 0xe8, 0x74, 0x2d, 0xdb, 0x1d, 0x83, 0xf8, 0xd3, 0x62, 0xa4, 0x3c, 0xbe, 0x1a, 0x65, 0x5b,
-		0xa9, 0x02, 0x3b, 0xfd, 0x31, 0xa9, 0x4a, 0x7e, 0x96, 0x03, 0xe5, 0x7c, 0x2e, 0x6e, 0x38, 0x74,
-		0xab, 0xd3, 0xd5, 0xec, 0x49, 0xec, 0xdf, 0x3f, 0x16, 0x86, 0x83, 0xb3, 0x70, 0xc3, 0x41, 0x3c,
-		0x3a, 0xb2, 0xda, 0x4f, 0x80, 0xf0, 0x33, 0xc2, 0x70, 0x08, 0x1e, 0x0e, 0x21, 0x1c, 0x86, 0x09,
-		0x20, 0x3e, 0x27, 0x20, 0x04, 0x0f, 0x81, 0x78, 0xc9, 0x5f, 0x68, 0x6d, 0xdc, 0x32, 0x1c, 0xd7,
-		0x66, 0x2e, 0xf9, 0xd1, 0x50, 0x3f, 0xfb, 0xe5, 0xb0, 0x13, 0xa6, 0x04, 0x58, 0x89, 0x25, 0xe2,
-		0x5b, 0x1f, 0x34, 0x64, 0x1b, 0xdf, 0xb0, 0x9f, 0x13, 0x96, 0x28, 0xc0, 0x46, 0xda, 0x16, 0xf0,
-		0x10, 0x89, 0xd8, 0x75, 0x12, 0xa8, 0x4c, 0x00, 0xf7, 0x4f, 0xfa, 0x1a, 0x57, 0x17, 0xbc, 0x04,
-		0x33, 0xe0, 0xff, 0xf4, 0xcc, 0x9b, 0xf8, 0x70, 0x22, 0xed, 0xfc, 0xf9, 0x3e, 0xff, 0x67, 0x87,
-		0x71, 0x32, 0x1b, 0x52, 0xe8, 0xf3, 0xa7, 0xd0, 0xb8, 0xb3, 0x7e, 0xf3, 0xdf, 0xf8, 0x0e, 0xef,
-		0x6f, 0xd8, 0x9d, 0x5a, 0x59, 0x27, 0x4a, 0x1e, 0x76, 0x7a, 0xc6, 0x83, 0x7d, 0xf4, 0x1d, 0x4f,
-		0xcf, 0x43, 0x3e, 0xcf, 0xca, 0x55, 0xc8, 0x85, 0x1c, 0x9e, 0xf1, 0x50, 0xdf, 0xcc, 0xa1, 0xb2,
-		0x41, 0x7f, 0x67, 0xe5, 0x12, 0xc4, 0x89, 0xf3, 0x32, 0x9e, 0xfd, 0x5b, 0x38, 0x3b, 0x25, 0x5f,
-		0x79, 0x1e, 0x52, 0xc2, 0x69, 0x19, 0xcf, 0xfa, 0xd7, 0x39, 0xab, 0xc7, 0x42, 0xd8, 0x85, 0xc3,
-		0x32, 0x9e, 0xfd, 0x6f, 0x08, 0x76, 0xc1, 0x42, 0xd8, 0x27, 0x17, 0xe1, 0x2f, 0xfd, 0xcd, 0x38,
-		0x5f, 0x74, 0x84, 0xec, 0xae, 0x40, 0x92, 0x7b, 0x2a, 0xe3, 0xb9, 0xbf, 0x95, 0xbf, 0x5c, 0x70,
-		0xac, 0x3c, 0x03, 0xd3, 0x13, 0x0a, 0xfc, 0x6f, 0x71, 0x56, 0x46, 0xbf, 0x52, 0x81, 0x4c, 0xc0,
-		0x3b, 0x19, 0xcf, 0xfe, 0xed, 0x9c, 0x3d, 0xc8, 0x45, 0x9a, 0xce, 0xbd, 0x93, 0xf1, 0x00, 0xdf,
-		0x21, 0x9a, 0xce, 0x39, 0x88, 0xd8, 0x84, 0x63, 0x32, 0x9e, 0xfb, 0xe3, 0x42, 0xea, 0x82, 0x65,
-		0xe5, 0x05, 0x48, 0x7b, 0x8b, 0xcd, 0x78, 0xfe, 0xef, 0xe4, 0xfc, 0x3e, 0x0f, 0x91, 0x40, 0x60,
-		0xb1, 0x1b, 0x0f, 0xf1, 0x5d, 0x42, 0x02, 0x01, 0x2e, 0x32, 0x8d, 0xfa, 0x1d, 0x98, 0xf1, 0x48,
-		0xdf, 0x2d, 0xa6, 0x51, 0x9f, 0xff, 0x42, 0x46, 0x93, 0xda, 0xfc, 0xf1, 0x10, 0xdf, 0x23, 0x46,
-		0x93, 0xd2, 0x93, 0x66, 0xf4, 0x7b, 0x04, 0xe3, 0x31, 0xbe, 0x57, 0x34, 0xa3, 0xcf, 0x21, 0x58,
-		0xd9, 0x06, 0x34, 0xe8, 0x0d, 0x8c, 0xc7, 0xfb, 0x04, 0xc7, 0x9b, 0x19, 0x70, 0x06, 0x56, 0x5e,
-		0x86, 0x93, 0xc3, 0x3d, 0x81, 0xf1, 0xa8, 0xdf, 0xf7, 0x4e, 0x5f, 0xec, 0x16, 0x74, 0x04, 0x56,
-		0x1a, 0xfe, 0x92, 0x12, 0xf4, 0x02, 0xc6, 0xc3, 0x7e, 0xff, 0x3b, 0x61, 0xc3, 0x1d, 0x74, 0x02,
-		0x56, 0x4a, 0x00, 0xfe, 0x02, 0x3c, 0x1e, 0xeb, 0x93, 0x1c, 0x2b, 0xc0, 0x44, 0xa6, 0x06, 0x5f,
-		0x7f, 0xc7, 0xf3, 0xdf, 0x15, 0x53, 0x83, 0x73, 0x90, 0xa9, 0x21, 0x96, 0xde, 0xf1, 0xdc, 0x3f,
-		0x28, 0xa6, 0x86, 0x60, 0x21, 0x9a, 0x1d, 0x58, 0xdd, 0xc6, 0x23, 0x7c, 0x4a, 0x68, 0x76, 0x80,
-		0x6b, 0x65, 0x13, 0x66, 0x06, 0x16, 0xc4, 0xf1, 0x50, 0x3f, 0xc4, 0xa1, 0xa4, 0xfe, 0xf5, 0x30,
-		0xb8, 0x78, 0xf1, 0xc5, 0x70, 0x3c, 0xda, 0x0f, 0xf7, 0x2d, 0x5e, 0x7c, 0x2d, 0x5c, 0xb9, 0x02,
-		0x29, 0xb3, 0xd7, 0x6e, 0x93, 0xc9, 0x83, 0x8e, 0x3e, 0x9f, 0x3b, 0xff, 0x5f, 0xbe, 0xc2, 0xa5,
-		0x23, 0x18, 0x56, 0x2e, 0xc1, 0x34, 0xee, 0xec, 0xe2, 0xe6, 0x38, 0xce, 0x2f, 0x7d, 0x45, 0x18,
-		0x4c, 0x42, 0xbd, 0xf2, 0x02, 0x00, 0x4b, 0x8d, 0xd0, 0x8d, 0xf3, 0x31, 0xbc, 0xbf, 0xf7, 0x15,
-		0x7e, 0x20, 0xce, 0x67, 0xf1, 0x01, 0xd8, 0xf1, 0xba, 0xa3, 0x01, 0xbe, 0x1c, 0x06, 0xa0, 0x23,
-		0xf2, 0x1c, 0x24, 0x5f, 0x77, 0x2c, 0xd3, 0xd5, 0x5a, 0xe3, 0xb8, 0x7f, 0x9f, 0x73, 0x0b, 0x7a,
-		0x22, 0xb0, 0x8e, 0x65, 0x63, 0x57, 0x6b, 0x39, 0xe3, 0x78, 0xff, 0x2b, 0xe7, 0xf5, 0x18, 0x08,
-		0xb3, 0xae, 0x39, 0xee, 0x24, 0xfd, 0xfe, 0x03, 0xc1, 0x2c, 0x18, 0x48, 0xa3, 0xc9, 0xef, 0x9b,
-		0xf8, 0x70, 0x1c, 0xef, 0x1f, 0x8a, 0x46, 0x73, 0xfa, 0x95, 0xe7, 0x21, 0x4d, 0x7e, 0xb2, 0x53,
-		0xae, 0x63, 0x98, 0xff, 0x88, 0x33, 0xfb, 0x1c, 0xe4, 0xcd, 0x8e, 0xdb, 0x74, 0x8d, 0xf1, 0xc2,
-		0xfe, 0x63, 0x3e, 0xd2, 0x82, 0x7e, 0xa5, 0x04, 0x19, 0xc7, 0x6d, 0x36, 0x7b, 0xdc, 0x3f, 0x1d,
-		0xc3, 0xfe, 0x27, 0x5f, 0xf1, 0x52, 0x16, 0x1e, 0x0f, 0x19, 0xed, 0xdb, 0x37, 0xdd, 0xae, 0x45,
-		0xf7, 0x5b, 0xc6, 0x21, 0xbc, 0xc3, 0x11, 0x02, 0x2c, 0x2b, 0x15, 0xc8, 0x92, 0xbe, 0xd8, 0xb8,
-		0x8b, 0xe9, 0xe6, 0xd8, 0x18, 0x88, 0x3f, 0xe5, 0x02, 0x08, 0x31, 0x95, 0xbf, 0xe1, 0x57, 0xde,
-		0x5e, 0x88, 0x7c, 0xfe, 0xed, 0x85, 0xc8, 0x6f, 0xbe, 0xbd, 0x10, 0xf9, 0xf8, 0x17, 0x16, 0xa6,
-		0x3e, 0xff, 0x85, 0x85, 0xa9, 0xdf, 0xf8, 0xc2, 0xc2, 0xd4, 0xf0, 0x2c, 0x31, 0xac, 0x59, 0x6b,
-		0x16, 0xcb, 0x0f, 0xbf, 0xf6, 0x50, 0xcb, 0x70, 0xf7, 0x7b, 0xbb, 0xcb, 0xba, 0xd5, 0xb9, 0xa0,
-		0x5b, 0x4e, 0xc7, 0x72, 0x2e, 0x84, 0xf3, 0xba, 0xf4, 0x17, 0xfc, 0xaf, 0x08, 0x89, 0x99, 0xc3,
-		0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0xf5, 0x31, 0xdd, 0x65, 0x88, 0x95, 0xcc, 0x43, 0x74, 0x9a, 0x19,
-		0x38, 0xb5, 0x67, 0xb7, 0xf9, 0x51, 0xcb, 0x24, 0x79, 0xde, 0xb1, 0xdb, 0x68, 0xce, 0x3f, 0x0f,
-		0x1d, 0x39, 0x97, 0xe5, 0x87, 0x9c, 0xcb, 0xdf, 0x1e, 0x39, 0x5e, 0x4f, 0x52, 0x25, 0xf3, 0x90,
-		0x76, 0x64, 0x3b, 0xf2, 0xda, 0x63, 0x63, 0xf3, 0xdc, 0x37, 0x4d, 0xeb, 0xb6, 0x49, 0x9a, 0xdd,
-		0xdd, 0x15, 0x39, 0xee, 0x85, 0xfe, 0x1c, 0xf7, 0xcb, 0xb8, 0xdd, 0x7e, 0x91, 0xd0, 0x35, 0x08,
-		0xcb, 0x6e, 0x82, 0x9d, 0xea, 0x87, 0xef, 0x8e, 0xc2, 0xc2, 0x40, 0x3a, 0x9b, 0x2b, 0xc1, 0x28,
-		0x21, 0xac, 0x40, 0x6a, 0x55, 0xe8, 0xd6, 0x3c, 0x24, 0x1d, 0xac, 0x5b, 0x66, 0xd3, 0xa1, 0x82,
-		0x88, 0x29, 0xe2, 0x91, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe1, 0x87, 0x95, 0xd9, 0x43, 0xf9, 0x07,
-		0x8e, 0x29, 0x88, 0x9c, 0x78, 0x93, 0x90, 0xc6, 0x93, 0x13, 0x4a, 0x43, 0x74, 0x22, 0x94, 0xf9,
-		0x9f, 0x54, 0x2a, 0xdf, 0x1b, 0x85, 0xc5, 0x7e, 0xa9, 0x90, 0x99, 0xe5, 0xb8, 0x5a, 0xa7, 0x3b,
-		0x4a, 0x2c, 0x57, 0x20, 0xdd, 0x10, 0x34, 0xc7, 0x96, 0xcb, 0xdd, 0x63, 0xca, 0x25, 0xef, 0xbd,
-		0x4a, 0x08, 0xe6, 0xe2, 0x84, 0x82, 0xf1, 0xfa, 0x71, 0x4f, 0x92, 0xf9, 0xb3, 0x04, 0x9c, 0x66,
-		0xd3, 0x49, 0x65, 0x53, 0x89, 0x3d, 0x70, 0x99, 0x64, 0x83, 0x55, 0xe3, 0xf7, 0x49, 0xe4, 0x17,
-		0x61, 0xb6, 0x46, 0xac, 0x05, 0x89, 0x82, 0xfc, 0x1d, 0x9e, 0xa1, 0xe7, 0xb9, 0x97, 0x42, 0x0e,
-		0x3f, 0xdf, 0xdf, 0x0a, 0x16, 0xc9, 0xdf, 0x18, 0x01, 0xa9, 0xae, 0x6b, 0x6d, 0xcd, 0xfe, 0x8b,
-		0x42, 0xa1, 0x67, 0x00, 0xd8, 0x71, 0x0f, 0xef, 0xc3, 0xbd, 0xfc, 0xc5, 0xf9, 0xe5, 0x60, 0xe7,
-		0x96, 0xd9, 0x9b, 0xe8, 0x09, 0xaa, 0x34, 0xa5, 0x25, 0x3f, 0xcf, 0xbf, 0x02, 0xe0, 0x57, 0xa0,
-		0x33, 0x70, 0xaa, 0x5e, 0x29, 0xad, 0x97, 0x14, 0x71, 0x48, 0xa8, 0xbe, 0x5d, 0xad, 0xd4, 0xae,
-		0xd6, 0xaa, 0xab, 0xd2, 0x14, 0x3a, 0x09, 0x28, 0x58, 0xe9, 0x1d, 0x6a, 0x3a, 0x01, 0x33, 0xc1,
-		0x72, 0xf6, 0x95, 0x4a, 0x94, 0x78, 0x8a, 0x46, 0xa7, 0xdb, 0xc6, 0x74, 0xe7, 0x51, 0x35, 0x84,
-		0xd4, 0xc6, 0x3b, 0x21, 0xbf, 0xfa, 0xef, 0xd9, 0x97, 0x0b, 0xb3, 0x3e, 0xbb, 0x27, 0xf3, 0x95,
-		0x75, 0x98, 0xd1, 0x74, 0x1d, 0x77, 0x43, 0x90, 0x63, 0x4c, 0x35, 0x01, 0xa4, 0x7b, 0xa9, 0x9c,
-		0xd3, 0x47, 0x7b, 0x06, 0x12, 0x0e, 0xed, 0xfd, 0x38, 0x88, 0x5f, 0xe3, 0x10, 0x9c, 0x7c, 0xc5,
-		0x84, 0x19, 0xe2, 0xf9, 0x69, 0x36, 0x0e, 0x34, 0xe3, 0xe8, 0x3c, 0xc3, 0x2f, 0xfc, 0xf4, 0x13,
-		0x74, 0x67, 0xf5, 0x81, 0xf0, 0xb0, 0x0c, 0x51, 0x27, 0x45, 0xe2, 0xd8, 0x7e, 0x43, 0x31, 0xe4,
-		0xc5, 0xfb, 0x78, 0x83, 0x8f, 0x7e, 0xd9, 0x2f, 0xf2, 0x97, 0x2d, 0x0c, 0xd3, 0x81, 0xc0, 0x9b,
-		0x72, 0x1c, 0x95, 0x55, 0x94, 0xab, 0xa3, 0xe6, 0xf4, 0x6b, 0x8f, 0x0e, 0xae, 0x4e, 0xec, 0xbf,
-		0xc7, 0x29, 0xf2, 0x95, 0xe0, 0x6b, 0xbc, 0xb9, 0xf7, 0x03, 0x71, 0x98, 0xd1, 0x3a, 0x86, 0x69,
-		0x5d, 0xa0, 0xff, 0xf2, 0x39, 0x37, 0x4d, 0x1f, 0x26, 0xd8, 0x94, 0xbc, 0xcc, 0xa6, 0xc2, 0x78,
-		0x8d, 0xf9, 0xa3, 0x6f, 0xfb, 0xd1, 0x69, 0x7f, 0xba, 0xac, 0x6c, 0x80, 0x24, 0x0e, 0xf1, 0x62,
-		0x53, 0xb7, 0x9a, 0x13, 0x65, 0x29, 0xfe, 0x58, 0x60, 0x88, 0xfc, 0x56, 0x95, 0xb3, 0xae, 0x7c,
-		0x10, 0x52, 0x1e, 0xcc, 0x38, 0xcf, 0x44, 0x80, 0x78, 0x1c, 0xc4, 0x2f, 0x61, 0x33, 0x73, 0x12,
-		0x2f, 0xf4, 0x1d, 0xc1, 0xcf, 0x66, 0xe8, 0x26, 0xe9, 0xcd, 0x1a, 0xe4, 0x9b, 0x96, 0xe9, 0xaa,
-		0x56, 0xc7, 0x70, 0x71, 0xa7, 0xeb, 0x8e, 0xf5, 0xeb, 0xfe, 0x94, 0x81, 0xa4, 0x94, 0x1c, 0xe1,
-		0xdb, 0x12, 0x6c, 0xa4, 0x25, 0xec, 0x3b, 0xbd, 0x49, 0x5a, 0xf2, 0xdf, 0xbc, 0x96, 0x50, 0x1e,
-		0xd2, 0x92, 0x7b, 0xd2, 0x0e, 0xa7, 0x79, 0x93, 0x1b, 0x73, 0xf7, 0x80, 0x69, 0x81, 0xa7, 0x1d,
-		0x7f, 0x10, 0x83, 0x05, 0x4e, 0xbc, 0xab, 0x39, 0xf8, 0xc2, 0xad, 0x27, 0x77, 0xb1, 0xab, 0x3d,
-		0x79, 0x41, 0xb7, 0x0c, 0xb1, 0x92, 0xcf, 0x72, 0x63, 0x4d, 0xea, 0x97, 0x79, 0x7d, 0x71, 0xe8,
-		0x76, 0x77, 0x71, 0xb4, 0x91, 0x2f, 0x0e, 0xea, 0xa0, 0xfc, 0x1a, 0xc4, 0x2b, 0x96, 0x61, 0x92,
-		0xb5, 0xad, 0x89, 0x4d, 0xab, 0xc3, 0xcd, 0x2d, 0x7b, 0x40, 0x97, 0x21, 0xa1, 0x75, 0xac, 0x9e,
-		0xe9, 0x32, 0x53, 0x5b, 0x5e, 0xf8, 0x95, 0xb7, 0x16, 0xa7, 0xfe, 0xe3, 0x5b, 0x8b, 0xb1, 0x9a,
-		0xe9, 0xfe, 0xfa, 0x67, 0x1f, 0x07, 0x8e, 0x5e, 0x33, 0xdd, 0xcf, 0xfc, 0xee, 0x4f, 0x9c, 0x8f,
-		0x28, 0x9c, 0x7a, 0x25, 0xfe, 0xc5, 0x37, 0x17, 0x23, 0xf2, 0x2b, 0x90, 0x5c, 0xc5, 0xfa, 0x11,
-		0xf0, 0x4f, 0xf6, 0xc1, 0x9f, 0x16, 0xf0, 0xab, 0x58, 0x0f, 0xc0, 0xaf, 0x62, 0xbd, 0x0f, 0xf9,
-		0x19, 0x48, 0xd5, 0x4c, 0x97, 0x7d, 0x42, 0xf4, 0x28, 0xc4, 0x0c, 0x93, 0x9d, 0x4a, 0x0f, 0x20,
-		0x0c, 0x34, 0x50, 0x21, 0x54, 0x84, 0x71, 0x15, 0xeb, 0x1e, 0x63, 0x13, 0xeb, 0xfd, 0x8c, 0x83,
-		0xaf, 0x26, 0x54, 0xe5, 0xd5, 0xdf, 0xf8, 0xad, 0x85, 0xa9, 0x8f, 0xbc, 0xbd, 0x30, 0x35, 0x72,
-		0xe8, 0xe5, 0xf1, 0x43, 0xef, 0x8d, 0xf8, 0x8f, 0xc6, 0xe1, 0x7e, 0xfa, 0x65, 0xa9, 0xdd, 0x31,
-		0x4c, 0xf7, 0x82, 0x6e, 0x1f, 0x76, 0x5d, 0x8b, 0x18, 0x00, 0x6b, 0x8f, 0x0f, 0xf8, 0x8c, 0x5f,
-		0xbd, 0xcc, 0xaa, 0x87, 0x0f, 0xb7, 0xbc, 0x07, 0xd3, 0xdb, 0x84, 0x8f, 0x88, 0xd8, 0xb5, 0x5c,
-		0xad, 0xcd, 0xbd, 0x16, 0xf6, 0x40, 0x4a, 0xd9, 0xd7, 0xa8, 0x51, 0x56, 0x6a, 0x88, 0x0f, 0x51,
-		0xdb, 0x58, 0xdb, 0x63, 0x1f, 0xf5, 0xc4, 0xa8, 0xbb, 0x9b, 0x22, 0x05, 0xf4, 0xfb, 0x9d, 0x39,
-		0x98, 0xd6, 0x7a, 0xec, 0xe0, 0x4d, 0x8c, 0xf8, 0xc1, 0xf4, 0x41, 0x7e, 0x11, 0x92, 0x7c, 0xff,
-		0x1d, 0x49, 0x10, 0xbb, 0x89, 0x0f, 0xe9, 0x7b, 0xb2, 0x0a, 0xf9, 0x89, 0x96, 0x61, 0x9a, 0x36,
-		0x9e, 0x7f, 0xad, 0x38, 0xbf, 0x3c, 0xd0, 0xfa, 0x65, 0xda, 0x48, 0x85, 0x91, 0xc9, 0xd7, 0x21,
-		0xb5, 0x6a, 0x11, 0x2d, 0x0c, 0xa3, 0xa5, 0x19, 0x1a, 0x6d, 0x73, 0xb7, 0xc7, 0xb5, 0x42, 0x61,
-		0x0f, 0xe8, 0x24, 0x24, 0xd8, 0x47, 0x5e, 0xfc, 0xf0, 0x10, 0x7f, 0x92, 0x2b, 0x90, 0xa4, 0xd8,
-		0x5b, 0x5d, 0xe2, 0x32, 0x78, 0xe7, 0xde, 0xd3, 0xfc, 0x93, 0x5f, 0x0e, 0x1f, 0xf5, 0x1b, 0x8b,
-		0x20, 0xde, 0xd4, 0x5c, 0x8d, 0xf7, 0x9b, 0xfe, 0x96, 0x3f, 0x04, 0x29, 0x0e, 0xe2, 0xa0, 0x8b,
-		0x10, 0xb3, 0xba, 0x0e, 0x3f, 0xfe, 0x53, 0x1c, 0xd5, 0x95, 0xad, 0x6e, 0x39, 0x4e, 0x74, 0x46,
-		0x21, 0xc4, 0xe5, 0xcd, 0x91, 0x6a, 0xf1, 0x74, 0x48, 0x2d, 0x3a, 0xd8, 0xdd, 0xdd, 0x73, 0xfd,
-		0x1f, 0x6c, 0x38, 0x07, 0x54, 0xc1, 0x53, 0x94, 0xbb, 0x51, 0x58, 0x08, 0xd4, 0xde, 0xc2, 0xb6,
-		0x63, 0x58, 0x26, 0xd3, 0x26, 0xae, 0x29, 0x28, 0xd0, 0x40, 0x5e, 0x3f, 0x42, 0x55, 0x9e, 0x87,
-		0x58, 0xa9, 0xdb, 0x45, 0x45, 0x48, 0xd1, 0x67, 0xdd, 0x62, 0xba, 0x12, 0x57, 0xbc, 0x67, 0x52,
-		0xe7, 0x58, 0x7b, 0xee, 0x6d, 0xcd, 0xf6, 0xbe, 0x81, 0x16, 0xcf, 0xf2, 0x73, 0x90, 0xae, 0x58,
-		0xa6, 0x83, 0x4d, 0xa7, 0x47, 0x7d, 0xe1, 0xdd, 0xb6, 0xa5, 0xdf, 0xe4, 0x08, 0xec, 0x81, 0x08,
-		0x5b, 0xeb, 0x76, 0x29, 0x67, 0x5c, 0x21, 0x3f, 0xd9, 0x7c, 0x2d, 0x6f, 0x8d, 0x14, 0xcf, 0xa5,
-		0xe3, 0x89, 0x87, 0x77, 0xd0, 0x77, 0x6a, 0x23, 0x70, 0xdf, 0xe0, 0x44, 0xba, 0x89, 0x0f, 0x9d,
-		0xe3, 0xce, 0xa3, 0x57, 0x20, 0xbd, 0x4d, 0xaf, 0x27, 0x79, 0x11, 0x1f, 0xa2, 0x22, 0x24, 0x71,
-		0xf3, 0xe2, 0xa5, 0x4b, 0x4f, 0x3e, 0xc7, 0xb4, 0xfc, 0xda, 0x94, 0x22, 0x0a, 0xd0, 0x02, 0xa4,
-		0x1d, 0xac, 0x77, 0x2f, 0x5e, 0xba, 0x7c, 0xf3, 0x49, 0xa6, 0x56, 0xd7, 0xa6, 0x14, 0xbf, 0x68,
-		0x25, 0x45, 0x7a, 0xfc, 0xc5, 0x4f, 0x2d, 0x46, 0xca, 0xd3, 0x10, 0x73, 0x7a, 0x9d, 0xf7, 0x4c,
-		0x37, 0xfe, 0x30, 0x01, 0x4b, 0x81, 0x5a, 0xb6, 0xb8, 0xdc, 0xd2, 0xda, 0x46, 0x53, 0xf3, 0x2f,
-		0x95, 0x91, 0x02, 0xfd, 0xa7, 0x14, 0x23, 0x56, 0x8d, 0x23, 0xa5, 0x28, 0xff, 0x54, 0x04, 0xb2,
-		0x37, 0x04, 0x72, 0x1d, 0xbb, 0xe8, 0x0a, 0x80, 0xf7, 0x26, 0x31, 0x55, 0xce, 0x2c, 0xf7, 0xbf,
-		0x6b, 0xd9, 0xe3, 0x51, 0x02, 0xe4, 0xe8, 0x19, 0xaa, 0x80, 0x5d, 0xcb, 0xe1, 0xdf, 0xc3, 0x8e,
-		0x61, 0xf5, 0x88, 0xd1, 0x63, 0x80, 0xa8, 0x55, 0x53, 0x6f, 0x59, 0xae, 0x61, 0xb6, 0xd4, 0xae,
-		0x75, 0x9b, 0xdf, 0x32, 0x10, 0x53, 0x24, 0x5a, 0x73, 0x83, 0x56, 0x6c, 0x93, 0x72, 0xd2, 0xe8,
-		0xb4, 0x87, 0x42, 0xc2, 0x3a, 0xad, 0xd9, 0xb4, 0xb1, 0xe3, 0x70, 0xc3, 0x25, 0x1e, 0xd1, 0x15,
-		0x48, 0x76, 0x7b, 0xbb, 0xaa, 0xb0, 0x12, 0x99, 0x8b, 0xf7, 0x0d, 0x9b, 0xf3, 0x42, 0x37, 0xf8,
-		0xac, 0x4f, 0x74, 0x7b, 0xbb, 0x44, 0x53, 0x1e, 0x80, 0xec, 0x90, 0xc6, 0x64, 0x6e, 0xf9, 0xed,
-		0xa0, 0x37, 0xe2, 0xf0, 0x1e, 0xa8, 0x5d, 0xdb, 0xb0, 0x6c, 0xc3, 0x3d, 0xa4, 0xc7, 0xf6, 0x62,
-		0x8a, 0x24, 0x2a, 0xb6, 0x79, 0xb9, 0x7c, 0x13, 0x0a, 0x75, 0xea, 0xee, 0xfb, 0x2d, 0xbf, 0xe4,
-		0xb7, 0x2f, 0x32, 0xbe, 0x7d, 0x23, 0x5b, 0x16, 0x1d, 0x68, 0xd9, 0xf9, 0xff, 0x10, 0x81, 0x4c,
-		0x99, 0x4c, 0xdc, 0xda, 0xea, 0xd5, 0xb6, 0xd6, 0x42, 0x4f, 0xc2, 0x89, 0xf2, 0xfa, 0x56, 0xe5,
-		0x45, 0xb5, 0xb6, 0xaa, 0x5e, 0x5d, 0x2f, 0xad, 0xf9, 0xe7, 0x84, 0x8b, 0x27, 0xef, 0xdc, 0x5d,
-		0x42, 0x01, 0xda, 0x1d, 0x93, 0xc6, 0xa4, 0xe8, 0x02, 0xcc, 0x85, 0x59, 0x4a, 0xe5, 0x7a, 0x75,
-		0xb3, 0x21, 0x45, 0x8a, 0x27, 0xee, 0xdc, 0x5d, 0x9a, 0x09, 0x70, 0x94, 0x76, 0x1d, 0x6c, 0xba,
-		0x83, 0x0c, 0x95, 0xad, 0x8d, 0x8d, 0x5a, 0x43, 0x8a, 0x0e, 0x30, 0x54, 0xac, 0x4e, 0xc7, 0x70,
-		0xd1, 0x23, 0x30, 0x13, 0x66, 0xd8, 0xac, 0xad, 0x4b, 0xb1, 0x22, 0xba, 0x73, 0x77, 0x29, 0x1f,
-		0xa0, 0xde, 0x34, 0xda, 0xc5, 0xd4, 0xc7, 0x7e, 0x78, 0x61, 0xea, 0x33, 0x7f, 0x77, 0x21, 0x52,
-		0xde, 0x18, 0x39, 0xe7, 0x9e, 0x3a, 0xde, 0x9c, 0x0b, 0xaf, 0xdb, 0x5f, 0xb9, 0x2f, 0x64, 0x6e,
-		0xb8, 0x3f, 0x17, 0x30, 0xc6, 0x93, 0x4e, 0xb7, 0x71, 0x39, 0x8a, 0xe2, 0xd1, 0xee, 0x41, 0x71,
-		0xcc, 0xa2, 0x50, 0x1c, 0x6b, 0x18, 0xe4, 0xe7, 0x20, 0xb7, 0xad, 0xd9, 0x6e, 0x1d, 0xbb, 0xd7,
-		0xb0, 0xd6, 0xc4, 0x76, 0xd8, 0x7f, 0xc8, 0x09, 0xff, 0x01, 0x41, 0x9c, 0x3a, 0x09, 0x6c, 0xfd,
-		0xa4, 0xbf, 0xe5, 0x7d, 0x88, 0xd3, 0x13, 0xc9, 0x9e, 0x6f, 0xc1, 0x39, 0x98, 0x6f, 0x41, 0x56,
-		0x86, 0x43, 0x17, 0x3b, 0x22, 0x8d, 0x46, 0x1f, 0xd0, 0xd3, 0xc2, 0x43, 0x88, 0x1d, 0xed, 0x21,
-		0xf0, 0xe9, 0xc5, 0xfd, 0x84, 0x36, 0x24, 0xf9, 0x10, 0x7b, 0x0d, 0x89, 0xf8, 0x0d, 0x41, 0x1b,
-		0x50, 0xe8, 0x6a, 0xb6, 0x4b, 0xbf, 0x50, 0xdc, 0xa7, 0xbd, 0xe0, 0x33, 0x78, 0x71, 0xd0, 0x9e,
-		0x84, 0x3a, 0xcb, 0xdf, 0x92, 0xeb, 0x06, 0x0b, 0xe5, 0xdf, 0x89, 0x43, 0x82, 0x0b, 0xe3, 0x79,
-		0x48, 0x72, 0xb1, 0xf2, 0x39, 0x77, 0xff, 0xf2, 0xe0, 0x32, 0xbb, 0xec, 0x2d, 0x87, 0x1c, 0x4f,
-		0xf0, 0xa0, 0x87, 0x21, 0xa5, 0xef, 0x6b, 0x86, 0xa9, 0x1a, 0x4d, 0xee, 0xda, 0x66, 0xde, 0x7e,
-		0x6b, 0x31, 0x59, 0x21, 0x65, 0xb5, 0x55, 0x25, 0x49, 0x2b, 0x6b, 0x4d, 0xe2, 0xd3, 0xec, 0x63,
-		0xa3, 0xb5, 0xef, 0x72, 0xbb, 0xc1, 0x9f, 0xd0, 0xb3, 0x10, 0x27, 0x0a, 0xc1, 0xbf, 0x5f, 0x2f,
-		0x0e, 0x44, 0x28, 0x5e, 0x0a, 0xa9, 0x9c, 0x22, 0x2f, 0xfe, 0xf8, 0x7f, 0x5e, 0x8c, 0x28, 0x94,
-		0x03, 0x55, 0x20, 0xd7, 0xd6, 0x1c, 0x57, 0xa5, 0xeb, 0x31, 0x79, 0xfd, 0x34, 0x85, 0x38, 0x3d,
-		0x28, 0x10, 0x2e, 0x58, 0xde, 0xf4, 0x0c, 0xe1, 0x62, 0x45, 0x4d, 0x74, 0x0e, 0x24, 0x0a, 0xa2,
-		0xd3, 0x19, 0xc8, 0xbc, 0xc4, 0x04, 0x95, 0x7b, 0x9e, 0x94, 0xb3, 0x89, 0x49, 0x7d, 0xc5, 0x33,
-		0x90, 0xa6, 0x5f, 0xcc, 0x52, 0x12, 0x76, 0x0c, 0x3e, 0x45, 0x0a, 0x68, 0xe5, 0x59, 0x28, 0xf8,
-		0x56, 0x9f, 0x91, 0xa4, 0x18, 0x8a, 0x5f, 0x4c, 0x09, 0x9f, 0x80, 0x39, 0x13, 0x1f, 0xd0, 0x83,
-		0xf9, 0x21, 0xea, 0x34, 0xa5, 0x46, 0xa4, 0xee, 0x46, 0x98, 0xe3, 0x21, 0xc8, 0xeb, 0x42, 0xf8,
-		0x8c, 0x16, 0x28, 0x6d, 0xce, 0x2b, 0xa5, 0x64, 0xa7, 0x21, 0xa5, 0x75, 0xbb, 0x8c, 0x20, 0xc3,
-		0xad, 0x7e, 0xb7, 0x4b, 0xab, 0xce, 0xc3, 0x0c, 0xed, 0xa3, 0x8d, 0x9d, 0x5e, 0xdb, 0xe5, 0x20,
-		0x59, 0x4a, 0x53, 0x20, 0x15, 0x0a, 0x2b, 0xa7, 0xb4, 0x0f, 0x42, 0x0e, 0xdf, 0x32, 0x9a, 0xd8,
-		0xd4, 0x31, 0xa3, 0xcb, 0x51, 0xba, 0xac, 0x28, 0xa4, 0x44, 0x8f, 0x80, 0x67, 0xcd, 0x55, 0xb1,
-		0xd2, 0xe4, 0x19, 0x9e, 0x28, 0x2f, 0xb1, 0x62, 0x79, 0x1e, 0xe2, 0xab, 0x9a, 0xab, 0x11, 0x77,
-		0xc9, 0x3d, 0x60, 0xcb, 0x67, 0x56, 0x21, 0x3f, 0xe5, 0xcf, 0xc5, 0x20, 0x7e, 0xc3, 0x72, 0x31,
-		0x7a, 0x2a, 0xe0, 0xca, 0xe6, 0x87, 0xe9, 0x73, 0xdd, 0x68, 0x99, 0xb8, 0xb9, 0xe1, 0xb4, 0x02,
-		0xd7, 0xdb, 0xf8, 0xea, 0x14, 0x0d, 0xa9, 0xd3, 0x1c, 0x4c, 0xdb, 0x56, 0xcf, 0x6c, 0x8a, 0x03,
-		0xe4, 0xf4, 0x01, 0x55, 0x21, 0xe5, 0x69, 0x49, 0x7c, 0x9c, 0x96, 0x14, 0x88, 0x96, 0x10, 0x1d,
-		0xe6, 0x05, 0x4a, 0x72, 0x97, 0x2b, 0x4b, 0x19, 0xd2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x99, 0xc2,
-		0xfa, 0x6c, 0x64, 0x89, 0xf4, 0xc6, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xc9, 0xab, 0xe0, 0xd2, 0x0b,
-		0xa9, 0x15, 0xbf, 0x6a, 0x27, 0x49, 0xfb, 0xe5, 0xab, 0x15, 0xbb, 0x6e, 0xe7, 0x3e, 0x48, 0x3b,
-		0x46, 0xcb, 0xd4, 0xdc, 0x9e, 0x8d, 0xb9, 0xe6, 0xf9, 0x05, 0xa4, 0xd6, 0xff, 0x98, 0x82, 0x69,
-		0x5a, 0xe0, 0x06, 0xb0, 0x0b, 0x30, 0xeb, 0xdf, 0xbd, 0xe5, 0xa3, 0x30, 0x2d, 0x43, 0x5e, 0x55,
-		0x5d, 0xd4, 0xc8, 0xbf, 0x14, 0x81, 0x04, 0x5f, 0xb1, 0xfc, 0x61, 0x88, 0x0c, 0x1f, 0x86, 0xe8,
-		0xa8, 0x61, 0x88, 0xdd, 0xfb, 0x30, 0x94, 0x00, 0xbc, 0x66, 0x3a, 0xfc, 0x42, 0x95, 0x21, 0x6e,
-		0x15, 0x6b, 0x62, 0xdd, 0x68, 0xf1, 0x79, 0x1f, 0x60, 0x92, 0xff, 0x53, 0x84, 0x78, 0xf8, 0xbc,
-		0x1e, 0x95, 0x20, 0x27, 0xda, 0xa5, 0xee, 0xb5, 0xb5, 0x16, 0x57, 0xc5, 0xfb, 0x47, 0x36, 0x8e,
-		0xac, 0xc2, 0x4a, 0x86, 0xb7, 0x87, 0xfa, 0x13, 0x43, 0x87, 0x35, 0x3a, 0x62, 0x58, 0x43, 0x7a,
-		0x14, 0xbb, 0x37, 0x3d, 0x0a, 0x8d, 0x78, 0xbc, 0x6f, 0xc4, 0xe5, 0xdf, 0x8a, 0xf0, 0x9b, 0xbf,
-		0x9a, 0xec, 0x4b, 0x90, 0xbf, 0xac, 0xa1, 0x7a, 0x8d, 0xeb, 0x56, 0x13, 0x37, 0xd5, 0x81, 0x31,
-		0x7b, 0x70, 0x10, 0x31, 0xdc, 0x66, 0x7f, 0xec, 0x90, 0x40, 0xa9, 0xfb, 0x63, 0xf8, 0xd9, 0x28,
-		0xcc, 0x0c, 0xd0, 0xff, 0xdf, 0x37, 0x96, 0xe1, 0xd9, 0x3b, 0x3d, 0xe1, 0xec, 0x4d, 0x8c, 0x9c,
-		0xbd, 0x3f, 0x1d, 0xa5, 0x09, 0x80, 0xae, 0xe5, 0x68, 0xed, 0xf7, 0xc3, 0xf6, 0x9e, 0x81, 0x74,
-		0xd7, 0x6a, 0xab, 0xac, 0x86, 0x7d, 0xc2, 0x93, 0xea, 0x5a, 0x6d, 0x65, 0x40, 0xcd, 0xa6, 0xdf,
-		0x25, 0xc3, 0x9c, 0x78, 0x17, 0x06, 0x21, 0xd9, 0x3f, 0xa1, 0x6c, 0xc8, 0x32, 0x51, 0x70, 0xaf,
-		0xe9, 0x09, 0x22, 0x03, 0xea, 0x86, 0x45, 0x06, 0xbd, 0x3c, 0xd6, 0x6c, 0x46, 0xa9, 0x70, 0x3a,
-		0xc2, 0xc1, 0x9c, 0x8c, 0x61, 0x99, 0xa3, 0xa0, 0xc5, 0x52, 0x38, 0x9d, 0xfc, 0xb7, 0x23, 0x00,
-		0xeb, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71, 0x68, 0x13, 0xd4, 0xd0, 0x9b, 0x17, 0x46, 0x0d,
-		0x1a, 0x7f, 0x7f, 0xd6, 0x09, 0xb6, 0xbb, 0x02, 0x39, 0x5f, 0xb7, 0x1d, 0x2c, 0x1a, 0xb3, 0x70,
-		0x44, 0x54, 0x5a, 0xc7, 0xae, 0x92, 0xbd, 0x15, 0x78, 0x92, 0xff, 0x59, 0x04, 0xd2, 0xb4, 0x4d,
-		0x1b, 0xd8, 0xd5, 0x42, 0x63, 0x18, 0xb9, 0xf7, 0x31, 0xbc, 0x1f, 0x80, 0xc1, 0x38, 0xc6, 0x1b,
-		0x98, 0x6b, 0x56, 0x9a, 0x96, 0xd4, 0x8d, 0x37, 0x30, 0xba, 0xec, 0x09, 0x3c, 0x76, 0xb4, 0xc0,
-		0x45, 0xd4, 0xca, 0xc5, 0x7e, 0x0a, 0x92, 0xf4, 0x6e, 0xca, 0x03, 0x87, 0x07, 0xa2, 0x09, 0xb3,
-		0xd7, 0x69, 0x1c, 0x38, 0xf2, 0xeb, 0x90, 0x6c, 0x1c, 0xb0, 0x7c, 0xe2, 0x19, 0x48, 0xdb, 0x96,
-		0xc5, 0xbd, 0x3f, 0xe6, 0x75, 0xa7, 0x48, 0x01, 0x75, 0x76, 0x44, 0x0e, 0x2d, 0xea, 0xe7, 0xd0,
-		0xfc, 0x24, 0x60, 0x6c, 0xa2, 0x24, 0x20, 0x89, 0x3e, 0x73, 0xa1, 0x99, 0x84, 0x1e, 0x83, 0x53,
-		0xf5, 0xda, 0xda, 0x66, 0x75, 0x55, 0xdd, 0xa8, 0xaf, 0xf5, 0x7d, 0x9e, 0x5f, 0x2c, 0xdc, 0xb9,
-		0xbb, 0x94, 0xe1, 0x61, 0xe7, 0x28, 0xea, 0x6d, 0xa5, 0x7a, 0x63, 0xab, 0x51, 0x95, 0x22, 0x8c,
-		0x7a, 0xdb, 0xc6, 0xb7, 0x2c, 0x97, 0x5d, 0xfe, 0xfa, 0x04, 0x9c, 0x1e, 0x42, 0xed, 0x05, 0x9f,
-		0x33, 0x77, 0xee, 0x2e, 0xe5, 0xb6, 0x6d, 0xcc, 0xb4, 0x8c, 0x72, 0x2c, 0xc3, 0xfc, 0x20, 0xc7,
-		0xd6, 0xf6, 0x56, 0xbd, 0xb4, 0x2e, 0x2d, 0x15, 0xa5, 0x3b, 0x77, 0x97, 0xb2, 0xc2, 0x64, 0x10,
-		0xfa, 0xf7, 0x3e, 0xfa, 0xfc, 0xd6, 0x54, 0x28, 0x6b, 0xcc, 0xe2, 0xba, 0xae, 0x66, 0x6b, 0x9d,
-		0xe3, 0x86, 0x9f, 0x63, 0x0e, 0x0e, 0xc8, 0x3f, 0x15, 0x85, 0x82, 0x17, 0xdc, 0x6c, 0xd3, 0x37,
-		0xa0, 0xa7, 0x82, 0x19, 0xbf, 0xcc, 0xc8, 0xb5, 0x83, 0x51, 0x8b, 0x84, 0xe0, 0x07, 0x21, 0x25,
-		0x9c, 0x64, 0x3e, 0xa9, 0x96, 0x86, 0xac, 0x6f, 0x9c, 0x82, 0xb3, 0x7a, 0x1c, 0xe8, 0x05, 0x48,
-		0x7b, 0x53, 0xcc, 0xbb, 0x20, 0x6d, 0xf4, 0x9c, 0xe4, 0xfc, 0x3e, 0x0f, 0x7a, 0xce, 0x0f, 0xe3,
-		0xe2, 0xa3, 0x02, 0xc3, 0x1b, 0x8c, 0x80, 0x33, 0x7b, 0x21, 0xdc, 0x13, 0x10, 0xd7, 0x76, 0x75,
-		0x83, 0x1b, 0xe0, 0xfb, 0x06, 0xf9, 0x4a, 0xe5, 0x4a, 0x8d, 0x33, 0x51, 0x4a, 0xb9, 0xc6, 0x93,
-		0x29, 0x5c, 0x5e, 0xf4, 0x3a, 0x9a, 0x03, 0x95, 0xc5, 0xc2, 0xcc, 0xbb, 0x48, 0x75, 0xb4, 0x83,
-		0x32, 0x0d, 0x87, 0x4f, 0x41, 0x92, 0x54, 0xb6, 0xf8, 0x85, 0x07, 0x31, 0x25, 0xd1, 0xd1, 0x0e,
-		0xd6, 0x34, 0xe7, 0x7a, 0x3c, 0x15, 0x93, 0xe2, 0xf2, 0x8f, 0x11, 0x4f, 0x25, 0x24, 0x15, 0xf4,
-		0x28, 0x20, 0xc2, 0xa1, 0xb5, 0xb0, 0x4a, 0xa6, 0x2e, 0x15, 0xaf, 0xc0, 0x2d, 0x74, 0xb4, 0x83,
-		0x52, 0x0b, 0x6f, 0xf6, 0x3a, 0xb4, 0x01, 0x0e, 0xda, 0x00, 0x49, 0x10, 0x8b, 0x91, 0xe5, 0xe2,
-		0x3f, 0x3d, 0x78, 0xc1, 0x2a, 0x27, 0x60, 0x0b, 0xc0, 0x27, 0xc8, 0x02, 0x90, 0x67, 0x78, 0xde,
-		0x51, 0x91, 0x50, 0x57, 0x62, 0xe1, 0xae, 0xc8, 0x2f, 0x40, 0xa1, 0x6f, 0x04, 0x90, 0x0c, 0x39,
-		0x9e, 0xb1, 0xa2, 0xdb, 0xf0, 0x2c, 0xc2, 0x49, 0x2b, 0x19, 0x96, 0x99, 0xa2, 0xc7, 0x12, 0x56,
-		0x52, 0x3f, 0xfb, 0xe6, 0x62, 0x84, 0x6e, 0xe6, 0x3c, 0x0a, 0xb9, 0xd0, 0x18, 0x88, 0x2c, 0x72,
-		0xc4, 0xcf, 0x22, 0xfb, 0xc4, 0xaf, 0x41, 0x96, 0x18, 0x20, 0xdc, 0xe4, 0xb4, 0x0f, 0x43, 0x81,
-		0x19, 0xc8, 0x7e, 0x59, 0x33, 0x87, 0x67, 0x43, 0x08, 0x5c, 0x16, 0x1e, 0x50, 0x58, 0xec, 0x19,
-		0x41, 0xb5, 0xa6, 0x39, 0xf2, 0x16, 0x80, 0x3f, 0xa8, 0xa8, 0x04, 0xf7, 0x13, 0xe3, 0x11, 0x3c,
-		0x36, 0xca, 0xaf, 0xc6, 0x0a, 0x79, 0x8c, 0x45, 0x42, 0xe4, 0x1f, 0x0d, 0x65, 0x17, 0x64, 0x5d,
-		0xa3, 0x14, 0xe5, 0x97, 0x3e, 0xf3, 0xf6, 0x42, 0xe4, 0xbd, 0xb1, 0x05, 0xdf, 0xf5, 0x32, 0x9c,
-		0x09, 0x54, 0x12, 0x05, 0x0c, 0x25, 0xa2, 0x0a, 0x01, 0x7d, 0x25, 0x95, 0xe3, 0x12, 0x4a, 0x47,
-		0xe6, 0x7f, 0x8b, 0x47, 0x9b, 0x9d, 0xf1, 0xd9, 0xa6, 0xf1, 0xf9, 0xae, 0xe1, 0x49, 0xf9, 0x7f,
-		0x9a, 0x86, 0xa4, 0x82, 0x3f, 0xdc, 0xc3, 0x8e, 0x8b, 0x2e, 0x42, 0x1c, 0xeb, 0xfb, 0xd6, 0xb0,
-		0x1c, 0x28, 0xe9, 0xe0, 0x32, 0xa7, 0xab, 0xea, 0xfb, 0xd6, 0xb5, 0x29, 0x85, 0xd2, 0xa2, 0x4b,
-		0x30, 0xbd, 0xd7, 0xee, 0xf1, 0xf4, 0x55, 0x9f, 0xcd, 0x0a, 0x32, 0x5d, 0x25, 0x44, 0xd7, 0xa6,
-		0x14, 0x46, 0x4d, 0x5e, 0x45, 0x6f, 0xc6, 0x8e, 0x1d, 0xfd, 0xaa, 0x9a, 0xb9, 0x47, 0x5f, 0x45,
-		0x68, 0x51, 0x19, 0xc0, 0x30, 0x0d, 0x57, 0xa5, 0xa9, 0x1d, 0x6e, 0x35, 0x1e, 0x18, 0xcd, 0x69,
-		0xb8, 0x34, 0x19, 0x74, 0x6d, 0x4a, 0x49, 0x1b, 0xe2, 0x81, 0x34, 0xf7, 0xc3, 0x3d, 0x6c, 0x1f,
-		0x72, 0x6f, 0x6d, 0x64, 0x73, 0x5f, 0x22, 0x44, 0xa4, 0xb9, 0x94, 0x9a, 0x18, 0x59, 0x76, 0xc9,
-		0x9e, 0x7b, 0xc0, 0xaf, 0x8e, 0x5d, 0x1c, 0xc5, 0x49, 0x6f, 0xda, 0x6b, 0x1c, 0x5c, 0x9b, 0x52,
-		0x92, 0x3a, 0xfb, 0x89, 0x9e, 0xf5, 0x5c, 0xb0, 0xcc, 0xa0, 0xd7, 0x13, 0xe2, 0x65, 0x69, 0x9f,
-		0x29, 0xe1, 0x8a, 0xa1, 0x4d, 0xc8, 0xb7, 0x0d, 0xc7, 0x55, 0x1d, 0x53, 0xeb, 0x3a, 0xfb, 0x96,
-		0xeb, 0xd0, 0xfc, 0x49, 0xe6, 0xe2, 0x43, 0xa3, 0x10, 0xd6, 0x0d, 0xc7, 0xad, 0x0b, 0xe2, 0x6b,
-		0x53, 0x4a, 0xae, 0x1d, 0x2c, 0x20, 0x78, 0xd6, 0xde, 0x1e, 0xb6, 0x3d, 0x40, 0x9a, 0x67, 0x39,
-		0x02, 0x6f, 0x8b, 0x50, 0x0b, 0x7e, 0x82, 0x67, 0x05, 0x0b, 0xd0, 0xd7, 0xc0, 0x6c, 0xdb, 0xd2,
-		0x9a, 0x1e, 0x9c, 0xaa, 0xef, 0xf7, 0xcc, 0x9b, 0x34, 0x29, 0x93, 0xb9, 0xf8, 0xc8, 0xc8, 0x46,
-		0x5a, 0x5a, 0x53, 0x40, 0x54, 0x08, 0xc3, 0xb5, 0x29, 0x65, 0xa6, 0xdd, 0x5f, 0x88, 0xbe, 0x1e,
-		0xe6, 0xb4, 0x6e, 0xb7, 0x7d, 0xd8, 0x8f, 0x5e, 0xa0, 0xe8, 0xe7, 0x47, 0xa1, 0x97, 0x08, 0x4f,
-		0x3f, 0x3c, 0xd2, 0x06, 0x4a, 0x51, 0x03, 0xa4, 0xae, 0x8d, 0xe9, 0x97, 0x57, 0x5d, 0xee, 0x65,
-		0xd0, 0x7b, 0xa9, 0x32, 0x17, 0xcf, 0x8e, 0xc2, 0xde, 0x66, 0xf4, 0xc2, 0x29, 0xb9, 0x36, 0xa5,
-		0x14, 0xba, 0xe1, 0x22, 0x86, 0x6a, 0xe9, 0x98, 0x5e, 0x9b, 0xc7, 0x51, 0x67, 0xc6, 0xa1, 0x52,
-		0xfa, 0x30, 0x6a, 0xa8, 0x08, 0x55, 0x21, 0xc3, 0x42, 0x51, 0x95, 0x18, 0x43, 0x7a, 0x9b, 0x55,
-		0xe6, 0xa2, 0x3c, 0x72, 0x86, 0x52, 0xd2, 0x1b, 0x96, 0x8b, 0xaf, 0x4d, 0x29, 0x80, 0xbd, 0x27,
-		0xa4, 0xc1, 0x09, 0x7a, 0xb1, 0xd7, 0xa1, 0x1a, 0x36, 0xbc, 0xf3, 0xb3, 0x14, 0xf0, 0xd1, 0x51,
-		0x80, 0x37, 0x28, 0xd3, 0x8d, 0xa0, 0x1d, 0xbe, 0x36, 0xa5, 0xcc, 0xde, 0x1a, 0x2c, 0x26, 0x2a,
-		0xb6, 0x67, 0x98, 0x5a, 0xdb, 0x78, 0x03, 0xb3, 0x25, 0x94, 0x5e, 0x69, 0x79, 0x84, 0x8a, 0x5d,
-		0xe5, 0xd4, 0x74, 0x61, 0x25, 0x2a, 0xb6, 0x17, 0x2c, 0x28, 0x27, 0xf9, 0x99, 0x51, 0xef, 0x8a,
-		0xb6, 0xa4, 0x94, 0x62, 0xd7, 0xb2, 0x5d, 0x8f, 0xa7, 0x40, 0xca, 0xc8, 0x67, 0x21, 0x13, 0x30,
-		0x4c, 0x68, 0x1e, 0x92, 0xfc, 0x54, 0x8d, 0x38, 0x7d, 0xca, 0x1f, 0xe5, 0x3c, 0x64, 0x83, 0xc6,
-		0x48, 0xfe, 0x78, 0xc4, 0xe3, 0xa4, 0x77, 0x53, 0xcc, 0x87, 0x33, 0xd2, 0x69, 0xdf, 0x53, 0x79,
-		0x50, 0x2c, 0x6d, 0xa2, 0x9e, 0x6d, 0xbf, 0x66, 0x69, 0x21, 0x5f, 0x59, 0xd1, 0x22, 0x64, 0xba,
-		0x17, 0xbb, 0x1e, 0x49, 0x8c, 0x92, 0x40, 0xf7, 0x62, 0x57, 0x10, 0x3c, 0x00, 0x59, 0xd2, 0x6f,
-		0x35, 0xe8, 0x2f, 0xa5, 0x95, 0x0c, 0x29, 0xe3, 0x24, 0xf2, 0xbf, 0x89, 0x82, 0xd4, 0x6f, 0xc0,
-		0xbc, 0x54, 0x75, 0xe4, 0xd8, 0xa9, 0xea, 0xd3, 0xfd, 0x49, 0x72, 0x3f, 0x2f, 0xbe, 0x0e, 0x92,
-		0x9f, 0xde, 0x65, 0x4b, 0xcd, 0x68, 0xff, 0xaf, 0xcf, 0x51, 0x55, 0x0a, 0x7a, 0x9f, 0xe7, 0x7a,
-		0x35, 0xb4, 0x59, 0x29, 0xfe, 0x74, 0x44, 0xff, 0x80, 0x7b, 0x4e, 0xcc, 0x4e, 0xb7, 0xa9, 0xb9,
-		0x58, 0xe4, 0xc7, 0x02, 0xfb, 0x96, 0x0f, 0x43, 0x41, 0xeb, 0x76, 0x55, 0xc7, 0xd5, 0x5c, 0xcc,
-		0x7d, 0x0d, 0x96, 0x79, 0xc8, 0x69, 0xdd, 0x6e, 0x9d, 0x94, 0x32, 0x5f, 0xe3, 0x21, 0xc8, 0x13,
-		0x9b, 0x6e, 0x68, 0x6d, 0xe1, 0x2a, 0x24, 0x98, 0x4b, 0xc2, 0x4b, 0x99, 0x77, 0x20, 0x37, 0xbd,
-		0x11, 0xa7, 0xf6, 0xdc, 0x8b, 0xa9, 0x22, 0x81, 0x98, 0x0a, 0xf1, 0x3b, 0x43, 0x98, 0x7c, 0xc4,
-		0x3d, 0x2b, 0xc3, 0x37, 0x0d, 0xe6, 0x68, 0xfc, 0x75, 0x8b, 0x25, 0x4b, 0x52, 0x0a, 0x7b, 0x90,
-		0x15, 0xc8, 0x87, 0x6d, 0x3f, 0xca, 0x43, 0xd4, 0x3d, 0xe0, 0x6f, 0x89, 0xba, 0x07, 0xc4, 0xd3,
-		0xf5, 0x6e, 0xda, 0xcd, 0x0f, 0x59, 0xed, 0x38, 0x9f, 0x9f, 0xeb, 0x90, 0x0b, 0x90, 0x0b, 0xad,
-		0x09, 0xf2, 0x49, 0x98, 0x1b, 0x66, 0xe2, 0xe5, 0x7d, 0xaf, 0x3c, 0x64, 0xaa, 0xd1, 0x25, 0x48,
-		0x79, 0x36, 0x7e, 0x48, 0x74, 0x4c, 0x5f, 0x2b, 0x88, 0x15, 0x8f, 0x34, 0x94, 0xce, 0x8f, 0x86,
-		0xd2, 0xf9, 0xf2, 0x37, 0xc0, 0xfc, 0x28, 0xfb, 0xdd, 0x97, 0xe4, 0x8b, 0x7b, 0x02, 0x3b, 0x09,
-		0x09, 0x7e, 0xa5, 0x65, 0x94, 0x6e, 0x60, 0xf1, 0x27, 0x22, 0x48, 0x66, 0xcb, 0x63, 0x6c, 0x5f,
-		0x8b, 0x3e, 0xc8, 0x2a, 0x9c, 0x1e, 0x69, 0xc3, 0x47, 0x6f, 0x85, 0x31, 0x20, 0xbe, 0x15, 0xa6,
-		0x8b, 0xe6, 0x38, 0xb4, 0xaf, 0xe2, 0x20, 0x0b, 0x7b, 0x92, 0x3f, 0x11, 0x83, 0x93, 0xc3, 0x2d,
-		0x39, 0x5a, 0x82, 0x2c, 0xf1, 0x5b, 0xdd, 0xb0, 0x8b, 0x0b, 0x1d, 0xed, 0xa0, 0xc1, 0xfd, 0x5b,
-		0xbe, 0x95, 0x10, 0xf5, 0xb6, 0x12, 0xd0, 0x0e, 0xcc, 0xb4, 0x2d, 0x5d, 0x6b, 0xab, 0x81, 0xad,
-		0x1c, 0x3e, 0x89, 0x1e, 0x1c, 0x10, 0x76, 0x38, 0x65, 0x48, 0x0c, 0x0e, 0xd7, 0xff, 0x02, 0xc5,
-		0x58, 0xf7, 0x76, 0x7d, 0xd0, 0x2a, 0x64, 0x3a, 0x86, 0xb3, 0x8b, 0xf7, 0xb5, 0x5b, 0x86, 0x65,
-		0xf3, 0xd9, 0x34, 0xa8, 0x34, 0x1b, 0x3e, 0x8d, 0xd8, 0x61, 0x0a, 0xb0, 0x05, 0x86, 0x64, 0x7a,
-		0xe8, 0xc6, 0x57, 0xe2, 0xd8, 0xd6, 0x64, 0xd4, 0x1e, 0x52, 0x72, 0xe4, 0x1e, 0xd2, 0xb0, 0x0d,
-		0x9b, 0xd4, 0xf0, 0x0d, 0x9b, 0x8f, 0x05, 0x87, 0x26, 0xbc, 0xf6, 0x0d, 0xec, 0xe1, 0xa0, 0x3a,
-		0xcc, 0x71, 0xfe, 0x66, 0x48, 0xf6, 0x43, 0x8e, 0x3a, 0xb0, 0xf9, 0xd5, 0x2f, 0x73, 0x24, 0xd8,
-		0x47, 0x8b, 0x3d, 0x76, 0x6f, 0x62, 0x17, 0x9b, 0xa8, 0xf1, 0xc0, 0x26, 0xea, 0xff, 0x61, 0x43,
-		0xf1, 0x02, 0xcc, 0x0c, 0xf8, 0x11, 0x43, 0x37, 0x87, 0x47, 0x24, 0x64, 0xe5, 0x4f, 0x46, 0xa0,
-		0x38, 0xda, 0x71, 0x18, 0x0a, 0x75, 0xac, 0xdc, 0xf7, 0x28, 0xf3, 0xfc, 0x10, 0xe4, 0xfb, 0xdc,
-		0x1a, 0x36, 0x0a, 0xb9, 0x50, 0x00, 0x29, 0x7f, 0x73, 0xcc, 0xb3, 0x99, 0x21, 0xdf, 0x63, 0x88,
-		0xa2, 0xbd, 0x04, 0xb3, 0x4d, 0xac, 0x1b, 0xcd, 0x7b, 0xd5, 0xb3, 0x19, 0xce, 0xfd, 0xff, 0xd4,
-		0x6c, 0x50, 0xcd, 0xbe, 0x07, 0x20, 0xa5, 0x60, 0xa7, 0x4b, 0x5c, 0x09, 0x54, 0x86, 0x34, 0x3e,
-		0xd0, 0x71, 0xd7, 0xf5, 0xcf, 0x03, 0x0c, 0xf3, 0x6e, 0x19, 0x75, 0x55, 0x50, 0x92, 0xd8, 0xce,
-		0x63, 0x43, 0x4f, 0xf1, 0xf0, 0x75, 0x74, 0x24, 0xca, 0xd9, 0x83, 0xf1, 0xeb, 0x65, 0x11, 0xbf,
-		0xc6, 0x46, 0x86, 0x66, 0x8c, 0xab, 0x2f, 0x80, 0x7d, 0x8a, 0x07, 0xb0, 0xf1, 0x31, 0x2f, 0x0b,
-		0x45, 0xb0, 0x95, 0x50, 0x04, 0x9b, 0x18, 0xd3, 0xcd, 0x11, 0x21, 0xec, 0x65, 0x11, 0xc2, 0x26,
-		0xc7, 0xb4, 0xb8, 0x2f, 0x86, 0x7d, 0x3e, 0x10, 0xc3, 0xa6, 0x07, 0x13, 0x85, 0x21, 0xd6, 0x21,
-		0x41, 0xec, 0x73, 0x5e, 0x10, 0x9b, 0x1d, 0x19, 0x00, 0x73, 0xe6, 0xfe, 0x28, 0x76, 0x6b, 0x20,
-		0x8a, 0xcd, 0xf1, 0xbf, 0xed, 0x33, 0x0a, 0x62, 0x4c, 0x18, 0xbb, 0x35, 0x10, 0xc6, 0xe6, 0xc7,
-		0x00, 0x8e, 0x89, 0x63, 0xbf, 0x76, 0x78, 0x1c, 0x3b, 0x3a, 0xd2, 0xe4, 0xcd, 0x9c, 0x2c, 0x90,
-		0x55, 0x47, 0x04, 0xb2, 0xd2, 0xc8, 0xa0, 0x8b, 0xc1, 0x4f, 0x1c, 0xc9, 0xee, 0x0c, 0x89, 0x64,
-		0x59, 0xcc, 0x79, 0x6e, 0x24, 0xf8, 0x04, 0xa1, 0xec, 0xce, 0x90, 0x50, 0x16, 0x8d, 0x85, 0x1d,
-		0x1b, 0xcb, 0x5e, 0x0d, 0xc7, 0xb2, 0xb3, 0x23, 0x1c, 0x26, 0x7f, 0xb6, 0x8f, 0x08, 0x66, 0x77,
-		0x47, 0x05, 0xb3, 0x2c, 0xe0, 0x7c, 0x6c, 0x24, 0xe2, 0x31, 0xa2, 0xd9, 0xad, 0x81, 0x68, 0xf6,
-		0xc4, 0x18, 0x4d, 0x9b, 0x3c, 0x9c, 0x65, 0x77, 0x8d, 0xb3, 0x5b, 0xc6, 0x41, 0xca, 0x5c, 0x8f,
-		0xa7, 0x32, 0x52, 0x56, 0x7e, 0x84, 0xac, 0xbe, 0x7d, 0x76, 0x8e, 0xb8, 0xb9, 0xd8, 0xb6, 0x2d,
-		0x5b, 0x1c, 0xee, 0xa7, 0x0f, 0xf2, 0x39, 0x12, 0xde, 0xf8, 0x36, 0xed, 0x88, 0xd0, 0x97, 0x86,
-		0x13, 0x01, 0x3b, 0x26, 0xff, 0x6c, 0xc4, 0xe7, 0xa5, 0xc1, 0x6f, 0x30, 0x34, 0x4a, 0xf3, 0xd0,
-		0x28, 0x10, 0x10, 0x47, 0xc3, 0x01, 0xf1, 0x22, 0x64, 0x48, 0x98, 0xd0, 0x17, 0xeb, 0x6a, 0x5d,
-		0x2f, 0xd6, 0x15, 0x67, 0x7f, 0x58, 0xd8, 0xcc, 0x97, 0x25, 0xb6, 0x11, 0x56, 0xf0, 0xce, 0x41,
-		0xb1, 0x28, 0x0d, 0x3d, 0x0e, 0xb3, 0x01, 0x5a, 0x2f, 0xfc, 0x60, 0x81, 0x9f, 0xe4, 0x51, 0x97,
-		0x78, 0x1c, 0xf2, 0xcf, 0x23, 0xbe, 0x84, 0xfc, 0x20, 0x79, 0x58, 0x3c, 0x1b, 0x79, 0x97, 0xe2,
-		0xd9, 0xe8, 0x3d, 0xc7, 0xb3, 0xc1, 0x70, 0x2a, 0x16, 0x0e, 0xa7, 0xfe, 0x7b, 0xc4, 0x1f, 0x13,
-		0x2f, 0x3a, 0xd5, 0xad, 0x26, 0xe6, 0x01, 0x0e, 0xfd, 0x4d, 0x5c, 0x92, 0xb6, 0xd5, 0xe2, 0x61,
-		0x0c, 0xf9, 0x49, 0xa8, 0xbc, 0x85, 0x27, 0xcd, 0xd7, 0x15, 0x2f, 0x36, 0x9a, 0x0e, 0x7e, 0x82,
-		0xc0, 0xcf, 0xe5, 0x27, 0xfc, 0x73, 0xf9, 0xde, 0xf7, 0xb7, 0xc9, 0xc0, 0xf7, 0xb7, 0xe8, 0x59,
-		0x48, 0xd3, 0x3c, 0xb5, 0x6a, 0x75, 0xc5, 0x1f, 0xc6, 0x3a, 0x33, 0xfa, 0x4c, 0xbe, 0x43, 0x4f,
-		0x0b, 0xb3, 0x73, 0xfc, 0xbe, 0xc7, 0x91, 0x0e, 0x79, 0x1c, 0xf7, 0x41, 0x9a, 0xb4, 0x9e, 0xfd,
-		0x81, 0x07, 0xe0, 0x1f, 0x6f, 0x8b, 0x02, 0xf9, 0xc7, 0xa3, 0x50, 0xe8, 0x5b, 0x68, 0x86, 0xf6,
-		0x7d, 0xd8, 0x0e, 0xe8, 0x64, 0xf2, 0x58, 0x00, 0x68, 0x69, 0x8e, 0x7a, 0x5b, 0x33, 0x5d, 0xdc,
-		0xe4, 0x42, 0x09, 0x94, 0xa0, 0x22, 0xa4, 0xc8, 0x53, 0xcf, 0xc1, 0x4d, 0x9e, 0x38, 0xf0, 0x9e,
-		0xd1, 0x35, 0x48, 0xe0, 0x5b, 0xf4, 0x82, 0x53, 0x76, 0x4d, 0xf0, 0xc9, 0xc1, 0x48, 0x8e, 0x54,
-		0x97, 0xe7, 0xc9, 0x60, 0x7f, 0xe9, 0xad, 0x45, 0x89, 0x51, 0x3f, 0xe6, 0x7d, 0x33, 0xa5, 0x70,
-		0xfe, 0xb0, 0x14, 0x52, 0x7d, 0x52, 0xa0, 0x29, 0xac, 0xac, 0x88, 0x4c, 0x89, 0x4c, 0xd9, 0xf1,
-		0x64, 0x25, 0xd7, 0xc1, 0x9d, 0xae, 0x65, 0xb5, 0x55, 0x36, 0xc7, 0x4b, 0x90, 0x0f, 0xaf, 0xab,
-		0xec, 0x8f, 0x2f, 0xb9, 0x9a, 0x61, 0xaa, 0x21, 0x27, 0x38, 0xcb, 0x0a, 0xd9, 0x9c, 0xba, 0x1e,
-		0x4f, 0x45, 0xa4, 0xe8, 0xf5, 0x78, 0x2a, 0x2a, 0xc5, 0xe4, 0x6d, 0x38, 0x31, 0x74, 0x5d, 0x45,
-		0xcf, 0x40, 0xda, 0x5f, 0x92, 0xd9, 0x09, 0xf3, 0x23, 0x92, 0x04, 0x3e, 0xad, 0xfc, 0x8b, 0x11,
-		0x1f, 0x32, 0x9c, 0x76, 0xa8, 0x42, 0x82, 0x1d, 0xf7, 0xe3, 0x47, 0x3b, 0x1e, 0x9f, 0x6c, 0x45,
-		0x5e, 0x66, 0x67, 0x01, 0x15, 0xce, 0x2c, 0x7f, 0x3d, 0x24, 0x58, 0x09, 0xca, 0x40, 0xd2, 0xbf,
-		0x4a, 0x19, 0x20, 0x51, 0xaa, 0x54, 0xaa, 0xdb, 0xe2, 0x5e, 0xd4, 0xf2, 0x96, 0xd2, 0x90, 0xa2,
-		0xa4, 0x58, 0xa9, 0x5e, 0xaf, 0x56, 0x1a, 0x52, 0x0c, 0xcd, 0x40, 0x8e, 0xfd, 0x56, 0xaf, 0x6e,
-		0x29, 0x1b, 0xa5, 0x86, 0x14, 0x0f, 0x14, 0xd5, 0xab, 0x9b, 0xab, 0x55, 0x45, 0x9a, 0x96, 0x9f,
-		0x84, 0xd3, 0x23, 0xd7, 0x70, 0x3f, 0xa7, 0x10, 0x09, 0xe4, 0x14, 0xe4, 0x4f, 0x44, 0x49, 0x50,
-		0x33, 0x6a, 0x61, 0x46, 0xd7, 0xfb, 0x3a, 0x7e, 0xf1, 0x18, 0xab, 0x7a, 0x5f, 0xef, 0x49, 0x1c,
-		0x63, 0xe3, 0x3d, 0xec, 0xea, 0xfb, 0xcc, 0x51, 0x60, 0x16, 0x28, 0xa7, 0xe4, 0x78, 0x29, 0x65,
-		0x72, 0x18, 0xd9, 0xeb, 0x58, 0x77, 0x55, 0xa6, 0x44, 0x0e, 0xff, 0x5b, 0xb1, 0x39, 0x56, 0x5a,
-		0x67, 0x85, 0xf2, 0x37, 0x1c, 0x4b, 0x96, 0x69, 0x98, 0x56, 0xaa, 0x0d, 0xe5, 0x55, 0x29, 0x86,
-		0x10, 0xe4, 0xe9, 0x4f, 0xb5, 0xbe, 0x59, 0xda, 0xae, 0x5f, 0xdb, 0x22, 0xb2, 0x9c, 0x85, 0x82,
-		0x90, 0xa5, 0x28, 0x9c, 0x96, 0x1f, 0x85, 0x53, 0x23, 0xbc, 0x8a, 0x21, 0xe7, 0x2f, 0x3f, 0x15,
-		0x09, 0x52, 0x87, 0x3d, 0x83, 0x2d, 0x48, 0x38, 0xae, 0xe6, 0xf6, 0x1c, 0x2e, 0xc4, 0x67, 0x26,
-		0x75, 0x33, 0x96, 0xc5, 0x8f, 0x3a, 0x65, 0x57, 0x38, 0x8c, 0x7c, 0x09, 0xf2, 0xe1, 0x9a, 0xd1,
-		0x32, 0xf0, 0x95, 0x28, 0x2a, 0x5f, 0x01, 0x34, 0xe8, 0x7d, 0x0c, 0x09, 0x2f, 0x23, 0xc3, 0xc2,
-		0xcb, 0x1f, 0x89, 0xc0, 0x99, 0x23, 0x3c, 0x0d, 0xf4, 0x52, 0x5f, 0x27, 0x9f, 0x3b, 0x8e, 0x9f,
-		0xb2, 0xcc, 0xca, 0xfa, 0xba, 0xf9, 0x14, 0x64, 0x83, 0xe5, 0x93, 0x75, 0xf2, 0x4b, 0x51, 0x7f,
-		0x12, 0x87, 0xe3, 0x60, 0xdf, 0x04, 0x46, 0xfe, 0x82, 0x26, 0xf0, 0x83, 0x00, 0xee, 0x81, 0x38,
-		0x00, 0xcc, 0xd7, 0xd1, 0xfb, 0x87, 0xa4, 0xc6, 0xb0, 0xde, 0x38, 0xe0, 0x93, 0x20, 0xed, 0xf2,
-		0x5f, 0x0e, 0xaa, 0x07, 0x93, 0x02, 0x3d, 0xba, 0xc6, 0x3a, 0xde, 0x5f, 0x8a, 0x9d, 0x6c, 0x31,
-		0xf6, 0x93, 0x07, 0xac, 0xd8, 0x41, 0xaf, 0xc2, 0xa9, 0x3e, 0x47, 0xc1, 0x83, 0x8e, 0x4f, 0xea,
-		0x2f, 0x9c, 0x08, 0xfb, 0x0b, 0x02, 0x3a, 0xb8, 0xda, 0x4f, 0x87, 0x57, 0xfb, 0x57, 0x01, 0xfc,
-		0xe4, 0x80, 0x7f, 0x62, 0x2d, 0x12, 0x3c, 0xb1, 0x76, 0x09, 0xa6, 0x89, 0x26, 0x09, 0x39, 0x0d,
-		0x9a, 0x62, 0xa2, 0x09, 0x81, 0xe4, 0x02, 0xa3, 0x96, 0x0d, 0x40, 0x83, 0xb9, 0xc5, 0x11, 0xaf,
-		0x78, 0x3e, 0xfc, 0x8a, 0x07, 0x46, 0x66, 0x29, 0x87, 0xbf, 0xea, 0x0d, 0x98, 0xa6, 0x23, 0x3f,
-		0xf4, 0x33, 0xc0, 0xaf, 0x03, 0xd0, 0x5c, 0xd7, 0x36, 0x76, 0x7b, 0xfe, 0x0b, 0x16, 0x87, 0x6b,
-		0x4e, 0x49, 0xd0, 0x95, 0xef, 0xe3, 0x2a, 0x34, 0xe7, 0xb3, 0x06, 0xd4, 0x28, 0x00, 0x28, 0x6f,
-		0x42, 0x3e, 0xcc, 0x3b, 0xfc, 0xb3, 0x46, 0xff, 0x7e, 0x91, 0xb4, 0xf0, 0x6f, 0x3c, 0xef, 0x88,
-		0xdf, 0xf9, 0x43, 0x1f, 0xe4, 0x3f, 0x8f, 0x40, 0x36, 0xa8, 0x78, 0xef, 0xb2, 0x0b, 0x72, 0xff,
-		0x10, 0x17, 0x24, 0xdd, 0xd2, 0x9c, 0x97, 0x99, 0x07, 0x72, 0x7a, 0xc0, 0x03, 0x49, 0xb6, 0x34,
-		0x67, 0xe7, 0x7d, 0x74, 0x40, 0xe4, 0x6f, 0x89, 0x40, 0xca, 0xeb, 0xfc, 0x11, 0xa7, 0x74, 0xfd,
-		0x8f, 0x5b, 0xbd, 0xac, 0x3b, 0xdb, 0xdf, 0x88, 0x79, 0xfb, 0x1b, 0x57, 0xbc, 0xc5, 0x6f, 0x54,
-		0x3a, 0x24, 0x28, 0x69, 0x71, 0x52, 0x8e, 0xaf, 0xf5, 0x57, 0x26, 0xfb, 0x86, 0x6c, 0x0e, 0xa6,
-		0x83, 0xdf, 0x7f, 0xb1, 0x07, 0xb9, 0x19, 0x38, 0x1a, 0xc3, 0xa6, 0x61, 0xf0, 0x63, 0xb3, 0xc8,
-		0xb1, 0x3f, 0x36, 0xf3, 0xde, 0x12, 0x0d, 0xbe, 0xe5, 0xbb, 0x23, 0x90, 0x12, 0x33, 0x02, 0x7d,
-		0x28, 0x78, 0x64, 0x4a, 0xec, 0xc9, 0x8d, 0xb4, 0x46, 0x1c, 0x3f, 0x70, 0x62, 0x6a, 0xe0, 0xa4,
-		0x70, 0xec, 0xb8, 0x27, 0x85, 0xb9, 0x5f, 0xf7, 0x27, 0x11, 0x90, 0xfa, 0xe7, 0xeb, 0x5f, 0xb8,
-		0x75, 0x83, 0x8b, 0x5c, 0x6c, 0xc8, 0x22, 0x37, 0xea, 0xf8, 0x6f, 0x7c, 0xd4, 0xf1, 0xdf, 0xc1,
-		0x5e, 0x4f, 0xdf, 0x63, 0xaf, 0x3f, 0x1a, 0x85, 0x4c, 0x20, 0x39, 0x8a, 0x9e, 0x0e, 0x1d, 0x25,
-		0x5e, 0x3a, 0x2a, 0x91, 0x1a, 0x38, 0x4b, 0x1c, 0x12, 0x53, 0xf4, 0xf8, 0x62, 0x7a, 0xf7, 0x3f,
-		0x2b, 0x1a, 0xfe, 0xe5, 0xe5, 0xf4, 0x88, 0x2f, 0x2f, 0xbf, 0x29, 0x02, 0x29, 0xcf, 0xe9, 0x3e,
-		0xee, 0xee, 0xdb, 0x49, 0x48, 0x70, 0xbf, 0x92, 0x6d, 0xbf, 0xf1, 0xa7, 0xa1, 0x49, 0xe5, 0x22,
-		0xa4, 0xc4, 0x1f, 0xed, 0xe3, 0x6b, 0x9a, 0xf7, 0x7c, 0xfe, 0x39, 0xc8, 0x04, 0x76, 0x2e, 0x89,
-		0x61, 0xdc, 0xac, 0xbe, 0x2c, 0x4d, 0x15, 0x93, 0x77, 0xee, 0x2e, 0xc5, 0x36, 0xf1, 0x6d, 0x32,
-		0x9b, 0x95, 0x6a, 0xe5, 0x5a, 0xb5, 0xf2, 0xa2, 0x14, 0x29, 0x66, 0xee, 0xdc, 0x5d, 0x4a, 0x2a,
-		0x98, 0xe6, 0x13, 0xcf, 0xbf, 0x08, 0x85, 0xbe, 0x81, 0x09, 0x3b, 0x2d, 0x08, 0xf2, 0xab, 0x3b,
-		0xdb, 0xeb, 0xb5, 0x4a, 0xa9, 0x51, 0x55, 0xd9, 0x81, 0x53, 0x74, 0x0a, 0x66, 0xd7, 0x6b, 0x6b,
-		0xd7, 0x1a, 0x6a, 0x65, 0xbd, 0x56, 0xdd, 0x6c, 0xa8, 0xa5, 0x46, 0xa3, 0x54, 0x79, 0x51, 0x8a,
-		0x5e, 0xbc, 0x9b, 0x81, 0x78, 0xa9, 0x5c, 0xa9, 0xa1, 0x0a, 0xc4, 0x69, 0x22, 0xe4, 0xc8, 0xa3,
-		0x4b, 0xc5, 0xa3, 0x33, 0xc3, 0xe8, 0x2a, 0x4c, 0xd3, 0x1c, 0x09, 0x3a, 0xfa, 0x2c, 0x53, 0x71,
-		0x4c, 0xaa, 0x98, 0x34, 0x86, 0xce, 0xc8, 0x23, 0x0f, 0x37, 0x15, 0x8f, 0xce, 0x1c, 0xa3, 0x75,
-		0x48, 0x8a, 0x10, 0x79, 0xdc, 0x89, 0xa3, 0xe2, 0xd8, 0x74, 0x2e, 0xe9, 0x1a, 0x4b, 0x35, 0x1c,
-		0x7d, 0xee, 0xa9, 0x38, 0x26, 0xa7, 0x8c, 0x6a, 0xde, 0x87, 0x38, 0x63, 0x8e, 0x32, 0x15, 0xc7,
-		0x65, 0x89, 0x91, 0x02, 0x69, 0x3f, 0x89, 0x33, 0xfe, 0x34, 0x57, 0x71, 0x82, 0x74, 0x39, 0xfa,
-		0x7a, 0xc8, 0x85, 0x03, 0xdd, 0xc9, 0x8e, 0x4b, 0x15, 0x27, 0xcc, 0x47, 0x13, 0xfc, 0x70, 0xd4,
-		0x3b, 0xd9, 0xf1, 0xa9, 0xe2, 0x84, 0xe9, 0x69, 0xf4, 0x3a, 0xcc, 0x0c, 0x46, 0xa5, 0x93, 0x9f,
-		0xa6, 0x2a, 0x1e, 0x23, 0x61, 0x8d, 0x3a, 0x80, 0x86, 0x44, 0xb3, 0xc7, 0x38, 0x5c, 0x55, 0x3c,
-		0x4e, 0xfe, 0x1a, 0x35, 0xa1, 0xd0, 0x1f, 0x22, 0x4e, 0x7a, 0xd8, 0xaa, 0x38, 0x71, 0x2e, 0x9b,
-		0xbd, 0x25, 0x1c, 0x5a, 0x4e, 0x7a, 0xf8, 0xaa, 0x38, 0x71, 0x6a, 0x1b, 0xed, 0x00, 0x04, 0xa2,
-		0xc3, 0x09, 0x0e, 0x63, 0x15, 0x27, 0x49, 0x72, 0xa3, 0x2e, 0xcc, 0x0e, 0x0b, 0x1b, 0x8f, 0x73,
-		0x36, 0xab, 0x78, 0xac, 0xdc, 0x37, 0xd1, 0xe7, 0x70, 0x00, 0x38, 0xd9, 0x59, 0xad, 0xe2, 0x84,
-		0x49, 0xf0, 0x72, 0x69, 0xe4, 0x01, 0xdd, 0xb3, 0x47, 0x1e, 0xd0, 0xf5, 0x8f, 0xdc, 0x7a, 0x87,
-		0x72, 0x7f, 0xe7, 0x09, 0xf8, 0x00, 0xbf, 0xfa, 0xc5, 0x71, 0xb5, 0x9b, 0x86, 0xd9, 0xf2, 0xee,
-		0xf2, 0xe1, 0xcf, 0xfc, 0x74, 0xee, 0x49, 0x7e, 0xbd, 0x8c, 0x28, 0x1d, 0x73, 0xa3, 0xcf, 0xc8,
-		0x6b, 0x0e, 0xc7, 0x1d, 0xe4, 0x1f, 0x7f, 0xee, 0xf6, 0x88, 0xdb, 0x82, 0xc6, 0xdc, 0x49, 0x34,
-		0xe4, 0x36, 0xa1, 0xe2, 0x91, 0x1f, 0xc7, 0x17, 0x8f, 0x3a, 0xb0, 0x4c, 0xbc, 0xd8, 0xfc, 0x35,
-		0xc3, 0x71, 0x2d, 0xdb, 0xd0, 0xb5, 0x36, 0x5d, 0x5e, 0xae, 0x4c, 0xfa, 0x39, 0x51, 0x39, 0x4d,
-		0xdc, 0x15, 0x7e, 0x05, 0x11, 0xff, 0xc4, 0x65, 0x15, 0x12, 0xb7, 0xb4, 0x36, 0xfb, 0x98, 0x27,
-		0x78, 0xdb, 0x58, 0xbf, 0xcc, 0x03, 0x7e, 0x54, 0x10, 0x85, 0xf1, 0xca, 0x9f, 0xa3, 0x1f, 0x42,
-		0x74, 0x3a, 0x86, 0x43, 0x74, 0x51, 0xa1, 0x81, 0xf4, 0x0e, 0xc4, 0x6d, 0xcd, 0xe5, 0xe1, 0x65,
-		0xb9, 0xc4, 0x2f, 0x0e, 0x7a, 0x78, 0xfc, 0xf5, 0x3f, 0xcb, 0x03, 0x77, 0x0b, 0xb1, 0x97, 0x51,
-		0x38, 0xf4, 0xb5, 0x90, 0xea, 0x68, 0x07, 0x2a, 0x85, 0x8e, 0xbe, 0x5b, 0xd0, 0xc9, 0x8e, 0x76,
-		0x40, 0x5a, 0x8d, 0x0c, 0x28, 0x10, 0x74, 0x7d, 0x5f, 0x33, 0x5b, 0x98, 0xbd, 0x24, 0xf6, 0x6e,
-		0xbd, 0x24, 0xd7, 0xd1, 0x0e, 0x2a, 0x14, 0x98, 0xbc, 0x8a, 0x5f, 0xd1, 0xf4, 0xcb, 0x11, 0x9e,
-		0x54, 0xa0, 0x92, 0x43, 0x1a, 0x48, 0xba, 0xf7, 0x44, 0xdf, 0x2f, 0x76, 0x40, 0xce, 0x8e, 0x1a,
-		0x98, 0x3e, 0xb9, 0x97, 0x73, 0xa4, 0xa5, 0x9f, 0x7f, 0x6b, 0x31, 0xc2, 0xde, 0x5a, 0xd0, 0xfb,
-		0xc6, 0xe5, 0x3a, 0x64, 0x58, 0xae, 0x44, 0xa5, 0x4e, 0x6e, 0x74, 0xac, 0x93, 0x9b, 0x13, 0x4e,
-		0x2e, 0x03, 0x04, 0xc6, 0x4d, 0xea, 0x79, 0x1f, 0x3e, 0x13, 0x81, 0xcc, 0x6a, 0xe0, 0x72, 0xc1,
-		0x79, 0x48, 0x76, 0x2c, 0xd3, 0xb8, 0x89, 0x6d, 0x6f, 0x0b, 0x8b, 0x3d, 0x12, 0x47, 0x94, 0xfd,
-		0x8d, 0x3a, 0xf7, 0x50, 0xdc, 0x9b, 0x23, 0x9e, 0x09, 0xd7, 0x6d, 0xbc, 0xeb, 0x18, 0x42, 0xe4,
-		0x8a, 0x78, 0x44, 0x8f, 0x80, 0xe4, 0x60, 0xbd, 0x67, 0x1b, 0xee, 0xa1, 0xaa, 0x5b, 0xa6, 0xab,
-		0xe9, 0x2e, 0x0f, 0xd3, 0x0b, 0xa2, 0xbc, 0xc2, 0x8a, 0x09, 0x48, 0x13, 0xbb, 0x9a, 0xd1, 0x66,
-		0x67, 0x0e, 0xd3, 0x8a, 0x78, 0xe4, 0x4d, 0xfd, 0x5c, 0x32, 0x18, 0xa8, 0x56, 0x40, 0xb2, 0xba,
-		0xd8, 0x0e, 0x9d, 0x57, 0x61, 0xea, 0x3a, 0xff, 0xeb, 0x9f, 0x7d, 0x7c, 0x8e, 0x0b, 0x9c, 0x9f,
-		0x75, 0x60, 0x77, 0xe9, 0x2b, 0x05, 0xc1, 0x21, 0x0e, 0xb2, 0xbc, 0x1a, 0xda, 0xb4, 0xea, 0xed,
-		0xfa, 0x17, 0xa4, 0xcc, 0x0d, 0x08, 0xb5, 0x64, 0x1e, 0x96, 0xe7, 0x7f, 0xcd, 0x87, 0xf6, 0x03,
-		0xd9, 0x17, 0xf1, 0x61, 0x70, 0x07, 0x8b, 0xc2, 0x10, 0x1f, 0xff, 0x75, 0xcd, 0x68, 0x8b, 0x3f,
-		0xe7, 0xa9, 0xf0, 0x27, 0xb4, 0xe2, 0x65, 0x1f, 0xe3, 0x34, 0x60, 0x92, 0x47, 0xe9, 0x46, 0xd9,
-		0x32, 0x9b, 0xe1, 0x34, 0x23, 0x6a, 0x40, 0xc2, 0xb5, 0x6e, 0x62, 0x93, 0x0b, 0xa8, 0xfc, 0xc1,
-		0x63, 0x28, 0xf6, 0xe0, 0x6d, 0x61, 0x1c, 0x0b, 0xb5, 0x40, 0x6a, 0xe2, 0x36, 0x6e, 0xb1, 0x4f,
-		0x03, 0xf7, 0x35, 0x1b, 0xb3, 0x0f, 0xd3, 0x8f, 0x87, 0x3f, 0x78, 0xa9, 0x58, 0xc1, 0x43, 0xad,
-		0x53, 0x50, 0xb4, 0x1d, 0xbe, 0xda, 0x32, 0xc9, 0xf7, 0x9d, 0x47, 0xf4, 0x3f, 0xa0, 0x95, 0x41,
-		0xb3, 0x15, 0xba, 0x0a, 0xf3, 0x11, 0x90, 0x7a, 0xe6, 0xae, 0x65, 0xd2, 0xbf, 0x90, 0xc7, 0x43,
-		0xb0, 0x14, 0xdb, 0xe4, 0xf4, 0xca, 0xf9, 0x26, 0xe7, 0x36, 0xe4, 0x7d, 0x52, 0x3a, 0x7b, 0xd2,
-		0xc7, 0x9d, 0x3d, 0x39, 0x0f, 0x80, 0x90, 0xa0, 0x0d, 0x00, 0x7f, 0x7e, 0xd2, 0x5d, 0xb6, 0xcc,
-		0xe8, 0xd1, 0xf4, 0x67, 0x7a, 0xb0, 0x33, 0x01, 0x00, 0xd4, 0x86, 0xd9, 0x8e, 0x61, 0xaa, 0x0e,
-		0x6e, 0xef, 0xa9, 0x5c, 0x72, 0x04, 0x37, 0xf3, 0x2e, 0x8c, 0xf4, 0x4c, 0xc7, 0x30, 0xeb, 0xb8,
-		0xbd, 0xb7, 0xea, 0xc1, 0xa2, 0x0f, 0xc2, 0x19, 0x5f, 0x1c, 0x96, 0xa9, 0xee, 0x5b, 0xed, 0xa6,
-		0x6a, 0xe3, 0x3d, 0x55, 0xa7, 0x97, 0xd5, 0x65, 0xa9, 0x10, 0x4f, 0x79, 0x24, 0x5b, 0xe6, 0x35,
-		0xab, 0xdd, 0x54, 0xf0, 0x5e, 0x85, 0x54, 0xa3, 0x07, 0xc1, 0x97, 0x85, 0x6a, 0x34, 0x9d, 0xf9,
-		0xdc, 0x52, 0xec, 0x5c, 0x5c, 0xc9, 0x7a, 0x85, 0xb5, 0xa6, 0xb3, 0x92, 0xfa, 0xd8, 0x9b, 0x8b,
-		0x53, 0x5f, 0x7c, 0x73, 0x71, 0x4a, 0xbe, 0x4a, 0xaf, 0x56, 0xe2, 0x93, 0x0e, 0x3b, 0xe8, 0x32,
-		0xa4, 0x35, 0xf1, 0xc0, 0x3e, 0x9c, 0x3a, 0x62, 0xd2, 0xfa, 0xa4, 0xf2, 0x9b, 0x11, 0x48, 0xac,
-		0xde, 0xd8, 0xd6, 0x0c, 0x1b, 0x55, 0x61, 0xc6, 0x57, 0xda, 0x49, 0xe7, 0xbf, 0xaf, 0xe7, 0xc2,
-		0x00, 0x54, 0x47, 0x1d, 0x7b, 0x3b, 0x12, 0xa6, 0xff, 0x40, 0x5c, 0xa0, 0xab, 0xd7, 0x21, 0xc9,
-		0x5a, 0xe8, 0xa0, 0x17, 0x60, 0xba, 0x4b, 0x7e, 0xf0, 0x24, 0xfe, 0xc2, 0x48, 0x45, 0xa7, 0xf4,
-		0x41, 0xb5, 0x60, 0x7c, 0xf2, 0x9f, 0x45, 0x00, 0x56, 0x6f, 0xdc, 0x68, 0xd8, 0x46, 0xb7, 0x8d,
-		0xdd, 0x77, 0xab, 0xcb, 0xeb, 0x70, 0x22, 0xf0, 0x25, 0xb0, 0xad, 0x4f, 0xdc, 0xed, 0x59, 0xff,
-		0x5b, 0x60, 0x5b, 0x1f, 0x8a, 0xd6, 0x74, 0x5c, 0x0f, 0x2d, 0x36, 0x31, 0xda, 0xaa, 0xe3, 0x0e,
-		0xca, 0xf1, 0x15, 0xc8, 0xf8, 0x5d, 0x77, 0x50, 0x0d, 0x52, 0x2e, 0xff, 0xcd, 0xc5, 0x29, 0x8f,
-		0x16, 0xa7, 0x60, 0x0b, 0x8a, 0xd4, 0x63, 0x97, 0xff, 0x27, 0x91, 0xaa, 0x3f, 0x11, 0xbe, 0xaa,
-		0x14, 0x89, 0x58, 0x78, 0x6e, 0x81, 0x63, 0xef, 0x82, 0x05, 0xe6, 0x58, 0x01, 0xb1, 0x7e, 0x73,
-		0x14, 0x66, 0x77, 0xc4, 0x24, 0xfd, 0xaa, 0x95, 0xc2, 0x0e, 0x24, 0xb1, 0xe9, 0xda, 0x86, 0xb7,
-		0xdb, 0xf4, 0xc4, 0xa8, 0xc1, 0x1e, 0xd2, 0x97, 0xaa, 0xe9, 0xda, 0x87, 0xc1, 0xa1, 0x17, 0x58,
-		0x01, 0x31, 0xfc, 0x42, 0x0c, 0xe6, 0x47, 0xb1, 0xa2, 0xb3, 0x50, 0xd0, 0x6d, 0x4c, 0x0b, 0xc2,
-		0xdf, 0x41, 0xe6, 0x45, 0x31, 0x5f, 0x52, 0x14, 0x20, 0x0e, 0x1a, 0xd1, 0x2a, 0x42, 0x7a, 0x6f,
-		0x1e, 0x59, 0xde, 0x47, 0xa0, 0x8b, 0x0a, 0x86, 0x82, 0xf8, 0xb0, 0x62, 0x57, 0x6b, 0x6b, 0xa6,
-		0x8e, 0xef, 0x41, 0x13, 0x06, 0x57, 0x00, 0xf1, 0xb5, 0x46, 0x99, 0x61, 0xa2, 0x1b, 0x90, 0x14,
-		0xf0, 0xf1, 0x77, 0x01, 0x5e, 0x80, 0xa1, 0x07, 0x20, 0x1b, 0x5c, 0x18, 0xa8, 0x9f, 0x12, 0x57,
-		0x32, 0x81, 0x75, 0x61, 0xdc, 0xca, 0x93, 0x38, 0x72, 0xe5, 0xe1, 0xae, 0xe0, 0xcf, 0xc7, 0x60,
-		0x46, 0xc1, 0xcd, 0xbf, 0x82, 0x03, 0xf7, 0x35, 0x00, 0x6c, 0x52, 0x13, 0x63, 0x7b, 0x0f, 0x63,
-		0x37, 0x68, 0x24, 0xd2, 0x0c, 0x6f, 0xd5, 0x71, 0xdf, 0xaf, 0xd1, 0xfb, 0xd7, 0x51, 0xc8, 0x06,
-		0x47, 0xef, 0xaf, 0xc0, 0xca, 0x86, 0x36, 0x7d, 0x93, 0xc6, 0xbe, 0x27, 0x79, 0x64, 0x94, 0x49,
-		0x1b, 0xd0, 0xeb, 0x31, 0xb6, 0xec, 0xd3, 0x31, 0x48, 0xf0, 0x53, 0x73, 0x5b, 0x03, 0x3e, 0x6e,
-		0x64, 0xdc, 0x17, 0xf1, 0x39, 0xf1, 0x45, 0xfc, 0x50, 0x17, 0xf7, 0x21, 0xc8, 0x93, 0x90, 0x3a,
-		0x74, 0x14, 0x2f, 0x72, 0x2e, 0x47, 0xc3, 0x61, 0xff, 0xe0, 0x39, 0x5a, 0x84, 0x0c, 0x21, 0xf3,
-		0x6d, 0x36, 0xa1, 0x81, 0x8e, 0x76, 0x50, 0x65, 0x25, 0xe8, 0x71, 0x40, 0xfb, 0x5e, 0xe2, 0x43,
-		0xf5, 0x05, 0x41, 0xe8, 0x66, 0xfc, 0x1a, 0x41, 0x7e, 0x3f, 0x00, 0x69, 0x85, 0xca, 0xee, 0x53,
-		0xe6, 0x77, 0x53, 0x93, 0x92, 0x55, 0x7a, 0xa7, 0xf2, 0x77, 0x44, 0x98, 0xab, 0xdc, 0x17, 0x6d,
-		0xf3, 0xa0, 0x45, 0x3d, 0xde, 0x6c, 0xf8, 0xe3, 0xb7, 0x16, 0x8b, 0x87, 0x5a, 0xa7, 0xbd, 0x22,
-		0x0f, 0x81, 0x94, 0x87, 0xe5, 0x02, 0x88, 0x37, 0x1d, 0x0e, 0xdc, 0x57, 0x3e, 0x40, 0xf4, 0xfa,
-		0xce, 0xef, 0xfe, 0xc4, 0xf9, 0x33, 0x81, 0x57, 0x1c, 0x78, 0x19, 0x32, 0x36, 0x3c, 0xf2, 0x8f,
-		0x45, 0x00, 0xf9, 0x8b, 0x8d, 0x77, 0xf4, 0x7e, 0x03, 0x20, 0xe0, 0xef, 0x47, 0x8e, 0x8e, 0x23,
-		0x7c, 0xfe, 0x50, 0x1c, 0x11, 0x98, 0x4c, 0x1f, 0xf2, 0x4d, 0xbb, 0xb8, 0x0f, 0x61, 0xc8, 0xcd,
-		0xda, 0xcb, 0x15, 0xcb, 0x08, 0x41, 0x08, 0x26, 0x3a, 0x47, 0xa7, 0xe4, 0xb7, 0x22, 0x70, 0x7a,
-		0x40, 0x13, 0xbd, 0x26, 0xeb, 0x80, 0xec, 0x40, 0x25, 0x1d, 0x51, 0xb1, 0xdb, 0x7b, 0x6f, 0x8a,
-		0x3d, 0x63, 0x0f, 0x98, 0xf3, 0xf7, 0x68, 0x8d, 0xe2, 0x46, 0xe8, 0x57, 0x23, 0x30, 0x17, 0x6c,
-		0x91, 0xd7, 0xb7, 0x3a, 0x64, 0x83, 0x6d, 0xe1, 0xbd, 0xfa, 0xc0, 0x24, 0xbd, 0x0a, 0x76, 0x28,
-		0x04, 0x42, 0xfa, 0x22, 0xb4, 0x9e, 0xe5, 0xea, 0x9e, 0x9c, 0x58, 0x4a, 0xa2, 0x61, 0x43, 0xcd,
-		0x00, 0x1b, 0xac, 0x6f, 0x8f, 0x42, 0x7c, 0xdb, 0xb2, 0xda, 0xe8, 0x9b, 0x22, 0x30, 0x63, 0x5a,
-		0xae, 0x4a, 0xe6, 0x0a, 0x6e, 0xaa, 0x3c, 0x59, 0xc0, 0x2c, 0xe9, 0x8d, 0xe3, 0x49, 0xef, 0x4b,
-		0x6f, 0x2d, 0x0e, 0x42, 0x0d, 0xbb, 0x10, 0xbd, 0x60, 0x5a, 0x6e, 0x99, 0x12, 0x35, 0x58, 0x3e,
-		0xe1, 0x36, 0xe4, 0xc2, 0xef, 0x67, 0xe6, 0x57, 0x39, 0xf6, 0xfb, 0x73, 0x63, 0xdf, 0x9d, 0xdd,
-		0x0d, 0xbc, 0x98, 0x5d, 0x4b, 0xfc, 0x87, 0x64, 0x70, 0x5f, 0x05, 0xe9, 0x46, 0xff, 0xb9, 0xa3,
-		0x2a, 0x24, 0xc5, 0x39, 0xa3, 0xc8, 0x84, 0x47, 0x98, 0x82, 0x12, 0xe7, 0xbc, 0xe7, 0x7f, 0x26,
-		0x02, 0xe0, 0xa7, 0x66, 0xd0, 0x63, 0x70, 0xaa, 0xbc, 0xb5, 0xb9, 0xaa, 0xd6, 0x1b, 0xa5, 0xc6,
-		0x4e, 0x3d, 0xfc, 0x57, 0x34, 0xc4, 0x7d, 0x3e, 0x4e, 0x17, 0xeb, 0xc6, 0x9e, 0x81, 0x9b, 0xe8,
-		0x61, 0x98, 0x0b, 0x53, 0x93, 0xa7, 0xea, 0xaa, 0x14, 0x29, 0x66, 0xef, 0xdc, 0x5d, 0x4a, 0x31,
-		0x97, 0x14, 0x37, 0xd1, 0x39, 0x38, 0x31, 0x48, 0x57, 0xdb, 0x5c, 0x93, 0xa2, 0xc5, 0xdc, 0x9d,
-		0xbb, 0x4b, 0x69, 0xcf, 0x77, 0x45, 0x32, 0xa0, 0x20, 0x25, 0xc7, 0x8b, 0x15, 0xe1, 0xce, 0xdd,
-		0xa5, 0x04, 0x1b, 0x96, 0x62, 0xfc, 0x63, 0x3f, 0xbc, 0x30, 0x75, 0xfe, 0xeb, 0x00, 0x6a, 0xe6,
-		0x9e, 0xad, 0xd1, 0x3f, 0x25, 0x8f, 0x8a, 0x70, 0xb2, 0xb6, 0x79, 0x55, 0x29, 0x55, 0x1a, 0xb5,
-		0xad, 0xcd, 0xbe, 0x3f, 0xfe, 0x11, 0xae, 0x5b, 0xdd, 0xda, 0x29, 0xaf, 0x57, 0xd5, 0x7a, 0x6d,
-		0x6d, 0x93, 0xed, 0x01, 0x87, 0xea, 0x5e, 0xde, 0x6c, 0xd4, 0x36, 0xaa, 0x52, 0xb4, 0x7c, 0x75,
-		0xe4, 0x1e, 0xc3, 0x63, 0x47, 0x0e, 0xb8, 0x6f, 0x25, 0x43, 0x1b, 0x0d, 0xff, 0x3b, 0x00, 0x00,
-		0xff, 0xff, 0xd6, 0xf2, 0x1e, 0x47, 0x50, 0xa0, 0x00, 0x00,
+		// 11726 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x90, 0x63, 0xc7,
+		0x75, 0xd8, 0xe0, 0x39, 0xc0, 0x01, 0x30, 0x73, 0xa7, 0x67, 0x76, 0x89, 0xc5, 0x72, 0x67, 0x87,
+		0xa0, 0x48, 0x2e, 0x97, 0xe4, 0x2c, 0xb9, 0xe4, 0x2e, 0xc5, 0x59, 0x51, 0x0c, 0x30, 0xc0, 0xee,
+		0x62, 0x39, 0x2f, 0x5d, 0x60, 0x96, 0x0f, 0x3f, 0xae, 0xef, 0x00, 0x3d, 0x33, 0xe0, 0x02, 0xf7,
+		0x42, 0xf7, 0x5e, 0x2c, 0x67, 0x54, 0xa9, 0x94, 0x6c, 0xd9, 0x89, 0xbd, 0x71, 0x6c, 0xd9, 0x72,
+		0xd9, 0xf2, 0x63, 0x1d, 0xc9, 0x8e, 0x6d, 0xd9, 0x79, 0xf9, 0xa1, 0x58, 0x76, 0x5c, 0x7e, 0xa5,
+		0xf2, 0x90, 0x9d, 0x54, 0x4a, 0xf1, 0x47, 0xe2, 0x4a, 0x55, 0x18, 0x5b, 0x72, 0xc5, 0x8a, 0x2c,
+		0xc7, 0x2f, 0x39, 0x89, 0xa3, 0x8a, 0x2b, 0x75, 0xfa, 0x71, 0x1f, 0xc0, 0xc5, 0x00, 0xb3, 0x5e,
+		0xd2, 0x4a, 0x9c, 0x9f, 0xdd, 0xb9, 0xdd, 0xe7, 0x9c, 0xee, 0x3e, 0x7d, 0xfa, 0xf4, 0x39, 0xa7,
+		0x4f, 0x37, 0xe0, 0xb7, 0xcb, 0xb0, 0xb4, 0x67, 0x9a, 0x7b, 0x1d, 0x7a, 0xa1, 0x67, 0x99, 0x8e,
+		0xb9, 0xd3, 0xdf, 0xbd, 0xd0, 0xa2, 0x76, 0xd3, 0x6a, 0xf7, 0x1c, 0xd3, 0x5a, 0x66, 0x65, 0x64,
+		0x96, 0x43, 0x2c, 0x4b, 0x88, 0xe2, 0x3a, 0xcc, 0x5d, 0x6d, 0x77, 0x68, 0xc5, 0x05, 0xac, 0x53,
+		0x87, 0xbc, 0x1b, 0xe2, 0xbb, 0xed, 0x0e, 0xcd, 0x47, 0x96, 0x62, 0xe7, 0x32, 0x17, 0xdf, 0xb5,
+		0x3c, 0x80, 0xb4, 0x1c, 0xc4, 0xd8, 0xc2, 0x62, 0x95, 0x61, 0x14, 0xff, 0x3c, 0x0e, 0xf3, 0x21,
+		0xb5, 0x84, 0x40, 0xdc, 0xd0, 0xbb, 0x48, 0x31, 0x72, 0x2e, 0xad, 0xb2, 0xbf, 0x49, 0x1e, 0xa6,
+		0x7b, 0x7a, 0xf3, 0x96, 0xbe, 0x47, 0xf3, 0x51, 0x56, 0x2c, 0x3f, 0xc9, 0x22, 0x40, 0x8b, 0xf6,
+		0xa8, 0xd1, 0xa2, 0x46, 0xf3, 0x30, 0x1f, 0x5b, 0x8a, 0x9d, 0x4b, 0xab, 0xbe, 0x12, 0xf2, 0x04,
+		0xcc, 0xf5, 0xfa, 0x3b, 0x9d, 0x76, 0x53, 0xf3, 0x81, 0xc1, 0x52, 0xec, 0x5c, 0x42, 0x55, 0x78,
+		0x45, 0xc5, 0x03, 0x7e, 0x0c, 0x66, 0xdf, 0xa4, 0xfa, 0x2d, 0x3f, 0x68, 0x86, 0x81, 0xce, 0x60,
+		0xb1, 0x0f, 0x70, 0x15, 0xb2, 0x5d, 0x6a, 0xdb, 0xfa, 0x1e, 0xd5, 0x9c, 0xc3, 0x1e, 0xcd, 0xc7,
+		0xd9, 0xe8, 0x97, 0x86, 0x46, 0x3f, 0x38, 0xf2, 0x8c, 0xc0, 0x6a, 0x1c, 0xf6, 0x28, 0x29, 0x41,
+		0x9a, 0x1a, 0xfd, 0x2e, 0xa7, 0x90, 0x18, 0xc1, 0xbf, 0xaa, 0xd1, 0xef, 0x0e, 0x52, 0x49, 0x21,
+		0x9a, 0x20, 0x31, 0x6d, 0x53, 0xeb, 0x76, 0xbb, 0x49, 0xf3, 0x49, 0x46, 0xe0, 0xb1, 0x21, 0x02,
+		0x75, 0x5e, 0x3f, 0x48, 0x43, 0xe2, 0x91, 0x55, 0x48, 0xd3, 0x03, 0x87, 0x1a, 0x76, 0xdb, 0x34,
+		0xf2, 0xd3, 0x8c, 0xc8, 0x23, 0x21, 0xb3, 0x48, 0x3b, 0xad, 0x41, 0x12, 0x1e, 0x1e, 0xb9, 0x0c,
+		0xd3, 0x66, 0xcf, 0x69, 0x9b, 0x86, 0x9d, 0x4f, 0x2d, 0x45, 0xce, 0x65, 0x2e, 0x3e, 0x18, 0x2a,
+		0x08, 0x9b, 0x1c, 0x46, 0x95, 0xc0, 0xa4, 0x06, 0x8a, 0x6d, 0xf6, 0xad, 0x26, 0xd5, 0x9a, 0x66,
+		0x8b, 0x6a, 0x6d, 0x63, 0xd7, 0xcc, 0xa7, 0x19, 0x81, 0xb3, 0xc3, 0x03, 0x61, 0x80, 0xab, 0x66,
+		0x8b, 0xd6, 0x8c, 0x5d, 0x53, 0x9d, 0xb1, 0x03, 0xdf, 0xe4, 0x24, 0x24, 0xed, 0x43, 0xc3, 0xd1,
+		0x0f, 0xf2, 0x59, 0x26, 0x21, 0xe2, 0x0b, 0x45, 0x87, 0xb6, 0xda, 0xd8, 0x5c, 0x3e, 0xc7, 0x45,
+		0x47, 0x7c, 0x16, 0x7f, 0x3e, 0x09, 0xb3, 0x93, 0x08, 0xdf, 0x15, 0x48, 0xec, 0xe2, 0xf8, 0xf3,
+		0xd1, 0xe3, 0x70, 0x87, 0xe3, 0x04, 0xd9, 0x9b, 0xbc, 0x47, 0xf6, 0x96, 0x20, 0x63, 0x50, 0xdb,
+		0xa1, 0x2d, 0x2e, 0x2b, 0xb1, 0x09, 0xa5, 0x0d, 0x38, 0xd2, 0xb0, 0xb0, 0xc5, 0xef, 0x49, 0xd8,
+		0x5e, 0x85, 0x59, 0xb7, 0x4b, 0x9a, 0xa5, 0x1b, 0x7b, 0x52, 0x6a, 0x2f, 0x8c, 0xeb, 0xc9, 0x72,
+		0x55, 0xe2, 0xa9, 0x88, 0xa6, 0xce, 0xd0, 0xc0, 0x37, 0xa9, 0x00, 0x98, 0x06, 0x35, 0x77, 0xb5,
+		0x16, 0x6d, 0x76, 0xf2, 0xa9, 0x11, 0x5c, 0xda, 0x44, 0x90, 0x21, 0x2e, 0x99, 0xbc, 0xb4, 0xd9,
+		0x21, 0x2f, 0x78, 0x42, 0x38, 0x3d, 0x42, 0x86, 0xd6, 0xf9, 0xf2, 0x1b, 0x92, 0xc3, 0x6d, 0x98,
+		0xb1, 0x28, 0xae, 0x08, 0xda, 0x12, 0x23, 0x4b, 0xb3, 0x4e, 0x2c, 0x8f, 0x1d, 0x99, 0x2a, 0xd0,
+		0xf8, 0xc0, 0x72, 0x96, 0xff, 0x93, 0x3c, 0x0c, 0x6e, 0x81, 0xc6, 0xc4, 0x0a, 0x98, 0x7e, 0xca,
+		0xca, 0xc2, 0x0d, 0xbd, 0x4b, 0x0b, 0x1f, 0x80, 0x99, 0x20, 0x7b, 0xc8, 0x02, 0x24, 0x6c, 0x47,
+		0xb7, 0x1c, 0x26, 0x85, 0x09, 0x95, 0x7f, 0x10, 0x05, 0x62, 0xd4, 0x68, 0x31, 0xfd, 0x97, 0x50,
+		0xf1, 0x4f, 0xf2, 0xd7, 0xbc, 0x01, 0xc7, 0xd8, 0x80, 0x1f, 0x1d, 0x9e, 0xd1, 0x00, 0xe5, 0xc1,
+		0x71, 0x17, 0x9e, 0x87, 0x5c, 0x60, 0x00, 0x93, 0x36, 0x5d, 0xfc, 0xeb, 0x70, 0x22, 0x94, 0x34,
+		0x79, 0x15, 0x16, 0xfa, 0x46, 0xdb, 0x70, 0xa8, 0xd5, 0xb3, 0x28, 0x4a, 0x2c, 0x6f, 0x2a, 0xff,
+		0xbb, 0xd3, 0x23, 0x64, 0x6e, 0xdb, 0x0f, 0xcd, 0xa9, 0xa8, 0xf3, 0xfd, 0xe1, 0xc2, 0xf3, 0xe9,
+		0xd4, 0xe7, 0xa7, 0x95, 0x0f, 0x7e, 0xf0, 0x83, 0x1f, 0x8c, 0x16, 0x7f, 0x35, 0x09, 0x0b, 0x61,
+		0x6b, 0x26, 0x74, 0xf9, 0x9e, 0x84, 0xa4, 0xd1, 0xef, 0xee, 0x50, 0x8b, 0x31, 0x29, 0xa1, 0x8a,
+		0x2f, 0x52, 0x82, 0x44, 0x47, 0xdf, 0xa1, 0x9d, 0x7c, 0x7c, 0x29, 0x72, 0x6e, 0xe6, 0xe2, 0x13,
+		0x13, 0xad, 0xca, 0xe5, 0x35, 0x44, 0x51, 0x39, 0x26, 0x79, 0x2f, 0xc4, 0x85, 0xf2, 0x46, 0x0a,
+		0xe7, 0x27, 0xa3, 0x80, 0x6b, 0x49, 0x65, 0x78, 0xe4, 0x34, 0xa4, 0xf1, 0x7f, 0x2e, 0x1b, 0x49,
+		0xd6, 0xe7, 0x14, 0x16, 0xa0, 0x5c, 0x90, 0x02, 0xa4, 0xd8, 0x32, 0x69, 0x51, 0xb9, 0xe9, 0xb9,
+		0xdf, 0x28, 0x58, 0x2d, 0xba, 0xab, 0xf7, 0x3b, 0x8e, 0x76, 0x5b, 0xef, 0xf4, 0x29, 0x13, 0xf8,
+		0xb4, 0x9a, 0x15, 0x85, 0x37, 0xb1, 0x8c, 0x9c, 0x85, 0x0c, 0x5f, 0x55, 0x6d, 0xa3, 0x45, 0x0f,
+		0x98, 0x5e, 0x4d, 0xa8, 0x7c, 0xa1, 0xd5, 0xb0, 0x04, 0x9b, 0x7f, 0xc3, 0x36, 0x0d, 0x29, 0x9a,
+		0xac, 0x09, 0x2c, 0x60, 0xcd, 0x3f, 0x3f, 0xa8, 0xd2, 0xcf, 0x84, 0x0f, 0x6f, 0x68, 0x2d, 0x3d,
+		0x06, 0xb3, 0x0c, 0xe2, 0x59, 0x31, 0xf5, 0x7a, 0x27, 0x3f, 0xb7, 0x14, 0x39, 0x97, 0x52, 0x67,
+		0x78, 0xf1, 0xa6, 0x28, 0x2d, 0xfe, 0x6c, 0x14, 0xe2, 0x4c, 0xb1, 0xcc, 0x42, 0xa6, 0xf1, 0xda,
+		0x56, 0x55, 0xab, 0x6c, 0x6e, 0x97, 0xd7, 0xaa, 0x4a, 0x84, 0xcc, 0x00, 0xb0, 0x82, 0xab, 0x6b,
+		0x9b, 0xa5, 0x86, 0x12, 0x75, 0xbf, 0x6b, 0x1b, 0x8d, 0xcb, 0xcf, 0x29, 0x31, 0x17, 0x61, 0x9b,
+		0x17, 0xc4, 0xfd, 0x00, 0xcf, 0x5e, 0x54, 0x12, 0x44, 0x81, 0x2c, 0x27, 0x50, 0x7b, 0xb5, 0x5a,
+		0xb9, 0xfc, 0x9c, 0x92, 0x0c, 0x96, 0x3c, 0x7b, 0x51, 0x99, 0x26, 0x39, 0x48, 0xb3, 0x92, 0xf2,
+		0xe6, 0xe6, 0x9a, 0x92, 0x72, 0x69, 0xd6, 0x1b, 0x6a, 0x6d, 0xe3, 0x9a, 0x92, 0x76, 0x69, 0x5e,
+		0x53, 0x37, 0xb7, 0xb7, 0x14, 0x70, 0x29, 0xac, 0x57, 0xeb, 0xf5, 0xd2, 0xb5, 0xaa, 0x92, 0x71,
+		0x21, 0xca, 0xaf, 0x35, 0xaa, 0x75, 0x25, 0x1b, 0xe8, 0xd6, 0xb3, 0x17, 0x95, 0x9c, 0xdb, 0x44,
+		0x75, 0x63, 0x7b, 0x5d, 0x99, 0x21, 0x73, 0x90, 0xe3, 0x4d, 0xc8, 0x4e, 0xcc, 0x0e, 0x14, 0x5d,
+		0x7e, 0x4e, 0x51, 0xbc, 0x8e, 0x70, 0x2a, 0x73, 0x81, 0x82, 0xcb, 0xcf, 0x29, 0xa4, 0xb8, 0x0a,
+		0x09, 0x26, 0x86, 0x84, 0xc0, 0xcc, 0x5a, 0xa9, 0x5c, 0x5d, 0xd3, 0x36, 0xb7, 0x1a, 0xb5, 0xcd,
+		0x8d, 0xd2, 0x9a, 0x12, 0xf1, 0xca, 0xd4, 0xea, 0xfb, 0xb6, 0x6b, 0x6a, 0xb5, 0xa2, 0x44, 0xfd,
+		0x65, 0x5b, 0xd5, 0x52, 0xa3, 0x5a, 0x51, 0x62, 0xc5, 0x26, 0x2c, 0x84, 0x29, 0xd4, 0xd0, 0x25,
+		0xe4, 0x93, 0x85, 0xe8, 0x08, 0x59, 0x60, 0xb4, 0x06, 0x65, 0xa1, 0xf8, 0xb9, 0x28, 0xcc, 0x87,
+		0x6c, 0x2a, 0xa1, 0x8d, 0xbc, 0x04, 0x09, 0x2e, 0xcb, 0x7c, 0x9b, 0x7d, 0x3c, 0x74, 0x77, 0x62,
+		0x92, 0x3d, 0xb4, 0xd5, 0x32, 0x3c, 0xbf, 0x11, 0x12, 0x1b, 0x61, 0x84, 0x20, 0x89, 0x21, 0x81,
+		0xfd, 0x9a, 0x21, 0xe5, 0xcf, 0xf7, 0xc7, 0xcb, 0x93, 0xec, 0x8f, 0xac, 0xec, 0x78, 0x9b, 0x40,
+		0x22, 0x64, 0x13, 0xb8, 0x02, 0x73, 0x43, 0x84, 0x26, 0x56, 0xc6, 0x1f, 0x8a, 0x40, 0x7e, 0x14,
+		0x73, 0xc6, 0xa8, 0xc4, 0x68, 0x40, 0x25, 0x5e, 0x19, 0xe4, 0xe0, 0x43, 0xa3, 0x27, 0x61, 0x68,
+		0xae, 0x7f, 0x2c, 0x02, 0x27, 0xc3, 0x8d, 0xcd, 0xd0, 0x3e, 0xbc, 0x17, 0x92, 0x5d, 0xea, 0xec,
+		0x9b, 0xd2, 0xac, 0x7a, 0x34, 0x64, 0xb3, 0xc6, 0xea, 0xc1, 0xc9, 0x16, 0x58, 0xe4, 0x85, 0xc1,
+		0xbe, 0x9e, 0x1d, 0x65, 0xfa, 0x0e, 0xf5, 0xf4, 0x5b, 0xa2, 0x70, 0x22, 0x94, 0x78, 0x68, 0x47,
+		0xcf, 0x00, 0xb4, 0x8d, 0x5e, 0xdf, 0xe1, 0xa6, 0x13, 0xd7, 0xc4, 0x69, 0x56, 0xc2, 0x94, 0x17,
+		0x6a, 0xd9, 0xbe, 0xe3, 0xd6, 0xc7, 0x58, 0x3d, 0xf0, 0x22, 0x06, 0xf0, 0x6e, 0xaf, 0xa3, 0x71,
+		0xd6, 0xd1, 0xc5, 0x11, 0x23, 0x1d, 0x12, 0xcc, 0xa7, 0x41, 0x69, 0x76, 0xda, 0xd4, 0x70, 0x34,
+		0xdb, 0xb1, 0xa8, 0xde, 0x6d, 0x1b, 0x7b, 0x6c, 0xab, 0x49, 0xad, 0x24, 0x76, 0xf5, 0x8e, 0x4d,
+		0xd5, 0x59, 0x5e, 0x5d, 0x97, 0xb5, 0x88, 0xc1, 0x04, 0xc8, 0xf2, 0x61, 0x24, 0x03, 0x18, 0xbc,
+		0xda, 0xc5, 0x28, 0x7e, 0x47, 0x1a, 0x32, 0x3e, 0xd3, 0x9c, 0x3c, 0x04, 0xd9, 0x37, 0xf4, 0xdb,
+		0xba, 0x26, 0xdd, 0x2d, 0xce, 0x89, 0x0c, 0x96, 0x6d, 0xf1, 0x22, 0xf2, 0x34, 0x2c, 0x30, 0x10,
+		0xb3, 0xef, 0x50, 0x4b, 0x6b, 0x76, 0x74, 0xdb, 0x66, 0x4c, 0x4b, 0x31, 0x50, 0x82, 0x75, 0x9b,
+		0x58, 0xb5, 0x2a, 0x6b, 0xc8, 0x25, 0x98, 0x67, 0x18, 0xdd, 0x7e, 0xc7, 0x69, 0xf7, 0x3a, 0x54,
+		0x43, 0x07, 0xd0, 0xce, 0x83, 0xbf, 0x67, 0x73, 0x08, 0xb1, 0x2e, 0x00, 0xb0, 0x47, 0x36, 0xa9,
+		0xc0, 0x19, 0x86, 0xb6, 0x47, 0x0d, 0x6a, 0xe9, 0x0e, 0xd5, 0xe8, 0xfb, 0xfb, 0x7a, 0xc7, 0xd6,
+		0x74, 0xa3, 0xa5, 0xed, 0xeb, 0xf6, 0x7e, 0x7e, 0x01, 0x09, 0x94, 0xa3, 0xf9, 0x88, 0x7a, 0x0a,
+		0x01, 0xaf, 0x09, 0xb8, 0x2a, 0x03, 0x2b, 0x19, 0xad, 0xeb, 0xba, 0xbd, 0x4f, 0x56, 0xe0, 0x24,
+		0xa3, 0x62, 0x3b, 0x56, 0xdb, 0xd8, 0xd3, 0x9a, 0xfb, 0xb4, 0x79, 0x4b, 0xeb, 0x3b, 0xbb, 0xef,
+		0xce, 0x9f, 0xf6, 0xb7, 0xcf, 0x7a, 0x58, 0x67, 0x30, 0xab, 0x08, 0xb2, 0xed, 0xec, 0xbe, 0x9b,
+		0xd4, 0x21, 0x8b, 0x93, 0xd1, 0x6d, 0x7f, 0x80, 0x6a, 0xbb, 0xa6, 0xc5, 0xf6, 0xd0, 0x99, 0x10,
+		0xd5, 0xe4, 0xe3, 0xe0, 0xf2, 0xa6, 0x40, 0x58, 0x37, 0x5b, 0x74, 0x25, 0x51, 0xdf, 0xaa, 0x56,
+		0x2b, 0x6a, 0x46, 0x52, 0xb9, 0x6a, 0x5a, 0x28, 0x50, 0x7b, 0xa6, 0xcb, 0xe0, 0x0c, 0x17, 0xa8,
+		0x3d, 0x53, 0xb2, 0xf7, 0x12, 0xcc, 0x37, 0x9b, 0x7c, 0xcc, 0xed, 0xa6, 0x26, 0xdc, 0x34, 0x3b,
+		0xaf, 0x04, 0x98, 0xd5, 0x6c, 0x5e, 0xe3, 0x00, 0x42, 0xc6, 0x6d, 0xf2, 0x02, 0x9c, 0xf0, 0x98,
+		0xe5, 0x47, 0x9c, 0x1b, 0x1a, 0xe5, 0x20, 0xea, 0x25, 0x98, 0xef, 0x1d, 0x0e, 0x23, 0x92, 0x40,
+		0x8b, 0xbd, 0xc3, 0x41, 0xb4, 0xe7, 0x61, 0xa1, 0xb7, 0xdf, 0x1b, 0xc6, 0x3b, 0xef, 0xc7, 0x23,
+		0xbd, 0xfd, 0xde, 0x20, 0xe2, 0x23, 0xcc, 0x67, 0xb7, 0x68, 0x53, 0x77, 0x68, 0x2b, 0xff, 0x80,
+		0x1f, 0xdc, 0x57, 0x41, 0x96, 0x41, 0x69, 0x36, 0x35, 0x6a, 0xe8, 0x3b, 0x1d, 0xaa, 0xe9, 0x16,
+		0x35, 0x74, 0x3b, 0x7f, 0x96, 0x01, 0xc7, 0x1d, 0xab, 0x4f, 0xd5, 0x99, 0x66, 0xb3, 0xca, 0x2a,
+		0x4b, 0xac, 0x8e, 0x9c, 0x87, 0x39, 0x73, 0xe7, 0x8d, 0x26, 0x97, 0x48, 0xad, 0x67, 0xd1, 0xdd,
+		0xf6, 0x41, 0xfe, 0x5d, 0x8c, 0xbd, 0xb3, 0x58, 0xc1, 0xe4, 0x71, 0x8b, 0x15, 0x93, 0xc7, 0x41,
+		0x69, 0xda, 0xfb, 0xba, 0xd5, 0x63, 0x2a, 0xd9, 0xee, 0xe9, 0x4d, 0x9a, 0x7f, 0x84, 0x83, 0xf2,
+		0xf2, 0x0d, 0x59, 0x8c, 0x2b, 0xc2, 0x7e, 0xb3, 0xbd, 0xeb, 0x48, 0x8a, 0x8f, 0xf1, 0x15, 0xc1,
+		0xca, 0x04, 0xb5, 0x73, 0xa0, 0x20, 0x27, 0x02, 0x0d, 0x9f, 0x63, 0x60, 0x33, 0xbd, 0xfd, 0x9e,
+		0xbf, 0xdd, 0x87, 0x21, 0xd7, 0xdb, 0xf7, 0x37, 0xfa, 0x38, 0x37, 0xdc, 0x7a, 0xfb, 0xbe, 0x16,
+		0x9f, 0x83, 0x93, 0x08, 0xd4, 0xa5, 0x8e, 0xde, 0xd2, 0x1d, 0xdd, 0x07, 0xfd, 0x24, 0x83, 0x46,
+		0xb6, 0xaf, 0x8b, 0xca, 0x40, 0x3f, 0xad, 0xfe, 0xce, 0xa1, 0x2b, 0x58, 0x4f, 0xf1, 0x7e, 0x62,
+		0x99, 0x14, 0xad, 0xb7, 0xcd, 0x38, 0x2f, 0xae, 0x40, 0xd6, 0x2f, 0xf7, 0x24, 0x0d, 0x5c, 0xf2,
+		0x95, 0x08, 0x1a, 0x41, 0xab, 0x9b, 0x15, 0x34, 0x5f, 0x5e, 0xaf, 0x2a, 0x51, 0x34, 0xa3, 0xd6,
+		0x6a, 0x8d, 0xaa, 0xa6, 0x6e, 0x6f, 0x34, 0x6a, 0xeb, 0x55, 0x25, 0xe6, 0x33, 0xec, 0x6f, 0xc4,
+		0x53, 0x8f, 0x2a, 0x8f, 0x15, 0x7f, 0x21, 0x06, 0x33, 0x41, 0x4f, 0x8d, 0xbc, 0x07, 0x1e, 0x90,
+		0x01, 0x17, 0x9b, 0x3a, 0xda, 0x9b, 0x6d, 0x8b, 0x2d, 0xc8, 0xae, 0xce, 0x37, 0x47, 0x57, 0x7e,
+		0x16, 0x04, 0x54, 0x9d, 0x3a, 0xaf, 0xb4, 0x2d, 0x5c, 0x6e, 0x5d, 0xdd, 0x21, 0x6b, 0x70, 0xd6,
+		0x30, 0x35, 0xdb, 0xd1, 0x8d, 0x96, 0x6e, 0xb5, 0x34, 0x2f, 0xd4, 0xa5, 0xe9, 0xcd, 0x26, 0xb5,
+		0x6d, 0x93, 0x6f, 0x84, 0x2e, 0x95, 0x07, 0x0d, 0xb3, 0x2e, 0x80, 0xbd, 0x1d, 0xa2, 0x24, 0x40,
+		0x07, 0xc4, 0x37, 0x36, 0x4a, 0x7c, 0x4f, 0x43, 0xba, 0xab, 0xf7, 0x34, 0x6a, 0x38, 0xd6, 0x21,
+		0xb3, 0xcf, 0x53, 0x6a, 0xaa, 0xab, 0xf7, 0xaa, 0xf8, 0x4d, 0x6e, 0xc2, 0xa3, 0x1e, 0xa8, 0xd6,
+		0xa1, 0x7b, 0x7a, 0xf3, 0x50, 0x63, 0xc6, 0x38, 0x0b, 0x1b, 0x68, 0x4d, 0xd3, 0xd8, 0xed, 0xb4,
+		0x9b, 0x8e, 0x9d, 0xcf, 0xb8, 0x3a, 0xae, 0xe8, 0x61, 0xac, 0x31, 0x84, 0x1b, 0xb6, 0x69, 0x30,
+		0x1b, 0x7c, 0x55, 0x42, 0xbf, 0x23, 0xee, 0xd7, 0x8d, 0x78, 0x2a, 0xae, 0x24, 0x6e, 0xc4, 0x53,
+		0x09, 0x25, 0x79, 0x23, 0x9e, 0x4a, 0x2a, 0xd3, 0x37, 0xe2, 0xa9, 0x94, 0x92, 0xbe, 0x11, 0x4f,
+		0xa5, 0x15, 0x28, 0x7e, 0x2a, 0x05, 0x59, 0xbf, 0x67, 0x80, 0x8e, 0x56, 0x93, 0xed, 0x8d, 0x11,
+		0xa6, 0x3d, 0x1f, 0x3e, 0xd2, 0x8f, 0x58, 0x5e, 0xc5, 0x4d, 0x73, 0x25, 0xc9, 0xcd, 0x70, 0x95,
+		0x63, 0xa2, 0xc1, 0x82, 0x62, 0x4d, 0xb9, 0xd9, 0x93, 0x52, 0xc5, 0x17, 0xb9, 0x06, 0xc9, 0x37,
+		0x6c, 0x46, 0x3b, 0xc9, 0x68, 0xbf, 0xeb, 0x68, 0xda, 0x37, 0xea, 0x8c, 0x78, 0xfa, 0x46, 0x5d,
+		0xdb, 0xd8, 0x54, 0xd7, 0x4b, 0x6b, 0xaa, 0x40, 0x27, 0xa7, 0x20, 0xde, 0xd1, 0x3f, 0x70, 0x18,
+		0xdc, 0x5e, 0x59, 0x11, 0x59, 0x86, 0xd9, 0xbe, 0x71, 0x9b, 0x5a, 0xed, 0xdd, 0x36, 0x4e, 0x15,
+		0x42, 0xcd, 0xfa, 0xa1, 0x66, 0xbc, 0xda, 0x35, 0x84, 0x9f, 0x50, 0x3c, 0x4e, 0x41, 0x1c, 0x83,
+		0x8a, 0xc1, 0x4d, 0x90, 0x15, 0x91, 0x73, 0x90, 0x6d, 0xd1, 0x9d, 0xfe, 0x9e, 0x66, 0xd1, 0x96,
+		0xde, 0x74, 0x82, 0xaa, 0x3f, 0xc3, 0xaa, 0x54, 0x56, 0x43, 0x5e, 0x86, 0x34, 0xce, 0x91, 0xc1,
+		0xe6, 0x78, 0x8e, 0xb1, 0xe0, 0xa9, 0xa3, 0x59, 0x20, 0xa6, 0x58, 0x22, 0xa9, 0x1e, 0x3e, 0xb9,
+		0x0a, 0x49, 0x47, 0xb7, 0xf6, 0xa8, 0xc3, 0x34, 0xff, 0xcc, 0xc5, 0xe5, 0x49, 0x28, 0x35, 0x18,
+		0x06, 0xf3, 0x69, 0x05, 0xf6, 0xdb, 0xa8, 0x65, 0x2e, 0x40, 0x82, 0x89, 0x07, 0x01, 0x10, 0x02,
+		0xa2, 0x4c, 0x91, 0x14, 0xc4, 0x57, 0x37, 0x55, 0xd4, 0x34, 0x0a, 0x64, 0x79, 0xa9, 0xb6, 0x55,
+		0xab, 0xae, 0x56, 0x95, 0x68, 0xf1, 0x12, 0x24, 0xf9, 0x9c, 0xa3, 0x16, 0x72, 0x67, 0x5d, 0x99,
+		0x12, 0x9f, 0x82, 0x46, 0x44, 0xd6, 0x6e, 0xaf, 0x97, 0xab, 0xaa, 0x12, 0x2d, 0x6e, 0xc3, 0xec,
+		0x00, 0x9f, 0xc8, 0x09, 0x98, 0x53, 0xab, 0x8d, 0xea, 0x06, 0xfa, 0x59, 0xda, 0xf6, 0xc6, 0xcb,
+		0x1b, 0x9b, 0xaf, 0x6c, 0x28, 0x53, 0xc1, 0x62, 0xa9, 0xd2, 0x22, 0x64, 0x01, 0x14, 0xaf, 0xb8,
+		0xbe, 0xb9, 0xad, 0xb2, 0xde, 0x7c, 0x6b, 0x14, 0x94, 0x41, 0xae, 0x91, 0x07, 0x60, 0xbe, 0x51,
+		0x52, 0xaf, 0x55, 0x1b, 0x1a, 0xf7, 0x1d, 0x5d, 0xd2, 0x0b, 0xa0, 0xf8, 0x2b, 0xae, 0xd6, 0x98,
+		0x6b, 0x7c, 0x16, 0x4e, 0xfb, 0x4b, 0xab, 0xaf, 0x36, 0xaa, 0x1b, 0x75, 0xd6, 0x78, 0x69, 0xe3,
+		0x1a, 0xea, 0xd7, 0x01, 0x7a, 0xd2, 0x5b, 0x8d, 0x61, 0x57, 0x83, 0xf4, 0xaa, 0x6b, 0x15, 0x25,
+		0x3e, 0x58, 0xbc, 0xb9, 0x51, 0xdd, 0xbc, 0xaa, 0x24, 0x06, 0x5b, 0x67, 0x1e, 0x6c, 0x92, 0x14,
+		0xe0, 0xe4, 0x60, 0xa9, 0x56, 0xdd, 0x68, 0xa8, 0xaf, 0x29, 0xd3, 0x83, 0x0d, 0xd7, 0xab, 0xea,
+		0xcd, 0xda, 0x6a, 0x55, 0x49, 0x91, 0x93, 0x40, 0x82, 0x3d, 0x6a, 0x5c, 0xdf, 0xac, 0x28, 0xe9,
+		0x21, 0x8d, 0x52, 0xb4, 0x21, 0xeb, 0x77, 0x23, 0xdf, 0x99, 0x58, 0xd2, 0x47, 0xa3, 0x90, 0xf1,
+		0xb9, 0x85, 0x68, 0xcf, 0xeb, 0x9d, 0x8e, 0xf9, 0xa6, 0xa6, 0x77, 0xda, 0xba, 0x2d, 0xf4, 0x0d,
+		0xb0, 0xa2, 0x12, 0x96, 0x4c, 0xba, 0xbe, 0x27, 0xd7, 0xf0, 0xc9, 0xaf, 0x44, 0x0d, 0x9f, 0x50,
+		0x92, 0xc5, 0x1f, 0x8c, 0x80, 0x32, 0xe8, 0xef, 0x0d, 0x0c, 0x3f, 0x32, 0x6a, 0xf8, 0xef, 0xc8,
+		0xdc, 0xfd, 0x40, 0x04, 0x66, 0x82, 0x4e, 0xde, 0x40, 0xf7, 0x1e, 0xfa, 0x4b, 0xed, 0xde, 0x6f,
+		0x45, 0x21, 0x17, 0x70, 0xed, 0x26, 0xed, 0xdd, 0xfb, 0x61, 0xae, 0xdd, 0xa2, 0xdd, 0x9e, 0xe9,
+		0xe0, 0x69, 0x93, 0xd6, 0xa1, 0xb7, 0x69, 0x27, 0x5f, 0x64, 0x4a, 0xf9, 0xc2, 0xd1, 0xce, 0xe3,
+		0x72, 0xcd, 0xc3, 0x5b, 0x43, 0xb4, 0x95, 0xf9, 0x5a, 0xa5, 0xba, 0xbe, 0xb5, 0xd9, 0xa8, 0x6e,
+		0xac, 0xbe, 0x26, 0xb5, 0x8b, 0xaa, 0xb4, 0x07, 0xc0, 0xde, 0x46, 0xa5, 0xbd, 0x05, 0xca, 0x60,
+		0xa7, 0x50, 0x57, 0x84, 0x74, 0x4b, 0x99, 0x22, 0xf3, 0x30, 0xbb, 0xb1, 0xa9, 0xd5, 0x6b, 0x95,
+		0xaa, 0x56, 0xbd, 0x7a, 0xb5, 0xba, 0xda, 0xa8, 0xf3, 0x70, 0xa0, 0x0b, 0xdd, 0x50, 0xa2, 0x7e,
+		0x16, 0x7f, 0x5f, 0x0c, 0xe6, 0x43, 0x7a, 0x42, 0x4a, 0xc2, 0x91, 0xe7, 0xb1, 0x85, 0xa7, 0x26,
+		0xe9, 0xfd, 0x32, 0x9a, 0xd2, 0x5b, 0xba, 0xe5, 0x08, 0xbf, 0xff, 0x71, 0x40, 0x2e, 0x19, 0x0e,
+		0xee, 0xec, 0x96, 0x08, 0xb3, 0x72, 0xef, 0x7e, 0xd6, 0x2b, 0xe7, 0x91, 0xd6, 0x27, 0x81, 0xf4,
+		0x4c, 0xbb, 0xed, 0xb4, 0x6f, 0xe3, 0x19, 0x96, 0x8c, 0xc9, 0xa2, 0xb7, 0x1f, 0x57, 0x15, 0x59,
+		0x53, 0x33, 0x1c, 0x17, 0xda, 0xa0, 0x7b, 0xfa, 0x00, 0x34, 0x5a, 0x1e, 0x31, 0x55, 0x91, 0x35,
+		0x2e, 0xf4, 0x43, 0x90, 0x6d, 0x99, 0x7d, 0x74, 0x81, 0x38, 0x1c, 0x6a, 0x8b, 0x88, 0x9a, 0xe1,
+		0x65, 0x2e, 0x88, 0x70, 0x6e, 0xbd, 0x60, 0x70, 0x56, 0xcd, 0xf0, 0x32, 0x0e, 0xf2, 0x18, 0xcc,
+		0xea, 0x7b, 0x7b, 0x16, 0x12, 0x97, 0x84, 0xb8, 0xbb, 0x3e, 0xe3, 0x16, 0x33, 0xc0, 0xc2, 0x0d,
+		0x48, 0x49, 0x3e, 0xa0, 0x05, 0x8b, 0x9c, 0xd0, 0x7a, 0x3c, 0x06, 0x15, 0xc5, 0xf8, 0xb0, 0x21,
+		0x2b, 0x1f, 0x82, 0x6c, 0xdb, 0xd6, 0xbc, 0xb3, 0xad, 0xe8, 0x52, 0xf4, 0x5c, 0x4a, 0xcd, 0xb4,
+		0x6d, 0xf7, 0x5c, 0xa0, 0xf8, 0x63, 0x51, 0x98, 0x09, 0x9e, 0xda, 0x91, 0x0a, 0xa4, 0x3a, 0x66,
+		0x53, 0x67, 0xa2, 0xc5, 0x8f, 0x8c, 0xcf, 0x8d, 0x39, 0xe8, 0x5b, 0x5e, 0x13, 0xf0, 0xaa, 0x8b,
+		0x59, 0xf8, 0xb7, 0x11, 0x48, 0xc9, 0x62, 0x72, 0x12, 0xe2, 0x3d, 0xdd, 0xd9, 0x67, 0xe4, 0x12,
+		0xe5, 0xa8, 0x12, 0x51, 0xd9, 0x37, 0x96, 0xdb, 0x3d, 0xdd, 0xc8, 0x47, 0xbd, 0x72, 0xfc, 0xc6,
+		0x79, 0xed, 0x50, 0xbd, 0xc5, 0x62, 0x01, 0x66, 0xb7, 0x4b, 0x0d, 0xc7, 0x96, 0xf3, 0x2a, 0xca,
+		0x57, 0x45, 0x31, 0x1e, 0x1e, 0x3b, 0x96, 0xde, 0xee, 0x04, 0x60, 0xe3, 0x0c, 0x56, 0x91, 0x15,
+		0x2e, 0xf0, 0x0a, 0x9c, 0x92, 0x74, 0x5b, 0xd4, 0xd1, 0x9b, 0xfb, 0xb4, 0xe5, 0x21, 0x25, 0x59,
+		0xcc, 0xef, 0x01, 0x01, 0x50, 0x11, 0xf5, 0x12, 0xb7, 0xf8, 0x99, 0x28, 0xcc, 0xc9, 0xe8, 0x45,
+		0xcb, 0x65, 0xd6, 0x3a, 0x80, 0x6e, 0x18, 0xa6, 0xe3, 0x67, 0xd7, 0xb0, 0x28, 0x0f, 0xe1, 0x2d,
+		0x97, 0x5c, 0x24, 0xd5, 0x47, 0xa0, 0xf0, 0x7b, 0x11, 0x00, 0xaf, 0x6a, 0x24, 0xdf, 0xce, 0x42,
+		0x46, 0x9c, 0xc9, 0xb2, 0x83, 0x7d, 0x1e, 0xf0, 0x02, 0x5e, 0x84, 0x71, 0x0e, 0x0c, 0x4b, 0xee,
+		0xd0, 0xbd, 0xb6, 0x21, 0xce, 0x53, 0xf8, 0x87, 0x0c, 0x4b, 0xc6, 0xbd, 0xe3, 0x29, 0x15, 0x52,
+		0x36, 0xed, 0xea, 0x86, 0xd3, 0x6e, 0x8a, 0x13, 0x92, 0xcb, 0xc7, 0xea, 0xfc, 0x72, 0x5d, 0x60,
+		0xab, 0x2e, 0x9d, 0xe2, 0x39, 0x48, 0xc9, 0x52, 0x34, 0xfc, 0x36, 0x36, 0x37, 0xaa, 0xca, 0x14,
+		0x99, 0x86, 0x58, 0xbd, 0xda, 0x50, 0x22, 0xe8, 0x76, 0x96, 0xd6, 0x6a, 0xa5, 0xba, 0x12, 0x2d,
+		0xff, 0x0d, 0x98, 0x6f, 0x9a, 0xdd, 0xc1, 0x06, 0xcb, 0xca, 0x40, 0xc8, 0xcf, 0xbe, 0x1e, 0x79,
+		0xfd, 0x29, 0x01, 0xb4, 0x67, 0x76, 0x74, 0x63, 0x6f, 0xd9, 0xb4, 0xf6, 0xbc, 0xb4, 0x08, 0xf4,
+		0x0e, 0x6c, 0x5f, 0x72, 0x44, 0x6f, 0xe7, 0x7f, 0x46, 0x22, 0x3f, 0x14, 0x8d, 0x5d, 0xdb, 0x2a,
+		0xff, 0x44, 0xb4, 0x70, 0x8d, 0x23, 0x6e, 0xc9, 0xe1, 0xa8, 0x74, 0xb7, 0x43, 0x9b, 0xd8, 0x79,
+		0xf8, 0xfd, 0x27, 0x60, 0x61, 0xcf, 0xdc, 0x33, 0x19, 0xa5, 0x0b, 0xf8, 0x17, 0xef, 0x04, 0x49,
+		0xbb, 0xa5, 0x85, 0xb1, 0x49, 0x18, 0x2b, 0x1b, 0x30, 0x2f, 0x80, 0x35, 0x76, 0x7c, 0xcb, 0x83,
+		0x0b, 0xe4, 0xc8, 0xc8, 0x76, 0xfe, 0xa7, 0x7e, 0x87, 0x59, 0x25, 0xea, 0x9c, 0x40, 0xc5, 0x3a,
+		0x1e, 0x7f, 0x58, 0x51, 0xe1, 0x44, 0x80, 0x1e, 0xd7, 0x11, 0xd4, 0x1a, 0x43, 0xf1, 0x5f, 0x08,
+		0x8a, 0xf3, 0x3e, 0x8a, 0x75, 0x81, 0xba, 0xb2, 0x0a, 0xb9, 0xe3, 0xd0, 0xfa, 0x97, 0x82, 0x56,
+		0x96, 0xfa, 0x89, 0x5c, 0x83, 0x59, 0x46, 0xa4, 0xd9, 0xb7, 0x1d, 0xb3, 0xcb, 0x14, 0xf0, 0xd1,
+		0x64, 0xfe, 0xd5, 0xef, 0xf0, 0x45, 0x3b, 0x83, 0x68, 0xab, 0x2e, 0xd6, 0xca, 0x0a, 0xb0, 0x13,
+		0x6b, 0x3c, 0x49, 0x1e, 0x43, 0xe1, 0xd3, 0xa2, 0x23, 0x2e, 0xfc, 0xca, 0x4d, 0x58, 0xc0, 0xbf,
+		0x99, 0x7e, 0xf4, 0xf7, 0x64, 0x7c, 0x18, 0x3c, 0xff, 0xef, 0x3e, 0xc4, 0xf5, 0xc2, 0xbc, 0x4b,
+		0xc0, 0xd7, 0x27, 0xdf, 0x2c, 0xee, 0x51, 0xc7, 0xa1, 0x96, 0xad, 0xe9, 0x9d, 0xb0, 0xee, 0xf9,
+		0xe2, 0x88, 0xf9, 0xef, 0xfd, 0x62, 0x70, 0x16, 0xaf, 0x71, 0xcc, 0x52, 0xa7, 0xb3, 0xb2, 0x0d,
+		0x0f, 0x84, 0x48, 0xc5, 0x04, 0x34, 0xbf, 0x4f, 0xd0, 0x5c, 0x18, 0x92, 0x0c, 0x24, 0xbb, 0x05,
+		0xb2, 0xdc, 0x9d, 0xcb, 0x09, 0x68, 0x7e, 0xbf, 0xa0, 0x49, 0x04, 0xae, 0x9c, 0x52, 0xa4, 0x78,
+		0x03, 0xe6, 0x6e, 0x53, 0x6b, 0xc7, 0xb4, 0x45, 0xec, 0x76, 0x02, 0x72, 0x3f, 0x20, 0xc8, 0xcd,
+		0x0a, 0x44, 0x16, 0xcc, 0x45, 0x5a, 0x2f, 0x40, 0x6a, 0x57, 0x6f, 0xd2, 0x09, 0x48, 0xdc, 0x15,
+		0x24, 0xa6, 0x11, 0x1e, 0x51, 0x4b, 0x90, 0xdd, 0x33, 0xc5, 0x16, 0x39, 0x1e, 0xfd, 0x07, 0x05,
+		0x7a, 0x46, 0xe2, 0x08, 0x12, 0x3d, 0xb3, 0xd7, 0xef, 0xe0, 0xfe, 0x39, 0x9e, 0xc4, 0xdf, 0x95,
+		0x24, 0x24, 0x8e, 0x20, 0x71, 0x0c, 0xb6, 0x7e, 0x4c, 0x92, 0xb0, 0x7d, 0xfc, 0x7c, 0x09, 0x8f,
+		0x74, 0x3b, 0x87, 0xa6, 0x31, 0x49, 0x27, 0x3e, 0x2e, 0x28, 0x80, 0x40, 0x41, 0x02, 0x57, 0x20,
+		0x3d, 0xe9, 0x44, 0xfc, 0xc8, 0x17, 0xe5, 0xf2, 0x90, 0x33, 0x70, 0x0d, 0x66, 0xa5, 0x82, 0xc2,
+		0x14, 0x90, 0xf1, 0x24, 0x7e, 0x54, 0x90, 0x98, 0xf1, 0xa1, 0x89, 0x61, 0x38, 0xd4, 0x76, 0xf6,
+		0xe8, 0x24, 0x44, 0x7e, 0x4c, 0x0e, 0x43, 0xa0, 0x08, 0x56, 0xee, 0x50, 0xa3, 0xb9, 0x3f, 0x19,
+		0x85, 0x4f, 0x48, 0x56, 0x4a, 0x1c, 0x24, 0xb1, 0x0a, 0xb9, 0xae, 0x6e, 0xd9, 0xfb, 0x7a, 0x67,
+		0xa2, 0xe9, 0xf8, 0x71, 0x41, 0x23, 0xeb, 0x22, 0x09, 0x8e, 0xf4, 0x8d, 0xe3, 0x90, 0xf9, 0x09,
+		0xc9, 0x91, 0xbe, 0x11, 0x20, 0xb4, 0x05, 0x0b, 0xb6, 0xc3, 0x02, 0xdd, 0xc7, 0xa1, 0xf6, 0xf7,
+		0xe5, 0xd2, 0xe3, 0xb8, 0xeb, 0x7e, 0x8a, 0x57, 0x20, 0x6d, 0xb7, 0x3f, 0x30, 0x11, 0x99, 0x7f,
+		0x20, 0x67, 0x9a, 0x21, 0x20, 0xf2, 0x6b, 0x70, 0x2a, 0x74, 0x9b, 0x98, 0x80, 0xd8, 0x3f, 0x14,
+		0xc4, 0x4e, 0x86, 0x6c, 0x15, 0x42, 0x25, 0x1c, 0x97, 0xe4, 0x3f, 0x92, 0x2a, 0x81, 0x0e, 0xd0,
+		0xda, 0x42, 0xa7, 0xc5, 0xd6, 0x77, 0x8f, 0xc7, 0xb5, 0x7f, 0x2c, 0xb9, 0xc6, 0x71, 0x03, 0x5c,
+		0x6b, 0xc0, 0x49, 0x41, 0xf1, 0x78, 0xf3, 0xfa, 0x93, 0x52, 0xb1, 0x72, 0xec, 0xed, 0xe0, 0xec,
+		0x7e, 0x15, 0x14, 0x5c, 0x76, 0x4a, 0xeb, 0xd8, 0xd6, 0x30, 0x3a, 0x3c, 0x9e, 0xf2, 0x4f, 0x09,
+		0xca, 0x52, 0xe3, 0xbb, 0xe6, 0xb5, 0xbd, 0xae, 0xf7, 0x90, 0xf8, 0xab, 0x90, 0x97, 0xc4, 0xfb,
+		0x86, 0x45, 0x9b, 0xe6, 0x9e, 0xd1, 0xfe, 0x00, 0x6d, 0x4d, 0x40, 0xfa, 0xa7, 0x07, 0xa6, 0x6a,
+		0xdb, 0x87, 0x8e, 0x94, 0x6b, 0xa0, 0xb8, 0xb6, 0x8a, 0xd6, 0xee, 0xf6, 0x4c, 0xcb, 0x19, 0x43,
+		0xf1, 0x67, 0xe4, 0x4c, 0xb9, 0x78, 0x35, 0x86, 0xb6, 0x52, 0x05, 0x9e, 0xfd, 0x31, 0xa9, 0x48,
+		0x7e, 0x52, 0x10, 0xca, 0x79, 0x58, 0x42, 0x71, 0x34, 0xcd, 0x6e, 0x4f, 0xb7, 0x26, 0xd1, 0x7f,
+		0xff, 0x44, 0x2a, 0x0e, 0x81, 0x22, 0x14, 0x07, 0x5a, 0x74, 0xb8, 0xdb, 0x4f, 0x40, 0xe1, 0x67,
+		0xa5, 0xe2, 0x90, 0x38, 0x82, 0x84, 0x34, 0x18, 0x26, 0x20, 0xf1, 0x29, 0x49, 0x42, 0xe2, 0x20,
+		0x89, 0xf7, 0x79, 0x1b, 0xad, 0x45, 0xf7, 0xda, 0xb6, 0x63, 0x71, 0x93, 0xfc, 0x68, 0x52, 0x3f,
+		0xf7, 0xc5, 0xa0, 0x11, 0xa6, 0xfa, 0x50, 0x51, 0x13, 0x89, 0xa3, 0x0f, 0xe6, 0xb2, 0x8d, 0xef,
+		0xd8, 0xcf, 0x4b, 0x4d, 0xe4, 0x43, 0xc3, 0xbe, 0xf9, 0x2c, 0x44, 0x64, 0x7b, 0x13, 0x1d, 0x95,
+		0x09, 0xc8, 0xfd, 0xd3, 0x81, 0xce, 0xd5, 0x25, 0x2e, 0xd2, 0xf4, 0xd9, 0x3f, 0x7d, 0xe3, 0x16,
+		0x3d, 0x9c, 0x48, 0x3a, 0x7f, 0x61, 0xc0, 0xfe, 0xd9, 0xe6, 0x98, 0x5c, 0x87, 0xcc, 0x0e, 0xd8,
+		0x53, 0x64, 0x5c, 0xae, 0x5f, 0xfe, 0xeb, 0xbf, 0x24, 0xc6, 0x1b, 0x34, 0xa7, 0x56, 0xd6, 0x40,
+		0x11, 0x25, 0x9e, 0x01, 0x3b, 0x96, 0xd8, 0x87, 0xbe, 0xe4, 0xca, 0x79, 0xc0, 0xe6, 0x59, 0xb9,
+		0x0a, 0xb9, 0x80, 0xc1, 0x33, 0x9e, 0xd4, 0x37, 0x0a, 0x52, 0x59, 0xbf, 0xbd, 0xb3, 0x72, 0x09,
+		0xe2, 0x68, 0xbc, 0x8c, 0x47, 0xff, 0x26, 0x81, 0xce, 0xc0, 0x57, 0x5e, 0x84, 0x94, 0x34, 0x5a,
+		0xc6, 0xa3, 0xfe, 0x4d, 0x81, 0xea, 0xa2, 0x20, 0xba, 0x34, 0x58, 0xc6, 0xa3, 0xff, 0x2d, 0x89,
+		0x2e, 0x51, 0x10, 0x7d, 0x72, 0x16, 0xfe, 0xca, 0xdf, 0x8e, 0x73, 0x74, 0x89, 0xb2, 0x82, 0xd9,
+		0x27, 0xdc, 0x52, 0x19, 0x8f, 0xfd, 0x2d, 0xa2, 0x71, 0x89, 0xb1, 0xf2, 0x3c, 0x24, 0x26, 0x64,
+		0xf8, 0xdf, 0x11, 0xa8, 0x1c, 0x7e, 0x65, 0x15, 0x32, 0x3e, 0xeb, 0x64, 0x3c, 0xfa, 0xb7, 0x09,
+		0x74, 0x3f, 0x16, 0x76, 0x5d, 0x58, 0x27, 0xe3, 0x09, 0x7c, 0xbb, 0xec, 0xba, 0xc0, 0x40, 0xb6,
+		0x49, 0xc3, 0x64, 0x3c, 0xf6, 0x87, 0x25, 0xd7, 0x25, 0xca, 0xca, 0x4b, 0x90, 0x76, 0x37, 0x9b,
+		0xf1, 0xf8, 0xdf, 0x21, 0xf0, 0x3d, 0x1c, 0xe4, 0x40, 0xdf, 0x38, 0x06, 0x89, 0xef, 0x94, 0x1c,
+		0xf0, 0x61, 0xe1, 0x32, 0x1a, 0x34, 0x60, 0xc6, 0x53, 0xfa, 0x88, 0x5c, 0x46, 0x03, 0xf6, 0x0b,
+		0xce, 0x26, 0xd3, 0xf9, 0xe3, 0x49, 0x7c, 0x97, 0x9c, 0x4d, 0x06, 0x8f, 0xdd, 0x18, 0xb4, 0x08,
+		0xc6, 0xd3, 0xf8, 0x1e, 0xd9, 0x8d, 0x01, 0x83, 0x60, 0x65, 0x0b, 0xc8, 0xb0, 0x35, 0x30, 0x9e,
+		0xde, 0x47, 0x05, 0xbd, 0xb9, 0x21, 0x63, 0x60, 0xe5, 0x15, 0x38, 0x19, 0x6e, 0x09, 0x8c, 0xa7,
+		0xfa, 0xbd, 0x5f, 0x1a, 0xf0, 0xdd, 0xfc, 0x86, 0xc0, 0x4a, 0x03, 0x16, 0xc2, 0xac, 0x80, 0xf1,
+		0x64, 0xbf, 0xef, 0x4b, 0x41, 0xc5, 0xed, 0x37, 0x02, 0x56, 0x4a, 0x00, 0xde, 0x06, 0x3c, 0x9e,
+		0xd6, 0x0f, 0x08, 0x5a, 0x3e, 0x24, 0x5c, 0x1a, 0x62, 0xff, 0x1d, 0x8f, 0x7f, 0x57, 0x2e, 0x0d,
+		0x81, 0x81, 0x4b, 0x43, 0x6e, 0xbd, 0xe3, 0xb1, 0x7f, 0x50, 0x2e, 0x0d, 0x89, 0x82, 0x92, 0xed,
+		0xdb, 0xdd, 0xc6, 0x53, 0xf8, 0xb8, 0x94, 0x6c, 0x1f, 0xd6, 0xca, 0x06, 0xcc, 0x0d, 0x6d, 0x88,
+		0xe3, 0x49, 0xfd, 0x90, 0x20, 0xa5, 0x0c, 0xee, 0x87, 0xfe, 0xcd, 0x4b, 0x6c, 0x86, 0xe3, 0xa9,
+		0xfd, 0xf0, 0xc0, 0xe6, 0x25, 0xf6, 0xc2, 0x95, 0x2b, 0x90, 0x32, 0xfa, 0x9d, 0x0e, 0x2e, 0x1e,
+		0x72, 0x74, 0x7e, 0x6e, 0xfe, 0xbf, 0x7e, 0x59, 0x70, 0x47, 0x22, 0xac, 0x5c, 0x82, 0x04, 0xed,
+		0xee, 0xd0, 0xd6, 0x38, 0xcc, 0x2f, 0x7c, 0x59, 0x2a, 0x4c, 0x84, 0x5e, 0x79, 0x09, 0x80, 0x87,
+		0x46, 0xd8, 0xc1, 0xf9, 0x18, 0xdc, 0xdf, 0xfb, 0xb2, 0x48, 0x88, 0xf3, 0x50, 0x3c, 0x02, 0x3c,
+		0xbd, 0xee, 0x68, 0x02, 0x5f, 0x0c, 0x12, 0x60, 0x33, 0xf2, 0x02, 0x4c, 0xe3, 0x41, 0x9a, 0xa3,
+		0xef, 0x8d, 0xc3, 0xfe, 0x7d, 0x81, 0x2d, 0xe1, 0x91, 0x61, 0x5d, 0xd3, 0xa2, 0x8e, 0xbe, 0x67,
+		0x8f, 0xc3, 0xfd, 0x6f, 0x02, 0xd7, 0x45, 0x40, 0xe4, 0xa6, 0x6e, 0x3b, 0x93, 0x8c, 0xfb, 0x0f,
+		0x24, 0xb2, 0x44, 0xc0, 0x4e, 0xe3, 0xdf, 0xb7, 0xe8, 0xe1, 0x38, 0xdc, 0x3f, 0x94, 0x9d, 0x16,
+		0xf0, 0x2b, 0x2f, 0x42, 0x1a, 0xff, 0xe4, 0x59, 0xae, 0x63, 0x90, 0xff, 0x48, 0x20, 0x7b, 0x18,
+		0xd8, 0xb2, 0xed, 0xb4, 0x9c, 0xf6, 0x78, 0x66, 0xff, 0xb1, 0x98, 0x69, 0x09, 0xbf, 0x52, 0x82,
+		0x8c, 0xed, 0xb4, 0x5a, 0x7d, 0x61, 0x9f, 0x8e, 0x41, 0xff, 0x93, 0x2f, 0xbb, 0x21, 0x0b, 0x17,
+		0x07, 0x67, 0xfb, 0xcd, 0x5b, 0x4e, 0xcf, 0x64, 0xe7, 0x2d, 0xe3, 0x28, 0x7c, 0x49, 0x50, 0xf0,
+		0xa1, 0xac, 0xac, 0x42, 0x16, 0xc7, 0x62, 0xd1, 0x1e, 0x65, 0x87, 0x63, 0x63, 0x48, 0xfc, 0xa9,
+		0x60, 0x40, 0x00, 0xa9, 0xfc, 0x75, 0x9f, 0xfe, 0xec, 0x62, 0xe4, 0x33, 0x9f, 0x5d, 0x8c, 0xfc,
+		0xd6, 0x67, 0x17, 0x23, 0x1f, 0xfe, 0xdc, 0xe2, 0xd4, 0x67, 0x3e, 0xb7, 0x38, 0xf5, 0x9b, 0x9f,
+		0x5b, 0x9c, 0x0a, 0x8f, 0x12, 0xc3, 0x35, 0xf3, 0x9a, 0xc9, 0xe3, 0xc3, 0xaf, 0x3f, 0xb2, 0xd7,
+		0x76, 0xf6, 0xfb, 0x3b, 0xcb, 0x4d, 0xb3, 0x7b, 0xa1, 0x69, 0xda, 0x5d, 0xd3, 0xbe, 0x10, 0x8c,
+		0xeb, 0xb2, 0xbf, 0xe0, 0x7f, 0x47, 0xe0, 0x14, 0x27, 0xe3, 0x85, 0x73, 0x75, 0xe3, 0x70, 0xd4,
+		0x65, 0xba, 0xcb, 0x10, 0x2b, 0x19, 0x87, 0xe4, 0x14, 0x57, 0x70, 0x5a, 0xdf, 0xea, 0x88, 0x54,
+		0xcb, 0x69, 0xfc, 0xde, 0xb6, 0x3a, 0x18, 0x66, 0x97, 0xf9, 0xd0, 0x78, 0x9c, 0xc3, 0x3f, 0xca,
+		0xdf, 0x16, 0x39, 0xde, 0x48, 0x52, 0x25, 0xe3, 0x90, 0x0d, 0x64, 0x2b, 0xf2, 0xfa, 0x93, 0x63,
+		0xe3, 0xdc, 0xb7, 0x0c, 0xf3, 0x4d, 0x03, 0xbb, 0xdd, 0xdb, 0x91, 0x31, 0xee, 0xc5, 0xc1, 0x18,
+		0xf7, 0x2b, 0xb4, 0xd3, 0x79, 0x19, 0xe1, 0x30, 0x7d, 0xc1, 0xde, 0x49, 0xf2, 0xac, 0x7e, 0xf8,
+		0x48, 0x14, 0x16, 0x87, 0xc2, 0xd9, 0x42, 0x08, 0x46, 0x31, 0x61, 0x05, 0x52, 0x15, 0x29, 0x5b,
+		0x79, 0xbc, 0xca, 0xd6, 0x34, 0x8d, 0x96, 0xcd, 0x18, 0x11, 0x53, 0xe5, 0x27, 0x32, 0xc2, 0xd0,
+		0x0d, 0xd3, 0x16, 0xc9, 0xca, 0xfc, 0xa3, 0xfc, 0xfd, 0xc7, 0x64, 0x44, 0x4e, 0xb6, 0x24, 0xb9,
+		0xf1, 0xcc, 0x84, 0xdc, 0x90, 0x83, 0x08, 0x44, 0xfe, 0x27, 0xe5, 0xca, 0xf7, 0x44, 0xe1, 0xec,
+		0x20, 0x57, 0x70, 0x65, 0xd9, 0x8e, 0xde, 0xed, 0x8d, 0x62, 0xcb, 0x15, 0x48, 0x37, 0x24, 0xcc,
+		0xb1, 0xf9, 0x72, 0xf7, 0x98, 0x7c, 0x99, 0x71, 0x9b, 0x92, 0x8c, 0xb9, 0x38, 0x21, 0x63, 0xdc,
+		0x71, 0xdc, 0x13, 0x67, 0xfe, 0x2c, 0x09, 0xa7, 0xf8, 0x72, 0xd2, 0xf8, 0x52, 0xe2, 0x1f, 0x82,
+		0x27, 0x59, 0x7f, 0xd5, 0xf8, 0x73, 0x92, 0xe2, 0xcb, 0x30, 0x5f, 0x43, 0x6d, 0x81, 0x5e, 0x90,
+		0x77, 0xc2, 0x13, 0x9a, 0xcf, 0xbd, 0x14, 0x30, 0xf8, 0xc5, 0xf9, 0x96, 0xbf, 0xa8, 0xf8, 0xf5,
+		0x11, 0x50, 0xea, 0x4d, 0xbd, 0xa3, 0x5b, 0x7f, 0x51, 0x52, 0xe4, 0x79, 0x00, 0x9e, 0xee, 0xe1,
+		0x5e, 0xdc, 0x9b, 0xb9, 0x98, 0x5f, 0xf6, 0x0f, 0x6e, 0x99, 0xb7, 0xc4, 0x32, 0xa8, 0xd2, 0x0c,
+		0x16, 0xff, 0x3c, 0xff, 0x2a, 0x80, 0x57, 0x41, 0x4e, 0xc3, 0x03, 0xf5, 0xd5, 0xd2, 0x5a, 0x49,
+		0x95, 0x49, 0x42, 0xf5, 0xad, 0xea, 0x6a, 0xed, 0x6a, 0xad, 0x5a, 0x51, 0xa6, 0x30, 0xbf, 0xc6,
+		0x5f, 0xe9, 0x26, 0x35, 0x9d, 0x80, 0x39, 0x7f, 0x39, 0xbf, 0xa5, 0x12, 0x45, 0x4b, 0xb1, 0xdd,
+		0xed, 0x75, 0x28, 0x3b, 0x79, 0xd4, 0xda, 0x92, 0x6b, 0xe3, 0x8d, 0x90, 0x5f, 0xfb, 0xf7, 0xfc,
+		0xe6, 0xc2, 0xbc, 0x87, 0xee, 0xf2, 0x7c, 0x65, 0x0d, 0xe6, 0x30, 0x97, 0xb2, 0x17, 0x20, 0x39,
+		0x46, 0x55, 0x23, 0x41, 0x76, 0x96, 0x2a, 0x30, 0x3d, 0x6a, 0xcf, 0x43, 0xd2, 0x66, 0xa3, 0x1f,
+		0x47, 0xe2, 0xd7, 0x05, 0x09, 0x01, 0xbe, 0x62, 0xc0, 0x1c, 0x5a, 0x7e, 0x18, 0x20, 0xf2, 0xba,
+		0x71, 0x74, 0x9c, 0xe1, 0x17, 0x7f, 0xe6, 0x69, 0x76, 0xb2, 0xfa, 0x50, 0x70, 0x5a, 0x42, 0xc4,
+		0x49, 0x55, 0x04, 0x6d, 0xaf, 0xa3, 0x14, 0x66, 0x64, 0x7b, 0xa2, 0xc3, 0x47, 0x37, 0xf6, 0x4b,
+		0xa2, 0xb1, 0xc5, 0x30, 0x19, 0xf0, 0xb5, 0x94, 0x13, 0x54, 0x79, 0x45, 0xb9, 0x3a, 0x6a, 0x4d,
+		0xbf, 0xfe, 0xc4, 0xf0, 0xee, 0xc4, 0xff, 0x7b, 0x8a, 0x51, 0xbe, 0xe2, 0x6f, 0xc6, 0x5d, 0x7b,
+		0xdf, 0x1f, 0x87, 0x39, 0x4c, 0xff, 0x37, 0x2f, 0xb0, 0x7f, 0xc5, 0x9a, 0x4b, 0xb0, 0x8f, 0x09,
+		0x0e, 0x25, 0x2f, 0xf3, 0xa5, 0x30, 0x5e, 0x62, 0xfe, 0xe8, 0x5b, 0x7f, 0x34, 0xe1, 0x2d, 0x97,
+		0x95, 0x75, 0x50, 0x64, 0x12, 0x2f, 0x35, 0x9a, 0x66, 0x6b, 0xa2, 0x28, 0xc5, 0x1f, 0x4b, 0x1a,
+		0x32, 0xbe, 0x55, 0x15, 0xa8, 0x2b, 0xef, 0xc1, 0x93, 0x3e, 0x41, 0x66, 0x9c, 0x65, 0x22, 0x89,
+		0xb8, 0x18, 0x68, 0x97, 0xf0, 0x95, 0x39, 0x89, 0x15, 0xfa, 0x25, 0x89, 0xcf, 0x57, 0x28, 0x66,
+		0x48, 0xac, 0x5c, 0x83, 0x99, 0x96, 0x69, 0x38, 0x9a, 0xd9, 0x6d, 0x3b, 0xb4, 0xdb, 0x73, 0xc6,
+		0xda, 0x75, 0x7f, 0xca, 0x89, 0xa4, 0xd4, 0x1c, 0xe2, 0x6d, 0x4a, 0x34, 0xec, 0x09, 0xbf, 0xa7,
+		0x37, 0x49, 0x4f, 0xfe, 0xbb, 0xdb, 0x13, 0x86, 0x83, 0x3d, 0xb9, 0x27, 0xe9, 0xb0, 0x5b, 0xb7,
+		0x84, 0x32, 0x77, 0x0e, 0xb8, 0x14, 0xb8, 0xd2, 0xf1, 0x07, 0x31, 0x58, 0x14, 0xc0, 0x3b, 0xba,
+		0x4d, 0x2f, 0xdc, 0x7e, 0x66, 0x87, 0x3a, 0xfa, 0x33, 0x17, 0x9a, 0x66, 0x5b, 0xee, 0xe4, 0xf3,
+		0xbc, 0x7e, 0x19, 0xeb, 0x97, 0x45, 0x7d, 0x21, 0xf4, 0xb8, 0xbb, 0x30, 0x5a, 0xc9, 0x17, 0x86,
+		0x65, 0xb0, 0xf8, 0x3a, 0xc4, 0x57, 0xcd, 0xb6, 0x81, 0x7b, 0x5b, 0x8b, 0x1a, 0x66, 0x57, 0xa8,
+		0x5b, 0xfe, 0x41, 0x2e, 0x43, 0x52, 0xef, 0x9a, 0x7d, 0xc3, 0xe1, 0xaa, 0xb6, 0xbc, 0xf8, 0xe9,
+		0xb7, 0xce, 0x4e, 0xfd, 0xc7, 0xb7, 0xce, 0xc6, 0x6a, 0x86, 0xf3, 0x1b, 0x9f, 0x7c, 0x0a, 0x04,
+		0xf5, 0x9a, 0xe1, 0x7c, 0xe2, 0x77, 0x7f, 0xf2, 0x7c, 0x44, 0x15, 0xd0, 0x2b, 0xf1, 0xcf, 0x7f,
+		0xec, 0x6c, 0xa4, 0xf8, 0x2a, 0x4c, 0x57, 0x68, 0xf3, 0x08, 0xf2, 0xcf, 0x0c, 0x90, 0x3f, 0x25,
+		0xc9, 0x57, 0x68, 0xd3, 0x47, 0xbe, 0x42, 0x9b, 0x03, 0x94, 0x9f, 0x87, 0x54, 0xcd, 0x70, 0xf8,
+		0x15, 0xa2, 0x27, 0x20, 0xd6, 0x36, 0x78, 0x56, 0xba, 0x8f, 0xc2, 0x50, 0x07, 0x55, 0x84, 0x42,
+		0xc4, 0x0a, 0x6d, 0xba, 0x88, 0x2d, 0xda, 0xcc, 0x47, 0xc6, 0x35, 0x8d, 0x50, 0xe5, 0xca, 0x6f,
+		0xfe, 0xf6, 0xe2, 0xd4, 0x07, 0x3f, 0xbb, 0x38, 0x35, 0x72, 0xea, 0x8b, 0xe3, 0xa7, 0xde, 0x9d,
+		0xf1, 0x1f, 0x8d, 0xc3, 0x19, 0x76, 0xb3, 0xd4, 0xea, 0xb6, 0x0d, 0xe7, 0x42, 0xd3, 0x3a, 0xec,
+		0x39, 0x26, 0x2a, 0x00, 0x73, 0x57, 0x4c, 0xf8, 0x9c, 0x57, 0xbd, 0xcc, 0xab, 0xc3, 0xa7, 0xbb,
+		0xb8, 0x0b, 0x89, 0x2d, 0xc4, 0x43, 0x16, 0x3b, 0xa6, 0xa3, 0x77, 0x84, 0xd5, 0xc2, 0x3f, 0xb0,
+		0x94, 0xdf, 0x46, 0x8d, 0xf2, 0xd2, 0xb6, 0xbc, 0x88, 0xda, 0xa1, 0xfa, 0x2e, 0xbf, 0xd4, 0x13,
+		0x63, 0xe6, 0x6e, 0x0a, 0x0b, 0xd8, 0xfd, 0x9d, 0x05, 0x48, 0xe8, 0x7d, 0x9e, 0x78, 0x13, 0x43,
+		0x3b, 0x98, 0x7d, 0x14, 0x5f, 0x86, 0x69, 0x71, 0xfe, 0x8e, 0x99, 0x27, 0xb7, 0xe8, 0x21, 0x6b,
+		0x27, 0xab, 0xe2, 0x9f, 0x64, 0x19, 0x12, 0xac, 0xf3, 0xe2, 0xb6, 0x62, 0x7e, 0x79, 0xa8, 0xf7,
+		0xcb, 0xac, 0x93, 0x2a, 0x07, 0x2b, 0xde, 0x80, 0x54, 0xc5, 0x44, 0x29, 0x0c, 0x52, 0x4b, 0x73,
+		0x6a, 0xac, 0xcf, 0xbd, 0xbe, 0x90, 0x0a, 0x95, 0x7f, 0x60, 0x4a, 0x3a, 0xbf, 0xe4, 0x25, 0x92,
+		0x87, 0xc4, 0x57, 0x71, 0x15, 0xa6, 0x19, 0xed, 0xcd, 0x1e, 0x9a, 0x0c, 0x6e, 0xde, 0x7b, 0x5a,
+		0x5c, 0xf9, 0x15, 0xe4, 0xa3, 0x5e, 0x67, 0x09, 0xc4, 0xf1, 0x22, 0x87, 0x18, 0x37, 0xfb, 0xbb,
+		0xf8, 0x5e, 0x48, 0x09, 0x22, 0x36, 0xb9, 0x08, 0x31, 0xb3, 0x67, 0x8b, 0xf4, 0x9f, 0xc2, 0xa8,
+		0xa1, 0x6c, 0xf6, 0xca, 0x71, 0x94, 0x19, 0x15, 0x81, 0xcb, 0x1b, 0x23, 0xc5, 0xe2, 0xb9, 0x80,
+		0x58, 0x74, 0xa9, 0xb3, 0xb3, 0xeb, 0x78, 0x7f, 0xf0, 0xe9, 0x1c, 0x12, 0x05, 0x57, 0x50, 0xee,
+		0x46, 0x61, 0xd1, 0x57, 0x7b, 0x9b, 0x5a, 0x18, 0x80, 0xe2, 0xd2, 0x24, 0x24, 0x85, 0xf8, 0x3a,
+		0x28, 0xea, 0x47, 0x88, 0xca, 0x8b, 0x10, 0x2b, 0xf5, 0x7a, 0x78, 0xcf, 0x99, 0x7d, 0x37, 0x4d,
+		0x2e, 0x2b, 0x71, 0xd5, 0xfd, 0xc6, 0x3a, 0xdb, 0xdc, 0x75, 0xde, 0xd4, 0x2d, 0xf7, 0x0e, 0xb4,
+		0xfc, 0x2e, 0xbe, 0x00, 0xe9, 0x55, 0xd3, 0xb0, 0xa9, 0x61, 0xf7, 0x99, 0x2d, 0xbc, 0xd3, 0x31,
+		0x9b, 0xb7, 0x04, 0x05, 0xfe, 0x81, 0xcc, 0xd6, 0x7b, 0x3d, 0x86, 0x19, 0x57, 0xf1, 0x4f, 0xbe,
+		0x5e, 0xcb, 0x9b, 0x23, 0xd9, 0x73, 0xe9, 0x78, 0xec, 0x11, 0x03, 0xf4, 0x8c, 0xda, 0x08, 0x3c,
+		0x38, 0xbc, 0x90, 0x6e, 0xd1, 0x43, 0xfb, 0xb8, 0xeb, 0xe8, 0x55, 0x48, 0x6f, 0xb1, 0xe7, 0x49,
+		0x5e, 0xa6, 0x87, 0xa4, 0x80, 0x6f, 0x58, 0x5c, 0xbc, 0x74, 0xe9, 0x99, 0x17, 0xb8, 0x94, 0x5f,
+		0x9f, 0x52, 0x65, 0x01, 0x59, 0x84, 0xb4, 0x4d, 0x9b, 0xbd, 0x8b, 0x97, 0x2e, 0xdf, 0x7a, 0x86,
+		0x8b, 0xd5, 0xf5, 0x29, 0xd5, 0x2b, 0x5a, 0x49, 0xe1, 0x88, 0x3f, 0xff, 0xf1, 0xb3, 0x91, 0x72,
+		0x02, 0x62, 0x76, 0xbf, 0xfb, 0xb6, 0xc9, 0xc6, 0x1f, 0x26, 0x61, 0xc9, 0x57, 0xcb, 0x37, 0x97,
+		0xdb, 0x7a, 0xa7, 0xdd, 0xd2, 0xbd, 0x47, 0x65, 0x14, 0xdf, 0xf8, 0x19, 0xc4, 0x88, 0x5d, 0xe3,
+		0x48, 0x2e, 0x16, 0x7f, 0x3a, 0x02, 0xd9, 0x9b, 0x92, 0x32, 0xbe, 0x42, 0x73, 0x05, 0xc0, 0x6d,
+		0x49, 0x2e, 0x95, 0xd3, 0xcb, 0x83, 0x6d, 0x2d, 0xbb, 0x38, 0xaa, 0x0f, 0x9c, 0x3c, 0xcf, 0x04,
+		0xb0, 0x67, 0xda, 0xe2, 0x3e, 0xec, 0x18, 0x54, 0x17, 0x18, 0x13, 0x39, 0x99, 0x56, 0xd3, 0x6e,
+		0x9b, 0x0e, 0xa6, 0x96, 0xf4, 0xcc, 0x37, 0xc5, 0x2b, 0x03, 0x31, 0x55, 0x61, 0x35, 0x37, 0x59,
+		0xc5, 0x16, 0x96, 0x63, 0xa7, 0xd3, 0x2e, 0x15, 0x74, 0xeb, 0xf4, 0x56, 0xcb, 0xa2, 0xb6, 0x2d,
+		0x14, 0x97, 0xfc, 0xc4, 0x4b, 0xb8, 0xbd, 0xfe, 0x8e, 0x26, 0xb5, 0x04, 0x5e, 0x63, 0x0e, 0x59,
+		0xf3, 0x52, 0x36, 0xc4, 0xaa, 0x4f, 0xf6, 0xfa, 0x3b, 0x28, 0x29, 0x0f, 0x41, 0x36, 0xa4, 0x33,
+		0x99, 0xdb, 0x5e, 0x3f, 0xd8, 0x8b, 0x38, 0x62, 0x04, 0x5a, 0xcf, 0x6a, 0x9b, 0x56, 0xdb, 0x39,
+		0x64, 0x69, 0x7b, 0x31, 0x55, 0x91, 0x15, 0x5b, 0xa2, 0xbc, 0x78, 0x0b, 0x66, 0xeb, 0xcc, 0xdc,
+		0xf7, 0x7a, 0x7e, 0xc9, 0xeb, 0x5f, 0x64, 0x7c, 0xff, 0x46, 0xf6, 0x2c, 0x3a, 0xd4, 0xb3, 0xf3,
+		0xff, 0x21, 0x02, 0x99, 0x32, 0x2e, 0xdc, 0x5a, 0xe5, 0x6a, 0x47, 0xdf, 0x23, 0xcf, 0xc0, 0x89,
+		0xf2, 0xda, 0xe6, 0xea, 0xcb, 0x5a, 0xad, 0xa2, 0x5d, 0x5d, 0x2b, 0x5d, 0xf3, 0xf2, 0x84, 0x0b,
+		0x27, 0xef, 0xdc, 0x5d, 0x22, 0x3e, 0xd8, 0x6d, 0x83, 0xf9, 0xa4, 0xe4, 0x02, 0x2c, 0x04, 0x51,
+		0x4a, 0xe5, 0x3a, 0x26, 0x0d, 0x47, 0x0a, 0x27, 0xee, 0xdc, 0x5d, 0x9a, 0xf3, 0x61, 0x94, 0x76,
+		0x6c, 0x6a, 0x38, 0xc3, 0x08, 0xab, 0x9b, 0xeb, 0xeb, 0xb5, 0x86, 0x12, 0x1d, 0x42, 0xc0, 0x64,
+		0xcd, 0xb6, 0x43, 0x1e, 0x87, 0xb9, 0x20, 0xc2, 0x46, 0x6d, 0x4d, 0x89, 0x15, 0xc8, 0x9d, 0xbb,
+		0x4b, 0x33, 0x3e, 0xe8, 0x8d, 0x76, 0xa7, 0x90, 0xfa, 0xe6, 0x1f, 0x5e, 0x9c, 0xfa, 0xc4, 0xdf,
+		0x5b, 0x8c, 0x94, 0xd7, 0x47, 0xae, 0xb9, 0x67, 0x8f, 0xb7, 0xe6, 0x82, 0xfb, 0xf6, 0x97, 0x1f,
+		0x84, 0x07, 0x07, 0x2b, 0x03, 0xca, 0x78, 0xd2, 0xe5, 0x36, 0x2e, 0x46, 0x51, 0x38, 0xda, 0x3c,
+		0x28, 0x8c, 0xd9, 0x14, 0x0a, 0x63, 0x15, 0x43, 0xf1, 0x05, 0xc8, 0x61, 0x5a, 0x71, 0x9d, 0x3a,
+		0xd7, 0xa9, 0xde, 0xa2, 0x56, 0xd0, 0x7e, 0xc8, 0x49, 0xfb, 0x81, 0x40, 0x9c, 0x19, 0x09, 0x7c,
+		0xff, 0x64, 0x7f, 0x17, 0xf7, 0x21, 0x8e, 0xa8, 0x9e, 0x6d, 0x21, 0x30, 0xd8, 0x07, 0x96, 0xee,
+		0x1c, 0x3a, 0xd4, 0x16, 0x28, 0xfc, 0x83, 0x3c, 0x27, 0x2d, 0x84, 0xd8, 0xd1, 0x16, 0x82, 0x58,
+		0x5e, 0xc2, 0x4e, 0xe8, 0xc0, 0xb4, 0x98, 0x62, 0xb7, 0x23, 0x11, 0xaf, 0x23, 0x64, 0x1d, 0x66,
+		0x7b, 0xba, 0xe5, 0xb0, 0x1b, 0x8a, 0xfb, 0x6c, 0x14, 0x62, 0x05, 0x9f, 0x1d, 0xd6, 0x27, 0x81,
+		0xc1, 0x8a, 0x56, 0x72, 0x3d, 0x7f, 0x61, 0xf1, 0xbf, 0xc4, 0x21, 0x29, 0x98, 0xf1, 0x22, 0x4c,
+		0x0b, 0xb6, 0x8a, 0x35, 0x77, 0x66, 0x79, 0x78, 0x9b, 0x5d, 0x76, 0xb7, 0x43, 0x41, 0x4f, 0xe2,
+		0x90, 0x47, 0x21, 0xd5, 0xdc, 0xd7, 0xdb, 0x86, 0xd6, 0x6e, 0x09, 0xd3, 0x36, 0xf3, 0xd9, 0xb7,
+		0xce, 0x4e, 0xaf, 0x62, 0x59, 0xad, 0xa2, 0x4e, 0xb3, 0xca, 0x5a, 0x0b, 0x6d, 0x9a, 0x7d, 0xda,
+		0xde, 0xdb, 0x77, 0x84, 0xde, 0x10, 0x5f, 0xf8, 0xc8, 0x17, 0x0a, 0x84, 0xb8, 0xbf, 0x5e, 0x18,
+		0xf2, 0x50, 0xdc, 0x10, 0x52, 0x39, 0x85, 0x0d, 0x7f, 0xf8, 0x3f, 0x9f, 0x8d, 0xa8, 0x0c, 0x83,
+		0xac, 0x42, 0xae, 0xa3, 0xdb, 0x8e, 0xc6, 0xf6, 0x63, 0x6c, 0x3e, 0xc1, 0x48, 0x9c, 0x1a, 0x66,
+		0x88, 0x60, 0xac, 0xe8, 0x7a, 0x06, 0xb1, 0x78, 0x51, 0x0b, 0xaf, 0xd7, 0x32, 0x22, 0x4d, 0xb6,
+		0x02, 0xb9, 0x95, 0x98, 0x64, 0x7c, 0x9f, 0xc1, 0x72, 0xbe, 0x30, 0x99, 0xad, 0x78, 0x1a, 0xd2,
+		0xec, 0xc6, 0x2c, 0x03, 0xe1, 0x69, 0xf0, 0x29, 0x2c, 0x60, 0x95, 0x8f, 0xc1, 0xac, 0xa7, 0xf5,
+		0x39, 0x48, 0x8a, 0x53, 0xf1, 0x8a, 0x19, 0xe0, 0xd3, 0xb0, 0x60, 0xd0, 0x03, 0x47, 0xf3, 0x8a,
+		0x39, 0x74, 0x9a, 0x41, 0x13, 0xac, 0xbb, 0x19, 0xc4, 0x78, 0x04, 0x66, 0x9a, 0x92, 0xf9, 0x1c,
+		0x16, 0x18, 0x6c, 0xce, 0x2d, 0x65, 0x60, 0xa7, 0x20, 0xa5, 0xf7, 0x7a, 0x1c, 0x20, 0x23, 0xb4,
+		0x7e, 0xaf, 0xc7, 0xaa, 0xce, 0xc3, 0x1c, 0x1b, 0xa3, 0x45, 0xed, 0x7e, 0xc7, 0x11, 0x44, 0xb2,
+		0x0c, 0x66, 0x16, 0x2b, 0x54, 0x5e, 0xce, 0x60, 0x1f, 0x86, 0x1c, 0xbd, 0x8d, 0x77, 0x10, 0x9a,
+		0x94, 0xc3, 0xe5, 0x18, 0x5c, 0x56, 0x16, 0x32, 0xa0, 0xc7, 0xc1, 0xd5, 0xe6, 0x9a, 0xdc, 0x69,
+		0x66, 0x38, 0x3d, 0x59, 0x5e, 0xe2, 0xc5, 0xc5, 0x3c, 0xc4, 0x2b, 0xba, 0xa3, 0xa3, 0xb9, 0xe4,
+		0x1c, 0xf0, 0xed, 0x33, 0xab, 0xe2, 0x9f, 0xc5, 0x4f, 0xc5, 0x20, 0x7e, 0xd3, 0x74, 0x28, 0x79,
+		0xd6, 0x67, 0xca, 0xce, 0x84, 0xc9, 0x73, 0xbd, 0xbd, 0x67, 0xd0, 0xd6, 0xba, 0xbd, 0xe7, 0x7b,
+		0xde, 0xc6, 0x13, 0xa7, 0x68, 0x40, 0x9c, 0x16, 0x20, 0x61, 0x99, 0x7d, 0xa3, 0x25, 0x13, 0xc8,
+		0xd9, 0x07, 0xa9, 0x42, 0xca, 0x95, 0x92, 0xf8, 0x38, 0x29, 0x99, 0x45, 0x29, 0x41, 0x19, 0x16,
+		0x05, 0xea, 0xf4, 0x8e, 0x10, 0x96, 0x32, 0xa4, 0x5d, 0xe5, 0x95, 0x4f, 0x1c, 0x43, 0x60, 0x3d,
+		0x34, 0xdc, 0x22, 0xdd, 0xb9, 0x77, 0x99, 0xc7, 0x25, 0x4e, 0x71, 0x2b, 0x04, 0xf7, 0x02, 0x62,
+		0x25, 0x9e, 0xda, 0x99, 0x66, 0xe3, 0xf2, 0xc4, 0x8a, 0x3f, 0xb7, 0xf3, 0x20, 0x66, 0xe4, 0xed,
+		0x19, 0xba, 0xd3, 0xb7, 0xa8, 0x90, 0x3c, 0xaf, 0x00, 0x6b, 0xbd, 0xcb, 0x14, 0x5c, 0xd2, 0xbc,
+		0x02, 0x72, 0x01, 0xe6, 0xdd, 0x0f, 0xcd, 0xa3, 0xc2, 0xa5, 0x8c, 0xb8, 0x55, 0x75, 0x59, 0x53,
+		0xfc, 0x95, 0x08, 0x24, 0xc5, 0x8e, 0xe5, 0x4d, 0x43, 0x24, 0x7c, 0x1a, 0xa2, 0xa3, 0xa6, 0x21,
+		0x76, 0xef, 0xd3, 0x50, 0x02, 0x70, 0xbb, 0x69, 0x8b, 0x07, 0x55, 0x42, 0xcc, 0x2a, 0xde, 0xc5,
+		0x7a, 0x7b, 0x4f, 0xac, 0x7b, 0x1f, 0x52, 0xf1, 0x3f, 0x45, 0x20, 0xed, 0xd6, 0x93, 0x12, 0xe4,
+		0x64, 0xbf, 0xb4, 0xdd, 0x8e, 0xbe, 0x27, 0x44, 0xf1, 0xcc, 0xc8, 0xce, 0xe1, 0x2e, 0xac, 0x66,
+		0x44, 0x7f, 0xf0, 0x23, 0x7c, 0x5a, 0xa3, 0x23, 0xa6, 0x35, 0x20, 0x47, 0xb1, 0x7b, 0x93, 0xa3,
+		0xc0, 0x8c, 0xc7, 0x07, 0x66, 0xbc, 0xf8, 0xdb, 0x11, 0xf1, 0xf2, 0x57, 0x8b, 0xb6, 0xfe, 0x32,
+		0xa7, 0xea, 0x75, 0x21, 0x5b, 0x2d, 0xda, 0xd2, 0x86, 0xe6, 0xec, 0xe1, 0x61, 0x8a, 0xc1, 0x3e,
+		0x7b, 0x73, 0x47, 0x24, 0x95, 0xba, 0x37, 0x87, 0x9f, 0x8c, 0xc2, 0xdc, 0x10, 0xfc, 0xff, 0x7b,
+		0x73, 0x19, 0x5c, 0xbd, 0x89, 0x09, 0x57, 0x6f, 0x72, 0xe4, 0xea, 0xfd, 0x99, 0x28, 0x0b, 0x00,
+		0xf4, 0x4c, 0x5b, 0xef, 0xbc, 0x13, 0xba, 0xf7, 0x34, 0xa4, 0x7b, 0x66, 0x47, 0xe3, 0x35, 0xfc,
+		0x0a, 0x4f, 0xaa, 0x67, 0x76, 0xd4, 0x21, 0x31, 0x4b, 0xdc, 0x27, 0xc5, 0x9c, 0xbc, 0x0f, 0x93,
+		0x30, 0x3d, 0xb8, 0xa0, 0x2c, 0xc8, 0x72, 0x56, 0x08, 0xab, 0xe9, 0x69, 0xe4, 0x01, 0xfe, 0x95,
+		0x8f, 0x0c, 0x5b, 0x79, 0xbc, 0xdb, 0x1c, 0x52, 0x4d, 0xee, 0xbb, 0x18, 0xdc, 0xc8, 0xc8, 0x47,
+		0x47, 0x61, 0x70, 0x29, 0x56, 0x05, 0x5c, 0xf1, 0xbb, 0x23, 0x00, 0x6b, 0xc8, 0x59, 0x36, 0x5e,
+		0xb4, 0x77, 0x6c, 0xd6, 0x05, 0x2d, 0xd0, 0xf2, 0xe2, 0xa8, 0x49, 0x13, 0xed, 0x67, 0x6d, 0x7f,
+		0xbf, 0x57, 0x21, 0xe7, 0xc9, 0xb6, 0x4d, 0x65, 0x67, 0x16, 0x8f, 0xf0, 0x4a, 0xeb, 0xd4, 0x51,
+		0xb3, 0xb7, 0x7d, 0x5f, 0xc5, 0x7f, 0x16, 0x81, 0x34, 0xeb, 0x13, 0xbe, 0x14, 0x12, 0x98, 0xc3,
+		0xc8, 0xbd, 0xcf, 0xe1, 0x19, 0x00, 0x4e, 0x06, 0x33, 0x61, 0x84, 0x64, 0xa5, 0x59, 0x09, 0xe6,
+		0xb7, 0x60, 0x7c, 0x56, 0x0c, 0x3b, 0x76, 0x34, 0xc3, 0xa5, 0xd7, 0x2a, 0xd8, 0xfe, 0x00, 0x4c,
+		0xb3, 0xb7, 0x29, 0x0f, 0x6c, 0xe1, 0x88, 0xe2, 0x83, 0x54, 0x8d, 0x03, 0xbb, 0xf8, 0x06, 0x4c,
+		0x37, 0x0e, 0x78, 0x3c, 0xf1, 0x34, 0xa4, 0x2d, 0xd3, 0x14, 0xd6, 0x1f, 0xb7, 0xba, 0x53, 0x58,
+		0xc0, 0x8c, 0x1d, 0x19, 0x43, 0x8b, 0x7a, 0x31, 0x34, 0x2f, 0x08, 0x18, 0x9b, 0x28, 0x08, 0x88,
+		0xde, 0x67, 0x2e, 0xb0, 0x92, 0xc8, 0x93, 0xf0, 0x40, 0xbd, 0x76, 0x6d, 0xa3, 0x5a, 0xd1, 0xd6,
+		0xeb, 0xd7, 0x06, 0xae, 0xe7, 0x17, 0x66, 0xef, 0xdc, 0x5d, 0xca, 0x08, 0xb7, 0x73, 0x14, 0xf4,
+		0x96, 0x5a, 0xbd, 0xb9, 0xd9, 0xa8, 0x2a, 0x11, 0x0e, 0xbd, 0x65, 0xd1, 0xdb, 0xa6, 0xc3, 0x1f,
+		0x7f, 0x7d, 0x1a, 0x4e, 0x85, 0x40, 0xbb, 0xce, 0xe7, 0xdc, 0x9d, 0xbb, 0x4b, 0xb9, 0x2d, 0x8b,
+		0x72, 0x29, 0x63, 0x18, 0xcb, 0x90, 0x1f, 0xc6, 0xd8, 0xdc, 0xda, 0xac, 0x97, 0xd6, 0x94, 0xa5,
+		0x82, 0x72, 0xe7, 0xee, 0x52, 0x56, 0xaa, 0x0c, 0x84, 0x7f, 0xfb, 0xbd, 0xcf, 0x6f, 0x49, 0xc1,
+		0x99, 0xc1, 0xca, 0x0b, 0x3d, 0xdd, 0xd2, 0xbb, 0xc7, 0x75, 0x3f, 0xc7, 0x24, 0x0e, 0x14, 0x7f,
+		0x3a, 0x0a, 0xb3, 0xae, 0x73, 0xb3, 0xc5, 0x5a, 0x20, 0xcf, 0xfa, 0x23, 0x7e, 0x99, 0x91, 0x7b,
+		0x07, 0x87, 0x96, 0x01, 0x41, 0x3c, 0x32, 0x12, 0x46, 0xb2, 0x58, 0x54, 0x4b, 0x21, 0xfb, 0x9b,
+		0x80, 0x10, 0xa8, 0x2e, 0x06, 0x79, 0x09, 0xd2, 0xee, 0x12, 0x73, 0x1f, 0x48, 0x1b, 0xbd, 0x26,
+		0x05, 0xbe, 0x87, 0x83, 0x6f, 0x96, 0x49, 0x37, 0x2e, 0x3e, 0xca, 0x31, 0xbc, 0xc9, 0x01, 0x04,
+		0xb2, 0x84, 0x27, 0x4f, 0x43, 0x5c, 0xdf, 0x69, 0xb6, 0xf3, 0x89, 0xe1, 0x90, 0x0b, 0xc7, 0x2b,
+		0x95, 0x57, 0x6b, 0x02, 0x89, 0x41, 0x16, 0x6b, 0x22, 0x98, 0x22, 0xf8, 0xc5, 0x9e, 0xa3, 0x39,
+		0xd0, 0xb8, 0x2f, 0xcc, 0xad, 0x8b, 0x54, 0x57, 0x3f, 0x28, 0xe3, 0x37, 0x2e, 0x40, 0xac, 0xdc,
+		0x13, 0x0f, 0x1e, 0xc4, 0xd4, 0x64, 0x57, 0x3f, 0xb8, 0xa6, 0xdb, 0x37, 0xe2, 0xa9, 0x98, 0x12,
+		0x2f, 0xfe, 0x38, 0x5a, 0x2a, 0x01, 0xae, 0x90, 0x27, 0x80, 0x20, 0x06, 0x9e, 0xe5, 0xe1, 0xd2,
+		0x65, 0xec, 0x95, 0x74, 0x67, 0xbb, 0xfa, 0x41, 0x69, 0x8f, 0x6e, 0xf4, 0xbb, 0xac, 0x03, 0x36,
+		0xc1, 0x83, 0x3f, 0x01, 0x2c, 0x67, 0x56, 0xb0, 0xff, 0xd4, 0xf0, 0x03, 0xab, 0x02, 0x80, 0x6f,
+		0x00, 0x1f, 0xc5, 0x0d, 0x60, 0x86, 0xd3, 0x93, 0x35, 0xc1, 0xa1, 0xc4, 0x82, 0x43, 0x29, 0xbe,
+		0x04, 0xb3, 0x03, 0x33, 0x40, 0x8a, 0x90, 0x13, 0x11, 0x2b, 0x76, 0x0c, 0xcf, 0x3d, 0x9c, 0xb4,
+		0x9a, 0xe1, 0x91, 0x29, 0x96, 0x96, 0xb0, 0x92, 0xfa, 0x39, 0x0c, 0x93, 0xe2, 0x61, 0xce, 0x13,
+		0x90, 0x0b, 0xcc, 0x81, 0x8c, 0x22, 0x47, 0xbc, 0x28, 0xb2, 0x07, 0xfc, 0x3a, 0x64, 0x51, 0x01,
+		0xd1, 0x96, 0x80, 0x7d, 0x14, 0x66, 0xb9, 0x82, 0x1c, 0xe4, 0x35, 0x37, 0x78, 0xd6, 0x25, 0xc3,
+		0x8b, 0x90, 0xf3, 0xe0, 0x3c, 0xb6, 0x67, 0x24, 0xd4, 0x35, 0xdd, 0x2e, 0x6e, 0x02, 0x78, 0x93,
+		0x4a, 0x4a, 0x70, 0x06, 0x95, 0x87, 0x3f, 0x6d, 0x54, 0x3c, 0x8d, 0x15, 0xb0, 0x18, 0x0b, 0x08,
+		0xe4, 0xa5, 0x86, 0xf2, 0x07, 0xb2, 0xae, 0x33, 0x88, 0xf2, 0xfb, 0x3e, 0xf1, 0xd9, 0xc5, 0xc8,
+		0xdb, 0xa3, 0x0b, 0xbe, 0xf3, 0x15, 0x38, 0xed, 0xab, 0x44, 0x01, 0x0c, 0x04, 0xa2, 0x66, 0xbd,
+		0xca, 0x65, 0xac, 0x1c, 0x17, 0x50, 0x3a, 0x32, 0xfe, 0x5b, 0x38, 0x5a, 0xed, 0x8c, 0x8f, 0x36,
+		0x8d, 0x8f, 0x77, 0x85, 0x07, 0xe5, 0x7f, 0x39, 0x0d, 0xd3, 0x2a, 0x7d, 0x7f, 0x9f, 0xda, 0x0e,
+		0xb9, 0x08, 0x71, 0xda, 0xdc, 0x37, 0xc3, 0x62, 0xa0, 0x38, 0xc0, 0x65, 0x01, 0x57, 0x6d, 0xee,
+		0x9b, 0xd7, 0xa7, 0x54, 0x06, 0x4b, 0x2e, 0x41, 0x62, 0xb7, 0xd3, 0x17, 0xe1, 0xab, 0x01, 0x9d,
+		0xe5, 0x47, 0xba, 0x8a, 0x40, 0xd7, 0xa7, 0x54, 0x0e, 0x8d, 0x4d, 0xb1, 0x97, 0xb1, 0x63, 0x47,
+		0x37, 0x85, 0xd7, 0xa7, 0xb1, 0x29, 0x84, 0x25, 0x65, 0x80, 0xb6, 0xd1, 0x76, 0x34, 0x16, 0xda,
+		0xc9, 0x27, 0x86, 0x95, 0x55, 0x10, 0xb3, 0xed, 0xb0, 0x60, 0x10, 0x1e, 0x1d, 0xb4, 0xe5, 0x07,
+		0x76, 0xf7, 0xfd, 0x7d, 0x6a, 0x1d, 0xe6, 0x93, 0x47, 0x77, 0xf7, 0x7d, 0x08, 0x84, 0xdd, 0x65,
+		0xd0, 0xa8, 0x64, 0xf9, 0x23, 0x7b, 0xce, 0x41, 0x3e, 0x35, 0xac, 0xe6, 0xfc, 0x98, 0xec, 0xa5,
+		0xbd, 0xc6, 0x01, 0x9e, 0x67, 0x34, 0xf9, 0x9f, 0xe4, 0xdd, 0xae, 0x09, 0x96, 0x19, 0xb6, 0x7a,
+		0x02, 0xb8, 0x3c, 0xec, 0x33, 0x25, 0x4d, 0x31, 0xb2, 0x01, 0x33, 0x9d, 0xb6, 0xed, 0x68, 0xb6,
+		0xa1, 0xf7, 0xec, 0x7d, 0xd3, 0xb1, 0x59, 0xfc, 0x04, 0x5f, 0x92, 0x1e, 0x41, 0x61, 0xad, 0x6d,
+		0x3b, 0x75, 0x09, 0x7c, 0x7d, 0x4a, 0xcd, 0x75, 0xfc, 0x05, 0x48, 0xcf, 0xdc, 0xdd, 0xa5, 0x96,
+		0x4b, 0x30, 0x9f, 0x3b, 0x9a, 0xde, 0x26, 0x42, 0x4b, 0x7c, 0xa4, 0x67, 0xfa, 0x0b, 0xc8, 0x57,
+		0xc1, 0x7c, 0xc7, 0xd4, 0x5b, 0x2e, 0x39, 0xad, 0xb9, 0xdf, 0x37, 0x6e, 0xb1, 0xa0, 0x0c, 0x3e,
+		0x77, 0x3a, 0xaa, 0x93, 0xa6, 0xde, 0x92, 0x24, 0x56, 0x11, 0xe1, 0xfa, 0x94, 0x3a, 0xd7, 0x19,
+		0x2c, 0x24, 0x5f, 0x0b, 0x0b, 0x7a, 0xaf, 0xd7, 0x39, 0x1c, 0xa4, 0x3e, 0xcb, 0xa8, 0x9f, 0x1f,
+		0x45, 0xbd, 0x84, 0x38, 0x83, 0xe4, 0x89, 0x3e, 0x54, 0x4a, 0x1a, 0x18, 0x4e, 0xa2, 0xec, 0xe6,
+		0x55, 0x4f, 0x58, 0x19, 0xec, 0x5d, 0x2a, 0x7c, 0x72, 0x7e, 0x04, 0xed, 0x2d, 0x0e, 0x2f, 0x8d,
+		0x92, 0xeb, 0x53, 0x18, 0x79, 0x0a, 0x14, 0x71, 0xaa, 0x66, 0x93, 0xb2, 0x67, 0xf3, 0x04, 0xd5,
+		0xb9, 0x71, 0x54, 0x19, 0x7c, 0x90, 0x6a, 0xa0, 0x88, 0x54, 0x21, 0xc3, 0x5d, 0x51, 0x3c, 0x98,
+		0xa1, 0xec, 0x35, 0xab, 0xcc, 0xc5, 0xe2, 0xc8, 0x15, 0xca, 0x40, 0x31, 0xca, 0x75, 0x7d, 0x4a,
+		0x05, 0xea, 0x7e, 0x11, 0x1d, 0x4e, 0xb0, 0x87, 0xbd, 0x0e, 0xb5, 0xa0, 0xe2, 0xcd, 0xcf, 0x33,
+		0x82, 0x4f, 0x8c, 0x22, 0x78, 0x93, 0x21, 0xdd, 0xf4, 0xeb, 0xe1, 0xeb, 0x53, 0xea, 0xfc, 0xed,
+		0xe1, 0x62, 0x14, 0xb1, 0xdd, 0xb6, 0xa1, 0x77, 0xf0, 0x7d, 0x49, 0x6e, 0xcd, 0x2c, 0x1c, 0x2d,
+		0x62, 0x57, 0x05, 0x34, 0xdb, 0x58, 0x51, 0xc4, 0x76, 0xfd, 0x05, 0xe5, 0x69, 0x91, 0x33, 0xea,
+		0x3e, 0xd1, 0x36, 0xad, 0xa4, 0xf8, 0xb3, 0x6c, 0x37, 0xe2, 0x29, 0x50, 0x32, 0xc5, 0xc7, 0x20,
+		0xe3, 0x53, 0x4c, 0x78, 0x0a, 0x25, 0xb2, 0x6a, 0x64, 0xf6, 0xa9, 0xf8, 0x2c, 0xce, 0x40, 0xd6,
+		0xaf, 0x8c, 0x8a, 0x1f, 0x8e, 0x40, 0xc6, 0xa7, 0x67, 0x10, 0xd3, 0x1f, 0x91, 0x4e, 0x7b, 0x96,
+		0xca, 0xc3, 0x72, 0x6b, 0x93, 0xf5, 0xfc, 0xf8, 0x35, 0xcb, 0x0a, 0xc5, 0xce, 0x8a, 0x8f, 0x4c,
+		0xf4, 0x2e, 0xf6, 0x5c, 0x90, 0x18, 0x03, 0x81, 0xde, 0xc5, 0x9e, 0x04, 0x78, 0x08, 0xb2, 0x38,
+		0x6e, 0xcd, 0x6f, 0x2f, 0xa5, 0xd5, 0x0c, 0x96, 0x09, 0x90, 0xe2, 0xbf, 0x89, 0x82, 0x32, 0xa8,
+		0xc0, 0xdc, 0x50, 0x75, 0xe4, 0xd8, 0xa1, 0xea, 0x53, 0x83, 0x41, 0x72, 0x2f, 0x2e, 0xbe, 0x06,
+		0x8a, 0x17, 0xde, 0xe5, 0x5b, 0xcd, 0x68, 0xfb, 0x6f, 0xc0, 0x50, 0x55, 0x67, 0x9b, 0xc1, 0x02,
+		0x72, 0x35, 0x70, 0x58, 0x29, 0x7f, 0x3a, 0x62, 0x70, 0xc2, 0x5d, 0x23, 0x66, 0xbb, 0xd7, 0xd2,
+		0x1d, 0x2a, 0xe3, 0x63, 0x1e, 0x26, 0xda, 0x1a, 0x18, 0x4d, 0xb6, 0x1d, 0xdd, 0xa1, 0xc2, 0xd6,
+		0xe0, 0x91, 0x87, 0x9c, 0xde, 0xeb, 0xd5, 0xb1, 0x94, 0xdb, 0x1a, 0x8f, 0xc0, 0x0c, 0xea, 0xf4,
+		0xb6, 0xde, 0x91, 0xa6, 0x42, 0x92, 0x9b, 0x24, 0xa2, 0x94, 0x5b, 0x07, 0xc5, 0x16, 0x64, 0xfd,
+		0xfa, 0xdc, 0xf5, 0xa9, 0x22, 0x3e, 0x9f, 0x8a, 0x88, 0x37, 0x43, 0x38, 0x7f, 0xe4, 0x3b, 0x2b,
+		0xe1, 0x87, 0x06, 0x0b, 0xcc, 0xff, 0xba, 0xcd, 0x83, 0x25, 0x29, 0x95, 0x7f, 0x14, 0x55, 0x98,
+		0x09, 0xea, 0x7e, 0x32, 0x03, 0x51, 0xe7, 0x40, 0xb4, 0x12, 0x75, 0x0e, 0xd0, 0xd2, 0x75, 0x5f,
+		0xda, 0x9d, 0x09, 0xd9, 0xed, 0x04, 0x9e, 0x17, 0xeb, 0x28, 0xce, 0x42, 0x4e, 0xd2, 0xe4, 0x4e,
+		0xf9, 0x49, 0x58, 0x08, 0x53, 0xf1, 0xc5, 0x7d, 0x58, 0x08, 0x53, 0xd5, 0xe4, 0x12, 0xa4, 0x5c,
+		0x1d, 0x1f, 0xe2, 0x1d, 0xb3, 0x66, 0x25, 0xb0, 0xea, 0x82, 0x06, 0xc2, 0xf9, 0xd1, 0x40, 0x38,
+		0xbf, 0xf8, 0x75, 0x90, 0x1f, 0xa5, 0xbf, 0x07, 0x82, 0x7c, 0x71, 0x97, 0x61, 0x27, 0x21, 0x29,
+		0x9e, 0xb4, 0x8c, 0xb2, 0x03, 0x2c, 0xf1, 0x85, 0x8c, 0xe4, 0xba, 0x3c, 0xc6, 0x8a, 0xf9, 0x47,
+		0x51, 0x83, 0x53, 0x23, 0x75, 0xf8, 0xe8, 0xa3, 0x30, 0x4e, 0x48, 0x1c, 0x85, 0x35, 0x65, 0x77,
+		0x6c, 0x36, 0x56, 0x99, 0xc8, 0xc2, 0xbf, 0x8a, 0x1f, 0x8d, 0xc1, 0xc9, 0x70, 0x4d, 0x4e, 0x96,
+		0x20, 0x8b, 0x76, 0xab, 0x13, 0x34, 0x71, 0xa1, 0xab, 0x1f, 0x34, 0x84, 0x7d, 0x2b, 0x8e, 0x12,
+		0xa2, 0xee, 0x51, 0x02, 0xd9, 0x86, 0xb9, 0x8e, 0xd9, 0xd4, 0x3b, 0x9a, 0xef, 0x28, 0x47, 0x2c,
+		0xa2, 0x87, 0x87, 0x98, 0x1d, 0x0c, 0x19, 0xa2, 0xc2, 0x11, 0xf2, 0x3f, 0xcb, 0x68, 0xac, 0xb9,
+		0xa7, 0x3e, 0xa4, 0x02, 0x99, 0x6e, 0xdb, 0xde, 0xa1, 0xfb, 0xfa, 0xed, 0xb6, 0x69, 0x89, 0xd5,
+		0x34, 0x2c, 0x34, 0xeb, 0x1e, 0x8c, 0x3c, 0x61, 0xf2, 0xa1, 0xf9, 0xa6, 0x24, 0x11, 0x7a, 0xf0,
+		0x95, 0x3c, 0xb6, 0x36, 0x19, 0x75, 0x86, 0x34, 0x3d, 0xf2, 0x0c, 0x29, 0xec, 0xc0, 0x26, 0x15,
+		0x7e, 0x60, 0xf3, 0xcd, 0xfe, 0xa9, 0x09, 0xee, 0x7d, 0x43, 0x67, 0x38, 0xa4, 0x0e, 0x0b, 0x02,
+		0xbf, 0x15, 0xe0, 0x7d, 0x48, 0xaa, 0x03, 0x5f, 0x5f, 0x83, 0x3c, 0x27, 0x12, 0x7d, 0x34, 0xdb,
+		0x63, 0xf7, 0xc6, 0x76, 0x79, 0x88, 0x1a, 0xf7, 0x1d, 0xa2, 0xfe, 0x5f, 0x36, 0x15, 0x2f, 0xc1,
+		0xdc, 0x90, 0x1d, 0x11, 0x7a, 0x38, 0x3c, 0x22, 0x20, 0x8b, 0x6f, 0xd8, 0x15, 0x46, 0x1b, 0x0e,
+		0xa1, 0xa4, 0x8e, 0x15, 0xfb, 0x1e, 0xa5, 0x9e, 0x1f, 0x81, 0x99, 0x01, 0xb3, 0x86, 0xcf, 0x42,
+		0x2e, 0xe0, 0x40, 0x16, 0xbf, 0x31, 0x06, 0x0b, 0x61, 0xb6, 0x47, 0x88, 0xa0, 0xbd, 0x0f, 0xe6,
+		0x5b, 0xb4, 0xd9, 0x6e, 0xdd, 0xab, 0x9c, 0xcd, 0x09, 0xec, 0xff, 0x2f, 0x66, 0xc3, 0x62, 0xf6,
+		0x5d, 0x00, 0x29, 0x95, 0xda, 0x3d, 0x34, 0x25, 0x30, 0x92, 0x4e, 0x0f, 0x9a, 0xb4, 0xe7, 0x78,
+		0xf9, 0x00, 0x61, 0xd6, 0x2d, 0x87, 0xae, 0x4a, 0x48, 0xf4, 0xed, 0x5c, 0x34, 0x3c, 0x54, 0x60,
+		0xee, 0xeb, 0x68, 0x4f, 0x54, 0xa0, 0xfb, 0xfd, 0xd7, 0xcb, 0xd2, 0x7f, 0x8d, 0x8d, 0x74, 0xcd,
+		0x38, 0xd6, 0x80, 0x03, 0xfb, 0xac, 0x70, 0x60, 0xe3, 0x63, 0x1a, 0x0b, 0x78, 0xb0, 0xab, 0x01,
+		0x0f, 0x36, 0x39, 0x66, 0x98, 0x23, 0x5c, 0xd8, 0xcb, 0xd2, 0x85, 0x9d, 0x1e, 0xd3, 0xe3, 0x01,
+		0x1f, 0xf6, 0x45, 0x9f, 0x0f, 0x9b, 0x1e, 0x0e, 0x14, 0x06, 0x50, 0x43, 0x9c, 0xd8, 0x17, 0x5c,
+		0x27, 0x36, 0x3b, 0xd2, 0x01, 0x16, 0xc8, 0x83, 0x5e, 0xec, 0xe6, 0x90, 0x17, 0x9b, 0x13, 0xbf,
+		0xed, 0x33, 0x8a, 0xc4, 0x18, 0x37, 0x76, 0x73, 0xc8, 0x8d, 0x9d, 0x19, 0x43, 0x70, 0x8c, 0x1f,
+		0xfb, 0xd5, 0xe1, 0x7e, 0xec, 0x68, 0x4f, 0x53, 0x74, 0x73, 0x32, 0x47, 0x56, 0x1b, 0xe1, 0xc8,
+		0x2a, 0x23, 0x9d, 0x2e, 0x4e, 0x7e, 0x62, 0x4f, 0x76, 0x3b, 0xc4, 0x93, 0xe5, 0x3e, 0xe7, 0xb9,
+		0x91, 0xc4, 0x27, 0x70, 0x65, 0xb7, 0x43, 0x5c, 0x59, 0x32, 0x96, 0xec, 0x58, 0x5f, 0xf6, 0x6a,
+		0xd0, 0x97, 0x9d, 0x1f, 0x61, 0x30, 0x79, 0xab, 0x7d, 0x84, 0x33, 0xbb, 0x33, 0xca, 0x99, 0xe5,
+		0x0e, 0xe7, 0x93, 0x23, 0x29, 0x1e, 0xc3, 0x9b, 0xdd, 0x1c, 0xf2, 0x66, 0x4f, 0x8c, 0x91, 0xb4,
+		0xc9, 0xdd, 0x59, 0xfe, 0xd6, 0x38, 0x7f, 0x65, 0x1c, 0x94, 0xcc, 0x8d, 0x78, 0x2a, 0xa3, 0x64,
+		0x8b, 0x8f, 0xc3, 0x9c, 0x24, 0xe5, 0xea, 0x39, 0x34, 0x73, 0xa9, 0x65, 0x99, 0x96, 0x4c, 0xee,
+		0x67, 0x1f, 0xc5, 0x73, 0x90, 0x75, 0x41, 0x8f, 0x76, 0x7d, 0x99, 0x3b, 0xe1, 0xd3, 0x63, 0xc5,
+		0x9f, 0x8b, 0x40, 0xd6, 0xaf, 0xa2, 0x02, 0xae, 0x51, 0x5a, 0xb8, 0x46, 0x3e, 0x87, 0x38, 0x1a,
+		0x74, 0x88, 0xf1, 0x45, 0xe1, 0xde, 0x90, 0xaf, 0xab, 0xf7, 0x5c, 0x5f, 0x57, 0xe6, 0xfe, 0x70,
+		0xb7, 0x59, 0x6c, 0x4b, 0xfc, 0x20, 0x6c, 0xd6, 0xcd, 0x83, 0xe2, 0x5e, 0x1a, 0x79, 0x0a, 0xe6,
+		0x7d, 0xb0, 0xae, 0xfb, 0xc1, 0x1d, 0x3f, 0xc5, 0x85, 0x2e, 0x09, 0x3f, 0xe4, 0x9f, 0x47, 0x60,
+		0x6e, 0x48, 0x45, 0x86, 0xfa, 0xb3, 0x91, 0xfb, 0xe4, 0xcf, 0x46, 0xef, 0xd9, 0x9f, 0xf5, 0xbb,
+		0x53, 0xb1, 0xa0, 0x3b, 0xf5, 0x3f, 0x22, 0x90, 0x0b, 0x68, 0x6a, 0x9c, 0x82, 0xa6, 0xd9, 0xa2,
+		0xc2, 0xc1, 0x61, 0x7f, 0xa3, 0x49, 0xd2, 0x31, 0xf7, 0x84, 0x1b, 0x83, 0x7f, 0x22, 0x94, 0xbb,
+		0xf1, 0xa4, 0xc5, 0xbe, 0xe2, 0xfa, 0x46, 0x09, 0xff, 0x15, 0x04, 0x91, 0x97, 0x9f, 0xf4, 0xf2,
+		0xf2, 0xdd, 0xfb, 0xb7, 0xd3, 0xbe, 0xfb, 0xb7, 0xe4, 0xdd, 0x90, 0x66, 0x71, 0x6a, 0xcd, 0xec,
+		0xf1, 0xcd, 0x7a, 0xc0, 0xb4, 0x09, 0xe6, 0xe4, 0xdb, 0x2c, 0x5b, 0x98, 0xfd, 0xe5, 0xb3, 0x38,
+		0xd2, 0x01, 0x8b, 0xe3, 0x41, 0x48, 0x63, 0xef, 0xf9, 0x0f, 0x3c, 0x80, 0xb8, 0xbc, 0x2d, 0x0b,
+		0x8a, 0x3f, 0x11, 0x85, 0xd9, 0x81, 0x8d, 0x26, 0x74, 0xec, 0x61, 0x27, 0xa0, 0x93, 0xf1, 0x63,
+		0x11, 0x60, 0x4f, 0xb7, 0xb5, 0x37, 0x75, 0xc3, 0xa1, 0x2d, 0xc1, 0x14, 0x5f, 0x09, 0xe6, 0xe0,
+		0xe3, 0x57, 0xdf, 0xa6, 0x2d, 0x11, 0x38, 0x70, 0xbf, 0xc9, 0x75, 0x48, 0xd2, 0xdb, 0xec, 0x81,
+		0x53, 0xfe, 0x4c, 0xf0, 0xc9, 0x61, 0x4f, 0x0e, 0xab, 0xcb, 0x79, 0x9c, 0xec, 0x2f, 0xbc, 0x75,
+		0x56, 0xe1, 0xd0, 0x4f, 0xba, 0x77, 0xa6, 0x54, 0x81, 0x1f, 0xe4, 0x42, 0x6a, 0x80, 0x0b, 0x2c,
+		0x84, 0x95, 0x95, 0x9e, 0x29, 0xf2, 0x94, 0xa7, 0x27, 0xab, 0xb9, 0x2e, 0xed, 0xf6, 0x4c, 0xb3,
+		0xa3, 0xf1, 0x35, 0x5e, 0x82, 0x19, 0x97, 0x57, 0x7c, 0x37, 0x65, 0x3f, 0xbe, 0xe4, 0x60, 0x54,
+		0x27, 0x60, 0x04, 0x67, 0x79, 0x21, 0x5f, 0x53, 0x37, 0xe2, 0xa9, 0x88, 0x82, 0x6f, 0x5e, 0x47,
+		0x95, 0x58, 0x71, 0x0b, 0x4e, 0x84, 0xee, 0xab, 0xe4, 0x79, 0x48, 0x7b, 0x5b, 0x32, 0xcf, 0x30,
+		0x3f, 0x22, 0x48, 0xe0, 0xc1, 0x16, 0x7f, 0x29, 0x02, 0x27, 0x42, 0x77, 0x56, 0x52, 0x85, 0x24,
+		0x4f, 0xf7, 0x13, 0xa9, 0x1d, 0x4f, 0x4d, 0xb6, 0x23, 0x2f, 0xf3, 0x5c, 0x40, 0x55, 0x20, 0x17,
+		0xbf, 0x16, 0x92, 0xbc, 0x84, 0x64, 0x60, 0xda, 0x7b, 0x4a, 0x19, 0x20, 0x59, 0x5a, 0x5d, 0xad,
+		0x6e, 0xc9, 0x77, 0x51, 0xcb, 0x9b, 0x2a, 0xfe, 0x96, 0x1a, 0x40, 0x52, 0xad, 0xde, 0xa8, 0xae,
+		0x36, 0x94, 0x18, 0xfe, 0xfa, 0x18, 0xff, 0x5b, 0xbb, 0x8a, 0x0f, 0xe3, 0x37, 0x94, 0xb8, 0xaf,
+		0xa8, 0x5e, 0xdd, 0xa8, 0x54, 0x55, 0x25, 0x51, 0x7c, 0x06, 0x4e, 0xc9, 0x7e, 0x0c, 0x07, 0x33,
+		0xdc, 0x98, 0x42, 0xc4, 0x17, 0x53, 0xc0, 0x47, 0xd5, 0x0b, 0xa3, 0x37, 0x66, 0x72, 0x63, 0x60,
+		0xe0, 0x17, 0x8f, 0xb1, 0xab, 0x0f, 0x8c, 0x1e, 0xfd, 0x18, 0x8b, 0xee, 0x52, 0xa7, 0xb9, 0xcf,
+		0x0d, 0x05, 0xae, 0x81, 0x72, 0x6a, 0x4e, 0x94, 0x32, 0x24, 0x9b, 0x83, 0xbd, 0x41, 0x9b, 0x8e,
+		0xc6, 0x85, 0xc8, 0x16, 0xbf, 0x15, 0x9b, 0xe3, 0xa5, 0x75, 0x5e, 0x58, 0xfc, 0xba, 0x63, 0xf1,
+		0x32, 0x0d, 0x09, 0xb5, 0x8a, 0xaf, 0xdf, 0xc7, 0xf0, 0x27, 0xd6, 0xd8, 0x9f, 0x5a, 0x7d, 0xa3,
+		0xb4, 0x55, 0xbf, 0xbe, 0x89, 0xbc, 0x9c, 0x87, 0x59, 0xc9, 0x4b, 0x59, 0x98, 0x28, 0x3e, 0x01,
+		0x0f, 0x8c, 0xb0, 0x2a, 0x42, 0xf2, 0x2f, 0x3f, 0x1e, 0xf1, 0x43, 0x07, 0x2d, 0x83, 0x4d, 0x48,
+		0xda, 0x8e, 0xee, 0xf4, 0x6d, 0xc1, 0xc4, 0xe7, 0x27, 0x35, 0x33, 0x96, 0xe5, 0x1f, 0x75, 0x86,
+		0xae, 0x0a, 0x32, 0xc5, 0x4b, 0x30, 0x13, 0xac, 0x19, 0xcd, 0x03, 0x4f, 0x88, 0xa2, 0xc5, 0x2b,
+		0x40, 0x86, 0xad, 0x8f, 0x10, 0xf7, 0x32, 0x12, 0xe6, 0x5e, 0xfe, 0x48, 0x04, 0x4e, 0x1f, 0x61,
+		0x69, 0x90, 0xf7, 0x0d, 0x0c, 0xf2, 0x85, 0xe3, 0xd8, 0x29, 0xcb, 0xbc, 0x6c, 0x60, 0x98, 0xcf,
+		0x42, 0xd6, 0x5f, 0x3e, 0xd9, 0x20, 0xbf, 0x10, 0x85, 0x13, 0xa1, 0x46, 0x8b, 0x4f, 0x05, 0x46,
+		0xfe, 0x82, 0x2a, 0xf0, 0x3d, 0x00, 0xce, 0x81, 0x4c, 0x00, 0x16, 0xfb, 0xe8, 0x99, 0x90, 0xd0,
+		0x18, 0x6d, 0x36, 0x0e, 0xc4, 0x22, 0x48, 0x3b, 0xe2, 0x2f, 0x0c, 0xf3, 0xf8, 0x82, 0x02, 0x7d,
+		0xb6, 0xc7, 0xda, 0xee, 0x2f, 0xc5, 0x4e, 0xb6, 0x19, 0x2b, 0xb7, 0x83, 0xc5, 0x36, 0x79, 0x0d,
+		0x1e, 0x18, 0x30, 0x14, 0x5c, 0xd2, 0xf1, 0x49, 0xed, 0x85, 0x13, 0x41, 0x7b, 0x41, 0x92, 0xf6,
+		0xef, 0xf6, 0x89, 0xe0, 0x6e, 0xff, 0x1a, 0x80, 0x17, 0x1c, 0xf0, 0x32, 0xd6, 0x22, 0xfe, 0x8c,
+		0xb5, 0x4b, 0x90, 0x40, 0x49, 0x92, 0x7c, 0x1a, 0x56, 0xc5, 0x28, 0x09, 0xbe, 0xe0, 0x02, 0x87,
+		0x2e, 0xb6, 0x81, 0x0c, 0xc7, 0x16, 0x47, 0x34, 0xf1, 0x62, 0xb0, 0x89, 0x87, 0x46, 0x46, 0x29,
+		0xc3, 0x9b, 0xfa, 0x00, 0x24, 0xd8, 0xcc, 0x87, 0x5e, 0x03, 0xfc, 0x1a, 0x00, 0xdd, 0x71, 0xac,
+		0xf6, 0x4e, 0xdf, 0x6b, 0xe0, 0x6c, 0xb8, 0xe4, 0x94, 0x24, 0x5c, 0xf9, 0x41, 0x21, 0x42, 0x0b,
+		0x1e, 0xaa, 0x4f, 0x8c, 0x7c, 0x04, 0x8b, 0x1b, 0x30, 0x13, 0xc4, 0x0d, 0xbf, 0xd6, 0xe8, 0xbd,
+		0x2f, 0x92, 0x96, 0xf6, 0x8d, 0x6b, 0x1d, 0x89, 0x37, 0x7f, 0xd8, 0x47, 0xf1, 0xcf, 0x23, 0x90,
+		0xf5, 0x0b, 0xde, 0x7d, 0x36, 0x41, 0xce, 0x84, 0x98, 0x20, 0xe9, 0x3d, 0xdd, 0x7e, 0x85, 0x15,
+		0xa0, 0xa8, 0x0c, 0x58, 0x20, 0xd3, 0x7b, 0xba, 0xbd, 0xfd, 0x0e, 0x1a, 0x20, 0xc5, 0x6f, 0x8a,
+		0x40, 0xca, 0x1d, 0xfc, 0x11, 0x59, 0xba, 0xde, 0xe5, 0x56, 0x37, 0xea, 0xce, 0xcf, 0x37, 0x62,
+		0xee, 0xf9, 0xc6, 0x15, 0x77, 0xf3, 0x1b, 0x15, 0x0e, 0xf1, 0x73, 0x5a, 0x66, 0xca, 0x89, 0xbd,
+		0xfe, 0xca, 0x64, 0x77, 0xc8, 0xf0, 0xec, 0xc5, 0x77, 0xff, 0x8b, 0x7f, 0x14, 0x5b, 0xbe, 0xd4,
+		0x18, 0xbe, 0x0c, 0xfd, 0x97, 0xcd, 0x22, 0xc7, 0xbe, 0x6c, 0xe6, 0xb6, 0x12, 0xf5, 0xb7, 0xf2,
+		0x91, 0x08, 0xa4, 0xe4, 0x8a, 0x20, 0xef, 0xf5, 0xa7, 0x4c, 0xc9, 0x33, 0xb9, 0x91, 0xda, 0x48,
+		0xd0, 0xf7, 0x50, 0x86, 0x33, 0x85, 0x63, 0xc7, 0xcd, 0x14, 0x16, 0x76, 0xdd, 0x9f, 0xe0, 0x6f,
+		0x99, 0x0c, 0xac, 0xd7, 0xbf, 0x70, 0xef, 0x86, 0x37, 0xb9, 0x58, 0xc8, 0x26, 0x37, 0x2a, 0xfd,
+		0x37, 0x3e, 0x2a, 0xfd, 0x77, 0x78, 0xd4, 0x89, 0x7b, 0x1c, 0xf5, 0x87, 0xa2, 0x90, 0xf1, 0x05,
+		0x47, 0xc9, 0x73, 0x81, 0x54, 0xe2, 0xa5, 0xa3, 0x02, 0xa9, 0xbe, 0x5c, 0xe2, 0x00, 0x9b, 0xa2,
+		0xc7, 0x67, 0xd3, 0xfd, 0xbf, 0x56, 0x14, 0x7e, 0xf3, 0x32, 0x31, 0xe2, 0xe6, 0xe5, 0x37, 0x44,
+		0x20, 0xe5, 0x1a, 0xdd, 0xc7, 0x3d, 0x7d, 0x3b, 0x09, 0x49, 0x61, 0x57, 0xf2, 0xe3, 0x37, 0xf1,
+		0x15, 0x1a, 0x54, 0x2e, 0x40, 0x4a, 0xfe, 0x68, 0x9f, 0xd8, 0xd3, 0xdc, 0xef, 0xf3, 0x2f, 0x40,
+		0xc6, 0x77, 0x72, 0x89, 0x8a, 0x71, 0xa3, 0xfa, 0x8a, 0x32, 0x55, 0x98, 0xbe, 0x73, 0x77, 0x29,
+		0xb6, 0x41, 0xdf, 0xc4, 0xd5, 0xac, 0x56, 0x57, 0xaf, 0x57, 0x57, 0x5f, 0x56, 0x22, 0x85, 0xcc,
+		0x9d, 0xbb, 0x4b, 0xd3, 0x2a, 0x65, 0xf1, 0xc4, 0xf3, 0x2f, 0xc3, 0xec, 0xc0, 0xc4, 0x04, 0x8d,
+		0x16, 0x02, 0x33, 0x95, 0xed, 0xad, 0xb5, 0xda, 0x6a, 0xa9, 0x51, 0xd5, 0x78, 0xc2, 0x29, 0xfe,
+		0xc2, 0xca, 0x5a, 0xed, 0xda, 0xf5, 0x86, 0xb6, 0xba, 0x56, 0xab, 0x6e, 0x34, 0xb4, 0x52, 0xa3,
+		0x51, 0x5a, 0x7d, 0x59, 0x89, 0x5e, 0xbc, 0x9b, 0x81, 0x38, 0xa6, 0x95, 0x91, 0x55, 0x88, 0xb3,
+		0x40, 0xc8, 0x91, 0xa9, 0x4b, 0x85, 0xa3, 0x23, 0xc3, 0xe4, 0x2a, 0x24, 0x58, 0x8c, 0x84, 0x1c,
+		0x9d, 0xcb, 0x54, 0x18, 0x13, 0x2a, 0xc6, 0xce, 0xb0, 0x15, 0x79, 0x64, 0x72, 0x53, 0xe1, 0xe8,
+		0xc8, 0x31, 0x59, 0x83, 0x69, 0xe9, 0x22, 0x8f, 0xcb, 0x38, 0x2a, 0x8c, 0x0d, 0xe7, 0xe2, 0xd0,
+		0x78, 0xa8, 0xe1, 0xe8, 0xbc, 0xa7, 0xc2, 0x98, 0x98, 0x32, 0xa9, 0xb9, 0x17, 0x71, 0xc6, 0xa4,
+		0x32, 0x15, 0xc6, 0x45, 0x89, 0x89, 0x0a, 0x69, 0x2f, 0x88, 0x33, 0x3e, 0x9b, 0xab, 0x30, 0x41,
+		0xb8, 0x9c, 0x7c, 0x2d, 0xe4, 0x82, 0x8e, 0xee, 0x64, 0xe9, 0x52, 0x85, 0x09, 0xe3, 0xd1, 0x48,
+		0x3f, 0xe8, 0xf5, 0x4e, 0x96, 0x3e, 0x55, 0x98, 0x30, 0x3c, 0x4d, 0xde, 0x80, 0xb9, 0x61, 0xaf,
+		0x74, 0xf2, 0x6c, 0xaa, 0xc2, 0x31, 0x02, 0xd6, 0xa4, 0x0b, 0x24, 0xc4, 0x9b, 0x3d, 0x46, 0x72,
+		0x55, 0xe1, 0x38, 0xf1, 0x6b, 0xd2, 0x82, 0xd9, 0x41, 0x17, 0x71, 0xd2, 0x64, 0xab, 0xc2, 0xc4,
+		0xb1, 0x6c, 0xde, 0x4a, 0xd0, 0xb5, 0x9c, 0x34, 0xf9, 0xaa, 0x30, 0x71, 0x68, 0x9b, 0x6c, 0x03,
+		0xf8, 0xbc, 0xc3, 0x09, 0x92, 0xb1, 0x0a, 0x93, 0x04, 0xb9, 0x49, 0x0f, 0xe6, 0xc3, 0xdc, 0xc6,
+		0xe3, 0xe4, 0x66, 0x15, 0x8e, 0x15, 0xfb, 0x46, 0x79, 0x0e, 0x3a, 0x80, 0x93, 0xe5, 0x6a, 0x15,
+		0x26, 0x0c, 0x82, 0x97, 0x4b, 0x23, 0x13, 0x74, 0x1f, 0x3b, 0x32, 0x41, 0xd7, 0x4b, 0xb9, 0x75,
+		0x93, 0x72, 0xbf, 0xbb, 0x02, 0xef, 0x12, 0x4f, 0xbf, 0xd8, 0x8e, 0x7e, 0xab, 0x6d, 0xec, 0xb9,
+		0x6f, 0xf9, 0x88, 0x6f, 0x91, 0x9d, 0x7b, 0x92, 0x43, 0x2d, 0xcb, 0xd2, 0x31, 0x2f, 0xfa, 0x8c,
+		0x7c, 0xe6, 0x70, 0x5c, 0x22, 0xff, 0xf8, 0xbc, 0xdb, 0x23, 0x5e, 0x0b, 0x1a, 0xf3, 0x26, 0x51,
+		0xc8, 0x6b, 0x42, 0x85, 0x23, 0x2f, 0xc7, 0x17, 0x8e, 0x4a, 0x58, 0x46, 0x2b, 0x76, 0xe6, 0x7a,
+		0xdb, 0x76, 0x4c, 0xab, 0xdd, 0xd4, 0x3b, 0x6c, 0x7b, 0xb9, 0x32, 0xe9, 0x75, 0xa2, 0x72, 0x1a,
+		0xcd, 0x15, 0xf1, 0x04, 0x11, 0x47, 0x21, 0x15, 0x48, 0xde, 0xd6, 0x3b, 0xfc, 0x32, 0x8f, 0xff,
+		0xb5, 0xb1, 0x41, 0x9e, 0xfb, 0xec, 0x28, 0x3f, 0x15, 0x8e, 0x5b, 0xfc, 0x8e, 0x28, 0x9c, 0xf4,
+		0xdf, 0xf9, 0x61, 0x7b, 0x15, 0x7b, 0x28, 0x73, 0xa4, 0x53, 0xf2, 0xa2, 0x30, 0xb2, 0xa2, 0x63,
+		0x8d, 0xac, 0x9c, 0x34, 0xb2, 0x78, 0x9b, 0x0c, 0x2d, 0xec, 0xd2, 0x74, 0x2c, 0xf4, 0xd2, 0xf4,
+		0x59, 0xc8, 0x70, 0x93, 0x8c, 0xdb, 0x62, 0xfc, 0xf8, 0x02, 0x58, 0x11, 0x7f, 0x77, 0xe2, 0x66,
+		0xe0, 0x98, 0x20, 0xc1, 0xb8, 0xf0, 0xc4, 0x28, 0x2e, 0x78, 0x03, 0x0b, 0xe5, 0x87, 0x8f, 0x52,
+		0xf1, 0xfd, 0x30, 0x1f, 0x02, 0x4d, 0x2a, 0x90, 0xc5, 0xc0, 0x83, 0xe6, 0xf7, 0x90, 0xd2, 0xe5,
+		0x87, 0x7e, 0xe3, 0x93, 0x4f, 0x9d, 0x11, 0x6d, 0xba, 0x81, 0x0a, 0x71, 0xc8, 0xce, 0x1f, 0x71,
+		0x57, 0x33, 0x88, 0x56, 0x1a, 0x74, 0xa4, 0x02, 0x2e, 0xce, 0xa7, 0xd8, 0x7d, 0x94, 0x6e, 0xb7,
+		0x6d, 0xa3, 0x4a, 0x50, 0x59, 0x3c, 0x63, 0x1b, 0xe2, 0x96, 0xee, 0x08, 0x2f, 0xbf, 0x5c, 0x12,
+		0xef, 0x37, 0x3d, 0x3a, 0xfe, 0x15, 0xa6, 0xe5, 0xa1, 0x27, 0x9e, 0x04, 0xff, 0x91, 0x1c, 0xf9,
+		0x6a, 0xc0, 0xab, 0x0d, 0x1a, 0x23, 0x1d, 0xbd, 0x5f, 0xa4, 0xf1, 0xb2, 0x07, 0xf6, 0x9a, 0xb4,
+		0x01, 0xef, 0x6a, 0xe0, 0x11, 0xbb, 0xb1, 0x47, 0x79, 0x23, 0xb1, 0xfb, 0xd5, 0x48, 0xae, 0xab,
+		0x1f, 0xac, 0x32, 0xc2, 0xd8, 0x94, 0x78, 0x29, 0xeb, 0x57, 0x23, 0x00, 0x1e, 0xe7, 0x88, 0x8e,
+		0x07, 0x51, 0xf2, 0x8b, 0xb5, 0x2f, 0x0f, 0xa2, 0x1e, 0x1b, 0x29, 0x19, 0x41, 0xbe, 0x73, 0xa9,
+		0xfd, 0xcc, 0x5b, 0x52, 0x6a, 0x67, 0x9b, 0x03, 0xf3, 0x72, 0x03, 0x32, 0x3c, 0x64, 0xa5, 0xdd,
+		0xdb, 0x32, 0x00, 0x8e, 0x8d, 0xf5, 0x62, 0x0c, 0x9f, 0x88, 0x40, 0xa6, 0xe2, 0x7b, 0xe3, 0x11,
+		0x4f, 0x12, 0x4d, 0xa3, 0x7d, 0x8b, 0x5a, 0xee, 0x49, 0x22, 0xff, 0x44, 0x7f, 0x80, 0xff, 0x54,
+		0xa0, 0x73, 0x28, 0x9f, 0x2f, 0x92, 0xdf, 0x88, 0xf5, 0x26, 0xdd, 0xb1, 0xdb, 0x92, 0xe5, 0xaa,
+		0xfc, 0xc4, 0xb4, 0x10, 0x9b, 0x36, 0xfb, 0x78, 0xc0, 0x81, 0xbf, 0x12, 0xea, 0xe0, 0x4f, 0x00,
+		0xf3, 0x68, 0xc9, 0xac, 0x2c, 0x5f, 0xe5, 0xc5, 0x48, 0xa4, 0x45, 0x1d, 0xbd, 0xdd, 0xe1, 0xa9,
+		0x9f, 0x69, 0x55, 0x7e, 0x8a, 0xae, 0x7e, 0x6a, 0xda, 0x1f, 0x2f, 0x58, 0x05, 0xc5, 0xec, 0x51,
+		0x2b, 0x90, 0x36, 0xc4, 0xc5, 0x35, 0xff, 0x1b, 0x9f, 0x7c, 0x6a, 0x41, 0x30, 0x3c, 0xb8, 0x1a,
+		0x66, 0x25, 0x86, 0x5c, 0x11, 0xaf, 0x05, 0xce, 0x0e, 0xfb, 0x3b, 0xde, 0x3b, 0x35, 0x0b, 0x43,
+		0x4c, 0x2d, 0x19, 0x87, 0xe5, 0xfc, 0xaf, 0x7b, 0xa4, 0xbd, 0x78, 0x02, 0xbe, 0x0c, 0xe3, 0x3b,
+		0x48, 0x64, 0x64, 0x50, 0x85, 0xbd, 0xa1, 0xb7, 0x3b, 0xf2, 0x57, 0x55, 0x55, 0xf1, 0x45, 0x56,
+		0xdc, 0x20, 0x70, 0x9c, 0xf9, 0xad, 0xc5, 0x51, 0xb2, 0x51, 0x36, 0x8d, 0x56, 0x30, 0xda, 0x4b,
+		0x1a, 0x90, 0x74, 0xcc, 0x5b, 0xd4, 0x10, 0x0c, 0x2a, 0xbf, 0xe7, 0x18, 0x82, 0x3d, 0xfc, 0x68,
+		0x9b, 0xa0, 0x45, 0xf6, 0x40, 0x69, 0xd1, 0x0e, 0xdd, 0x63, 0xac, 0xc4, 0xdf, 0x8b, 0xa7, 0xfc,
+		0x7d, 0x80, 0xe3, 0xd1, 0x1f, 0x7e, 0xdb, 0x6d, 0xd6, 0xa5, 0x5a, 0x67, 0x44, 0xc9, 0x56, 0xf0,
+		0x85, 0xd1, 0x69, 0x71, 0xfc, 0x3f, 0x62, 0xfc, 0x3e, 0xa9, 0xf4, 0x6b, 0x4b, 0x3f, 0x09, 0x94,
+		0xae, 0xbe, 0xb1, 0x63, 0x1a, 0xec, 0x87, 0x0a, 0xc5, 0x8e, 0x91, 0xe2, 0x67, 0xcd, 0x6e, 0xb9,
+		0x38, 0x6b, 0xde, 0x82, 0x19, 0x0f, 0x94, 0xad, 0x9e, 0xf4, 0x71, 0x57, 0x4f, 0xce, 0x25, 0x80,
+		0x20, 0xf8, 0x8b, 0x86, 0xde, 0xfa, 0xcc, 0x83, 0xc8, 0xe9, 0x19, 0xbb, 0xd2, 0x03, 0xaa, 0xdf,
+		0x23, 0x40, 0x3a, 0x30, 0xdf, 0x6d, 0x1b, 0x9a, 0x4d, 0x3b, 0xbb, 0x9a, 0xe0, 0x1c, 0xd2, 0xcd,
+		0xdc, 0x87, 0x99, 0x9e, 0xeb, 0xb6, 0x8d, 0x3a, 0xed, 0xec, 0x56, 0x5c, 0xb2, 0xe4, 0x3d, 0x70,
+		0xda, 0x63, 0x87, 0x69, 0x68, 0xfb, 0x66, 0xa7, 0xa5, 0x59, 0x74, 0x57, 0x6b, 0xb2, 0x37, 0x03,
+		0xb3, 0x8c, 0x89, 0x0f, 0xb8, 0x20, 0x9b, 0xc6, 0x75, 0xb3, 0xd3, 0x52, 0xe9, 0xee, 0x2a, 0x56,
+		0xe3, 0x49, 0xa4, 0x87, 0xdd, 0x6e, 0x61, 0x5a, 0x4f, 0x0c, 0xd3, 0xe2, 0xdd, 0xc2, 0x5a, 0xcb,
+		0x5e, 0x49, 0x7d, 0xf3, 0xc7, 0xce, 0x4e, 0x7d, 0xfe, 0x63, 0x67, 0xa7, 0x8a, 0x57, 0xd9, 0x0b,
+		0x57, 0x62, 0xd1, 0x51, 0x9b, 0x5c, 0x86, 0xb4, 0x2e, 0x3f, 0xf8, 0xfd, 0xb5, 0x23, 0x16, 0xad,
+		0x07, 0x5a, 0xfc, 0x58, 0x04, 0x92, 0x95, 0x9b, 0x5b, 0x7a, 0xdb, 0x22, 0x55, 0x7c, 0x1b, 0x55,
+		0x0a, 0xed, 0xa4, 0xeb, 0xdf, 0x93, 0x73, 0x51, 0x8e, 0x64, 0xc2, 0xb3, 0x0f, 0x8f, 0x24, 0x33,
+		0x98, 0x97, 0xe8, 0x1b, 0xea, 0x0d, 0x98, 0xe6, 0x3d, 0xb4, 0xc9, 0x4b, 0x90, 0xe8, 0xe1, 0x1f,
+		0xf9, 0x48, 0xe0, 0x95, 0xd4, 0x61, 0x41, 0x67, 0xf0, 0x7e, 0xb1, 0xe0, 0x78, 0xc5, 0x3f, 0x8b,
+		0x00, 0x54, 0x6e, 0xde, 0x6c, 0x58, 0xed, 0x5e, 0x87, 0x3a, 0xf7, 0x6b, 0xc8, 0x6b, 0x70, 0xc2,
+		0x1b, 0xb2, 0x6d, 0x35, 0x27, 0x1e, 0xf6, 0xbc, 0x8b, 0x56, 0xb7, 0x9a, 0xa1, 0xd4, 0x5a, 0xb6,
+		0xe3, 0x52, 0x8b, 0x4d, 0x4c, 0xad, 0x62, 0x3b, 0xc3, 0x7c, 0x7c, 0x15, 0x32, 0xde, 0xd0, 0x6d,
+		0x52, 0x83, 0x94, 0x23, 0xfe, 0x16, 0xec, 0x2c, 0x8e, 0x66, 0xa7, 0x44, 0xf3, 0xb3, 0xd4, 0x45,
+		0x2f, 0xfe, 0x2f, 0xe4, 0xaa, 0xb7, 0x10, 0xbe, 0xa2, 0x04, 0x09, 0x35, 0xbc, 0xd0, 0xc0, 0xb1,
+		0xfb, 0xa0, 0x81, 0x05, 0x2d, 0x1f, 0x5b, 0x3f, 0x1d, 0x81, 0x59, 0x6f, 0xf0, 0x6b, 0xfa, 0x0e,
+		0xed, 0xdc, 0x2f, 0x0e, 0x6c, 0x8c, 0xe6, 0x80, 0xdf, 0x50, 0xbd, 0x39, 0x30, 0xe4, 0x91, 0xac,
+		0x58, 0x80, 0x44, 0x07, 0xfb, 0x27, 0x2c, 0x0a, 0xfe, 0xe1, 0x1b, 0xca, 0x47, 0xa2, 0xa0, 0x94,
+		0xfa, 0x8e, 0xa9, 0xd2, 0xd6, 0x7d, 0x9f, 0x4d, 0x0d, 0x0a, 0xbb, 0x7a, 0xa7, 0xb3, 0xa3, 0xe3,
+		0xcd, 0x9f, 0x7b, 0x1f, 0x54, 0x5e, 0x12, 0x19, 0xac, 0x27, 0xeb, 0x90, 0x43, 0x7b, 0xc0, 0xbb,
+		0x41, 0x1c, 0x1b, 0x77, 0x83, 0x38, 0x27, 0x6f, 0x10, 0x73, 0x79, 0xce, 0x22, 0xba, 0xac, 0xf4,
+		0x71, 0x05, 0x75, 0xc6, 0x0d, 0xbd, 0xdd, 0x51, 0x69, 0xd3, 0xb4, 0x5a, 0xe1, 0x93, 0x12, 0xb9,
+		0xf7, 0x49, 0x59, 0xc1, 0xf3, 0x1e, 0xdd, 0x16, 0x79, 0x61, 0x47, 0x58, 0x2f, 0xbc, 0x0f, 0x08,
+		0xa9, 0x0a, 0x8c, 0x91, 0x91, 0xf3, 0x17, 0x27, 0x8e, 0x9c, 0x87, 0x39, 0x75, 0xbe, 0xb1, 0xff,
+		0x7c, 0x04, 0x4e, 0x04, 0xb7, 0xb9, 0x0a, 0xdd, 0x6d, 0x37, 0xdb, 0xce, 0x7d, 0x67, 0xc3, 0x55,
+		0x48, 0xb3, 0xe9, 0xbb, 0x37, 0x2b, 0x3c, 0x85, 0xb8, 0x8d, 0x60, 0xdf, 0xbf, 0x31, 0x8a, 0xbf,
+		0xfd, 0x2d, 0x76, 0xcf, 0xaf, 0x58, 0xf5, 0xb4, 0x0d, 0xd3, 0xd4, 0x70, 0xac, 0xb6, 0x7b, 0x1a,
+		0xff, 0xf4, 0xa8, 0xf9, 0x0f, 0x19, 0x4b, 0xd5, 0x70, 0xac, 0x43, 0xbf, 0x4e, 0x96, 0xb4, 0x7c,
+		0x6c, 0xf8, 0xc5, 0x18, 0xe4, 0x47, 0xa1, 0xa2, 0xfb, 0xde, 0xb4, 0x28, 0x2b, 0x08, 0xde, 0x13,
+		0x9f, 0x91, 0xc5, 0xc2, 0xd6, 0x53, 0x01, 0x3d, 0x27, 0x54, 0xf7, 0x08, 0x7a, 0x6f, 0x93, 0x34,
+		0xe3, 0x51, 0x40, 0x18, 0x42, 0x61, 0x56, 0x5e, 0x3c, 0xdb, 0xd1, 0x3b, 0x3a, 0x3e, 0xba, 0x10,
+		0xbb, 0x0f, 0xa6, 0x99, 0xbc, 0xcd, 0x56, 0xe6, 0x34, 0xc9, 0x4d, 0x98, 0x96, 0xe4, 0xe3, 0xf7,
+		0x81, 0xbc, 0x24, 0x86, 0x57, 0x10, 0xfd, 0x16, 0x1b, 0x73, 0x20, 0xe2, 0x6a, 0xc6, 0x2d, 0xab,
+		0xb5, 0xc6, 0x99, 0x84, 0xc9, 0x23, 0x4d, 0x42, 0xe1, 0xa3, 0xfd, 0x42, 0x0c, 0x53, 0x34, 0x5b,
+		0x7f, 0x05, 0x27, 0xee, 0xab, 0x00, 0xf8, 0x6e, 0x8b, 0x56, 0x50, 0x3e, 0x7e, 0x1f, 0x76, 0xef,
+		0x34, 0xa7, 0x57, 0xb1, 0x9d, 0x77, 0x6a, 0xf6, 0xfe, 0x75, 0x14, 0xb2, 0xfe, 0xd9, 0xfb, 0x2b,
+		0x60, 0x72, 0x92, 0x0d, 0x4f, 0xa5, 0xf1, 0xfb, 0x76, 0x8f, 0x8f, 0x52, 0x69, 0x43, 0x72, 0x3d,
+		0x46, 0x97, 0x7d, 0x08, 0x20, 0x29, 0xb2, 0x8a, 0x37, 0x87, 0x9c, 0xcf, 0xc8, 0x31, 0xf7, 0xfb,
+		0x01, 0xdf, 0xf3, 0x11, 0xc0, 0x77, 0x44, 0xb4, 0x40, 0xaa, 0x32, 0x1e, 0xe8, 0x62, 0x9c, 0xca,
+		0xbb, 0x98, 0x83, 0x71, 0x4c, 0x04, 0xf3, 0x74, 0x36, 0xc2, 0xe0, 0xd5, 0xc6, 0x2a, 0x2f, 0x21,
+		0x4f, 0x01, 0xd9, 0x77, 0x03, 0xc3, 0x9a, 0xc7, 0x08, 0x84, 0x9b, 0xf3, 0x6a, 0x24, 0x38, 0x3e,
+		0x99, 0x64, 0x1a, 0x2d, 0x8d, 0xbf, 0x37, 0x2f, 0xde, 0xee, 0xc7, 0x92, 0x0a, 0x16, 0x90, 0x6f,
+		0x8f, 0x70, 0x1f, 0x76, 0x20, 0x0c, 0x26, 0xa2, 0x09, 0xda, 0xf1, 0x56, 0xc3, 0x1f, 0xbf, 0x75,
+		0xb6, 0x70, 0xa8, 0x77, 0x3b, 0x2b, 0xc5, 0x10, 0x92, 0xc5, 0xb0, 0x20, 0x1d, 0xba, 0xb9, 0xc1,
+		0x88, 0x1a, 0xf9, 0xfa, 0x08, 0x2e, 0x0b, 0x6f, 0xde, 0xb4, 0x56, 0xdf, 0x76, 0x34, 0x67, 0xdf,
+		0xa2, 0x36, 0x2e, 0x90, 0xfc, 0xf4, 0xb1, 0x03, 0x84, 0x23, 0x5e, 0xe7, 0x3f, 0xe5, 0x6f, 0xa5,
+		0xd2, 0xb7, 0x9d, 0x86, 0x6c, 0x83, 0xbc, 0x00, 0xa7, 0x7c, 0x3c, 0xc6, 0x1c, 0x21, 0xdf, 0x83,
+		0x2a, 0x29, 0xb6, 0x92, 0x4f, 0xee, 0x07, 0xa2, 0xf3, 0xee, 0xcb, 0x2a, 0x35, 0x78, 0x28, 0xf0,
+		0x78, 0x96, 0xd6, 0x74, 0x83, 0xc3, 0xfc, 0x27, 0x41, 0x6e, 0xeb, 0x1d, 0x16, 0xc7, 0x88, 0xab,
+		0x8b, 0xb7, 0x43, 0x43, 0xe9, 0x35, 0x01, 0x45, 0x76, 0xe0, 0xa4, 0xde, 0x6a, 0xb5, 0xb1, 0x7b,
+		0xa8, 0x09, 0xdd, 0x59, 0xb4, 0xf3, 0x70, 0x74, 0x0c, 0xbf, 0x2c, 0xa7, 0xd7, 0x2f, 0xee, 0x0b,
+		0x1e, 0x2d, 0xb7, 0xde, 0x26, 0x3d, 0x58, 0x0a, 0x09, 0x61, 0x68, 0x7b, 0x16, 0xfe, 0xd4, 0x79,
+		0x8f, 0x5a, 0x6d, 0xb3, 0x95, 0xcf, 0x1c, 0x53, 0xf0, 0x1f, 0x1c, 0x8a, 0x5d, 0x5c, 0x43, 0x72,
+		0x5b, 0x8c, 0x1a, 0xe9, 0xc3, 0x09, 0x6c, 0xd1, 0xd7, 0x98, 0xde, 0x75, 0x03, 0x18, 0xf7, 0x65,
+		0x62, 0x51, 0xa0, 0xbd, 0xb6, 0x4b, 0x8c, 0x3a, 0xb1, 0xe1, 0xa1, 0xb6, 0xa1, 0x37, 0x9d, 0xf6,
+		0x6d, 0xea, 0xf3, 0x0f, 0x9a, 0x1d, 0xaa, 0x1b, 0xfd, 0x9e, 0x1c, 0x69, 0xee, 0x98, 0x23, 0x5d,
+		0x94, 0x24, 0xdd, 0x05, 0xbc, 0xca, 0x09, 0x8a, 0xb1, 0xde, 0x80, 0xe2, 0x11, 0x8d, 0xb6, 0xac,
+		0x43, 0xcd, 0xea, 0x1b, 0xec, 0x3e, 0x54, 0x6a, 0x34, 0xad, 0x8a, 0x75, 0xa8, 0xf6, 0x0d, 0x52,
+		0x86, 0x33, 0x22, 0x83, 0xd8, 0x31, 0xbb, 0x3b, 0xb6, 0x63, 0xe2, 0x2b, 0x6f, 0x1e, 0x17, 0x6d,
+		0x76, 0x01, 0x2a, 0xa5, 0x9e, 0xe6, 0x40, 0x0d, 0x17, 0xc6, 0xe3, 0x84, 0xbd, 0xf2, 0x2e, 0xdc,
+		0x33, 0xee, 0xfc, 0xee, 0x4f, 0x9e, 0x3f, 0xed, 0xe3, 0xe5, 0x81, 0x7b, 0x3a, 0xc7, 0x55, 0x1f,
+		0x9e, 0x3d, 0xa5, 0x5d, 0x11, 0x19, 0xf1, 0x5b, 0x15, 0x6f, 0xc0, 0x2c, 0x3b, 0x8b, 0xd0, 0x2c,
+		0xda, 0xea, 0x37, 0xbd, 0x9f, 0x1f, 0xba, 0x1f, 0xf3, 0x37, 0xc3, 0x28, 0xab, 0x92, 0xb0, 0xd8,
+		0xe9, 0x7e, 0x39, 0x02, 0xc4, 0x1b, 0x8b, 0x7b, 0x19, 0x71, 0x1d, 0xc0, 0x17, 0x7a, 0x8b, 0x1c,
+		0x1d, 0xd2, 0xf3, 0xf0, 0x03, 0x21, 0x3d, 0xdf, 0xf6, 0xf9, 0x5e, 0xcf, 0x98, 0x93, 0x2f, 0x44,
+		0x85, 0xfc, 0xd6, 0xc8, 0x32, 0xfe, 0x8a, 0x47, 0x60, 0x2f, 0x11, 0x48, 0x23, 0x5c, 0xe0, 0x38,
+		0xdb, 0x5d, 0xde, 0x8a, 0x60, 0x26, 0xfb, 0xc0, 0x8e, 0xe4, 0x0e, 0xa4, 0x09, 0xc4, 0xf2, 0x55,
+		0x32, 0xcd, 0x2e, 0xb3, 0xe2, 0xee, 0x6d, 0x83, 0x9b, 0xb3, 0x06, 0x6b, 0xdf, 0x2e, 0x5b, 0x55,
+		0x4c, 0xd1, 0xaf, 0x45, 0xf0, 0xb2, 0x6e, 0x6b, 0x78, 0x92, 0xea, 0x90, 0xf5, 0xf7, 0x45, 0x8c,
+		0xea, 0x5d, 0x93, 0x8c, 0xca, 0x3f, 0xa0, 0x00, 0x11, 0x1c, 0x8b, 0xdc, 0xfd, 0xf8, 0x99, 0xe6,
+		0x33, 0x13, 0x73, 0x49, 0x76, 0x2c, 0xd4, 0x1c, 0xe0, 0x93, 0xf5, 0x6d, 0x51, 0x88, 0x6f, 0x99,
+		0x66, 0x87, 0x7c, 0x43, 0x04, 0xe6, 0x0c, 0xd3, 0x61, 0xfa, 0x97, 0xb6, 0x34, 0x11, 0xcd, 0xe7,
+		0x16, 0xd5, 0xcd, 0xe3, 0x71, 0xef, 0x0b, 0x6f, 0x9d, 0x1d, 0x26, 0x15, 0xb6, 0x02, 0x66, 0x0d,
+		0xd3, 0x29, 0x33, 0xa0, 0x06, 0x83, 0x21, 0x6f, 0x42, 0x2e, 0xd8, 0x3e, 0x5f, 0x6c, 0xea, 0xb1,
+		0xdb, 0xcf, 0x8d, 0x6d, 0x3b, 0xbb, 0xe3, 0x6b, 0x98, 0xff, 0x7c, 0xc3, 0x1f, 0xe2, 0xe4, 0xbe,
+		0x06, 0xca, 0xcd, 0xc1, 0xfc, 0xec, 0x2a, 0x4c, 0xcb, 0x7c, 0xec, 0xc8, 0x84, 0xa9, 0xde, 0x7e,
+		0x8e, 0x0b, 0xdc, 0xf3, 0x3f, 0x1b, 0x01, 0xf0, 0xce, 0x4e, 0xf0, 0x25, 0xc3, 0xf2, 0xe6, 0x46,
+		0x45, 0xab, 0x37, 0x4a, 0x8d, 0xed, 0x7a, 0xf0, 0xd7, 0xc6, 0xe4, 0xbb, 0x87, 0x76, 0x8f, 0x36,
+		0xdb, 0xbb, 0x6d, 0xda, 0x22, 0x8f, 0xc2, 0x42, 0x10, 0x1a, 0xbf, 0xaa, 0x15, 0x25, 0x52, 0xc8,
+		0xde, 0xb9, 0xbb, 0x94, 0xe2, 0xae, 0x29, 0xc5, 0x57, 0xbc, 0x4f, 0x0c, 0xc3, 0xe1, 0x2f, 0x95,
+		0x45, 0x0b, 0xb9, 0x3b, 0x77, 0x97, 0xd2, 0xae, 0x0f, 0x4b, 0x8a, 0x40, 0xfc, 0x90, 0x82, 0x5e,
+		0xac, 0x00, 0x77, 0xee, 0x2e, 0x25, 0xf9, 0xb4, 0x14, 0xe2, 0xf8, 0xba, 0xe1, 0xf9, 0xaf, 0x01,
+		0xa8, 0x19, 0xbb, 0x96, 0xce, 0xf4, 0x14, 0x29, 0xc0, 0xc9, 0xda, 0xc6, 0x55, 0xb5, 0xb4, 0xda,
+		0xa8, 0x6d, 0x6e, 0x04, 0xbb, 0x3d, 0x50, 0x57, 0xd9, 0xdc, 0x2e, 0xaf, 0x55, 0x35, 0x7c, 0x4f,
+		0x91, 0xe7, 0xca, 0x05, 0xea, 0x5e, 0xd9, 0x68, 0xd4, 0xd6, 0xab, 0x4a, 0xf4, 0xfc, 0x37, 0xb9,
+		0x91, 0x21, 0x16, 0x8d, 0x39, 0x0d, 0x0f, 0xdc, 0x28, 0xd5, 0xd6, 0x34, 0xb5, 0x5a, 0xaa, 0x0f,
+		0x35, 0x90, 0x87, 0x05, 0x7f, 0xa5, 0x4b, 0x25, 0x32, 0x88, 0xe6, 0x6f, 0x3b, 0x4a, 0x1e, 0x86,
+		0xb3, 0xfe, 0xca, 0xf5, 0xda, 0x86, 0x56, 0xaf, 0xae, 0x5d, 0xd5, 0x2a, 0xd5, 0xb5, 0xea, 0xb5,
+		0x12, 0x76, 0x48, 0x89, 0x95, 0xaf, 0x8e, 0xcc, 0x09, 0x79, 0xf2, 0x48, 0xc1, 0xf3, 0x76, 0x96,
+		0x40, 0x62, 0xc8, 0xff, 0x19, 0x00, 0xa5, 0xcd, 0x28, 0x9c, 0x00, 0xaa, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2326,6 +2903,65 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.UndelegationDustThreshold.Equal(that1.UndelegationDustThreshold) {
+		return false
+	}
+	if this.HistoricalInfoMaxBytes != that1.HistoricalInfoMaxBytes {
+		return false
+	}
+	if this.ValidatorSetCheckpointInterval != that1.ValidatorSetCheckpointInterval {
+		return false
+	}
+	if len(this.AdditionalBondDenoms) != len(that1.AdditionalBondDenoms) {
+		return false
+	}
+	for i := range this.AdditionalBondDenoms {
+		if !this.AdditionalBondDenoms[i].Equal(&that1.AdditionalBondDenoms[i]) {
+			return false
+		}
+	}
+	if this.MinSelfDelegationGracePeriod != that1.MinSelfDelegationGracePeriod {
+		return false
+	}
+	if !this.MinDelegationAmount.Equal(that1.MinDelegationAmount) {
+		return false
+	}
+	if this.InactiveValidatorCleanupPeriod != that1.InactiveValidatorCleanupPeriod {
+		return false
+	}
+	if this.InactiveValidatorCleanupDryRun != that1.InactiveValidatorCleanupDryRun {
+		return false
+	}
+	if this.RejectTombstonedDelegations != that1.RejectTombstonedDelegations {
+		return false
+	}
+	return true
+}
+func (this *BondDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BondDenom)
+	if !ok {
+		that2, ok := that.(BondDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.PowerReduction.Equal(that1.PowerReduction) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2429,6 +3065,103 @@ func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSetCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorsHash) > 0 {
+		i -= len(m.ValidatorsHash)
+		copy(dAtA[i:], m.ValidatorsHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorsHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStaking(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2502,12 +3235,12 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintStaking(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintStaking(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2602,20 +3335,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.UnbondingIds) > 0 {
-		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
+		var j5 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStaking(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -2644,12 +3377,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintStaking(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2958,7 +3691,7 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+func (m *DelegationLabel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2968,29 +3701,22 @@ func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegationLabel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegationLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
@@ -3009,7 +3735,7 @@ func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *AutoRedelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3019,29 +3745,211 @@ func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoRedelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingOnHoldRefCount != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
-		i--
-		dAtA[i] = 0x30
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err11 != nil {
+		return 0, err11
 	}
-	if m.UnbondingId != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FallbackValidatorAddress) > 0 {
+		i -= len(m.FallbackValidatorAddress)
+		copy(dAtA[i:], m.FallbackValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.FallbackValidatorAddress)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	{
-		size := m.Balance.Size()
-		i -= size
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SelfDelegationDeficit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelfDelegationDeficit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelfDelegationDeficit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
 		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
@@ -3059,12 +3967,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3125,12 +4033,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3219,6 +4127,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RejectTombstonedDelegations {
+		i--
+		if m.RejectTombstonedDelegations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.InactiveValidatorCleanupDryRun {
+		i--
+		if m.InactiveValidatorCleanupDryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InactiveValidatorCleanupPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactiveValidatorCleanupPeriod):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinDelegationAmount.Size()
+		i -= size
+		if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinSelfDelegationGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.AdditionalBondDenoms) > 0 {
+		for iNdEx := len(m.AdditionalBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalBondDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ValidatorSetCheckpointInterval != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ValidatorSetCheckpointInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HistoricalInfoMaxBytes != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalInfoMaxBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.UndelegationDustThreshold.Size()
+		i -= size
+		if _, err := m.UndelegationDustThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3251,17 +4239,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *BondDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PowerReduction.Size()
+		i -= size
+		if _, err := m.PowerReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3282,6 +4310,13 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3503,7 +4538,50 @@ func (m *HistoricalInfo) Size() (n int) {
 	return n
 }
 
-func (m *CommissionRates) Size() (n int) {
+func (m *ValidatorSetCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.ValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovStaking(uint64(m.TotalPower))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3710,6 +4788,82 @@ func (m *Delegation) Size() (n int) {
 	return n
 }
 
+func (m *DelegationLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.FallbackValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovStaking(uint64(m.Reason))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SelfDelegationDeficit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *UnbondingDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3831,6 +4985,47 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.UndelegationDustThreshold.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.HistoricalInfoMaxBytes != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalInfoMaxBytes))
+	}
+	if m.ValidatorSetCheckpointInterval != 0 {
+		n += 1 + sovStaking(uint64(m.ValidatorSetCheckpointInterval))
+	}
+	if len(m.AdditionalBondDenoms) > 0 {
+		for _, e := range m.AdditionalBondDenoms {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinDelegationAmount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactiveValidatorCleanupPeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.InactiveValidatorCleanupDryRun {
+		n += 2
+	}
+	if m.RejectTombstonedDelegations {
+		n += 2
+	}
+	return n
+}
+
+func (m *BondDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.PowerReduction.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3844,6 +5039,10 @@ func (m *DelegationResponse) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4028,7 +5227,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4051,17 +5250,36 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4071,31 +5289,30 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4105,31 +5322,50 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorsHash == nil {
+				m.ValidatorsHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4139,23 +5375,23 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, CheckpointValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4180,7 +5416,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *CheckpointValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4203,17 +5439,17 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4223,30 +5459,29 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4256,25 +5491,11 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4296,7 +5517,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4319,15 +5540,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4355,11 +5576,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4387,11 +5610,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4419,14 +5644,66 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
 				}
@@ -4435,29 +5712,30 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4467,23 +5745,24 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4506,7 +5785,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *Description) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4529,15 +5808,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4565,13 +5844,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4581,33 +5860,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4617,17 +5892,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4637,14 +5924,27 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4672,13 +5972,61 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4706,13 +6054,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4739,15 +6085,18 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			m.UnbondingHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4757,16 +6106,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4776,19 +6126,158 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BondStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			if postIndex > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
@@ -4988,28 +6477,750 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowStaking
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5037,7 +7248,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5060,7 +7271,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *AutoRedelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5083,10 +7294,10 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRedelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5123,7 +7334,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5151,61 +7362,11 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.FallbackValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5232,8 +7393,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5258,7 +7418,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,15 +7441,15 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5317,13 +7477,13 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var stringLen uint64
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5333,29 +7493,35 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Reason |= JailReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5365,23 +7531,24 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5404,7 +7571,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *SelfDelegationDeficit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5427,15 +7594,47 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: SelfDelegationDeficit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SelfDelegationDeficit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5462,8 +7661,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5488,7 +7686,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5511,10 +7709,10 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5583,9 +7781,9 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5595,23 +7793,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5636,7 +7834,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,15 +7857,67 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5695,11 +7945,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5727,13 +7979,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			var msglen int
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5743,26 +7997,30 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5784,7 +8042,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5807,10 +8065,10 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5901,7 +8159,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5929,7 +8187,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5992,7 +8250,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6015,36 +8273,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6054,28 +8293,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6103,13 +8341,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6137,15 +8373,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.UnbondingId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6155,30 +8389,26 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6200,7 +8430,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6223,15 +8453,105 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6259,11 +8579,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6291,11 +8611,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationDustThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6323,11 +8645,51 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.UndelegationDustThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalInfoMaxBytes", wireType)
+			}
+			m.HistoricalInfoMaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalInfoMaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCheckpointInterval", wireType)
+			}
+			m.ValidatorSetCheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCheckpointInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalBondDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6354,66 +8716,49 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalBondDenoms = append(m.AdditionalBondDenoms, BondDenom{})
+			if err := m.AdditionalBondDenoms[len(m.AdditionalBondDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationGracePeriod", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinSelfDelegationGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6423,30 +8768,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorCleanupPeriod", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6456,16 +8802,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InactiveValidatorCleanupPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorCleanupDryRun", wireType)
 			}
-			m.MaxEntries = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6475,16 +8835,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.InactiveValidatorCleanupDryRun = bool(v != 0)
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTombstonedDelegations", wireType)
 			}
-			m.HistoricalEntries = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6494,14 +8855,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.RejectTombstonedDelegations = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6529,11 +8941,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerReduction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6561,7 +8973,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PowerReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6681,6 +9093,38 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -325,6 +325,16 @@ func (v Validator) SharesFromTokensTruncated(amt math.Int) (math.LegacyDec, erro
 	return v.GetDelegatorShares().MulInt(amt).QuoTruncate(math.LegacyNewDecFromInt(v.GetTokens())), nil
 }
 
+// SharesFromTokensRoundUp returns the shares of a delegation given a bond
+// amount, rounded up. It returns an error if the validator has no tokens.
+func (v Validator) SharesFromTokensRoundUp(amt math.Int) (math.LegacyDec, error) {
+	if v.Tokens.IsZero() {
+		return math.LegacyZeroDec(), ErrInsufficientShares
+	}
+
+	return v.GetDelegatorShares().MulInt(amt).QuoRoundUp(math.LegacyNewDecFromInt(v.GetTokens())), nil
+}
+
 // get the bonded tokens which the validator holds
 func (v Validator) BondedTokens() math.Int {
 	if v.IsBonded() {
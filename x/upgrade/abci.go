@@ -45,6 +45,14 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 				panic(fmt.Sprintf("Wrong app version %d, upgrade handler is missing for %s upgrade plan", appVersion, lastAppliedPlan))
 			}
 		}
+
+		// This check will make sure that the module consensus versions of the binary match the ones recorded on chain
+		// by the applied upgrades, so that a binary which skipped or predates an upgrade refuses to start instead of
+		// diverging from the network.
+		if err := k.CheckBinaryCompatibility(ctx, k.GetInitVersionMap()); err != nil {
+			ctx.Logger().Error(err.Error())
+			panic(err.Error())
+		}
 	}
 
 	if !found {
@@ -550,3 +550,38 @@ func TestDowngradeVerification(t *testing.T) {
 		}
 	}
 }
+
+func TestBinaryCompatibilityVerification(t *testing.T) {
+	testCases := map[string]struct {
+		binaryVM    module.VersionMap
+		expectPanic bool
+	}{
+		"matching binary": {
+			binaryVM: module.VersionMap{"bank": 2, "staking": 3},
+		},
+		"binary predates the applied upgrade": {
+			binaryVM:    module.VersionMap{"bank": 1, "staking": 3},
+			expectPanic: true,
+		},
+		"binary skipped an upgrade": {
+			binaryVM:    module.VersionMap{"bank": 2, "staking": 4},
+			expectPanic: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s := setupTest(t, 10, map[int64]bool{})
+		s.keeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": 2, "staking": 3})
+		s.keeper.SetInitVersionMap(tc.binaryVM)
+
+		if tc.expectPanic {
+			require.Panics(t, func() {
+				s.module.BeginBlock(s.ctx)
+			}, name)
+		} else {
+			require.NotPanics(t, func() {
+				s.module.BeginBlock(s.ctx)
+			}, name)
+		}
+	}
+}
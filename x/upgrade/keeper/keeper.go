@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -468,3 +469,54 @@ func (k *Keeper) SetDowngradeVerified(v bool) {
 func (k Keeper) DowngradeVerified() bool {
 	return k.downgradeVerified
 }
+
+// CheckBinaryCompatibility compares the consensus versions of the modules registered in the binary, vm, against the
+// module versions recorded on chain by genesis and the applied upgrades. It returns an error reporting every module for
+// which the binary predates the chain state, or is ahead of it while no upgrade with a handler in this binary is due at
+// the current height, meaning that the binary skipped one or more upgrades.
+// Modules only known to the chain are ignored, since modules deleted by an upgrade are kept in the version map.
+func (k Keeper) CheckBinaryCompatibility(ctx sdk.Context, vm module.VersionMap) error {
+	chainVM := k.GetModuleVersionMap(ctx)
+	if len(vm) == 0 || len(chainVM) == 0 {
+		// the chain state predates the module version map or genesis was not run yet
+		return nil
+	}
+
+	plan, found := k.GetUpgradePlan(ctx)
+	upgradeDue := found && plan.ShouldExecute(ctx) && !k.IsSkipHeight(ctx.BlockHeight()) && k.HasHandler(plan.Name)
+
+	moduleNames := make([]string, 0, len(vm))
+	for name := range vm {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	var mismatches []string
+	for _, name := range moduleNames {
+		binaryVersion := vm[name]
+		chainVersion, ok := chainVM[name]
+		switch {
+		case !ok && !upgradeDue:
+			mismatches = append(mismatches, fmt.Sprintf("module %s: registered in the binary at version %d but not found on chain", name, binaryVersion))
+		case !ok:
+			continue
+		case binaryVersion < chainVersion:
+			mismatches = append(mismatches, fmt.Sprintf("module %s: binary version %d predates on-chain version %d", name, binaryVersion, chainVersion))
+		case binaryVersion > chainVersion && !upgradeDue:
+			mismatches = append(mismatches, fmt.Sprintf("module %s: binary version %d is ahead of on-chain version %d", name, binaryVersion, chainVersion))
+		}
+	}
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	lastAppliedPlan, lastAppliedHeight := k.GetLastCompletedUpgrade(ctx)
+	history := "no upgrade applied"
+	if lastAppliedPlan != "" {
+		history = fmt.Sprintf("last applied upgrade %q at height %d", lastAppliedPlan, lastAppliedHeight)
+	}
+
+	return fmt.Errorf("binary is incompatible with the chain state at height %d (%s):\n  %s",
+		ctx.BlockHeight(), history, strings.Join(mismatches, "\n  "))
+}
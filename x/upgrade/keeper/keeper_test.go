@@ -369,6 +369,44 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 	require.Equal(int64(15), height)
 }
 
+func (s *KeeperTestSuite) TestCheckBinaryCompatibility() {
+	keeper := s.upgradeKeeper
+	require := s.Require()
+
+	s.T().Log("verify the check is skipped if the chain has no module version map")
+	require.NoError(keeper.CheckBinaryCompatibility(s.ctx, module.VersionMap{"bank": 2}))
+
+	keeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": 2, "staking": 3, "crisis": 1})
+	keeper.SetUpgradeHandler("v2", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "v2", Height: 10})
+
+	s.T().Log("verify modules only known to the chain are ignored")
+	require.NoError(keeper.CheckBinaryCompatibility(s.ctx, module.VersionMap{"bank": 2, "staking": 3}))
+
+	s.T().Log("verify a binary predating the chain state is rejected")
+	err := keeper.CheckBinaryCompatibility(s.ctx, module.VersionMap{"bank": 1, "staking": 3})
+	require.ErrorContains(err, `last applied upgrade "v2" at height 10`)
+	require.ErrorContains(err, "module bank: binary version 1 predates on-chain version 2")
+
+	s.T().Log("verify a binary ahead of the chain state is rejected if no upgrade is due")
+	err = keeper.CheckBinaryCompatibility(s.ctx, module.VersionMap{"bank": 2, "staking": 4, "nft": 1})
+	require.ErrorContains(err, "module nft: registered in the binary at version 1 but not found on chain")
+	require.ErrorContains(err, "module staking: binary version 4 is ahead of on-chain version 3")
+
+	s.T().Log("verify a binary ahead of the chain state is accepted if it applies the upgrade due")
+	require.NoError(keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "v3", Height: 11}))
+	ctx := s.ctx.WithBlockHeight(11)
+	require.Error(keeper.CheckBinaryCompatibility(ctx, module.VersionMap{"bank": 2, "staking": 4, "nft": 1}))
+
+	keeper.SetUpgradeHandler("v3", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	require.NoError(keeper.CheckBinaryCompatibility(ctx, module.VersionMap{"bank": 2, "staking": 4, "nft": 1}))
+	require.Error(keeper.CheckBinaryCompatibility(s.ctx, module.VersionMap{"bank": 2, "staking": 4, "nft": 1}))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }